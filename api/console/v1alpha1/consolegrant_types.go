@@ -0,0 +1,106 @@
+/*
+Copyright 2026 The Holos Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// GrantSet identifies which list of project grants a ConsoleGrant belongs
+// to. Each set corresponds to one of the grant annotations it replaces.
+// +kubebuilder:validation:Enum=Share;RBAC;Default
+type GrantSet string
+
+const (
+	// GrantSetShare holds the grants shown and edited in the console, with
+	// users identified by email.
+	GrantSetShare GrantSet = "Share"
+	// GrantSetRBAC holds the user grants resolved to OIDC subjects for the
+	// Kubernetes RoleBindings.
+	GrantSetRBAC GrantSet = "RBAC"
+	// GrantSetDefault holds the grants copied onto new secrets in the
+	// project.
+	GrantSetDefault GrantSet = "Default"
+)
+
+// SubjectKind is the kind of principal a ConsoleGrant names.
+// +kubebuilder:validation:Enum=User;Group
+type SubjectKind string
+
+const (
+	// SubjectKindUser names a user by email or OIDC subject.
+	SubjectKindUser SubjectKind = "User"
+	// SubjectKindGroup names an OIDC group (role claim value).
+	SubjectKindGroup SubjectKind = "Group"
+)
+
+// ConsoleGrantSpec is a single share grant on the project in the grant's
+// namespace.
+type ConsoleGrantSpec struct {
+	// Set is the grant list this grant belongs to.
+	Set GrantSet `json:"set"`
+	// SubjectKind is the kind of principal.
+	SubjectKind SubjectKind `json:"subjectKind"`
+	// Principal is the user or group the grant applies to.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=1024
+	Principal string `json:"principal"`
+	// Role is the role granted. Ignored for deny grants.
+	// +kubebuilder:validation:Enum=viewer;editor;owner
+	// +optional
+	Role string `json:"role,omitempty"`
+	// Deny excludes the principal instead of granting a role.
+	// +optional
+	Deny bool `json:"deny,omitempty"`
+	// NotBefore is the time the grant becomes active.
+	// +optional
+	NotBefore *metav1.Time `json:"notBefore,omitempty"`
+	// Expires is the time the grant stops being active.
+	// +optional
+	Expires *metav1.Time `json:"expires,omitempty"`
+}
+
+// ConsoleGrant is one share grant on the project backed by the namespace it
+// lives in. Storing one object per grant lifts the annotation size limit on
+// the number of grants a project can hold.
+//
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Namespaced,shortName=cgrant,categories=holos
+// +kubebuilder:printcolumn:name="Set",type=string,JSONPath=`.spec.set`
+// +kubebuilder:printcolumn:name="Kind",type=string,JSONPath=`.spec.subjectKind`
+// +kubebuilder:printcolumn:name="Principal",type=string,JSONPath=`.spec.principal`
+// +kubebuilder:printcolumn:name="Role",type=string,JSONPath=`.spec.role`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+type ConsoleGrant struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ConsoleGrantSpec `json:"spec,omitempty"`
+}
+
+// ConsoleGrantList contains a list of ConsoleGrant.
+//
+// +kubebuilder:object:root=true
+type ConsoleGrantList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ConsoleGrant `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ConsoleGrant{}, &ConsoleGrantList{})
+}
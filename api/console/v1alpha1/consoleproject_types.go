@@ -0,0 +1,74 @@
+/*
+Copyright 2026 The Holos Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ConsoleProjectName is the name of the single ConsoleProject stored in each
+// project namespace.
+const ConsoleProjectName = "project"
+
+// ConsoleProjectSpec holds the console metadata of a project. It replaces the
+// display-name, description and creator annotations on the project
+// namespace.
+type ConsoleProjectSpec struct {
+	// DisplayName is the human-readable project name shown in the console.
+	// +kubebuilder:validation:MaxLength=253
+	// +optional
+	DisplayName string `json:"displayName,omitempty"`
+	// Description is a free-form description of the project.
+	// +kubebuilder:validation:MaxLength=4096
+	// +optional
+	Description string `json:"description,omitempty"`
+	// CreatorEmail is the email address of the user who created the project.
+	// +optional
+	CreatorEmail string `json:"creatorEmail,omitempty"`
+	// CreatorSubject is the OIDC subject of the user who created the project.
+	// +optional
+	CreatorSubject string `json:"creatorSubject,omitempty"`
+}
+
+// ConsoleProject is the console metadata of the project backed by the
+// namespace it lives in. The ConsoleGrant objects in the same namespace
+// hold the project's share grants.
+//
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Namespaced,shortName=cproj,categories=holos
+// +kubebuilder:printcolumn:name="Display Name",type=string,JSONPath=`.spec.displayName`
+// +kubebuilder:printcolumn:name="Creator",type=string,JSONPath=`.spec.creatorEmail`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+type ConsoleProject struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ConsoleProjectSpec `json:"spec,omitempty"`
+}
+
+// ConsoleProjectList contains a list of ConsoleProject.
+//
+// +kubebuilder:object:root=true
+type ConsoleProjectList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ConsoleProject `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ConsoleProject{}, &ConsoleProjectList{})
+}
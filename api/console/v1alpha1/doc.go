@@ -0,0 +1,26 @@
+/*
+Copyright 2026 The Holos Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package v1alpha1 contains API Schema definitions for the console.holos.run
+// v1alpha1 API group. ConsoleProject and ConsoleGrant are an alternative to
+// the console.holos.run annotations on project namespaces: annotations are
+// limited in size and have no schema, while these kinds are validated by the
+// API server and store one object per grant. The console selects the
+// storage backend with --resource-store.
+//
+// +kubebuilder:object:generate=true
+// +groupName=console.holos.run
+package v1alpha1
@@ -16,6 +16,14 @@ const (
 	LabelOrganization = "console.holos.run/organization"
 	LabelFolder       = "console.holos.run/folder"
 	LabelProject      = "console.holos.run/project"
+	// LabelExternalSecretsManaged is set to ExternalSecretsManagedValue by
+	// External Secrets Operator on the Secrets it syncs. The console lists
+	// these Secrets read-only when external secrets are enabled.
+	LabelExternalSecretsManaged = "reconcile.external-secrets.io/managed"
+	// LabelDeleted is set to DeletedValue on soft-deleted Secrets and
+	// project Namespaces. The console hides labeled resources and purges
+	// them once the trash retention window passes.
+	LabelDeleted = "console.holos.run/deleted"
 
 	// Label values.
 	ManagedByValue           = "console.holos.run"
@@ -23,6 +31,11 @@ const (
 	ResourceTypeFolder       = "folder"
 	ResourceTypeProject      = "project"
 	ResourceTypeDeployment   = "deployment"
+	// ExternalSecretsManagedValue is the LabelExternalSecretsManaged value
+	// on Secrets synced by External Secrets Operator.
+	ExternalSecretsManagedValue = "true"
+	// DeletedValue is the LabelDeleted value on soft-deleted resources.
+	DeletedValue = "true"
 	// ResourceTypeTemplatePolicyBinding is the resource type label value for
 	// TemplatePolicyBinding ConfigMaps. A TemplatePolicyBinding attaches a
 	// single TemplatePolicy to an explicit list of project templates and/or
@@ -42,6 +55,29 @@ const (
 	// namespaces and authorize cross-namespace template references, mirroring
 	// the Gateway API ReferenceGrant pattern.
 	ResourceTypeTemplateGrant = "template-grant"
+	// ResourceTypeAccessRequest is the resource type label value for
+	// break-glass access request ConfigMaps. Requests live in the project
+	// namespace they ask for access to and are written by the console
+	// service account.
+	ResourceTypeAccessRequest = "access-request"
+	// ResourceTypeSecretTemplate is the resource type label value for secret
+	// template ConfigMaps. Templates live in the organization or project
+	// namespace that defines them and are written by the console service
+	// account.
+	ResourceTypeSecretTemplate = "secret-template"
+	// ResourceTypeAPIToken is the resource type label value for the Secrets
+	// that hold hashed console API tokens. Tokens live in the console
+	// namespace and are written by the console service account.
+	ResourceTypeAPIToken = "api-token"
+	// ResourceTypeGroups is the resource type label value for the ConfigMap
+	// holding console-local group memberships. It lives in the console
+	// namespace and is written by the console service account.
+	ResourceTypeGroups = "groups"
+	// ResourceTypeInvitations is the resource type label value for the
+	// ConfigMap holding the registry of users who have signed in and the
+	// pending invitations of those who have not. It lives in the console
+	// namespace and is written by the console service account.
+	ResourceTypeInvitations = "invitations"
 
 	// Annotations.
 	AnnotationDisplayName    = "console.holos.run/display-name"
@@ -51,6 +87,58 @@ const (
 	AnnotationShareUsers     = "console.holos.run/share-users"
 	AnnotationShareRoles     = "console.holos.run/share-roles"
 	AnnotationRBACShareUsers = "console.holos.run/rbac-share-users"
+	// AnnotationShareKeyUsers and AnnotationShareKeyRoles store key-scoped
+	// secret sharing grants as JSON. Kubernetes RBAC cannot restrict access
+	// to individual Secret data keys, so these grants live on the Secret and
+	// are evaluated by SecretsService.GetSecretKey.
+	AnnotationShareKeyUsers = "console.holos.run/share-key-users"
+	AnnotationShareKeyRoles = "console.holos.run/share-key-roles"
+	// AnnotationShareDenyUsers and AnnotationShareDenyRoles store deny
+	// grants as JSON. Kubernetes RBAC is purely additive, so an explicit
+	// exclusion from a Secret lives on the Secret and is enforced by
+	// SecretsService ahead of any project or organization grant.
+	AnnotationShareDenyUsers = "console.holos.run/share-deny-users"
+	AnnotationShareDenyRoles = "console.holos.run/share-deny-roles"
+	// AnnotationRotationWebhook holds an HTTPS URL that SecretsService
+	// notifies after RotateSecret stores new values for the Secret.
+	AnnotationRotationWebhook = "console.holos.run/rotation-webhook"
+	// AnnotationRotatedAt records the RFC 3339 time of the Secret's most
+	// recent RotateSecret.
+	AnnotationRotatedAt = "console.holos.run/rotated-at"
+	// AnnotationContentTypes holds a JSON object mapping Secret data keys to
+	// the media type of their value, recorded when a file is uploaded so
+	// the value downloads with the same type.
+	AnnotationContentTypes = "console.holos.run/content-types"
+	// AnnotationEncryptionKey holds the base64 data key, wrapped by the key
+	// encryption key named by AnnotationEncryptionKeyID, that the Secret's
+	// values are encrypted under when secret encryption at rest is enabled.
+	AnnotationEncryptionKey   = "console.holos.run/encryption-key"
+	AnnotationEncryptionKeyID = "console.holos.run/encryption-key-id"
+	// AnnotationVaultPath holds the HashiCorp Vault API path, e.g.
+	// "secret/data/team/db", that SecretsService reads the Secret's values
+	// from. The Kubernetes Secret then carries only metadata and sharing.
+	AnnotationVaultPath = "console.holos.run/vault-path"
+	// AnnotationLastAccessedAt and AnnotationLastAccessedBy record the RFC
+	// 3339 time and the email of the most recent GetSecret that read the
+	// Secret's values. SecretsService refreshes them at most hourly and
+	// writes them as FieldManagerAccessTracking so the write does not count
+	// as a modification of the Secret.
+	AnnotationLastAccessedAt = "console.holos.run/last-accessed-at"
+	AnnotationLastAccessedBy = "console.holos.run/last-accessed-by"
+	// FieldManagerAccessTracking is the field manager SecretsService uses to
+	// write AnnotationLastAccessedAt and AnnotationLastAccessedBy.
+	FieldManagerAccessTracking = "holos-console-access-tracking"
+	// FieldManagerApply is the field manager ApplySecretRaw server-side
+	// applies Secret manifests as, so fields a manifest omits on a later
+	// apply are removed only if an earlier manifest set them.
+	FieldManagerApply = "holos-console-apply"
+	// AnnotationDeletedAt and AnnotationDeletedBy record the RFC 3339 time
+	// a resource was soft-deleted and the email of the principal who
+	// deleted it. AnnotationDeletedGrants holds a JSON object of the grant
+	// annotations stripped at deletion, restored verbatim on undo.
+	AnnotationDeletedAt     = "console.holos.run/deleted-at"
+	AnnotationDeletedBy     = "console.holos.run/deleted-by"
+	AnnotationDeletedGrants = "console.holos.run/deleted-grants"
 	// AnnotationDefaultShareUsers specifies the default share users annotation.
 	// This annotation appears on org, folder, and project namespaces and drives
 	// the default-share cascade chain applied when a new Secret is created
@@ -66,6 +154,18 @@ const (
 	AnnotationURL               = "console.holos.run/url"
 	AnnotationEnabled           = "console.holos.run/enabled"
 	AnnotationSettings          = "console.holos.run/project-settings"
+	// AnnotationQuotaMaxSecrets and AnnotationQuotaMaxSecretBytes limit the
+	// number of console-managed Secrets and their total data size in bytes.
+	// On a project Namespace they set the project's quota; on an
+	// organization Namespace they set the default for the organization's
+	// projects. A missing annotation or zero means unlimited.
+	AnnotationQuotaMaxSecrets     = "console.holos.run/quota-max-secrets"
+	AnnotationQuotaMaxSecretBytes = "console.holos.run/quota-max-secret-bytes"
+	// AnnotationNotifications holds the JSON notification settings of an
+	// organization: which audit actions to notify on and the email
+	// recipients and webhooks to notify. Lives on the organization
+	// namespace; see console/notifications.
+	AnnotationNotifications = "console.holos.run/notifications"
 	// AnnotationGatewayNamespace stores the Kubernetes namespace that hosts
 	// the platform Gateway referenced by templates rendered for an
 	// organization. Lives on the organization namespace; surfaced to template
@@ -12,16 +12,31 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/holos-run/holos-console/console"
+	"github.com/holos-run/holos-console/console/k8sretry"
+	"github.com/holos-run/holos-console/console/logging"
+	"github.com/holos-run/holos-console/console/nsscope"
+	"github.com/holos-run/holos-console/console/oidc"
+	"github.com/holos-run/holos-console/console/resourcestore"
+	"github.com/holos-run/holos-console/console/rpc"
+	"github.com/holos-run/holos-console/console/vault"
 )
 
 var (
 	listenAddr         string
+	adminListenAddr    string
+	metricsTokenFile   string
+	enableProfiling    bool
+	shutdownTimeout    time.Duration
+	shutdownDelay      time.Duration
+	maxRequestBytes    int64
 	certFile           string
 	keyFile            string
 	caCertFile         string
 	plainHTTP          bool
+	clientCAFile       string
 	origin             string
 	issuer             string
+	frontendAuthority  string
 	clientID           string
 	idTokenTTL         string
 	refreshTokenTTL    string
@@ -29,14 +44,95 @@ var (
 	organizationPrefix string
 	folderPrefix       string
 	projectPrefix      string
+	namespaceAllowlist string
+	namespaceDenylist  string
+	namespaceSelector  string
+	staticProjects     string
 	disableOrgCreation bool
 	orgCreatorUsers    string
 	orgCreatorRoles    string
+	orgCreatorsFile    string
 	rolesClaim         string
+	maxTokenAge        time.Duration
+	stepUp             string
 	enableInsecureDex  bool
+	dexConnectors      string
 	enableDevTools     bool
 	logHealthChecks    bool
 	logLevel           string
+	logFormat          string
+	resourceStore      string
+	auditBufferSize    int
+	auditSinkURL       string
+
+	enableServiceAccountAuth bool
+	serviceAccountAudiences  string
+	apiTokensNamespace       string
+	groupsNamespace          string
+
+	trustedProxyCIDRs            string
+	trustedProxySignatureKeyFile string
+
+	rateLimitPrincipalRPS   float64
+	rateLimitPrincipalBurst int
+	rateLimitIPRPS          float64
+	rateLimitIPBurst        int
+	rateLimitClientIPHeader string
+
+	rpcReadTimeout     time.Duration
+	rpcListTimeout     time.Duration
+	rpcWriteTimeout    time.Duration
+	rpcMethodTimeouts  string
+	rpcGetCacheControl string
+
+	tracingEndpoint    string
+	tracingInsecure    bool
+	tracingSampleRatio float64
+
+	externalSecrets bool
+
+	k8sRetryAttempts int
+	k8sRetryBudget   time.Duration
+
+	enableSessions bool
+	sessionKeyFile string
+
+	clustersKubeconfig string
+	trashRetention     time.Duration
+	sealedSecretsCert  string
+	enableShareLinks   bool
+	shareLinkKeyFile   string
+
+	secretEncryptionKeyFile          string
+	secretEncryptionPreviousKeyFiles []string
+
+	secretMetricsLabels     []string
+	secretMetricsMaxSecrets int
+
+	vaultAddress   string
+	vaultRole      string
+	vaultAuthMount string
+
+	invitationsNamespace string
+	enableNotifications  bool
+	kubeEvents           bool
+	smtpAddress          string
+	smtpFrom             string
+	smtpUsername         string
+	smtpPasswordFile     string
+
+	grantExpiryWarning    time.Duration
+	expiredGrantRetention time.Duration
+
+	contentSecurityPolicy string
+	frameOptions          string
+	referrerPolicy        string
+	hstsMaxAge            time.Duration
+
+	maintenanceMode    bool
+	maintenanceMessage string
+	platformOwnerUsers string
+	platformOwnerRoles string
 )
 
 // Command returns the root cobra command for the CLI.
@@ -52,14 +148,22 @@ func Command() *cobra.Command {
 		SilenceUsage:  true,
 		SilenceErrors: true,
 		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
-			level, err := parseLogLevel(logLevel)
+			// Only the server, config validate, preflight and migrate take server
+			// settings.
+			if cmd.Flags().Lookup("config") != nil {
+				if err := loadConfig(cmd.Flags()); err != nil {
+					return err
+				}
+			}
+			level, err := logging.ParseLevel(logLevel)
 			if err != nil {
 				return err
 			}
-			logger := slog.New(slog.NewJSONHandler(os.Stderr, &slog.HandlerOptions{
-				Level: level,
-			}))
-			slog.SetDefault(logger)
+			handler, err := logging.NewHandler(os.Stderr, logFormat, level)
+			if err != nil {
+				return err
+			}
+			slog.SetDefault(slog.New(handler))
 			return nil
 		},
 		RunE: Run,
@@ -73,17 +177,33 @@ func Command() *cobra.Command {
 	cmd.PersistentFlags().Lookup("help").Hidden = true
 
 	// Server flags
+	cmd.Flags().StringVar(&configFile, "config", "", "YAML file of server settings keyed by flag name; flags and "+envPrefix+"* environment variables override it")
 	cmd.Flags().StringVar(&listenAddr, "listen", ":8443", "Address to listen on")
+	cmd.Flags().StringVar(&adminListenAddr, "admin-listen", "localhost:9090", "Plain HTTP address serving /healthz, /readyz, /metrics, and /api/debug/oidc; use :9090 for Kubernetes probes, or empty to serve them on --listen")
+	cmd.Flags().BoolVar(&enableProfiling, "enable-profiling", false, "Serve pprof profiles under /debug/pprof/ and runtime stats at /debug/vars on --admin-listen")
+	cmd.Flags().StringVar(&metricsTokenFile, "metrics-token-file", "", "File holding a bearer token /metrics requires (default: no token)")
+	cmd.Flags().DurationVar(&shutdownTimeout, "shutdown-timeout", 30*time.Second, "How long shutdown waits for in-flight requests, such as uploads, before closing their connections")
+	cmd.Flags().DurationVar(&shutdownDelay, "shutdown-delay", 0, "How long to keep serving with /readyz not ready before draining, so load balancers stop routing (e.g. 5s)")
+	cmd.Flags().Int64Var(&maxRequestBytes, "max-request-bytes", 4<<20, "Largest request body accepted; larger RPC messages fail with ResourceExhausted")
 	cmd.Flags().StringVar(&certFile, "cert", "", "TLS certificate file (auto-generated if empty)")
 	cmd.Flags().StringVar(&keyFile, "key", "", "TLS key file (auto-generated if empty)")
 	cmd.Flags().StringVar(&caCertFile, "ca-cert", "", "PEM-encoded CA certificate file to trust (e.g., mkcert CA root)")
 	cmd.Flags().BoolVar(&plainHTTP, "plain-http", false, "Listen on plain HTTP instead of HTTPS")
+	cmd.Flags().StringVar(&clientCAFile, "client-ca-file", "", "PEM-encoded CA bundle for client certificates; when set, API routes require a verified client certificate and the UI stays on regular TLS")
+
+	// Security header flags
+	cmd.Flags().StringVar(&contentSecurityPolicy, "content-security-policy", "", "Content-Security-Policy for every response, with {nonce} replaced per request; \"off\" omits it (default: same-origin policy allowing the OIDC authority)")
+	cmd.Flags().StringVar(&frameOptions, "frame-options", "DENY", "X-Frame-Options header value; empty omits it")
+	cmd.Flags().StringVar(&referrerPolicy, "referrer-policy", "same-origin", "Referrer-Policy header value; empty omits it")
+	cmd.Flags().DurationVar(&hstsMaxAge, "hsts-max-age", 365*24*time.Hour, "Strict-Transport-Security max-age sent over TLS; 0 omits it")
 
 	// OIDC flags
 	cmd.Flags().BoolVar(&enableInsecureDex, "enable-insecure-dex", false, "Enable the built-in Dex OIDC provider with auto-login (INSECURE: intended for local development only)")
+	cmd.Flags().StringVar(&dexConnectors, "dex-connectors-config", "", "YAML or JSON file listing upstream Dex connectors (ldap, github, google, oidc) for the built-in OIDC provider; credential values may be env:NAME or file:/path references")
 	cmd.Flags().BoolVar(&enableDevTools, "enable-dev-tools", false, "Enable development tools in the web UI (persona switcher, token panel)")
 	cmd.Flags().StringVar(&origin, "origin", "", "Public-facing base URL of the console for OIDC redirect URIs (e.g., https://holos-console.example.com)")
 	cmd.Flags().StringVar(&issuer, "issuer", "", "OIDC issuer URL for token validation (e.g., https://idp.example.com/dex)")
+	cmd.Flags().StringVar(&frontendAuthority, "frontend-authority", "", "OIDC authority the web UI signs in against when it differs from --issuer (default: --issuer)")
 	cmd.Flags().StringVar(&clientID, "client-id", "holos-console", "Expected audience for tokens")
 
 	// Token TTL flags
@@ -95,16 +215,88 @@ func Command() *cobra.Command {
 	cmd.Flags().StringVar(&organizationPrefix, "organization-prefix", "org-", "Prefix for organization namespace names")
 	cmd.Flags().StringVar(&folderPrefix, "folder-prefix", "fld-", "Prefix for folder namespace names")
 	cmd.Flags().StringVar(&projectPrefix, "project-prefix", "prj-", "Prefix for project namespace names")
+	cmd.Flags().StringVar(&namespaceAllowlist, "namespace-allowlist", "", "Comma-separated project namespace names or glob patterns the console manages (default: all)")
+	cmd.Flags().StringVar(&namespaceDenylist, "namespace-denylist", "", "Comma-separated project namespace names or glob patterns the console ignores, overriding --namespace-allowlist")
+	cmd.Flags().StringVar(&namespaceSelector, "namespace-selector", "", "Label selector project namespaces must match in addition to the managed-by label, e.g. team=web")
+	cmd.Flags().StringVar(&staticProjects, "static-projects", "", "Comma-separated project names to serve with only namespace-scoped RBAC in their namespaces; disables organizations, folders, and creating or deleting projects")
 
 	// Organization creation permission flags
 	cmd.Flags().BoolVar(&disableOrgCreation, "disable-org-creation", false, "Disable the implicit organization creation grant to all authenticated principals")
 	cmd.Flags().StringVar(&orgCreatorUsers, "org-creator-users", "", "Comma-separated email addresses allowed to create organizations")
 	cmd.Flags().StringVar(&orgCreatorRoles, "org-creator-roles", "owner", "Comma-separated OIDC role names allowed to create organizations")
+	cmd.Flags().StringVar(&orgCreatorsFile, "org-creators-file", "", "YAML file with users and roles lists allowed to create organizations, reloaded on change; replaces --org-creator-users and --org-creator-roles")
 	cmd.Flags().StringVar(&rolesClaim, "roles-claim", "groups", "OIDC ID token claim name for role memberships")
+	cmd.Flags().DurationVar(&maxTokenAge, "max-token-age", 0, "Reject OIDC tokens whose sign-in was longer ago than this, regardless of their expiry or session refreshes (0 for no limit)")
+	cmd.Flags().StringVar(&stepUp, "step-up", "", "Comma-separated Service/Method=duration entries requiring a sign-in within duration, e.g. holos.console.v1.SecretsService/GetSecret=15m")
+	cmd.Flags().StringVar(&platformOwnerUsers, "platform-owner-users", "", "Comma-separated email addresses of platform owners, who may toggle maintenance mode and force sharing that removes the last owner")
+	cmd.Flags().StringVar(&platformOwnerRoles, "platform-owner-roles", "", "Comma-separated OIDC role names of platform owners, who may toggle maintenance mode and force sharing that removes the last owner")
+	cmd.Flags().BoolVar(&maintenanceMode, "maintenance", false, "Start in read-only maintenance mode, rejecting RPCs that change state with FailedPrecondition")
+	cmd.Flags().StringVar(&maintenanceMessage, "maintenance-message", "", "Message returned to RPCs rejected by maintenance mode and shown in the UI")
+
+	// Machine authentication flags
+	cmd.Flags().BoolVar(&enableServiceAccountAuth, "enable-service-account-auth", false, "Accept Kubernetes ServiceAccount tokens (verified via TokenReview) on protected RPCs")
+	cmd.Flags().StringVar(&serviceAccountAudiences, "service-account-audiences", "", "Comma-separated audiences ServiceAccount tokens must be issued for (default: API server audiences)")
+	cmd.Flags().StringVar(&apiTokensNamespace, "api-tokens-namespace", "", "Namespace storing personal API tokens; enables the TokensService (default: disabled)")
+	cmd.Flags().StringVar(&groupsNamespace, "groups-namespace", "", "Namespace storing console-local group memberships; enables the GroupsService (default: disabled)")
+	cmd.Flags().StringVar(&trustedProxyCIDRs, "trusted-proxy-cidrs", "", "Comma-separated CIDRs of an oauth2-proxy whose signed identity headers are accepted on protected RPCs")
+	cmd.Flags().StringVar(&trustedProxySignatureKeyFile, "trusted-proxy-signature-key-file", "", "File containing the oauth2-proxy --signature-key (<algorithm>:<secret>) used to verify GAP-Signature")
+
+	// Rate limiting flags
+	cmd.Flags().Float64Var(&rateLimitPrincipalRPS, "rate-limit-principal-rps", 50, "Sustained RPC requests per second allowed per authenticated principal (0 disables)")
+	cmd.Flags().IntVar(&rateLimitPrincipalBurst, "rate-limit-principal-burst", 100, "RPC request burst allowed per authenticated principal")
+	cmd.Flags().Float64Var(&rateLimitIPRPS, "rate-limit-ip-rps", 100, "Sustained RPC requests per second allowed per client IP (0 disables)")
+	cmd.Flags().IntVar(&rateLimitIPBurst, "rate-limit-ip-burst", 200, "RPC request burst allowed per client IP")
+	cmd.Flags().StringVar(&rateLimitClientIPHeader, "rate-limit-client-ip-header", "", "Header carrying the client IP when behind a trusted proxy, e.g. X-Forwarded-For (default: peer address)")
+	cmd.Flags().DurationVar(&rpcReadTimeout, "rpc-read-timeout", 15*time.Second, "Deadline for RPCs that read a single resource, e.g. GetSecret (0 disables)")
+	cmd.Flags().DurationVar(&rpcListTimeout, "rpc-list-timeout", time.Minute, "Deadline for RPCs that may return many resources, e.g. ListSecrets (0 disables)")
+	cmd.Flags().DurationVar(&rpcWriteTimeout, "rpc-write-timeout", time.Minute, "Deadline for every other RPC (0 disables)")
+	cmd.Flags().StringVar(&rpcMethodTimeouts, "rpc-method-timeouts", "", "Comma-separated Method=duration or Service/Method=duration entries overriding RPC deadlines, e.g. ListSecrets=2m")
+	cmd.Flags().StringVar(&rpcGetCacheControl, "rpc-get-cache-control", rpc.DefaultGetCacheControl, "Cache-Control header of read RPCs called with HTTP GET; set e.g. \"public, max-age=10\" only behind a cache that keys on credentials (empty omits the header)")
+
+	// Tracing flags
+	cmd.Flags().StringVar(&tracingEndpoint, "otlp-endpoint", "", "OTLP gRPC collector address (host:port) to export traces to (default: tracing disabled)")
+	cmd.Flags().BoolVar(&tracingInsecure, "otlp-insecure", false, "Disable TLS when connecting to the OTLP collector")
+	cmd.Flags().Float64Var(&tracingSampleRatio, "trace-sample-ratio", 1, "Fraction of new traces to record, from 0 to 1")
+
+	// Secrets flags
+	cmd.Flags().BoolVar(&enableSessions, "enable-sessions", false, "Serve /api/session endpoints that keep OIDC tokens in an encrypted HTTP-only cookie (register <origin>/api/session/callback with the IdP)")
+	cmd.Flags().StringVar(&sessionKeyFile, "session-key-file", "", "File holding at least 32 bytes of secret used to encrypt session cookies (default: random per process)")
+	cmd.Flags().DurationVar(&trashRetention, "trash-retention", 0, "Soft-delete secrets and projects, keeping them restorable for this long before purging (0 deletes permanently)")
+	cmd.Flags().StringVar(&clustersKubeconfig, "clusters-kubeconfig", "", "Kubeconfig whose contexts register remote clusters that resource RPCs may target by name")
+	cmd.Flags().BoolVar(&enableShareLinks, "enable-share-links", false, "Let secret owners create signed, time-limited links that read a secret without an account")
+	cmd.Flags().StringVar(&shareLinkKeyFile, "share-link-key-file", "", "File holding at least 32 bytes of secret used to sign share links (default: random per process)")
+	cmd.Flags().StringSliceVar(&secretMetricsLabels, "secret-metrics-labels", []string{"project"}, "Labels the secret read counters carry: project, secret, both, or none with an empty value")
+	cmd.Flags().IntVar(&secretMetricsMaxSecrets, "secret-metrics-max-secrets", 1000, "Distinct secrets the secret metric label reports before counting reads as _other (0 for no limit)")
+	cmd.Flags().StringVar(&secretEncryptionKeyFile, "secret-encryption-key-file", "", "File holding a 32-byte key, raw or base64, that encrypts secret values at rest; workloads mounting such Secrets see ciphertext (empty stores plaintext)")
+	cmd.Flags().StringSliceVar(&secretEncryptionPreviousKeyFiles, "secret-encryption-previous-key-files", nil, "Retired --secret-encryption-key-file keys that still decrypt Secrets written before a key rotation")
+	cmd.Flags().StringVar(&sealedSecretsCert, "sealed-secrets-cert", "", "SealedSecrets controller certificate (kubeseal --fetch-cert) that ExportSecretSealed encrypts to (empty disables sealed export)")
+	cmd.Flags().StringVar(&vaultAddress, "vault-address", "", "HashiCorp Vault URL that secrets annotated with console.holos.run/vault-path read their values from (empty disables Vault)")
+	cmd.Flags().StringVar(&vaultRole, "vault-role", "", "Vault Kubernetes auth role the console logs in as")
+	cmd.Flags().StringVar(&vaultAuthMount, "vault-auth-mount", vault.DefaultAuthMount, "Mount path of Vault's Kubernetes auth method")
+	cmd.Flags().StringVar(&invitationsNamespace, "invitations-namespace", "", "Namespace storing signed-in users and pending invitations; enables inviting unknown users when sharing (default: disabled)")
+	cmd.Flags().BoolVar(&enableNotifications, "enable-notifications", false, "Notify organizations of audit events selected by their console.holos.run/notifications annotation")
+	cmd.Flags().BoolVar(&kubeEvents, "kube-events", false, "Record denied access and sharing changes as rate-limited Kubernetes Events on the Secret or namespace concerned")
+	cmd.Flags().StringVar(&smtpAddress, "smtp-address", "", "host:port of the SMTP relay invitation and notification email is sent through (empty disables email)")
+	cmd.Flags().StringVar(&smtpFrom, "smtp-from", "", "Sender address of console email")
+	cmd.Flags().StringVar(&smtpUsername, "smtp-username", "", "Username to authenticate with the SMTP relay")
+	cmd.Flags().StringVar(&smtpPasswordFile, "smtp-password-file", "", "File holding the SMTP relay password")
+	cmd.Flags().DurationVar(&grantExpiryWarning, "grant-expiry-warning", 72*time.Hour, "Report time-bounded sharing grants this long before they expire")
+	cmd.Flags().DurationVar(&expiredGrantRetention, "expired-grant-retention", 30*24*time.Hour, "Remove sharing grants from annotations this long after they expire (0 keeps them)")
+	cmd.Flags().BoolVar(&externalSecrets, "external-secrets", false, "List Secrets synced by External Secrets Operator as read-only entries")
+	cmd.Flags().IntVar(&k8sRetryAttempts, "k8s-retry-attempts", k8sretry.DefaultPolicy.MaxAttempts, "Attempts, including the first, for secret Kubernetes calls that fail transiently (conflict, throttling, timeout); 1 disables retries")
+	cmd.Flags().DurationVar(&k8sRetryBudget, "k8s-retry-budget", k8sretry.DefaultPolicy.Budget, "Maximum time a retried secret Kubernetes call spends across all attempts")
+	cmd.Flags().StringVar(&resourceStore, "resource-store", resourcestore.BackendAnnotations, "Where to store project metadata and share grants (annotations, crd); crd requires the console.holos.run CRDs")
 
 	// Logging flags
 	cmd.Flags().BoolVar(&logHealthChecks, "log-health-checks", false, "Log /healthz and /readyz requests (suppressed by default)")
 	cmd.PersistentFlags().StringVar(&logLevel, "log-level", "info", "Log level (debug, info, warn, error)")
+	cmd.PersistentFlags().StringVar(&logFormat, "log-format", logging.FormatJSON, "Log format (json, text); attributes naming secret material are always redacted")
+	cmd.Flags().IntVar(&auditBufferSize, "audit-buffer-size", 1000, "Number of recent audit events retained in memory for the AuditService")
+	cmd.Flags().StringVar(&auditSinkURL, "audit-sink-url", "", "HTTP endpoint, such as a Knative broker, that receives every audit event as a CloudEvent")
+
+	// API client subcommands
+	cmd.AddCommand(loginCommand(), whoamiCommand(), orgsCommand(), projectsCommand(), secretsCommand(), groupsCommand())
+	cmd.AddCommand(configCommand(cmd), preflightCommand(cmd), migrateCommand(cmd))
 
 	return cmd
 }
@@ -170,22 +362,6 @@ func deriveIssuer(listenAddr, issuer string, plainHTTP bool) string {
 	return fmt.Sprintf("%s://%s:%s/dex", scheme, host, port)
 }
 
-// parseLogLevel converts a string log level to slog.Level.
-func parseLogLevel(level string) (slog.Level, error) {
-	switch strings.ToLower(level) {
-	case "debug":
-		return slog.LevelDebug, nil
-	case "info":
-		return slog.LevelInfo, nil
-	case "warn", "warning":
-		return slog.LevelWarn, nil
-	case "error":
-		return slog.LevelError, nil
-	default:
-		return slog.LevelInfo, fmt.Errorf("invalid log level %q: must be debug, info, warn, or error", level)
-	}
-}
-
 // splitCSV splits a comma-separated string into a slice, trimming whitespace
 // and omitting empty entries.
 func splitCSV(s string) []string {
@@ -209,51 +385,185 @@ func Run(cmd *cobra.Command, args []string) error {
 	if ctx == nil {
 		ctx = context.Background()
 	}
+	cfg, err := serverConfig()
+	if err != nil {
+		return err
+	}
+	server := console.New(cfg)
+	return server.Serve(ctx)
+}
 
+// serverConfig validates the server flags and builds the console
+// configuration from them.
+func serverConfig() (console.Config, error) {
 	// Parse token TTL durations
 	idTTL, err := time.ParseDuration(idTokenTTL)
 	if err != nil {
-		return fmt.Errorf("invalid --id-token-ttl: %w", err)
+		return console.Config{}, fmt.Errorf("invalid --id-token-ttl: %w", err)
 	}
 	refreshTTL, err := time.ParseDuration(refreshTokenTTL)
 	if err != nil {
-		return fmt.Errorf("invalid --refresh-token-ttl: %w", err)
+		return console.Config{}, fmt.Errorf("invalid --refresh-token-ttl: %w", err)
+	}
+
+	if err := resourcestore.ValidateBackend(resourceStore); err != nil {
+		return console.Config{}, fmt.Errorf("invalid --resource-store: %w", err)
+	}
+
+	if _, err := nsscope.New(splitCSV(namespaceAllowlist), splitCSV(namespaceDenylist), namespaceSelector); err != nil {
+		return console.Config{}, fmt.Errorf("invalid namespace scope: %w", err)
+	}
+
+	if enableProfiling && adminListenAddr == "" {
+		return console.Config{}, fmt.Errorf("--enable-profiling requires --admin-listen")
+	}
+
+	if maxRequestBytes <= 0 {
+		return console.Config{}, fmt.Errorf("--max-request-bytes must be positive")
+	}
+
+	stepUpPolicy, err := rpc.ParseStepUp(stepUp)
+	if err != nil {
+		return console.Config{}, fmt.Errorf("invalid --step-up: %w", err)
+	}
+
+	methodTimeouts, err := rpc.ParseMethodTimeouts(rpcMethodTimeouts)
+	if err != nil {
+		return console.Config{}, fmt.Errorf("invalid --rpc-method-timeouts: %w", err)
 	}
 
 	// Derive origin from listen address if not explicitly set
 	derivedOrigin := deriveOrigin(listenAddr, origin, plainHTTP)
 
+	var connectors []oidc.Connector
+	if dexConnectors != "" {
+		connectors, err = oidc.LoadConnectors(dexConnectors)
+		if err != nil {
+			return console.Config{}, fmt.Errorf("invalid --dex-connectors-config: %w", err)
+		}
+	}
+
 	// Only auto-derive the issuer when the built-in Dex provider is enabled.
 	// An explicit --issuer is always honored (external OIDC provider).
 	derivedIssuer := issuer
-	if enableInsecureDex && issuer == "" {
+	if (enableInsecureDex || len(connectors) > 0) && issuer == "" {
 		derivedIssuer = deriveIssuer(listenAddr, "", plainHTTP)
 	}
+	if frontendAuthority != "" && derivedIssuer == "" {
+		return console.Config{}, fmt.Errorf("--frontend-authority requires --issuer")
+	}
 
-	cfg := console.Config{
+	return console.Config{
 		ListenAddr:         listenAddr,
+		AdminListenAddr:    adminListenAddr,
+		MetricsTokenFile:   metricsTokenFile,
+		EnableProfiling:    enableProfiling,
+		ShutdownTimeout:    shutdownTimeout,
+		ShutdownDelay:      shutdownDelay,
+		MaxRequestBytes:    maxRequestBytes,
 		CertFile:           certFile,
 		KeyFile:            keyFile,
 		CACertFile:         caCertFile,
 		PlainHTTP:          plainHTTP,
+		ClientCAFile:       clientCAFile,
 		Origin:             derivedOrigin,
 		Issuer:             derivedIssuer,
+		FrontendAuthority:  frontendAuthority,
 		ClientID:           clientID,
 		EnableInsecureDex:  enableInsecureDex,
+		DexConnectors:      connectors,
 		IDTokenTTL:         idTTL,
 		RefreshTokenTTL:    refreshTTL,
 		NamespacePrefix:    namespacePrefix,
 		OrganizationPrefix: organizationPrefix,
 		FolderPrefix:       folderPrefix,
 		ProjectPrefix:      projectPrefix,
+		NamespaceAllowlist: splitCSV(namespaceAllowlist),
+		NamespaceDenylist:  splitCSV(namespaceDenylist),
+		NamespaceSelector:  namespaceSelector,
+		StaticProjects:     splitCSV(staticProjects),
 		DisableOrgCreation: disableOrgCreation,
 		OrgCreatorUsers:    splitCSV(orgCreatorUsers),
 		OrgCreatorRoles:    splitCSV(orgCreatorRoles),
+		OrgCreatorsFile:    orgCreatorsFile,
 		RolesClaim:         rolesClaim,
+		MaxTokenAge:        maxTokenAge,
+		StepUp:             stepUpPolicy,
 		LogHealthChecks:    logHealthChecks,
 		EnableDevTools:     enableDevTools,
-	}
-
-	server := console.New(cfg)
-	return server.Serve(ctx)
+		AuditBufferSize:    auditBufferSize,
+		AuditSinkURL:       auditSinkURL,
+
+		EnableServiceAccountAuth: enableServiceAccountAuth,
+		ServiceAccountAudiences:  splitCSV(serviceAccountAudiences),
+		APITokensNamespace:       apiTokensNamespace,
+		GroupsNamespace:          groupsNamespace,
+
+		TrustedProxyCIDRs:            splitCSV(trustedProxyCIDRs),
+		TrustedProxySignatureKeyFile: trustedProxySignatureKeyFile,
+
+		RateLimitPrincipalRPS:   rateLimitPrincipalRPS,
+		RateLimitPrincipalBurst: rateLimitPrincipalBurst,
+		RateLimitIPRPS:          rateLimitIPRPS,
+		RateLimitIPBurst:        rateLimitIPBurst,
+		RateLimitClientIPHeader: rateLimitClientIPHeader,
+
+		RPCTimeouts: rpc.TimeoutConfig{
+			Read:    rpcReadTimeout,
+			List:    rpcListTimeout,
+			Write:   rpcWriteTimeout,
+			Methods: methodTimeouts,
+		},
+		RPCGetCacheControl: rpcGetCacheControl,
+
+		TracingEndpoint:    tracingEndpoint,
+		TracingInsecure:    tracingInsecure,
+		TracingSampleRatio: tracingSampleRatio,
+
+		ExternalSecrets: externalSecrets,
+
+		K8sRetryAttempts: k8sRetryAttempts,
+		K8sRetryBudget:   k8sRetryBudget,
+
+		EnableSessions: enableSessions,
+		SessionKeyFile: sessionKeyFile,
+
+		ClustersKubeconfig: clustersKubeconfig,
+		TrashRetention:     trashRetention,
+		SealedSecretsCert:  sealedSecretsCert,
+		EnableShareLinks:   enableShareLinks,
+		ShareLinkKeyFile:   shareLinkKeyFile,
+		ResourceStore:      resourceStore,
+
+		SecretEncryptionKeyFile:          secretEncryptionKeyFile,
+		SecretEncryptionPreviousKeyFiles: secretEncryptionPreviousKeyFiles,
+
+		SecretMetricsLabels:     secretMetricsLabels,
+		SecretMetricsMaxSecrets: secretMetricsMaxSecrets,
+
+		VaultAddress:   vaultAddress,
+		VaultRole:      vaultRole,
+		VaultAuthMount: vaultAuthMount,
+
+		InvitationsNamespace: invitationsNamespace,
+		EnableNotifications:  enableNotifications,
+		KubeEvents:           kubeEvents,
+		SMTPAddress:          smtpAddress,
+		SMTPFrom:             smtpFrom,
+		SMTPUsername:         smtpUsername,
+		SMTPPasswordFile:     smtpPasswordFile,
+
+		GrantExpiryWarning:    grantExpiryWarning,
+		ExpiredGrantRetention: expiredGrantRetention,
+
+		ContentSecurityPolicy: contentSecurityPolicy,
+		FrameOptions:          frameOptions,
+		ReferrerPolicy:        referrerPolicy,
+		HSTSMaxAge:            hstsMaxAge,
+
+		Maintenance:        maintenanceMode,
+		MaintenanceMessage: maintenanceMessage,
+		PlatformOwnerUsers: splitCSV(platformOwnerUsers),
+		PlatformOwnerRoles: splitCSV(platformOwnerRoles),
+	}, nil
 }
@@ -0,0 +1,310 @@
+package cli
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+)
+
+// ConfigEnv names the environment variable that overrides the default
+// client config file path.
+const ConfigEnv = "HOLOS_CONSOLE_CONFIG"
+
+// ClientConfig is the kubeconfig-style file the API subcommands read to find
+// the console server and the bearer token to send. Contexts pair a server
+// with a user; CurrentContext names the one used when --context is unset.
+type ClientConfig struct {
+	CurrentContext string         `json:"current-context,omitempty"`
+	Contexts       []NamedContext `json:"contexts,omitempty"`
+	Servers        []NamedServer  `json:"servers,omitempty"`
+	Users          []NamedUser    `json:"users,omitempty"`
+}
+
+// NamedContext is a named Context entry.
+type NamedContext struct {
+	Name    string  `json:"name"`
+	Context Context `json:"context"`
+}
+
+// Context names the server and user entries to connect with.
+type Context struct {
+	Server string `json:"server"`
+	User   string `json:"user,omitempty"`
+}
+
+// NamedServer is a named Server entry.
+type NamedServer struct {
+	Name   string `json:"name"`
+	Server Server `json:"server"`
+}
+
+// Server describes how to reach a console.
+type Server struct {
+	// URL is the console origin, e.g. https://console.example.com.
+	URL string `json:"url"`
+	// CertificateAuthority is a PEM file used to verify the server
+	// certificate instead of the system roots.
+	CertificateAuthority string `json:"certificate-authority,omitempty"`
+	// InsecureSkipTLSVerify disables server certificate verification.
+	InsecureSkipTLSVerify bool `json:"insecure-skip-tls-verify,omitempty"`
+}
+
+// NamedUser is a named User entry.
+type NamedUser struct {
+	Name string `json:"name"`
+	User User   `json:"user"`
+}
+
+// User holds the credentials sent to the console. Token takes precedence
+// over TokenFile, which takes precedence over Issuer.
+type User struct {
+	Token     string `json:"token,omitempty"`
+	TokenFile string `json:"token-file,omitempty"`
+	// Issuer is the OIDC issuer `holos-console login` authenticated against.
+	// The cached ID token for Issuer and ClientID is sent, and refreshed
+	// when it expires.
+	Issuer string `json:"issuer,omitempty"`
+	// ClientID is the OAuth2 client used with Issuer (default: holos-console).
+	ClientID string `json:"client-id,omitempty"`
+}
+
+// clientID returns the user's OAuth2 client ID or the console default.
+func (u User) clientID() string {
+	if u.ClientID != "" {
+		return u.ClientID
+	}
+	return defaultClientID
+}
+
+// DefaultConfigPath returns the client config path used when --config is
+// unset: $HOLOS_CONSOLE_CONFIG, or holos-console/config in the user config
+// directory.
+func DefaultConfigPath() string {
+	if path := os.Getenv(ConfigEnv); path != "" {
+		return path
+	}
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "holos-console", "config")
+}
+
+// LoadClientConfig reads the client config at path. A missing file yields
+// an empty config so flags alone can describe the connection.
+func LoadClientConfig(path string) (*ClientConfig, error) {
+	cfg := &ClientConfig{}
+	if path == "" {
+		return cfg, nil
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return cfg, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading client config: %w", err)
+	}
+	if err := yaml.UnmarshalStrict(data, cfg); err != nil {
+		return nil, fmt.Errorf("parsing client config %s: %w", path, err)
+	}
+	return cfg, nil
+}
+
+// clientOptions are the connection flags shared by the API subcommands.
+type clientOptions struct {
+	configPath  string
+	contextName string
+	server      string
+	token       string
+	insecure    bool
+}
+
+func (o *clientOptions) addFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().StringVar(&o.configPath, "config", DefaultConfigPath(), "Client config file (env "+ConfigEnv+")")
+	cmd.PersistentFlags().StringVar(&o.contextName, "context", "", "Client config context to use (default: current-context)")
+	cmd.PersistentFlags().StringVar(&o.server, "server", "", "Console URL, overriding the context's server")
+	cmd.PersistentFlags().StringVar(&o.token, "token", "", "Bearer token, overriding the context's user")
+	cmd.PersistentFlags().BoolVar(&o.insecure, "insecure-skip-tls-verify", false, "Do not verify the console's TLS certificate")
+}
+
+// connection is a resolved server URL, TLS settings and token.
+type connection struct {
+	url    string
+	server Server
+	token  string
+}
+
+// resolve merges the client config with the command line flags.
+func (o *clientOptions) resolve(ctx context.Context) (*connection, error) {
+	conn, user, err := o.load()
+	if err != nil {
+		return nil, err
+	}
+	if o.token != "" {
+		conn.token = o.token
+	} else if user != nil {
+		if conn.token, err = conn.userToken(ctx, *user); err != nil {
+			return nil, err
+		}
+	}
+	if conn.url == "" {
+		return nil, fmt.Errorf("no console server configured: pass --server or set a context in %s", o.configPath)
+	}
+	conn.url = strings.TrimSuffix(conn.url, "/")
+	return conn, nil
+}
+
+// load reads the selected context and applies the --server and
+// --insecure-skip-tls-verify flags. It returns the context's user, if any,
+// without resolving its token.
+func (o *clientOptions) load() (*connection, *User, error) {
+	cfg, err := LoadClientConfig(o.configPath)
+	if err != nil {
+		return nil, nil, err
+	}
+	conn := &connection{}
+	var user *User
+	name := o.contextName
+	if name == "" {
+		name = cfg.CurrentContext
+	}
+	if name != "" {
+		ctx, ok := findContext(cfg, name)
+		if !ok {
+			return nil, nil, fmt.Errorf("context %q not found in %s", name, o.configPath)
+		}
+		if ctx.Server != "" {
+			server, ok := findServer(cfg, ctx.Server)
+			if !ok {
+				return nil, nil, fmt.Errorf("server %q of context %q not found", ctx.Server, name)
+			}
+			conn.server = server
+			conn.url = server.URL
+		}
+		if ctx.User != "" {
+			u, ok := findUser(cfg, ctx.User)
+			if !ok {
+				return nil, nil, fmt.Errorf("user %q of context %q not found", ctx.User, name)
+			}
+			user = &u
+		}
+	}
+	if o.server != "" {
+		conn.url = o.server
+	}
+	if o.insecure {
+		conn.server.InsecureSkipTLSVerify = true
+	}
+	return conn, user, nil
+}
+
+func findContext(cfg *ClientConfig, name string) (Context, bool) {
+	for _, c := range cfg.Contexts {
+		if c.Name == name {
+			return c.Context, true
+		}
+	}
+	return Context{}, false
+}
+
+func findServer(cfg *ClientConfig, name string) (Server, bool) {
+	for _, s := range cfg.Servers {
+		if s.Name == name {
+			return s.Server, true
+		}
+	}
+	return Server{}, false
+}
+
+func findUser(cfg *ClientConfig, name string) (User, bool) {
+	for _, u := range cfg.Users {
+		if u.Name == name {
+			return u.User, true
+		}
+	}
+	return User{}, false
+}
+
+// userToken returns the bearer token of u: its static token, the contents
+// of its token file, or the cached login token for its issuer.
+func (c *connection) userToken(ctx context.Context, u User) (string, error) {
+	switch {
+	case u.Token != "":
+		return u.Token, nil
+	case u.TokenFile != "":
+	case u.Issuer != "":
+		return c.loginToken(ctx, u.Issuer, u.clientID())
+	default:
+		return "", nil
+	}
+	data, err := os.ReadFile(u.TokenFile)
+	if err != nil {
+		return "", fmt.Errorf("reading token file: %w", err)
+	}
+	return strings.TrimSpace(string(data)), nil
+}
+
+// httpClient returns an HTTP client honoring the connection's TLS settings.
+func (c *connection) httpClient() (*http.Client, error) {
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: c.server.InsecureSkipTLSVerify, //nolint:gosec // Opt-in via --insecure-skip-tls-verify.
+	}
+	if c.server.CertificateAuthority != "" {
+		pem, err := os.ReadFile(c.server.CertificateAuthority)
+		if err != nil {
+			return nil, fmt.Errorf("reading certificate authority: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in %s", c.server.CertificateAuthority)
+		}
+		tlsConfig.RootCAs = pool
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+	return &http.Client{Transport: transport}, nil
+}
+
+// newServiceClient resolves the connection described by o and builds a
+// service client with newFn, e.g. consolev1connect.NewSecretsServiceClient.
+func newServiceClient[T any](ctx context.Context, o *clientOptions, newFn func(connect.HTTPClient, string, ...connect.ClientOption) T) (T, error) {
+	var zero T
+	conn, err := o.resolve(ctx)
+	if err != nil {
+		return zero, err
+	}
+	httpClient, err := conn.httpClient()
+	if err != nil {
+		return zero, err
+	}
+	return newFn(httpClient, conn.url, conn.clientOptions()...), nil
+}
+
+// clientOptions returns the connect options every service client uses:
+// the bearer token, when one is configured.
+func (c *connection) clientOptions() []connect.ClientOption {
+	if c.token == "" {
+		return nil
+	}
+	token := c.token
+	return []connect.ClientOption{connect.WithInterceptors(connect.UnaryInterceptorFunc(
+		func(next connect.UnaryFunc) connect.UnaryFunc {
+			return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+				req.Header().Set("Authorization", "Bearer "+token)
+				return next(ctx, req)
+			}
+		},
+	))}
+}
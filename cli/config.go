@@ -0,0 +1,192 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"sigs.k8s.io/yaml"
+)
+
+// envPrefix prefixes the environment variable that overrides each server
+// flag, e.g. HOLOS_CONSOLE_LISTEN for --listen.
+const envPrefix = "HOLOS_CONSOLE_"
+
+// configFile is the --config path.
+var configFile string
+
+// envName returns the environment variable that overrides flag name.
+func envName(name string) string {
+	return envPrefix + strings.ToUpper(strings.ReplaceAll(name, "-", "_"))
+}
+
+// loadConfig fills the flags not set on the command line from HOLOS_CONSOLE_*
+// environment variables, then from the YAML file named by --config (or
+// HOLOS_CONSOLE_CONFIG). Command-line flags win over the environment, which
+// wins over the file. Values are parsed by each flag, so a file or variable
+// is held to the same types and rules as the flag it sets.
+func loadConfig(flags *pflag.FlagSet) error {
+	path := configFile
+	if value, ok := os.LookupEnv(envName("config")); ok && !flags.Changed("config") {
+		path = value
+	}
+	fileValues := map[string]string{}
+	if path != "" {
+		var err error
+		if fileValues, err = readConfigFile(path, flags); err != nil {
+			return err
+		}
+	}
+
+	var errs []error
+	flags.VisitAll(func(f *pflag.Flag) {
+		if f.Changed || !configurable(f) {
+			return
+		}
+		source := envName(f.Name)
+		value, ok := os.LookupEnv(source)
+		if !ok {
+			source = path
+			if value, ok = fileValues[f.Name]; !ok {
+				return
+			}
+		}
+		if err := f.Value.Set(value); err != nil {
+			errs = append(errs, fmt.Errorf("%s: invalid %s: %w", source, f.Name, err))
+		}
+	})
+	return errors.Join(errs...)
+}
+
+// readConfigFile parses a YAML file whose keys are flag names, returning each
+// value in the string form the flag parses. Lists are joined with commas for
+// the comma-separated flags. Unknown keys are an error so typos do not pass
+// silently.
+func readConfigFile(path string, flags *pflag.FlagSet) (map[string]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading config file: %w", err)
+	}
+	var raw map[string]any
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("parsing config file %s: %w", path, err)
+	}
+	values := make(map[string]string, len(raw))
+	var errs []error
+	for key, value := range raw {
+		f := flags.Lookup(key)
+		if f == nil || !configurable(f) {
+			errs = append(errs, fmt.Errorf("%s: unknown setting %q", path, key))
+			continue
+		}
+		if value == nil {
+			continue
+		}
+		s, err := configValue(value)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: invalid %s: %w", path, key, err))
+			continue
+		}
+		values[key] = s
+	}
+	return values, errors.Join(errs...)
+}
+
+// configValue renders a YAML scalar, or a list of scalars, as a flag value.
+func configValue(value any) (string, error) {
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case []any:
+		parts := make([]string, 0, len(v))
+		for _, item := range v {
+			if _, ok := item.([]any); ok {
+				return "", errors.New("nested lists are not supported")
+			}
+			s, err := configValue(item)
+			if err != nil {
+				return "", err
+			}
+			parts = append(parts, s)
+		}
+		return strings.Join(parts, ","), nil
+	default:
+		return "", fmt.Errorf("expected a scalar or list, got %T", value)
+	}
+}
+
+// configurable reports whether f may be set from the environment or a
+// config file.
+func configurable(f *pflag.Flag) bool {
+	return f.Name != "config" && f.Name != "help"
+}
+
+// effectiveConfig returns every configurable flag's current value, typed as
+// YAML would write it.
+func effectiveConfig(flags *pflag.FlagSet) map[string]any {
+	out := map[string]any{}
+	flags.VisitAll(func(f *pflag.Flag) {
+		if !configurable(f) {
+			return
+		}
+		value := f.Value.String()
+		switch f.Value.Type() {
+		case "bool":
+			if b, err := strconv.ParseBool(value); err == nil {
+				out[f.Name] = b
+				return
+			}
+		case "int":
+			if n, err := strconv.Atoi(value); err == nil {
+				out[f.Name] = n
+				return
+			}
+		case "float64":
+			if n, err := strconv.ParseFloat(value, 64); err == nil {
+				out[f.Name] = n
+				return
+			}
+		}
+		out[f.Name] = value
+	})
+	return out
+}
+
+// configCommand returns the config command group. validate shares the
+// server flags of root so it sees exactly what the server would run with.
+func configCommand(root *cobra.Command) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "config",
+		Short: "Inspect the server configuration",
+		Args:  cobra.NoArgs,
+	}
+	validate := &cobra.Command{
+		Use:   "validate",
+		Short: "Validate the server configuration and print the effective settings",
+		Long: "Validate merges --config, HOLOS_CONSOLE_* environment variables, and flags the same way the\n" +
+			"server does, checks the result, and prints every setting as YAML.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if _, err := serverConfig(); err != nil {
+				return err
+			}
+			out, err := yaml.Marshal(effectiveConfig(cmd.Flags()))
+			if err != nil {
+				return fmt.Errorf("rendering configuration: %w", err)
+			}
+			_, err = cmd.OutOrStdout().Write(out)
+			return err
+		},
+	}
+	validate.Flags().AddFlagSet(root.Flags())
+	cmd.AddCommand(validate)
+	return cmd
+}
@@ -0,0 +1,85 @@
+package cli
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"sigs.k8s.io/yaml"
+)
+
+func runConfigValidate(t *testing.T, config string, args ...string) (map[string]any, error) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "server.yaml")
+	if err := os.WriteFile(path, []byte(config), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	cmd := Command()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetArgs(append([]string{"config", "validate", "--config", path}, args...))
+	if err := cmd.Execute(); err != nil {
+		return nil, err
+	}
+	var settings map[string]any
+	if err := yaml.Unmarshal(out.Bytes(), &settings); err != nil {
+		t.Fatalf("expected YAML output, got %v", err)
+	}
+	return settings, nil
+}
+
+func TestConfigValidate(t *testing.T) {
+	t.Setenv("HOLOS_CONSOLE_ORIGIN", "https://env.example.com")
+	t.Setenv("HOLOS_CONSOLE_CLIENT_ID", "from-env")
+	settings, err := runConfigValidate(t, `
+listen: ":9443"
+client-id: from-file
+origin: https://file.example.com
+org-creator-roles: [owner, platform-admins]
+grant-expiry-warning: 24h
+rate-limit-ip-burst: 50
+plain-http: true
+`, "--client-id", "from-flag")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	for key, want := range map[string]any{
+		"listen":               ":9443",
+		"client-id":            "from-flag",
+		"origin":               "https://env.example.com",
+		"org-creator-roles":    "owner,platform-admins",
+		"grant-expiry-warning": "24h0m0s",
+		"rate-limit-ip-burst":  float64(50),
+		"plain-http":           true,
+		"project-prefix":       "prj-",
+	} {
+		if settings[key] != want {
+			t.Errorf("%s: expected %v, got %v", key, want, settings[key])
+		}
+	}
+	if _, ok := settings["config"]; ok {
+		t.Error("expected config to be omitted from the effective settings")
+	}
+}
+
+func TestConfigValidateRejectsBadSettings(t *testing.T) {
+	for name, tt := range map[string]struct {
+		config string
+		want   string
+	}{
+		"unknown key":   {"listn: \":9443\"\n", `unknown setting "listn"`},
+		"wrong type":    {"audit-buffer-size: lots\n", "invalid audit-buffer-size"},
+		"bad duration":  {"trash-retention: forever\n", "invalid trash-retention"},
+		"nested object": {"listen: {port: 1}\n", "invalid listen"},
+		"semantic":      {"resource-store: etcd\n", "invalid --resource-store"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := runConfigValidate(t, tt.config)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("expected error containing %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
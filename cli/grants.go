@@ -0,0 +1,63 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+)
+
+// grantFlags are the --user and --role flags of the commands that set share
+// grants.
+type grantFlags struct {
+	users []string
+	roles []string
+}
+
+func (f *grantFlags) addFlags(cmd *cobra.Command) {
+	cmd.Flags().StringArrayVar(&f.users, "user", nil, "User grant as EMAIL=ROLE, ROLE one of viewer, editor, owner (repeatable)")
+	cmd.Flags().StringArrayVar(&f.roles, "role", nil, "Group grant as GROUP=ROLE, ROLE one of viewer, editor, owner (repeatable)")
+}
+
+// grants returns the user and role grants described by the flags.
+func (f *grantFlags) grants() (users, roles []*consolev1.ShareGrant, err error) {
+	if users, err = parseGrants("--user", f.users); err != nil {
+		return nil, nil, err
+	}
+	if roles, err = parseGrants("--role", f.roles); err != nil {
+		return nil, nil, err
+	}
+	return users, roles, nil
+}
+
+func parseGrants(flag string, entries []string) ([]*consolev1.ShareGrant, error) {
+	grants := make([]*consolev1.ShareGrant, 0, len(entries))
+	for _, entry := range entries {
+		i := strings.LastIndex(entry, "=")
+		if i <= 0 {
+			return nil, fmt.Errorf("invalid %s %q: want PRINCIPAL=ROLE", flag, entry)
+		}
+		role := protoRoleFromString(entry[i+1:])
+		if role == consolev1.Role_ROLE_UNSPECIFIED {
+			return nil, fmt.Errorf("invalid %s %q: role must be viewer, editor or owner", flag, entry)
+		}
+		grants = append(grants, &consolev1.ShareGrant{Principal: entry[:i], Role: role})
+	}
+	return grants, nil
+}
+
+// protoRoleFromString converts a role name string to the proto Role enum.
+func protoRoleFromString(s string) consolev1.Role {
+	switch strings.ToLower(s) {
+	case "viewer":
+		return consolev1.Role_ROLE_VIEWER
+	case "editor":
+		return consolev1.Role_ROLE_EDITOR
+	case "owner":
+		return consolev1.Role_ROLE_OWNER
+	default:
+		return consolev1.Role_ROLE_UNSPECIFIED
+	}
+}
@@ -0,0 +1,113 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/spf13/cobra"
+
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+	"github.com/holos-run/holos-console/gen/holos/console/v1/consolev1connect"
+)
+
+// groupsOptions are the flags shared by the groups subcommands.
+type groupsOptions struct {
+	apiOptions
+}
+
+// client resolves the connection and returns a GroupsService client.
+func (o *groupsOptions) client(ctx context.Context) (consolev1connect.GroupsServiceClient, error) {
+	if err := o.validate(); err != nil {
+		return nil, err
+	}
+	return newServiceClient(ctx, &o.clientOptions, consolev1connect.NewGroupsServiceClient)
+}
+
+// groupsCommand returns the `groups` command group, which manages
+// console-local group membership through the GroupsService API.
+func groupsCommand() *cobra.Command {
+	o := &groupsOptions{}
+	cmd := &cobra.Command{
+		Use:   "groups",
+		Short: "Manage console-local group membership through the console API",
+		Args:  cobra.NoArgs,
+	}
+	o.addFlags(cmd)
+	cmd.AddCommand(
+		groupsListCommand(o),
+		groupsAddCommand(o),
+		groupsRemoveCommand(o),
+	)
+	return cmd
+}
+
+func printGroups(w io.Writer, groups ...*consolev1.Group) {
+	fmt.Fprintln(w, "NAME\tMEMBERS")
+	for _, group := range groups {
+		fmt.Fprintf(w, "%s\t%s\n", group.GetName(), strings.Join(group.GetMembers(), ","))
+	}
+}
+
+func groupsListCommand(o *groupsOptions) *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List groups and their members",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := o.client(cmd.Context())
+			if err != nil {
+				return err
+			}
+			resp, err := client.ListGroups(cmd.Context(), connect.NewRequest(&consolev1.ListGroupsRequest{}))
+			if err != nil {
+				return err
+			}
+			return o.print(cmd.OutOrStdout(), resp.Msg, func(w io.Writer) {
+				printGroups(w, resp.Msg.GetGroups()...)
+			})
+		},
+	}
+}
+
+func groupsAddCommand(o *groupsOptions) *cobra.Command {
+	return &cobra.Command{
+		Use:   "add GROUP EMAIL",
+		Short: "Add a member to a group",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := o.client(cmd.Context())
+			if err != nil {
+				return err
+			}
+			resp, err := client.AddGroupMember(cmd.Context(), connect.NewRequest(&consolev1.AddGroupMemberRequest{Group: args[0], Member: args[1]}))
+			if err != nil {
+				return err
+			}
+			return o.print(cmd.OutOrStdout(), resp.Msg, func(w io.Writer) {
+				printGroups(w, resp.Msg.GetGroup())
+			})
+		},
+	}
+}
+
+func groupsRemoveCommand(o *groupsOptions) *cobra.Command {
+	return &cobra.Command{
+		Use:   "remove GROUP EMAIL",
+		Short: "Remove a member from a group",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := o.client(cmd.Context())
+			if err != nil {
+				return err
+			}
+			if _, err := client.RemoveGroupMember(cmd.Context(), connect.NewRequest(&consolev1.RemoveGroupMemberRequest{Group: args[0], Member: args[1]})); err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "%s removed from group/%s\n", args[1], args[0])
+			return nil
+		},
+	}
+}
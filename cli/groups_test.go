@@ -0,0 +1,68 @@
+package cli
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"connectrpc.com/connect"
+
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+	"github.com/holos-run/holos-console/gen/holos/console/v1/consolev1connect"
+)
+
+// fakeGroupsService records the requests it receives.
+type fakeGroupsService struct {
+	consolev1connect.UnimplementedGroupsServiceHandler
+	added   *consolev1.AddGroupMemberRequest
+	removed *consolev1.RemoveGroupMemberRequest
+}
+
+func (f *fakeGroupsService) ListGroups(context.Context, *connect.Request[consolev1.ListGroupsRequest]) (*connect.Response[consolev1.ListGroupsResponse], error) {
+	return connect.NewResponse(&consolev1.ListGroupsResponse{Groups: []*consolev1.Group{
+		{Name: "platform", Members: []string{"alice@example.com", "bob@example.com"}},
+	}}), nil
+}
+
+func (f *fakeGroupsService) AddGroupMember(_ context.Context, req *connect.Request[consolev1.AddGroupMemberRequest]) (*connect.Response[consolev1.AddGroupMemberResponse], error) {
+	f.added = req.Msg
+	return connect.NewResponse(&consolev1.AddGroupMemberResponse{Group: &consolev1.Group{Name: req.Msg.Group, Members: []string{req.Msg.Member}}}), nil
+}
+
+func (f *fakeGroupsService) RemoveGroupMember(_ context.Context, req *connect.Request[consolev1.RemoveGroupMemberRequest]) (*connect.Response[consolev1.RemoveGroupMemberResponse], error) {
+	f.removed = req.Msg
+	return connect.NewResponse(&consolev1.RemoveGroupMemberResponse{}), nil
+}
+
+func TestGroups(t *testing.T) {
+	svc := &fakeGroupsService{}
+	mux := http.NewServeMux()
+	mux.Handle(consolev1connect.NewGroupsServiceHandler(svc))
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	out, err := runCommand(t, groupsCommand(), "list", "--server", srv.URL, "--config", "")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !strings.Contains(out, "platform  alice@example.com,bob@example.com") {
+		t.Errorf("unexpected output:\n%s", out)
+	}
+
+	if _, err := runCommand(t, groupsCommand(), "add", "platform", "carol@example.com", "--server", srv.URL, "--config", ""); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if svc.added.GetGroup() != "platform" || svc.added.GetMember() != "carol@example.com" {
+		t.Errorf("unexpected add request %v", svc.added)
+	}
+
+	out, err = runCommand(t, groupsCommand(), "remove", "platform", "carol@example.com", "--server", srv.URL, "--config", "")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if out != "carol@example.com removed from group/platform\n" || svc.removed.GetMember() != "carol@example.com" {
+		t.Errorf("unexpected output %q for request %v", out, svc.removed)
+	}
+}
@@ -0,0 +1,275 @@
+package cli
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/spf13/cobra"
+	"golang.org/x/oauth2"
+)
+
+// defaultClientID is the OAuth2 client the console registers with its
+// embedded Dex and expects as the token audience by default.
+const defaultClientID = "holos-console"
+
+// loginScopes are requested by `holos-console login`. offline_access yields
+// the refresh token that keeps the cached login usable after the ID token
+// expires.
+var loginScopes = []string{oidc.ScopeOpenID, "profile", "email", "groups", oidc.ScopeOfflineAccess}
+
+// tokenRefreshLeeway is how long before expiry a cached ID token is
+// refreshed, so a request does not race the expiry.
+const tokenRefreshLeeway = time.Minute
+
+// cachedToken is a login persisted in the token cache.
+type cachedToken struct {
+	IDToken      string    `json:"id_token"`
+	RefreshToken string    `json:"refresh_token,omitempty"`
+	Expiry       time.Time `json:"expiry"`
+}
+
+// tokenCachePath returns the cache file for logins to issuer with clientID:
+// holos-console/tokens/<hash>.json in the user config directory.
+func tokenCachePath(issuer, clientID string) (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("locating token cache: %w", err)
+	}
+	sum := sha256.Sum256([]byte(issuer + "\n" + clientID))
+	return filepath.Join(dir, "holos-console", "tokens", hex.EncodeToString(sum[:8])+".json"), nil
+}
+
+// loadCachedToken reads the token cache at path. A missing file yields nil.
+func loadCachedToken(path string) (*cachedToken, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading token cache: %w", err)
+	}
+	tok := &cachedToken{}
+	if err := json.Unmarshal(data, tok); err != nil {
+		return nil, fmt.Errorf("parsing token cache %s: %w", path, err)
+	}
+	return tok, nil
+}
+
+// saveCachedToken writes tok to path, readable only by the current user.
+func saveCachedToken(path string, tok *cachedToken) error {
+	data, err := json.Marshal(tok)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return fmt.Errorf("creating token cache: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		return fmt.Errorf("writing token cache: %w", err)
+	}
+	return nil
+}
+
+// idTokenClaims are the ID token claims the CLI reads.
+type idTokenClaims struct {
+	Email  string `json:"email"`
+	Expiry int64  `json:"exp"`
+}
+
+// parseIDToken decodes the claims of a raw ID token without verifying its
+// signature. The CLI only receives ID tokens directly from the issuer's
+// token endpoint and the console verifies every token it is sent, so the
+// claims are used for display and cache expiry only.
+func parseIDToken(raw string) (*idTokenClaims, error) {
+	parts := strings.Split(raw, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("malformed id_token")
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("malformed id_token: %w", err)
+	}
+	claims := &idTokenClaims{}
+	if err := json.Unmarshal(payload, claims); err != nil {
+		return nil, fmt.Errorf("malformed id_token: %w", err)
+	}
+	return claims, nil
+}
+
+// newCachedToken returns the cache entry for an issuer token response.
+// fallbackRefresh is kept when the issuer does not rotate refresh tokens.
+func newCachedToken(tok *oauth2.Token, fallbackRefresh string) (*cachedToken, *idTokenClaims, error) {
+	rawIDToken, _ := tok.Extra("id_token").(string)
+	if rawIDToken == "" {
+		return nil, nil, fmt.Errorf("token response has no id_token")
+	}
+	claims, err := parseIDToken(rawIDToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	next := &cachedToken{IDToken: rawIDToken, RefreshToken: tok.RefreshToken, Expiry: time.Unix(claims.Expiry, 0)}
+	if next.RefreshToken == "" {
+		next.RefreshToken = fallbackRefresh
+	}
+	return next, claims, nil
+}
+
+// oauth2Config discovers issuer and returns its OAuth2 config for clientID,
+// along with a context that routes issuer requests through the connection's
+// TLS settings.
+func (c *connection) oauth2Config(ctx context.Context, issuer, clientID string) (context.Context, *oauth2.Config, error) {
+	httpClient, err := c.httpClient()
+	if err != nil {
+		return nil, nil, err
+	}
+	ctx = oidc.ClientContext(ctx, httpClient)
+	provider, err := oidc.NewProvider(ctx, issuer)
+	if err != nil {
+		return nil, nil, fmt.Errorf("discovering issuer %s: %w", issuer, err)
+	}
+	return ctx, &oauth2.Config{
+		ClientID: clientID,
+		Endpoint: provider.Endpoint(),
+		Scopes:   loginScopes,
+	}, nil
+}
+
+// loginToken returns the cached ID token for issuer and clientID, redeeming
+// the cached refresh token first when the ID token is about to expire.
+func (c *connection) loginToken(ctx context.Context, issuer, clientID string) (string, error) {
+	path, err := tokenCachePath(issuer, clientID)
+	if err != nil {
+		return "", err
+	}
+	tok, err := loadCachedToken(path)
+	if err != nil {
+		return "", err
+	}
+	if tok == nil {
+		return "", fmt.Errorf("not logged in to %s: run holos-console login", issuer)
+	}
+	if time.Until(tok.Expiry) > tokenRefreshLeeway {
+		return tok.IDToken, nil
+	}
+	if tok.RefreshToken == "" {
+		return "", fmt.Errorf("login to %s expired: run holos-console login", issuer)
+	}
+	ctx, cfg, err := c.oauth2Config(ctx, issuer, clientID)
+	if err != nil {
+		return "", err
+	}
+	refreshed, err := cfg.TokenSource(ctx, &oauth2.Token{RefreshToken: tok.RefreshToken}).Token()
+	if err != nil {
+		return "", fmt.Errorf("refreshing login to %s (run holos-console login): %w", issuer, err)
+	}
+	next, _, err := newCachedToken(refreshed, tok.RefreshToken)
+	if err != nil {
+		return "", err
+	}
+	if err := saveCachedToken(path, next); err != nil {
+		return "", err
+	}
+	return next.IDToken, nil
+}
+
+// loginCommand returns the `login` command, which signs in with the OAuth
+// 2.0 device authorization grant and caches the resulting tokens for the
+// API subcommands.
+func loginCommand() *cobra.Command {
+	var (
+		o        clientOptions
+		issuer   string
+		clientID string
+	)
+	cmd := &cobra.Command{
+		Use:   "login",
+		Short: "Sign in to the console's OIDC issuer with a device code",
+		Long: "Sign in with the OAuth 2.0 device authorization grant. The command prints\n" +
+			"a URL and code to enter in a browser on any machine, waits for approval\n" +
+			"and caches the tokens in the user config directory.\n\n" +
+			"The issuer defaults to the issuer of the context's user, or the console's\n" +
+			"built-in provider at <server>/dex. Set issuer (and client-id) on a user in\n" +
+			"the client config to send the cached token, refreshed automatically:\n\n" +
+			"  users:\n" +
+			"  - name: alice\n" +
+			"    user:\n" +
+			"      issuer: https://console.example.com/dex",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			conn, user, err := o.load()
+			if err != nil {
+				return err
+			}
+			if issuer == "" && user != nil {
+				issuer = user.Issuer
+			}
+			if issuer == "" && conn.url != "" {
+				issuer = strings.TrimSuffix(conn.url, "/") + "/dex"
+			}
+			if issuer == "" {
+				return errors.New("no issuer configured: pass --issuer or --server, or set issuer on the context's user")
+			}
+			if clientID == "" {
+				clientID = defaultClientID
+				if user != nil {
+					clientID = user.clientID()
+				}
+			}
+
+			ctx, cfg, err := conn.oauth2Config(cmd.Context(), issuer, clientID)
+			if err != nil {
+				return err
+			}
+			if cfg.Endpoint.DeviceAuthURL == "" {
+				return fmt.Errorf("issuer %s does not support the device authorization grant", issuer)
+			}
+			auth, err := cfg.DeviceAuth(ctx)
+			if err != nil {
+				return fmt.Errorf("starting device login: %w", err)
+			}
+			if auth.VerificationURIComplete != "" {
+				fmt.Fprintf(cmd.ErrOrStderr(), "To sign in, open %s\nand confirm the code %s\n", auth.VerificationURIComplete, auth.UserCode)
+			} else {
+				fmt.Fprintf(cmd.ErrOrStderr(), "To sign in, open %s\nand enter the code %s\n", auth.VerificationURI, auth.UserCode)
+			}
+			tok, err := cfg.DeviceAccessToken(ctx, auth)
+			if err != nil {
+				return fmt.Errorf("device login: %w", err)
+			}
+			cached, claims, err := newCachedToken(tok, "")
+			if err != nil {
+				return err
+			}
+			path, err := tokenCachePath(issuer, clientID)
+			if err != nil {
+				return err
+			}
+			if err := saveCachedToken(path, cached); err != nil {
+				return err
+			}
+			who := claims.Email
+			if who == "" {
+				who = "the issuer"
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Logged in to %s as %s\n", issuer, who)
+			return nil
+		},
+	}
+	o.addFlags(cmd)
+	// --token makes no sense for a command that obtains one.
+	_ = cmd.PersistentFlags().MarkHidden("token")
+	cmd.Flags().StringVar(&issuer, "issuer", "", "OIDC issuer URL (default: the context user's issuer, or <server>/dex)")
+	cmd.Flags().StringVar(&clientID, "client-id", "", "OAuth2 client ID (default: the context user's client-id, or "+defaultClientID+")")
+	return cmd
+}
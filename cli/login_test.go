@@ -0,0 +1,177 @@
+package cli
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// fakeIDToken returns an unsigned JWT carrying email and exp claims.
+func fakeIDToken(email string, exp time.Time) string {
+	enc := base64.RawURLEncoding
+	payload, _ := json.Marshal(map[string]any{"email": email, "exp": exp.Unix()})
+	return enc.EncodeToString([]byte(`{"alg":"none"}`)) + "." + enc.EncodeToString(payload) + ".sig"
+}
+
+// fakeIssuer is an OIDC issuer supporting the device and refresh grants.
+type fakeIssuer struct {
+	url       string
+	refreshed int
+}
+
+func newFakeIssuer(t *testing.T) *fakeIssuer {
+	t.Helper()
+	f := &fakeIssuer{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                        f.url,
+			"authorization_endpoint":        f.url + "/auth",
+			"token_endpoint":                f.url + "/token",
+			"device_authorization_endpoint": f.url + "/device/code",
+			"jwks_uri":                      f.url + "/keys",
+		})
+	})
+	mux.HandleFunc("/device/code", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"device_code":"dev-code","user_code":"ABCD-EFGH","verification_uri":"`+f.url+`/device","expires_in":60,"interval":1}`)
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		var email, refresh string
+		switch r.PostFormValue("grant_type") {
+		case "urn:ietf:params:oauth:grant-type:device_code":
+			if r.PostFormValue("device_code") != "dev-code" {
+				http.Error(w, `{"error":"invalid_grant"}`, http.StatusBadRequest)
+				return
+			}
+			email, refresh = "alice@example.com", "refresh-1"
+		case "refresh_token":
+			if r.PostFormValue("refresh_token") != "refresh-1" {
+				http.Error(w, `{"error":"invalid_grant"}`, http.StatusBadRequest)
+				return
+			}
+			f.refreshed++
+			email = "refreshed@example.com"
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"access_token":  "access",
+			"token_type":    "bearer",
+			"expires_in":    3600,
+			"refresh_token": refresh,
+			"id_token":      fakeIDToken(email, time.Now().Add(time.Hour)),
+		})
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	f.url = srv.URL
+	return f
+}
+
+func TestLogin_CachesDeviceToken(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	issuer := newFakeIssuer(t)
+
+	out, err := runCommand(t, loginCommand(), "--config", "", "--issuer", issuer.url)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !strings.Contains(out, "enter the code ABCD-EFGH") {
+		t.Errorf("expected user code in output:\n%s", out)
+	}
+	if !strings.Contains(out, "Logged in to "+issuer.url+" as alice@example.com") {
+		t.Errorf("unexpected output:\n%s", out)
+	}
+
+	path, err := tokenCachePath(issuer.url, defaultClientID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tok, err := loadCachedToken(path)
+	if err != nil || tok == nil {
+		t.Fatalf("expected cached token, got %v, %v", tok, err)
+	}
+	if tok.RefreshToken != "refresh-1" || time.Until(tok.Expiry) < 50*time.Minute {
+		t.Errorf("unexpected cached token %+v", tok)
+	}
+	if info, err := os.Stat(path); err != nil || info.Mode().Perm() != 0o600 {
+		t.Errorf("expected token cache mode 0600, got %v, %v", info.Mode(), err)
+	}
+}
+
+func TestLoginToken_RefreshesExpiredToken(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	issuer := newFakeIssuer(t)
+	svc, url := newFakeSecretsServer(t)
+
+	path, err := tokenCachePath(issuer.url, defaultClientID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := saveCachedToken(path, &cachedToken{
+		IDToken:      fakeIDToken("alice@example.com", time.Now()),
+		RefreshToken: "refresh-1",
+		Expiry:       time.Now(),
+	}); err != nil {
+		t.Fatal(err)
+	}
+	config := filepath.Join(t.TempDir(), "config")
+	if err := os.WriteFile(config, []byte(`current-context: dev
+contexts:
+- name: dev
+  context:
+    server: local
+    user: alice
+servers:
+- name: local
+  server:
+    url: `+url+`
+users:
+- name: alice
+  user:
+    issuer: `+issuer.url+`
+`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := runSecrets(t, "list", "--config", config, "-p", "web"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if issuer.refreshed != 1 {
+		t.Errorf("expected one refresh, got %d", issuer.refreshed)
+	}
+	tok, err := loadCachedToken(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if svc.auth != "Bearer "+tok.IDToken {
+		t.Errorf("expected refreshed token to be sent, got %q", svc.auth)
+	}
+	if tok.RefreshToken != "refresh-1" {
+		t.Errorf("expected refresh token to be kept, got %q", tok.RefreshToken)
+	}
+
+	// The refreshed token is reused until it nears expiry.
+	if _, err := runSecrets(t, "list", "--config", config, "-p", "web"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if issuer.refreshed != 1 {
+		t.Errorf("expected cached token to be reused, got %d refreshes", issuer.refreshed)
+	}
+}
+
+func TestLoginToken_RequiresLogin(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	conn := &connection{}
+	_, err := conn.userToken(t.Context(), User{Issuer: "https://idp.example.com"})
+	if err == nil || !strings.Contains(err.Error(), "run holos-console login") {
+		t.Errorf("expected login hint, got %v", err)
+	}
+}
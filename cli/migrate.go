@@ -0,0 +1,123 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+
+	"github.com/holos-run/holos-console/console/migrate"
+	"github.com/holos-run/holos-console/console/resolver"
+	"github.com/holos-run/holos-console/console/secrets"
+)
+
+// migrateCommand upgrades legacy label and annotation formats. It shares the
+// server flags of root so it classifies namespaces with the same prefixes
+// the server uses.
+func migrateCommand(root *cobra.Command) *cobra.Command {
+	var (
+		out   outputOptions
+		apply bool
+	)
+	cmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "Upgrade managed namespaces and secrets to the current label and annotation formats",
+		Long: "Migrate scans the namespaces and secrets the console manages and upgrades formats written by\n" +
+			"earlier releases: it labels namespaces created before the resource-type and name labels existed\n" +
+			"and rewrites sharing grants in the current schema. Without --apply it only reports the changes\n" +
+			"it would make. It is safe to run repeatedly, and exits non-zero when an object needs manual\n" +
+			"attention.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := out.validate(); err != nil {
+				return err
+			}
+			cfg, err := serverConfig()
+			if err != nil {
+				return err
+			}
+			kube, err := secrets.NewClientset()
+			if err != nil {
+				return fmt.Errorf("failed to create kubernetes client: %w", err)
+			}
+			if kube == nil {
+				return fmt.Errorf("no in-cluster config or KUBECONFIG")
+			}
+			r := &resolver.Resolver{
+				NamespacePrefix:    cfg.NamespacePrefix,
+				OrganizationPrefix: cfg.OrganizationPrefix,
+				FolderPrefix:       cfg.FolderPrefix,
+				ProjectPrefix:      cfg.ProjectPrefix,
+			}
+			report, err := migrate.New(kube, r).Run(cmd.Context(), apply)
+			if err != nil {
+				return err
+			}
+			if err := printMigrateReport(cmd.OutOrStdout(), out.format, report); err != nil {
+				return err
+			}
+			if len(report.Problems) > 0 {
+				return fmt.Errorf("%d problems need manual attention", len(report.Problems))
+			}
+			return nil
+		},
+	}
+	cmd.Flags().AddFlagSet(root.Flags())
+	cmd.Flags().BoolVar(&apply, "apply", false, "Write the upgrades; without it the changes are only reported")
+	out.addFlags(cmd)
+	return cmd
+}
+
+// printMigrateReport writes report in format.
+func printMigrateReport(w io.Writer, format string, report *migrate.Report) error {
+	switch format {
+	case outputJSON:
+		var buf bytes.Buffer
+		enc := json.NewEncoder(&buf)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(report); err != nil {
+			return err
+		}
+		_, err := buf.WriteTo(w)
+		return err
+	case outputYAML:
+		data, err := yaml.Marshal(report)
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(data)
+		return err
+	}
+
+	verb := "would upgrade"
+	if report.Applied {
+		verb = "upgraded"
+	}
+	fmt.Fprintf(w, "Scanned %d objects, %s %d, %d need attention.\n", report.Scanned, verb, len(report.Changes), len(report.Problems))
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	if len(report.Changes) > 0 {
+		fmt.Fprintln(tw, "\nKIND\tOBJECT\tCHANGE")
+		for _, c := range report.Changes {
+			fmt.Fprintf(tw, "%s\t%s\t%s\n", c.Kind, objectName(c.Namespace, c.Name), strings.Join(c.Description, "; "))
+		}
+	}
+	if len(report.Problems) > 0 {
+		fmt.Fprintln(tw, "\nKIND\tOBJECT\tPROBLEM")
+		for _, p := range report.Problems {
+			fmt.Fprintf(tw, "%s\t%s\t%s\n", p.Kind, objectName(p.Namespace, p.Name), p.Message)
+		}
+	}
+	return tw.Flush()
+}
+
+func objectName(namespace, name string) string {
+	if namespace == "" {
+		return name
+	}
+	return namespace + "/" + name
+}
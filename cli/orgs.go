@@ -0,0 +1,233 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"connectrpc.com/connect"
+	"github.com/spf13/cobra"
+
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+	"github.com/holos-run/holos-console/gen/holos/console/v1/consolev1connect"
+)
+
+// orgsOptions are the flags shared by the orgs subcommands.
+type orgsOptions struct {
+	apiOptions
+}
+
+// client resolves the connection and returns an OrganizationService client.
+func (o *orgsOptions) client(ctx context.Context) (consolev1connect.OrganizationServiceClient, error) {
+	if err := o.validate(); err != nil {
+		return nil, err
+	}
+	return newServiceClient(ctx, &o.clientOptions, consolev1connect.NewOrganizationServiceClient)
+}
+
+// orgsCommand returns the `orgs` command group, which manages organizations
+// through the OrganizationService API.
+func orgsCommand() *cobra.Command {
+	o := &orgsOptions{}
+	cmd := &cobra.Command{
+		Use:     "orgs",
+		Aliases: []string{"organizations"},
+		Short:   "Manage organizations through the console API",
+		Args:    cobra.NoArgs,
+	}
+	o.addFlags(cmd)
+	cmd.AddCommand(
+		orgsListCommand(o),
+		orgsGetCommand(o),
+		orgsCreateCommand(o),
+		orgsUpdateCommand(o),
+		orgsDeleteCommand(o),
+		orgsShareCommand(o),
+	)
+	return cmd
+}
+
+func printOrgs(w io.Writer, orgs ...*consolev1.Organization) {
+	fmt.Fprintln(w, "NAME\tDISPLAY NAME\tROLE\tCREATOR")
+	for _, org := range orgs {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", org.GetName(), org.GetDisplayName(), roleName(org.GetUserRole()), org.GetCreatorEmail())
+	}
+}
+
+func orgsListCommand(o *orgsOptions) *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List organizations",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := o.client(cmd.Context())
+			if err != nil {
+				return err
+			}
+			resp, err := client.ListOrganizations(cmd.Context(), connect.NewRequest(&consolev1.ListOrganizationsRequest{}))
+			if err != nil {
+				return err
+			}
+			return o.print(cmd.OutOrStdout(), resp.Msg, func(w io.Writer) {
+				printOrgs(w, resp.Msg.GetOrganizations()...)
+			})
+		},
+	}
+}
+
+func orgsGetCommand(o *orgsOptions) *cobra.Command {
+	return &cobra.Command{
+		Use:   "get NAME",
+		Short: "Show an organization",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := o.client(cmd.Context())
+			if err != nil {
+				return err
+			}
+			resp, err := client.GetOrganization(cmd.Context(), connect.NewRequest(&consolev1.GetOrganizationRequest{Name: args[0]}))
+			if err != nil {
+				return err
+			}
+			return o.print(cmd.OutOrStdout(), resp.Msg, func(w io.Writer) {
+				printOrgs(w, resp.Msg.GetOrganization())
+			})
+		},
+	}
+}
+
+func orgsCreateCommand(o *orgsOptions) *cobra.Command {
+	var (
+		grants           grantFlags
+		displayName      string
+		description      string
+		populateDefaults bool
+	)
+	cmd := &cobra.Command{
+		Use:   "create NAME",
+		Short: "Create an organization",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			users, roles, err := grants.grants()
+			if err != nil {
+				return err
+			}
+			req := &consolev1.CreateOrganizationRequest{
+				Name:        args[0],
+				DisplayName: displayName,
+				Description: description,
+				UserGrants:  users,
+				RoleGrants:  roles,
+			}
+			if cmd.Flags().Changed("populate-defaults") {
+				req.PopulateDefaults = &populateDefaults
+			}
+			client, err := o.client(cmd.Context())
+			if err != nil {
+				return err
+			}
+			resp, err := client.CreateOrganization(cmd.Context(), connect.NewRequest(req))
+			if err != nil {
+				return err
+			}
+			return o.print(cmd.OutOrStdout(), resp.Msg, func(w io.Writer) {
+				fmt.Fprintf(w, "organization/%s created\n", resp.Msg.GetName())
+			})
+		},
+	}
+	grants.addFlags(cmd)
+	cmd.Flags().StringVar(&displayName, "display-name", "", "Human-readable organization name")
+	cmd.Flags().StringVar(&description, "description", "", "Organization description")
+	cmd.Flags().BoolVar(&populateDefaults, "populate-defaults", false, "Seed the organization with example templates and a default project")
+	return cmd
+}
+
+func orgsUpdateCommand(o *orgsOptions) *cobra.Command {
+	var displayName, description, gatewayNamespace string
+	cmd := &cobra.Command{
+		Use:   "update NAME",
+		Short: "Update an organization",
+		Long:  "Update the display name, description or gateway namespace of an\norganization. Unset flags are left unchanged.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			req := &consolev1.UpdateOrganizationRequest{Name: args[0]}
+			if cmd.Flags().Changed("display-name") {
+				req.DisplayName = &displayName
+			}
+			if cmd.Flags().Changed("description") {
+				req.Description = &description
+			}
+			if cmd.Flags().Changed("gateway-namespace") {
+				req.GatewayNamespace = &gatewayNamespace
+			}
+			client, err := o.client(cmd.Context())
+			if err != nil {
+				return err
+			}
+			if _, err := client.UpdateOrganization(cmd.Context(), connect.NewRequest(req)); err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "organization/%s updated\n", args[0])
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&displayName, "display-name", "", "Human-readable organization name")
+	cmd.Flags().StringVar(&description, "description", "", "Organization description")
+	cmd.Flags().StringVar(&gatewayNamespace, "gateway-namespace", "", "Namespace hosting the platform Gateway referenced by rendered templates")
+	return cmd
+}
+
+func orgsDeleteCommand(o *orgsOptions) *cobra.Command {
+	return &cobra.Command{
+		Use:   "delete NAME",
+		Short: "Delete an organization",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := o.client(cmd.Context())
+			if err != nil {
+				return err
+			}
+			if _, err := client.DeleteOrganization(cmd.Context(), connect.NewRequest(&consolev1.DeleteOrganizationRequest{Name: args[0]})); err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "organization/%s deleted\n", args[0])
+			return nil
+		},
+	}
+}
+
+func orgsShareCommand(o *orgsOptions) *cobra.Command {
+	var grants grantFlags
+	cmd := &cobra.Command{
+		Use:   "share NAME",
+		Short: "Replace the share grants of an organization",
+		Long: "Replace the share grants of an organization with the --user and --role\n" +
+			"grants. Grants not listed are removed.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			users, roles, err := grants.grants()
+			if err != nil {
+				return err
+			}
+			client, err := o.client(cmd.Context())
+			if err != nil {
+				return err
+			}
+			resp, err := client.UpdateOrganizationSharing(cmd.Context(), connect.NewRequest(&consolev1.UpdateOrganizationSharingRequest{
+				Name:       args[0],
+				UserGrants: users,
+				RoleGrants: roles,
+			}))
+			if err != nil {
+				return err
+			}
+			return o.print(cmd.OutOrStdout(), resp.Msg, func(w io.Writer) {
+				fmt.Fprintln(w, "KIND\tPRINCIPAL\tROLE")
+				printGrants(w, "user", resp.Msg.GetOrganization().GetUserGrants())
+				printGrants(w, "role", resp.Msg.GetOrganization().GetRoleGrants())
+			})
+		},
+	}
+	grants.addFlags(cmd)
+	return cmd
+}
@@ -0,0 +1,112 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"sigs.k8s.io/yaml"
+
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+)
+
+// Output formats accepted by --output.
+const (
+	outputTable = "table"
+	outputJSON  = "json"
+	outputYAML  = "yaml"
+)
+
+// apiOptions are the connection and output flags every API command group
+// registers.
+type apiOptions struct {
+	clientOptions
+	outputOptions
+}
+
+func (o *apiOptions) addFlags(cmd *cobra.Command) {
+	o.clientOptions.addFlags(cmd)
+	o.outputOptions.addFlags(cmd)
+}
+
+// outputOptions is the --output flag of the API subcommands.
+type outputOptions struct {
+	format string
+}
+
+func (o *outputOptions) addFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().StringVarP(&o.format, "output", "o", outputTable, "Output format (table, json, yaml)")
+}
+
+func (o *outputOptions) validate() error {
+	switch o.format {
+	case outputTable, outputJSON, outputYAML:
+		return nil
+	default:
+		return fmt.Errorf("invalid --output %q (want %s, %s or %s)", o.format, outputTable, outputJSON, outputYAML)
+	}
+}
+
+// print writes msg as JSON or YAML, or calls table with a tabwriter for the
+// table format.
+func (o *outputOptions) print(w io.Writer, msg proto.Message, table func(w io.Writer)) error {
+	switch o.format {
+	case outputJSON, outputYAML:
+		data, err := protojson.Marshal(msg)
+		if err != nil {
+			return err
+		}
+		if o.format == outputYAML {
+			if data, err = yaml.JSONToYAML(data); err != nil {
+				return err
+			}
+			_, err = w.Write(data)
+			return err
+		}
+		// protojson randomizes its whitespace; re-indent so scripts can
+		// rely on stable output.
+		var buf bytes.Buffer
+		if err := json.Indent(&buf, data, "", "  "); err != nil {
+			return err
+		}
+		buf.WriteByte('\n')
+		_, err = buf.WriteTo(w)
+		return err
+	default:
+		tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+		table(tw)
+		return tw.Flush()
+	}
+}
+
+// printGrants writes one table row per grant.
+func printGrants(w io.Writer, kind string, grants []*consolev1.ShareGrant) {
+	for _, g := range grants {
+		role := roleName(g.GetRole())
+		if g.GetDeny() {
+			role = "deny"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\n", kind, g.GetPrincipal(), role)
+	}
+}
+
+// roleName returns the lowercase name of a role, e.g. "viewer".
+func roleName(r consolev1.Role) string {
+	if r == consolev1.Role_ROLE_UNSPECIFIED {
+		return ""
+	}
+	return strings.ToLower(strings.TrimPrefix(r.String(), "ROLE_"))
+}
+
+func dryRunSuffix(dryRun bool) string {
+	if dryRun {
+		return " (dry run)"
+	}
+	return ""
+}
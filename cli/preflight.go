@@ -0,0 +1,86 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+
+	"github.com/holos-run/holos-console/console"
+	"github.com/holos-run/holos-console/console/secrets"
+)
+
+// preflightCommand validates the deployment environment. Like config
+// validate it shares the server flags of root so it checks exactly what the
+// server would run with.
+func preflightCommand(root *cobra.Command) *cobra.Command {
+	var out outputOptions
+	cmd := &cobra.Command{
+		Use:   "preflight",
+		Short: "Check the deployment environment before starting the server",
+		Long: "Preflight checks the TLS and CA files, reaches the OIDC issuer's discovery document, asks the\n" +
+			"Kubernetes API server whether the console's ServiceAccount may manage namespaces and secrets, and\n" +
+			"looks for namespace prefixes that collide with each other or with unmanaged namespaces. It exits\n" +
+			"non-zero when any check fails.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := out.validate(); err != nil {
+				return err
+			}
+			cfg, err := serverConfig()
+			if err != nil {
+				return err
+			}
+			kube, err := secrets.NewClientset()
+			if err != nil {
+				return fmt.Errorf("failed to create kubernetes client: %w", err)
+			}
+			report := console.New(cfg).Preflight(cmd.Context(), kube)
+			if err := printPreflight(cmd.OutOrStdout(), out.format, report); err != nil {
+				return err
+			}
+			if report.Failed() {
+				return errors.New("preflight checks failed")
+			}
+			return nil
+		},
+	}
+	cmd.Flags().AddFlagSet(root.Flags())
+	out.addFlags(cmd)
+	return cmd
+}
+
+// printPreflight writes report in format.
+func printPreflight(w io.Writer, format string, report console.PreflightReport) error {
+	switch format {
+	case outputJSON:
+		var buf bytes.Buffer
+		enc := json.NewEncoder(&buf)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(report); err != nil {
+			return err
+		}
+		_, err := buf.WriteTo(w)
+		return err
+	case outputYAML:
+		data, err := yaml.Marshal(report)
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(data)
+		return err
+	default:
+		tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(tw, "CHECK\tSTATUS\tMESSAGE")
+		for _, c := range report.Checks {
+			fmt.Fprintf(tw, "%s\t%s\t%s\n", c.Name, strings.ToUpper(c.Status), c.Message)
+		}
+		return tw.Flush()
+	}
+}
@@ -0,0 +1,333 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/spf13/cobra"
+
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+	"github.com/holos-run/holos-console/gen/holos/console/v1/consolev1connect"
+)
+
+// projectsOptions are the flags shared by the projects subcommands.
+type projectsOptions struct {
+	apiOptions
+}
+
+// client resolves the connection and returns a ProjectService client.
+func (o *projectsOptions) client(ctx context.Context) (consolev1connect.ProjectServiceClient, error) {
+	if err := o.validate(); err != nil {
+		return nil, err
+	}
+	return newServiceClient(ctx, &o.clientOptions, consolev1connect.NewProjectServiceClient)
+}
+
+// projectsCommand returns the `projects` command group, which manages
+// projects through the ProjectService API.
+func projectsCommand() *cobra.Command {
+	o := &projectsOptions{}
+	cmd := &cobra.Command{
+		Use:   "projects",
+		Short: "Manage projects through the console API",
+		Args:  cobra.NoArgs,
+	}
+	o.addFlags(cmd)
+	cmd.AddCommand(
+		projectsListCommand(o),
+		projectsGetCommand(o),
+		projectsCreateCommand(o),
+		projectsUpdateCommand(o),
+		projectsDeleteCommand(o),
+		projectsShareCommand(o),
+		projectsTokenCommand(o),
+	)
+	return cmd
+}
+
+// parentFlags are the --org and --folder flags that place a project in the
+// hierarchy.
+type parentFlags struct {
+	org    string
+	folder string
+}
+
+// parent returns the parent type and name the flags select: the folder
+// when --folder is set, otherwise the organization root when --org is set.
+func (f *parentFlags) parent() (consolev1.ParentType, string) {
+	switch {
+	case f.folder != "":
+		return consolev1.ParentType_PARENT_TYPE_FOLDER, f.folder
+	case f.org != "":
+		return consolev1.ParentType_PARENT_TYPE_ORGANIZATION, f.org
+	default:
+		return consolev1.ParentType_PARENT_TYPE_UNSPECIFIED, ""
+	}
+}
+
+func printProjects(w io.Writer, projects ...*consolev1.Project) {
+	fmt.Fprintln(w, "NAME\tDISPLAY NAME\tORGANIZATION\tPARENT\tROLE")
+	for _, p := range projects {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", p.GetName(), p.GetDisplayName(), p.GetOrganization(), p.GetParentName(), roleName(p.GetUserRole()))
+	}
+}
+
+func projectsListCommand(o *projectsOptions) *cobra.Command {
+	var parent parentFlags
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List projects",
+		Long:  "List the projects you can read, optionally limited to an organization or\nthe direct children of a folder.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := o.client(cmd.Context())
+			if err != nil {
+				return err
+			}
+			req := &consolev1.ListProjectsRequest{Organization: parent.org}
+			if parent.folder != "" {
+				req.ParentType, req.ParentName = parent.parent()
+			}
+			resp, err := client.ListProjects(cmd.Context(), connect.NewRequest(req))
+			if err != nil {
+				return err
+			}
+			return o.print(cmd.OutOrStdout(), resp.Msg, func(w io.Writer) {
+				printProjects(w, resp.Msg.GetProjects()...)
+			})
+		},
+	}
+	cmd.Flags().StringVar(&parent.org, "org", "", "Only list projects in this organization")
+	cmd.Flags().StringVar(&parent.folder, "folder", "", "Only list projects directly in this folder")
+	return cmd
+}
+
+func projectsGetCommand(o *projectsOptions) *cobra.Command {
+	return &cobra.Command{
+		Use:   "get NAME",
+		Short: "Show a project",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := o.client(cmd.Context())
+			if err != nil {
+				return err
+			}
+			resp, err := client.GetProject(cmd.Context(), connect.NewRequest(&consolev1.GetProjectRequest{Name: args[0]}))
+			if err != nil {
+				return err
+			}
+			return o.print(cmd.OutOrStdout(), resp.Msg, func(w io.Writer) {
+				printProjects(w, resp.Msg.GetProject())
+			})
+		},
+	}
+}
+
+func projectsCreateCommand(o *projectsOptions) *cobra.Command {
+	var (
+		parent      parentFlags
+		grants      grantFlags
+		displayName string
+		description string
+		dryRun      bool
+	)
+	cmd := &cobra.Command{
+		Use:   "create [NAME]",
+		Short: "Create a project",
+		Long: "Create a project in an organization, optionally inside a folder. When NAME\n" +
+			"is omitted the console derives it from --display-name.",
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			users, roles, err := grants.grants()
+			if err != nil {
+				return err
+			}
+			req := &consolev1.CreateProjectRequest{
+				DisplayName:  displayName,
+				Description:  description,
+				Organization: parent.org,
+				UserGrants:   users,
+				RoleGrants:   roles,
+				DryRun:       dryRun,
+			}
+			if len(args) == 1 {
+				req.Name = args[0]
+			}
+			if parent.folder != "" {
+				req.ParentType, req.ParentName = parent.parent()
+			}
+			client, err := o.client(cmd.Context())
+			if err != nil {
+				return err
+			}
+			resp, err := client.CreateProject(cmd.Context(), connect.NewRequest(req))
+			if err != nil {
+				return err
+			}
+			return o.print(cmd.OutOrStdout(), resp.Msg, func(w io.Writer) {
+				fmt.Fprintf(w, "project/%s created%s\n", resp.Msg.GetName(), dryRunSuffix(dryRun))
+			})
+		},
+	}
+	grants.addFlags(cmd)
+	cmd.Flags().StringVar(&parent.org, "org", "", "Organization to create the project in (required)")
+	cmd.Flags().StringVar(&parent.folder, "folder", "", "Folder to create the project in (default: the organization root)")
+	cmd.Flags().StringVar(&displayName, "display-name", "", "Human-readable project name")
+	cmd.Flags().StringVar(&description, "description", "", "Project description")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Validate the request without creating the project")
+	_ = cmd.MarkFlagRequired("org")
+	return cmd
+}
+
+func projectsUpdateCommand(o *projectsOptions) *cobra.Command {
+	var (
+		parent      parentFlags
+		displayName string
+		description string
+		dryRun      bool
+	)
+	cmd := &cobra.Command{
+		Use:   "update NAME",
+		Short: "Update a project",
+		Long: "Update the display name or description of a project, or move it with\n" +
+			"--folder or to its organization root with --org. Unset flags are left\n" +
+			"unchanged.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if parent.org != "" && parent.folder != "" {
+				return errors.New("--org and --folder are mutually exclusive")
+			}
+			req := &consolev1.UpdateProjectRequest{Name: args[0], DryRun: dryRun}
+			if cmd.Flags().Changed("display-name") {
+				req.DisplayName = &displayName
+			}
+			if cmd.Flags().Changed("description") {
+				req.Description = &description
+			}
+			if parentType, parentName := parent.parent(); parentName != "" {
+				req.ParentType = &parentType
+				req.ParentName = &parentName
+			}
+			client, err := o.client(cmd.Context())
+			if err != nil {
+				return err
+			}
+			if _, err := client.UpdateProject(cmd.Context(), connect.NewRequest(req)); err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "project/%s updated%s\n", args[0], dryRunSuffix(dryRun))
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&parent.org, "org", "", "Move the project to the root of this organization")
+	cmd.Flags().StringVar(&parent.folder, "folder", "", "Move the project into this folder")
+	cmd.Flags().StringVar(&displayName, "display-name", "", "Human-readable project name")
+	cmd.Flags().StringVar(&description, "description", "", "Project description")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Validate the request without updating the project")
+	return cmd
+}
+
+func projectsDeleteCommand(o *projectsOptions) *cobra.Command {
+	var dryRun bool
+	cmd := &cobra.Command{
+		Use:   "delete NAME",
+		Short: "Delete a project",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := o.client(cmd.Context())
+			if err != nil {
+				return err
+			}
+			if _, err := client.DeleteProject(cmd.Context(), connect.NewRequest(&consolev1.DeleteProjectRequest{
+				Name:   args[0],
+				DryRun: dryRun,
+			})); err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "project/%s deleted%s\n", args[0], dryRunSuffix(dryRun))
+			return nil
+		},
+	}
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Validate the request without deleting the project")
+	return cmd
+}
+
+func projectsShareCommand(o *projectsOptions) *cobra.Command {
+	var (
+		grants grantFlags
+		dryRun bool
+	)
+	cmd := &cobra.Command{
+		Use:   "share NAME",
+		Short: "Replace the share grants of a project",
+		Long: "Replace the share grants of a project with the --user and --role grants.\n" +
+			"Grants not listed are removed.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			users, roles, err := grants.grants()
+			if err != nil {
+				return err
+			}
+			client, err := o.client(cmd.Context())
+			if err != nil {
+				return err
+			}
+			resp, err := client.UpdateProjectSharing(cmd.Context(), connect.NewRequest(&consolev1.UpdateProjectSharingRequest{
+				Name:       args[0],
+				UserGrants: users,
+				RoleGrants: roles,
+				DryRun:     dryRun,
+			}))
+			if err != nil {
+				return err
+			}
+			return o.print(cmd.OutOrStdout(), resp.Msg, func(w io.Writer) {
+				fmt.Fprintln(w, "KIND\tPRINCIPAL\tROLE")
+				printGrants(w, "user", resp.Msg.GetProject().GetUserGrants())
+				printGrants(w, "role", resp.Msg.GetProject().GetRoleGrants())
+			})
+		},
+	}
+	grants.addFlags(cmd)
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Validate the request without changing the grants")
+	return cmd
+}
+
+func projectsTokenCommand(o *projectsOptions) *cobra.Command {
+	var (
+		ttl      time.Duration
+		audience string
+	)
+	cmd := &cobra.Command{
+		Use:   "token NAME",
+		Short: "Mint a token for the project service account",
+		Long: "Mint a short-lived token for the project's ServiceAccount, which may edit\n" +
+			"resources in the project namespace. The table output is the bare token so\n" +
+			"it can be captured by CI scripts. Requires owner access to the project.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := o.client(cmd.Context())
+			if err != nil {
+				return err
+			}
+			resp, err := client.CreateProjectToken(cmd.Context(), connect.NewRequest(&consolev1.CreateProjectTokenRequest{
+				Project:    args[0],
+				TtlSeconds: int64(ttl / time.Second),
+				Audience:   audience,
+			}))
+			if err != nil {
+				return err
+			}
+			return o.print(cmd.OutOrStdout(), resp.Msg, func(w io.Writer) {
+				fmt.Fprintln(w, resp.Msg.GetToken())
+			})
+		},
+	}
+	cmd.Flags().DurationVar(&ttl, "ttl", 0, "How long the token is valid, between 10m and 24h (default: 1h)")
+	cmd.Flags().StringVar(&audience, "audience", "", "Intended audience of the token (default: the API server's audiences)")
+	return cmd
+}
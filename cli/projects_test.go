@@ -0,0 +1,188 @@
+package cli
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"connectrpc.com/connect"
+
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+	"github.com/holos-run/holos-console/gen/holos/console/v1/consolev1connect"
+)
+
+// fakeProjectService records the requests it receives.
+type fakeProjectService struct {
+	consolev1connect.UnimplementedProjectServiceHandler
+	listed  *consolev1.ListProjectsRequest
+	created *consolev1.CreateProjectRequest
+	updated *consolev1.UpdateProjectRequest
+	token   *consolev1.CreateProjectTokenRequest
+}
+
+func (f *fakeProjectService) ListProjects(_ context.Context, req *connect.Request[consolev1.ListProjectsRequest]) (*connect.Response[consolev1.ListProjectsResponse], error) {
+	f.listed = req.Msg
+	return connect.NewResponse(&consolev1.ListProjectsResponse{Projects: []*consolev1.Project{
+		{Name: "web", DisplayName: "Web", Organization: "acme", ParentName: "platform", UserRole: consolev1.Role_ROLE_OWNER},
+	}}), nil
+}
+
+func (f *fakeProjectService) CreateProject(_ context.Context, req *connect.Request[consolev1.CreateProjectRequest]) (*connect.Response[consolev1.CreateProjectResponse], error) {
+	f.created = req.Msg
+	name := req.Msg.GetName()
+	if name == "" {
+		name = strings.ToLower(req.Msg.GetDisplayName())
+	}
+	return connect.NewResponse(&consolev1.CreateProjectResponse{Name: name}), nil
+}
+
+func (f *fakeProjectService) UpdateProject(_ context.Context, req *connect.Request[consolev1.UpdateProjectRequest]) (*connect.Response[consolev1.UpdateProjectResponse], error) {
+	f.updated = req.Msg
+	return connect.NewResponse(&consolev1.UpdateProjectResponse{}), nil
+}
+
+func (f *fakeProjectService) CreateProjectToken(_ context.Context, req *connect.Request[consolev1.CreateProjectTokenRequest]) (*connect.Response[consolev1.CreateProjectTokenResponse], error) {
+	f.token = req.Msg
+	return connect.NewResponse(&consolev1.CreateProjectTokenResponse{
+		Token:          "minted-token",
+		ServiceAccount: "system:serviceaccount:prj-web:holos-project",
+		ExpiresAt:      "2026-01-02T03:04:05Z",
+	}), nil
+}
+
+// fakeOrganizationService records the requests it receives.
+type fakeOrganizationService struct {
+	consolev1connect.UnimplementedOrganizationServiceHandler
+	created *consolev1.CreateOrganizationRequest
+}
+
+func (f *fakeOrganizationService) GetOrganization(_ context.Context, req *connect.Request[consolev1.GetOrganizationRequest]) (*connect.Response[consolev1.GetOrganizationResponse], error) {
+	return connect.NewResponse(&consolev1.GetOrganizationResponse{Organization: &consolev1.Organization{
+		Name:         req.Msg.GetName(),
+		DisplayName:  "Acme",
+		UserRole:     consolev1.Role_ROLE_VIEWER,
+		CreatorEmail: "alice@example.com",
+	}}), nil
+}
+
+func (f *fakeOrganizationService) CreateOrganization(_ context.Context, req *connect.Request[consolev1.CreateOrganizationRequest]) (*connect.Response[consolev1.CreateOrganizationResponse], error) {
+	f.created = req.Msg
+	return connect.NewResponse(&consolev1.CreateOrganizationResponse{Name: req.Msg.GetName()}), nil
+}
+
+func newFakeHierarchyServer(t *testing.T) (*fakeProjectService, *fakeOrganizationService, string) {
+	t.Helper()
+	projects := &fakeProjectService{}
+	orgs := &fakeOrganizationService{}
+	mux := http.NewServeMux()
+	mux.Handle(consolev1connect.NewProjectServiceHandler(projects))
+	mux.Handle(consolev1connect.NewOrganizationServiceHandler(orgs))
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return projects, orgs, srv.URL
+}
+
+func TestProjectsList_Output(t *testing.T) {
+	svc, _, url := newFakeHierarchyServer(t)
+	tests := []struct {
+		name   string
+		format string
+		want   string
+	}{
+		{"table", "table", "web   Web           acme          platform  owner"},
+		{"json", "json", `"organization": "acme"`},
+		{"yaml", "yaml", "  parentName: platform\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := runCommand(t, projectsCommand(), "list", "--server", url, "--config", "", "--folder", "platform", "-o", tt.format)
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if !strings.Contains(out, tt.want) {
+				t.Errorf("expected output to contain %q, got:\n%s", tt.want, out)
+			}
+		})
+	}
+	if svc.listed.GetParentType() != consolev1.ParentType_PARENT_TYPE_FOLDER || svc.listed.GetParentName() != "platform" {
+		t.Errorf("expected folder filter, got %v", svc.listed)
+	}
+}
+
+func TestProjectsCreate_SendsParentAndGrants(t *testing.T) {
+	svc, _, url := newFakeHierarchyServer(t)
+	out, err := runCommand(t, projectsCommand(), "create", "--server", url, "--config", "",
+		"--org", "acme", "--folder", "platform", "--display-name", "Web", "--role", "dev=editor")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if out != "project/web created\n" {
+		t.Errorf("unexpected output %q", out)
+	}
+	got := svc.created
+	if got.GetOrganization() != "acme" || got.GetParentType() != consolev1.ParentType_PARENT_TYPE_FOLDER || got.GetParentName() != "platform" {
+		t.Errorf("unexpected parent in %v", got)
+	}
+	if len(got.GetRoleGrants()) != 1 || got.GetRoleGrants()[0].GetRole() != consolev1.Role_ROLE_EDITOR {
+		t.Errorf("unexpected role grants %v", got.GetRoleGrants())
+	}
+}
+
+func TestProjectsUpdate_OnlySendsChangedFields(t *testing.T) {
+	svc, _, url := newFakeHierarchyServer(t)
+	if _, err := runCommand(t, projectsCommand(), "update", "web", "--server", url, "--config", "", "--description", "", "--org", "acme"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	got := svc.updated
+	if got.DisplayName != nil {
+		t.Errorf("expected display name to be left unset, got %q", got.GetDisplayName())
+	}
+	if got.Description == nil || got.GetDescription() != "" {
+		t.Errorf("expected description to be cleared, got %v", got.Description)
+	}
+	if got.GetParentType() != consolev1.ParentType_PARENT_TYPE_ORGANIZATION || got.GetParentName() != "acme" {
+		t.Errorf("expected move to organization root, got %v", got)
+	}
+
+	_, err := runCommand(t, projectsCommand(), "update", "web", "--server", url, "--config", "", "--org", "acme", "--folder", "platform")
+	if err == nil || !strings.Contains(err.Error(), "mutually exclusive") {
+		t.Errorf("expected mutually exclusive error, got %v", err)
+	}
+}
+
+func TestProjectsToken_PrintsBareToken(t *testing.T) {
+	svc, _, url := newFakeHierarchyServer(t)
+	out, err := runCommand(t, projectsCommand(), "token", "web", "--server", url, "--config", "", "--ttl", "2h", "--audience", "ci")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if out != "minted-token\n" {
+		t.Errorf("unexpected output %q", out)
+	}
+	if svc.token.GetProject() != "web" || svc.token.GetTtlSeconds() != 7200 || svc.token.GetAudience() != "ci" {
+		t.Errorf("unexpected request %v", svc.token)
+	}
+}
+
+func TestOrgs_GetAndCreate(t *testing.T) {
+	_, svc, url := newFakeHierarchyServer(t)
+	out, err := runCommand(t, orgsCommand(), "get", "acme", "--server", url, "--config", "")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !strings.Contains(out, "acme  Acme          viewer  alice@example.com") {
+		t.Errorf("unexpected output:\n%s", out)
+	}
+
+	if _, err := runCommand(t, orgsCommand(), "create", "acme", "--server", url, "--config", "", "--user", "alice@example.com=owner"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if svc.created.PopulateDefaults != nil {
+		t.Errorf("expected populate_defaults to be left to the server, got %v", svc.created.GetPopulateDefaults())
+	}
+	if len(svc.created.GetUserGrants()) != 1 {
+		t.Errorf("unexpected user grants %v", svc.created.GetUserGrants())
+	}
+}
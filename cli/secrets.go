@@ -0,0 +1,385 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/spf13/cobra"
+
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+	"github.com/holos-run/holos-console/gen/holos/console/v1/consolev1connect"
+)
+
+// secretsOptions are the flags shared by the secrets subcommands.
+type secretsOptions struct {
+	apiOptions
+	project string
+	cluster string
+}
+
+// client resolves the connection and returns a SecretsService client.
+func (o *secretsOptions) client(ctx context.Context) (consolev1connect.SecretsServiceClient, error) {
+	if err := o.validate(); err != nil {
+		return nil, err
+	}
+	return newServiceClient(ctx, &o.clientOptions, consolev1connect.NewSecretsServiceClient)
+}
+
+// secretsCommand returns the `secrets` command group, which manages project
+// secrets through the SecretsService API.
+func secretsCommand() *cobra.Command {
+	o := &secretsOptions{}
+	cmd := &cobra.Command{
+		Use:   "secrets",
+		Short: "Manage project secrets through the console API",
+		Args:  cobra.NoArgs,
+	}
+	o.addFlags(cmd)
+	cmd.PersistentFlags().StringVarP(&o.project, "project", "p", "", "Project that owns the secrets (required)")
+	cmd.PersistentFlags().StringVar(&o.cluster, "cluster", "", "Registered cluster to act in (default: the console's cluster)")
+	_ = cmd.MarkPersistentFlagRequired("project")
+
+	cmd.AddCommand(
+		secretsListCommand(o),
+		secretsGetCommand(o),
+		secretsCreateCommand(o),
+		secretsUpdateCommand(o),
+		secretsUploadCommand(o),
+		secretsDeleteCommand(o),
+		secretsShareCommand(o),
+	)
+	return cmd
+}
+
+func secretsListCommand(o *secretsOptions) *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List the secrets in a project",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := o.client(cmd.Context())
+			if err != nil {
+				return err
+			}
+			resp, err := client.ListSecrets(cmd.Context(), connect.NewRequest(&consolev1.ListSecretsRequest{
+				Project: o.project,
+				Cluster: o.cluster,
+			}))
+			if err != nil {
+				return err
+			}
+			return o.print(cmd.OutOrStdout(), resp.Msg, func(w io.Writer) {
+				fmt.Fprintln(w, "NAME\tACCESSIBLE\tSOURCE\tDESCRIPTION")
+				for _, s := range resp.Msg.GetSecrets() {
+					fmt.Fprintf(w, "%s\t%t\t%s\t%s\n", s.GetName(), s.GetAccessible(), s.GetSource(), s.GetDescription())
+				}
+			})
+		},
+	}
+}
+
+func secretsGetCommand(o *secretsOptions) *cobra.Command {
+	return &cobra.Command{
+		Use:   "get NAME",
+		Short: "Print the data of a secret as KEY=VALUE lines",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := o.client(cmd.Context())
+			if err != nil {
+				return err
+			}
+			resp, err := client.GetSecret(cmd.Context(), connect.NewRequest(&consolev1.GetSecretRequest{
+				Name:    args[0],
+				Project: o.project,
+				Cluster: o.cluster,
+			}))
+			if err != nil {
+				return err
+			}
+			if o.format != outputTable {
+				return o.print(cmd.OutOrStdout(), resp.Msg, nil)
+			}
+			data := resp.Msg.GetData()
+			for _, key := range slices.Sorted(maps.Keys(data)) {
+				fmt.Fprintf(cmd.OutOrStdout(), "%s=%s\n", key, data[key])
+			}
+			return nil
+		},
+	}
+}
+
+// secretDataFlags are the --from-literal and --from-file flags of the
+// commands that write secret data.
+type secretDataFlags struct {
+	literals []string
+	files    []string
+}
+
+func (f *secretDataFlags) addFlags(cmd *cobra.Command) {
+	cmd.Flags().StringArrayVar(&f.literals, "from-literal", nil, "Secret data entry as KEY=VALUE (repeatable)")
+	cmd.Flags().StringArrayVar(&f.files, "from-file", nil, "Secret data entry as KEY=PATH, read from the file (repeatable)")
+}
+
+// data returns the secret data described by the flags.
+func (f *secretDataFlags) data() (map[string][]byte, error) {
+	data := make(map[string][]byte, len(f.literals)+len(f.files))
+	for _, entry := range f.literals {
+		key, value, ok := strings.Cut(entry, "=")
+		if !ok || key == "" {
+			return nil, fmt.Errorf("invalid --from-literal %q: want KEY=VALUE", entry)
+		}
+		data[key] = []byte(value)
+	}
+	for _, entry := range f.files {
+		key, path, ok := strings.Cut(entry, "=")
+		if !ok || key == "" || path == "" {
+			return nil, fmt.Errorf("invalid --from-file %q: want KEY=PATH", entry)
+		}
+		value, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("reading --from-file %s: %w", key, err)
+		}
+		data[key] = value
+	}
+	return data, nil
+}
+
+func secretsCreateCommand(o *secretsOptions) *cobra.Command {
+	var (
+		data        secretDataFlags
+		grants      grantFlags
+		description string
+		url         string
+		secretType  string
+		dryRun      bool
+	)
+	cmd := &cobra.Command{
+		Use:   "create NAME",
+		Short: "Create a secret",
+		Long: "Create a secret. The caller is granted owner by the console; --user and\n" +
+			"--role add further grants.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			secretData, err := data.data()
+			if err != nil {
+				return err
+			}
+			users, roles, err := grants.grants()
+			if err != nil {
+				return err
+			}
+			req := &consolev1.CreateSecretRequest{
+				Name:       args[0],
+				Project:    o.project,
+				Cluster:    o.cluster,
+				Data:       secretData,
+				UserGrants: users,
+				RoleGrants: roles,
+				Type:       secretType,
+				DryRun:     dryRun,
+			}
+			if cmd.Flags().Changed("description") {
+				req.Description = &description
+			}
+			if cmd.Flags().Changed("url") {
+				req.Url = &url
+			}
+			client, err := o.client(cmd.Context())
+			if err != nil {
+				return err
+			}
+			if _, err := client.CreateSecret(cmd.Context(), connect.NewRequest(req)); err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "secret/%s created%s\n", args[0], dryRunSuffix(dryRun))
+			return nil
+		},
+	}
+	data.addFlags(cmd)
+	grants.addFlags(cmd)
+	cmd.Flags().StringVar(&description, "description", "", "Secret description")
+	cmd.Flags().StringVar(&url, "url", "", "URL of the service the secret belongs to")
+	cmd.Flags().StringVar(&secretType, "type", "", `Kubernetes secret type, "Opaque" (default) or "kubernetes.io/tls" with tls.crt and tls.key`)
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Validate the request without creating the secret")
+	return cmd
+}
+
+func secretsUpdateCommand(o *secretsOptions) *cobra.Command {
+	var (
+		data        secretDataFlags
+		description string
+		url         string
+		dryRun      bool
+	)
+	cmd := &cobra.Command{
+		Use:   "update NAME",
+		Short: "Replace the data of a secret",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			secretData, err := data.data()
+			if err != nil {
+				return err
+			}
+			req := &consolev1.UpdateSecretRequest{
+				Name:    args[0],
+				Project: o.project,
+				Cluster: o.cluster,
+				Data:    secretData,
+				DryRun:  dryRun,
+			}
+			if cmd.Flags().Changed("description") {
+				req.Description = &description
+			}
+			if cmd.Flags().Changed("url") {
+				req.Url = &url
+			}
+			client, err := o.client(cmd.Context())
+			if err != nil {
+				return err
+			}
+			if _, err := client.UpdateSecret(cmd.Context(), connect.NewRequest(req)); err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "secret/%s updated%s\n", args[0], dryRunSuffix(dryRun))
+			return nil
+		},
+	}
+	data.addFlags(cmd)
+	cmd.Flags().StringVar(&description, "description", "", "Secret description")
+	cmd.Flags().StringVar(&url, "url", "", "URL of the service the secret belongs to")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Validate the request without updating the secret")
+	return cmd
+}
+
+// uploadChunkSize is the chunk size `secrets upload` sends, the largest
+// AppendSecretKey accepts.
+const uploadChunkSize = 256 << 10
+
+func secretsUploadCommand(o *secretsOptions) *cobra.Command {
+	var contentType string
+	cmd := &cobra.Command{
+		Use:   "upload NAME KEY PATH",
+		Short: "Upload a file into one key of a secret in chunks",
+		Long: "Upload sets KEY of the existing secret NAME to the contents of PATH, sending it in chunks so\n" +
+			"values approaching the 1MiB secret size limit fit under the server's request size limit.\n" +
+			"Other keys of the secret are left untouched.",
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name, key := args[0], args[1]
+			value, err := os.ReadFile(args[2])
+			if err != nil {
+				return fmt.Errorf("reading %s: %w", args[2], err)
+			}
+			if len(value) == 0 {
+				return fmt.Errorf("%s is empty", args[2])
+			}
+			client, err := o.client(cmd.Context())
+			if err != nil {
+				return err
+			}
+			total := int64(len(value))
+			for offset := int64(0); offset < total; {
+				end := min(offset+uploadChunkSize, total)
+				resp, err := client.AppendSecretKey(cmd.Context(), connect.NewRequest(&consolev1.AppendSecretKeyRequest{
+					Name:        name,
+					Project:     o.project,
+					Cluster:     o.cluster,
+					Key:         key,
+					Offset:      offset,
+					TotalSize:   total,
+					Chunk:       value[offset:end],
+					ContentType: contentType,
+				}))
+				if err != nil {
+					return err
+				}
+				offset = resp.Msg.GetSize()
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "secret/%s key %s uploaded (%d bytes)\n", name, key, total)
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&contentType, "content-type", "", "Media type of the value, e.g. application/x-pem-file")
+	return cmd
+}
+
+func secretsDeleteCommand(o *secretsOptions) *cobra.Command {
+	var (
+		force  bool
+		dryRun bool
+	)
+	cmd := &cobra.Command{
+		Use:   "delete NAME",
+		Short: "Delete a secret",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := o.client(cmd.Context())
+			if err != nil {
+				return err
+			}
+			if _, err := client.DeleteSecret(cmd.Context(), connect.NewRequest(&consolev1.DeleteSecretRequest{
+				Name:    args[0],
+				Project: o.project,
+				Cluster: o.cluster,
+				Force:   force,
+				DryRun:  dryRun,
+			})); err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "secret/%s deleted%s\n", args[0], dryRunSuffix(dryRun))
+			return nil
+		},
+	}
+	cmd.Flags().BoolVar(&force, "force", false, "Delete the secret even if deployments still reference it")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Validate the request without deleting the secret")
+	return cmd
+}
+
+func secretsShareCommand(o *secretsOptions) *cobra.Command {
+	var (
+		grants grantFlags
+		dryRun bool
+	)
+	cmd := &cobra.Command{
+		Use:   "share NAME",
+		Short: "Replace the share grants of a secret",
+		Long: "Replace the share grants of a secret with the --user and --role grants.\n" +
+			"Grants not listed are removed.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			users, roles, err := grants.grants()
+			if err != nil {
+				return err
+			}
+			client, err := o.client(cmd.Context())
+			if err != nil {
+				return err
+			}
+			resp, err := client.UpdateSharing(cmd.Context(), connect.NewRequest(&consolev1.UpdateSharingRequest{
+				Name:       args[0],
+				Project:    o.project,
+				Cluster:    o.cluster,
+				UserGrants: users,
+				RoleGrants: roles,
+				DryRun:     dryRun,
+			}))
+			if err != nil {
+				return err
+			}
+			return o.print(cmd.OutOrStdout(), resp.Msg, func(w io.Writer) {
+				fmt.Fprintln(w, "KIND\tPRINCIPAL\tROLE")
+				printGrants(w, "user", resp.Msg.GetMetadata().GetUserGrants())
+				printGrants(w, "role", resp.Msg.GetMetadata().GetRoleGrants())
+			})
+		},
+	}
+	grants.addFlags(cmd)
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Validate the request without changing the grants")
+	return cmd
+}
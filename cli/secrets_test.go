@@ -0,0 +1,233 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/spf13/cobra"
+
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+	"github.com/holos-run/holos-console/gen/holos/console/v1/consolev1connect"
+)
+
+// fakeSecretsService records the requests and bearer tokens it receives.
+type fakeSecretsService struct {
+	consolev1connect.UnimplementedSecretsServiceHandler
+	auth     string
+	created  *consolev1.CreateSecretRequest
+	shared   *consolev1.UpdateSharingRequest
+	uploaded []byte
+	chunks   int
+}
+
+func (f *fakeSecretsService) ListSecrets(_ context.Context, req *connect.Request[consolev1.ListSecretsRequest]) (*connect.Response[consolev1.ListSecretsResponse], error) {
+	f.auth = req.Header().Get("Authorization")
+	if req.Msg.GetProject() != "web" {
+		return nil, connect.NewError(connect.CodeNotFound, nil)
+	}
+	description := "database credentials"
+	return connect.NewResponse(&consolev1.ListSecretsResponse{Secrets: []*consolev1.SecretMetadata{
+		{Name: "db-creds", Accessible: true, Source: "console", Description: &description},
+	}}), nil
+}
+
+func (f *fakeSecretsService) GetSecret(_ context.Context, req *connect.Request[consolev1.GetSecretRequest]) (*connect.Response[consolev1.GetSecretResponse], error) {
+	return connect.NewResponse(&consolev1.GetSecretResponse{Data: map[string][]byte{
+		"username": []byte("admin"),
+		"password": []byte("hunter2"),
+	}}), nil
+}
+
+func (f *fakeSecretsService) CreateSecret(_ context.Context, req *connect.Request[consolev1.CreateSecretRequest]) (*connect.Response[consolev1.CreateSecretResponse], error) {
+	f.created = req.Msg
+	return connect.NewResponse(&consolev1.CreateSecretResponse{Name: req.Msg.GetName()}), nil
+}
+
+func (f *fakeSecretsService) UpdateSharing(_ context.Context, req *connect.Request[consolev1.UpdateSharingRequest]) (*connect.Response[consolev1.UpdateSharingResponse], error) {
+	f.shared = req.Msg
+	return connect.NewResponse(&consolev1.UpdateSharingResponse{Metadata: &consolev1.SecretMetadata{
+		Name:       req.Msg.GetName(),
+		UserGrants: req.Msg.GetUserGrants(),
+		RoleGrants: req.Msg.GetRoleGrants(),
+	}}), nil
+}
+
+func (f *fakeSecretsService) AppendSecretKey(_ context.Context, req *connect.Request[consolev1.AppendSecretKeyRequest]) (*connect.Response[consolev1.AppendSecretKeyResponse], error) {
+	if req.Msg.GetOffset() != int64(len(f.uploaded)) {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, nil)
+	}
+	f.uploaded = append(f.uploaded, req.Msg.GetChunk()...)
+	f.chunks++
+	size := int64(len(f.uploaded))
+	return connect.NewResponse(&consolev1.AppendSecretKeyResponse{Size: size, Complete: size == req.Msg.GetTotalSize()}), nil
+}
+
+func newFakeSecretsServer(t *testing.T) (*fakeSecretsService, string) {
+	t.Helper()
+	svc := &fakeSecretsService{}
+	mux := http.NewServeMux()
+	mux.Handle(consolev1connect.NewSecretsServiceHandler(svc))
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return svc, srv.URL
+}
+
+// runSecrets runs `holos-console secrets args...` and returns its output.
+func runSecrets(t *testing.T, args ...string) (string, error) {
+	t.Helper()
+	return runCommand(t, secretsCommand(), args...)
+}
+
+// runCommand runs cmd with args and returns its combined output.
+func runCommand(t *testing.T, cmd *cobra.Command, args ...string) (string, error) {
+	t.Helper()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	cmd.SetArgs(args)
+	err := cmd.ExecuteContext(context.Background())
+	return out.String(), err
+}
+
+func TestSecretsList_UsesConfigContext(t *testing.T) {
+	svc, url := newFakeSecretsServer(t)
+	dir := t.TempDir()
+	tokenFile := filepath.Join(dir, "token")
+	if err := os.WriteFile(tokenFile, []byte("file-token\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	config := filepath.Join(dir, "config")
+	if err := os.WriteFile(config, []byte(`current-context: dev
+contexts:
+- name: dev
+  context:
+    server: local
+    user: alice
+servers:
+- name: local
+  server:
+    url: `+url+`
+users:
+- name: alice
+  user:
+    token-file: `+tokenFile+`
+`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	out, err := runSecrets(t, "list", "--config", config, "--project", "web")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if svc.auth != "Bearer file-token" {
+		t.Errorf("expected token from token-file, got %q", svc.auth)
+	}
+	if !strings.Contains(out, "db-creds") || !strings.Contains(out, "database credentials") {
+		t.Errorf("unexpected output:\n%s", out)
+	}
+}
+
+func TestSecretsGet_PrintsSortedData(t *testing.T) {
+	_, url := newFakeSecretsServer(t)
+	out, err := runSecrets(t, "get", "db-creds", "--server", url, "--config", "", "-p", "web")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if want := "password=hunter2\nusername=admin\n"; out != want {
+		t.Errorf("output = %q, want %q", out, want)
+	}
+}
+
+func TestSecretsCreate_SendsDataAndGrants(t *testing.T) {
+	svc, url := newFakeSecretsServer(t)
+	valueFile := filepath.Join(t.TempDir(), "ca.crt")
+	if err := os.WriteFile(valueFile, []byte("PEM"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	_, err := runSecrets(t, "create", "tls", "--server", url, "--config", "", "--token", "flag-token", "-p", "web",
+		"--from-literal", "user=admin", "--from-file", "ca.crt="+valueFile,
+		"--user", "bob@example.com=viewer", "--role", "dev=editor", "--description", "TLS material")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	got := svc.created
+	if got.GetName() != "tls" || got.GetProject() != "web" || got.GetDescription() != "TLS material" {
+		t.Errorf("unexpected request %v", got)
+	}
+	if string(got.GetData()["user"]) != "admin" || string(got.GetData()["ca.crt"]) != "PEM" {
+		t.Errorf("unexpected data %v", got.GetData())
+	}
+	if len(got.GetUserGrants()) != 1 || got.GetUserGrants()[0].GetRole() != consolev1.Role_ROLE_VIEWER {
+		t.Errorf("unexpected user grants %v", got.GetUserGrants())
+	}
+	if len(got.GetRoleGrants()) != 1 || got.GetRoleGrants()[0].GetPrincipal() != "dev" {
+		t.Errorf("unexpected role grants %v", got.GetRoleGrants())
+	}
+	if got.Url != nil {
+		t.Errorf("expected url to be left unset, got %q", got.GetUrl())
+	}
+}
+
+func TestSecretsUpload_SendsChunks(t *testing.T) {
+	svc, url := newFakeSecretsServer(t)
+	value := bytes.Repeat([]byte("x"), uploadChunkSize*2+10)
+	valueFile := filepath.Join(t.TempDir(), "bundle.pem")
+	if err := os.WriteFile(valueFile, value, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	out, err := runSecrets(t, "upload", "ca", "bundle.pem", valueFile, "--server", url, "--config", "", "-p", "web")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if svc.chunks != 3 || !bytes.Equal(svc.uploaded, value) {
+		t.Errorf("expected the value in 3 chunks, got %d chunks of %d bytes", svc.chunks, len(svc.uploaded))
+	}
+	if !strings.Contains(out, "uploaded") {
+		t.Errorf("unexpected output:\n%s", out)
+	}
+}
+
+func TestSecretsShare_PrintsGrants(t *testing.T) {
+	svc, url := newFakeSecretsServer(t)
+	out, err := runSecrets(t, "share", "db-creds", "--server", url, "--config", "", "-p", "web",
+		"--user", "alice@example.com=owner")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(svc.shared.GetRoleGrants()) != 0 {
+		t.Errorf("expected no role grants, got %v", svc.shared.GetRoleGrants())
+	}
+	if !strings.Contains(out, "user  alice@example.com  owner") {
+		t.Errorf("unexpected output:\n%s", out)
+	}
+}
+
+func TestSecrets_RejectsInvalidFlags(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		want string
+	}{
+		{"missing project", []string{"list", "--server", "http://localhost"}, `"project" not set`},
+		{"missing server", []string{"list", "--config", "", "-p", "web"}, "no console server configured"},
+		{"bad grant role", []string{"share", "x", "--server", "http://localhost", "-p", "web", "--user", "bob@example.com=admin"}, "role must be viewer, editor or owner"},
+		{"bad literal", []string{"create", "x", "--server", "http://localhost", "-p", "web", "--from-literal", "novalue"}, "want KEY=VALUE"},
+		{"bad output", []string{"list", "--server", "http://localhost", "-p", "web", "-o", "wide"}, "invalid --output"},
+		{"unknown context", []string{"list", "--config", "", "--context", "prod", "-p", "web"}, `context "prod" not found`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := runSecrets(t, tt.args...)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("expected error containing %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/spf13/cobra"
+
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+	"github.com/holos-run/holos-console/gen/holos/console/v1/consolev1connect"
+)
+
+// whoamiCommand returns the `whoami` command, which prints the caller's
+// identity as the console resolves it.
+func whoamiCommand() *cobra.Command {
+	var o apiOptions
+	cmd := &cobra.Command{
+		Use:   "whoami",
+		Short: "Show the identity the console resolves from your token",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := o.validate(); err != nil {
+				return err
+			}
+			client, err := newServiceClient(cmd.Context(), &o.clientOptions, consolev1connect.NewIdentityServiceClient)
+			if err != nil {
+				return err
+			}
+			resp, err := client.WhoAmI(cmd.Context(), connect.NewRequest(&consolev1.WhoAmIRequest{}))
+			if err != nil {
+				return err
+			}
+			return o.print(cmd.OutOrStdout(), resp.Msg, func(w io.Writer) {
+				me := resp.Msg
+				rows := [][2]string{
+					{"Subject", me.GetSubject()},
+					{"Email", me.GetEmail()},
+					{"Name", me.GetName()},
+					{"Issuer", me.GetIssuer()},
+					{"Principal type", me.GetPrincipalType()},
+					{"Groups", strings.Join(me.GetGroups(), ", ")},
+					{"Kubernetes user", me.GetKubernetesUser()},
+					{"Kubernetes groups", strings.Join(me.GetKubernetesGroups(), ", ")},
+					{"Platform roles", strings.Join(me.GetPlatformRoles(), ", ")},
+					{"Impersonator", me.GetImpersonator()},
+				}
+				if me.GetExpiresAt() != nil {
+					rows = append(rows, [2]string{"Expires", me.GetExpiresAt().AsTime().Local().Format(time.RFC3339)})
+				}
+				for _, row := range rows {
+					if row[1] != "" {
+						fmt.Fprintf(w, "%s:\t%s\n", row[0], row[1])
+					}
+				}
+			})
+		},
+	}
+	o.addFlags(cmd)
+	return cmd
+}
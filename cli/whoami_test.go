@@ -0,0 +1,48 @@
+package cli
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"connectrpc.com/connect"
+
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+	"github.com/holos-run/holos-console/gen/holos/console/v1/consolev1connect"
+)
+
+type fakeIdentityService struct {
+	consolev1connect.UnimplementedIdentityServiceHandler
+}
+
+func (fakeIdentityService) WhoAmI(context.Context, *connect.Request[consolev1.WhoAmIRequest]) (*connect.Response[consolev1.WhoAmIResponse], error) {
+	return connect.NewResponse(&consolev1.WhoAmIResponse{
+		Subject:          "alice",
+		Email:            "alice@example.com",
+		Groups:           []string{"devs", "ops"},
+		KubernetesUser:   "oidc:alice",
+		KubernetesGroups: []string{"oidc:devs", "oidc:ops"},
+	}), nil
+}
+
+func TestWhoAmI_PrintsIdentity(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.Handle(consolev1connect.NewIdentityServiceHandler(fakeIdentityService{}))
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	out, err := runCommand(t, whoamiCommand(), "--server", srv.URL, "--config", "")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	for _, want := range []string{"Email:              alice@example.com\n", "Groups:             devs, ops\n", "Kubernetes groups:  oidc:devs, oidc:ops\n"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected output to contain %q, got:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "Impersonator") {
+		t.Errorf("expected empty fields to be omitted, got:\n%s", out)
+	}
+}
@@ -0,0 +1,435 @@
+// Package accessrequests implements AccessRequestService: time-boxed
+// break-glass access to projects and secrets. A user without access files a
+// request with a justification, and a project owner approves it by
+// installing a share grant bounded by nbf/exp, or denies it.
+package accessrequests
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+
+	v1alpha2 "github.com/holos-run/holos-console/api/v1alpha2"
+	"github.com/holos-run/holos-console/console/permissions"
+	"github.com/holos-run/holos-console/console/projects"
+	"github.com/holos-run/holos-console/console/rpc"
+	"github.com/holos-run/holos-console/console/secrets"
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+	"github.com/holos-run/holos-console/gen/holos/console/v1/consolev1connect"
+)
+
+const (
+	// auditResourceType is the resource_type value for access request audit
+	// log events.
+	auditResourceType = "access_request"
+
+	// resourceTypeSecret is the AccessRequest resource_type for project
+	// secrets. Project requests use v1alpha2.ResourceTypeProject.
+	resourceTypeSecret = "secret"
+
+	// defaultDuration applies when a request does not set duration_seconds.
+	defaultDuration = time.Hour
+	// maxDuration caps how long a break-glass grant may last.
+	maxDuration = 24 * time.Hour
+)
+
+// Handler implements consolev1connect.AccessRequestServiceHandler.
+type Handler struct {
+	consolev1connect.UnimplementedAccessRequestServiceHandler
+	k8s      *K8sClient
+	projects *projects.K8sClient
+	secrets  *secrets.K8sClient
+}
+
+// NewHandler returns an AccessRequestService handler. Requests are stored
+// with k8s; approvals install grants with projectsK8s and secretsK8s.
+func NewHandler(k8s *K8sClient, projectsK8s *projects.K8sClient, secretsK8s *secrets.K8sClient) *Handler {
+	return &Handler{k8s: k8s, projects: projectsK8s, secrets: secretsK8s}
+}
+
+// CreateAccessRequest files a pending access request for the caller.
+func (h *Handler) CreateAccessRequest(
+	ctx context.Context,
+	req *connect.Request[consolev1.CreateAccessRequestRequest],
+) (*connect.Response[consolev1.CreateAccessRequestResponse], error) {
+	claims := rpc.MustClaims(ctx)
+	msg := req.Msg
+	if msg.Project == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("project is required"))
+	}
+	resourceName := msg.ResourceName
+	switch msg.ResourceType {
+	case v1alpha2.ResourceTypeProject:
+		if resourceName == "" {
+			resourceName = msg.Project
+		}
+		if resourceName != msg.Project {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("resource_name must match project for project requests"))
+		}
+	case resourceTypeSecret:
+		if resourceName == "" {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("resource_name is required for secret requests"))
+		}
+		if msg.Role != consolev1.Role_ROLE_VIEWER {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("secret requests support ROLE_VIEWER only"))
+		}
+	default:
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unsupported resource_type %q", msg.ResourceType))
+	}
+	if msg.Role == consolev1.Role_ROLE_UNSPECIFIED || consolev1.Role_name[int32(msg.Role)] == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("role is required"))
+	}
+	justification := strings.TrimSpace(msg.Justification)
+	if justification == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("justification is required"))
+	}
+	duration := time.Duration(msg.DurationSeconds) * time.Second
+	if msg.DurationSeconds == 0 {
+		duration = defaultDuration
+	}
+	if duration <= 0 || duration > maxDuration {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("duration_seconds must be between 1 and %d", int64(maxDuration/time.Second)))
+	}
+
+	// The secret itself is not looked up here: telling a caller without
+	// access whether a secret exists would leak its name. Approval fails
+	// if it does not.
+	exists, err := h.k8s.ProjectExists(ctx, msg.Project)
+	if apierrors.IsNotFound(err) || (err == nil && !exists) {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("project %q not found", msg.Project))
+	}
+	if err != nil {
+		return nil, rpc.MapK8sError(err)
+	}
+
+	created, err := h.k8s.CreateAccessRequest(ctx, &consolev1.AccessRequest{
+		Project:         msg.Project,
+		ResourceType:    msg.ResourceType,
+		ResourceName:    resourceName,
+		Role:            msg.Role,
+		Justification:   justification,
+		DurationSeconds: int64(duration / time.Second),
+		RequesterEmail:  claims.Email,
+		RequesterSub:    claims.Sub,
+		State:           consolev1.AccessRequestState_ACCESS_REQUEST_STATE_PENDING,
+		CreatedAt:       timestamppb.Now(),
+	})
+	if err != nil {
+		return nil, rpc.MapK8sError(err)
+	}
+
+	slog.InfoContext(ctx, "access request created",
+		slog.String("action", "access_request_create"),
+		slog.String("resource_type", auditResourceType),
+		slog.String("name", created.Name),
+		slog.String("project", created.Project),
+		slog.String("sub", claims.Sub),
+		slog.String("email", claims.Email),
+		slog.String("target_type", created.ResourceType),
+		slog.String("target_name", created.ResourceName),
+		slog.String("role", created.Role.String()),
+		slog.Int64("duration_seconds", created.DurationSeconds),
+	)
+	return connect.NewResponse(&consolev1.CreateAccessRequestResponse{AccessRequest: created}), nil
+}
+
+// ListAccessRequests returns the access requests in a project, newest first.
+// Owners see every request; other callers see only the requests they filed.
+func (h *Handler) ListAccessRequests(
+	ctx context.Context,
+	req *connect.Request[consolev1.ListAccessRequestsRequest],
+) (*connect.Response[consolev1.ListAccessRequestsResponse], error) {
+	claims := rpc.MustClaims(ctx)
+	if req.Msg.Project == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("project is required"))
+	}
+	owner, err := h.isProjectOwner(ctx, req.Msg.Project)
+	if err != nil {
+		return nil, err
+	}
+	all, err := h.k8s.ListAccessRequests(ctx, req.Msg.Project)
+	if err != nil {
+		return nil, rpc.MapK8sError(err)
+	}
+	requests := make([]*consolev1.AccessRequest, 0, len(all))
+	for _, ar := range all {
+		if req.Msg.State != consolev1.AccessRequestState_ACCESS_REQUEST_STATE_UNSPECIFIED && ar.State != req.Msg.State {
+			continue
+		}
+		if !owner && !isRequester(ar, claims) {
+			continue
+		}
+		requests = append(requests, ar)
+	}
+	return connect.NewResponse(&consolev1.ListAccessRequestsResponse{AccessRequests: requests}), nil
+}
+
+// ApproveAccessRequest installs a grant for the requested role that expires
+// after the requested duration.
+func (h *Handler) ApproveAccessRequest(
+	ctx context.Context,
+	req *connect.Request[consolev1.ApproveAccessRequestRequest],
+) (*connect.Response[consolev1.ApproveAccessRequestResponse], error) {
+	claims := rpc.MustClaims(ctx)
+	ar, resourceVersion, err := h.pendingRequest(ctx, claims, req.Msg.Project, req.Msg.Name, "access_request_approve_denied")
+	if err != nil {
+		return nil, err
+	}
+	if isRequester(ar, claims) {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("cannot approve your own access request"))
+	}
+
+	now := time.Now()
+	nbf := now.Unix()
+	exp := now.Add(time.Duration(ar.DurationSeconds) * time.Second).Unix()
+	ar.State = consolev1.AccessRequestState_ACCESS_REQUEST_STATE_APPROVED
+	ar.DecidedBy = claims.Email
+	ar.DecidedAt = timestamppb.New(now)
+	ar.Reason = req.Msg.Reason
+	ar.Nbf = &nbf
+	ar.Exp = &exp
+	// Record the decision before installing the grant so a concurrent
+	// approve or deny of the same request fails on the resourceVersion.
+	approved, err := h.k8s.UpdateAccessRequest(ctx, ar, resourceVersion)
+	if err != nil {
+		return nil, rpc.MapK8sError(err)
+	}
+	if err := h.installGrant(ctx, approved); err != nil {
+		h.revertToPending(ctx, approved)
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "access request approved",
+		slog.String("action", "access_request_approve"),
+		slog.String("resource_type", auditResourceType),
+		slog.String("name", approved.Name),
+		slog.String("project", approved.Project),
+		slog.String("sub", claims.Sub),
+		slog.String("email", claims.Email),
+		slog.String("requester_email", approved.RequesterEmail),
+		slog.String("target_type", approved.ResourceType),
+		slog.String("target_name", approved.ResourceName),
+		slog.String("role", approved.Role.String()),
+		slog.Int64("exp", exp),
+	)
+	return connect.NewResponse(&consolev1.ApproveAccessRequestResponse{AccessRequest: approved}), nil
+}
+
+// DenyAccessRequest rejects a pending access request.
+func (h *Handler) DenyAccessRequest(
+	ctx context.Context,
+	req *connect.Request[consolev1.DenyAccessRequestRequest],
+) (*connect.Response[consolev1.DenyAccessRequestResponse], error) {
+	claims := rpc.MustClaims(ctx)
+	ar, resourceVersion, err := h.pendingRequest(ctx, claims, req.Msg.Project, req.Msg.Name, "access_request_deny_denied")
+	if err != nil {
+		return nil, err
+	}
+
+	ar.State = consolev1.AccessRequestState_ACCESS_REQUEST_STATE_DENIED
+	ar.DecidedBy = claims.Email
+	ar.DecidedAt = timestamppb.Now()
+	ar.Reason = req.Msg.Reason
+	denied, err := h.k8s.UpdateAccessRequest(ctx, ar, resourceVersion)
+	if err != nil {
+		return nil, rpc.MapK8sError(err)
+	}
+
+	slog.InfoContext(ctx, "access request denied",
+		slog.String("action", "access_request_deny"),
+		slog.String("resource_type", auditResourceType),
+		slog.String("name", denied.Name),
+		slog.String("project", denied.Project),
+		slog.String("sub", claims.Sub),
+		slog.String("email", claims.Email),
+		slog.String("requester_email", denied.RequesterEmail),
+		slog.String("target_type", denied.ResourceType),
+		slog.String("target_name", denied.ResourceName),
+		slog.String("role", denied.Role.String()),
+	)
+	return connect.NewResponse(&consolev1.DenyAccessRequestResponse{AccessRequest: denied}), nil
+}
+
+// pendingRequest authorizes the caller as a project owner and returns the
+// named request, which must still be pending. deniedAction is the audit
+// action logged when the caller is not an owner.
+func (h *Handler) pendingRequest(ctx context.Context, claims *rpc.Claims, project, name, deniedAction string) (*consolev1.AccessRequest, string, error) {
+	if project == "" {
+		return nil, "", connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("project is required"))
+	}
+	if name == "" {
+		return nil, "", connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("name is required"))
+	}
+	owner, err := h.isProjectOwner(ctx, project)
+	if err != nil {
+		return nil, "", err
+	}
+	if !owner {
+		slog.WarnContext(ctx, "access request decision denied",
+			slog.String("action", deniedAction),
+			slog.String("resource_type", auditResourceType),
+			slog.String("name", name),
+			slog.String("project", project),
+			slog.String("sub", claims.Sub),
+			slog.String("email", claims.Email),
+		)
+		return nil, "", connect.NewError(connect.CodePermissionDenied, fmt.Errorf("owner access to project %q is required", project))
+	}
+	ar, resourceVersion, err := h.k8s.GetAccessRequest(ctx, project, name)
+	if err != nil {
+		return nil, "", rpc.MapK8sError(err)
+	}
+	if ar.State != consolev1.AccessRequestState_ACCESS_REQUEST_STATE_PENDING {
+		return nil, "", connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("access request %q is %s, not pending", name, ar.State))
+	}
+	return ar, resourceVersion, nil
+}
+
+// isProjectOwner reports whether the caller may delete the project
+// namespace, the ADR 036 proof of the owner role.
+func (h *Handler) isProjectOwner(ctx context.Context, project string) (bool, error) {
+	clientset := rpc.ImpersonatedClientsetFromContext(ctx)
+	if clientset == nil {
+		return false, connect.NewError(connect.CodeInternal, fmt.Errorf("no kubernetes client on request context"))
+	}
+	perm, err := permissions.Review(ctx, clientset, &consolev1.ResourceAttributes{
+		Verb:     "delete",
+		Resource: "namespaces",
+		Name:     h.k8s.Resolver.ProjectNamespace(project),
+	})
+	if err != nil {
+		return false, rpc.MapK8sError(err)
+	}
+	return perm.Allowed, nil
+}
+
+// installGrant adds the time-limited grant an approved request carries.
+func (h *Handler) installGrant(ctx context.Context, ar *consolev1.AccessRequest) error {
+	switch ar.ResourceType {
+	case v1alpha2.ResourceTypeProject:
+		return h.installProjectGrant(ctx, ar)
+	case resourceTypeSecret:
+		return h.installSecretGrant(ctx, ar)
+	default:
+		return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("unsupported resource_type %q", ar.ResourceType))
+	}
+}
+
+// installProjectGrant appends a bounded share grant for the requester to the
+// project, alongside the subject-keyed grant Kubernetes RBAC binds.
+func (h *Handler) installProjectGrant(ctx context.Context, ar *consolev1.AccessRequest) error {
+	ns, err := h.projects.GetProject(ctx, ar.Project)
+	if err != nil {
+		return rpc.MapK8sError(err)
+	}
+	shareUsers, err := projects.GetShareUsers(ns)
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	shareRoles, err := projects.GetShareRoles(ns)
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	rbacShareUsers, err := projects.GetRBACShareUsers(ns)
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	grant := secrets.AnnotationGrant{
+		Principal: ar.RequesterEmail,
+		Role:      roleName(ar.Role),
+		Nbf:       ar.Nbf,
+		Exp:       ar.Exp,
+	}
+	shareUsers = append(shareUsers, grant)
+	rbacShareUsers = append(rbacShareUsers, secrets.RBACUserGrantsForSubjects(
+		[]secrets.AnnotationGrant{grant},
+		secrets.UserIdentity{Email: ar.RequesterEmail, Subject: ar.RequesterSub},
+	)...)
+	if _, err := h.projects.UpdateProjectSharing(ctx, ar.Project, shareUsers, shareRoles, rbacShareUsers); err != nil {
+		return rpc.MapK8sError(err)
+	}
+	return nil
+}
+
+// installSecretGrant appends a bounded key-scoped viewer grant covering every
+// key the secret holds at approval time. The grant is keyed by the
+// requester's OIDC subject so it is never merged with a standing grant the
+// requester may already hold by email.
+func (h *Handler) installSecretGrant(ctx context.Context, ar *consolev1.AccessRequest) error {
+	secret, err := h.secrets.GetSecret(ctx, ar.Project, ar.ResourceName)
+	if err != nil {
+		return rpc.MapK8sError(err)
+	}
+	keys := make([]string, 0, len(secret.Data))
+	for key := range secret.Data {
+		keys = append(keys, key)
+	}
+	if len(keys) == 0 {
+		return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("secret %q has no keys to share", ar.ResourceName))
+	}
+	slices.Sort(keys)
+	keyUsers, err := secrets.GetKeyShareUsers(secret)
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	keyRoles, err := secrets.GetKeyShareRoles(secret)
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	principal := ar.RequesterEmail
+	if ar.RequesterSub != "" {
+		principal = "oidc:" + ar.RequesterSub
+	}
+	keyUsers = append(keyUsers, secrets.AnnotationGrant{
+		Principal: principal,
+		Role:      roleName(ar.Role),
+		Keys:      keys,
+		Nbf:       ar.Nbf,
+		Exp:       ar.Exp,
+	})
+	if _, err := h.secrets.UpdateKeySharing(ctx, ar.Project, ar.ResourceName, keyUsers, keyRoles); err != nil {
+		if errors.Is(err, secrets.ErrNotManaged) {
+			return connect.NewError(connect.CodeFailedPrecondition, err)
+		}
+		return rpc.MapK8sError(err)
+	}
+	return nil
+}
+
+// revertToPending best-effort restores an approved request whose grant
+// could not be installed, so an owner can retry.
+func (h *Handler) revertToPending(ctx context.Context, ar *consolev1.AccessRequest) {
+	current, resourceVersion, err := h.k8s.GetAccessRequest(ctx, ar.Project, ar.Name)
+	if err == nil {
+		current.State = consolev1.AccessRequestState_ACCESS_REQUEST_STATE_PENDING
+		current.DecidedBy, current.DecidedAt, current.Reason = "", nil, ""
+		current.Nbf, current.Exp = nil, nil
+		_, err = h.k8s.UpdateAccessRequest(ctx, current, resourceVersion)
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "could not revert access request after failed approval",
+			slog.String("project", ar.Project),
+			slog.String("name", ar.Name),
+			slog.Any("error", err),
+		)
+	}
+}
+
+// isRequester reports whether claims identify the user who filed ar.
+func isRequester(ar *consolev1.AccessRequest, claims *rpc.Claims) bool {
+	return (ar.RequesterSub != "" && ar.RequesterSub == claims.Sub) ||
+		(ar.RequesterEmail != "" && strings.EqualFold(ar.RequesterEmail, claims.Email))
+}
+
+// roleName returns the share grant role string for role, e.g. "viewer".
+func roleName(role consolev1.Role) string {
+	return strings.ToLower(strings.TrimPrefix(role.String(), "ROLE_"))
+}
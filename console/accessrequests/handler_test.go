@@ -0,0 +1,264 @@
+package accessrequests
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	authzv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	clienttesting "k8s.io/client-go/testing"
+
+	v1alpha2 "github.com/holos-run/holos-console/api/v1alpha2"
+	"github.com/holos-run/holos-console/console/audit"
+	"github.com/holos-run/holos-console/console/projects"
+	"github.com/holos-run/holos-console/console/resolver"
+	"github.com/holos-run/holos-console/console/rpc"
+	"github.com/holos-run/holos-console/console/secrets"
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+)
+
+func testResolver() *resolver.Resolver {
+	return &resolver.Resolver{NamespacePrefix: "holos-", OrganizationPrefix: "org-", FolderPrefix: "fld-", ProjectPrefix: "prj-"}
+}
+
+// testEnv is a fake cluster holding the billing project and its db-creds
+// secret, shared by the console service account and every caller.
+type testEnv struct {
+	client  *fake.Clientset
+	handler *Handler
+	ring    *audit.Ring
+	owner   bool
+}
+
+func newTestEnv(t *testing.T) *testEnv {
+	t.Helper()
+	r := testResolver()
+	client := fake.NewClientset(
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+			Name: r.ProjectNamespace("billing"),
+			Labels: map[string]string{
+				v1alpha2.LabelManagedBy:    v1alpha2.ManagedByValue,
+				v1alpha2.LabelResourceType: v1alpha2.ResourceTypeProject,
+				v1alpha2.LabelProject:      "billing",
+			},
+		}},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "db-creds",
+				Namespace: r.ProjectNamespace("billing"),
+				Labels:    map[string]string{v1alpha2.LabelManagedBy: v1alpha2.ManagedByValue},
+			},
+			Data: map[string][]byte{"username": []byte("admin"), "password": []byte("hunter2")},
+		},
+	)
+	ring := audit.NewRing(100)
+	old := slog.Default()
+	slog.SetDefault(slog.New(audit.NewLogHandler(slog.DiscardHandler, ring)))
+	t.Cleanup(func() { slog.SetDefault(old) })
+	e := &testEnv{
+		client:  client,
+		handler: NewHandler(NewK8sClient(client, r), projects.NewK8sClient(client, r), secrets.NewK8sClient(client, r)),
+		ring:    ring,
+	}
+	client.PrependReactor("create", "selfsubjectaccessreviews", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		ssar := action.(clienttesting.CreateAction).GetObject().(*authzv1.SelfSubjectAccessReview)
+		ssar.Status.Allowed = e.owner
+		return true, ssar, nil
+	})
+	return e
+}
+
+// as returns a context for claims whose SelfSubjectAccessReviews report
+// owner when owner is set. The flag applies until the next call to as.
+func (e *testEnv) as(claims *rpc.Claims, owner bool) context.Context {
+	e.owner = owner
+	ctx := rpc.ContextWithClaims(context.Background(), claims)
+	return rpc.ContextWithImpersonatedClients(ctx, &rpc.ImpersonatedClients{Clientset: e.client})
+}
+
+var (
+	requester = &rpc.Claims{Sub: "sub-bob", Email: "bob@example.com"}
+	approver  = &rpc.Claims{Sub: "sub-alice", Email: "alice@example.com"}
+)
+
+func (e *testEnv) create(t *testing.T, msg *consolev1.CreateAccessRequestRequest) *consolev1.AccessRequest {
+	t.Helper()
+	resp, err := e.handler.CreateAccessRequest(e.as(requester, false), connect.NewRequest(msg))
+	if err != nil {
+		t.Fatalf("CreateAccessRequest: %v", err)
+	}
+	return resp.Msg.AccessRequest
+}
+
+func (e *testEnv) actions() []string {
+	var actions []string
+	for _, ev := range e.ring.List(audit.Filter{ResourceType: auditResourceType}) {
+		actions = append(actions, ev.Action)
+	}
+	return actions
+}
+
+func TestCreateAccessRequest_Validation(t *testing.T) {
+	e := newTestEnv(t)
+	ctx := e.as(requester, false)
+	for _, tt := range []struct {
+		name string
+		msg  *consolev1.CreateAccessRequestRequest
+		code connect.Code
+	}{
+		{"missing justification", &consolev1.CreateAccessRequestRequest{Project: "billing", ResourceType: "project", Role: consolev1.Role_ROLE_EDITOR}, connect.CodeInvalidArgument},
+		{"missing role", &consolev1.CreateAccessRequestRequest{Project: "billing", ResourceType: "project", Justification: "incident"}, connect.CodeInvalidArgument},
+		{"duration over cap", &consolev1.CreateAccessRequestRequest{Project: "billing", ResourceType: "project", Role: consolev1.Role_ROLE_EDITOR, Justification: "incident", DurationSeconds: 48 * 3600}, connect.CodeInvalidArgument},
+		{"secret editor", &consolev1.CreateAccessRequestRequest{Project: "billing", ResourceType: "secret", ResourceName: "db-creds", Role: consolev1.Role_ROLE_EDITOR, Justification: "incident"}, connect.CodeInvalidArgument},
+		{"unknown project", &consolev1.CreateAccessRequestRequest{Project: "payroll", ResourceType: "project", Role: consolev1.Role_ROLE_EDITOR, Justification: "incident"}, connect.CodeNotFound},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := e.handler.CreateAccessRequest(ctx, connect.NewRequest(tt.msg))
+			if connect.CodeOf(err) != tt.code {
+				t.Fatalf("expected %v, got %v", tt.code, err)
+			}
+		})
+	}
+}
+
+func TestApproveAccessRequest_Project(t *testing.T) {
+	e := newTestEnv(t)
+	ar := e.create(t, &consolev1.CreateAccessRequestRequest{
+		Project:         "billing",
+		ResourceType:    "project",
+		Role:            consolev1.Role_ROLE_EDITOR,
+		Justification:   "INC-42 database failover",
+		DurationSeconds: 1800,
+	})
+	if ar.State != consolev1.AccessRequestState_ACCESS_REQUEST_STATE_PENDING || ar.ResourceName != "billing" {
+		t.Fatalf("expected a pending request for billing, got %+v", ar)
+	}
+
+	// The requester sees their own request but cannot decide it.
+	list, err := e.handler.ListAccessRequests(e.as(requester, false), connect.NewRequest(&consolev1.ListAccessRequestsRequest{Project: "billing"}))
+	if err != nil || len(list.Msg.AccessRequests) != 1 {
+		t.Fatalf("expected requester to see their request, got %v, %v", list, err)
+	}
+	_, err = e.handler.ApproveAccessRequest(e.as(requester, false), connect.NewRequest(&consolev1.ApproveAccessRequestRequest{Project: "billing", Name: ar.Name}))
+	if connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Fatalf("expected PermissionDenied for a non-owner, got %v", err)
+	}
+
+	before := time.Now().Unix()
+	resp, err := e.handler.ApproveAccessRequest(e.as(approver, true), connect.NewRequest(&consolev1.ApproveAccessRequestRequest{Project: "billing", Name: ar.Name, Reason: "ok"}))
+	if err != nil {
+		t.Fatalf("ApproveAccessRequest: %v", err)
+	}
+	approved := resp.Msg.AccessRequest
+	if approved.State != consolev1.AccessRequestState_ACCESS_REQUEST_STATE_APPROVED || approved.DecidedBy != approver.Email {
+		t.Fatalf("expected request approved by alice, got %+v", approved)
+	}
+	if approved.Exp == nil || *approved.Exp < before+1800 {
+		t.Fatalf("expected exp at least 30 minutes out, got %v", approved.Exp)
+	}
+
+	ns, err := e.client.CoreV1().Namespaces().Get(context.Background(), "holos-prj-billing", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	users, _ := projects.GetShareUsers(ns)
+	if len(users) != 1 || users[0].Principal != "bob@example.com" || users[0].Role != "editor" || users[0].Exp == nil || *users[0].Exp != *approved.Exp {
+		t.Fatalf("expected a time-limited editor grant for bob, got %+v", users)
+	}
+	rbacUsers, _ := projects.GetRBACShareUsers(ns)
+	if len(rbacUsers) != 1 || rbacUsers[0].Principal != "sub-bob" {
+		t.Fatalf("expected an RBAC grant for bob's subject, got %+v", rbacUsers)
+	}
+
+	_, err = e.handler.DenyAccessRequest(e.as(approver, true), connect.NewRequest(&consolev1.DenyAccessRequestRequest{Project: "billing", Name: ar.Name}))
+	if connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Fatalf("expected FailedPrecondition deciding twice, got %v", err)
+	}
+
+	want := []string{"access_request_approve", "access_request_approve_denied", "access_request_create"}
+	if got := e.actions(); !slices.Equal(got, want) {
+		t.Fatalf("audit actions = %v, want %v", got, want)
+	}
+}
+
+func TestApproveAccessRequest_Secret(t *testing.T) {
+	e := newTestEnv(t)
+	ar := e.create(t, &consolev1.CreateAccessRequestRequest{
+		Project:       "billing",
+		ResourceType:  "secret",
+		ResourceName:  "db-creds",
+		Role:          consolev1.Role_ROLE_VIEWER,
+		Justification: "rotate credentials",
+	})
+	if ar.DurationSeconds != int64(defaultDuration/time.Second) {
+		t.Fatalf("expected the default duration, got %d", ar.DurationSeconds)
+	}
+	if _, err := e.handler.ApproveAccessRequest(e.as(approver, true), connect.NewRequest(&consolev1.ApproveAccessRequestRequest{Project: "billing", Name: ar.Name})); err != nil {
+		t.Fatalf("ApproveAccessRequest: %v", err)
+	}
+	secret, err := e.client.CoreV1().Secrets("holos-prj-billing").Get(context.Background(), "db-creds", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyUsers, _ := secrets.GetKeyShareUsers(secret)
+	now := time.Now()
+	for _, key := range []string{"password", "username"} {
+		if !secrets.KeyGrantAllows(keyUsers, nil, "bob@example.com", "sub-bob", nil, key, now) {
+			t.Errorf("expected bob to read %q now", key)
+		}
+		if secrets.KeyGrantAllows(keyUsers, nil, "bob@example.com", "sub-bob", nil, key, now.Add(2*time.Hour)) {
+			t.Errorf("expected bob's access to %q to lapse", key)
+		}
+	}
+}
+
+func TestDenyAccessRequest(t *testing.T) {
+	e := newTestEnv(t)
+	ar := e.create(t, &consolev1.CreateAccessRequestRequest{Project: "billing", ResourceType: "project", Role: consolev1.Role_ROLE_OWNER, Justification: "curious"})
+
+	_, err := e.handler.ApproveAccessRequest(e.as(requester, true), connect.NewRequest(&consolev1.ApproveAccessRequestRequest{Project: "billing", Name: ar.Name}))
+	if connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Fatalf("expected PermissionDenied approving your own request, got %v", err)
+	}
+
+	resp, err := e.handler.DenyAccessRequest(e.as(approver, true), connect.NewRequest(&consolev1.DenyAccessRequestRequest{Project: "billing", Name: ar.Name, Reason: "not needed"}))
+	if err != nil {
+		t.Fatalf("DenyAccessRequest: %v", err)
+	}
+	if resp.Msg.AccessRequest.State != consolev1.AccessRequestState_ACCESS_REQUEST_STATE_DENIED || resp.Msg.AccessRequest.Reason != "not needed" {
+		t.Fatalf("expected denied request with reason, got %+v", resp.Msg.AccessRequest)
+	}
+	ns, err := e.client.CoreV1().Namespaces().Get(context.Background(), "holos-prj-billing", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if users, _ := projects.GetShareUsers(ns); len(users) != 0 {
+		t.Fatalf("expected no grants after deny, got %+v", users)
+	}
+
+	// Other non-owners do not see bob's request.
+	carol := &rpc.Claims{Sub: "sub-carol", Email: "carol@example.com"}
+	list, err := e.handler.ListAccessRequests(e.as(carol, false), connect.NewRequest(&consolev1.ListAccessRequestsRequest{Project: "billing"}))
+	if err != nil || len(list.Msg.AccessRequests) != 0 {
+		t.Fatalf("expected carol to see no requests, got %v, %v", list, err)
+	}
+	list, err = e.handler.ListAccessRequests(e.as(approver, true), connect.NewRequest(&consolev1.ListAccessRequestsRequest{
+		Project: "billing",
+		State:   consolev1.AccessRequestState_ACCESS_REQUEST_STATE_DENIED,
+	}))
+	if err != nil || len(list.Msg.AccessRequests) != 1 {
+		t.Fatalf("expected the owner to see the denied request, got %v, %v", list, err)
+	}
+
+	want := []string{"access_request_deny", "access_request_create"}
+	if got := e.actions(); !slices.Equal(got, want) {
+		t.Fatalf("audit actions = %v, want %v", got, want)
+	}
+}
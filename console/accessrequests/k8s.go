@@ -0,0 +1,182 @@
+package accessrequests
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"sort"
+
+	"connectrpc.com/connect"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/protobuf/encoding/protojson"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+
+	v1alpha2 "github.com/holos-run/holos-console/api/v1alpha2"
+	"github.com/holos-run/holos-console/console/resolver"
+	"github.com/holos-run/holos-console/console/tracing"
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+)
+
+const (
+	// namePrefix prefixes the generated name of every access request.
+	namePrefix = "access-request-"
+	// dataKey is the ConfigMap data key holding the protojson-encoded
+	// AccessRequest.
+	dataKey = "request.json"
+)
+
+// K8sClient stores access requests as ConfigMaps in the project namespace.
+// Every call uses the console service account because requesters by
+// definition lack access to the namespace.
+type K8sClient struct {
+	client   kubernetes.Interface
+	Resolver *resolver.Resolver
+}
+
+// NewK8sClient creates a client for access request storage.
+func NewK8sClient(client kubernetes.Interface, r *resolver.Resolver) *K8sClient {
+	return &K8sClient{client: client, Resolver: r}
+}
+
+// ProjectExists reports whether project is backed by a console-managed
+// project namespace.
+func (c *K8sClient) ProjectExists(ctx context.Context, project string) (bool, error) {
+	ns, err := c.client.CoreV1().Namespaces().Get(ctx, c.Resolver.ProjectNamespace(project), metav1.GetOptions{})
+	if err != nil {
+		return false, err
+	}
+	return ns.Labels[v1alpha2.LabelManagedBy] == v1alpha2.ManagedByValue &&
+		ns.Labels[v1alpha2.LabelResourceType] == v1alpha2.ResourceTypeProject, nil
+}
+
+// CreateAccessRequest stores req under a generated name and returns it with
+// the name set.
+func (c *K8sClient) CreateAccessRequest(ctx context.Context, req *consolev1.AccessRequest) (*consolev1.AccessRequest, error) {
+	ctx, span := tracing.Start(ctx, "accessrequests.K8sClient.CreateAccessRequest", attribute.String("project", req.Project))
+	defer span.End()
+	suffix := make([]byte, 4)
+	if _, err := rand.Read(suffix); err != nil {
+		return nil, fmt.Errorf("generating access request name: %w", err)
+	}
+	req.Name = namePrefix + hex.EncodeToString(suffix)
+	ns := c.Resolver.ProjectNamespace(req.Project)
+	slog.DebugContext(ctx, "creating access request in kubernetes",
+		slog.String("project", req.Project),
+		slog.String("namespace", ns),
+		slog.String("name", req.Name),
+	)
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      req.Name,
+			Namespace: ns,
+			Labels: map[string]string{
+				v1alpha2.LabelManagedBy:    v1alpha2.ManagedByValue,
+				v1alpha2.LabelResourceType: v1alpha2.ResourceTypeAccessRequest,
+				v1alpha2.LabelProject:      req.Project,
+			},
+		},
+	}
+	if err := encode(cm, req); err != nil {
+		return nil, err
+	}
+	created, err := c.client.CoreV1().ConfigMaps(ns).Create(ctx, cm, metav1.CreateOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return decode(created)
+}
+
+// GetAccessRequest returns the named access request in project along with
+// its resourceVersion for optimistic concurrency on update.
+func (c *K8sClient) GetAccessRequest(ctx context.Context, project, name string) (*consolev1.AccessRequest, string, error) {
+	ctx, span := tracing.Start(ctx, "accessrequests.K8sClient.GetAccessRequest", attribute.String("project", project), attribute.String("name", name))
+	defer span.End()
+	cm, err := c.client.CoreV1().ConfigMaps(c.Resolver.ProjectNamespace(project)).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, "", err
+	}
+	if cm.Labels[v1alpha2.LabelResourceType] != v1alpha2.ResourceTypeAccessRequest {
+		return nil, "", connect.NewError(connect.CodeNotFound, fmt.Errorf("access request %q not found", name))
+	}
+	req, err := decode(cm)
+	if err != nil {
+		return nil, "", err
+	}
+	return req, cm.ResourceVersion, nil
+}
+
+// ListAccessRequests returns every access request in project, newest first.
+func (c *K8sClient) ListAccessRequests(ctx context.Context, project string) ([]*consolev1.AccessRequest, error) {
+	ctx, span := tracing.Start(ctx, "accessrequests.K8sClient.ListAccessRequests", attribute.String("project", project))
+	defer span.End()
+	selector := labels.SelectorFromSet(labels.Set{
+		v1alpha2.LabelManagedBy:    v1alpha2.ManagedByValue,
+		v1alpha2.LabelResourceType: v1alpha2.ResourceTypeAccessRequest,
+	})
+	list, err := c.client.CoreV1().ConfigMaps(c.Resolver.ProjectNamespace(project)).List(ctx, metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return nil, err
+	}
+	requests := make([]*consolev1.AccessRequest, 0, len(list.Items))
+	for i := range list.Items {
+		req, err := decode(&list.Items[i])
+		if err != nil {
+			slog.WarnContext(ctx, "skipping malformed access request",
+				slog.String("project", project),
+				slog.String("name", list.Items[i].Name),
+				slog.Any("error", err),
+			)
+			continue
+		}
+		requests = append(requests, req)
+	}
+	sort.SliceStable(requests, func(i, j int) bool {
+		return requests[i].CreatedAt.AsTime().After(requests[j].CreatedAt.AsTime())
+	})
+	return requests, nil
+}
+
+// UpdateAccessRequest stores req, failing with a conflict if the ConfigMap
+// changed since resourceVersion was read. This keeps two owners from
+// deciding the same request concurrently.
+func (c *K8sClient) UpdateAccessRequest(ctx context.Context, req *consolev1.AccessRequest, resourceVersion string) (*consolev1.AccessRequest, error) {
+	ctx, span := tracing.Start(ctx, "accessrequests.K8sClient.UpdateAccessRequest", attribute.String("project", req.Project), attribute.String("name", req.Name))
+	defer span.End()
+	ns := c.Resolver.ProjectNamespace(req.Project)
+	cm, err := c.client.CoreV1().ConfigMaps(ns).Get(ctx, req.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	cm.ResourceVersion = resourceVersion
+	if err := encode(cm, req); err != nil {
+		return nil, err
+	}
+	updated, err := c.client.CoreV1().ConfigMaps(ns).Update(ctx, cm, metav1.UpdateOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return decode(updated)
+}
+
+func encode(cm *corev1.ConfigMap, req *consolev1.AccessRequest) error {
+	raw, err := protojson.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("marshaling access request: %w", err)
+	}
+	cm.Data = map[string]string{dataKey: string(raw)}
+	return nil
+}
+
+func decode(cm *corev1.ConfigMap) (*consolev1.AccessRequest, error) {
+	var req consolev1.AccessRequest
+	if err := protojson.Unmarshal([]byte(cm.Data[dataKey]), &req); err != nil {
+		return nil, fmt.Errorf("parsing access request %q: %w", cm.Name, err)
+	}
+	req.Name = cm.Name
+	return &req, nil
+}
@@ -0,0 +1,92 @@
+package console
+
+import (
+	"crypto/subtle"
+	"expvar"
+	"fmt"
+	"net/http"
+	"net/http/pprof"
+	"os"
+	"runtime"
+	"strings"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// adminPaths are the operational routes served on the admin listener when
+// Config.AdminListenAddr is set.
+var adminPaths = []string{"/healthz", "/readyz", "/metrics", "/api/debug/oidc"}
+
+// adminMux returns the mux the admin routes are registered on: public
+// itself when they share the public listener, otherwise a new mux. In the
+// latter case the admin paths on public answer 404 rather than falling
+// through to the SPA, so a probe aimed at the wrong port fails loudly.
+func (s *Server) adminMux(public *http.ServeMux) *http.ServeMux {
+	if s.cfg.AdminListenAddr == "" {
+		return public
+	}
+	for _, path := range adminPaths {
+		public.Handle(path, http.NotFoundHandler())
+	}
+	return http.NewServeMux()
+}
+
+// metricsHandler returns the Prometheus handler, requiring the bearer token
+// in Config.MetricsTokenFile when one is configured.
+func (s *Server) metricsHandler() (http.Handler, error) {
+	if s.cfg.MetricsTokenFile == "" {
+		return promhttp.Handler(), nil
+	}
+	raw, err := os.ReadFile(s.cfg.MetricsTokenFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read metrics token: %w", err)
+	}
+	token := strings.TrimSpace(string(raw))
+	if token == "" {
+		return nil, fmt.Errorf("metrics token file %s is empty", s.cfg.MetricsTokenFile)
+	}
+	return requireBearerToken(token, promhttp.Handler()), nil
+}
+
+// requireBearerToken serves next only to requests presenting token in the
+// Authorization header.
+func requireBearerToken(token string, next http.Handler) http.Handler {
+	want := []byte("Bearer " + token)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got := []byte(r.Header.Get("Authorization"))
+		if subtle.ConstantTimeCompare(got, want) != 1 {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="metrics"`)
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// publishRuntimeStats guards the process-global expvar registrations,
+// which panic when repeated.
+var publishRuntimeStats sync.Once
+
+// registerDiagnostics mounts net/http/pprof under /debug/pprof/ and the
+// expvar runtime stats, including memstats, at /debug/vars on the admin
+// mux. It refuses to mount them on the public listener.
+func (s *Server) registerDiagnostics(admin *http.ServeMux) error {
+	if !s.cfg.EnableProfiling {
+		return nil
+	}
+	if s.cfg.AdminListenAddr == "" {
+		return fmt.Errorf("profiling requires a separate admin listener")
+	}
+	publishRuntimeStats.Do(func() {
+		expvar.Publish("goroutines", expvar.Func(func() any { return runtime.NumGoroutine() }))
+		expvar.Publish("version", expvar.Func(func() any { return GetVersion() }))
+	})
+	admin.HandleFunc("/debug/pprof/", pprof.Index)
+	admin.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	admin.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	admin.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	admin.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	admin.Handle("/debug/vars", expvar.Handler())
+	return nil
+}
@@ -0,0 +1,91 @@
+package console
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestAdminMux(t *testing.T) {
+	public := http.NewServeMux()
+	if got := New(Config{}).adminMux(public); got != public {
+		t.Error("expected admin routes on the public mux without an admin listener")
+	}
+
+	public = http.NewServeMux()
+	public.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) { _, _ = w.Write([]byte("spa")) })
+	admin := New(Config{AdminListenAddr: "localhost:0"}).adminMux(public)
+	if admin == public {
+		t.Fatal("expected a separate admin mux")
+	}
+	for _, path := range adminPaths {
+		rec := httptest.NewRecorder()
+		public.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		if rec.Code != http.StatusNotFound {
+			t.Errorf("%s: expected 404 on the public listener, got %d", path, rec.Code)
+		}
+	}
+}
+
+func TestMetricsHandler(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(tokenFile, []byte("s3cret\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	handler, err := New(Config{MetricsTokenFile: tokenFile}).metricsHandler()
+	if err != nil {
+		t.Fatalf("metricsHandler: %v", err)
+	}
+	for _, tc := range []struct {
+		authorization string
+		want          int
+	}{
+		{"", http.StatusUnauthorized},
+		{"Bearer wrong", http.StatusUnauthorized},
+		{"Bearer s3cret", http.StatusOK},
+	} {
+		req := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+		if tc.authorization != "" {
+			req.Header.Set("Authorization", tc.authorization)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		if rec.Code != tc.want {
+			t.Errorf("Authorization %q: expected %d, got %d", tc.authorization, tc.want, rec.Code)
+		}
+	}
+
+	empty := filepath.Join(t.TempDir(), "empty")
+	if err := os.WriteFile(empty, nil, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := New(Config{MetricsTokenFile: empty}).metricsHandler(); err == nil {
+		t.Error("expected an empty token file to be rejected")
+	}
+}
+
+func TestRegisterDiagnostics(t *testing.T) {
+	if err := New(Config{EnableProfiling: true}).registerDiagnostics(http.NewServeMux()); err == nil {
+		t.Error("expected profiling without an admin listener to be rejected")
+	}
+
+	admin := http.NewServeMux()
+	if err := New(Config{EnableProfiling: true, AdminListenAddr: "localhost:0"}).registerDiagnostics(admin); err != nil {
+		t.Fatalf("registerDiagnostics: %v", err)
+	}
+	for _, path := range []string{"/debug/pprof/", "/debug/pprof/heap", "/debug/vars"} {
+		rec := httptest.NewRecorder()
+		admin.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		if rec.Code != http.StatusOK {
+			t.Errorf("%s: expected 200, got %d", path, rec.Code)
+		}
+	}
+	rec := httptest.NewRecorder()
+	admin.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/vars", nil))
+	if body := rec.Body.String(); !strings.Contains(body, `"memstats"`) || !strings.Contains(body, `"goroutines"`) {
+		t.Errorf("expected runtime stats, got %.200s", body)
+	}
+}
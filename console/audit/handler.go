@@ -0,0 +1,158 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/holos-run/holos-console/console/permissions"
+	"github.com/holos-run/holos-console/console/resolver"
+	"github.com/holos-run/holos-console/console/rpc"
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+	"github.com/holos-run/holos-console/gen/holos/console/v1/consolev1connect"
+)
+
+const (
+	// auditResourceType is the resource_type value for audit query log events.
+	auditResourceType = "audit_event"
+
+	// Group and Resource identify the virtual resource the API server
+	// authorizes ListAuditEvents against. Grant access with a Role or
+	// ClusterRole rule for verb "list" on auditevents.console.holos.run.
+	Group    = "console.holos.run"
+	Resource = "auditevents"
+
+	// defaultLimit is applied when the request does not set a limit.
+	defaultLimit = 100
+	// maxLimit caps the number of events returned in a single response.
+	maxLimit = 1000
+)
+
+// Handler implements consolev1connect.AuditServiceHandler.
+type Handler struct {
+	consolev1connect.UnimplementedAuditServiceHandler
+	ring     *Ring
+	resolver *resolver.Resolver
+}
+
+// NewHandler returns an AuditService handler serving events from ring. The
+// resolver maps the project filter to the namespace used for authorization.
+func NewHandler(ring *Ring, r *resolver.Resolver) *Handler {
+	return &Handler{ring: ring, resolver: r}
+}
+
+// ListAuditEvents returns retained audit events matching the request filters,
+// newest first.
+func (h *Handler) ListAuditEvents(
+	ctx context.Context,
+	req *connect.Request[consolev1.ListAuditEventsRequest],
+) (*connect.Response[consolev1.ListAuditEventsResponse], error) {
+	claims := rpc.MustClaims(ctx)
+
+	msg := req.Msg
+	if msg.Limit < 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("limit must not be negative"))
+	}
+	if msg.StartTime != nil && msg.EndTime != nil && !msg.StartTime.AsTime().Before(msg.EndTime.AsTime()) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("start_time must be before end_time"))
+	}
+
+	if err := h.authorize(ctx, msg.Project); err != nil {
+		slog.WarnContext(ctx, "audit events list denied",
+			slog.String("action", "audit_events_list_denied"),
+			slog.String("resource_type", auditResourceType),
+			slog.String("project", msg.Project),
+			slog.String("sub", claims.Sub),
+			slog.String("email", claims.Email),
+		)
+		return nil, err
+	}
+
+	limit := int(msg.Limit)
+	if limit == 0 {
+		limit = defaultLimit
+	}
+	if limit > maxLimit {
+		limit = maxLimit
+	}
+	filter := Filter{
+		Project:      msg.Project,
+		ResourceType: msg.ResourceType,
+		Action:       msg.Action,
+		Principal:    msg.Principal,
+		Limit:        limit,
+	}
+	if msg.StartTime != nil {
+		filter.Start = msg.StartTime.AsTime()
+	}
+	if msg.EndTime != nil {
+		filter.End = msg.EndTime.AsTime()
+	}
+
+	// Query before logging so the list call does not report itself.
+	events := h.ring.List(filter)
+
+	slog.InfoContext(ctx, "audit events listed",
+		slog.String("action", "audit_events_list"),
+		slog.String("resource_type", auditResourceType),
+		slog.String("project", msg.Project),
+		slog.String("sub", claims.Sub),
+		slog.String("email", claims.Email),
+		slog.Int("count", len(events)),
+	)
+
+	out := &consolev1.ListAuditEventsResponse{
+		Events: make([]*consolev1.AuditEvent, 0, len(events)),
+	}
+	for i := range events {
+		out.Events = append(out.Events, toProto(&events[i]))
+	}
+	return connect.NewResponse(out), nil
+}
+
+// authorize asks the API server whether the caller may list audit events in
+// the project namespace, or cluster-wide when project is empty.
+func (h *Handler) authorize(ctx context.Context, project string) error {
+	clientset := rpc.ImpersonatedClientsetFromContext(ctx)
+	if clientset == nil {
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("no kubernetes client on request context"))
+	}
+	attr := &consolev1.ResourceAttributes{
+		Verb:     "list",
+		Group:    Group,
+		Resource: Resource,
+	}
+	if project != "" {
+		attr.Namespace = h.resolver.ProjectNamespace(project)
+	}
+	perm, err := permissions.Review(ctx, clientset, attr)
+	if err != nil {
+		return rpc.MapK8sError(err)
+	}
+	if !perm.Allowed {
+		return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("not allowed to list audit events"))
+	}
+	return nil
+}
+
+func toProto(e *Event) *consolev1.AuditEvent {
+	return &consolev1.AuditEvent{
+		Time:         timestamppb.New(e.Time),
+		Action:       e.Action,
+		ResourceType: e.ResourceType,
+		ResourceName: e.ResourceName,
+		Project:      e.Project,
+		Sub:          e.Sub,
+		Email:        e.Email,
+		Message:      e.Message,
+		Attributes:   e.Attributes,
+
+		ImpersonatorSub:   e.ImpersonatorSub,
+		ImpersonatorEmail: e.ImpersonatorEmail,
+		Cluster:           e.Cluster,
+		RequestId:         e.RequestID,
+	}
+}
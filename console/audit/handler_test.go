@@ -0,0 +1,161 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	authzv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	clienttesting "k8s.io/client-go/testing"
+
+	"github.com/holos-run/holos-console/console/resolver"
+	"github.com/holos-run/holos-console/console/rpc"
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+)
+
+// ssarClient returns a fake clientset that answers every
+// SelfSubjectAccessReview with allowed and records the reviewed attributes.
+func ssarClient(allowed bool, seen *[]authzv1.ResourceAttributes) *fake.Clientset {
+	clientset := fake.NewClientset()
+	clientset.PrependReactor("create", "selfsubjectaccessreviews", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		ssar := action.(clienttesting.CreateAction).GetObject().(*authzv1.SelfSubjectAccessReview)
+		if seen != nil {
+			*seen = append(*seen, *ssar.Spec.ResourceAttributes)
+		}
+		ssar.Status = authzv1.SubjectAccessReviewStatus{Allowed: allowed}
+		return true, ssar, nil
+	})
+	return clientset
+}
+
+func testContext(clientset *fake.Clientset) context.Context {
+	ctx := rpc.ContextWithClaims(context.Background(), &rpc.Claims{Sub: "admin", Email: "admin@example.com"})
+	return rpc.ContextWithImpersonatedClients(ctx, &rpc.ImpersonatedClients{Clientset: clientset})
+}
+
+func testRing() *Ring {
+	ring := NewRing(10)
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	ring.Append(Event{Time: base, Action: "secret_access", ResourceType: "secret", ResourceName: "a", Project: "billing", Email: "alice@example.com"})
+	ring.Append(Event{Time: base.Add(time.Minute), Action: "secret_delete", ResourceType: "secret", ResourceName: "b", Project: "billing", Email: "bob@example.com"})
+	ring.Append(Event{Time: base.Add(2 * time.Minute), Action: "secret_access", ResourceType: "secret", ResourceName: "c", Project: "web", Email: "alice@example.com"})
+	return ring
+}
+
+func TestListAuditEvents(t *testing.T) {
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	cases := []struct {
+		name  string
+		req   *consolev1.ListAuditEventsRequest
+		names []string
+	}{
+		{name: "all newest first", req: &consolev1.ListAuditEventsRequest{}, names: []string{"c", "b", "a"}},
+		{name: "by project", req: &consolev1.ListAuditEventsRequest{Project: "billing"}, names: []string{"b", "a"}},
+		{name: "by action", req: &consolev1.ListAuditEventsRequest{Action: "secret_access"}, names: []string{"c", "a"}},
+		{name: "by principal", req: &consolev1.ListAuditEventsRequest{Principal: "BOB@example.com"}, names: []string{"b"}},
+		{name: "by resource type", req: &consolev1.ListAuditEventsRequest{ResourceType: "project"}, names: nil},
+		{
+			name:  "by time range",
+			req:   &consolev1.ListAuditEventsRequest{StartTime: timestamppb.New(base.Add(time.Minute)), EndTime: timestamppb.New(base.Add(2 * time.Minute))},
+			names: []string{"b"},
+		},
+		{name: "limit", req: &consolev1.ListAuditEventsRequest{Limit: 1}, names: []string{"c"}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			h := NewHandler(testRing(), &resolver.Resolver{ProjectPrefix: "prj-"})
+			resp, err := h.ListAuditEvents(testContext(ssarClient(true, nil)), connect.NewRequest(tc.req))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			var got []string
+			for _, e := range resp.Msg.Events {
+				got = append(got, e.ResourceName)
+			}
+			if len(got) != len(tc.names) {
+				t.Fatalf("got events %v, want %v", got, tc.names)
+			}
+			for i := range got {
+				if got[i] != tc.names[i] {
+					t.Fatalf("got events %v, want %v", got, tc.names)
+				}
+			}
+		})
+	}
+}
+
+func TestListAuditEvents_AuthorizationAttributes(t *testing.T) {
+	var seen []authzv1.ResourceAttributes
+	h := NewHandler(testRing(), &resolver.Resolver{ProjectPrefix: "prj-"})
+	ctx := testContext(ssarClient(true, &seen))
+
+	if _, err := h.ListAuditEvents(ctx, connect.NewRequest(&consolev1.ListAuditEventsRequest{})); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := h.ListAuditEvents(ctx, connect.NewRequest(&consolev1.ListAuditEventsRequest{Project: "billing"})); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(seen) != 2 {
+		t.Fatalf("expected 2 access reviews, got %d", len(seen))
+	}
+	for _, attr := range seen {
+		if attr.Verb != "list" || attr.Group != Group || attr.Resource != Resource {
+			t.Errorf("unexpected review attributes: %+v", attr)
+		}
+	}
+	if seen[0].Namespace != "" {
+		t.Errorf("expected cluster-wide review without project, got namespace %q", seen[0].Namespace)
+	}
+	if seen[1].Namespace != "prj-billing" {
+		t.Errorf("expected review in project namespace, got %q", seen[1].Namespace)
+	}
+}
+
+func TestListAuditEvents_Errors(t *testing.T) {
+	cases := []struct {
+		name string
+		ctx  context.Context
+		req  *consolev1.ListAuditEventsRequest
+		code connect.Code
+	}{
+		{
+			name: "denied",
+			ctx:  testContext(ssarClient(false, nil)),
+			req:  &consolev1.ListAuditEventsRequest{},
+			code: connect.CodePermissionDenied,
+		},
+		{
+			name: "negative limit",
+			ctx:  testContext(ssarClient(true, nil)),
+			req:  &consolev1.ListAuditEventsRequest{Limit: -1},
+			code: connect.CodeInvalidArgument,
+		},
+		{
+			name: "inverted time range",
+			ctx:  testContext(ssarClient(true, nil)),
+			req: &consolev1.ListAuditEventsRequest{
+				StartTime: timestamppb.New(time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)),
+				EndTime:   timestamppb.New(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			code: connect.CodeInvalidArgument,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			h := NewHandler(testRing(), &resolver.Resolver{})
+			_, err := h.ListAuditEvents(tc.ctx, connect.NewRequest(tc.req))
+			var connectErr *connect.Error
+			if !errors.As(err, &connectErr) {
+				t.Fatalf("expected connect error, got %v", err)
+			}
+			if connectErr.Code() != tc.code {
+				t.Fatalf("expected code %v, got %v", tc.code, connectErr.Code())
+			}
+		})
+	}
+}
@@ -0,0 +1,163 @@
+package audit
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/holos-run/holos-console/console/logging"
+	"github.com/holos-run/holos-console/console/rpc"
+)
+
+// Attribute keys with dedicated Event fields. Every audit log call in the
+// console sets action and resource_type; the remaining keys are optional.
+const (
+	keyAction       = "action"
+	keyResourceType = "resource_type"
+	keyName         = "name"
+	keyProject      = "project"
+	keySub          = "sub"
+	keyEmail        = "email"
+
+	keyImpersonatorSub   = "impersonator_sub"
+	keyImpersonatorEmail = "impersonator_email"
+	keyCluster           = "cluster"
+	keyRequestID         = "request_id"
+)
+
+// LogHandler is an slog.Handler that copies audit records into a Ring before
+// forwarding every record to the wrapped handler. A record is an audit record
+// when it carries both an "action" and a "resource_type" attribute at the top
+// level. Sensitive attributes, as reported by logging.Sensitive, are redacted
+// in the copy.
+type LogHandler struct {
+	next  slog.Handler
+	ring  *Ring
+	attrs []slog.Attr
+	// grouped is set once WithGroup has been called; attributes added after
+	// that point are nested and no longer identify audit records, so capture
+	// is disabled.
+	grouped bool
+}
+
+// NewLogHandler returns a LogHandler that records audit events into ring and
+// forwards every record to next. When next is itself a LogHandler it is
+// unwrapped first so repeated server starts in one process do not stack
+// handlers.
+func NewLogHandler(next slog.Handler, ring *Ring) *LogHandler {
+	if lh, ok := next.(*LogHandler); ok && !lh.grouped && len(lh.attrs) == 0 {
+		next = lh.next
+	}
+	return &LogHandler{next: next, ring: ring}
+}
+
+// Enabled reports whether the record should be handled. Info and above are
+// always enabled so audit events are captured even when the wrapped handler
+// is configured with a higher level.
+func (h *LogHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return level >= slog.LevelInfo || h.next.Enabled(ctx, level)
+}
+
+// Handle captures r when it is an audit record, then forwards it to the
+// wrapped handler if that handler is enabled for the record's level. Records
+// logged while an admin is acting as another user are stamped with the real
+// principal so the audit trail names both. Records logged while serving a
+// request routed to a remote cluster are stamped with the cluster name, and
+// records logged while serving any request with its request ID.
+func (h *LogHandler) Handle(ctx context.Context, r slog.Record) error {
+	if id := rpc.RequestIDFromContext(ctx); id != "" {
+		r = r.Clone()
+		r.AddAttrs(slog.String(keyRequestID, id))
+	}
+	if claims := rpc.ClaimsFromContext(ctx); claims != nil && claims.Impersonator != nil {
+		r = r.Clone()
+		r.AddAttrs(
+			slog.String(keyImpersonatorSub, claims.Impersonator.Sub),
+			slog.String(keyImpersonatorEmail, claims.Impersonator.Email),
+		)
+	}
+	if cluster := rpc.ClusterFromContext(ctx); cluster != "" {
+		r = r.Clone()
+		r.AddAttrs(slog.String(keyCluster, cluster))
+	}
+	if !h.grouped && r.Level >= slog.LevelInfo {
+		if e, ok := h.event(r); ok {
+			h.ring.Append(e)
+		}
+	}
+	if !h.next.Enabled(ctx, r.Level) {
+		return nil
+	}
+	return h.next.Handle(ctx, r)
+}
+
+// WithAttrs returns a handler whose records include attrs.
+func (h *LogHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	out := &LogHandler{next: h.next.WithAttrs(attrs), ring: h.ring, grouped: h.grouped}
+	if !h.grouped {
+		out.attrs = append(append([]slog.Attr(nil), h.attrs...), attrs...)
+	}
+	return out
+}
+
+// WithGroup returns a handler that nests subsequent attributes under name.
+func (h *LogHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+	return &LogHandler{next: h.next.WithGroup(name), ring: h.ring, attrs: h.attrs, grouped: true}
+}
+
+// event converts r into an Event. It returns false when r is not an audit
+// record.
+func (h *LogHandler) event(r slog.Record) (Event, bool) {
+	values := make(map[string]string, len(h.attrs)+r.NumAttrs())
+	collect := func(a slog.Attr) bool {
+		v := a.Value.Resolve()
+		if a.Key == "" || v.Kind() == slog.KindGroup {
+			return true
+		}
+		if logging.Sensitive(a.Key) {
+			values[a.Key] = logging.RedactedValue
+			return true
+		}
+		values[a.Key] = v.String()
+		return true
+	}
+	for _, a := range h.attrs {
+		collect(a)
+	}
+	r.Attrs(collect)
+
+	action, resourceType := values[keyAction], values[keyResourceType]
+	if action == "" || resourceType == "" {
+		return Event{}, false
+	}
+	// Handlers name the resource either with a "name" attribute or with an
+	// attribute keyed by the resource type itself, e.g. "secret".
+	nameKey := keyName
+	if _, ok := values[resourceType]; ok {
+		nameKey = resourceType
+	}
+	e := Event{
+		Time:         r.Time,
+		Action:       action,
+		ResourceType: resourceType,
+		ResourceName: values[nameKey],
+		Project:      values[keyProject],
+		Sub:          values[keySub],
+		Email:        values[keyEmail],
+		Message:      r.Message,
+
+		ImpersonatorSub:   values[keyImpersonatorSub],
+		ImpersonatorEmail: values[keyImpersonatorEmail],
+		Cluster:           values[keyCluster],
+		RequestID:         values[keyRequestID],
+	}
+	for _, k := range []string{keyAction, keyResourceType, nameKey, keyProject, keySub, keyEmail, keyImpersonatorSub, keyImpersonatorEmail, keyCluster, keyRequestID} {
+		delete(values, k)
+	}
+	if len(values) > 0 {
+		e.Attributes = values
+	}
+	return e, true
+}
@@ -0,0 +1,182 @@
+// Package audit retains the structured audit events the console emits via
+// slog and serves them through the AuditService Connect handler.
+//
+// Handlers keep logging audit events exactly as they always have (an
+// slog.InfoContext call carrying "action" and "resource_type" attributes).
+// LogHandler sits in front of the process-wide slog handler, copies every such
+// record into a bounded Ring, and forwards the record unchanged. The Ring is a
+// per-replica view of recent activity and is not a durable audit store.
+package audit
+
+import (
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultBufferSize is the number of audit events retained when no explicit
+// size is configured.
+const DefaultBufferSize = 1000
+
+// Event is a single audit log record captured by LogHandler.
+type Event struct {
+	Time         time.Time
+	Action       string
+	ResourceType string
+	ResourceName string
+	Project      string
+	Sub          string
+	Email        string
+	Message      string
+	// ImpersonatorSub and ImpersonatorEmail identify the real principal when
+	// Sub was acting on their behalf via X-Impersonate-User.
+	ImpersonatorSub   string
+	ImpersonatorEmail string
+	// Cluster is the registered cluster the action targeted, empty for the
+	// cluster the console runs in.
+	Cluster string
+	// RequestID identifies the request that produced the event, matching the
+	// X-Request-Id response header and the access log.
+	RequestID string
+	// Attributes holds every other attribute on the record rendered as a
+	// string.
+	Attributes map[string]string
+}
+
+// Filter selects events from a Ring. Zero-valued fields match every event.
+type Filter struct {
+	Project      string
+	ResourceType string
+	Action       string
+	// Principal matches Sub, Email, ImpersonatorSub, or ImpersonatorEmail
+	// case-insensitively.
+	Principal string
+	// Start matches events recorded at or after Start.
+	Start time.Time
+	// End matches events recorded before End.
+	End time.Time
+	// Limit caps the number of events returned. Zero means no cap.
+	Limit int
+}
+
+// Matches reports whether e satisfies every field set on f.
+func (f Filter) Matches(e *Event) bool {
+	if f.Project != "" && e.Project != f.Project {
+		return false
+	}
+	if f.ResourceType != "" && e.ResourceType != f.ResourceType {
+		return false
+	}
+	if f.Action != "" && e.Action != f.Action {
+		return false
+	}
+	if f.Principal != "" && !matchesPrincipal(e, f.Principal) {
+		return false
+	}
+	if !f.Start.IsZero() && e.Time.Before(f.Start) {
+		return false
+	}
+	if !f.End.IsZero() && !e.Time.Before(f.End) {
+		return false
+	}
+	return true
+}
+
+func matchesPrincipal(e *Event, principal string) bool {
+	for _, v := range []string{e.Sub, e.Email, e.ImpersonatorSub, e.ImpersonatorEmail} {
+		if v != "" && strings.EqualFold(v, principal) {
+			return true
+		}
+	}
+	return false
+}
+
+// Ring is a fixed-capacity, concurrency-safe buffer of audit events. When the
+// buffer is full the oldest event is overwritten.
+type Ring struct {
+	mu     sync.RWMutex
+	events []Event
+	next   int
+	full   bool
+
+	subscribers []func(Event)
+}
+
+// NewRing returns a Ring that retains up to size events. A size of zero or
+// less selects DefaultBufferSize.
+func NewRing(size int) *Ring {
+	if size <= 0 {
+		size = DefaultBufferSize
+	}
+	return &Ring{events: make([]Event, size)}
+}
+
+// Subscribe registers fn to be called with every event appended after it
+// returns. fn runs on the goroutine that logged the event, so it must not
+// block or log audit events itself.
+func (r *Ring) Subscribe(fn func(Event)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.subscribers = append(r.subscribers, fn)
+}
+
+// Append records e, evicting the oldest event when the buffer is full, and
+// passes it to every subscriber.
+func (r *Ring) Append(e Event) {
+	r.mu.Lock()
+	r.events[r.next] = e
+	r.next++
+	if r.next == len(r.events) {
+		r.next = 0
+		r.full = true
+	}
+	subscribers := r.subscribers
+	r.mu.Unlock()
+	for _, fn := range subscribers {
+		fn(e)
+	}
+}
+
+// Len returns the number of events currently retained.
+func (r *Ring) Len() int {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if r.full {
+		return len(r.events)
+	}
+	return r.next
+}
+
+// List returns copies of the retained events matching f, newest first.
+func (r *Ring) List(f Filter) []Event {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	n := r.next
+	if r.full {
+		n = len(r.events)
+	}
+	var out []Event
+	for i := 0; i < n; i++ {
+		idx := (r.next - 1 - i + len(r.events)) % len(r.events)
+		e := &r.events[idx]
+		if !f.Matches(e) {
+			continue
+		}
+		out = append(out, copyEvent(e))
+		if f.Limit > 0 && len(out) >= f.Limit {
+			break
+		}
+	}
+	return out
+}
+
+func copyEvent(e *Event) Event {
+	c := *e
+	if e.Attributes != nil {
+		c.Attributes = make(map[string]string, len(e.Attributes))
+		for k, v := range e.Attributes {
+			c.Attributes[k] = v
+		}
+	}
+	return c
+}
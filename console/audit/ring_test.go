@@ -0,0 +1,248 @@
+package audit
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/holos-run/holos-console/console/logging"
+	"github.com/holos-run/holos-console/console/rpc"
+)
+
+func TestRing_ListNewestFirstAndEvicts(t *testing.T) {
+	r := NewRing(3)
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i, action := range []string{"a", "b", "c", "d"} {
+		r.Append(Event{Time: base.Add(time.Duration(i) * time.Minute), Action: action})
+	}
+	if got := r.Len(); got != 3 {
+		t.Fatalf("Len() = %d, want 3", got)
+	}
+	got := r.List(Filter{})
+	want := []string{"d", "c", "b"}
+	if len(got) != len(want) {
+		t.Fatalf("List() returned %d events, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if got[i].Action != want[i] {
+			t.Errorf("List()[%d].Action = %q, want %q", i, got[i].Action, want[i])
+		}
+	}
+}
+
+func TestFilter_Matches(t *testing.T) {
+	base := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	e := &Event{
+		Time:         base,
+		Action:       "secret_access",
+		ResourceType: "secret",
+		Project:      "billing",
+		Sub:          "user-123",
+		Email:        "Alice@Example.com",
+
+		ImpersonatorSub:   "admin-1",
+		ImpersonatorEmail: "root@example.com",
+	}
+	cases := []struct {
+		name   string
+		filter Filter
+		want   bool
+	}{
+		{name: "empty filter", filter: Filter{}, want: true},
+		{name: "project match", filter: Filter{Project: "billing"}, want: true},
+		{name: "project mismatch", filter: Filter{Project: "other"}, want: false},
+		{name: "resource type mismatch", filter: Filter{ResourceType: "project"}, want: false},
+		{name: "action match", filter: Filter{Action: "secret_access"}, want: true},
+		{name: "principal matches sub", filter: Filter{Principal: "user-123"}, want: true},
+		{name: "principal matches email case-insensitively", filter: Filter{Principal: "alice@example.com"}, want: true},
+		{name: "principal matches impersonator", filter: Filter{Principal: "root@example.com"}, want: true},
+		{name: "principal mismatch", filter: Filter{Principal: "bob@example.com"}, want: false},
+		{name: "start inclusive", filter: Filter{Start: base}, want: true},
+		{name: "start after event", filter: Filter{Start: base.Add(time.Second)}, want: false},
+		{name: "end exclusive", filter: Filter{End: base}, want: false},
+		{name: "end after event", filter: Filter{End: base.Add(time.Second)}, want: true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.filter.Matches(e); got != tc.want {
+				t.Fatalf("Matches() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestRing_ListLimit(t *testing.T) {
+	r := NewRing(10)
+	for i := 0; i < 5; i++ {
+		r.Append(Event{Action: "secret_access"})
+	}
+	if got := len(r.List(Filter{Limit: 2})); got != 2 {
+		t.Fatalf("List(Limit: 2) returned %d events, want 2", got)
+	}
+}
+
+func TestRing_Subscribe(t *testing.T) {
+	r := NewRing(10)
+	r.Append(Event{Action: "before"})
+	var got []string
+	r.Subscribe(func(e Event) { got = append(got, e.Action) })
+	r.Append(Event{Action: "secret_delete"})
+	if len(got) != 1 || got[0] != "secret_delete" {
+		t.Fatalf("subscriber saw %v, want [secret_delete]", got)
+	}
+}
+
+func TestLogHandler_CapturesAuditRecords(t *testing.T) {
+	ring := NewRing(10)
+	// The wrapped handler only accepts errors; audit events must still be
+	// captured at info level.
+	next := slog.NewTextHandler(discard{}, &slog.HandlerOptions{Level: slog.LevelError})
+	logger := slog.New(NewLogHandler(next, ring))
+
+	logger.Info("secret deleted",
+		slog.String("action", "secret_delete"),
+		slog.String("resource_type", "secret"),
+		slog.String("secret", "db-creds"),
+		slog.String("project", "billing"),
+		slog.String("sub", "user-123"),
+		slog.String("email", "alice@example.com"),
+	)
+	logger.With(slog.String("resource_type", "project")).Info("project created",
+		slog.String("action", "project_create"),
+		slog.String("name", "billing"),
+		slog.String("organization", "acme"),
+	)
+	logger.Info("server listening", slog.String("addr", ":8443"))
+	logger.WithGroup("req").Info("grouped", slog.String("action", "x"), slog.String("resource_type", "y"))
+
+	events := ring.List(Filter{})
+	if len(events) != 2 {
+		t.Fatalf("captured %d events, want 2", len(events))
+	}
+	project, secret := events[0], events[1]
+	if secret.Action != "secret_delete" || secret.ResourceName != "db-creds" || secret.Project != "billing" ||
+		secret.Sub != "user-123" || secret.Email != "alice@example.com" || secret.Message != "secret deleted" {
+		t.Errorf("unexpected secret event: %+v", secret)
+	}
+	if len(secret.Attributes) != 0 {
+		t.Errorf("expected no extra attributes on secret event, got %v", secret.Attributes)
+	}
+	if project.ResourceType != "project" || project.ResourceName != "billing" {
+		t.Errorf("unexpected project event: %+v", project)
+	}
+	if project.Attributes["organization"] != "acme" {
+		t.Errorf("expected organization attribute, got %v", project.Attributes)
+	}
+}
+
+func TestLogHandler_StampsImpersonator(t *testing.T) {
+	ring := NewRing(10)
+	logger := slog.New(NewLogHandler(slog.NewTextHandler(discard{}, nil), ring))
+	ctx := rpc.ContextWithClaims(context.Background(), &rpc.Claims{
+		Sub:          "alice",
+		Impersonator: &rpc.Claims{Sub: "admin-1", Email: "root@example.com"},
+	})
+
+	logger.InfoContext(ctx, "secret accessed",
+		slog.String("action", "secret_access"),
+		slog.String("resource_type", "secret"),
+		slog.String("secret", "db-creds"),
+		slog.String("sub", "alice"),
+	)
+
+	events := ring.List(Filter{})
+	if len(events) != 1 {
+		t.Fatalf("captured %d events, want 1", len(events))
+	}
+	e := events[0]
+	if e.Sub != "alice" || e.ImpersonatorSub != "admin-1" || e.ImpersonatorEmail != "root@example.com" {
+		t.Errorf("expected effective and real principals, got %+v", e)
+	}
+	if len(e.Attributes) != 0 {
+		t.Errorf("expected impersonator fields not to leak into attributes, got %v", e.Attributes)
+	}
+}
+
+func TestLogHandler_StampsCluster(t *testing.T) {
+	ring := NewRing(10)
+	logger := slog.New(NewLogHandler(slog.NewTextHandler(discard{}, nil), ring))
+	ctx := rpc.ContextWithCluster(context.Background(), "east", nil)
+
+	logger.InfoContext(ctx, "secret deleted",
+		slog.String("action", "secret_delete"),
+		slog.String("resource_type", "secret"),
+		slog.String("secret", "db-creds"),
+	)
+
+	events := ring.List(Filter{})
+	if len(events) != 1 || events[0].Cluster != "east" {
+		t.Fatalf("expected one event stamped with cluster east, got %+v", events)
+	}
+	if len(events[0].Attributes) != 0 {
+		t.Errorf("expected cluster not to leak into attributes, got %v", events[0].Attributes)
+	}
+}
+
+func TestLogHandler_StampsRequestID(t *testing.T) {
+	ring := NewRing(10)
+	logger := slog.New(NewLogHandler(slog.NewTextHandler(discard{}, nil), ring))
+	ctx := rpc.ContextWithRequestID(context.Background(), "req-1")
+
+	logger.InfoContext(ctx, "secret deleted",
+		slog.String("action", "secret_delete"),
+		slog.String("resource_type", "secret"),
+		slog.String("secret", "db-creds"),
+	)
+
+	events := ring.List(Filter{})
+	if len(events) != 1 || events[0].RequestID != "req-1" {
+		t.Fatalf("expected one event stamped with request ID req-1, got %+v", events)
+	}
+	if len(events[0].Attributes) != 0 {
+		t.Errorf("expected request ID not to leak into attributes, got %v", events[0].Attributes)
+	}
+}
+
+func TestLogHandler_RedactsSensitiveAttributes(t *testing.T) {
+	ring := NewRing(10)
+	logger := slog.New(NewLogHandler(slog.NewTextHandler(discard{}, nil), ring))
+
+	logger.Info("secret updated",
+		slog.String("action", "secret_update"),
+		slog.String("resource_type", "secret"),
+		slog.String("secret", "db-creds"),
+		slog.String("stringData", "hunter2"),
+		slog.String("id_token", "eyJ..."),
+	)
+
+	events := ring.List(Filter{})
+	if len(events) != 1 {
+		t.Fatalf("captured %d events, want 1", len(events))
+	}
+	e := events[0]
+	if e.ResourceName != "db-creds" {
+		t.Errorf("expected resource name db-creds, got %q", e.ResourceName)
+	}
+	for _, key := range []string{"stringData", "id_token"} {
+		if got := e.Attributes[key]; got != logging.RedactedValue {
+			t.Errorf("attribute %s: expected %q, got %q", key, logging.RedactedValue, got)
+		}
+	}
+}
+
+func TestNewLogHandler_DoesNotStack(t *testing.T) {
+	next := slog.NewTextHandler(discard{}, nil)
+	first := NewLogHandler(next, NewRing(1))
+	second := NewLogHandler(first, NewRing(1))
+	if second.next != next {
+		t.Fatal("expected NewLogHandler to unwrap an existing LogHandler")
+	}
+	if !second.Enabled(context.Background(), slog.LevelInfo) {
+		t.Fatal("expected info level to be enabled")
+	}
+}
+
+type discard struct{}
+
+func (discard) Write(p []byte) (int, error) { return len(p), nil }
@@ -0,0 +1,140 @@
+package console
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/holos-run/holos-console/console/rpc"
+	"github.com/holos-run/holos-console/console/secrets"
+)
+
+// newTestClientCA returns a CA certificate and key for signing client
+// certificates.
+func newTestClientCA(t *testing.T) (*x509.Certificate, *ecdsa.PrivateKey) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test client CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert, key
+}
+
+// newTestClientCert returns a client certificate for cn signed by ca.
+func newTestClientCert(t *testing.T, ca *x509.Certificate, caKey *ecdsa.PrivateKey, cn string) tls.Certificate {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:   big.NewInt(2),
+		Subject:        pkix.Name{CommonName: cn, Organization: []string{"platform"}},
+		EmailAddresses: []string{cn + "@example.com"},
+		NotBefore:      time.Now().Add(-time.Hour),
+		NotAfter:       time.Now().Add(time.Hour),
+		KeyUsage:       x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:    []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca, &key.PublicKey, caKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}
+}
+
+func TestClientCertificatesRequiredOnAPIRoutesOnly(t *testing.T) {
+	ca, caKey := newTestClientCA(t)
+	caFile := filepath.Join(t.TempDir(), "client-ca.pem")
+	if err := os.WriteFile(caFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ca.Raw}), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	tlsConfig, err := New(Config{ClientCAFile: caFile}).tlsConfig()
+	if err != nil {
+		t.Fatalf("tlsConfig: %v", err)
+	}
+
+	var subject string
+	mux := http.NewServeMux()
+	services := newServiceRegistry(mux)
+	services.middleware = rpc.ClientCertMiddleware
+	services.handleAPI("/holos.console.v1.VersionService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		subject = r.TLS.VerifiedChains[0][0].Subject.CommonName
+	}))
+	handleShareLinks(services, secrets.NewProjectScopedHandler(nil, nil).
+		WithShareLinks(secrets.NewShareLinks(secrets.GenerateShareLinkKey(), "https://console.example.com")))
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {})
+
+	srv := httptest.NewUnstartedServer(mux)
+	srv.TLS = tlsConfig
+	srv.StartTLS()
+	defer srv.Close()
+
+	newClient := func(certs ...tls.Certificate) *http.Client {
+		transport := srv.Client().Transport.(*http.Transport).Clone()
+		transport.TLSClientConfig.Certificates = certs
+		return &http.Client{Transport: transport}
+	}
+	get := func(client *http.Client, path string) int {
+		t.Helper()
+		resp, err := client.Get(srv.URL + path)
+		if err != nil {
+			t.Fatalf("GET %s: %v", path, err)
+		}
+		_ = resp.Body.Close()
+		return resp.StatusCode
+	}
+
+	anonymous := newClient()
+	if code := get(anonymous, "/"); code != http.StatusOK {
+		t.Errorf("expected the UI to be served without a client certificate, got %d", code)
+	}
+	// The share link handler itself rejects the unsigned token.
+	if code := get(anonymous, secrets.ShareLinkPath+"not-a-token"); code != http.StatusNotFound {
+		t.Errorf("expected share links to be served without a client certificate, got %d", code)
+	}
+	if code := get(anonymous, "/holos.console.v1.VersionService/GetVersion"); code != http.StatusUnauthorized {
+		t.Errorf("expected API route to require a client certificate, got %d", code)
+	}
+
+	if code := get(newClient(newTestClientCert(t, ca, caKey, "ci-bot")), "/holos.console.v1.VersionService/GetVersion"); code != http.StatusOK {
+		t.Errorf("expected API route to accept a verified client certificate, got %d", code)
+	}
+	if subject != "ci-bot" {
+		t.Errorf("expected subject ci-bot, got %q", subject)
+	}
+
+	// A certificate from another CA fails the TLS handshake.
+	other, otherKey := newTestClientCA(t)
+	if _, err := newClient(newTestClientCert(t, other, otherKey, "mallory")).Get(srv.URL + "/"); err == nil {
+		t.Error("expected a certificate from an unknown CA to be rejected")
+	}
+}
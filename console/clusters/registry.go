@@ -0,0 +1,66 @@
+// Package clusters holds the registry of remote Kubernetes clusters a console
+// instance can manage. Resource RPCs name a registered cluster in their
+// cluster field; rpc.ClusterInterceptor looks it up here so the request's
+// impersonating clients, and the RBAC evaluated for them, belong to that
+// cluster.
+package clusters
+
+import (
+	"fmt"
+	"slices"
+
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// Registry maps cluster names to REST configs. The zero value has no
+// clusters registered.
+type Registry struct {
+	configs map[string]*rest.Config
+}
+
+// LoadKubeconfig registers one cluster per context in the kubeconfig at
+// path, named after the context. Each context's credentials must be allowed
+// to impersonate users and groups in its cluster, just as the console's own
+// service account is in the cluster it runs in.
+func LoadKubeconfig(path string) (*Registry, error) {
+	raw, err := clientcmd.LoadFromFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("loading cluster kubeconfig: %w", err)
+	}
+	if len(raw.Contexts) == 0 {
+		return nil, fmt.Errorf("cluster kubeconfig %s has no contexts", path)
+	}
+	r := &Registry{configs: make(map[string]*rest.Config, len(raw.Contexts))}
+	for name := range raw.Contexts {
+		config, err := clientcmd.NewNonInteractiveClientConfig(*raw, name, &clientcmd.ConfigOverrides{}, nil).ClientConfig()
+		if err != nil {
+			return nil, fmt.Errorf("cluster %q: %w", name, err)
+		}
+		r.configs[name] = config
+	}
+	return r, nil
+}
+
+// RESTConfig returns the config for the named cluster. It implements
+// rpc.ClusterRegistry.
+func (r *Registry) RESTConfig(name string) (*rest.Config, bool) {
+	if r == nil {
+		return nil, false
+	}
+	config, ok := r.configs[name]
+	return config, ok
+}
+
+// Names returns the registered cluster names in sorted order.
+func (r *Registry) Names() []string {
+	if r == nil {
+		return nil
+	}
+	names := make([]string, 0, len(r.configs))
+	for name := range r.configs {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
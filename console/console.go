@@ -7,11 +7,14 @@ import (
 	"crypto/ecdsa"
 	"crypto/elliptic"
 	"crypto/rand"
+	"crypto/sha256"
 	"crypto/tls"
 	"crypto/x509"
 	"crypto/x509/pkix"
 	"embed"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"io/fs"
@@ -20,32 +23,54 @@ import (
 	"mime"
 	"net"
 	"net/http"
+	"net/netip"
+	"net/url"
 	"os"
 	"path"
+	"strconv"
 	"strings"
 	"sync/atomic"
 	"time"
 
 	"connectrpc.com/connect"
-	"connectrpc.com/grpcreflect"
-	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/prometheus/client_golang/prometheus"
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/h2c"
 	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/holos-run/holos-console/console/accessrequests"
+	"github.com/holos-run/holos-console/console/audit"
+	"github.com/holos-run/holos-console/console/clusters"
+	"github.com/holos-run/holos-console/console/dashboard"
 	"github.com/holos-run/holos-console/console/deployments"
 	"github.com/holos-run/holos-console/console/deployments/statuscache"
+	"github.com/holos-run/holos-console/console/envelope"
+	"github.com/holos-run/holos-console/console/events"
 	"github.com/holos-run/holos-console/console/folders"
+	"github.com/holos-run/holos-console/console/grantexpiry"
+	"github.com/holos-run/holos-console/console/groups"
+	"github.com/holos-run/holos-console/console/identity"
+	"github.com/holos-run/holos-console/console/invitations"
+	"github.com/holos-run/holos-console/console/k8sretry"
+	"github.com/holos-run/holos-console/console/kubeevents"
+	"github.com/holos-run/holos-console/console/maintenance"
+	"github.com/holos-run/holos-console/console/notifications"
+	"github.com/holos-run/holos-console/console/nsscope"
 	"github.com/holos-run/holos-console/console/oidc"
 	"github.com/holos-run/holos-console/console/organizations"
+	"github.com/holos-run/holos-console/console/ownership"
 	"github.com/holos-run/holos-console/console/permissions"
 	"github.com/holos-run/holos-console/console/policyresolver"
 	"github.com/holos-run/holos-console/console/projects"
 	"github.com/holos-run/holos-console/console/projects/projectapply"
 	"github.com/holos-run/holos-console/console/projects/projectnspipeline"
+	"github.com/holos-run/holos-console/console/readiness"
 	"github.com/holos-run/holos-console/console/resolver"
+	"github.com/holos-run/holos-console/console/resourcestore"
 	"github.com/holos-run/holos-console/console/rpc"
 	"github.com/holos-run/holos-console/console/secrets"
+	"github.com/holos-run/holos-console/console/secrettemplates"
+	"github.com/holos-run/holos-console/console/session"
 	"github.com/holos-run/holos-console/console/settings"
 	"github.com/holos-run/holos-console/console/templatedependencies"
 	"github.com/holos-run/holos-console/console/templategrants"
@@ -53,6 +78,10 @@ import (
 	"github.com/holos-run/holos-console/console/templatepolicybindings"
 	"github.com/holos-run/holos-console/console/templaterequirements"
 	"github.com/holos-run/holos-console/console/templates"
+	"github.com/holos-run/holos-console/console/tokens"
+	"github.com/holos-run/holos-console/console/tracing"
+	"github.com/holos-run/holos-console/console/trash"
+	"github.com/holos-run/holos-console/console/vault"
 	"github.com/holos-run/holos-console/gen/holos/console/v1/consolev1connect"
 	controllermgr "github.com/holos-run/holos-console/internal/controller"
 	"github.com/holos-run/holos-console/internal/deploymentrender"
@@ -67,10 +96,62 @@ type Config struct {
 	CertFile   string
 	KeyFile    string
 
+	// AdminListenAddr moves /healthz, /readyz, /metrics, and the OIDC debug
+	// endpoint off the public listener onto a plain HTTP listener at this
+	// address, e.g. "localhost:9090". Empty serves them on ListenAddr.
+	AdminListenAddr string
+
+	// MetricsTokenFile holds a bearer token /metrics requires. Empty serves
+	// metrics to anyone who can reach the listener.
+	MetricsTokenFile string
+
+	// EnableProfiling serves net/http/pprof under /debug/pprof/ and expvar
+	// runtime stats at /debug/vars. It requires AdminListenAddr so the
+	// profiles are never reachable through the public listener.
+	EnableProfiling bool
+
+	// ShutdownTimeout bounds how long shutdown waits for in-flight requests,
+	// such as long uploads, to finish before closing their connections.
+	// Default: 30s
+	ShutdownTimeout time.Duration
+
+	// ShutdownDelay is how long the server keeps serving with /readyz
+	// reporting not ready before it starts draining, giving load balancers
+	// time to stop routing to it. Default: no delay.
+	ShutdownDelay time.Duration
+
+	// MaxRequestBytes caps the size of a request body. Larger ConnectRPC
+	// messages fail with ResourceExhausted before they are decoded.
+	// Default: 4MiB
+	MaxRequestBytes int64
+
 	// PlainHTTP disables TLS, listening on plain HTTP instead.
 	// Use when running behind a TLS-terminating ingress or gateway.
 	PlainHTTP bool
 
+	// ClientCAFile is a PEM-encoded CA bundle that signs client
+	// certificates. When set, the Connect API routes require a client
+	// certificate verified against it and authenticate the caller as the
+	// certificate subject; the UI, share link, health, and metrics routes
+	// stay on regular TLS. Empty disables client certificate authentication.
+	ClientCAFile string
+
+	// ContentSecurityPolicy is sent on every response, with {nonce} replaced
+	// by a per-request nonce that the scripts injected into index.html
+	// carry. Empty selects a policy allowing only same-origin resources and
+	// the OIDC authority; "off" omits the header.
+	ContentSecurityPolicy string
+
+	// FrameOptions is the X-Frame-Options header value. Empty omits it.
+	FrameOptions string
+
+	// ReferrerPolicy is the Referrer-Policy header value. Empty omits it.
+	ReferrerPolicy string
+
+	// HSTSMaxAge is the Strict-Transport-Security max-age sent on responses
+	// served over TLS. Zero omits the header.
+	HSTSMaxAge time.Duration
+
 	// Origin is the public-facing base URL of the console.
 	// Used to construct OIDC redirect URIs (e.g., redirect_uri, post_logout_redirect_uri).
 	// When empty, redirect URIs are derived from Issuer for backward compatibility.
@@ -79,9 +160,17 @@ type Config struct {
 
 	// Issuer is the OIDC issuer URL for token validation.
 	// This also determines the embedded Dex issuer URL.
+	// When the embedded Dex provider is not enabled, Issuer names an
+	// external provider (Keycloak, Okta, ...) and nothing is mounted at /dex/.
 	// Example: "https://localhost:8443/dex"
 	Issuer string
 
+	// FrontendAuthority is the OIDC authority the web UI signs in against.
+	// Set it when browsers reach the external provider at a different URL
+	// than the one used for token verification.
+	// Default: Issuer
+	FrontendAuthority string
+
 	// ClientID is the expected audience for tokens.
 	// Default: "holos-console"
 	ClientID string
@@ -118,6 +207,29 @@ type Config struct {
 	// Default: "prj-"
 	ProjectPrefix string
 
+	// NamespaceAllowlist limits the project namespaces the console manages
+	// to these names or path.Match glob patterns. Empty allows every
+	// namespace.
+	NamespaceAllowlist []string
+
+	// NamespaceDenylist excludes project namespaces by name or glob
+	// pattern. It takes precedence over NamespaceAllowlist.
+	NamespaceDenylist []string
+
+	// NamespaceSelector is a label selector project namespaces must match
+	// in addition to the managed-by label. New projects get the labels it
+	// requires an exact value for.
+	NamespaceSelector string
+
+	// StaticProjects runs the console in lightweight mode for clusters that
+	// grant it only namespace-scoped RBAC: it serves exactly these projects
+	// by name without listing namespaces, caches resources only in their
+	// namespaces, and disables organizations, folders, and creating or
+	// deleting projects. Requests naming any other project fail with
+	// NotFound. Each project's namespace must pass NamespaceAllowlist and
+	// NamespaceDenylist. Empty serves every managed project.
+	StaticProjects []string
+
 	// DisableOrgCreation disables the implicit organization creation grant to all
 	// authenticated principals. Explicit OrgCreatorUsers and OrgCreatorRoles are
 	// still honored when this is true.
@@ -129,15 +241,55 @@ type Config struct {
 	// OrgCreatorRoles is a list of OIDC role names allowed to create organizations.
 	OrgCreatorRoles []string
 
+	// OrgCreatorsFile is a YAML file of organization creator users and roles
+	// that replaces OrgCreatorUsers and OrgCreatorRoles. It is reloaded when
+	// it changes, so a mounted ConfigMap can be edited without a restart.
+	OrgCreatorsFile string
+
 	// RolesClaim is the OIDC ID token claim name for role memberships.
 	// Default: "groups"
 	RolesClaim string
 
+	// MaxTokenAge rejects OIDC tokens whose sign-in (auth_time, else iat)
+	// was longer ago than this, regardless of the lifetime the identity
+	// provider gave them or how often the session was refreshed. API
+	// tokens are exempt. Zero places no limit beyond the token's expiry.
+	MaxTokenAge time.Duration
+
+	// StepUp maps RPC procedures, e.g.
+	// "/holos.console.v1.SecretsService/GetSecret", to how recently the
+	// caller must have signed in to call them.
+	StepUp map[string]time.Duration
+
+	// PlatformOwnerUsers is a list of email addresses of platform owners,
+	// who may toggle maintenance mode at runtime and force sharing updates
+	// that leave an organization or project without an owner.
+	PlatformOwnerUsers []string
+
+	// PlatformOwnerRoles is a list of OIDC role names of platform owners.
+	PlatformOwnerRoles []string
+
+	// Maintenance starts the console in read-only maintenance mode: RPCs
+	// that change state fail with FailedPrecondition while reads keep
+	// working. Platform owners may turn it off with SetMaintenance.
+	Maintenance bool
+
+	// MaintenanceMessage is returned to RPCs rejected by maintenance mode.
+	// Default: maintenance.DefaultMessage
+	MaintenanceMessage string
+
 	// EnableInsecureDex starts the built-in Dex OIDC provider with an
 	// auto-login connector that authenticates users without credentials.
 	// INSECURE: intended for local development only.
 	EnableInsecureDex bool
 
+	// DexConnectors are upstream identity providers (LDAP, GitHub, Google,
+	// generic OIDC) for the embedded Dex provider. When set, the embedded
+	// provider is started with these connectors in place of auto-login,
+	// even without EnableInsecureDex; the dev token-exchange and debug
+	// endpoints still require EnableInsecureDex.
+	DexConnectors []oidc.Connector
+
 	// LogHealthChecks enables logging of /healthz and /readyz requests.
 	// Default: false (suppresses health check logging to reduce noise from Kubernetes probes).
 	LogHealthChecks bool
@@ -146,6 +298,231 @@ type Config struct {
 	// (persona switcher, dev token panel).
 	// Default: false (disabled).
 	EnableDevTools bool
+
+	// EnableServiceAccountAuth accepts Kubernetes ServiceAccount bearer
+	// tokens, verified with the TokenReview API, on protected RPCs in
+	// addition to OIDC ID tokens. Requests authenticated this way
+	// impersonate the ServiceAccount itself.
+	// Default: false
+	EnableServiceAccountAuth bool
+
+	// ServiceAccountAudiences are the audiences ServiceAccount tokens must be
+	// issued for. Empty accepts the API server's default audiences.
+	ServiceAccountAudiences []string
+
+	// APITokensNamespace is the namespace holding personal API tokens minted
+	// by the TokensService. Tokens are accepted on protected RPCs as the user
+	// who created them. Empty disables API tokens.
+	APITokensNamespace string
+
+	// GroupsNamespace is the namespace holding console-local group
+	// memberships managed by the GroupsService, for identity providers such
+	// as embedded Dex that issue no groups. Memberships are merged into the
+	// groups of every authenticated user. Empty disables console groups.
+	GroupsNamespace string
+
+	// TrustedProxyCIDRs enables authentication from oauth2-proxy identity
+	// headers on requests whose peer address falls in one of these networks.
+	// Empty disables trusted proxy authentication.
+	TrustedProxyCIDRs []string
+
+	// TrustedProxySignatureKeyFile is a file holding the oauth2-proxy
+	// --signature-key ("<algorithm>:<secret>") used to verify the
+	// GAP-Signature header. Required when TrustedProxyCIDRs is set.
+	TrustedProxySignatureKeyFile string
+
+	// AuditBufferSize is the number of recent audit events retained in memory
+	// and served by the AuditService.
+	// Default: 1000
+	AuditBufferSize int
+
+	// AuditSinkURL is an HTTP endpoint, such as a Knative broker, that
+	// receives every audit event as a CloudEvents 1.0 structured JSON event.
+	// Empty disables the sink.
+	AuditSinkURL string
+
+	// RateLimitPrincipalRPS is the sustained requests per second allowed for
+	// each authenticated principal. Zero disables the principal limit.
+	// Default: 50
+	RateLimitPrincipalRPS float64
+
+	// RateLimitPrincipalBurst is the burst size for each principal.
+	// Default: 100
+	RateLimitPrincipalBurst int
+
+	// RateLimitIPRPS is the sustained requests per second allowed for each
+	// client IP. Zero disables the IP limit.
+	// Default: 100
+	RateLimitIPRPS float64
+
+	// RateLimitIPBurst is the burst size for each client IP.
+	// Default: 200
+	RateLimitIPBurst int
+
+	// RateLimitClientIPHeader names a header, such as X-Forwarded-For, that
+	// carries the client IP when the console runs behind a trusted proxy.
+	// Empty uses the connection's peer address.
+	RateLimitClientIPHeader string
+
+	// RPCTimeouts bounds how long unary RPCs may run, by method class and
+	// per method. Zero timeouts leave calls bounded only by the client.
+	RPCTimeouts rpc.TimeoutConfig
+
+	// RPCGetCacheControl is the Cache-Control header of read RPCs called
+	// with HTTP GET. Empty omits the header.
+	RPCGetCacheControl string
+
+	// TracingEndpoint is the OTLP gRPC collector address (host:port) traces
+	// are exported to. Empty disables tracing.
+	TracingEndpoint string
+
+	// TracingInsecure disables TLS on the connection to the OTLP collector.
+	// Default: false
+	TracingInsecure bool
+
+	// TracingSampleRatio is the fraction of new traces recorded, from 0 to 1.
+	// Default: 1
+	TracingSampleRatio float64
+
+	// ExternalSecrets lists Secrets synced by External Secrets Operator
+	// alongside console-managed secrets as read-only entries.
+	// Default: false
+	ExternalSecrets bool
+
+	// K8sRetryAttempts is the number of attempts, including the first, the
+	// secrets service makes for Kubernetes calls that fail for transient
+	// reasons such as conflicts, throttling, and timeouts. Values below two
+	// disable retries.
+	K8sRetryAttempts int
+
+	// K8sRetryBudget caps the time a retried Kubernetes call may spend
+	// across all of its attempts, within the RPC's own deadline.
+	K8sRetryBudget time.Duration
+
+	// TrashRetention enables soft deletion of secrets and projects when
+	// positive. Deleted resources are hidden and their grants stripped, and
+	// owners may restore them until the retention window passes, after
+	// which they are purged.
+	// Default: 0 (deletes are permanent)
+	TrashRetention time.Duration
+
+	// ClustersKubeconfig is a kubeconfig whose contexts register remote
+	// clusters. Resource RPCs that name one in their cluster field act in
+	// that cluster, with RBAC evaluated by its API server. Empty registers
+	// no remote clusters.
+	ClustersKubeconfig string
+
+	// SealedSecretsCert is the path of a SealedSecrets controller certificate
+	// (kubeseal --fetch-cert). When set, ExportSecretSealed returns secrets
+	// as SealedSecret manifests encrypted to it for GitOps repositories.
+	// Empty disables sealed export.
+	SealedSecretsCert string
+
+	// SecretEncryptionKeyFile holds a 32-byte key, raw or base64, that wraps
+	// the data keys secret values are encrypted under at rest. Secrets
+	// written without it stay readable, and Secrets written with it must be
+	// decrypted by the console: workloads mounting them see ciphertext.
+	// Empty stores secret values as plaintext.
+	SecretEncryptionKeyFile string
+
+	// SecretEncryptionPreviousKeyFiles hold retired key encryption keys that
+	// still decrypt Secrets written before a key rotation. Each Secret is
+	// rewrapped with SecretEncryptionKeyFile on its next write.
+	SecretEncryptionPreviousKeyFiles []string
+
+	// EnableShareLinks lets secret owners create signed, time-limited links
+	// that read a secret without an account, served under
+	// secrets.ShareLinkPath. Requires Origin.
+	// Default: false
+	EnableShareLinks bool
+
+	// ShareLinkKeyFile holds the secret that signs share links. When empty
+	// a random key is generated at startup, so links stop working on
+	// restart and are only served by the replica that made them.
+	ShareLinkKeyFile string
+
+	// SecretMetricsLabels selects which of the project and secret labels the
+	// console_secret_reads_total and console_secret_read_denials_total
+	// counters carry; empty counts reads by method only. Every label
+	// multiplies the number of series, so the CLI enables only project by
+	// default.
+	SecretMetricsLabels []string
+
+	// SecretMetricsMaxSecrets caps the distinct secrets the secret metric
+	// label reports; reads of further secrets are counted as "_other".
+	// Zero means no limit.
+	SecretMetricsMaxSecrets int
+
+	// VaultAddress is the HashiCorp Vault server that Secrets annotated with
+	// console.holos.run/vault-path read their values from. The console
+	// logs in with its service account token through Vault's Kubernetes
+	// auth method. Empty disables the Vault backend.
+	VaultAddress string
+
+	// VaultRole is the Vault Kubernetes auth role the console logs in as.
+	VaultRole string
+
+	// VaultAuthMount is the mount path of Vault's Kubernetes auth method.
+	// Default: kubernetes
+	VaultAuthMount string
+
+	// InvitationsNamespace is the namespace holding the registry of users
+	// who have signed in and pending invitations for those who have not.
+	// Secret sharing grants naming unknown users are reported as pending and
+	// may invite them. Empty disables invitations.
+	InvitationsNamespace string
+
+	// EnableNotifications notifies organizations of audit events they opt
+	// in to through the console.holos.run/notifications annotation on their
+	// namespace, by email and Slack-compatible webhook.
+	// Default: false
+	EnableNotifications bool
+
+	// KubeEvents records denied access and sharing changes as Kubernetes
+	// Events on the Secret or namespace concerned, rate-limited, so
+	// kubectl get events and event exporters see console activity.
+	// Default: false
+	KubeEvents bool
+
+	// SMTPAddress is the host:port of the SMTP relay invitation and
+	// notification email is sent through. Empty disables email.
+	SMTPAddress string
+
+	// SMTPFrom is the sender address of console email.
+	SMTPFrom string
+
+	// SMTPUsername authenticates with the SMTP relay when set.
+	SMTPUsername string
+
+	// SMTPPasswordFile is a file holding the SMTP password for SMTPUsername.
+	SMTPPasswordFile string
+
+	// GrantExpiryWarning is how long before a time-bounded sharing grant
+	// expires that it is reported as expiring.
+	GrantExpiryWarning time.Duration
+
+	// ExpiredGrantRetention is how long expired sharing grants are kept in
+	// annotations before they are removed. Zero keeps them forever.
+	ExpiredGrantRetention time.Duration
+
+	// EnableSessions serves the /api/session endpoints, which run the OIDC
+	// login server-side and keep tokens in an encrypted HTTP-only cookie
+	// instead of browser storage. The callback URL,
+	// Origin + /api/session/callback, must be registered with the IdP.
+	// Default: false
+	EnableSessions bool
+
+	// SessionKeyFile holds the secret that encrypts session cookies. When
+	// empty a random key is generated at startup, so sessions do not
+	// survive restarts or span replicas.
+	SessionKeyFile string
+
+	// ResourceStore selects where project metadata and share grants are
+	// stored: "annotations" keeps them on the project namespace, "crd" also
+	// stores them as ConsoleProject and ConsoleGrant objects (see
+	// console/resourcestore).
+	// Default: "annotations"
+	ResourceStore string
 }
 
 // OIDCConfig is the OIDC configuration injected into the frontend.
@@ -170,6 +547,9 @@ type ConsoleConfig struct {
 	OrganizationPrefix string `json:"organizationPrefix"`
 	FolderPrefix       string `json:"folderPrefix"`
 	ProjectPrefix      string `json:"projectPrefix"`
+	// SessionsEnabled reports that the server-side /api/session login is
+	// available.
+	SessionsEnabled bool `json:"sessionsEnabled,omitempty"`
 }
 
 // deriveRedirectURI derives the OIDC redirect URI from the console origin.
@@ -177,20 +557,70 @@ func deriveRedirectURI(origin string) string {
 	return strings.TrimSuffix(origin, "/") + "/pkce/verify"
 }
 
+// newOIDCConfig returns the OIDC configuration injected into the frontend,
+// or nil when no issuer is configured.
+func newOIDCConfig(cfg Config) *OIDCConfig {
+	if cfg.Issuer == "" {
+		return nil
+	}
+	authority := cfg.FrontendAuthority
+	if authority == "" {
+		authority = cfg.Issuer
+	}
+	return &OIDCConfig{
+		Authority:             authority,
+		ClientID:              cfg.ClientID,
+		RedirectURI:           deriveRedirectURI(cfg.Origin),
+		PostLogoutRedirectURI: derivePostLogoutRedirectURI(cfg.Origin),
+	}
+}
+
 // derivePostLogoutRedirectURI derives the post-logout redirect URI from the console origin.
 func derivePostLogoutRedirectURI(origin string) string {
 	return strings.TrimSuffix(origin, "/") + "/"
 }
 
+// Readiness checks run every readinessInterval, each bounded by
+// readinessTimeout.
+const (
+	readinessInterval = 5 * time.Second
+	readinessTimeout  = 3 * time.Second
+)
+
+// trashPurgeInterval is how often soft-deleted resources past the trash
+// retention window are purged.
+const trashPurgeInterval = 10 * time.Minute
+
+// grantScanInterval is how often sharing grants are scanned for expiry.
+const grantScanInterval = time.Hour
+
+// auditSinkTimeout bounds a single audit sink delivery.
+const auditSinkTimeout = 10 * time.Second
+
+// defaultShutdownTimeout is the ShutdownTimeout when none is configured.
+const defaultShutdownTimeout = 30 * time.Second
+
+// defaultMaxRequestBytes is the MaxRequestBytes when none is configured. It
+// leaves room for a secret at the 1MiB Kubernetes limit encoded as JSON.
+const defaultMaxRequestBytes = 4 << 20
+
+// adminShutdownTimeout bounds the admin listener's shutdown, which serves
+// only short probe and metrics requests.
+const adminShutdownTimeout = 5 * time.Second
+
+// orgCreatorsReloadInterval is how often the organization creators file is
+// checked for changes.
+const orgCreatorsReloadInterval = 10 * time.Second
+
 // Server represents the console server.
 type Server struct {
 	cfg   Config
 	ready atomic.Bool
 	// controllerMgr is the embedded controller-runtime manager (HOL-620).
-	// The /readyz probe ANDs s.ready with controllerMgr.Ready() so the pod
-	// stays 503 until the listener is up AND every informer cache has
-	// completed its initial sync. Nil when Serve runs without a Kubernetes
-	// config (dummy-secret-only mode).
+	// The /readyz probe checks s.ready and controllerMgr.Ready() along with
+	// the other dependency checks so the pod stays 503 until the listener is
+	// up AND every informer cache has completed its initial sync. Nil when
+	// Serve runs without a Kubernetes config (dummy-secret-only mode).
 	controllerMgr *controllermgr.Manager
 }
 
@@ -201,16 +631,7 @@ func New(cfg Config) *Server {
 
 // Serve starts the HTTPS server and blocks until the context is cancelled.
 func (s *Server) Serve(ctx context.Context) error {
-	// Apply defaults for namespace prefixes
-	if s.cfg.OrganizationPrefix == "" {
-		s.cfg.OrganizationPrefix = "org-"
-	}
-	if s.cfg.FolderPrefix == "" {
-		s.cfg.FolderPrefix = "fld-"
-	}
-	if s.cfg.ProjectPrefix == "" {
-		s.cfg.ProjectPrefix = "prj-"
-	}
+	s.applyPrefixDefaults()
 
 	// Load custom CA certificate pool for internal HTTP client (OIDC discovery, etc.)
 	caPool, err := loadCACertPool(s.cfg.CACertFile)
@@ -222,39 +643,117 @@ func (s *Server) Serve(ctx context.Context) error {
 	}
 	internalClient := httpClientWithCA(caPool)
 
+	shutdownTracing, err := tracing.Setup(ctx, tracing.Config{
+		Endpoint:       s.cfg.TracingEndpoint,
+		Insecure:       s.cfg.TracingInsecure,
+		SampleRatio:    s.cfg.TracingSampleRatio,
+		ServiceVersion: GetVersion(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to configure tracing: %w", err)
+	}
+	defer func() {
+		flushCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := shutdownTracing(flushCtx); err != nil {
+			slog.Warn("failed to flush traces", "error", err)
+		}
+	}()
+	if s.cfg.TracingEndpoint != "" {
+		slog.Info("tracing enabled", "endpoint", s.cfg.TracingEndpoint, "sampleRatio", s.cfg.TracingSampleRatio)
+	}
+
+	// Retain recent audit events for the AuditService by teeing the default
+	// slog handler into a bounded ring buffer.
+	auditRing := audit.NewRing(s.cfg.AuditBufferSize)
+	slog.SetDefault(slog.New(audit.NewLogHandler(slog.Default().Handler(), auditRing)))
+	if s.cfg.AuditSinkURL != "" {
+		u, err := url.Parse(s.cfg.AuditSinkURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("audit sink must be an http or https URL, got %q", s.cfg.AuditSinkURL)
+		}
+		client := *internalClient
+		client.Timeout = auditSinkTimeout
+		sink := events.NewSink(events.NewHTTPPublisher(&client, s.cfg.AuditSinkURL), s.cfg.Origin)
+		auditRing.Subscribe(sink.Enqueue)
+		go sink.Run(ctx)
+		slog.Info("audit sink enabled", "url", u.Redacted())
+	}
+
 	mux := http.NewServeMux()
+	// services mounts every Connect handler and tracks it for gRPC reflection.
+	services := newServiceRegistry(mux)
+	if s.cfg.ClientCAFile != "" {
+		if s.cfg.PlainHTTP {
+			return fmt.Errorf("client certificate auth requires TLS")
+		}
+		if s.cfg.Issuer == "" || s.cfg.ClientID == "" {
+			return fmt.Errorf("client certificate auth requires an OIDC issuer and client ID")
+		}
+		slog.Info("client certificates required on API routes", "ca", s.cfg.ClientCAFile)
+		services.middleware = rpc.ClientCertMiddleware
+	}
+
+	admin := s.adminMux(mux)
+	if err := s.registerDiagnostics(admin); err != nil {
+		return err
+	}
+	metricsHandler, err := s.metricsHandler()
+	if err != nil {
+		return err
+	}
 
 	// Health check endpoints for Kubernetes probes
-	mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+	admin.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "text/plain")
 		w.WriteHeader(http.StatusOK)
 		_, _ = io.WriteString(w, "ok")
 	})
-	mux.HandleFunc("/readyz", func(w http.ResponseWriter, r *http.Request) {
-		w.Header().Set("Content-Type", "text/plain")
-		// HOL-620: /readyz only flips to 200 when the listener has
-		// finished wiring up AND the controller-runtime manager's
-		// informer cache has completed its initial sync. The cache
-		// check short-circuits to `true` when the manager is nil —
-		// that case is dummy-secret-only mode, which has no cluster
-		// and therefore nothing to sync.
-		cacheReady := true
-		if s.controllerMgr != nil {
-			cacheReady = s.controllerMgr.Ready()
-		}
-		if s.ready.Load() && cacheReady {
-			w.WriteHeader(http.StatusOK)
-			_, _ = io.WriteString(w, "ok")
-			return
-		}
-		w.WriteHeader(http.StatusServiceUnavailable)
-		_, _ = io.WriteString(w, "not ready")
+	// /readyz flips to 200 only once the listener is up and every dependency
+	// check registered below (Kubernetes API, OIDC discovery, informer
+	// caches) has passed. /readyz?verbose=1 reports each component as JSON.
+	checker := readiness.NewChecker(readinessInterval, readinessTimeout)
+	checker.Add("server", readiness.FuncCheck(s.ready.Load, "listener not started"))
+	admin.Handle("/readyz", checker)
+
+	// A single rate limiter is shared by public and protected routes so each
+	// client IP draws from one bucket regardless of the service it calls.
+	rateLimitInterceptor := rpc.RateLimitInterceptor(rpc.RateLimitConfig{
+		PrincipalRate:  s.cfg.RateLimitPrincipalRPS,
+		PrincipalBurst: s.cfg.RateLimitPrincipalBurst,
+		IPRate:         s.cfg.RateLimitIPRPS,
+		IPBurst:        s.cfg.RateLimitIPBurst,
+		ClientIPHeader: s.cfg.RateLimitClientIPHeader,
 	})
 
+	maintenanceMode := maintenance.NewMode(s.cfg.Maintenance, s.cfg.MaintenanceMessage)
+	if s.cfg.Maintenance {
+		slog.Warn("starting in read-only maintenance mode", "message", maintenanceMode.State().Message)
+	}
+
+	// Public and protected routes share the per-method RPC deadlines and
+	// the caching policy of GET calls.
+	timeoutInterceptor := rpc.TimeoutInterceptor(s.cfg.RPCTimeouts)
+	cacheControlInterceptor := rpc.CacheControlInterceptor(s.cfg.RPCGetCacheControl)
+
+	// draining is cancelled when shutdown begins draining connections, which
+	// ends streaming RPCs while unary calls finish.
+	draining, drain := context.WithCancel(context.Background())
+	defer drain()
+
 	// Configure ConnectRPC interceptors for public routes (no auth required)
 	publicInterceptors := connect.WithInterceptors(
+		rpc.RequestIDInterceptor(),
+		rpc.TracingInterceptor(),
 		rpc.MetricsInterceptor(),
 		rpc.LoggingInterceptor(),
+		rpc.DrainInterceptor(draining),
+		timeoutInterceptor,
+		cacheControlInterceptor,
+		rateLimitInterceptor,
+		rpc.RequireClaimsInterceptor(publicServices...),
+		maintenance.Interceptor(maintenanceMode),
+		rpc.ValidationInterceptor(),
 	)
 
 	// Resolve the base Kubernetes REST config once. Startup-scoped clients keep
@@ -265,29 +764,159 @@ func (s *Server) Serve(ctx context.Context) error {
 	if err != nil {
 		return fmt.Errorf("failed to resolve kubernetes REST config: %w", err)
 	}
+	if s.cfg.TracingEndpoint != "" {
+		restConfig = tracing.WrapRestConfig(restConfig)
+	}
+
+	// Initialize Kubernetes client for secrets (may be nil if no cluster available).
+	// We share the resolved REST config with the controller-runtime manager
+	// below so there is a single loader for the cluster connection.
+	k8sClientset, err := secrets.NewClientsetForConfig(restConfig)
+	if err != nil {
+		return fmt.Errorf("failed to create kubernetes client: %w", err)
+	}
+	if k8sClientset != nil {
+		checker.Add("kubernetes", func(ctx context.Context) error {
+			return k8sClientset.Discovery().RESTClient().Get().AbsPath("/version").Do(ctx).Error()
+		})
+	}
+	if s.cfg.Issuer != "" {
+		checker.Add("oidc", readiness.HTTPCheck(internalClient, strings.TrimSuffix(s.cfg.Issuer, "/")+"/.well-known/openid-configuration"))
+	}
+
+	trustedProxy, err := s.trustedProxyConfig()
+	if err != nil {
+		return err
+	}
+	if trustedProxy != nil && (s.cfg.Issuer == "" || s.cfg.ClientID == "") {
+		return fmt.Errorf("trusted proxy auth requires an OIDC issuer and client ID")
+	}
+
+	var clusterRegistry rpc.ClusterRegistry
+	if s.cfg.ClustersKubeconfig != "" {
+		registry, err := clusters.LoadKubeconfig(s.cfg.ClustersKubeconfig)
+		if err != nil {
+			return err
+		}
+		slog.Info("remote clusters registered", "clusters", registry.Names())
+		clusterRegistry = registry
+	}
+
+	sessions, err := s.sessionHandler(internalClient)
+	if err != nil {
+		return err
+	}
+	if sessions != nil {
+		mux.Handle(session.BasePath, sessions)
+		mux.Handle(session.BasePath+"/", sessions)
+		slog.Info("server-side sessions enabled", "callback", session.CallbackURL(s.cfg.Origin))
+	}
 
 	// Configure ConnectRPC interceptors for protected routes (auth required)
 	// Note: The auth interceptor uses lazy verifier initialization since Dex
 	// isn't running yet when we create the interceptor.
 	var protectedInterceptors connect.Option
+	var tokensHandler *tokens.Handler
+	var groupsK8s *groups.K8sClient
+	var invitationsService *invitations.Service
 	if s.cfg.Issuer != "" && s.cfg.ClientID != "" {
 		slog.Info("auth configured", "issuer", s.cfg.Issuer, "clientID", s.cfg.ClientID)
+		var authOpts []rpc.AuthInterceptorOption
+		if s.cfg.APITokensNamespace != "" {
+			if k8sClientset == nil {
+				return fmt.Errorf("api tokens require a kubernetes cluster")
+			}
+			slog.Info("api tokens enabled", "namespace", s.cfg.APITokensNamespace)
+			tokensHandler = tokens.NewHandler(tokens.NewK8sClient(k8sClientset, s.cfg.APITokensNamespace))
+			// Checked first: API tokens are recognized by prefix without a
+			// round trip to the API server.
+			authOpts = append(authOpts, rpc.WithTokenReviewer(tokensHandler))
+		}
+		if s.cfg.GroupsNamespace != "" {
+			if k8sClientset == nil {
+				return fmt.Errorf("console groups require a kubernetes cluster")
+			}
+			slog.Info("console groups enabled", "namespace", s.cfg.GroupsNamespace)
+			groupsK8s = groups.NewK8sClient(k8sClientset, s.cfg.GroupsNamespace)
+			authOpts = append(authOpts, rpc.WithGroupResolver(groupsK8s))
+		}
+		if s.cfg.InvitationsNamespace != "" {
+			if k8sClientset == nil {
+				return fmt.Errorf("invitations require a kubernetes cluster")
+			}
+			mailer, err := s.mailer()
+			if err != nil {
+				return err
+			}
+			slog.Info("invitations enabled", "namespace", s.cfg.InvitationsNamespace, "smtp", s.cfg.SMTPAddress)
+			invitationsService = invitations.NewService(invitations.NewK8sClient(k8sClientset, s.cfg.InvitationsNamespace), mailer, s.cfg.Origin)
+		}
+		if s.cfg.EnableServiceAccountAuth {
+			if k8sClientset == nil {
+				return fmt.Errorf("service account auth requires a kubernetes cluster")
+			}
+			slog.Info("service account token auth enabled", "audiences", s.cfg.ServiceAccountAudiences)
+			authOpts = append(authOpts, rpc.WithTokenReviewer(rpc.NewServiceAccountTokenReviewer(k8sClientset, s.cfg.ServiceAccountAudiences)))
+		}
+		if trustedProxy != nil {
+			authOpts = append(authOpts, rpc.WithTrustedProxy())
+		}
+		if s.cfg.ClientCAFile != "" {
+			authOpts = append(authOpts, rpc.WithClientCertificates())
+		}
+		if s.cfg.MaxTokenAge > 0 || len(s.cfg.StepUp) > 0 {
+			slog.Info("session policy enabled", "maxTokenAge", s.cfg.MaxTokenAge, "stepUp", s.cfg.StepUp)
+			authOpts = append(authOpts, rpc.WithSessionPolicy(s.sessionPolicy()))
+		}
 		protectedInterceptors = connect.WithInterceptors(
+			rpc.RequestIDInterceptor(),
+			rpc.TracingInterceptor(),
 			rpc.MetricsInterceptor(),
 			rpc.LoggingInterceptor(),
+			rpc.DrainInterceptor(draining),
+			timeoutInterceptor,
+			cacheControlInterceptor,
 			rpc.LazyAuthInterceptor(
 				s.cfg.Issuer,
 				s.cfg.ClientID,
 				s.cfg.RolesClaim,
 				internalClient,
+				authOpts...,
 			),
+			rpc.RequireClaimsInterceptor(publicServices...),
+			maintenance.Interceptor(maintenanceMode),
+			tokens.ScopeInterceptor(),
+			rpc.AuthorizationMetricsInterceptor(),
+			rateLimitInterceptor,
+			rpc.ClusterInterceptor(clusterRegistry),
 			rpc.ImpersonationInterceptor(restConfig, controllermgr.Scheme),
+			rpc.ValidationInterceptor(),
 		)
+		if invitationsService != nil {
+			protectedInterceptors = connect.WithOptions(protectedInterceptors, connect.WithInterceptors(invitationsService.SignInInterceptor()))
+		}
 	} else {
 		// Fallback to public interceptors if auth not configured
 		protectedInterceptors = publicInterceptors
 	}
 
+	// Messages larger than the request limit fail with ResourceExhausted
+	// before they are decoded.
+	readLimit := connect.WithReadMaxBytes(int(s.maxRequestBytes()))
+	publicInterceptors = connect.WithOptions(publicInterceptors, readLimit)
+	protectedInterceptors = connect.WithOptions(protectedInterceptors, readLimit)
+
+	// Every per-project client resolves the project namespace from the
+	// request's project name, so requests naming a project outside the
+	// managed namespaces or the static projects fail with NotFound here,
+	// before any handler runs.
+	nsScope, err := s.namespaceScope()
+	if err != nil {
+		return err
+	}
+	projectScope := nsscope.NewProjects(nsScope, s.cfg.StaticProjects, s.namespaceResolver(), k8sClientset)
+	protectedInterceptors = connect.WithOptions(protectedInterceptors, connect.WithInterceptors(nsscope.Interceptor(projectScope)))
+
 	// Register VersionService
 	versionHandler := rpc.NewVersionHandler(rpc.VersionInfo{
 		Version:      GetVersion(),
@@ -296,15 +925,7 @@ func (s *Server) Serve(ctx context.Context) error {
 		BuildDate:    BuildDate,
 	})
 	path, handler := consolev1connect.NewVersionServiceHandler(versionHandler, publicInterceptors)
-	mux.Handle(path, handler)
-
-	// Initialize Kubernetes client for secrets (may be nil if no cluster available).
-	// We share the resolved REST config with the controller-runtime manager
-	// below so there is a single loader for the cluster connection.
-	k8sClientset, err := secrets.NewClientsetForConfig(restConfig)
-	if err != nil {
-		return fmt.Errorf("failed to create kubernetes client: %w", err)
-	}
+	services.handle(path, handler)
 
 	// HOL-620: embed the controller-runtime manager when a cluster config
 	// is available. The manager owns the informer caches HOL-621 rewires
@@ -333,17 +954,19 @@ func (s *Server) Serve(ctx context.Context) error {
 			OrganizationPrefix: s.cfg.OrganizationPrefix,
 			FolderPrefix:       s.cfg.FolderPrefix,
 			ProjectPrefix:      s.cfg.ProjectPrefix,
+			Namespaces:         s.staticProjectNamespaces(),
 		})
 		if err != nil {
 			return fmt.Errorf("failed to build controller manager: %w", err)
 		}
 		s.controllerMgr = mgr
+		checker.Add("informers", readiness.FuncCheck(mgr.Ready, "informer caches have not synced"))
 		slog.Info("controller-runtime manager initialized")
 	}
 
 	// Register services (protected - requires auth)
 	if k8sClientset != nil {
-		nsResolver := &resolver.Resolver{NamespacePrefix: s.cfg.NamespacePrefix, OrganizationPrefix: s.cfg.OrganizationPrefix, FolderPrefix: s.cfg.FolderPrefix, ProjectPrefix: s.cfg.ProjectPrefix}
+		nsResolver := s.namespaceResolver()
 		slog.Info("kubernetes client initialized")
 
 		foldersK8s := folders.NewK8sClient(k8sClientset, nsResolver)
@@ -351,15 +974,65 @@ func (s *Server) Serve(ctx context.Context) error {
 		// Organization service (projectsK8s created first for linked-project precondition check)
 		orgsK8s := organizations.NewK8sClient(k8sClientset, nsResolver)
 		orgGrantResolver := organizations.NewOrgGrantResolver(orgsK8s)
-		projectsK8s := projects.NewK8sClient(k8sClientset, nsResolver)
-		orgsHandler := organizations.NewHandler(orgsK8s, projectsK8s, s.cfg.DisableOrgCreation, s.cfg.OrgCreatorUsers, s.cfg.OrgCreatorRoles)
-		orgsPath, orgsHTTPHandler := consolev1connect.NewOrganizationServiceHandler(orgsHandler, protectedInterceptors)
-		mux.Handle(orgsPath, orgsHTTPHandler)
+		projectsK8s := projects.NewK8sClient(k8sClientset, nsResolver).WithScope(nsScope)
+		if len(s.cfg.StaticProjects) > 0 {
+			projectsK8s = projectsK8s.WithStaticProjects(s.cfg.StaticProjects)
+			slog.Info("serving static projects; organizations and folders are disabled",
+				slog.Any("projects", s.cfg.StaticProjects),
+			)
+		}
+		if nsScope != nil {
+			slog.Info("limiting managed project namespaces",
+				slog.Any("allow", s.cfg.NamespaceAllowlist),
+				slog.Any("deny", s.cfg.NamespaceDenylist),
+				slog.String("selector", s.cfg.NamespaceSelector),
+			)
+		}
+		if s.cfg.ResourceStore == resourcestore.BackendCRD {
+			if s.controllerMgr == nil {
+				return fmt.Errorf("resource store %q requires the embedded controller manager", resourcestore.BackendCRD)
+			}
+			projectsK8s = projectsK8s.WithStore(resourcestore.NewCRDStore(
+				s.controllerMgr.GetClient(),
+				s.controllerMgr.GetManager().GetAPIReader(),
+			))
+			slog.Info("storing project metadata and grants in console resources")
+		}
+		orgsHandler := organizations.NewHandler(orgsK8s, projectsK8s, s.cfg.DisableOrgCreation, s.cfg.OrgCreatorUsers, s.cfg.OrgCreatorRoles).
+			WithAuditRing(auditRing).
+			WithPlatformOwners(s.platformOwners())
+		if s.cfg.OrgCreatorsFile != "" {
+			if err := orgsHandler.Creators().LoadFile(s.cfg.OrgCreatorsFile); err != nil {
+				return err
+			}
+			go orgsHandler.Creators().WatchFile(ctx, s.cfg.OrgCreatorsFile, orgCreatorsReloadInterval)
+			slog.Info("organization creators loaded from file", "path", s.cfg.OrgCreatorsFile, "version", orgsHandler.Creators().Version())
+		}
+		if len(s.cfg.StaticProjects) == 0 {
+			orgsPath, orgsHTTPHandler := consolev1connect.NewOrganizationServiceHandler(orgsHandler, protectedInterceptors)
+			services.handle(orgsPath, orgsHTTPHandler)
+		}
+
+		// Identity service. Nobody may create organizations while static
+		// projects disable them.
+		identityHandler := identity.NewHandler().WithSessionPolicy(s.sessionPolicy())
+		if len(s.cfg.StaticProjects) == 0 {
+			identityHandler = identityHandler.WithOrganizationCreators(orgsHandler)
+		}
+		identityPath, identityHTTPHandler := consolev1connect.NewIdentityServiceHandler(identityHandler, protectedInterceptors)
+		services.handle(identityPath, identityHTTPHandler)
+
+		// Maintenance service
+		maintenanceHandler := maintenance.NewHandler(maintenanceMode, s.cfg.PlatformOwnerUsers, s.cfg.PlatformOwnerRoles)
+		maintenancePath, maintenanceHTTPHandler := consolev1connect.NewMaintenanceServiceHandler(maintenanceHandler, protectedInterceptors)
+		services.handle(maintenancePath, maintenanceHTTPHandler)
 
 		// Folder service
-		foldersHandler := folders.NewHandler(foldersK8s)
-		foldersPath, foldersHTTPHandler := consolev1connect.NewFolderServiceHandler(foldersHandler, protectedInterceptors)
-		mux.Handle(foldersPath, foldersHTTPHandler)
+		if len(s.cfg.StaticProjects) == 0 {
+			foldersHandler := folders.NewHandler(foldersK8s)
+			foldersPath, foldersHTTPHandler := consolev1connect.NewFolderServiceHandler(foldersHandler, protectedInterceptors)
+			services.handle(foldersPath, foldersHTTPHandler)
+		}
 
 		// Dynamic client used by the deployment service's applier for
 		// Server-Side Apply onto project namespaces.
@@ -380,8 +1053,11 @@ func (s *Server) Serve(ctx context.Context) error {
 		// lookup)" extends to the namespace lookups the walker performs.
 		// The Client field is retained as a fallback for test wiring and
 		// for any deployment that turns the Manager off.
+		//
+		// Namespaces are cluster-scoped, so a static-projects deployment
+		// cannot cache them and reads each one by name instead.
 		var nsGetter resolver.NamespaceGetter
-		if s.controllerMgr != nil {
+		if s.controllerMgr != nil && len(s.cfg.StaticProjects) == 0 {
 			nsGetter = &resolver.CtrlRuntimeNamespaceGetter{Client: s.controllerMgr.GetClient()}
 		}
 		nsWalker := &resolver.Walker{Getter: nsGetter, Client: k8sClientset, Resolver: nsResolver}
@@ -475,7 +1151,7 @@ func (s *Server) Serve(ctx context.Context) error {
 		// creation-time RequiredTemplateApplier (Layer B in the HOL-580
 		// analysis); REQUIRE rules are now enforced exclusively at render
 		// time via folderResolver (Layer A).
-		projectsHandler := projects.NewHandler(projectsK8s, orgGrantResolver)
+		projectsHandler := projects.NewHandler(projectsK8s, orgGrantResolver).WithPlatformOwners(s.platformOwners())
 
 		// HOL-812: wire the ProjectNamespace pipeline
 		// (resolve → render → apply) into CreateProject. The pipeline is
@@ -498,29 +1174,166 @@ func (s *Server) Serve(ctx context.Context) error {
 			projectsHandler = projectsHandler.WithProjectNamespacePipeline(&projectNSPipelineAdapter{p: pipeline})
 		}
 
+		projectsHandler = projectsHandler.WithTrashRetention(s.cfg.TrashRetention)
+
 		projectsPath, projectsHTTPHandler := consolev1connect.NewProjectServiceHandler(projectsHandler, protectedInterceptors)
-		mux.Handle(projectsPath, projectsHTTPHandler)
+		services.handle(projectsPath, projectsHTTPHandler)
+
+		// OwnershipService — hands an organization or project to a new
+		// owner in one sharing update through the handlers above.
+		ownershipPath, ownershipHTTPHandler := consolev1connect.NewOwnershipServiceHandler(ownership.NewHandler(orgsHandler, projectsHandler), protectedInterceptors)
+		services.handle(ownershipPath, ownershipHTTPHandler)
 
 		// PermissionsService — bulk SelfSubjectAccessReview fan-out for the
-		// frontend's UI gating contract (ADR 036). Handler is stateless;
-		// every call resolves its impersonating client from the request
-		// context.
-		permissionsHandler := permissions.NewHandler()
+		// frontend's UI gating contract (ADR 036). ListResourcePermissions
+		// and CanI resolve their impersonating client from the request
+		// context; GetAccessReview reads RBAC with the service account once
+		// the caller is authorized as an owner.
+		permissionsHandler := permissions.NewHandler().WithResolver(nsResolver).WithAccessReview(k8sClientset)
 		permissionsPath, permissionsHTTPHandler := consolev1connect.NewPermissionsServiceHandler(permissionsHandler, protectedInterceptors)
-		mux.Handle(permissionsPath, permissionsHTTPHandler)
+		services.handle(permissionsPath, permissionsHTTPHandler)
+
+		// DashboardService — every organization, project, and secret the
+		// caller can access in one call for the home dashboard. Namespaces
+		// come from the manager's informer cache when it is running.
+		dashboardHandler := dashboard.NewHandler(k8sClientset, nsResolver).WithPlatformOwners(s.platformOwners())
+		if s.controllerMgr != nil {
+			dashboardHandler.WithCache(s.controllerMgr.GetClient())
+		}
+		dashboardPath, dashboardHTTPHandler := consolev1connect.NewDashboardServiceHandler(dashboardHandler, protectedInterceptors)
+		services.handle(dashboardPath, dashboardHTTPHandler)
+
+		// AuditService — recent audit events from the in-memory ring buffer.
+		// Access is gated by a SelfSubjectAccessReview on the virtual
+		// auditevents.console.holos.run resource.
+		auditHandler := audit.NewHandler(auditRing, nsResolver)
+		auditPath, auditHTTPHandler := consolev1connect.NewAuditServiceHandler(auditHandler, protectedInterceptors)
+		services.handle(auditPath, auditHTTPHandler)
 
 		// Secrets service with project grant fallback and ancestor default-share cascade.
 		secretsK8s := secrets.NewK8sClient(k8sClientset, nsResolver)
+		secretsK8s.ExternalSecrets = s.cfg.ExternalSecrets
+		secretsK8s.Retry = k8sretry.Policy{
+			MaxAttempts:    s.cfg.K8sRetryAttempts,
+			InitialBackoff: k8sretry.DefaultPolicy.InitialBackoff,
+			MaxBackoff:     k8sretry.DefaultPolicy.MaxBackoff,
+			Budget:         s.cfg.K8sRetryBudget,
+		}
+		if s.cfg.SecretEncryptionKeyFile != "" {
+			env, err := loadSecretEnvelope(s.cfg.SecretEncryptionKeyFile, s.cfg.SecretEncryptionPreviousKeyFiles)
+			if err != nil {
+				return err
+			}
+			secretsK8s.Envelope = env
+		}
 		projectResolver := projects.NewProjectGrantResolver(projectsK8s).WithWalker(nsWalker)
-		secretsHandler := secrets.NewProjectScopedHandler(secretsK8s, projectResolver)
+		secretsHandler := secrets.NewProjectScopedHandler(secretsK8s, projectResolver).WithTrashRetention(s.cfg.TrashRetention).WithMaintenance(maintenanceMode)
+		if s.cfg.SealedSecretsCert != "" {
+			sealer, err := secrets.LoadSealer(s.cfg.SealedSecretsCert)
+			if err != nil {
+				return err
+			}
+			secretsHandler = secretsHandler.WithSealer(sealer)
+		}
+		if s.cfg.VaultAddress != "" {
+			backend, err := vault.New(vault.Config{
+				Address:   s.cfg.VaultAddress,
+				Role:      s.cfg.VaultRole,
+				AuthMount: s.cfg.VaultAuthMount,
+			})
+			if err != nil {
+				return err
+			}
+			secretsHandler = secretsHandler.WithBackend(backend)
+		}
+		if invitationsService != nil {
+			secretsHandler = secretsHandler.WithInviter(invitationsService)
+		}
+		secretMetrics, err := secrets.NewSecretMetrics(prometheus.DefaultRegisterer, s.cfg.SecretMetricsLabels, s.cfg.SecretMetricsMaxSecrets)
+		if err != nil {
+			return err
+		}
+		secretsHandler = secretsHandler.WithMetrics(secretMetrics)
+		shareLinks, err := s.shareLinks()
+		if err != nil {
+			return err
+		}
+		if shareLinks != nil {
+			secretsHandler = secretsHandler.WithShareLinks(shareLinks)
+		}
 		secretsPath, secretsHTTPHandler := consolev1connect.NewSecretsServiceHandler(secretsHandler, protectedInterceptors)
-		mux.Handle(secretsPath, secretsHTTPHandler)
+		services.handle(secretsPath, secretsHTTPHandler)
+		services.handleAPI(secrets.DownloadPath, secrets.NewDownloadHandler(secretsHTTPHandler))
+		if shareLinks != nil {
+			handleShareLinks(services, secretsHandler)
+		}
+
+		// Purge soft-deleted secrets and projects once their retention
+		// window passes.
+		if s.cfg.TrashRetention > 0 {
+			go trash.NewReaper(k8sClientset, s.cfg.TrashRetention, trashPurgeInterval).Run(ctx)
+		}
+
+		// Notify organizations of the audit events they opted in to.
+		if s.cfg.EnableNotifications {
+			mailer, err := s.mailer()
+			if err != nil {
+				return err
+			}
+			slog.Info("notifications enabled", "smtp", s.cfg.SMTPAddress)
+			notifier := notifications.NewNotifier(k8sClientset, nsResolver, mailer, s.cfg.Origin)
+			auditRing.Subscribe(notifier.Notify)
+			go notifier.Run(ctx)
+		}
+
+		// Mirror denials and sharing changes into Kubernetes Events.
+		if s.cfg.KubeEvents {
+			recorder := kubeevents.NewRecorder(k8sClientset, nsResolver, kubeevents.DefaultLimit, kubeevents.DefaultBurst)
+			auditRing.Subscribe(recorder.Record)
+			go recorder.Run(ctx)
+		}
+
+		// Report grants about to expire and remove long-expired ones.
+		go grantexpiry.NewWorker(k8sClientset, s.cfg.GrantExpiryWarning, s.cfg.ExpiredGrantRetention, grantScanInterval).Run(ctx)
 
 		// Project settings service with org-level RBAC for deployments toggle
 		settingsK8s := settings.NewK8sClient(k8sClientset, nsResolver)
 		settingsHandler := settings.NewHandler(settingsK8s, projectResolver, orgGrantResolver, projectResolver)
 		settingsPath, settingsHTTPHandler := consolev1connect.NewProjectSettingsServiceHandler(settingsHandler, protectedInterceptors)
-		mux.Handle(settingsPath, settingsHTTPHandler)
+		services.handle(settingsPath, settingsHTTPHandler)
+
+		// AccessRequestService — time-boxed break-glass access. Requests are
+		// stored by the service account; approvals are gated by an SSAR for
+		// owner access to the project namespace.
+		accessRequestsK8s := accessrequests.NewK8sClient(k8sClientset, nsResolver)
+		accessRequestsHandler := accessrequests.NewHandler(accessRequestsK8s, projectsK8s, secretsK8s)
+		accessRequestsPath, accessRequestsHTTPHandler := consolev1connect.NewAccessRequestServiceHandler(accessRequestsHandler, protectedInterceptors)
+		services.handle(accessRequestsPath, accessRequestsHTTPHandler)
+
+		// GroupsService — console-local group membership merged into the
+		// groups of authenticated users. Access is gated by a
+		// SelfSubjectAccessReview on the virtual groups.console.holos.run
+		// resource.
+		if groupsK8s != nil {
+			groupsPath, groupsHTTPHandler := consolev1connect.NewGroupsServiceHandler(groups.NewHandler(groupsK8s), protectedInterceptors)
+			services.handle(groupsPath, groupsHTTPHandler)
+		}
+
+		// TokensService — personal API tokens, stored hashed in the console
+		// namespace and accepted by the auth interceptor above.
+		if tokensHandler != nil {
+			tokensPath, tokensHTTPHandler := consolev1connect.NewTokensServiceHandler(tokensHandler, protectedInterceptors)
+			services.handle(tokensPath, tokensHTTPHandler)
+		}
+
+		// SecretTemplatesService — org and project secret blueprints. Templates
+		// are stored by the service account behind SSARs on the scope
+		// namespace; secrets are created through the secrets handler so they
+		// get the same authorization as any other new secret.
+		secretTemplatesK8s := secrettemplates.NewK8sClient(k8sClientset, nsResolver)
+		secretTemplatesHandler := secrettemplates.NewHandler(secretTemplatesK8s, secretsHandler)
+		secretTemplatesPath, secretTemplatesHTTPHandler := consolev1connect.NewSecretTemplatesServiceHandler(secretTemplatesHandler, protectedInterceptors)
+		services.handle(secretTemplatesPath, secretTemplatesHTTPHandler)
 
 		// HOL-644 / HOL-828: shared gateway-namespace resolver used by both the
 		// deployments handler (project→org→annotation) and the template-preview
@@ -540,7 +1353,7 @@ func (s *Server) Serve(ctx context.Context) error {
 			WithProjectTemplateDriftChecker(projectTemplateDriftAdapter).
 			WithOrganizationGatewayResolver(gatewayResolver)
 		templatesPath, templatesHTTPHandler := consolev1connect.NewTemplateServiceHandler(templatesHandler, protectedInterceptors)
-		mux.Handle(templatesPath, templatesHTTPHandler)
+		services.handle(templatesPath, templatesHTTPHandler)
 
 		// TemplateDependencyService handler manages project-namespaced
 		// TemplateDependency CRDs used by ADR 032 dependency materialisation.
@@ -550,7 +1363,7 @@ func (s *Server) Serve(ctx context.Context) error {
 		templateDependenciesHandler := templatedependencies.NewHandler(templateDependenciesK8s, nsResolver).
 			WithProjectGrantResolver(projectResolver)
 		templateDependenciesPath, templateDependenciesHTTPHandler := consolev1connect.NewTemplateDependencyServiceHandler(templateDependenciesHandler, protectedInterceptors)
-		mux.Handle(templateDependenciesPath, templateDependenciesHTTPHandler)
+		services.handle(templateDependenciesPath, templateDependenciesHTTPHandler)
 
 		// TemplateRequirementService handler manages organization/folder scoped
 		// TemplateRequirement CRDs used by ADR 032 dependency materialisation.
@@ -559,7 +1372,7 @@ func (s *Server) Serve(ctx context.Context) error {
 			WithOrgGrantResolver(orgGrantResolver).
 			WithFolderGrantResolver(folderGrantResolver)
 		templateRequirementsPath, templateRequirementsHTTPHandler := consolev1connect.NewTemplateRequirementServiceHandler(templateRequirementsHandler, protectedInterceptors)
-		mux.Handle(templateRequirementsPath, templateRequirementsHTTPHandler)
+		services.handle(templateRequirementsPath, templateRequirementsHTTPHandler)
 
 		// TemplateGrantService handler manages organization/folder scoped
 		// TemplateGrant CRDs used by ADR 032 dependency materialisation.
@@ -570,7 +1383,7 @@ func (s *Server) Serve(ctx context.Context) error {
 			WithOrgGrantResolver(orgGrantResolver).
 			WithFolderGrantResolver(folderGrantResolver)
 		templateGrantsPath, templateGrantsHTTPHandler := consolev1connect.NewTemplateGrantServiceHandler(templateGrantsHandler, protectedInterceptors)
-		mux.Handle(templateGrantsPath, templateGrantsHTTPHandler)
+		services.handle(templateGrantsPath, templateGrantsHTTPHandler)
 
 		// TemplatePolicyService handler — manages REQUIRE/EXCLUDE policies at
 		// organization and folder scopes (HOL-556). Project scope is rejected:
@@ -591,7 +1404,7 @@ func (s *Server) Serve(ctx context.Context) error {
 			WithFolderGrantResolver(folderGrantResolver).
 			WithTemplateExistsResolver(templates.NewTemplateExistsAdapter(templatesK8s))
 		templatePoliciesPath, templatePoliciesHTTPHandler := consolev1connect.NewTemplatePolicyServiceHandler(templatePoliciesHandler, protectedInterceptors)
-		mux.Handle(templatePoliciesPath, templatePoliciesHTTPHandler)
+		services.handle(templatePoliciesPath, templatePoliciesHTTPHandler)
 
 		// TemplatePolicyBindingService handler — manages the explicit,
 		// non-glob binding of a TemplatePolicy to a list of project
@@ -614,7 +1427,7 @@ func (s *Server) Serve(ctx context.Context) error {
 			WithAncestorChainResolver(templatepolicybindings.NewAncestorChainAdapter(nsWalker)).
 			WithProjectExistsResolver(templatepolicybindings.NewProjectExistsAdapter(k8sClientset, nsResolver))
 		templatePolicyBindingsPath, templatePolicyBindingsHTTPHandler := consolev1connect.NewTemplatePolicyBindingServiceHandler(templatePolicyBindingsHandler, protectedInterceptors)
-		mux.Handle(templatePolicyBindingsPath, templatePolicyBindingsHTTPHandler)
+		services.handle(templatePolicyBindingsPath, templatePolicyBindingsHTTPHandler)
 
 		// Deployment service with project grant fallback.
 		// ancestorTemplateResolver wraps templatesK8s + nsWalker to satisfy
@@ -674,69 +1487,68 @@ func (s *Server) Serve(ctx context.Context) error {
 			)
 		}
 		deploymentsPath, deploymentsHTTPHandler := consolev1connect.NewDeploymentServiceHandler(deploymentsHandler, protectedInterceptors)
-		mux.Handle(deploymentsPath, deploymentsHTTPHandler)
+		services.handle(deploymentsPath, deploymentsHTTPHandler)
 	} else {
 		slog.Info("no kubernetes config available, using dummy-secret only")
 		// Fallback: secrets handler without K8s (no resolvers)
 		secretsHandler := secrets.NewProjectScopedHandler(nil, nil)
 		secretsPath, secretsHTTPHandler := consolev1connect.NewSecretsServiceHandler(secretsHandler, protectedInterceptors)
-		mux.Handle(secretsPath, secretsHTTPHandler)
+		services.handle(secretsPath, secretsHTTPHandler)
+		services.handleAPI(secrets.DownloadPath, secrets.NewDownloadHandler(secretsHTTPHandler))
 	}
 
+	// The standard gRPC health service reports the /readyz checks per
+	// service for grpcurl, kubelet gRPC probes, and service meshes.
+	services.handleHealth(checker)
+
 	// Register gRPC reflection for introspection (grpcurl, etc.).
 	// These endpoints are intentionally unauthenticated. The API surface they
 	// expose (service names, method signatures, message schemas) is public
 	// information available in the proto/ source files and UI bundle.
 	// See ADR 009 (docs/adrs/009-grpc-reflection-unauthenticated.md).
-	reflector := grpcreflect.NewStaticReflector(
-		consolev1connect.VersionServiceName,
-		consolev1connect.SecretsServiceName,
-		consolev1connect.ProjectServiceName,
-		consolev1connect.OrganizationServiceName,
-		consolev1connect.ProjectSettingsServiceName,
-		consolev1connect.TemplateServiceName,
-		consolev1connect.TemplateDependencyServiceName,
-		consolev1connect.TemplateRequirementServiceName,
-		consolev1connect.TemplateGrantServiceName,
-		consolev1connect.TemplatePolicyServiceName,
-		consolev1connect.TemplatePolicyBindingServiceName,
-		consolev1connect.FolderServiceName,
-		consolev1connect.DeploymentServiceName,
-		consolev1connect.PermissionsServiceName,
-	)
-	reflectPath, reflectHandler := grpcreflect.NewHandlerV1(reflector)
-	mux.Handle(reflectPath, reflectHandler)
-	reflectAlphaPath, reflectAlphaHandler := grpcreflect.NewHandlerV1Alpha(reflector)
-	mux.Handle(reflectAlphaPath, reflectAlphaHandler)
+	services.handleReflection()
 
 	// Initialize embedded OIDC identity provider (Dex).
-	// Only started when explicitly enabled via --enable-insecure-dex.
-	if s.cfg.EnableInsecureDex && s.cfg.Issuer != "" {
+	// Started for local development via --enable-insecure-dex, or with
+	// upstream connectors via --dex-connectors-config.
+	embeddedDex := (s.cfg.EnableInsecureDex || len(s.cfg.DexConnectors) > 0) && s.cfg.Issuer != ""
+	var dexState *oidc.DexState
+	if embeddedDex {
 		// Derive redirect URIs from origin
 		redirectURI := deriveRedirectURI(s.cfg.Origin)
 
 		// Also allow Vite dev server redirect URI for local development
 		redirectURIs := []string{redirectURI}
 		viteRedirectURI := "https://localhost:5173/pkce/verify"
-		if redirectURI != viteRedirectURI {
+		if s.cfg.EnableInsecureDex && redirectURI != viteRedirectURI {
 			redirectURIs = append(redirectURIs, viteRedirectURI)
 		}
+		if s.cfg.EnableSessions {
+			redirectURIs = append(redirectURIs, session.CallbackURL(s.cfg.Origin))
+		}
 
-		oidcHandler, dexState, err := oidc.NewHandler(ctx, oidc.Config{
+		oidcHandler, state, err := oidc.NewHandler(ctx, oidc.Config{
 			Issuer:          s.cfg.Issuer,
 			ClientID:        s.cfg.ClientID,
 			RedirectURIs:    redirectURIs,
 			Logger:          slog.Default(),
 			IDTokenTTL:      s.cfg.IDTokenTTL,
 			RefreshTokenTTL: s.cfg.RefreshTokenTTL,
+			Connectors:      s.cfg.DexConnectors,
 		})
 		if err != nil {
 			return fmt.Errorf("failed to create OIDC handler: %w", err)
 		}
+		dexState = state
 
 		// Mount Dex at /dex/ - Dex handles the full path internally since issuer includes /dex
 		mux.Handle("/dex/", oidcHandler)
 
+		slog.Info("embedded OIDC provider mounted", "path", "/dex/", "issuer", s.cfg.Issuer)
+	} else if s.cfg.Issuer != "" {
+		slog.Info("using external OIDC provider", "issuer", s.cfg.Issuer, "authority", newOIDCConfig(s.cfg).Authority)
+	}
+	if embeddedDex && s.cfg.EnableInsecureDex {
 		// Mount dev token-exchange endpoint for programmatic persona tokens.
 		// This endpoint mints real OIDC ID tokens signed by Dex's keys for any
 		// registered test user, enabling API testing without a browser flow.
@@ -745,18 +1557,17 @@ func (s *Server) Serve(ctx context.Context) error {
 
 		// Debug endpoint for OIDC investigation (insecure Dex mode only)
 		issuer := s.cfg.Issuer
-		mux.HandleFunc("/api/debug/oidc", func(w http.ResponseWriter, r *http.Request) {
+		admin.HandleFunc("/api/debug/oidc", func(w http.ResponseWriter, r *http.Request) {
 			handleDebugOIDC(w, r, issuer, internalClient)
 		})
-
-		slog.Info("embedded OIDC provider mounted", "path", "/dex/", "issuer", s.cfg.Issuer)
 	} else {
-		// When Dex is disabled, register fallback handlers for dev-only API
-		// endpoints so they return a proper 404 JSON error instead of falling
-		// through to the SPA catch-all (which would serve index.html as HTML 200).
+		// When insecure Dex is disabled, register fallback handlers for
+		// dev-only API endpoints so they return a proper 404 JSON error
+		// instead of falling through to the SPA catch-all (which would serve
+		// index.html as HTML 200).
 		// See https://github.com/holos-run/holos-console/issues/716.
 		mux.HandleFunc("/api/dev/token", apiNotAvailable("/api/dev/token", "Dex"))
-		mux.HandleFunc("/api/debug/oidc", apiNotAvailable("/api/debug/oidc", "Dex"))
+		admin.HandleFunc("/api/debug/oidc", apiNotAvailable("/api/debug/oidc", "Dex"))
 	}
 
 	// Prepare embedded UI files
@@ -766,15 +1577,7 @@ func (s *Server) Serve(ctx context.Context) error {
 	}
 
 	// Create OIDC config for frontend injection
-	var oidcConfig *OIDCConfig
-	if s.cfg.Issuer != "" {
-		oidcConfig = &OIDCConfig{
-			Authority:             s.cfg.Issuer,
-			ClientID:              s.cfg.ClientID,
-			RedirectURI:           deriveRedirectURI(s.cfg.Origin),
-			PostLogoutRedirectURI: derivePostLogoutRedirectURI(s.cfg.Origin),
-		}
-	}
+	oidcConfig := newOIDCConfig(s.cfg)
 
 	// Create console config for frontend injection.
 	// The namespace prefixes are always injected so the frontend's
@@ -787,6 +1590,7 @@ func (s *Server) Serve(ctx context.Context) error {
 		OrganizationPrefix: s.cfg.OrganizationPrefix,
 		FolderPrefix:       s.cfg.FolderPrefix,
 		ProjectPrefix:      s.cfg.ProjectPrefix,
+		SessionsEnabled:    sessions != nil,
 	}
 
 	uiHandler := newUIHandler(uiContent, oidcConfig, consoleConfig)
@@ -811,20 +1615,49 @@ func (s *Server) Serve(ctx context.Context) error {
 	})
 
 	// Expose Prometheus metrics at /metrics
-	mux.Handle("/metrics", promhttp.Handler())
+	admin.Handle("/metrics", metricsHandler)
 
 	// Wrap with h2c for HTTP/2 cleartext support (needed for gRPC over HTTP/2)
-	h2cHandler := h2c.NewHandler(mux, &http2.Server{})
+	var rootHandler http.Handler = mux
+	if sessions != nil {
+		rootHandler = sessions.Middleware(rootHandler)
+	}
+	if trustedProxy != nil {
+		slog.Info("trusted proxy auth enabled", "cidrs", s.cfg.TrustedProxyCIDRs)
+		rootHandler = rpc.TrustedProxyMiddleware(*trustedProxy, rootHandler)
+	}
+	rootHandler = securityHeadersMiddleware(s.securityHeaders(), rootHandler)
+	rootHandler = limitRequestBody(s.maxRequestBytes(), rootHandler)
+	// Assign request IDs inside h2c so every HTTP/2 stream gets its own.
+	rootHandler = rpc.RequestIDMiddleware(rootHandler)
+	h2cHandler := h2c.NewHandler(rootHandler, &http2.Server{})
 	loggedHandler := logRequests(h2cHandler, s.cfg.LogHealthChecks)
 
+	// Requests outlive the cancellation of ctx so shutdown can drain them.
+	// requestsCtx is cancelled only if the drain times out.
+	requestsCtx, cancelRequests := context.WithCancel(context.WithoutCancel(ctx))
+	defer cancelRequests()
 	server := &http.Server{
 		Addr:    s.cfg.ListenAddr,
 		Handler: loggedHandler,
 		BaseContext: func(l net.Listener) context.Context {
-			return ctx
+			return requestsCtx
 		},
 	}
 
+	// The admin listener serves plain HTTP; it is meant to be reachable
+	// only from the pod or node, not through the ingress.
+	var adminServer *http.Server
+	if admin != mux {
+		adminServer = &http.Server{
+			Addr:    s.cfg.AdminListenAddr,
+			Handler: logRequests(admin, s.cfg.LogHealthChecks),
+			BaseContext: func(l net.Listener) context.Context {
+				return requestsCtx
+			},
+		}
+	}
+
 	// Configure TLS (skipped for plain HTTP)
 	if !s.cfg.PlainHTTP {
 		tlsConfig, err := s.tlsConfig()
@@ -834,8 +1667,10 @@ func (s *Server) Serve(ctx context.Context) error {
 		server.TLSConfig = tlsConfig
 	}
 
-	// Mark server as ready before starting the listener
+	// Mark the listener as started and begin polling dependencies. The
+	// first round of checks may race the listener; later rounds pick it up.
 	s.ready.Store(true)
+	go checker.Run(ctx)
 
 	// Start server
 	scheme := "https"
@@ -845,7 +1680,13 @@ func (s *Server) Serve(ctx context.Context) error {
 	slog.Info("starting server", "addr", s.cfg.ListenAddr, "scheme", scheme)
 	slog.Info("ready", "version", GetVersion(), "url", s.cfg.Origin)
 
-	errCh := make(chan error, 2)
+	errCh := make(chan error, 3)
+	if adminServer != nil {
+		slog.Info("starting admin server", "addr", s.cfg.AdminListenAddr)
+		go func() {
+			errCh <- adminServer.ListenAndServe()
+		}()
+	}
 	go func() {
 		if s.cfg.PlainHTTP {
 			errCh <- server.ListenAndServe()
@@ -876,15 +1717,45 @@ func (s *Server) Serve(ctx context.Context) error {
 
 	select {
 	case <-ctx.Done():
-		slog.Info("shutting down server")
-		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-		defer cancel()
-		return server.Shutdown(shutdownCtx)
+		return s.shutdown(checker, drain, cancelRequests, server, adminServer)
 	case err := <-errCh:
 		return err
 	}
 }
 
+// shutdown drains the server. /readyz reports not ready at once; after
+// ShutdownDelay the listener stops accepting connections, HTTP/2 clients
+// get a GOAWAY, streaming RPCs end, and in-flight requests get
+// ShutdownTimeout to finish before their connections are closed. The admin
+// listener stays up until the drain finishes so probes can observe it.
+func (s *Server) shutdown(checker *readiness.Checker, drain, cancelRequests context.CancelFunc, server, adminServer *http.Server) error {
+	timeout := s.cfg.ShutdownTimeout
+	if timeout <= 0 {
+		timeout = defaultShutdownTimeout
+	}
+	slog.Info("shutting down server", "delay", s.cfg.ShutdownDelay, "timeout", timeout)
+	checker.Drain()
+	time.Sleep(s.cfg.ShutdownDelay)
+	drain()
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	err := server.Shutdown(ctx)
+	if errors.Is(err, context.DeadlineExceeded) {
+		slog.Warn("in-flight requests did not finish before the shutdown timeout; closing their connections", "timeout", timeout)
+		cancelRequests()
+		err = server.Close()
+	}
+	if adminServer != nil {
+		adminCtx, cancel := context.WithTimeout(context.Background(), adminShutdownTimeout)
+		defer cancel()
+		if err := adminServer.Shutdown(adminCtx); err != nil {
+			slog.Warn("shutting down admin server", "error", err)
+		}
+	}
+	return err
+}
+
 type loggingResponseWriter struct {
 	http.ResponseWriter
 	statusCode int
@@ -974,6 +1845,12 @@ func logRequests(next http.Handler, logHealthChecks bool) http.Handler {
 			referer,
 			userAgent,
 		)
+		// The request ID is read back from the response header because the
+		// middleware that assigns it runs inside this handler.
+		if id := writer.Header().Get(rpc.RequestIDHeader); id != "" {
+			slog.Info(logLine, slog.String("request_id", id))
+			return
+		}
 		slog.Info(logLine)
 	})
 }
@@ -988,14 +1865,63 @@ func apiNotAvailable(endpoint, service string) http.HandlerFunc {
 	}
 }
 
+// uiImmutableCacheControl is sent for Vite's fingerprinted assets under
+// assets/, whose names change whenever their content does.
+const uiImmutableCacheControl = "public, max-age=31536000, immutable"
+
+// uiEncodings are the precompressed variants the frontend build writes next
+// to each compressible asset, in order of preference.
+var uiEncodings = []struct{ coding, ext string }{
+	{"br", ".br"},
+	{"gzip", ".gz"},
+}
+
+// uiAsset describes an embedded UI file.
+type uiAsset struct {
+	// etag is a strong ETag derived from the file content.
+	etag string
+	// variants maps a content coding to the name of the precompressed
+	// variant of the file, e.g. "br" to "assets/index-abc.js.br".
+	variants map[string]string
+}
+
 type uiHandler struct {
 	fs            fs.FS
+	assets        map[string]*uiAsset
 	oidcConfig    *OIDCConfig
 	consoleConfig *ConsoleConfig
 }
 
 func newUIHandler(uiContent fs.FS, oidcConfig *OIDCConfig, consoleConfig *ConsoleConfig) *uiHandler {
-	return &uiHandler{fs: uiContent, oidcConfig: oidcConfig, consoleConfig: consoleConfig}
+	return &uiHandler{fs: uiContent, assets: indexUIAssets(uiContent), oidcConfig: oidcConfig, consoleConfig: consoleConfig}
+}
+
+// indexUIAssets hashes every file in fsys and records its precompressed
+// variants once, so requests never hash or probe the filesystem.
+func indexUIAssets(fsys fs.FS) map[string]*uiAsset {
+	assets := map[string]*uiAsset{}
+	_ = fs.WalkDir(fsys, ".", func(name string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return nil
+		}
+		data, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			return nil
+		}
+		sum := sha256.Sum256(data)
+		asset := &uiAsset{etag: `"` + hex.EncodeToString(sum[:16]) + `"`}
+		for _, enc := range uiEncodings {
+			if info, err := fs.Stat(fsys, name+enc.ext); err == nil && !info.IsDir() {
+				if asset.variants == nil {
+					asset.variants = map[string]string{}
+				}
+				asset.variants[enc.coding] = name + enc.ext
+			}
+		}
+		assets[name] = asset
+		return nil
+	})
+	return assets
 }
 
 func (h *uiHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
@@ -1023,11 +1949,17 @@ func (h *uiHandler) serveIndex(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Injected scripts carry the Content-Security-Policy nonce, if any.
+	var nonceAttr string
+	if nonce := cspNonceFromContext(r.Context()); nonce != "" {
+		nonceAttr = fmt.Sprintf(` nonce="%s"`, nonce)
+	}
+
 	// Inject OIDC config if available
 	if h.oidcConfig != nil {
 		configJSON, err := json.Marshal(h.oidcConfig)
 		if err == nil {
-			script := fmt.Sprintf(`<script>window.__OIDC_CONFIG__=%s;</script>`, configJSON)
+			script := fmt.Sprintf(`<script%s>window.__OIDC_CONFIG__=%s;</script>`, nonceAttr, configJSON)
 			// Insert before </head>
 			data = bytes.Replace(data, []byte("</head>"), []byte(script+"</head>"), 1)
 		}
@@ -1037,45 +1969,103 @@ func (h *uiHandler) serveIndex(w http.ResponseWriter, r *http.Request) {
 	if h.consoleConfig != nil {
 		configJSON, err := json.Marshal(h.consoleConfig)
 		if err == nil {
-			script := fmt.Sprintf(`<script>window.__CONSOLE_CONFIG__=%s;</script>`, configJSON)
+			script := fmt.Sprintf(`<script%s>window.__CONSOLE_CONFIG__=%s;</script>`, nonceAttr, configJSON)
 			data = bytes.Replace(data, []byte("</head>"), []byte(script+"</head>"), 1)
 		}
 	}
 
+	// index.html carries per-deployment config and names the current
+	// fingerprinted assets, so it must never be served from a cache.
+	w.Header().Set("Cache-Control", "no-store")
 	w.Header().Set("Content-Type", "text/html; charset=utf-8")
 	_, _ = w.Write(data)
 }
 
+// serveIfFile serves the embedded file name, or its best precompressed
+// variant the client accepts, and reports whether name exists.
 func (h *uiHandler) serveIfFile(w http.ResponseWriter, r *http.Request, name string) bool {
-	file, err := h.fs.Open(name)
+	asset, ok := h.assets[name]
+	if !ok {
+		return false
+	}
+
+	served, coding, etag := name, "", asset.etag
+	for _, enc := range uiEncodings {
+		variant, ok := asset.variants[enc.coding]
+		if !ok || !acceptsEncoding(r.Header.Get("Accept-Encoding"), enc.coding) {
+			continue
+		}
+		served, coding = variant, enc.coding
+		// Each representation needs its own strong ETag.
+		etag = strings.TrimSuffix(asset.etag, `"`) + "-" + enc.coding + `"`
+		break
+	}
+
+	file, err := h.fs.Open(served)
 	if err != nil {
 		return false
 	}
 	defer func() {
 		_ = file.Close()
 	}()
-
 	info, err := file.Stat()
-	if err != nil || info.IsDir() {
+	if err != nil {
 		return false
 	}
 
-	h.serveFileWithInfo(w, r, name, file, info)
-	return true
-}
+	// The content type is that of the original name, not the variant.
+	if contentType := mime.TypeByExtension(path.Ext(name)); contentType != "" {
+		w.Header().Set("Content-Type", contentType)
+	}
+	if len(asset.variants) > 0 {
+		w.Header().Add("Vary", "Accept-Encoding")
+	}
+	if coding != "" {
+		w.Header().Set("Content-Encoding", coding)
+	}
+	w.Header().Set("ETag", etag)
+	if strings.HasPrefix(name, "assets/") {
+		w.Header().Set("Cache-Control", uiImmutableCacheControl)
+	} else {
+		// Unfingerprinted files such as favicons revalidate by ETag.
+		w.Header().Set("Cache-Control", "no-cache")
+	}
 
-func (h *uiHandler) serveFileWithInfo(w http.ResponseWriter, r *http.Request, name string, file fs.File, info fs.FileInfo) {
+	if content, ok := file.(io.ReadSeeker); ok {
+		http.ServeContent(w, r, name, info.ModTime(), content)
+		return true
+	}
 	data, err := io.ReadAll(file)
 	if err != nil {
 		http.Error(w, "failed to read file", http.StatusInternalServerError)
-		return
+		return true
 	}
+	http.ServeContent(w, r, name, info.ModTime(), bytes.NewReader(data))
+	return true
+}
 
-	if contentType := mime.TypeByExtension(path.Ext(name)); contentType != "" {
-		w.Header().Set("Content-Type", contentType)
+// acceptsEncoding reports whether an Accept-Encoding header value accepts
+// coding. A coding listed with q=0 is refused; an explicit entry for coding
+// takes precedence over the "*" wildcard.
+func acceptsEncoding(header, coding string) bool {
+	wildcard := false
+	for _, part := range strings.Split(header, ",") {
+		name, params, _ := strings.Cut(part, ";")
+		name = strings.TrimSpace(name)
+		accepted := true
+		if q, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			if v, err := strconv.ParseFloat(q, 64); err == nil && v == 0 {
+				accepted = false
+			}
+		}
+		if strings.EqualFold(name, coding) {
+			return accepted
+		}
+		if name == "*" {
+			wildcard = accepted
+		}
 	}
-
-	http.ServeContent(w, r, name, info.ModTime(), bytes.NewReader(data))
+	return wildcard
 }
 
 // handleDebugOIDC returns debug information about OIDC configuration.
@@ -1119,11 +2109,23 @@ func handleDebugOIDC(w http.ResponseWriter, r *http.Request, issuer string, clie
 
 // tlsConfig returns the TLS configuration for the server.
 func (s *Server) tlsConfig() (*tls.Config, error) {
+	cfg := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+	if s.cfg.ClientCAFile != "" {
+		pool, err := loadClientCAPool(s.cfg.ClientCAFile)
+		if err != nil {
+			return nil, err
+		}
+		// Certificates are verified whenever presented but only required
+		// by rpc.ClientCertMiddleware on API routes, so the UI keeps
+		// working over regular TLS.
+		cfg.ClientCAs = pool
+		cfg.ClientAuth = tls.VerifyClientCertIfGiven
+	}
 	if s.cfg.CertFile != "" && s.cfg.KeyFile != "" {
 		// Use provided certificate files
-		return &tls.Config{
-			MinVersion: tls.VersionTLS12,
-		}, nil
+		return cfg, nil
 	}
 
 	// Generate self-signed certificate
@@ -1134,10 +2136,195 @@ func (s *Server) tlsConfig() (*tls.Config, error) {
 
 	slog.Info("generated self-signed certificate")
 
-	return &tls.Config{
-		Certificates: []tls.Certificate{cert},
-		MinVersion:   tls.VersionTLS12,
-	}, nil
+	cfg.Certificates = []tls.Certificate{cert}
+	return cfg, nil
+}
+
+// loadClientCAPool loads the PEM-encoded CA bundle client certificates are
+// verified against. Unlike loadCACertPool it excludes the system roots, so
+// only certificates issued by these CAs are accepted.
+func loadClientCAPool(caFile string) (*x509.CertPool, error) {
+	pemData, err := os.ReadFile(caFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read client CA file: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pemData) {
+		return nil, fmt.Errorf("no valid certificates found in %s", caFile)
+	}
+	return pool, nil
+}
+
+// sessionPolicy returns the token age limits enforced by the auth
+// interceptor and reported by GetSession.
+func (s *Server) sessionPolicy() rpc.SessionPolicy {
+	return rpc.SessionPolicy{MaxTokenAge: s.cfg.MaxTokenAge, StepUp: s.cfg.StepUp}
+}
+
+// platformOwners returns the configured platform owners.
+func (s *Server) platformOwners() rpc.PlatformOwners {
+	return rpc.PlatformOwners{Users: s.cfg.PlatformOwnerUsers, Roles: s.cfg.PlatformOwnerRoles}
+}
+
+// namespaceResolver returns the resolver translating resource names to
+// namespaces with the configured prefixes.
+func (s *Server) namespaceResolver() *resolver.Resolver {
+	return &resolver.Resolver{NamespacePrefix: s.cfg.NamespacePrefix, OrganizationPrefix: s.cfg.OrganizationPrefix, FolderPrefix: s.cfg.FolderPrefix, ProjectPrefix: s.cfg.ProjectPrefix}
+}
+
+// namespaceScope parses the managed namespace restrictions and checks the
+// static projects do not fall outside them.
+func (s *Server) namespaceScope() (*nsscope.Scope, error) {
+	scope, err := nsscope.New(s.cfg.NamespaceAllowlist, s.cfg.NamespaceDenylist, s.cfg.NamespaceSelector)
+	if err != nil {
+		return nil, err
+	}
+	for i, ns := range s.staticProjectNamespaces() {
+		if !scope.ManagesName(ns) {
+			return nil, fmt.Errorf("static project %q: namespace %q is excluded by the namespace allowlist or denylist", s.cfg.StaticProjects[i], ns)
+		}
+	}
+	return scope, nil
+}
+
+// staticProjectNamespaces returns the namespaces of the statically
+// configured projects, or nil when projects are not static.
+func (s *Server) staticProjectNamespaces() []string {
+	if len(s.cfg.StaticProjects) == 0 {
+		return nil
+	}
+	r := s.namespaceResolver()
+	namespaces := make([]string, 0, len(s.cfg.StaticProjects))
+	for _, name := range s.cfg.StaticProjects {
+		namespaces = append(namespaces, r.ProjectNamespace(name))
+	}
+	return namespaces
+}
+
+// trustedProxyConfig parses the trusted proxy settings. It returns nil when
+// trusted proxy authentication is disabled.
+func (s *Server) trustedProxyConfig() (*rpc.TrustedProxyConfig, error) {
+	if len(s.cfg.TrustedProxyCIDRs) == 0 {
+		return nil, nil
+	}
+	if s.cfg.TrustedProxySignatureKeyFile == "" {
+		return nil, fmt.Errorf("trusted proxy auth requires a signature key file")
+	}
+	cfg := &rpc.TrustedProxyConfig{}
+	for _, cidr := range s.cfg.TrustedProxyCIDRs {
+		prefix, err := netip.ParsePrefix(cidr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy CIDR %q: %w", cidr, err)
+		}
+		cfg.CIDRs = append(cfg.CIDRs, prefix.Masked())
+	}
+	raw, err := os.ReadFile(s.cfg.TrustedProxySignatureKeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read trusted proxy signature key: %w", err)
+	}
+	if cfg.Key, err = rpc.ParseSignatureKey(string(raw)); err != nil {
+		return nil, fmt.Errorf("invalid trusted proxy signature key: %w", err)
+	}
+	return cfg, nil
+}
+
+// mailer builds the mailer invitations and notifications are sent with. It
+// returns nil when no SMTP relay is configured.
+func (s *Server) mailer() (invitations.Mailer, error) {
+	if s.cfg.SMTPAddress == "" {
+		return nil, nil
+	}
+	if s.cfg.SMTPFrom == "" {
+		return nil, fmt.Errorf("smtp requires a from address")
+	}
+	mailer := &invitations.SMTPMailer{Addr: s.cfg.SMTPAddress, From: s.cfg.SMTPFrom, Username: s.cfg.SMTPUsername}
+	if s.cfg.SMTPPasswordFile != "" {
+		raw, err := os.ReadFile(s.cfg.SMTPPasswordFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read smtp password: %w", err)
+		}
+		mailer.Password = strings.TrimSpace(string(raw))
+	}
+	return mailer, nil
+}
+
+// sessionHandler builds the /api/session handler. It returns nil when
+// server-side sessions are disabled.
+func (s *Server) sessionHandler(client *http.Client) (*session.Handler, error) {
+	if !s.cfg.EnableSessions {
+		return nil, nil
+	}
+	if s.cfg.Issuer == "" || s.cfg.ClientID == "" || s.cfg.Origin == "" {
+		return nil, fmt.Errorf("sessions require an OIDC issuer, client ID, and origin")
+	}
+	var key []byte
+	if s.cfg.SessionKeyFile != "" {
+		var err error
+		if key, err = session.LoadKey(s.cfg.SessionKeyFile); err != nil {
+			return nil, err
+		}
+	} else {
+		slog.Warn("no session key file configured; sessions will not survive a restart")
+		key = session.GenerateKey()
+	}
+	return session.NewHandler(session.Config{
+		Issuer:     s.cfg.Issuer,
+		ClientID:   s.cfg.ClientID,
+		Origin:     s.cfg.Origin,
+		RolesClaim: s.cfg.RolesClaim,
+		Key:        key,
+		HTTPClient: client,
+	})
+}
+
+// shareLinks builds the secret share link signer. It returns nil when share
+// links are disabled.
+// handleShareLinks mounts the secret share link handler of h. Share links
+// are opened by recipients without an account, let alone a client
+// certificate, so they bypass the API middleware; the signed token
+// authorizes them.
+func handleShareLinks(services *serviceRegistry, h *secrets.Handler) {
+	services.handlePublic(secrets.ShareLinkPath, h.ShareLinkHandler())
+	slog.Info("secret share links enabled", "path", secrets.ShareLinkPath)
+}
+
+func (s *Server) shareLinks() (*secrets.ShareLinks, error) {
+	if !s.cfg.EnableShareLinks {
+		return nil, nil
+	}
+	if s.cfg.Origin == "" {
+		return nil, fmt.Errorf("share links require an origin")
+	}
+	var key []byte
+	if s.cfg.ShareLinkKeyFile != "" {
+		var err error
+		if key, err = secrets.LoadShareLinkKey(s.cfg.ShareLinkKeyFile); err != nil {
+			return nil, err
+		}
+	} else {
+		slog.Warn("no share link key file configured; share links will not survive a restart")
+		key = secrets.GenerateShareLinkKey()
+	}
+	return secrets.NewShareLinks(key, s.cfg.Origin), nil
+}
+
+// loadSecretEnvelope builds the envelope that encrypts secret values at rest
+// from the current key encryption key file and any previous ones.
+func loadSecretEnvelope(keyFile string, previousKeyFiles []string) (*envelope.Envelope, error) {
+	primary, err := envelope.LoadKeyFile(keyFile)
+	if err != nil {
+		return nil, err
+	}
+	var previous []envelope.KeyWrapper
+	for _, path := range previousKeyFiles {
+		w, err := envelope.LoadKeyFile(path)
+		if err != nil {
+			return nil, err
+		}
+		previous = append(previous, w)
+	}
+	slog.Info("encrypting secret values at rest", slog.String("key_id", primary.ID()), slog.Int("previous_keys", len(previous)))
+	return envelope.New(primary, previous...), nil
 }
 
 // loadCACertPool loads a PEM-encoded CA certificate file and returns a cert
@@ -2,12 +2,16 @@ package console
 
 import (
 	"bytes"
+	"io"
 	"log/slog"
 	"net/http"
 	"net/http/httptest"
 	"strings"
 	"testing"
 	"testing/fstest"
+	"time"
+
+	"github.com/holos-run/holos-console/console/readiness"
 )
 
 func TestLogRequests_HealthCheck_Suppressed(t *testing.T) {
@@ -188,6 +192,77 @@ func TestServeIndex_ConsoleConfigDevToolsDisabled(t *testing.T) {
 	}
 }
 
+func TestUIHandler_ServesPrecompressedAssets(t *testing.T) {
+	// Fingerprinted assets are served from their precompressed variants when
+	// the client accepts them, with immutable caching and a per-encoding
+	// ETag; index.html is never cached.
+	fakeFS := fstest.MapFS{
+		"index.html":             &fstest.MapFile{Data: []byte(`<html><head></head></html>`)},
+		"assets/index-abc.js":    &fstest.MapFile{Data: []byte("console.log('plain')")},
+		"assets/index-abc.js.br": &fstest.MapFile{Data: []byte("brotli")},
+		"assets/index-abc.js.gz": &fstest.MapFile{Data: []byte("gzip")},
+		"favicon.svg":            &fstest.MapFile{Data: []byte("<svg/>")},
+	}
+	h := newUIHandler(fakeFS, nil, nil)
+
+	get := func(path, acceptEncoding, ifNoneMatch string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		if acceptEncoding != "" {
+			req.Header.Set("Accept-Encoding", acceptEncoding)
+		}
+		if ifNoneMatch != "" {
+			req.Header.Set("If-None-Match", ifNoneMatch)
+		}
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		return rec
+	}
+
+	tests := []struct {
+		acceptEncoding string
+		wantBody       string
+		wantEncoding   string
+	}{
+		{"gzip, deflate, br", "brotli", "br"},
+		{"gzip", "gzip", "gzip"},
+		{"br;q=0, gzip", "gzip", "gzip"},
+		{"*", "brotli", "br"},
+		{"", "console.log('plain')", ""},
+	}
+	etags := map[string]bool{}
+	for _, tt := range tests {
+		rec := get("/assets/index-abc.js", tt.acceptEncoding, "")
+		if rec.Body.String() != tt.wantBody || rec.Header().Get("Content-Encoding") != tt.wantEncoding {
+			t.Errorf("Accept-Encoding %q: expected %q encoded %q, got %q encoded %q",
+				tt.acceptEncoding, tt.wantBody, tt.wantEncoding, rec.Body.String(), rec.Header().Get("Content-Encoding"))
+		}
+		if ct := rec.Header().Get("Content-Type"); !strings.HasPrefix(ct, "text/javascript") {
+			t.Errorf("expected javascript content type, got %q", ct)
+		}
+		if rec.Header().Get("Cache-Control") != uiImmutableCacheControl || rec.Header().Get("Vary") != "Accept-Encoding" {
+			t.Errorf("unexpected caching headers %v", rec.Header())
+		}
+		etags[rec.Header().Get("ETag")] = true
+	}
+	if len(etags) != 3 {
+		t.Errorf("expected a distinct ETag per encoding, got %v", etags)
+	}
+
+	etag := get("/assets/index-abc.js", "br", "").Header().Get("ETag")
+	if rec := get("/assets/index-abc.js", "br", etag); rec.Code != http.StatusNotModified {
+		t.Errorf("expected 304 for a matching ETag, got %d", rec.Code)
+	}
+
+	if rec := get("/favicon.svg", "br", ""); rec.Header().Get("Cache-Control") != "no-cache" || rec.Header().Get("Content-Encoding") != "" || rec.Header().Get("ETag") == "" {
+		t.Errorf("expected an uncompressed, revalidated favicon, got %v", rec.Header())
+	}
+	for _, path := range []string{"/", "/projects/billing"} {
+		if rec := get(path, "br", ""); rec.Header().Get("Cache-Control") != "no-store" || rec.Header().Get("Content-Encoding") != "" {
+			t.Errorf("%s: expected uncached, uncompressed index.html, got %v", path, rec.Header())
+		}
+	}
+}
+
 func TestAPIDevToken_ReturnsJSON404WhenDexDisabled(t *testing.T) {
 	// When Dex is disabled, /api/dev/token should return a JSON 404 error
 	// response, not fall through to the SPA catch-all (which would serve
@@ -261,3 +336,94 @@ func TestLogRequests_NonHealthPath_AlwaysLogged(t *testing.T) {
 		t.Error("expected log output for /ui, got nothing")
 	}
 }
+
+func TestNewOIDCConfig_ExternalProvider(t *testing.T) {
+	cfg := Config{
+		Origin:   "https://console.example.com",
+		Issuer:   "http://keycloak.auth.svc/realms/holos",
+		ClientID: "holos-console",
+	}
+	if got := newOIDCConfig(cfg).Authority; got != cfg.Issuer {
+		t.Errorf("expected authority to default to the issuer, got %q", got)
+	}
+
+	cfg.FrontendAuthority = "https://sso.example.com/realms/holos"
+	got := newOIDCConfig(cfg)
+	if got.Authority != cfg.FrontendAuthority {
+		t.Errorf("expected frontend authority %q, got %q", cfg.FrontendAuthority, got.Authority)
+	}
+	if got.RedirectURI != "https://console.example.com/pkce/verify" {
+		t.Errorf("unexpected redirect URI %q", got.RedirectURI)
+	}
+
+	if newOIDCConfig(Config{ClientID: "holos-console"}) != nil {
+		t.Error("expected no OIDC config without an issuer")
+	}
+}
+
+func TestNamespaceScope_StaticProjects(t *testing.T) {
+	cfg := Config{NamespacePrefix: "holos-", ProjectPrefix: "prj-", StaticProjects: []string{"web", "legacy"}}
+	if _, err := New(cfg).namespaceScope(); err != nil {
+		t.Fatalf("expected static projects without namespace restrictions, got %v", err)
+	}
+
+	cfg.NamespaceDenylist = []string{"holos-prj-legacy"}
+	if _, err := New(cfg).namespaceScope(); err == nil || !strings.Contains(err.Error(), `"legacy"`) {
+		t.Errorf("expected a denylisted static project to fail, got %v", err)
+	}
+
+	cfg.NamespaceDenylist = nil
+	cfg.NamespaceAllowlist = []string{"holos-prj-w*"}
+	if _, err := New(cfg).namespaceScope(); err == nil || !strings.Contains(err.Error(), `"legacy"`) {
+		t.Errorf("expected a static project outside the allowlist to fail, got %v", err)
+	}
+
+	cfg.NamespaceAllowlist = []string{"holos-prj-*"}
+	if _, err := New(cfg).namespaceScope(); err != nil {
+		t.Errorf("expected allowlisted static projects to pass, got %v", err)
+	}
+}
+
+func TestShutdown_DrainsInFlightRequests(t *testing.T) {
+	release := make(chan struct{})
+	started := make(chan struct{})
+	ts := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		<-release
+		_, _ = io.WriteString(w, "uploaded")
+	}))
+	ts.Start()
+	defer ts.Close()
+
+	checker := readiness.NewChecker(time.Minute, time.Second)
+	drained := make(chan struct{})
+	drain := func() { close(drained) }
+
+	body := make(chan string, 1)
+	go func() {
+		resp, err := ts.Client().Get(ts.URL)
+		if err != nil {
+			body <- err.Error()
+			return
+		}
+		defer resp.Body.Close()
+		data, _ := io.ReadAll(resp.Body)
+		body <- string(data)
+	}()
+	<-started
+
+	server := New(Config{ShutdownTimeout: 5 * time.Second})
+	done := make(chan error, 1)
+	go func() { done <- server.shutdown(checker, drain, func() {}, ts.Config, nil) }()
+	<-drained
+	if report := checker.Report(); report.Ready || !report.Draining {
+		t.Errorf("expected /readyz to report draining, got %+v", report)
+	}
+	close(release)
+	if got := <-body; got != "uploaded" {
+		t.Errorf("expected the in-flight request to finish, got %q", got)
+	}
+	if err := <-done; err != nil {
+		t.Errorf("shutdown: %v", err)
+	}
+}
@@ -0,0 +1,290 @@
+// Package dashboard implements the DashboardService, which answers the home
+// dashboard's cross-project questions in one call.
+package dashboard
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"time"
+
+	"connectrpc.com/connect"
+	"go.opentelemetry.io/otel/attribute"
+	authv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1alpha2 "github.com/holos-run/holos-console/api/v1alpha2"
+	"github.com/holos-run/holos-console/console/projects"
+	"github.com/holos-run/holos-console/console/rbac"
+	"github.com/holos-run/holos-console/console/resolver"
+	"github.com/holos-run/holos-console/console/rpc"
+	"github.com/holos-run/holos-console/console/secretrbac"
+	"github.com/holos-run/holos-console/console/secrets"
+	"github.com/holos-run/holos-console/console/tracing"
+	"github.com/holos-run/holos-console/console/trash"
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+	"github.com/holos-run/holos-console/gen/holos/console/v1/consolev1connect"
+)
+
+// namespaceVerbs orders the cluster-wide namespace verbs that prove each
+// role, highest first, matching rbac.EffectiveRole.
+var namespaceVerbs = []struct {
+	verb string
+	role rbac.Role
+}{
+	{"delete", rbac.RoleOwner},
+	{"update", rbac.RoleEditor},
+	{"get", rbac.RoleViewer},
+}
+
+// Handler implements consolev1connect.DashboardServiceHandler.
+type Handler struct {
+	consolev1connect.UnimplementedDashboardServiceHandler
+	k8s      kubernetes.Interface
+	cache    ctrlclient.Reader
+	resolver *resolver.Resolver
+	// platformOwners may run GetPrincipalAccessReport.
+	platformOwners rpc.PlatformOwners
+}
+
+// NewHandler returns a DashboardService handler. k8s is the console's
+// service account client; the caller's access is decided from the grants it
+// reads.
+func NewHandler(k8s kubernetes.Interface, r *resolver.Resolver) *Handler {
+	return &Handler{k8s: k8s, resolver: r}
+}
+
+// WithCache reads namespaces from cache, usually the embedded manager's
+// informer-backed client, instead of listing them from the apiserver on
+// every request.
+func (h *Handler) WithCache(cache ctrlclient.Reader) *Handler {
+	h.cache = cache
+	return h
+}
+
+// WithPlatformOwners sets the platform owners, who may report on any
+// principal's access with GetPrincipalAccessReport.
+func (h *Handler) WithPlatformOwners(owners rpc.PlatformOwners) *Handler {
+	h.platformOwners = owners
+	return h
+}
+
+// ListAccessibleResources returns the organizations, projects, and secrets
+// the caller holds a role on.
+func (h *Handler) ListAccessibleResources(
+	ctx context.Context,
+	req *connect.Request[consolev1.ListAccessibleResourcesRequest],
+) (*connect.Response[consolev1.ListAccessibleResourcesResponse], error) {
+	claims := rpc.MustClaims(ctx)
+	want := func(t consolev1.AccessibleResourceType, permission consolev1.Permission) bool {
+		return (len(req.Msg.Types) == 0 || slices.Contains(req.Msg.Types, t)) && claims.Allows(permission)
+	}
+	wantOrgs := want(consolev1.AccessibleResourceType_ACCESSIBLE_RESOURCE_TYPE_ORGANIZATION, consolev1.Permission_PERMISSION_ORGANIZATIONS_LIST)
+	wantProjects := want(consolev1.AccessibleResourceType_ACCESSIBLE_RESOURCE_TYPE_PROJECT, consolev1.Permission_PERMISSION_PROJECTS_LIST)
+	wantSecrets := want(consolev1.AccessibleResourceType_ACCESSIBLE_RESOURCE_TYPE_SECRET, consolev1.Permission_PERMISSION_SECRETS_LIST)
+
+	namespaces, err := h.listNamespaces(ctx)
+	if err != nil {
+		return nil, rpc.MapK8sError(err)
+	}
+	floor, err := clusterRole(ctx)
+	if err != nil {
+		return nil, rpc.MapK8sError(err)
+	}
+
+	now := time.Now()
+	var orgs, projs []*consolev1.AccessibleResource
+	projectsByNamespace := map[string]*consolev1.AccessibleResource{}
+	for i := range namespaces {
+		ns := &namespaces[i]
+		if ns.DeletionTimestamp != nil || trash.IsDeleted(ns) {
+			continue
+		}
+		role := namespaceRole(claims, ns, floor, now)
+		switch ns.Labels[v1alpha2.LabelResourceType] {
+		case v1alpha2.ResourceTypeOrganization:
+			name, err := h.resolver.OrgFromNamespace(ns.Name)
+			if err != nil || !wantOrgs || role == rbac.RoleUnspecified {
+				continue
+			}
+			orgs = append(orgs, &consolev1.AccessibleResource{
+				Type:         consolev1.AccessibleResourceType_ACCESSIBLE_RESOURCE_TYPE_ORGANIZATION,
+				Name:         name,
+				DisplayName:  ns.Annotations[v1alpha2.AnnotationDisplayName],
+				Organization: name,
+				Role:         role,
+			})
+		case v1alpha2.ResourceTypeProject:
+			name, err := h.resolver.ProjectFromNamespace(ns.Name)
+			if err != nil {
+				continue
+			}
+			project := &consolev1.AccessibleResource{
+				Type:         consolev1.AccessibleResourceType_ACCESSIBLE_RESOURCE_TYPE_PROJECT,
+				Name:         name,
+				DisplayName:  ns.Annotations[v1alpha2.AnnotationDisplayName],
+				Organization: ns.Labels[v1alpha2.LabelOrganization],
+				Project:      name,
+				Role:         role,
+			}
+			// Secrets are shared per project, so a caller without a role on
+			// the project may still hold one on its secrets.
+			projectsByNamespace[ns.Name] = project
+			if wantProjects && role != rbac.RoleUnspecified {
+				projs = append(projs, project)
+			}
+		}
+	}
+
+	var secretList []*consolev1.AccessibleResource
+	if wantSecrets && len(projectsByNamespace) > 0 {
+		if secretList, err = h.listSecrets(ctx, claims, projectsByNamespace, now); err != nil {
+			return nil, rpc.MapK8sError(err)
+		}
+	}
+
+	sortResources(orgs)
+	sortResources(projs)
+	sortResources(secretList)
+	resources := slices.Concat(orgs, projs, secretList)
+
+	slog.InfoContext(ctx, "accessible resources listed",
+		slog.String("action", "accessible_resources_list"),
+		slog.String("resource_type", "dashboard"),
+		slog.String("sub", claims.Sub),
+		slog.String("email", claims.Email),
+		slog.Int("organizations", len(orgs)),
+		slog.Int("projects", len(projs)),
+		slog.Int("secrets", len(secretList)),
+	)
+	return connect.NewResponse(&consolev1.ListAccessibleResourcesResponse{Resources: resources}), nil
+}
+
+// listNamespaces returns the console-managed namespaces, from the cache when
+// one is configured.
+func (h *Handler) listNamespaces(ctx context.Context) ([]corev1.Namespace, error) {
+	ctx, span := tracing.Start(ctx, "dashboard.Handler.listNamespaces", attribute.Bool("cached", h.cache != nil))
+	defer span.End()
+	selector := labels.Set{v1alpha2.LabelManagedBy: v1alpha2.ManagedByValue}
+	if h.cache != nil {
+		list := &corev1.NamespaceList{}
+		if err := h.cache.List(ctx, list, ctrlclient.MatchingLabels(selector)); err != nil {
+			return nil, err
+		}
+		return list.Items, nil
+	}
+	list, err := h.k8s.CoreV1().Namespaces().List(ctx, metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// listSecrets returns the secrets in projectsByNamespace the caller holds a secret
+// sharing role on and is not denied. The secrets and the sharing bindings
+// are each read with one cluster-wide list.
+func (h *Handler) listSecrets(ctx context.Context, claims *rpc.Claims, projectsByNamespace map[string]*consolev1.AccessibleResource, now time.Time) ([]*consolev1.AccessibleResource, error) {
+	ctx, span := tracing.Start(ctx, "dashboard.Handler.listSecrets", attribute.Int("projects", len(projectsByNamespace)))
+	defer span.End()
+	bindingList, err := h.k8s.RbacV1().RoleBindings(metav1.NamespaceAll).List(ctx, metav1.ListOptions{
+		LabelSelector: labels.Set{
+			v1alpha2.LabelManagedBy:     v1alpha2.ManagedByValue,
+			secretrbac.LabelRolePurpose: secretrbac.RolePurposeProjectSecrets,
+		}.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	bindings := map[string][]rbacv1.RoleBinding{}
+	for _, binding := range bindingList.Items {
+		bindings[binding.Namespace] = append(bindings[binding.Namespace], binding)
+	}
+	roles := map[string]rbac.Role{}
+	for ns := range projectsByNamespace {
+		shareUsers, shareRoles := secrets.SharingFromRoleBindings(bindings[ns])
+		// User bindings name the OIDC subject Kubernetes sees; grants are
+		// matched by email.
+		for i := range shareUsers {
+			if claims.Sub != "" && shareUsers[i].Principal == claims.Sub {
+				shareUsers[i].Principal = claims.Email
+			}
+		}
+		roles[ns] = rbac.BestRoleFromGrants(claims.Email, claims.EmailVerified, claims.Roles, secrets.ActiveGrantsMap(shareUsers, now), secrets.ActiveGrantsMap(shareRoles, now))
+	}
+
+	secretList, err := h.k8s.CoreV1().Secrets(metav1.NamespaceAll).List(ctx, metav1.ListOptions{
+		LabelSelector: v1alpha2.LabelManagedBy + "=" + v1alpha2.ManagedByValue + "," +
+			v1alpha2.LabelDeleted + "!=" + v1alpha2.DeletedValue,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var result []*consolev1.AccessibleResource
+	for i := range secretList.Items {
+		secret := &secretList.Items[i]
+		project, ok := projectsByNamespace[secret.Namespace]
+		role := roles[secret.Namespace]
+		if !ok || role == rbac.RoleUnspecified || secrets.DenyGrantMatches(secret, claims.Email, claims.Sub, claims.EmailVerified, claims.Roles, now) {
+			continue
+		}
+		result = append(result, &consolev1.AccessibleResource{
+			Type:         consolev1.AccessibleResourceType_ACCESSIBLE_RESOURCE_TYPE_SECRET,
+			Name:         secret.Name,
+			Organization: project.Organization,
+			Project:      project.Name,
+			Role:         role,
+		})
+	}
+	return result, nil
+}
+
+// namespaceRole returns the caller's role on an organization or project
+// namespace: the best of its active share grants and floor, or none when a
+// deny grant matches.
+func namespaceRole(claims *rpc.Claims, ns *corev1.Namespace, floor rbac.Role, now time.Time) rbac.Role {
+	shareUsers, _ := projects.GetShareUsers(ns)
+	shareRoles, _ := projects.GetShareRoles(ns)
+	users, roles := secrets.ActiveGrantsMap(shareUsers, now), secrets.ActiveGrantsMap(shareRoles, now)
+	if rbac.DeniedByGrants(claims.Email, claims.EmailVerified, claims.Roles, users, roles) {
+		return rbac.RoleUnspecified
+	}
+	role := rbac.BestRoleFromGrants(claims.Email, claims.EmailVerified, claims.Roles, users, roles)
+	if rbac.RoleLevel(floor) > rbac.RoleLevel(role) {
+		return floor
+	}
+	return role
+}
+
+// clusterRole returns the role the caller holds on every namespace through
+// cluster-wide RBAC, such as a platform administrator's ClusterRoleBinding.
+// It costs at most one SelfSubjectAccessReview per role and is skipped when
+// the request carries no impersonated clients.
+func clusterRole(ctx context.Context) (rbac.Role, error) {
+	if !rpc.HasImpersonatedClients(ctx) {
+		return rbac.RoleUnspecified, nil
+	}
+	client := rpc.ImpersonatedClientsetFromContext(ctx)
+	for _, nv := range namespaceVerbs {
+		review, err := client.AuthorizationV1().SelfSubjectAccessReviews().Create(ctx, &authv1.SelfSubjectAccessReview{
+			Spec: authv1.SelfSubjectAccessReviewSpec{
+				ResourceAttributes: &authv1.ResourceAttributes{Verb: nv.verb, Resource: "namespaces"},
+			},
+		}, metav1.CreateOptions{})
+		if err != nil {
+			return rbac.RoleUnspecified, err
+		}
+		if review.Status.Allowed {
+			return nv.role, nil
+		}
+	}
+	return rbac.RoleUnspecified, nil
+}
+
+func sortResources(resources []*consolev1.AccessibleResource) {
+	slices.SortFunc(resources, compareResources)
+}
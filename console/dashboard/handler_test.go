@@ -0,0 +1,126 @@
+package dashboard
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+
+	v1alpha2 "github.com/holos-run/holos-console/api/v1alpha2"
+	"github.com/holos-run/holos-console/console/resolver"
+	"github.com/holos-run/holos-console/console/rpc"
+	"github.com/holos-run/holos-console/console/secretrbac"
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+)
+
+func testResolver() *resolver.Resolver {
+	return &resolver.Resolver{NamespacePrefix: "holos-", OrganizationPrefix: "org-", FolderPrefix: "fld-", ProjectPrefix: "prj-"}
+}
+
+func namespace(name, resourceType, org, shareUsers string) *corev1.Namespace {
+	return &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+		Name: name,
+		Labels: map[string]string{
+			v1alpha2.LabelManagedBy:    v1alpha2.ManagedByValue,
+			v1alpha2.LabelResourceType: resourceType,
+			v1alpha2.LabelOrganization: org,
+		},
+		Annotations: map[string]string{v1alpha2.AnnotationShareUsers: shareUsers},
+	}}
+}
+
+func secret(namespace, name string, annotations map[string]string) *corev1.Secret {
+	return &corev1.Secret{ObjectMeta: metav1.ObjectMeta{
+		Name:        name,
+		Namespace:   namespace,
+		Labels:      map[string]string{v1alpha2.LabelManagedBy: v1alpha2.ManagedByValue},
+		Annotations: annotations,
+	}}
+}
+
+func TestListAccessibleResources(t *testing.T) {
+	objects := []runtime.Object{
+		namespace("holos-org-acme", v1alpha2.ResourceTypeOrganization, "acme", `[{"principal":"alice@example.com","role":"owner"}]`),
+		namespace("holos-prj-web", v1alpha2.ResourceTypeProject, "acme", `[{"principal":"alice@example.com","role":"viewer"}]`),
+		namespace("holos-prj-api", v1alpha2.ResourceTypeProject, "acme", `[{"principal":"bob@example.com","role":"owner"}]`),
+		namespace("holos-prj-ops", v1alpha2.ResourceTypeProject, "acme", `[{"principal":"alice@example.com","role":"deny"},{"principal":"bob@example.com","role":"owner"}]`),
+		secret("holos-prj-web", "db", nil),
+		secret("holos-prj-web", "root", map[string]string{v1alpha2.AnnotationShareDenyUsers: `[{"principal":"alice@example.com","role":"deny"}]`}),
+		secret("holos-prj-api", "token", nil),
+		secret("holos-prj-ops", "pager", nil),
+		secretrbac.RoleBinding("holos-prj-web", secretrbac.ShareTargetUser, "alice-sub", secretrbac.RoleEditor, nil),
+		secretrbac.RoleBinding("holos-prj-api", secretrbac.ShareTargetGroup, "devs", secretrbac.RoleViewer, nil),
+	}
+	h := NewHandler(fake.NewClientset(objects...), testResolver())
+	ctx := rpc.ContextWithClaims(context.Background(), &rpc.Claims{
+		Sub:   "alice-sub",
+		Email: "alice@example.com",
+		Roles: []string{"devs"},
+	})
+
+	resp, err := h.ListAccessibleResources(ctx, connect.NewRequest(&consolev1.ListAccessibleResourcesRequest{}))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	type entry struct {
+		Type    consolev1.AccessibleResourceType
+		Project string
+		Name    string
+		Role    consolev1.Role
+	}
+	want := []entry{
+		{consolev1.AccessibleResourceType_ACCESSIBLE_RESOURCE_TYPE_ORGANIZATION, "", "acme", consolev1.Role_ROLE_OWNER},
+		{consolev1.AccessibleResourceType_ACCESSIBLE_RESOURCE_TYPE_PROJECT, "web", "web", consolev1.Role_ROLE_VIEWER},
+		// Secret sharing on api reaches the caller through the devs group
+		// even without a role on the project.
+		{consolev1.AccessibleResourceType_ACCESSIBLE_RESOURCE_TYPE_SECRET, "api", "token", consolev1.Role_ROLE_VIEWER},
+		{consolev1.AccessibleResourceType_ACCESSIBLE_RESOURCE_TYPE_SECRET, "web", "db", consolev1.Role_ROLE_EDITOR},
+	}
+	var got []entry
+	for _, r := range resp.Msg.Resources {
+		got = append(got, entry{r.Type, r.Project, r.Name, r.Role})
+		if r.Organization != "acme" {
+			t.Errorf("expected organization acme on %s, got %q", r.Name, r.Organization)
+		}
+	}
+	if len(got) != len(want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("resource %d: expected %v, got %v", i, want[i], got[i])
+		}
+	}
+
+	t.Run("filters by type", func(t *testing.T) {
+		resp, err := h.ListAccessibleResources(ctx, connect.NewRequest(&consolev1.ListAccessibleResourcesRequest{
+			Types: []consolev1.AccessibleResourceType{consolev1.AccessibleResourceType_ACCESSIBLE_RESOURCE_TYPE_PROJECT},
+		}))
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(resp.Msg.Resources) != 1 || resp.Msg.Resources[0].Name != "web" {
+			t.Errorf("expected only project web, got %v", resp.Msg.Resources)
+		}
+	})
+
+	t.Run("honors token scope", func(t *testing.T) {
+		scoped := rpc.ContextWithClaims(context.Background(), &rpc.Claims{
+			Sub:         "alice-sub",
+			Email:       "alice@example.com",
+			Roles:       []string{"devs"},
+			Permissions: []consolev1.Permission{consolev1.Permission_PERMISSION_ORGANIZATIONS_LIST},
+		})
+		resp, err := h.ListAccessibleResources(scoped, connect.NewRequest(&consolev1.ListAccessibleResourcesRequest{}))
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(resp.Msg.Resources) != 1 || resp.Msg.Resources[0].Name != "acme" {
+			t.Errorf("expected only organization acme, got %v", resp.Msg.Resources)
+		}
+	})
+}
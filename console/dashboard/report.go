@@ -0,0 +1,331 @@
+package dashboard
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"log/slog"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"go.opentelemetry.io/otel/attribute"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	v1alpha2 "github.com/holos-run/holos-console/api/v1alpha2"
+	"github.com/holos-run/holos-console/console/principal"
+	"github.com/holos-run/holos-console/console/projects"
+	"github.com/holos-run/holos-console/console/rbac"
+	"github.com/holos-run/holos-console/console/rpc"
+	"github.com/holos-run/holos-console/console/secretrbac"
+	"github.com/holos-run/holos-console/console/secrets"
+	"github.com/holos-run/holos-console/console/tracing"
+	"github.com/holos-run/holos-console/console/trash"
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+)
+
+// resourceTypeSecret is the RoleSource resource_type of key grants.
+const resourceTypeSecret = "secret"
+
+// GetPrincipalAccessReport lists everything a user or group holds a role on
+// through the console's sharing grants. Only platform owners may report on
+// another principal.
+func (h *Handler) GetPrincipalAccessReport(
+	ctx context.Context,
+	req *connect.Request[consolev1.GetPrincipalAccessReportRequest],
+) (*connect.Response[consolev1.GetPrincipalAccessReportResponse], error) {
+	claims := rpc.MustClaims(ctx)
+	subject, err := reportSubject(req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	if !h.platformOwners.Includes(claims) {
+		slog.WarnContext(ctx, "principal access report denied",
+			slog.String("action", "principal_access_report_denied"),
+			slog.String("resource_type", "dashboard"),
+			slog.String("principal", req.Msg.Principal),
+			slog.String("sub", claims.Sub),
+			slog.String("email", claims.Email),
+		)
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("only platform owners may report on another principal's access"))
+	}
+
+	namespaces, err := h.listNamespaces(ctx)
+	if err != nil {
+		return nil, rpc.MapK8sError(err)
+	}
+	now := time.Now()
+	var orgs, folders, projs []*consolev1.PrincipalAccess
+	projectsByNamespace := map[string]*consolev1.AccessibleResource{}
+	for i := range namespaces {
+		ns := &namespaces[i]
+		if ns.DeletionTimestamp != nil || trash.IsDeleted(ns) {
+			continue
+		}
+		resource := h.reportResource(ns)
+		if resource == nil {
+			continue
+		}
+		if resource.Type == consolev1.AccessibleResourceType_ACCESSIBLE_RESOURCE_TYPE_PROJECT {
+			projectsByNamespace[ns.Name] = resource
+		}
+		shareUsers, _ := projects.GetShareUsers(ns)
+		shareRoles, _ := projects.GetShareRoles(ns)
+		access := evaluateGrants(subject, resource, rbac.GrantScope{
+			ResourceType: ns.Labels[v1alpha2.LabelResourceType],
+			ResourceName: resource.Name,
+			Users:        secrets.ActiveGrantsMap(shareUsers, now),
+			Roles:        secrets.ActiveGrantsMap(shareRoles, now),
+		})
+		if access == nil {
+			continue
+		}
+		switch resource.Type {
+		case consolev1.AccessibleResourceType_ACCESSIBLE_RESOURCE_TYPE_ORGANIZATION:
+			orgs = append(orgs, access)
+		case consolev1.AccessibleResourceType_ACCESSIBLE_RESOURCE_TYPE_FOLDER:
+			folders = append(folders, access)
+		case consolev1.AccessibleResourceType_ACCESSIBLE_RESOURCE_TYPE_PROJECT:
+			projs = append(projs, access)
+		}
+	}
+
+	var secretAccess []*consolev1.PrincipalAccess
+	if len(projectsByNamespace) > 0 {
+		if secretAccess, err = h.reportSecrets(ctx, subject, projectsByNamespace, now); err != nil {
+			return nil, rpc.MapK8sError(err)
+		}
+	}
+
+	for _, list := range [][]*consolev1.PrincipalAccess{orgs, folders, projs, secretAccess} {
+		slices.SortFunc(list, func(a, b *consolev1.PrincipalAccess) int {
+			return compareResources(a.Resource, b.Resource)
+		})
+	}
+	access := slices.Concat(orgs, folders, projs, secretAccess)
+
+	slog.InfoContext(ctx, "principal access reported",
+		slog.String("action", "principal_access_report"),
+		slog.String("resource_type", "dashboard"),
+		slog.String("principal", req.Msg.Principal),
+		slog.String("principal_kind", req.Msg.Kind.String()),
+		slog.String("sub", claims.Sub),
+		slog.String("email", claims.Email),
+		slog.Int("resources", len(access)),
+	)
+	return connect.NewResponse(&consolev1.GetPrincipalAccessReportResponse{Access: access}), nil
+}
+
+// reportSubject returns the principal to report on as the claims it would
+// present: a user's email, subject, and groups, or a group alone. The email
+// is taken as verified, so the report covers the domain grants that reach
+// the user once their identity provider verifies it.
+func reportSubject(msg *consolev1.GetPrincipalAccessReportRequest) (*rpc.Claims, error) {
+	name := strings.TrimPrefix(strings.TrimSpace(msg.Principal), "oidc:")
+	if name == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("principal is required"))
+	}
+	switch msg.Kind {
+	case consolev1.PrincipalKind_PRINCIPAL_KIND_USER:
+		groups := make([]string, 0, len(msg.Groups))
+		for _, g := range msg.Groups {
+			if g = strings.TrimPrefix(strings.TrimSpace(g), "oidc:"); g != "" {
+				groups = append(groups, g)
+			}
+		}
+		return &rpc.Claims{Sub: msg.Subject, Email: name, EmailVerified: true, Roles: groups}, nil
+	case consolev1.PrincipalKind_PRINCIPAL_KIND_GROUP:
+		return &rpc.Claims{Roles: []string{name}}, nil
+	}
+	return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("kind must be a user or a group"))
+}
+
+// reportResource describes an organization, folder, or project namespace,
+// or returns nil for any other namespace.
+func (h *Handler) reportResource(ns *corev1.Namespace) *consolev1.AccessibleResource {
+	resource := &consolev1.AccessibleResource{
+		DisplayName:  ns.Annotations[v1alpha2.AnnotationDisplayName],
+		Organization: ns.Labels[v1alpha2.LabelOrganization],
+	}
+	var err error
+	switch ns.Labels[v1alpha2.LabelResourceType] {
+	case v1alpha2.ResourceTypeOrganization:
+		resource.Type = consolev1.AccessibleResourceType_ACCESSIBLE_RESOURCE_TYPE_ORGANIZATION
+		resource.Name, err = h.resolver.OrgFromNamespace(ns.Name)
+		resource.Organization = resource.Name
+	case v1alpha2.ResourceTypeFolder:
+		resource.Type = consolev1.AccessibleResourceType_ACCESSIBLE_RESOURCE_TYPE_FOLDER
+		resource.Name, err = h.resolver.FolderFromNamespace(ns.Name)
+	case v1alpha2.ResourceTypeProject:
+		resource.Type = consolev1.AccessibleResourceType_ACCESSIBLE_RESOURCE_TYPE_PROJECT
+		resource.Name, err = h.resolver.ProjectFromNamespace(ns.Name)
+		resource.Project = resource.Name
+	default:
+		return nil
+	}
+	if err != nil {
+		return nil
+	}
+	return resource
+}
+
+// reportSecrets returns the secrets in projectsByNamespace the subject holds
+// a role on, through its project's secret sharing or a key grant, and is not
+// denied. As for listSecrets, the secrets and the sharing bindings are each
+// read with one cluster-wide list.
+func (h *Handler) reportSecrets(ctx context.Context, subject *rpc.Claims, projectsByNamespace map[string]*consolev1.AccessibleResource, now time.Time) ([]*consolev1.PrincipalAccess, error) {
+	ctx, span := tracing.Start(ctx, "dashboard.Handler.reportSecrets", attribute.Int("projects", len(projectsByNamespace)))
+	defer span.End()
+	bindingList, err := h.k8s.RbacV1().RoleBindings(metav1.NamespaceAll).List(ctx, metav1.ListOptions{
+		LabelSelector: labels.Set{
+			v1alpha2.LabelManagedBy:     v1alpha2.ManagedByValue,
+			secretrbac.LabelRolePurpose: secretrbac.RolePurposeProjectSecrets,
+		}.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	bindings := map[string][]rbacv1.RoleBinding{}
+	for _, binding := range bindingList.Items {
+		bindings[binding.Namespace] = append(bindings[binding.Namespace], binding)
+	}
+	sharing := map[string]*consolev1.PrincipalAccess{}
+	for ns, project := range projectsByNamespace {
+		shareUsers, shareRoles := secrets.SharingFromRoleBindings(bindings[ns])
+		for i := range shareUsers {
+			if subject.Sub != "" && shareUsers[i].Principal == subject.Sub {
+				shareUsers[i].Principal = subject.Email
+			}
+		}
+		sharing[ns] = evaluateGrants(subject, project, rbac.GrantScope{
+			ResourceType: v1alpha2.ResourceTypeProject,
+			ResourceName: project.Name,
+			Users:        secrets.ActiveGrantsMap(shareUsers, now),
+			Roles:        secrets.ActiveGrantsMap(shareRoles, now),
+		})
+	}
+
+	secretList, err := h.k8s.CoreV1().Secrets(metav1.NamespaceAll).List(ctx, metav1.ListOptions{
+		LabelSelector: v1alpha2.LabelManagedBy + "=" + v1alpha2.ManagedByValue + "," +
+			v1alpha2.LabelDeleted + "!=" + v1alpha2.DeletedValue,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var result []*consolev1.PrincipalAccess
+	for i := range secretList.Items {
+		secret := &secretList.Items[i]
+		project, ok := projectsByNamespace[secret.Namespace]
+		if !ok || secrets.DenyGrantMatches(secret, subject.Email, subject.Sub, subject.EmailVerified, subject.Roles, now) {
+			continue
+		}
+		access := &consolev1.PrincipalAccess{Resource: &consolev1.AccessibleResource{
+			Type:         consolev1.AccessibleResourceType_ACCESSIBLE_RESOURCE_TYPE_SECRET,
+			Name:         secret.Name,
+			Organization: project.Organization,
+			Project:      project.Name,
+		}}
+		if shared := sharing[secret.Namespace]; shared != nil {
+			access.Resource.Role = shared.Resource.Role
+			access.Sources = slices.Clone(shared.Sources)
+		}
+		keySources, keys := keyGrants(subject, secret, now)
+		access.Sources = append(access.Sources, keySources...)
+		if access.Resource.Role == consolev1.Role_ROLE_UNSPECIFIED {
+			if len(keys) == 0 {
+				continue
+			}
+			access.Resource.Role = rbac.RoleViewer
+			access.Keys = keys
+		}
+		result = append(result, access)
+	}
+	return result, nil
+}
+
+// evaluateGrants returns the subject's access to resource through the active
+// grants of scope, or nil when it holds no role or a deny grant matches.
+// A group has no email, so no user grant, not even one to every user, counts
+// as a grant to the group.
+func evaluateGrants(subject *rpc.Claims, resource *consolev1.AccessibleResource, scope rbac.GrantScope) *consolev1.PrincipalAccess {
+	role := rbac.BestRoleFromGrants(subject.Email, subject.EmailVerified, subject.Roles, scope.Users, scope.Roles)
+	if role == rbac.RoleUnspecified {
+		return nil
+	}
+	var sources []*consolev1.RoleSource
+	// Only the most specific kind of user grant that names the subject
+	// counts toward the role, so broader ones are not sources.
+	_, tier := rbac.MatchedUserGrant(subject.Email, subject.EmailVerified, scope.Users)
+	for _, p := range slices.Sorted(maps.Keys(scope.Users)) {
+		if tier != principal.NoMatch && scope.Users[p] != rbac.DenyRole && principal.Matches(p, subject.Email, subject.EmailVerified) == tier {
+			sources = append(sources, grantSource(consolev1.RoleSourceType_ROLE_SOURCE_TYPE_USER_GRANT, p, scope.ResourceType, scope.ResourceName))
+		}
+	}
+	for _, p := range slices.Sorted(maps.Keys(scope.Roles)) {
+		if scope.Roles[p] != rbac.DenyRole && slices.ContainsFunc(subject.Roles, func(g string) bool { return rbac.GroupPrincipalMatches(p, g) }) {
+			sources = append(sources, grantSource(consolev1.RoleSourceType_ROLE_SOURCE_TYPE_GROUP_GRANT, p, scope.ResourceType, scope.ResourceName))
+		}
+	}
+	return &consolev1.PrincipalAccess{
+		Resource: &consolev1.AccessibleResource{
+			Type:         resource.Type,
+			Name:         resource.Name,
+			DisplayName:  resource.DisplayName,
+			Organization: resource.Organization,
+			Project:      resource.Project,
+			Role:         role,
+		},
+		Sources: sources,
+	}
+}
+
+// keyGrants returns the active key grants on secret naming the subject and
+// the keys they cover, sorted.
+func keyGrants(subject *rpc.Claims, secret *corev1.Secret, now time.Time) ([]*consolev1.RoleSource, []string) {
+	keyUsers, _ := secrets.GetKeyShareUsers(secret)
+	keyRoles, _ := secrets.GetKeyShareRoles(secret)
+	var sources []*consolev1.RoleSource
+	var keys []string
+	add := func(kind consolev1.RoleSourceType, g secrets.AnnotationGrant) {
+		if _, active := secrets.ActiveGrantsMap([]secrets.AnnotationGrant{g}, now)[g.Principal]; !active || g.Deny {
+			return
+		}
+		sources = append(sources, grantSource(kind, g.Principal, resourceTypeSecret, secret.Name))
+		keys = append(keys, g.Keys...)
+	}
+	for _, g := range keyUsers {
+		p := strings.TrimPrefix(g.Principal, "oidc:")
+		if (subject.Email != "" && strings.EqualFold(p, subject.Email)) || (subject.Sub != "" && p == subject.Sub) {
+			add(consolev1.RoleSourceType_ROLE_SOURCE_TYPE_USER_GRANT, g)
+		}
+	}
+	for _, g := range keyRoles {
+		if slices.ContainsFunc(subject.Roles, func(group string) bool { return rbac.GroupPrincipalMatches(g.Principal, group) }) {
+			add(consolev1.RoleSourceType_ROLE_SOURCE_TYPE_GROUP_GRANT, g)
+		}
+	}
+	slices.Sort(keys)
+	return sources, slices.Compact(keys)
+}
+
+func grantSource(kind consolev1.RoleSourceType, name, resourceType, resourceName string) *consolev1.RoleSource {
+	return &consolev1.RoleSource{
+		Type:         kind,
+		Principal:    name,
+		ResourceType: resourceType,
+		ResourceName: resourceName,
+	}
+}
+
+func compareResources(a, b *consolev1.AccessibleResource) int {
+	return cmp.Or(
+		cmp.Compare(a.Organization, b.Organization),
+		cmp.Compare(a.Project, b.Project),
+		cmp.Compare(a.Name, b.Name),
+	)
+}
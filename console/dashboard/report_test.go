@@ -0,0 +1,105 @@
+package dashboard
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"connectrpc.com/connect"
+	"k8s.io/client-go/kubernetes/fake"
+
+	v1alpha2 "github.com/holos-run/holos-console/api/v1alpha2"
+	"github.com/holos-run/holos-console/console/rpc"
+	"github.com/holos-run/holos-console/console/secretrbac"
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+)
+
+func TestGetPrincipalAccessReport(t *testing.T) {
+	acme := namespace("holos-org-acme", v1alpha2.ResourceTypeOrganization, "acme", `[{"principal":"*@example.com","role":"viewer"}]`)
+	acme.Annotations[v1alpha2.AnnotationShareRoles] = `[{"principal":"devs","role":"editor"}]`
+	client := fake.NewClientset(
+		acme,
+		namespace("holos-fld-eng", v1alpha2.ResourceTypeFolder, "acme", `[{"principal":"Bob@example.com","role":"owner"}]`),
+		namespace("holos-prj-web", v1alpha2.ResourceTypeProject, "acme", `[{"principal":"bob@example.com","role":"viewer"}]`),
+		namespace("holos-prj-ops", v1alpha2.ResourceTypeProject, "acme", `[{"principal":"bob@example.com","role":"deny"},{"principal":"alice@example.com","role":"owner"}]`),
+		secret("holos-prj-web", "db", nil),
+		secret("holos-prj-web", "root", map[string]string{v1alpha2.AnnotationShareDenyUsers: `[{"principal":"bob@example.com","role":"deny"}]`}),
+		secret("holos-prj-ops", "pager", map[string]string{v1alpha2.AnnotationShareKeyUsers: `[{"principal":"bob@example.com","role":"viewer","keys":["url"]}]`}),
+		secretrbac.RoleBinding("holos-prj-web", secretrbac.ShareTargetUser, "bob-sub", secretrbac.RoleEditor, nil),
+	)
+	h := NewHandler(client, testResolver()).WithPlatformOwners(rpc.PlatformOwners{Users: []string{"admin@example.com"}})
+	admin := rpc.ContextWithClaims(context.Background(), &rpc.Claims{Sub: "admin-sub", Email: "admin@example.com"})
+
+	type entry struct {
+		Type    consolev1.AccessibleResourceType
+		Project string
+		Name    string
+		Role    consolev1.Role
+		Sources int
+		Keys    []string
+	}
+	report := func(t *testing.T, req *consolev1.GetPrincipalAccessReportRequest) []entry {
+		t.Helper()
+		resp, err := h.GetPrincipalAccessReport(admin, connect.NewRequest(req))
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		var got []entry
+		for _, a := range resp.Msg.Access {
+			got = append(got, entry{a.Resource.Type, a.Resource.Project, a.Resource.Name, a.Resource.Role, len(a.Sources), a.Keys})
+		}
+		return got
+	}
+	check := func(t *testing.T, got, want []entry) {
+		t.Helper()
+		if len(got) != len(want) {
+			t.Fatalf("expected %v, got %v", want, got)
+		}
+		for i := range want {
+			if got[i].Type != want[i].Type || got[i].Project != want[i].Project || got[i].Name != want[i].Name ||
+				got[i].Role != want[i].Role || got[i].Sources != want[i].Sources || !slices.Equal(got[i].Keys, want[i].Keys) {
+				t.Errorf("entry %d: expected %v, got %v", i, want[i], got[i])
+			}
+		}
+	}
+
+	t.Run("reports a user's grants, groups, and key grants", func(t *testing.T) {
+		got := report(t, &consolev1.GetPrincipalAccessReportRequest{
+			Principal: "bob@example.com",
+			Kind:      consolev1.PrincipalKind_PRINCIPAL_KIND_USER,
+			Subject:   "bob-sub",
+			Groups:    []string{"devs"},
+		})
+		check(t, got, []entry{
+			// The domain grant and the devs group both apply.
+			{consolev1.AccessibleResourceType_ACCESSIBLE_RESOURCE_TYPE_ORGANIZATION, "", "acme", consolev1.Role_ROLE_EDITOR, 2, nil},
+			{consolev1.AccessibleResourceType_ACCESSIBLE_RESOURCE_TYPE_FOLDER, "", "eng", consolev1.Role_ROLE_OWNER, 1, nil},
+			{consolev1.AccessibleResourceType_ACCESSIBLE_RESOURCE_TYPE_PROJECT, "web", "web", consolev1.Role_ROLE_VIEWER, 1, nil},
+			// Denied on the ops project, yet a key grant reaches pager; the
+			// deny grant on root hides it.
+			{consolev1.AccessibleResourceType_ACCESSIBLE_RESOURCE_TYPE_SECRET, "ops", "pager", consolev1.Role_ROLE_VIEWER, 1, []string{"url"}},
+			{consolev1.AccessibleResourceType_ACCESSIBLE_RESOURCE_TYPE_SECRET, "web", "db", consolev1.Role_ROLE_EDITOR, 1, nil},
+		})
+	})
+
+	t.Run("reports a group without grants to every user", func(t *testing.T) {
+		got := report(t, &consolev1.GetPrincipalAccessReportRequest{
+			Principal: "oidc:devs",
+			Kind:      consolev1.PrincipalKind_PRINCIPAL_KIND_GROUP,
+		})
+		check(t, got, []entry{
+			{consolev1.AccessibleResourceType_ACCESSIBLE_RESOURCE_TYPE_ORGANIZATION, "", "acme", consolev1.Role_ROLE_EDITOR, 1, nil},
+		})
+	})
+
+	t.Run("requires a platform owner", func(t *testing.T) {
+		ctx := rpc.ContextWithClaims(context.Background(), &rpc.Claims{Sub: "alice-sub", Email: "alice@example.com"})
+		_, err := h.GetPrincipalAccessReport(ctx, connect.NewRequest(&consolev1.GetPrincipalAccessReportRequest{
+			Principal: "bob@example.com",
+			Kind:      consolev1.PrincipalKind_PRINCIPAL_KIND_USER,
+		}))
+		if connect.CodeOf(err) != connect.CodePermissionDenied {
+			t.Fatalf("expected PermissionDenied, got %v", err)
+		}
+	})
+}
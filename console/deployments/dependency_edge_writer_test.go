@@ -82,19 +82,6 @@ func TestHandler_GetDependencyEdgeCascadeDelete(t *testing.T) {
 		}
 	})
 
-	t.Run("unauthenticated rejected", func(t *testing.T) {
-		fakeClient := fake.NewClientset(projectNS("my-project"))
-		h := defaultHandler(fakeClient, &stubProjectResolver{}).WithDependencyEdgeWriter(&stubDependencyEdgeWriter{})
-
-		_, err := h.GetDependencyEdgeCascadeDelete(context.Background(), connect.NewRequest(&consolev1.GetDependencyEdgeCascadeDeleteRequest{
-			Project:           "my-project",
-			OriginatingObject: newOriginating(KindTemplateDependency, "prj-other", "edge-1"),
-		}))
-		if got := connect.CodeOf(err); got != connect.CodeUnauthenticated {
-			t.Errorf("code = %v, want Unauthenticated", got)
-		}
-	})
-
 	// Per HOL-1033 + ADR 036, in-process project-grant authorization was
 	// removed in favor of native K8s RBAC via the OIDC-impersonated client.
 	// PermissionDenied for unauthorized callers now flows back from the API
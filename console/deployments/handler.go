@@ -6,7 +6,6 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
-	"regexp"
 	"strconv"
 	"strings"
 	"time"
@@ -19,7 +18,9 @@ import (
 
 	v1alpha2 "github.com/holos-run/holos-console/api/v1alpha2"
 	"github.com/holos-run/holos-console/console/deployments/statuscache"
+	"github.com/holos-run/holos-console/console/listfilter"
 	"github.com/holos-run/holos-console/console/rpc"
+	"github.com/holos-run/holos-console/console/validation"
 	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
 	"github.com/holos-run/holos-console/gen/holos/console/v1/consolev1connect"
 	"github.com/holos-run/holos-console/internal/deploymentrender"
@@ -39,9 +40,6 @@ const (
 	OutputURLAnnotation = "console.holos.run/output-url"
 )
 
-// dnsLabelRe validates deployment names as DNS labels.
-var dnsLabelRe = regexp.MustCompile(`^[a-z][a-z0-9-]*[a-z0-9]$`)
-
 // ProjectResolver resolves project namespace grants for access checks.
 type ProjectResolver interface {
 	GetProjectGrants(ctx context.Context, project string) (shareUsers, shareRoles map[string]string, err error)
@@ -294,15 +292,19 @@ func (h *Handler) ListDeployments(
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("project is required"))
 	}
 
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	claims := rpc.MustClaims(ctx)
+	order, err := listfilter.NewOrder("order_by", req.Msg.OrderBy)
+	if err != nil {
+		return nil, err
 	}
 
 	cms, err := h.requestK8s(ctx).ListDeployments(ctx, project)
 	if err != nil {
 		return nil, mapK8sError(err)
 	}
+	listfilter.Sort(order, cms, func(cm corev1.ConfigMap) listfilter.SortKey {
+		return listfilter.KeyOf(cm.Name, &cm)
+	})
 
 	ns := h.k8s.Resolver.ProjectNamespace(project)
 	// Aggregate dependency edges across the project's RenderStates so each
@@ -377,10 +379,7 @@ func (h *Handler) GetDeployment(
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("name is required"))
 	}
 
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
-	}
+	claims := rpc.MustClaims(ctx)
 
 	cm, err := h.requestK8s(ctx).GetDeployment(ctx, project, name)
 	if err != nil {
@@ -465,10 +464,7 @@ func (h *Handler) CreateDeployment(
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("template is required"))
 	}
 
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
-	}
+	claims := rpc.MustClaims(ctx)
 
 	// Check that deployments are enabled in project settings.
 	if h.settingsResolver != nil {
@@ -694,10 +690,7 @@ func (h *Handler) UpdateDeployment(
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("name is required"))
 	}
 
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
-	}
+	claims := rpc.MustClaims(ctx)
 
 	envInputs, err := validateEnvVars(req.Msg.Env)
 	if err != nil {
@@ -842,10 +835,7 @@ func (h *Handler) DeleteDeployment(
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("name is required"))
 	}
 
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
-	}
+	claims := rpc.MustClaims(ctx)
 
 	// Clean up all K8s resources owned by this deployment before removing the record.
 	// Discover all namespaces with owned resources so cross-namespace resources
@@ -909,10 +899,7 @@ func (h *Handler) ListNamespaceSecrets(
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("project is required"))
 	}
 
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
-	}
+	claims := rpc.MustClaims(ctx)
 
 	items, err := h.requestK8s(ctx).ListNamespaceSecrets(ctx, project)
 	if err != nil {
@@ -949,10 +936,7 @@ func (h *Handler) ListNamespaceConfigMaps(
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("project is required"))
 	}
 
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
-	}
+	claims := rpc.MustClaims(ctx)
 
 	items, err := h.requestK8s(ctx).ListNamespaceConfigMaps(ctx, project)
 	if err != nil {
@@ -994,10 +978,7 @@ func (h *Handler) GetDeploymentRenderPreview(
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("name is required"))
 	}
 
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
-	}
+	claims := rpc.MustClaims(ctx)
 
 	// Look up the deployment record.
 	cm, err := h.requestK8s(ctx).GetDeployment(ctx, project, name)
@@ -1398,16 +1379,9 @@ func serializeUnstructured(resources []unstructured.Unstructured) (yamlStr, json
 
 // validateDeploymentName checks that the name is a valid DNS label.
 func validateDeploymentName(name string) error {
-	if name == "" {
-		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("name is required"))
-	}
-	if len(name) > 63 {
-		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("name must be at most 63 characters"))
-	}
-	if !dnsLabelRe.MatchString(name) {
-		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("name must be a valid DNS label (lowercase alphanumeric and hyphens, starting with a letter)"))
-	}
-	return nil
+	var v validation.Violations
+	v.Name("name", name)
+	return v.Err()
 }
 
 // configMapToDeployment converts a Kubernetes ConfigMap to a Deployment protobuf message.
@@ -1677,10 +1651,6 @@ func (h *Handler) GetDeploymentPolicyState(
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("name is required"))
 	}
 
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
-	}
 	if _, err := h.requestK8s(ctx).GetDeployment(ctx, project, name); err != nil {
 		return nil, mapK8sError(err)
 	}
@@ -1721,10 +1691,7 @@ func (h *Handler) PreflightCheck(
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("planned_deployments must not be empty"))
 	}
 
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
-	}
+	claims := rpc.MustClaims(ctx)
 	// Fetch the existing deployment ConfigMaps from the project namespace so we
 	// can detect name collisions without mutating anything.
 	existingCMs, err := h.requestK8s(ctx).ListDeployments(ctx, project)
@@ -1810,10 +1777,7 @@ func (h *Handler) GetDependencyEdgeCascadeDelete(
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
-	}
+	claims := rpc.MustClaims(ctx)
 	if h.dependencyEdgeWriter == nil {
 		return nil, connect.NewError(connect.CodeFailedPrecondition,
 			fmt.Errorf("dependency-edge writer not configured"))
@@ -1856,10 +1820,7 @@ func (h *Handler) SetDependencyEdgeCascadeDelete(
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
-	}
+	claims := rpc.MustClaims(ctx)
 	if h.dependencyEdgeWriter == nil {
 		return nil, connect.NewError(connect.CodeFailedPrecondition,
 			fmt.Errorf("dependency-edge writer not configured"))
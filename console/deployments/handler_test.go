@@ -175,21 +175,6 @@ func TestHandler_ListDeployments(t *testing.T) {
 		}
 	})
 
-	t.Run("rejects unauthenticated request", func(t *testing.T) {
-		fakeClient := fake.NewClientset(projectNS("my-project"))
-		handler := defaultHandler(fakeClient, &stubProjectResolver{})
-
-		ctx := context.Background()
-		req := connect.NewRequest(&consolev1.ListDeploymentsRequest{Project: "my-project"})
-		_, err := handler.ListDeployments(ctx, req)
-		if err == nil {
-			t.Fatal("expected error for unauthenticated request")
-		}
-		if connect.CodeOf(err) != connect.CodeUnauthenticated {
-			t.Errorf("expected CodeUnauthenticated, got %v", connect.CodeOf(err))
-		}
-	})
-
 	// Per HOL-1033 + ADR 036, in-process project-grant authorization was
 	// removed in favor of native K8s RBAC via the OIDC-impersonated client.
 	// PermissionDenied for unauthorized callers now flows back from the API
@@ -555,21 +540,6 @@ func TestHandler_ListNamespaceSecrets(t *testing.T) {
 	// PermissionDenied for unauthorized callers now flows back from the API
 	// server.
 
-	t.Run("rejects unauthenticated request", func(t *testing.T) {
-		fakeClient := fake.NewClientset(projectNS("my-project"))
-		handler := defaultHandler(fakeClient, &stubProjectResolver{})
-
-		ctx := context.Background()
-		req := connect.NewRequest(&consolev1.ListNamespaceSecretsRequest{Project: "my-project"})
-		_, err := handler.ListNamespaceSecrets(ctx, req)
-		if err == nil {
-			t.Fatal("expected error for unauthenticated request")
-		}
-		if connect.CodeOf(err) != connect.CodeUnauthenticated {
-			t.Errorf("expected CodeUnauthenticated, got %v", connect.CodeOf(err))
-		}
-	})
-
 	t.Run("rejects empty project", func(t *testing.T) {
 		fakeClient := fake.NewClientset()
 		handler := defaultHandler(fakeClient, &stubProjectResolver{users: map[string]string{"alice@example.com": "editor"}})
@@ -625,21 +595,6 @@ func TestHandler_ListNamespaceConfigMaps(t *testing.T) {
 	// PermissionDenied for unauthorized callers now flows back from the API
 	// server.
 
-	t.Run("rejects unauthenticated request", func(t *testing.T) {
-		fakeClient := fake.NewClientset(projectNS("my-project"))
-		handler := defaultHandler(fakeClient, &stubProjectResolver{})
-
-		ctx := context.Background()
-		req := connect.NewRequest(&consolev1.ListNamespaceConfigMapsRequest{Project: "my-project"})
-		_, err := handler.ListNamespaceConfigMaps(ctx, req)
-		if err == nil {
-			t.Fatal("expected error for unauthenticated request")
-		}
-		if connect.CodeOf(err) != connect.CodeUnauthenticated {
-			t.Errorf("expected CodeUnauthenticated, got %v", connect.CodeOf(err))
-		}
-	})
-
 	t.Run("rejects empty project", func(t *testing.T) {
 		fakeClient := fake.NewClientset()
 		handler := defaultHandler(fakeClient, &stubProjectResolver{users: map[string]string{"alice@example.com": "editor"}})
@@ -1079,24 +1034,6 @@ func TestHandler_GetDeploymentRenderPreview(t *testing.T) {
 		}
 	})
 
-	t.Run("rejects unauthenticated request", func(t *testing.T) {
-		fakeClient := fake.NewClientset(projectNS("my-project"))
-		handler := defaultHandler(fakeClient, &stubProjectResolver{})
-
-		ctx := context.Background()
-		req := connect.NewRequest(&consolev1.GetDeploymentRenderPreviewRequest{
-			Project: "my-project",
-			Name:    "web-app",
-		})
-		_, err := handler.GetDeploymentRenderPreview(ctx, req)
-		if err == nil {
-			t.Fatal("expected error for unauthenticated request")
-		}
-		if connect.CodeOf(err) != connect.CodeUnauthenticated {
-			t.Errorf("expected CodeUnauthenticated, got %v", connect.CodeOf(err))
-		}
-	})
-
 	t.Run("response contains system and user input fields", func(t *testing.T) {
 		ns := projectNS("my-project")
 		cm := deploymentConfigMap("my-project", "web-app", "nginx", "1.25", "default", "Web App", "desc")
@@ -50,10 +50,7 @@ func (h *Handler) GetDeploymentLogs(
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("name is required"))
 	}
 
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
-	}
+	claims := rpc.MustClaims(ctx)
 
 	rk8s := h.requestK8s(ctx)
 	ns := rk8s.Resolver.ProjectNamespace(project)
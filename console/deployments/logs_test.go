@@ -154,25 +154,6 @@ func TestGetDeploymentLogs_Previous(t *testing.T) {
 	}
 }
 
-// TestGetDeploymentLogs_UnauthenticatedDenied verifies unauthenticated access is rejected.
-func TestGetDeploymentLogs_UnauthenticatedDenied(t *testing.T) {
-	fakeClient := fake.NewClientset()
-	k8s := NewK8sClient(fakeClient, testResolver())
-	pr := &stubProjectResolver{users: map[string]string{}}
-	h := &Handler{k8s: k8s, projectResolver: pr}
-
-	_, err := h.GetDeploymentLogs(context.Background(), connect.NewRequest(&consolev1.GetDeploymentLogsRequest{
-		Name:    "my-app",
-		Project: "my-project",
-	}))
-	if err == nil {
-		t.Fatal("expected error for unauthenticated request, got nil")
-	}
-	if connect.CodeOf(err) != connect.CodeUnauthenticated {
-		t.Errorf("expected Unauthenticated error, got %v", err)
-	}
-}
-
 // TestGetDeploymentLogs_ViewerCanRead verifies viewer role can access logs.
 func TestGetDeploymentLogs_ViewerCanRead(t *testing.T) {
 	const ns = "prj-my-project"
@@ -117,12 +117,6 @@ func TestGetDeploymentPolicyState(t *testing.T) {
 			req:      &consolev1.GetDeploymentPolicyStateRequest{Project: project, Name: ""},
 			wantCode: connect.CodeInvalidArgument,
 		},
-		{
-			desc:     "unauthenticated is rejected",
-			ctx:      context.Background(),
-			req:      &consolev1.GetDeploymentPolicyStateRequest{Project: project, Name: name},
-			wantCode: connect.CodeUnauthenticated,
-		},
 		// Per HOL-1033, in-process project-grant authorization was removed
 		// in favor of native K8s RBAC via the OIDC-impersonated client (ADR
 		// 036). PermissionDenied now flows back from the API server when an
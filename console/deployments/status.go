@@ -45,11 +45,6 @@ func (h *Handler) GetDeploymentStatusSummary(
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("name is required"))
 	}
 
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
-	}
-
 	rk8s := h.requestK8s(ctx)
 	ns := rk8s.Resolver.ProjectNamespace(project)
 	summary, ok := h.summaryFromCache(ns, name)
@@ -132,10 +127,7 @@ func (h *Handler) GetDeploymentStatus(
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("name is required"))
 	}
 
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
-	}
+	claims := rpc.MustClaims(ctx)
 
 	rk8s := h.requestK8s(ctx)
 	ns := rk8s.Resolver.ProjectNamespace(project)
@@ -0,0 +1,244 @@
+// Package envelope encrypts secret values at rest with envelope encryption.
+// Each Secret's values are encrypted with AES-256-GCM under a random data
+// key, and the data key is stored next to them wrapped by a key encryption
+// key that never leaves its KeyWrapper: a local key file, or a cloud KMS
+// through an adapter implementing KeyWrapper.
+//
+// Sealed values start with a marker, so a Secret may hold sealed and
+// plaintext values at once while existing Secrets migrate on their next
+// write. Each value is bound to the Secret and data key name it was sealed
+// for, so it cannot be copied into another Secret and still open.
+package envelope
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"maps"
+	"sync"
+)
+
+// KeyWrapper wraps and unwraps data keys with a key encryption key. Adapters
+// for a KMS, such as GCP Cloud KMS or AWS KMS, implement it by calling the
+// service's Encrypt and Decrypt operations.
+type KeyWrapper interface {
+	// ID identifies the key encryption key. It is stored with every wrapped
+	// data key so the key can be rotated while old data keys still unwrap.
+	ID() string
+	// Wrap encrypts a data key.
+	Wrap(ctx context.Context, dataKey []byte) ([]byte, error)
+	// Unwrap decrypts a data key returned by Wrap.
+	Unwrap(ctx context.Context, wrapped []byte) ([]byte, error)
+}
+
+// Key is a wrapped data key and the ID of the KeyWrapper that wrapped it.
+type Key struct {
+	ID      string
+	Wrapped []byte
+}
+
+// String encodes the wrapped data key for storage in an annotation.
+func (k Key) String() string {
+	return base64.StdEncoding.EncodeToString(k.Wrapped)
+}
+
+// ParseKey decodes a Key stored with Key.String.
+func ParseKey(id, wrapped string) (Key, error) {
+	b, err := base64.StdEncoding.DecodeString(wrapped)
+	if err != nil {
+		return Key{}, fmt.Errorf("decoding wrapped data key: %w", err)
+	}
+	return Key{ID: id, Wrapped: b}, nil
+}
+
+// marker prefixes every sealed value. The leading NUL keeps it out of
+// ordinary text values.
+var marker = []byte("\x00hce1:")
+
+// dataKeySize is the AES-256 key size.
+const dataKeySize = 32
+
+// maxCachedKeys bounds the unwrapped data key cache.
+const maxCachedKeys = 1024
+
+// ErrUnknownKey is returned when a data key was wrapped by a key encryption
+// key the Envelope was not given.
+var ErrUnknownKey = errors.New("unknown key encryption key")
+
+// ErrMarkedPlaintext is returned for a plaintext value that starts with the
+// marker of sealed values, which reads could not tell apart from one.
+var ErrMarkedPlaintext = errors.New("value starts with the marker reserved for encrypted values")
+
+// Envelope seals and opens secret values.
+type Envelope struct {
+	primary  KeyWrapper
+	wrappers map[string]KeyWrapper
+
+	mu    sync.Mutex
+	cache map[string][]byte
+}
+
+// New returns an Envelope that wraps new data keys with primary and also
+// unwraps data keys wrapped by previous, so key encryption keys can be
+// rotated without rewriting every Secret first.
+func New(primary KeyWrapper, previous ...KeyWrapper) *Envelope {
+	e := &Envelope{primary: primary, wrappers: map[string]KeyWrapper{primary.ID(): primary}, cache: map[string][]byte{}}
+	for _, w := range previous {
+		e.wrappers[w.ID()] = w
+	}
+	return e
+}
+
+// IsSealed reports whether value was sealed by an Envelope.
+func IsSealed(value []byte) bool {
+	return bytes.HasPrefix(value, marker)
+}
+
+// CheckPlaintext returns ErrMarkedPlaintext when a value in data starts
+// with the marker of sealed values.
+func CheckPlaintext(data map[string][]byte) error {
+	for k, v := range data {
+		if IsSealed(v) {
+			return fmt.Errorf("key %q: %w", k, ErrMarkedPlaintext)
+		}
+	}
+	return nil
+}
+
+// Sealed reports whether any value in data is sealed.
+func Sealed(data map[string][]byte) bool {
+	for _, v := range data {
+		if IsSealed(v) {
+			return true
+		}
+	}
+	return false
+}
+
+// NewKey generates a data key and wraps it with the primary KeyWrapper.
+func (e *Envelope) NewKey(ctx context.Context) (Key, error) {
+	dataKey := make([]byte, dataKeySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return Key{}, fmt.Errorf("generating data key: %w", err)
+	}
+	wrapped, err := e.primary.Wrap(ctx, dataKey)
+	if err != nil {
+		return Key{}, fmt.Errorf("wrapping data key with %s: %w", e.primary.ID(), err)
+	}
+	key := Key{ID: e.primary.ID(), Wrapped: wrapped}
+	e.remember(key, dataKey)
+	return key, nil
+}
+
+// Seal returns a copy of data with every value encrypted under key for the
+// Secret id, e.g. "namespace/name". It returns ErrMarkedPlaintext rather
+// than store a value Open would mistake for a sealed one.
+func (e *Envelope) Seal(ctx context.Context, data map[string][]byte, key Key, id string) (map[string][]byte, error) {
+	if err := CheckPlaintext(data); err != nil {
+		return nil, err
+	}
+	aead, err := e.aead(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	sealed := make(map[string][]byte, len(data))
+	for k, v := range data {
+		nonce := make([]byte, aead.NonceSize())
+		if _, err := rand.Read(nonce); err != nil {
+			return nil, fmt.Errorf("generating nonce: %w", err)
+		}
+		out := append(append(append([]byte{}, marker...), nonce...), aead.Seal(nil, nonce, v, additionalData(id, k))...)
+		sealed[k] = out
+	}
+	return sealed, nil
+}
+
+// Open returns a copy of data with every sealed value decrypted with key.
+// Plaintext values are returned as they are. The Secret id and key name
+// are authenticated, so a sealed value moved to another Secret or key
+// fails to open.
+func (e *Envelope) Open(ctx context.Context, data map[string][]byte, key Key, id string) (map[string][]byte, error) {
+	if !Sealed(data) {
+		return maps.Clone(data), nil
+	}
+	aead, err := e.aead(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	opened := make(map[string][]byte, len(data))
+	for k, v := range data {
+		if !IsSealed(v) {
+			opened[k] = v
+			continue
+		}
+		body := v[len(marker):]
+		if len(body) < aead.NonceSize() {
+			return nil, fmt.Errorf("sealed value of key %q is truncated", k)
+		}
+		plain, err := aead.Open(nil, body[:aead.NonceSize()], body[aead.NonceSize():], additionalData(id, k))
+		if err != nil {
+			return nil, fmt.Errorf("decrypting key %q: %w", k, err)
+		}
+		opened[k] = plain
+	}
+	return opened, nil
+}
+
+// additionalData authenticates the Secret and data key name a value was
+// sealed for. Neither can contain a NUL.
+func additionalData(id, name string) []byte {
+	return []byte(id + "\x00" + name)
+}
+
+// aead returns the cipher of the data key in key, unwrapping it if needed.
+func (e *Envelope) aead(ctx context.Context, key Key) (cipher.AEAD, error) {
+	dataKey, err := e.dataKey(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	return newGCM(dataKey)
+}
+
+func (e *Envelope) dataKey(ctx context.Context, key Key) ([]byte, error) {
+	cacheKey := key.ID + "/" + key.String()
+	e.mu.Lock()
+	dataKey, ok := e.cache[cacheKey]
+	e.mu.Unlock()
+	if ok {
+		return dataKey, nil
+	}
+	w, ok := e.wrappers[key.ID]
+	if !ok {
+		return nil, fmt.Errorf("%w %q", ErrUnknownKey, key.ID)
+	}
+	dataKey, err := w.Unwrap(ctx, key.Wrapped)
+	if err != nil {
+		return nil, fmt.Errorf("unwrapping data key with %s: %w", key.ID, err)
+	}
+	e.remember(key, dataKey)
+	return dataKey, nil
+}
+
+// remember caches an unwrapped data key so reads do not call the KMS each
+// time. The cache is reset when full.
+func (e *Envelope) remember(key Key, dataKey []byte) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if len(e.cache) >= maxCachedKeys {
+		clear(e.cache)
+	}
+	e.cache[key.ID+"/"+key.String()] = dataKey
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,175 @@
+package envelope
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func testWrapper(t *testing.T, b byte) *AESKeyWrapper {
+	t.Helper()
+	w, err := NewAESKeyWrapper(bytes.Repeat([]byte{b}, 32))
+	if err != nil {
+		t.Fatalf("NewAESKeyWrapper: %v", err)
+	}
+	return w
+}
+
+func TestEnvelope(t *testing.T) {
+	ctx := context.Background()
+	data := map[string][]byte{"password": []byte("hunter2"), "user": []byte("admin")}
+
+	t.Run("round trips", func(t *testing.T) {
+		e := New(testWrapper(t, 1))
+		key, err := e.NewKey(ctx)
+		if err != nil {
+			t.Fatalf("NewKey: %v", err)
+		}
+		sealed, err := e.Seal(ctx, data, key, "prj-web/db")
+		if err != nil {
+			t.Fatalf("Seal: %v", err)
+		}
+		for k, v := range sealed {
+			if !IsSealed(v) || bytes.Contains(v, data[k]) {
+				t.Errorf("expected %s to be encrypted, got %q", k, v)
+			}
+		}
+		// A fresh Envelope has no cached data keys and must unwrap.
+		opened, err := New(testWrapper(t, 1)).Open(ctx, sealed, key, "prj-web/db")
+		if err != nil {
+			t.Fatalf("Open: %v", err)
+		}
+		if string(opened["password"]) != "hunter2" || string(opened["user"]) != "admin" {
+			t.Errorf("expected the original values, got %v", opened)
+		}
+	})
+
+	t.Run("passes plaintext through", func(t *testing.T) {
+		e := New(testWrapper(t, 1))
+		key, err := e.NewKey(ctx)
+		if err != nil {
+			t.Fatalf("NewKey: %v", err)
+		}
+		sealed, err := e.Seal(ctx, map[string][]byte{"a": []byte("1")}, key, "prj-web/db")
+		if err != nil {
+			t.Fatalf("Seal: %v", err)
+		}
+		sealed["b"] = []byte("2")
+		opened, err := e.Open(ctx, sealed, key, "prj-web/db")
+		if err != nil {
+			t.Fatalf("Open: %v", err)
+		}
+		if string(opened["a"]) != "1" || string(opened["b"]) != "2" {
+			t.Errorf("expected mixed values to open, got %v", opened)
+		}
+	})
+
+	t.Run("rejects values moved between keys", func(t *testing.T) {
+		e := New(testWrapper(t, 1))
+		key, err := e.NewKey(ctx)
+		if err != nil {
+			t.Fatalf("NewKey: %v", err)
+		}
+		sealed, err := e.Seal(ctx, data, key, "prj-web/db")
+		if err != nil {
+			t.Fatalf("Seal: %v", err)
+		}
+		sealed["password"], sealed["user"] = sealed["user"], sealed["password"]
+		if _, err := e.Open(ctx, sealed, key, "prj-web/db"); err == nil {
+			t.Fatal("expected swapped values to fail to decrypt")
+		}
+	})
+
+	t.Run("rejects values moved between secrets", func(t *testing.T) {
+		e := New(testWrapper(t, 1))
+		key, err := e.NewKey(ctx)
+		if err != nil {
+			t.Fatalf("NewKey: %v", err)
+		}
+		sealed, err := e.Seal(ctx, data, key, "prj-web/db")
+		if err != nil {
+			t.Fatalf("Seal: %v", err)
+		}
+		for _, id := range []string{"prj-web/other", "prj-data/db"} {
+			if _, err := e.Open(ctx, sealed, key, id); err == nil {
+				t.Errorf("expected values copied to %s to fail to decrypt", id)
+			}
+		}
+	})
+
+	t.Run("rejects plaintext that looks sealed", func(t *testing.T) {
+		e := New(testWrapper(t, 1))
+		key, err := e.NewKey(ctx)
+		if err != nil {
+			t.Fatalf("NewKey: %v", err)
+		}
+		marked := map[string][]byte{"note": append(append([]byte{}, marker...), "not sealed"...)}
+		if _, err := e.Seal(ctx, marked, key, "prj-web/db"); !errors.Is(err, ErrMarkedPlaintext) {
+			t.Errorf("expected ErrMarkedPlaintext, got %v", err)
+		}
+		if err := CheckPlaintext(data); err != nil {
+			t.Errorf("expected ordinary values to pass, got %v", err)
+		}
+	})
+
+	t.Run("rotates key encryption keys", func(t *testing.T) {
+		old, current := testWrapper(t, 1), testWrapper(t, 2)
+		key, err := New(old).NewKey(ctx)
+		if err != nil {
+			t.Fatalf("NewKey: %v", err)
+		}
+		sealed, err := New(old).Seal(ctx, data, key, "prj-web/db")
+		if err != nil {
+			t.Fatalf("Seal: %v", err)
+		}
+		if _, err := New(current).Open(ctx, sealed, key, "prj-web/db"); !errors.Is(err, ErrUnknownKey) {
+			t.Fatalf("expected ErrUnknownKey without the previous key, got %v", err)
+		}
+		rotated := New(current, old)
+		if _, err := rotated.Open(ctx, sealed, key, "prj-web/db"); err != nil {
+			t.Fatalf("expected the previous key to open, got %v", err)
+		}
+		newKey, err := rotated.NewKey(ctx)
+		if err != nil {
+			t.Fatalf("NewKey: %v", err)
+		}
+		if newKey.ID != current.ID() {
+			t.Errorf("expected new data keys to be wrapped by %s, got %s", current.ID(), newKey.ID)
+		}
+	})
+}
+
+func TestLoadKeyFile(t *testing.T) {
+	dir := t.TempDir()
+	key := bytes.Repeat([]byte{7}, 32)
+	raw := filepath.Join(dir, "raw")
+	encoded := filepath.Join(dir, "encoded")
+	if err := os.WriteFile(raw, key, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(encoded, []byte(base64.StdEncoding.EncodeToString(key)+"\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	a, err := LoadKeyFile(raw)
+	if err != nil {
+		t.Fatalf("LoadKeyFile(raw): %v", err)
+	}
+	b, err := LoadKeyFile(encoded)
+	if err != nil {
+		t.Fatalf("LoadKeyFile(encoded): %v", err)
+	}
+	if a.ID() != b.ID() {
+		t.Errorf("expected both encodings to load the same key, got %s and %s", a.ID(), b.ID())
+	}
+	short := filepath.Join(dir, "short")
+	if err := os.WriteFile(short, []byte("too short"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadKeyFile(short); err == nil {
+		t.Error("expected a short key to be rejected")
+	}
+}
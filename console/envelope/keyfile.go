@@ -0,0 +1,76 @@
+package envelope
+
+import (
+	"bytes"
+	"context"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"os"
+)
+
+// AESKeyWrapper wraps data keys locally with an AES-256-GCM key encryption
+// key, typically read from a file mounted from a Kubernetes Secret that only
+// the console can read.
+type AESKeyWrapper struct {
+	id   string
+	aead cipher.AEAD
+}
+
+// NewAESKeyWrapper returns a KeyWrapper using the 32-byte key. Its ID is
+// derived from the key, so rotating the key changes the ID.
+func NewAESKeyWrapper(key []byte) (*AESKeyWrapper, error) {
+	if len(key) != dataKeySize {
+		return nil, fmt.Errorf("key encryption key must be %d bytes, got %d", dataKeySize, len(key))
+	}
+	aead, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	sum := sha256.Sum256(key)
+	return &AESKeyWrapper{id: "local:" + hex.EncodeToString(sum[:8]), aead: aead}, nil
+}
+
+// LoadKeyFile reads a key encryption key from path for NewAESKeyWrapper. The
+// file holds the 32 key bytes, raw or base64 encoded, e.g. the output of
+// `openssl rand -base64 32`.
+func LoadKeyFile(path string) (*AESKeyWrapper, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading key encryption key: %w", err)
+	}
+	if len(b) != dataKeySize {
+		decoded, err := base64.StdEncoding.DecodeString(string(bytes.TrimSpace(b)))
+		if err != nil {
+			return nil, fmt.Errorf("key file %s is neither %d raw bytes nor base64: %w", path, dataKeySize, err)
+		}
+		b = decoded
+	}
+	return NewAESKeyWrapper(b)
+}
+
+// ID implements KeyWrapper.
+func (w *AESKeyWrapper) ID() string {
+	return w.id
+}
+
+// Wrap implements KeyWrapper.
+func (w *AESKeyWrapper) Wrap(_ context.Context, dataKey []byte) ([]byte, error) {
+	nonce := make([]byte, w.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("generating nonce: %w", err)
+	}
+	return w.aead.Seal(nonce, nonce, dataKey, nil), nil
+}
+
+// Unwrap implements KeyWrapper.
+func (w *AESKeyWrapper) Unwrap(_ context.Context, wrapped []byte) ([]byte, error) {
+	if len(wrapped) < w.aead.NonceSize() {
+		return nil, fmt.Errorf("wrapped data key is truncated")
+	}
+	n := w.aead.NonceSize()
+	return w.aead.Open(nil, wrapped[:n], wrapped[n:], nil)
+}
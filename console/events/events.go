@@ -0,0 +1,151 @@
+// Package events renders console audit events as CloudEvents 1.0 and
+// publishes them, so they integrate with Knative and other eventing
+// pipelines.
+//
+// Events use the structured JSON mode: the whole CloudEvent, attributes and
+// data, is the request body with content type application/cloudevents+json.
+// HTTPPublisher delivers to any HTTP endpoint that accepts that format, such
+// as a Knative broker. Other transports, such as a NATS subject or a Kafka
+// topic, plug in by implementing Publisher.
+package events
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/holos-run/holos-console/console/audit"
+)
+
+const (
+	// SpecVersion is the CloudEvents specification version of Event.
+	SpecVersion = "1.0"
+	// ContentType is the media type of a structured-mode JSON CloudEvent.
+	ContentType = "application/cloudevents+json"
+	// TypePrefix prefixes the audit action in the type of audit events,
+	// e.g. run.holos.console.audit.secret_delete.
+	TypePrefix = "run.holos.console.audit."
+	// DefaultSource is the source of events when the console has no origin.
+	DefaultSource = "holos-console"
+)
+
+// Event is a CloudEvent in structured JSON form.
+type Event struct {
+	SpecVersion     string    `json:"specversion"`
+	ID              string    `json:"id"`
+	Source          string    `json:"source"`
+	Type            string    `json:"type"`
+	Subject         string    `json:"subject,omitempty"`
+	Time            time.Time `json:"time"`
+	DataContentType string    `json:"datacontenttype"`
+	Data            AuditData `json:"data"`
+}
+
+// AuditData is the data of an audit CloudEvent.
+type AuditData struct {
+	Action            string            `json:"action"`
+	ResourceType      string            `json:"resourceType,omitempty"`
+	ResourceName      string            `json:"resourceName,omitempty"`
+	Project           string            `json:"project,omitempty"`
+	Sub               string            `json:"sub,omitempty"`
+	Email             string            `json:"email,omitempty"`
+	Message           string            `json:"message,omitempty"`
+	ImpersonatorSub   string            `json:"impersonatorSub,omitempty"`
+	ImpersonatorEmail string            `json:"impersonatorEmail,omitempty"`
+	Cluster           string            `json:"cluster,omitempty"`
+	RequestID         string            `json:"requestId,omitempty"`
+	Attributes        map[string]string `json:"attributes,omitempty"`
+}
+
+// FromAudit returns the CloudEvent for e. source identifies the console
+// instance, normally its origin URL; empty selects DefaultSource. Every call
+// assigns a new ID, so each delivery of the same audit event is distinct to
+// consumers that deduplicate by source and ID.
+func FromAudit(e audit.Event, source string) Event {
+	if source == "" {
+		source = DefaultSource
+	}
+	return Event{
+		SpecVersion:     SpecVersion,
+		ID:              rand.Text(),
+		Source:          source,
+		Type:            TypePrefix + e.Action,
+		Subject:         subject(e),
+		Time:            e.Time.UTC(),
+		DataContentType: "application/json",
+		Data: AuditData{
+			Action:            e.Action,
+			ResourceType:      e.ResourceType,
+			ResourceName:      e.ResourceName,
+			Project:           e.Project,
+			Sub:               e.Sub,
+			Email:             e.Email,
+			Message:           e.Message,
+			ImpersonatorSub:   e.ImpersonatorSub,
+			ImpersonatorEmail: e.ImpersonatorEmail,
+			Cluster:           e.Cluster,
+			RequestID:         e.RequestID,
+			Attributes:        e.Attributes,
+		},
+	}
+}
+
+// subject names the resource e concerns, qualified by its project.
+func subject(e audit.Event) string {
+	if e.Project != "" && e.ResourceName != "" && e.ResourceName != e.Project {
+		return e.Project + "/" + e.ResourceName
+	}
+	if e.ResourceName != "" {
+		return e.ResourceName
+	}
+	return e.Project
+}
+
+// Publisher delivers CloudEvents to a transport.
+type Publisher interface {
+	Publish(ctx context.Context, event Event) error
+}
+
+// Post sends event to url in structured JSON mode with client. Any non-2xx
+// response is an error.
+func Post(ctx context.Context, client *http.Client, url string, event Event) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("encoding cloudevent: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("building cloudevent request: %w", err)
+	}
+	req.Header.Set("Content-Type", ContentType)
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("posting cloudevent: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("cloudevent endpoint returned %s", resp.Status)
+	}
+	return nil
+}
+
+// HTTPPublisher posts events to a single HTTP endpoint, such as a Knative
+// broker.
+type HTTPPublisher struct {
+	client *http.Client
+	url    string
+}
+
+// NewHTTPPublisher returns an HTTPPublisher that posts to url with client.
+func NewHTTPPublisher(client *http.Client, url string) *HTTPPublisher {
+	return &HTTPPublisher{client: client, url: url}
+}
+
+// Publish implements Publisher.
+func (p *HTTPPublisher) Publish(ctx context.Context, event Event) error {
+	return Post(ctx, p.client, p.url, event)
+}
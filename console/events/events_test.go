@@ -0,0 +1,89 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/holos-run/holos-console/console/audit"
+)
+
+func TestFromAudit(t *testing.T) {
+	at := time.Date(2026, 3, 1, 12, 0, 0, 0, time.FixedZone("EST", -5*3600))
+	e := audit.Event{
+		Time:         at,
+		Action:       "secret_delete",
+		ResourceType: "secret",
+		ResourceName: "db",
+		Project:      "billing",
+		Email:        "alice@example.com",
+		RequestID:    "req-1",
+		Attributes:   map[string]string{"organization": "acme"},
+	}
+	got := FromAudit(e, "https://console.example.com")
+	if got.SpecVersion != "1.0" || got.Source != "https://console.example.com" || got.Type != "run.holos.console.audit.secret_delete" {
+		t.Errorf("unexpected attributes %+v", got)
+	}
+	if got.Subject != "billing/db" || !got.Time.Equal(at) || got.Time.Location() != time.UTC {
+		t.Errorf("unexpected subject or time %q %v", got.Subject, got.Time)
+	}
+	if got.ID == "" || got.ID == FromAudit(e, "").ID {
+		t.Error("expected a unique id per event")
+	}
+	if got.Data.Email != "alice@example.com" || got.Data.Attributes["organization"] != "acme" {
+		t.Errorf("unexpected data %+v", got.Data)
+	}
+	if src := FromAudit(e, "").Source; src != DefaultSource {
+		t.Errorf("expected default source, got %q", src)
+	}
+}
+
+func TestSinkPublishesStructuredCloudEvents(t *testing.T) {
+	received := make(chan map[string]any, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if ct := r.Header.Get("Content-Type"); ct != ContentType {
+			t.Errorf("expected content type %s, got %s", ContentType, ct)
+		}
+		body, _ := io.ReadAll(r.Body)
+		var event map[string]any
+		if err := json.Unmarshal(body, &event); err != nil {
+			t.Errorf("body is not json: %v", err)
+		}
+		received <- event
+	}))
+	defer srv.Close()
+
+	sink := NewSink(NewHTTPPublisher(srv.Client(), srv.URL), "https://console.example.com")
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go sink.Run(ctx)
+	sink.Enqueue(audit.Event{Time: time.Now(), Action: "project_create", ResourceType: "project", ResourceName: "billing"})
+
+	select {
+	case event := <-received:
+		if event["specversion"] != "1.0" || event["type"] != "run.holos.console.audit.project_create" || event["subject"] != "billing" {
+			t.Errorf("unexpected event %v", event)
+		}
+		data, _ := event["data"].(map[string]any)
+		if data["resourceType"] != "project" {
+			t.Errorf("unexpected data %v", event["data"])
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected the sink to publish the event")
+	}
+}
+
+func TestHTTPPublisherReportsErrors(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+	err := NewHTTPPublisher(srv.Client(), srv.URL).Publish(context.Background(), FromAudit(audit.Event{Action: "secret_delete"}, ""))
+	if err == nil {
+		t.Fatal("expected an error for a non-2xx response")
+	}
+}
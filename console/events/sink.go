@@ -0,0 +1,66 @@
+package events
+
+import (
+	"context"
+	"log/slog"
+	"sync/atomic"
+
+	"github.com/holos-run/holos-console/console/audit"
+)
+
+// sinkQueueSize bounds the events waiting for publication. Events arriving
+// while the queue is full are dropped rather than slowing down the request
+// that logged them.
+const sinkQueueSize = 1024
+
+// Sink publishes every audit event as a CloudEvent.
+type Sink struct {
+	publisher Publisher
+	source    string
+
+	queue   chan audit.Event
+	dropped atomic.Int64
+}
+
+// NewSink returns a Sink that publishes through publisher with source as the
+// CloudEvents source.
+func NewSink(publisher Publisher, source string) *Sink {
+	return &Sink{
+		publisher: publisher,
+		source:    source,
+		queue:     make(chan audit.Event, sinkQueueSize),
+	}
+}
+
+// Enqueue queues e for publication. It never blocks; subscribe it to the
+// audit.Ring.
+func (s *Sink) Enqueue(e audit.Event) {
+	select {
+	case s.queue <- e:
+	default:
+		s.dropped.Add(1)
+	}
+}
+
+// Run publishes queued events until ctx is cancelled. Publication failures
+// are logged and not retried.
+func (s *Sink) Run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case e := <-s.queue:
+			if dropped := s.dropped.Swap(0); dropped > 0 {
+				slog.WarnContext(ctx, "audit sink queue full, events dropped", slog.Int64("dropped", dropped))
+			}
+			if err := s.publisher.Publish(ctx, FromAudit(e, s.source)); err != nil {
+				// event_action, not action: this record must not be
+				// captured as an audit event itself.
+				slog.WarnContext(ctx, "could not publish audit event",
+					slog.String("event_action", e.Action),
+					slog.Any("error", err),
+				)
+			}
+		}
+	}
+}
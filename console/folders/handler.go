@@ -13,9 +13,11 @@ import (
 	"k8s.io/apimachinery/pkg/api/errors"
 
 	v1alpha2 "github.com/holos-run/holos-console/api/v1alpha2"
+	"github.com/holos-run/holos-console/console/listfilter"
 	"github.com/holos-run/holos-console/console/rbac"
 	"github.com/holos-run/holos-console/console/rpc"
 	"github.com/holos-run/holos-console/console/secrets"
+	"github.com/holos-run/holos-console/console/validation"
 	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
 	"github.com/holos-run/holos-console/gen/holos/console/v1/consolev1connect"
 )
@@ -28,7 +30,8 @@ const maxFolderDepth = 3
 // Handler implements the FolderService.
 type Handler struct {
 	consolev1connect.UnimplementedFolderServiceHandler
-	k8s *K8sClient
+	k8s        *K8sClient
+	authorizer rbac.Authorizer
 }
 
 // NewHandler creates a new FolderService handler.
@@ -41,9 +44,10 @@ func (h *Handler) ListFolders(
 	ctx context.Context,
 	req *connect.Request[consolev1.ListFoldersRequest],
 ) (*connect.Response[consolev1.ListFoldersResponse], error) {
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	claims := rpc.MustClaims(ctx)
+	order, err := listfilter.NewOrder("order_by", req.Msg.OrderBy)
+	if err != nil {
+		return nil, err
 	}
 
 	// Resolve parent namespace filter when parent_type+parent_name are set.
@@ -61,6 +65,9 @@ func (h *Handler) ListFolders(
 		return nil, mapK8sError(err)
 	}
 
+	listfilter.Sort(order, allFolders, func(ns *corev1.Namespace) listfilter.SortKey {
+		return listfilter.KeyOf(ns.Name, ns)
+	})
 	var result []*consolev1.Folder
 	for _, ns := range allFolders {
 		shareUsers, _ := GetShareUsers(ns)
@@ -88,14 +95,7 @@ func (h *Handler) GetFolder(
 	ctx context.Context,
 	req *connect.Request[consolev1.GetFolderRequest],
 ) (*connect.Response[consolev1.GetFolderResponse], error) {
-	if req.Msg.Name == "" {
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("folder name is required"))
-	}
-
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
-	}
+	claims := rpc.MustClaims(ctx)
 
 	ns, err := h.k8s.GetFolder(ctx, req.Msg.Name)
 	if err != nil {
@@ -127,14 +127,11 @@ func (h *Handler) CreateFolder(
 	ctx context.Context,
 	req *connect.Request[consolev1.CreateFolderRequest],
 ) (*connect.Response[consolev1.CreateFolderResponse], error) {
-	if req.Msg.Organization == "" {
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("organization is required"))
-	}
-	if req.Msg.ParentName == "" {
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("parent_name is required"))
-	}
-	if req.Msg.ParentType == consolev1.ParentType_PARENT_TYPE_UNSPECIFIED {
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("parent_type is required"))
+	var v validation.Violations
+	v.UserGrants("user_grants", req.Msg.UserGrants)
+	v.RoleGrants("role_grants", req.Msg.RoleGrants)
+	if err := v.Err(); err != nil {
+		return nil, err
 	}
 
 	// Derive name from display_name when not explicitly provided.
@@ -145,9 +142,6 @@ func (h *Handler) CreateFolder(
 		return h.k8s.NamespaceExists(ctx, nsName)
 	}
 	if autoGenerated {
-		if req.Msg.DisplayName == "" {
-			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("folder name or display_name is required"))
-		}
 		generated, err := v1alpha2.GenerateIdentifier(ctx, req.Msg.DisplayName, prefix, exists)
 		if err != nil {
 			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("generating folder identifier: %w", err))
@@ -155,10 +149,7 @@ func (h *Handler) CreateFolder(
 		name = generated
 	}
 
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
-	}
+	claims := rpc.MustClaims(ctx)
 
 	// Resolve parent namespace.
 	parentNs, err := h.resolveParentNS(req.Msg.ParentType, req.Msg.ParentName)
@@ -172,7 +163,7 @@ func (h *Handler) CreateFolder(
 	}
 	parentShareUsers, _ := GetShareUsers(parentNamespace)
 	parentShareRoles, _ := GetShareRoles(parentNamespace)
-	if err := h.requireNamespaceOwner(ctx, claims, parentNamespace, parentShareUsers, parentShareRoles, "create folders"); err != nil {
+	if err := h.requireNamespaceOwner(ctx, claims, parentNamespace, parentShareUsers, parentShareRoles, rbac.PermissionFoldersCreate, "create folders"); err != nil {
 		return nil, err
 	}
 
@@ -241,14 +232,7 @@ func (h *Handler) UpdateFolder(
 	ctx context.Context,
 	req *connect.Request[consolev1.UpdateFolderRequest],
 ) (*connect.Response[consolev1.UpdateFolderResponse], error) {
-	if req.Msg.Name == "" {
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("folder name is required"))
-	}
-
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
-	}
+	claims := rpc.MustClaims(ctx)
 
 	ns, err := h.k8s.GetFolder(ctx, req.Msg.Name)
 	if err != nil {
@@ -433,14 +417,7 @@ func (h *Handler) DeleteFolder(
 	ctx context.Context,
 	req *connect.Request[consolev1.DeleteFolderRequest],
 ) (*connect.Response[consolev1.DeleteFolderResponse], error) {
-	if req.Msg.Name == "" {
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("folder name is required"))
-	}
-
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
-	}
+	claims := rpc.MustClaims(ctx)
 
 	if _, err := h.k8s.GetFolder(ctx, req.Msg.Name); err != nil {
 		return nil, mapK8sError(err)
@@ -487,14 +464,14 @@ func (h *Handler) UpdateFolderSharing(
 	ctx context.Context,
 	req *connect.Request[consolev1.UpdateFolderSharingRequest],
 ) (*connect.Response[consolev1.UpdateFolderSharingResponse], error) {
-	if req.Msg.Name == "" {
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("folder name is required"))
+	var v validation.Violations
+	v.UserGrants("user_grants", req.Msg.UserGrants)
+	v.RoleGrants("role_grants", req.Msg.RoleGrants)
+	if err := v.Err(); err != nil {
+		return nil, err
 	}
 
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
-	}
+	claims := rpc.MustClaims(ctx)
 
 	ns, err := h.k8s.GetFolder(ctx, req.Msg.Name)
 	if err != nil {
@@ -502,7 +479,7 @@ func (h *Handler) UpdateFolderSharing(
 	}
 	shareUsers, _ := GetShareUsers(ns)
 	shareRoles, _ := GetShareRoles(ns)
-	if err := h.requireNamespaceOwner(ctx, claims, ns, shareUsers, shareRoles, "folder sharing update"); err != nil {
+	if err := h.requireNamespaceOwner(ctx, claims, ns, shareUsers, shareRoles, rbac.PermissionFoldersAdmin, "folder sharing update"); err != nil {
 		return nil, err
 	}
 
@@ -546,14 +523,14 @@ func (h *Handler) UpdateFolderDefaultSharing(
 	ctx context.Context,
 	req *connect.Request[consolev1.UpdateFolderDefaultSharingRequest],
 ) (*connect.Response[consolev1.UpdateFolderDefaultSharingResponse], error) {
-	if req.Msg.Name == "" {
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("folder name is required"))
+	var v validation.Violations
+	v.UserGrants("default_user_grants", req.Msg.DefaultUserGrants)
+	v.RoleGrants("default_role_grants", req.Msg.DefaultRoleGrants)
+	if err := v.Err(); err != nil {
+		return nil, err
 	}
 
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
-	}
+	claims := rpc.MustClaims(ctx)
 
 	ns, err := h.k8s.GetFolder(ctx, req.Msg.Name)
 	if err != nil {
@@ -561,7 +538,7 @@ func (h *Handler) UpdateFolderDefaultSharing(
 	}
 	shareUsers, _ := GetShareUsers(ns)
 	shareRoles, _ := GetShareRoles(ns)
-	if err := h.requireNamespaceOwner(ctx, claims, ns, shareUsers, shareRoles, "folder default sharing update"); err != nil {
+	if err := h.requireNamespaceOwner(ctx, claims, ns, shareUsers, shareRoles, rbac.PermissionFoldersAdmin, "folder default sharing update"); err != nil {
 		return nil, err
 	}
 
@@ -595,14 +572,7 @@ func (h *Handler) GetFolderRaw(
 	ctx context.Context,
 	req *connect.Request[consolev1.GetFolderRawRequest],
 ) (*connect.Response[consolev1.GetFolderRawResponse], error) {
-	if req.Msg.Name == "" {
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("folder name is required"))
-	}
-
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
-	}
+	claims := rpc.MustClaims(ctx)
 
 	ns, err := h.k8s.GetFolder(ctx, req.Msg.Name)
 	if err != nil {
@@ -635,14 +605,7 @@ func (h *Handler) CheckFolderIdentifier(
 	ctx context.Context,
 	req *connect.Request[consolev1.CheckFolderIdentifierRequest],
 ) (*connect.Response[consolev1.CheckFolderIdentifierResponse], error) {
-	if req.Msg.Identifier == "" {
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("identifier is required"))
-	}
-
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
-	}
+	claims := rpc.MustClaims(ctx)
 
 	prefix := h.k8s.Resolver.NamespacePrefix + h.k8s.Resolver.FolderPrefix
 	exists := func(ctx context.Context, nsName string) (bool, error) {
@@ -766,25 +729,20 @@ func mergeGrants(base, override []secrets.AnnotationGrant) []secrets.AnnotationG
 	return result
 }
 
-func (h *Handler) effectiveRoleForNamespace(ctx context.Context, claims *rpc.Claims, ns *corev1.Namespace, shareUsers, shareRoles []secrets.AnnotationGrant) rbac.Role {
-	if rpc.HasImpersonatedClients(ctx) {
-		if ok, err := h.k8s.canVerbNamespace(ctx, "delete", ns.Name); err == nil && ok {
-			return rbac.RoleOwner
-		}
-		if ok, err := h.k8s.canVerbNamespace(ctx, "update", ns.Name); err == nil && ok {
-			return rbac.RoleEditor
-		}
-		if ok, err := h.k8s.canVerbNamespace(ctx, "get", ns.Name); err == nil && ok {
-			return rbac.RoleViewer
-		}
-		return rbac.RoleUnspecified
+// namespaceResource describes ns and its active share grants to the
+// authorizer.
+func namespaceResource(ns *corev1.Namespace, shareUsers, shareRoles []secrets.AnnotationGrant) *rbac.Resource {
+	now := time.Now()
+	return &rbac.Resource{
+		Namespace: ns.Name,
+		Users:     secrets.ActiveGrantsMap(shareUsers, now),
+		Roles:     secrets.ActiveGrantsMap(shareRoles, now),
 	}
-	return rbac.BestRoleFromGrants(
-		claims.Email,
-		claims.Roles,
-		secrets.ActiveGrantsMap(shareUsers, time.Now()),
-		secrets.ActiveGrantsMap(shareRoles, time.Now()),
-	)
+}
+
+func (h *Handler) effectiveRoleForNamespace(ctx context.Context, claims *rpc.Claims, ns *corev1.Namespace, shareUsers, shareRoles []secrets.AnnotationGrant) rbac.Role {
+	role, _ := rbac.EffectiveRole(ctx, claims, namespaceResource(ns, shareUsers, shareRoles))
+	return role
 }
 
 // requireReparentOwner enforces Owner-level permission on a parent namespace
@@ -802,24 +760,18 @@ func (h *Handler) requireReparentOwner(ctx context.Context, claims *rpc.Claims,
 	}
 	parentShareUsers, _ := GetShareUsers(parent)
 	parentShareRoles, _ := GetShareRoles(parent)
-	return h.requireNamespaceOwner(ctx, claims, parent, parentShareUsers, parentShareRoles, action)
+	return h.requireNamespaceOwner(ctx, claims, parent, parentShareUsers, parentShareRoles, rbac.PermissionFoldersCreate, action)
 }
 
-func (h *Handler) requireNamespaceOwner(ctx context.Context, claims *rpc.Claims, ns *corev1.Namespace, shareUsers, shareRoles []secrets.AnnotationGrant, action string) error {
-	if rpc.HasImpersonatedClients(ctx) {
-		ok, err := h.k8s.canVerbNamespace(ctx, "delete", ns.Name)
-		if err != nil {
-			return mapK8sError(err)
-		}
-		if ok {
-			return nil
-		}
-		return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("RBAC: not authorized to %s", action))
-	}
-	if h.effectiveRoleForNamespace(ctx, claims, ns, shareUsers, shareRoles) == rbac.RoleOwner {
+func (h *Handler) requireNamespaceOwner(ctx context.Context, claims *rpc.Claims, ns *corev1.Namespace, shareUsers, shareRoles []secrets.AnnotationGrant, permission rbac.Permission, action string) error {
+	err := h.authorizer.Authorize(ctx, claims, namespaceResource(ns, shareUsers, shareRoles), permission)
+	if err == nil {
 		return nil
 	}
-	return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("RBAC: not authorized to %s", action))
+	if connect.CodeOf(err) == connect.CodePermissionDenied {
+		return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("RBAC: not authorized to %s", action))
+	}
+	return mapK8sError(err)
 }
 
 // buildFolder creates a Folder proto message from a namespace.
@@ -18,6 +18,7 @@ import (
 
 	v1alpha2 "github.com/holos-run/holos-console/api/v1alpha2"
 	"github.com/holos-run/holos-console/console/rpc"
+	"github.com/holos-run/holos-console/console/rpc/rpctesting"
 	secrpkg "github.com/holos-run/holos-console/console/secrets"
 	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
 )
@@ -94,28 +95,16 @@ func orgNSWithGrants(name, shareUsersJSON string) *corev1.Namespace {
 
 // ---- ListFolders tests ----
 
-func TestListFolders_Unauthenticated(t *testing.T) {
-	handler := newTestHandler()
-	_, err := handler.ListFolders(context.Background(), connect.NewRequest(&consolev1.ListFoldersRequest{}))
-	assertUnauthenticated(t, err)
-}
-
 // ---- GetFolder tests ----
 
 func TestGetFolder_EmptyNameRejects(t *testing.T) {
 	handler := newTestHandler()
 	ctx := contextWithClaims("alice@example.com")
 
-	_, err := handler.GetFolder(ctx, connect.NewRequest(&consolev1.GetFolderRequest{Name: ""}))
+	_, err := rpctesting.Validated(ctx, connect.NewRequest(&consolev1.GetFolderRequest{Name: ""}), handler.GetFolder)
 	assertInvalidArgument(t, err)
 }
 
-func TestGetFolder_Unauthenticated(t *testing.T) {
-	handler := newTestHandler()
-	_, err := handler.GetFolder(context.Background(), connect.NewRequest(&consolev1.GetFolderRequest{Name: "eng"}))
-	assertUnauthenticated(t, err)
-}
-
 // ---- CreateFolder tests ----
 
 func TestCreateFolder_UnderOrg_Depth1(t *testing.T) {
@@ -316,25 +305,14 @@ func TestCreateFolder_MissingNameAndDisplayName(t *testing.T) {
 	handler := newTestHandler()
 	ctx := contextWithClaims("alice@example.com")
 
-	_, err := handler.CreateFolder(ctx, connect.NewRequest(&consolev1.CreateFolderRequest{
+	_, err := rpctesting.Validated(ctx, connect.NewRequest(&consolev1.CreateFolderRequest{
 		Organization: "acme",
 		ParentType:   consolev1.ParentType_PARENT_TYPE_ORGANIZATION,
 		ParentName:   "acme",
-	}))
+	}), handler.CreateFolder)
 	assertInvalidArgument(t, err)
 }
 
-func TestCreateFolder_Unauthenticated(t *testing.T) {
-	handler := newTestHandler()
-	_, err := handler.CreateFolder(context.Background(), connect.NewRequest(&consolev1.CreateFolderRequest{
-		Name:         "eng",
-		Organization: "acme",
-		ParentType:   consolev1.ParentType_PARENT_TYPE_ORGANIZATION,
-		ParentName:   "acme",
-	}))
-	assertUnauthenticated(t, err)
-}
-
 func TestCreateFolder_CreatorIsAutoOwner(t *testing.T) {
 	orgNs := orgNSWithGrants("acme", `[{"principal":"alice@example.com","role":"owner"}]`)
 	fakeClient := fake.NewClientset(orgNs)
@@ -692,20 +670,12 @@ func TestCheckFolderIdentifier_EmptyRejects(t *testing.T) {
 	handler := newTestHandler()
 	ctx := contextWithClaims("alice@example.com")
 
-	_, err := handler.CheckFolderIdentifier(ctx, connect.NewRequest(&consolev1.CheckFolderIdentifierRequest{
+	_, err := rpctesting.Validated(ctx, connect.NewRequest(&consolev1.CheckFolderIdentifierRequest{
 		Identifier: "",
-	}))
+	}), handler.CheckFolderIdentifier)
 	assertInvalidArgument(t, err)
 }
 
-func TestCheckFolderIdentifier_Unauthenticated(t *testing.T) {
-	handler := newTestHandler()
-	_, err := handler.CheckFolderIdentifier(context.Background(), connect.NewRequest(&consolev1.CheckFolderIdentifierRequest{
-		Identifier: "eng",
-	}))
-	assertUnauthenticated(t, err)
-}
-
 func TestCheckFolderIdentifier_NonSlugReturnsUnavailable(t *testing.T) {
 	// No folder namespace exists, but the input is not a valid slug.
 	// Should return available=false with the slugified form as suggestion.
@@ -882,20 +852,6 @@ func TestUpdateFolder_Reparent_DepthAllowed(t *testing.T) {
 
 // ---- helpers ----
 
-func assertUnauthenticated(t *testing.T, err error) {
-	t.Helper()
-	if err == nil {
-		t.Fatal("expected error, got nil")
-	}
-	connectErr, ok := err.(*connect.Error)
-	if !ok {
-		t.Fatalf("expected *connect.Error, got %T", err)
-	}
-	if connectErr.Code() != connect.CodeUnauthenticated {
-		t.Errorf("expected CodeUnauthenticated, got %v", connectErr.Code())
-	}
-}
-
 func assertInvalidArgument(t *testing.T, err error) {
 	t.Helper()
 	if err == nil {
@@ -13,7 +13,6 @@ import (
 	"github.com/holos-run/holos-console/console/rpc"
 	"github.com/holos-run/holos-console/console/secrets"
 	"github.com/holos-run/holos-console/console/sharing/legacy" //nolint:staticcheck // Folders still expose legacy grant-derived role hints.
-	authv1 "k8s.io/api/authorization/v1"
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -38,27 +37,6 @@ func (c *K8sClient) clientset(ctx context.Context) kubernetes.Interface {
 	return c.client
 }
 
-func (c *K8sClient) canVerbNamespace(ctx context.Context, verb, name string) (bool, error) {
-	if !rpc.HasImpersonatedClients(ctx) {
-		return true, nil
-	}
-	review := &authv1.SelfSubjectAccessReview{
-		Spec: authv1.SelfSubjectAccessReviewSpec{
-			ResourceAttributes: &authv1.ResourceAttributes{
-				Verb:     verb,
-				Group:    "",
-				Resource: "namespaces",
-				Name:     name,
-			},
-		},
-	}
-	got, err := rpc.ImpersonatedClientsetFromContext(ctx).AuthorizationV1().SelfSubjectAccessReviews().Create(ctx, review, metav1.CreateOptions{})
-	if err != nil {
-		return false, err
-	}
-	return got.Status.Allowed, nil
-}
-
 // ListFolders returns all folder namespaces. When org is non-empty, filters by
 // organization label. When parentNs is non-empty, filters to direct children of
 // that parent namespace.
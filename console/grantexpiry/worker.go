@@ -0,0 +1,311 @@
+// Package grantexpiry watches time-bounded sharing grants stored in
+// annotations on organization, folder and project Namespaces and on
+// Secrets. It reports grants about to expire to the audit pipeline, where
+// notifications pick them up, exports counts as metrics, and removes grants
+// that expired long ago so annotations do not grow without bound.
+package grantexpiry
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	v1alpha2 "github.com/holos-run/holos-console/api/v1alpha2"
+	"github.com/holos-run/holos-console/console/secrets"
+)
+
+const (
+	// ActionExpiring is the audit action logged once for each grant about
+	// to expire.
+	ActionExpiring = "grant_expiring"
+	// ActionPurge is the audit action logged when expired grants are
+	// removed from an object.
+	ActionPurge = "grant_purge"
+
+	resourceTypeSecret = "secret"
+)
+
+// namespaceAnnotations and secretAnnotations hold the grants of each object
+// kind.
+var (
+	namespaceAnnotations = []string{
+		v1alpha2.AnnotationShareUsers,
+		v1alpha2.AnnotationShareRoles,
+		v1alpha2.AnnotationDefaultShareUsers,
+		v1alpha2.AnnotationDefaultShareRoles,
+	}
+	secretAnnotations = []string{
+		v1alpha2.AnnotationShareKeyUsers,
+		v1alpha2.AnnotationShareKeyRoles,
+		v1alpha2.AnnotationShareDenyUsers,
+		v1alpha2.AnnotationShareDenyRoles,
+	}
+)
+
+var (
+	grantsExpiring = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "grants_expiring",
+			Help: "Number of sharing grants that expire within the warning window, by resource type.",
+		},
+		[]string{"resource_type"},
+	)
+	grantsExpired = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "grants_expired",
+			Help: "Number of expired sharing grants still stored in annotations, by resource type.",
+		},
+		[]string{"resource_type"},
+	)
+	grantsPurgedTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "grants_purged_total",
+			Help: "Total number of expired sharing grants removed from annotations, by resource type.",
+		},
+		[]string{"resource_type"},
+	)
+)
+
+// Worker scans grants with the console service account.
+type Worker struct {
+	client    kubernetes.Interface
+	warning   time.Duration
+	retention time.Duration
+	interval  time.Duration
+	now       func() time.Time
+
+	// reported holds the expiry of each grant already reported, so each is
+	// reported once per process.
+	reported map[string]time.Time
+}
+
+// NewWorker returns a Worker that reports grants expiring within warning
+// and removes grants expired for longer than retention, scanning every
+// interval. A zero retention keeps expired grants.
+func NewWorker(client kubernetes.Interface, warning, retention, interval time.Duration) *Worker {
+	return &Worker{
+		client:    client,
+		warning:   warning,
+		retention: retention,
+		interval:  interval,
+		now:       time.Now,
+		reported:  map[string]time.Time{},
+	}
+}
+
+// Run scans immediately and then each interval until ctx is cancelled.
+// Errors are logged and retried on the next pass.
+func (w *Worker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	for {
+		if err := w.ScanNow(ctx); err != nil {
+			slog.ErrorContext(ctx, "scanning grant expiry", slog.Any("error", err))
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// object is a Namespace or Secret holding grants, with the audit attributes
+// that identify it.
+type object struct {
+	meta         *metav1.ObjectMeta
+	resourceType string
+	attrs        []slog.Attr
+	annotations  []string
+	update       func(context.Context) error
+}
+
+// counts tallies grants by resource type for the metrics.
+type counts map[string]float64
+
+// ScanNow runs a single pass over every console-managed Namespace and
+// Secret.
+func (w *Worker) ScanNow(ctx context.Context) error {
+	now := w.now()
+	for key, exp := range w.reported {
+		if !exp.After(now) {
+			delete(w.reported, key)
+		}
+	}
+	selector := v1alpha2.LabelManagedBy + "=" + v1alpha2.ManagedByValue + "," + v1alpha2.LabelDeleted + "!=" + v1alpha2.DeletedValue
+	namespaces, err := w.client.CoreV1().Namespaces().List(ctx, metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return err
+	}
+	list, err := w.client.CoreV1().Secrets(metav1.NamespaceAll).List(ctx, metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return err
+	}
+
+	var objects []object
+	projectOf := map[string]*corev1.Namespace{}
+	for i := range namespaces.Items {
+		ns := &namespaces.Items[i]
+		resourceType := ns.Labels[v1alpha2.LabelResourceType]
+		var name string
+		switch resourceType {
+		case v1alpha2.ResourceTypeOrganization:
+			name = ns.Labels[v1alpha2.LabelOrganization]
+		case v1alpha2.ResourceTypeFolder:
+			name = ns.Labels[v1alpha2.LabelFolder]
+		case v1alpha2.ResourceTypeProject:
+			name = ns.Labels[v1alpha2.LabelProject]
+			projectOf[ns.Name] = ns
+		default:
+			continue
+		}
+		attrs := []slog.Attr{slog.String(resourceType, name)}
+		if org := ns.Labels[v1alpha2.LabelOrganization]; org != "" && resourceType != v1alpha2.ResourceTypeOrganization {
+			attrs = append(attrs, slog.String("organization", org))
+		}
+		objects = append(objects, object{
+			meta:         &ns.ObjectMeta,
+			resourceType: resourceType,
+			attrs:        attrs,
+			annotations:  namespaceAnnotations,
+			update: func(ctx context.Context) error {
+				_, err := w.client.CoreV1().Namespaces().Update(ctx, ns, metav1.UpdateOptions{})
+				return err
+			},
+		})
+	}
+	for i := range list.Items {
+		secret := &list.Items[i]
+		attrs := []slog.Attr{slog.String(resourceTypeSecret, secret.Name)}
+		if ns, ok := projectOf[secret.Namespace]; ok {
+			attrs = append(attrs,
+				slog.String("project", ns.Labels[v1alpha2.LabelProject]),
+				slog.String("organization", ns.Labels[v1alpha2.LabelOrganization]),
+			)
+		}
+		objects = append(objects, object{
+			meta:         &secret.ObjectMeta,
+			resourceType: resourceTypeSecret,
+			attrs:        attrs,
+			annotations:  secretAnnotations,
+			update: func(ctx context.Context) error {
+				_, err := w.client.CoreV1().Secrets(secret.Namespace).Update(ctx, secret, metav1.UpdateOptions{})
+				return err
+			},
+		})
+	}
+
+	expiring, expired := counts{}, counts{}
+	var errs []error
+	for _, obj := range objects {
+		if err := w.scan(ctx, obj, now, expiring, expired); err != nil {
+			errs = append(errs, fmt.Errorf("%s %s/%s: %w", obj.resourceType, obj.meta.Namespace, obj.meta.Name, err))
+		}
+	}
+	grantsExpiring.Reset()
+	grantsExpired.Reset()
+	for resourceType, n := range expiring {
+		grantsExpiring.WithLabelValues(resourceType).Set(n)
+	}
+	for resourceType, n := range expired {
+		grantsExpired.WithLabelValues(resourceType).Set(n)
+	}
+	return errors.Join(errs...)
+}
+
+// scan reports and counts the grants on obj and removes those expired past
+// the retention window.
+func (w *Worker) scan(ctx context.Context, obj object, now time.Time, expiring, expired counts) error {
+	purged := 0
+	for _, key := range obj.annotations {
+		raw, ok := obj.meta.Annotations[key]
+		if !ok || raw == "" {
+			continue
+		}
+		var grants []secrets.AnnotationGrant
+		if err := json.Unmarshal([]byte(raw), &grants); err != nil {
+			return fmt.Errorf("invalid %s annotation: %w", key, err)
+		}
+		kept := grants[:0:0]
+		for _, g := range grants {
+			if g.Exp == nil {
+				kept = append(kept, g)
+				continue
+			}
+			exp := time.Unix(*g.Exp, 0)
+			switch {
+			case w.retention > 0 && now.Sub(exp) > w.retention:
+				purged++
+				continue
+			case !exp.After(now):
+				expired[obj.resourceType]++
+			case exp.Sub(now) <= w.warning:
+				expiring[obj.resourceType]++
+				w.report(ctx, obj, key, g, exp)
+			}
+			kept = append(kept, g)
+		}
+		if len(kept) == len(grants) {
+			continue
+		}
+		if len(kept) == 0 {
+			delete(obj.meta.Annotations, key)
+			continue
+		}
+		value, err := json.Marshal(kept)
+		if err != nil {
+			return fmt.Errorf("encoding %s annotation: %w", key, err)
+		}
+		obj.meta.Annotations[key] = string(value)
+	}
+	if purged == 0 {
+		return nil
+	}
+	if err := obj.update(ctx); err != nil {
+		return err
+	}
+	grantsPurgedTotal.WithLabelValues(obj.resourceType).Add(float64(purged))
+	args := []any{
+		slog.String("action", ActionPurge),
+		slog.String("resource_type", obj.resourceType),
+		slog.Int("purged", purged),
+	}
+	for _, a := range obj.attrs {
+		args = append(args, a)
+	}
+	slog.InfoContext(ctx, "expired grants purged", args...)
+	return nil
+}
+
+// report logs an audit event for g unless it has already been reported.
+func (w *Worker) report(ctx context.Context, obj object, annotation string, g secrets.AnnotationGrant, exp time.Time) {
+	key := fmt.Sprintf("%s/%s/%s/%s/%s/%d", obj.resourceType, obj.meta.Namespace, obj.meta.Name, annotation, g.Principal, *g.Exp)
+	if _, ok := w.reported[key]; ok {
+		return
+	}
+	w.reported[key] = exp
+	args := []any{
+		slog.String("action", ActionExpiring),
+		slog.String("resource_type", obj.resourceType),
+	}
+	for _, a := range obj.attrs {
+		args = append(args, a)
+	}
+	args = append(args,
+		slog.String("annotation", annotation),
+		slog.String("principal", g.Principal),
+		slog.String("role", g.Role),
+		slog.Bool("deny", g.Deny),
+		slog.String("expires_at", exp.UTC().Format(time.RFC3339)),
+	)
+	slog.InfoContext(ctx, "grant expiring", args...)
+}
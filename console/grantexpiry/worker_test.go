@@ -0,0 +1,113 @@
+package grantexpiry
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	v1alpha2 "github.com/holos-run/holos-console/api/v1alpha2"
+	"github.com/holos-run/holos-console/console/audit"
+)
+
+var managed = map[string]string{v1alpha2.LabelManagedBy: v1alpha2.ManagedByValue}
+
+func labels(extra map[string]string) map[string]string {
+	out := map[string]string{}
+	for k, v := range managed {
+		out[k] = v
+	}
+	for k, v := range extra {
+		out[k] = v
+	}
+	return out
+}
+
+func grant(principal string, exp time.Time) string {
+	return fmt.Sprintf(`{"principal":%q,"role":"viewer","exp":%d}`, principal, exp.Unix())
+}
+
+func TestWorker(t *testing.T) {
+	now := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	project := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+		Name: "holos-prj-billing",
+		Labels: labels(map[string]string{
+			v1alpha2.LabelResourceType: v1alpha2.ResourceTypeProject,
+			v1alpha2.LabelProject:      "billing",
+			v1alpha2.LabelOrganization: "acme",
+		}),
+		Annotations: map[string]string{
+			v1alpha2.AnnotationShareUsers: "[" + grant("soon@example.com", now.Add(time.Hour)) + "," +
+				grant("later@example.com", now.Add(30*24*time.Hour)) + "," +
+				grant("recent@example.com", now.Add(-time.Hour)) + "," +
+				grant("ancient@example.com", now.Add(-90*24*time.Hour)) + "," +
+				`{"principal":"forever@example.com","role":"owner"}]`,
+		},
+	}}
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{
+		Name:      "db",
+		Namespace: "holos-prj-billing",
+		Labels:    labels(nil),
+		Annotations: map[string]string{
+			v1alpha2.AnnotationShareKeyUsers: "[" + grant("ancient@example.com", now.Add(-90*24*time.Hour)) + "]",
+		},
+	}}
+	client := fake.NewClientset(project, secret)
+
+	ring := audit.NewRing(20)
+	oldLogger := slog.Default()
+	slog.SetDefault(slog.New(audit.NewLogHandler(slog.NewTextHandler(io.Discard, nil), ring)))
+	defer slog.SetDefault(oldLogger)
+
+	w := NewWorker(client, 72*time.Hour, 30*24*time.Hour, time.Hour)
+	w.now = func() time.Time { return now }
+	for range 2 {
+		if err := w.ScanNow(context.Background()); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	}
+
+	expiring := ring.List(audit.Filter{Action: ActionExpiring})
+	if len(expiring) != 1 {
+		t.Fatalf("expected one grant_expiring event, got %v", expiring)
+	}
+	if e := expiring[0]; e.Project != "billing" || e.Attributes["principal"] != "soon@example.com" || e.Attributes["organization"] != "acme" {
+		t.Errorf("unexpected event %+v", e)
+	}
+	if got := len(ring.List(audit.Filter{Action: ActionPurge})); got != 2 {
+		t.Errorf("expected purge events for the project and the secret, got %d", got)
+	}
+
+	ns, err := client.CoreV1().Namespaces().Get(context.Background(), project.Name, metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "[" + grant("soon@example.com", now.Add(time.Hour)) + "," +
+		grant("later@example.com", now.Add(30*24*time.Hour)) + "," +
+		grant("recent@example.com", now.Add(-time.Hour)) + "," +
+		`{"principal":"forever@example.com","role":"owner"}]`
+	if got := ns.Annotations[v1alpha2.AnnotationShareUsers]; got != want {
+		t.Errorf("share-users = %s\nwant %s", got, want)
+	}
+	s, err := client.CoreV1().Secrets(secret.Namespace).Get(context.Background(), secret.Name, metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := s.Annotations[v1alpha2.AnnotationShareKeyUsers]; ok {
+		t.Errorf("expected empty share-key-users annotation to be removed, got %v", s.Annotations)
+	}
+
+	if got := testutil.ToFloat64(grantsExpiring.WithLabelValues(v1alpha2.ResourceTypeProject)); got != 1 {
+		t.Errorf("grants_expiring{project} = %v, want 1", got)
+	}
+	if got := testutil.ToFloat64(grantsExpired.WithLabelValues(v1alpha2.ResourceTypeProject)); got != 1 {
+		t.Errorf("grants_expired{project} = %v, want 1", got)
+	}
+}
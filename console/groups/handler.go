@@ -0,0 +1,191 @@
+// Package groups implements the GroupsService: console-local group
+// membership for identity providers that do not issue groups, such as
+// embedded Dex with static users.
+package groups
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sort"
+	"strings"
+
+	"connectrpc.com/connect"
+
+	"github.com/holos-run/holos-console/console/permissions"
+	"github.com/holos-run/holos-console/console/rpc"
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+	"github.com/holos-run/holos-console/gen/holos/console/v1/consolev1connect"
+)
+
+const (
+	auditResourceType = "group"
+
+	// Group and Resource identify the virtual resource the API server
+	// authorizes the GroupsService against. Grant access with a ClusterRole
+	// rule for verbs "list" and "update" on groups.console.holos.run.
+	Group    = "console.holos.run"
+	Resource = "groups"
+)
+
+// Handler implements consolev1connect.GroupsServiceHandler.
+type Handler struct {
+	consolev1connect.UnimplementedGroupsServiceHandler
+	k8s *K8sClient
+}
+
+// NewHandler creates a GroupsService handler.
+func NewHandler(k8s *K8sClient) *Handler {
+	return &Handler{k8s: k8s}
+}
+
+// ListGroups returns every console-local group.
+func (h *Handler) ListGroups(
+	ctx context.Context,
+	req *connect.Request[consolev1.ListGroupsRequest],
+) (*connect.Response[consolev1.ListGroupsResponse], error) {
+	if err := h.authorize(ctx, "list"); err != nil {
+		return nil, err
+	}
+	m, err := h.k8s.List(ctx)
+	if err != nil {
+		return nil, rpc.MapK8sError(err)
+	}
+	names := make([]string, 0, len(m))
+	for name := range m {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	resp := &consolev1.ListGroupsResponse{Groups: make([]*consolev1.Group, 0, len(names))}
+	for _, name := range names {
+		resp.Groups = append(resp.Groups, &consolev1.Group{Name: name, Members: slices.Clone(m[name])})
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// AddGroupMember adds a member to a group.
+func (h *Handler) AddGroupMember(
+	ctx context.Context,
+	req *connect.Request[consolev1.AddGroupMemberRequest],
+) (*connect.Response[consolev1.AddGroupMemberResponse], error) {
+	claims := rpc.MustClaims(ctx)
+	if err := h.authorize(ctx, "update"); err != nil {
+		return nil, err
+	}
+	group, member := req.Msg.Group, normalize(req.Msg.Member)
+	m, err := h.k8s.Update(ctx, func(m memberships) { m.add(group, member) })
+	if err != nil {
+		return nil, rpc.MapK8sError(err)
+	}
+
+	slog.InfoContext(ctx, "group member added",
+		slog.String("action", "group_member_add"),
+		slog.String("resource_type", auditResourceType),
+		slog.String("name", group),
+		slog.String("member", member),
+		slog.String("sub", claims.Sub),
+		slog.String("email", claims.Email),
+	)
+	return connect.NewResponse(&consolev1.AddGroupMemberResponse{
+		Group: &consolev1.Group{Name: group, Members: m[group]},
+	}), nil
+}
+
+// RemoveGroupMember removes a member from a group.
+func (h *Handler) RemoveGroupMember(
+	ctx context.Context,
+	req *connect.Request[consolev1.RemoveGroupMemberRequest],
+) (*connect.Response[consolev1.RemoveGroupMemberResponse], error) {
+	claims := rpc.MustClaims(ctx)
+	if err := h.authorize(ctx, "update"); err != nil {
+		return nil, err
+	}
+	group, member := req.Msg.Group, normalize(req.Msg.Member)
+	removed := false
+	m, err := h.k8s.Update(ctx, func(m memberships) { removed = m.remove(group, member) })
+	if err != nil {
+		return nil, rpc.MapK8sError(err)
+	}
+	if !removed {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("%q is not a member of group %q", member, group))
+	}
+
+	slog.InfoContext(ctx, "group member removed",
+		slog.String("action", "group_member_remove"),
+		slog.String("resource_type", auditResourceType),
+		slog.String("name", group),
+		slog.String("member", member),
+		slog.String("sub", claims.Sub),
+		slog.String("email", claims.Email),
+	)
+	resp := &consolev1.RemoveGroupMemberResponse{}
+	if members, ok := m[group]; ok {
+		resp.Group = &consolev1.Group{Name: group, Members: members}
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// authorize asks the API server whether the caller may perform verb on the
+// virtual groups.console.holos.run resource.
+func (h *Handler) authorize(ctx context.Context, verb string) error {
+	clientset := rpc.ImpersonatedClientsetFromContext(ctx)
+	if clientset == nil {
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("no kubernetes client on request context"))
+	}
+	perm, err := permissions.Review(ctx, clientset, &consolev1.ResourceAttributes{
+		Verb:     verb,
+		Group:    Group,
+		Resource: Resource,
+	})
+	if err != nil {
+		return rpc.MapK8sError(err)
+	}
+	if !perm.Allowed {
+		return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("not allowed to %s groups", verb))
+	}
+	return nil
+}
+
+// normalize returns the canonical form of a member email.
+func normalize(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// groupsOf returns the sorted groups email is a member of.
+func (m memberships) groupsOf(email string) []string {
+	email = normalize(email)
+	var groups []string
+	for name, members := range m {
+		if _, found := slices.BinarySearch(members, email); found {
+			groups = append(groups, name)
+		}
+	}
+	sort.Strings(groups)
+	return groups
+}
+
+// add inserts member into group, keeping members sorted.
+func (m memberships) add(group, member string) {
+	members := m[group]
+	if i, found := slices.BinarySearch(members, member); !found {
+		m[group] = slices.Insert(members, i, member)
+	}
+}
+
+// remove deletes member from group and reports whether it was present. A
+// group left empty is deleted.
+func (m memberships) remove(group, member string) bool {
+	members := m[group]
+	i, found := slices.BinarySearch(members, member)
+	if !found {
+		return false
+	}
+	members = slices.Delete(members, i, i+1)
+	if len(members) == 0 {
+		delete(m, group)
+	} else {
+		m[group] = members
+	}
+	return true
+}
@@ -0,0 +1,134 @@
+package groups
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	authzv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	clienttesting "k8s.io/client-go/testing"
+
+	"github.com/holos-run/holos-console/console/rpc"
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+)
+
+const testNamespace = "holos-system"
+
+// ssarClient returns a fake clientset that answers every
+// SelfSubjectAccessReview with allowed and records the reviewed attributes.
+func ssarClient(allowed bool, seen *[]authzv1.ResourceAttributes) *fake.Clientset {
+	clientset := fake.NewClientset()
+	clientset.PrependReactor("create", "selfsubjectaccessreviews", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		ssar := action.(clienttesting.CreateAction).GetObject().(*authzv1.SelfSubjectAccessReview)
+		if seen != nil {
+			*seen = append(*seen, *ssar.Spec.ResourceAttributes)
+		}
+		ssar.Status = authzv1.SubjectAccessReviewStatus{Allowed: allowed}
+		return true, ssar, nil
+	})
+	return clientset
+}
+
+func testContext(clientset *fake.Clientset) context.Context {
+	ctx := rpc.ContextWithClaims(context.Background(), &rpc.Claims{Sub: "admin", Email: "admin@example.com"})
+	return rpc.ContextWithImpersonatedClients(ctx, &rpc.ImpersonatedClients{Clientset: clientset})
+}
+
+func TestGroupMembership(t *testing.T) {
+	k8s := NewK8sClient(fake.NewClientset(), testNamespace)
+	h := NewHandler(k8s)
+	var seen []authzv1.ResourceAttributes
+	ctx := testContext(ssarClient(true, &seen))
+
+	add := func(group, member string) *consolev1.Group {
+		t.Helper()
+		resp, err := h.AddGroupMember(ctx, connect.NewRequest(&consolev1.AddGroupMemberRequest{Group: group, Member: member}))
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		return resp.Msg.Group
+	}
+	add("platform", "Bob@example.com")
+	add("platform", "alice@example.com")
+	if got := add("platform", "alice@example.com"); !slices.Equal(got.Members, []string{"alice@example.com", "bob@example.com"}) {
+		t.Errorf("expected sorted, de-duplicated members, got %v", got.Members)
+	}
+	add("sre", "alice@example.com")
+
+	if len(seen) == 0 || seen[0].Verb != "update" || seen[0].Group != Group || seen[0].Resource != Resource {
+		t.Errorf("unexpected access review %+v", seen)
+	}
+
+	groups, err := k8s.GroupsFor(context.Background(), "ALICE@example.com")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !slices.Equal(groups, []string{"platform", "sre"}) {
+		t.Errorf("GroupsFor = %v", groups)
+	}
+
+	resp, err := h.RemoveGroupMember(ctx, connect.NewRequest(&consolev1.RemoveGroupMemberRequest{Group: "sre", Member: "alice@example.com"}))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if resp.Msg.Group != nil {
+		t.Errorf("expected empty group to be deleted, got %v", resp.Msg.Group)
+	}
+	_, err = h.RemoveGroupMember(ctx, connect.NewRequest(&consolev1.RemoveGroupMemberRequest{Group: "sre", Member: "alice@example.com"}))
+	if connect.CodeOf(err) != connect.CodeNotFound {
+		t.Errorf("expected NotFound, got %v", err)
+	}
+
+	list, err := h.ListGroups(ctx, connect.NewRequest(&consolev1.ListGroupsRequest{}))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(list.Msg.Groups) != 1 || list.Msg.Groups[0].Name != "platform" {
+		t.Errorf("unexpected groups %v", list.Msg.Groups)
+	}
+}
+
+func TestGroupsRequireAccess(t *testing.T) {
+	h := NewHandler(NewK8sClient(fake.NewClientset(), testNamespace))
+	ctx := testContext(ssarClient(false, nil))
+	if _, err := h.ListGroups(ctx, connect.NewRequest(&consolev1.ListGroupsRequest{})); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Errorf("expected PermissionDenied, got %v", err)
+	}
+	_, err := h.AddGroupMember(ctx, connect.NewRequest(&consolev1.AddGroupMemberRequest{Group: "platform", Member: "alice@example.com"}))
+	if connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Errorf("expected PermissionDenied, got %v", err)
+	}
+}
+
+func TestGroupsForCachesAndNormalizes(t *testing.T) {
+	client := fake.NewClientset(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: ConfigMapName, Namespace: testNamespace},
+		// Edited by hand: unsorted, mixed case.
+		Data: map[string]string{dataKey: `{"platform":["zed@example.com","Alice@Example.com"]}`},
+	})
+	k8s := NewK8sClient(client, testNamespace)
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	k8s.now = func() time.Time { return now }
+
+	groups, err := k8s.GroupsFor(context.Background(), "alice@example.com")
+	if err != nil || !slices.Equal(groups, []string{"platform"}) {
+		t.Fatalf("GroupsFor = %v, %v", groups, err)
+	}
+
+	if err := client.CoreV1().ConfigMaps(testNamespace).Delete(context.Background(), ConfigMapName, metav1.DeleteOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if groups, _ := k8s.GroupsFor(context.Background(), "alice@example.com"); len(groups) != 1 {
+		t.Errorf("expected cached groups, got %v", groups)
+	}
+	now = now.Add(cacheTTL)
+	if groups, _ := k8s.GroupsFor(context.Background(), "alice@example.com"); len(groups) != 0 {
+		t.Errorf("expected groups to be re-read after the cache expires, got %v", groups)
+	}
+}
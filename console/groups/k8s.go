@@ -0,0 +1,171 @@
+package groups
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+
+	v1alpha2 "github.com/holos-run/holos-console/api/v1alpha2"
+	"github.com/holos-run/holos-console/console/tracing"
+)
+
+const (
+	// ConfigMapName is the ConfigMap holding every console-local group.
+	ConfigMapName = "holos-groups"
+	// dataKey is the ConfigMap data key holding the JSON-encoded
+	// memberships, a map of group name to sorted member emails.
+	dataKey = "groups.json"
+	// cacheTTL bounds how long GroupsFor serves memberships without
+	// re-reading the ConfigMap. Changes made through this replica are
+	// visible immediately.
+	cacheTTL = 15 * time.Second
+)
+
+// memberships maps group names to sorted, lower-cased member emails.
+type memberships map[string][]string
+
+// K8sClient stores console-local groups in a single ConfigMap in the console
+// namespace. Every call uses the console service account; the handler
+// authorizes callers with SelfSubjectAccessReviews first.
+type K8sClient struct {
+	client    kubernetes.Interface
+	namespace string
+	now       func() time.Time
+
+	mu       sync.Mutex
+	cached   memberships
+	cachedAt time.Time
+}
+
+// NewK8sClient creates a client that stores groups in namespace.
+func NewK8sClient(client kubernetes.Interface, namespace string) *K8sClient {
+	return &K8sClient{client: client, namespace: namespace, now: time.Now}
+}
+
+// List returns the current memberships.
+func (c *K8sClient) List(ctx context.Context) (memberships, error) {
+	ctx, span := tracing.Start(ctx, "groups.K8sClient.List", attribute.String("namespace", c.namespace))
+	defer span.End()
+	cm, err := c.client.CoreV1().ConfigMaps(c.namespace).Get(ctx, ConfigMapName, metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		c.store(memberships{})
+		return memberships{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	m, err := decode(cm)
+	if err != nil {
+		return nil, err
+	}
+	c.store(m)
+	return m, nil
+}
+
+// Update applies mutate to the current memberships and writes the result,
+// retrying on conflicts with concurrent writers.
+func (c *K8sClient) Update(ctx context.Context, mutate func(memberships)) (memberships, error) {
+	ctx, span := tracing.Start(ctx, "groups.K8sClient.Update", attribute.String("namespace", c.namespace))
+	defer span.End()
+	var result memberships
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		configMaps := c.client.CoreV1().ConfigMaps(c.namespace)
+		cm, err := configMaps.Get(ctx, ConfigMapName, metav1.GetOptions{})
+		create := k8serrors.IsNotFound(err)
+		if create {
+			cm = &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      ConfigMapName,
+					Namespace: c.namespace,
+					Labels: map[string]string{
+						v1alpha2.LabelManagedBy:    v1alpha2.ManagedByValue,
+						v1alpha2.LabelResourceType: v1alpha2.ResourceTypeGroups,
+					},
+				},
+			}
+		} else if err != nil {
+			return err
+		}
+		m, err := decode(cm)
+		if err != nil {
+			return err
+		}
+		mutate(m)
+		raw, err := json.Marshal(m)
+		if err != nil {
+			return fmt.Errorf("marshaling groups: %w", err)
+		}
+		cm.Data = map[string]string{dataKey: string(raw)}
+		if create {
+			_, err = configMaps.Create(ctx, cm, metav1.CreateOptions{})
+			if k8serrors.IsAlreadyExists(err) {
+				// Lost the race to create; retry as an update.
+				return k8serrors.NewConflict(corev1.Resource("configmaps"), ConfigMapName, err)
+			}
+		} else {
+			_, err = configMaps.Update(ctx, cm, metav1.UpdateOptions{})
+		}
+		if err != nil {
+			return err
+		}
+		result = m
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	c.store(result)
+	return result, nil
+}
+
+// GroupsFor implements rpc.GroupResolver. It returns the groups email is a
+// member of, from a cache refreshed every cacheTTL.
+func (c *K8sClient) GroupsFor(ctx context.Context, email string) ([]string, error) {
+	c.mu.Lock()
+	m, fresh := c.cached, c.cached != nil && c.now().Sub(c.cachedAt) < cacheTTL
+	c.mu.Unlock()
+	if !fresh {
+		var err error
+		if m, err = c.List(ctx); err != nil {
+			return nil, err
+		}
+	}
+	return m.groupsOf(email), nil
+}
+
+func (c *K8sClient) store(m memberships) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.cached, c.cachedAt = m, c.now()
+}
+
+func decode(cm *corev1.ConfigMap) (memberships, error) {
+	m := memberships{}
+	raw, ok := cm.Data[dataKey]
+	if !ok {
+		return m, nil
+	}
+	if err := json.Unmarshal([]byte(raw), &m); err != nil {
+		return nil, fmt.Errorf("parsing %s/%s: %w", cm.Namespace, cm.Name, err)
+	}
+	// The ConfigMap may have been edited by hand; restore the invariants
+	// lookups rely on.
+	for name, members := range m {
+		for i := range members {
+			members[i] = normalize(members[i])
+		}
+		slices.Sort(members)
+		m[name] = slices.Compact(members)
+	}
+	return m, nil
+}
@@ -0,0 +1,79 @@
+package console
+
+import (
+	"crypto/tls"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+	"testing/fstest"
+	"time"
+)
+
+func TestSecurityHeadersMiddleware(t *testing.T) {
+	server := New(Config{
+		Issuer:         "https://login.example.com/dex",
+		FrameOptions:   "DENY",
+		ReferrerPolicy: "same-origin",
+		HSTSMaxAge:     24 * time.Hour,
+	})
+	ui := newUIHandler(fstest.MapFS{
+		"index.html": &fstest.MapFile{Data: []byte(`<!DOCTYPE html><html><head></head><body></body></html>`)},
+	}, &OIDCConfig{Authority: "https://login.example.com/dex"}, &ConsoleConfig{})
+	handler := securityHeadersMiddleware(server.securityHeaders(), ui)
+
+	serve := func(r *http.Request) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, r)
+		return rec
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.TLS = &tls.ConnectionState{}
+	rec := serve(req)
+	for header, want := range map[string]string{
+		"X-Content-Type-Options":    "nosniff",
+		"X-Frame-Options":           "DENY",
+		"Referrer-Policy":           "same-origin",
+		"Strict-Transport-Security": "max-age=86400",
+	} {
+		if got := rec.Header().Get(header); got != want {
+			t.Errorf("%s: expected %q, got %q", header, want, got)
+		}
+	}
+
+	csp := rec.Header().Get("Content-Security-Policy")
+	if !strings.Contains(csp, "connect-src 'self' https://login.example.com") || !strings.Contains(csp, "frame-ancestors 'none'") {
+		t.Errorf("unexpected policy %q", csp)
+	}
+	match := regexp.MustCompile(`'nonce-([^']+)'`).FindStringSubmatch(csp)
+	if match == nil {
+		t.Fatalf("expected a nonce in policy %q", csp)
+	}
+	body := rec.Body.String()
+	if got := strings.Count(body, `<script nonce="`+match[1]+`">`); got != 2 {
+		t.Errorf("expected both injected scripts to carry the nonce, got %d in:\n%s", got, body)
+	}
+
+	// Each request gets a fresh nonce, and HSTS is only sent over TLS.
+	plain := serve(httptest.NewRequest(http.MethodGet, "/", nil))
+	if plain.Header().Get("Content-Security-Policy") == csp {
+		t.Error("expected a new nonce per request")
+	}
+	if plain.Header().Get("Strict-Transport-Security") != "" {
+		t.Error("expected no HSTS header over plain HTTP")
+	}
+
+	t.Run("off omits the policy", func(t *testing.T) {
+		h := securityHeadersMiddleware(New(Config{ContentSecurityPolicy: "off"}).securityHeaders(), ui)
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+		if rec.Header().Get("Content-Security-Policy") != "" || strings.Contains(rec.Body.String(), "nonce=") {
+			t.Errorf("expected no policy and no nonce, got %v", rec.Header())
+		}
+		if rec.Header().Get("X-Frame-Options") != "" {
+			t.Error("expected empty frame options to omit the header")
+		}
+	})
+}
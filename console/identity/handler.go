@@ -0,0 +1,150 @@
+// Package identity implements the IdentityService, which reports how the
+// console resolved the authenticated caller's token.
+package identity
+
+import (
+	"context"
+	"log/slog"
+	"maps"
+	"slices"
+	"time"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/holos-run/holos-console/console/rpc"
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+	"github.com/holos-run/holos-console/gen/holos/console/v1/consolev1connect"
+)
+
+// PlatformRoleOrganizationCreator is reported for callers allowed to create
+// organizations.
+const PlatformRoleOrganizationCreator = "organization-creator"
+
+// OrganizationCreators reports whether a caller may create organizations.
+// The organizations handler implements it from --disable-org-creation,
+// --org-creator-users and --org-creator-roles.
+type OrganizationCreators interface {
+	CanCreateOrganizations(claims *rpc.Claims) bool
+}
+
+// Handler implements the IdentityService.
+type Handler struct {
+	consolev1connect.UnimplementedIdentityServiceHandler
+	orgCreators   OrganizationCreators
+	sessionPolicy rpc.SessionPolicy
+}
+
+// NewHandler returns an IdentityService handler.
+func NewHandler() *Handler { return &Handler{} }
+
+// WithOrganizationCreators sets the checker WhoAmI uses to report the
+// organization-creator platform role.
+func (h *Handler) WithOrganizationCreators(c OrganizationCreators) *Handler {
+	h.orgCreators = c
+	return h
+}
+
+// WithSessionPolicy sets the policy GetSession reports. It should match the
+// policy the auth interceptor enforces.
+func (h *Handler) WithSessionPolicy(policy rpc.SessionPolicy) *Handler {
+	h.sessionPolicy = policy
+	return h
+}
+
+// WhoAmI reports the caller's resolved claims. Any authenticated caller may
+// describe themselves.
+func (h *Handler) WhoAmI(
+	ctx context.Context,
+	req *connect.Request[consolev1.WhoAmIRequest],
+) (*connect.Response[consolev1.WhoAmIResponse], error) {
+	claims := rpc.MustClaims(ctx)
+
+	kubernetesUser, kubernetesGroups := rpc.KubernetesIdentity(claims)
+	resp := &consolev1.WhoAmIResponse{
+		Issuer:           claims.Iss,
+		Subject:          claims.Sub,
+		Email:            claims.Email,
+		EmailVerified:    claims.EmailVerified,
+		Name:             claims.Name,
+		Groups:           claims.Roles,
+		PrincipalType:    claims.PrincipalType,
+		IssuedAt:         unixTimestamp(claims.Iat),
+		ExpiresAt:        unixTimestamp(claims.Exp),
+		KubernetesUser:   kubernetesUser,
+		KubernetesGroups: kubernetesGroups,
+	}
+	if h.orgCreators != nil && h.orgCreators.CanCreateOrganizations(claims) {
+		resp.PlatformRoles = append(resp.PlatformRoles, PlatformRoleOrganizationCreator)
+	}
+	if imp := claims.Impersonator; imp != nil {
+		resp.Impersonator = imp.Email
+		if resp.Impersonator == "" {
+			resp.Impersonator = imp.Sub
+		}
+	}
+
+	slog.InfoContext(ctx, "identity described",
+		slog.String("action", "whoami"),
+		slog.String("resource_type", "identity"),
+		slog.String("sub", claims.Sub),
+		slog.String("email", claims.Email),
+	)
+	return connect.NewResponse(resp), nil
+}
+
+// GetSession reports when the caller's token stops being accepted and which
+// RPCs require a more recent sign-in. Any authenticated caller may describe
+// their own session.
+func (h *Handler) GetSession(
+	ctx context.Context,
+	req *connect.Request[consolev1.GetSessionRequest],
+) (*connect.Response[consolev1.GetSessionResponse], error) {
+	claims := rpc.MustClaims(ctx)
+	// The session belongs to the token presented, which is the
+	// administrator's when acting as another user.
+	token := claims
+	if claims.Impersonator != nil {
+		token = claims.Impersonator
+	}
+
+	now := time.Now()
+	resp := &consolev1.GetSessionResponse{
+		MaxTokenAgeSeconds: int64(h.sessionPolicy.MaxTokenAge / time.Second),
+	}
+	if deadline := h.sessionPolicy.Deadline(token); !deadline.IsZero() {
+		resp.ExpiresAt = timestamppb.New(deadline)
+		resp.RemainingSeconds = max(int64(deadline.Sub(now)/time.Second), 0)
+	}
+	authenticatedAt := rpc.AuthenticatedAt(token)
+	if !authenticatedAt.IsZero() {
+		resp.AuthenticatedAt = timestamppb.New(authenticatedAt)
+	}
+	for _, procedure := range slices.Sorted(maps.Keys(h.sessionPolicy.StepUp)) {
+		maxAge := h.sessionPolicy.StepUp[procedure]
+		requirement := &consolev1.StepUpRequirement{
+			Procedure:     procedure,
+			MaxAgeSeconds: int64(maxAge / time.Second),
+		}
+		if !authenticatedAt.IsZero() {
+			requirement.SatisfiedUntil = timestamppb.New(authenticatedAt.Add(maxAge))
+		}
+		resp.StepUp = append(resp.StepUp, requirement)
+	}
+
+	slog.InfoContext(ctx, "session described",
+		slog.String("action", "get_session"),
+		slog.String("resource_type", "identity"),
+		slog.String("sub", claims.Sub),
+		slog.String("email", claims.Email),
+	)
+	return connect.NewResponse(resp), nil
+}
+
+// unixTimestamp converts a Unix time claim, returning nil when it is unset.
+func unixTimestamp(sec int64) *timestamppb.Timestamp {
+	if sec == 0 {
+		return nil
+	}
+	return timestamppb.New(time.Unix(sec, 0))
+}
@@ -0,0 +1,135 @@
+package identity
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+
+	"github.com/holos-run/holos-console/console/rpc"
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+)
+
+// creators allows the listed emails to create organizations.
+type creators []string
+
+func (c creators) CanCreateOrganizations(claims *rpc.Claims) bool {
+	return slices.Contains(c, claims.Email)
+}
+
+func TestWhoAmI(t *testing.T) {
+	h := NewHandler().WithOrganizationCreators(creators{"alice@example.com"})
+
+	tests := []struct {
+		name          string
+		claims        *rpc.Claims
+		wantUser      string
+		wantGroups    []string
+		wantPlatform  []string
+		impersonator  string
+		wantExpiresAt bool
+	}{
+		{
+			name: "oidc user",
+			claims: &rpc.Claims{
+				Iss: "https://idp.example.com", Sub: "alice", Email: "alice@example.com", EmailVerified: true,
+				Roles: []string{"devs", "system:masters"}, PrincipalType: rpc.PrincipalTypeUser, Iat: 1700000000, Exp: 1700003600,
+			},
+			wantUser:      "oidc:alice",
+			wantGroups:    []string{"oidc:devs", "holos:authenticated", "holos:email-domain:example.com"},
+			wantPlatform:  []string{PlatformRoleOrganizationCreator},
+			wantExpiresAt: true,
+		},
+		{
+			name: "service account",
+			claims: &rpc.Claims{
+				Sub: "system:serviceaccount:ci:deployer", Roles: []string{"system:serviceaccounts"}, PrincipalType: rpc.PrincipalTypeServiceAccount,
+			},
+			wantUser:   "system:serviceaccount:ci:deployer",
+			wantGroups: []string{"system:serviceaccounts"},
+		},
+		{
+			name: "impersonated",
+			claims: &rpc.Claims{
+				Sub: "bob", Email: "bob@example.com", PrincipalType: rpc.PrincipalTypeUser,
+				Impersonator: &rpc.Claims{Sub: "root", Email: "admin@example.com"},
+			},
+			wantUser:     "oidc:bob",
+			wantGroups:   []string{"holos:authenticated"},
+			impersonator: "admin@example.com",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := rpc.ContextWithClaims(context.Background(), tt.claims)
+			resp, err := h.WhoAmI(ctx, connect.NewRequest(&consolev1.WhoAmIRequest{}))
+			if err != nil {
+				t.Fatalf("WhoAmI: %v", err)
+			}
+			got := resp.Msg
+			if got.Subject != tt.claims.Sub || got.Email != tt.claims.Email || got.PrincipalType != tt.claims.PrincipalType {
+				t.Errorf("unexpected identity %v", got)
+			}
+			if !slices.Equal(got.Groups, tt.claims.Roles) {
+				t.Errorf("groups = %v, want the token's %v", got.Groups, tt.claims.Roles)
+			}
+			if got.KubernetesUser != tt.wantUser || !slices.Equal(got.KubernetesGroups, tt.wantGroups) {
+				t.Errorf("kubernetes identity = %q %v, want %q %v", got.KubernetesUser, got.KubernetesGroups, tt.wantUser, tt.wantGroups)
+			}
+			if !slices.Equal(got.PlatformRoles, tt.wantPlatform) {
+				t.Errorf("platform roles = %v, want %v", got.PlatformRoles, tt.wantPlatform)
+			}
+			if got.Impersonator != tt.impersonator {
+				t.Errorf("impersonator = %q, want %q", got.Impersonator, tt.impersonator)
+			}
+			if (got.ExpiresAt != nil) != tt.wantExpiresAt {
+				t.Errorf("expires_at = %v, want set %t", got.ExpiresAt, tt.wantExpiresAt)
+			}
+			if tt.wantExpiresAt && got.ExpiresAt.AsTime().Unix() != tt.claims.Exp {
+				t.Errorf("expires_at = %v, want %d", got.ExpiresAt.AsTime(), tt.claims.Exp)
+			}
+		})
+	}
+}
+
+func TestGetSession(t *testing.T) {
+	const getSecret = "/holos.console.v1.SecretsService/GetSecret"
+	now := time.Now().Truncate(time.Second)
+	h := NewHandler().WithSessionPolicy(rpc.SessionPolicy{
+		MaxTokenAge: 8 * time.Hour,
+		StepUp:      map[string]time.Duration{getSecret: 15 * time.Minute},
+	})
+	admin := &rpc.Claims{
+		Sub:      "admin-1",
+		Email:    "admin@example.com",
+		Iat:      now.Add(-7 * time.Hour).Unix(),
+		AuthTime: now.Add(-7 * time.Hour).Unix(),
+		Exp:      now.Add(time.Hour).Unix(),
+	}
+	// The session reported while acting as another user is the
+	// administrator's own.
+	claims := &rpc.Claims{Sub: "user-1", Email: "bob@example.com", Impersonator: admin}
+
+	resp, err := h.GetSession(rpc.ContextWithClaims(context.Background(), claims), connect.NewRequest(&consolev1.GetSessionRequest{}))
+	if err != nil {
+		t.Fatalf("GetSession: %v", err)
+	}
+	got := resp.Msg
+	if want := now.Add(time.Hour); !got.ExpiresAt.AsTime().Equal(want) {
+		t.Errorf("expires_at = %v, want the max token age deadline %v", got.ExpiresAt.AsTime(), want)
+	}
+	if got.RemainingSeconds <= 0 || got.RemainingSeconds > 3600 {
+		t.Errorf("remaining_seconds = %d, want at most an hour", got.RemainingSeconds)
+	}
+	if got.MaxTokenAgeSeconds != 8*3600 || !got.AuthenticatedAt.AsTime().Equal(now.Add(-7*time.Hour)) {
+		t.Errorf("unexpected session %v", got)
+	}
+	if len(got.StepUp) != 1 || got.StepUp[0].Procedure != getSecret || got.StepUp[0].MaxAgeSeconds != 900 {
+		t.Fatalf("unexpected step-up requirements %v", got.StepUp)
+	}
+	if !got.StepUp[0].SatisfiedUntil.AsTime().Before(now) {
+		t.Errorf("satisfied_until = %v, want in the past", got.StepUp[0].SatisfiedUntil.AsTime())
+	}
+}
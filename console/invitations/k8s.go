@@ -0,0 +1,249 @@
+package invitations
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+
+	v1alpha2 "github.com/holos-run/holos-console/api/v1alpha2"
+	"github.com/holos-run/holos-console/console/tracing"
+)
+
+const (
+	// ConfigMapName is the ConfigMap holding the user registry and pending
+	// invitations.
+	ConfigMapName = "holos-invitations"
+	// usersKey holds a JSON map of email to first sign-in time.
+	usersKey = "users.json"
+	// invitationsKey holds a JSON map of email to pending Invitation.
+	invitationsKey = "invitations.json"
+	// refreshInterval bounds how often a lookup of an unknown email re-reads
+	// the ConfigMap for sign-ins recorded by other replicas.
+	refreshInterval = 15 * time.Second
+)
+
+// Invitation is a pending invitation for an email that has never signed in.
+type Invitation struct {
+	// InvitedBy is the email of the owner who first invited the user.
+	InvitedBy string `json:"invitedBy"`
+	// InvitedAt is when the user was first invited.
+	InvitedAt time.Time `json:"invitedAt"`
+	// Resources names every resource the user was invited to, e.g.
+	// "secret/my-project/db-password".
+	Resources []string `json:"resources"`
+}
+
+// state is the decoded ConfigMap.
+type state struct {
+	users       map[string]time.Time
+	invitations map[string]*Invitation
+}
+
+// K8sClient stores the user registry and pending invitations in a single
+// ConfigMap in the console namespace, using the console service account.
+// Known emails are cached for the life of the process: a user who has signed
+// in stays known.
+type K8sClient struct {
+	client    kubernetes.Interface
+	namespace string
+	now       func() time.Time
+
+	mu          sync.Mutex
+	known       map[string]bool
+	refreshedAt time.Time
+}
+
+// NewK8sClient creates a client that stores invitations in namespace.
+func NewK8sClient(client kubernetes.Interface, namespace string) *K8sClient {
+	return &K8sClient{client: client, namespace: namespace, now: time.Now, known: map[string]bool{}}
+}
+
+// Known reports whether each of emails has signed in, keyed by email.
+func (c *K8sClient) Known(ctx context.Context, emails []string) (map[string]bool, error) {
+	c.mu.Lock()
+	missing := false
+	for _, email := range emails {
+		missing = missing || !c.known[email]
+	}
+	stale := c.now().Sub(c.refreshedAt) >= refreshInterval
+	c.mu.Unlock()
+	if missing && stale {
+		if _, err := c.refresh(ctx); err != nil {
+			return nil, err
+		}
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	result := make(map[string]bool, len(emails))
+	for _, email := range emails {
+		result[email] = c.known[email]
+	}
+	return result, nil
+}
+
+// RecordSignIn marks email as known. On the first sign-in of an invited user
+// it removes and returns their invitation.
+func (c *K8sClient) RecordSignIn(ctx context.Context, email string) (first bool, inv *Invitation, err error) {
+	c.mu.Lock()
+	known := c.known[email]
+	c.mu.Unlock()
+	if known {
+		return false, nil, nil
+	}
+	ctx, span := tracing.Start(ctx, "invitations.K8sClient.RecordSignIn")
+	defer span.End()
+	err = c.update(ctx, func(s *state) bool {
+		first, inv = false, nil
+		if _, ok := s.users[email]; ok {
+			return false
+		}
+		first = true
+		s.users[email] = c.now().UTC()
+		inv = s.invitations[email]
+		delete(s.invitations, email)
+		return true
+	})
+	return first, inv, err
+}
+
+// Invite records an invitation of email to resource. It reports whether the
+// invitation is new, as opposed to a further resource added to an existing
+// invitation, and does nothing for users who have already signed in.
+func (c *K8sClient) Invite(ctx context.Context, email, resource, invitedBy string) (created bool, err error) {
+	ctx, span := tracing.Start(ctx, "invitations.K8sClient.Invite", attribute.String("resource", resource))
+	defer span.End()
+	err = c.update(ctx, func(s *state) bool {
+		created = false
+		if _, ok := s.users[email]; ok {
+			return false
+		}
+		inv, ok := s.invitations[email]
+		if !ok {
+			created = true
+			inv = &Invitation{InvitedBy: invitedBy, InvitedAt: c.now().UTC()}
+			s.invitations[email] = inv
+		}
+		for _, r := range inv.Resources {
+			if r == resource {
+				return !ok
+			}
+		}
+		inv.Resources = append(inv.Resources, resource)
+		return true
+	})
+	return created, err
+}
+
+// Invitations returns the pending invitations keyed by email.
+func (c *K8sClient) Invitations(ctx context.Context) (map[string]*Invitation, error) {
+	s, err := c.refresh(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.invitations, nil
+}
+
+// refresh reads the ConfigMap and updates the cache of known emails.
+func (c *K8sClient) refresh(ctx context.Context) (*state, error) {
+	cm, err := c.client.CoreV1().ConfigMaps(c.namespace).Get(ctx, ConfigMapName, metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		cm = &corev1.ConfigMap{}
+	} else if err != nil {
+		return nil, err
+	}
+	s, err := decode(cm)
+	if err != nil {
+		return nil, err
+	}
+	c.remember(s)
+	return s, nil
+}
+
+// update applies mutate to the stored state and writes it back when mutate
+// reports a change, retrying on conflicts with concurrent writers.
+func (c *K8sClient) update(ctx context.Context, mutate func(*state) bool) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		configMaps := c.client.CoreV1().ConfigMaps(c.namespace)
+		cm, err := configMaps.Get(ctx, ConfigMapName, metav1.GetOptions{})
+		create := k8serrors.IsNotFound(err)
+		if create {
+			cm = &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      ConfigMapName,
+					Namespace: c.namespace,
+					Labels: map[string]string{
+						v1alpha2.LabelManagedBy:    v1alpha2.ManagedByValue,
+						v1alpha2.LabelResourceType: v1alpha2.ResourceTypeInvitations,
+					},
+				},
+			}
+		} else if err != nil {
+			return err
+		}
+		s, err := decode(cm)
+		if err != nil {
+			return err
+		}
+		if !mutate(s) {
+			c.remember(s)
+			return nil
+		}
+		users, err := json.Marshal(s.users)
+		if err != nil {
+			return fmt.Errorf("marshaling users: %w", err)
+		}
+		invitations, err := json.Marshal(s.invitations)
+		if err != nil {
+			return fmt.Errorf("marshaling invitations: %w", err)
+		}
+		cm.Data = map[string]string{usersKey: string(users), invitationsKey: string(invitations)}
+		if create {
+			_, err = configMaps.Create(ctx, cm, metav1.CreateOptions{})
+			if k8serrors.IsAlreadyExists(err) {
+				// Lost the race to create; retry as an update.
+				return k8serrors.NewConflict(corev1.Resource("configmaps"), ConfigMapName, err)
+			}
+		} else {
+			_, err = configMaps.Update(ctx, cm, metav1.UpdateOptions{})
+		}
+		if err != nil {
+			return err
+		}
+		c.remember(s)
+		return nil
+	})
+}
+
+// remember adds the users in s to the cache of known emails.
+func (c *K8sClient) remember(s *state) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for email := range s.users {
+		c.known[email] = true
+	}
+	c.refreshedAt = c.now()
+}
+
+func decode(cm *corev1.ConfigMap) (*state, error) {
+	s := &state{users: map[string]time.Time{}, invitations: map[string]*Invitation{}}
+	if raw, ok := cm.Data[usersKey]; ok {
+		if err := json.Unmarshal([]byte(raw), &s.users); err != nil {
+			return nil, fmt.Errorf("parsing %s/%s %s: %w", cm.Namespace, cm.Name, usersKey, err)
+		}
+	}
+	if raw, ok := cm.Data[invitationsKey]; ok {
+		if err := json.Unmarshal([]byte(raw), &s.invitations); err != nil {
+			return nil, fmt.Errorf("parsing %s/%s %s: %w", cm.Namespace, cm.Name, invitationsKey, err)
+		}
+	}
+	return s, nil
+}
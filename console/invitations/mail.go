@@ -0,0 +1,93 @@
+package invitations
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strings"
+	"time"
+)
+
+// dialTimeout bounds how long sending an invitation waits for the SMTP
+// server, so a slow relay cannot stall UpdateSharing.
+const dialTimeout = 10 * time.Second
+
+// Mailer sends invitation email.
+type Mailer interface {
+	Send(ctx context.Context, to, subject, body string) error
+}
+
+// SMTPMailer sends plain text email through an SMTP relay. It upgrades to
+// TLS when the server offers STARTTLS and authenticates when a username is
+// set.
+type SMTPMailer struct {
+	// Addr is the host:port of the SMTP server.
+	Addr string
+	// From is the envelope and header sender address.
+	From string
+	// Username and Password authenticate with PLAIN auth when Username is
+	// set.
+	Username string
+	Password string
+}
+
+// Send delivers a single message to to.
+func (m *SMTPMailer) Send(ctx context.Context, to, subject, body string) error {
+	host, _, err := net.SplitHostPort(m.Addr)
+	if err != nil {
+		return fmt.Errorf("invalid smtp address %q: %w", m.Addr, err)
+	}
+	if strings.ContainsAny(to+subject, "\r\n") {
+		return fmt.Errorf("invalid header value")
+	}
+	ctx, cancel := context.WithTimeout(ctx, dialTimeout)
+	defer cancel()
+	conn, err := (&net.Dialer{}).DialContext(ctx, "tcp", m.Addr)
+	if err != nil {
+		return fmt.Errorf("connecting to smtp server: %w", err)
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+	c, err := smtp.NewClient(conn, host)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("connecting to smtp server: %w", err)
+	}
+	defer c.Close()
+	if ok, _ := c.Extension("STARTTLS"); ok {
+		if err := c.StartTLS(nil); err != nil {
+			return fmt.Errorf("starting tls: %w", err)
+		}
+	}
+	if m.Username != "" {
+		if err := c.Auth(smtp.PlainAuth("", m.Username, m.Password, host)); err != nil {
+			return fmt.Errorf("smtp auth: %w", err)
+		}
+	}
+	if err := c.Mail(m.From); err != nil {
+		return fmt.Errorf("smtp MAIL: %w", err)
+	}
+	if err := c.Rcpt(to); err != nil {
+		return fmt.Errorf("smtp RCPT: %w", err)
+	}
+	w, err := c.Data()
+	if err != nil {
+		return fmt.Errorf("smtp DATA: %w", err)
+	}
+	msg := "From: " + m.From + "\r\n" +
+		"To: " + to + "\r\n" +
+		"Subject: " + subject + "\r\n" +
+		"MIME-Version: 1.0\r\n" +
+		"Content-Type: text/plain; charset=utf-8\r\n" +
+		"\r\n" +
+		strings.ReplaceAll(body, "\n", "\r\n")
+	if _, err := w.Write([]byte(msg)); err != nil {
+		return fmt.Errorf("writing message: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("writing message: %w", err)
+	}
+	return c.Quit()
+}
@@ -0,0 +1,108 @@
+// Package invitations tracks which users have signed in to the console so
+// sharing grants naming anyone else can be reported as pending, and records
+// invitations for those users that are accepted on their first sign-in.
+package invitations
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"connectrpc.com/connect"
+
+	"github.com/holos-run/holos-console/console/rpc"
+)
+
+const auditResourceType = "invitation"
+
+// Service invites users to resources and records their sign-ins. It
+// implements secrets.Inviter.
+type Service struct {
+	k8s    *K8sClient
+	mailer Mailer
+	origin string
+}
+
+// NewService creates an invitation service. Invitations are emailed through
+// mailer when it is non-nil, with a link to origin.
+func NewService(k8s *K8sClient, mailer Mailer, origin string) *Service {
+	return &Service{k8s: k8s, mailer: mailer, origin: origin}
+}
+
+// Known reports whether each of emails has signed in, keyed by email.
+func (s *Service) Known(ctx context.Context, emails []string) (map[string]bool, error) {
+	return s.k8s.Known(ctx, emails)
+}
+
+// Invite records an invitation of email to resource on behalf of the caller
+// and, for a new invitation, emails the invitee. Mail delivery is best
+// effort: the invitation stands when it fails.
+func (s *Service) Invite(ctx context.Context, email, resource string) error {
+	claims := rpc.MustClaims(ctx)
+	email = strings.ToLower(email)
+	created, err := s.k8s.Invite(ctx, email, resource, claims.Email)
+	if err != nil {
+		return err
+	}
+	slog.InfoContext(ctx, "user invited",
+		slog.String("action", "invitation_create"),
+		slog.String("resource_type", auditResourceType),
+		slog.String("invitee", email),
+		slog.String("resource", resource),
+		slog.Bool("new", created),
+		slog.String("sub", claims.Sub),
+		slog.String("email", claims.Email),
+	)
+	if !created || s.mailer == nil {
+		return nil
+	}
+	inviter := claims.Email
+	if claims.Name != "" {
+		inviter = claims.Name + " (" + claims.Email + ")"
+	}
+	body := fmt.Sprintf("%s shared %s with you on Holos Console.\n\nSign in with this email address to get access:\n\n%s\n", inviter, resource, s.origin)
+	if err := s.mailer.Send(ctx, email, "You have been invited to Holos Console", body); err != nil {
+		slog.WarnContext(ctx, "could not send invitation email",
+			slog.String("invitee", email),
+			slog.Any("error", err),
+		)
+	}
+	return nil
+}
+
+// SignInInterceptor records the sign-in of every authenticated user and
+// accepts their pending invitation the first time. Impersonated requests
+// are not sign-ins of the impersonated user and are skipped. Failures are
+// logged and never fail the request. Install it after
+// RequireClaimsInterceptor.
+func (s *Service) SignInInterceptor() connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			claims := rpc.ClaimsFromContext(ctx)
+			if claims != nil && claims.Email != "" && claims.Impersonator == nil && !claims.IsServiceAccount() {
+				s.recordSignIn(ctx, claims)
+			}
+			return next(ctx, req)
+		}
+	}
+}
+
+func (s *Service) recordSignIn(ctx context.Context, claims *rpc.Claims) {
+	first, inv, err := s.k8s.RecordSignIn(ctx, strings.ToLower(claims.Email))
+	if err != nil {
+		slog.WarnContext(ctx, "could not record sign-in", slog.Any("error", err))
+		return
+	}
+	if !first || inv == nil {
+		return
+	}
+	slog.InfoContext(ctx, "invitation accepted",
+		slog.String("action", "invitation_accept"),
+		slog.String("resource_type", auditResourceType),
+		slog.String("invited_by", inv.InvitedBy),
+		slog.String("resources", strings.Join(inv.Resources, ",")),
+		slog.String("sub", claims.Sub),
+		slog.String("email", claims.Email),
+	)
+}
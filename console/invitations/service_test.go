@@ -0,0 +1,120 @@
+package invitations
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/holos-run/holos-console/console/rpc"
+)
+
+const testNamespace = "holos-system"
+
+// fakeMailer records the recipients of sent email.
+type fakeMailer struct {
+	sent []string
+}
+
+func (m *fakeMailer) Send(_ context.Context, to, _, _ string) error {
+	m.sent = append(m.sent, to)
+	return nil
+}
+
+func as(sub, email string) context.Context {
+	return rpc.ContextWithClaims(context.Background(), &rpc.Claims{Sub: sub, Email: email, PrincipalType: rpc.PrincipalTypeUser})
+}
+
+// signIn passes ctx through the sign-in interceptor.
+func signIn(t *testing.T, s *Service, ctx context.Context) {
+	t.Helper()
+	next := func(context.Context, connect.AnyRequest) (connect.AnyResponse, error) { return nil, nil }
+	if _, err := s.SignInInterceptor()(next)(ctx, nil); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+func TestInvitationLifecycle(t *testing.T) {
+	client := fake.NewClientset()
+	mailer := &fakeMailer{}
+	s := NewService(NewK8sClient(client, testNamespace), mailer, "https://console.example.com")
+	alice := as("alice", "alice@example.com")
+	signIn(t, s, alice)
+
+	if err := s.Invite(alice, "Bob@example.com", "secret/p/db"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if err := s.Invite(alice, "bob@example.com", "secret/p/api"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(mailer.sent) != 1 || mailer.sent[0] != "bob@example.com" {
+		t.Errorf("expected one email to bob, got %v", mailer.sent)
+	}
+	invs, err := s.k8s.Invitations(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if inv := invs["bob@example.com"]; inv == nil || inv.InvitedBy != "alice@example.com" || len(inv.Resources) != 2 {
+		t.Fatalf("unexpected invitation %+v", inv)
+	}
+	known, err := s.Known(context.Background(), []string{"alice@example.com", "bob@example.com"})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !known["alice@example.com"] || known["bob@example.com"] {
+		t.Errorf("unexpected known users %v", known)
+	}
+
+	// An impersonated request is not a sign-in of the impersonated user.
+	impersonated := rpc.ContextWithClaims(context.Background(), &rpc.Claims{
+		Sub:          "bob",
+		Email:        "bob@example.com",
+		Impersonator: &rpc.Claims{Sub: "alice", Email: "alice@example.com"},
+	})
+	signIn(t, s, impersonated)
+	if invs, _ := s.k8s.Invitations(context.Background()); invs["bob@example.com"] == nil {
+		t.Fatal("expected invitation to survive an impersonated request")
+	}
+
+	signIn(t, s, as("bob", "bob@example.com"))
+	if invs, _ := s.k8s.Invitations(context.Background()); len(invs) != 0 {
+		t.Errorf("expected invitation to be accepted, got %v", invs)
+	}
+	known, err = s.Known(context.Background(), []string{"bob@example.com"})
+	if err != nil || !known["bob@example.com"] {
+		t.Errorf("expected bob to be known, got %v, %v", known, err)
+	}
+
+	// Known users are not invited again.
+	if err := s.Invite(alice, "bob@example.com", "secret/p/other"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if invs, _ := s.k8s.Invitations(context.Background()); len(invs) != 0 || len(mailer.sent) != 1 {
+		t.Errorf("expected no new invitation, got %v and mail %v", invs, mailer.sent)
+	}
+}
+
+func TestKnownSeesOtherReplicas(t *testing.T) {
+	client := fake.NewClientset()
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	reader := NewK8sClient(client, testNamespace)
+	reader.now = func() time.Time { return now }
+	writer := NewK8sClient(client, testNamespace)
+
+	if known, err := reader.Known(context.Background(), []string{"bob@example.com"}); err != nil || known["bob@example.com"] {
+		t.Fatalf("expected bob to be unknown, got %v, %v", known, err)
+	}
+	if _, _, err := writer.RecordSignIn(context.Background(), "bob@example.com"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	// Within the refresh interval the miss is served from the cache.
+	if known, _ := reader.Known(context.Background(), []string{"bob@example.com"}); known["bob@example.com"] {
+		t.Fatal("expected cached lookup")
+	}
+	now = now.Add(refreshInterval)
+	if known, _ := reader.Known(context.Background(), []string{"bob@example.com"}); !known["bob@example.com"] {
+		t.Error("expected sign-in on another replica to be seen after refresh")
+	}
+}
@@ -0,0 +1,129 @@
+// Package k8sretry retries Kubernetes API calls that failed for transient
+// reasons, so a brief API server blip does not surface to users as an
+// Internal error.
+package k8sretry
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	utilnet "k8s.io/apimachinery/pkg/util/net"
+)
+
+var retriesTotal = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "k8s_client_retries_total",
+		Help: "Total number of Kubernetes API calls retried after a transient failure, by operation and reason.",
+	},
+	[]string{"operation", "reason"},
+)
+
+// Retry reasons recorded in k8s_client_retries_total.
+const (
+	ReasonConflict    = "conflict"
+	ReasonThrottled   = "throttled"
+	ReasonTimeout     = "timeout"
+	ReasonUnavailable = "unavailable"
+	ReasonConnection  = "connection"
+)
+
+// Policy bounds how a call is retried. The zero Policy makes a single
+// attempt.
+type Policy struct {
+	// MaxAttempts is the number of attempts including the first. Values
+	// below two disable retries.
+	MaxAttempts int
+
+	// InitialBackoff is the wait before the first retry. It doubles on
+	// every further retry up to MaxBackoff.
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+
+	// Budget caps the time a call spends across all of its attempts. A
+	// retry is skipped when waiting for it would exceed the budget or the
+	// caller's deadline, whichever is earlier. Zero leaves only the
+	// caller's deadline.
+	Budget time.Duration
+}
+
+// DefaultPolicy retries up to three times within five seconds.
+var DefaultPolicy = Policy{
+	MaxAttempts:    4,
+	InitialBackoff: 100 * time.Millisecond,
+	MaxBackoff:     2 * time.Second,
+	Budget:         5 * time.Second,
+}
+
+// Do calls fn until it succeeds, fails with a non-transient error, or the
+// policy is exhausted, and returns the last error. operation names the call
+// in metrics and logs. Conflicts are retried, so fn must re-read the object
+// it writes. A throttled response's Retry-After replaces the backoff.
+func (p Policy) Do(ctx context.Context, operation string, fn func(context.Context) error) error {
+	start := time.Now()
+	deadline, hasDeadline := ctx.Deadline()
+	if p.Budget > 0 && (!hasDeadline || start.Add(p.Budget).Before(deadline)) {
+		deadline, hasDeadline = start.Add(p.Budget), true
+	}
+	backoff := p.InitialBackoff
+	for attempt := 1; ; attempt++ {
+		err := fn(ctx)
+		if err == nil || attempt >= p.MaxAttempts {
+			return err
+		}
+		reason, ok := Reason(err)
+		if !ok {
+			return err
+		}
+		wait := backoff
+		if seconds, ok := apierrors.SuggestsClientDelay(err); ok && reason == ReasonThrottled {
+			wait = time.Duration(seconds) * time.Second
+		}
+		if hasDeadline && time.Now().Add(wait).After(deadline) {
+			return err
+		}
+		retriesTotal.WithLabelValues(operation, reason).Inc()
+		slog.DebugContext(ctx, "retrying kubernetes call",
+			slog.String("operation", operation),
+			slog.String("reason", reason),
+			slog.Int("attempt", attempt),
+			slog.Duration("wait", wait),
+			slog.Any("error", err),
+		)
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+		backoff *= 2
+		if p.MaxBackoff > 0 {
+			backoff = min(backoff, p.MaxBackoff)
+		}
+	}
+}
+
+// Reason classifies err, reporting whether it is transient. Errors caused
+// by the caller's own context are never transient.
+func Reason(err error) (string, bool) {
+	switch {
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		return "", false
+	case apierrors.IsConflict(err):
+		return ReasonConflict, true
+	case apierrors.IsTooManyRequests(err):
+		return ReasonThrottled, true
+	case apierrors.IsServerTimeout(err), apierrors.IsTimeout(err), utilnet.IsTimeout(err):
+		return ReasonTimeout, true
+	case apierrors.IsServiceUnavailable(err):
+		return ReasonUnavailable, true
+	case utilnet.IsConnectionRefused(err), utilnet.IsConnectionReset(err), utilnet.IsProbableEOF(err):
+		return ReasonConnection, true
+	}
+	return "", false
+}
@@ -0,0 +1,102 @@
+package k8sretry
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var secrets = schema.GroupResource{Resource: "secrets"}
+
+func TestDo(t *testing.T) {
+	policy := Policy{MaxAttempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: 2 * time.Millisecond}
+	conflict := apierrors.NewConflict(secrets, "db", errors.New("modified"))
+
+	t.Run("retries transient errors until success", func(t *testing.T) {
+		counter := retriesTotal.WithLabelValues("TestSuccess", ReasonConflict)
+		calls := 0
+		err := policy.Do(context.Background(), "TestSuccess", func(context.Context) error {
+			calls++
+			if calls < 3 {
+				return conflict
+			}
+			return nil
+		})
+		if err != nil || calls != 3 {
+			t.Fatalf("expected success on the third call, got %d calls and %v", calls, err)
+		}
+		if got := testutil.ToFloat64(counter); got != 2 {
+			t.Errorf("expected 2 retries counted, got %v", got)
+		}
+	})
+
+	t.Run("returns the last error once attempts run out", func(t *testing.T) {
+		calls := 0
+		err := policy.Do(context.Background(), "TestExhausted", func(context.Context) error {
+			calls++
+			return apierrors.NewServiceUnavailable("down")
+		})
+		if !apierrors.IsServiceUnavailable(err) || calls != 3 {
+			t.Fatalf("expected 3 calls ending unavailable, got %d calls and %v", calls, err)
+		}
+	})
+
+	t.Run("does not retry permanent errors", func(t *testing.T) {
+		calls := 0
+		err := policy.Do(context.Background(), "TestPermanent", func(context.Context) error {
+			calls++
+			return apierrors.NewForbidden(secrets, "db", errors.New("denied"))
+		})
+		if !apierrors.IsForbidden(err) || calls != 1 {
+			t.Fatalf("expected a single forbidden call, got %d calls and %v", calls, err)
+		}
+	})
+
+	t.Run("stops when the budget would be exceeded", func(t *testing.T) {
+		slow := Policy{MaxAttempts: 5, InitialBackoff: time.Hour, Budget: time.Second}
+		calls := 0
+		_ = slow.Do(context.Background(), "TestBudget", func(context.Context) error {
+			calls++
+			return conflict
+		})
+		if calls != 1 {
+			t.Fatalf("expected the budget to prevent a retry, got %d calls", calls)
+		}
+	})
+
+	t.Run("zero policy makes one attempt", func(t *testing.T) {
+		calls := 0
+		_ = Policy{}.Do(context.Background(), "TestZero", func(context.Context) error {
+			calls++
+			return conflict
+		})
+		if calls != 1 {
+			t.Fatalf("expected one call, got %d", calls)
+		}
+	})
+}
+
+func TestReason(t *testing.T) {
+	tests := []struct {
+		err  error
+		want string
+	}{
+		{apierrors.NewConflict(secrets, "db", errors.New("modified")), ReasonConflict},
+		{apierrors.NewTooManyRequests("slow down", 1), ReasonThrottled},
+		{apierrors.NewServerTimeout(secrets, "get", 1), ReasonTimeout},
+		{apierrors.NewServiceUnavailable("down"), ReasonUnavailable},
+		{apierrors.NewNotFound(secrets, "db"), ""},
+		{context.DeadlineExceeded, ""},
+	}
+	for _, tt := range tests {
+		got, ok := Reason(tt.err)
+		if got != tt.want || ok != (tt.want != "") {
+			t.Errorf("Reason(%v) = %q, %v; want %q", tt.err, got, ok, tt.want)
+		}
+	}
+}
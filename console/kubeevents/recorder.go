@@ -0,0 +1,268 @@
+// Package kubeevents records audit events as Kubernetes Events on the
+// Secret or namespace they concern, so cluster-native tooling such as
+// kubectl get events and event exporters sees console activity without
+// scraping the console's logs.
+//
+// Only denied access and sharing changes are recorded. Repeated events for
+// the same object, reason, and message are aggregated into one Event whose
+// count grows, as the client-go event recorder does, and writes are
+// rate-limited so a burst of denials cannot flood the API server.
+package kubeevents
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/time/rate"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+
+	v1alpha2 "github.com/holos-run/holos-console/api/v1alpha2"
+	"github.com/holos-run/holos-console/console/audit"
+	"github.com/holos-run/holos-console/console/resolver"
+)
+
+// Component is the source component of the Events the console records.
+const Component = "holos-console"
+
+// Event reasons.
+const (
+	ReasonAccessDenied   = "AccessDenied"
+	ReasonSharingChanged = "SharingChanged"
+)
+
+const (
+	// queueSize bounds the events waiting to be recorded. Events arriving
+	// while the queue is full are dropped rather than slowing down the
+	// request that logged them.
+	queueSize = 256
+	// maxTracked bounds the Events whose counts are tracked for
+	// aggregation. The tracked counts are reset when full.
+	maxTracked = 4096
+	// maxNamePrefix keeps Event names within the 253 character limit.
+	maxNamePrefix = 236
+)
+
+// DefaultLimit and DefaultBurst rate-limit Event writes: a burst of
+// DefaultBurst, then one per second.
+const (
+	DefaultLimit = rate.Limit(1)
+	DefaultBurst = 25
+)
+
+// Recorder records audit events as Kubernetes Events with the console
+// service account.
+type Recorder struct {
+	client   kubernetes.Interface
+	resolver *resolver.Resolver
+	limiter  *rate.Limiter
+	host     string
+	now      func() time.Time
+
+	queue      chan audit.Event
+	dropped    atomic.Int64
+	suppressed atomic.Int64
+
+	mu     sync.Mutex
+	counts map[string]int32
+}
+
+// NewRecorder creates a Recorder that writes at most limit Events per
+// second after an initial burst.
+func NewRecorder(client kubernetes.Interface, r *resolver.Resolver, limit rate.Limit, burst int) *Recorder {
+	host, _ := os.Hostname()
+	return &Recorder{
+		client:   client,
+		resolver: r,
+		limiter:  rate.NewLimiter(limit, burst),
+		host:     host,
+		now:      time.Now,
+		queue:    make(chan audit.Event, queueSize),
+		counts:   map[string]int32{},
+	}
+}
+
+// Record queues e when it is a denial or a sharing change. It never
+// blocks; subscribe it to the audit.Ring.
+func (r *Recorder) Record(e audit.Event) {
+	if reason, _ := classify(e); reason == "" {
+		return
+	}
+	select {
+	case r.queue <- e:
+	default:
+		r.dropped.Add(1)
+	}
+}
+
+// Run records queued events until ctx is cancelled. Events over the rate
+// limit are discarded, and failures are logged and not retried.
+func (r *Recorder) Run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case e := <-r.queue:
+			if dropped := r.dropped.Swap(0); dropped > 0 {
+				slog.WarnContext(ctx, "kubernetes event queue full, events dropped", slog.Int64("dropped", dropped))
+			}
+			if !r.limiter.Allow() {
+				r.suppressed.Add(1)
+				continue
+			}
+			if suppressed := r.suppressed.Swap(0); suppressed > 0 {
+				slog.WarnContext(ctx, "kubernetes events rate limited, events dropped", slog.Int64("dropped", suppressed))
+			}
+			if err := r.Emit(ctx, e); err != nil {
+				slog.WarnContext(ctx, "could not record kubernetes event",
+					slog.String("event_action", e.Action),
+					slog.String("project", e.Project),
+					slog.Any("error", err),
+				)
+			}
+		}
+	}
+}
+
+// Emit records e as an Event, or increments the count of the Event already
+// recorded for the same object, reason, and message. Events that concern
+// no Secret or namespace are ignored.
+func (r *Recorder) Emit(ctx context.Context, e audit.Event) error {
+	reason, eventType := classify(e)
+	ref := r.target(e)
+	if reason == "" || ref == nil {
+		return nil
+	}
+	message := summary(e)
+	name := eventName(ref, reason, message)
+	now := metav1.NewTime(r.now())
+
+	if count := r.increment(name); count > 1 {
+		patch := fmt.Sprintf(`{"count":%d,"lastTimestamp":%q}`, count, now.UTC().Format(time.RFC3339))
+		_, err := r.client.CoreV1().Events(ref.Namespace).Patch(ctx, name, types.MergePatchType, []byte(patch), metav1.PatchOptions{})
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+		// The Event expired; record it afresh.
+		r.reset(name)
+	}
+	event := &corev1.Event{
+		ObjectMeta:          metav1.ObjectMeta{Name: name, Namespace: ref.Namespace},
+		InvolvedObject:      *ref,
+		Reason:              reason,
+		Message:             message,
+		Type:                eventType,
+		Source:              corev1.EventSource{Component: Component, Host: r.host},
+		FirstTimestamp:      now,
+		LastTimestamp:       now,
+		Count:               1,
+		ReportingController: Component,
+		ReportingInstance:   r.host,
+	}
+	_, err := r.client.CoreV1().Events(ref.Namespace).Create(ctx, event, metav1.CreateOptions{})
+	if apierrors.IsAlreadyExists(err) {
+		// Another replica, or this one before a restart, recorded it.
+		count := r.increment(name)
+		patch := fmt.Sprintf(`{"count":%d,"lastTimestamp":%q}`, count, now.UTC().Format(time.RFC3339))
+		_, err = r.client.CoreV1().Events(ref.Namespace).Patch(ctx, name, types.MergePatchType, []byte(patch), metav1.PatchOptions{})
+	}
+	return err
+}
+
+// increment counts an occurrence of the Event name and returns the count.
+func (r *Recorder) increment(name string) int32 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.counts[name]; !ok && len(r.counts) >= maxTracked {
+		clear(r.counts)
+	}
+	r.counts[name]++
+	return r.counts[name]
+}
+
+func (r *Recorder) reset(name string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.counts[name] = 1
+}
+
+// classify returns the reason and type of the Event recorded for e, or an
+// empty reason when e is not recorded. Dry runs change nothing and are not
+// recorded.
+func classify(e audit.Event) (reason, eventType string) {
+	switch {
+	case strings.HasSuffix(e.Action, "_denied"):
+		return ReasonAccessDenied, corev1.EventTypeWarning
+	case strings.HasSuffix(e.Action, "sharing_update") && e.Attributes["dry_run"] != "true":
+		return ReasonSharingChanged, corev1.EventTypeNormal
+	}
+	return "", ""
+}
+
+// target returns the object e concerns: the Secret for secret events,
+// otherwise the namespace of the project, folder, or organization.
+// Namespace Events are recorded in the namespace itself so the project's
+// members can list them.
+func (r *Recorder) target(e audit.Event) *corev1.ObjectReference {
+	var ns string
+	switch {
+	case e.ResourceType == "secret" && e.Project != "" && e.ResourceName != "":
+		return &corev1.ObjectReference{
+			APIVersion: "v1",
+			Kind:       "Secret",
+			Namespace:  r.resolver.ProjectNamespace(e.Project),
+			Name:       e.ResourceName,
+		}
+	case e.Project != "":
+		ns = r.resolver.ProjectNamespace(e.Project)
+	case e.ResourceType == v1alpha2.ResourceTypeProject && e.ResourceName != "":
+		ns = r.resolver.ProjectNamespace(e.ResourceName)
+	case e.ResourceType == v1alpha2.ResourceTypeFolder && e.ResourceName != "":
+		ns = r.resolver.FolderNamespace(e.ResourceName)
+	case e.ResourceType == v1alpha2.ResourceTypeOrganization && e.ResourceName != "":
+		ns = r.resolver.OrgNamespace(e.ResourceName)
+	default:
+		return nil
+	}
+	return &corev1.ObjectReference{APIVersion: "v1", Kind: "Namespace", Namespace: ns, Name: ns}
+}
+
+// summary renders the Event message: the audit message, the principal, and
+// the audit action, e.g. "sharing updated by alice@example.com
+// (sharing_update)". The request ID is left out so repeats aggregate.
+func summary(e audit.Event) string {
+	actor := e.Email
+	if actor == "" {
+		actor = e.Sub
+	}
+	if e.ImpersonatorEmail != "" {
+		actor = e.ImpersonatorEmail + " acting as " + actor
+	}
+	if actor == "" {
+		return fmt.Sprintf("%s (%s)", e.Message, e.Action)
+	}
+	return fmt.Sprintf("%s by %s (%s)", e.Message, actor, e.Action)
+}
+
+// eventName derives a stable Event name from the object, reason, and
+// message so repeated events aggregate, following the <object>.<suffix>
+// convention of the client-go event recorder.
+func eventName(ref *corev1.ObjectReference, reason, message string) string {
+	sum := sha256.Sum256([]byte(ref.Kind + "\x00" + ref.Namespace + "\x00" + ref.Name + "\x00" + reason + "\x00" + message))
+	prefix := ref.Name
+	if len(prefix) > maxNamePrefix {
+		prefix = prefix[:maxNamePrefix]
+	}
+	return prefix + "." + hex.EncodeToString(sum[:8])
+}
@@ -0,0 +1,110 @@
+package kubeevents
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/holos-run/holos-console/console/audit"
+	"github.com/holos-run/holos-console/console/resolver"
+)
+
+func testResolver() *resolver.Resolver {
+	return &resolver.Resolver{NamespacePrefix: "holos-", OrganizationPrefix: "org-", ProjectPrefix: "prj-"}
+}
+
+func TestRecorder_Emit(t *testing.T) {
+	ctx := context.Background()
+	events := func(t *testing.T, client *fake.Clientset, ns string) []corev1.Event {
+		t.Helper()
+		list, err := client.CoreV1().Events(ns).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			t.Fatalf("listing events: %v", err)
+		}
+		return list.Items
+	}
+
+	t.Run("records denials on the secret and aggregates repeats", func(t *testing.T) {
+		client := fake.NewClientset()
+		r := NewRecorder(client, testResolver(), DefaultLimit, DefaultBurst)
+		e := audit.Event{
+			Action:       "secret_access_denied",
+			ResourceType: "secret",
+			ResourceName: "db-creds",
+			Project:      "billing",
+			Email:        "mallory@example.com",
+			Message:      "secret access denied by deny grant",
+			RequestID:    "req-1",
+		}
+		for range 3 {
+			if err := r.Emit(ctx, e); err != nil {
+				t.Fatalf("Emit: %v", err)
+			}
+		}
+		got := events(t, client, "holos-prj-billing")
+		if len(got) != 1 {
+			t.Fatalf("expected repeats to aggregate into 1 event, got %d", len(got))
+		}
+		ev := got[0]
+		if ev.InvolvedObject.Kind != "Secret" || ev.InvolvedObject.Name != "db-creds" {
+			t.Errorf("expected the event on secret db-creds, got %+v", ev.InvolvedObject)
+		}
+		if ev.Type != corev1.EventTypeWarning || ev.Reason != ReasonAccessDenied {
+			t.Errorf("expected a Warning AccessDenied event, got %s %s", ev.Type, ev.Reason)
+		}
+		if ev.Count != 3 {
+			t.Errorf("expected count 3, got %d", ev.Count)
+		}
+		if !strings.Contains(ev.Message, "mallory@example.com") {
+			t.Errorf("expected the message to name the principal, got %q", ev.Message)
+		}
+	})
+
+	t.Run("records sharing changes on the namespace", func(t *testing.T) {
+		client := fake.NewClientset()
+		r := NewRecorder(client, testResolver(), DefaultLimit, DefaultBurst)
+		if err := r.Emit(ctx, audit.Event{
+			Action:       "project_sharing_update",
+			ResourceType: "project",
+			ResourceName: "billing",
+			Email:        "owner@example.com",
+			Message:      "project sharing updated",
+		}); err != nil {
+			t.Fatalf("Emit: %v", err)
+		}
+		got := events(t, client, "holos-prj-billing")
+		if len(got) != 1 {
+			t.Fatalf("expected 1 event, got %d", len(got))
+		}
+		if ref := got[0].InvolvedObject; ref.Kind != "Namespace" || ref.Name != "holos-prj-billing" {
+			t.Errorf("expected the event on the project namespace, got %+v", ref)
+		}
+		if got[0].Reason != ReasonSharingChanged || got[0].Type != corev1.EventTypeNormal {
+			t.Errorf("expected a Normal SharingChanged event, got %s %s", got[0].Type, got[0].Reason)
+		}
+	})
+
+	t.Run("ignores other actions and dry runs", func(t *testing.T) {
+		client := fake.NewClientset()
+		r := NewRecorder(client, testResolver(), DefaultLimit, DefaultBurst)
+		for _, e := range []audit.Event{
+			{Action: "secret_read", ResourceType: "secret", ResourceName: "db-creds", Project: "billing"},
+			{Action: "sharing_update", ResourceType: "secret", ResourceName: "db-creds", Project: "billing", Attributes: map[string]string{"dry_run": "true"}},
+		} {
+			r.Record(e)
+			if err := r.Emit(ctx, e); err != nil {
+				t.Fatalf("Emit: %v", err)
+			}
+		}
+		if n := len(r.queue); n != 0 {
+			t.Errorf("expected nothing queued, got %d", n)
+		}
+		if got := events(t, client, "holos-prj-billing"); len(got) != 0 {
+			t.Errorf("expected no events, got %+v", got)
+		}
+	})
+}
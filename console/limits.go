@@ -0,0 +1,24 @@
+package console
+
+import "net/http"
+
+// maxRequestBytes returns Config.MaxRequestBytes, or its default when unset.
+func (s *Server) maxRequestBytes() int64 {
+	if s.cfg.MaxRequestBytes > 0 {
+		return s.cfg.MaxRequestBytes
+	}
+	return defaultMaxRequestBytes
+}
+
+// limitRequestBody caps every request body at limit bytes. Reads past the
+// limit fail with *http.MaxBytesError, which ConnectRPC handlers report as
+// ResourceExhausted, so an oversized upload is cut off as it arrives
+// rather than buffered in full.
+func limitRequestBody(limit int64, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Body != nil && r.Body != http.NoBody {
+			r.Body = http.MaxBytesReader(w, r.Body, limit)
+		}
+		next.ServeHTTP(w, r)
+	})
+}
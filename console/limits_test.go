@@ -0,0 +1,31 @@
+package console
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestLimitRequestBody(t *testing.T) {
+	handler := limitRequestBody(8, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var maxErr *http.MaxBytesError
+		if _, err := io.ReadAll(r.Body); errors.As(err, &maxErr) {
+			http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+		}
+	}))
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/", strings.NewReader("12345678")))
+	if rec.Code != http.StatusOK {
+		t.Errorf("expected a body at the limit to be read, got %d", rec.Code)
+	}
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/", strings.NewReader("123456789")))
+	if rec.Code != http.StatusRequestEntityTooLarge {
+		t.Errorf("expected a body over the limit to fail, got %d", rec.Code)
+	}
+}
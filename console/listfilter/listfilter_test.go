@@ -0,0 +1,66 @@
+package listfilter
+
+import (
+	"testing"
+
+	"connectrpc.com/connect"
+
+	"github.com/holos-run/holos-console/console/validation"
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+)
+
+func TestFilter_Match(t *testing.T) {
+	item := Item{
+		Name:        "orders-db",
+		DisplayName: "Orders Database",
+		Description: "Primary Postgres credentials",
+		Labels:      map[string]string{"env": "prod"},
+		Role:        consolev1.Role_ROLE_EDITOR,
+		Accessible:  true,
+	}
+	tests := []struct {
+		name   string
+		filter *consolev1.ListFilter
+		want   bool
+	}{
+		{"nil filter", nil, true},
+		{"name", &consolev1.ListFilter{NameContains: "ORDERS"}, true},
+		{"display name", &consolev1.ListFilter{NameContains: "database"}, true},
+		{"name mismatch", &consolev1.ListFilter{NameContains: "billing"}, false},
+		{"description", &consolev1.ListFilter{DescriptionContains: "postgres"}, true},
+		{"selector", &consolev1.ListFilter{LabelSelector: "env in (prod,staging)"}, true},
+		{"selector mismatch", &consolev1.ListFilter{LabelSelector: "env!=prod"}, false},
+		{"min role met", &consolev1.ListFilter{MinRole: consolev1.Role_ROLE_VIEWER}, true},
+		{"min role unmet", &consolev1.ListFilter{MinRole: consolev1.Role_ROLE_OWNER}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := New("filter", tt.filter)
+			if err != nil {
+				t.Fatalf("New: %v", err)
+			}
+			if got := f.Match(item); got != tt.want {
+				t.Errorf("Match = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	f, _ := New("filter", &consolev1.ListFilter{AccessibleOnly: true})
+	if f.Match(Item{Name: "denied"}) {
+		t.Error("expected accessible_only to drop an inaccessible item")
+	}
+}
+
+func TestNew_Violations(t *testing.T) {
+	_, err := New("filter", &consolev1.ListFilter{LabelSelector: "env in (prod", MinRole: 42})
+	if connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Fatalf("expected InvalidArgument, got %v", err)
+	}
+	fields := map[string]string{}
+	for _, fv := range validation.FieldViolations(err) {
+		fields[fv.Field] = fv.Reason
+	}
+	if fields["filter.label_selector"] != validation.ReasonSelector || fields["filter.min_role"] != validation.ReasonEnum {
+		t.Errorf("unexpected violations %v", fields)
+	}
+}
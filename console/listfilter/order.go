@@ -0,0 +1,93 @@
+package listfilter
+
+import (
+	"cmp"
+	"slices"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1alpha2 "github.com/holos-run/holos-console/api/v1alpha2"
+	"github.com/holos-run/holos-console/console/validation"
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+)
+
+// SortKey is the view of a listed resource an Order sorts by.
+type SortKey struct {
+	Name       string
+	CreatedAt  time.Time
+	ModifiedAt time.Time
+}
+
+// KeyOf returns the sort key of a resource named name and backed by obj.
+func KeyOf(name string, obj metav1.Object) SortKey {
+	return SortKey{
+		Name:       name,
+		CreatedAt:  obj.GetCreationTimestamp().Time,
+		ModifiedAt: LastModified(obj),
+	}
+}
+
+// LastModified returns the time of the latest managedFields write to obj,
+// which the API server records on every create, update and patch, or the
+// creation time when obj carries no managed fields. Writes that only record
+// read access are not modifications and are skipped.
+func LastModified(obj metav1.Object) time.Time {
+	modified := obj.GetCreationTimestamp().Time
+	for _, entry := range obj.GetManagedFields() {
+		if entry.Manager == v1alpha2.FieldManagerAccessTracking {
+			continue
+		}
+		if entry.Time != nil && entry.Time.After(modified) {
+			modified = entry.Time.Time
+		}
+	}
+	return modified
+}
+
+// Order is a parsed ListOrder. A nil *Order leaves results in the order
+// the handler produced them.
+type Order struct {
+	field      consolev1.ListOrderField
+	descending bool
+}
+
+// NewOrder parses o, whose violations are reported under field. A nil o
+// yields a nil Order.
+func NewOrder(field string, o *consolev1.ListOrder) (*Order, error) {
+	if o == nil {
+		return nil, nil
+	}
+	if _, ok := consolev1.ListOrderField_name[int32(o.Field)]; !ok {
+		var v validation.Violations
+		v.Add(field+".field", validation.ReasonEnum, "%s.field %d is not a known order field", field, o.Field)
+		return nil, v.Err()
+	}
+	return &Order{field: o.Field, descending: o.Descending}, nil
+}
+
+// Sort orders items in place by o, using key to read each item's sort key.
+// Items that compare equal are ordered by name, so the result is stable
+// across calls.
+func Sort[T any](o *Order, items []T, key func(T) SortKey) {
+	if o == nil {
+		return
+	}
+	slices.SortStableFunc(items, func(a, b T) int {
+		ka, kb := key(a), key(b)
+		c := 0
+		switch o.field {
+		case consolev1.ListOrderField_LIST_ORDER_FIELD_CREATED:
+			c = ka.CreatedAt.Compare(kb.CreatedAt)
+		case consolev1.ListOrderField_LIST_ORDER_FIELD_MODIFIED:
+			c = ka.ModifiedAt.Compare(kb.ModifiedAt)
+		}
+		if c == 0 {
+			c = cmp.Compare(ka.Name, kb.Name)
+		}
+		if o.descending {
+			c = -c
+		}
+		return c
+	})
+}
@@ -0,0 +1,78 @@
+package listfilter
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/holos-run/holos-console/console/validation"
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+)
+
+func TestKeyOf(t *testing.T) {
+	created := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	updated := created.Add(time.Hour)
+	obj := &metav1.ObjectMeta{
+		Name:              "a",
+		CreationTimestamp: metav1.NewTime(created),
+		ManagedFields: []metav1.ManagedFieldsEntry{
+			{Time: &metav1.Time{Time: updated}},
+			{Time: &metav1.Time{Time: created}},
+			{},
+		},
+	}
+	key := KeyOf("a", obj)
+	if !key.CreatedAt.Equal(created) || !key.ModifiedAt.Equal(updated) {
+		t.Errorf("unexpected key %+v", key)
+	}
+	if key := KeyOf("b", &metav1.ObjectMeta{CreationTimestamp: metav1.NewTime(created)}); !key.ModifiedAt.Equal(created) {
+		t.Errorf("expected ModifiedAt to fall back to creation, got %v", key.ModifiedAt)
+	}
+}
+
+func TestSort(t *testing.T) {
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	keys := map[string]SortKey{
+		"bravo":   {Name: "bravo", CreatedAt: base, ModifiedAt: base.Add(3 * time.Hour)},
+		"alpha":   {Name: "alpha", CreatedAt: base.Add(time.Hour), ModifiedAt: base.Add(time.Hour)},
+		"charlie": {Name: "charlie", CreatedAt: base, ModifiedAt: base.Add(2 * time.Hour)},
+	}
+	tests := []struct {
+		name  string
+		order *consolev1.ListOrder
+		want  []string
+	}{
+		{"nil order", nil, []string{"charlie", "alpha", "bravo"}},
+		{"default field", &consolev1.ListOrder{}, []string{"alpha", "bravo", "charlie"}},
+		{"name descending", &consolev1.ListOrder{Field: consolev1.ListOrderField_LIST_ORDER_FIELD_NAME, Descending: true}, []string{"charlie", "bravo", "alpha"}},
+		{"created ties by name", &consolev1.ListOrder{Field: consolev1.ListOrderField_LIST_ORDER_FIELD_CREATED}, []string{"bravo", "charlie", "alpha"}},
+		{"modified descending", &consolev1.ListOrder{Field: consolev1.ListOrderField_LIST_ORDER_FIELD_MODIFIED, Descending: true}, []string{"bravo", "charlie", "alpha"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o, err := NewOrder("order_by", tt.order)
+			if err != nil {
+				t.Fatalf("NewOrder: %v", err)
+			}
+			items := []string{"charlie", "alpha", "bravo"}
+			Sort(o, items, func(name string) SortKey { return keys[name] })
+			if !slices.Equal(items, tt.want) {
+				t.Errorf("got %v, want %v", items, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewOrder_Violations(t *testing.T) {
+	_, err := NewOrder("order_by", &consolev1.ListOrder{Field: 42})
+	if connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Fatalf("expected InvalidArgument, got %v", err)
+	}
+	fv := validation.FieldViolations(err)
+	if len(fv) != 1 || fv[0].Field != "order_by.field" || fv[0].Reason != validation.ReasonEnum {
+		t.Errorf("unexpected violations %v", fv)
+	}
+}
@@ -0,0 +1,163 @@
+// Package logging builds the console's structured logger. Every handler it
+// returns redacts attributes whose keys name secret material, so a value
+// logged by mistake under a key such as "data" or "id_token" never reaches
+// the log stream.
+package logging
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+	"unicode"
+)
+
+// Log formats accepted by NewHandler.
+const (
+	FormatJSON = "json"
+	FormatText = "text"
+)
+
+// RedactedValue replaces the value of every sensitive attribute.
+const RedactedValue = "[REDACTED]"
+
+// sensitiveWords are the key words that mark an attribute as sensitive.
+var sensitiveWords = map[string]bool{
+	"data":     true,
+	"password": true,
+	"token":    true,
+}
+
+// NewHandler returns a redacting handler that writes records at or above
+// level to w in format, which is "json" or "text".
+func NewHandler(w io.Writer, format string, level slog.Leveler) (slog.Handler, error) {
+	opts := &slog.HandlerOptions{Level: level}
+	switch strings.ToLower(format) {
+	case FormatJSON:
+		return NewRedactHandler(slog.NewJSONHandler(w, opts)), nil
+	case FormatText:
+		return NewRedactHandler(slog.NewTextHandler(w, opts)), nil
+	default:
+		return nil, fmt.Errorf("invalid log format %q: must be json or text", format)
+	}
+}
+
+// ParseLevel converts a string log level to slog.Level.
+func ParseLevel(level string) (slog.Level, error) {
+	switch strings.ToLower(level) {
+	case "debug":
+		return slog.LevelDebug, nil
+	case "info":
+		return slog.LevelInfo, nil
+	case "warn", "warning":
+		return slog.LevelWarn, nil
+	case "error":
+		return slog.LevelError, nil
+	default:
+		return slog.LevelInfo, fmt.Errorf("invalid log level %q: must be debug, info, warn, or error", level)
+	}
+}
+
+// Sensitive reports whether an attribute key names secret material. The key
+// is split into words on '_', '-', '.' and camel case boundaries, and it is
+// sensitive when any word is on the denylist: "data", "stringData" and
+// "id_token" are sensitive, "metadata" and "secret" are not.
+func Sensitive(key string) bool {
+	for _, word := range keyWords(key) {
+		if sensitiveWords[word] {
+			return true
+		}
+	}
+	return false
+}
+
+// keyWords splits key into lower case words.
+func keyWords(key string) []string {
+	var words []string
+	var word strings.Builder
+	flush := func() {
+		if word.Len() > 0 {
+			words = append(words, word.String())
+			word.Reset()
+		}
+	}
+	prevLower := false
+	for _, r := range key {
+		switch {
+		case r == '_' || r == '-' || r == '.' || unicode.IsSpace(r):
+			flush()
+			prevLower = false
+			continue
+		case unicode.IsUpper(r) && prevLower:
+			flush()
+		}
+		word.WriteRune(unicode.ToLower(r))
+		prevLower = unicode.IsLower(r) || unicode.IsDigit(r)
+	}
+	flush()
+	return words
+}
+
+// RedactHandler is an slog.Handler that replaces the value of every
+// sensitive attribute, including attributes nested in groups, with
+// RedactedValue before forwarding records to the wrapped handler.
+type RedactHandler struct {
+	next slog.Handler
+	// redactAll is set once a group with a sensitive name has been opened;
+	// every attribute nested under it is redacted.
+	redactAll bool
+}
+
+// NewRedactHandler returns a RedactHandler forwarding to next.
+func NewRedactHandler(next slog.Handler) *RedactHandler {
+	return &RedactHandler{next: next}
+}
+
+// Enabled reports whether the wrapped handler handles records at level.
+func (h *RedactHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.next.Enabled(ctx, level)
+}
+
+// Handle redacts the attributes of r and forwards it.
+func (h *RedactHandler) Handle(ctx context.Context, r slog.Record) error {
+	out := slog.NewRecord(r.Time, r.Level, r.Message, r.PC)
+	r.Attrs(func(a slog.Attr) bool {
+		out.AddAttrs(h.redact(a))
+		return true
+	})
+	return h.next.Handle(ctx, out)
+}
+
+// WithAttrs returns a handler whose records include the redacted attrs.
+func (h *RedactHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	redacted := make([]slog.Attr, len(attrs))
+	for i, a := range attrs {
+		redacted[i] = h.redact(a)
+	}
+	return &RedactHandler{next: h.next.WithAttrs(redacted), redactAll: h.redactAll}
+}
+
+// WithGroup returns a handler that nests subsequent attributes under name.
+func (h *RedactHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+	return &RedactHandler{next: h.next.WithGroup(name), redactAll: h.redactAll || Sensitive(name)}
+}
+
+func (h *RedactHandler) redact(a slog.Attr) slog.Attr {
+	if h.redactAll || Sensitive(a.Key) {
+		return slog.String(a.Key, RedactedValue)
+	}
+	v := a.Value.Resolve()
+	if v.Kind() != slog.KindGroup {
+		return slog.Attr{Key: a.Key, Value: v}
+	}
+	group := v.Group()
+	redacted := make([]slog.Attr, len(group))
+	for i, ga := range group {
+		redacted[i] = h.redact(ga)
+	}
+	return slog.Attr{Key: a.Key, Value: slog.GroupValue(redacted...)}
+}
@@ -0,0 +1,107 @@
+package logging
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+func TestSensitive(t *testing.T) {
+	tests := map[string]bool{
+		"data":          true,
+		"string_data":   true,
+		"stringData":    true,
+		"password":      true,
+		"DB-Password":   true,
+		"id_token":      true,
+		"accessToken":   true,
+		"token.value":   true,
+		"metadata":      false,
+		"secret":        false,
+		"tokenizer":     false,
+		"resource_type": false,
+		"":              false,
+	}
+	for key, want := range tests {
+		if got := Sensitive(key); got != want {
+			t.Errorf("Sensitive(%q) = %v, want %v", key, got, want)
+		}
+	}
+}
+
+func TestNewHandler_RedactsJSON(t *testing.T) {
+	var buf bytes.Buffer
+	handler, err := NewHandler(&buf, "json", slog.LevelInfo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	logger := slog.New(handler).With(slog.String("refresh_token", "r-123"))
+	logger.Info("secret created",
+		slog.String("secret", "db-creds"),
+		slog.Any("data", map[string][]byte{"password": []byte("hunter2")}),
+		slog.Group("req", slog.String("password", "hunter2"), slog.String("name", "web")),
+	)
+	logger.WithGroup("data").Info("nested", slog.String("key", "hunter2"))
+	logger.Debug("filtered", slog.String("name", "hidden"))
+
+	if strings.Contains(buf.String(), "hunter2") || strings.Contains(buf.String(), "r-123") {
+		t.Fatalf("expected secret material to be redacted, got %s", buf.String())
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 records at info level, got %d: %s", len(lines), buf.String())
+	}
+	var rec struct {
+		Secret       string            `json:"secret"`
+		Data         string            `json:"data"`
+		RefreshToken string            `json:"refresh_token"`
+		Req          map[string]string `json:"req"`
+	}
+	if err := json.Unmarshal([]byte(lines[0]), &rec); err != nil {
+		t.Fatal(err)
+	}
+	if rec.Secret != "db-creds" || rec.Req["name"] != "web" {
+		t.Errorf("expected non-sensitive attributes to pass through, got %+v", rec)
+	}
+	if rec.Data != RedactedValue || rec.RefreshToken != RedactedValue || rec.Req["password"] != RedactedValue {
+		t.Errorf("expected sensitive attributes to be redacted, got %+v", rec)
+	}
+}
+
+func TestNewHandler_Text(t *testing.T) {
+	var buf bytes.Buffer
+	handler, err := NewHandler(&buf, "TEXT", slog.LevelDebug)
+	if err != nil {
+		t.Fatal(err)
+	}
+	slog.New(handler).Debug("login", slog.String("password", "hunter2"))
+	if got := buf.String(); !strings.Contains(got, "password="+RedactedValue) || strings.Contains(got, "hunter2") {
+		t.Errorf("expected text record with redacted password, got %q", got)
+	}
+}
+
+func TestNewHandler_InvalidFormat(t *testing.T) {
+	if _, err := NewHandler(&bytes.Buffer{}, "xml", slog.LevelInfo); err == nil {
+		t.Fatal("expected an error for an unknown format")
+	}
+}
+
+func TestParseLevel(t *testing.T) {
+	tests := map[string]slog.Level{
+		"debug":   slog.LevelDebug,
+		"INFO":    slog.LevelInfo,
+		"warning": slog.LevelWarn,
+		"error":   slog.LevelError,
+	}
+	for in, want := range tests {
+		got, err := ParseLevel(in)
+		if err != nil || got != want {
+			t.Errorf("ParseLevel(%q) = %v, %v; want %v", in, got, err, want)
+		}
+	}
+	if _, err := ParseLevel("trace"); err == nil {
+		t.Error("expected an error for an unknown level")
+	}
+}
@@ -0,0 +1,76 @@
+package maintenance
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/holos-run/holos-console/console/rpc"
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+	"github.com/holos-run/holos-console/gen/holos/console/v1/consolev1connect"
+)
+
+// Handler implements the MaintenanceService.
+type Handler struct {
+	consolev1connect.UnimplementedMaintenanceServiceHandler
+	mode   *Mode
+	owners rpc.PlatformOwners
+}
+
+// NewHandler returns a MaintenanceService handler for mode. Callers whose
+// email is in ownerUsers, or who hold one of ownerRoles, are platform owners
+// and may change the state.
+func NewHandler(mode *Mode, ownerUsers, ownerRoles []string) *Handler {
+	return &Handler{mode: mode, owners: rpc.PlatformOwners{Users: ownerUsers, Roles: ownerRoles}}
+}
+
+// GetMaintenance returns the current state to any authenticated caller.
+func (h *Handler) GetMaintenance(
+	ctx context.Context,
+	req *connect.Request[consolev1.GetMaintenanceRequest],
+) (*connect.Response[consolev1.GetMaintenanceResponse], error) {
+	rpc.MustClaims(ctx)
+	return connect.NewResponse(&consolev1.GetMaintenanceResponse{Maintenance: toProto(h.mode.State())}), nil
+}
+
+// SetMaintenance changes the state. Only platform owners may call it.
+func (h *Handler) SetMaintenance(
+	ctx context.Context,
+	req *connect.Request[consolev1.SetMaintenanceRequest],
+) (*connect.Response[consolev1.SetMaintenanceResponse], error) {
+	claims := rpc.MustClaims(ctx)
+	if !h.owners.Includes(claims) {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("only platform owners may change maintenance mode"))
+	}
+
+	changedBy := claims.Email
+	if changedBy == "" {
+		changedBy = claims.Sub
+	}
+	state := h.mode.Set(req.Msg.GetEnabled(), req.Msg.GetMessage(), changedBy)
+
+	slog.InfoContext(ctx, "maintenance mode updated",
+		slog.String("action", "maintenance_update"),
+		slog.String("resource_type", "maintenance"),
+		slog.Bool("enabled", state.Enabled),
+		slog.String("message", state.Message),
+		slog.String("sub", claims.Sub),
+		slog.String("email", claims.Email),
+	)
+	return connect.NewResponse(&consolev1.SetMaintenanceResponse{Maintenance: toProto(state)}), nil
+}
+
+func toProto(s State) *consolev1.Maintenance {
+	m := &consolev1.Maintenance{
+		Enabled:   s.Enabled,
+		Message:   s.Message,
+		ChangedBy: s.ChangedBy,
+	}
+	if !s.ChangedAt.IsZero() {
+		m.ChangedAt = timestamppb.New(s.ChangedAt)
+	}
+	return m
+}
@@ -0,0 +1,126 @@
+// Package maintenance implements the console's read-only maintenance mode,
+// used during cluster migrations and etcd maintenance windows: while it is
+// enabled every RPC that changes state fails with FailedPrecondition and
+// reads keep working.
+package maintenance
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"connectrpc.com/connect"
+
+	"github.com/holos-run/holos-console/gen/holos/console/v1/consolev1connect"
+)
+
+// DefaultMessage is returned to rejected RPCs when no message is configured.
+const DefaultMessage = "The console is in read-only maintenance mode; changes are disabled until maintenance completes."
+
+// Header is set to "read-only" on the error of a rejected RPC so clients
+// can tell maintenance apart from other failed preconditions.
+const Header = "Holos-Maintenance"
+
+// readPrefixes are the method name prefixes of RPCs that do not change
+// state. Methods matching no prefix are treated as mutating. A batch method
+// is judged by the verb after its batchPrefix, so BatchGetSecrets reads and
+// BatchDeleteSecrets mutates.
+var readPrefixes = []string{
+	"List",
+	"Get",
+	"Check",
+	"Export",
+	"Download",
+	"Stream",
+	"Search",
+	"Render",
+	"Preflight",
+	"Diff",
+	"CanI",
+	"WhoAmI",
+}
+
+// batchPrefix starts the name of a method applying its verb to several
+// resources.
+const batchPrefix = "Batch"
+
+// State is a snapshot of the maintenance mode.
+type State struct {
+	Enabled bool
+	Message string
+	// ChangedAt and ChangedBy are zero for the state set at startup.
+	ChangedAt time.Time
+	ChangedBy string
+}
+
+// Mode holds the current maintenance state. It is safe for concurrent use.
+type Mode struct {
+	current atomic.Pointer[State]
+}
+
+// NewMode returns a Mode starting in the given state. An empty message uses
+// DefaultMessage.
+func NewMode(enabled bool, message string) *Mode {
+	m := &Mode{}
+	m.current.Store(&State{Enabled: enabled, Message: messageOrDefault(message)})
+	return m
+}
+
+// State returns the current state.
+func (m *Mode) State() State {
+	return *m.current.Load()
+}
+
+// Set replaces the state, recording who changed it, and returns the new
+// state. An empty message uses DefaultMessage.
+func (m *Mode) Set(enabled bool, message, changedBy string) State {
+	s := &State{
+		Enabled:   enabled,
+		Message:   messageOrDefault(message),
+		ChangedAt: time.Now(),
+		ChangedBy: changedBy,
+	}
+	m.current.Store(s)
+	return *s
+}
+
+func messageOrDefault(message string) string {
+	if message = strings.TrimSpace(message); message != "" {
+		return message
+	}
+	return DefaultMessage
+}
+
+// IsMutating reports whether procedure may change state, judged by its
+// method name. SetMaintenance is not mutating so maintenance mode can be
+// turned off.
+func IsMutating(procedure string) bool {
+	if procedure == consolev1connect.MaintenanceServiceSetMaintenanceProcedure {
+		return false
+	}
+	_, method, _ := strings.Cut(strings.TrimPrefix(procedure, "/"), "/")
+	method = strings.TrimPrefix(method, batchPrefix)
+	for _, prefix := range readPrefixes {
+		if strings.HasPrefix(method, prefix) {
+			return false
+		}
+	}
+	return true
+}
+
+// Interceptor rejects mutating RPCs with CodeFailedPrecondition while m is
+// enabled.
+func Interceptor(m *Mode) connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			if s := m.State(); s.Enabled && IsMutating(req.Spec().Procedure) {
+				err := connect.NewError(connect.CodeFailedPrecondition, errors.New(s.Message))
+				err.Meta().Set(Header, "read-only")
+				return nil, err
+			}
+			return next(ctx, req)
+		}
+	}
+}
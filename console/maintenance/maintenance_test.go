@@ -0,0 +1,118 @@
+package maintenance
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+
+	"github.com/holos-run/holos-console/console/rpc"
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+	"github.com/holos-run/holos-console/gen/holos/console/v1/consolev1connect"
+)
+
+func TestIsMutating(t *testing.T) {
+	for procedure, want := range map[string]bool{
+		consolev1connect.SecretsServiceListSecretsProcedure:                false,
+		consolev1connect.SecretsServiceGetSecretProcedure:                  false,
+		consolev1connect.TemplateServiceRenderTemplateProcedure:            false,
+		consolev1connect.DeploymentServicePreflightCheckProcedure:          false,
+		consolev1connect.PermissionsServiceCanIProcedure:                   false,
+		consolev1connect.MaintenanceServiceSetMaintenanceProcedure:         false,
+		consolev1connect.SecretsServiceBatchGetSecretsProcedure:            false,
+		consolev1connect.SecretsServiceBatchDeleteSecretsProcedure:         true,
+		consolev1connect.SecretsServiceCreateSecretProcedure:               true,
+		consolev1connect.SecretsServiceDeleteSecretProcedure:               true,
+		consolev1connect.AccessRequestServiceApproveAccessRequestProcedure: true,
+		consolev1connect.TemplateServiceCloneTemplateProcedure:             true,
+	} {
+		if got := IsMutating(procedure); got != want {
+			t.Errorf("IsMutating(%s) = %t, want %t", procedure, got, want)
+		}
+	}
+}
+
+func TestInterceptor(t *testing.T) {
+	mode := NewMode(true, "etcd maintenance until 14:00 UTC")
+	next := func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		return connect.NewResponse(&consolev1.GetMaintenanceResponse{}), nil
+	}
+	call := func(procedure string) error {
+		_, err := Interceptor(mode)(next)(context.Background(), &fakeRequest{procedure: procedure})
+		return err
+	}
+
+	err := call(consolev1connect.SecretsServiceCreateSecretProcedure)
+	if connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Fatalf("expected CodeFailedPrecondition, got %v", err)
+	}
+	var connectErr *connect.Error
+	if ce, ok := err.(*connect.Error); ok {
+		connectErr = ce
+	}
+	if connectErr.Message() != "etcd maintenance until 14:00 UTC" || connectErr.Meta().Get(Header) != "read-only" {
+		t.Errorf("unexpected error %v %v", connectErr.Message(), connectErr.Meta())
+	}
+	if err := call(consolev1connect.SecretsServiceListSecretsProcedure); err != nil {
+		t.Errorf("expected reads to succeed, got %v", err)
+	}
+
+	mode.Set(false, "", "owner@example.com")
+	if err := call(consolev1connect.SecretsServiceCreateSecretProcedure); err != nil {
+		t.Errorf("expected writes to succeed once disabled, got %v", err)
+	}
+}
+
+func TestSetMaintenance(t *testing.T) {
+	mode := NewMode(false, "")
+	h := NewHandler(mode, []string{"alice@example.com"}, []string{"platform-owners"})
+	set := func(claims *rpc.Claims, enabled bool) (*consolev1.Maintenance, error) {
+		ctx := rpc.ContextWithClaims(context.Background(), claims)
+		resp, err := h.SetMaintenance(ctx, connect.NewRequest(&consolev1.SetMaintenanceRequest{Enabled: enabled, Message: "migrating clusters"}))
+		if err != nil {
+			return nil, err
+		}
+		return resp.Msg.GetMaintenance(), nil
+	}
+
+	if _, err := set(&rpc.Claims{Sub: "bob", Email: "bob@example.com", Roles: []string{"developers"}}, true); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Fatalf("expected CodePermissionDenied for a non-owner, got %v", err)
+	}
+	if mode.State().Enabled {
+		t.Fatal("expected a denied call to leave the state unchanged")
+	}
+
+	got, err := set(&rpc.Claims{Sub: "alice", Email: "Alice@example.com"}, true)
+	if err != nil {
+		t.Fatalf("SetMaintenance: %v", err)
+	}
+	if !got.Enabled || got.Message != "migrating clusters" || got.ChangedBy != "Alice@example.com" || got.ChangedAt == nil {
+		t.Errorf("unexpected state %v", got)
+	}
+
+	got, err = set(&rpc.Claims{Sub: "carol", Roles: []string{"platform-owners"}}, false)
+	if err != nil {
+		t.Fatalf("SetMaintenance: %v", err)
+	}
+	if got.Enabled || got.ChangedBy != "carol" {
+		t.Errorf("unexpected state %v", got)
+	}
+
+	resp, err := h.GetMaintenance(rpc.ContextWithClaims(context.Background(), &rpc.Claims{Sub: "bob"}), connect.NewRequest(&consolev1.GetMaintenanceRequest{}))
+	if err != nil {
+		t.Fatalf("GetMaintenance: %v", err)
+	}
+	if resp.Msg.GetMaintenance().Enabled {
+		t.Errorf("expected maintenance disabled, got %v", resp.Msg.GetMaintenance())
+	}
+}
+
+// fakeRequest is a connect.AnyRequest for a procedure.
+type fakeRequest struct {
+	connect.AnyRequest
+	procedure string
+}
+
+func (r *fakeRequest) Spec() connect.Spec {
+	return connect.Spec{Procedure: r.procedure}
+}
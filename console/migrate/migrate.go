@@ -0,0 +1,256 @@
+// Package migrate upgrades console-managed namespaces and secrets written by
+// earlier releases to the current label and annotation formats. It replaces
+// the fallback parsing handlers used to carry for those formats with a
+// one-time, idempotent migration run by `holos-console migrate`.
+package migrate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+
+	v1alpha2 "github.com/holos-run/holos-console/api/v1alpha2"
+	"github.com/holos-run/holos-console/console/resolver"
+	"github.com/holos-run/holos-console/console/secrets"
+)
+
+// Object kinds reported in a Change.
+const (
+	KindNamespace = "Namespace"
+	KindSecret    = "Secret"
+)
+
+// namespaceGrantAnnotations are the grant annotations stored on managed
+// namespaces.
+var namespaceGrantAnnotations = []string{
+	v1alpha2.AnnotationShareUsers,
+	v1alpha2.AnnotationShareRoles,
+	v1alpha2.AnnotationDefaultShareUsers,
+	v1alpha2.AnnotationDefaultShareRoles,
+}
+
+// secretGrantAnnotations are the grant annotations stored on managed
+// secrets.
+var secretGrantAnnotations = []string{
+	v1alpha2.AnnotationShareUsers,
+	v1alpha2.AnnotationShareRoles,
+}
+
+// nameLabels maps a namespace resource type to the label holding its name.
+var nameLabels = map[string]string{
+	v1alpha2.ResourceTypeOrganization: v1alpha2.LabelOrganization,
+	v1alpha2.ResourceTypeFolder:       v1alpha2.LabelFolder,
+	v1alpha2.ResourceTypeProject:      v1alpha2.LabelProject,
+}
+
+// Change is one planned or applied upgrade of an object.
+type Change struct {
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+	// Description lists what was upgraded, e.g. "set label
+	// console.holos.run/project=web".
+	Description []string `json:"description"`
+}
+
+// Problem is an object that needs manual attention because it cannot be
+// upgraded automatically.
+type Problem struct {
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+	Message   string `json:"message"`
+}
+
+// Report is the result of a migration run.
+type Report struct {
+	// Applied is true when changes were written rather than planned.
+	Applied bool `json:"applied"`
+	// Scanned counts the managed namespaces and secrets examined.
+	Scanned  int       `json:"scanned"`
+	Changes  []Change  `json:"changes"`
+	Problems []Problem `json:"problems"`
+}
+
+// Migrator upgrades legacy formats using a ServiceAccount-scoped client.
+type Migrator struct {
+	client   kubernetes.Interface
+	resolver *resolver.Resolver
+}
+
+// New returns a Migrator. r classifies namespaces that predate the
+// resource-type label by their name prefix.
+func New(client kubernetes.Interface, r *resolver.Resolver) *Migrator {
+	return &Migrator{client: client, resolver: r}
+}
+
+// Run scans every console-managed namespace and secret and upgrades legacy
+// formats. Without apply it only reports the changes it would make. Run is
+// idempotent: once everything is upgraded it reports no changes.
+func (m *Migrator) Run(ctx context.Context, apply bool) (*Report, error) {
+	report := &Report{Applied: apply}
+	selector := labels.SelectorFromSet(labels.Set{v1alpha2.LabelManagedBy: v1alpha2.ManagedByValue}).String()
+
+	namespaces, err := m.client.CoreV1().Namespaces().List(ctx, metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return nil, fmt.Errorf("listing managed namespaces: %w", err)
+	}
+	sort.Slice(namespaces.Items, func(i, j int) bool { return namespaces.Items[i].Name < namespaces.Items[j].Name })
+	for i := range namespaces.Items {
+		ns := &namespaces.Items[i]
+		report.Scanned++
+		descriptions, problems := m.upgradeNamespace(ns)
+		report.addProblems(KindNamespace, "", ns.Name, problems)
+		if len(descriptions) == 0 {
+			continue
+		}
+		if apply {
+			if _, err := m.client.CoreV1().Namespaces().Update(ctx, ns, metav1.UpdateOptions{}); err != nil {
+				return nil, fmt.Errorf("updating namespace %q: %w", ns.Name, err)
+			}
+		}
+		report.Changes = append(report.Changes, Change{Kind: KindNamespace, Name: ns.Name, Description: descriptions})
+	}
+
+	secretList, err := m.client.CoreV1().Secrets("").List(ctx, metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return nil, fmt.Errorf("listing managed secrets: %w", err)
+	}
+	sort.Slice(secretList.Items, func(i, j int) bool {
+		a, b := secretList.Items[i], secretList.Items[j]
+		return a.Namespace < b.Namespace || a.Namespace == b.Namespace && a.Name < b.Name
+	})
+	for i := range secretList.Items {
+		secret := &secretList.Items[i]
+		report.Scanned++
+		descriptions, problems := upgradeGrants(secret.Annotations, secretGrantAnnotations)
+		report.addProblems(KindSecret, secret.Namespace, secret.Name, problems)
+		if len(descriptions) == 0 {
+			continue
+		}
+		if apply {
+			if _, err := m.client.CoreV1().Secrets(secret.Namespace).Update(ctx, secret, metav1.UpdateOptions{}); err != nil {
+				return nil, fmt.Errorf("updating secret %s/%s: %w", secret.Namespace, secret.Name, err)
+			}
+		}
+		report.Changes = append(report.Changes, Change{Kind: KindSecret, Namespace: secret.Namespace, Name: secret.Name, Description: descriptions})
+	}
+	return report, nil
+}
+
+func (r *Report) addProblems(kind, namespace, name string, messages []string) {
+	for _, msg := range messages {
+		r.Problems = append(r.Problems, Problem{Kind: kind, Namespace: namespace, Name: name, Message: msg})
+	}
+}
+
+// upgradeNamespace adds the resource-type and name labels namespaces
+// created before they existed are missing, and upgrades grant annotations.
+// It modifies ns in place and returns what it changed.
+func (m *Migrator) upgradeNamespace(ns *corev1.Namespace) (descriptions, problems []string) {
+	if ns.Labels == nil {
+		ns.Labels = map[string]string{}
+	}
+	kind, name, err := m.resolver.ResourceTypeFromNamespace(ns.Name)
+	resourceType := ns.Labels[v1alpha2.LabelResourceType]
+	switch {
+	case resourceType == "" && err != nil:
+		problems = append(problems, fmt.Sprintf("no %s label and %v", v1alpha2.LabelResourceType, err))
+	case resourceType == "":
+		ns.Labels[v1alpha2.LabelResourceType] = kind
+		resourceType = kind
+		descriptions = append(descriptions, fmt.Sprintf("set label %s=%s", v1alpha2.LabelResourceType, kind))
+	case err == nil && kind != resourceType:
+		// The name does not match the type's prefix, so the name cannot
+		// be derived from it.
+		err = fmt.Errorf("namespace prefix implies %s", kind)
+	}
+
+	if label, ok := nameLabels[resourceType]; ok && ns.Labels[label] == "" {
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("no %s label and the name cannot be derived: %v", label, err))
+		} else {
+			ns.Labels[label] = name
+			descriptions = append(descriptions, fmt.Sprintf("set label %s=%s", label, name))
+		}
+	}
+
+	grantDescriptions, grantProblems := upgradeGrants(ns.Annotations, namespaceGrantAnnotations)
+	return append(descriptions, grantDescriptions...), append(problems, grantProblems...)
+}
+
+// upgradeGrants rewrites grant annotations in the current format: roles in
+// lowercase without the ROLE_ prefix earlier releases stored, principals
+// trimmed, empty principals dropped, and duplicate principals merged to
+// their highest role. Annotations that do not parse, or that name an
+// unknown role, are reported and left unchanged.
+func upgradeGrants(annotations map[string]string, keys []string) (descriptions, problems []string) {
+	for _, key := range keys {
+		value, ok := annotations[key]
+		if !ok {
+			continue
+		}
+		var grants []secrets.AnnotationGrant
+		if err := json.Unmarshal([]byte(value), &grants); err != nil {
+			problems = append(problems, fmt.Sprintf("invalid %s annotation: %v", key, err))
+			continue
+		}
+		var unknown []string
+		upgraded := make([]secrets.AnnotationGrant, 0, len(grants))
+		for _, g := range grants {
+			g.Principal = strings.TrimSpace(g.Principal)
+			if !g.Deny {
+				g.Role = normalizeRole(g.Role)
+				if !slices.Contains([]string{"viewer", "editor", "owner"}, g.Role) {
+					unknown = append(unknown, fmt.Sprintf("%q for %s", g.Role, g.Principal))
+				}
+			}
+			upgraded = append(upgraded, g)
+		}
+		if len(unknown) > 0 {
+			problems = append(problems, fmt.Sprintf("%s names unknown roles: %s", key, strings.Join(unknown, ", ")))
+			continue
+		}
+		upgraded = secrets.DeduplicateGrants(upgraded)
+		data, err := json.Marshal(upgraded)
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("encoding %s annotation: %v", key, err))
+			continue
+		}
+		if canonical(value) == string(data) {
+			continue
+		}
+		annotations[key] = string(data)
+		descriptions = append(descriptions, fmt.Sprintf("rewrote %s annotation (%d grants to %d)", key, len(grants), len(upgraded)))
+	}
+	return descriptions, problems
+}
+
+// normalizeRole converts the role spellings of earlier releases, e.g.
+// "ROLE_OWNER" or "Owner", to the current lowercase form.
+func normalizeRole(role string) string {
+	role = strings.ToLower(strings.TrimSpace(role))
+	return strings.TrimPrefix(role, "role_")
+}
+
+// canonical re-encodes a grant annotation so formatting differences alone,
+// such as whitespace, do not count as a change.
+func canonical(value string) string {
+	var grants []secrets.AnnotationGrant
+	if err := json.Unmarshal([]byte(value), &grants); err != nil {
+		return value
+	}
+	data, err := json.Marshal(grants)
+	if err != nil {
+		return value
+	}
+	return string(data)
+}
@@ -0,0 +1,97 @@
+package migrate
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	v1alpha2 "github.com/holos-run/holos-console/api/v1alpha2"
+	"github.com/holos-run/holos-console/console/resolver"
+)
+
+func managed(extra map[string]string) map[string]string {
+	labels := map[string]string{v1alpha2.LabelManagedBy: v1alpha2.ManagedByValue}
+	for k, v := range extra {
+		labels[k] = v
+	}
+	return labels
+}
+
+func TestRun(t *testing.T) {
+	ctx := context.Background()
+	r := &resolver.Resolver{NamespacePrefix: "holos-", OrganizationPrefix: "org-", FolderPrefix: "fld-", ProjectPrefix: "prj-"}
+	client := fake.NewClientset(
+		// A project namespace from before the project label.
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+			Name:   "holos-prj-web",
+			Labels: managed(map[string]string{v1alpha2.LabelResourceType: v1alpha2.ResourceTypeProject}),
+			Annotations: map[string]string{
+				v1alpha2.AnnotationShareUsers: `[{"principal":"alice@example.com","role":"ROLE_OWNER"},{"principal":"alice@example.com","role":"Viewer"},{"principal":" ","role":"viewer"}]`,
+			},
+		}},
+		// An organization namespace from before the resource-type label.
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "holos-org-acme", Labels: managed(nil)}},
+		// Already current.
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+			Name:        "holos-fld-eng",
+			Labels:      managed(map[string]string{v1alpha2.LabelResourceType: v1alpha2.ResourceTypeFolder, v1alpha2.LabelFolder: "eng"}),
+			Annotations: map[string]string{v1alpha2.AnnotationShareRoles: `[ {"principal": "eng", "role": "editor"} ]`},
+		}},
+		// Cannot be classified.
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-x", Labels: managed(nil)}},
+		&corev1.Secret{ObjectMeta: metav1.ObjectMeta{
+			Name:        "db",
+			Namespace:   "holos-prj-web",
+			Labels:      managed(nil),
+			Annotations: map[string]string{v1alpha2.AnnotationShareRoles: `[{"principal":"dba","role":"superuser"}]`},
+		}},
+	)
+	m := New(client, r)
+
+	dryRun, err := m.Run(ctx, false)
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if dryRun.Scanned != 5 || len(dryRun.Changes) != 2 || len(dryRun.Problems) != 2 {
+		t.Fatalf("unexpected report %+v", dryRun)
+	}
+	web, _ := client.CoreV1().Namespaces().Get(ctx, "holos-prj-web", metav1.GetOptions{})
+	if web.Labels[v1alpha2.LabelProject] != "" {
+		t.Fatal("expected a dry run not to write")
+	}
+
+	applied, err := m.Run(ctx, true)
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if len(applied.Changes) != 2 {
+		t.Fatalf("expected the planned changes to be applied, got %+v", applied.Changes)
+	}
+	web, _ = client.CoreV1().Namespaces().Get(ctx, "holos-prj-web", metav1.GetOptions{})
+	if web.Labels[v1alpha2.LabelProject] != "web" {
+		t.Errorf("expected the project label, got %v", web.Labels)
+	}
+	if got := web.Annotations[v1alpha2.AnnotationShareUsers]; got != `[{"principal":"alice@example.com","role":"owner"}]` {
+		t.Errorf("unexpected grants %s", got)
+	}
+	acme, _ := client.CoreV1().Namespaces().Get(ctx, "holos-org-acme", metav1.GetOptions{})
+	if acme.Labels[v1alpha2.LabelResourceType] != v1alpha2.ResourceTypeOrganization || acme.Labels[v1alpha2.LabelOrganization] != "acme" {
+		t.Errorf("unexpected organization labels %v", acme.Labels)
+	}
+	secret, _ := client.CoreV1().Secrets("holos-prj-web").Get(ctx, "db", metav1.GetOptions{})
+	if got := secret.Annotations[v1alpha2.AnnotationShareRoles]; !strings.Contains(got, "superuser") {
+		t.Errorf("expected a grant with an unknown role to be left alone, got %s", got)
+	}
+
+	again, err := m.Run(ctx, true)
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if len(again.Changes) != 0 {
+		t.Errorf("expected a second run to change nothing, got %+v", again.Changes)
+	}
+}
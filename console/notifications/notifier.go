@@ -0,0 +1,222 @@
+package notifications
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	v1alpha2 "github.com/holos-run/holos-console/api/v1alpha2"
+	"github.com/holos-run/holos-console/console/audit"
+	"github.com/holos-run/holos-console/console/events"
+	"github.com/holos-run/holos-console/console/projects"
+	"github.com/holos-run/holos-console/console/resolver"
+)
+
+// queueSize bounds the events waiting for delivery. Events arriving while
+// the queue is full are dropped rather than slowing down the request that
+// logged them.
+const queueSize = 256
+
+// Notifier delivers notifications for audit events. It reads organization
+// settings and project owners with the console service account.
+type Notifier struct {
+	client   kubernetes.Interface
+	resolver *resolver.Resolver
+	mailer   Mailer
+	webhooks *WebhookSender
+	origin   string
+	now      func() time.Time
+
+	queue   chan audit.Event
+	dropped atomic.Int64
+}
+
+// NewNotifier creates a Notifier. Email is sent through mailer when it is
+// non-nil; messages link to origin.
+func NewNotifier(client kubernetes.Interface, r *resolver.Resolver, mailer Mailer, origin string) *Notifier {
+	return &Notifier{
+		client:   client,
+		resolver: r,
+		mailer:   mailer,
+		webhooks: NewWebhookSender(),
+		origin:   origin,
+		now:      time.Now,
+		queue:    make(chan audit.Event, queueSize),
+	}
+}
+
+// Notify queues e for delivery when its action is notifiable. It never
+// blocks; subscribe it to the audit.Ring.
+func (n *Notifier) Notify(e audit.Event) {
+	if _, ok := actionSubjects[e.Action]; !ok {
+		return
+	}
+	select {
+	case n.queue <- e:
+	default:
+		n.dropped.Add(1)
+	}
+}
+
+// Run delivers queued events until ctx is cancelled. Delivery failures are
+// logged and not retried.
+func (n *Notifier) Run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case e := <-n.queue:
+			if dropped := n.dropped.Swap(0); dropped > 0 {
+				slog.WarnContext(ctx, "notification queue full, events dropped", slog.Int64("dropped", dropped))
+			}
+			if err := n.Deliver(ctx, e); err != nil {
+				slog.WarnContext(ctx, "could not deliver notification",
+					slog.String("event_action", e.Action),
+					slog.String("project", e.Project),
+					slog.Any("error", err),
+				)
+			}
+		}
+	}
+}
+
+// Deliver sends the notifications the owning organization's settings select
+// for e. Events that cannot be attributed to an organization are ignored.
+func (n *Notifier) Deliver(ctx context.Context, e audit.Event) error {
+	var project *corev1.Namespace
+	org := e.Attributes["organization"]
+	if e.ResourceType == v1alpha2.ResourceTypeOrganization {
+		org = e.ResourceName
+	}
+	if e.Project != "" {
+		ns, err := n.client.CoreV1().Namespaces().Get(ctx, n.resolver.ProjectNamespace(e.Project), metav1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf("looking up project %q: %w", e.Project, err)
+		}
+		project = ns
+		org = projects.GetOrganization(ns)
+	}
+	if org == "" {
+		return nil
+	}
+	orgNS, err := n.client.CoreV1().Namespaces().Get(ctx, n.resolver.OrgNamespace(org), metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("looking up organization %q: %w", org, err)
+	}
+	settings, err := SettingsFor(orgNS)
+	if err != nil || settings == nil || !settings.Selects(e.Action) {
+		return err
+	}
+
+	recipients := slices.Clone(settings.Email)
+	if settings.NotifyOwners && project != nil {
+		recipients = append(recipients, n.owners(project)...)
+	}
+	slices.Sort(recipients)
+	recipients = slices.Compact(recipients)
+
+	subject, body := n.message(e)
+	var errs []error
+	if n.mailer != nil {
+		for _, to := range recipients {
+			if err := n.mailer.Send(ctx, to, subject, body); err != nil {
+				errs = append(errs, fmt.Errorf("emailing %s: %w", to, err))
+			}
+		}
+	}
+	for _, hook := range settings.Webhooks {
+		var err error
+		if settings.WebhookFormat == WebhookFormatCloudEvents {
+			err = n.webhooks.SendEvent(ctx, hook, events.FromAudit(e, n.origin))
+		} else {
+			err = n.webhooks.Send(ctx, hook, summary(e))
+		}
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// owners returns the email addresses holding an active owner grant on the
+// project.
+func (n *Notifier) owners(ns *corev1.Namespace) []string {
+	grants, err := projects.GetShareUsers(ns)
+	if err != nil {
+		return nil
+	}
+	now := n.now().Unix()
+	var emails []string
+	for _, g := range grants {
+		if g.Deny || g.Role != "owner" || !strings.Contains(g.Principal, "@") || strings.HasPrefix(g.Principal, "oidc:") {
+			continue
+		}
+		if (g.Nbf != nil && *g.Nbf > now) || (g.Exp != nil && *g.Exp <= now) {
+			continue
+		}
+		emails = append(emails, g.Principal)
+	}
+	return emails
+}
+
+// message renders the email subject and body for e.
+func (n *Notifier) message(e audit.Event) (subject, body string) {
+	subject = "[Holos Console] " + actionSubjects[e.Action]
+	if name := resourceName(e); name != "" {
+		subject += ": " + name
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s\n\n", summary(e))
+	fmt.Fprintf(&b, "Action:   %s\n", e.Action)
+	fmt.Fprintf(&b, "Time:     %s\n", e.Time.UTC().Format(time.RFC3339))
+	if e.RequestID != "" {
+		fmt.Fprintf(&b, "Request:  %s\n", e.RequestID)
+	}
+	for _, k := range slices.Sorted(maps.Keys(e.Attributes)) {
+		fmt.Fprintf(&b, "%s: %s\n", k, e.Attributes[k])
+	}
+	if n.origin != "" {
+		fmt.Fprintf(&b, "\n%s\n", n.origin)
+	}
+	return subject, b.String()
+}
+
+// summary renders a one-line description of e.
+func summary(e audit.Event) string {
+	s := actionSubjects[e.Action]
+	if name := resourceName(e); name != "" {
+		s += ": " + e.ResourceType + " " + name
+	}
+	actor := e.Email
+	if actor == "" {
+		actor = e.Sub
+	}
+	if actor != "" {
+		s += " by " + actor
+		if e.ImpersonatorEmail != "" {
+			s += " (impersonated by " + e.ImpersonatorEmail + ")"
+		}
+	}
+	return s
+}
+
+// resourceName names the resource e concerns, qualified by its project.
+func resourceName(e audit.Event) string {
+	if e.Project != "" && e.ResourceName != "" && e.ResourceName != e.Project {
+		return e.Project + "/" + e.ResourceName
+	}
+	if e.ResourceName != "" {
+		return e.ResourceName
+	}
+	return e.Project
+}
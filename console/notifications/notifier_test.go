@@ -0,0 +1,165 @@
+package notifications
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	v1alpha2 "github.com/holos-run/holos-console/api/v1alpha2"
+	"github.com/holos-run/holos-console/console/audit"
+	"github.com/holos-run/holos-console/console/resolver"
+)
+
+// fakeMailer records sent email.
+type fakeMailer struct {
+	to       []string
+	subjects []string
+}
+
+func (m *fakeMailer) Send(_ context.Context, to, subject, _ string) error {
+	m.to = append(m.to, to)
+	m.subjects = append(m.subjects, subject)
+	return nil
+}
+
+func testResolver() *resolver.Resolver {
+	return &resolver.Resolver{NamespacePrefix: "holos-", OrganizationPrefix: "org-", ProjectPrefix: "prj-"}
+}
+
+func orgNamespace(settings string) *corev1.Namespace {
+	return &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+		Name:        "holos-org-acme",
+		Annotations: map[string]string{v1alpha2.AnnotationNotifications: settings},
+	}}
+}
+
+func projectNamespace(shareUsers string) *corev1.Namespace {
+	return &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+		Name: "holos-prj-billing",
+		Labels: map[string]string{
+			v1alpha2.LabelManagedBy:    v1alpha2.ManagedByValue,
+			v1alpha2.LabelResourceType: v1alpha2.ResourceTypeProject,
+			v1alpha2.LabelOrganization: "acme",
+			v1alpha2.LabelProject:      "billing",
+		},
+		Annotations: map[string]string{v1alpha2.AnnotationShareUsers: shareUsers},
+	}}
+}
+
+func TestSettingsFor(t *testing.T) {
+	for name, tt := range map[string]struct {
+		raw string
+		ok  bool
+	}{
+		"valid":             {`{"actions":["secret_delete"],"webhooks":["https://hooks.example.com/x"]}`, true},
+		"unknown action":    {`{"actions":["secrets_list"]}`, false},
+		"plain http hook":   {`{"webhooks":["http://hooks.example.com/x"]}`, false},
+		"cloudevents":       {`{"webhooks":["https://hooks.example.com/x"],"webhookFormat":"cloudevents"}`, true},
+		"unknown format":    {`{"webhookFormat":"xml"}`, false},
+		"malformed json":    {`{`, false},
+		"empty selects all": {`{}`, true},
+	} {
+		_, err := SettingsFor(orgNamespace(tt.raw))
+		if (err == nil) != tt.ok {
+			t.Errorf("%s: SettingsFor error = %v, want ok %v", name, err, tt.ok)
+		}
+	}
+	if s, err := SettingsFor(&corev1.Namespace{}); s != nil || err != nil {
+		t.Errorf("expected no settings without the annotation, got %v, %v", s, err)
+	}
+}
+
+func TestDeliver(t *testing.T) {
+	var posted []string
+	hook := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		var msg map[string]string
+		if err := json.Unmarshal(body, &msg); err != nil {
+			t.Errorf("webhook body is not json: %v", err)
+		}
+		posted = append(posted, msg["text"])
+	}))
+	defer hook.Close()
+
+	client := fake.NewClientset(
+		orgNamespace(`{"actions":["secret_delete"],"email":["security@example.com"],"notifyOwners":true,"webhooks":["`+hook.URL+`"]}`),
+		projectNamespace(`[{"principal":"owner@example.com","role":"owner"},{"principal":"viewer@example.com","role":"viewer"},{"principal":"former@example.com","role":"owner","exp":1}]`),
+	)
+	mailer := &fakeMailer{}
+	n := NewNotifier(client, testResolver(), mailer, "https://console.example.com")
+	n.webhooks.client = hook.Client()
+
+	deleted := audit.Event{Action: "secret_delete", ResourceType: "secret", ResourceName: "db", Project: "billing", Email: "alice@example.com"}
+	if err := n.Deliver(context.Background(), deleted); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if want := []string{"owner@example.com", "security@example.com"}; strings.Join(mailer.to, ",") != strings.Join(want, ",") {
+		t.Errorf("expected email to %v, got %v", want, mailer.to)
+	}
+	if len(mailer.subjects) == 0 || mailer.subjects[0] != "[Holos Console] Secret deleted: billing/db" {
+		t.Errorf("unexpected subjects %v", mailer.subjects)
+	}
+	if len(posted) != 1 || posted[0] != "Secret deleted: secret billing/db by alice@example.com" {
+		t.Errorf("unexpected webhook messages %v", posted)
+	}
+
+	// Actions the organization did not select are not delivered.
+	mailer.to = nil
+	sharing := audit.Event{Action: "sharing_update", ResourceType: "secret", ResourceName: "db", Project: "billing"}
+	if err := n.Deliver(context.Background(), sharing); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(mailer.to) != 0 {
+		t.Errorf("expected no email for an unselected action, got %v", mailer.to)
+	}
+}
+
+func TestDeliverCloudEvents(t *testing.T) {
+	var (
+		contentType string
+		event       map[string]any
+	)
+	hook := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		contentType = r.Header.Get("Content-Type")
+		body, _ := io.ReadAll(r.Body)
+		if err := json.Unmarshal(body, &event); err != nil {
+			t.Errorf("webhook body is not json: %v", err)
+		}
+	}))
+	defer hook.Close()
+
+	client := fake.NewClientset(
+		orgNamespace(`{"webhooks":["`+hook.URL+`"],"webhookFormat":"cloudevents"}`),
+		projectNamespace(`[]`),
+	)
+	n := NewNotifier(client, testResolver(), nil, "https://console.example.com")
+	n.webhooks.client = hook.Client()
+
+	deleted := audit.Event{Action: "secret_delete", ResourceType: "secret", ResourceName: "db", Project: "billing", Email: "alice@example.com"}
+	if err := n.Deliver(context.Background(), deleted); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if contentType != "application/cloudevents+json" {
+		t.Errorf("expected a structured cloudevent, got content type %q", contentType)
+	}
+	if event["type"] != "run.holos.console.audit.secret_delete" || event["source"] != "https://console.example.com" || event["subject"] != "billing/db" {
+		t.Errorf("unexpected event %v", event)
+	}
+}
+
+func TestNotifyIgnoresOtherActions(t *testing.T) {
+	n := NewNotifier(fake.NewClientset(), testResolver(), nil, "")
+	n.Notify(audit.Event{Action: "secrets_list"})
+	n.Notify(audit.Event{Action: "secret_delete"})
+	if got := len(n.queue); got != 1 {
+		t.Errorf("expected one queued event, got %d", got)
+	}
+}
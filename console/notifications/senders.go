@@ -0,0 +1,58 @@
+package notifications
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/holos-run/holos-console/console/events"
+)
+
+// defaultWebhookTimeout bounds a single webhook call.
+const defaultWebhookTimeout = 10 * time.Second
+
+// Mailer sends notification email. invitations.SMTPMailer implements it.
+type Mailer interface {
+	Send(ctx context.Context, to, subject, body string) error
+}
+
+// WebhookSender posts Slack-compatible messages and CloudEvents.
+type WebhookSender struct {
+	client *http.Client
+}
+
+// NewWebhookSender returns a WebhookSender with a bounded timeout.
+func NewWebhookSender() *WebhookSender {
+	return &WebhookSender{client: &http.Client{Timeout: defaultWebhookTimeout}}
+}
+
+// Send POSTs {"text": text} to url. Any non-2xx response is an error.
+func (w *WebhookSender) Send(ctx context.Context, url, text string) error {
+	body, err := json.Marshal(map[string]string{"text": text})
+	if err != nil {
+		return fmt.Errorf("encoding webhook message: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("building webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("calling webhook: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook returned %s", resp.Status)
+	}
+	return nil
+}
+
+// SendEvent POSTs event to url as a structured JSON CloudEvent. Any non-2xx
+// response is an error.
+func (w *WebhookSender) SendEvent(ctx context.Context, url string, event events.Event) error {
+	return events.Post(ctx, w.client, url, event)
+}
@@ -0,0 +1,102 @@
+// Package notifications emails and posts webhook messages about audit-worthy
+// events, such as sharing changes, secret deletion, denied access and
+// expiring grants.
+//
+// Notifications are driven by the structured audit pipeline: Notifier
+// subscribes to the audit.Ring, so any handler that logs an audit event with
+// a notifiable action is covered without further wiring. Each organization
+// opts in through the console.holos.run/notifications annotation on its
+// namespace.
+package notifications
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+
+	v1alpha2 "github.com/holos-run/holos-console/api/v1alpha2"
+)
+
+// actionSubjects maps each notifiable audit action to the subject line of
+// its notification. Other actions are never notified.
+var actionSubjects = map[string]string{
+	"sharing_update":           "Secret sharing changed",
+	"project_sharing_update":   "Project sharing changed",
+	"secret_delete":            "Secret deleted",
+	"secret_access_denied":     "Secret access denied",
+	"secret_key_access_denied": "Secret key access denied",
+	"secret_rotate_denied":     "Secret rotation denied",
+	"grant_expiring":           "Access grant expiring",
+}
+
+// Webhook formats selected by Settings.WebhookFormat.
+const (
+	// WebhookFormatSlack posts a Slack-compatible {"text": ...} message.
+	WebhookFormatSlack = "slack"
+	// WebhookFormatCloudEvents posts a CloudEvents 1.0 structured JSON
+	// event; see the events package.
+	WebhookFormatCloudEvents = "cloudevents"
+)
+
+// Settings are an organization's notification settings, stored as JSON in
+// the console.holos.run/notifications annotation on its namespace, e.g.
+//
+//	{"actions": ["secret_delete"], "email": ["security@example.com"],
+//	 "notifyOwners": true, "webhooks": ["https://hooks.slack.com/..."]}
+type Settings struct {
+	// Actions lists the audit actions to notify on. Empty selects every
+	// notifiable action.
+	Actions []string `json:"actions,omitempty"`
+	// Email lists addresses notified of every selected event.
+	Email []string `json:"email,omitempty"`
+	// NotifyOwners additionally emails the owners of the project an event
+	// concerns.
+	NotifyOwners bool `json:"notifyOwners,omitempty"`
+	// Webhooks lists HTTPS endpoints that receive a message for every
+	// selected event.
+	Webhooks []string `json:"webhooks,omitempty"`
+	// WebhookFormat is the body posted to Webhooks: WebhookFormatSlack, the
+	// default, or WebhookFormatCloudEvents.
+	WebhookFormat string `json:"webhookFormat,omitempty"`
+}
+
+// SettingsFor parses the notification settings on an organization
+// namespace. It returns nil when the annotation is absent.
+func SettingsFor(ns *corev1.Namespace) (*Settings, error) {
+	raw, ok := ns.Annotations[v1alpha2.AnnotationNotifications]
+	if !ok || raw == "" {
+		return nil, nil
+	}
+	var s Settings
+	if err := json.Unmarshal([]byte(raw), &s); err != nil {
+		return nil, fmt.Errorf("invalid %s annotation on %s: %w", v1alpha2.AnnotationNotifications, ns.Name, err)
+	}
+	for _, action := range s.Actions {
+		if _, ok := actionSubjects[action]; !ok {
+			return nil, fmt.Errorf("invalid %s annotation on %s: action %q is not notifiable", v1alpha2.AnnotationNotifications, ns.Name, action)
+		}
+	}
+	switch s.WebhookFormat {
+	case "", WebhookFormatSlack, WebhookFormatCloudEvents:
+	default:
+		return nil, fmt.Errorf("invalid %s annotation on %s: unknown webhook format %q", v1alpha2.AnnotationNotifications, ns.Name, s.WebhookFormat)
+	}
+	for _, hook := range s.Webhooks {
+		u, err := url.Parse(hook)
+		if err != nil || u.Scheme != "https" || u.Host == "" {
+			return nil, fmt.Errorf("invalid %s annotation on %s: webhooks must be https URLs", v1alpha2.AnnotationNotifications, ns.Name)
+		}
+	}
+	return &s, nil
+}
+
+// Selects reports whether s notifies on action.
+func (s *Settings) Selects(action string) bool {
+	if _, ok := actionSubjects[action]; !ok {
+		return false
+	}
+	return len(s.Actions) == 0 || slices.Contains(s.Actions, action)
+}
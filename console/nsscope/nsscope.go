@@ -0,0 +1,115 @@
+// Package nsscope restricts which project namespaces the console manages. A
+// shared cluster operator scopes the console to a subset of namespaces with
+// an allowlist, a denylist, or a label selector applied on top of the
+// managed-by label; namespaces outside the scope are invisible to the
+// console, as if they did not exist.
+package nsscope
+
+import (
+	"fmt"
+	"path"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+)
+
+// Scope decides whether the console manages a namespace. A nil Scope
+// manages every namespace.
+type Scope struct {
+	allow    []string
+	deny     []string
+	selector labels.Selector
+}
+
+// New returns a Scope. allow and deny are namespace names or path.Match
+// glob patterns such as "holos-prj-team-*". An empty allow list allows
+// every namespace, and deny takes precedence over allow. selector is a
+// Kubernetes label selector, e.g. "team in (web,data)", the namespace
+// labels must also match. New returns nil when nothing is restricted.
+func New(allow, deny []string, selector string) (*Scope, error) {
+	for _, pattern := range append(append([]string{}, allow...), deny...) {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("invalid namespace pattern %q: %w", pattern, err)
+		}
+	}
+	s := &Scope{allow: allow, deny: deny}
+	if selector != "" {
+		sel, err := labels.Parse(selector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid namespace selector %q: %w", selector, err)
+		}
+		s.selector = sel
+	}
+	if len(s.allow) == 0 && len(s.deny) == 0 && s.selector == nil {
+		return nil, nil
+	}
+	return s, nil
+}
+
+// Manages reports whether ns is in scope.
+func (s *Scope) Manages(ns *corev1.Namespace) bool {
+	if s == nil {
+		return true
+	}
+	if !s.ManagesName(ns.Name) {
+		return false
+	}
+	return s.selector == nil || s.selector.Matches(labels.Set(ns.Labels))
+}
+
+// ManagesName reports whether a namespace named name passes the allow and
+// deny lists. It ignores the label selector, so a true result is not
+// final for a scope with one.
+func (s *Scope) ManagesName(name string) bool {
+	if s == nil {
+		return true
+	}
+	if matchAny(s.deny, name) {
+		return false
+	}
+	return len(s.allow) == 0 || matchAny(s.allow, name)
+}
+
+// ApplyLabels sets on ns the labels the selector requires an exact value
+// for, e.g. team=web, so namespaces the console creates fall inside the
+// scope. Requirements without a single value, such as "team in (web,data)"
+// or "!legacy", are left for Manages to check.
+func (s *Scope) ApplyLabels(ns *corev1.Namespace) {
+	if s == nil || s.selector == nil {
+		return
+	}
+	requirements, _ := s.selector.Requirements()
+	for _, r := range requirements {
+		value, ok := exactValue(r)
+		if !ok {
+			continue
+		}
+		if ns.Labels == nil {
+			ns.Labels = map[string]string{}
+		}
+		if _, set := ns.Labels[r.Key()]; !set {
+			ns.Labels[r.Key()] = value
+		}
+	}
+}
+
+func exactValue(r labels.Requirement) (string, bool) {
+	switch r.Operator() {
+	case selection.Equals, selection.DoubleEquals, selection.In:
+		if r.Values().Len() == 1 {
+			return r.Values().List()[0], true
+		}
+	}
+	return "", false
+}
+
+func matchAny(patterns []string, name string) bool {
+	for _, pattern := range patterns {
+		// Patterns were validated by New.
+		if ok, _ := path.Match(pattern, name); ok {
+			return true
+		}
+	}
+	return false
+}
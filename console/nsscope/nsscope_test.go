@@ -0,0 +1,71 @@
+package nsscope
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func namespace(name string, labels map[string]string) *corev1.Namespace {
+	return &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels}}
+}
+
+func TestNew(t *testing.T) {
+	s, err := New(nil, nil, "")
+	if err != nil || s != nil {
+		t.Fatalf("expected no scope without restrictions, got %v, %v", s, err)
+	}
+	if !s.Manages(namespace("anything", nil)) {
+		t.Error("expected a nil scope to manage every namespace")
+	}
+	if _, err := New([]string{"holos-["}, nil, ""); err == nil {
+		t.Error("expected an invalid pattern to fail")
+	}
+	if _, err := New(nil, nil, "team in (web"); err == nil {
+		t.Error("expected an invalid selector to fail")
+	}
+}
+
+func TestManages(t *testing.T) {
+	s, err := New([]string{"holos-prj-team-*", "holos-prj-shared"}, []string{"holos-prj-team-legacy"}, "tier!=sandbox")
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	for _, tc := range []struct {
+		name   string
+		labels map[string]string
+		want   bool
+	}{
+		{"holos-prj-team-web", nil, true},
+		{"holos-prj-shared", map[string]string{"tier": "prod"}, true},
+		{"holos-prj-team-legacy", nil, false},
+		{"holos-prj-other", nil, false},
+		{"holos-prj-team-web", map[string]string{"tier": "sandbox"}, false},
+	} {
+		if got := s.Manages(namespace(tc.name, tc.labels)); got != tc.want {
+			t.Errorf("Manages(%s, %v): expected %v, got %v", tc.name, tc.labels, tc.want, got)
+		}
+	}
+}
+
+func TestApplyLabels(t *testing.T) {
+	s, err := New(nil, nil, "team=web,env in (prod),tier notin (sandbox),!legacy")
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	ns := namespace("holos-prj-web", map[string]string{"env": "staging"})
+	s.ApplyLabels(ns)
+	if ns.Labels["team"] != "web" {
+		t.Errorf("expected team=web, got %v", ns.Labels)
+	}
+	if ns.Labels["env"] != "staging" {
+		t.Errorf("expected an existing label to be kept, got %v", ns.Labels)
+	}
+	if _, ok := ns.Labels["tier"]; ok {
+		t.Errorf("expected no label for a notin requirement, got %v", ns.Labels)
+	}
+	if s.Manages(ns) {
+		t.Error("expected env=staging to stay outside the scope")
+	}
+}
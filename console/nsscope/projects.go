@@ -0,0 +1,115 @@
+package nsscope
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"connectrpc.com/connect"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/holos-run/holos-console/console/resolver"
+)
+
+// Projects applies a Scope, and in static mode the configured project
+// list, to the projects RPCs name. Every per-project client derives its
+// namespace from the project name with the resolver, so the check runs
+// once per request in Interceptor rather than in each client. A nil
+// Projects serves every project.
+type Projects struct {
+	scope *Scope
+	// static, when non-nil, lists the only projects served.
+	static   []string
+	resolver *resolver.Resolver
+	// client reads namespace labels for the scope's selector with the
+	// console's own credentials.
+	client kubernetes.Interface
+}
+
+// NewProjects returns the projects scope serves, limited to static when it
+// is not empty. It returns nil when nothing is restricted.
+func NewProjects(scope *Scope, static []string, r *resolver.Resolver, client kubernetes.Interface) *Projects {
+	if scope == nil && len(static) == 0 {
+		return nil
+	}
+	p := &Projects{scope: scope, resolver: r, client: client}
+	if len(static) > 0 {
+		p.static = static
+	}
+	return p
+}
+
+// Check returns a NotFound error when project is outside the scope or the
+// static list, as if it did not exist. A project whose namespace does not exist passes, so
+// the handler reports it the way it always has.
+func (p *Projects) Check(ctx context.Context, project string) error {
+	if p == nil {
+		return nil
+	}
+	if p.static != nil && !slices.Contains(p.static, project) {
+		return notFound(project)
+	}
+	nsName := p.resolver.ProjectNamespace(project)
+	if !p.scope.ManagesName(nsName) {
+		return notFound(project)
+	}
+	if p.scope == nil || p.scope.selector == nil || p.client == nil {
+		return nil
+	}
+	ns, err := p.client.CoreV1().Namespaces().Get(ctx, nsName, metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("reading namespace %q: %w", nsName, err))
+	}
+	if !p.scope.Manages(ns) {
+		return notFound(project)
+	}
+	return nil
+}
+
+// CheckNamespace applies Check to the project a namespace belongs to.
+// Organization and folder namespaces pass.
+func (p *Projects) CheckNamespace(ctx context.Context, ns string) error {
+	if p == nil {
+		return nil
+	}
+	project, err := p.resolver.ProjectFromNamespace(ns)
+	if err != nil {
+		return nil
+	}
+	return p.Check(ctx, project)
+}
+
+func notFound(project string) error {
+	return connect.NewError(connect.CodeNotFound, fmt.Errorf("project %q not found", project))
+}
+
+// Interceptor rejects requests whose project or namespace field names a
+// project p does not serve with CodeNotFound, before any handler resolves
+// the project's namespace.
+func Interceptor(p *Projects) connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			if err := p.checkRequest(ctx, req.Any()); err != nil {
+				return nil, err
+			}
+			return next(ctx, req)
+		}
+	}
+}
+
+func (p *Projects) checkRequest(ctx context.Context, msg any) error {
+	if m, ok := msg.(interface{ GetProject() string }); ok && m.GetProject() != "" {
+		if err := p.Check(ctx, m.GetProject()); err != nil {
+			return err
+		}
+	}
+	if m, ok := msg.(interface{ GetNamespace() string }); ok && m.GetNamespace() != "" {
+		return p.CheckNamespace(ctx, m.GetNamespace())
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package nsscope
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/holos-run/holos-console/console/resolver"
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+)
+
+func TestProjects(t *testing.T) {
+	if NewProjects(nil, nil, nil, nil) != nil {
+		t.Fatal("expected no projects scope without a namespace scope")
+	}
+	var unrestricted *Projects
+	if err := unrestricted.Check(context.Background(), "anything"); err != nil {
+		t.Fatalf("expected a nil scope to serve every project, got %v", err)
+	}
+
+	s, err := New([]string{"holos-prj-team-*"}, []string{"holos-prj-team-legacy"}, "tier!=sandbox")
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	client := fake.NewClientset(
+		namespace("holos-prj-team-web", nil),
+		namespace("holos-prj-team-sandbox", map[string]string{"tier": "sandbox"}),
+	)
+	p := NewProjects(s, nil, &resolver.Resolver{NamespacePrefix: "holos-", OrganizationPrefix: "org-", FolderPrefix: "fld-", ProjectPrefix: "prj-"}, client)
+
+	for _, tc := range []struct {
+		project string
+		served  bool
+	}{
+		{"team-web", true},
+		{"team-missing", true},
+		{"team-legacy", false},
+		{"other", false},
+		{"team-sandbox", false},
+	} {
+		err := p.Check(context.Background(), tc.project)
+		if tc.served && err != nil {
+			t.Errorf("Check(%s): expected the project to be served, got %v", tc.project, err)
+		}
+		if !tc.served && connect.CodeOf(err) != connect.CodeNotFound {
+			t.Errorf("Check(%s): expected NotFound, got %v", tc.project, err)
+		}
+	}
+
+	next := func(context.Context, connect.AnyRequest) (connect.AnyResponse, error) {
+		return connect.NewResponse(&consolev1.GetSecretResponse{}), nil
+	}
+	call := func(msg any) error {
+		_, err := Interceptor(p)(next)(context.Background(), &fakeRequest{msg: msg})
+		return err
+	}
+	if err := call(&consolev1.GetSecretRequest{Name: "db", Project: "other"}); connect.CodeOf(err) != connect.CodeNotFound {
+		t.Errorf("expected an out-of-scope project to be NotFound, got %v", err)
+	}
+	if err := call(&consolev1.GetSecretRequest{Name: "db", Project: "team-web"}); err != nil {
+		t.Errorf("expected an in-scope project to pass, got %v", err)
+	}
+	if err := call(&consolev1.ListTemplatesRequest{Namespace: "holos-prj-other"}); connect.CodeOf(err) != connect.CodeNotFound {
+		t.Errorf("expected an out-of-scope project namespace to be NotFound, got %v", err)
+	}
+	if err := call(&consolev1.ListTemplatesRequest{Namespace: "holos-org-acme"}); err != nil {
+		t.Errorf("expected an organization namespace to pass, got %v", err)
+	}
+}
+
+func TestProjects_Static(t *testing.T) {
+	s, err := New(nil, []string{"holos-prj-legacy"}, "")
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	r := &resolver.Resolver{NamespacePrefix: "holos-", ProjectPrefix: "prj-"}
+	for _, scope := range []*Scope{nil, s} {
+		p := NewProjects(scope, []string{"web", "legacy"}, r, nil)
+		if err := p.Check(context.Background(), "web"); err != nil {
+			t.Errorf("expected a static project to be served, got %v", err)
+		}
+		if err := p.Check(context.Background(), "data"); connect.CodeOf(err) != connect.CodeNotFound {
+			t.Errorf("expected a project outside the static list to be NotFound, got %v", err)
+		}
+	}
+}
+
+// fakeRequest is a connect.AnyRequest carrying msg.
+type fakeRequest struct {
+	connect.AnyRequest
+	msg any
+}
+
+func (r *fakeRequest) Any() any { return r.msg }
@@ -0,0 +1,157 @@
+package oidc
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/dexidp/dex/server"
+	"github.com/dexidp/dex/storage"
+	"sigs.k8s.io/yaml"
+)
+
+// autoConnectorID is the ID of the development auto-login connector.
+// Upstream connectors may not reuse it.
+const autoConnectorID = "holos"
+
+// connectorTypes are the upstream Dex connector types the embedded provider
+// accepts.
+var connectorTypes = map[string]bool{
+	"ldap":   true,
+	"github": true,
+	"google": true,
+	"oidc":   true,
+}
+
+// Connector configures an upstream identity provider for the embedded Dex
+// instance. The fields mirror an entry in the connectors list of a Dex
+// config file, so an existing Dex connectors block can be reused as is.
+//
+// String values anywhere in Config may reference a credential instead of
+// embedding it: "env:NAME" is replaced with the NAME environment variable
+// and "file:/path" with the contents of the file, trailing newline removed.
+// References are resolved when the connector is loaded so a missing secret
+// fails at startup rather than at the first login.
+type Connector struct {
+	// Type is the Dex connector type: "ldap", "github", "google", or "oidc".
+	Type string `json:"type"`
+	// ID uniquely identifies the connector and appears in the OIDC sub
+	// claim Dex issues, so it must not change once users have logged in.
+	ID string `json:"id"`
+	// Name is shown on the Dex connector selection page.
+	Name string `json:"name"`
+	// Config is the connector-specific Dex configuration.
+	Config map[string]any `json:"config"`
+}
+
+// connectorsFile is the on-disk shape read by LoadConnectors.
+type connectorsFile struct {
+	Connectors []Connector `json:"connectors"`
+}
+
+// LoadConnectors reads upstream connectors from a YAML or JSON file with a
+// top-level connectors list, resolves credential references, and validates
+// each entry against its Dex connector type.
+func LoadConnectors(path string) ([]Connector, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading dex connectors: %w", err)
+	}
+	var file connectorsFile
+	if err := yaml.UnmarshalStrict(data, &file); err != nil {
+		return nil, fmt.Errorf("parsing dex connectors %s: %w", path, err)
+	}
+	seen := make(map[string]bool, len(file.Connectors))
+	for i := range file.Connectors {
+		c := &file.Connectors[i]
+		if seen[c.ID] {
+			return nil, fmt.Errorf("dex connector %q: duplicate id", c.ID)
+		}
+		seen[c.ID] = true
+		resolved, err := resolveSecretRefs(c.Config)
+		if err != nil {
+			return nil, fmt.Errorf("dex connector %q: %w", c.ID, err)
+		}
+		c.Config, _ = resolved.(map[string]any)
+		if _, err := c.storageConnector(); err != nil {
+			return nil, err
+		}
+	}
+	return file.Connectors, nil
+}
+
+// storageConnector validates c and converts it to the Dex storage form.
+func (c Connector) storageConnector() (storage.Connector, error) {
+	if c.ID == "" {
+		return storage.Connector{}, fmt.Errorf("dex connector id is required")
+	}
+	if c.ID == autoConnectorID {
+		return storage.Connector{}, fmt.Errorf("dex connector id %q is reserved", c.ID)
+	}
+	if !connectorTypes[c.Type] {
+		return storage.Connector{}, fmt.Errorf("dex connector %q: unsupported type %q (want ldap, github, google, or oidc)", c.ID, c.Type)
+	}
+	raw, err := json.Marshal(c.Config)
+	if err != nil {
+		return storage.Connector{}, fmt.Errorf("dex connector %q: marshaling config: %w", c.ID, err)
+	}
+	// Decode into the connector's own config type so typos and type
+	// mismatches are reported at startup.
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(server.ConnectorsConfig[c.Type]()); err != nil {
+		return storage.Connector{}, fmt.Errorf("dex connector %q: invalid %s config: %w", c.ID, c.Type, err)
+	}
+	name := c.Name
+	if name == "" {
+		name = c.ID
+	}
+	return storage.Connector{ID: c.ID, Type: c.Type, Name: name, Config: raw}, nil
+}
+
+// resolveSecretRefs returns v with every "env:" and "file:" string replaced
+// by the value it references.
+func resolveSecretRefs(v any) (any, error) {
+	switch v := v.(type) {
+	case map[string]any:
+		out := make(map[string]any, len(v))
+		for k, val := range v {
+			resolved, err := resolveSecretRefs(val)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", k, err)
+			}
+			out[k] = resolved
+		}
+		return out, nil
+	case []any:
+		out := make([]any, len(v))
+		for i, val := range v {
+			resolved, err := resolveSecretRefs(val)
+			if err != nil {
+				return nil, err
+			}
+			out[i] = resolved
+		}
+		return out, nil
+	case string:
+		if name, ok := strings.CutPrefix(v, "env:"); ok {
+			value, set := os.LookupEnv(name)
+			if !set {
+				return nil, fmt.Errorf("environment variable %s is not set", name)
+			}
+			return value, nil
+		}
+		if path, ok := strings.CutPrefix(v, "file:"); ok {
+			data, err := os.ReadFile(path)
+			if err != nil {
+				return nil, err
+			}
+			return strings.TrimRight(string(data), "\r\n"), nil
+		}
+		return v, nil
+	default:
+		return v, nil
+	}
+}
@@ -0,0 +1,105 @@
+package oidc_test
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/holos-run/holos-console/console/oidc"
+)
+
+func writeConnectors(t *testing.T, body string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "connectors.yaml")
+	if err := os.WriteFile(path, []byte(body), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadConnectors_ResolvesSecrets(t *testing.T) {
+	secretFile := filepath.Join(t.TempDir(), "client-secret")
+	if err := os.WriteFile(secretFile, []byte("from-file\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("TEST_GITHUB_CLIENT_ID", "from-env")
+
+	connectors, err := oidc.LoadConnectors(writeConnectors(t, `
+connectors:
+- type: github
+  id: github
+  name: GitHub
+  config:
+    clientID: env:TEST_GITHUB_CLIENT_ID
+    clientSecret: file:`+secretFile+`
+    redirectURI: https://console.example.com/dex/callback
+    orgs:
+    - name: holos-run
+`))
+	if err != nil {
+		t.Fatalf("LoadConnectors: %v", err)
+	}
+	if len(connectors) != 1 {
+		t.Fatalf("expected 1 connector, got %d", len(connectors))
+	}
+	cfg := connectors[0].Config
+	if cfg["clientID"] != "from-env" || cfg["clientSecret"] != "from-file" {
+		t.Fatalf("expected resolved credentials, got %v", cfg)
+	}
+
+	handler, _, err := oidc.NewHandler(context.Background(), oidc.Config{
+		Issuer:       "https://test.example.com/dex",
+		ClientID:     "test-client",
+		RedirectURIs: []string{"https://test.example.com/callback"},
+		Logger:       slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError})),
+		Connectors:   connectors,
+	})
+	if err != nil || handler == nil {
+		t.Fatalf("NewHandler with upstream connector: %v", err)
+	}
+}
+
+func TestLoadConnectors_Errors(t *testing.T) {
+	cases := []struct {
+		name string
+		body string
+		want string
+	}{
+		{
+			name: "missing env",
+			body: "connectors:\n- {type: oidc, id: corp, config: {issuer: https://idp.example.com, clientID: env:TEST_UNSET_CLIENT_ID}}\n",
+			want: "TEST_UNSET_CLIENT_ID is not set",
+		},
+		{
+			name: "unsupported type",
+			body: "connectors:\n- {type: saml, id: corp, config: {}}\n",
+			want: `unsupported type "saml"`,
+		},
+		{
+			name: "reserved id",
+			body: "connectors:\n- {type: github, id: holos, config: {}}\n",
+			want: "reserved",
+		},
+		{
+			name: "duplicate id",
+			body: "connectors:\n- {type: github, id: gh, config: {}}\n- {type: github, id: gh, config: {}}\n",
+			want: "duplicate id",
+		},
+		{
+			name: "unknown config field",
+			body: "connectors:\n- {type: google, id: google, config: {clientSecrt: typo}}\n",
+			want: "invalid google config",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := oidc.LoadConnectors(writeConnectors(t, tc.body))
+			if err == nil || !strings.Contains(err.Error(), tc.want) {
+				t.Fatalf("expected error containing %q, got %v", tc.want, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package oidc
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+var (
+	dexRequestsTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "dex_http_requests_total",
+			Help: "Total number of requests to the embedded Dex provider by endpoint, method and status code.",
+		},
+		[]string{"endpoint", "method", "code"},
+	)
+
+	dexRequestDuration = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "dex_http_request_duration_seconds",
+			Help:    "Histogram of embedded Dex provider request latencies by endpoint.",
+			Buckets: []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10},
+		},
+		[]string{"endpoint"},
+	)
+)
+
+// dexEndpoints are the first path segments below the issuer that Dex
+// serves. Anything else is counted as "other" so unknown paths cannot grow
+// the number of series.
+var dexEndpoints = []string{
+	".well-known", "approval", "auth", "callback", "device", "healthz",
+	"keys", "logout", "static", "theme", "token", "userinfo",
+}
+
+// instrumentHandler wraps the Dex handler mounted at the path of issuer with
+// request counters and latency histograms labeled by endpoint.
+func instrumentHandler(issuer string, next http.Handler) http.Handler {
+	prefix := "/"
+	if u, err := url.Parse(issuer); err == nil {
+		prefix = strings.TrimSuffix(u.Path, "/") + "/"
+	}
+	handlers := make(map[string]http.Handler, len(dexEndpoints)+1)
+	for _, endpoint := range append(dexEndpoints, "other") {
+		labels := prometheus.Labels{"endpoint": endpoint}
+		handlers[endpoint] = promhttp.InstrumentHandlerDuration(dexRequestDuration.MustCurryWith(labels),
+			promhttp.InstrumentHandlerCounter(dexRequestsTotal.MustCurryWith(labels), next))
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handlers[dexEndpoint(prefix, r.URL.Path)].ServeHTTP(w, r)
+	})
+}
+
+// dexEndpoint returns the endpoint label of a request path below prefix.
+func dexEndpoint(prefix, path string) string {
+	rest, ok := strings.CutPrefix(path, prefix)
+	if !ok {
+		return "other"
+	}
+	segment, _, _ := strings.Cut(rest, "/")
+	for _, endpoint := range dexEndpoints {
+		if segment == endpoint {
+			return endpoint
+		}
+	}
+	return "other"
+}
@@ -0,0 +1,19 @@
+package oidc
+
+import "testing"
+
+func TestDexEndpoint(t *testing.T) {
+	tests := map[string]string{
+		"/dex/.well-known/openid-configuration": ".well-known",
+		"/dex/auth/holos":                       "auth",
+		"/dex/token":                            "token",
+		"/dex/keys":                             "keys",
+		"/dex/unknown/path":                     "other",
+		"/elsewhere/token":                      "other",
+	}
+	for path, want := range tests {
+		if got := dexEndpoint("/dex/", path); got != want {
+			t.Errorf("dexEndpoint(%q) = %q, want %q", path, got, want)
+		}
+	}
+}
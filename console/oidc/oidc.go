@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"log/slog"
 	"net/http"
+	"slices"
 	"time"
 
 	"github.com/dexidp/dex/server"
@@ -36,8 +37,18 @@ type Config struct {
 	// After this duration, users must re-authenticate.
 	// Default: 12 hours
 	RefreshTokenTTL time.Duration
+
+	// Connectors are the upstream identity providers users log in with.
+	// When empty, the development auto-login connector is used instead.
+	Connectors []Connector
 }
 
+// DeviceCallbackURI is the redirect URI Dex uses to complete the OAuth 2.0
+// device authorization grant (`holos-console login`). Dex only accepts it for
+// public clients without explicit redirect URIs, so NewHandler adds it to the
+// console client's list.
+const DeviceCallbackURI = "/device/callback"
+
 func init() {
 	// Register the auto-login connector for development.
 	// This connector bypasses the login form entirely.
@@ -89,33 +100,17 @@ func NewHandler(ctx context.Context, cfg Config) (http.Handler, *DexState, error
 	store = storage.WithStaticClients(store, []storage.Client{
 		{
 			ID:           cfg.ClientID,
-			RedirectURIs: cfg.RedirectURIs,
+			RedirectURIs: append(slices.Clone(cfg.RedirectURIs), DeviceCallbackURI),
 			Name:         "Holos Console",
 			Public:       true, // SPA = public client, no secret
 		},
 	})
 
-	// Configure auto-login connector for development.
-	// This connector bypasses the login form entirely and immediately authenticates
-	// users as the configured username with the configured groups.
-	autoConfig, err := json.Marshal(AutoConnectorConfig{
-		Username: GetUsername(),
-		Groups:   []string{"owner"},
-	})
+	connectors, err := storageConnectors(cfg.Connectors)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to marshal auto connector config: %w", err)
+		return nil, nil, err
 	}
-
-	// Single auto-login connector for development. Dex auto-redirects when
-	// there is exactly one connector, which is required for E2E tests.
-	store = storage.WithStaticConnectors(store, []storage.Connector{
-		{
-			ID:     "holos",
-			Type:   "holosAuto",
-			Name:   "Development Auto-Login",
-			Config: autoConfig,
-		},
-	})
+	store = storage.WithStaticConnectors(store, connectors)
 
 	// Create Dex server config
 	serverConfig := server.Config{
@@ -153,10 +148,14 @@ func NewHandler(ctx context.Context, cfg Config) (http.Handler, *DexState, error
 		return nil, nil, fmt.Errorf("failed to create dex server: %w", err)
 	}
 
+	connectorIDs := make([]string, 0, len(connectors))
+	for _, c := range connectors {
+		connectorIDs = append(connectorIDs, c.ID)
+	}
 	logger.Info("embedded OIDC provider initialized",
 		"issuer", cfg.Issuer,
 		"clientID", cfg.ClientID,
-		"username", GetUsername(),
+		"connectors", connectorIDs,
 	)
 
 	state := &DexState{
@@ -165,5 +164,37 @@ func NewHandler(ctx context.Context, cfg Config) (http.Handler, *DexState, error
 		ClientID: cfg.ClientID,
 	}
 
-	return dexServer, state, nil
+	return instrumentHandler(cfg.Issuer, dexServer), state, nil
+}
+
+// storageConnectors returns the Dex connectors for upstream. With no
+// upstream connectors it returns the single development auto-login
+// connector, which authenticates users as GetUsername with the owner group.
+// Dex auto-redirects when there is exactly one connector, which the E2E
+// tests rely on.
+func storageConnectors(upstream []Connector) ([]storage.Connector, error) {
+	if len(upstream) == 0 {
+		autoConfig, err := json.Marshal(AutoConnectorConfig{
+			Username: GetUsername(),
+			Groups:   []string{"owner"},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal auto connector config: %w", err)
+		}
+		return []storage.Connector{{
+			ID:     autoConnectorID,
+			Type:   "holosAuto",
+			Name:   "Development Auto-Login",
+			Config: autoConfig,
+		}}, nil
+	}
+	connectors := make([]storage.Connector, 0, len(upstream))
+	for _, c := range upstream {
+		sc, err := c.storageConnector()
+		if err != nil {
+			return nil, err
+		}
+		connectors = append(connectors, sc)
+	}
+	return connectors, nil
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"log/slog"
 	"os"
+	"slices"
 	"testing"
 
 	"github.com/holos-run/holos-console/console/oidc"
@@ -56,6 +57,30 @@ func TestNewHandler_RegistersSingleAutoConnector(t *testing.T) {
 	}
 }
 
+func TestNewHandler_AllowsDeviceFlowCallback(t *testing.T) {
+	ctx := context.Background()
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError}))
+
+	// The CLI device-code login completes through Dex's device callback,
+	// which Dex rejects unless the client lists it explicitly.
+	_, state, err := oidc.NewHandler(ctx, oidc.Config{
+		Issuer:       "https://test.example.com/dex",
+		ClientID:     "test-client",
+		RedirectURIs: []string{"https://test.example.com/callback"},
+		Logger:       logger,
+	})
+	if err != nil {
+		t.Fatalf("NewHandler() error = %v", err)
+	}
+	client, err := state.Storage.GetClient(ctx, "test-client")
+	if err != nil {
+		t.Fatalf("GetClient() error = %v", err)
+	}
+	if !slices.Contains(client.RedirectURIs, oidc.DeviceCallbackURI) {
+		t.Errorf("expected redirect URIs to include %q, got %v", oidc.DeviceCallbackURI, client.RedirectURIs)
+	}
+}
+
 func TestNewHandler_ValidationErrors(t *testing.T) {
 	ctx := context.Background()
 	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError}))
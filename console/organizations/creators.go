@@ -0,0 +1,156 @@
+package organizations
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"sigs.k8s.io/yaml"
+)
+
+var (
+	creatorsConfigInfo = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "org_creators_config_info",
+		Help: "Always 1, labelled with the version of the organization creator lists currently loaded.",
+	}, []string{"version"})
+	creatorsConfigReloads = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "org_creators_config_reloads_total",
+		Help: "Organization creator file reloads by result (success, error).",
+	}, []string{"result"})
+)
+
+// CreatorsFile is the YAML shape of the --org-creators-file: the users and
+// OIDC roles allowed to create organizations when implicit creation is
+// disabled.
+type CreatorsFile struct {
+	Users []string `json:"users,omitempty"`
+	Roles []string `json:"roles,omitempty"`
+}
+
+type creatorSet struct {
+	users   []string
+	roles   []string
+	version string
+}
+
+// Creators holds the explicit organization creator users and roles. It is
+// safe for concurrent use, so the lists can be replaced while requests are
+// being served.
+type Creators struct {
+	current atomic.Pointer[creatorSet]
+}
+
+// NewCreators returns Creators holding users and roles, versioned "static"
+// until a file is loaded.
+func NewCreators(users, roles []string) *Creators {
+	c := &Creators{}
+	c.set(&creatorSet{users: users, roles: roles, version: "static"})
+	return c
+}
+
+// Version identifies the loaded lists: "static" for the flag values, or a
+// hash of the file contents.
+func (c *Creators) Version() string {
+	return c.current.Load().version
+}
+
+// Allows reports whether email or one of roles is an explicit creator.
+func (c *Creators) Allows(email string, roles []string) bool {
+	set := c.current.Load()
+	for _, u := range set.users {
+		if strings.EqualFold(u, email) {
+			return true
+		}
+	}
+	for _, r := range roles {
+		for _, cr := range set.roles {
+			if strings.EqualFold(cr, r) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// LoadFile replaces the lists with the contents of path. The lists are left
+// unchanged when the file cannot be read or parsed.
+func (c *Creators) LoadFile(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("reading organization creators file: %w", err)
+	}
+	return c.load(data)
+}
+
+func (c *Creators) load(data []byte) error {
+	var file CreatorsFile
+	if err := yaml.UnmarshalStrict(data, &file); err != nil {
+		return fmt.Errorf("parsing organization creators file: %w", err)
+	}
+	c.set(&creatorSet{users: file.Users, roles: file.Roles, version: fileVersion(data)})
+	return nil
+}
+
+// fileVersion returns the version of creators file contents.
+func fileVersion(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:6])
+}
+
+func (c *Creators) set(next *creatorSet) {
+	if prev := c.current.Swap(next); prev != nil {
+		creatorsConfigInfo.DeleteLabelValues(prev.version)
+	}
+	creatorsConfigInfo.WithLabelValues(next.version).Set(1)
+}
+
+// WatchFile reloads path every interval until ctx is done, so a mounted
+// ConfigMap can change the creator lists without a restart. Content hashes
+// are compared rather than modification times because the kubelet swaps
+// ConfigMap volumes through symlinks. A file that fails to parse keeps the
+// previous lists in place.
+func (c *Creators) WatchFile(ctx context.Context, path string, interval time.Duration) {
+	var last string
+	failing := false
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			// Warn once per outage rather than on every tick.
+			if !failing {
+				slog.WarnContext(ctx, "could not read organization creators file", slog.String("path", path), slog.Any("error", err))
+			}
+			failing = true
+			continue
+		}
+		failing = false
+		// Compare with the last attempt as well as the loaded version so a
+		// broken file is reported once, not on every tick.
+		version := fileVersion(data)
+		if version == last || version == c.Version() {
+			continue
+		}
+		last = version
+		if err := c.load(data); err != nil {
+			creatorsConfigReloads.WithLabelValues("error").Inc()
+			slog.ErrorContext(ctx, "organization creators file not reloaded", slog.String("path", path), slog.Any("error", err))
+			continue
+		}
+		creatorsConfigReloads.WithLabelValues("success").Inc()
+		slog.InfoContext(ctx, "organization creators reloaded", slog.String("path", path), slog.String("version", c.Version()))
+	}
+}
@@ -0,0 +1,80 @@
+package organizations
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestCreators(t *testing.T) {
+	c := NewCreators([]string{"Alice@example.com"}, []string{"owner"})
+	if c.Version() != "static" {
+		t.Fatalf("expected static version, got %q", c.Version())
+	}
+	if !c.Allows("alice@example.com", nil) {
+		t.Error("expected user match to be case-insensitive")
+	}
+	if !c.Allows("bob@example.com", []string{"OWNER"}) {
+		t.Error("expected role match to be case-insensitive")
+	}
+	if c.Allows("bob@example.com", []string{"viewer"}) {
+		t.Error("expected bob to be denied")
+	}
+
+	path := filepath.Join(t.TempDir(), "creators.yaml")
+	if err := os.WriteFile(path, []byte("users: [bob@example.com]\nroles: [platform-admins]\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.LoadFile(path); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	version := c.Version()
+	if version == "static" {
+		t.Error("expected the version to change after loading a file")
+	}
+	if c.Allows("alice@example.com", []string{"owner"}) || !c.Allows("bob@example.com", nil) {
+		t.Error("expected the file to replace the flag lists")
+	}
+
+	t.Run("invalid file keeps the previous lists", func(t *testing.T) {
+		if err := os.WriteFile(path, []byte("user: [mallory@example.com]\n"), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		if err := c.LoadFile(path); err == nil {
+			t.Fatal("expected an error for an unknown key")
+		}
+		if c.Version() != version || !c.Allows("bob@example.com", nil) {
+			t.Error("expected the previous lists to remain in place")
+		}
+	})
+}
+
+func TestCreatorsWatchFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "creators.yaml")
+	if err := os.WriteFile(path, []byte("users: [alice@example.com]\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	c := NewCreators(nil, nil)
+	if err := c.LoadFile(path); err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go c.WatchFile(ctx, path, 10*time.Millisecond)
+
+	if err := os.WriteFile(path, []byte("users: [carol@example.com]\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for !c.Allows("carol@example.com", nil) {
+		if time.Now().After(deadline) {
+			t.Fatal("expected carol to be allowed after the file changed")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if c.Allows("alice@example.com", nil) {
+		t.Error("expected alice to be removed after the file changed")
+	}
+}
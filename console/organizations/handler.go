@@ -2,7 +2,6 @@ package organizations
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"log/slog"
 	"strings"
@@ -10,12 +9,16 @@ import (
 
 	"connectrpc.com/connect"
 	corev1 "k8s.io/api/core/v1"
-	"k8s.io/apimachinery/pkg/util/validation"
+	k8svalidation "k8s.io/apimachinery/pkg/util/validation"
 
 	v1alpha2 "github.com/holos-run/holos-console/api/v1alpha2"
+	"github.com/holos-run/holos-console/console/audit"
+	"github.com/holos-run/holos-console/console/listfilter"
+	"github.com/holos-run/holos-console/console/rawobject"
 	"github.com/holos-run/holos-console/console/rbac"
 	"github.com/holos-run/holos-console/console/rpc"
 	"github.com/holos-run/holos-console/console/secrets"
+	"github.com/holos-run/holos-console/console/validation"
 	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
 	"github.com/holos-run/holos-console/gen/holos/console/v1/consolev1connect"
 )
@@ -47,13 +50,15 @@ type ProjectCreator interface {
 type Handler struct {
 	consolev1connect.UnimplementedOrganizationServiceHandler
 	k8s             *K8sClient
+	authorizer      rbac.Authorizer
 	projectLister   ProjectLister
 	templateSeeder  TemplateSeeder
 	projectCreator  ProjectCreator
 	projectPrefix   string // namespace prefix + project prefix (e.g. "holos-prj-")
 	disableCreation bool
-	creatorUsers    []string
-	creatorRoles    []string
+	creators        *Creators
+	auditRing       *audit.Ring
+	platformOwners  rpc.PlatformOwners
 }
 
 // NewHandler creates a new OrganizationService handler.
@@ -61,7 +66,20 @@ type Handler struct {
 // authenticated principals. When true, only explicit creatorUsers and
 // creatorRoles are allowed to create organizations.
 func NewHandler(k8s *K8sClient, projectLister ProjectLister, disableCreation bool, creatorUsers, creatorRoles []string) *Handler {
-	return &Handler{k8s: k8s, projectLister: projectLister, disableCreation: disableCreation, creatorUsers: creatorUsers, creatorRoles: creatorRoles}
+	return &Handler{k8s: k8s, projectLister: projectLister, disableCreation: disableCreation, creators: NewCreators(creatorUsers, creatorRoles)}
+}
+
+// WithPlatformOwners sets the platform owners, who may force sharing
+// updates that leave an organization without an active owner.
+func (h *Handler) WithPlatformOwners(owners rpc.PlatformOwners) *Handler {
+	h.platformOwners = owners
+	return h
+}
+
+// Creators returns the explicit creator lists, so they can be reloaded from
+// a file at runtime.
+func (h *Handler) Creators() *Creators {
+	return h.creators
 }
 
 // WithDefaultsSeeder sets the template seeder and project creator used to
@@ -78,9 +96,14 @@ func (h *Handler) ListOrganizations(
 	ctx context.Context,
 	req *connect.Request[consolev1.ListOrganizationsRequest],
 ) (*connect.Response[consolev1.ListOrganizationsResponse], error) {
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	claims := rpc.MustClaims(ctx)
+	filter, err := listfilter.New("filter", req.Msg.Filter)
+	if err != nil {
+		return nil, err
+	}
+	order, err := listfilter.NewOrder("order_by", req.Msg.OrderBy)
+	if err != nil {
+		return nil, err
 	}
 
 	allOrgs, err := h.k8s.ListOrganizations(ctx)
@@ -88,13 +111,26 @@ func (h *Handler) ListOrganizations(
 		return nil, mapK8sError(err)
 	}
 
+	listfilter.Sort(order, allOrgs, func(ns *corev1.Namespace) listfilter.SortKey {
+		return listfilter.KeyOf(ns.Name, ns)
+	})
 	var result []*consolev1.Organization
 	for _, ns := range allOrgs {
 		shareUsers, _ := GetShareUsers(ns)
 		shareRoles, _ := GetShareRoles(ns)
 
-		userRole := h.effectiveRoleForNamespace(ctx, claims, ns, shareUsers, shareRoles)
-		result = append(result, buildOrganization(h.k8s, ns, shareUsers, shareRoles, userRole))
+		org := h.organizationForCaller(ctx, claims, ns, shareUsers, shareRoles)
+		if !filter.Match(listfilter.Item{
+			Name:        org.Name,
+			DisplayName: org.DisplayName,
+			Description: org.Description,
+			Labels:      ns.Labels,
+			Role:        org.UserRole,
+			Accessible:  true,
+		}) {
+			continue
+		}
+		result = append(result, org)
 	}
 
 	slog.InfoContext(ctx, "organizations listed",
@@ -115,14 +151,7 @@ func (h *Handler) GetOrganization(
 	ctx context.Context,
 	req *connect.Request[consolev1.GetOrganizationRequest],
 ) (*connect.Response[consolev1.GetOrganizationResponse], error) {
-	if req.Msg.Name == "" {
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("organization name is required"))
-	}
-
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
-	}
+	claims := rpc.MustClaims(ctx)
 
 	ns, err := h.k8s.GetOrganization(ctx, req.Msg.Name)
 	if err != nil {
@@ -132,7 +161,7 @@ func (h *Handler) GetOrganization(
 	shareUsers, _ := GetShareUsers(ns)
 	shareRoles, _ := GetShareRoles(ns)
 
-	userRole := h.effectiveRoleForNamespace(ctx, claims, ns, shareUsers, shareRoles)
+	org := h.organizationForCaller(ctx, claims, ns, shareUsers, shareRoles)
 
 	slog.InfoContext(ctx, "organization accessed",
 		slog.String("action", "organization_read"),
@@ -143,7 +172,7 @@ func (h *Handler) GetOrganization(
 	)
 
 	return connect.NewResponse(&consolev1.GetOrganizationResponse{
-		Organization: buildOrganization(h.k8s, ns, shareUsers, shareRoles, userRole),
+		Organization: org,
 	}), nil
 }
 
@@ -152,18 +181,18 @@ func (h *Handler) CreateOrganization(
 	ctx context.Context,
 	req *connect.Request[consolev1.CreateOrganizationRequest],
 ) (*connect.Response[consolev1.CreateOrganizationResponse], error) {
-	if req.Msg.Name == "" {
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("organization name is required"))
+	var v validation.Violations
+	v.UserGrants("user_grants", req.Msg.UserGrants)
+	v.RoleGrants("role_grants", req.Msg.RoleGrants)
+	if err := v.Err(); err != nil {
+		return nil, err
 	}
 
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
-	}
+	claims := rpc.MustClaims(ctx)
 
 	// Implicit grant: all authenticated principals can create orgs unless disabled.
 	// Explicit grants via --org-creator-users/--org-creator-roles always apply.
-	if h.disableCreation && !h.isOrgCreator(claims.Email, claims.Roles) {
+	if !h.CanCreateOrganizations(claims) {
 		slog.WarnContext(ctx, "organization create denied",
 			slog.String("action", "organization_create_denied"),
 			slog.String("resource_type", auditResourceType),
@@ -339,14 +368,7 @@ func (h *Handler) UpdateOrganization(
 	ctx context.Context,
 	req *connect.Request[consolev1.UpdateOrganizationRequest],
 ) (*connect.Response[consolev1.UpdateOrganizationResponse], error) {
-	if req.Msg.Name == "" {
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("organization name is required"))
-	}
-
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
-	}
+	claims := rpc.MustClaims(ctx)
 
 	if _, err := h.k8s.GetOrganization(ctx, req.Msg.Name); err != nil {
 		return nil, mapK8sError(err)
@@ -357,10 +379,11 @@ func (h *Handler) UpdateOrganization(
 	// the Kubernetes DNS-1123 label rule (the same rule k8s applies to
 	// namespace names).
 	if req.Msg.GatewayNamespace != nil && *req.Msg.GatewayNamespace != "" {
-		if errs := validation.IsDNS1123Label(*req.Msg.GatewayNamespace); len(errs) > 0 {
-			return nil, connect.NewError(connect.CodeInvalidArgument,
-				fmt.Errorf("gateway_namespace %q is not a valid DNS-1123 label: %s",
-					*req.Msg.GatewayNamespace, strings.Join(errs, "; ")))
+		if errs := k8svalidation.IsDNS1123Label(*req.Msg.GatewayNamespace); len(errs) > 0 {
+			var v validation.Violations
+			v.Add("gateway_namespace", validation.ReasonDNSLabel, "gateway_namespace %q is not a valid DNS-1123 label: %s",
+				*req.Msg.GatewayNamespace, strings.Join(errs, "; "))
+			return nil, v.Err()
 		}
 	}
 
@@ -384,14 +407,7 @@ func (h *Handler) DeleteOrganization(
 	ctx context.Context,
 	req *connect.Request[consolev1.DeleteOrganizationRequest],
 ) (*connect.Response[consolev1.DeleteOrganizationResponse], error) {
-	if req.Msg.Name == "" {
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("organization name is required"))
-	}
-
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
-	}
+	claims := rpc.MustClaims(ctx)
 
 	if _, err := h.k8s.GetOrganization(ctx, req.Msg.Name); err != nil {
 		return nil, mapK8sError(err)
@@ -428,14 +444,14 @@ func (h *Handler) UpdateOrganizationSharing(
 	ctx context.Context,
 	req *connect.Request[consolev1.UpdateOrganizationSharingRequest],
 ) (*connect.Response[consolev1.UpdateOrganizationSharingResponse], error) {
-	if req.Msg.Name == "" {
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("organization name is required"))
+	var v validation.Violations
+	v.UserGrants("user_grants", req.Msg.UserGrants)
+	v.RoleGrants("role_grants", req.Msg.RoleGrants)
+	if err := v.Err(); err != nil {
+		return nil, err
 	}
 
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
-	}
+	claims := rpc.MustClaims(ctx)
 
 	ns, err := h.k8s.GetOrganization(ctx, req.Msg.Name)
 	if err != nil {
@@ -443,12 +459,15 @@ func (h *Handler) UpdateOrganizationSharing(
 	}
 	shareUsers, _ := GetShareUsers(ns)
 	shareRoles, _ := GetShareRoles(ns)
-	if err := h.requireNamespaceOwner(ctx, claims, ns, shareUsers, shareRoles, "organization sharing update"); err != nil {
+	if err := h.requireNamespaceOwner(ctx, claims, ns, shareUsers, shareRoles, rbac.PermissionOrganizationsAdmin, "organization sharing update"); err != nil {
 		return nil, err
 	}
 
 	newShareUsers := shareGrantsToAnnotations(req.Msg.UserGrants)
 	newShareRoles := shareGrantsToAnnotations(req.Msg.RoleGrants)
+	if err := secrets.RequireActiveOwner(claims, h.platformOwners, "organization "+req.Msg.Name, newShareUsers, newShareRoles, req.Msg.Force); err != nil {
+		return nil, err
+	}
 
 	storedCreator := secrets.UserIdentity{
 		Email:   ns.Annotations[v1alpha2.AnnotationCreatorEmail],
@@ -471,14 +490,14 @@ func (h *Handler) UpdateOrganizationSharing(
 		slog.String("organization", req.Msg.Name),
 		slog.String("sub", claims.Sub),
 		slog.String("email", claims.Email),
+		slog.Bool("force", req.Msg.Force),
 	)
 
 	updatedUsers, _ := GetShareUsers(updated)
 	updatedRoles, _ := GetShareRoles(updated)
-	userRole := h.effectiveRoleForNamespace(ctx, claims, updated, updatedUsers, updatedRoles)
 
 	return connect.NewResponse(&consolev1.UpdateOrganizationSharingResponse{
-		Organization: buildOrganization(h.k8s, updated, updatedUsers, updatedRoles, userRole),
+		Organization: h.organizationForCaller(ctx, claims, updated, updatedUsers, updatedRoles),
 	}), nil
 }
 
@@ -487,14 +506,14 @@ func (h *Handler) UpdateOrganizationDefaultSharing(
 	ctx context.Context,
 	req *connect.Request[consolev1.UpdateOrganizationDefaultSharingRequest],
 ) (*connect.Response[consolev1.UpdateOrganizationDefaultSharingResponse], error) {
-	if req.Msg.Name == "" {
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("organization name is required"))
+	var v validation.Violations
+	v.UserGrants("default_user_grants", req.Msg.DefaultUserGrants)
+	v.RoleGrants("default_role_grants", req.Msg.DefaultRoleGrants)
+	if err := v.Err(); err != nil {
+		return nil, err
 	}
 
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
-	}
+	claims := rpc.MustClaims(ctx)
 
 	ns, err := h.k8s.GetOrganization(ctx, req.Msg.Name)
 	if err != nil {
@@ -502,7 +521,7 @@ func (h *Handler) UpdateOrganizationDefaultSharing(
 	}
 	shareUsers, _ := GetShareUsers(ns)
 	shareRoles, _ := GetShareRoles(ns)
-	if err := h.requireNamespaceOwner(ctx, claims, ns, shareUsers, shareRoles, "organization default sharing update"); err != nil {
+	if err := h.requireNamespaceOwner(ctx, claims, ns, shareUsers, shareRoles, rbac.PermissionOrganizationsAdmin, "organization default sharing update"); err != nil {
 		return nil, err
 	}
 
@@ -524,26 +543,18 @@ func (h *Handler) UpdateOrganizationDefaultSharing(
 
 	updatedShareUsers, _ := GetShareUsers(updated)
 	updatedShareRoles, _ := GetShareRoles(updated)
-	userRole := h.effectiveRoleForNamespace(ctx, claims, updated, updatedShareUsers, updatedShareRoles)
 
 	return connect.NewResponse(&consolev1.UpdateOrganizationDefaultSharingResponse{
-		Organization: buildOrganization(h.k8s, updated, updatedShareUsers, updatedShareRoles, userRole),
+		Organization: h.organizationForCaller(ctx, claims, updated, updatedShareUsers, updatedShareRoles),
 	}), nil
 }
 
-// GetOrganizationRaw retrieves the full Kubernetes Namespace object as verbatim JSON.
+// GetOrganizationRaw retrieves the full Kubernetes Namespace object as JSON or YAML.
 func (h *Handler) GetOrganizationRaw(
 	ctx context.Context,
 	req *connect.Request[consolev1.GetOrganizationRawRequest],
 ) (*connect.Response[consolev1.GetOrganizationRawResponse], error) {
-	if req.Msg.Name == "" {
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("organization name is required"))
-	}
-
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
-	}
+	claims := rpc.MustClaims(ctx)
 
 	ns, err := h.k8s.GetOrganization(ctx, req.Msg.Name)
 	if err != nil {
@@ -562,9 +573,9 @@ func (h *Handler) GetOrganizationRaw(
 	ns.APIVersion = "v1"
 	ns.Kind = "Namespace"
 
-	raw, err := json.Marshal(ns)
+	raw, err := rawobject.Marshal(ns, req.Msg.Format, req.Msg.StripManagedFields)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("marshaling namespace to JSON: %w", err))
+		return nil, err
 	}
 
 	return connect.NewResponse(&consolev1.GetOrganizationRawResponse{
@@ -572,62 +583,53 @@ func (h *Handler) GetOrganizationRaw(
 	}), nil
 }
 
-// isOrgCreator checks whether the caller is authorized to create organizations
-// based on the CLI-configured creator lists.
-func (h *Handler) isOrgCreator(email string, roles []string) bool {
-	emailLower := strings.ToLower(email)
-	for _, u := range h.creatorUsers {
-		if strings.ToLower(u) == emailLower {
-			return true
-		}
-	}
-	for _, r := range roles {
-		rLower := strings.ToLower(r)
-		for _, cr := range h.creatorRoles {
-			if strings.ToLower(cr) == rLower {
-				return true
-			}
-		}
+// CanCreateOrganizations reports whether claims may create organizations:
+// every principal unless creation is disabled, plus the explicit creator
+// users and roles.
+func (h *Handler) CanCreateOrganizations(claims *rpc.Claims) bool {
+	return !h.disableCreation || h.creators.Allows(claims.Email, claims.Roles)
+}
+
+// namespaceResource describes ns and its active share grants to the
+// authorizer.
+func namespaceResource(ns interface{ GetName() string }, shareUsers, shareRoles []secrets.AnnotationGrant) *rbac.Resource {
+	now := time.Now()
+	return &rbac.Resource{
+		Namespace: ns.GetName(),
+		Users:     secrets.ActiveGrantsMap(shareUsers, now),
+		Roles:     secrets.ActiveGrantsMap(shareRoles, now),
 	}
-	return false
+}
+
+// organizationForCaller builds the Organization ns describes with the
+// caller's effective role on it and the grant that role comes from.
+func (h *Handler) organizationForCaller(ctx context.Context, claims *rpc.Claims, ns interface{ GetName() string }, shareUsers, shareRoles []secrets.AnnotationGrant) *consolev1.Organization {
+	userRole := h.effectiveRoleForNamespace(ctx, claims, ns, shareUsers, shareRoles)
+	org := buildOrganization(h.k8s, ns, shareUsers, shareRoles, userRole)
+	res := namespaceResource(ns, shareUsers, shareRoles)
+	org.UserRoleSource = rbac.RoleSourceFromGrants(userRole, claims.Email, claims.EmailVerified, claims.Roles, rbac.GrantScope{
+		ResourceType: v1alpha2.ResourceTypeOrganization,
+		ResourceName: org.Name,
+		Users:        res.Users,
+		Roles:        res.Roles,
+	})
+	return org
 }
 
 func (h *Handler) effectiveRoleForNamespace(ctx context.Context, claims *rpc.Claims, ns interface{ GetName() string }, shareUsers, shareRoles []secrets.AnnotationGrant) rbac.Role {
-	if rpc.HasImpersonatedClients(ctx) {
-		if ok, err := h.k8s.canVerbNamespace(ctx, "delete", ns.GetName()); err == nil && ok {
-			return rbac.RoleOwner
-		}
-		if ok, err := h.k8s.canVerbNamespace(ctx, "update", ns.GetName()); err == nil && ok {
-			return rbac.RoleEditor
-		}
-		if ok, err := h.k8s.canVerbNamespace(ctx, "get", ns.GetName()); err == nil && ok {
-			return rbac.RoleViewer
-		}
-		return rbac.RoleUnspecified
-	}
-	return rbac.BestRoleFromGrants(
-		claims.Email,
-		claims.Roles,
-		secrets.ActiveGrantsMap(shareUsers, time.Now()),
-		secrets.ActiveGrantsMap(shareRoles, time.Now()),
-	)
+	role, _ := rbac.EffectiveRole(ctx, claims, namespaceResource(ns, shareUsers, shareRoles))
+	return role
 }
 
-func (h *Handler) requireNamespaceOwner(ctx context.Context, claims *rpc.Claims, ns interface{ GetName() string }, shareUsers, shareRoles []secrets.AnnotationGrant, action string) error {
-	if rpc.HasImpersonatedClients(ctx) {
-		ok, err := h.k8s.canVerbNamespace(ctx, "delete", ns.GetName())
-		if err != nil {
-			return mapK8sError(err)
-		}
-		if ok {
-			return nil
-		}
-		return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("RBAC: not authorized to %s", action))
-	}
-	if h.effectiveRoleForNamespace(ctx, claims, ns, shareUsers, shareRoles) == rbac.RoleOwner {
+func (h *Handler) requireNamespaceOwner(ctx context.Context, claims *rpc.Claims, ns interface{ GetName() string }, shareUsers, shareRoles []secrets.AnnotationGrant, permission rbac.Permission, action string) error {
+	err := h.authorizer.Authorize(ctx, claims, namespaceResource(ns, shareUsers, shareRoles), permission)
+	if err == nil {
 		return nil
 	}
-	return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("RBAC: not authorized to %s", action))
+	if connect.CodeOf(err) == connect.CodePermissionDenied {
+		return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("RBAC: not authorized to %s", action))
+	}
+	return mapK8sError(err)
 }
 
 // buildOrganization creates an Organization proto message from a namespace.
@@ -647,22 +649,13 @@ func buildOrganization(k8s *K8sClient, ns interface{ GetName() string }, shareUs
 			org.Name = labels[v1alpha2.LabelOrganization]
 		}
 	}
-	// Fallback: derive org name from namespace if label is missing (pre-label namespaces)
+	// Namespaces created before the organization label existed are
+	// labelled by `holos-console migrate`.
 	if org.Name == "" {
-		name, err := k8s.resolver.OrgFromNamespace(ns.GetName())
-		if err != nil {
-			slog.Warn("organization namespace missing label and prefix mismatch",
-				slog.String("namespace", ns.GetName()),
-				slog.String("label", v1alpha2.LabelOrganization),
-				slog.Any("error", err),
-			)
-		} else {
-			org.Name = name
-			slog.Warn("organization namespace missing label, falling back to namespace parsing",
-				slog.String("namespace", ns.GetName()),
-				slog.String("label", v1alpha2.LabelOrganization),
-			)
-		}
+		slog.Warn("organization namespace missing label; run holos-console migrate",
+			slog.String("namespace", ns.GetName()),
+			slog.String("label", v1alpha2.LabelOrganization),
+		)
 	}
 
 	type annotated interface {
@@ -685,6 +678,7 @@ func buildOrganization(k8s *K8sClient, ns interface{ GetName() string }, shareUs
 				org.DefaultRoleGrants = annotationGrantsToProto(defaultRoles)
 			}
 			org.CreatedAt = nsTyped.CreationTimestamp.UTC().Format(time.RFC3339)
+			org.UpdatedAt = listfilter.LastModified(nsTyped).UTC().Format(time.RFC3339)
 		}
 	}
 
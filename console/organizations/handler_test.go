@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"io"
 	"log/slog"
+	"slices"
 	"testing"
+	"time"
 
 	"connectrpc.com/connect"
 	corev1 "k8s.io/api/core/v1"
@@ -18,6 +20,7 @@ import (
 	v1alpha2 "github.com/holos-run/holos-console/api/v1alpha2"
 	"github.com/holos-run/holos-console/console/resolver"
 	"github.com/holos-run/holos-console/console/rpc"
+	"github.com/holos-run/holos-console/console/rpc/rpctesting"
 	"github.com/holos-run/holos-console/console/secrets"
 	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
 )
@@ -97,12 +100,6 @@ func newTestHandlerWithOpts(opts testHandlerOpts, namespaces ...*corev1.Namespac
 
 // ---- ListOrganizations tests ----
 
-func TestListOrganizations_Unauthenticated(t *testing.T) {
-	handler := newTestHandler()
-	_, err := handler.ListOrganizations(context.Background(), connect.NewRequest(&consolev1.ListOrganizationsRequest{}))
-	assertUnauthenticated(t, err)
-}
-
 func TestListOrganizations_ReturnsOrgNameNotNamespace(t *testing.T) {
 	ns := orgNS("acme", `[{"principal":"alice@example.com","role":"viewer"}]`)
 	handler := newTestHandler(ns)
@@ -120,13 +117,92 @@ func TestListOrganizations_ReturnsOrgNameNotNamespace(t *testing.T) {
 	}
 }
 
+func TestListOrganizations_Filter(t *testing.T) {
+	acme := orgNS("acme", `[{"principal":"alice@example.com","role":"owner"}]`)
+	beta := orgNS("beta", `[{"principal":"alice@example.com","role":"viewer"}]`)
+	beta.Labels["env"] = "prod"
+	handler := newTestHandler(acme, beta)
+	ctx := contextWithClaims("alice@example.com")
+
+	tests := []struct {
+		name   string
+		filter *consolev1.ListFilter
+		want   []string
+	}{
+		{"no filter", nil, []string{"acme", "beta"}},
+		{"name contains", &consolev1.ListFilter{NameContains: "BET"}, []string{"beta"}},
+		{"label selector", &consolev1.ListFilter{LabelSelector: "env=prod"}, []string{"beta"}},
+		{"min role", &consolev1.ListFilter{MinRole: consolev1.Role_ROLE_OWNER}, []string{"acme"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := handler.ListOrganizations(ctx, connect.NewRequest(&consolev1.ListOrganizationsRequest{Filter: tt.filter}))
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			var got []string
+			for _, org := range resp.Msg.Organizations {
+				got = append(got, org.Name)
+			}
+			slices.Sort(got)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("organizations = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	_, err := handler.ListOrganizations(ctx, connect.NewRequest(&consolev1.ListOrganizationsRequest{
+		Filter: &consolev1.ListFilter{LabelSelector: "env in (prod"},
+	}))
+	assertInvalidArgument(t, err)
+}
+
+func TestListOrganizations_OrderBy(t *testing.T) {
+	acme := orgNS("acme", `[{"principal":"alice@example.com","role":"owner"}]`)
+	beta := orgNS("beta", `[{"principal":"alice@example.com","role":"viewer"}]`)
+	acme.CreationTimestamp = metav1.NewTime(time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC))
+	beta.CreationTimestamp = metav1.NewTime(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	handler := newTestHandler(beta, acme)
+	ctx := contextWithClaims("alice@example.com")
+
+	tests := []struct {
+		name  string
+		order *consolev1.ListOrder
+		want  []string
+	}{
+		{"name", &consolev1.ListOrder{Field: consolev1.ListOrderField_LIST_ORDER_FIELD_NAME}, []string{"acme", "beta"}},
+		{"name descending", &consolev1.ListOrder{Descending: true}, []string{"beta", "acme"}},
+		{"created", &consolev1.ListOrder{Field: consolev1.ListOrderField_LIST_ORDER_FIELD_CREATED}, []string{"beta", "acme"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := handler.ListOrganizations(ctx, connect.NewRequest(&consolev1.ListOrganizationsRequest{OrderBy: tt.order}))
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			var got []string
+			for _, org := range resp.Msg.Organizations {
+				got = append(got, org.Name)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("organizations = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	_, err := handler.ListOrganizations(ctx, connect.NewRequest(&consolev1.ListOrganizationsRequest{
+		OrderBy: &consolev1.ListOrder{Field: 42},
+	}))
+	assertInvalidArgument(t, err)
+}
+
 // ---- GetOrganization tests ----
 
 func TestGetOrganization_InvalidArgument(t *testing.T) {
 	handler := newTestHandler()
 	ctx := contextWithClaims("alice@example.com")
 
-	_, err := handler.GetOrganization(ctx, connect.NewRequest(&consolev1.GetOrganizationRequest{Name: ""}))
+	_, err := rpctesting.Validated(ctx, connect.NewRequest(&consolev1.GetOrganizationRequest{Name: ""}), handler.GetOrganization)
 	assertInvalidArgument(t, err)
 }
 
@@ -443,7 +519,7 @@ func TestUpdateOrgSharing_RoleGrantsOnly(t *testing.T) {
 	resp, err := handler.UpdateOrganizationSharing(ctx, connect.NewRequest(&consolev1.UpdateOrganizationSharingRequest{
 		Name: "acme",
 		RoleGrants: []*consolev1.ShareGrant{
-			{Principal: "dev-team", Role: consolev1.Role_ROLE_VIEWER},
+			{Principal: "dev-team", Role: consolev1.Role_ROLE_OWNER},
 		},
 	}))
 	if err != nil {
@@ -457,6 +533,46 @@ func TestUpdateOrgSharing_RoleGrantsOnly(t *testing.T) {
 	}
 }
 
+func TestUpdateOrgSharing_LastOwner(t *testing.T) {
+	viewersOnly := []*consolev1.ShareGrant{{Principal: "alice@example.com", Role: consolev1.Role_ROLE_VIEWER}}
+
+	t.Run("rejects removing the last owner", func(t *testing.T) {
+		handler := newTestHandler(orgNS("acme", `[{"principal":"alice@example.com","role":"owner"}]`))
+		_, err := handler.UpdateOrganizationSharing(contextWithClaims("alice@example.com"), connect.NewRequest(&consolev1.UpdateOrganizationSharingRequest{
+			Name:       "acme",
+			UserGrants: viewersOnly,
+		}))
+		if connect.CodeOf(err) != connect.CodeFailedPrecondition {
+			t.Fatalf("expected FailedPrecondition, got %v", err)
+		}
+	})
+
+	t.Run("force requires a platform owner", func(t *testing.T) {
+		handler := newTestHandler(orgNS("acme", `[{"principal":"alice@example.com","role":"owner"}]`))
+		_, err := handler.UpdateOrganizationSharing(contextWithClaims("alice@example.com"), connect.NewRequest(&consolev1.UpdateOrganizationSharingRequest{
+			Name:       "acme",
+			UserGrants: viewersOnly,
+			Force:      true,
+		}))
+		if connect.CodeOf(err) != connect.CodePermissionDenied {
+			t.Fatalf("expected PermissionDenied, got %v", err)
+		}
+	})
+
+	t.Run("a platform owner may force", func(t *testing.T) {
+		handler := newTestHandler(orgNS("acme", `[{"principal":"alice@example.com","role":"owner"}]`)).
+			WithPlatformOwners(rpc.PlatformOwners{Users: []string{"alice@example.com"}})
+		_, err := handler.UpdateOrganizationSharing(contextWithClaims("alice@example.com"), connect.NewRequest(&consolev1.UpdateOrganizationSharingRequest{
+			Name:       "acme",
+			UserGrants: viewersOnly,
+			Force:      true,
+		}))
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	})
+}
+
 // ---- UpdateOrganizationDefaultSharing tests ----
 
 func TestUpdateOrgDefaultSharing_OwnerAllows(t *testing.T) {
@@ -492,20 +608,12 @@ func TestUpdateOrgDefaultSharing_EmptyNameRejects(t *testing.T) {
 	handler := newTestHandler()
 	ctx := contextWithClaims("alice@example.com")
 
-	_, err := handler.UpdateOrganizationDefaultSharing(ctx, connect.NewRequest(&consolev1.UpdateOrganizationDefaultSharingRequest{
+	_, err := rpctesting.Validated(ctx, connect.NewRequest(&consolev1.UpdateOrganizationDefaultSharingRequest{
 		Name: "",
-	}))
+	}), handler.UpdateOrganizationDefaultSharing)
 	assertInvalidArgument(t, err)
 }
 
-func TestUpdateOrgDefaultSharing_Unauthenticated(t *testing.T) {
-	handler := newTestHandler()
-	_, err := handler.UpdateOrganizationDefaultSharing(context.Background(), connect.NewRequest(&consolev1.UpdateOrganizationDefaultSharingRequest{
-		Name: "acme",
-	}))
-	assertUnauthenticated(t, err)
-}
-
 func TestBuildOrganization_IncludesDefaultSharing(t *testing.T) {
 	ns := orgNS("acme", `[{"principal":"alice@example.com","role":"owner"}]`)
 	ns.Annotations[v1alpha2.AnnotationDefaultShareUsers] = `[{"principal":"bob@example.com","role":"editor"}]`
@@ -1395,20 +1503,6 @@ func TestCreateOrganization_SeedsDefaultRoleGrants(t *testing.T) {
 
 // ---- Helpers ----
 
-func assertUnauthenticated(t *testing.T, err error) {
-	t.Helper()
-	if err == nil {
-		t.Fatal("expected error, got nil")
-	}
-	connectErr, ok := err.(*connect.Error)
-	if !ok {
-		t.Fatalf("expected *connect.Error, got %T", err)
-	}
-	if connectErr.Code() != connect.CodeUnauthenticated {
-		t.Errorf("expected CodeUnauthenticated, got %v", connectErr.Code())
-	}
-}
-
 func assertPermissionDenied(t *testing.T, err error) {
 	t.Helper()
 	if err == nil {
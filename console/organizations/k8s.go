@@ -13,7 +13,8 @@ import (
 	"github.com/holos-run/holos-console/console/rpc"
 	"github.com/holos-run/holos-console/console/secrets"
 	"github.com/holos-run/holos-console/console/sharing/legacy" //nolint:staticcheck // Organizations still expose legacy grant-derived role hints.
-	authv1 "k8s.io/api/authorization/v1"
+	"github.com/holos-run/holos-console/console/tracing"
+	"go.opentelemetry.io/otel/attribute"
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -38,29 +39,10 @@ func (c *K8sClient) clientset(ctx context.Context) kubernetes.Interface {
 	return c.client
 }
 
-func (c *K8sClient) canVerbNamespace(ctx context.Context, verb, name string) (bool, error) {
-	if !rpc.HasImpersonatedClients(ctx) {
-		return true, nil
-	}
-	review := &authv1.SelfSubjectAccessReview{
-		Spec: authv1.SelfSubjectAccessReviewSpec{
-			ResourceAttributes: &authv1.ResourceAttributes{
-				Verb:     verb,
-				Group:    "",
-				Resource: "namespaces",
-				Name:     name,
-			},
-		},
-	}
-	got, err := rpc.ImpersonatedClientsetFromContext(ctx).AuthorizationV1().SelfSubjectAccessReviews().Create(ctx, review, metav1.CreateOptions{})
-	if err != nil {
-		return false, err
-	}
-	return got.Status.Allowed, nil
-}
-
 // ListOrganizations returns all namespaces with the organization resource-type label.
 func (c *K8sClient) ListOrganizations(ctx context.Context) ([]*corev1.Namespace, error) {
+	ctx, span := tracing.Start(ctx, "organizations.K8sClient.ListOrganizations")
+	defer span.End()
 	labelSelector := v1alpha2.LabelManagedBy + "=" + v1alpha2.ManagedByValue + "," +
 		v1alpha2.LabelResourceType + "=" + v1alpha2.ResourceTypeOrganization
 	slog.DebugContext(ctx, "listing organizations from kubernetes",
@@ -118,6 +100,8 @@ func (c *K8sClient) ListOrganizations(ctx context.Context) ([]*corev1.Namespace,
 // GetOrganization retrieves a managed organization namespace by name.
 // Returns an error if the namespace does not have the expected labels.
 func (c *K8sClient) GetOrganization(ctx context.Context, name string) (*corev1.Namespace, error) {
+	ctx, span := tracing.Start(ctx, "organizations.K8sClient.GetOrganization", attribute.String("name", name))
+	defer span.End()
 	nsName := c.resolver.OrgNamespace(name)
 	slog.DebugContext(ctx, "getting organization from kubernetes",
 		slog.String("name", name),
@@ -138,6 +122,8 @@ func (c *K8sClient) GetOrganization(ctx context.Context, name string) (*corev1.N
 
 // CreateOrganization creates a new namespace with organization labels and annotations.
 func (c *K8sClient) CreateOrganization(ctx context.Context, name, displayName, description, creatorEmail, creatorSubject string, shareUsers, shareRoles []secrets.AnnotationGrant) (*corev1.Namespace, error) {
+	ctx, span := tracing.Start(ctx, "organizations.K8sClient.CreateOrganization", attribute.String("name", name))
+	defer span.End()
 	nsName := c.resolver.OrgNamespace(name)
 	slog.DebugContext(ctx, "creating organization in kubernetes",
 		slog.String("name", name),
@@ -216,6 +202,8 @@ func (c *K8sClient) impersonatedOrNil(ctx context.Context) kubernetes.Interface
 // namespace annotations on an organization namespace. Nil pointers preserve
 // existing values; empty strings clear the corresponding annotation.
 func (c *K8sClient) UpdateOrganization(ctx context.Context, name string, displayName, description, gatewayNamespace *string) (*corev1.Namespace, error) {
+	ctx, span := tracing.Start(ctx, "organizations.K8sClient.UpdateOrganization", attribute.String("name", name))
+	defer span.End()
 	slog.DebugContext(ctx, "updating organization in kubernetes",
 		slog.String("name", name),
 	)
@@ -253,6 +241,8 @@ func (c *K8sClient) UpdateOrganization(ctx context.Context, name string, display
 // DeleteOrganization deletes a managed organization namespace.
 // Returns an error if the namespace does not have the expected labels.
 func (c *K8sClient) DeleteOrganization(ctx context.Context, name string) error {
+	ctx, span := tracing.Start(ctx, "organizations.K8sClient.DeleteOrganization", attribute.String("name", name))
+	defer span.End()
 	slog.DebugContext(ctx, "deleting organization from kubernetes",
 		slog.String("name", name),
 	)
@@ -276,6 +266,8 @@ func GetGatewayNamespace(ns *corev1.Namespace) string {
 // SetGatewayNamespace writes (or clears) the gateway-namespace annotation on
 // the org namespace. An empty value deletes the annotation.
 func (c *K8sClient) SetGatewayNamespace(ctx context.Context, name, value string) error {
+	ctx, span := tracing.Start(ctx, "organizations.K8sClient.SetGatewayNamespace", attribute.String("name", name))
+	defer span.End()
 	ns, err := c.GetOrganization(ctx, name)
 	if err != nil {
 		return err
@@ -294,6 +286,8 @@ func (c *K8sClient) SetGatewayNamespace(ctx context.Context, name, value string)
 
 // UpdateOrganizationSharing updates the sharing annotations on an organization namespace.
 func (c *K8sClient) UpdateOrganizationSharing(ctx context.Context, name string, shareUsers, shareRoles, rbacShareUsers []secrets.AnnotationGrant) (*corev1.Namespace, error) {
+	ctx, span := tracing.Start(ctx, "organizations.K8sClient.UpdateOrganizationSharing", attribute.String("name", name))
+	defer span.End()
 	slog.DebugContext(ctx, "updating organization sharing in kubernetes",
 		slog.String("name", name),
 	)
@@ -364,6 +358,8 @@ func GetDefaultShareRoles(ns *corev1.Namespace) ([]secrets.AnnotationGrant, erro
 // leaving AnnotationDefaultShareUsers untouched. Used when seeding the
 // default role grants (Owner/Editor/Viewer) at org-create time.
 func (c *K8sClient) UpdateOrganizationDefaultRoleGrants(ctx context.Context, name string, defaultRoles []secrets.AnnotationGrant) (*corev1.Namespace, error) {
+	ctx, span := tracing.Start(ctx, "organizations.K8sClient.UpdateOrganizationDefaultRoleGrants", attribute.String("name", name))
+	defer span.End()
 	slog.DebugContext(ctx, "updating organization default role grants in kubernetes",
 		slog.String("name", name),
 	)
@@ -386,6 +382,8 @@ func (c *K8sClient) UpdateOrganizationDefaultRoleGrants(ctx context.Context, nam
 
 // UpdateOrganizationDefaultSharing updates the default sharing annotations on an organization namespace.
 func (c *K8sClient) UpdateOrganizationDefaultSharing(ctx context.Context, name string, defaultUsers, defaultRoles []secrets.AnnotationGrant) (*corev1.Namespace, error) {
+	ctx, span := tracing.Start(ctx, "organizations.K8sClient.UpdateOrganizationDefaultSharing", attribute.String("name", name))
+	defer span.End()
 	slog.DebugContext(ctx, "updating organization default sharing in kubernetes",
 		slog.String("name", name),
 	)
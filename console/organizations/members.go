@@ -0,0 +1,193 @@
+package organizations
+
+import (
+	"cmp"
+	"context"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"go.opentelemetry.io/otel/attribute"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	v1alpha2 "github.com/holos-run/holos-console/api/v1alpha2"
+	"github.com/holos-run/holos-console/console/rbac"
+	"github.com/holos-run/holos-console/console/rpc"
+	"github.com/holos-run/holos-console/console/secretrbac"
+	"github.com/holos-run/holos-console/console/secrets"
+	"github.com/holos-run/holos-console/console/tracing"
+	"github.com/holos-run/holos-console/console/trash"
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+)
+
+// ListOrganizationMembers returns every user and group holding a grant in
+// an organization. The caller must own the organization; the grants are
+// then gathered with the service account.
+func (h *Handler) ListOrganizationMembers(
+	ctx context.Context,
+	req *connect.Request[consolev1.ListOrganizationMembersRequest],
+) (*connect.Response[consolev1.ListOrganizationMembersResponse], error) {
+	claims := rpc.MustClaims(ctx)
+	name := req.Msg.Name
+
+	ns, err := h.k8s.GetOrganization(ctx, name)
+	if err != nil {
+		return nil, mapK8sError(err)
+	}
+	shareUsers, _ := GetShareUsers(ns)
+	shareRoles, _ := GetShareRoles(ns)
+	if err := h.requireNamespaceOwner(ctx, claims, ns, shareUsers, shareRoles, rbac.PermissionOrganizationsAdmin, "list organization members"); err != nil {
+		return nil, err
+	}
+
+	members, err := h.k8s.OrganizationMembers(ctx, name)
+	if err != nil {
+		return nil, mapK8sError(err)
+	}
+
+	slog.InfoContext(ctx, "organization members listed",
+		slog.String("action", "organization_members_list"),
+		slog.String("resource_type", auditResourceType),
+		slog.String("organization", name),
+		slog.String("sub", claims.Sub),
+		slog.String("email", claims.Email),
+		slog.Int("members", len(members)),
+	)
+	return connect.NewResponse(&consolev1.ListOrganizationMembersResponse{Members: members}), nil
+}
+
+// OrganizationMembers collects the active, non-deny grants on an
+// organization's namespaces, its project secret sharing RoleBindings, and
+// the key grants of its secrets, grouped by principal, highest role first.
+// Each kind of object is read with one list, however many projects the
+// organization has.
+func (c *K8sClient) OrganizationMembers(ctx context.Context, name string) ([]*consolev1.OrganizationMember, error) {
+	ctx, span := tracing.Start(ctx, "organizations.K8sClient.OrganizationMembers", attribute.String("name", name))
+	defer span.End()
+	namespaces, err := c.client.CoreV1().Namespaces().List(ctx, metav1.ListOptions{
+		LabelSelector: v1alpha2.LabelManagedBy + "=" + v1alpha2.ManagedByValue + "," +
+			v1alpha2.LabelOrganization + "=" + name,
+	})
+	if err != nil {
+		return nil, err
+	}
+	m := memberSet{}
+	now := time.Now()
+	projects := map[string]bool{}
+	for i := range namespaces.Items {
+		ns := &namespaces.Items[i]
+		if ns.DeletionTimestamp != nil || trash.IsDeleted(ns) {
+			continue
+		}
+		level := ns.Labels[v1alpha2.LabelResourceType]
+		if level == v1alpha2.ResourceTypeProject {
+			projects[ns.Name] = true
+		}
+		users, _ := GetShareUsers(ns)
+		roles, _ := GetShareRoles(ns)
+		m.add(consolev1.PrincipalKind_PRINCIPAL_KIND_USER, users, level, now)
+		m.add(consolev1.PrincipalKind_PRINCIPAL_KIND_GROUP, roles, level, now)
+	}
+	if len(projects) == 0 {
+		return m.sorted(), nil
+	}
+
+	bindings, err := c.client.RbacV1().RoleBindings(metav1.NamespaceAll).List(ctx, metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(labels.Set{
+			v1alpha2.LabelManagedBy:     v1alpha2.ManagedByValue,
+			secretrbac.LabelRolePurpose: secretrbac.RolePurposeProjectSecrets,
+		}).String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	byNamespace := map[string][]rbacv1.RoleBinding{}
+	for _, binding := range bindings.Items {
+		if projects[binding.Namespace] {
+			byNamespace[binding.Namespace] = append(byNamespace[binding.Namespace], binding)
+		}
+	}
+	for _, inProject := range byNamespace {
+		users, roles := secrets.SharingFromRoleBindings(inProject)
+		m.add(consolev1.PrincipalKind_PRINCIPAL_KIND_USER, users, levelSecret, now)
+		m.add(consolev1.PrincipalKind_PRINCIPAL_KIND_GROUP, roles, levelSecret, now)
+	}
+
+	list, err := c.client.CoreV1().Secrets(metav1.NamespaceAll).List(ctx, metav1.ListOptions{
+		LabelSelector: v1alpha2.LabelManagedBy + "=" + v1alpha2.ManagedByValue + "," +
+			v1alpha2.LabelDeleted + "!=" + v1alpha2.DeletedValue,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for i := range list.Items {
+		secret := &list.Items[i]
+		if !projects[secret.Namespace] {
+			continue
+		}
+		users, _ := secrets.GetKeyShareUsers(secret)
+		roles, _ := secrets.GetKeyShareRoles(secret)
+		m.add(consolev1.PrincipalKind_PRINCIPAL_KIND_USER, users, levelSecret, now)
+		m.add(consolev1.PrincipalKind_PRINCIPAL_KIND_GROUP, roles, levelSecret, now)
+	}
+	return m.sorted(), nil
+}
+
+// levelSecret marks grants held on secrets rather than on a namespace.
+const levelSecret = "secret"
+
+// memberSet accumulates organization members keyed by kind and principal.
+// User principals are lowercased, as grants match them case-insensitively.
+type memberSet map[string]*consolev1.OrganizationMember
+
+// add counts the active, non-deny grants at level.
+func (m memberSet) add(kind consolev1.PrincipalKind, grants []secrets.AnnotationGrant, level string, now time.Time) {
+	for principal, role := range secrets.ActiveGrantsMap(grants, now) {
+		if role == rbac.DenyRole {
+			continue
+		}
+		principal = strings.TrimPrefix(principal, "oidc:")
+		if kind == consolev1.PrincipalKind_PRINCIPAL_KIND_USER {
+			principal = strings.ToLower(principal)
+		}
+		key := kind.String() + ":" + principal
+		member, ok := m[key]
+		if !ok {
+			member = &consolev1.OrganizationMember{Principal: principal, Kind: kind}
+			m[key] = member
+		}
+		if r := rbac.RoleFromString(role); rbac.RoleLevel(r) > rbac.RoleLevel(member.HighestRole) {
+			member.HighestRole = r
+		}
+		switch level {
+		case v1alpha2.ResourceTypeOrganization:
+			member.OrganizationGrants++
+		case v1alpha2.ResourceTypeFolder:
+			member.FolderGrants++
+		case v1alpha2.ResourceTypeProject:
+			member.ProjectGrants++
+		case levelSecret:
+			member.SecretGrants++
+		}
+	}
+}
+
+// sorted returns the members, highest role first and then by principal.
+func (m memberSet) sorted() []*consolev1.OrganizationMember {
+	members := make([]*consolev1.OrganizationMember, 0, len(m))
+	for _, member := range m {
+		members = append(members, member)
+	}
+	slices.SortFunc(members, func(a, b *consolev1.OrganizationMember) int {
+		return cmp.Or(
+			cmp.Compare(rbac.RoleLevel(b.HighestRole), rbac.RoleLevel(a.HighestRole)),
+			cmp.Compare(strings.ToLower(a.Principal), strings.ToLower(b.Principal)),
+			cmp.Compare(a.Kind, b.Kind),
+		)
+	})
+	return members
+}
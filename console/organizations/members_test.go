@@ -0,0 +1,87 @@
+package organizations
+
+import (
+	"testing"
+
+	"connectrpc.com/connect"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	v1alpha2 "github.com/holos-run/holos-console/api/v1alpha2"
+	"github.com/holos-run/holos-console/console/secretrbac"
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+)
+
+func TestListOrganizationMembers(t *testing.T) {
+	acme := orgNS("acme", `[{"principal":"alice@example.com","role":"owner"},{"principal":"bob@example.com","role":"viewer"},{"principal":"mallory@example.com","role":"deny"}]`)
+	acme.Labels[v1alpha2.LabelOrganization] = "acme"
+	acme.Annotations[v1alpha2.AnnotationShareRoles] = `[{"principal":"devs","role":"editor"}]`
+	namespace := func(name, resourceType, org, shareUsers string) *corev1.Namespace {
+		return &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+			Labels: map[string]string{
+				v1alpha2.LabelManagedBy:    v1alpha2.ManagedByValue,
+				v1alpha2.LabelResourceType: resourceType,
+				v1alpha2.LabelOrganization: org,
+			},
+			Annotations: map[string]string{v1alpha2.AnnotationShareUsers: shareUsers},
+		}}
+	}
+	client := fake.NewClientset(
+		acme,
+		namespace("holos-fld-eng", v1alpha2.ResourceTypeFolder, "acme", `[{"principal":"Bob@example.com","role":"editor"}]`),
+		namespace("holos-prj-web", v1alpha2.ResourceTypeProject, "acme", `[{"principal":"bob@example.com","role":"owner"},{"principal":"carol@example.com","role":"viewer"}]`),
+		namespace("holos-prj-other", v1alpha2.ResourceTypeProject, "globex", `[{"principal":"dave@example.com","role":"owner"}]`),
+		secretrbac.RoleBinding("holos-prj-web", secretrbac.ShareTargetUser, "carol@example.com", "editor", nil),
+		secretrbac.RoleBinding("holos-prj-other", secretrbac.ShareTargetUser, "dave@example.com", "editor", nil),
+		&corev1.Secret{ObjectMeta: metav1.ObjectMeta{
+			Name:        "db",
+			Namespace:   "holos-prj-web",
+			Labels:      map[string]string{v1alpha2.LabelManagedBy: v1alpha2.ManagedByValue},
+			Annotations: map[string]string{v1alpha2.AnnotationShareKeyUsers: `[{"principal":"erin@example.com","role":"viewer","keys":["url"]}]`},
+		}},
+	)
+	handler := NewHandler(NewK8sClient(client, testResolver()), nil, false, nil, nil)
+
+	resp, err := handler.ListOrganizationMembers(contextWithClaims("alice@example.com"), connect.NewRequest(&consolev1.ListOrganizationMembersRequest{Name: "acme"}))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	type member struct {
+		principal string
+		role      consolev1.Role
+		counts    [4]int32
+	}
+	var got []member
+	for _, m := range resp.Msg.Members {
+		got = append(got, member{m.Principal, m.HighestRole, [4]int32{m.OrganizationGrants, m.FolderGrants, m.ProjectGrants, m.SecretGrants}})
+	}
+	// bob is granted in three places in different case; mallory's deny
+	// grant and globex's dave are not members.
+	want := []member{
+		{"alice@example.com", consolev1.Role_ROLE_OWNER, [4]int32{1, 0, 0, 0}},
+		{"bob@example.com", consolev1.Role_ROLE_OWNER, [4]int32{1, 1, 1, 0}},
+		{"carol@example.com", consolev1.Role_ROLE_EDITOR, [4]int32{0, 0, 1, 1}},
+		{"devs", consolev1.Role_ROLE_EDITOR, [4]int32{1, 0, 0, 0}},
+		{"erin@example.com", consolev1.Role_ROLE_VIEWER, [4]int32{0, 0, 0, 1}},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("expected members %v, got %v", want, got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("member %d: expected %v, got %v", i, want[i], got[i])
+		}
+	}
+	if kind := resp.Msg.Members[3].Kind; kind != consolev1.PrincipalKind_PRINCIPAL_KIND_GROUP {
+		t.Errorf("expected devs to be a group, got %v", kind)
+	}
+
+	t.Run("requires ownership of the organization", func(t *testing.T) {
+		_, err := handler.ListOrganizationMembers(contextWithClaims("bob@example.com"), connect.NewRequest(&consolev1.ListOrganizationMembersRequest{Name: "acme"}))
+		if connect.CodeOf(err) != connect.CodePermissionDenied {
+			t.Fatalf("expected PermissionDenied, got %v", err)
+		}
+	})
+}
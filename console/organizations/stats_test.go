@@ -0,0 +1,97 @@
+package organizations
+
+import (
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	v1alpha2 "github.com/holos-run/holos-console/api/v1alpha2"
+	"github.com/holos-run/holos-console/console/audit"
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+)
+
+func TestGetOrganizationStats(t *testing.T) {
+	acme := orgNS("acme", `[{"principal":"alice@example.com","role":"owner"},{"principal":"Bob@example.com","role":"viewer"},{"principal":"mallory@example.com","role":"deny"}]`)
+	acme.Annotations[v1alpha2.AnnotationShareRoles] = `[{"principal":"devs","role":"editor"}]`
+	project := func(name, org, shareUsers string) *corev1.Namespace {
+		return &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+			Name: "holos-prj-" + name,
+			Labels: map[string]string{
+				v1alpha2.LabelManagedBy:    v1alpha2.ManagedByValue,
+				v1alpha2.LabelResourceType: v1alpha2.ResourceTypeProject,
+				v1alpha2.LabelOrganization: org,
+			},
+			Annotations: map[string]string{v1alpha2.AnnotationShareUsers: shareUsers},
+		}}
+	}
+	secret := func(namespace, name string, deleted bool) *corev1.Secret {
+		s := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			Labels:    map[string]string{v1alpha2.LabelManagedBy: v1alpha2.ManagedByValue},
+		}}
+		if deleted {
+			s.Labels[v1alpha2.LabelDeleted] = v1alpha2.DeletedValue
+		}
+		return s
+	}
+	client := fake.NewClientset(
+		acme,
+		project("web", "acme", `[{"principal":"bob@example.com","role":"owner"},{"principal":"carol@example.com","role":"viewer"}]`),
+		project("api", "acme", `[]`),
+		project("other", "globex", `[{"principal":"dave@example.com","role":"owner"}]`),
+		secret("holos-prj-web", "db", false),
+		secret("holos-prj-web", "old", true),
+		secret("holos-prj-api", "token", false),
+		secret("holos-prj-other", "pager", false),
+	)
+	ring := audit.NewRing(10)
+	now := time.Now()
+	for _, e := range []audit.Event{
+		{Time: now.Add(-time.Hour), Action: "secret_update", ResourceType: "secret", Project: "web"},
+		{Time: now.Add(-time.Minute), Action: "secret_update", ResourceType: "secret", Project: "api"},
+		{Time: now.Add(-2 * time.Hour), Action: "organization_update", ResourceType: "organization", ResourceName: "acme"},
+		{Time: now, Action: "secret_update", ResourceType: "secret", Project: "other"},
+		{Time: now.Add(-30 * 24 * time.Hour), Action: "secret_delete", ResourceType: "secret", Project: "web"},
+	} {
+		ring.Append(e)
+	}
+	handler := NewHandler(NewK8sClient(client, testResolver()), nil, false, nil, nil).WithAuditRing(ring)
+
+	resp, err := handler.GetOrganizationStats(contextWithClaims("alice@example.com"), connect.NewRequest(&consolev1.GetOrganizationStatsRequest{Name: "acme"}))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	msg := resp.Msg
+	if msg.ProjectCount != 2 {
+		t.Errorf("expected 2 projects, got %d", msg.ProjectCount)
+	}
+	if msg.SecretCount != 2 {
+		t.Errorf("expected 2 secrets, got %d", msg.SecretCount)
+	}
+	// alice, bob (granted twice, in different case), carol, and the devs
+	// group; the deny grant does not count.
+	if msg.MemberCount != 4 {
+		t.Errorf("expected 4 members, got %d", msg.MemberCount)
+	}
+	if len(msg.RecentActivity) != 2 {
+		t.Fatalf("expected 2 activity summaries, got %v", msg.RecentActivity)
+	}
+	if got := msg.RecentActivity[0]; got.Action != "secret_update" || got.Count != 2 || !got.LastTime.AsTime().Equal(now.Add(-time.Minute)) {
+		t.Errorf("unexpected first summary %v", got)
+	}
+	if got := msg.RecentActivity[1]; got.Action != "organization_update" || got.Count != 1 {
+		t.Errorf("unexpected second summary %v", got)
+	}
+
+	t.Run("requires a role on the organization", func(t *testing.T) {
+		_, err := handler.GetOrganizationStats(contextWithClaims("carol@example.com"), connect.NewRequest(&consolev1.GetOrganizationStatsRequest{Name: "acme"}))
+		if connect.CodeOf(err) != connect.CodePermissionDenied {
+			t.Fatalf("expected PermissionDenied, got %v", err)
+		}
+	})
+}
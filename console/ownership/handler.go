@@ -0,0 +1,123 @@
+// Package ownership implements OwnershipService: handing an organization or
+// project to a new owner in a single sharing update, rather than the two
+// racy UpdateSharing calls a departing owner would otherwise make.
+package ownership
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"connectrpc.com/connect"
+
+	v1alpha2 "github.com/holos-run/holos-console/api/v1alpha2"
+	"github.com/holos-run/holos-console/console/rpc"
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+	"github.com/holos-run/holos-console/gen/holos/console/v1/consolev1connect"
+)
+
+// Handler implements consolev1connect.OwnershipServiceHandler. Transfers go
+// through the organization and project handlers, so they are authorized,
+// audited, and reconciled into RBAC exactly like any other sharing update.
+type Handler struct {
+	consolev1connect.UnimplementedOwnershipServiceHandler
+	organizations consolev1connect.OrganizationServiceHandler
+	projects      consolev1connect.ProjectServiceHandler
+}
+
+// NewHandler returns an OwnershipService handler.
+func NewHandler(organizations consolev1connect.OrganizationServiceHandler, projects consolev1connect.ProjectServiceHandler) *Handler {
+	return &Handler{organizations: organizations, projects: projects}
+}
+
+// TransferOwnership grants owner to the new owner and optionally demotes the
+// caller in one sharing update.
+func (h *Handler) TransferOwnership(
+	ctx context.Context,
+	req *connect.Request[consolev1.TransferOwnershipRequest],
+) (*connect.Response[consolev1.TransferOwnershipResponse], error) {
+	claims := rpc.MustClaims(ctx)
+	msg := req.Msg
+	if strings.EqualFold(msg.NewOwner, claims.Email) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("new_owner must be another user"))
+	}
+
+	var users, roles []*consolev1.ShareGrant
+	var err error
+	switch msg.ResourceType {
+	case v1alpha2.ResourceTypeOrganization:
+		users, roles, err = h.transferOrganization(ctx, claims, msg)
+	case v1alpha2.ResourceTypeProject:
+		users, roles, err = h.transferProject(ctx, claims, msg)
+	default:
+		err = connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unsupported resource_type %q", msg.ResourceType))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "ownership transferred",
+		slog.String("action", "ownership_transfer"),
+		slog.String("resource_type", msg.ResourceType),
+		slog.String("name", msg.Name),
+		slog.String("sub", claims.Sub),
+		slog.String("email", claims.Email),
+		slog.String("new_owner", msg.NewOwner),
+		slog.String("caller_role", msg.CallerRole.String()),
+	)
+	return connect.NewResponse(&consolev1.TransferOwnershipResponse{UserGrants: users, RoleGrants: roles}), nil
+}
+
+func (h *Handler) transferOrganization(ctx context.Context, claims *rpc.Claims, msg *consolev1.TransferOwnershipRequest) ([]*consolev1.ShareGrant, []*consolev1.ShareGrant, error) {
+	got, err := h.organizations.GetOrganization(ctx, connect.NewRequest(&consolev1.GetOrganizationRequest{Name: msg.Name}))
+	if err != nil {
+		return nil, nil, err
+	}
+	org := got.Msg.GetOrganization()
+	updated, err := h.organizations.UpdateOrganizationSharing(ctx, connect.NewRequest(&consolev1.UpdateOrganizationSharingRequest{
+		Name:       msg.Name,
+		UserGrants: transferGrants(org.GetUserGrants(), msg.NewOwner, claims.Email, msg.CallerRole),
+		RoleGrants: org.GetRoleGrants(),
+	}))
+	if err != nil {
+		return nil, nil, err
+	}
+	return updated.Msg.GetOrganization().GetUserGrants(), updated.Msg.GetOrganization().GetRoleGrants(), nil
+}
+
+func (h *Handler) transferProject(ctx context.Context, claims *rpc.Claims, msg *consolev1.TransferOwnershipRequest) ([]*consolev1.ShareGrant, []*consolev1.ShareGrant, error) {
+	got, err := h.projects.GetProject(ctx, connect.NewRequest(&consolev1.GetProjectRequest{Name: msg.Name}))
+	if err != nil {
+		return nil, nil, err
+	}
+	project := got.Msg.GetProject()
+	updated, err := h.projects.UpdateProjectSharing(ctx, connect.NewRequest(&consolev1.UpdateProjectSharingRequest{
+		Name:       msg.Name,
+		UserGrants: transferGrants(project.GetUserGrants(), msg.NewOwner, claims.Email, msg.CallerRole),
+		RoleGrants: project.GetRoleGrants(),
+	}))
+	if err != nil {
+		return nil, nil, err
+	}
+	return updated.Msg.GetProject().GetUserGrants(), updated.Msg.GetProject().GetRoleGrants(), nil
+}
+
+// transferGrants returns users with newOwner's grants replaced by a standing
+// owner grant and, unless callerRole is unspecified or owner, the caller's
+// grants replaced by one for callerRole.
+func transferGrants(users []*consolev1.ShareGrant, newOwner, caller string, callerRole consolev1.Role) []*consolev1.ShareGrant {
+	demote := callerRole != consolev1.Role_ROLE_UNSPECIFIED && callerRole != consolev1.Role_ROLE_OWNER
+	result := make([]*consolev1.ShareGrant, 0, len(users)+2)
+	for _, g := range users {
+		if strings.EqualFold(g.Principal, newOwner) || (demote && strings.EqualFold(g.Principal, caller)) {
+			continue
+		}
+		result = append(result, g)
+	}
+	result = append(result, &consolev1.ShareGrant{Principal: newOwner, Role: consolev1.Role_ROLE_OWNER})
+	if demote {
+		result = append(result, &consolev1.ShareGrant{Principal: caller, Role: callerRole})
+	}
+	return result
+}
@@ -0,0 +1,152 @@
+package ownership
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"connectrpc.com/connect"
+
+	"github.com/holos-run/holos-console/console/rpc"
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+	"github.com/holos-run/holos-console/gen/holos/console/v1/consolev1connect"
+)
+
+// fakeOrganizations serves one organization and records sharing updates.
+type fakeOrganizations struct {
+	consolev1connect.UnimplementedOrganizationServiceHandler
+	org       *consolev1.Organization
+	updates   []*consolev1.UpdateOrganizationSharingRequest
+	updateErr error
+}
+
+func (f *fakeOrganizations) GetOrganization(_ context.Context, req *connect.Request[consolev1.GetOrganizationRequest]) (*connect.Response[consolev1.GetOrganizationResponse], error) {
+	if req.Msg.Name != f.org.Name {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("not found"))
+	}
+	return connect.NewResponse(&consolev1.GetOrganizationResponse{Organization: f.org}), nil
+}
+
+func (f *fakeOrganizations) UpdateOrganizationSharing(_ context.Context, req *connect.Request[consolev1.UpdateOrganizationSharingRequest]) (*connect.Response[consolev1.UpdateOrganizationSharingResponse], error) {
+	f.updates = append(f.updates, req.Msg)
+	if f.updateErr != nil {
+		return nil, f.updateErr
+	}
+	f.org.UserGrants, f.org.RoleGrants = req.Msg.UserGrants, req.Msg.RoleGrants
+	return connect.NewResponse(&consolev1.UpdateOrganizationSharingResponse{Organization: f.org}), nil
+}
+
+// fakeProjects serves one project and records sharing updates.
+type fakeProjects struct {
+	consolev1connect.UnimplementedProjectServiceHandler
+	project *consolev1.Project
+	updates []*consolev1.UpdateProjectSharingRequest
+}
+
+func (f *fakeProjects) GetProject(_ context.Context, req *connect.Request[consolev1.GetProjectRequest]) (*connect.Response[consolev1.GetProjectResponse], error) {
+	return connect.NewResponse(&consolev1.GetProjectResponse{Project: f.project}), nil
+}
+
+func (f *fakeProjects) UpdateProjectSharing(_ context.Context, req *connect.Request[consolev1.UpdateProjectSharingRequest]) (*connect.Response[consolev1.UpdateProjectSharingResponse], error) {
+	f.updates = append(f.updates, req.Msg)
+	f.project.UserGrants, f.project.RoleGrants = req.Msg.UserGrants, req.Msg.RoleGrants
+	return connect.NewResponse(&consolev1.UpdateProjectSharingResponse{Project: f.project}), nil
+}
+
+func asAlice() context.Context {
+	return rpc.ContextWithClaims(context.Background(), &rpc.Claims{Sub: "alice-sub", Email: "alice@example.com"})
+}
+
+func roleOf(grants []*consolev1.ShareGrant, principal string) consolev1.Role {
+	for _, g := range grants {
+		if g.Principal == principal {
+			return g.Role
+		}
+	}
+	return consolev1.Role_ROLE_UNSPECIFIED
+}
+
+func TestTransferOwnership(t *testing.T) {
+	t.Run("transfers an organization and demotes the caller in one update", func(t *testing.T) {
+		orgs := &fakeOrganizations{org: &consolev1.Organization{
+			Name: "acme",
+			UserGrants: []*consolev1.ShareGrant{
+				{Principal: "alice@example.com", Role: consolev1.Role_ROLE_OWNER},
+				{Principal: "bob@example.com", Role: consolev1.Role_ROLE_VIEWER},
+			},
+			RoleGrants: []*consolev1.ShareGrant{{Principal: "dev-team", Role: consolev1.Role_ROLE_EDITOR}},
+		}}
+		h := NewHandler(orgs, &fakeProjects{})
+		resp, err := h.TransferOwnership(asAlice(), connect.NewRequest(&consolev1.TransferOwnershipRequest{
+			ResourceType: "organization",
+			Name:         "acme",
+			NewOwner:     "Bob@example.com",
+			CallerRole:   consolev1.Role_ROLE_EDITOR,
+		}))
+		if err != nil {
+			t.Fatalf("TransferOwnership: %v", err)
+		}
+		if len(orgs.updates) != 1 {
+			t.Fatalf("expected one sharing update, got %d", len(orgs.updates))
+		}
+		if got := roleOf(resp.Msg.UserGrants, "Bob@example.com"); got != consolev1.Role_ROLE_OWNER {
+			t.Errorf("expected the new owner to be an owner, got %v", got)
+		}
+		if got := roleOf(resp.Msg.UserGrants, "bob@example.com"); got != consolev1.Role_ROLE_UNSPECIFIED {
+			t.Errorf("expected the new owner's earlier grant to be replaced, got %v", got)
+		}
+		if got := roleOf(resp.Msg.UserGrants, "alice@example.com"); got != consolev1.Role_ROLE_EDITOR {
+			t.Errorf("expected the caller to be demoted to editor, got %v", got)
+		}
+		if len(resp.Msg.RoleGrants) != 1 {
+			t.Errorf("expected role grants to be kept, got %v", resp.Msg.RoleGrants)
+		}
+	})
+
+	t.Run("keeps the caller an owner without caller_role", func(t *testing.T) {
+		projects := &fakeProjects{project: &consolev1.Project{
+			Name:       "web",
+			UserGrants: []*consolev1.ShareGrant{{Principal: "alice@example.com", Role: consolev1.Role_ROLE_OWNER}},
+		}}
+		h := NewHandler(&fakeOrganizations{}, projects)
+		resp, err := h.TransferOwnership(asAlice(), connect.NewRequest(&consolev1.TransferOwnershipRequest{
+			ResourceType: "project",
+			Name:         "web",
+			NewOwner:     "bob@example.com",
+		}))
+		if err != nil {
+			t.Fatalf("TransferOwnership: %v", err)
+		}
+		if roleOf(resp.Msg.UserGrants, "alice@example.com") != consolev1.Role_ROLE_OWNER || roleOf(resp.Msg.UserGrants, "bob@example.com") != consolev1.Role_ROLE_OWNER {
+			t.Errorf("expected both users to be owners, got %v", resp.Msg.UserGrants)
+		}
+	})
+
+	t.Run("returns the sharing update error", func(t *testing.T) {
+		orgs := &fakeOrganizations{
+			org:       &consolev1.Organization{Name: "acme"},
+			updateErr: connect.NewError(connect.CodePermissionDenied, errors.New("not an owner")),
+		}
+		h := NewHandler(orgs, &fakeProjects{})
+		_, err := h.TransferOwnership(asAlice(), connect.NewRequest(&consolev1.TransferOwnershipRequest{
+			ResourceType: "organization",
+			Name:         "acme",
+			NewOwner:     "bob@example.com",
+		}))
+		if connect.CodeOf(err) != connect.CodePermissionDenied {
+			t.Fatalf("expected PermissionDenied, got %v", err)
+		}
+	})
+
+	t.Run("rejects transferring to the caller", func(t *testing.T) {
+		h := NewHandler(&fakeOrganizations{}, &fakeProjects{})
+		_, err := h.TransferOwnership(asAlice(), connect.NewRequest(&consolev1.TransferOwnershipRequest{
+			ResourceType: "organization",
+			Name:         "acme",
+			NewOwner:     "ALICE@example.com",
+		}))
+		if connect.CodeOf(err) != connect.CodeInvalidArgument {
+			t.Fatalf("expected InvalidArgument, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,242 @@
+package permissions
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sort"
+	"strings"
+
+	"connectrpc.com/connect"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1alpha2 "github.com/holos-run/holos-console/api/v1alpha2"
+	"github.com/holos-run/holos-console/console/rpc"
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+)
+
+// resourceTypeSecret is the GetAccessReview and CanI resource_type for
+// project secrets. The namespace-backed types reuse the v1alpha2 resource
+// type labels.
+const resourceTypeSecret = "secret"
+
+// oidcPrefix is the ADR 036 principal namespace for OIDC users and groups.
+const oidcPrefix = "oidc:"
+
+// GetAccessReview reports every principal with access to a resource and the
+// highest role each holds. The caller must be an owner of the resource; the
+// RBAC objects themselves are read with the console's service account so
+// owners can see bindings they could not list directly, such as
+// ClusterRoleBindings for platform groups.
+func (h *Handler) GetAccessReview(
+	ctx context.Context,
+	req *connect.Request[consolev1.GetAccessReviewRequest],
+) (*connect.Response[consolev1.GetAccessReviewResponse], error) {
+	claims := rpc.MustClaims(ctx)
+	if h.k8s == nil || h.resolver == nil {
+		return nil, connect.NewError(connect.CodeUnimplemented, fmt.Errorf("access review is not configured"))
+	}
+	target, err := h.accessReviewTarget(req.Msg)
+	if err != nil {
+		return nil, err
+	}
+
+	owner := &consolev1.ResourceAttributes{
+		Verb:      "delete",
+		Resource:  target.Resource,
+		Namespace: target.Namespace,
+		Name:      target.Name,
+	}
+	perm, err := Review(ctx, rpc.ImpersonatedClientsetFromContext(ctx), owner)
+	if err != nil {
+		return nil, rpc.MapK8sError(err)
+	}
+	if !perm.Allowed {
+		slog.WarnContext(ctx, "access review denied",
+			slog.String("action", "access_review_denied"),
+			slog.String("resource_type", req.Msg.ResourceType),
+			slog.String("name", req.Msg.Name),
+			slog.String("project", req.Msg.Project),
+			slog.String("sub", claims.Sub),
+			slog.String("email", claims.Email),
+		)
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("owner access to %s %q is required", req.Msg.ResourceType, req.Msg.Name))
+	}
+
+	grants, err := h.reviewAccess(ctx, target)
+	if err != nil {
+		return nil, rpc.MapK8sError(err)
+	}
+
+	slog.InfoContext(ctx, "access review",
+		slog.String("action", "access_review"),
+		slog.String("resource_type", req.Msg.ResourceType),
+		slog.String("name", req.Msg.Name),
+		slog.String("project", req.Msg.Project),
+		slog.String("sub", claims.Sub),
+		slog.String("email", claims.Email),
+		slog.Int("principals", len(grants)),
+	)
+	return connect.NewResponse(&consolev1.GetAccessReviewResponse{Grants: grants}), nil
+}
+
+// accessReviewTarget maps a request onto the Kubernetes object it reviews.
+func (h *Handler) accessReviewTarget(msg *consolev1.GetAccessReviewRequest) (*consolev1.ResourceAttributes, error) {
+	if msg.Name == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("name is required"))
+	}
+	switch msg.ResourceType {
+	case resourceTypeSecret:
+		if msg.Project == "" {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("project is required for secrets"))
+		}
+		return &consolev1.ResourceAttributes{Resource: "secrets", Namespace: h.resolver.ProjectNamespace(msg.Project), Name: msg.Name}, nil
+	case v1alpha2.ResourceTypeProject, v1alpha2.ResourceTypeFolder, v1alpha2.ResourceTypeOrganization:
+		return &consolev1.ResourceAttributes{Resource: "namespaces", Name: h.namespaceFor(msg.ResourceType, msg.Name)}, nil
+	default:
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unsupported resource_type %q", msg.ResourceType))
+	}
+}
+
+// reviewAccess evaluates every RoleBinding in the target's namespace and
+// every ClusterRoleBinding against target, keeping the highest role per
+// subject.
+func (h *Handler) reviewAccess(ctx context.Context, target *consolev1.ResourceAttributes) ([]*consolev1.AccessGrant, error) {
+	byPrincipal := map[string]*consolev1.AccessGrant{}
+	add := func(subjects []rbacv1.Subject, rules []rbacv1.PolicyRule, source string) {
+		role := roleForRules(rules, target)
+		if role == consolev1.Role_ROLE_UNSPECIFIED {
+			return
+		}
+		for _, subject := range subjects {
+			principal, kind := subjectPrincipal(subject)
+			key := kind.String() + "/" + principal
+			grant, ok := byPrincipal[key]
+			if !ok {
+				grant = &consolev1.AccessGrant{Principal: principal, Kind: kind}
+				byPrincipal[key] = grant
+			}
+			if role > grant.Role {
+				grant.Role = role
+			}
+			if !slices.Contains(grant.Sources, source) {
+				grant.Sources = append(grant.Sources, source)
+			}
+		}
+	}
+
+	clusterRoles := map[string][]rbacv1.PolicyRule{}
+	clusterRoleRules := func(name string) ([]rbacv1.PolicyRule, error) {
+		if rules, ok := clusterRoles[name]; ok {
+			return rules, nil
+		}
+		role, err := h.k8s.RbacV1().ClusterRoles().Get(ctx, name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			clusterRoles[name] = nil
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		clusterRoles[name] = role.Rules
+		return role.Rules, nil
+	}
+
+	clusterBindings, err := h.k8s.RbacV1().ClusterRoleBindings().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, binding := range clusterBindings.Items {
+		rules, err := clusterRoleRules(binding.RoleRef.Name)
+		if err != nil {
+			return nil, err
+		}
+		add(binding.Subjects, rules, "ClusterRoleBinding/"+binding.Name)
+	}
+
+	if target.Namespace != "" {
+		bindings, err := h.k8s.RbacV1().RoleBindings(target.Namespace).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		for _, binding := range bindings.Items {
+			var rules []rbacv1.PolicyRule
+			if binding.RoleRef.Kind == "ClusterRole" {
+				rules, err = clusterRoleRules(binding.RoleRef.Name)
+			} else {
+				var role *rbacv1.Role
+				role, err = h.k8s.RbacV1().Roles(target.Namespace).Get(ctx, binding.RoleRef.Name, metav1.GetOptions{})
+				if err == nil {
+					rules = role.Rules
+				} else if apierrors.IsNotFound(err) {
+					err = nil
+				}
+			}
+			if err != nil {
+				return nil, err
+			}
+			add(binding.Subjects, rules, "RoleBinding/"+binding.Namespace+"/"+binding.Name)
+		}
+	}
+
+	grants := make([]*consolev1.AccessGrant, 0, len(byPrincipal))
+	for _, grant := range byPrincipal {
+		sort.Strings(grant.Sources)
+		grants = append(grants, grant)
+	}
+	sort.Slice(grants, func(i, j int) bool {
+		if grants[i].Role != grants[j].Role {
+			return grants[i].Role > grants[j].Role
+		}
+		if grants[i].Principal != grants[j].Principal {
+			return grants[i].Principal < grants[j].Principal
+		}
+		return grants[i].Kind < grants[j].Kind
+	})
+	return grants, nil
+}
+
+// roleForRules returns the console role the rules grant on target: owner for
+// delete, editor for update, viewer for get.
+func roleForRules(rules []rbacv1.PolicyRule, target *consolev1.ResourceAttributes) consolev1.Role {
+	switch {
+	case rulesAllow(rules, target, "delete"):
+		return consolev1.Role_ROLE_OWNER
+	case rulesAllow(rules, target, "update"):
+		return consolev1.Role_ROLE_EDITOR
+	case rulesAllow(rules, target, "get"):
+		return consolev1.Role_ROLE_VIEWER
+	default:
+		return consolev1.Role_ROLE_UNSPECIFIED
+	}
+}
+
+func rulesAllow(rules []rbacv1.PolicyRule, target *consolev1.ResourceAttributes, verb string) bool {
+	for _, rule := range rules {
+		if matchesRule(rule.Verbs, verb) &&
+			matchesRule(rule.APIGroups, target.Group) &&
+			matchesRule(rule.Resources, target.Resource) &&
+			(len(rule.ResourceNames) == 0 || slices.Contains(rule.ResourceNames, target.Name)) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchesRule(values []string, want string) bool {
+	return slices.Contains(values, rbacv1.VerbAll) || slices.Contains(values, want)
+}
+
+func subjectPrincipal(subject rbacv1.Subject) (string, consolev1.PrincipalKind) {
+	switch subject.Kind {
+	case rbacv1.ServiceAccountKind:
+		return "system:serviceaccount:" + subject.Namespace + ":" + subject.Name, consolev1.PrincipalKind_PRINCIPAL_KIND_SERVICE_ACCOUNT
+	case rbacv1.GroupKind:
+		return strings.TrimPrefix(subject.Name, oidcPrefix), consolev1.PrincipalKind_PRINCIPAL_KIND_GROUP
+	default:
+		return strings.TrimPrefix(subject.Name, oidcPrefix), consolev1.PrincipalKind_PRINCIPAL_KIND_USER
+	}
+}
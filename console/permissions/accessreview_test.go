@@ -0,0 +1,148 @@
+package permissions
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	authzv1 "k8s.io/api/authorization/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	clienttesting "k8s.io/client-go/testing"
+
+	"github.com/holos-run/holos-console/console/resolver"
+	"github.com/holos-run/holos-console/console/rpc"
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+)
+
+func accessReviewObjects() []runtime.Object {
+	const ns = "holos-prj-billing"
+	secretRule := func(verbs ...string) []rbacv1.PolicyRule {
+		return []rbacv1.PolicyRule{{APIGroups: []string{""}, Resources: []string{"secrets"}, Verbs: verbs}}
+	}
+	binding := func(name, roleKind, role string, subjects ...rbacv1.Subject) *rbacv1.RoleBinding {
+		return &rbacv1.RoleBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: ns},
+			Subjects:   subjects,
+			RoleRef:    rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: roleKind, Name: role},
+		}
+	}
+	user := func(name string) rbacv1.Subject { return rbacv1.Subject{Kind: rbacv1.UserKind, Name: name} }
+	group := func(name string) rbacv1.Subject { return rbacv1.Subject{Kind: rbacv1.GroupKind, Name: name} }
+
+	return []runtime.Object{
+		&rbacv1.Role{ObjectMeta: metav1.ObjectMeta{Name: "secrets-owner", Namespace: ns}, Rules: secretRule("*")},
+		&rbacv1.Role{ObjectMeta: metav1.ObjectMeta{Name: "secrets-editor", Namespace: ns}, Rules: secretRule("get", "list", "update")},
+		&rbacv1.Role{ObjectMeta: metav1.ObjectMeta{Name: "db-creds-viewer", Namespace: ns}, Rules: []rbacv1.PolicyRule{{
+			APIGroups: []string{""}, Resources: []string{"secrets"}, ResourceNames: []string{"db-creds"}, Verbs: []string{"get"},
+		}}},
+		&rbacv1.Role{ObjectMeta: metav1.ObjectMeta{Name: "other-viewer", Namespace: ns}, Rules: []rbacv1.PolicyRule{{
+			APIGroups: []string{""}, Resources: []string{"secrets"}, ResourceNames: []string{"other"}, Verbs: []string{"get"},
+		}}},
+		&rbacv1.Role{ObjectMeta: metav1.ObjectMeta{Name: "configmaps", Namespace: ns}, Rules: []rbacv1.PolicyRule{{
+			APIGroups: []string{""}, Resources: []string{"configmaps"}, Verbs: []string{"*"},
+		}}},
+		binding("owner-alice", "Role", "secrets-owner", user("oidc:alice@example.com")),
+		binding("editor-devs", "Role", "secrets-editor", group("oidc:devs"), user("oidc:alice@example.com")),
+		binding("viewer-bob", "Role", "db-creds-viewer", user("oidc:bob@example.com")),
+		binding("viewer-carol", "Role", "other-viewer", user("oidc:carol@example.com")),
+		binding("configmaps-dave", "Role", "configmaps", user("oidc:dave@example.com")),
+		binding("ci", "ClusterRole", "secret-reader",
+			rbacv1.Subject{Kind: rbacv1.ServiceAccountKind, Namespace: "ci", Name: "deployer"}),
+		&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "secret-reader"}, Rules: secretRule("get")},
+		&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "platform-admin"}, Rules: []rbacv1.PolicyRule{{
+			APIGroups: []string{"*"}, Resources: []string{"*"}, Verbs: []string{"*"},
+		}}},
+		&rbacv1.ClusterRoleBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: "platform-admins"},
+			Subjects:   []rbacv1.Subject{group("oidc:platform-admins")},
+			RoleRef:    rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "ClusterRole", Name: "platform-admin"},
+		},
+	}
+}
+
+func accessReviewContext(allowed bool) context.Context {
+	caller := fake.NewClientset()
+	caller.PrependReactor("create", "selfsubjectaccessreviews", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		ssar := action.(clienttesting.CreateAction).GetObject().(*authzv1.SelfSubjectAccessReview)
+		ssar.Status.Allowed = allowed && ssar.Spec.ResourceAttributes.Verb == "delete"
+		return true, ssar, nil
+	})
+	ctx := rpc.ContextWithClaims(context.Background(), &rpc.Claims{Sub: "alice", Email: "alice@example.com"})
+	return rpc.ContextWithImpersonatedClients(ctx, &rpc.ImpersonatedClients{Clientset: caller})
+}
+
+func TestGetAccessReview(t *testing.T) {
+	r := &resolver.Resolver{NamespacePrefix: "holos-", OrganizationPrefix: "org-", FolderPrefix: "fld-", ProjectPrefix: "prj-"}
+	h := NewHandler().WithResolver(r).WithAccessReview(fake.NewClientset(accessReviewObjects()...))
+
+	resp, err := h.GetAccessReview(accessReviewContext(true), connect.NewRequest(&consolev1.GetAccessReviewRequest{
+		ResourceType: "secret",
+		Project:      "billing",
+		Name:         "db-creds",
+	}))
+	if err != nil {
+		t.Fatalf("GetAccessReview: %v", err)
+	}
+
+	type row struct {
+		principal string
+		kind      consolev1.PrincipalKind
+		role      consolev1.Role
+		sources   int
+	}
+	want := []row{
+		{"alice@example.com", consolev1.PrincipalKind_PRINCIPAL_KIND_USER, consolev1.Role_ROLE_OWNER, 2},
+		{"platform-admins", consolev1.PrincipalKind_PRINCIPAL_KIND_GROUP, consolev1.Role_ROLE_OWNER, 1},
+		{"devs", consolev1.PrincipalKind_PRINCIPAL_KIND_GROUP, consolev1.Role_ROLE_EDITOR, 1},
+		{"bob@example.com", consolev1.PrincipalKind_PRINCIPAL_KIND_USER, consolev1.Role_ROLE_VIEWER, 1},
+		{"system:serviceaccount:ci:deployer", consolev1.PrincipalKind_PRINCIPAL_KIND_SERVICE_ACCOUNT, consolev1.Role_ROLE_VIEWER, 1},
+	}
+	got := resp.Msg.Grants
+	if len(got) != len(want) {
+		for _, g := range got {
+			t.Logf("grant %s %s %s %v", g.Principal, g.Kind, g.Role, g.Sources)
+		}
+		t.Fatalf("got %d grants, want %d", len(got), len(want))
+	}
+	for i, w := range want {
+		g := got[i]
+		if g.Principal != w.principal || g.Kind != w.kind || g.Role != w.role || len(g.Sources) != w.sources {
+			t.Errorf("grant %d = %s %s %s %v, want %+v", i, g.Principal, g.Kind, g.Role, g.Sources, w)
+		}
+	}
+	if got[1].Sources[0] != "ClusterRoleBinding/platform-admins" {
+		t.Errorf("expected platform group source, got %v", got[1].Sources)
+	}
+}
+
+func TestGetAccessReview_Errors(t *testing.T) {
+	r := &resolver.Resolver{NamespacePrefix: "holos-", OrganizationPrefix: "org-", FolderPrefix: "fld-", ProjectPrefix: "prj-"}
+	h := NewHandler().WithResolver(r).WithAccessReview(fake.NewClientset(accessReviewObjects()...))
+
+	cases := []struct {
+		name    string
+		allowed bool
+		req     *consolev1.GetAccessReviewRequest
+		want    connect.Code
+	}{
+		{name: "not an owner", req: &consolev1.GetAccessReviewRequest{ResourceType: "project", Name: "billing"}, want: connect.CodePermissionDenied},
+		{name: "missing name", allowed: true, req: &consolev1.GetAccessReviewRequest{ResourceType: "organization"}, want: connect.CodeInvalidArgument},
+		{name: "secret without project", allowed: true, req: &consolev1.GetAccessReviewRequest{ResourceType: "secret", Name: "db-creds"}, want: connect.CodeInvalidArgument},
+		{name: "unknown type", allowed: true, req: &consolev1.GetAccessReviewRequest{ResourceType: "deployment", Name: "web"}, want: connect.CodeInvalidArgument},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := h.GetAccessReview(accessReviewContext(tc.allowed), connect.NewRequest(tc.req))
+			if connect.CodeOf(err) != tc.want {
+				t.Fatalf("expected %v, got %v", tc.want, err)
+			}
+		})
+	}
+
+	if _, err := NewHandler().GetAccessReview(accessReviewContext(true), connect.NewRequest(&consolev1.GetAccessReviewRequest{ResourceType: "project", Name: "billing"})); connect.CodeOf(err) != connect.CodeUnimplemented {
+		t.Fatalf("expected CodeUnimplemented without access review config, got %v", err)
+	}
+}
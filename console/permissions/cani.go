@@ -0,0 +1,169 @@
+package permissions
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+
+	v1alpha2 "github.com/holos-run/holos-console/api/v1alpha2"
+	"github.com/holos-run/holos-console/console/rpc"
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+)
+
+// namespaceVerbs maps the per-resource permissions of the namespace-backed
+// types to the verbs their handlers review. Owner-only operations review
+// "delete", matching requireNamespaceOwner in the projects and folders
+// handlers.
+var namespaceVerbs = map[consolev1.Permission]struct {
+	resourceType string
+	verb         string
+}{
+	consolev1.Permission_PERMISSION_PROJECTS_READ:        {v1alpha2.ResourceTypeProject, "get"},
+	consolev1.Permission_PERMISSION_PROJECTS_WRITE:       {v1alpha2.ResourceTypeProject, "update"},
+	consolev1.Permission_PERMISSION_PROJECTS_DELETE:      {v1alpha2.ResourceTypeProject, "delete"},
+	consolev1.Permission_PERMISSION_PROJECTS_ADMIN:       {v1alpha2.ResourceTypeProject, "delete"},
+	consolev1.Permission_PERMISSION_FOLDERS_READ:         {v1alpha2.ResourceTypeFolder, "get"},
+	consolev1.Permission_PERMISSION_FOLDERS_WRITE:        {v1alpha2.ResourceTypeFolder, "update"},
+	consolev1.Permission_PERMISSION_FOLDERS_DELETE:       {v1alpha2.ResourceTypeFolder, "delete"},
+	consolev1.Permission_PERMISSION_FOLDERS_ADMIN:        {v1alpha2.ResourceTypeFolder, "delete"},
+	consolev1.Permission_PERMISSION_ORGANIZATIONS_READ:   {v1alpha2.ResourceTypeOrganization, "get"},
+	consolev1.Permission_PERMISSION_ORGANIZATIONS_WRITE:  {v1alpha2.ResourceTypeOrganization, "update"},
+	consolev1.Permission_PERMISSION_ORGANIZATIONS_DELETE: {v1alpha2.ResourceTypeOrganization, "delete"},
+	consolev1.Permission_PERMISSION_ORGANIZATIONS_ADMIN:  {v1alpha2.ResourceTypeOrganization, "delete"},
+}
+
+// CanI translates a console permission into the SelfSubjectAccessReview the
+// owning handler relies on and reviews it as the caller.
+func (h *Handler) CanI(
+	ctx context.Context,
+	req *connect.Request[consolev1.CanIRequest],
+) (*connect.Response[consolev1.CanIResponse], error) {
+	if h.resolver == nil {
+		return nil, connect.NewError(connect.CodeUnimplemented, fmt.Errorf("CanI is not configured"))
+	}
+	attr, err := h.permissionAttributes(req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	perm, err := Review(ctx, rpc.ImpersonatedClientsetFromContext(ctx), attr)
+	if err != nil {
+		return nil, rpc.MapK8sError(err)
+	}
+	return connect.NewResponse(&consolev1.CanIResponse{
+		Allowed:    perm.Allowed,
+		Reason:     perm.Reason,
+		Attributes: attr,
+	}), nil
+}
+
+// permissionAttributes maps a CanI request onto ResourceAttributes.
+func (h *Handler) permissionAttributes(msg *consolev1.CanIRequest) (*consolev1.ResourceAttributes, error) {
+	invalid := func(format string, args ...any) error {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf(format, args...))
+	}
+	requireType := func(types ...string) error {
+		for _, t := range types {
+			if msg.ResourceType == t {
+				return nil
+			}
+		}
+		return invalid("resource_type %q does not match %s", msg.ResourceType, msg.Permission)
+	}
+	requireName := func() error {
+		if msg.Name == "" {
+			return invalid("name is required for %s", msg.Permission)
+		}
+		return nil
+	}
+
+	switch msg.Permission {
+	case consolev1.Permission_PERMISSION_SECRETS_READ,
+		consolev1.Permission_PERMISSION_SECRETS_LIST,
+		consolev1.Permission_PERMISSION_SECRETS_WRITE,
+		consolev1.Permission_PERMISSION_SECRETS_DELETE,
+		consolev1.Permission_PERMISSION_SECRETS_ADMIN,
+		consolev1.Permission_PERMISSION_SECRETS_ROTATE:
+		if err := requireType(resourceTypeSecret); err != nil {
+			return nil, err
+		}
+		if msg.Project == "" {
+			return nil, invalid("project is required for secrets")
+		}
+		attr := &consolev1.ResourceAttributes{Resource: "secrets", Namespace: h.resolver.ProjectNamespace(msg.Project), Name: msg.Name}
+		switch msg.Permission {
+		case consolev1.Permission_PERMISSION_SECRETS_READ:
+			attr.Verb = "get"
+		case consolev1.Permission_PERMISSION_SECRETS_LIST:
+			attr.Verb, attr.Name = "list", ""
+			return attr, nil
+		case consolev1.Permission_PERMISSION_SECRETS_WRITE:
+			attr.Verb = "update"
+			if msg.Name == "" {
+				attr.Verb = "create"
+				return attr, nil
+			}
+		case consolev1.Permission_PERMISSION_SECRETS_DELETE:
+			attr.Verb = "delete"
+		case consolev1.Permission_PERMISSION_SECRETS_ADMIN:
+			// Sharing a secret writes RoleBindings in the project namespace.
+			return &consolev1.ResourceAttributes{
+				Verb:      "create",
+				Group:     "rbac.authorization.k8s.io",
+				Resource:  "rolebindings",
+				Namespace: attr.Namespace,
+			}, nil
+		case consolev1.Permission_PERMISSION_SECRETS_ROTATE:
+			attr.Verb = "rotate"
+		}
+		if err := requireName(); err != nil {
+			return nil, err
+		}
+		return attr, nil
+
+	case consolev1.Permission_PERMISSION_RESOURCES_READ:
+		// ListProjectResources gates on listing Deployments in the project
+		// namespace; see projects.K8sClient.ListResources.
+		if err := requireType(v1alpha2.ResourceTypeProject); err != nil {
+			return nil, err
+		}
+		if err := requireName(); err != nil {
+			return nil, err
+		}
+		return &consolev1.ResourceAttributes{Verb: "list", Group: "apps", Resource: "deployments", Namespace: h.resolver.ProjectNamespace(msg.Name)}, nil
+
+	case consolev1.Permission_PERMISSION_PROJECTS_CREATE, consolev1.Permission_PERMISSION_FOLDERS_CREATE:
+		// Creating a child requires owner access on the parent, so the
+		// resource is the parent organization or folder.
+		if err := requireType(v1alpha2.ResourceTypeOrganization, v1alpha2.ResourceTypeFolder); err != nil {
+			return nil, err
+		}
+		if err := requireName(); err != nil {
+			return nil, err
+		}
+		return &consolev1.ResourceAttributes{Verb: "delete", Resource: "namespaces", Name: h.namespaceFor(msg.ResourceType, msg.Name)}, nil
+	}
+
+	entry, ok := namespaceVerbs[msg.Permission]
+	if !ok {
+		return nil, invalid("CanI does not support %s", msg.Permission)
+	}
+	if err := requireType(entry.resourceType); err != nil {
+		return nil, err
+	}
+	if err := requireName(); err != nil {
+		return nil, err
+	}
+	return &consolev1.ResourceAttributes{Verb: entry.verb, Resource: "namespaces", Name: h.namespaceFor(msg.ResourceType, msg.Name)}, nil
+}
+
+func (h *Handler) namespaceFor(resourceType, name string) string {
+	switch resourceType {
+	case v1alpha2.ResourceTypeProject:
+		return h.resolver.ProjectNamespace(name)
+	case v1alpha2.ResourceTypeFolder:
+		return h.resolver.FolderNamespace(name)
+	default:
+		return h.resolver.OrgNamespace(name)
+	}
+}
@@ -0,0 +1,129 @@
+package permissions
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	authzv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	clienttesting "k8s.io/client-go/testing"
+
+	"github.com/holos-run/holos-console/console/resolver"
+	"github.com/holos-run/holos-console/console/rpc"
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+)
+
+func TestCanI(t *testing.T) {
+	// The caller may get and rotate secrets in prj-billing and delete the
+	// acme organization namespace; everything else is denied.
+	allowed := map[string]bool{
+		"get:secrets:holos-prj-billing:db-creds":    true,
+		"rotate:secrets:holos-prj-billing:db-creds": true,
+		"delete:namespaces:holos-org-acme":          true,
+	}
+	caller := fake.NewClientset()
+	caller.PrependReactor("create", "selfsubjectaccessreviews", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		ssar := action.(clienttesting.CreateAction).GetObject().(*authzv1.SelfSubjectAccessReview)
+		a := ssar.Spec.ResourceAttributes
+		ssar.Status.Allowed = allowed[PermissionKey(&consolev1.ResourceAttributes{
+			Verb: a.Verb, Group: a.Group, Resource: a.Resource, Namespace: a.Namespace, Name: a.Name,
+		})]
+		return true, ssar, nil
+	})
+	ctx := rpc.ContextWithClaims(context.Background(), &rpc.Claims{Sub: "alice", Email: "alice@example.com"})
+	ctx = rpc.ContextWithImpersonatedClients(ctx, &rpc.ImpersonatedClients{Clientset: caller})
+
+	r := &resolver.Resolver{NamespacePrefix: "holos-", OrganizationPrefix: "org-", FolderPrefix: "fld-", ProjectPrefix: "prj-"}
+	h := NewHandler().WithResolver(r)
+
+	cases := []struct {
+		name    string
+		req     *consolev1.CanIRequest
+		wantKey string
+		allowed bool
+	}{
+		{
+			name:    "read secret",
+			req:     &consolev1.CanIRequest{Permission: consolev1.Permission_PERMISSION_SECRETS_READ, ResourceType: "secret", Project: "billing", Name: "db-creds"},
+			wantKey: "get:secrets:holos-prj-billing:db-creds",
+			allowed: true,
+		},
+		{
+			name:    "rotate secret",
+			req:     &consolev1.CanIRequest{Permission: consolev1.Permission_PERMISSION_SECRETS_ROTATE, ResourceType: "secret", Project: "billing", Name: "db-creds"},
+			wantKey: "rotate:secrets:holos-prj-billing:db-creds",
+			allowed: true,
+		},
+		{
+			name:    "create secret",
+			req:     &consolev1.CanIRequest{Permission: consolev1.Permission_PERMISSION_SECRETS_WRITE, ResourceType: "secret", Project: "billing"},
+			wantKey: "create:secrets:holos-prj-billing",
+		},
+		{
+			name:    "share secret",
+			req:     &consolev1.CanIRequest{Permission: consolev1.Permission_PERMISSION_SECRETS_ADMIN, ResourceType: "secret", Project: "billing", Name: "db-creds"},
+			wantKey: "create:rbac.authorization.k8s.io/rolebindings:holos-prj-billing",
+		},
+		{
+			name:    "list project resources",
+			req:     &consolev1.CanIRequest{Permission: consolev1.Permission_PERMISSION_RESOURCES_READ, ResourceType: "project", Name: "billing"},
+			wantKey: "list:apps/deployments:holos-prj-billing",
+		},
+		{
+			name:    "administer organization",
+			req:     &consolev1.CanIRequest{Permission: consolev1.Permission_PERMISSION_ORGANIZATIONS_ADMIN, ResourceType: "organization", Name: "acme"},
+			wantKey: "delete:namespaces:holos-org-acme",
+			allowed: true,
+		},
+		{
+			name:    "create project in organization",
+			req:     &consolev1.CanIRequest{Permission: consolev1.Permission_PERMISSION_PROJECTS_CREATE, ResourceType: "organization", Name: "acme"},
+			wantKey: "delete:namespaces:holos-org-acme",
+			allowed: true,
+		},
+		{
+			name:    "update folder",
+			req:     &consolev1.CanIRequest{Permission: consolev1.Permission_PERMISSION_FOLDERS_WRITE, ResourceType: "folder", Name: "eng"},
+			wantKey: "update:namespaces:holos-fld-eng",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := h.CanI(ctx, connect.NewRequest(tc.req))
+			if err != nil {
+				t.Fatalf("CanI: %v", err)
+			}
+			if got := PermissionKey(resp.Msg.Attributes); got != tc.wantKey {
+				t.Errorf("attributes key = %q, want %q", got, tc.wantKey)
+			}
+			if resp.Msg.Allowed != tc.allowed {
+				t.Errorf("allowed = %v, want %v", resp.Msg.Allowed, tc.allowed)
+			}
+		})
+	}
+}
+
+func TestCanI_InvalidArgument(t *testing.T) {
+	ctx := rpc.ContextWithClaims(context.Background(), &rpc.Claims{Sub: "alice"})
+	ctx = rpc.ContextWithImpersonatedClients(ctx, &rpc.ImpersonatedClients{Clientset: fake.NewClientset()})
+	h := NewHandler().WithResolver(&resolver.Resolver{NamespacePrefix: "holos-", OrganizationPrefix: "org-", FolderPrefix: "fld-", ProjectPrefix: "prj-"})
+
+	cases := []struct {
+		name string
+		req  *consolev1.CanIRequest
+	}{
+		{name: "mismatched resource type", req: &consolev1.CanIRequest{Permission: consolev1.Permission_PERMISSION_PROJECTS_READ, ResourceType: "folder", Name: "eng"}},
+		{name: "secret without project", req: &consolev1.CanIRequest{Permission: consolev1.Permission_PERMISSION_SECRETS_READ, ResourceType: "secret", Name: "db-creds"}},
+		{name: "read without name", req: &consolev1.CanIRequest{Permission: consolev1.Permission_PERMISSION_SECRETS_READ, ResourceType: "secret", Project: "billing"}},
+		{name: "unsupported permission", req: &consolev1.CanIRequest{Permission: consolev1.Permission_PERMISSION_DEPLOYMENTS_LOGS, ResourceType: "project", Name: "billing"}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := h.CanI(ctx, connect.NewRequest(tc.req)); connect.CodeOf(err) != connect.CodeInvalidArgument {
+				t.Fatalf("expected CodeInvalidArgument, got %v", err)
+			}
+		})
+	}
+}
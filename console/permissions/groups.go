@@ -0,0 +1,149 @@
+package permissions
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"connectrpc.com/connect"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/holos-run/holos-console/console/rpc"
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+)
+
+// ListMyGroups reports the caller's groups and the bindings each activates.
+// No authorization is required beyond authentication: the response only
+// describes grants the caller already holds through their own groups.
+func (h *Handler) ListMyGroups(
+	ctx context.Context,
+	req *connect.Request[consolev1.ListMyGroupsRequest],
+) (*connect.Response[consolev1.ListMyGroupsResponse], error) {
+	claims := rpc.MustClaims(ctx)
+	if h.k8s == nil || h.resolver == nil {
+		return nil, connect.NewError(connect.CodeUnimplemented, fmt.Errorf("group introspection is not configured"))
+	}
+
+	groups := make([]*consolev1.GroupMembership, 0, len(claims.Roles))
+	byPrincipal := map[string]*consolev1.GroupMembership{}
+	for _, group := range claims.Roles {
+		membership := &consolev1.GroupMembership{Group: group}
+		if prefixed := rpc.PrefixedGroups(claims, []string{group}); len(prefixed) == 1 {
+			membership.Principal = prefixed[0]
+			byPrincipal[membership.Principal] = membership
+		}
+		groups = append(groups, membership)
+	}
+	if len(byPrincipal) > 0 {
+		if err := h.groupGrants(ctx, byPrincipal); err != nil {
+			return nil, rpc.MapK8sError(err)
+		}
+	}
+
+	slog.InfoContext(ctx, "group memberships listed",
+		slog.String("action", "group_memberships_list"),
+		slog.String("resource_type", "group"),
+		slog.String("sub", claims.Sub),
+		slog.String("email", claims.Email),
+		slog.Int("groups", len(groups)),
+	)
+	return connect.NewResponse(&consolev1.ListMyGroupsResponse{Groups: groups}), nil
+}
+
+// groupGrants appends to each membership in byPrincipal the
+// ClusterRoleBindings, then the RoleBindings, whose Group subjects name it.
+func (h *Handler) groupGrants(ctx context.Context, byPrincipal map[string]*consolev1.GroupMembership) error {
+	rules := map[string][]rbacv1.PolicyRule{}
+	roleRules := func(namespace string, ref rbacv1.RoleRef) ([]rbacv1.PolicyRule, error) {
+		key := ref.Kind + "/" + namespace + "/" + ref.Name
+		if ref.Kind == "ClusterRole" {
+			key = ref.Kind + "/" + ref.Name
+		}
+		if cached, ok := rules[key]; ok {
+			return cached, nil
+		}
+		var err error
+		if ref.Kind == "ClusterRole" {
+			var role *rbacv1.ClusterRole
+			if role, err = h.k8s.RbacV1().ClusterRoles().Get(ctx, ref.Name, metav1.GetOptions{}); err == nil {
+				rules[key] = role.Rules
+			}
+		} else {
+			var role *rbacv1.Role
+			if role, err = h.k8s.RbacV1().Roles(namespace).Get(ctx, ref.Name, metav1.GetOptions{}); err == nil {
+				rules[key] = role.Rules
+			}
+		}
+		if apierrors.IsNotFound(err) {
+			rules[key], err = nil, nil
+		}
+		return rules[key], err
+	}
+	// members returns the memberships whose principal a binding names.
+	members := func(subjects []rbacv1.Subject) []*consolev1.GroupMembership {
+		var out []*consolev1.GroupMembership
+		for _, subject := range subjects {
+			if subject.Kind != rbacv1.GroupKind {
+				continue
+			}
+			if membership, ok := byPrincipal[subject.Name]; ok && !slices.Contains(out, membership) {
+				out = append(out, membership)
+			}
+		}
+		return out
+	}
+
+	clusterBindings, err := h.k8s.RbacV1().ClusterRoleBindings().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	for _, binding := range clusterBindings.Items {
+		matched := members(binding.Subjects)
+		if len(matched) == 0 {
+			continue
+		}
+		ruleSet, err := roleRules("", binding.RoleRef)
+		if err != nil {
+			return err
+		}
+		grant := &consolev1.GroupGrant{
+			Source:  "ClusterRoleBinding/" + binding.Name,
+			RoleRef: binding.RoleRef.Kind + "/" + binding.RoleRef.Name,
+			Role:    roleForRules(ruleSet, &consolev1.ResourceAttributes{Resource: "namespaces"}),
+		}
+		for _, membership := range matched {
+			membership.Grants = append(membership.Grants, grant)
+		}
+	}
+
+	bindings, err := h.k8s.RbacV1().RoleBindings(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	for _, binding := range bindings.Items {
+		matched := members(binding.Subjects)
+		if len(matched) == 0 {
+			continue
+		}
+		ruleSet, err := roleRules(binding.Namespace, binding.RoleRef)
+		if err != nil {
+			return err
+		}
+		grant := &consolev1.GroupGrant{
+			Source:    "RoleBinding/" + binding.Namespace + "/" + binding.Name,
+			RoleRef:   binding.RoleRef.Kind + "/" + binding.RoleRef.Name,
+			Namespace: binding.Namespace,
+			Role:      roleForRules(ruleSet, &consolev1.ResourceAttributes{Resource: "namespaces", Name: binding.Namespace}),
+		}
+		if kind, name, err := h.resolver.ResourceTypeFromNamespace(binding.Namespace); err == nil {
+			grant.ResourceType, grant.ResourceName = kind, name
+		}
+		for _, membership := range matched {
+			membership.Grants = append(membership.Grants, grant)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package permissions
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/holos-run/holos-console/console/resolver"
+	"github.com/holos-run/holos-console/console/rpc"
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+)
+
+func TestListMyGroups(t *testing.T) {
+	r := &resolver.Resolver{NamespacePrefix: "holos-", OrganizationPrefix: "org-", FolderPrefix: "fld-", ProjectPrefix: "prj-"}
+	h := NewHandler().WithResolver(r).WithAccessReview(fake.NewClientset(accessReviewObjects()...))
+	ctx := rpc.ContextWithClaims(context.Background(), &rpc.Claims{
+		Sub:   "alice",
+		Email: "alice@example.com",
+		Roles: []string{"devs", "platform-admins", "typo-admins", "system:masters"},
+	})
+
+	resp, err := h.ListMyGroups(ctx, connect.NewRequest(&consolev1.ListMyGroupsRequest{}))
+	if err != nil {
+		t.Fatalf("ListMyGroups: %v", err)
+	}
+	groups := resp.Msg.Groups
+	if len(groups) != 4 {
+		t.Fatalf("expected 4 groups, got %d", len(groups))
+	}
+
+	devs := groups[0]
+	if devs.Principal != "oidc:devs" || len(devs.Grants) != 1 {
+		t.Fatalf("expected devs to activate one binding, got %+v", devs)
+	}
+	if g := devs.Grants[0]; g.Source != "RoleBinding/holos-prj-billing/editor-devs" || g.RoleRef != "Role/secrets-editor" ||
+		g.ResourceType != "project" || g.ResourceName != "billing" || g.Role != consolev1.Role_ROLE_UNSPECIFIED {
+		t.Errorf("unexpected devs grant %+v", g)
+	}
+
+	admins := groups[1]
+	if len(admins.Grants) != 1 || admins.Grants[0].Source != "ClusterRoleBinding/platform-admins" || admins.Grants[0].Role != consolev1.Role_ROLE_OWNER {
+		t.Errorf("expected platform-admins to hold cluster-wide owner, got %+v", admins.Grants)
+	}
+
+	if typo := groups[2]; typo.Principal != "oidc:typo-admins" || len(typo.Grants) != 0 {
+		t.Errorf("expected an unbound group with no grants, got %+v", typo)
+	}
+	if system := groups[3]; system.Principal != "" || len(system.Grants) != 0 {
+		t.Errorf("expected system groups to be reported but never forwarded, got %+v", system)
+	}
+}
+
+func TestListMyGroups_NotConfigured(t *testing.T) {
+	ctx := rpc.ContextWithClaims(context.Background(), &rpc.Claims{Sub: "alice"})
+	_, err := NewHandler().ListMyGroups(ctx, connect.NewRequest(&consolev1.ListMyGroupsRequest{}))
+	if connect.CodeOf(err) != connect.CodeUnimplemented {
+		t.Fatalf("expected Unimplemented, got %v", err)
+	}
+}
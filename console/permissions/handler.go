@@ -19,6 +19,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 
+	"github.com/holos-run/holos-console/console/resolver"
 	"github.com/holos-run/holos-console/console/rpc"
 	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
 	"github.com/holos-run/holos-console/gen/holos/console/v1/consolev1connect"
@@ -27,12 +28,30 @@ import (
 // Handler implements consolev1connect.PermissionsServiceHandler.
 type Handler struct {
 	consolev1connect.UnimplementedPermissionsServiceHandler
+	k8s      kubernetes.Interface
+	resolver *resolver.Resolver
 }
 
-// NewHandler returns a PermissionsService handler. The handler is stateless;
-// every request resolves its Kubernetes client from the request context.
+// NewHandler returns a PermissionsService handler. ListResourcePermissions is
+// stateless; every request resolves its Kubernetes client from the request
+// context.
 func NewHandler() *Handler { return &Handler{} }
 
+// WithResolver sets the resolver CanI and GetAccessReview use to map
+// resource names to namespaces.
+func (h *Handler) WithResolver(r *resolver.Resolver) *Handler {
+	h.resolver = r
+	return h
+}
+
+// WithAccessReview enables GetAccessReview. k8s is the console's service
+// account client used to read RBAC objects once the caller has been
+// authorized.
+func (h *Handler) WithAccessReview(k8s kubernetes.Interface) *Handler {
+	h.k8s = k8s
+	return h
+}
+
 // ListResourcePermissions issues one SelfSubjectAccessReview per requested
 // attribute against the impersonating client and returns the decisions in the
 // same order.
@@ -43,10 +62,6 @@ func (h *Handler) ListResourcePermissions(
 	if req == nil || req.Msg == nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("request is required"))
 	}
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
-	}
 	clientset := rpc.ImpersonatedClientsetFromContext(ctx)
 	if clientset == nil {
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("no kubernetes client on request context"))
@@ -89,6 +104,19 @@ func PermissionKey(attr *consolev1.ResourceAttributes) string {
 	return strings.Join(parts, ":")
 }
 
+// Review issues a single SelfSubjectAccessReview for attr against clientset,
+// which is expected to be the per-request impersonating client. Other
+// handlers use it to gate console-only virtual resources (for example
+// auditevents.console.holos.run) through the API server rather than
+// re-implementing authorization in Go.
+func Review(
+	ctx context.Context,
+	clientset kubernetes.Interface,
+	attr *consolev1.ResourceAttributes,
+) (*consolev1.ResourcePermission, error) {
+	return selfSubjectAccessReview(ctx, clientset, attr)
+}
+
 func selfSubjectAccessReview(
 	ctx context.Context,
 	clientset kubernetes.Interface,
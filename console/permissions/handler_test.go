@@ -2,7 +2,6 @@ package permissions
 
 import (
 	"context"
-	"errors"
 	"testing"
 
 	"connectrpc.com/connect"
@@ -126,15 +125,3 @@ func TestListResourcePermissions_Denied(t *testing.T) {
 		t.Fatalf("expected denied, got %+v", got)
 	}
 }
-
-func TestListResourcePermissions_Unauthenticated(t *testing.T) {
-	h := NewHandler()
-	_, err := h.ListResourcePermissions(context.Background(), connect.NewRequest(&consolev1.ListResourcePermissionsRequest{}))
-	if err == nil {
-		t.Fatalf("expected error")
-	}
-	var ce *connect.Error
-	if !errors.As(err, &ce) || ce.Code() != connect.CodeUnauthenticated {
-		t.Fatalf("expected CodeUnauthenticated, got %v", err)
-	}
-}
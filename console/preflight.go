@@ -0,0 +1,356 @@
+package console
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	v1alpha2 "github.com/holos-run/holos-console/api/v1alpha2"
+)
+
+// Preflight check results.
+const (
+	PreflightPass = "pass"
+	PreflightWarn = "warn"
+	PreflightFail = "fail"
+	PreflightSkip = "skip"
+)
+
+// preflightTimeout bounds each network call a preflight check makes.
+const preflightTimeout = 10 * time.Second
+
+// certificateExpiryWarning is how close to expiry the serving certificate
+// must be for preflight to warn.
+const certificateExpiryWarning = 30 * 24 * time.Hour
+
+// maxCollisionsReported limits how many colliding namespaces a check names.
+const maxCollisionsReported = 5
+
+// preflightVerbs are the verbs the console's ServiceAccount needs on
+// namespaces and secrets.
+var preflightVerbs = []string{"get", "list", "watch", "create", "update", "patch", "delete"}
+
+// staticNamespaceVerbs are the verbs the ServiceAccount needs on each
+// project namespace object when projects are static. It never lists,
+// creates, or deletes them.
+var staticNamespaceVerbs = []string{"get", "update", "patch"}
+
+// PreflightCheck is the result of one preflight check.
+type PreflightCheck struct {
+	Name    string `json:"name"`
+	Status  string `json:"status"`
+	Message string `json:"message"`
+}
+
+// PreflightReport is the result of Preflight.
+type PreflightReport struct {
+	Checks []PreflightCheck `json:"checks"`
+}
+
+// Failed reports whether any check failed.
+func (r PreflightReport) Failed() bool {
+	return slices.ContainsFunc(r.Checks, func(c PreflightCheck) bool { return c.Status == PreflightFail })
+}
+
+func (r *PreflightReport) add(name, status, format string, args ...any) {
+	r.Checks = append(r.Checks, PreflightCheck{Name: name, Status: status, Message: fmt.Sprintf(format, args...)})
+}
+
+// Preflight validates the environment the server would run in without
+// starting it: the TLS and CA files, OIDC discovery, the Kubernetes RBAC of
+// the console's ServiceAccount, and whether the namespace prefixes collide
+// with each other or with namespaces the console does not manage. kube is
+// the ServiceAccount's clientset; nil skips the Kubernetes checks.
+func (s *Server) Preflight(ctx context.Context, kube kubernetes.Interface) PreflightReport {
+	var report PreflightReport
+	s.applyPrefixDefaults()
+
+	s.preflightTLS(&report)
+	client := s.preflightCATrust(&report)
+	s.preflightOIDC(ctx, &report, client)
+	s.preflightPrefixes(&report)
+	if kube == nil {
+		report.add("kubernetes-rbac", PreflightSkip, "no in-cluster config or KUBECONFIG")
+		report.add("namespace-collisions", PreflightSkip, "no in-cluster config or KUBECONFIG")
+		return report
+	}
+	if len(s.cfg.StaticProjects) > 0 {
+		s.preflightStaticProjects(ctx, &report, kube)
+		return report
+	}
+	preflightRBAC(ctx, &report, kube)
+	s.preflightCollisions(ctx, &report, kube)
+	return report
+}
+
+// applyPrefixDefaults fills in the default namespace prefixes.
+func (s *Server) applyPrefixDefaults() {
+	if s.cfg.OrganizationPrefix == "" {
+		s.cfg.OrganizationPrefix = "org-"
+	}
+	if s.cfg.FolderPrefix == "" {
+		s.cfg.FolderPrefix = "fld-"
+	}
+	if s.cfg.ProjectPrefix == "" {
+		s.cfg.ProjectPrefix = "prj-"
+	}
+}
+
+// preflightTLS checks the serving certificate and the client CA bundle.
+func (s *Server) preflightTLS(report *PreflightReport) {
+	switch {
+	case s.cfg.PlainHTTP:
+		report.add("tls", PreflightSkip, "serving plain HTTP")
+	case s.cfg.CertFile == "" || s.cfg.KeyFile == "":
+		report.add("tls", PreflightWarn, "no --cert and --key; a self-signed certificate is generated at startup")
+	default:
+		cert, err := tls.LoadX509KeyPair(s.cfg.CertFile, s.cfg.KeyFile)
+		if err != nil {
+			report.add("tls", PreflightFail, "loading --cert and --key: %v", err)
+			break
+		}
+		leaf := cert.Leaf
+		switch remaining := time.Until(leaf.NotAfter); {
+		case remaining <= 0:
+			report.add("tls", PreflightFail, "certificate for %v expired at %s", leaf.DNSNames, leaf.NotAfter.Format(time.RFC3339))
+		case remaining < certificateExpiryWarning:
+			report.add("tls", PreflightWarn, "certificate for %v expires at %s", leaf.DNSNames, leaf.NotAfter.Format(time.RFC3339))
+		default:
+			report.add("tls", PreflightPass, "certificate for %v valid until %s", leaf.DNSNames, leaf.NotAfter.Format(time.RFC3339))
+		}
+	}
+
+	if s.cfg.ClientCAFile == "" {
+		return
+	}
+	if _, err := loadClientCAPool(s.cfg.ClientCAFile); err != nil {
+		report.add("client-ca", PreflightFail, "%v", err)
+		return
+	}
+	report.add("client-ca", PreflightPass, "loaded %s", s.cfg.ClientCAFile)
+}
+
+// preflightCATrust loads --ca-cert and returns the client OIDC discovery
+// uses, matching the server's internal client.
+func (s *Server) preflightCATrust(report *PreflightReport) *http.Client {
+	pool, err := loadCACertPool(s.cfg.CACertFile)
+	switch {
+	case err != nil:
+		report.add("ca-trust", PreflightFail, "loading --ca-cert: %v", err)
+	case pool == nil:
+		report.add("ca-trust", PreflightPass, "using system roots")
+	default:
+		report.add("ca-trust", PreflightPass, "trusting system roots and %s", s.cfg.CACertFile)
+	}
+	client := httpClientWithCA(pool)
+	client.Timeout = preflightTimeout
+	return client
+}
+
+// preflightOIDC fetches the issuer's discovery document the way the auth
+// interceptor does on the first request.
+func (s *Server) preflightOIDC(ctx context.Context, report *PreflightReport, client *http.Client) {
+	if s.cfg.Issuer == "" {
+		report.add("oidc-discovery", PreflightWarn, "no --issuer; protected RPCs are served without authentication")
+		return
+	}
+	if s.cfg.EnableInsecureDex || len(s.cfg.DexConnectors) > 0 {
+		report.add("oidc-discovery", PreflightSkip, "the embedded Dex provider is served by the console itself")
+		return
+	}
+	discoveryURL := strings.TrimSuffix(s.cfg.Issuer, "/") + "/.well-known/openid-configuration"
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, discoveryURL, nil)
+	if err != nil {
+		report.add("oidc-discovery", PreflightFail, "invalid --issuer: %v", err)
+		return
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		report.add("oidc-discovery", PreflightFail, "fetching %s: %v", discoveryURL, err)
+		return
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		report.add("oidc-discovery", PreflightFail, "fetching %s: %s", discoveryURL, resp.Status)
+		return
+	}
+	var doc struct {
+		Issuer  string `json:"issuer"`
+		JWKSURI string `json:"jwks_uri"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&doc); err != nil {
+		report.add("oidc-discovery", PreflightFail, "decoding %s: %v", discoveryURL, err)
+		return
+	}
+	switch {
+	case doc.Issuer != s.cfg.Issuer:
+		report.add("oidc-discovery", PreflightFail, "discovery document issuer %q does not match --issuer %q", doc.Issuer, s.cfg.Issuer)
+	case doc.JWKSURI == "":
+		report.add("oidc-discovery", PreflightFail, "discovery document has no jwks_uri")
+	default:
+		report.add("oidc-discovery", PreflightPass, "discovered %s", s.cfg.Issuer)
+	}
+}
+
+// preflightPrefixes checks that a namespace name maps to exactly one
+// resource type.
+func (s *Server) preflightPrefixes(report *PreflightReport) {
+	prefixes := []struct{ kind, prefix string }{
+		{"organization", s.cfg.NamespacePrefix + s.cfg.OrganizationPrefix},
+		{"folder", s.cfg.NamespacePrefix + s.cfg.FolderPrefix},
+		{"project", s.cfg.NamespacePrefix + s.cfg.ProjectPrefix},
+	}
+	var overlaps, described []string
+	for i, a := range prefixes {
+		described = append(described, fmt.Sprintf("%s %q", a.kind, a.prefix))
+		for _, b := range prefixes[i+1:] {
+			if strings.HasPrefix(a.prefix, b.prefix) || strings.HasPrefix(b.prefix, a.prefix) {
+				overlaps = append(overlaps, fmt.Sprintf("%s %q and %s %q", a.kind, a.prefix, b.kind, b.prefix))
+			}
+		}
+	}
+	if len(overlaps) > 0 {
+		report.add("namespace-prefixes", PreflightFail, "ambiguous prefixes: %s", strings.Join(overlaps, "; "))
+		return
+	}
+	report.add("namespace-prefixes", PreflightPass, "%s", strings.Join(described, ", "))
+}
+
+// preflightRBAC asks the API server whether the ServiceAccount may manage
+// namespaces and secrets. RBAC cannot be scoped by label, so the console
+// needs these verbs cluster-wide and limits itself to objects carrying the
+// managed-by label.
+func preflightRBAC(ctx context.Context, report *PreflightReport, kube kubernetes.Interface) {
+	var denied []string
+	for _, resource := range []string{"namespaces", "secrets"} {
+		for _, verb := range preflightVerbs {
+			allowed, err := reviewAccess(ctx, kube, "", verb, resource)
+			if err != nil {
+				report.add("kubernetes-rbac", PreflightFail, "reviewing access to %s: %v", resource, err)
+				return
+			}
+			if !allowed {
+				denied = append(denied, verb+" "+resource)
+			}
+		}
+	}
+	if len(denied) > 0 {
+		report.add("kubernetes-rbac", PreflightFail, "the ServiceAccount may not %s", strings.Join(denied, ", "))
+		return
+	}
+	report.add("kubernetes-rbac", PreflightPass, "the ServiceAccount may manage namespaces and secrets")
+}
+
+// preflightStaticProjects checks a static-projects deployment: each
+// project namespace must exist and be managed, and the ServiceAccount needs
+// access only within those namespaces. A Role bound in a namespace grants
+// access to the namespace object itself as well as to its secrets.
+func (s *Server) preflightStaticProjects(ctx context.Context, report *PreflightReport, kube kubernetes.Interface) {
+	var denied, problems []string
+	for _, ns := range s.staticProjectNamespaces() {
+		for _, access := range []struct {
+			resource string
+			verbs    []string
+		}{{"namespaces", staticNamespaceVerbs}, {"secrets", preflightVerbs}} {
+			for _, verb := range access.verbs {
+				allowed, err := reviewAccess(ctx, kube, ns, verb, access.resource)
+				if err != nil {
+					report.add("kubernetes-rbac", PreflightFail, "reviewing access to %s in %s: %v", access.resource, ns, err)
+					return
+				}
+				if !allowed {
+					denied = append(denied, fmt.Sprintf("%s %s in %s", verb, access.resource, ns))
+				}
+			}
+		}
+
+		getCtx, cancel := context.WithTimeout(ctx, preflightTimeout)
+		got, err := kube.CoreV1().Namespaces().Get(getCtx, ns, metav1.GetOptions{})
+		cancel()
+		switch {
+		case err != nil:
+			problems = append(problems, fmt.Sprintf("%s: %v", ns, err))
+		case got.Labels[v1alpha2.LabelManagedBy] != v1alpha2.ManagedByValue:
+			problems = append(problems, fmt.Sprintf("%s lacks the %s=%s label", ns, v1alpha2.LabelManagedBy, v1alpha2.ManagedByValue))
+		}
+	}
+	if len(denied) > 0 {
+		report.add("kubernetes-rbac", PreflightFail, "the ServiceAccount may not %s", strings.Join(denied, ", "))
+	} else {
+		report.add("kubernetes-rbac", PreflightPass, "the ServiceAccount may manage the static project namespaces")
+	}
+	if len(problems) > 0 {
+		report.add("static-projects", PreflightFail, "%s", strings.Join(problems, "; "))
+		return
+	}
+	report.add("static-projects", PreflightPass, "%d project namespaces exist and are managed", len(s.cfg.StaticProjects))
+}
+
+// reviewAccess asks the API server whether the ServiceAccount may perform
+// verb on resource. An empty namespace asks about every namespace.
+func reviewAccess(ctx context.Context, kube kubernetes.Interface, namespace, verb, resource string) (bool, error) {
+	attrs := &authorizationv1.ResourceAttributes{Namespace: namespace, Verb: verb, Resource: resource}
+	if resource == "namespaces" {
+		// Access to a namespace object is reviewed within itself.
+		attrs.Name = namespace
+	}
+	review := &authorizationv1.SelfSubjectAccessReview{
+		Spec: authorizationv1.SelfSubjectAccessReviewSpec{ResourceAttributes: attrs},
+	}
+	ctx, cancel := context.WithTimeout(ctx, preflightTimeout)
+	defer cancel()
+	result, err := kube.AuthorizationV1().SelfSubjectAccessReviews().Create(ctx, review, metav1.CreateOptions{})
+	if err != nil {
+		return false, err
+	}
+	return result.Status.Allowed, nil
+}
+
+// preflightCollisions finds namespaces named like console namespaces that
+// the console does not manage. The console refuses to act on them, so a
+// user creating an organization, folder or project of the same name gets a
+// confusing error at request time.
+func (s *Server) preflightCollisions(ctx context.Context, report *PreflightReport, kube kubernetes.Interface) {
+	ctx, cancel := context.WithTimeout(ctx, preflightTimeout)
+	defer cancel()
+	list, err := kube.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		report.add("namespace-collisions", PreflightFail, "listing namespaces: %v", err)
+		return
+	}
+	prefixes := []string{
+		s.cfg.NamespacePrefix + s.cfg.OrganizationPrefix,
+		s.cfg.NamespacePrefix + s.cfg.FolderPrefix,
+		s.cfg.NamespacePrefix + s.cfg.ProjectPrefix,
+	}
+	var collisions []string
+	for _, ns := range list.Items {
+		if ns.Labels[v1alpha2.LabelManagedBy] == v1alpha2.ManagedByValue {
+			continue
+		}
+		if slices.ContainsFunc(prefixes, func(p string) bool { return strings.HasPrefix(ns.Name, p) }) {
+			collisions = append(collisions, ns.Name)
+		}
+	}
+	if len(collisions) == 0 {
+		report.add("namespace-collisions", PreflightPass, "no unmanaged namespaces use the console prefixes")
+		return
+	}
+	slices.Sort(collisions)
+	named := collisions[:min(len(collisions), maxCollisionsReported)]
+	more := ""
+	if len(collisions) > len(named) {
+		more = fmt.Sprintf(" and %d more", len(collisions)-len(named))
+	}
+	report.add("namespace-collisions", PreflightFail, "%d namespaces use the console prefixes without the %s=%s label: %s%s",
+		len(collisions), v1alpha2.LabelManagedBy, v1alpha2.ManagedByValue, strings.Join(named, ", "), more)
+}
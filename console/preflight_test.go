@@ -0,0 +1,123 @@
+package console
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	v1alpha2 "github.com/holos-run/holos-console/api/v1alpha2"
+)
+
+func TestPreflight(t *testing.T) {
+	var issuer string
+	oidc := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/.well-known/openid-configuration" {
+			http.NotFound(w, r)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]string{"issuer": issuer, "jwks_uri": issuer + "/keys"})
+	}))
+	defer oidc.Close()
+	issuer = oidc.URL
+
+	namespace := func(name string, managed bool) *corev1.Namespace {
+		ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name}}
+		if managed {
+			ns.Labels = map[string]string{v1alpha2.LabelManagedBy: v1alpha2.ManagedByValue}
+		}
+		return ns
+	}
+	kube := fake.NewClientset(
+		namespace("holos-org-acme", true),
+		namespace("holos-prj-legacy", false),
+		namespace("kube-system", false),
+	)
+	// The ServiceAccount may do everything except delete secrets.
+	kube.PrependReactor("create", "selfsubjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		review := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SelfSubjectAccessReview)
+		attrs := review.Spec.ResourceAttributes
+		review.Status.Allowed = !(attrs.Resource == "secrets" && attrs.Verb == "delete")
+		return true, review, nil
+	})
+
+	server := New(Config{PlainHTTP: true, Issuer: issuer, NamespacePrefix: "holos-"})
+	report := server.Preflight(t.Context(), kube)
+	got := map[string]PreflightCheck{}
+	for _, c := range report.Checks {
+		got[c.Name] = c
+	}
+
+	for name, want := range map[string]string{
+		"tls":                  PreflightSkip,
+		"ca-trust":             PreflightPass,
+		"oidc-discovery":       PreflightPass,
+		"namespace-prefixes":   PreflightPass,
+		"kubernetes-rbac":      PreflightFail,
+		"namespace-collisions": PreflightFail,
+	} {
+		if got[name].Status != want {
+			t.Errorf("%s: expected %s, got %+v", name, want, got[name])
+		}
+	}
+	if msg := got["kubernetes-rbac"].Message; msg != "the ServiceAccount may not delete secrets" {
+		t.Errorf("unexpected rbac message %q", msg)
+	}
+	if msg := got["namespace-collisions"].Message; !strings.Contains(msg, "holos-prj-legacy") || strings.Contains(msg, "holos-org-acme") {
+		t.Errorf("unexpected collisions message %q", msg)
+	}
+	if !report.Failed() {
+		t.Error("expected the report to fail")
+	}
+
+	t.Run("static projects", func(t *testing.T) {
+		kube := fake.NewClientset(namespace("holos-prj-web", true), namespace("holos-prj-data", false))
+		// Only namespace-scoped access, and none to list secrets in data.
+		kube.PrependReactor("create", "selfsubjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			review := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SelfSubjectAccessReview)
+			attrs := review.Spec.ResourceAttributes
+			review.Status.Allowed = attrs.Namespace != "" && !(attrs.Namespace == "holos-prj-data" && attrs.Resource == "secrets" && attrs.Verb == "list")
+			return true, review, nil
+		})
+		server := New(Config{PlainHTTP: true, Issuer: issuer, NamespacePrefix: "holos-", StaticProjects: []string{"web", "data"}})
+		report := server.Preflight(t.Context(), kube)
+		got := map[string]PreflightCheck{}
+		for _, c := range report.Checks {
+			got[c.Name] = c
+		}
+		if msg := got["kubernetes-rbac"].Message; msg != "the ServiceAccount may not list secrets in holos-prj-data" {
+			t.Errorf("unexpected rbac check %+v", got["kubernetes-rbac"])
+		}
+		if c := got["static-projects"]; c.Status != PreflightFail || !strings.Contains(c.Message, "holos-prj-data lacks") || strings.Contains(c.Message, "holos-prj-web") {
+			t.Errorf("unexpected static-projects check %+v", c)
+		}
+		if _, ok := got["namespace-collisions"]; ok {
+			t.Error("expected no collision check without cluster-wide namespace list")
+		}
+	})
+
+	t.Run("ambiguous prefixes and mismatched issuer", func(t *testing.T) {
+		server := New(Config{PlainHTTP: true, Issuer: issuer + "/", NamespacePrefix: "holos-", FolderPrefix: "org-"})
+		report := server.Preflight(t.Context(), nil)
+		for _, c := range report.Checks {
+			switch c.Name {
+			case "namespace-prefixes", "oidc-discovery":
+				if c.Status != PreflightFail {
+					t.Errorf("%s: expected fail, got %+v", c.Name, c)
+				}
+			case "kubernetes-rbac", "namespace-collisions":
+				if c.Status != PreflightSkip {
+					t.Errorf("%s: expected skip without a cluster, got %+v", c.Name, c)
+				}
+			}
+		}
+	})
+}
@@ -0,0 +1,145 @@
+// Package principal classifies the principals of user share grants. Besides
+// an email address, a user grant may name every user of an email domain,
+// "*@example.com", or every authenticated user, "*". The broader grants save
+// enumerating every employee to give a whole company read access.
+//
+// Kubernetes RBAC has no notion of either, so the console binds them to
+// groups it adds to the identity it impersonates (see Groups): a domain
+// grant to EmailDomainGroupPrefix plus the domain, and "*" to
+// AuthenticatedGroup. Neither group carries the oidc: prefix every group
+// from an ID token is given, so no identity provider can issue them.
+package principal
+
+import (
+	"net/mail"
+	"strings"
+)
+
+const (
+	// Wildcard is the user grant principal matching every authenticated
+	// user.
+	Wildcard = "*"
+	// domainPrefix starts a principal matching every user of a domain.
+	domainPrefix = "*@"
+
+	// AuthenticatedGroup is the Kubernetes group of every OIDC user the
+	// console impersonates. Wildcard grants are bound to it.
+	AuthenticatedGroup = "holos:authenticated"
+	// EmailDomainGroupPrefix prefixes the Kubernetes group of the users of
+	// an email domain, e.g. "holos:email-domain:example.com". Domain grants
+	// are bound to it.
+	EmailDomainGroupPrefix = "holos:email-domain:"
+)
+
+// Match is how specifically a user grant principal names a user. Higher
+// values are more specific: a user grant takes precedence over a domain
+// grant, and a domain grant over a wildcard grant.
+type Match int
+
+const (
+	// NoMatch means the principal does not name the user.
+	NoMatch Match = iota
+	// MatchWildcard means the principal is Wildcard.
+	MatchWildcard
+	// MatchDomain means the principal names the user's email domain.
+	MatchDomain
+	// MatchUser means the principal is the user's email address.
+	MatchUser
+)
+
+// Matches reports how specifically principal names the user with email,
+// which verified reports the identity provider has verified. Emails and
+// domains compare case-insensitively. No principal names a user without an
+// email, and, as with Groups, a domain principal requires a verified one.
+func Matches(principal, email string, verified bool) Match {
+	switch {
+	case email == "":
+		return NoMatch
+	case principal == Wildcard:
+		return MatchWildcard
+	case strings.EqualFold(principal, email):
+		return MatchUser
+	}
+	if domain, ok := Domain(principal); ok && verified && strings.EqualFold(domain, emailDomain(email)) {
+		return MatchDomain
+	}
+	return NoMatch
+}
+
+// Best returns the principal among principals that names the user with
+// email most specifically, and how. It returns NoMatch when none does.
+func Best(principals []string, email string, verified bool) (string, Match) {
+	var best string
+	var bestMatch Match
+	for _, p := range principals {
+		if m := Matches(p, email, verified); m > bestMatch {
+			best, bestMatch = p, m
+		}
+	}
+	return best, bestMatch
+}
+
+// IsPattern reports whether principal is Wildcard or a domain principal
+// rather than an email address.
+func IsPattern(principal string) bool {
+	if principal == Wildcard {
+		return true
+	}
+	_, ok := Domain(principal)
+	return ok
+}
+
+// Domain returns the domain a principal such as "*@example.com" names.
+func Domain(principal string) (string, bool) {
+	domain, ok := strings.CutPrefix(principal, domainPrefix)
+	if !ok || domain == "" {
+		return "", false
+	}
+	// A domain is valid when an address in it is.
+	if addr, err := mail.ParseAddress("user@" + domain); err != nil || addr.Address != "user@"+domain {
+		return "", false
+	}
+	return domain, true
+}
+
+// Group returns the Kubernetes group a pattern principal is bound to.
+func Group(principal string) (string, bool) {
+	if principal == Wildcard {
+		return AuthenticatedGroup, true
+	}
+	if domain, ok := Domain(principal); ok {
+		return EmailDomainGroupPrefix + strings.ToLower(domain), true
+	}
+	return "", false
+}
+
+// FromGroup returns the pattern principal bound to group, the inverse of
+// Group, so grants read back from RBAC bindings keep their principal.
+func FromGroup(group string) (string, bool) {
+	if group == AuthenticatedGroup {
+		return Wildcard, true
+	}
+	if domain, ok := strings.CutPrefix(group, EmailDomainGroupPrefix); ok && domain != "" {
+		return domainPrefix + domain, true
+	}
+	return "", false
+}
+
+// Groups returns the groups the console adds to the identity it
+// impersonates for a user with email, so RBAC bindings for pattern grants
+// apply to them. The domain group requires a verified email.
+func Groups(email string, verified bool) []string {
+	groups := []string{AuthenticatedGroup}
+	if domain := emailDomain(email); verified && domain != "" {
+		groups = append(groups, EmailDomainGroupPrefix+strings.ToLower(domain))
+	}
+	return groups
+}
+
+func emailDomain(email string) string {
+	at := strings.LastIndex(email, "@")
+	if at < 0 {
+		return ""
+	}
+	return email[at+1:]
+}
@@ -0,0 +1,95 @@
+package principal
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestMatches(t *testing.T) {
+	cases := []struct {
+		principal string
+		email     string
+		verified  bool
+		want      Match
+	}{
+		{principal: "alice@example.com", email: "alice@example.com", verified: true, want: MatchUser},
+		{principal: "Alice@Example.com", email: "alice@example.com", verified: true, want: MatchUser},
+		{principal: "*@example.com", email: "alice@example.com", verified: true, want: MatchDomain},
+		{principal: "*@EXAMPLE.com", email: "alice@example.com", verified: true, want: MatchDomain},
+		{principal: "*@example.com", email: "alice@example.org", verified: true, want: NoMatch},
+		{principal: "*@example.com", email: "alice@sub.example.com", verified: true, want: NoMatch},
+		{principal: "*", email: "alice@example.com", verified: true, want: MatchWildcard},
+		{principal: "*", email: "", verified: true, want: NoMatch},
+		{principal: "*@example.com", email: "", verified: true, want: NoMatch},
+		{principal: "*@example.com", email: "alice@example.com", verified: false, want: NoMatch},
+		{principal: "*", email: "alice@example.com", verified: false, want: MatchWildcard},
+		{principal: "alice@example.com", email: "alice@example.com", verified: false, want: MatchUser},
+		{principal: "bob@example.com", email: "alice@example.com", verified: true, want: NoMatch},
+	}
+	for _, tc := range cases {
+		t.Run(tc.principal+"/"+tc.email, func(t *testing.T) {
+			if got := Matches(tc.principal, tc.email, tc.verified); got != tc.want {
+				t.Fatalf("Matches(%q, %q, %v) = %v, want %v", tc.principal, tc.email, tc.verified, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestBestPrefersTheMostSpecificPrincipal(t *testing.T) {
+	got, match := Best([]string{"*", "*@example.com", "alice@example.com"}, "alice@example.com", true)
+	if got != "alice@example.com" || match != MatchUser {
+		t.Fatalf("Best = %q, %v, want the user principal", got, match)
+	}
+	got, match = Best([]string{"*", "*@example.com"}, "bob@example.com", true)
+	if got != "*@example.com" || match != MatchDomain {
+		t.Fatalf("Best = %q, %v, want the domain principal", got, match)
+	}
+	if _, match = Best([]string{"*@example.org"}, "bob@example.com", true); match != NoMatch {
+		t.Fatalf("Best matched %v, want NoMatch", match)
+	}
+	got, match = Best([]string{"*", "*@example.com"}, "bob@example.com", false)
+	if got != "*" || match != MatchWildcard {
+		t.Fatalf("Best = %q, %v, want the wildcard for an unverified email", got, match)
+	}
+}
+
+func TestDomain(t *testing.T) {
+	for _, p := range []string{"*@", "*@bad domain", "example.com", "alice@example.com", "*@a@b"} {
+		if _, ok := Domain(p); ok {
+			t.Errorf("Domain(%q) accepted an invalid principal", p)
+		}
+	}
+	if domain, ok := Domain("*@example.com"); !ok || domain != "example.com" {
+		t.Fatalf("Domain(*@example.com) = %q, %v", domain, ok)
+	}
+}
+
+func TestGroupRoundTrip(t *testing.T) {
+	for _, p := range []string{"*", "*@example.com"} {
+		group, ok := Group(p)
+		if !ok {
+			t.Fatalf("Group(%q) rejected a pattern principal", p)
+		}
+		if back, ok := FromGroup(group); !ok || back != p {
+			t.Fatalf("FromGroup(%q) = %q, %v, want %q", group, back, ok, p)
+		}
+	}
+	if group, _ := Group("*@Example.COM"); group != "holos:email-domain:example.com" {
+		t.Fatalf("expected the domain group to be lower-cased, got %q", group)
+	}
+	if _, ok := Group("alice@example.com"); ok {
+		t.Fatal("expected an email principal to have no group")
+	}
+	if _, ok := FromGroup("oidc:holos:authenticated"); ok {
+		t.Fatal("expected an OIDC group not to map to a pattern principal")
+	}
+}
+
+func TestGroups(t *testing.T) {
+	if got := Groups("alice@Example.com", true); !slices.Equal(got, []string{AuthenticatedGroup, "holos:email-domain:example.com"}) {
+		t.Fatalf("verified Groups = %v", got)
+	}
+	if got := Groups("alice@example.com", false); !slices.Equal(got, []string{AuthenticatedGroup}) {
+		t.Fatalf("unverified Groups = %v, want only the authenticated group", got)
+	}
+}
@@ -0,0 +1,157 @@
+package projects
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strconv"
+	"time"
+
+	"connectrpc.com/connect"
+	"go.opentelemetry.io/otel/attribute"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/holos-run/holos-console/console/rpc"
+	"github.com/holos-run/holos-console/console/tracing"
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+)
+
+const (
+	// defaultEventPageSize is applied when the request does not set a page size.
+	defaultEventPageSize = 50
+	// maxEventPageSize caps the number of events returned in a single response.
+	maxEventPageSize = 500
+)
+
+// ListEvents returns the Events in the project namespace. Events are read
+// with the service account: callers are authorized by project read access,
+// which project viewers hold without any RBAC on events.
+func (c *K8sClient) ListEvents(ctx context.Context, project string) ([]corev1.Event, error) {
+	ctx, span := tracing.Start(ctx, "projects.K8sClient.ListEvents", attribute.String("project", project))
+	defer span.End()
+	list, err := c.client.CoreV1().Events(c.Resolver.ProjectNamespace(project)).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// eventTime is when an event most recently occurred. Events recorded with
+// the events.k8s.io API set only eventTime, and series updates leave
+// lastTimestamp unset.
+func eventTime(e *corev1.Event) time.Time {
+	switch {
+	case !e.LastTimestamp.IsZero():
+		return e.LastTimestamp.Time
+	case e.Series != nil && !e.Series.LastObservedTime.IsZero():
+		return e.Series.LastObservedTime.Time
+	case !e.EventTime.IsZero():
+		return e.EventTime.Time
+	case !e.FirstTimestamp.IsZero():
+		return e.FirstTimestamp.Time
+	}
+	return e.CreationTimestamp.Time
+}
+
+func toProjectEvent(e *corev1.Event) *consolev1.ProjectEvent {
+	first := e.FirstTimestamp.Time
+	if first.IsZero() {
+		first = cmp.Or(e.EventTime.Time, e.CreationTimestamp.Time)
+	}
+	count := e.Count
+	if e.Series != nil {
+		count = max(count, e.Series.Count)
+	}
+	return &consolev1.ProjectEvent{
+		Type:         e.Type,
+		Reason:       e.Reason,
+		Message:      e.Message,
+		InvolvedKind: e.InvolvedObject.Kind,
+		InvolvedName: e.InvolvedObject.Name,
+		Count:        max(count, 1),
+		Source:       cmp.Or(e.ReportingController, e.Source.Component),
+		FirstSeen:    first.UTC().Format(time.RFC3339),
+		LastSeen:     eventTime(e).UTC().Format(time.RFC3339),
+	}
+}
+
+// ListProjectEvents returns a page of the project's Events, most recent
+// first. Page tokens are offsets into the filtered, sorted list, so events
+// recorded between calls may shift entries across page boundaries.
+func (h *Handler) ListProjectEvents(
+	ctx context.Context,
+	req *connect.Request[consolev1.ListProjectEventsRequest],
+) (*connect.Response[consolev1.ListProjectEventsResponse], error) {
+	msg := req.Msg
+	if msg.Project == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("project is required"))
+	}
+	if msg.PageSize < 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("page_size must not be negative"))
+	}
+	offset := 0
+	if msg.PageToken != "" {
+		var err error
+		if offset, err = strconv.Atoi(msg.PageToken); err != nil || offset < 0 {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid page_token"))
+		}
+	}
+
+	claims := rpc.MustClaims(ctx)
+
+	// Reading the project namespace as the caller is the project read check.
+	if _, err := h.k8s.GetProject(ctx, msg.Project); err != nil {
+		return nil, mapK8sError(err)
+	}
+	items, err := h.k8s.ListEvents(ctx, msg.Project)
+	if err != nil {
+		return nil, mapK8sError(err)
+	}
+
+	matched := make([]*corev1.Event, 0, len(items))
+	for i := range items {
+		e := &items[i]
+		if len(msg.Types) > 0 && !slices.Contains(msg.Types, e.Type) {
+			continue
+		}
+		if msg.InvolvedKind != "" && e.InvolvedObject.Kind != msg.InvolvedKind {
+			continue
+		}
+		if msg.InvolvedName != "" && e.InvolvedObject.Name != msg.InvolvedName {
+			continue
+		}
+		matched = append(matched, e)
+	}
+	slices.SortStableFunc(matched, func(a, b *corev1.Event) int {
+		return cmp.Or(eventTime(b).Compare(eventTime(a)), cmp.Compare(a.Name, b.Name))
+	})
+
+	pageSize := int(msg.PageSize)
+	if pageSize == 0 {
+		pageSize = defaultEventPageSize
+	}
+	pageSize = min(pageSize, maxEventPageSize)
+	resp := &consolev1.ListProjectEventsResponse{}
+	if offset < len(matched) {
+		end := min(offset+pageSize, len(matched))
+		for _, e := range matched[offset:end] {
+			resp.Events = append(resp.Events, toProjectEvent(e))
+		}
+		if end < len(matched) {
+			resp.NextPageToken = strconv.Itoa(end)
+		}
+	}
+
+	slog.InfoContext(ctx, "project events listed",
+		slog.String("action", "project_events_list"),
+		slog.String("resource_type", auditResourceType),
+		slog.String("project", msg.Project),
+		slog.String("sub", claims.Sub),
+		slog.String("email", claims.Email),
+		slog.Int("count", len(resp.Events)),
+	)
+	return connect.NewResponse(resp), nil
+}
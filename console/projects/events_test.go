@@ -0,0 +1,127 @@
+package projects
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+)
+
+func newEventsHandler(t *testing.T) *Handler {
+	t.Helper()
+	base := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	event := func(name, typ, kind, object string, minutes int) *corev1.Event {
+		return &corev1.Event{
+			ObjectMeta:     metav1.ObjectMeta{Name: name, Namespace: "holos-prj-billing"},
+			Type:           typ,
+			Reason:         "Reason-" + name,
+			InvolvedObject: corev1.ObjectReference{Kind: kind, Name: object},
+			Count:          2,
+			Source:         corev1.EventSource{Component: "kubelet"},
+			FirstTimestamp: metav1.NewTime(base),
+			LastTimestamp:  metav1.NewTime(base.Add(time.Duration(minutes) * time.Minute)),
+		}
+	}
+	objs := []runtime.Object{
+		managedNS("billing", ""),
+		event("scaled", corev1.EventTypeNormal, "Deployment", "api", 1),
+		event("backoff", corev1.EventTypeWarning, "Pod", "api-7d9f", 5),
+		event("pulled", corev1.EventTypeNormal, "Pod", "api-7d9f", 3),
+		event("unhealthy", corev1.EventTypeWarning, "Pod", "db-0", 4),
+		// Recorded with the events.k8s.io API: only eventTime is set.
+		&corev1.Event{
+			ObjectMeta:          metav1.ObjectMeta{Name: "modern", Namespace: "holos-prj-billing"},
+			Type:                corev1.EventTypeNormal,
+			Reason:              "Reason-modern",
+			InvolvedObject:      corev1.ObjectReference{Kind: "StatefulSet", Name: "db"},
+			EventTime:           metav1.NewMicroTime(base.Add(2 * time.Minute)),
+			ReportingController: "statefulset-controller",
+		},
+	}
+	handler, _ := newHandler()
+	handler.k8s = NewK8sClient(fake.NewClientset(objs...), testResolver())
+	return handler
+}
+
+func listEvents(t *testing.T, h *Handler, req *consolev1.ListProjectEventsRequest) *consolev1.ListProjectEventsResponse {
+	t.Helper()
+	resp, err := h.ListProjectEvents(contextWithClaims("alice@example.com"), connect.NewRequest(req))
+	if err != nil {
+		t.Fatalf("ListProjectEvents: %v", err)
+	}
+	return resp.Msg
+}
+
+func reasons(events []*consolev1.ProjectEvent) string {
+	var out []string
+	for _, e := range events {
+		out = append(out, e.Reason)
+	}
+	return fmt.Sprint(out)
+}
+
+func TestListProjectEvents_SortsAndPaginates(t *testing.T) {
+	h := newEventsHandler(t)
+
+	first := listEvents(t, h, &consolev1.ListProjectEventsRequest{Project: "billing", PageSize: 3})
+	if got := reasons(first.Events); got != "[Reason-backoff Reason-unhealthy Reason-pulled]" {
+		t.Fatalf("unexpected first page %s", got)
+	}
+	if first.NextPageToken == "" {
+		t.Fatal("expected a next page token")
+	}
+	second := listEvents(t, h, &consolev1.ListProjectEventsRequest{Project: "billing", PageSize: 3, PageToken: first.NextPageToken})
+	if got := reasons(second.Events); got != "[Reason-modern Reason-scaled]" {
+		t.Fatalf("unexpected second page %s", got)
+	}
+	if second.NextPageToken != "" {
+		t.Errorf("expected the last page to have no next token, got %q", second.NextPageToken)
+	}
+	modern := second.Events[0]
+	if modern.Source != "statefulset-controller" || modern.Count != 1 || modern.LastSeen != "2026-10-01T12:02:00Z" {
+		t.Errorf("expected eventTime-only event to be normalized, got %+v", modern)
+	}
+}
+
+func TestListProjectEvents_Filters(t *testing.T) {
+	h := newEventsHandler(t)
+	warnings := listEvents(t, h, &consolev1.ListProjectEventsRequest{Project: "billing", Types: []string{corev1.EventTypeWarning}})
+	if got := reasons(warnings.Events); got != "[Reason-backoff Reason-unhealthy]" {
+		t.Errorf("unexpected warnings %s", got)
+	}
+	pod := listEvents(t, h, &consolev1.ListProjectEventsRequest{Project: "billing", InvolvedKind: "Pod", InvolvedName: "api-7d9f"})
+	if got := reasons(pod.Events); got != "[Reason-backoff Reason-pulled]" {
+		t.Errorf("unexpected pod events %s", got)
+	}
+}
+
+func TestListProjectEvents_Errors(t *testing.T) {
+	h := newEventsHandler(t)
+	cases := []struct {
+		name     string
+		ctx      context.Context
+		req      *consolev1.ListProjectEventsRequest
+		wantCode connect.Code
+	}{
+		{"missing project", contextWithClaims("alice@example.com"), &consolev1.ListProjectEventsRequest{}, connect.CodeInvalidArgument},
+		{"bad page token", contextWithClaims("alice@example.com"), &consolev1.ListProjectEventsRequest{Project: "billing", PageToken: "abc"}, connect.CodeInvalidArgument},
+		{"negative page size", contextWithClaims("alice@example.com"), &consolev1.ListProjectEventsRequest{Project: "billing", PageSize: -1}, connect.CodeInvalidArgument},
+		{"unknown project", contextWithClaims("alice@example.com"), &consolev1.ListProjectEventsRequest{Project: "payroll"}, connect.CodeNotFound},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := h.ListProjectEvents(tc.ctx, connect.NewRequest(tc.req))
+			if connect.CodeOf(err) != tc.wantCode {
+				t.Fatalf("expected %v, got %v", tc.wantCode, err)
+			}
+		})
+	}
+}
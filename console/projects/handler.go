@@ -15,10 +15,13 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	v1alpha2 "github.com/holos-run/holos-console/api/v1alpha2"
+	"github.com/holos-run/holos-console/console/listfilter"
+	"github.com/holos-run/holos-console/console/rawobject"
 	"github.com/holos-run/holos-console/console/rbac"
 	"github.com/holos-run/holos-console/console/resourcerbac"
 	"github.com/holos-run/holos-console/console/rpc"
 	"github.com/holos-run/holos-console/console/secrets"
+	"github.com/holos-run/holos-console/console/validation"
 	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
 	"github.com/holos-run/holos-console/gen/holos/console/v1/consolev1connect"
 )
@@ -89,6 +92,7 @@ type OrgDefaultShareResolver interface {
 type Handler struct {
 	consolev1connect.UnimplementedProjectServiceHandler
 	k8s         *K8sClient
+	authorizer  rbac.Authorizer
 	orgResolver OrgResolver
 	// projectNSPipeline wires the HOL-812 resolve → render → apply flow.
 	// Nil (the default) falls through to the existing Namespace-create
@@ -100,6 +104,12 @@ type Handler struct {
 	// console/templates (which would form an import cycle with the
 	// deployments tests that import console/projects).
 	projectNSPipeline ProjectNamespacePipeline
+	// trashRetention enables soft deletion when positive. See
+	// WithTrashRetention.
+	trashRetention time.Duration
+	// platformOwners may force sharing updates that leave a project without
+	// an active owner.
+	platformOwners rpc.PlatformOwners
 }
 
 // NewHandler creates a new ProjectService handler.
@@ -107,6 +117,13 @@ func NewHandler(k8s *K8sClient, orgResolver OrgResolver) *Handler {
 	return &Handler{k8s: k8s, orgResolver: orgResolver}
 }
 
+// WithPlatformOwners sets the platform owners, who may force sharing
+// updates that leave a project without an active owner.
+func (h *Handler) WithPlatformOwners(owners rpc.PlatformOwners) *Handler {
+	h.platformOwners = owners
+	return h
+}
+
 // WithProjectNamespacePipeline wires the HOL-812 resolve → render →
 // apply pipeline into CreateProject. Passing a nil interface value
 // leaves the handler on the existing Namespace-create path. Callers
@@ -124,9 +141,14 @@ func (h *Handler) ListProjects(
 	ctx context.Context,
 	req *connect.Request[consolev1.ListProjectsRequest],
 ) (*connect.Response[consolev1.ListProjectsResponse], error) {
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	claims := rpc.MustClaims(ctx)
+	filter, err := listfilter.New("filter", req.Msg.Filter)
+	if err != nil {
+		return nil, err
+	}
+	order, err := listfilter.NewOrder("order_by", req.Msg.OrderBy)
+	if err != nil {
+		return nil, err
 	}
 
 	// Resolve parent namespace filter when parent_type+parent_name are set.
@@ -143,14 +165,42 @@ func (h *Handler) ListProjects(
 	if err != nil {
 		return nil, mapK8sError(err)
 	}
+	// The tag is derived from the namespaces so an unchanged listing is
+	// answered before the caller's role on each project is evaluated.
+	versions := make([]string, 0, len(allProjects))
+	for _, ns := range allProjects {
+		versions = append(versions, rpc.ObjectVersion(ns))
+	}
+	etag, err := rpc.ReadETag(claims, req.Msg, versions...)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if rpc.NotModified(req.Msg.IfNoneMatch, etag) {
+		res := connect.NewResponse(&consolev1.ListProjectsResponse{Etag: etag, NotModified: true})
+		rpc.SetETag(res.Header(), etag)
+		return res, nil
+	}
 
+	listfilter.Sort(order, allProjects, func(ns *corev1.Namespace) listfilter.SortKey {
+		return listfilter.KeyOf(ns.Name, ns)
+	})
 	var result []*consolev1.Project
 	for _, ns := range allProjects {
 		shareUsers, _ := GetShareUsers(ns)
 		shareRoles, _ := GetShareRoles(ns)
 
-		userRole := h.effectiveRoleForNamespace(ctx, claims, ns, shareUsers, shareRoles)
-		result = append(result, h.buildProject(ns, shareUsers, shareRoles, userRole))
+		project := h.projectForCaller(ctx, claims, ns, shareUsers, shareRoles)
+		if !filter.Match(listfilter.Item{
+			Name:        project.Name,
+			DisplayName: project.DisplayName,
+			Description: project.Description,
+			Labels:      ns.Labels,
+			Role:        project.UserRole,
+			Accessible:  true,
+		}) {
+			continue
+		}
+		result = append(result, project)
 	}
 
 	slog.InfoContext(ctx, "projects listed",
@@ -162,9 +212,9 @@ func (h *Handler) ListProjects(
 		slog.Int("total", len(result)),
 	)
 
-	return connect.NewResponse(&consolev1.ListProjectsResponse{
-		Projects: result,
-	}), nil
+	res := connect.NewResponse(&consolev1.ListProjectsResponse{Projects: result, Etag: etag})
+	rpc.SetETag(res.Header(), etag)
+	return res, nil
 }
 
 // GetProject retrieves a project by name.
@@ -172,27 +222,15 @@ func (h *Handler) GetProject(
 	ctx context.Context,
 	req *connect.Request[consolev1.GetProjectRequest],
 ) (*connect.Response[consolev1.GetProjectResponse], error) {
-	if req.Msg.Name == "" {
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("project name is required"))
-	}
-
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
-	}
+	claims := rpc.MustClaims(ctx)
 
 	ns, err := h.k8s.GetProject(ctx, req.Msg.Name)
 	if err != nil {
 		return nil, mapK8sError(err)
 	}
 
-	shareUsers, _ := GetShareUsers(ns)
-	shareRoles, _ := GetShareRoles(ns)
-
 	org := GetOrganization(ns)
 
-	userRole := h.effectiveRoleForNamespace(ctx, claims, ns, shareUsers, shareRoles)
-
 	slog.InfoContext(ctx, "project accessed",
 		slog.String("action", "project_read"),
 		slog.String("resource_type", auditResourceType),
@@ -202,9 +240,19 @@ func (h *Handler) GetProject(
 		slog.String("email", claims.Email),
 	)
 
-	return connect.NewResponse(&consolev1.GetProjectResponse{
-		Project: h.buildProject(ns, shareUsers, shareRoles, userRole),
-	}), nil
+	etag, err := rpc.ReadETag(claims, req.Msg, rpc.ObjectVersion(ns))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	resp := &consolev1.GetProjectResponse{Etag: etag, NotModified: true}
+	if !rpc.NotModified(req.Msg.IfNoneMatch, etag) {
+		shareUsers, _ := GetShareUsers(ns)
+		shareRoles, _ := GetShareRoles(ns)
+		resp = &consolev1.GetProjectResponse{Project: h.projectForCaller(ctx, claims, ns, shareUsers, shareRoles), Etag: etag}
+	}
+	res := connect.NewResponse(resp)
+	rpc.SetETag(res.Header(), etag)
+	return res, nil
 }
 
 // CreateProject creates a new project.
@@ -214,6 +262,13 @@ func (h *Handler) CreateProject(
 	ctx context.Context,
 	req *connect.Request[consolev1.CreateProjectRequest],
 ) (*connect.Response[consolev1.CreateProjectResponse], error) {
+	var v validation.Violations
+	v.UserGrants("user_grants", req.Msg.UserGrants)
+	v.RoleGrants("role_grants", req.Msg.RoleGrants)
+	if err := v.Err(); err != nil {
+		return nil, err
+	}
+
 	// Derive name from display_name when not explicitly provided.
 	name := req.Msg.Name
 	autoGenerated := name == ""
@@ -222,9 +277,6 @@ func (h *Handler) CreateProject(
 		return h.k8s.NamespaceExists(ctx, nsName)
 	}
 	if autoGenerated {
-		if req.Msg.DisplayName == "" {
-			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("project name or display_name is required"))
-		}
 		generated, err := v1alpha2.GenerateIdentifier(ctx, req.Msg.DisplayName, prefix, exists)
 		if err != nil {
 			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("generating project identifier: %w", err))
@@ -232,9 +284,9 @@ func (h *Handler) CreateProject(
 		name = generated
 	}
 
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	claims := rpc.MustClaims(ctx)
+	if req.Msg.DryRun {
+		ctx = rpc.ContextWithDryRun(ctx)
 	}
 
 	if err := validateOrganizationProjectParent(req.Msg.ParentType, req.Msg.ParentName, req.Msg.Organization); err != nil {
@@ -248,7 +300,7 @@ func (h *Handler) CreateProject(
 		}
 		parentShareUsers, _ := GetShareUsers(parentNamespace)
 		parentShareRoles, _ := GetShareRoles(parentNamespace)
-		if err := h.requireNamespaceOwner(ctx, claims, parentNamespace, parentShareUsers, parentShareRoles, "create projects"); err != nil {
+		if err := h.requireNamespaceOwner(ctx, claims, parentNamespace, parentShareUsers, parentShareRoles, rbac.PermissionProjectsCreate, "create projects"); err != nil {
 			return nil, err
 		}
 	}
@@ -321,6 +373,7 @@ func (h *Handler) CreateProject(
 		slog.String("organization", req.Msg.Organization),
 		slog.String("sub", claims.Sub),
 		slog.String("email", claims.Email),
+		slog.Bool("dry_run", req.Msg.DryRun),
 	)
 
 	return connect.NewResponse(&consolev1.CreateProjectResponse{
@@ -374,6 +427,14 @@ func (h *Handler) createProjectOnce(
 		baseNs.Annotations[v1alpha2.AnnotationCreatorSubject] = creatorSubject
 	}
 
+	// A dry run submits the typed Namespace create with server-side dry-run
+	// so admission and validation run, then stops: the ProjectNamespace
+	// pipeline and RBAC bootstrap both require the namespace to exist.
+	if rpc.IsDryRun(ctx) {
+		_, err := h.k8s.client.CoreV1().Namespaces().Create(ctx, baseNs, metav1.CreateOptions{DryRun: rpc.DryRunFromContext(ctx)})
+		return err
+	}
+
 	// Pipeline (HOL-812): resolve ProjectNamespace bindings; if any
 	// match, render and apply via SSA. A nil pipeline falls through to
 	// the existing Namespace-create path so CreateProject keeps
@@ -493,13 +554,9 @@ func (h *Handler) UpdateProject(
 	ctx context.Context,
 	req *connect.Request[consolev1.UpdateProjectRequest],
 ) (*connect.Response[consolev1.UpdateProjectResponse], error) {
-	if req.Msg.Name == "" {
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("project name is required"))
-	}
-
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	claims := rpc.MustClaims(ctx)
+	if req.Msg.DryRun {
+		ctx = rpc.ContextWithDryRun(ctx)
 	}
 
 	ns, err := h.k8s.GetProject(ctx, req.Msg.Name)
@@ -537,11 +594,79 @@ func (h *Handler) UpdateProject(
 		slog.String("organization", org),
 		slog.String("sub", claims.Sub),
 		slog.String("email", claims.Email),
+		slog.Bool("dry_run", req.Msg.DryRun),
 	)
 
 	return connect.NewResponse(&consolev1.UpdateProjectResponse{}), nil
 }
 
+// DiffProject previews an UpdateProject: it reports the metadata fields the
+// proposed values would change without writing anything.
+func (h *Handler) DiffProject(
+	ctx context.Context,
+	req *connect.Request[consolev1.DiffProjectRequest],
+) (*connect.Response[consolev1.DiffProjectResponse], error) {
+	claims := rpc.MustClaims(ctx)
+
+	ns, err := h.k8s.GetProject(ctx, req.Msg.Name)
+	if err != nil {
+		return nil, mapK8sError(err)
+	}
+
+	org := GetOrganization(ns)
+
+	if (req.Msg.ParentType == nil) != (req.Msg.ParentName == nil) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("parent_type and parent_name must be set together"))
+	}
+
+	var changes []*consolev1.FieldChange
+	if req.Msg.DisplayName != nil {
+		changes = appendFieldChange(changes, "display_name", ns.Annotations[v1alpha2.AnnotationDisplayName], *req.Msg.DisplayName)
+	}
+	if req.Msg.Description != nil {
+		changes = appendFieldChange(changes, "description", ns.Annotations[v1alpha2.AnnotationDescription], *req.Msg.Description)
+	}
+	if req.Msg.ParentType != nil && req.Msg.ParentName != nil {
+		if err := validateOrganizationProjectParent(*req.Msg.ParentType, *req.Msg.ParentName, org); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		newParentNs, err := h.resolveParentNS(*req.Msg.ParentType, *req.Msg.ParentName)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		changes = appendFieldChange(changes, "parent", ns.Labels[v1alpha2.AnnotationParent], newParentNs)
+	}
+
+	slog.InfoContext(ctx, "project diffed",
+		slog.String("action", "project_diff"),
+		slog.String("resource_type", auditResourceType),
+		slog.String("project", req.Msg.Name),
+		slog.String("organization", org),
+		slog.String("sub", claims.Sub),
+		slog.String("email", claims.Email),
+		slog.Int("changes", len(changes)),
+	)
+
+	return connect.NewResponse(&consolev1.DiffProjectResponse{Changes: changes}), nil
+}
+
+// appendFieldChange appends the change from old to proposed to changes,
+// where an empty value means the field is unset. Equal values are skipped.
+func appendFieldChange(changes []*consolev1.FieldChange, field, old, proposed string) []*consolev1.FieldChange {
+	change := &consolev1.FieldChange{Field: field, OldValue: old, NewValue: proposed}
+	switch {
+	case old == proposed:
+		return changes
+	case old == "":
+		change.Change = consolev1.ChangeType_CHANGE_TYPE_ADDED
+	case proposed == "":
+		change.Change = consolev1.ChangeType_CHANGE_TYPE_REMOVED
+	default:
+		change.Change = consolev1.ChangeType_CHANGE_TYPE_MODIFIED
+	}
+	return append(changes, change)
+}
+
 // reparentProject validates and executes a project reparent operation.
 // Checks Owner-level permission on both source and destination parents
 // when the impersonated client is present (ADR 036), so the API server
@@ -608,6 +733,7 @@ func (h *Handler) reparentProject(
 		slog.String("to_parent", newParentNs),
 		slog.String("sub", claims.Sub),
 		slog.String("email", claims.Email),
+		slog.Bool("dry_run", rpc.IsDryRun(ctx)),
 	)
 
 	return nil
@@ -618,13 +744,9 @@ func (h *Handler) DeleteProject(
 	ctx context.Context,
 	req *connect.Request[consolev1.DeleteProjectRequest],
 ) (*connect.Response[consolev1.DeleteProjectResponse], error) {
-	if req.Msg.Name == "" {
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("project name is required"))
-	}
-
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	claims := rpc.MustClaims(ctx)
+	if req.Msg.DryRun {
+		ctx = rpc.ContextWithDryRun(ctx)
 	}
 
 	ns, err := h.k8s.GetProject(ctx, req.Msg.Name)
@@ -634,7 +756,17 @@ func (h *Handler) DeleteProject(
 
 	org := GetOrganization(ns)
 
-	if err := h.k8s.DeleteProject(ctx, req.Msg.Name); err != nil {
+	if h.trashRetention > 0 {
+		shareUsers, _ := GetShareUsers(ns)
+		shareRoles, _ := GetShareRoles(ns)
+		if err := h.requireNamespaceOwner(ctx, claims, ns, shareUsers, shareRoles, rbac.PermissionProjectsAdmin, "delete project"); err != nil {
+			return nil, err
+		}
+		err = h.k8s.TrashProject(ctx, ns, claims.Email)
+	} else {
+		err = h.k8s.DeleteProject(ctx, req.Msg.Name)
+	}
+	if err != nil {
 		return nil, mapK8sError(err)
 	}
 
@@ -645,6 +777,8 @@ func (h *Handler) DeleteProject(
 		slog.String("organization", org),
 		slog.String("sub", claims.Sub),
 		slog.String("email", claims.Email),
+		slog.Bool("dry_run", req.Msg.DryRun),
+		slog.Bool("soft", h.trashRetention > 0),
 	)
 
 	return connect.NewResponse(&consolev1.DeleteProjectResponse{}), nil
@@ -655,13 +789,16 @@ func (h *Handler) UpdateProjectSharing(
 	ctx context.Context,
 	req *connect.Request[consolev1.UpdateProjectSharingRequest],
 ) (*connect.Response[consolev1.UpdateProjectSharingResponse], error) {
-	if req.Msg.Name == "" {
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("project name is required"))
+	var v validation.Violations
+	v.UserGrants("user_grants", req.Msg.UserGrants)
+	v.RoleGrants("role_grants", req.Msg.RoleGrants)
+	if err := v.Err(); err != nil {
+		return nil, err
 	}
 
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	claims := rpc.MustClaims(ctx)
+	if req.Msg.DryRun {
+		ctx = rpc.ContextWithDryRun(ctx)
 	}
 
 	ns, err := h.k8s.GetProject(ctx, req.Msg.Name)
@@ -670,7 +807,7 @@ func (h *Handler) UpdateProjectSharing(
 	}
 	shareUsers, _ := GetShareUsers(ns)
 	shareRoles, _ := GetShareRoles(ns)
-	if err := h.requireNamespaceOwner(ctx, claims, ns, shareUsers, shareRoles, "project sharing update"); err != nil {
+	if err := h.requireNamespaceOwner(ctx, claims, ns, shareUsers, shareRoles, rbac.PermissionProjectsAdmin, "project sharing update"); err != nil {
 		return nil, err
 	}
 
@@ -678,6 +815,9 @@ func (h *Handler) UpdateProjectSharing(
 
 	newShareUsers := shareGrantsToAnnotations(req.Msg.UserGrants)
 	newShareRoles := shareGrantsToAnnotations(req.Msg.RoleGrants)
+	if err := secrets.RequireActiveOwner(claims, h.platformOwners, "project "+req.Msg.Name, newShareUsers, newShareRoles, req.Msg.Force); err != nil {
+		return nil, err
+	}
 
 	storedCreator := secrets.UserIdentity{
 		Email:   ns.Annotations[v1alpha2.AnnotationCreatorEmail],
@@ -701,14 +841,15 @@ func (h *Handler) UpdateProjectSharing(
 		slog.String("organization", org),
 		slog.String("sub", claims.Sub),
 		slog.String("email", claims.Email),
+		slog.Bool("dry_run", req.Msg.DryRun),
+		slog.Bool("force", req.Msg.Force),
 	)
 
 	updatedUsers, _ := GetShareUsers(updated)
 	updatedRoles, _ := GetShareRoles(updated)
-	userRole := h.effectiveRoleForNamespace(ctx, claims, updated, updatedUsers, updatedRoles)
 
 	return connect.NewResponse(&consolev1.UpdateProjectSharingResponse{
-		Project: h.buildProject(updated, updatedUsers, updatedRoles, userRole),
+		Project: h.projectForCaller(ctx, claims, updated, updatedUsers, updatedRoles),
 	}), nil
 }
 
@@ -717,14 +858,14 @@ func (h *Handler) UpdateProjectDefaultSharing(
 	ctx context.Context,
 	req *connect.Request[consolev1.UpdateProjectDefaultSharingRequest],
 ) (*connect.Response[consolev1.UpdateProjectDefaultSharingResponse], error) {
-	if req.Msg.Name == "" {
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("project name is required"))
+	var v validation.Violations
+	v.UserGrants("default_user_grants", req.Msg.DefaultUserGrants)
+	v.RoleGrants("default_role_grants", req.Msg.DefaultRoleGrants)
+	if err := v.Err(); err != nil {
+		return nil, err
 	}
 
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
-	}
+	claims := rpc.MustClaims(ctx)
 
 	ns, err := h.k8s.GetProject(ctx, req.Msg.Name)
 	if err != nil {
@@ -732,7 +873,7 @@ func (h *Handler) UpdateProjectDefaultSharing(
 	}
 	shareUsers, _ := GetShareUsers(ns)
 	shareRoles, _ := GetShareRoles(ns)
-	if err := h.requireNamespaceOwner(ctx, claims, ns, shareUsers, shareRoles, "project default sharing update"); err != nil {
+	if err := h.requireNamespaceOwner(ctx, claims, ns, shareUsers, shareRoles, rbac.PermissionProjectsAdmin, "project default sharing update"); err != nil {
 		return nil, err
 	}
 
@@ -757,26 +898,18 @@ func (h *Handler) UpdateProjectDefaultSharing(
 
 	updatedShareUsers, _ := GetShareUsers(updated)
 	updatedShareRoles, _ := GetShareRoles(updated)
-	userRole := h.effectiveRoleForNamespace(ctx, claims, updated, updatedShareUsers, updatedShareRoles)
 
 	return connect.NewResponse(&consolev1.UpdateProjectDefaultSharingResponse{
-		Project: h.buildProject(updated, updatedShareUsers, updatedShareRoles, userRole),
+		Project: h.projectForCaller(ctx, claims, updated, updatedShareUsers, updatedShareRoles),
 	}), nil
 }
 
-// GetProjectRaw retrieves the full Kubernetes Namespace object as verbatim JSON.
+// GetProjectRaw retrieves the full Kubernetes Namespace object as JSON or YAML.
 func (h *Handler) GetProjectRaw(
 	ctx context.Context,
 	req *connect.Request[consolev1.GetProjectRawRequest],
 ) (*connect.Response[consolev1.GetProjectRawResponse], error) {
-	if req.Msg.Name == "" {
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("project name is required"))
-	}
-
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
-	}
+	claims := rpc.MustClaims(ctx)
 
 	ns, err := h.k8s.GetProject(ctx, req.Msg.Name)
 	if err != nil {
@@ -798,9 +931,9 @@ func (h *Handler) GetProjectRaw(
 	ns.APIVersion = "v1"
 	ns.Kind = "Namespace"
 
-	raw, err := json.Marshal(ns)
+	raw, err := rawobject.Marshal(ns, req.Msg.Format, req.Msg.StripManagedFields)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("marshaling namespace to JSON: %w", err))
+		return nil, err
 	}
 
 	return connect.NewResponse(&consolev1.GetProjectRawResponse{
@@ -813,14 +946,7 @@ func (h *Handler) CheckProjectIdentifier(
 	ctx context.Context,
 	req *connect.Request[consolev1.CheckProjectIdentifierRequest],
 ) (*connect.Response[consolev1.CheckProjectIdentifierResponse], error) {
-	if req.Msg.Identifier == "" {
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("identifier is required"))
-	}
-
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
-	}
+	claims := rpc.MustClaims(ctx)
 
 	prefix := h.k8s.Resolver.NamespacePrefix + h.k8s.Resolver.ProjectPrefix
 	exists := func(ctx context.Context, nsName string) (bool, error) {
@@ -848,25 +974,57 @@ func (h *Handler) CheckProjectIdentifier(
 	}), nil
 }
 
-func (h *Handler) effectiveRoleForNamespace(ctx context.Context, claims *rpc.Claims, ns *corev1.Namespace, shareUsers, shareRoles []secrets.AnnotationGrant) rbac.Role {
-	if rpc.HasImpersonatedClients(ctx) {
-		if ok, err := h.k8s.canVerbNamespace(ctx, "delete", ns.Name); err == nil && ok {
-			return rbac.RoleOwner
-		}
-		if ok, err := h.k8s.canVerbNamespace(ctx, "update", ns.Name); err == nil && ok {
-			return rbac.RoleEditor
-		}
-		if ok, err := h.k8s.canVerbNamespace(ctx, "get", ns.Name); err == nil && ok {
-			return rbac.RoleViewer
-		}
-		return rbac.RoleUnspecified
+// namespaceResource describes ns and its active share grants to the
+// authorizer.
+func namespaceResource(ns *corev1.Namespace, shareUsers, shareRoles []secrets.AnnotationGrant) *rbac.Resource {
+	now := time.Now()
+	return &rbac.Resource{
+		Namespace: ns.Name,
+		Users:     secrets.ActiveGrantsMap(shareUsers, now),
+		Roles:     secrets.ActiveGrantsMap(shareRoles, now),
 	}
-	return rbac.BestRoleFromGrants(
-		claims.Email,
-		claims.Roles,
-		secrets.ActiveGrantsMap(shareUsers, time.Now()),
-		secrets.ActiveGrantsMap(shareRoles, time.Now()),
-	)
+}
+
+// projectForCaller builds the Project ns describes with the caller's
+// effective role on it and the grant that role comes from. The
+// organization's grants are read only when the project's own grants do not
+// explain the role.
+func (h *Handler) projectForCaller(ctx context.Context, claims *rpc.Claims, ns *corev1.Namespace, shareUsers, shareRoles []secrets.AnnotationGrant) *consolev1.Project {
+	userRole := h.effectiveRoleForNamespace(ctx, claims, ns, shareUsers, shareRoles)
+	p := h.buildProject(ns, shareUsers, shareRoles, userRole)
+	res := namespaceResource(ns, shareUsers, shareRoles)
+	scopes := []rbac.GrantScope{{
+		ResourceType: v1alpha2.ResourceTypeProject,
+		ResourceName: p.Name,
+		Users:        res.Users,
+		Roles:        res.Roles,
+	}}
+	p.UserRoleSource = rbac.RoleSourceFromGrants(userRole, claims.Email, claims.EmailVerified, claims.Roles, scopes...)
+	if p.UserRoleSource.GetType() != consolev1.RoleSourceType_ROLE_SOURCE_TYPE_PLATFORM || h.orgResolver == nil || p.Organization == "" {
+		return p
+	}
+	orgUsers, orgRoles, err := h.orgResolver.GetOrgGrants(ctx, p.Organization)
+	if err != nil {
+		slog.WarnContext(ctx, "failed to resolve org grants, attributing the project role to the platform",
+			slog.String("project", p.Name),
+			slog.String("organization", p.Organization),
+			slog.Any("error", err),
+		)
+		return p
+	}
+	scopes = append(scopes, rbac.GrantScope{
+		ResourceType: v1alpha2.ResourceTypeOrganization,
+		ResourceName: p.Organization,
+		Users:        orgUsers,
+		Roles:        orgRoles,
+	})
+	p.UserRoleSource = rbac.RoleSourceFromGrants(userRole, claims.Email, claims.EmailVerified, claims.Roles, scopes...)
+	return p
+}
+
+func (h *Handler) effectiveRoleForNamespace(ctx context.Context, claims *rpc.Claims, ns *corev1.Namespace, shareUsers, shareRoles []secrets.AnnotationGrant) rbac.Role {
+	role, _ := rbac.EffectiveRole(ctx, claims, namespaceResource(ns, shareUsers, shareRoles))
+	return role
 }
 
 // requireReparentOwner enforces Owner-level permission on a parent namespace
@@ -884,24 +1042,18 @@ func (h *Handler) requireReparentOwner(ctx context.Context, claims *rpc.Claims,
 	}
 	parentShareUsers, _ := GetShareUsers(parent)
 	parentShareRoles, _ := GetShareRoles(parent)
-	return h.requireNamespaceOwner(ctx, claims, parent, parentShareUsers, parentShareRoles, action)
+	return h.requireNamespaceOwner(ctx, claims, parent, parentShareUsers, parentShareRoles, rbac.PermissionProjectsCreate, action)
 }
 
-func (h *Handler) requireNamespaceOwner(ctx context.Context, claims *rpc.Claims, ns *corev1.Namespace, shareUsers, shareRoles []secrets.AnnotationGrant, action string) error {
-	if rpc.HasImpersonatedClients(ctx) {
-		ok, err := h.k8s.canVerbNamespace(ctx, "delete", ns.Name)
-		if err != nil {
-			return mapK8sError(err)
-		}
-		if ok {
-			return nil
-		}
-		return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("RBAC: not authorized to %s", action))
-	}
-	if h.effectiveRoleForNamespace(ctx, claims, ns, shareUsers, shareRoles) == rbac.RoleOwner {
+func (h *Handler) requireNamespaceOwner(ctx context.Context, claims *rpc.Claims, ns *corev1.Namespace, shareUsers, shareRoles []secrets.AnnotationGrant, permission rbac.Permission, action string) error {
+	err := h.authorizer.Authorize(ctx, claims, namespaceResource(ns, shareUsers, shareRoles), permission)
+	if err == nil {
 		return nil
 	}
-	return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("RBAC: not authorized to %s", action))
+	if connect.CodeOf(err) == connect.CodePermissionDenied {
+		return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("RBAC: not authorized to %s", action))
+	}
+	return mapK8sError(err)
 }
 
 // buildProject creates a Project proto message from a namespace.
@@ -932,22 +1084,13 @@ func (h *Handler) buildProject(ns *corev1.Namespace, shareUsers, shareRoles []se
 		}
 	}
 
-	// Fallback: derive project name from namespace if label is missing (pre-label namespaces)
+	// Namespaces created before the project label existed are labelled by
+	// `holos-console migrate`.
 	if p.Name == "" {
-		name, err := h.k8s.Resolver.ProjectFromNamespace(ns.GetName())
-		if err != nil {
-			slog.Warn("project namespace missing label and prefix mismatch",
-				slog.String("namespace", ns.GetName()),
-				slog.String("label", v1alpha2.LabelProject),
-				slog.Any("error", err),
-			)
-		} else {
-			p.Name = name
-			slog.Warn("project namespace missing label, falling back to namespace parsing",
-				slog.String("namespace", ns.GetName()),
-				slog.String("label", v1alpha2.LabelProject),
-			)
-		}
+		slog.Warn("project namespace missing label; run holos-console migrate",
+			slog.String("namespace", ns.GetName()),
+			slog.String("label", v1alpha2.LabelProject),
+		)
 	}
 
 	if ns.Annotations != nil {
@@ -963,6 +1106,7 @@ func (h *Handler) buildProject(ns *corev1.Namespace, shareUsers, shareRoles []se
 		p.DefaultRoleGrants = annotationGrantsToProto(defaultRoles)
 	}
 	p.CreatedAt = ns.CreationTimestamp.UTC().Format(time.RFC3339)
+	p.UpdatedAt = listfilter.LastModified(ns).UTC().Format(time.RFC3339)
 
 	return p
 }
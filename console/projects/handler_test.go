@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"log/slog"
+	"strings"
 	"sync/atomic"
 	"testing"
 
@@ -21,6 +22,7 @@ import (
 	v1alpha2 "github.com/holos-run/holos-console/api/v1alpha2"
 	"github.com/holos-run/holos-console/console/resolver"
 	"github.com/holos-run/holos-console/console/rpc"
+	"github.com/holos-run/holos-console/console/rpc/rpctesting"
 	"github.com/holos-run/holos-console/console/secrets"
 	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
 )
@@ -119,28 +121,13 @@ func newHandler(namespaces ...*corev1.Namespace) (*Handler, *testLogHandler) {
 
 // ---- ListProjects tests ----
 
-func TestListProjects_ReturnsUnauthenticatedWithoutClaims(t *testing.T) {
-	handler, _ := newHandler()
-	_, err := handler.ListProjects(context.Background(), connect.NewRequest(&consolev1.ListProjectsRequest{}))
-	if err == nil {
-		t.Fatal("expected error, got nil")
-	}
-	connectErr, ok := err.(*connect.Error)
-	if !ok {
-		t.Fatalf("expected *connect.Error, got %T", err)
-	}
-	if connectErr.Code() != connect.CodeUnauthenticated {
-		t.Errorf("expected CodeUnauthenticated, got %v", connectErr.Code())
-	}
-}
-
 // ---- GetProject tests ----
 
 func TestGetProject_RequiresProjectName(t *testing.T) {
 	handler, _ := newHandler()
 	ctx := contextWithClaims("alice@example.com")
 
-	_, err := handler.GetProject(ctx, connect.NewRequest(&consolev1.GetProjectRequest{Name: ""}))
+	_, err := rpctesting.Validated(ctx, connect.NewRequest(&consolev1.GetProjectRequest{Name: ""}), handler.GetProject)
 	if err == nil {
 		t.Fatal("expected error, got nil")
 	}
@@ -170,12 +157,6 @@ func TestGetProject_ReturnsNotFoundForMissing(t *testing.T) {
 	}
 }
 
-func TestGetProject_ReturnsUnauthenticatedWithoutClaims(t *testing.T) {
-	handler, _ := newHandler()
-	_, err := handler.GetProject(context.Background(), connect.NewRequest(&consolev1.GetProjectRequest{Name: "test"}))
-	assertUnauthenticated(t, err)
-}
-
 func TestGetProject_AuditLogIncludesOrganization(t *testing.T) {
 	ns := managedNSWithOrg("my-project", "my-org", `[{"principal":"alice@example.com","role":"viewer"}]`)
 	handler, logHandler := newHandler(ns)
@@ -195,6 +176,72 @@ func TestGetProject_AuditLogIncludesOrganization(t *testing.T) {
 	}
 }
 
+func TestProjectReads_NotModified(t *testing.T) {
+	ns := managedNSWithOrg("my-project", "my-org", `[{"principal":"alice@example.com","role":"viewer"}]`)
+	ns.ResourceVersion = "1"
+	handler, fakeClient := newHandlerWithOrgAndClient(nil, ns)
+	ctx := contextWithClaims("alice@example.com")
+	get := func(t *testing.T, ifNoneMatch string) *consolev1.GetProjectResponse {
+		t.Helper()
+		resp, err := handler.GetProject(ctx, connect.NewRequest(&consolev1.GetProjectRequest{Name: "my-project", IfNoneMatch: ifNoneMatch}))
+		if err != nil {
+			t.Fatalf("GetProject: %v", err)
+		}
+		return resp.Msg
+	}
+	list := func(t *testing.T, ifNoneMatch string) *consolev1.ListProjectsResponse {
+		t.Helper()
+		resp, err := handler.ListProjects(ctx, connect.NewRequest(&consolev1.ListProjectsRequest{Organization: "my-org", IfNoneMatch: ifNoneMatch}))
+		if err != nil {
+			t.Fatalf("ListProjects: %v", err)
+		}
+		return resp.Msg
+	}
+
+	got, listed := get(t, ""), list(t, "")
+	if got.Etag == "" || got.Project.GetName() != "my-project" || listed.Etag == "" || len(listed.Projects) != 1 {
+		t.Fatalf("expected full responses with etags, got %v and %v", got, listed)
+	}
+	if again := get(t, got.Etag); !again.NotModified || again.Project != nil || again.Etag != got.Etag {
+		t.Errorf("expected a not-modified project, got %v", again)
+	}
+	if again := list(t, listed.Etag); !again.NotModified || len(again.Projects) != 0 || again.Etag != listed.Etag {
+		t.Errorf("expected a not-modified listing, got %v", again)
+	}
+
+	// The fake clientset leaves resource versions alone; bump it the way
+	// the apiserver would.
+	ns.Annotations[v1alpha2.AnnotationShareUsers] = `[{"principal":"alice@example.com","role":"editor"}]`
+	ns.ResourceVersion = "2"
+	if _, err := fakeClient.CoreV1().Namespaces().Update(context.Background(), ns, metav1.UpdateOptions{}); err != nil {
+		t.Fatalf("updating namespace: %v", err)
+	}
+	if changed := get(t, got.Etag); changed.NotModified || changed.Project.GetUserRole() != consolev1.Role_ROLE_EDITOR {
+		t.Errorf("expected the changed project, got %v", changed)
+	}
+	if changed := list(t, listed.Etag); changed.NotModified || len(changed.Projects) != 1 {
+		t.Errorf("expected the changed listing, got %v", changed)
+	}
+}
+
+func TestGetProject_ExplainsUserRole(t *testing.T) {
+	ns := managedNSWithOrg("my-project", "my-org", `[{"principal":"alice@example.com","role":"editor"}]`)
+	handler, _ := newHandler(ns)
+	ctx := contextWithClaims("alice@example.com")
+
+	resp, err := handler.GetProject(ctx, connect.NewRequest(&consolev1.GetProjectRequest{Name: "my-project"}))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	src := resp.Msg.Project.UserRoleSource
+	if src.GetType() != consolev1.RoleSourceType_ROLE_SOURCE_TYPE_USER_GRANT || src.GetPrincipal() != "alice@example.com" {
+		t.Errorf("expected the user grant as the role source, got %v", src)
+	}
+	if src.GetResourceType() != v1alpha2.ResourceTypeProject || src.GetResourceName() != "my-project" {
+		t.Errorf("expected the grant to be on the project, got %v", src)
+	}
+}
+
 // ---- CreateProject tests ----
 
 func TestCreateProject_CreatesForAuthorizedUser(t *testing.T) {
@@ -307,12 +354,6 @@ func TestCreateProject_DeriveNameWithCollision(t *testing.T) {
 	}
 }
 
-func TestCreateProject_ReturnsUnauthenticatedWithoutClaims(t *testing.T) {
-	handler, _ := newHandler()
-	_, err := handler.CreateProject(context.Background(), connect.NewRequest(&consolev1.CreateProjectRequest{Name: "test"}))
-	assertUnauthenticated(t, err)
-}
-
 func TestCreateProject_RetriesOnAlreadyExistsRace(t *testing.T) {
 	// Simulate race: GenerateIdentifier finds "frontend" available, but by the
 	// time CreateProject calls K8s Create, another request has taken it.
@@ -401,12 +442,6 @@ func TestUpdateProject_UpdatesMetadataForEditor(t *testing.T) {
 	}
 }
 
-func TestUpdateProject_ReturnsUnauthenticatedWithoutClaims(t *testing.T) {
-	handler, _ := newHandler()
-	_, err := handler.UpdateProject(context.Background(), connect.NewRequest(&consolev1.UpdateProjectRequest{Name: "test"}))
-	assertUnauthenticated(t, err)
-}
-
 // ---- DeleteProject tests ----
 
 func TestDeleteProject_DeletesForOwner(t *testing.T) {
@@ -424,12 +459,6 @@ func TestDeleteProject_DeletesForOwner(t *testing.T) {
 	}
 }
 
-func TestDeleteProject_ReturnsUnauthenticatedWithoutClaims(t *testing.T) {
-	handler, _ := newHandler()
-	_, err := handler.DeleteProject(context.Background(), connect.NewRequest(&consolev1.DeleteProjectRequest{Name: "test"}))
-	assertUnauthenticated(t, err)
-}
-
 // ---- UpdateProjectSharing tests ----
 
 func TestUpdateProjectSharing_UpdatesGrantsForOwner(t *testing.T) {
@@ -462,17 +491,39 @@ func TestUpdateProjectSharing_UpdatesGrantsForOwner(t *testing.T) {
 	}
 }
 
-func TestUpdateProjectSharing_ReturnsUnauthenticatedWithoutClaims(t *testing.T) {
-	handler, _ := newHandler()
-	_, err := handler.UpdateProjectSharing(context.Background(), connect.NewRequest(&consolev1.UpdateProjectSharingRequest{Name: "test"}))
-	assertUnauthenticated(t, err)
+func TestUpdateProjectSharing_RejectsRemovingLastOwner(t *testing.T) {
+	ns := managedNS("my-project", `[{"principal":"alice@example.com","role":"owner"}]`)
+	handler, _ := newHandler(ns)
+	ctx := contextWithClaims("alice@example.com")
+	req := &consolev1.UpdateProjectSharingRequest{
+		Name:       "my-project",
+		UserGrants: []*consolev1.ShareGrant{{Principal: "alice@example.com", Role: consolev1.Role_ROLE_EDITOR}},
+	}
+
+	_, err := handler.UpdateProjectSharing(ctx, connect.NewRequest(req))
+	if connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Fatalf("expected FailedPrecondition, got %v", err)
+	}
+
+	req.Force = true
+	_, err = handler.UpdateProjectSharing(ctx, connect.NewRequest(req))
+	if connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Fatalf("expected PermissionDenied for force from a non-platform owner, got %v", err)
+	}
+
+	handler.WithPlatformOwners(rpc.PlatformOwners{Roles: []string{"platform"}})
+	_, err = handler.UpdateProjectSharing(contextWithClaims("alice@example.com", "platform"), connect.NewRequest(req))
+	if err != nil {
+		t.Fatalf("expected a platform owner to force, got %v", err)
+	}
 }
 
 // ---- Label-based name extraction tests ----
 
-func TestBuildProject_FallbackProducesWrongNameWithPrefix(t *testing.T) {
-	// When the project label is missing and namespace-prefix is configured,
-	// ProjectFromNamespace produces the wrong name.
+func TestBuildProject_MissingLabelRequiresMigration(t *testing.T) {
+	// Names are no longer parsed from namespace names, which is fragile
+	// when prefixes change; holos-console migrate labels pre-label
+	// namespaces instead.
 	r := &resolver.Resolver{NamespacePrefix: "holos-", OrganizationPrefix: "o-", FolderPrefix: "fld-", ProjectPrefix: "p-"}
 	ns := &corev1.Namespace{
 		ObjectMeta: metav1.ObjectMeta{
@@ -480,7 +531,7 @@ func TestBuildProject_FallbackProducesWrongNameWithPrefix(t *testing.T) {
 			Labels: map[string]string{
 				v1alpha2.LabelManagedBy:    v1alpha2.ManagedByValue,
 				v1alpha2.LabelResourceType: v1alpha2.ResourceTypeProject,
-				// No ProjectLabel — forces fallback
+				// No ProjectLabel
 			},
 			Annotations: map[string]string{
 				v1alpha2.AnnotationShareUsers: `[{"principal":"alice@example.com","role":"viewer"}]`,
@@ -493,14 +544,8 @@ func TestBuildProject_FallbackProducesWrongNameWithPrefix(t *testing.T) {
 	slog.SetDefault(slog.New(slog.NewTextHandler(io.Discard, nil)))
 
 	p := handler.buildProject(ns, nil, nil, 0)
-	// The fallback uses ProjectFromNamespace which with NamespacePrefix "holos-"
-	// and ProjectPrefix "p-" strips "holos-p-" leaving "holos" — this happens
-	// to be correct by coincidence. Test with a name where it breaks:
-	// TrimPrefix("holos-p-holos", "holos-p-") = "holos" — coincidence.
-	// Use a name where the prefix is NOT a prefix of the namespace name to show
-	// the fallback is fragile.
-	if p.Name == "" {
-		t.Errorf("expected non-empty name from fallback, got empty")
+	if p.Name != "" {
+		t.Errorf("expected no name without the project label, got %q", p.Name)
 	}
 }
 
@@ -574,20 +619,6 @@ func TestCreateProject_NamespacePrefixIncluded(t *testing.T) {
 
 // ---- Helpers ----
 
-func assertUnauthenticated(t *testing.T, err error) {
-	t.Helper()
-	if err == nil {
-		t.Fatal("expected error, got nil")
-	}
-	connectErr, ok := err.(*connect.Error)
-	if !ok {
-		t.Fatalf("expected *connect.Error, got %T", err)
-	}
-	if connectErr.Code() != connect.CodeUnauthenticated {
-		t.Errorf("expected CodeUnauthenticated, got %v", connectErr.Code())
-	}
-}
-
 // ---- GetProjectRaw tests ----
 
 func TestGetProjectRaw_ReturnsNamespaceJSON(t *testing.T) {
@@ -624,6 +655,73 @@ func TestGetProjectRaw_ReturnsNamespaceJSON(t *testing.T) {
 	}
 }
 
+func TestGetProjectRaw_ReturnsApplyReadyYAML(t *testing.T) {
+	ns := managedNS("my-project", `[{"principal":"alice@example.com","role":"viewer"}]`)
+	ns.UID = "uid-1"
+	ns.ResourceVersion = "42"
+	handler, _ := newHandler(ns)
+	ctx := contextWithClaims("alice@example.com")
+
+	resp, err := handler.GetProjectRaw(ctx, connect.NewRequest(&consolev1.GetProjectRawRequest{
+		Name:               "my-project",
+		Format:             consolev1.RawFormat_RAW_FORMAT_YAML,
+		StripManagedFields: true,
+	}))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !strings.HasPrefix(resp.Msg.Raw, "apiVersion: v1\n") {
+		t.Errorf("expected a YAML manifest, got %q", resp.Msg.Raw)
+	}
+	for _, field := range []string{"uid:", "resourceVersion:", "creationTimestamp:", "status:"} {
+		if strings.Contains(resp.Msg.Raw, field) {
+			t.Errorf("expected %s to be stripped, got %q", field, resp.Msg.Raw)
+		}
+	}
+	if !strings.Contains(resp.Msg.Raw, "name: holos-prj-my-project") {
+		t.Errorf("expected the namespace name, got %q", resp.Msg.Raw)
+	}
+}
+
+func TestDiffProject_ReportsChangedFields(t *testing.T) {
+	ns := managedNS("my-project", `[{"principal":"alice@example.com","role":"editor"}]`)
+	ns.Annotations[v1alpha2.AnnotationDisplayName] = "My Project"
+	handler, _ := newHandler(ns)
+	ctx := contextWithClaims("alice@example.com")
+
+	displayName, description := "Renamed", "A description"
+	resp, err := handler.DiffProject(ctx, connect.NewRequest(&consolev1.DiffProjectRequest{
+		Name:        "my-project",
+		DisplayName: &displayName,
+		Description: &description,
+	}))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	want := []*consolev1.FieldChange{
+		{Field: "display_name", Change: consolev1.ChangeType_CHANGE_TYPE_MODIFIED, OldValue: "My Project", NewValue: "Renamed"},
+		{Field: "description", Change: consolev1.ChangeType_CHANGE_TYPE_ADDED, NewValue: "A description"},
+	}
+	if len(resp.Msg.Changes) != len(want) {
+		t.Fatalf("expected %d changes, got %v", len(want), resp.Msg.Changes)
+	}
+	for i, w := range want {
+		got := resp.Msg.Changes[i]
+		if got.Field != w.Field || got.Change != w.Change || got.OldValue != w.OldValue || got.NewValue != w.NewValue {
+			t.Errorf("change %d: expected %v, got %v", i, w, got)
+		}
+	}
+
+	same := "My Project"
+	resp, err = handler.DiffProject(ctx, connect.NewRequest(&consolev1.DiffProjectRequest{Name: "my-project", DisplayName: &same}))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(resp.Msg.Changes) != 0 {
+		t.Errorf("expected no changes, got %v", resp.Msg.Changes)
+	}
+}
+
 // ---- Cascade permission tests (org grant fallback) ----
 
 // mockOrgResolver implements OrgResolver for testing.
@@ -698,16 +796,10 @@ func TestUpdateProjectDefaultSharing_RequiresProjectName(t *testing.T) {
 	handler, _ := newHandler()
 	ctx := contextWithClaims("alice@example.com")
 
-	_, err := handler.UpdateProjectDefaultSharing(ctx, connect.NewRequest(&consolev1.UpdateProjectDefaultSharingRequest{Name: ""}))
+	_, err := rpctesting.Validated(ctx, connect.NewRequest(&consolev1.UpdateProjectDefaultSharingRequest{Name: ""}), handler.UpdateProjectDefaultSharing)
 	assertInvalidArgument(t, err)
 }
 
-func TestUpdateProjectDefaultSharing_ReturnsUnauthenticatedWithoutClaims(t *testing.T) {
-	handler, _ := newHandler()
-	_, err := handler.UpdateProjectDefaultSharing(context.Background(), connect.NewRequest(&consolev1.UpdateProjectDefaultSharingRequest{Name: "test"}))
-	assertUnauthenticated(t, err)
-}
-
 func TestBuildProject_PopulatesDefaultGrants(t *testing.T) {
 	ns := &corev1.Namespace{
 		ObjectMeta: metav1.ObjectMeta{
@@ -1133,20 +1225,12 @@ func TestCheckProjectIdentifier_EmptyRejects(t *testing.T) {
 	handler, _ := newHandler()
 	ctx := contextWithClaims("alice@example.com")
 
-	_, err := handler.CheckProjectIdentifier(ctx, connect.NewRequest(&consolev1.CheckProjectIdentifierRequest{
+	_, err := rpctesting.Validated(ctx, connect.NewRequest(&consolev1.CheckProjectIdentifierRequest{
 		Identifier: "",
-	}))
+	}), handler.CheckProjectIdentifier)
 	assertInvalidArgument(t, err)
 }
 
-func TestCheckProjectIdentifier_Unauthenticated(t *testing.T) {
-	handler, _ := newHandler()
-	_, err := handler.CheckProjectIdentifier(context.Background(), connect.NewRequest(&consolev1.CheckProjectIdentifierRequest{
-		Identifier: "frontend",
-	}))
-	assertUnauthenticated(t, err)
-}
-
 func TestCheckProjectIdentifier_NonSlugReturnsUnavailable(t *testing.T) {
 	// No project namespace exists, but the input is not a valid slug.
 	// Should return available=false with the slugified form as suggestion.
@@ -1406,3 +1490,99 @@ func TestCreateProject_ExplicitFolderParentRejected(t *testing.T) {
 		t.Fatalf("expected CodeInvalidArgument, got %v: %v", connectErr.Code(), err)
 	}
 }
+
+// ---- Dry-run tests ----
+
+// assertDryRunWrites fails unless client recorded at least one write and every
+// create, update, and delete carried DryRun=[All].
+func assertDryRunWrites(t *testing.T, client *fake.Clientset) {
+	t.Helper()
+	writes := 0
+	for _, action := range client.Actions() {
+		var dryRun []string
+		switch a := action.(type) {
+		case k8stesting.CreateActionImpl:
+			dryRun = a.GetCreateOptions().DryRun
+		case k8stesting.UpdateActionImpl:
+			dryRun = a.GetUpdateOptions().DryRun
+		case k8stesting.DeleteActionImpl:
+			dryRun = a.GetDeleteOptions().DryRun
+		default:
+			continue
+		}
+		writes++
+		if len(dryRun) != 1 || dryRun[0] != metav1.DryRunAll {
+			t.Errorf("%s %s: expected DryRun=[All], got %v", action.GetVerb(), action.GetResource().Resource, dryRun)
+		}
+	}
+	if writes == 0 {
+		t.Fatal("expected at least one dry-run write")
+	}
+}
+
+func TestProjectMutations_DryRun(t *testing.T) {
+	ownerGrant := `[{"principal":"alice@example.com","role":"owner"}]`
+	displayName := "Renamed"
+	cases := []struct {
+		name   string
+		action string
+		call   func(ctx context.Context, h *Handler) error
+	}{
+		{
+			name:   "create",
+			action: "project_create",
+			call: func(ctx context.Context, h *Handler) error {
+				_, err := h.CreateProject(ctx, connect.NewRequest(&consolev1.CreateProjectRequest{Name: "new-project", Organization: "acme", DryRun: true}))
+				return err
+			},
+		},
+		{
+			name:   "update",
+			action: "project_update",
+			call: func(ctx context.Context, h *Handler) error {
+				_, err := h.UpdateProject(ctx, connect.NewRequest(&consolev1.UpdateProjectRequest{Name: "my-project", DisplayName: &displayName, DryRun: true}))
+				return err
+			},
+		},
+		{
+			name:   "delete",
+			action: "project_delete",
+			call: func(ctx context.Context, h *Handler) error {
+				_, err := h.DeleteProject(ctx, connect.NewRequest(&consolev1.DeleteProjectRequest{Name: "my-project", DryRun: true}))
+				return err
+			},
+		},
+		{
+			name:   "update sharing",
+			action: "project_sharing_update",
+			call: func(ctx context.Context, h *Handler) error {
+				_, err := h.UpdateProjectSharing(ctx, connect.NewRequest(&consolev1.UpdateProjectSharingRequest{
+					Name:       "my-project",
+					UserGrants: []*consolev1.ShareGrant{{Principal: "alice@example.com", Role: consolev1.Role_ROLE_OWNER}},
+					DryRun:     true,
+				}))
+				return err
+			},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			handler, client := newHandlerWithOrgAndClient(nil, managedNS("my-project", ownerGrant))
+			logHandler := &testLogHandler{}
+			slog.SetDefault(slog.New(logHandler))
+
+			if err := tc.call(contextWithClaims("alice@example.com"), handler); err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			assertDryRunWrites(t, client)
+
+			r := logHandler.findRecord(tc.action)
+			if r == nil {
+				t.Fatalf("expected %s audit log", tc.action)
+			}
+			if got := findAttr(r, "dry_run"); got != "true" {
+				t.Errorf("expected dry_run=true, got %q", got)
+			}
+		})
+	}
+}
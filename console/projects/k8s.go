@@ -6,15 +6,20 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"slices"
 
 	v1alpha2 "github.com/holos-run/holos-console/api/v1alpha2"
+	"github.com/holos-run/holos-console/console/nsscope"
 	"github.com/holos-run/holos-console/console/resolver"
 	"github.com/holos-run/holos-console/console/resourcerbac"
+	"github.com/holos-run/holos-console/console/resourcestore"
 	"github.com/holos-run/holos-console/console/rpc"
 	"github.com/holos-run/holos-console/console/secretrbac"
 	"github.com/holos-run/holos-console/console/secrets"
 	"github.com/holos-run/holos-console/console/sharing/legacy" //nolint:staticcheck // Projects still expose legacy grant-derived role hints.
-	authv1 "k8s.io/api/authorization/v1"
+	"github.com/holos-run/holos-console/console/tracing"
+	"github.com/holos-run/holos-console/console/trash"
+	"go.opentelemetry.io/otel/attribute"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
@@ -26,11 +31,32 @@ import (
 type K8sClient struct {
 	client   kubernetes.Interface
 	Resolver *resolver.Resolver
-}
+	// store holds project metadata and grants alongside the namespace
+	// annotations. The default AnnotationStore keeps them only in the
+	// annotations.
+	store resourcestore.Store
+	// scope limits the project namespaces the console manages. Namespaces
+	// outside it are reported as NotFound. Nil manages every namespace.
+	scope *nsscope.Scope
+	// static, when non-nil, is the fixed list of project names the client
+	// serves without listing namespaces. See WithStaticProjects.
+	static []string
+}
+
+// errStaticProjects rejects creating or deleting a project when projects
+// are configured statically.
+var errStaticProjects = errors.New("projects are configured statically and cannot be created or deleted")
 
 // NewK8sClient creates a client for project operations.
 func NewK8sClient(client kubernetes.Interface, r *resolver.Resolver) *K8sClient {
-	return &K8sClient{client: client, Resolver: r}
+	return &K8sClient{client: client, Resolver: r, store: resourcestore.AnnotationStore{}}
+}
+
+// WithStore configures where project metadata and grants are stored.
+// Returns the receiver for fluent chaining.
+func (c *K8sClient) WithStore(s resourcestore.Store) *K8sClient {
+	c.store = s
+	return c
 }
 
 func (c *K8sClient) clientset(ctx context.Context) kubernetes.Interface {
@@ -40,32 +66,34 @@ func (c *K8sClient) clientset(ctx context.Context) kubernetes.Interface {
 	return c.client
 }
 
-func (c *K8sClient) canVerbNamespace(ctx context.Context, verb, name string) (bool, error) {
-	if !rpc.HasImpersonatedClients(ctx) {
-		return true, nil
-	}
-	review := &authv1.SelfSubjectAccessReview{
-		Spec: authv1.SelfSubjectAccessReviewSpec{
-			ResourceAttributes: &authv1.ResourceAttributes{
-				Verb:     verb,
-				Group:    "",
-				Resource: "namespaces",
-				Name:     name,
-			},
-		},
-	}
-	got, err := rpc.ImpersonatedClientsetFromContext(ctx).AuthorizationV1().SelfSubjectAccessReviews().Create(ctx, review, metav1.CreateOptions{})
-	if err != nil {
-		return false, err
-	}
-	return got.Status.Allowed, nil
+// WithScope limits the project namespaces the client manages. Projects
+// outside the scope are omitted from lists, reported as NotFound, and may
+// not be created. Returns the receiver for fluent chaining.
+func (c *K8sClient) WithScope(s *nsscope.Scope) *K8sClient {
+	c.scope = s
+	return c
+}
+
+// WithStaticProjects serves exactly the named projects, for clusters where
+// the console holds only namespace-scoped RBAC in each project namespace.
+// ListProjects reads each namespace by name instead of listing namespaces
+// cluster-wide, and projects cannot be created or deleted. Returns the
+// receiver for fluent chaining.
+func (c *K8sClient) WithStaticProjects(names []string) *K8sClient {
+	c.static = names
+	return c
 }
 
 // ListProjects returns all project namespaces. When org is non-empty, filters by organization.
 // When parentNs is non-empty, additionally filters to direct children of that parent namespace.
 func (c *K8sClient) ListProjects(ctx context.Context, org, parentNs string) ([]*corev1.Namespace, error) {
+	ctx, span := tracing.Start(ctx, "projects.K8sClient.ListProjects", attribute.String("org", org), attribute.String("parent_namespace", parentNs))
+	defer span.End()
 	labelSelector := v1alpha2.LabelManagedBy + "=" + v1alpha2.ManagedByValue + "," +
 		v1alpha2.LabelResourceType + "=" + v1alpha2.ResourceTypeProject
+	if c.static != nil {
+		return c.listStaticProjects(ctx, org, parentNs)
+	}
 	if org != "" {
 		labelSelector += "," + v1alpha2.LabelOrganization + "=" + org
 	}
@@ -85,7 +113,10 @@ func (c *K8sClient) ListProjects(ctx context.Context, org, parentNs string) ([]*
 	}
 	result := make([]*corev1.Namespace, 0, len(list.Items))
 	for i := range list.Items {
-		if list.Items[i].DeletionTimestamp != nil {
+		if list.Items[i].DeletionTimestamp != nil || trash.IsDeleted(&list.Items[i]) {
+			continue
+		}
+		if !c.scope.Manages(&list.Items[i]) {
 			continue
 		}
 		if _, err := c.Resolver.ProjectFromNamespace(list.Items[i].Name); err != nil {
@@ -105,6 +136,11 @@ func (c *K8sClient) ListProjects(ctx context.Context, org, parentNs string) ([]*
 		result = append(result, &list.Items[i])
 	}
 	if !rpc.HasImpersonatedClients(ctx) {
+		for i, ns := range result {
+			if result[i], err = c.store.Hydrate(ctx, ns); err != nil {
+				return nil, err
+			}
+		}
 		return result, nil
 	}
 	authorized := make([]*corev1.Namespace, 0, len(result))
@@ -126,10 +162,55 @@ func (c *K8sClient) ListProjects(ctx context.Context, org, parentNs string) ([]*
 	return authorized, nil
 }
 
+// listStaticProjects reads each statically configured project, omitting
+// those that do not exist or the caller may not read.
+func (c *K8sClient) listStaticProjects(ctx context.Context, org, parentNs string) ([]*corev1.Namespace, error) {
+	result := make([]*corev1.Namespace, 0, len(c.static))
+	for _, name := range c.static {
+		ns, err := c.GetProject(ctx, name)
+		if err != nil {
+			if k8serrors.IsForbidden(err) || k8serrors.IsNotFound(err) {
+				slog.DebugContext(ctx, "omitting static project",
+					slog.String("name", name),
+					slog.String("reason", err.Error()),
+				)
+				continue
+			}
+			return nil, err
+		}
+		if org != "" && ns.Labels[v1alpha2.LabelOrganization] != org {
+			continue
+		}
+		if parentNs != "" && ns.Labels[v1alpha2.AnnotationParent] != parentNs {
+			continue
+		}
+		result = append(result, ns)
+	}
+	return result, nil
+}
+
 // GetProject retrieves a managed project namespace by name.
 // The name is the user-facing project name (not the Kubernetes namespace).
+// Soft-deleted projects are reported as NotFound. The metadata and grant
+// annotations are filled from the configured store.
 func (c *K8sClient) GetProject(ctx context.Context, name string) (*corev1.Namespace, error) {
+	ctx, span := tracing.Start(ctx, "projects.K8sClient.GetProject", attribute.String("name", name))
+	defer span.End()
+	ns, err := c.getProject(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return c.store.Hydrate(ctx, ns)
+}
+
+// getProject reads the project namespace as stored in the cluster. Update
+// paths use it so they never write hydrated annotations back. Projects
+// outside the static list are reported as NotFound.
+func (c *K8sClient) getProject(ctx context.Context, name string) (*corev1.Namespace, error) {
 	nsName := c.Resolver.ProjectNamespace(name)
+	if c.static != nil && !slices.Contains(c.static, name) {
+		return nil, k8serrors.NewNotFound(corev1.Resource("namespaces"), nsName)
+	}
 	slog.DebugContext(ctx, "getting project from kubernetes",
 		slog.String("name", name),
 		slog.String("namespace", nsName),
@@ -144,6 +225,9 @@ func (c *K8sClient) GetProject(ctx context.Context, name string) (*corev1.Namesp
 	if ns.Labels[v1alpha2.LabelResourceType] != v1alpha2.ResourceTypeProject {
 		return nil, fmt.Errorf("namespace %q is not a project", nsName)
 	}
+	if trash.IsDeleted(ns) || !c.scope.Manages(ns) {
+		return nil, k8serrors.NewNotFound(corev1.Resource("namespaces"), nsName)
+	}
 	return ns, nil
 }
 
@@ -157,6 +241,9 @@ func (c *K8sClient) GetProject(ctx context.Context, name string) (*corev1.Namesp
 // happy path stays byte-identical to the pre-HOL-812 behavior.
 func (c *K8sClient) BuildProjectNamespace(name, displayName, description, org, parentNs, creatorEmail string, shareUsers, shareRoles, defaultShareUsers, defaultShareRoles []secrets.AnnotationGrant) (*corev1.Namespace, error) {
 	nsName := c.Resolver.ProjectNamespace(name)
+	if c.static != nil {
+		return nil, k8serrors.NewForbidden(corev1.Resource("namespaces"), nsName, errStaticProjects)
+	}
 	usersJSON, err := json.Marshal(shareUsers)
 	if err != nil {
 		return nil, fmt.Errorf("marshaling share-users: %w", err)
@@ -203,19 +290,27 @@ func (c *K8sClient) BuildProjectNamespace(name, displayName, description, org, p
 	if parentNs != "" {
 		labels[v1alpha2.AnnotationParent] = parentNs
 	}
-	return &corev1.Namespace{
+	ns := &corev1.Namespace{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:        nsName,
 			Labels:      labels,
 			Annotations: annotations,
 		},
-	}, nil
+	}
+	c.scope.ApplyLabels(ns)
+	if !c.scope.Manages(ns) {
+		return nil, k8serrors.NewForbidden(corev1.Resource("namespaces"), nsName,
+			errors.New("the namespace is outside the namespaces this console manages"))
+	}
+	return ns, nil
 }
 
 // CreateProject creates a new namespace with managed-by and resource-type labels.
 // parentNs is the Kubernetes namespace name of the immediate parent (org or folder namespace).
 // When non-empty, it is stored in the v1alpha2.AnnotationParent label for hierarchy traversal.
 func (c *K8sClient) CreateProject(ctx context.Context, name, displayName, description, org, parentNs, creatorEmail, creatorSubject string, shareUsers, shareRoles, defaultShareUsers, defaultShareRoles []secrets.AnnotationGrant) (*corev1.Namespace, error) {
+	ctx, span := tracing.Start(ctx, "projects.K8sClient.CreateProject", attribute.String("name", name), attribute.String("org", org), attribute.String("parent_namespace", parentNs))
+	defer span.End()
 	slog.DebugContext(ctx, "creating project in kubernetes",
 		slog.String("name", name),
 		slog.String("namespace", c.Resolver.ProjectNamespace(name)),
@@ -258,6 +353,14 @@ func (c *K8sClient) CreateProject(ctx context.Context, name, displayName, descri
 		}
 		return nil, bsErr
 	}
+	// A project whose resources could not be saved is migrated from its
+	// annotations on the next read, so the create still succeeds.
+	if err := c.store.Save(ctx, created); err != nil {
+		slog.WarnContext(ctx, "saving project to resource store",
+			slog.String("namespace", created.Name),
+			slog.String("error", err.Error()),
+		)
+	}
 	return created, nil
 }
 
@@ -273,6 +376,8 @@ func (c *K8sClient) impersonatedOrNil(ctx context.Context) kubernetes.Interface
 // console service account per ADR 036 Decision 5 because it reconciles RBAC for
 // humans rather than acting as the requesting human.
 func (c *K8sClient) EnsureProjectSecretRBAC(ctx context.Context, project string, shareUsers, shareRoles []secrets.AnnotationGrant) error {
+	ctx, span := tracing.Start(ctx, "projects.K8sClient.EnsureProjectSecretRBAC", attribute.String("project", project))
+	defer span.End()
 	ns, err := c.GetProject(ctx, project)
 	if err != nil {
 		return err
@@ -281,6 +386,8 @@ func (c *K8sClient) EnsureProjectSecretRBAC(ctx context.Context, project string,
 }
 
 func (c *K8sClient) EnsureProjectSecretRBACForNamespace(ctx context.Context, namespace string, ownerRefs []metav1.OwnerReference, shareUsers, shareRoles []secrets.AnnotationGrant) error {
+	ctx, span := tracing.Start(ctx, "projects.K8sClient.EnsureProjectSecretRBACForNamespace", attribute.String("namespace", namespace))
+	defer span.End()
 	roleOwners := make(map[string][]metav1.OwnerReference)
 	for _, role := range secretrbac.ProjectSecretRoles(namespace, ownerRefs) {
 		if err := c.applyRole(ctx, role); err != nil {
@@ -385,10 +492,12 @@ func (c *K8sClient) applyRoleBinding(ctx context.Context, binding *rbacv1.RoleBi
 // UpdateProject updates the description and display name annotations on a managed namespace.
 // Nil pointers preserve existing values.
 func (c *K8sClient) UpdateProject(ctx context.Context, name string, displayName, description *string) (*corev1.Namespace, error) {
+	ctx, span := tracing.Start(ctx, "projects.K8sClient.UpdateProject", attribute.String("name", name))
+	defer span.End()
 	slog.DebugContext(ctx, "updating project in kubernetes",
 		slog.String("name", name),
 	)
-	ns, err := c.GetProject(ctx, name)
+	ns, err := c.getProject(ctx, name)
 	if err != nil {
 		return nil, err
 	}
@@ -409,16 +518,25 @@ func (c *K8sClient) UpdateProject(ctx context.Context, name string, displayName,
 			ns.Annotations[v1alpha2.AnnotationDescription] = *description
 		}
 	}
-	return c.clientset(ctx).CoreV1().Namespaces().Update(ctx, ns, metav1.UpdateOptions{})
+	updated, err := c.clientset(ctx).CoreV1().Namespaces().Update(ctx, ns, metav1.UpdateOptions{DryRun: rpc.DryRunFromContext(ctx)})
+	if err != nil || rpc.IsDryRun(ctx) {
+		return updated, err
+	}
+	if err := c.store.Save(ctx, updated); err != nil {
+		return nil, fmt.Errorf("saving project to resource store: %w", err)
+	}
+	return updated, nil
 }
 
 // UpdateParentLabel updates the parent label on a project namespace.
 func (c *K8sClient) UpdateParentLabel(ctx context.Context, name, newParentNs string) (*corev1.Namespace, error) {
+	ctx, span := tracing.Start(ctx, "projects.K8sClient.UpdateParentLabel", attribute.String("name", name))
+	defer span.End()
 	slog.DebugContext(ctx, "updating project parent label in kubernetes",
 		slog.String("name", name),
 		slog.String("newParent", newParentNs),
 	)
-	ns, err := c.GetProject(ctx, name)
+	ns, err := c.getProject(ctx, name)
 	if err != nil {
 		return nil, err
 	}
@@ -428,35 +546,44 @@ func (c *K8sClient) UpdateParentLabel(ctx context.Context, name, newParentNs str
 	ns.Labels[v1alpha2.AnnotationParent] = newParentNs
 	// Locked label (parent) — namespace-share-annotations-console-only
 	// denies non-console-SA writes to console classification labels.
-	return c.client.CoreV1().Namespaces().Update(ctx, ns, metav1.UpdateOptions{})
+	return c.client.CoreV1().Namespaces().Update(ctx, ns, metav1.UpdateOptions{DryRun: rpc.DryRunFromContext(ctx)})
 }
 
 // GetNamespace retrieves any namespace by its full Kubernetes name.
 // Used for resolving parent namespaces during reparent validation.
 func (c *K8sClient) GetNamespace(ctx context.Context, nsName string) (*corev1.Namespace, error) {
+	ctx, span := tracing.Start(ctx, "projects.K8sClient.GetNamespace", attribute.String("namespace", nsName))
+	defer span.End()
 	return c.clientset(ctx).CoreV1().Namespaces().Get(ctx, nsName, metav1.GetOptions{})
 }
 
 // DeleteProject deletes a managed project namespace.
 // Returns an error if the namespace does not have the managed-by label.
 func (c *K8sClient) DeleteProject(ctx context.Context, name string) error {
+	ctx, span := tracing.Start(ctx, "projects.K8sClient.DeleteProject", attribute.String("name", name))
+	defer span.End()
 	slog.DebugContext(ctx, "deleting project from kubernetes",
 		slog.String("name", name),
 	)
 	// Verify the namespace is managed before deleting.
-	ns, err := c.GetProject(ctx, name)
+	ns, err := c.getProject(ctx, name)
 	if err != nil {
 		return err
 	}
-	return c.clientset(ctx).CoreV1().Namespaces().Delete(ctx, ns.Name, metav1.DeleteOptions{})
+	if c.static != nil {
+		return k8serrors.NewForbidden(corev1.Resource("namespaces"), ns.Name, errStaticProjects)
+	}
+	return c.clientset(ctx).CoreV1().Namespaces().Delete(ctx, ns.Name, metav1.DeleteOptions{DryRun: rpc.DryRunFromContext(ctx)})
 }
 
 // UpdateProjectSharing updates the sharing annotations on a managed namespace.
 func (c *K8sClient) UpdateProjectSharing(ctx context.Context, name string, shareUsers, shareRoles, rbacShareUsers []secrets.AnnotationGrant) (*corev1.Namespace, error) {
+	ctx, span := tracing.Start(ctx, "projects.K8sClient.UpdateProjectSharing", attribute.String("name", name))
+	defer span.End()
 	slog.DebugContext(ctx, "updating project sharing in kubernetes",
 		slog.String("name", name),
 	)
-	ns, err := c.GetProject(ctx, name)
+	ns, err := c.getProject(ctx, name)
 	if err != nil {
 		return nil, err
 	}
@@ -479,12 +606,19 @@ func (c *K8sClient) UpdateProjectSharing(ctx context.Context, name string, share
 	ns.Annotations[v1alpha2.AnnotationShareRoles] = string(rolesJSON)
 	ns.Annotations[v1alpha2.AnnotationRBACShareUsers] = string(rbacUsersJSON)
 	// Locked annotations — see UpdateParentLabel.
-	updated, err := c.client.CoreV1().Namespaces().Update(ctx, ns, metav1.UpdateOptions{})
+	updated, err := c.client.CoreV1().Namespaces().Update(ctx, ns, metav1.UpdateOptions{DryRun: rpc.DryRunFromContext(ctx)})
 	if err != nil {
 		return nil, err
 	}
 	// Synchronously reconcile per-resource RBAC so newly-granted users get
 	// their ClusterRoleBindings without waiting for the async reconciler.
+	// A dry run returns the would-be namespace without reconciling.
+	if rpc.IsDryRun(ctx) {
+		return updated, nil
+	}
+	if err := c.store.Save(ctx, updated); err != nil {
+		return nil, fmt.Errorf("saving project to resource store: %w", err)
+	}
 	if err := resourcerbac.EnsureResourceRBAC(ctx, c.client, updated, resourcerbac.Projects); err != nil {
 		return nil, fmt.Errorf("reconciling project RBAC after sharing update: %w", err)
 	}
@@ -493,6 +627,8 @@ func (c *K8sClient) UpdateProjectSharing(ctx context.Context, name string, share
 
 // NamespaceExists returns true if a namespace with the given name exists.
 func (c *K8sClient) NamespaceExists(ctx context.Context, nsName string) (bool, error) {
+	ctx, span := tracing.Start(ctx, "projects.K8sClient.NamespaceExists", attribute.String("namespace", nsName))
+	defer span.End()
 	_, err := c.client.CoreV1().Namespaces().Get(ctx, nsName, metav1.GetOptions{})
 	if err != nil {
 		if k8serrors.IsNotFound(err) {
@@ -514,6 +650,8 @@ func GetOrganization(ns *corev1.Namespace) string {
 // GetProjectOrg returns the organization name for the given project.
 // Returns an empty string if the project is not associated with an organization.
 func (c *K8sClient) GetProjectOrg(ctx context.Context, project string) (string, error) {
+	ctx, span := tracing.Start(ctx, "projects.K8sClient.GetProjectOrg", attribute.String("project", project))
+	defer span.End()
 	ns, err := c.GetProject(ctx, project)
 	if err != nil {
 		return "", fmt.Errorf("getting project %q: %w", project, err)
@@ -578,6 +716,12 @@ func GetShareRoles(ns *corev1.Namespace) ([]secrets.AnnotationGrant, error) {
 	return parseGrantAnnotation(ns, v1alpha2.AnnotationShareRoles)
 }
 
+// GetRBACShareUsers parses the rbac-share-users annotation from a namespace.
+// Returns nil if the annotation is absent.
+func GetRBACShareUsers(ns *corev1.Namespace) ([]secrets.AnnotationGrant, error) {
+	return parseGrantAnnotation(ns, v1alpha2.AnnotationRBACShareUsers)
+}
+
 // GetDefaultShareUsers parses the default-share-users annotation from a namespace.
 // Returns nil if the annotation is absent.
 func GetDefaultShareUsers(ns *corev1.Namespace) ([]secrets.AnnotationGrant, error) {
@@ -592,10 +736,12 @@ func GetDefaultShareRoles(ns *corev1.Namespace) ([]secrets.AnnotationGrant, erro
 
 // UpdateProjectDefaultSharing updates the default sharing annotations on a managed namespace.
 func (c *K8sClient) UpdateProjectDefaultSharing(ctx context.Context, name string, defaultUsers, defaultRoles []secrets.AnnotationGrant) (*corev1.Namespace, error) {
+	ctx, span := tracing.Start(ctx, "projects.K8sClient.UpdateProjectDefaultSharing", attribute.String("name", name))
+	defer span.End()
 	slog.DebugContext(ctx, "updating project default sharing in kubernetes",
 		slog.String("name", name),
 	)
-	ns, err := c.GetProject(ctx, name)
+	ns, err := c.getProject(ctx, name)
 	if err != nil {
 		return nil, err
 	}
@@ -613,7 +759,14 @@ func (c *K8sClient) UpdateProjectDefaultSharing(ctx context.Context, name string
 	ns.Annotations[v1alpha2.AnnotationDefaultShareUsers] = string(usersJSON)
 	ns.Annotations[v1alpha2.AnnotationDefaultShareRoles] = string(rolesJSON)
 	// Locked annotations — see UpdateParentLabel.
-	return c.client.CoreV1().Namespaces().Update(ctx, ns, metav1.UpdateOptions{})
+	updated, err := c.client.CoreV1().Namespaces().Update(ctx, ns, metav1.UpdateOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if err := c.store.Save(ctx, updated); err != nil {
+		return nil, fmt.Errorf("saving project to resource store: %w", err)
+	}
+	return updated, nil
 }
 
 // ProjectCreatorAdapter adapts the projects K8sClient to satisfy the
@@ -7,9 +7,16 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	consolev1alpha1 "github.com/holos-run/holos-console/api/console/v1alpha1"
 	v1alpha2 "github.com/holos-run/holos-console/api/v1alpha2"
+	"github.com/holos-run/holos-console/console/nsscope"
+	"github.com/holos-run/holos-console/console/resourcestore"
 	"github.com/holos-run/holos-console/console/secrets"
 )
 
@@ -358,6 +365,56 @@ func TestUpdateProject_UpdatesDescriptionAndDisplayName(t *testing.T) {
 	}
 }
 
+func TestUpdateProject_SavesToCRDStore(t *testing.T) {
+	ns := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "holos-prj-my-project",
+			Labels: map[string]string{
+				v1alpha2.LabelManagedBy:    v1alpha2.ManagedByValue,
+				v1alpha2.LabelResourceType: v1alpha2.ResourceTypeProject,
+				v1alpha2.LabelProject:      "my-project",
+			},
+			Annotations: map[string]string{
+				v1alpha2.AnnotationShareUsers: `[{"principal":"alice@example.com","role":"owner"}]`,
+			},
+		},
+	}
+	scheme := runtime.NewScheme()
+	if err := consolev1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("register console scheme: %v", err)
+	}
+	ctrlClient := ctrlfake.NewClientBuilder().WithScheme(scheme).Build()
+	k8s := NewK8sClient(fake.NewClientset(ns), testResolver()).
+		WithStore(resourcestore.NewCRDStore(ctrlClient, ctrlClient))
+
+	displayName := "Storefront"
+	if _, err := k8s.UpdateProject(context.Background(), "my-project", &displayName, nil); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	var project consolev1alpha1.ConsoleProject
+	if err := ctrlClient.Get(context.Background(), ctrlclient.ObjectKey{Namespace: ns.Name, Name: consolev1alpha1.ConsoleProjectName}, &project); err != nil {
+		t.Fatalf("expected ConsoleProject to be saved, got %v", err)
+	}
+	if project.Spec.DisplayName != "Storefront" {
+		t.Errorf("expected display name 'Storefront', got %q", project.Spec.DisplayName)
+	}
+
+	project.Spec.Description = "set through the CR"
+	if err := ctrlClient.Update(context.Background(), &project); err != nil {
+		t.Fatalf("updating ConsoleProject: %v", err)
+	}
+	got, err := k8s.GetProject(context.Background(), "my-project")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if got.Annotations[v1alpha2.AnnotationDescription] != "set through the CR" {
+		t.Errorf("expected description from ConsoleProject, got %q", got.Annotations[v1alpha2.AnnotationDescription])
+	}
+	if got.Annotations[v1alpha2.AnnotationShareUsers] != `[{"principal":"alice@example.com","role":"owner"}]` {
+		t.Errorf("unexpected share-users %q", got.Annotations[v1alpha2.AnnotationShareUsers])
+	}
+}
+
 func TestUpdateProject_RejectsUnmanagedNamespace(t *testing.T) {
 	fakeClient := fake.NewClientset()
 	k8s := NewK8sClient(fakeClient, testResolver())
@@ -445,6 +502,104 @@ func TestListProjects_FiltersPrefixMismatchNamespaces(t *testing.T) {
 	}
 }
 
+func TestK8sClient_Scope(t *testing.T) {
+	project := func(name string, extra map[string]string) *corev1.Namespace {
+		labels := map[string]string{
+			v1alpha2.LabelManagedBy:    v1alpha2.ManagedByValue,
+			v1alpha2.LabelResourceType: v1alpha2.ResourceTypeProject,
+			v1alpha2.LabelProject:      name,
+		}
+		for k, v := range extra {
+			labels[k] = v
+		}
+		return &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "holos-prj-" + name, Labels: labels}}
+	}
+	fakeClient := fake.NewClientset(
+		project("web", map[string]string{"team": "web"}),
+		project("web-legacy", map[string]string{"team": "web"}),
+		project("data", map[string]string{"team": "data"}),
+		project("other", map[string]string{"team": "web"}),
+	)
+	scope, err := nsscope.New([]string{"holos-prj-web*", "holos-prj-data"}, []string{"*-legacy"}, "team=web")
+	if err != nil {
+		t.Fatalf("nsscope.New: %v", err)
+	}
+	k8s := NewK8sClient(fakeClient, testResolver()).WithScope(scope)
+	ctx := context.Background()
+
+	projects, err := k8s.ListProjects(ctx, "", "")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(projects) != 1 || projects[0].Name != "holos-prj-web" {
+		t.Fatalf("expected only holos-prj-web in scope, got %d projects", len(projects))
+	}
+	for _, name := range []string{"web-legacy", "data", "other"} {
+		if _, err := k8s.GetProject(ctx, name); !errors.IsNotFound(err) {
+			t.Errorf("%s: expected NotFound outside the scope, got %v", name, err)
+		}
+	}
+	if err := k8s.DeleteProject(ctx, "data"); !errors.IsNotFound(err) {
+		t.Errorf("expected delete outside the scope to fail with NotFound, got %v", err)
+	}
+
+	created, err := k8s.CreateProject(ctx, "web-new", "", "", "acme", "", "", "", nil, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("expected create inside the scope to succeed, got %v", err)
+	}
+	if created.Labels["team"] != "web" {
+		t.Errorf("expected the selector label on the new namespace, got %v", created.Labels)
+	}
+	if _, err := k8s.CreateProject(ctx, "api", "", "", "acme", "", "", "", nil, nil, nil, nil); !errors.IsForbidden(err) {
+		t.Errorf("expected create outside the scope to be forbidden, got %v", err)
+	}
+}
+
+func TestK8sClient_StaticProjects(t *testing.T) {
+	project := func(name, org string) *corev1.Namespace {
+		return &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+			Name: "holos-prj-" + name,
+			Labels: map[string]string{
+				v1alpha2.LabelManagedBy:    v1alpha2.ManagedByValue,
+				v1alpha2.LabelResourceType: v1alpha2.ResourceTypeProject,
+				v1alpha2.LabelProject:      name,
+				v1alpha2.LabelOrganization: org,
+			},
+		}}
+	}
+	fakeClient := fake.NewClientset(project("web", "acme"), project("data", "other"), project("unlisted", "acme"))
+	// Static mode must never need cluster-wide namespace list.
+	fakeClient.PrependReactor("list", "namespaces", func(k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, errors.NewForbidden(corev1.Resource("namespaces"), "", nil)
+	})
+	k8s := NewK8sClient(fakeClient, testResolver()).WithStaticProjects([]string{"web", "data", "missing"})
+	ctx := context.Background()
+
+	all, err := k8s.ListProjects(ctx, "", "")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(all) != 2 || all[0].Name != "holos-prj-web" || all[1].Name != "holos-prj-data" {
+		t.Fatalf("expected the configured projects that exist, got %d", len(all))
+	}
+	acme, err := k8s.ListProjects(ctx, "acme", "")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(acme) != 1 || acme[0].Name != "holos-prj-web" {
+		t.Errorf("expected the organization filter to apply, got %d projects", len(acme))
+	}
+	if _, err := k8s.GetProject(ctx, "unlisted"); !errors.IsNotFound(err) {
+		t.Errorf("expected a project outside the static list to be NotFound, got %v", err)
+	}
+	if _, err := k8s.CreateProject(ctx, "new", "", "", "acme", "", "", "", nil, nil, nil, nil); !errors.IsForbidden(err) {
+		t.Errorf("expected create to be forbidden, got %v", err)
+	}
+	if err := k8s.DeleteProject(ctx, "web"); !errors.IsForbidden(err) {
+		t.Errorf("expected delete to be forbidden, got %v", err)
+	}
+}
+
 func TestGetDefaultShareUsers_ParsesAnnotation(t *testing.T) {
 	ns := &corev1.Namespace{
 		ObjectMeta: metav1.ObjectMeta{
@@ -0,0 +1,195 @@
+package projects
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"go.opentelemetry.io/otel/attribute"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/holos-run/holos-console/console/rpc"
+	"github.com/holos-run/holos-console/console/tracing"
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+)
+
+// Kinds reported by ListProjectResources, in response order.
+const (
+	kindDeployment  = "Deployment"
+	kindStatefulSet = "StatefulSet"
+	kindService     = "Service"
+	kindIngress     = "Ingress"
+)
+
+var resourceKindOrder = map[string]int{kindDeployment: 0, kindStatefulSet: 1, kindService: 2, kindIngress: 3}
+
+// ListResources summarizes the workloads in the project namespace. The
+// Deployment list doubles as the PERMISSION_RESOURCES_READ check, so a
+// Forbidden error there is returned; the remaining kinds are skipped when
+// the caller may not list them.
+func (c *K8sClient) ListResources(ctx context.Context, project string) ([]*consolev1.ProjectResource, error) {
+	ctx, span := tracing.Start(ctx, "projects.K8sClient.ListResources", attribute.String("project", project))
+	defer span.End()
+	ns := c.Resolver.ProjectNamespace(project)
+	client := c.clientset(ctx)
+	opts := metav1.ListOptions{}
+
+	deployments, err := client.AppsV1().Deployments(ns).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	var out []*consolev1.ProjectResource
+	for i := range deployments.Items {
+		d := &deployments.Items[i]
+		out = append(out, replicaResource(kindDeployment, d.ObjectMeta, d.Spec.Replicas, d.Status.ReadyReplicas, d.Status.ObservedGeneration))
+	}
+
+	skipForbidden := func(kind string, err error) error {
+		if k8serrors.IsForbidden(err) {
+			slog.DebugContext(ctx, "omitting project resources the caller may not list",
+				slog.String("project", project),
+				slog.String("kind", kind),
+			)
+			return nil
+		}
+		return err
+	}
+
+	if sets, err := client.AppsV1().StatefulSets(ns).List(ctx, opts); err != nil {
+		if err := skipForbidden(kindStatefulSet, err); err != nil {
+			return nil, err
+		}
+	} else {
+		for i := range sets.Items {
+			s := &sets.Items[i]
+			out = append(out, replicaResource(kindStatefulSet, s.ObjectMeta, s.Spec.Replicas, s.Status.ReadyReplicas, s.Status.ObservedGeneration))
+		}
+	}
+
+	if services, err := client.CoreV1().Services(ns).List(ctx, opts); err != nil {
+		if err := skipForbidden(kindService, err); err != nil {
+			return nil, err
+		}
+	} else {
+		for i := range services.Items {
+			out = append(out, serviceResource(&services.Items[i]))
+		}
+	}
+
+	if ingresses, err := client.NetworkingV1().Ingresses(ns).List(ctx, opts); err != nil {
+		if err := skipForbidden(kindIngress, err); err != nil {
+			return nil, err
+		}
+	} else {
+		for i := range ingresses.Items {
+			ing := &ingresses.Items[i]
+			r := newResource(kindIngress, ing.ObjectMeta)
+			var addrs []string
+			for _, lb := range ing.Status.LoadBalancer.Ingress {
+				addrs = append(addrs, cmp.Or(lb.Hostname, lb.IP))
+			}
+			r.Ready = len(addrs) > 0
+			r.Status = "Pending"
+			if r.Ready {
+				r.Status = strings.Join(addrs, ", ")
+			}
+			out = append(out, r)
+		}
+	}
+
+	slices.SortFunc(out, func(a, b *consolev1.ProjectResource) int {
+		return cmp.Or(cmp.Compare(resourceKindOrder[a.Kind], resourceKindOrder[b.Kind]), cmp.Compare(a.Name, b.Name))
+	})
+	return out, nil
+}
+
+func newResource(kind string, meta metav1.ObjectMeta) *consolev1.ProjectResource {
+	return &consolev1.ProjectResource{
+		Kind:      kind,
+		Name:      meta.Name,
+		CreatedAt: meta.CreationTimestamp.UTC().Format(time.RFC3339),
+	}
+}
+
+// replicaResource summarizes a Deployment or StatefulSet. A rollout the
+// controller has not yet observed is never reported ready.
+func replicaResource(kind string, meta metav1.ObjectMeta, replicas *int32, ready int32, observedGeneration int64) *consolev1.ProjectResource {
+	r := newResource(kind, meta)
+	want := int32(1)
+	if replicas != nil {
+		want = *replicas
+	}
+	r.Ready = ready >= want && observedGeneration >= meta.Generation
+	r.Status = fmt.Sprintf("%d/%d ready", ready, want)
+	return r
+}
+
+// serviceResource summarizes a Service. Only LoadBalancer Services wait on
+// an external address.
+func serviceResource(svc *corev1.Service) *consolev1.ProjectResource {
+	r := newResource(kindService, svc.ObjectMeta)
+	r.Ready = true
+	r.Status = string(cmp.Or(svc.Spec.Type, corev1.ServiceTypeClusterIP))
+	switch {
+	case svc.Spec.Type == corev1.ServiceTypeLoadBalancer:
+		var addrs []string
+		for _, lb := range svc.Status.LoadBalancer.Ingress {
+			addrs = append(addrs, cmp.Or(lb.Hostname, lb.IP))
+		}
+		r.Ready = len(addrs) > 0
+		if r.Ready {
+			r.Status += " " + strings.Join(addrs, ", ")
+		} else {
+			r.Status += " pending"
+		}
+	case svc.Spec.Type == corev1.ServiceTypeExternalName:
+		r.Status += " " + svc.Spec.ExternalName
+	case svc.Spec.ClusterIP != "":
+		r.Status += " " + svc.Spec.ClusterIP
+	}
+	return r
+}
+
+// ListProjectResources summarizes the workloads in a project namespace.
+func (h *Handler) ListProjectResources(
+	ctx context.Context,
+	req *connect.Request[consolev1.ListProjectResourcesRequest],
+) (*connect.Response[consolev1.ListProjectResourcesResponse], error) {
+	project := req.Msg.Project
+
+	claims := rpc.MustClaims(ctx)
+
+	if _, err := h.k8s.GetProject(ctx, project); err != nil {
+		return nil, mapK8sError(err)
+	}
+	resources, err := h.k8s.ListResources(ctx, project)
+	if err != nil {
+		if k8serrors.IsForbidden(err) {
+			slog.WarnContext(ctx, "project resources list denied",
+				slog.String("action", "project_resources_list_denied"),
+				slog.String("resource_type", auditResourceType),
+				slog.String("project", project),
+				slog.String("sub", claims.Sub),
+				slog.String("email", claims.Email),
+			)
+		}
+		return nil, mapK8sError(err)
+	}
+
+	slog.InfoContext(ctx, "project resources listed",
+		slog.String("action", "project_resources_list"),
+		slog.String("resource_type", auditResourceType),
+		slog.String("project", project),
+		slog.String("sub", claims.Sub),
+		slog.String("email", claims.Email),
+		slog.Int("count", len(resources)),
+	)
+	return connect.NewResponse(&consolev1.ListProjectResourcesResponse{Resources: resources}), nil
+}
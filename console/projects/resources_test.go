@@ -0,0 +1,115 @@
+package projects
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/holos-run/holos-console/console/rpc/rpctesting"
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+)
+
+func ptr[T any](v T) *T { return &v }
+
+func newResourcesHandler(t *testing.T, forbidden ...string) *Handler {
+	t.Helper()
+	const ns = "holos-prj-billing"
+	meta := func(name string) metav1.ObjectMeta {
+		return metav1.ObjectMeta{Name: name, Namespace: ns, Generation: 2}
+	}
+	client := fake.NewClientset(
+		managedNS("billing", ""),
+		&appsv1.Deployment{ObjectMeta: meta("api"), Spec: appsv1.DeploymentSpec{Replicas: ptr(int32(3))}, Status: appsv1.DeploymentStatus{ReadyReplicas: 2, ObservedGeneration: 2}},
+		&appsv1.StatefulSet{ObjectMeta: meta("db"), Status: appsv1.StatefulSetStatus{ReadyReplicas: 1, ObservedGeneration: 2}},
+		&corev1.Service{ObjectMeta: meta("api"), Spec: corev1.ServiceSpec{Type: corev1.ServiceTypeClusterIP, ClusterIP: "10.96.0.10"}},
+		&corev1.Service{ObjectMeta: meta("public"), Spec: corev1.ServiceSpec{Type: corev1.ServiceTypeLoadBalancer}},
+		&networkingv1.Ingress{ObjectMeta: meta("web"), Status: networkingv1.IngressStatus{LoadBalancer: networkingv1.IngressLoadBalancerStatus{
+			Ingress: []networkingv1.IngressLoadBalancerIngress{{IP: "203.0.113.7"}},
+		}}},
+	)
+	for _, resource := range forbidden {
+		client.PrependReactor("list", resource, func(action k8stesting.Action) (bool, runtime.Object, error) {
+			gvr := action.GetResource()
+			return true, nil, k8serrors.NewForbidden(schema.GroupResource{Group: gvr.Group, Resource: gvr.Resource}, "", nil)
+		})
+	}
+	handler, _ := newHandler()
+	handler.k8s = NewK8sClient(client, testResolver())
+	return handler
+}
+
+func TestListProjectResources_SummarizesWorkloads(t *testing.T) {
+	h := newResourcesHandler(t)
+	resp, err := h.ListProjectResources(contextWithClaims("alice@example.com"), connect.NewRequest(&consolev1.ListProjectResourcesRequest{Project: "billing"}))
+	if err != nil {
+		t.Fatalf("ListProjectResources: %v", err)
+	}
+	want := []struct {
+		kind, name, status string
+		ready              bool
+	}{
+		{"Deployment", "api", "2/3 ready", false},
+		{"StatefulSet", "db", "1/1 ready", true},
+		{"Service", "api", "ClusterIP 10.96.0.10", true},
+		{"Service", "public", "LoadBalancer pending", false},
+		{"Ingress", "web", "203.0.113.7", true},
+	}
+	got := resp.Msg.Resources
+	if len(got) != len(want) {
+		t.Fatalf("expected %d resources, got %d: %v", len(want), len(got), got)
+	}
+	for i, w := range want {
+		g := got[i]
+		if g.Kind != w.kind || g.Name != w.name || g.Status != w.status || g.Ready != w.ready {
+			t.Errorf("resource %d = %s/%s %q ready=%v, want %s/%s %q ready=%v", i, g.Kind, g.Name, g.Status, g.Ready, w.kind, w.name, w.status, w.ready)
+		}
+	}
+}
+
+func TestListProjectResources_OmitsForbiddenKinds(t *testing.T) {
+	h := newResourcesHandler(t, "ingresses", "services")
+	resp, err := h.ListProjectResources(contextWithClaims("alice@example.com"), connect.NewRequest(&consolev1.ListProjectResourcesRequest{Project: "billing"}))
+	if err != nil {
+		t.Fatalf("ListProjectResources: %v", err)
+	}
+	for _, r := range resp.Msg.Resources {
+		if r.Kind == "Service" || r.Kind == "Ingress" {
+			t.Errorf("expected forbidden kind %s to be omitted", r.Kind)
+		}
+	}
+	if len(resp.Msg.Resources) != 2 {
+		t.Errorf("expected the Deployment and StatefulSet, got %v", resp.Msg.Resources)
+	}
+}
+
+func TestListProjectResources_Errors(t *testing.T) {
+	cases := []struct {
+		name     string
+		handler  *Handler
+		ctx      context.Context
+		project  string
+		wantCode connect.Code
+	}{
+		{"missing project", newResourcesHandler(t), contextWithClaims("alice@example.com"), "", connect.CodeInvalidArgument},
+		{"unknown project", newResourcesHandler(t), contextWithClaims("alice@example.com"), "payroll", connect.CodeNotFound},
+		{"deployments forbidden", newResourcesHandler(t, "deployments"), contextWithClaims("alice@example.com"), "billing", connect.CodePermissionDenied},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := rpctesting.Validated(tc.ctx, connect.NewRequest(&consolev1.ListProjectResourcesRequest{Project: tc.project}), tc.handler.ListProjectResources)
+			if connect.CodeOf(err) != tc.wantCode {
+				t.Fatalf("expected %v, got %v", tc.wantCode, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,151 @@
+package projects
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"connectrpc.com/connect"
+	"go.opentelemetry.io/otel/attribute"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1alpha2 "github.com/holos-run/holos-console/api/v1alpha2"
+	"github.com/holos-run/holos-console/console/rbac"
+	"github.com/holos-run/holos-console/console/rpc"
+	"github.com/holos-run/holos-console/console/tracing"
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+)
+
+const (
+	// ProjectServiceAccountName is the ServiceAccount in every project
+	// namespace that project tokens authenticate as.
+	ProjectServiceAccountName = "holos-project"
+	// projectServiceAccountClusterRole is the aggregated Kubernetes role the
+	// project ServiceAccount holds within its namespace.
+	projectServiceAccountClusterRole = "edit"
+
+	// defaultTokenTTL applies when a request does not set ttl_seconds.
+	defaultTokenTTL = time.Hour
+	// minTokenTTL is the shortest lifetime the TokenRequest API accepts.
+	minTokenTTL = 10 * time.Minute
+	// maxTokenTTL caps how long a project token may last.
+	maxTokenTTL = 24 * time.Hour
+)
+
+// EnsureProjectServiceAccount creates the project ServiceAccount and binds it
+// to the edit role in the project namespace. Like EnsureProjectSecretRBAC it
+// runs as the console service account, since it reconciles RBAC on behalf of
+// an owner rather than acting as them.
+func (c *K8sClient) EnsureProjectServiceAccount(ctx context.Context, ns *corev1.Namespace) error {
+	ctx, span := tracing.Start(ctx, "projects.K8sClient.EnsureProjectServiceAccount", attribute.String("namespace", ns.Name))
+	defer span.End()
+	labels := map[string]string{v1alpha2.LabelManagedBy: v1alpha2.ManagedByValue}
+	sa := &corev1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            ProjectServiceAccountName,
+			Namespace:       ns.Name,
+			Labels:          labels,
+			OwnerReferences: namespaceOwnerRefs(ns),
+		},
+	}
+	if _, err := c.client.CoreV1().ServiceAccounts(ns.Name).Create(ctx, sa, metav1.CreateOptions{}); err != nil && !k8serrors.IsAlreadyExists(err) {
+		return fmt.Errorf("creating project service account: %w", err)
+	}
+	binding := &rbacv1.RoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            ProjectServiceAccountName,
+			Namespace:       ns.Name,
+			Labels:          labels,
+			OwnerReferences: namespaceOwnerRefs(ns),
+		},
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: rbacv1.GroupName,
+			Kind:     "ClusterRole",
+			Name:     projectServiceAccountClusterRole,
+		},
+		Subjects: []rbacv1.Subject{{
+			Kind:      rbacv1.ServiceAccountKind,
+			Name:      ProjectServiceAccountName,
+			Namespace: ns.Name,
+		}},
+	}
+	if err := c.applyRoleBinding(ctx, binding); err != nil {
+		return fmt.Errorf("binding project service account: %w", err)
+	}
+	return nil
+}
+
+// CreateProjectToken mints a token for the project ServiceAccount in ns.
+func (c *K8sClient) CreateProjectToken(ctx context.Context, ns *corev1.Namespace, ttl time.Duration, audience string) (*authenticationv1.TokenRequest, error) {
+	ctx, span := tracing.Start(ctx, "projects.K8sClient.CreateProjectToken", attribute.String("namespace", ns.Name))
+	defer span.End()
+	if err := c.EnsureProjectServiceAccount(ctx, ns); err != nil {
+		return nil, err
+	}
+	seconds := int64(ttl / time.Second)
+	req := &authenticationv1.TokenRequest{
+		Spec: authenticationv1.TokenRequestSpec{ExpirationSeconds: &seconds},
+	}
+	if audience != "" {
+		req.Spec.Audiences = []string{audience}
+	}
+	return c.client.CoreV1().ServiceAccounts(ns.Name).CreateToken(ctx, ProjectServiceAccountName, req, metav1.CreateOptions{})
+}
+
+// CreateProjectToken mints a short-lived token for the project ServiceAccount.
+// The token itself is never logged.
+func (h *Handler) CreateProjectToken(
+	ctx context.Context,
+	req *connect.Request[consolev1.CreateProjectTokenRequest],
+) (*connect.Response[consolev1.CreateProjectTokenResponse], error) {
+	msg := req.Msg
+	ttl := time.Duration(msg.TtlSeconds) * time.Second
+	if msg.TtlSeconds == 0 {
+		ttl = defaultTokenTTL
+	}
+	if ttl < minTokenTTL || ttl > maxTokenTTL {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("ttl_seconds must be between %d and %d", int64(minTokenTTL/time.Second), int64(maxTokenTTL/time.Second)))
+	}
+
+	claims := rpc.MustClaims(ctx)
+	ns, err := h.k8s.GetProject(ctx, msg.Project)
+	if err != nil {
+		return nil, mapK8sError(err)
+	}
+	shareUsers, _ := GetShareUsers(ns)
+	shareRoles, _ := GetShareRoles(ns)
+	if err := h.requireNamespaceOwner(ctx, claims, ns, shareUsers, shareRoles, rbac.PermissionProjectsAdmin, "create project token"); err != nil {
+		return nil, err
+	}
+
+	token, err := h.k8s.CreateProjectToken(ctx, ns, ttl, msg.Audience)
+	if err != nil {
+		return nil, mapK8sError(err)
+	}
+	username := "system:serviceaccount:" + ns.Name + ":" + ProjectServiceAccountName
+	expiresAt := token.Status.ExpirationTimestamp.UTC().Format(time.RFC3339)
+
+	slog.InfoContext(ctx, "project token created",
+		slog.String("action", "project_token_create"),
+		slog.String("resource_type", auditResourceType),
+		slog.String("project", msg.Project),
+		slog.String("organization", GetOrganization(ns)),
+		slog.String("service_account", username),
+		slog.String("audience", msg.Audience),
+		slog.Int64("ttl_seconds", int64(ttl/time.Second)),
+		slog.String("expires_at", expiresAt),
+		slog.String("sub", claims.Sub),
+		slog.String("email", claims.Email),
+	)
+
+	return connect.NewResponse(&consolev1.CreateProjectTokenResponse{
+		Token:          token.Status.Token,
+		ServiceAccount: username,
+		ExpiresAt:      expiresAt,
+	}), nil
+}
@@ -0,0 +1,98 @@
+package projects
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8stesting "k8s.io/client-go/testing"
+
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+)
+
+func TestCreateProjectToken(t *testing.T) {
+	ns := managedNS("my-project", `[{"principal":"alice@example.com","role":"owner"},{"principal":"bob@example.com","role":"editor"}]`)
+	handler, fakeClient := newHandlerWithOrgAndClient(nil, ns)
+	logHandler := &testLogHandler{}
+	slog.SetDefault(slog.New(logHandler))
+
+	var minted *authenticationv1.TokenRequest
+	fakeClient.PrependReactor("create", "serviceaccounts", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		if action.GetSubresource() != "token" {
+			return false, nil, nil
+		}
+		minted = action.(k8stesting.CreateAction).GetObject().(*authenticationv1.TokenRequest).DeepCopy()
+		minted.Status = authenticationv1.TokenRequestStatus{
+			Token:               "minted-token",
+			ExpirationTimestamp: metav1.NewTime(time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)),
+		}
+		return true, minted, nil
+	})
+
+	t.Run("rejects an out of range ttl", func(t *testing.T) {
+		_, err := handler.CreateProjectToken(contextWithClaims("alice@example.com"), connect.NewRequest(&consolev1.CreateProjectTokenRequest{
+			Project:    "my-project",
+			TtlSeconds: 60,
+		}))
+		if connect.CodeOf(err) != connect.CodeInvalidArgument {
+			t.Fatalf("expected InvalidArgument, got %v", err)
+		}
+	})
+
+	t.Run("requires owner", func(t *testing.T) {
+		_, err := handler.CreateProjectToken(contextWithClaims("bob@example.com"), connect.NewRequest(&consolev1.CreateProjectTokenRequest{Project: "my-project"}))
+		if connect.CodeOf(err) != connect.CodePermissionDenied {
+			t.Fatalf("expected PermissionDenied, got %v", err)
+		}
+	})
+
+	t.Run("mints a token for the project service account", func(t *testing.T) {
+		resp, err := handler.CreateProjectToken(contextWithClaims("alice@example.com"), connect.NewRequest(&consolev1.CreateProjectTokenRequest{
+			Project:  "my-project",
+			Audience: "ci.example.com",
+		}))
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if resp.Msg.Token != "minted-token" || resp.Msg.ExpiresAt != "2026-01-02T03:04:05Z" {
+			t.Errorf("unexpected response %v", resp.Msg)
+		}
+		if resp.Msg.ServiceAccount != "system:serviceaccount:holos-prj-my-project:holos-project" {
+			t.Errorf("ServiceAccount = %q", resp.Msg.ServiceAccount)
+		}
+		if got := *minted.Spec.ExpirationSeconds; got != 3600 {
+			t.Errorf("expected default ttl of 3600s, got %d", got)
+		}
+		if len(minted.Spec.Audiences) != 1 || minted.Spec.Audiences[0] != "ci.example.com" {
+			t.Errorf("Audiences = %v", minted.Spec.Audiences)
+		}
+
+		ctx := context.Background()
+		if _, err := fakeClient.CoreV1().ServiceAccounts(ns.Name).Get(ctx, ProjectServiceAccountName, metav1.GetOptions{}); err != nil {
+			t.Errorf("expected project service account, got %v", err)
+		}
+		binding, err := fakeClient.RbacV1().RoleBindings(ns.Name).Get(ctx, ProjectServiceAccountName, metav1.GetOptions{})
+		if err != nil {
+			t.Fatalf("expected project role binding, got %v", err)
+		}
+		if binding.RoleRef.Name != "edit" || binding.Subjects[0].Name != ProjectServiceAccountName {
+			t.Errorf("unexpected binding %v", binding)
+		}
+
+		r := logHandler.findRecord("project_token_create")
+		if r == nil {
+			t.Fatal("expected project_token_create audit log")
+		}
+		if got := findAttr(r, "email"); got != "alice@example.com" {
+			t.Errorf("audit email = %q", got)
+		}
+		if got := findAttr(r, "token"); got != "" {
+			t.Error("audit log must not include the token")
+		}
+	})
+}
@@ -0,0 +1,239 @@
+package projects
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"connectrpc.com/connect"
+	"go.opentelemetry.io/otel/attribute"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	v1alpha2 "github.com/holos-run/holos-console/api/v1alpha2"
+	"github.com/holos-run/holos-console/console/rbac"
+	"github.com/holos-run/holos-console/console/resourcerbac"
+	"github.com/holos-run/holos-console/console/rpc"
+	"github.com/holos-run/holos-console/console/secretrbac"
+	"github.com/holos-run/holos-console/console/secrets"
+	"github.com/holos-run/holos-console/console/tracing"
+	"github.com/holos-run/holos-console/console/trash"
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+)
+
+// projectGrantAnnotations are the grant annotations stripped from a
+// soft-deleted project namespace. Default share grants only seed new
+// secrets and stay in place.
+var projectGrantAnnotations = []string{
+	v1alpha2.AnnotationShareUsers,
+	v1alpha2.AnnotationShareRoles,
+	v1alpha2.AnnotationRBACShareUsers,
+}
+
+// WithTrashRetention switches DeleteProject to soft deletion. Deleted
+// projects can be restored by their owners until retention has passed,
+// after which the trash.Reaper purges them. Zero keeps hard deletion.
+func (h *Handler) WithTrashRetention(retention time.Duration) *Handler {
+	h.trashRetention = retention
+	return h
+}
+
+// TrashProject soft-deletes a project namespace: it is labeled deleted, its
+// grant annotations are stashed, and the RoleBindings derived from them are
+// removed so nobody keeps access to the namespace. Writes run as the
+// service account because the grant annotations are locked to it.
+func (c *K8sClient) TrashProject(ctx context.Context, ns *corev1.Namespace, deletedBy string) error {
+	ctx, span := tracing.Start(ctx, "projects.K8sClient.TrashProject", attribute.String("namespace", ns.Name))
+	defer span.End()
+	ns = ns.DeepCopy()
+	if err := trash.Mark(ns, deletedBy, time.Now(), projectGrantAnnotations...); err != nil {
+		return err
+	}
+	updated, err := c.client.CoreV1().Namespaces().Update(ctx, ns, metav1.UpdateOptions{DryRun: rpc.DryRunFromContext(ctx)})
+	if err != nil || rpc.IsDryRun(ctx) {
+		return err
+	}
+	if err := resourcerbac.EnsureResourceRBAC(ctx, c.client, updated, resourcerbac.Projects); err != nil {
+		return fmt.Errorf("revoking project RBAC: %w", err)
+	}
+	selector := labels.SelectorFromSet(labels.Set{
+		v1alpha2.LabelManagedBy:     v1alpha2.ManagedByValue,
+		secretrbac.LabelRolePurpose: secretrbac.RolePurposeProjectSecrets,
+	})
+	bindings, err := c.client.RbacV1().RoleBindings(ns.Name).List(ctx, metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return fmt.Errorf("listing project secret role bindings: %w", err)
+	}
+	for _, binding := range bindings.Items {
+		if err := c.client.RbacV1().RoleBindings(ns.Name).Delete(ctx, binding.Name, metav1.DeleteOptions{}); err != nil && !k8serrors.IsNotFound(err) {
+			return fmt.Errorf("deleting project secret role binding %q: %w", binding.Name, err)
+		}
+	}
+	return nil
+}
+
+// ListDeletedProjects returns the soft-deleted project namespaces, read with
+// the service account. When org is non-empty, only that organization's
+// projects are returned.
+func (c *K8sClient) ListDeletedProjects(ctx context.Context, org string) ([]corev1.Namespace, error) {
+	ctx, span := tracing.Start(ctx, "projects.K8sClient.ListDeletedProjects", attribute.String("org", org))
+	defer span.End()
+	selector := trash.Selector + "," + v1alpha2.LabelResourceType + "=" + v1alpha2.ResourceTypeProject
+	if org != "" {
+		selector += "," + v1alpha2.LabelOrganization + "=" + org
+	}
+	list, err := c.client.CoreV1().Namespaces().List(ctx, metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// GetDeletedProject returns a soft-deleted project namespace, read with the
+// service account. Projects that are not soft-deleted are reported as
+// NotFound.
+func (c *K8sClient) GetDeletedProject(ctx context.Context, name string) (*corev1.Namespace, error) {
+	ctx, span := tracing.Start(ctx, "projects.K8sClient.GetDeletedProject", attribute.String("name", name))
+	defer span.End()
+	nsName := c.Resolver.ProjectNamespace(name)
+	ns, err := c.client.CoreV1().Namespaces().Get(ctx, nsName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if ns.Labels[v1alpha2.LabelManagedBy] != v1alpha2.ManagedByValue ||
+		ns.Labels[v1alpha2.LabelResourceType] != v1alpha2.ResourceTypeProject ||
+		!trash.IsDeleted(ns) || ns.DeletionTimestamp != nil {
+		return nil, k8serrors.NewNotFound(corev1.Resource("namespaces"), nsName)
+	}
+	return ns, nil
+}
+
+// RestoreProject undoes TrashProject, restoring the grant annotations and
+// reconciling the RoleBindings derived from them.
+func (c *K8sClient) RestoreProject(ctx context.Context, ns *corev1.Namespace) error {
+	ctx, span := tracing.Start(ctx, "projects.K8sClient.RestoreProject", attribute.String("namespace", ns.Name))
+	defer span.End()
+	ns = ns.DeepCopy()
+	if err := trash.Restore(ns); err != nil {
+		return err
+	}
+	updated, err := c.client.CoreV1().Namespaces().Update(ctx, ns, metav1.UpdateOptions{})
+	if err != nil {
+		return err
+	}
+	if err := resourcerbac.EnsureResourceRBAC(ctx, c.client, updated, resourcerbac.Projects); err != nil {
+		return fmt.Errorf("reconciling project RBAC after restore: %w", err)
+	}
+	rbacShareUsers, _ := GetRBACShareUsers(updated)
+	shareRoles, _ := GetShareRoles(updated)
+	return c.EnsureProjectSecretRBACForNamespace(ctx, updated.Name, namespaceOwnerRefs(updated), rbacShareUsers, shareRoles)
+}
+
+// ownedBeforeDeletion reports whether claims held the owner role through
+// the grants stashed when ns was soft-deleted. Kubernetes cannot answer
+// this once the grants' RoleBindings are gone.
+func ownedBeforeDeletion(claims *rpc.Claims, ns *corev1.Namespace) bool {
+	stashed, err := trash.StashedGrants(ns)
+	if err != nil {
+		return false
+	}
+	grants := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Annotations: stashed}}
+	shareUsers, _ := GetShareUsers(grants)
+	shareRoles, _ := GetShareRoles(grants)
+	now := time.Now()
+	return rbac.CheckAccessGrants(claims.Email, claims.EmailVerified, claims.Roles,
+		secrets.ActiveGrantsMap(shareUsers, now), secrets.ActiveGrantsMap(shareRoles, now),
+		rbac.PermissionProjectsAdmin) == nil
+}
+
+// ListDeletedProjects lists the soft-deleted projects the caller owned,
+// most recently deleted first.
+func (h *Handler) ListDeletedProjects(
+	ctx context.Context,
+	req *connect.Request[consolev1.ListDeletedProjectsRequest],
+) (*connect.Response[consolev1.ListDeletedProjectsResponse], error) {
+	claims := rpc.MustClaims(ctx)
+
+	items, err := h.k8s.ListDeletedProjects(ctx, req.Msg.Organization)
+	if err != nil {
+		return nil, mapK8sError(err)
+	}
+	slices.SortFunc(items, func(a, b corev1.Namespace) int {
+		return cmp.Or(trash.DeletedAt(&b).Compare(trash.DeletedAt(&a)), cmp.Compare(a.Name, b.Name))
+	})
+	resp := &consolev1.ListDeletedProjectsResponse{}
+	for i := range items {
+		ns := &items[i]
+		if ns.DeletionTimestamp != nil || !ownedBeforeDeletion(claims, ns) {
+			continue
+		}
+		name, err := h.k8s.Resolver.ProjectFromNamespace(ns.Name)
+		if err != nil {
+			continue
+		}
+		deletedAt := trash.DeletedAt(ns)
+		deleted := &consolev1.DeletedProject{
+			Name:         name,
+			DisplayName:  ns.Annotations[v1alpha2.AnnotationDisplayName],
+			Organization: GetOrganization(ns),
+			DeletedAt:    deletedAt.UTC().Format(time.RFC3339),
+			DeletedBy:    trash.DeletedBy(ns),
+		}
+		if h.trashRetention > 0 {
+			deleted.PurgeAt = trash.PurgeAt(deletedAt, h.trashRetention).UTC().Format(time.RFC3339)
+		}
+		resp.Projects = append(resp.Projects, deleted)
+	}
+
+	slog.InfoContext(ctx, "deleted projects listed",
+		slog.String("action", "project_trash_list"),
+		slog.String("resource_type", auditResourceType),
+		slog.String("organization", req.Msg.Organization),
+		slog.String("sub", claims.Sub),
+		slog.String("email", claims.Email),
+		slog.Int("count", len(resp.Projects)),
+	)
+	return connect.NewResponse(resp), nil
+}
+
+// RestoreProject restores a soft-deleted project and its grants. Only
+// principals who owned the project when it was deleted may restore it.
+func (h *Handler) RestoreProject(
+	ctx context.Context,
+	req *connect.Request[consolev1.RestoreProjectRequest],
+) (*connect.Response[consolev1.RestoreProjectResponse], error) {
+	claims := rpc.MustClaims(ctx)
+
+	ns, err := h.k8s.GetDeletedProject(ctx, req.Msg.Name)
+	if err != nil {
+		return nil, mapK8sError(err)
+	}
+	if !ownedBeforeDeletion(claims, ns) {
+		slog.WarnContext(ctx, "project restore denied",
+			slog.String("action", "project_restore_denied"),
+			slog.String("resource_type", auditResourceType),
+			slog.String("project", req.Msg.Name),
+			slog.String("sub", claims.Sub),
+			slog.String("email", claims.Email),
+		)
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("RBAC: not authorized to restore project"))
+	}
+	if err := h.k8s.RestoreProject(ctx, ns); err != nil {
+		return nil, mapK8sError(err)
+	}
+
+	slog.InfoContext(ctx, "project restored",
+		slog.String("action", "project_restore"),
+		slog.String("resource_type", auditResourceType),
+		slog.String("project", req.Msg.Name),
+		slog.String("organization", GetOrganization(ns)),
+		slog.String("sub", claims.Sub),
+		slog.String("email", claims.Email),
+	)
+	return connect.NewResponse(&consolev1.RestoreProjectResponse{}), nil
+}
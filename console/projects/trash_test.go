@@ -0,0 +1,140 @@
+package projects
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1alpha2 "github.com/holos-run/holos-console/api/v1alpha2"
+	"github.com/holos-run/holos-console/console/secretrbac"
+	"github.com/holos-run/holos-console/console/trash"
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+)
+
+const ownerGrants = `[{"principal":"alice@example.com","role":"owner"},{"principal":"bob@example.com","role":"viewer"}]`
+
+func TestDeleteProject_SoftDelete(t *testing.T) {
+	retention := 7 * 24 * time.Hour
+	newTrashHandler := func(t *testing.T) *Handler {
+		t.Helper()
+		handler, _ := newHandler(managedNS("my-project", ownerGrants))
+		handler.WithTrashRetention(retention)
+		binding := &rbacv1.RoleBinding{ObjectMeta: metav1.ObjectMeta{
+			Name:      "project-secrets-alice",
+			Namespace: "holos-prj-my-project",
+			Labels: map[string]string{
+				v1alpha2.LabelManagedBy:     v1alpha2.ManagedByValue,
+				secretrbac.LabelRolePurpose: secretrbac.RolePurposeProjectSecrets,
+			},
+		}}
+		if _, err := handler.k8s.client.RbacV1().RoleBindings(binding.Namespace).Create(context.Background(), binding, metav1.CreateOptions{}); err != nil {
+			t.Fatal(err)
+		}
+		return handler
+	}
+	deleteProject := func(t *testing.T, handler *Handler) {
+		t.Helper()
+		_, err := handler.DeleteProject(contextWithClaims("alice@example.com"), connect.NewRequest(&consolev1.DeleteProjectRequest{Name: "my-project"}))
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	}
+
+	t.Run("hides the project and strips grants", func(t *testing.T) {
+		handler := newTrashHandler(t)
+		deleteProject(t, handler)
+		ctx := contextWithClaims("alice@example.com")
+
+		ns, err := handler.k8s.client.CoreV1().Namespaces().Get(ctx, "holos-prj-my-project", metav1.GetOptions{})
+		if err != nil {
+			t.Fatalf("expected namespace to remain in the trash, got %v", err)
+		}
+		if !trash.IsDeleted(ns) || trash.DeletedBy(ns) != "alice@example.com" {
+			t.Errorf("expected namespace marked deleted by alice, got labels=%v annotations=%v", ns.Labels, ns.Annotations)
+		}
+		if _, ok := ns.Annotations[v1alpha2.AnnotationShareUsers]; ok {
+			t.Error("expected share-users to be stripped")
+		}
+		bindings, err := handler.k8s.client.RbacV1().RoleBindings(ns.Name).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(bindings.Items) != 0 {
+			t.Errorf("expected project secret role bindings to be removed, got %d", len(bindings.Items))
+		}
+
+		_, err = handler.GetProject(ctx, connect.NewRequest(&consolev1.GetProjectRequest{Name: "my-project"}))
+		if connect.CodeOf(err) != connect.CodeNotFound {
+			t.Errorf("expected NotFound for a deleted project, got %v", err)
+		}
+		list, err := handler.ListProjects(ctx, connect.NewRequest(&consolev1.ListProjectsRequest{}))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(list.Msg.Projects) != 0 {
+			t.Errorf("expected deleted project to be hidden, got %v", list.Msg.Projects)
+		}
+	})
+
+	t.Run("lists deleted projects for former owners", func(t *testing.T) {
+		handler := newTrashHandler(t)
+		deleteProject(t, handler)
+
+		resp, err := handler.ListDeletedProjects(contextWithClaims("alice@example.com"), connect.NewRequest(&consolev1.ListDeletedProjectsRequest{}))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(resp.Msg.Projects) != 1 {
+			t.Fatalf("expected 1 deleted project, got %v", resp.Msg.Projects)
+		}
+		got := resp.Msg.Projects[0]
+		deletedAt, err := time.Parse(time.RFC3339, got.DeletedAt)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.Name != "my-project" || got.DeletedBy != "alice@example.com" || got.PurgeAt != deletedAt.Add(retention).Format(time.RFC3339) {
+			t.Errorf("unexpected deleted project %v", got)
+		}
+
+		resp, err = handler.ListDeletedProjects(contextWithClaims("bob@example.com"), connect.NewRequest(&consolev1.ListDeletedProjectsRequest{}))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(resp.Msg.Projects) != 0 {
+			t.Errorf("expected viewers not to see deleted projects, got %v", resp.Msg.Projects)
+		}
+	})
+
+	t.Run("restores the project for former owners", func(t *testing.T) {
+		handler := newTrashHandler(t)
+		deleteProject(t, handler)
+		req := connect.NewRequest(&consolev1.RestoreProjectRequest{Name: "my-project"})
+
+		_, err := handler.RestoreProject(contextWithClaims("bob@example.com"), req)
+		if connect.CodeOf(err) != connect.CodePermissionDenied {
+			t.Fatalf("expected PermissionDenied for a former viewer, got %v", err)
+		}
+		if _, err := handler.RestoreProject(contextWithClaims("alice@example.com"), req); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		ns, err := handler.k8s.GetProject(contextWithClaims("alice@example.com"), "my-project")
+		if err != nil {
+			t.Fatalf("expected restored project to be readable, got %v", err)
+		}
+		if ns.Annotations[v1alpha2.AnnotationShareUsers] != ownerGrants {
+			t.Errorf("expected share-users restored, got %q", ns.Annotations[v1alpha2.AnnotationShareUsers])
+		}
+	})
+
+	t.Run("requires owner to delete", func(t *testing.T) {
+		handler := newTrashHandler(t)
+		_, err := handler.DeleteProject(contextWithClaims("bob@example.com"), connect.NewRequest(&consolev1.DeleteProjectRequest{Name: "my-project"}))
+		if connect.CodeOf(err) != connect.CodePermissionDenied {
+			t.Errorf("expected PermissionDenied, got %v", err)
+		}
+	})
+}
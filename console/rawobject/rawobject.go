@@ -0,0 +1,72 @@
+// Package rawobject serializes the Kubernetes objects returned by the
+// Get*Raw RPCs as JSON or YAML, optionally as manifests that apply cleanly
+// to another cluster.
+package rawobject
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"sigs.k8s.io/yaml"
+
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+)
+
+// serverFields are the metadata fields the API server populates. They
+// identify the object in its source cluster, so kubectl apply elsewhere
+// rejects or ignores them.
+var serverFields = []string{
+	"uid",
+	"resourceVersion",
+	"generation",
+	"creationTimestamp",
+	"managedFields",
+	"ownerReferences",
+	"selfLink",
+}
+
+// Marshal serializes obj, whose apiVersion and kind must be set, in format.
+// With strip the server-populated metadata fields and status are omitted.
+// Errors are connect errors.
+func Marshal(obj any, format consolev1.RawFormat, strip bool) (string, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return "", connect.NewError(connect.CodeInternal, fmt.Errorf("marshaling object to JSON: %w", err))
+	}
+	if strip {
+		if data, err = stripServerFields(data); err != nil {
+			return "", connect.NewError(connect.CodeInternal, err)
+		}
+	}
+	switch format {
+	case consolev1.RawFormat_RAW_FORMAT_UNSPECIFIED, consolev1.RawFormat_RAW_FORMAT_JSON:
+		return string(data), nil
+	case consolev1.RawFormat_RAW_FORMAT_YAML:
+		out, err := yaml.JSONToYAML(data)
+		if err != nil {
+			return "", connect.NewError(connect.CodeInternal, fmt.Errorf("converting object to YAML: %w", err))
+		}
+		return string(out), nil
+	}
+	return "", connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unsupported raw format %v", format))
+}
+
+// stripServerFields removes serverFields and status from a JSON object.
+func stripServerFields(data []byte) ([]byte, error) {
+	var obj map[string]any
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return nil, fmt.Errorf("decoding object: %w", err)
+	}
+	delete(obj, "status")
+	if metadata, ok := obj["metadata"].(map[string]any); ok {
+		for _, field := range serverFields {
+			delete(metadata, field)
+		}
+	}
+	out, err := json.Marshal(obj)
+	if err != nil {
+		return nil, fmt.Errorf("encoding object: %w", err)
+	}
+	return out, nil
+}
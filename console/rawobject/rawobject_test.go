@@ -0,0 +1,66 @@
+package rawobject
+
+import (
+	"strings"
+	"testing"
+
+	"connectrpc.com/connect"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+)
+
+func testSecret() *corev1.Secret {
+	return &corev1.Secret{
+		TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Secret"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "db",
+			Namespace:       "prj-web",
+			UID:             "uid-1",
+			ResourceVersion: "42",
+			ManagedFields:   []metav1.ManagedFieldsEntry{{Manager: "console"}},
+			Labels:          map[string]string{"app": "web"},
+		},
+		Data: map[string][]byte{"password": []byte("hunter2")},
+	}
+}
+
+func TestMarshal(t *testing.T) {
+	t.Run("json keeps server fields by default", func(t *testing.T) {
+		got, err := Marshal(testSecret(), consolev1.RawFormat_RAW_FORMAT_UNSPECIFIED, false)
+		if err != nil {
+			t.Fatalf("Marshal: %v", err)
+		}
+		if !strings.HasPrefix(got, "{") || !strings.Contains(got, `"resourceVersion":"42"`) {
+			t.Errorf("expected verbatim JSON, got %s", got)
+		}
+	})
+
+	t.Run("yaml without server fields", func(t *testing.T) {
+		got, err := Marshal(testSecret(), consolev1.RawFormat_RAW_FORMAT_YAML, true)
+		if err != nil {
+			t.Fatalf("Marshal: %v", err)
+		}
+		want := `apiVersion: v1
+data:
+  password: aHVudGVyMg==
+kind: Secret
+metadata:
+  labels:
+    app: web
+  name: db
+  namespace: prj-web
+`
+		if got != want {
+			t.Errorf("got:\n%s\nwant:\n%s", got, want)
+		}
+	})
+
+	t.Run("rejects unknown formats", func(t *testing.T) {
+		_, err := Marshal(testSecret(), consolev1.RawFormat(99), false)
+		if connect.CodeOf(err) != connect.CodeInvalidArgument {
+			t.Fatalf("expected InvalidArgument, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,179 @@
+package rbac
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"connectrpc.com/connect"
+	"go.opentelemetry.io/otel/attribute"
+	authv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/holos-run/holos-console/console/principal"
+	"github.com/holos-run/holos-console/console/rpc"
+	"github.com/holos-run/holos-console/console/tracing"
+)
+
+// Resource is one level of the organization → folder → project hierarchy as
+// seen by an Authorizer.
+type Resource struct {
+	// Namespace is the Kubernetes namespace backing the resource. When set
+	// and the request carries impersonated clients, the caller's role is
+	// derived from SelfSubjectAccessReviews on the namespace instead of
+	// from Users and Roles.
+	Namespace string
+	// Users and Roles are the active share grants on the resource, keyed by
+	// email and role claim respectively. A DenyRole value excludes the
+	// principal.
+	Users map[string]string
+	Roles map[string]string
+	// Parent is the next level up the hierarchy, or nil at the top.
+	Parent *Resource
+	// Cascade maps the roles held on Parent to the permissions they confer
+	// on this resource. A nil Cascade stops evaluation at this level.
+	Cascade CascadeTable
+}
+
+// namespaceVerbs orders the namespace verbs that prove each role, highest
+// first: delete for owners, update for editors, get for viewers.
+var namespaceVerbs = []struct {
+	verb string
+	role Role
+}{
+	{"delete", RoleOwner},
+	{"update", RoleEditor},
+	{"get", RoleViewer},
+}
+
+// Authorizer evaluates a permission against a resource and the parents that
+// cascade to it. Every handler that gates on roles goes through Authorize so
+// the per-resource and cascade rules cannot drift between services. The zero
+// value is ready to use.
+type Authorizer struct{}
+
+// Authorize returns nil if subject holds permission on resource, either
+// through its own role on resource or through a role on an ancestor whose
+// Cascade table grants the permission. A deny grant on a level overrides
+// that level and everything above it, and a permission outside the subject's
+// token scope is always denied. It returns a PermissionDenied error
+// otherwise, or the underlying error if no role could be established because
+// an access review failed.
+//
+// The outcome and the time spent resolving roles are recorded with
+// rpc.RecordAuthorization and rpc.ObserveGrantResolution.
+func (a Authorizer) Authorize(ctx context.Context, subject *rpc.Claims, resource *Resource, permission Permission) error {
+	start := time.Now()
+	err := a.authorize(ctx, subject, resource, permission)
+	rpc.ObserveGrantResolution(ctx, start)
+	rpc.RecordAuthorization(ctx, err)
+	if err == nil {
+		auditPatternGrant(ctx, subject, resource, permission)
+	}
+	return err
+}
+
+// auditPatternGrant records an authorization where the user grant naming
+// subject on resource is a domain or wildcard grant rather than one of
+// their own, so the audit log shows which broad grant let them in.
+func auditPatternGrant(ctx context.Context, subject *rpc.Claims, resource *Resource, permission Permission) {
+	matched, how := MatchedUserGrant(subject.Email, subject.EmailVerified, resource.Users)
+	if how != principal.MatchDomain && how != principal.MatchWildcard {
+		return
+	}
+	slog.InfoContext(ctx, "access matched a pattern grant",
+		slog.String("action", "pattern_grant_match"),
+		slog.String("namespace", resource.Namespace),
+		slog.String("permission", permission.String()),
+		slog.String("matched_grant", matched),
+		slog.String("role", resource.Users[matched]),
+		slog.String("sub", subject.Sub),
+		slog.String("email", subject.Email),
+	)
+}
+
+func (a Authorizer) authorize(ctx context.Context, subject *rpc.Claims, resource *Resource, permission Permission) error {
+	denied := connect.NewError(connect.CodePermissionDenied, fmt.Errorf("RBAC: authorization denied"))
+	if !subject.Allows(permission) || resource.denies(subject) {
+		return denied
+	}
+	var reviewErr error
+	role, err := EffectiveRole(ctx, subject, resource)
+	if err != nil {
+		reviewErr = err
+	}
+	if HasPermission(role, permission) {
+		return nil
+	}
+	for child := resource; child.Parent != nil && child.Cascade != nil; child = child.Parent {
+		if child.Parent.denies(subject) {
+			break
+		}
+		role, err := EffectiveRole(ctx, subject, child.Parent)
+		if err != nil && reviewErr == nil {
+			reviewErr = err
+		}
+		if HasCascadePermission(role, permission, child.Cascade) {
+			return nil
+		}
+	}
+	if reviewErr != nil {
+		return reviewErr
+	}
+	return denied
+}
+
+// EffectiveRole returns the highest role subject holds on resource itself,
+// ignoring parents. A deny grant yields RoleUnspecified. Otherwise, with
+// impersonated clients and a Namespace the apiserver decides; without them
+// the share grants do. A review error is returned only when no role could be
+// established.
+func EffectiveRole(ctx context.Context, subject *rpc.Claims, resource *Resource) (Role, error) {
+	if resource.denies(subject) {
+		return RoleUnspecified, nil
+	}
+	if resource.Namespace == "" || !rpc.HasImpersonatedClients(ctx) {
+		return BestRoleFromGrants(subject.Email, subject.EmailVerified, subject.Roles, resource.Users, resource.Roles), nil
+	}
+	var firstErr error
+	for _, nv := range namespaceVerbs {
+		ok, err := canVerbNamespace(ctx, nv.verb, resource.Namespace)
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		if ok {
+			return nv.role, nil
+		}
+	}
+	return RoleUnspecified, firstErr
+}
+
+// denies reports whether a deny grant on the resource itself matches
+// subject. Deny grants are honored even when the apiserver decides the role,
+// because Kubernetes RBAC cannot express them.
+func (r *Resource) denies(subject *rpc.Claims) bool {
+	return DeniedByGrants(subject.Email, subject.EmailVerified, subject.Roles, r.Users, r.Roles)
+}
+
+func canVerbNamespace(ctx context.Context, verb, name string) (bool, error) {
+	ctx, span := tracing.Start(ctx, "rbac.canVerbNamespace", attribute.String("name", name), attribute.String("verb", verb))
+	defer span.End()
+	review := &authv1.SelfSubjectAccessReview{
+		Spec: authv1.SelfSubjectAccessReviewSpec{
+			ResourceAttributes: &authv1.ResourceAttributes{
+				Verb:     verb,
+				Resource: "namespaces",
+				Name:     name,
+			},
+		},
+	}
+	got, err := rpc.ImpersonatedClientsetFromContext(ctx).AuthorizationV1().SelfSubjectAccessReviews().Create(ctx, review, metav1.CreateOptions{})
+	if err != nil {
+		return false, err
+	}
+	return got.Status.Allowed, nil
+}
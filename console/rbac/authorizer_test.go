@@ -0,0 +1,148 @@
+package rbac
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"connectrpc.com/connect"
+	authzv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	clienttesting "k8s.io/client-go/testing"
+
+	"github.com/holos-run/holos-console/console/rpc"
+)
+
+func TestAuthorizer_Grants(t *testing.T) {
+	alice := &rpc.Claims{Email: "alice@example.com", Roles: []string{"devs"}}
+	project := &Resource{Users: map[string]string{"alice@example.com": "viewer"}}
+	cascaded := &Resource{
+		Parent:  &Resource{Roles: map[string]string{"devs": "owner"}},
+		Cascade: OrgCascadeProjectSettingsPerms,
+	}
+	uncascaded := &Resource{Parent: cascaded.Parent}
+
+	for _, tt := range []struct {
+		name       string
+		resource   *Resource
+		permission Permission
+		allowed    bool
+	}{
+		{"direct grant", project, PermissionProjectSettingsRead, true},
+		{"direct grant lacks permission", project, PermissionProjectsAdmin, false},
+		{"parent owner cascades", cascaded, PermissionProjectDeploymentsEnable, true},
+		{"cascade table lacks permission", cascaded, PermissionProjectsAdmin, false},
+		{"parent without cascade table", uncascaded, PermissionProjectDeploymentsEnable, false},
+		{"no grants", &Resource{}, PermissionProjectSettingsRead, false},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Authorizer{}.Authorize(context.Background(), alice, tt.resource, tt.permission)
+			if tt.allowed && err != nil {
+				t.Fatalf("expected access, got %v", err)
+			}
+			if !tt.allowed && connect.CodeOf(err) != connect.CodePermissionDenied {
+				t.Fatalf("expected PermissionDenied, got %v", err)
+			}
+		})
+	}
+}
+
+// impersonatedContext returns a context whose impersonated clientset allows
+// the listed namespace verbs on every namespace.
+func impersonatedContext(reviewErr error, verbs ...string) context.Context {
+	allowed := map[string]bool{}
+	for _, verb := range verbs {
+		allowed[verb] = true
+	}
+	client := fake.NewClientset()
+	client.PrependReactor("create", "selfsubjectaccessreviews", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		if reviewErr != nil {
+			return true, nil, reviewErr
+		}
+		ssar := action.(clienttesting.CreateAction).GetObject().(*authzv1.SelfSubjectAccessReview)
+		ssar.Status.Allowed = allowed[ssar.Spec.ResourceAttributes.Verb]
+		return true, ssar, nil
+	})
+	return rpc.ContextWithImpersonatedClients(context.Background(), &rpc.ImpersonatedClients{Clientset: client})
+}
+
+func TestEffectiveRole_Impersonated(t *testing.T) {
+	// Grants name alice an owner, but the apiserver decides when the request
+	// is impersonated.
+	alice := &rpc.Claims{Email: "alice@example.com"}
+	ns := &Resource{Namespace: "holos-prj-billing", Users: map[string]string{"alice@example.com": "owner"}}
+
+	for _, tt := range []struct {
+		verbs []string
+		want  Role
+	}{
+		{[]string{"delete", "update", "get"}, RoleOwner},
+		{[]string{"update", "get"}, RoleEditor},
+		{[]string{"get"}, RoleViewer},
+		{nil, RoleUnspecified},
+	} {
+		got, err := EffectiveRole(impersonatedContext(nil, tt.verbs...), alice, ns)
+		if err != nil {
+			t.Fatalf("EffectiveRole(%v): %v", tt.verbs, err)
+		}
+		if got != tt.want {
+			t.Errorf("EffectiveRole(%v) = %v, want %v", tt.verbs, got, tt.want)
+		}
+	}
+
+	if err := (Authorizer{}).Authorize(impersonatedContext(nil, "update"), alice, ns, PermissionProjectsAdmin); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Fatalf("expected editor to be denied admin, got %v", err)
+	}
+
+	reviewErr := errors.New("apiserver unavailable")
+	if err := (Authorizer{}).Authorize(impersonatedContext(reviewErr), alice, ns, PermissionProjectsAdmin); !errors.Is(err, reviewErr) {
+		t.Fatalf("expected review error, got %v", err)
+	}
+}
+
+func TestAuthorizer_Deny(t *testing.T) {
+	bob := &rpc.Claims{Email: "bob@example.com", Roles: []string{"devs"}}
+	org := &Resource{Roles: map[string]string{"devs": "owner"}}
+
+	denied := &Resource{
+		Users:   map[string]string{"bob@example.com": DenyRole},
+		Parent:  org,
+		Cascade: OrgCascadeProjectSettingsPerms,
+	}
+	if err := (Authorizer{}).Authorize(context.Background(), bob, denied, PermissionProjectDeploymentsEnable); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Fatalf("expected deny to override the cascaded org grant, got %v", err)
+	}
+
+	// A deny on the parent stops its grants from cascading.
+	deniedOrg := &Resource{Roles: map[string]string{"devs": "owner", "contractors": DenyRole}}
+	contractor := &rpc.Claims{Email: "carol@example.com", Roles: []string{"devs", "contractors"}}
+	child := &Resource{Parent: deniedOrg, Cascade: OrgCascadeProjectSettingsPerms}
+	if err := (Authorizer{}).Authorize(context.Background(), contractor, child, PermissionProjectDeploymentsEnable); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Fatalf("expected parent deny to block cascade, got %v", err)
+	}
+
+	// Deny grants apply even when the apiserver would allow.
+	ns := &Resource{Namespace: "holos-prj-billing", Users: map[string]string{"bob@example.com": DenyRole}}
+	if role, err := EffectiveRole(impersonatedContext(nil, "delete"), bob, ns); err != nil || role != RoleUnspecified {
+		t.Fatalf("expected denied subject to have no role, got %v, %v", role, err)
+	}
+}
+
+func TestAuthorizer_TokenScope(t *testing.T) {
+	org := &Resource{Users: map[string]string{"alice@example.com": "owner"}}
+	alice := &rpc.Claims{Email: "alice@example.com"}
+	if err := (Authorizer{}).Authorize(context.Background(), alice, org, PermissionProjectsAdmin); err != nil {
+		t.Fatalf("expected unscoped owner to be allowed, got %v", err)
+	}
+
+	// A scoped token only carries the permissions it lists, whatever the
+	// role of its creator.
+	scoped := &rpc.Claims{Email: "alice@example.com", TokenID: "0123456789abcdef", Permissions: []Permission{PermissionProjectSettingsRead}}
+	if err := (Authorizer{}).Authorize(context.Background(), scoped, org, PermissionProjectSettingsRead); err != nil {
+		t.Fatalf("expected scoped permission to be allowed, got %v", err)
+	}
+	if err := (Authorizer{}).Authorize(context.Background(), scoped, org, PermissionProjectsAdmin); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Fatalf("expected a permission outside the token scope to be denied, got %v", err)
+	}
+}
@@ -5,29 +5,34 @@
 // rpc.ImpersonatedClientsetFromContext and per-resource RoleBindings
 // reconciled by console/resourcerbac (HOL-1062 / HOL-1063 / HOL-1064).
 //
-// What remains is intentionally small and load-bearing for two narrow uses:
+// What remains is intentionally small and load-bearing:
 //
-//  1. console/settings still gates ProjectSettings reads/writes via
-//     in-process grant evaluation. Migrating it to impersonation is tracked
-//     as a follow-up; until then it imports CheckAccessGrants and
-//     CheckCascadeAccess plus the two Permission constants those calls take.
+//  1. Authorizer evaluates a permission against a resource and the parents
+//     that cascade to it. With impersonated clients it asks the apiserver
+//     via SelfSubjectAccessReviews on the backing namespace; otherwise it
+//     falls back to in-process share-grant evaluation. console/settings and
+//     the owner checks in console/{organizations,folders,projects} all go
+//     through it so the per-resource and cascade rules cannot drift.
 //
 //  2. console/{organizations,folders,projects} use the Role enum and
-//     BestRoleFromGrants / RoleLevel / RoleFromString to derive the
-//     userRole field returned in list/get responses for UI hints. This
+//     EffectiveRole to derive the userRole field returned in list/get
+//     responses for UI hints, and RoleSourceFromGrants to explain it. This
 //     derivation does not gate access — the apiserver already did that —
 //     but the proto field is part of the public API contract.
 //
-// New code MUST NOT import this package. Add new gating via Kubernetes RBAC
-// + impersonation. The settings handler is expected to follow when its
-// migration lands.
+// New code MUST NOT grow the grant-evaluation fallback. Add new gating via
+// Kubernetes RBAC + impersonation, reaching it through Authorizer where a
+// console role is involved.
 package rbac
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 
 	"connectrpc.com/connect"
+
+	"github.com/holos-run/holos-console/console/principal"
 	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
 )
 
@@ -46,25 +51,45 @@ const (
 
 // Permission constants used by the surviving call sites.
 //
-// PermissionProjectSettingsRead is the permission CheckAccessGrants resolves
-// for project-grant evaluation in console/settings.
+// PermissionProjectSettingsRead is the permission console/settings
+// authorizes for project-grant evaluation.
+//
+// PermissionProjectDeploymentsEnable is the permission console/settings
+// authorizes through the org→project cascade in
+// OrgCascadeProjectSettingsPerms.
 //
-// PermissionProjectDeploymentsEnable is the permission CheckCascadeAccess
-// resolves for the org→project cascade in OrgCascadeProjectSettingsPerms.
+// The admin and create permissions are the owner-only operations of the
+// namespace-backed handlers: sharing updates on the resource itself, and
+// creating or re-parenting children under it.
 const (
 	PermissionProjectSettingsRead      = consolev1.Permission_PERMISSION_PROJECT_SETTINGS_READ
 	PermissionProjectDeploymentsEnable = consolev1.Permission_PERMISSION_PROJECT_DEPLOYMENTS_ENABLE
+	PermissionOrganizationsAdmin       = consolev1.Permission_PERMISSION_ORGANIZATIONS_ADMIN
+	PermissionFoldersAdmin             = consolev1.Permission_PERMISSION_FOLDERS_ADMIN
+	PermissionFoldersCreate            = consolev1.Permission_PERMISSION_FOLDERS_CREATE
+	PermissionProjectsAdmin            = consolev1.Permission_PERMISSION_PROJECTS_ADMIN
+	PermissionProjectsCreate           = consolev1.Permission_PERMISSION_PROJECTS_CREATE
 )
 
-// rolePermissions enumerates the per-role grants CheckAccessGrants consults.
-// Trimmed to the only Permission still consumed by an in-process check
-// (PermissionProjectSettingsRead in console/settings).
+// rolePermissions enumerates the per-role grants HasPermission consults.
 var rolePermissions = map[Role]map[Permission]bool{
 	RoleViewer: {PermissionProjectSettingsRead: true},
 	RoleEditor: {PermissionProjectSettingsRead: true},
-	RoleOwner:  {PermissionProjectSettingsRead: true},
+	RoleOwner: {
+		PermissionProjectSettingsRead: true,
+		PermissionOrganizationsAdmin:  true,
+		PermissionFoldersAdmin:        true,
+		PermissionFoldersCreate:       true,
+		PermissionProjectsAdmin:       true,
+		PermissionProjectsCreate:      true,
+	},
 }
 
+// DenyRole is the role value a deny grant carries in share-grant maps. A
+// matching deny overrides every other grant on the same resource and stops
+// evaluation of the grants that would cascade from its parents.
+const DenyRole = "deny"
+
 // HasPermission returns true if role has been granted permission in the
 // rolePermissions table.
 func HasPermission(role Role, permission Permission) bool {
@@ -91,33 +116,36 @@ func RoleFromString(s string) Role {
 }
 
 // CheckAccessGrants verifies access using per-user and per-role sharing
-// grants. Returns nil if granted, or a PermissionDenied error otherwise.
+// grants. A user grant may name the user's email, their email domain as
+// "*@example.com", or every user as "*". Only the most specific user grants
+// that match count, user before domain before wildcard, so a viewer grant
+// naming the user is not raised by an editor grant on their domain; every
+// matching role grant counts. A domain grant requires emailVerified.
+// Returns nil if granted, or a PermissionDenied error otherwise.
 func CheckAccessGrants(
 	userEmail string,
+	emailVerified bool,
 	userRoles []string,
 	shareUsers map[string]string,
 	shareRoles map[string]string,
 	permission Permission,
 ) error {
+	if DeniedByGrants(userEmail, emailVerified, userRoles, shareUsers, shareRoles) {
+		return connect.NewError(
+			connect.CodePermissionDenied,
+			fmt.Errorf("RBAC: authorization denied"),
+		)
+	}
 	bestLevel := -1
 
-	if shareUsers != nil {
-		emailLower := strings.ToLower(userEmail)
-		for email, roleName := range shareUsers {
-			if strings.ToLower(email) == emailLower {
-				role := RoleFromString(roleName)
-				if level := roleLevel[role]; level > bestLevel {
-					bestLevel = level
-				}
-			}
-		}
+	if level := userGrantLevel(userEmail, emailVerified, shareUsers); level > bestLevel {
+		bestLevel = level
 	}
 
 	if shareRoles != nil {
 		for _, ur := range userRoles {
-			urLower := strings.ToLower(ur)
 			for roleClaim, roleName := range shareRoles {
-				if strings.ToLower(roleClaim) == urLower {
+				if GroupPrincipalMatches(roleClaim, ur) {
 					role := RoleFromString(roleName)
 					if level := roleLevel[role]; level > bestLevel {
 						bestLevel = level
@@ -144,32 +172,28 @@ func CheckAccessGrants(
 }
 
 // BestRoleFromGrants returns the highest role the user holds via grants, or
-// RoleUnspecified if none match.
+// RoleUnspecified if none match or a deny grant matches. User grants follow
+// the precedence of CheckAccessGrants.
 func BestRoleFromGrants(
 	userEmail string,
+	emailVerified bool,
 	userRoles []string,
 	shareUsers map[string]string,
 	shareRoles map[string]string,
 ) Role {
+	if DeniedByGrants(userEmail, emailVerified, userRoles, shareUsers, shareRoles) {
+		return RoleUnspecified
+	}
 	bestLevel := 0
 
-	if shareUsers != nil {
-		emailLower := strings.ToLower(userEmail)
-		for email, roleName := range shareUsers {
-			if strings.ToLower(email) == emailLower {
-				role := RoleFromString(roleName)
-				if level := roleLevel[role]; level > bestLevel {
-					bestLevel = level
-				}
-			}
-		}
+	if level := userGrantLevel(userEmail, emailVerified, shareUsers); level > bestLevel {
+		bestLevel = level
 	}
 
 	if shareRoles != nil {
 		for _, ur := range userRoles {
-			urLower := strings.ToLower(ur)
 			for roleClaim, roleName := range shareRoles {
-				if strings.ToLower(roleClaim) == urLower {
+				if GroupPrincipalMatches(roleClaim, ur) {
 					role := RoleFromString(roleName)
 					if level := roleLevel[role]; level > bestLevel {
 						bestLevel = level
@@ -187,6 +211,82 @@ func BestRoleFromGrants(
 	return RoleUnspecified
 }
 
+// DeniedByGrants reports whether a DenyRole grant matches the user or any
+// of the user's roles. Among user grants only the most specific that match
+// count, user before domain before wildcard, so a grant naming the user
+// exempts them from a deny on their domain or on "*".
+func DeniedByGrants(
+	userEmail string,
+	emailVerified bool,
+	userRoles []string,
+	shareUsers map[string]string,
+	shareRoles map[string]string,
+) bool {
+	_, best := MatchedUserGrant(userEmail, emailVerified, shareUsers)
+	for email, roleName := range shareUsers {
+		if roleName == DenyRole && best != principal.NoMatch && principal.Matches(email, userEmail, emailVerified) == best {
+			return true
+		}
+	}
+	for roleClaim, roleName := range shareRoles {
+		if roleName != DenyRole {
+			continue
+		}
+		for _, ur := range userRoles {
+			if GroupPrincipalMatches(roleClaim, ur) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// MatchedUserGrant returns the user grant principal in shareUsers that names
+// the user most specifically, e.g. "*@example.com" for a user with no grant
+// of their own, and how it matched. It returns principal.NoMatch when no
+// user grant names the user.
+func MatchedUserGrant(userEmail string, emailVerified bool, shareUsers map[string]string) (string, principal.Match) {
+	principals := make([]string, 0, len(shareUsers))
+	for p := range shareUsers {
+		principals = append(principals, p)
+	}
+	// Sorted so ties between spellings of the same principal resolve the
+	// same way every time.
+	slices.Sort(principals)
+	return principal.Best(principals, userEmail, emailVerified)
+}
+
+// userGrantLevel returns the highest role level among the user grants in
+// shareUsers that name the user most specifically, or 0 when none does.
+func userGrantLevel(userEmail string, emailVerified bool, shareUsers map[string]string) int {
+	_, best := MatchedUserGrant(userEmail, emailVerified, shareUsers)
+	if best == principal.NoMatch {
+		return 0
+	}
+	level := 0
+	for p, roleName := range shareUsers {
+		if principal.Matches(p, userEmail, emailVerified) == best {
+			level = max(level, roleLevel[RoleFromString(roleName)])
+		}
+	}
+	return level
+}
+
+// groupPrincipalPrefix is the prefix ADR 036 gives OIDC groups as
+// Kubernetes principals.
+const groupPrincipalPrefix = "oidc:"
+
+// GroupPrincipalMatches reports whether the principal of a role grant names
+// group, a group claim of the caller. A grant may name a group as the ID
+// token carries it, e.g. "platform-admins", or as the Kubernetes principal
+// the console impersonates it as, "oidc:platform-admins"; both resolve to
+// the same group, and like user grants the match ignores case. Every
+// in-process evaluation of role grants, on secrets as on organizations,
+// folders, and projects, resolves groups through it.
+func GroupPrincipalMatches(principal, group string) bool {
+	return group != "" && strings.EqualFold(strings.TrimPrefix(principal, groupPrincipalPrefix), group)
+}
+
 // RoleLevel returns the hierarchy level of role for comparison.
 func RoleLevel(role Role) int {
 	return roleLevel[role]
@@ -220,13 +320,14 @@ func HasCascadePermission(role Role, perm Permission, table CascadeTable) bool {
 // PermissionDenied error otherwise.
 func CheckCascadeAccess(
 	userEmail string,
+	emailVerified bool,
 	userRoles []string,
 	shareUsers map[string]string,
 	shareRoles map[string]string,
 	permission Permission,
 	table CascadeTable,
 ) error {
-	role := BestRoleFromGrants(userEmail, userRoles, shareUsers, shareRoles)
+	role := BestRoleFromGrants(userEmail, emailVerified, userRoles, shareUsers, shareRoles)
 	if HasCascadePermission(role, permission, table) {
 		return nil
 	}
@@ -242,3 +343,62 @@ var roleLevel = map[Role]int{
 	RoleEditor:      2,
 	RoleOwner:       3,
 }
+
+// GrantScope is the active share grants held by one resource, for
+// explaining a role with RoleSourceFromGrants.
+type GrantScope struct {
+	// ResourceType is "organization" or "project".
+	ResourceType string
+	ResourceName string
+	Users        map[string]string
+	Roles        map[string]string
+}
+
+// RoleSourceFromGrants explains how the user holds role. It returns the
+// first grant, searching scopes in order, that confers at least role: within
+// a scope a user grant of the most specific kind that names the user, then
+// the role grant sorted first by principal. When no grant does, the role
+// was granted by RBAC outside the console's grants and the source is
+// ROLE_SOURCE_TYPE_PLATFORM. It returns nil for RoleUnspecified.
+func RoleSourceFromGrants(role Role, userEmail string, emailVerified bool, userRoles []string, scopes ...GrantScope) *consolev1.RoleSource {
+	if role == RoleUnspecified {
+		return nil
+	}
+	for _, scope := range scopes {
+		// Broader user grants are overridden by the most specific kind, so
+		// only grants of that kind can be the source.
+		_, tier := MatchedUserGrant(userEmail, emailVerified, scope.Users)
+		var users []string
+		for p, roleName := range scope.Users {
+			if tier != principal.NoMatch && roleLevel[RoleFromString(roleName)] >= roleLevel[role] && principal.Matches(p, userEmail, emailVerified) == tier {
+				users = append(users, p)
+			}
+		}
+		if len(users) > 0 {
+			// Ties between spellings of one principal resolve by name.
+			return roleSource(consolev1.RoleSourceType_ROLE_SOURCE_TYPE_USER_GRANT, slices.Min(users), scope)
+		}
+		groups := make([]string, 0, len(scope.Roles))
+		for p, roleName := range scope.Roles {
+			if roleLevel[RoleFromString(roleName)] >= roleLevel[role] {
+				groups = append(groups, p)
+			}
+		}
+		slices.Sort(groups)
+		for _, p := range groups {
+			if slices.ContainsFunc(userRoles, func(ur string) bool { return GroupPrincipalMatches(p, ur) }) {
+				return roleSource(consolev1.RoleSourceType_ROLE_SOURCE_TYPE_GROUP_GRANT, p, scope)
+			}
+		}
+	}
+	return &consolev1.RoleSource{Type: consolev1.RoleSourceType_ROLE_SOURCE_TYPE_PLATFORM}
+}
+
+func roleSource(kind consolev1.RoleSourceType, name string, scope GrantScope) *consolev1.RoleSource {
+	return &consolev1.RoleSource{
+		Type:         kind,
+		Principal:    name,
+		ResourceType: scope.ResourceType,
+		ResourceName: scope.ResourceName,
+	}
+}
@@ -2,6 +2,10 @@ package rbac
 
 import (
 	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
 )
 
 func TestRoleConstantsAreDistinct(t *testing.T) {
@@ -41,7 +45,7 @@ func TestRoleLevelOrdering(t *testing.T) {
 
 func TestBestRoleFromGrants(t *testing.T) {
 	t.Run("user grant resolves to its role", func(t *testing.T) {
-		got := BestRoleFromGrants("alice@example.com", nil,
+		got := BestRoleFromGrants("alice@example.com", true, nil,
 			map[string]string{"alice@example.com": "editor"}, nil)
 		if got != RoleEditor {
 			t.Fatalf("got %v, want RoleEditor", got)
@@ -49,7 +53,7 @@ func TestBestRoleFromGrants(t *testing.T) {
 	})
 
 	t.Run("group grant resolves to its role", func(t *testing.T) {
-		got := BestRoleFromGrants("bob@example.com", []string{"engineering"},
+		got := BestRoleFromGrants("bob@example.com", true, []string{"engineering"},
 			nil, map[string]string{"engineering": "viewer"})
 		if got != RoleViewer {
 			t.Fatalf("got %v, want RoleViewer", got)
@@ -57,7 +61,7 @@ func TestBestRoleFromGrants(t *testing.T) {
 	})
 
 	t.Run("highest grant wins", func(t *testing.T) {
-		got := BestRoleFromGrants("carol@example.com", []string{"engineering"},
+		got := BestRoleFromGrants("carol@example.com", true, []string{"engineering"},
 			map[string]string{"carol@example.com": "viewer"},
 			map[string]string{"engineering": "owner"})
 		if got != RoleOwner {
@@ -66,16 +70,231 @@ func TestBestRoleFromGrants(t *testing.T) {
 	})
 
 	t.Run("no grants returns RoleUnspecified", func(t *testing.T) {
-		got := BestRoleFromGrants("nobody@example.com", nil, nil, nil)
+		got := BestRoleFromGrants("nobody@example.com", true, nil, nil, nil)
 		if got != RoleUnspecified {
 			t.Fatalf("got %v, want RoleUnspecified", got)
 		}
 	})
+
+	t.Run("group grant named as a Kubernetes principal resolves", func(t *testing.T) {
+		got := BestRoleFromGrants("dan@example.com", true, []string{"platform-admins"},
+			nil, map[string]string{"oidc:platform-admins": "owner"})
+		if got != RoleOwner {
+			t.Fatalf("got %v, want RoleOwner", got)
+		}
+	})
+}
+
+func TestGroupPrincipalMatches(t *testing.T) {
+	cases := []struct {
+		principal string
+		group     string
+		want      bool
+	}{
+		{principal: "platform-admins", group: "platform-admins", want: true},
+		{principal: "oidc:platform-admins", group: "platform-admins", want: true},
+		{principal: "Platform-Admins", group: "platform-admins", want: true},
+		{principal: "oidc:platform-admins", group: "platform-admins-ro", want: false},
+		{principal: "platform-admins", group: "oidc:platform-admins", want: false},
+		{principal: "oidc:", group: "", want: false},
+		{principal: "system:masters", group: "masters", want: false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.principal+"/"+tc.group, func(t *testing.T) {
+			if got := GroupPrincipalMatches(tc.principal, tc.group); got != tc.want {
+				t.Fatalf("GroupPrincipalMatches(%q, %q) = %v, want %v", tc.principal, tc.group, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestDeniedByGrantsResolvesGroupPrincipals(t *testing.T) {
+	if !DeniedByGrants("dan@example.com", true, []string{"contractors"}, nil, map[string]string{"oidc:Contractors": DenyRole}) {
+		t.Fatal("expected a deny grant naming the group as a Kubernetes principal to match")
+	}
+	if err := CheckAccessGrants("dan@example.com", true, []string{"platform-admins"}, nil,
+		map[string]string{"oidc:platform-admins": "owner"}, PermissionOrganizationsAdmin); err != nil {
+		t.Fatalf("expected the aliased owner grant to allow admin, got %v", err)
+	}
+}
+
+func TestPatternUserGrants(t *testing.T) {
+	t.Run("domain grant allows users of the domain", func(t *testing.T) {
+		err := CheckAccessGrants("bob@example.com", true, nil,
+			map[string]string{"*@example.com": "viewer"}, nil, PermissionProjectSettingsRead)
+		if err != nil {
+			t.Fatalf("expected access granted, got %v", err)
+		}
+		err = CheckAccessGrants("bob@example.org", true, nil,
+			map[string]string{"*@example.com": "viewer"}, nil, PermissionProjectSettingsRead)
+		if err == nil {
+			t.Fatal("expected access denied outside the domain")
+		}
+	})
+
+	t.Run("a user grant takes precedence over a broader higher grant", func(t *testing.T) {
+		users := map[string]string{"*@example.com": "owner", "bob@example.com": "viewer"}
+		if got := BestRoleFromGrants("bob@example.com", true, nil, users, nil); got != RoleViewer {
+			t.Fatalf("expected viewer from the user grant, got %v", got)
+		}
+		if err := CheckAccessGrants("bob@example.com", true, nil, users, nil, PermissionProjectsAdmin); err == nil {
+			t.Fatal("expected the domain owner grant not to raise the user grant")
+		}
+		if got := BestRoleFromGrants("carol@example.com", true, nil, users, nil); got != RoleOwner {
+			t.Fatalf("expected owner from the domain grant for other users, got %v", got)
+		}
+	})
+
+	t.Run("a domain grant takes precedence over a broader higher grant", func(t *testing.T) {
+		users := map[string]string{"*": "owner", "*@example.com": "viewer"}
+		if got := BestRoleFromGrants("bob@example.com", true, nil, users, nil); got != RoleViewer {
+			t.Fatalf("expected viewer from the domain grant, got %v", got)
+		}
+		if err := CheckAccessGrants("bob@example.com", true, nil, users, nil, PermissionProjectsAdmin); err == nil {
+			t.Fatal("expected the wildcard owner grant not to raise the domain grant")
+		}
+		if got := BestRoleFromGrants("bob@example.org", true, nil, users, nil); got != RoleOwner {
+			t.Fatalf("expected owner from the wildcard grant outside the domain, got %v", got)
+		}
+	})
+
+	t.Run("group grants still add to user grants", func(t *testing.T) {
+		users := map[string]string{"bob@example.com": "viewer"}
+		roles := map[string]string{"devs": "editor"}
+		if got := BestRoleFromGrants("bob@example.com", true, []string{"devs"}, users, roles); got != RoleEditor {
+			t.Fatalf("expected editor from the group grant, got %v", got)
+		}
+	})
+
+	t.Run("a wildcard grant requires an email", func(t *testing.T) {
+		users := map[string]string{"*": "viewer"}
+		if got := BestRoleFromGrants("", false, nil, users, nil); got != RoleUnspecified {
+			t.Fatalf("expected no role without an email, got %v", got)
+		}
+		if err := CheckAccessGrants("", true, nil, users, nil, PermissionProjectSettingsRead); err == nil {
+			t.Fatal("expected access denied without an email")
+		}
+	})
+
+	t.Run("a domain grant requires a verified email", func(t *testing.T) {
+		users := map[string]string{"*": "viewer", "*@example.com": "owner"}
+		if got := BestRoleFromGrants("bob@example.com", false, nil, users, nil); got != RoleViewer {
+			t.Fatalf("expected only the wildcard grant for an unverified email, got %v", got)
+		}
+		if err := CheckAccessGrants("bob@example.com", false, nil, users, nil, PermissionProjectsAdmin); err == nil {
+			t.Fatal("expected the domain grant not to apply to an unverified email")
+		}
+		if DeniedByGrants("bob@example.com", false, nil, map[string]string{"*@example.com": DenyRole}, nil) {
+			t.Fatal("expected the domain deny not to match an unverified email")
+		}
+	})
+
+	t.Run("a user grant exempts the user from a domain deny", func(t *testing.T) {
+		users := map[string]string{"*@example.com": DenyRole, "bob@example.com": "viewer"}
+		if DeniedByGrants("bob@example.com", true, nil, users, nil) {
+			t.Fatal("expected the specific grant to take precedence over the domain deny")
+		}
+		if !DeniedByGrants("carol@example.com", true, nil, users, nil) {
+			t.Fatal("expected the domain deny to apply to other users of the domain")
+		}
+	})
+
+	t.Run("a wildcard deny applies to users without a more specific grant", func(t *testing.T) {
+		users := map[string]string{"*": DenyRole, "*@example.com": "viewer"}
+		if !DeniedByGrants("dan@example.org", true, nil, users, nil) {
+			t.Fatal("expected the wildcard deny to apply")
+		}
+		if DeniedByGrants("dan@example.com", true, nil, users, nil) {
+			t.Fatal("expected the domain grant to take precedence over the wildcard deny")
+		}
+	})
+
+	t.Run("matched user grant prefers the specific grant", func(t *testing.T) {
+		users := map[string]string{"*": "viewer", "*@example.com": "viewer", "bob@example.com": "owner"}
+		if got, _ := MatchedUserGrant("bob@example.com", true, users); got != "bob@example.com" {
+			t.Fatalf("MatchedUserGrant = %q, want the user grant", got)
+		}
+	})
+}
+
+func TestRoleSourceFromGrants(t *testing.T) {
+	project := GrantScope{
+		ResourceType: "project",
+		ResourceName: "foo",
+		Users:        map[string]string{"*@example.com": "viewer", "alice@example.com": "viewer"},
+		Roles:        map[string]string{"dev-team": "editor", "admins": "owner"},
+	}
+	org := GrantScope{
+		ResourceType: "organization",
+		ResourceName: "acme",
+		Users:        map[string]string{"*": "owner"},
+	}
+	tests := []struct {
+		name   string
+		role   Role
+		email  string
+		groups []string
+		want   *consolev1.RoleSource
+	}{
+		{
+			name:  "most specific user grant",
+			role:  RoleViewer,
+			email: "alice@example.com",
+			want:  &consolev1.RoleSource{Type: consolev1.RoleSourceType_ROLE_SOURCE_TYPE_USER_GRANT, Principal: "alice@example.com", ResourceType: "project", ResourceName: "foo"},
+		},
+		{
+			name:   "group grant conferring the role",
+			role:   RoleEditor,
+			email:  "alice@example.com",
+			groups: []string{"dev-team"},
+			want:   &consolev1.RoleSource{Type: consolev1.RoleSourceType_ROLE_SOURCE_TYPE_GROUP_GRANT, Principal: "dev-team", ResourceType: "project", ResourceName: "foo"},
+		},
+		{
+			name:  "cascade from the organization",
+			role:  RoleOwner,
+			email: "bob@example.com",
+			want:  &consolev1.RoleSource{Type: consolev1.RoleSourceType_ROLE_SOURCE_TYPE_USER_GRANT, Principal: "*", ResourceType: "organization", ResourceName: "acme"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := RoleSourceFromGrants(tt.role, tt.email, true, tt.groups, project, org)
+			if !proto.Equal(got, tt.want) {
+				t.Fatalf("RoleSourceFromGrants = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("unexplained role comes from the platform", func(t *testing.T) {
+		got := RoleSourceFromGrants(RoleOwner, "bob@example.com", true, nil, project)
+		if got.GetType() != consolev1.RoleSourceType_ROLE_SOURCE_TYPE_PLATFORM || got.GetPrincipal() != "" {
+			t.Fatalf("expected a platform source, got %v", got)
+		}
+	})
+
+	t.Run("an overridden broader user grant is not a source", func(t *testing.T) {
+		scope := GrantScope{
+			ResourceType: "project",
+			ResourceName: "foo",
+			Users:        map[string]string{"*@example.com": "editor", "alice@example.com": "viewer"},
+			Roles:        map[string]string{"dev-team": "editor"},
+		}
+		got := RoleSourceFromGrants(RoleEditor, "alice@example.com", true, []string{"dev-team"}, scope)
+		if got.GetType() != consolev1.RoleSourceType_ROLE_SOURCE_TYPE_GROUP_GRANT || got.GetPrincipal() != "dev-team" {
+			t.Fatalf("expected the group grant as the source, got %v", got)
+		}
+	})
+
+	t.Run("no role has no source", func(t *testing.T) {
+		if got := RoleSourceFromGrants(RoleUnspecified, "bob@example.com", true, nil, project); got != nil {
+			t.Fatalf("expected nil, got %v", got)
+		}
+	})
 }
 
 func TestCheckAccessGrantsForProjectSettingsRead(t *testing.T) {
 	t.Run("viewer grant allows project settings read", func(t *testing.T) {
-		err := CheckAccessGrants("alice@example.com", nil,
+		err := CheckAccessGrants("alice@example.com", true, nil,
 			map[string]string{"alice@example.com": "viewer"}, nil,
 			PermissionProjectSettingsRead)
 		if err != nil {
@@ -84,7 +303,7 @@ func TestCheckAccessGrantsForProjectSettingsRead(t *testing.T) {
 	})
 
 	t.Run("group grant allows project settings read", func(t *testing.T) {
-		err := CheckAccessGrants("bob@example.com", []string{"engineering"},
+		err := CheckAccessGrants("bob@example.com", true, []string{"engineering"},
 			nil, map[string]string{"engineering": "editor"},
 			PermissionProjectSettingsRead)
 		if err != nil {
@@ -93,7 +312,7 @@ func TestCheckAccessGrantsForProjectSettingsRead(t *testing.T) {
 	})
 
 	t.Run("no grants denies access", func(t *testing.T) {
-		err := CheckAccessGrants("nobody@example.com", []string{"unknown"}, nil, nil,
+		err := CheckAccessGrants("nobody@example.com", true, []string{"unknown"}, nil, nil,
 			PermissionProjectSettingsRead)
 		if err == nil {
 			t.Fatal("expected PermissionDenied, got nil")
@@ -103,7 +322,7 @@ func TestCheckAccessGrantsForProjectSettingsRead(t *testing.T) {
 
 func TestCheckCascadeAccessForOrgProjectSettings(t *testing.T) {
 	t.Run("org owner can enable deployments via cascade", func(t *testing.T) {
-		err := CheckCascadeAccess("owner@example.com", nil,
+		err := CheckCascadeAccess("owner@example.com", true, nil,
 			map[string]string{"owner@example.com": "owner"}, nil,
 			PermissionProjectDeploymentsEnable, OrgCascadeProjectSettingsPerms)
 		if err != nil {
@@ -112,7 +331,7 @@ func TestCheckCascadeAccessForOrgProjectSettings(t *testing.T) {
 	})
 
 	t.Run("org editor cannot enable deployments via cascade", func(t *testing.T) {
-		err := CheckCascadeAccess("editor@example.com", nil,
+		err := CheckCascadeAccess("editor@example.com", true, nil,
 			map[string]string{"editor@example.com": "editor"}, nil,
 			PermissionProjectDeploymentsEnable, OrgCascadeProjectSettingsPerms)
 		if err == nil {
@@ -120,3 +339,17 @@ func TestCheckCascadeAccessForOrgProjectSettings(t *testing.T) {
 		}
 	})
 }
+
+func TestDenyGrantOverridesAllow(t *testing.T) {
+	users := map[string]string{"Bob@example.com": DenyRole}
+	roles := map[string]string{"devs": "owner"}
+	if err := CheckAccessGrants("bob@example.com", true, []string{"devs"}, users, roles, PermissionProjectSettingsRead); err == nil {
+		t.Fatal("expected deny grant to override the role grant")
+	}
+	if got := BestRoleFromGrants("bob@example.com", true, []string{"devs"}, users, roles); got != RoleUnspecified {
+		t.Fatalf("BestRoleFromGrants = %v, want RoleUnspecified", got)
+	}
+	if err := CheckAccessGrants("alice@example.com", true, []string{"devs"}, users, roles, PermissionProjectSettingsRead); err != nil {
+		t.Fatalf("expected alice to keep access, got %v", err)
+	}
+}
@@ -0,0 +1,102 @@
+package readiness
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	"connectrpc.com/connect"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// HealthServiceName is the fully-qualified name of the standard gRPC health
+// service.
+const HealthServiceName = "grpc.health.v1.Health"
+
+// HealthHandler serves the standard grpc.health.v1.Health service from the
+// checker's results, so grpcurl, kubelet gRPC probes, and service meshes can
+// check the Connect endpoint natively. It returns the path to mount the
+// handler on.
+//
+// The empty service name reports the server as a whole, as /readyz does.
+// The names in services, the Connect services the server hosts, report the
+// same status because they share every dependency. The name of a registered
+// check, e.g. "oidc", reports that check alone. Other names are unknown.
+func (c *Checker) HealthHandler(services ...string) (string, http.Handler) {
+	h := &healthServer{checker: c, services: services}
+	mux := http.NewServeMux()
+	mux.Handle("/"+HealthServiceName+"/Check", connect.NewUnaryHandler(
+		"/"+HealthServiceName+"/Check",
+		h.check,
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+	))
+	mux.Handle("/"+HealthServiceName+"/Watch", connect.NewServerStreamHandler(
+		"/"+HealthServiceName+"/Watch",
+		h.watch,
+	))
+	return "/" + HealthServiceName + "/", mux
+}
+
+type healthServer struct {
+	checker  *Checker
+	services []string
+}
+
+// errUnknownService reports a service name the checker has no status for.
+var errUnknownService = errors.New("unknown service")
+
+// status returns the serving status of service.
+func (h *healthServer) status(service string) (healthpb.HealthCheckResponse_ServingStatus, error) {
+	report := h.checker.Report()
+	ready := report.Ready
+	if service != "" && !slices.Contains(h.services, service) {
+		i := slices.IndexFunc(report.Components, func(s ComponentStatus) bool { return s.Name == service })
+		if i < 0 {
+			return healthpb.HealthCheckResponse_SERVICE_UNKNOWN, fmt.Errorf("%w %q", errUnknownService, service)
+		}
+		ready = report.Components[i].Ready && !report.Draining
+	}
+	if ready {
+		return healthpb.HealthCheckResponse_SERVING, nil
+	}
+	return healthpb.HealthCheckResponse_NOT_SERVING, nil
+}
+
+func (h *healthServer) check(_ context.Context, req *connect.Request[healthpb.HealthCheckRequest]) (*connect.Response[healthpb.HealthCheckResponse], error) {
+	status, err := h.status(req.Msg.Service)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+	return connect.NewResponse(&healthpb.HealthCheckResponse{Status: status}), nil
+}
+
+// watch sends the status of the requested service now and again whenever it
+// changes, polling the checker as often as it runs its checks. Unknown
+// services are reported as SERVICE_UNKNOWN rather than an error, as the
+// health protocol requires, since they may be registered later.
+func (h *healthServer) watch(ctx context.Context, req *connect.Request[healthpb.HealthCheckRequest], stream *connect.ServerStream[healthpb.HealthCheckResponse]) error {
+	interval := h.checker.interval
+	if interval <= 0 {
+		interval = time.Second
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	last := healthpb.HealthCheckResponse_ServingStatus(-1)
+	for {
+		status, _ := h.status(req.Msg.Service)
+		if status != last {
+			if err := stream.Send(&healthpb.HealthCheckResponse{Status: status}); err != nil {
+				return err
+			}
+			last = status
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
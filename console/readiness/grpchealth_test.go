@@ -0,0 +1,74 @@
+package readiness
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func TestHealthHandler(t *testing.T) {
+	var dexUp bool
+	checker := NewChecker(10*time.Millisecond, time.Second)
+	checker.Add("kubernetes", func(context.Context) error { return nil })
+	checker.Add("oidc", func(context.Context) error {
+		if !dexUp {
+			return errors.New("connection refused")
+		}
+		return nil
+	})
+	checker.CheckNow(context.Background())
+
+	mux := http.NewServeMux()
+	mux.Handle(checker.HealthHandler("holos.console.v1.SecretsService"))
+	server := httptest.NewUnstartedServer(mux)
+	server.EnableHTTP2 = true
+	server.StartTLS()
+	defer server.Close()
+
+	check := connect.NewClient[healthpb.HealthCheckRequest, healthpb.HealthCheckResponse](server.Client(), server.URL+"/"+HealthServiceName+"/Check", connect.WithGRPC())
+	status := func(service string) (healthpb.HealthCheckResponse_ServingStatus, error) {
+		resp, err := check.CallUnary(context.Background(), connect.NewRequest(&healthpb.HealthCheckRequest{Service: service}))
+		if err != nil {
+			return 0, err
+		}
+		return resp.Msg.Status, nil
+	}
+
+	cases := map[string]healthpb.HealthCheckResponse_ServingStatus{
+		"":                                healthpb.HealthCheckResponse_NOT_SERVING,
+		"holos.console.v1.SecretsService": healthpb.HealthCheckResponse_NOT_SERVING,
+		"kubernetes":                      healthpb.HealthCheckResponse_SERVING,
+		"oidc":                            healthpb.HealthCheckResponse_NOT_SERVING,
+	}
+	for service, want := range cases {
+		if got, err := status(service); err != nil || got != want {
+			t.Errorf("Check(%q): expected %v, got %v %v", service, want, got, err)
+		}
+	}
+	if _, err := status("nope"); connect.CodeOf(err) != connect.CodeNotFound {
+		t.Errorf("expected NotFound for an unknown service, got %v", err)
+	}
+
+	watch := connect.NewClient[healthpb.HealthCheckRequest, healthpb.HealthCheckResponse](server.Client(), server.URL+"/"+HealthServiceName+"/Watch", connect.WithGRPC())
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	stream, err := watch.CallServerStream(ctx, connect.NewRequest(&healthpb.HealthCheckRequest{}))
+	if err != nil {
+		t.Fatalf("Watch: %v", err)
+	}
+	defer stream.Close()
+	if !stream.Receive() || stream.Msg().Status != healthpb.HealthCheckResponse_NOT_SERVING {
+		t.Fatalf("expected an initial NOT_SERVING, got %v %v", stream.Msg(), stream.Err())
+	}
+	dexUp = true
+	checker.CheckNow(context.Background())
+	if !stream.Receive() || stream.Msg().Status != healthpb.HealthCheckResponse_SERVING {
+		t.Fatalf("expected SERVING once oidc recovers, got %v %v", stream.Msg(), stream.Err())
+	}
+}
@@ -0,0 +1,197 @@
+// Package readiness tracks whether the console's runtime dependencies are
+// available and serves the result on the /readyz probe.
+//
+// A Checker runs each registered Check periodically in the background and
+// caches the outcome, so probe requests never block on a slow dependency.
+// The server reports ready only once every check has passed on its most
+// recent run.
+package readiness
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Check reports whether a dependency is available. A nil error is healthy.
+type Check func(ctx context.Context) error
+
+// ComponentStatus is the most recent result of one Check.
+type ComponentStatus struct {
+	Name      string    `json:"name"`
+	Ready     bool      `json:"ready"`
+	Error     string    `json:"error,omitempty"`
+	CheckedAt time.Time `json:"checkedAt,omitzero"`
+}
+
+// Report is the verbose /readyz response body.
+type Report struct {
+	Ready bool `json:"ready"`
+	// Draining is true once the server has begun shutting down.
+	Draining   bool              `json:"draining,omitempty"`
+	Components []ComponentStatus `json:"components"`
+}
+
+type namedCheck struct {
+	name  string
+	check Check
+}
+
+// Checker runs registered checks and caches their results.
+type Checker struct {
+	interval time.Duration
+	timeout  time.Duration
+
+	mu       sync.RWMutex
+	checks   []namedCheck
+	status   map[string]ComponentStatus
+	draining atomic.Bool
+}
+
+// NewChecker returns a Checker that runs every check each interval, bounding
+// each run of a check by timeout.
+func NewChecker(interval, timeout time.Duration) *Checker {
+	return &Checker{interval: interval, timeout: timeout, status: map[string]ComponentStatus{}}
+}
+
+// Add registers a named check. A check is unready until its first run
+// succeeds.
+func (c *Checker) Add(name string, check Check) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.checks = append(c.checks, namedCheck{name: name, check: check})
+	c.status[name] = ComponentStatus{Name: name, Error: "not checked yet"}
+}
+
+// Run checks every component immediately and then each interval until ctx is
+// cancelled.
+func (c *Checker) Run(ctx context.Context) {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+	for {
+		c.CheckNow(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// CheckNow runs every registered check once, concurrently, and records the
+// results.
+func (c *Checker) CheckNow(ctx context.Context) {
+	c.mu.RLock()
+	checks := append([]namedCheck(nil), c.checks...)
+	c.mu.RUnlock()
+
+	var wg sync.WaitGroup
+	for _, nc := range checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			checkCtx, cancel := context.WithTimeout(ctx, c.timeout)
+			defer cancel()
+			status := ComponentStatus{Name: nc.name, Ready: true, CheckedAt: time.Now().UTC()}
+			if err := nc.check(checkCtx); err != nil {
+				status.Ready = false
+				status.Error = err.Error()
+			}
+			c.mu.Lock()
+			c.status[nc.name] = status
+			c.mu.Unlock()
+		}()
+	}
+	wg.Wait()
+}
+
+// Drain reports the server unready from now on, whatever its checks say, so
+// load balancers stop routing to it before it shuts down. It takes effect
+// on the next probe rather than the next run of the checks.
+func (c *Checker) Drain() {
+	c.draining.Store(true)
+}
+
+// Ready reports whether every check passed on its most recent run.
+func (c *Checker) Ready() bool {
+	return c.Report().Ready
+}
+
+// Report returns the most recent status of every component in registration
+// order.
+func (c *Checker) Report() Report {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	draining := c.draining.Load()
+	report := Report{Ready: !draining, Draining: draining, Components: make([]ComponentStatus, 0, len(c.checks))}
+	for _, nc := range c.checks {
+		status := c.status[nc.name]
+		report.Ready = report.Ready && status.Ready
+		report.Components = append(report.Components, status)
+	}
+	return report
+}
+
+// ServeHTTP answers the readiness probe with 200 when ready and 503
+// otherwise. The body is "ok" or "not ready", or the JSON Report when the
+// request has the verbose query parameter set.
+func (c *Checker) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	report := c.Report()
+	code := http.StatusOK
+	if !report.Ready {
+		code = http.StatusServiceUnavailable
+	}
+	if v := r.URL.Query().Get("verbose"); v != "" && v != "0" && v != "false" {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(code)
+		_ = json.NewEncoder(w).Encode(report)
+		return
+	}
+	w.Header().Set("Content-Type", "text/plain")
+	w.WriteHeader(code)
+	if report.Ready {
+		_, _ = io.WriteString(w, "ok")
+	} else {
+		_, _ = io.WriteString(w, "not ready")
+	}
+}
+
+// FuncCheck adapts a boolean readiness function, such as an informer cache's
+// sync status, to a Check that fails with reason when ready returns false.
+func FuncCheck(ready func() bool, reason string) Check {
+	return func(context.Context) error {
+		if !ready() {
+			return errors.New(reason)
+		}
+		return nil
+	}
+}
+
+// HTTPCheck returns a Check that GETs url with client and fails unless the
+// response status is 2xx.
+func HTTPCheck(client *http.Client, url string) Check {
+	return func(ctx context.Context) error {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return err
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			_ = resp.Body.Close()
+		}()
+		if resp.StatusCode < 200 || resp.StatusCode > 299 {
+			return fmt.Errorf("GET %s returned %s", url, resp.Status)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,88 @@
+package readiness
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestChecker(t *testing.T) {
+	var dexUp bool
+	checker := NewChecker(time.Minute, time.Second)
+	checker.Add("kubernetes", func(context.Context) error { return nil })
+	checker.Add("oidc", func(context.Context) error {
+		if !dexUp {
+			return errors.New("connection refused")
+		}
+		return nil
+	})
+
+	if checker.Ready() {
+		t.Fatal("expected checker to be unready before the first check")
+	}
+
+	checker.CheckNow(context.Background())
+	if checker.Ready() {
+		t.Fatal("expected checker to be unready while oidc fails")
+	}
+
+	rec := httptest.NewRecorder()
+	checker.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz?verbose=1", nil))
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Errorf("expected 503, got %d", rec.Code)
+	}
+	var report Report
+	if err := json.NewDecoder(rec.Body).Decode(&report); err != nil {
+		t.Fatalf("decoding verbose report: %v", err)
+	}
+	if len(report.Components) != 2 || !report.Components[0].Ready || report.Components[1].Error != "connection refused" {
+		t.Errorf("unexpected report %+v", report)
+	}
+
+	dexUp = true
+	checker.CheckNow(context.Background())
+	rec = httptest.NewRecorder()
+	checker.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	if rec.Code != http.StatusOK || rec.Body.String() != "ok" {
+		t.Errorf("expected 200 ok, got %d %q", rec.Code, rec.Body.String())
+	}
+}
+
+func TestCheckerDrain(t *testing.T) {
+	checker := NewChecker(time.Minute, time.Second)
+	checker.Add("server", func(context.Context) error { return nil })
+	checker.CheckNow(context.Background())
+	if !checker.Ready() {
+		t.Fatal("expected checker to be ready")
+	}
+
+	checker.Drain()
+	report := checker.Report()
+	if report.Ready || !report.Draining {
+		t.Errorf("expected a draining checker to be unready, got %+v", report)
+	}
+	checker.CheckNow(context.Background())
+	if checker.Ready() {
+		t.Error("expected passing checks not to clear draining")
+	}
+}
+
+func TestHTTPCheck(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/.well-known/openid-configuration" {
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	if err := HTTPCheck(server.Client(), server.URL+"/.well-known/openid-configuration")(context.Background()); err != nil {
+		t.Errorf("expected discovery check to pass, got %v", err)
+	}
+	if err := HTTPCheck(server.Client(), server.URL+"/missing")(context.Background()); err == nil {
+		t.Error("expected check of a 404 to fail")
+	}
+}
@@ -0,0 +1,182 @@
+package resourcerbac
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	v1alpha2 "github.com/holos-run/holos-console/api/v1alpha2"
+	"github.com/holos-run/holos-console/console/rbacname"
+	"github.com/holos-run/holos-console/console/secrets"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+)
+
+// RolePurposeProjectWorkloads labels the namespaced Roles and RoleBindings
+// that extend a project's console share grants to kubectl users. They are
+// kept apart from the project-secrets RoleBindings, which also record secret
+// sharing made through the SecretsService and must not be pruned against
+// the namespace annotations.
+const RolePurposeProjectWorkloads = "project-workloads"
+
+// workloadResources are the namespaced resources project members work with
+// through kubectl. Secrets are governed by the project-secrets Roles.
+var workloadResources = []rbacv1.PolicyRule{
+	{APIGroups: []string{""}, Resources: []string{"configmaps", "persistentvolumeclaims", "pods", "services", "serviceaccounts"}},
+	{APIGroups: []string{"apps"}, Resources: []string{"deployments", "replicasets", "statefulsets", "daemonsets"}},
+	{APIGroups: []string{"batch"}, Resources: []string{"jobs", "cronjobs"}},
+	{APIGroups: []string{"networking.k8s.io"}, Resources: []string{"ingresses", "networkpolicies"}},
+}
+
+// ProjectWorkloadRoles returns the viewer, editor and owner Roles granting
+// kubectl access to the workloads in a project namespace.
+func ProjectWorkloadRoles(namespace string, ownerRefs []metav1.OwnerReference) []*rbacv1.Role {
+	return []*rbacv1.Role{
+		projectWorkloadRole(namespace, RoleViewer, ownerRefs),
+		projectWorkloadRole(namespace, RoleEditor, ownerRefs),
+		projectWorkloadRole(namespace, RoleOwner, ownerRefs),
+	}
+}
+
+func projectWorkloadRole(namespace, role string, ownerRefs []metav1.OwnerReference) *rbacv1.Role {
+	role = NormalizeRole(role)
+	verbs := []string{"get", "list", "watch"}
+	if role != RoleViewer {
+		verbs = append(verbs, "create", "update", "patch", "delete")
+	}
+	rules := make([]rbacv1.PolicyRule, 0, len(workloadResources)+3)
+	for _, r := range workloadResources {
+		r.Verbs = verbs
+		rules = append(rules, r)
+	}
+	rules = append(rules,
+		rbacv1.PolicyRule{APIGroups: []string{""}, Resources: []string{"pods/log", "events", "endpoints"}, Verbs: []string{"get", "list", "watch"}},
+		rbacv1.PolicyRule{APIGroups: []string{"events.k8s.io"}, Resources: []string{"events"}, Verbs: []string{"get", "list", "watch"}},
+	)
+	if role == RoleOwner {
+		rules = append(rules, rbacv1.PolicyRule{
+			APIGroups: []string{""},
+			Resources: []string{"pods/exec", "pods/portforward"},
+			Verbs:     []string{"create", "get"},
+		})
+	}
+	return &rbacv1.Role{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            ProjectWorkloadRoleName(role),
+			Namespace:       namespace,
+			Labels:          projectWorkloadLabels(role),
+			OwnerReferences: ownerRefs,
+		},
+		Rules: rules,
+	}
+}
+
+// ProjectWorkloadRoleName returns the name of the project workload Role for
+// role.
+func ProjectWorkloadRoleName(role string) string {
+	return "holos-" + RolePurposeProjectWorkloads + "-" + NormalizeRole(role)
+}
+
+func projectWorkloadLabels(role string) map[string]string {
+	return map[string]string{
+		v1alpha2.LabelManagedBy: v1alpha2.ManagedByValue,
+		LabelRolePurpose:        RolePurposeProjectWorkloads,
+		LabelResourceRole:       "workloads-" + NormalizeRole(role),
+	}
+}
+
+// ProjectWorkloadRoleBinding binds the OIDC user or group principal to the
+// project workload Role for role.
+func ProjectWorkloadRoleBinding(namespace, target, principal, role string, ownerRefs []metav1.OwnerReference) *rbacv1.RoleBinding {
+	target = NormalizeTarget(target)
+	role = NormalizeRole(role)
+	bindingLabels := projectWorkloadLabels(role)
+	bindingLabels[LabelShareTarget] = target
+	bindingLabels[LabelShareTargetName] = labelValue(principal)
+	return &rbacv1.RoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            rbacname.RoleBindingName(RolePurposeProjectWorkloads+"-"+role, target, OIDCPrincipal(principal)),
+			Namespace:       namespace,
+			Labels:          bindingLabels,
+			Annotations:     map[string]string{AnnotationShareTargetName: OIDCPrincipal(principal)},
+			OwnerReferences: ownerRefs,
+		},
+		Subjects: []rbacv1.Subject{Subject(target, principal)},
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: rbacv1.GroupName,
+			Kind:     "Role",
+			Name:     ProjectWorkloadRoleName(role),
+		},
+	}
+}
+
+// EnsureProjectWorkloadRBAC provisions the project workload Roles in the
+// project namespace ns and reconciles one RoleBinding per active user and
+// group share grant, deleting bindings for grants that were removed or have
+// expired. User grants come from the RBAC share-users annotation, which
+// records OIDC subjects rather than emails, so bindings match the identity
+// kubectl presents. Namespaces that are not managed projects are ignored.
+func EnsureProjectWorkloadRBAC(ctx context.Context, client kubernetes.Interface, ns *corev1.Namespace, now time.Time) error {
+	if client == nil {
+		return fmt.Errorf("resource RBAC client is required")
+	}
+	if ns == nil || !matches(ns, Projects) {
+		return nil
+	}
+	ownerRefs := OwnerReferences(ns, Projects)
+	for _, role := range ProjectWorkloadRoles(ns.Name, ownerRefs) {
+		if err := applyRole(ctx, client, role); err != nil {
+			return fmt.Errorf("applying project workload role %q: %w", role.Name, err)
+		}
+	}
+
+	desired := make(map[string]*rbacv1.RoleBinding)
+	addDesired := func(target string, grants []secrets.AnnotationGrant) {
+		for _, grant := range activeGrants(grants, now) {
+			if strings.TrimSpace(grant.Principal) == "" {
+				continue
+			}
+			binding := ProjectWorkloadRoleBinding(ns.Name, target, grant.Principal, grant.Role, ownerRefs)
+			desired[binding.Name] = binding
+		}
+	}
+	users, err := parseUserShareGrants(ns.Annotations)
+	if err != nil {
+		return err
+	}
+	addDesired(ShareTargetUser, users)
+	groups, err := parseShareGrants(ns.Annotations, v1alpha2.AnnotationShareRoles)
+	if err != nil {
+		return err
+	}
+	addDesired(ShareTargetGroup, groups)
+
+	selector := labels.SelectorFromSet(labels.Set{
+		v1alpha2.LabelManagedBy: v1alpha2.ManagedByValue,
+		LabelRolePurpose:        RolePurposeProjectWorkloads,
+	}).String()
+	current, err := client.RbacV1().RoleBindings(ns.Name).List(ctx, metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return fmt.Errorf("listing project workload role bindings: %w", err)
+	}
+	for i := range current.Items {
+		existing := current.Items[i]
+		if _, ok := desired[existing.Name]; ok {
+			continue
+		}
+		if err := client.RbacV1().RoleBindings(ns.Name).Delete(ctx, existing.Name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("deleting stale project workload role binding %q: %w", existing.Name, err)
+		}
+	}
+	for _, binding := range desired {
+		if err := applyRoleBinding(ctx, client, binding); err != nil {
+			return fmt.Errorf("applying project workload role binding %q: %w", binding.Name, err)
+		}
+	}
+	return nil
+}
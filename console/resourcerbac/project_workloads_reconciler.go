@@ -0,0 +1,79 @@
+package resourcerbac
+
+import (
+	"context"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// ProjectWorkloadReconciler keeps the project workload Roles and
+// RoleBindings of every managed project namespace in sync with its share
+// annotations, so console grants also apply to kubectl users authenticating
+// with the same OIDC identities.
+type ProjectWorkloadReconciler struct {
+	Client client.Client
+	Kube   kubernetes.Interface
+}
+
+func (r *ProjectWorkloadReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	var ns corev1.Namespace
+	if err := r.Client.Get(ctx, req.NamespacedName, &ns); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+	if !ns.DeletionTimestamp.IsZero() {
+		// Garbage collection removes the Roles and RoleBindings with the
+		// namespace.
+		return ctrl.Result{}, nil
+	}
+	now := time.Now()
+	if err := EnsureProjectWorkloadRBAC(ctx, r.Kube, &ns, now); err != nil {
+		return ctrl.Result{}, err
+	}
+	if requeueAfter := NextGrantRequeueAfter(&ns, now); requeueAfter > 0 {
+		return ctrl.Result{RequeueAfter: requeueAfter}, nil
+	}
+	return ctrl.Result{}, nil
+}
+
+// SetupWithManager watches project namespaces and the workload Roles and
+// RoleBindings in them, so out-of-band edits to the RBAC objects are
+// reverted.
+func (r *ProjectWorkloadReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("project-workload-rbac-controller").
+		For(&corev1.Namespace{}, builder.WithPredicates(predicate.NewPredicateFuncs(func(obj client.Object) bool {
+			return matches(obj, Projects)
+		}))).
+		Watches(&rbacv1.Role{}, handler.EnqueueRequestsFromMapFunc(projectWorkloadNamespace)).
+		Watches(&rbacv1.RoleBinding{}, handler.EnqueueRequestsFromMapFunc(projectWorkloadNamespace)).
+		Complete(r)
+}
+
+// projectWorkloadNamespace maps a project workload Role or RoleBinding to
+// the namespace that owns it.
+func projectWorkloadNamespace(_ context.Context, obj client.Object) []reconcile.Request {
+	if obj.GetLabels()[LabelRolePurpose] != RolePurposeProjectWorkloads {
+		return nil
+	}
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{Name: obj.GetNamespace()}}}
+}
+
+// SetupProjectWorkloadReconciler registers the ProjectWorkloadReconciler
+// with mgr.
+func SetupProjectWorkloadReconciler(mgr ctrl.Manager, kube kubernetes.Interface) error {
+	return (&ProjectWorkloadReconciler{Client: mgr.GetClient(), Kube: kube}).SetupWithManager(mgr)
+}
@@ -0,0 +1,87 @@
+package resourcerbac
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/holos-run/holos-console/console/secretrbac"
+	"github.com/holos-run/holos-console/console/secrets"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestEnsureProjectWorkloadRBAC(t *testing.T) {
+	ctx := context.Background()
+	now := time.Unix(1_800_000_000, 0)
+	expired := now.Add(-time.Hour).Unix()
+	ns := managedNamespace(t, "holos-prj-demo", "project",
+		[]secrets.AnnotationGrant{
+			{Principal: "user-123", Role: RoleOwner},
+			{Principal: "user-456", Role: RoleViewer, Exp: &expired},
+			{Principal: "user-789", Role: RoleEditor, Deny: true},
+		},
+		[]secrets.AnnotationGrant{{Principal: "platform-team", Role: RoleEditor}},
+	)
+	stale := ProjectWorkloadRoleBinding(ns.Name, ShareTargetUser, "user-gone", RoleEditor, nil)
+	secretBinding := secretrbac.RoleBinding(ns.Name, secretrbac.ShareTargetUser, "user-gone", secretrbac.RoleViewer, nil)
+	client := fake.NewClientset(ns, stale, secretBinding)
+
+	if err := EnsureProjectWorkloadRBAC(ctx, client, ns, now); err != nil {
+		t.Fatalf("EnsureProjectWorkloadRBAC: %v", err)
+	}
+
+	roles, err := client.RbacV1().Roles(ns.Name).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		t.Fatalf("list roles: %v", err)
+	}
+	if len(roles.Items) != 3 {
+		t.Fatalf("roles = %d, want 3", len(roles.Items))
+	}
+	for _, role := range roles.Items {
+		if len(role.OwnerReferences) != 1 {
+			t.Fatalf("Role %q ownerRefs = %d, want 1", role.Name, len(role.OwnerReferences))
+		}
+		assertNamespaceOwnerRef(t, role.OwnerReferences[0], ns.Name, ns.UID)
+		for _, rule := range role.Rules {
+			for _, resource := range rule.Resources {
+				if resource == "secrets" {
+					t.Errorf("Role %q grants secrets; secret access belongs to the project-secrets roles", role.Name)
+				}
+			}
+		}
+	}
+
+	bindings, err := client.RbacV1().RoleBindings(ns.Name).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		t.Fatalf("list rolebindings: %v", err)
+	}
+	var got []string
+	for _, rb := range bindings.Items {
+		got = append(got, rb.Subjects[0].Kind+" "+rb.Subjects[0].Name+" "+rb.RoleRef.Name)
+	}
+	sort.Strings(got)
+	want := []string{
+		rbacv1.GroupKind + " oidc:platform-team " + ProjectWorkloadRoleName(RoleEditor),
+		rbacv1.UserKind + " oidc:user-123 " + ProjectWorkloadRoleName(RoleOwner),
+		rbacv1.UserKind + " oidc:user-gone " + secretrbac.RoleName(secretrbac.RoleViewer),
+	}
+	assertStringSlice(t, got, want)
+}
+
+func TestEnsureProjectWorkloadRBACIgnoresOtherNamespaces(t *testing.T) {
+	ns := managedNamespace(t, "holos-fld-eng", "folder", []secrets.AnnotationGrant{{Principal: "user-123", Role: RoleOwner}}, nil)
+	client := fake.NewClientset(ns)
+	if err := EnsureProjectWorkloadRBAC(context.Background(), client, ns, time.Now()); err != nil {
+		t.Fatalf("EnsureProjectWorkloadRBAC: %v", err)
+	}
+	roles, err := client.RbacV1().Roles(ns.Name).List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		t.Fatalf("list roles: %v", err)
+	}
+	if len(roles.Items) != 0 {
+		t.Fatalf("roles = %d, want 0 for a folder namespace", len(roles.Items))
+	}
+}
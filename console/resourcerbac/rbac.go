@@ -11,6 +11,7 @@ import (
 
 	templatesv1alpha1 "github.com/holos-run/holos-console/api/templates/v1alpha1"
 	v1alpha2 "github.com/holos-run/holos-console/api/v1alpha2"
+	"github.com/holos-run/holos-console/console/principal"
 	"github.com/holos-run/holos-console/console/rbacname"
 	"github.com/holos-run/holos-console/console/secrets"
 	corev1 "k8s.io/api/core/v1"
@@ -362,10 +363,6 @@ func RoleBindingLabels(name string, cfg KindConfig, target, principal, role stri
 func RoleBinding(namespace, name string, cfg KindConfig, target, principal, role string, ownerRefs []metav1.OwnerReference) *rbacv1.RoleBinding {
 	target = NormalizeTarget(target)
 	role = NormalizeRole(role)
-	subjectKind := rbacv1.UserKind
-	if target == ShareTargetGroup {
-		subjectKind = rbacv1.GroupKind
-	}
 	return &rbacv1.RoleBinding{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:            RoleBindingName(name, cfg, role, target, principal),
@@ -374,11 +371,7 @@ func RoleBinding(namespace, name string, cfg KindConfig, target, principal, role
 			Annotations:     map[string]string{AnnotationShareTargetName: OIDCPrincipal(principal)},
 			OwnerReferences: ownerRefs,
 		},
-		Subjects: []rbacv1.Subject{{
-			Kind:     subjectKind,
-			APIGroup: rbacv1.GroupName,
-			Name:     OIDCPrincipal(principal),
-		}},
+		Subjects: []rbacv1.Subject{Subject(target, principal)},
 		RoleRef: rbacv1.RoleRef{
 			APIGroup: rbacv1.GroupName,
 			Kind:     "Role",
@@ -390,10 +383,6 @@ func RoleBinding(namespace, name string, cfg KindConfig, target, principal, role
 func ClusterRoleBinding(name string, cfg KindConfig, target, principal, role string, ownerRefs []metav1.OwnerReference) *rbacv1.ClusterRoleBinding {
 	target = NormalizeTarget(target)
 	role = NormalizeRole(role)
-	subjectKind := rbacv1.UserKind
-	if target == ShareTargetGroup {
-		subjectKind = rbacv1.GroupKind
-	}
 	return &rbacv1.ClusterRoleBinding{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:            RoleBindingName(name, cfg, role, target, principal),
@@ -401,11 +390,7 @@ func ClusterRoleBinding(name string, cfg KindConfig, target, principal, role str
 			Annotations:     map[string]string{AnnotationShareTargetName: OIDCPrincipal(principal)},
 			OwnerReferences: ownerRefs,
 		},
-		Subjects: []rbacv1.Subject{{
-			Kind:     subjectKind,
-			APIGroup: rbacv1.GroupName,
-			Name:     OIDCPrincipal(principal),
-		}},
+		Subjects: []rbacv1.Subject{Subject(target, principal)},
 		RoleRef: rbacv1.RoleRef{
 			APIGroup: rbacv1.GroupName,
 			Kind:     "ClusterRole",
@@ -473,6 +458,19 @@ func isManagedNamespace(obj metav1.Object) bool {
 	return obj.GetLabels()[v1alpha2.LabelManagedBy] == v1alpha2.ManagedByValue
 }
 
+// Subject returns the RBAC subject a share grant binds: the oidc:-prefixed
+// user or group, or for a "*" or "*@example.com" user grant the group the
+// console adds to every matching user it impersonates.
+func Subject(target, name string) rbacv1.Subject {
+	kind := rbacv1.UserKind
+	if NormalizeTarget(target) == ShareTargetGroup {
+		kind = rbacv1.GroupKind
+	} else if group, ok := principal.Group(strings.TrimSpace(name)); ok {
+		return rbacv1.Subject{Kind: rbacv1.GroupKind, APIGroup: rbacv1.GroupName, Name: group}
+	}
+	return rbacv1.Subject{Kind: kind, APIGroup: rbacv1.GroupName, Name: OIDCPrincipal(name)}
+}
+
 func OIDCPrincipal(principal string) string {
 	principal = strings.TrimSpace(principal)
 	if principal == "" || strings.HasPrefix(principal, OIDCPrefix) {
@@ -648,10 +646,6 @@ func reconcileTopResourceChildRoleBindings(ctx context.Context, client kubernete
 		}
 		target = NormalizeTarget(target)
 		role := NormalizeRole(grant.Role)
-		subjectKind := rbacv1.UserKind
-		if target == ShareTargetGroup {
-			subjectKind = rbacv1.GroupKind
-		}
 		labels := RoleBindingLabels(namespace, cfg, target, grant.Principal, role)
 		labels[LabelRolePurpose] = cfg.RolePurpose + "-children"
 		binding := &rbacv1.RoleBinding{
@@ -662,11 +656,7 @@ func reconcileTopResourceChildRoleBindings(ctx context.Context, client kubernete
 				Annotations:     map[string]string{AnnotationShareTargetName: OIDCPrincipal(grant.Principal)},
 				OwnerReferences: ownerRefs,
 			},
-			Subjects: []rbacv1.Subject{{
-				Kind:     subjectKind,
-				APIGroup: rbacv1.GroupName,
-				Name:     OIDCPrincipal(grant.Principal),
-			}},
+			Subjects: []rbacv1.Subject{Subject(target, grant.Principal)},
 			RoleRef: rbacv1.RoleRef{
 				APIGroup: rbacv1.GroupName,
 				Kind:     "Role",
@@ -719,6 +709,10 @@ func activeGrants(grants []secrets.AnnotationGrant, now time.Time) []secrets.Ann
 	nowUnix := now.Unix()
 	filtered := make([]secrets.AnnotationGrant, 0, len(grants))
 	for _, grant := range grants {
+		// Deny grants never become RoleBindings; RBAC cannot subtract access.
+		if grant.Deny {
+			continue
+		}
 		if grant.Nbf != nil && *grant.Nbf > nowUnix {
 			continue
 		}
@@ -0,0 +1,175 @@
+package resourcestore
+
+import (
+	"cmp"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	consolev1alpha1 "github.com/holos-run/holos-console/api/console/v1alpha1"
+	v1alpha2 "github.com/holos-run/holos-console/api/v1alpha2"
+	"github.com/holos-run/holos-console/console/secrets"
+	"github.com/holos-run/holos-console/console/sharing/legacy" //nolint:staticcheck // The CRD store converts from the legacy annotation format.
+)
+
+// grantAnnotations maps each project grant annotation to the set and subject
+// kind of the ConsoleGrants that replace it.
+var grantAnnotations = []struct {
+	key  string
+	set  consolev1alpha1.GrantSet
+	kind consolev1alpha1.SubjectKind
+}{
+	{v1alpha2.AnnotationShareUsers, consolev1alpha1.GrantSetShare, consolev1alpha1.SubjectKindUser},
+	{v1alpha2.AnnotationShareRoles, consolev1alpha1.GrantSetShare, consolev1alpha1.SubjectKindGroup},
+	{v1alpha2.AnnotationRBACShareUsers, consolev1alpha1.GrantSetRBAC, consolev1alpha1.SubjectKindUser},
+	{v1alpha2.AnnotationDefaultShareUsers, consolev1alpha1.GrantSetDefault, consolev1alpha1.SubjectKindUser},
+	{v1alpha2.AnnotationDefaultShareRoles, consolev1alpha1.GrantSetDefault, consolev1alpha1.SubjectKindGroup},
+}
+
+// ProjectSpec returns the ConsoleProject spec described by the metadata
+// annotations on a project namespace.
+func ProjectSpec(ns *corev1.Namespace) consolev1alpha1.ConsoleProjectSpec {
+	return consolev1alpha1.ConsoleProjectSpec{
+		DisplayName:    ns.Annotations[v1alpha2.AnnotationDisplayName],
+		Description:    ns.Annotations[v1alpha2.AnnotationDescription],
+		CreatorEmail:   ns.Annotations[v1alpha2.AnnotationCreatorEmail],
+		CreatorSubject: ns.Annotations[v1alpha2.AnnotationCreatorSubject],
+	}
+}
+
+// GrantSpecs returns the ConsoleGrant specs described by the grant
+// annotations on a project namespace, in annotation order.
+func GrantSpecs(ns *corev1.Namespace) ([]consolev1alpha1.ConsoleGrantSpec, error) {
+	var specs []consolev1alpha1.ConsoleGrantSpec
+	for _, a := range grantAnnotations {
+		grants, err := legacy.ParseGrants(ns.Annotations, a.key)
+		if err != nil {
+			return nil, err
+		}
+		for _, g := range grants {
+			if g.Principal == "" {
+				continue
+			}
+			specs = append(specs, grantSpec(a.set, a.kind, g))
+		}
+	}
+	return specs, nil
+}
+
+func grantSpec(set consolev1alpha1.GrantSet, kind consolev1alpha1.SubjectKind, g secrets.AnnotationGrant) consolev1alpha1.ConsoleGrantSpec {
+	spec := consolev1alpha1.ConsoleGrantSpec{
+		Set:         set,
+		SubjectKind: kind,
+		Principal:   g.Principal,
+		Role:        g.Role,
+		Deny:        g.Deny,
+	}
+	if g.Deny {
+		spec.Role = ""
+	}
+	if g.Nbf != nil {
+		t := metav1.NewTime(time.Unix(*g.Nbf, 0))
+		spec.NotBefore = &t
+	}
+	if g.Exp != nil {
+		t := metav1.NewTime(time.Unix(*g.Exp, 0))
+		spec.Expires = &t
+	}
+	return spec
+}
+
+func annotationGrant(spec consolev1alpha1.ConsoleGrantSpec) secrets.AnnotationGrant {
+	g := secrets.AnnotationGrant{
+		Principal: spec.Principal,
+		Role:      spec.Role,
+		Deny:      spec.Deny,
+	}
+	if spec.NotBefore != nil {
+		nbf := spec.NotBefore.Unix()
+		g.Nbf = &nbf
+	}
+	if spec.Expires != nil {
+		exp := spec.Expires.Unix()
+		g.Exp = &exp
+	}
+	return g
+}
+
+// ApplyToNamespace writes project and grants onto the metadata and grant
+// annotations of ns, replacing whatever the annotations held. Grant sets
+// without grants have their annotation removed. Grants are written sorted by
+// principal so the result does not depend on the order they were listed in.
+func ApplyToNamespace(ns *corev1.Namespace, project consolev1alpha1.ConsoleProjectSpec, grants []consolev1alpha1.ConsoleGrantSpec) error {
+	if ns.Annotations == nil {
+		ns.Annotations = make(map[string]string)
+	}
+	setOrDelete(ns.Annotations, v1alpha2.AnnotationDisplayName, project.DisplayName)
+	setOrDelete(ns.Annotations, v1alpha2.AnnotationDescription, project.Description)
+	setOrDelete(ns.Annotations, v1alpha2.AnnotationCreatorEmail, project.CreatorEmail)
+	setOrDelete(ns.Annotations, v1alpha2.AnnotationCreatorSubject, project.CreatorSubject)
+
+	sorted := slices.Clone(grants)
+	slices.SortStableFunc(sorted, func(a, b consolev1alpha1.ConsoleGrantSpec) int {
+		return cmp.Compare(a.Principal, b.Principal)
+	})
+	for _, a := range grantAnnotations {
+		var list []secrets.AnnotationGrant
+		for _, spec := range sorted {
+			if spec.Set == a.set && spec.SubjectKind == a.kind {
+				list = append(list, annotationGrant(spec))
+			}
+		}
+		if len(list) == 0 {
+			delete(ns.Annotations, a.key)
+			continue
+		}
+		data, err := json.Marshal(list)
+		if err != nil {
+			return fmt.Errorf("marshaling %s: %w", a.key, err)
+		}
+		ns.Annotations[a.key] = string(data)
+	}
+	return nil
+}
+
+func setOrDelete(annotations map[string]string, key, value string) {
+	if value == "" {
+		delete(annotations, key)
+		return
+	}
+	annotations[key] = value
+}
+
+// GrantName returns the ConsoleGrant object name for spec. The name is a
+// hash of the whole spec, so saving the same grants again is a no-op and a
+// changed grant replaces the old object instead of updating it in place.
+func GrantName(spec consolev1alpha1.ConsoleGrantSpec) string {
+	h := sha256.New()
+	for _, field := range []string{
+		string(spec.Set),
+		string(spec.SubjectKind),
+		spec.Principal,
+		spec.Role,
+		strconv.FormatBool(spec.Deny),
+		formatTime(spec.NotBefore),
+		formatTime(spec.Expires),
+	} {
+		h.Write([]byte(field))
+		h.Write([]byte{0})
+	}
+	return "grant-" + hex.EncodeToString(h.Sum(nil))[:16]
+}
+
+func formatTime(t *metav1.Time) string {
+	if t == nil {
+		return ""
+	}
+	return strconv.FormatInt(t.Unix(), 10)
+}
@@ -0,0 +1,190 @@
+// Package resourcestore selects where the console keeps project metadata and
+// share grants. The default annotations backend keeps them in annotations on
+// the project namespace. The crd backend also stores them as
+// console.holos.run/v1alpha1 ConsoleProject and ConsoleGrant objects in the
+// project namespace, which the API server validates against a schema.
+//
+// The crd backend is a migration step: the projects K8sClient still writes
+// the namespace annotations, because the RBAC reconcilers derive
+// RoleBindings from them, and mirrors the result into the CRs with Save.
+// Reads go through Hydrate, which overlays the CR contents onto the
+// namespace so callers keep parsing annotations as before. A project
+// without a ConsoleProject is migrated on its first read. The annotation
+// size limit on grant lists goes away once the reconcilers read the CRs and
+// the annotations are no longer written.
+package resourcestore
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	consolev1alpha1 "github.com/holos-run/holos-console/api/console/v1alpha1"
+	v1alpha2 "github.com/holos-run/holos-console/api/v1alpha2"
+)
+
+// Backend names accepted by --resource-store.
+const (
+	BackendAnnotations = "annotations"
+	BackendCRD         = "crd"
+)
+
+// ValidateBackend reports an error unless name is a known backend.
+func ValidateBackend(name string) error {
+	switch name {
+	case BackendAnnotations, BackendCRD:
+		return nil
+	default:
+		return fmt.Errorf("unknown resource store %q (want %s or %s)", name, BackendAnnotations, BackendCRD)
+	}
+}
+
+// Store persists project metadata and share grants.
+type Store interface {
+	// Hydrate returns ns with its metadata and grant annotations filled
+	// from the store. ns itself is not modified.
+	Hydrate(ctx context.Context, ns *corev1.Namespace) (*corev1.Namespace, error)
+	// Save records the metadata and grant annotations of ns in the store.
+	Save(ctx context.Context, ns *corev1.Namespace) error
+}
+
+// AnnotationStore keeps everything in the namespace annotations, so
+// Hydrate and Save have nothing to do.
+type AnnotationStore struct{}
+
+// Hydrate returns ns unchanged.
+func (AnnotationStore) Hydrate(_ context.Context, ns *corev1.Namespace) (*corev1.Namespace, error) {
+	return ns, nil
+}
+
+// Save is a no-op.
+func (AnnotationStore) Save(context.Context, *corev1.Namespace) error {
+	return nil
+}
+
+// CRDStore keeps project metadata in a ConsoleProject and each grant in a
+// ConsoleGrant in the project namespace.
+type CRDStore struct {
+	client ctrlclient.Client
+	reader ctrlclient.Reader
+}
+
+// NewCRDStore returns a CRDStore that writes through client and reads
+// through reader. Pass an uncached reader such as the manager's API reader
+// so a read that follows a Save observes it.
+func NewCRDStore(client ctrlclient.Client, reader ctrlclient.Reader) *CRDStore {
+	return &CRDStore{client: client, reader: reader}
+}
+
+// Hydrate overlays the ConsoleProject and ConsoleGrants of the project onto
+// a copy of ns. When the project has no ConsoleProject yet its annotations
+// are saved first, so the copy matches ns.
+func (s *CRDStore) Hydrate(ctx context.Context, ns *corev1.Namespace) (*corev1.Namespace, error) {
+	var project consolev1alpha1.ConsoleProject
+	err := s.reader.Get(ctx, ctrlclient.ObjectKey{Namespace: ns.Name, Name: consolev1alpha1.ConsoleProjectName}, &project)
+	if k8serrors.IsNotFound(err) {
+		slog.InfoContext(ctx, "migrating project annotations to console resources",
+			slog.String("namespace", ns.Name),
+		)
+		if err := s.Save(ctx, ns); err != nil {
+			return nil, err
+		}
+		return ns, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("getting console project %s: %w", ns.Name, err)
+	}
+	var list consolev1alpha1.ConsoleGrantList
+	if err := s.reader.List(ctx, &list, ctrlclient.InNamespace(ns.Name)); err != nil {
+		return nil, fmt.Errorf("listing console grants in %s: %w", ns.Name, err)
+	}
+	grants := make([]consolev1alpha1.ConsoleGrantSpec, 0, len(list.Items))
+	for _, g := range list.Items {
+		grants = append(grants, g.Spec)
+	}
+	hydrated := ns.DeepCopy()
+	if err := ApplyToNamespace(hydrated, project.Spec, grants); err != nil {
+		return nil, err
+	}
+	return hydrated, nil
+}
+
+// Save writes the ConsoleProject and ConsoleGrants described by the
+// annotations of ns and deletes ConsoleGrants for grants ns no longer has.
+// The objects are removed together with the namespace.
+func (s *CRDStore) Save(ctx context.Context, ns *corev1.Namespace) error {
+	grants, err := GrantSpecs(ns)
+	if err != nil {
+		return err
+	}
+	if err := s.saveProject(ctx, ns); err != nil {
+		return fmt.Errorf("saving console project %s: %w", ns.Name, err)
+	}
+
+	want := make(map[string]bool, len(grants))
+	for _, spec := range grants {
+		name := GrantName(spec)
+		if want[name] {
+			continue
+		}
+		want[name] = true
+		grant := &consolev1alpha1.ConsoleGrant{
+			ObjectMeta: objectMeta(ns, name),
+			Spec:       spec,
+		}
+		if err := s.client.Create(ctx, grant); err != nil && !k8serrors.IsAlreadyExists(err) {
+			return fmt.Errorf("saving console grant %s/%s: %w", ns.Name, name, err)
+		}
+	}
+
+	var list consolev1alpha1.ConsoleGrantList
+	if err := s.reader.List(ctx, &list, ctrlclient.InNamespace(ns.Name)); err != nil {
+		return fmt.Errorf("listing console grants in %s: %w", ns.Name, err)
+	}
+	for i := range list.Items {
+		if want[list.Items[i].Name] {
+			continue
+		}
+		if err := s.client.Delete(ctx, &list.Items[i]); err != nil && !k8serrors.IsNotFound(err) {
+			return fmt.Errorf("deleting console grant %s/%s: %w", ns.Name, list.Items[i].Name, err)
+		}
+	}
+	return nil
+}
+
+// saveProject creates the ConsoleProject of ns or updates its spec.
+func (s *CRDStore) saveProject(ctx context.Context, ns *corev1.Namespace) error {
+	project := &consolev1alpha1.ConsoleProject{
+		ObjectMeta: objectMeta(ns, consolev1alpha1.ConsoleProjectName),
+		Spec:       ProjectSpec(ns),
+	}
+	err := s.client.Create(ctx, project)
+	if !k8serrors.IsAlreadyExists(err) {
+		return err
+	}
+	var existing consolev1alpha1.ConsoleProject
+	if err := s.reader.Get(ctx, ctrlclient.ObjectKeyFromObject(project), &existing); err != nil {
+		return err
+	}
+	if existing.Spec == project.Spec {
+		return nil
+	}
+	existing.Spec = project.Spec
+	return s.client.Update(ctx, &existing)
+}
+
+func objectMeta(ns *corev1.Namespace, name string) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:      name,
+		Namespace: ns.Name,
+		Labels: map[string]string{
+			v1alpha2.LabelManagedBy:    v1alpha2.ManagedByValue,
+			v1alpha2.LabelResourceType: v1alpha2.ResourceTypeProject,
+		},
+	}
+}
@@ -0,0 +1,193 @@
+package resourcestore
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	consolev1alpha1 "github.com/holos-run/holos-console/api/console/v1alpha1"
+	v1alpha2 "github.com/holos-run/holos-console/api/v1alpha2"
+)
+
+func newTestStore(t *testing.T, objs ...ctrlclient.Object) (*CRDStore, ctrlclient.Client) {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatalf("register clientgo scheme: %v", err)
+	}
+	if err := consolev1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("register console scheme: %v", err)
+	}
+	cl := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+	return NewCRDStore(cl, cl), cl
+}
+
+func testNamespace(annotations map[string]string) *corev1.Namespace {
+	return &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "prj-web",
+			Annotations: annotations,
+		},
+	}
+}
+
+func listGrants(t *testing.T, cl ctrlclient.Client) []consolev1alpha1.ConsoleGrant {
+	t.Helper()
+	var list consolev1alpha1.ConsoleGrantList
+	if err := cl.List(context.Background(), &list, ctrlclient.InNamespace("prj-web")); err != nil {
+		t.Fatalf("listing grants: %v", err)
+	}
+	return list.Items
+}
+
+func TestValidateBackend(t *testing.T) {
+	for _, name := range []string{BackendAnnotations, BackendCRD} {
+		if err := ValidateBackend(name); err != nil {
+			t.Errorf("ValidateBackend(%q) = %v", name, err)
+		}
+	}
+	if err := ValidateBackend("configmap"); err == nil {
+		t.Error("expected an error for an unknown backend")
+	}
+}
+
+func TestCRDStore_SaveConvertsAnnotations(t *testing.T) {
+	store, cl := newTestStore(t)
+	ns := testNamespace(map[string]string{
+		v1alpha2.AnnotationDisplayName:       "Web",
+		v1alpha2.AnnotationCreatorEmail:      "alice@example.com",
+		v1alpha2.AnnotationShareUsers:        `[{"principal":"alice@example.com","role":"owner"},{"principal":"bob@example.com","role":"viewer","exp":1900000000}]`,
+		v1alpha2.AnnotationShareRoles:        `[{"principal":"dev","role":"editor"}]`,
+		v1alpha2.AnnotationRBACShareUsers:    `[{"principal":"oidc:alice","role":"owner"}]`,
+		v1alpha2.AnnotationDefaultShareRoles: `[{"principal":"contractors","deny":true}]`,
+	})
+	if err := store.Save(context.Background(), ns); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	var project consolev1alpha1.ConsoleProject
+	if err := cl.Get(context.Background(), ctrlclient.ObjectKey{Namespace: "prj-web", Name: consolev1alpha1.ConsoleProjectName}, &project); err != nil {
+		t.Fatalf("getting project: %v", err)
+	}
+	if project.Spec.DisplayName != "Web" || project.Spec.CreatorEmail != "alice@example.com" {
+		t.Errorf("unexpected project spec %+v", project.Spec)
+	}
+
+	got := map[string]consolev1alpha1.ConsoleGrantSpec{}
+	for _, g := range listGrants(t, cl) {
+		if g.Name != GrantName(g.Spec) {
+			t.Errorf("grant %s: expected name %s", g.Name, GrantName(g.Spec))
+		}
+		got[string(g.Spec.Set)+"/"+string(g.Spec.SubjectKind)+"/"+g.Spec.Principal] = g.Spec
+	}
+	if len(got) != 5 {
+		t.Fatalf("expected 5 grants, got %v", got)
+	}
+	if g := got["Share/User/bob@example.com"]; g.Role != "viewer" || g.Expires == nil || g.Expires.Unix() != 1900000000 {
+		t.Errorf("unexpected bob grant %+v", g)
+	}
+	if g := got["Share/Group/dev"]; g.Role != "editor" {
+		t.Errorf("unexpected dev grant %+v", g)
+	}
+	if g := got["RBAC/User/oidc:alice"]; g.Role != "owner" {
+		t.Errorf("unexpected rbac grant %+v", g)
+	}
+	if g := got["Default/Group/contractors"]; !g.Deny {
+		t.Errorf("unexpected default grant %+v", g)
+	}
+}
+
+func TestCRDStore_SavePrunesRemovedGrants(t *testing.T) {
+	store, cl := newTestStore(t)
+	ns := testNamespace(map[string]string{
+		v1alpha2.AnnotationShareUsers: `[{"principal":"alice@example.com","role":"owner"},{"principal":"bob@example.com","role":"viewer"}]`,
+	})
+	if err := store.Save(context.Background(), ns); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	ns.Annotations[v1alpha2.AnnotationShareUsers] = `[{"principal":"alice@example.com","role":"owner"},{"principal":"bob@example.com","role":"editor"}]`
+	ns.Annotations[v1alpha2.AnnotationDescription] = "storefront"
+	if err := store.Save(context.Background(), ns); err != nil {
+		t.Fatalf("second Save: %v", err)
+	}
+
+	grants := listGrants(t, cl)
+	if len(grants) != 2 {
+		t.Fatalf("expected 2 grants after update, got %d", len(grants))
+	}
+	for _, g := range grants {
+		if g.Spec.Principal == "bob@example.com" && g.Spec.Role != "editor" {
+			t.Errorf("expected bob to be an editor, got %q", g.Spec.Role)
+		}
+	}
+	var project consolev1alpha1.ConsoleProject
+	if err := cl.Get(context.Background(), ctrlclient.ObjectKey{Namespace: "prj-web", Name: consolev1alpha1.ConsoleProjectName}, &project); err != nil {
+		t.Fatalf("getting project: %v", err)
+	}
+	if project.Spec.Description != "storefront" {
+		t.Errorf("expected updated description, got %q", project.Spec.Description)
+	}
+}
+
+func TestCRDStore_HydrateMigratesLazily(t *testing.T) {
+	store, cl := newTestStore(t)
+	ns := testNamespace(map[string]string{
+		v1alpha2.AnnotationShareUsers: `[{"principal":"alice@example.com","role":"owner"}]`,
+	})
+	got, err := store.Hydrate(context.Background(), ns)
+	if err != nil {
+		t.Fatalf("Hydrate: %v", err)
+	}
+	if got.Annotations[v1alpha2.AnnotationShareUsers] != ns.Annotations[v1alpha2.AnnotationShareUsers] {
+		t.Errorf("expected annotations unchanged, got %v", got.Annotations)
+	}
+	if n := len(listGrants(t, cl)); n != 1 {
+		t.Fatalf("expected the grant to be migrated, got %d grants", n)
+	}
+}
+
+func TestCRDStore_HydrateOverlaysResources(t *testing.T) {
+	grant := consolev1alpha1.ConsoleGrantSpec{
+		Set:         consolev1alpha1.GrantSetShare,
+		SubjectKind: consolev1alpha1.SubjectKindUser,
+		Principal:   "carol@example.com",
+		Role:        "editor",
+	}
+	store, _ := newTestStore(t,
+		&consolev1alpha1.ConsoleProject{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "prj-web", Name: consolev1alpha1.ConsoleProjectName},
+			Spec:       consolev1alpha1.ConsoleProjectSpec{DisplayName: "Storefront"},
+		},
+		&consolev1alpha1.ConsoleGrant{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "prj-web", Name: GrantName(grant)},
+			Spec:       grant,
+		},
+	)
+	ns := testNamespace(map[string]string{
+		v1alpha2.AnnotationDisplayName: "Web",
+		v1alpha2.AnnotationShareUsers:  `[{"principal":"alice@example.com","role":"owner"}]`,
+		v1alpha2.AnnotationShareRoles:  `[{"principal":"dev","role":"viewer"}]`,
+	})
+	got, err := store.Hydrate(context.Background(), ns)
+	if err != nil {
+		t.Fatalf("Hydrate: %v", err)
+	}
+	if got.Annotations[v1alpha2.AnnotationDisplayName] != "Storefront" {
+		t.Errorf("expected display name from ConsoleProject, got %q", got.Annotations[v1alpha2.AnnotationDisplayName])
+	}
+	if want := `[{"principal":"carol@example.com","role":"editor"}]`; got.Annotations[v1alpha2.AnnotationShareUsers] != want {
+		t.Errorf("share-users = %s, want %s", got.Annotations[v1alpha2.AnnotationShareUsers], want)
+	}
+	if _, ok := got.Annotations[v1alpha2.AnnotationShareRoles]; ok {
+		t.Error("expected share-roles to be removed when no group grants are stored")
+	}
+	if ns.Annotations[v1alpha2.AnnotationDisplayName] != "Web" {
+		t.Error("Hydrate modified the namespace it was given")
+	}
+}
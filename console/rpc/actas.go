@@ -0,0 +1,129 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"connectrpc.com/connect"
+	authzv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/holos-run/holos-console/console/principal"
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+)
+
+// Request headers that let a caller holding PERMISSION_IMPERSONATE act as
+// another user, mirroring kubectl --as, --as-group, and --as-user-extra.
+// X-Impersonate-User names the target's OIDC subject. X-Impersonate-Email
+// supplies the target's email address, which the console's sharing grants
+// are keyed on; without it grants naming the user do not apply.
+const (
+	HeaderImpersonateUser  = "X-Impersonate-User"
+	HeaderImpersonateGroup = "X-Impersonate-Group"
+	HeaderImpersonateEmail = "X-Impersonate-Email"
+)
+
+// actAs returns the effective claims for a request. Without
+// X-Impersonate-User it returns claims unchanged. Otherwise the caller must
+// hold PERMISSION_IMPERSONATE and be allowed by the apiserver to
+// impersonate the target user, each requested group, and the target's
+// email, checked as the "email" user extra and the email domain group the
+// console binds domain grants to. The returned claims describe the target
+// and record the caller as Impersonator.
+func actAs(ctx context.Context, header http.Header, claims *Claims, cfg *authInterceptorConfig) (*Claims, error) {
+	user := strings.TrimSpace(header.Get(HeaderImpersonateUser))
+	email := strings.TrimSpace(header.Get(HeaderImpersonateEmail))
+	var groups []string
+	for _, value := range header.Values(HeaderImpersonateGroup) {
+		groups = append(groups, splitHeaderList(value)...)
+	}
+	if user == "" {
+		if len(groups) > 0 || email != "" {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("%s and %s require %s", HeaderImpersonateGroup, HeaderImpersonateEmail, HeaderImpersonateUser))
+		}
+		return claims, nil
+	}
+	if !claims.Allows(consolev1.Permission_PERMISSION_IMPERSONATE) {
+		slog.WarnContext(ctx, "impersonation denied",
+			slog.String("action", "impersonate_denied"),
+			slog.String("resource_type", "user"),
+			slog.String("name", user),
+			slog.String("denied", consolev1.Permission_PERMISSION_IMPERSONATE.String()),
+			slog.String("sub", claims.Sub),
+			slog.String("email", claims.Email),
+		)
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("token is not scoped to %s", consolev1.Permission_PERMISSION_IMPERSONATE))
+	}
+
+	caller, err := NewImpersonatedClients(claims, cfg.impersonationBaseConfig, cfg.impersonationScheme)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	targets := []authzv1.ResourceAttributes{{Verb: "impersonate", Resource: "users", Name: oidcImpersonationPrefix + user}}
+	for _, group := range PrefixedOIDCGroups(groups) {
+		targets = append(targets, authzv1.ResourceAttributes{Verb: "impersonate", Resource: "groups", Name: group})
+	}
+	if email != "" {
+		targets = append(targets, authzv1.ResourceAttributes{Verb: "impersonate", Resource: "userextras", Subresource: "email", Name: email})
+		for _, group := range principal.Groups(email, true) {
+			if group != principal.AuthenticatedGroup {
+				targets = append(targets, authzv1.ResourceAttributes{Verb: "impersonate", Resource: "groups", Name: group})
+			}
+		}
+	}
+	for _, attr := range targets {
+		allowed, err := reviewImpersonation(ctx, caller.Clientset, attr)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("checking impersonation permission: %w", err))
+		}
+		if !allowed {
+			slog.WarnContext(ctx, "impersonation denied",
+				slog.String("action", "impersonate_denied"),
+				slog.String("resource_type", "user"),
+				slog.String("name", user),
+				slog.String("denied", attr.Resource+"/"+attr.Name),
+				slog.String("sub", claims.Sub),
+				slog.String("email", claims.Email),
+			)
+			return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("not permitted to impersonate %s %q", strings.TrimSuffix(attr.Resource, "s"), strings.TrimPrefix(attr.Name, oidcImpersonationPrefix)))
+		}
+	}
+
+	slog.InfoContext(ctx, "impersonation",
+		slog.String("action", "impersonate"),
+		slog.String("resource_type", "user"),
+		slog.String("name", user),
+		slog.String("groups", strings.Join(groups, ",")),
+		slog.String("target_email", email),
+		slog.String("sub", claims.Sub),
+		slog.String("email", claims.Email),
+	)
+	return &Claims{
+		Iss: claims.Iss,
+		Sub: user,
+		// The apiserver allowed the caller to assert the email, so it is
+		// treated as verified, as the target's own ID token would be.
+		Email:         email,
+		EmailVerified: email != "",
+		Roles:         groups,
+		PrincipalType: PrincipalTypeUser,
+		Impersonator:  claims,
+		// A scoped API token stays scoped while acting as someone else.
+		TokenID:     claims.TokenID,
+		Permissions: claims.Permissions,
+	}, nil
+}
+
+func reviewImpersonation(ctx context.Context, clientset kubernetes.Interface, attr authzv1.ResourceAttributes) (bool, error) {
+	review, err := clientset.AuthorizationV1().SelfSubjectAccessReviews().Create(ctx, &authzv1.SelfSubjectAccessReview{
+		Spec: authzv1.SelfSubjectAccessReviewSpec{ResourceAttributes: &attr},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return false, err
+	}
+	return review.Status.Allowed, nil
+}
@@ -0,0 +1,61 @@
+package rpc_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"connectrpc.com/connect"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	v1alpha2 "github.com/holos-run/holos-console/api/v1alpha2"
+	"github.com/holos-run/holos-console/console/dashboard"
+	"github.com/holos-run/holos-console/console/resolver"
+	"github.com/holos-run/holos-console/console/rpc"
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+)
+
+// TestActAs_Grants checks that acting as a user reproduces the access their
+// sharing grants give them, which are keyed on the email address.
+func TestActAs_Grants(t *testing.T) {
+	apiServer := rpc.ImpersonationAPIServerForTest(t)
+	defer apiServer.Close()
+
+	namespace := func(name, resourceType, shareUsers string) *corev1.Namespace {
+		return &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+			Labels: map[string]string{
+				v1alpha2.LabelManagedBy:    v1alpha2.ManagedByValue,
+				v1alpha2.LabelResourceType: resourceType,
+				v1alpha2.LabelOrganization: "acme",
+			},
+			Annotations: map[string]string{v1alpha2.AnnotationShareUsers: shareUsers},
+		}}
+	}
+	client := fake.NewClientset(
+		namespace("holos-prj-web", v1alpha2.ResourceTypeProject, `[{"principal":"alice@example.com","role":"editor"}]`),
+		namespace("holos-prj-ops", v1alpha2.ResourceTypeProject, `[{"principal":"*@example.com","role":"viewer"},{"principal":"alice@example.com","role":"deny"}]`),
+	)
+	h := dashboard.NewHandler(client, &resolver.Resolver{NamespacePrefix: "holos-", OrganizationPrefix: "org-", ProjectPrefix: "prj-"})
+
+	header := http.Header{}
+	header.Set(rpc.HeaderImpersonateUser, "alice")
+	header.Set(rpc.HeaderImpersonateEmail, "alice@example.com")
+	admin := &rpc.Claims{Sub: "admin", Email: "admin@example.com", PrincipalType: rpc.PrincipalTypeUser}
+	claims, err := rpc.ActAsForTest(context.Background(), apiServer.URL, header, admin)
+	if err != nil {
+		t.Fatalf("acting as alice: %v", err)
+	}
+
+	resp, err := h.ListAccessibleResources(rpc.ContextWithClaims(context.Background(), claims), connect.NewRequest(&consolev1.ListAccessibleResourcesRequest{}))
+	if err != nil {
+		t.Fatalf("ListAccessibleResources: %v", err)
+	}
+	// alice's grant allows web; her deny grant overrides the domain grant
+	// on ops.
+	if len(resp.Msg.Resources) != 1 || resp.Msg.Resources[0].Name != "web" || resp.Msg.Resources[0].Role != consolev1.Role_ROLE_EDITOR {
+		t.Fatalf("expected only editor access to web, got %v", resp.Msg.Resources)
+	}
+}
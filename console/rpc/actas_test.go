@@ -0,0 +1,135 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"connectrpc.com/connect"
+	authzv1 "k8s.io/api/authorization/v1"
+
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+)
+
+// impersonationAPIServer answers SelfSubjectAccessReviews, allowing the
+// caller oidc:admin to impersonate oidc:alice, her email address, and the
+// oidc:support group.
+func impersonationAPIServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	allowed := map[string]bool{
+		"users/oidc:alice":                      true,
+		"groups/oidc:support":                   true,
+		"userextras/alice@example.com":          true,
+		"groups/holos:email-domain:example.com": true,
+	}
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var review authzv1.SelfSubjectAccessReview
+		if err := json.NewDecoder(r.Body).Decode(&review); err != nil {
+			t.Errorf("decoding review: %v", err)
+		}
+		attr := review.Spec.ResourceAttributes
+		review.Status.Allowed = r.Header.Get("Impersonate-User") == "oidc:admin" &&
+			attr.Verb == "impersonate" && allowed[attr.Resource+"/"+attr.Name]
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(review)
+	}))
+}
+
+func TestLazyAuthInterceptor_ActAs(t *testing.T) {
+	fake := newFakeOIDCServer(t)
+	defer fake.Server.Close()
+	apiServer := impersonationAPIServer(t)
+	defer apiServer.Close()
+
+	clientID := "test-client"
+	restConfig := testRESTConfig(apiServer.URL)
+	restConfig.ContentType = "application/json"
+	interceptor := LazyAuthInterceptor(fake.Server.URL, clientID, "groups", fake.Server.Client(),
+		WithImpersonationConfig(restConfig, nil))
+
+	var got *Claims
+	handler := interceptor(func(ctx context.Context, _ connect.AnyRequest) (connect.AnyResponse, error) {
+		got = ClaimsFromContext(ctx)
+		return nil, nil
+	})
+
+	tests := []struct {
+		name    string
+		caller  string
+		user    string
+		groups  []string
+		email   string
+		want    connect.Code
+		wantSub string
+	}{
+		{name: "no impersonation", caller: "admin", wantSub: "admin"},
+		{name: "permitted user", caller: "admin", user: "alice", wantSub: "alice"},
+		{name: "permitted user and group", caller: "admin", user: "alice", groups: []string{"support"}, wantSub: "alice"},
+		{name: "forbidden user", caller: "admin", user: "bob", want: connect.CodePermissionDenied},
+		{name: "forbidden group", caller: "admin", user: "alice", groups: []string{"owners"}, want: connect.CodePermissionDenied},
+		{name: "caller without permission", caller: "mallory", user: "alice", want: connect.CodePermissionDenied},
+		{name: "group without user", caller: "admin", groups: []string{"support"}, want: connect.CodeInvalidArgument},
+		{name: "permitted email", caller: "admin", user: "alice", email: "alice@example.com", wantSub: "alice"},
+		{name: "forbidden email", caller: "admin", user: "alice", email: "bob@example.com", want: connect.CodePermissionDenied},
+		{name: "email without user", caller: "admin", email: "alice@example.com", want: connect.CodeInvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got = nil
+			req := newTestRequest(fake.signToken(t, tt.caller, clientID))
+			if tt.user != "" {
+				req.Header().Set(HeaderImpersonateUser, tt.user)
+			}
+			for _, group := range tt.groups {
+				req.Header().Add(HeaderImpersonateGroup, group)
+			}
+			if tt.email != "" {
+				req.Header().Set(HeaderImpersonateEmail, tt.email)
+			}
+
+			_, err := handler(context.Background(), req)
+			if tt.want != 0 {
+				if connect.CodeOf(err) != tt.want {
+					t.Fatalf("expected %v, got %v", tt.want, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.Sub != tt.wantSub {
+				t.Fatalf("expected effective sub %q, got %q", tt.wantSub, got.Sub)
+			}
+			if tt.user == "" {
+				if got.Impersonator != nil {
+					t.Fatalf("expected no impersonator, got %+v", got.Impersonator)
+				}
+				return
+			}
+			if got.Impersonator == nil || got.Impersonator.Sub != tt.caller {
+				t.Fatalf("expected impersonator %q, got %+v", tt.caller, got.Impersonator)
+			}
+			if len(got.Roles) != len(tt.groups) {
+				t.Fatalf("expected roles %v, got %v", tt.groups, got.Roles)
+			}
+			if got.Email != tt.email || got.EmailVerified != (tt.email != "") {
+				t.Fatalf("expected email %q, got %q (verified %v)", tt.email, got.Email, got.EmailVerified)
+			}
+		})
+	}
+}
+
+func TestActAs_ScopedToken(t *testing.T) {
+	header := http.Header{}
+	header.Set(HeaderImpersonateUser, "alice")
+	claims := &Claims{
+		Sub:         "admin",
+		Permissions: []consolev1.Permission{consolev1.Permission_PERMISSION_SECRETS_READ},
+	}
+	_, err := actAs(context.Background(), header, claims, &authInterceptorConfig{})
+	if connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Fatalf("expected a token without PERMISSION_IMPERSONATE to be denied, got %v", err)
+	}
+}
@@ -6,9 +6,12 @@ import (
 	"net/http"
 	"strings"
 	"sync"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/coreos/go-oidc/v3/oidc"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/rest"
 )
@@ -16,6 +19,11 @@ import (
 type authInterceptorConfig struct {
 	impersonationBaseConfig *rest.Config
 	impersonationScheme     *runtime.Scheme
+	tokenReviewers          []TokenReviewer
+	groupResolver           GroupResolver
+	trustedProxy            bool
+	clientCertificates      bool
+	sessionPolicy           SessionPolicy
 }
 
 // AuthInterceptorOption configures LazyAuthInterceptor.
@@ -38,6 +46,24 @@ func WithImpersonationConfig(base *rest.Config, scheme *runtime.Scheme) AuthInte
 //
 // If OIDC discovery fails, the error is not cached. Subsequent requests retry
 // discovery until it succeeds, at which point the verifier is cached permanently.
+//
+// When TokenReviewers are configured via WithTokenReviewer, bearer tokens that
+// fail OIDC verification (or arrive while discovery is failing) are passed to
+// each reviewer in turn, letting machine principals authenticate without Dex.
+//
+// When a GroupResolver is configured via WithGroupResolver, its groups for
+// the authenticated user are merged into the claims before impersonation.
+//
+// A caller allowed to impersonate users may send X-Impersonate-User to act
+// as another principal; see actAs.
+//
+// When WithSessionPolicy is set, tokens older than the policy allows are
+// rejected before any other processing.
+//
+// When WithTrustedProxy is set, identities verified by TrustedProxyMiddleware
+// are accepted before any bearer token is considered. Likewise, when
+// WithClientCertificates is set, identities verified by ClientCertMiddleware
+// are accepted before any bearer token.
 func LazyAuthInterceptor(issuer, clientID, rolesClaim string, client *http.Client, opts ...AuthInterceptorOption) connect.UnaryInterceptorFunc {
 	var cfg authInterceptorConfig
 	for _, opt := range opts {
@@ -50,45 +76,92 @@ func LazyAuthInterceptor(issuer, clientID, rolesClaim string, client *http.Clien
 	)
 
 	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		authenticated := func(ctx context.Context, req connect.AnyRequest, claims *Claims) (connect.AnyResponse, error) {
+			if err := cfg.sessionPolicy.check(claims, req.Spec().Procedure, time.Now()); err != nil {
+				return nil, err
+			}
+			claims = mergeGroups(ctx, cfg.groupResolver, claims)
+			claims, err := actAs(ctx, req.Header(), claims, &cfg)
+			if err != nil {
+				return nil, err
+			}
+			ctx = ContextWithClaims(ctx, claims)
+			trace.SpanFromContext(ctx).SetAttributes(
+				attribute.String("enduser.id", claims.Sub),
+				attribute.String("enduser.principal_type", claims.PrincipalType),
+			)
+			impersonatedClients, err := NewImpersonatedClients(claims, cfg.impersonationBaseConfig, cfg.impersonationScheme)
+			if err != nil {
+				if errors.Is(err, ErrUnauthenticatedImpersonation) {
+					return nil, connect.NewError(connect.CodeUnauthenticated, err)
+				}
+				return nil, connect.NewError(connect.CodeInternal, err)
+			}
+			ctx = ContextWithImpersonatedClients(ctx, impersonatedClients)
+			return next(ctx, req)
+		}
+
 		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			if cfg.trustedProxy {
+				if claims := proxyClaimsFromContext(ctx); claims != nil {
+					return authenticated(ctx, req, claims)
+				}
+			}
+			if cfg.clientCertificates {
+				if claims := certClaimsFromContext(ctx); claims != nil {
+					return authenticated(ctx, req, claims)
+				}
+			}
+
 			// Double-checked locking: fast path avoids the mutex when already initialized.
 			mu.Lock()
 			v := verifier
 			mu.Unlock()
 
+			var discoveryErr error
 			if v == nil {
 				mu.Lock()
 				v = verifier
 				if v == nil {
 					oidcCtx := oidc.ClientContext(ctx, client)
-					provider, err := oidc.NewProvider(oidcCtx, issuer)
+					provider, err := DiscoverProvider(oidcCtx, issuer)
 					if err != nil {
-						mu.Unlock()
-						return nil, connect.NewError(connect.CodeUnavailable, err)
+						discoveryErr = err
+					} else {
+						v = provider.Verifier(&oidc.Config{
+							ClientID: clientID,
+						})
+						verifier = v
 					}
-					v = provider.Verifier(&oidc.Config{
-						ClientID: clientID,
-					})
-					verifier = v
 				}
 				mu.Unlock()
 			}
-
-			claims, err := extractAndVerifyToken(ctx, req, v, rolesClaim)
-			if err != nil {
-				return nil, connect.NewError(connect.CodeUnauthenticated, err)
+			if discoveryErr != nil && len(cfg.tokenReviewers) == 0 {
+				return nil, connect.NewError(connect.CodeUnavailable, discoveryErr)
 			}
 
-			ctx = ContextWithClaims(ctx, claims)
-			impersonatedClients, err := NewImpersonatedClients(claims, cfg.impersonationBaseConfig, cfg.impersonationScheme)
-			if err != nil {
-				if errors.Is(err, ErrUnauthenticatedImpersonation) {
-					return nil, connect.NewError(connect.CodeUnauthenticated, err)
+			var (
+				claims *Claims
+				err    error
+			)
+			if v != nil {
+				claims, err = extractAndVerifyToken(ctx, req, v, rolesClaim)
+			}
+			if token, ok := bearerToken(req); ok && claims == nil {
+				for _, reviewer := range cfg.tokenReviewers {
+					if reviewed, reviewErr := reviewer.ReviewToken(ctx, token); reviewErr == nil {
+						claims, err = reviewed, nil
+						break
+					}
 				}
-				return nil, connect.NewError(connect.CodeInternal, err)
 			}
-			ctx = ContextWithImpersonatedClients(ctx, impersonatedClients)
-			return next(ctx, req)
+			if claims == nil {
+				if discoveryErr != nil {
+					return nil, connect.NewError(connect.CodeUnavailable, discoveryErr)
+				}
+				return nil, connect.NewError(connect.CodeUnauthenticated, err)
+			}
+			return authenticated(ctx, req, claims)
 		}
 	}
 }
@@ -96,22 +169,12 @@ func LazyAuthInterceptor(issuer, clientID, rolesClaim string, client *http.Clien
 // extractAndVerifyToken extracts the bearer token from the Authorization header
 // and verifies it using the provided verifier.
 func extractAndVerifyToken(ctx context.Context, req connect.AnyRequest, verifier *oidc.IDTokenVerifier, rolesClaim string) (*Claims, error) {
-	auth := req.Header().Get("Authorization")
-	if auth == "" {
+	token, ok := bearerToken(req)
+	if !ok {
 		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
 	}
 
-	const bearerPrefix = "Bearer "
-	if !strings.HasPrefix(auth, bearerPrefix) {
-		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
-	}
-
-	token := strings.TrimPrefix(auth, bearerPrefix)
-	if token == "" {
-		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
-	}
-
-	idToken, err := verifier.Verify(ctx, token)
+	idToken, err := VerifyIDToken(ctx, verifier, token)
 	if err != nil {
 		return nil, err
 	}
@@ -140,6 +203,18 @@ func extractAndVerifyToken(ctx context.Context, req connect.AnyRequest, verifier
 	claims.Iss = idToken.Issuer
 	claims.Exp = idToken.Expiry.Unix()
 	claims.Iat = idToken.IssuedAt.Unix()
+	claims.PrincipalType = PrincipalTypeUser
 
 	return &claims, nil
 }
+
+// bearerToken returns the bearer token from the Authorization header.
+func bearerToken(req connect.AnyRequest) (string, bool) {
+	auth := req.Header().Get("Authorization")
+	const bearerPrefix = "Bearer "
+	if !strings.HasPrefix(auth, bearerPrefix) {
+		return "", false
+	}
+	token := strings.TrimPrefix(auth, bearerPrefix)
+	return token, token != ""
+}
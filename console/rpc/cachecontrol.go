@@ -0,0 +1,33 @@
+package rpc
+
+import (
+	"context"
+	"net/http"
+
+	"connectrpc.com/connect"
+)
+
+// DefaultGetCacheControl lets browsers keep GET responses but revalidate
+// them on every use, and keeps them out of shared caches because responses
+// depend on the caller's identity.
+const DefaultGetCacheControl = "private, no-cache"
+
+// CacheControlInterceptor sets the Cache-Control response header of RPCs
+// called with HTTP GET, which Connect allows for methods declared with
+// idempotency_level = NO_SIDE_EFFECTS. Responses vary by the caller's
+// credentials, so Vary names the headers that carry them. An empty
+// cacheControl leaves the header unset. POST calls are untouched.
+func CacheControlInterceptor(cacheControl string) connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			resp, err := next(ctx, req)
+			if err != nil || resp == nil || cacheControl == "" || req.HTTPMethod() != http.MethodGet {
+				return resp, err
+			}
+			resp.Header().Set("Cache-Control", cacheControl)
+			resp.Header().Add("Vary", "Authorization")
+			resp.Header().Add("Vary", "Cookie")
+			return resp, nil
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package rpc
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func TestCacheControlInterceptor(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.Handle("/test.Service/GetThing", connect.NewUnaryHandler("/test.Service/GetThing",
+		func(_ context.Context, _ *connect.Request[emptypb.Empty]) (*connect.Response[emptypb.Empty], error) {
+			return connect.NewResponse(&emptypb.Empty{}), nil
+		},
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithInterceptors(CacheControlInterceptor(DefaultGetCacheControl)),
+	))
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	get, err := http.Get(srv.URL + "/test.Service/GetThing?encoding=json&message=%7B%7D")
+	if err != nil {
+		t.Fatalf("GET: %v", err)
+	}
+	get.Body.Close()
+	if get.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200 for GET, got %d", get.StatusCode)
+	}
+	if got := get.Header.Get("Cache-Control"); got != DefaultGetCacheControl {
+		t.Errorf("expected Cache-Control %q, got %q", DefaultGetCacheControl, got)
+	}
+	if got := strings.Join(get.Header.Values("Vary"), ","); !strings.Contains(got, "Authorization") {
+		t.Errorf("expected Vary to name Authorization, got %q", got)
+	}
+
+	post, err := http.Post(srv.URL+"/test.Service/GetThing", "application/json", strings.NewReader("{}"))
+	if err != nil {
+		t.Fatalf("POST: %v", err)
+	}
+	post.Body.Close()
+	if got := post.Header.Get("Cache-Control"); got != "" {
+		t.Errorf("expected no Cache-Control for POST, got %q", got)
+	}
+}
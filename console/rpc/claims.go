@@ -1,6 +1,11 @@
 package rpc
 
-import "context"
+import (
+	"context"
+	"slices"
+
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+)
 
 // Claims represents the claims extracted from an OIDC ID token.
 type Claims struct {
@@ -16,6 +21,10 @@ type Claims struct {
 	// Iat is the issued-at time as a Unix timestamp (iat claim).
 	Iat int64 `json:"iat"`
 
+	// AuthTime is when the user last actively authenticated, as a Unix
+	// timestamp (auth_time claim). Zero when the provider does not send it.
+	AuthTime int64 `json:"auth_time"`
+
 	// Email is the user's email address.
 	Email string `json:"email"`
 
@@ -27,6 +36,36 @@ type Claims struct {
 
 	// Roles is the list of roles the user belongs to (from the configured OIDC claim).
 	Roles []string `json:"groups"`
+
+	// PrincipalType is PrincipalTypeUser for OIDC users and
+	// PrincipalTypeServiceAccount for Kubernetes ServiceAccounts
+	// authenticated by TokenReview. It is set by the auth interceptor and
+	// never read from the token.
+	PrincipalType string `json:"-"`
+
+	// Impersonator is the real authenticated principal when an admin is
+	// acting as Sub via X-Impersonate-User. It is nil for ordinary requests.
+	Impersonator *Claims `json:"-"`
+
+	// TokenID identifies the console API token the request authenticated
+	// with. It is empty for OIDC and ServiceAccount tokens.
+	TokenID string `json:"-"`
+
+	// Permissions limits the request to a subset of the principal's
+	// permissions. Nil places no limit beyond the principal's own roles.
+	Permissions []consolev1.Permission `json:"-"`
+}
+
+// Allows reports whether the claims' permission subset, if any, includes
+// permission. It does not check that the principal holds permission.
+func (c *Claims) Allows(permission consolev1.Permission) bool {
+	return c.Permissions == nil || slices.Contains(c.Permissions, permission)
+}
+
+// IsServiceAccount reports whether the claims describe a Kubernetes
+// ServiceAccount rather than an OIDC user.
+func (c *Claims) IsServiceAccount() bool {
+	return c != nil && c.PrincipalType == PrincipalTypeServiceAccount
 }
 
 // ExtractRoles extracts roles from a generic claims map using the specified claim name.
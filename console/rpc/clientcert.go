@@ -0,0 +1,73 @@
+package rpc
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"connectrpc.com/connect"
+)
+
+// PrincipalIssuerClientCertificate is the Claims.Iss recorded for principals
+// authenticated by ClientCertMiddleware.
+const PrincipalIssuerClientCertificate = "x509"
+
+type certClaimsKey struct{}
+
+func certClaimsFromContext(ctx context.Context) *Claims {
+	claims, _ := ctx.Value(certClaimsKey{}).(*Claims)
+	return claims
+}
+
+// ClientCertMiddleware requires a verified TLS client certificate on every
+// request to next. The server's tls.Config must verify presented
+// certificates against the client CA; this middleware only checks that one
+// was presented and verified, so other routes sharing the listener can stay
+// on ordinary TLS. Requests without a certificate are rejected with
+// CodeUnauthenticated. The certificate's identity is stored on the request
+// context for LazyAuthInterceptor configured WithClientCertificates.
+func ClientCertMiddleware(next http.Handler) http.Handler {
+	errorWriter := connect.NewErrorWriter()
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.TLS == nil || len(r.TLS.VerifiedChains) == 0 || len(r.TLS.VerifiedChains[0]) == 0 {
+			slog.WarnContext(r.Context(), "rejecting API request without a verified client certificate",
+				slog.String("peer", r.RemoteAddr),
+				slog.String("path", r.URL.Path),
+			)
+			_ = errorWriter.Write(w, r, connect.NewError(connect.CodeUnauthenticated, errors.New("client certificate required")))
+			return
+		}
+		claims := certificateClaims(r.TLS.VerifiedChains[0][0])
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), certClaimsKey{}, claims)))
+	})
+}
+
+// certificateClaims maps a client certificate to claims the same way the
+// Kubernetes API server does: the subject common name is the user and each
+// subject organization is a group. The first email SAN, if any, is the
+// email so sharing grants by email apply to certificate principals. The
+// console impersonates the user and groups under the x509: prefix.
+func certificateClaims(cert *x509.Certificate) *Claims {
+	claims := &Claims{
+		Iss:           PrincipalIssuerClientCertificate,
+		Sub:           cert.Subject.CommonName,
+		Name:          cert.Subject.CommonName,
+		Roles:         cert.Subject.Organization,
+		PrincipalType: PrincipalTypeUser,
+	}
+	if len(cert.EmailAddresses) > 0 {
+		claims.Email = cert.EmailAddresses[0]
+		claims.EmailVerified = true
+	}
+	return claims
+}
+
+// WithClientCertificates makes LazyAuthInterceptor accept identities
+// verified by ClientCertMiddleware in place of a bearer token.
+func WithClientCertificates() AuthInterceptorOption {
+	return func(cfg *authInterceptorConfig) {
+		cfg.clientCertificates = true
+	}
+}
@@ -0,0 +1,85 @@
+package rpc
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"connectrpc.com/connect"
+)
+
+func TestClientCertMiddleware(t *testing.T) {
+	var got *Claims
+	handler := ClientCertMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = certClaimsFromContext(r.Context())
+	}))
+
+	t.Run("rejects requests without a verified certificate", func(t *testing.T) {
+		got = nil
+		for _, state := range []*tls.ConnectionState{nil, {}} {
+			r := httptest.NewRequest(http.MethodPost, "/holos.console.v1.SecretsService/ListSecrets", nil)
+			r.TLS = state
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, r)
+			if w.Code != http.StatusUnauthorized {
+				t.Errorf("expected 401, got %d", w.Code)
+			}
+			if got != nil {
+				t.Errorf("expected handler not to run, got claims %+v", got)
+			}
+		}
+	})
+
+	t.Run("maps the certificate subject to claims", func(t *testing.T) {
+		cert := &x509.Certificate{
+			Subject:        pkix.Name{CommonName: "ci-bot", Organization: []string{"platform", "deployers"}},
+			EmailAddresses: []string{"ci-bot@example.com"},
+		}
+		r := httptest.NewRequest(http.MethodPost, "/holos.console.v1.SecretsService/ListSecrets", nil)
+		r.TLS = &tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}}
+		handler.ServeHTTP(httptest.NewRecorder(), r)
+		if got == nil {
+			t.Fatal("expected certificate claims")
+		}
+		if got.Sub != "ci-bot" || got.Email != "ci-bot@example.com" || got.Iss != PrincipalIssuerClientCertificate || got.PrincipalType != PrincipalTypeUser {
+			t.Errorf("unexpected claims %+v", got)
+		}
+		if len(got.Roles) != 2 || got.Roles[0] != "platform" || got.Roles[1] != "deployers" {
+			t.Errorf("expected roles [platform deployers], got %v", got.Roles)
+		}
+	})
+}
+
+func TestLazyAuthInterceptor_ClientCertificates(t *testing.T) {
+	fake := newFakeOIDCServer(t)
+	defer fake.Server.Close()
+	// Certificate identities must not depend on OIDC discovery.
+	fake.ShouldFail.Store(true)
+
+	certClaims := &Claims{Iss: PrincipalIssuerClientCertificate, Sub: "ci-bot", PrincipalType: PrincipalTypeUser}
+	ctx := context.WithValue(context.Background(), certClaimsKey{}, certClaims)
+
+	var got *Claims
+	next := func(ctx context.Context, _ connect.AnyRequest) (connect.AnyResponse, error) {
+		got = ClaimsFromContext(ctx)
+		return nil, nil
+	}
+
+	interceptor := LazyAuthInterceptor(fake.Server.URL, "test-client", "groups", fake.Server.Client(), WithClientCertificates())
+	if _, err := interceptor(next)(ctx, newTestRequest("")); err != nil {
+		t.Fatalf("certificate identity rejected: %v", err)
+	}
+	if got == nil || got.Sub != "ci-bot" {
+		t.Fatalf("expected certificate claims, got %+v", got)
+	}
+
+	// Without WithClientCertificates the context identity is ignored.
+	plain := LazyAuthInterceptor(fake.Server.URL, "test-client", "groups", fake.Server.Client())
+	if _, err := plain(next)(ctx, newTestRequest("")); connect.CodeOf(err) != connect.CodeUnavailable {
+		t.Fatalf("expected CodeUnavailable without client certificates, got %v", err)
+	}
+}
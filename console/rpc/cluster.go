@@ -0,0 +1,90 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"k8s.io/client-go/rest"
+)
+
+// clusterField is the request message field naming the target cluster.
+const clusterField protoreflect.Name = "cluster"
+
+// ClusterRegistry resolves the cluster names accepted in the cluster field
+// of resource RPCs to Kubernetes REST configs.
+type ClusterRegistry interface {
+	// RESTConfig returns the config for the named cluster, or false when
+	// the name is not registered.
+	RESTConfig(name string) (*rest.Config, bool)
+}
+
+type clusterKey struct{}
+
+type clusterTarget struct {
+	name   string
+	config *rest.Config
+}
+
+// ContextWithCluster records the cluster a request targets. Impersonating
+// clients built from the context afterwards talk to config instead of the
+// console's own cluster.
+func ContextWithCluster(ctx context.Context, name string, config *rest.Config) context.Context {
+	return context.WithValue(ctx, clusterKey{}, clusterTarget{name: name, config: config})
+}
+
+// ClusterFromContext returns the name of the cluster the request targets,
+// or "" for the cluster the console runs in.
+func ClusterFromContext(ctx context.Context) string {
+	target, _ := ctx.Value(clusterKey{}).(clusterTarget)
+	return target.name
+}
+
+func clusterConfigFromContext(ctx context.Context) *rest.Config {
+	target, _ := ctx.Value(clusterKey{}).(clusterTarget)
+	return target.config
+}
+
+// RequestCluster returns the value of msg's cluster field, or "" when the
+// message has none.
+func RequestCluster(msg any) string {
+	m, ok := msg.(proto.Message)
+	if !ok {
+		return ""
+	}
+	r := m.ProtoReflect()
+	fd := r.Descriptor().Fields().ByName(clusterField)
+	if fd == nil || fd.Kind() != protoreflect.StringKind || fd.IsList() {
+		return ""
+	}
+	return r.Get(fd).String()
+}
+
+// ClusterInterceptor routes requests whose message sets the cluster field to
+// the named cluster in registry. It must run after authentication and before
+// ImpersonationInterceptor so the impersonating clients, and therefore the
+// RBAC evaluation, belong to the target cluster. Unknown names are rejected
+// with InvalidArgument; requests without a cluster are untouched.
+func ClusterInterceptor(registry ClusterRegistry) connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			name := RequestCluster(req.Any())
+			if name == "" {
+				return next(ctx, req)
+			}
+			var config *rest.Config
+			if registry != nil {
+				config, _ = registry.RESTConfig(name)
+			}
+			if config == nil {
+				return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unknown cluster %q", name))
+			}
+			trace.SpanFromContext(ctx).SetAttributes(attribute.String("holos.cluster", name))
+			return next(ContextWithCluster(ctx, name, config), req)
+		}
+	}
+}
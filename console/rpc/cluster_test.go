@@ -0,0 +1,82 @@
+package rpc
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"connectrpc.com/connect"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+)
+
+type staticClusters map[string]*rest.Config
+
+func (s staticClusters) RESTConfig(name string) (*rest.Config, bool) {
+	config, ok := s[name]
+	return config, ok
+}
+
+func TestClusterInterceptorRoutesImpersonationToTargetCluster(t *testing.T) {
+	hits := make(chan string, 2)
+	serve := func(name string) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			hits <- name + " " + r.Header.Get("Impersonate-User")
+			writeNamespaceList(t, w)
+		}))
+	}
+	home, east := serve("home"), serve("east")
+	defer home.Close()
+	defer east.Close()
+
+	handler := ClusterInterceptor(staticClusters{"east": testRESTConfig(east.URL)})(
+		ImpersonationInterceptor(testRESTConfig(home.URL), nil)(
+			func(ctx context.Context, _ connect.AnyRequest) (connect.AnyResponse, error) {
+				if _, err := ImpersonatedClientsetFromContext(ctx).CoreV1().Namespaces().List(ctx, metav1.ListOptions{}); err != nil {
+					t.Fatalf("list namespaces: %v", err)
+				}
+				return nil, nil
+			}))
+	ctx := ContextWithClaims(context.Background(), &Claims{Sub: "alice"})
+
+	for _, tc := range []struct {
+		cluster string
+		want    string
+	}{
+		{cluster: "east", want: "east oidc:alice"},
+		{cluster: "", want: "home oidc:alice"},
+	} {
+		if _, err := handler(ctx, connect.NewRequest(&consolev1.GetSecretRequest{Name: "db", Project: "billing", Cluster: tc.cluster})); err != nil {
+			t.Fatalf("cluster %q: %v", tc.cluster, err)
+		}
+		if got := <-hits; got != tc.want {
+			t.Errorf("cluster %q: request served by %q, want %q", tc.cluster, got, tc.want)
+		}
+	}
+}
+
+func TestClusterInterceptorRejectsUnknownCluster(t *testing.T) {
+	handler := ClusterInterceptor(nil)(func(ctx context.Context, _ connect.AnyRequest) (connect.AnyResponse, error) {
+		t.Fatal("handler must not run for an unknown cluster")
+		return nil, nil
+	})
+	_, err := handler(context.Background(), connect.NewRequest(&consolev1.ListSecretsRequest{Project: "billing", Cluster: "west"}))
+	if connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Fatalf("expected InvalidArgument, got %v", err)
+	}
+}
+
+func TestRequestCluster(t *testing.T) {
+	if got := RequestCluster(&consolev1.ListSecretsRequest{Cluster: "east"}); got != "east" {
+		t.Errorf("RequestCluster = %q, want east", got)
+	}
+	if got := RequestCluster(&consolev1.ListAuditEventsRequest{}); got != "" {
+		t.Errorf("expected no cluster for a message without the field, got %q", got)
+	}
+	if got := RequestCluster(nil); got != "" {
+		t.Errorf("expected no cluster for a nil message, got %q", got)
+	}
+}
@@ -0,0 +1,49 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+)
+
+// errShuttingDown ends streams interrupted by a server shutdown.
+var errShuttingDown = errors.New("server is shutting down")
+
+// DrainInterceptor ends streaming RPCs with CodeUnavailable once draining is
+// done, so long-lived streams close cleanly and their clients reconnect to
+// another replica while unary calls, such as uploads, run to completion
+// within the shutdown timeout. Streams started after draining began are
+// refused.
+func DrainInterceptor(draining context.Context) connect.Interceptor {
+	return &drainInterceptor{draining: draining}
+}
+
+type drainInterceptor struct {
+	draining context.Context
+}
+
+func (d *drainInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return next
+}
+
+func (d *drainInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (d *drainInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		if d.draining.Err() != nil {
+			return connect.NewError(connect.CodeUnavailable, errShuttingDown)
+		}
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		stop := context.AfterFunc(d.draining, cancel)
+		defer stop()
+		err := next(ctx, conn)
+		if err != nil && d.draining.Err() != nil {
+			return connect.NewError(connect.CodeUnavailable, errShuttingDown)
+		}
+		return err
+	}
+}
@@ -0,0 +1,36 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+)
+
+func TestDrainInterceptor(t *testing.T) {
+	draining, drain := context.WithCancel(context.Background())
+	started := make(chan struct{})
+	handler := DrainInterceptor(draining).WrapStreamingHandler(func(ctx context.Context, _ connect.StreamingHandlerConn) error {
+		close(started)
+		<-ctx.Done()
+		return ctx.Err()
+	})
+
+	done := make(chan error, 1)
+	go func() { done <- handler(context.Background(), nil) }()
+	<-started
+	drain()
+	if err := <-done; connect.CodeOf(err) != connect.CodeUnavailable {
+		t.Errorf("expected a drained stream to end Unavailable, got %v", err)
+	}
+	if err := handler(context.Background(), nil); connect.CodeOf(err) != connect.CodeUnavailable {
+		t.Errorf("expected a stream started while draining to be refused, got %v", err)
+	}
+
+	unary := DrainInterceptor(draining).WrapUnary(func(context.Context, connect.AnyRequest) (connect.AnyResponse, error) {
+		return nil, nil
+	})
+	if _, err := unary(context.Background(), nil); err != nil {
+		t.Errorf("expected unary calls to run while draining, got %v", err)
+	}
+}
@@ -0,0 +1,33 @@
+package rpc
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// dryRunKey is the context key marking a request as a dry run.
+type dryRunKey struct{}
+
+// ContextWithDryRun marks ctx as a dry run. Storage clients consult
+// DryRunFromContext and submit Kubernetes writes with server-side dry-run so
+// the API server performs admission and authorization without persisting.
+func ContextWithDryRun(ctx context.Context) context.Context {
+	return context.WithValue(ctx, dryRunKey{}, true)
+}
+
+// IsDryRun reports whether ctx was marked by ContextWithDryRun.
+func IsDryRun(ctx context.Context) bool {
+	dryRun, _ := ctx.Value(dryRunKey{}).(bool)
+	return dryRun
+}
+
+// DryRunFromContext returns the DryRun value for Kubernetes Create, Update,
+// Patch, and Delete options: []string{metav1.DryRunAll} for a dry run and nil
+// otherwise.
+func DryRunFromContext(ctx context.Context) []string {
+	if IsDryRun(ctx) {
+		return []string{metav1.DryRunAll}
+	}
+	return nil
+}
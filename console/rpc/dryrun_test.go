@@ -0,0 +1,22 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestDryRunFromContext(t *testing.T) {
+	ctx := context.Background()
+	if IsDryRun(ctx) || DryRunFromContext(ctx) != nil {
+		t.Fatal("expected plain context not to be a dry run")
+	}
+	ctx = ContextWithDryRun(ctx)
+	if !IsDryRun(ctx) {
+		t.Fatal("expected IsDryRun after ContextWithDryRun")
+	}
+	if got := DryRunFromContext(ctx); len(got) != 1 || got[0] != metav1.DryRunAll {
+		t.Fatalf("DryRunFromContext = %v, want [%s]", got, metav1.DryRunAll)
+	}
+}
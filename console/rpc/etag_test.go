@@ -0,0 +1,135 @@
+package rpc
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+)
+
+func TestReadETag(t *testing.T) {
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	etagNow = func() time.Time { return now }
+	t.Cleanup(func() { etagNow = time.Now })
+
+	alice := &Claims{Sub: "alice", Email: "alice@example.com"}
+	req := &consolev1.GetProjectRequest{Name: "web"}
+	tag := func(claims *Claims, req *consolev1.GetProjectRequest, versions ...string) string {
+		t.Helper()
+		etag, err := ReadETag(claims, req, versions...)
+		if err != nil {
+			t.Fatalf("ReadETag: %v", err)
+		}
+		return etag
+	}
+	a := tag(alice, req, "uid/1")
+
+	if got := tag(alice, &consolev1.GetProjectRequest{Name: "web", IfNoneMatch: a}, "uid/1"); got != a {
+		t.Errorf("expected if_none_match not to change the tag, got %q and %q", a, got)
+	}
+	if got := tag(alice, req, "uid/2"); got == a {
+		t.Error("expected a new resource version to change the tag")
+	}
+	if got := tag(&Claims{Sub: "bob", Email: "bob@example.com"}, req, "uid/1"); got == a {
+		t.Error("expected another caller to get another tag")
+	}
+	if got := tag(alice, &consolev1.GetProjectRequest{Name: "ops"}, "uid/1"); got == a {
+		t.Error("expected another request to get another tag")
+	}
+	now = now.Add(etagWindow - time.Second)
+	if got := tag(alice, req, "uid/1"); got != a {
+		t.Error("expected the tag to hold within the window")
+	}
+	now = now.Add(time.Second)
+	if got := tag(alice, req, "uid/1"); got == a {
+		t.Error("expected the tag to roll over with the window")
+	}
+}
+
+func TestObjectVersion(t *testing.T) {
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{UID: "uid", ResourceVersion: "7"}}
+	if got := ObjectVersion(ns); got != "uid/7" {
+		t.Errorf("expected uid/7, got %q", got)
+	}
+}
+
+func TestNotModified(t *testing.T) {
+	tests := []struct {
+		name        string
+		ifNoneMatch string
+		want        bool
+	}{
+		{name: "no condition"},
+		{name: "field matches", ifNoneMatch: "abc", want: true},
+		{name: "field differs", ifNoneMatch: "xyz"},
+		{name: "quoted tag", ifNoneMatch: `"abc"`, want: true},
+		{name: "weak tag in a list", ifNoneMatch: `"xyz", W/"abc"`, want: true},
+		{name: "wildcard", ifNoneMatch: "*", want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NotModified(tt.ifNoneMatch, "abc"); got != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestNotModified_BrowserRevalidation(t *testing.T) {
+	const etag = "abc"
+	mux := http.NewServeMux()
+	mux.Handle("/test.Service/GetProject", connect.NewUnaryHandler("/test.Service/GetProject",
+		func(_ context.Context, req *connect.Request[consolev1.GetProjectRequest]) (*connect.Response[consolev1.GetProjectResponse], error) {
+			resp := &consolev1.GetProjectResponse{Project: &consolev1.Project{Name: "a"}}
+			if NotModified(req.Msg.IfNoneMatch, etag) {
+				resp = &consolev1.GetProjectResponse{NotModified: true}
+			}
+			resp.Etag = etag
+			res := connect.NewResponse(resp)
+			SetETag(res.Header(), etag)
+			return res, nil
+		},
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithInterceptors(CacheControlInterceptor(DefaultGetCacheControl)),
+	))
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	get := func(t *testing.T, message string) string {
+		t.Helper()
+		req, err := http.NewRequest(http.MethodGet, srv.URL+"/test.Service/GetProject?encoding=json&message="+url.QueryEscape(message), nil)
+		if err != nil {
+			t.Fatalf("NewRequest: %v", err)
+		}
+		req.Header.Set("If-None-Match", `"`+etag+`"`)
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("GET: %v", err)
+		}
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(resp.Body)
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("expected 200, got %d: %s", resp.StatusCode, body)
+		}
+		return string(body)
+	}
+
+	// The browser adds If-None-Match when revalidating; the client did not
+	// ask for a not-modified answer and must get the content.
+	if body := get(t, `{}`); strings.Contains(body, "notModified") || !strings.Contains(body, `"name":"a"`) {
+		t.Errorf("expected the full response, got %s", body)
+	}
+	if body := get(t, `{"ifNoneMatch":"abc"}`); !strings.Contains(body, `"notModified":true`) {
+		t.Errorf("expected a not-modified response for the field, got %s", body)
+	}
+}
@@ -0,0 +1,22 @@
+package rpc
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// ActAsForTest resolves the effective claims for header as actAs does,
+// checking impersonation against the SelfSubjectAccessReview server at
+// host. It lets handler tests in package rpc_test act as another user.
+func ActAsForTest(ctx context.Context, host string, header http.Header, claims *Claims) (*Claims, error) {
+	config := testRESTConfig(host)
+	config.ContentType = "application/json"
+	return actAs(ctx, header, claims, &authInterceptorConfig{impersonationBaseConfig: config})
+}
+
+// ImpersonationAPIServerForTest exposes impersonationAPIServer.
+func ImpersonationAPIServerForTest(t *testing.T) *httptest.Server {
+	return impersonationAPIServer(t)
+}
@@ -0,0 +1,53 @@
+package rpc
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+)
+
+// GroupResolver returns console-local groups for a user, merged into the
+// groups of every user the auth interceptor authenticates.
+type GroupResolver interface {
+	GroupsFor(ctx context.Context, email string) ([]string, error)
+}
+
+// WithGroupResolver merges the groups resolver returns for an authenticated
+// user's email into their claims, for identity providers that do not issue
+// groups themselves.
+func WithGroupResolver(resolver GroupResolver) AuthInterceptorOption {
+	return func(cfg *authInterceptorConfig) {
+		cfg.groupResolver = resolver
+	}
+}
+
+// mergeGroups returns claims with the resolver's groups for the user added.
+// ServiceAccounts and users without an email are returned unchanged. A
+// resolver failure is logged and leaves the claims unchanged: extra groups
+// only ever add access, so going without them is safe.
+func mergeGroups(ctx context.Context, resolver GroupResolver, claims *Claims) *Claims {
+	if resolver == nil || claims.PrincipalType != PrincipalTypeUser || claims.Email == "" {
+		return claims
+	}
+	groups, err := resolver.GroupsFor(ctx, claims.Email)
+	if err != nil {
+		slog.WarnContext(ctx, "could not resolve console groups",
+			slog.String("sub", claims.Sub),
+			slog.String("email", claims.Email),
+			slog.Any("error", err),
+		)
+		return claims
+	}
+	var added []string
+	for _, group := range groups {
+		if !slices.Contains(claims.Roles, group) && !slices.Contains(added, group) {
+			added = append(added, group)
+		}
+	}
+	if len(added) == 0 {
+		return claims
+	}
+	merged := *claims
+	merged.Roles = append(slices.Clone(claims.Roles), added...)
+	return &merged
+}
@@ -18,10 +18,21 @@ import (
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
 	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/holos-run/holos-console/console/principal"
 )
 
 const oidcImpersonationPrefix = "oidc:"
 
+// Client certificate and trusted proxy identities are impersonated in their
+// own principal namespaces. A certificate's common name or a proxy's
+// X-Forwarded-User is chosen by whoever issues the certificate or runs the
+// proxy, so neither may name an oidc: user or group.
+const (
+	clientCertImpersonationPrefix   = "x509:"
+	trustedProxyImpersonationPrefix = "proxy:"
+)
+
 // ErrUnauthenticatedImpersonation is returned when an impersonating client
 // cannot be built because the request has no authenticated OIDC principal.
 var ErrUnauthenticatedImpersonation = errors.New("authenticated OIDC subject is required for kubernetes impersonation")
@@ -35,7 +46,8 @@ type ImpersonatedClients struct {
 }
 
 // NewImpersonatedClients creates Kubernetes clients that impersonate the OIDC
-// subject and groups from claims. The returned clients must be scoped to the
+// subject and groups from claims, or the ServiceAccount when
+// claims.IsServiceAccount. The returned clients must be scoped to the
 // current request context; callers must not store them globally.
 func NewImpersonatedClients(claims *Claims, base *rest.Config, scheme *runtime.Scheme) (*ImpersonatedClients, error) {
 	if claims == nil || strings.TrimSpace(claims.Sub) == "" {
@@ -46,21 +58,60 @@ func NewImpersonatedClients(claims *Claims, base *rest.Config, scheme *runtime.S
 	}
 
 	config := rest.CopyConfig(base)
+	userName, groups := KubernetesIdentity(claims)
 	config.Impersonate = rest.ImpersonationConfig{
-		UserName: oidcImpersonationPrefix + claims.Sub,
-		Groups:   PrefixedOIDCGroups(claims.Roles),
+		UserName: userName,
+		Groups:   groups,
 	}
 	return newClientsForConfig(config, scheme)
 }
 
+// KubernetesIdentity returns the username and groups the console impersonates
+// for claims: the oidc:-prefixed subject and groups for OIDC users, plus the
+// groups "*" and "*@example.com" user grants are bound to (see package
+// principal). Client certificate and trusted proxy identities are prefixed
+// with x509: and proxy: instead, so they never match an oidc: binding.
+// ServiceAccount identities come from the API server's own TokenReview, so
+// they are impersonated verbatim rather than mapped into a principal
+// namespace.
+func KubernetesIdentity(claims *Claims) (string, []string) {
+	if claims.IsServiceAccount() {
+		return claims.Sub, claims.Roles
+	}
+	prefix := impersonationPrefix(claims)
+	groups := append(prefixGroups(prefix, claims.Roles), principal.Groups(claims.Email, claims.EmailVerified)...)
+	return prefix + claims.Sub, groups
+}
+
+// PrefixedGroups maps groups held by claims into the Kubernetes principal
+// namespace KubernetesIdentity impersonates them in.
+func PrefixedGroups(claims *Claims, groups []string) []string {
+	return prefixGroups(impersonationPrefix(claims), groups)
+}
+
+func impersonationPrefix(claims *Claims) string {
+	switch claims.Iss {
+	case PrincipalIssuerClientCertificate:
+		return clientCertImpersonationPrefix
+	case PrincipalIssuerTrustedProxy:
+		return trustedProxyImpersonationPrefix
+	}
+	return oidcImpersonationPrefix
+}
+
 // ImpersonationInterceptor builds per-request Kubernetes clients from the
 // authenticated OIDC claims already stored on the request context. It is kept
 // separate from auth so handlers can migrate to Impersonated*FromContext
-// without depending on a particular token verifier implementation.
+// without depending on a particular token verifier implementation. Requests
+// routed by ClusterInterceptor impersonate against the target cluster.
 func ImpersonationInterceptor(base *rest.Config, scheme *runtime.Scheme) connect.UnaryInterceptorFunc {
 	return func(next connect.UnaryFunc) connect.UnaryFunc {
 		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
-			clients, err := NewImpersonatedClients(ClaimsFromContext(ctx), base, scheme)
+			config := base
+			if target := clusterConfigFromContext(ctx); target != nil {
+				config = target
+			}
+			clients, err := NewImpersonatedClients(ClaimsFromContext(ctx), config, scheme)
 			if err != nil {
 				if errors.Is(err, ErrUnauthenticatedImpersonation) {
 					return nil, connect.NewError(connect.CodeUnauthenticated, err)
@@ -76,6 +127,10 @@ func ImpersonationInterceptor(base *rest.Config, scheme *runtime.Scheme) connect
 // PrefixedOIDCGroups maps OIDC group claims into the Kubernetes principal
 // namespace defined by ADR 036.
 func PrefixedOIDCGroups(groups []string) []string {
+	return prefixGroups(oidcImpersonationPrefix, groups)
+}
+
+func prefixGroups(prefix string, groups []string) []string {
 	if len(groups) == 0 {
 		return nil
 	}
@@ -85,7 +140,7 @@ func PrefixedOIDCGroups(groups []string) []string {
 		if group == "" || strings.HasPrefix(group, "system:") {
 			continue
 		}
-		prefixed = append(prefixed, oidcImpersonationPrefix+group)
+		prefixed = append(prefixed, prefix+group)
 	}
 	return prefixed
 }
@@ -41,7 +41,8 @@ func TestNewImpersonatedClientsAddsOIDCImpersonationHeaders(t *testing.T) {
 	if got.Get("Impersonate-User") != "oidc:user-123" {
 		t.Fatalf("Impersonate-User = %q, want oidc:user-123", got.Get("Impersonate-User"))
 	}
-	wantGroups := []string{"oidc:platform-admins", "oidc:project-editors"}
+	// The email is unverified, so only the wildcard group is added.
+	wantGroups := []string{"oidc:platform-admins", "oidc:project-editors", "holos:authenticated"}
 	if !reflect.DeepEqual(got.Values("Impersonate-Group"), wantGroups) {
 		t.Fatalf("Impersonate-Group = %#v, want %#v", got.Values("Impersonate-Group"), wantGroups)
 	}
@@ -175,3 +176,30 @@ func writeNamespaceList(t *testing.T, w http.ResponseWriter) {
 		t.Fatalf("write response: %v", err)
 	}
 }
+
+func TestKubernetesIdentityKeepsCertificateAndProxyPrincipalsOutOfOIDC(t *testing.T) {
+	// Each identity names the same subject and group as an OIDC user.
+	for _, tc := range []struct {
+		iss        string
+		wantUser   string
+		wantGroups []string
+	}{
+		{"https://login.example.com", "oidc:user-123", []string{"oidc:platform-admins", "holos:authenticated"}},
+		{PrincipalIssuerClientCertificate, "x509:user-123", []string{"x509:platform-admins", "holos:authenticated"}},
+		{PrincipalIssuerTrustedProxy, "proxy:user-123", []string{"proxy:platform-admins", "holos:authenticated"}},
+	} {
+		t.Run(tc.iss, func(t *testing.T) {
+			claims := &Claims{Iss: tc.iss, Sub: "user-123", Roles: []string{"platform-admins"}, PrincipalType: PrincipalTypeUser}
+			user, groups := KubernetesIdentity(claims)
+			if user != tc.wantUser {
+				t.Errorf("user = %q, want %q", user, tc.wantUser)
+			}
+			if !reflect.DeepEqual(groups, tc.wantGroups) {
+				t.Errorf("groups = %#v, want %#v", groups, tc.wantGroups)
+			}
+			if got := PrefixedGroups(claims, claims.Roles); !reflect.DeepEqual(got, tc.wantGroups[:1]) {
+				t.Errorf("PrefixedGroups = %#v, want %#v", got, tc.wantGroups[:1])
+			}
+		})
+	}
+}
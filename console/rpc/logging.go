@@ -25,9 +25,9 @@ func LoggingInterceptor() connect.UnaryInterceptorFunc {
 
 			if err != nil {
 				attrs = append(attrs, "error", err.Error())
-				slog.Error("rpc call failed", attrs...)
+				slog.ErrorContext(ctx, "rpc call failed", attrs...)
 			} else {
-				slog.Info("rpc call", attrs...)
+				slog.InfoContext(ctx, "rpc call", attrs...)
 			}
 
 			return resp, err
@@ -2,7 +2,10 @@ package rpc
 
 import (
 	"context"
+	"strings"
+	"sync"
 	"time"
+	"unicode"
 
 	"connectrpc.com/connect"
 	"github.com/prometheus/client_golang/prometheus"
@@ -34,6 +37,45 @@ var (
 		},
 		[]string{"procedure"},
 	)
+
+	rpcRateLimitedTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "rpc_rate_limited_total",
+			Help: "Total number of RPC requests rejected by rate limiting, by procedure and scope (principal or ip).",
+		},
+		[]string{"procedure", "scope"},
+	)
+
+	rpcTimeoutsTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "rpc_timeouts_total",
+			Help: "Total number of RPC requests that exceeded their server-side timeout, by procedure.",
+		},
+		[]string{"procedure"},
+	)
+
+	rpcAuthorizationsTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "rpc_authorizations_total",
+			Help: "Total number of authorization outcomes by service, method, resource type and result (allowed or denied).",
+		},
+		[]string{"service", "method", "resource_type", "result"},
+	)
+
+	rpcGrantResolutionDuration = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "rpc_grant_resolution_duration_seconds",
+			Help:    "Histogram of time spent resolving the caller's grants for an authorization check.",
+			Buckets: []float64{.0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1},
+		},
+		[]string{"service", "method", "resource_type"},
+	)
+)
+
+// Authorization results recorded by AuthorizationMetricsInterceptor.
+const (
+	authzResultAllowed = "allowed"
+	authzResultDenied  = "denied"
 )
 
 // MetricsInterceptor returns a connect.UnaryInterceptorFunc that records Prometheus metrics.
@@ -61,3 +103,109 @@ func MetricsInterceptor() connect.UnaryInterceptorFunc {
 		}
 	}
 }
+
+// authzOutcomeKey is the context key for the per-request authzOutcome.
+type authzOutcomeKey struct{}
+
+// authzOutcome accumulates the authorization decisions made while serving a
+// single request.
+type authzOutcome struct {
+	service, method, resourceType string
+
+	mu      sync.Mutex
+	allowed bool
+	denied  bool
+}
+
+// AuthorizationMetricsInterceptor records one authorization outcome per RPC,
+// labeled by service, method, resource type and result. It must run after
+// the auth interceptor so only authenticated calls are counted. A call is
+// denied when any check recorded with RecordAuthorization denied it or when
+// it returns PermissionDenied, which also covers denials from the Kubernetes
+// API server. It is allowed when a check allowed it or when it succeeded.
+// Calls that fail before any decision, e.g. on validation, are not counted.
+func AuthorizationMetricsInterceptor() connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			service, method := splitProcedure(req.Spec().Procedure)
+			outcome := &authzOutcome{service: service, method: method, resourceType: resourceType(service)}
+			resp, err := next(context.WithValue(ctx, authzOutcomeKey{}, outcome), req)
+
+			outcome.mu.Lock()
+			allowed, denied := outcome.allowed, outcome.denied
+			outcome.mu.Unlock()
+
+			var result string
+			switch {
+			case denied || connect.CodeOf(err) == connect.CodePermissionDenied:
+				result = authzResultDenied
+			case allowed || err == nil:
+				result = authzResultAllowed
+			default:
+				return resp, err
+			}
+			rpcAuthorizationsTotal.WithLabelValues(service, method, outcome.resourceType, result).Inc()
+			return resp, err
+		}
+	}
+}
+
+// RecordAuthorization notes the result of an authorization check made while
+// serving the request in ctx: a nil err allows and a PermissionDenied err
+// denies. Other errors mean no decision was reached and are ignored, as are
+// contexts without AuthorizationMetricsInterceptor.
+func RecordAuthorization(ctx context.Context, err error) {
+	outcome, ok := ctx.Value(authzOutcomeKey{}).(*authzOutcome)
+	if !ok {
+		return
+	}
+	outcome.mu.Lock()
+	defer outcome.mu.Unlock()
+	switch {
+	case err == nil:
+		outcome.allowed = true
+	case connect.CodeOf(err) == connect.CodePermissionDenied:
+		outcome.denied = true
+	}
+}
+
+// ObserveGrantResolution records the time since start as grant-resolution
+// latency for the request in ctx. Call it once the caller's grants or access
+// reviews have been resolved.
+func ObserveGrantResolution(ctx context.Context, start time.Time) {
+	outcome, ok := ctx.Value(authzOutcomeKey{}).(*authzOutcome)
+	if !ok {
+		return
+	}
+	rpcGrantResolutionDuration.WithLabelValues(outcome.service, outcome.method, outcome.resourceType).Observe(time.Since(start).Seconds())
+}
+
+// resourceTypeOverrides names the resource types of services whose names
+// are plural.
+var resourceTypeOverrides = map[string]string{
+	"SecretsService":     "secret",
+	"PermissionsService": "permission",
+}
+
+// resourceType derives the resource_type label from a fully qualified
+// service name, e.g. "holos.console.v1.TemplatePolicyService" is
+// "template_policy", matching the resource_type attribute of the audit logs.
+func resourceType(service string) string {
+	if i := strings.LastIndex(service, "."); i >= 0 {
+		service = service[i+1:]
+	}
+	if rt, ok := resourceTypeOverrides[service]; ok {
+		return rt
+	}
+	var b strings.Builder
+	for i, r := range strings.TrimSuffix(service, "Service") {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
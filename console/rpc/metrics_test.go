@@ -0,0 +1,88 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+// procedureRequest is a request whose Spec reports procedure.
+type procedureRequest struct {
+	*connect.Request[any]
+	procedure string
+}
+
+func (r procedureRequest) Spec() connect.Spec {
+	return connect.Spec{Procedure: r.procedure}
+}
+
+func TestAuthorizationMetricsInterceptor(t *testing.T) {
+	denied := connect.NewError(connect.CodePermissionDenied, errors.New("denied"))
+	tests := []struct {
+		name      string
+		method    string
+		record    []error
+		err       error
+		want      string // result label, empty when nothing is counted
+		wantGrant bool
+	}{
+		{name: "success allows", method: "GetSecret", want: authzResultAllowed},
+		{name: "permission denied", method: "UpdateSecret", err: denied, want: authzResultDenied},
+		{name: "recorded denial wins", method: "ListSecrets", record: []error{nil, denied}, want: authzResultDenied, wantGrant: true},
+		{name: "allowed then not found", method: "DeleteSecret", record: []error{nil}, err: connect.NewError(connect.CodeNotFound, errors.New("gone")), want: authzResultAllowed, wantGrant: true},
+		{name: "no decision", method: "CreateSecret", err: connect.NewError(connect.CodeInvalidArgument, errors.New("bad"))},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := AuthorizationMetricsInterceptor()(func(ctx context.Context, _ connect.AnyRequest) (connect.AnyResponse, error) {
+				for _, err := range tt.record {
+					ObserveGrantResolution(ctx, time.Now())
+					RecordAuthorization(ctx, err)
+				}
+				return nil, tt.err
+			})
+			before := map[string]float64{}
+			for _, result := range []string{authzResultAllowed, authzResultDenied} {
+				before[result] = testutil.ToFloat64(rpcAuthorizationsTotal.WithLabelValues("holos.console.v1.SecretsService", tt.method, "secret", result))
+			}
+			grantsBefore := testutil.CollectAndCount(rpcGrantResolutionDuration)
+
+			req := procedureRequest{Request: connect.NewRequest[any](nil), procedure: "/holos.console.v1.SecretsService/" + tt.method}
+			if _, err := handler(context.Background(), req); !errors.Is(err, tt.err) {
+				t.Fatalf("expected handler error to pass through, got %v", err)
+			}
+
+			for _, result := range []string{authzResultAllowed, authzResultDenied} {
+				want := 0.0
+				if result == tt.want {
+					want = 1
+				}
+				got := testutil.ToFloat64(rpcAuthorizationsTotal.WithLabelValues("holos.console.v1.SecretsService", tt.method, "secret", result)) - before[result]
+				if got != want {
+					t.Errorf("result %s: expected %v new outcomes, got %v", result, want, got)
+				}
+			}
+			if gotGrant := testutil.CollectAndCount(rpcGrantResolutionDuration) > grantsBefore; gotGrant != tt.wantGrant {
+				t.Errorf("expected grant resolution observed = %v, got %v", tt.wantGrant, gotGrant)
+			}
+		})
+	}
+}
+
+func TestResourceType(t *testing.T) {
+	tests := map[string]string{
+		"holos.console.v1.SecretsService": "secret",
+		"ProjectService":                  "project",
+		"TemplatePolicyBindingService":    "template_policy_binding",
+		"ProjectSettingsService":          "project_settings",
+	}
+	for service, want := range tests {
+		if got := resourceType(service); got != want {
+			t.Errorf("resourceType(%q) = %q, want %q", service, got, want)
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	oidcTokenVerificationsTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "oidc_token_verifications_total",
+			Help: "Total number of ID token verifications by result (ok or the failure reason).",
+		},
+		[]string{"result"},
+	)
+
+	oidcTokenVerificationDuration = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "oidc_token_verification_duration_seconds",
+			Help:    "Histogram of ID token verification latencies, including fetching signing keys.",
+			Buckets: []float64{.0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5},
+		},
+	)
+
+	oidcDiscoveryFailuresTotal = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "oidc_discovery_failures_total",
+			Help: "Total number of failed OIDC provider discovery document fetches.",
+		},
+	)
+)
+
+// Token verification results recorded by VerifyIDToken.
+const (
+	verifyResultOK           = "ok"
+	verifyResultExpired      = "expired"
+	verifyResultNotYetValid  = "not_yet_valid"
+	verifyResultBadAudience  = "bad_audience"
+	verifyResultBadIssuer    = "bad_issuer"
+	verifyResultBadSignature = "bad_signature"
+	verifyResultMalformed    = "malformed"
+	verifyResultOther        = "other"
+)
+
+// DiscoverProvider performs OIDC discovery for issuer, counting failures so
+// an unreachable identity provider shows up before users report it.
+func DiscoverProvider(ctx context.Context, issuer string) (*oidc.Provider, error) {
+	provider, err := oidc.NewProvider(ctx, issuer)
+	if err != nil {
+		oidcDiscoveryFailuresTotal.Inc()
+		return nil, err
+	}
+	return provider, nil
+}
+
+// VerifyIDToken verifies rawIDToken with verifier, recording the latency and
+// the result, which names the reason a token was rejected.
+func VerifyIDToken(ctx context.Context, verifier *oidc.IDTokenVerifier, rawIDToken string) (*oidc.IDToken, error) {
+	start := time.Now()
+	idToken, err := verifier.Verify(ctx, rawIDToken)
+	oidcTokenVerificationDuration.Observe(time.Since(start).Seconds())
+	oidcTokenVerificationsTotal.WithLabelValues(verifyResult(err)).Inc()
+	return idToken, err
+}
+
+// verifyResult classifies a Verify error. go-oidc only types the expiry
+// error, so the other reasons are matched on its error messages.
+func verifyResult(err error) string {
+	if err == nil {
+		return verifyResultOK
+	}
+	var expired *oidc.TokenExpiredError
+	if errors.As(err, &expired) {
+		return verifyResultExpired
+	}
+	msg := err.Error()
+	switch {
+	case strings.Contains(msg, "expected audience"):
+		return verifyResultBadAudience
+	case strings.Contains(msg, "issued by a different provider"):
+		return verifyResultBadIssuer
+	case strings.Contains(msg, "failed to verify signature"):
+		return verifyResultBadSignature
+	case strings.Contains(msg, "before the nbf"):
+		return verifyResultNotYetValid
+	case strings.Contains(msg, "malformed jwt"),
+		strings.Contains(msg, "id token not signed"),
+		strings.Contains(msg, "multiple signatures"),
+		strings.Contains(msg, "failed to unmarshal claims"):
+		return verifyResultMalformed
+	}
+	return verifyResultOther
+}
@@ -0,0 +1,68 @@
+package rpc
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestVerifyIDTokenRecordsResult(t *testing.T) {
+	fake := newFakeOIDCServer(t)
+	defer fake.Server.Close()
+
+	provider, err := DiscoverProvider(context.Background(), fake.Server.URL)
+	if err != nil {
+		t.Fatalf("DiscoverProvider: %v", err)
+	}
+	verifier := provider.Verifier(&oidc.Config{ClientID: "console"})
+
+	valid := fake.signToken(t, "user-1", "console")
+	other := fake.signToken(t, "user-2", "console")
+	forged := valid[:strings.LastIndex(valid, ".")] + other[strings.LastIndex(other, "."):]
+	expired := fake.signTokenWithClaims(t, "user-1", "console", map[string]interface{}{
+		"exp": time.Now().Add(-time.Minute).Unix(),
+	})
+
+	tests := []struct {
+		name  string
+		token string
+		want  string
+	}{
+		{"valid", valid, verifyResultOK},
+		{"wrong audience", fake.signToken(t, "user-1", "other"), verifyResultBadAudience},
+		{"expired", expired, verifyResultExpired},
+		{"forged signature", forged, verifyResultBadSignature},
+		{"not a jwt", "garbage", verifyResultMalformed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			counter := oidcTokenVerificationsTotal.WithLabelValues(tt.want)
+			before := testutil.ToFloat64(counter)
+			_, err := VerifyIDToken(context.Background(), verifier, tt.token)
+			if (err == nil) != (tt.want == verifyResultOK) {
+				t.Fatalf("unexpected error %v", err)
+			}
+			if got := testutil.ToFloat64(counter) - before; got != 1 {
+				t.Errorf("expected result %s to be counted once, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestDiscoverProviderCountsFailures(t *testing.T) {
+	fake := newFakeOIDCServer(t)
+	defer fake.Server.Close()
+	fake.ShouldFail.Store(true)
+
+	before := testutil.ToFloat64(oidcDiscoveryFailuresTotal)
+	if _, err := DiscoverProvider(context.Background(), fake.Server.URL); err == nil {
+		t.Fatal("expected discovery to fail")
+	}
+	if got := testutil.ToFloat64(oidcDiscoveryFailuresTotal) - before; got != 1 {
+		t.Errorf("expected one discovery failure, got %v", got)
+	}
+}
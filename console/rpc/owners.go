@@ -0,0 +1,31 @@
+package rpc
+
+import "strings"
+
+// PlatformOwners identifies the platform owners: callers whose email is in
+// Users or who hold one of Roles. They may change console-wide state and
+// override safety checks that protect ordinary owners from themselves.
+type PlatformOwners struct {
+	Users []string
+	Roles []string
+}
+
+// Includes reports whether claims identify a platform owner.
+func (o PlatformOwners) Includes(claims *Claims) bool {
+	if claims == nil {
+		return false
+	}
+	for _, u := range o.Users {
+		if claims.Email != "" && strings.EqualFold(u, claims.Email) {
+			return true
+		}
+	}
+	for _, r := range claims.Roles {
+		for _, owner := range o.Roles {
+			if strings.EqualFold(owner, r) {
+				return true
+			}
+		}
+	}
+	return false
+}
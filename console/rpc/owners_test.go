@@ -0,0 +1,24 @@
+package rpc
+
+import "testing"
+
+func TestPlatformOwners(t *testing.T) {
+	owners := PlatformOwners{Users: []string{"Admin@example.com"}, Roles: []string{"platform-admins"}}
+	cases := []struct {
+		name   string
+		claims *Claims
+		want   bool
+	}{
+		{"email matches case-insensitively", &Claims{Email: "admin@example.com"}, true},
+		{"role matches", &Claims{Email: "bob@example.com", Roles: []string{"dev", "Platform-Admins"}}, true},
+		{"neither matches", &Claims{Email: "bob@example.com", Roles: []string{"dev"}}, false},
+		{"no claims", nil, false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := owners.Includes(tc.claims); got != tc.want {
+				t.Errorf("Includes = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
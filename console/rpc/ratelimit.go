@@ -0,0 +1,135 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	"golang.org/x/time/rate"
+)
+
+// Rate limit scopes recorded on the rpc_rate_limited_total metric.
+const (
+	rateLimitScopePrincipal = "principal"
+	rateLimitScopeIP        = "ip"
+)
+
+// rateLimiterIdleTTL is how long an unused per-key bucket is retained. A
+// bucket idle this long has refilled completely, so dropping it is
+// indistinguishable from keeping it.
+const rateLimiterIdleTTL = 10 * time.Minute
+
+// RateLimitConfig configures RateLimitInterceptor. A zero rate disables the
+// corresponding limit.
+type RateLimitConfig struct {
+	// PrincipalRate is the sustained requests per second allowed for each
+	// authenticated principal, keyed by the OIDC subject.
+	PrincipalRate float64
+	// PrincipalBurst is the token bucket size for each principal.
+	PrincipalBurst int
+	// IPRate is the sustained requests per second allowed for each client IP.
+	IPRate float64
+	// IPBurst is the token bucket size for each client IP.
+	IPBurst int
+	// ClientIPHeader names a request header, such as X-Forwarded-For, whose
+	// first address identifies the client when the console runs behind a
+	// trusted proxy. Empty uses the connection's peer address.
+	ClientIPHeader string
+}
+
+// RateLimitInterceptor returns a connect.UnaryInterceptorFunc that enforces
+// token bucket limits per client IP and, once the request carries Claims, per
+// principal. Requests over either limit fail with CodeResourceExhausted and
+// increment rpc_rate_limited_total.
+//
+// Place it after the auth interceptor so the principal limit sees the
+// caller's Claims. On routes without auth only the IP limit applies.
+func RateLimitInterceptor(cfg RateLimitConfig) connect.UnaryInterceptorFunc {
+	var principals, ips *keyedLimiter
+	if cfg.PrincipalRate > 0 {
+		principals = newKeyedLimiter(rate.Limit(cfg.PrincipalRate), max(cfg.PrincipalBurst, 1))
+	}
+	if cfg.IPRate > 0 {
+		ips = newKeyedLimiter(rate.Limit(cfg.IPRate), max(cfg.IPBurst, 1))
+	}
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			procedure := req.Spec().Procedure
+			now := time.Now()
+			if ips != nil {
+				if ip := clientIP(req, cfg.ClientIPHeader); ip != "" && !ips.allow(ip, now) {
+					rpcRateLimitedTotal.WithLabelValues(procedure, rateLimitScopeIP).Inc()
+					return nil, connect.NewError(connect.CodeResourceExhausted, fmt.Errorf("rate limit exceeded for client address"))
+				}
+			}
+			if principals != nil {
+				if claims := ClaimsFromContext(ctx); claims != nil && claims.Sub != "" && !principals.allow(claims.Sub, now) {
+					rpcRateLimitedTotal.WithLabelValues(procedure, rateLimitScopePrincipal).Inc()
+					return nil, connect.NewError(connect.CodeResourceExhausted, fmt.Errorf("rate limit exceeded for principal"))
+				}
+			}
+			return next(ctx, req)
+		}
+	}
+}
+
+// clientIP returns the client address of req, preferring the first entry of
+// header when set and present.
+func clientIP(req connect.AnyRequest, header string) string {
+	if header != "" {
+		if value := req.Header().Get(header); value != "" {
+			first, _, _ := strings.Cut(value, ",")
+			return strings.TrimSpace(first)
+		}
+	}
+	addr := req.Peer().Addr
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		return host
+	}
+	return addr
+}
+
+// keyedLimiter holds one token bucket per key and evicts buckets that have
+// been idle longer than rateLimiterIdleTTL.
+type keyedLimiter struct {
+	limit rate.Limit
+	burst int
+
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+}
+
+type bucket struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+func newKeyedLimiter(limit rate.Limit, burst int) *keyedLimiter {
+	return &keyedLimiter{limit: limit, burst: burst, buckets: make(map[string]*bucket)}
+}
+
+// allow reports whether key may make a request at now, consuming a token if so.
+func (l *keyedLimiter) allow(key string, now time.Time) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if now.Sub(l.lastSweep) > rateLimiterIdleTTL {
+		for k, b := range l.buckets {
+			if now.Sub(b.lastSeen) > rateLimiterIdleTTL {
+				delete(l.buckets, k)
+			}
+		}
+		l.lastSweep = now
+	}
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{limiter: rate.NewLimiter(l.limit, l.burst)}
+		l.buckets[key] = b
+	}
+	b.lastSeen = now
+	return b.limiter.AllowN(now, 1)
+}
@@ -0,0 +1,85 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"golang.org/x/time/rate"
+)
+
+func TestRateLimitInterceptor(t *testing.T) {
+	cases := []struct {
+		name      string
+		cfg       RateLimitConfig
+		requests  []string // client IP per request; the principal is fixed
+		claims    *Claims
+		wantAllow []bool
+	}{
+		{
+			name:      "principal limit",
+			cfg:       RateLimitConfig{PrincipalRate: 0.001, PrincipalBurst: 2, ClientIPHeader: "X-Forwarded-For"},
+			requests:  []string{"10.0.0.1", "10.0.0.2", "10.0.0.3"},
+			claims:    &Claims{Sub: "user-1"},
+			wantAllow: []bool{true, true, false},
+		},
+		{
+			name:      "ip limit applies without claims",
+			cfg:       RateLimitConfig{IPRate: 0.001, IPBurst: 1, ClientIPHeader: "X-Forwarded-For"},
+			requests:  []string{"10.0.0.1, 192.168.0.1", "10.0.0.1", "10.0.0.2"},
+			wantAllow: []bool{true, false, true},
+		},
+		{
+			name:      "zero rates disable limits",
+			cfg:       RateLimitConfig{ClientIPHeader: "X-Forwarded-For"},
+			requests:  []string{"10.0.0.1", "10.0.0.1", "10.0.0.1"},
+			claims:    &Claims{Sub: "user-1"},
+			wantAllow: []bool{true, true, true},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			handler := RateLimitInterceptor(tc.cfg)(noopHandler)
+			ctx := context.Background()
+			if tc.claims != nil {
+				ctx = ContextWithClaims(ctx, tc.claims)
+			}
+			for i, ip := range tc.requests {
+				req := newTestRequest("")
+				req.Header().Set("X-Forwarded-For", ip)
+				_, err := handler(ctx, req)
+				if tc.wantAllow[i] {
+					if err != nil {
+						t.Fatalf("request %d: expected allowed, got %v", i, err)
+					}
+					continue
+				}
+				if connect.CodeOf(err) != connect.CodeResourceExhausted {
+					t.Fatalf("request %d: expected CodeResourceExhausted, got %v", i, err)
+				}
+			}
+		})
+	}
+}
+
+func TestKeyedLimiter_Refill(t *testing.T) {
+	l := newKeyedLimiter(rate.Limit(1), 1)
+	now := time.Unix(1000, 0)
+	if !l.allow("k", now) {
+		t.Fatal("expected first request allowed")
+	}
+	if l.allow("k", now) {
+		t.Fatal("expected second request in the same instant denied")
+	}
+	if !l.allow("k", now.Add(time.Second)) {
+		t.Fatal("expected request allowed after the bucket refills")
+	}
+
+	// Idle buckets are evicted on the next sweep.
+	later := now.Add(2 * rateLimiterIdleTTL)
+	l.allow("other", later)
+	if _, ok := l.buckets["k"]; ok {
+		t.Fatal("expected idle bucket to be evicted")
+	}
+}
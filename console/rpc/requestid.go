@@ -0,0 +1,103 @@
+package rpc
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"connectrpc.com/connect"
+)
+
+// RequestIDHeader carries the request ID on requests and responses.
+const RequestIDHeader = "X-Request-Id"
+
+// maxRequestIDLength bounds incoming request IDs so a client cannot inflate
+// every log line written for its request.
+const maxRequestIDLength = 128
+
+type requestIDKey struct{}
+
+// ContextWithRequestID returns a copy of ctx carrying the request ID id.
+func ContextWithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// RequestIDFromContext returns the request ID stored in ctx, or "" if none.
+func RequestIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+// RequestIDMiddleware assigns every HTTP request an ID, stores it in the
+// request context and echoes it in the X-Request-Id response header. A valid
+// incoming X-Request-Id, e.g. one set by an ingress proxy, is kept so the
+// console's logs correlate with the proxy's; otherwise a random ID is
+// generated.
+func RequestIDMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.Header.Get(RequestIDHeader)
+		if !validRequestID(id) {
+			id = newRequestID()
+		}
+		w.Header().Set(RequestIDHeader, id)
+		next.ServeHTTP(w, r.WithContext(ContextWithRequestID(r.Context(), id)))
+	})
+}
+
+// RequestIDInterceptor appends the request ID to the message of every error
+// an RPC returns, so a user reporting an error can quote the ID found in the
+// logs. The error code, details and metadata are preserved.
+func RequestIDInterceptor() connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			resp, err := next(ctx, req)
+			id := RequestIDFromContext(ctx)
+			if err == nil || id == "" {
+				return resp, err
+			}
+			return resp, withRequestID(err, id)
+		}
+	}
+}
+
+// withRequestID returns a connect error equivalent to err whose message ends
+// with the request ID.
+func withRequestID(err error, id string) error {
+	var ce *connect.Error
+	if !errors.As(err, &ce) {
+		ce = connect.NewError(connect.CodeUnknown, err)
+	}
+	out := connect.NewError(ce.Code(), fmt.Errorf("%s (request id %s)", ce.Message(), id))
+	for _, d := range ce.Details() {
+		out.AddDetail(d)
+	}
+	for k, v := range ce.Meta() {
+		out.Meta()[k] = v
+	}
+	out.Meta().Set(RequestIDHeader, id)
+	return out
+}
+
+// validRequestID reports whether id is a non-empty, bounded string of
+// printable ASCII without spaces, safe to log and echo in a header.
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for i := 0; i < len(id); i++ {
+		if id[i] <= ' ' || id[i] > '~' {
+			return false
+		}
+	}
+	return true
+}
+
+// newRequestID returns a random 128-bit request ID in hex.
+func newRequestID() string {
+	var b [16]byte
+	_, _ = rand.Read(b[:])
+	return hex.EncodeToString(b[:])
+}
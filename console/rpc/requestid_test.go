@@ -0,0 +1,76 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"connectrpc.com/connect"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+func TestRequestIDMiddleware(t *testing.T) {
+	tests := []struct {
+		name     string
+		incoming string
+		keep     bool
+	}{
+		{name: "generates an ID", incoming: ""},
+		{name: "honors incoming ID", incoming: "abc-123", keep: true},
+		{name: "replaces ID with spaces", incoming: "abc 123"},
+		{name: "replaces oversized ID", incoming: strings.Repeat("a", maxRequestIDLength+1)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got string
+			handler := RequestIDMiddleware(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+				got = RequestIDFromContext(r.Context())
+			}))
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tt.incoming != "" {
+				req.Header.Set(RequestIDHeader, tt.incoming)
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			if got == "" || rec.Header().Get(RequestIDHeader) != got {
+				t.Fatalf("expected context ID %q to match response header %q", got, rec.Header().Get(RequestIDHeader))
+			}
+			if (got == tt.incoming) != tt.keep {
+				t.Errorf("incoming %q: got ID %q, keep = %v", tt.incoming, got, tt.keep)
+			}
+		})
+	}
+}
+
+func TestRequestIDInterceptor(t *testing.T) {
+	ctx := ContextWithRequestID(context.Background(), "req-1")
+	want := connect.NewError(connect.CodeInvalidArgument, errors.New("name is required"))
+	if detail, err := connect.NewErrorDetail(&errdetails.BadRequest{}); err == nil {
+		want.AddDetail(detail)
+	}
+	handler := RequestIDInterceptor()(func(context.Context, connect.AnyRequest) (connect.AnyResponse, error) {
+		return nil, want
+	})
+
+	_, err := handler(ctx, connect.NewRequest[any](nil))
+	var ce *connect.Error
+	if !errors.As(err, &ce) {
+		t.Fatalf("expected a connect error, got %v", err)
+	}
+	if ce.Code() != connect.CodeInvalidArgument || ce.Message() != "name is required (request id req-1)" {
+		t.Errorf("unexpected error: %v", ce)
+	}
+	if len(ce.Details()) != 1 || ce.Meta().Get(RequestIDHeader) != "req-1" {
+		t.Errorf("expected details and request ID metadata to be preserved, got %v %v", ce.Details(), ce.Meta())
+	}
+
+	if _, err := RequestIDInterceptor()(func(context.Context, connect.AnyRequest) (connect.AnyResponse, error) {
+		return nil, want
+	})(context.Background(), connect.NewRequest[any](nil)); err != want {
+		t.Errorf("expected error without a request ID to pass through, got %v", err)
+	}
+}
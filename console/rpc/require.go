@@ -0,0 +1,47 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+)
+
+// errAuthenticationRequired is returned for protected procedures called
+// without authenticated claims.
+var errAuthenticationRequired = errors.New("authentication required")
+
+// RequireClaimsInterceptor classifies every procedure as public or protected
+// and rejects protected calls that reach it without claims on the context.
+// publicServices lists the fully-qualified names of the services callable
+// anonymously, e.g. consolev1connect.VersionServiceName; every other service
+// is protected. Install it after the auth interceptor on every handler so
+// protected handlers may rely on MustClaims, even when a service is mounted
+// with the wrong interceptor chain or auth is not configured.
+func RequireClaimsInterceptor(publicServices ...string) connect.UnaryInterceptorFunc {
+	public := make(map[string]bool, len(publicServices))
+	for _, service := range publicServices {
+		public[service] = true
+	}
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			service, _ := splitProcedure(req.Spec().Procedure)
+			if ClaimsFromContext(ctx) == nil && !public[service] {
+				return nil, connect.NewError(connect.CodeUnauthenticated, errAuthenticationRequired)
+			}
+			return next(ctx, req)
+		}
+	}
+}
+
+// MustClaims returns the claims of an authenticated request. Handlers of
+// protected services call it instead of checking for missing claims:
+// RequireClaimsInterceptor has already rejected unauthenticated calls, so a
+// nil result is a wiring bug and MustClaims panics.
+func MustClaims(ctx context.Context) *Claims {
+	claims := ClaimsFromContext(ctx)
+	if claims == nil {
+		panic("rpc.MustClaims: no claims on the context; mount the handler behind RequireClaimsInterceptor")
+	}
+	return claims
+}
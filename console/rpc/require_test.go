@@ -0,0 +1,55 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+)
+
+func TestRequireClaimsInterceptor(t *testing.T) {
+	interceptor := RequireClaimsInterceptor("holos.console.v1.VersionService")
+	tests := []struct {
+		name      string
+		procedure string
+		claims    *Claims
+		want      connect.Code
+	}{
+		{name: "public without claims", procedure: "/holos.console.v1.VersionService/GetVersion"},
+		{name: "protected with claims", procedure: "/holos.console.v1.SecretsService/ListSecrets", claims: &Claims{Sub: "alice"}},
+		{name: "protected without claims", procedure: "/holos.console.v1.SecretsService/ListSecrets", want: connect.CodeUnauthenticated},
+		{name: "unknown service is protected", procedure: "/holos.console.v1.NewService/Get", want: connect.CodeUnauthenticated},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			called := false
+			handler := interceptor(func(ctx context.Context, _ connect.AnyRequest) (connect.AnyResponse, error) {
+				called = true
+				if tt.claims != nil {
+					MustClaims(ctx)
+				}
+				return nil, nil
+			})
+			ctx := context.Background()
+			if tt.claims != nil {
+				ctx = ContextWithClaims(ctx, tt.claims)
+			}
+			_, err := handler(ctx, procedureRequest{Request: connect.NewRequest[any](nil), procedure: tt.procedure})
+			if got := connect.CodeOf(err); err != nil && got != tt.want || err == nil && tt.want != 0 {
+				t.Fatalf("code = %v, want %v (err %v)", got, tt.want, err)
+			}
+			if called != (tt.want == 0) {
+				t.Errorf("handler called = %t, want %t", called, tt.want == 0)
+			}
+		})
+	}
+}
+
+func TestMustClaims_PanicsWithoutClaims(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("expected MustClaims to panic without claims")
+		}
+	}()
+	MustClaims(context.Background())
+}
@@ -0,0 +1,25 @@
+// Package rpctesting holds helpers for handler tests that call Connect
+// handlers directly, without the interceptors the server installs in front
+// of them.
+package rpctesting
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+
+	"github.com/holos-run/holos-console/console/rpc"
+)
+
+// Validated applies the request validation rpc.ValidationInterceptor runs
+// in front of every handler, then calls the handler.
+func Validated[Req, Res any](
+	ctx context.Context,
+	req *connect.Request[Req],
+	call func(context.Context, *connect.Request[Req]) (*connect.Response[Res], error),
+) (*connect.Response[Res], error) {
+	if err := rpc.ValidateRequest(req.Msg); err != nil {
+		return nil, err
+	}
+	return call(ctx, req)
+}
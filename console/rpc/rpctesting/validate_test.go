@@ -0,0 +1,26 @@
+package rpctesting
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+)
+
+func TestValidated(t *testing.T) {
+	calls := 0
+	get := func(context.Context, *connect.Request[consolev1.GetProjectRequest]) (*connect.Response[consolev1.GetProjectResponse], error) {
+		calls++
+		return connect.NewResponse(&consolev1.GetProjectResponse{}), nil
+	}
+
+	_, err := Validated(context.Background(), connect.NewRequest(&consolev1.GetProjectRequest{}), get)
+	if connect.CodeOf(err) != connect.CodeInvalidArgument || calls != 0 {
+		t.Fatalf("expected InvalidArgument without calling the handler, got %v after %d calls", err, calls)
+	}
+	if _, err := Validated(context.Background(), connect.NewRequest(&consolev1.GetProjectRequest{Name: "web"}), get); err != nil || calls != 1 {
+		t.Fatalf("expected the handler to be called, got %v after %d calls", err, calls)
+	}
+}
@@ -0,0 +1,90 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	authnv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// Principal types recorded on Claims.PrincipalType.
+const (
+	// PrincipalTypeUser is a human user authenticated by an OIDC ID token.
+	PrincipalTypeUser = "user"
+	// PrincipalTypeServiceAccount is a Kubernetes ServiceAccount
+	// authenticated by a TokenReview of its bearer token.
+	PrincipalTypeServiceAccount = "serviceaccount"
+)
+
+// serviceAccountUserPrefix is the username prefix the API server assigns to
+// ServiceAccount principals.
+const serviceAccountUserPrefix = "system:serviceaccount:"
+
+// TokenReviewer authenticates bearer tokens that are not OIDC ID tokens.
+type TokenReviewer interface {
+	// ReviewToken returns the claims for token, or an error when the token
+	// is not valid.
+	ReviewToken(ctx context.Context, token string) (*Claims, error)
+}
+
+// ServiceAccountTokenReviewer authenticates Kubernetes ServiceAccount tokens
+// with the TokenReview API so machine clients such as CI pipelines can call
+// the console without an interactive OIDC login. Only ServiceAccount
+// principals are accepted; other identities the API server recognizes are
+// rejected so user access keeps flowing through OIDC.
+type ServiceAccountTokenReviewer struct {
+	client    kubernetes.Interface
+	audiences []string
+}
+
+// NewServiceAccountTokenReviewer returns a reviewer that submits TokenReviews
+// with client. When audiences is empty the API server's default audiences
+// apply.
+func NewServiceAccountTokenReviewer(client kubernetes.Interface, audiences []string) *ServiceAccountTokenReviewer {
+	return &ServiceAccountTokenReviewer{client: client, audiences: audiences}
+}
+
+// ReviewToken implements TokenReviewer. The returned claims carry the full
+// ServiceAccount username (system:serviceaccount:<namespace>:<name>) in Sub
+// and the API server's groups in Roles.
+func (r *ServiceAccountTokenReviewer) ReviewToken(ctx context.Context, token string) (*Claims, error) {
+	review, err := r.client.AuthenticationV1().TokenReviews().Create(ctx, &authnv1.TokenReview{
+		Spec: authnv1.TokenReviewSpec{
+			Token:     token,
+			Audiences: r.audiences,
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("token review: %w", err)
+	}
+	if !review.Status.Authenticated {
+		if review.Status.Error != "" {
+			return nil, fmt.Errorf("token review: %s", review.Status.Error)
+		}
+		return nil, errors.New("token review: not authenticated")
+	}
+	user := review.Status.User
+	if !strings.HasPrefix(user.Username, serviceAccountUserPrefix) {
+		return nil, fmt.Errorf("token review: %q is not a service account", user.Username)
+	}
+	return &Claims{
+		Iss:           "kubernetes",
+		Sub:           user.Username,
+		Name:          strings.TrimPrefix(user.Username, serviceAccountUserPrefix),
+		Roles:         user.Groups,
+		PrincipalType: PrincipalTypeServiceAccount,
+	}, nil
+}
+
+// WithTokenReviewer enables a fallback authenticator for bearer tokens that
+// fail OIDC verification, typically a ServiceAccountTokenReviewer. Reviewers
+// added by repeated options are tried in order until one accepts the token.
+func WithTokenReviewer(reviewer TokenReviewer) AuthInterceptorOption {
+	return func(cfg *authInterceptorConfig) {
+		cfg.tokenReviewers = append(cfg.tokenReviewers, reviewer)
+	}
+}
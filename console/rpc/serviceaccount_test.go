@@ -0,0 +1,215 @@
+package rpc
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"connectrpc.com/connect"
+	authnv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+const testSAToken = "sa-token"
+
+// tokenReviewClient returns a fake clientset whose TokenReview API
+// authenticates testSAToken as username and rejects everything else.
+func tokenReviewClient(username string, groups []string) *k8sfake.Clientset {
+	client := k8sfake.NewClientset()
+	client.PrependReactor("create", "tokenreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		review := action.(k8stesting.CreateAction).GetObject().(*authnv1.TokenReview)
+		if review.Spec.Token == testSAToken {
+			review.Status = authnv1.TokenReviewStatus{
+				Authenticated: true,
+				User:          authnv1.UserInfo{Username: username, Groups: groups},
+			}
+		} else {
+			review.Status = authnv1.TokenReviewStatus{Error: "invalid bearer token"}
+		}
+		return true, review, nil
+	})
+	return client
+}
+
+func TestServiceAccountTokenReviewer(t *testing.T) {
+	cases := []struct {
+		name     string
+		username string
+		token    string
+		wantErr  bool
+	}{
+		{name: "service account", username: "system:serviceaccount:ci:deployer", token: testSAToken},
+		{name: "invalid token", username: "system:serviceaccount:ci:deployer", token: "bogus", wantErr: true},
+		{name: "non service account user rejected", username: "kubernetes-admin", token: testSAToken, wantErr: true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			groups := []string{"system:serviceaccounts", "system:serviceaccounts:ci"}
+			reviewer := NewServiceAccountTokenReviewer(tokenReviewClient(tc.username, groups), nil)
+			claims, err := reviewer.ReviewToken(context.Background(), tc.token)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got claims %+v", claims)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ReviewToken: %v", err)
+			}
+			if !claims.IsServiceAccount() {
+				t.Fatalf("PrincipalType = %q, want %q", claims.PrincipalType, PrincipalTypeServiceAccount)
+			}
+			if claims.Sub != tc.username {
+				t.Fatalf("Sub = %q, want %q", claims.Sub, tc.username)
+			}
+			if claims.Name != "ci:deployer" {
+				t.Fatalf("Name = %q, want ci:deployer", claims.Name)
+			}
+			if !reflect.DeepEqual(claims.Roles, groups) {
+				t.Fatalf("Roles = %v, want %v", claims.Roles, groups)
+			}
+		})
+	}
+}
+
+func TestLazyAuthInterceptor_ServiceAccountFallback(t *testing.T) {
+	fake := newFakeOIDCServer(t)
+	defer fake.Server.Close()
+
+	clientID := "test-client"
+	reviewer := NewServiceAccountTokenReviewer(tokenReviewClient("system:serviceaccount:ci:deployer", nil), nil)
+	interceptor := LazyAuthInterceptor(fake.Server.URL, clientID, "groups", fake.Server.Client(), WithTokenReviewer(reviewer))
+
+	var got *Claims
+	handler := interceptor(func(ctx context.Context, _ connect.AnyRequest) (connect.AnyResponse, error) {
+		got = ClaimsFromContext(ctx)
+		return nil, nil
+	})
+
+	// OIDC tokens keep working and are marked as users.
+	if _, err := handler(context.Background(), newTestRequest(fake.signToken(t, "user-1", clientID))); err != nil {
+		t.Fatalf("OIDC token rejected: %v", err)
+	}
+	if got == nil || got.PrincipalType != PrincipalTypeUser {
+		t.Fatalf("expected user principal, got %+v", got)
+	}
+
+	// ServiceAccount tokens fall back to TokenReview.
+	if _, err := handler(context.Background(), newTestRequest(testSAToken)); err != nil {
+		t.Fatalf("service account token rejected: %v", err)
+	}
+	if !got.IsServiceAccount() || got.Sub != "system:serviceaccount:ci:deployer" {
+		t.Fatalf("expected service account principal, got %+v", got)
+	}
+
+	// ServiceAccount tokens still work while OIDC discovery is failing.
+	fake.ShouldFail.Store(true)
+	freshInterceptor := LazyAuthInterceptor(fake.Server.URL, clientID, "groups", fake.Server.Client(), WithTokenReviewer(reviewer))
+	if _, err := freshInterceptor(noopHandler)(context.Background(), newTestRequest(testSAToken)); err != nil {
+		t.Fatalf("service account token rejected during discovery failure: %v", err)
+	}
+	_, err := freshInterceptor(noopHandler)(context.Background(), newTestRequest("bogus"))
+	if connect.CodeOf(err) != connect.CodeUnavailable {
+		t.Fatalf("expected CodeUnavailable for unknown token during discovery failure, got %v", err)
+	}
+	fake.ShouldFail.Store(false)
+
+	// Unknown tokens are rejected.
+	_, err = handler(context.Background(), newTestRequest("bogus"))
+	if connect.CodeOf(err) != connect.CodeUnauthenticated {
+		t.Fatalf("expected CodeUnauthenticated, got %v", err)
+	}
+}
+
+func TestNewImpersonatedClientsServiceAccount(t *testing.T) {
+	headers := make(chan http.Header, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		headers <- r.Header.Clone()
+		writeNamespaceList(t, w)
+	}))
+	defer server.Close()
+
+	clients, err := NewImpersonatedClients(&Claims{
+		Sub:           "system:serviceaccount:ci:deployer",
+		Roles:         []string{"system:serviceaccounts", "system:serviceaccounts:ci"},
+		PrincipalType: PrincipalTypeServiceAccount,
+	}, testRESTConfig(server.URL), nil)
+	if err != nil {
+		t.Fatalf("NewImpersonatedClients: %v", err)
+	}
+	if _, err := clients.Clientset.CoreV1().Namespaces().List(context.Background(), metav1.ListOptions{}); err != nil {
+		t.Fatalf("list namespaces: %v", err)
+	}
+
+	got := <-headers
+	if got.Get("Impersonate-User") != "system:serviceaccount:ci:deployer" {
+		t.Fatalf("Impersonate-User = %q, want system:serviceaccount:ci:deployer", got.Get("Impersonate-User"))
+	}
+	wantGroups := []string{"system:serviceaccounts", "system:serviceaccounts:ci"}
+	if !reflect.DeepEqual(got.Values("Impersonate-Group"), wantGroups) {
+		t.Fatalf("Impersonate-Group = %#v, want %#v", got.Values("Impersonate-Group"), wantGroups)
+	}
+}
+
+// staticReviewer accepts a single token as sub.
+type staticReviewer struct{ token, sub string }
+
+func (r staticReviewer) ReviewToken(_ context.Context, token string) (*Claims, error) {
+	if token != r.token {
+		return nil, errAuthenticationRequired
+	}
+	return &Claims{Sub: r.sub, PrincipalType: PrincipalTypeUser}, nil
+}
+
+func TestLazyAuthInterceptor_ChainsTokenReviewers(t *testing.T) {
+	fake := newFakeOIDCServer(t)
+	defer fake.Server.Close()
+
+	interceptor := LazyAuthInterceptor(fake.Server.URL, "test-client", "groups", fake.Server.Client(),
+		WithTokenReviewer(staticReviewer{token: "first-token", sub: "first"}),
+		WithTokenReviewer(staticReviewer{token: "second-token", sub: "second"}),
+	)
+	var got *Claims
+	handler := interceptor(func(ctx context.Context, _ connect.AnyRequest) (connect.AnyResponse, error) {
+		got = ClaimsFromContext(ctx)
+		return nil, nil
+	})
+
+	for _, want := range []string{"first", "second"} {
+		if _, err := handler(context.Background(), newTestRequest(want+"-token")); err != nil {
+			t.Fatalf("%s token rejected: %v", want, err)
+		}
+		if got.Sub != want {
+			t.Fatalf("expected sub %q, got %+v", want, got)
+		}
+	}
+	if _, err := handler(context.Background(), newTestRequest("bogus")); connect.CodeOf(err) != connect.CodeUnauthenticated {
+		t.Fatalf("expected CodeUnauthenticated, got %v", err)
+	}
+}
+
+// staticGroups resolves every email to the same groups.
+type staticGroups []string
+
+func (g staticGroups) GroupsFor(context.Context, string) ([]string, error) { return g, nil }
+
+func TestMergeGroups(t *testing.T) {
+	user := &Claims{Sub: "alice", Email: "alice@example.com", Roles: []string{"devs"}, PrincipalType: PrincipalTypeUser}
+	merged := mergeGroups(context.Background(), staticGroups{"devs", "platform"}, user)
+	if !reflect.DeepEqual(merged.Roles, []string{"devs", "platform"}) {
+		t.Fatalf("Roles = %v", merged.Roles)
+	}
+	if !reflect.DeepEqual(user.Roles, []string{"devs"}) {
+		t.Fatalf("mergeGroups modified the original claims: %v", user.Roles)
+	}
+
+	sa := &Claims{Sub: "system:serviceaccount:ci:deployer", PrincipalType: PrincipalTypeServiceAccount}
+	if got := mergeGroups(context.Background(), staticGroups{"platform"}, sa); got != sa {
+		t.Fatalf("expected service account claims unchanged, got %+v", got)
+	}
+}
@@ -0,0 +1,115 @@
+package rpc
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+)
+
+// StepUpHeader is set on the error returned when a procedure requires a more
+// recent sign-in. Its value is the maximum authentication age in seconds,
+// suitable for the OIDC max_age parameter of an interactive sign-in.
+const StepUpHeader = "Holos-Step-Up-Max-Age"
+
+// SessionPolicy limits how long a user's sign-in is accepted, independent
+// of the token lifetimes the identity provider issues. It applies only to
+// OIDC tokens carrying an iat claim; ServiceAccount tokens, API tokens,
+// and proxy or certificate identities are exempt.
+type SessionPolicy struct {
+	// MaxTokenAge rejects tokens whose sign-in happened longer ago than
+	// this, so refreshing a session does not extend it. Sign-in time is
+	// the auth_time claim when present, otherwise iat. Zero places no
+	// limit beyond the token's own expiry.
+	MaxTokenAge time.Duration
+	// StepUp maps a procedure, e.g.
+	// "/holos.console.v1.SecretsService/GetSecret", to how recently the
+	// caller must have signed in to call it. Sign-in time is the auth_time
+	// claim when present, otherwise iat.
+	StepUp map[string]time.Duration
+}
+
+// ParseStepUp parses a comma-separated list of Service/Method=duration
+// entries, e.g. "holos.console.v1.SecretsService/GetSecret=15m", into a
+// SessionPolicy.StepUp map keyed by procedure.
+func ParseStepUp(s string) (map[string]time.Duration, error) {
+	stepUp := map[string]time.Duration{}
+	for _, entry := range strings.Split(s, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		procedure, age, ok := strings.Cut(entry, "=")
+		procedure = "/" + strings.TrimPrefix(strings.TrimSpace(procedure), "/")
+		if !ok || strings.Count(procedure, "/") != 2 || strings.HasSuffix(procedure, "/") {
+			return nil, fmt.Errorf("step-up entry %q must have the form Service/Method=duration", entry)
+		}
+		d, err := time.ParseDuration(strings.TrimSpace(age))
+		if err != nil || d <= 0 {
+			return nil, fmt.Errorf("step-up entry %q must have a positive duration", entry)
+		}
+		stepUp[procedure] = d
+	}
+	return stepUp, nil
+}
+
+// Deadline returns when claims stop being accepted: the earlier of the token
+// expiry and MaxTokenAge after sign-in. It is zero when neither applies.
+func (p SessionPolicy) Deadline(claims *Claims) time.Time {
+	var deadline time.Time
+	if claims.Exp != 0 {
+		deadline = time.Unix(claims.Exp, 0)
+	}
+	if p.MaxTokenAge > 0 && p.applies(claims) {
+		if limit := AuthenticatedAt(claims).Add(p.MaxTokenAge); deadline.IsZero() || limit.Before(deadline) {
+			deadline = limit
+		}
+	}
+	return deadline
+}
+
+// AuthenticatedAt returns when the caller signed in: the auth_time claim
+// when present, otherwise iat. It is zero when the token has neither.
+func AuthenticatedAt(claims *Claims) time.Time {
+	switch {
+	case claims.AuthTime != 0:
+		return time.Unix(claims.AuthTime, 0)
+	case claims.Iat != 0:
+		return time.Unix(claims.Iat, 0)
+	}
+	return time.Time{}
+}
+
+// applies reports whether the policy limits claims. API tokens carry the
+// creation time of the token as iat, so they are recognized by TokenID.
+func (p SessionPolicy) applies(claims *Claims) bool {
+	return claims.Iat != 0 && claims.TokenID == ""
+}
+
+// check returns a CodeUnauthenticated error when claims are too old for
+// procedure at now.
+func (p SessionPolicy) check(claims *Claims, procedure string, now time.Time) error {
+	if !p.applies(claims) {
+		return nil
+	}
+	if p.MaxTokenAge > 0 && now.Sub(AuthenticatedAt(claims)) > p.MaxTokenAge {
+		return connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("token is older than the maximum age of %s; sign in again", p.MaxTokenAge))
+	}
+	if maxAge, ok := p.StepUp[procedure]; ok && now.Sub(AuthenticatedAt(claims)) > maxAge {
+		err := connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("%s requires signing in within the last %s", procedure, maxAge))
+		err.Meta().Set(StepUpHeader, strconv.FormatInt(int64(maxAge/time.Second), 10))
+		return err
+	}
+	return nil
+}
+
+// WithSessionPolicy makes LazyAuthInterceptor enforce policy on every
+// authenticated request. When an administrator acts as another user the
+// policy applies to the administrator's own token.
+func WithSessionPolicy(policy SessionPolicy) AuthInterceptorOption {
+	return func(cfg *authInterceptorConfig) {
+		cfg.sessionPolicy = policy
+	}
+}
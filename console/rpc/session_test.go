@@ -0,0 +1,156 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+)
+
+func TestParseStepUp(t *testing.T) {
+	got, err := ParseStepUp(" holos.console.v1.SecretsService/GetSecret=15m, /holos.console.v1.SecretsService/DeleteSecret=1h ,")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := map[string]time.Duration{
+		"/holos.console.v1.SecretsService/GetSecret":    15 * time.Minute,
+		"/holos.console.v1.SecretsService/DeleteSecret": time.Hour,
+	}
+	if len(got) != len(want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+	for procedure, d := range want {
+		if got[procedure] != d {
+			t.Errorf("%s: expected %s, got %s", procedure, d, got[procedure])
+		}
+	}
+	for _, bad := range []string{"GetSecret=15m", "holos.console.v1.SecretsService/GetSecret", "holos.console.v1.SecretsService/=15m", "holos.console.v1.SecretsService/GetSecret=soon", "holos.console.v1.SecretsService/GetSecret=-1m"} {
+		if _, err := ParseStepUp(bad); err == nil {
+			t.Errorf("expected error for %q", bad)
+		}
+	}
+}
+
+func TestSessionPolicy(t *testing.T) {
+	const getSecret = "/holos.console.v1.SecretsService/GetSecret"
+	now := time.Now()
+	policy := SessionPolicy{
+		MaxTokenAge: 8 * time.Hour,
+		StepUp:      map[string]time.Duration{getSecret: 15 * time.Minute},
+	}
+	claims := func(issued, authenticated time.Duration) *Claims {
+		c := &Claims{Iat: now.Add(-issued).Unix(), Exp: now.Add(time.Hour).Unix()}
+		if authenticated != 0 {
+			c.AuthTime = now.Add(-authenticated).Unix()
+		}
+		return c
+	}
+
+	tests := []struct {
+		name      string
+		claims    *Claims
+		procedure string
+		wantErr   bool
+		wantMeta  string
+	}{
+		{name: "fresh token", claims: claims(time.Minute, 0), procedure: getSecret},
+		{name: "token past max age", claims: claims(9*time.Hour, 0), procedure: "/holos.console.v1.ProjectService/ListProjects", wantErr: true},
+		{name: "old token on unrestricted rpc", claims: claims(time.Hour, 0), procedure: "/holos.console.v1.ProjectService/ListProjects"},
+		{name: "old token on step-up rpc", claims: claims(time.Hour, 0), procedure: getSecret, wantErr: true, wantMeta: "900"},
+		// A refreshed token is newly issued, but auth_time records the
+		// original sign-in, so it does not satisfy step-up.
+		{name: "refreshed token with old auth_time", claims: claims(time.Minute, 2*time.Hour), procedure: getSecret, wantErr: true, wantMeta: "900"},
+		// A refreshed session keeps the original sign-in as auth_time, so
+		// refreshing does not extend it past the max age.
+		{name: "refreshed token past max age", claims: claims(time.Minute, 9*time.Hour), procedure: "/holos.console.v1.ProjectService/ListProjects", wantErr: true},
+		{name: "token without iat", claims: &Claims{Sub: "system:serviceaccount:ci:deployer"}, procedure: getSecret},
+		{name: "api token", claims: &Claims{Sub: "user-1", TokenID: "tok-1", Iat: now.Add(-30 * 24 * time.Hour).Unix()}, procedure: getSecret},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := policy.check(tt.claims, tt.procedure, now)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+			if err == nil {
+				return
+			}
+			if connect.CodeOf(err) != connect.CodeUnauthenticated {
+				t.Errorf("expected CodeUnauthenticated, got %v", err)
+			}
+			var connectErr *connect.Error
+			if ce, ok := err.(*connect.Error); ok {
+				connectErr = ce
+			}
+			if got := connectErr.Meta().Get(StepUpHeader); got != tt.wantMeta {
+				t.Errorf("expected %s %q, got %q", StepUpHeader, tt.wantMeta, got)
+			}
+		})
+	}
+
+	t.Run("deadline is the earlier of expiry and max age", func(t *testing.T) {
+		c := &Claims{Iat: now.Add(-7*time.Hour - 30*time.Minute).Unix(), Exp: now.Add(time.Hour).Unix()}
+		if got, want := policy.Deadline(c), time.Unix(c.Iat, 0).Add(8*time.Hour); !got.Equal(want) {
+			t.Errorf("expected %v, got %v", want, got)
+		}
+		if got := (SessionPolicy{}).Deadline(c); !got.Equal(time.Unix(c.Exp, 0)) {
+			t.Errorf("expected the token expiry without a max age, got %v", got)
+		}
+		refreshed := &Claims{Iat: now.Unix(), AuthTime: now.Add(-7 * time.Hour).Unix(), Exp: now.Add(time.Hour).Unix()}
+		if got, want := policy.Deadline(refreshed), time.Unix(refreshed.AuthTime, 0).Add(8*time.Hour); !got.Equal(want) {
+			t.Errorf("expected the deadline to count from auth_time, %v, got %v", want, got)
+		}
+		if got := policy.Deadline(&Claims{}); !got.IsZero() {
+			t.Errorf("expected no deadline without iat or exp, got %v", got)
+		}
+	})
+}
+
+func TestLazyAuthInterceptor_MaxTokenAge(t *testing.T) {
+	fake := newFakeOIDCServer(t)
+	defer fake.Server.Close()
+	interceptor := LazyAuthInterceptor(fake.Server.URL, "test-client", "groups", fake.Server.Client(),
+		WithSessionPolicy(SessionPolicy{MaxTokenAge: time.Hour}))
+
+	fresh := fake.signToken(t, "user-1", "test-client")
+	if _, err := interceptor(noopHandler)(t.Context(), newTestRequest(fresh)); err != nil {
+		t.Fatalf("expected a fresh token to be accepted, got %v", err)
+	}
+
+	stale := fake.signTokenWithClaims(t, "user-1", "test-client", map[string]interface{}{
+		"iat": time.Now().Add(-2 * time.Hour).Unix(),
+	})
+	if _, err := interceptor(noopHandler)(t.Context(), newTestRequest(stale)); connect.CodeOf(err) != connect.CodeUnauthenticated {
+		t.Fatalf("expected CodeUnauthenticated for a token past the max age, got %v", err)
+	}
+
+	// A session refresh issues a new token but keeps the original auth_time.
+	refreshed := fake.signTokenWithClaims(t, "user-1", "test-client", map[string]interface{}{
+		"auth_time": time.Now().Add(-2 * time.Hour).Unix(),
+	})
+	if _, err := interceptor(noopHandler)(t.Context(), newTestRequest(refreshed)); connect.CodeOf(err) != connect.CodeUnauthenticated {
+		t.Fatalf("expected CodeUnauthenticated for a refreshed session past the max age, got %v", err)
+	}
+}
+
+func TestLazyAuthInterceptor_MaxTokenAgeExemptsAPITokens(t *testing.T) {
+	fake := newFakeOIDCServer(t)
+	defer fake.Server.Close()
+	created := time.Now().Add(-30 * 24 * time.Hour)
+	interceptor := LazyAuthInterceptor(fake.Server.URL, "test-client", "groups", fake.Server.Client(),
+		WithTokenReviewer(apiTokenReviewer{created: created}),
+		WithSessionPolicy(SessionPolicy{MaxTokenAge: time.Hour}))
+
+	if _, err := interceptor(noopHandler)(t.Context(), newTestRequest("holos_api_token")); err != nil {
+		t.Fatalf("expected an API token older than the max age to be accepted, got %v", err)
+	}
+}
+
+// apiTokenReviewer accepts any token as a console API token created at
+// created.
+type apiTokenReviewer struct{ created time.Time }
+
+func (r apiTokenReviewer) ReviewToken(context.Context, string) (*Claims, error) {
+	return &Claims{Sub: "user-1", PrincipalType: PrincipalTypeUser, TokenID: "tok-1", Iat: r.created.Unix()}, nil
+}
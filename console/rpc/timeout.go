@@ -0,0 +1,116 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+)
+
+// TimeoutConfig configures TimeoutInterceptor. A zero timeout leaves the
+// corresponding methods bounded only by the client's deadline.
+type TimeoutConfig struct {
+	// Read bounds methods that fetch a single resource or answer a check,
+	// e.g. GetSecret and CheckAccess.
+	Read time.Duration
+	// List bounds methods that may return many resources, e.g. ListSecrets,
+	// BatchGetSecrets, and SearchResources.
+	List time.Duration
+	// Write bounds every other method.
+	Write time.Duration
+	// Methods overrides the timeout of individual methods, keyed by method
+	// name (GetSecret) or full procedure
+	// (/holos.console.v1.SecretsService/GetSecret).
+	Methods map[string]time.Duration
+}
+
+// listPrefixes and readPrefixes classify methods by the verb they start with.
+var (
+	listPrefixes = []string{"List", "Batch", "Search", "Export"}
+	readPrefixes = []string{"Get", "Check", "Can", "Who", "Preflight", "Render", "Diff"}
+)
+
+// timeout returns the deadline for procedure.
+func (c TimeoutConfig) timeout(procedure string) time.Duration {
+	_, method := splitProcedure(procedure)
+	if d, ok := c.Methods[procedure]; ok {
+		return d
+	}
+	if d, ok := c.Methods[method]; ok {
+		return d
+	}
+	for _, prefix := range listPrefixes {
+		if strings.HasPrefix(method, prefix) {
+			return c.List
+		}
+	}
+	for _, prefix := range readPrefixes {
+		if strings.HasPrefix(method, prefix) {
+			return c.Read
+		}
+	}
+	return c.Write
+}
+
+// TimeoutInterceptor bounds each unary RPC by the timeout cfg assigns its
+// method. The deadline is carried by the request context into the
+// Kubernetes clients, so a slow API server ends the call instead of hanging
+// it. A call that runs out of time fails with CodeDeadlineExceeded whatever
+// error the handler surfaced. A shorter deadline sent by the client still
+// applies.
+func TimeoutInterceptor(cfg TimeoutConfig) connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			procedure := req.Spec().Procedure
+			timeout := cfg.timeout(procedure)
+			if timeout <= 0 {
+				return next(ctx, req)
+			}
+			callCtx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+			resp, err := next(callCtx, req)
+			if err == nil || !errors.Is(callCtx.Err(), context.DeadlineExceeded) {
+				return resp, err
+			}
+			if ctx.Err() != nil {
+				return nil, connect.NewError(connect.CodeDeadlineExceeded, fmt.Errorf("the client's deadline passed before the request finished"))
+			}
+			rpcTimeoutsTotal.WithLabelValues(procedure).Inc()
+			_, method := splitProcedure(procedure)
+			return nil, connect.NewError(connect.CodeDeadlineExceeded, fmt.Errorf("%s did not finish within %s; the Kubernetes API server may be slow, try again later", method, timeout))
+		}
+	}
+}
+
+// ParseMethodTimeouts parses a comma-separated list of Method=duration or
+// Service/Method=duration entries, e.g. "ListSecrets=2m", into a
+// TimeoutConfig.Methods map. A zero duration removes the method's timeout.
+func ParseMethodTimeouts(s string) (map[string]time.Duration, error) {
+	timeouts := map[string]time.Duration{}
+	for _, entry := range strings.Split(s, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		method, timeout, ok := strings.Cut(entry, "=")
+		method = strings.TrimSpace(method)
+		if strings.Contains(method, "/") {
+			method = "/" + strings.TrimPrefix(method, "/")
+			if strings.Count(method, "/") != 2 || strings.HasSuffix(method, "/") {
+				ok = false
+			}
+		}
+		if !ok || method == "" {
+			return nil, fmt.Errorf("timeout entry %q must have the form Method=duration or Service/Method=duration", entry)
+		}
+		d, err := time.ParseDuration(strings.TrimSpace(timeout))
+		if err != nil || d < 0 {
+			return nil, fmt.Errorf("timeout entry %q must have a non-negative duration", entry)
+		}
+		timeouts[method] = d
+	}
+	return timeouts, nil
+}
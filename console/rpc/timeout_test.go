@@ -0,0 +1,111 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+)
+
+func TestTimeoutConfig(t *testing.T) {
+	cfg := TimeoutConfig{
+		Read:  time.Second,
+		List:  time.Minute,
+		Write: 30 * time.Second,
+		Methods: map[string]time.Duration{
+			"ListAuditEvents": 2 * time.Minute,
+			"/holos.console.v1.SecretsService/GetSecret": 5 * time.Second,
+		},
+	}
+	tests := map[string]time.Duration{
+		"/holos.console.v1.ProjectsService/GetProject":        time.Second,
+		"/holos.console.v1.SecretsService/ListSecrets":        time.Minute,
+		"/holos.console.v1.SecretsService/BatchGetSecrets":    time.Minute,
+		"/holos.console.v1.SecretsService/UpdateSecret":       30 * time.Second,
+		"/holos.console.v1.AuditService/ListAuditEvents":      2 * time.Minute,
+		"/holos.console.v1.SecretsService/GetSecret":          5 * time.Second,
+		"/holos.console.v1.SecretsService/GetSecretKey":       time.Second,
+		"/holos.console.v1.PermissionsService/CheckAccess":    time.Second,
+		"/holos.console.v1.ProjectsService/TransferOwnership": 30 * time.Second,
+	}
+	for procedure, want := range tests {
+		if got := cfg.timeout(procedure); got != want {
+			t.Errorf("timeout(%s) = %s, want %s", procedure, got, want)
+		}
+	}
+}
+
+func TestTimeoutInterceptor(t *testing.T) {
+	interceptor := TimeoutInterceptor(TimeoutConfig{Read: 10 * time.Millisecond, Write: time.Minute})
+	blocking := func(ctx context.Context, _ connect.AnyRequest) (connect.AnyResponse, error) {
+		<-ctx.Done()
+		// Handlers typically surface a Kubernetes client error, not the
+		// context error itself.
+		return nil, connect.NewError(connect.CodeInternal, errors.New("client rate limiter Wait returned an error"))
+	}
+	request := func(method string) connect.AnyRequest {
+		return procedureRequest{Request: connect.NewRequest[any](nil), procedure: "/holos.console.v1.SecretsService/" + method}
+	}
+
+	t.Run("slow call fails with DeadlineExceeded", func(t *testing.T) {
+		_, err := interceptor(blocking)(context.Background(), request("GetSecret"))
+		if connect.CodeOf(err) != connect.CodeDeadlineExceeded {
+			t.Fatalf("expected DeadlineExceeded, got %v", err)
+		}
+		if !strings.Contains(err.Error(), "GetSecret did not finish within 10ms") {
+			t.Errorf("expected a helpful message, got %q", err.Error())
+		}
+	})
+
+	t.Run("handler sees the deadline", func(t *testing.T) {
+		_, err := interceptor(func(ctx context.Context, _ connect.AnyRequest) (connect.AnyResponse, error) {
+			deadline, ok := ctx.Deadline()
+			if !ok || time.Until(deadline) > time.Minute {
+				t.Errorf("expected a deadline within a minute, got %v %v", deadline, ok)
+			}
+			return nil, nil
+		})(context.Background(), request("UpdateSecret"))
+		if err != nil {
+			t.Fatalf("unexpected error %v", err)
+		}
+	})
+
+	t.Run("shorter client deadline wins", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Millisecond)
+		defer cancel()
+		_, err := interceptor(blocking)(ctx, request("UpdateSecret"))
+		if connect.CodeOf(err) != connect.CodeDeadlineExceeded {
+			t.Fatalf("expected DeadlineExceeded, got %v", err)
+		}
+	})
+
+	t.Run("zero timeout passes through", func(t *testing.T) {
+		_, err := TimeoutInterceptor(TimeoutConfig{})(func(ctx context.Context, _ connect.AnyRequest) (connect.AnyResponse, error) {
+			if _, ok := ctx.Deadline(); ok {
+				t.Error("expected no deadline")
+			}
+			return nil, nil
+		})(context.Background(), request("GetSecret"))
+		if err != nil {
+			t.Fatalf("unexpected error %v", err)
+		}
+	})
+}
+
+func TestParseMethodTimeouts(t *testing.T) {
+	got, err := ParseMethodTimeouts(" ListSecrets=2m, holos.console.v1.SecretsService/GetSecret=5s ,")
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if got["ListSecrets"] != 2*time.Minute || got["/holos.console.v1.SecretsService/GetSecret"] != 5*time.Second || len(got) != 2 {
+		t.Errorf("unexpected timeouts %v", got)
+	}
+	for _, bad := range []string{"ListSecrets", "=1s", "ListSecrets=-1s", "ListSecrets=soon", "a/b/c=1s"} {
+		if _, err := ParseMethodTimeouts(bad); err == nil {
+			t.Errorf("expected %q to be rejected", bad)
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package rpc
+
+import (
+	"context"
+	"strings"
+
+	"connectrpc.com/connect"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracerName identifies spans created by TracingInterceptor.
+const tracerName = "github.com/holos-run/holos-console/console/rpc"
+
+// TracingInterceptor returns a connect.UnaryInterceptorFunc that starts a
+// server span for each RPC, continuing any W3C trace context carried in the
+// request headers. Place it first so the span covers authentication and
+// impersonation as well as the handler. The auth interceptor annotates the
+// span with the authenticated principal.
+func TracingInterceptor() connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			procedure := req.Spec().Procedure
+			service, method := splitProcedure(procedure)
+
+			ctx = otel.GetTextMapPropagator().Extract(ctx, propagation.HeaderCarrier(req.Header()))
+			ctx, span := otel.Tracer(tracerName).Start(ctx, strings.TrimPrefix(procedure, "/"),
+				trace.WithSpanKind(trace.SpanKindServer),
+				trace.WithAttributes(
+					attribute.String("rpc.system", "connect_rpc"),
+					attribute.String("rpc.service", service),
+					attribute.String("rpc.method", method),
+				),
+			)
+			defer span.End()
+
+			resp, err := next(ctx, req)
+			if err != nil {
+				code := connect.CodeOf(err)
+				span.SetAttributes(attribute.String("rpc.connect_rpc.error_code", code.String()))
+				span.SetStatus(codes.Error, err.Error())
+			}
+			return resp, err
+		}
+	}
+}
+
+// splitProcedure splits a Connect procedure such as
+// /holos.console.v1.SecretsService/GetSecret into service and method.
+func splitProcedure(procedure string) (service, method string) {
+	service, method, _ = strings.Cut(strings.TrimPrefix(procedure, "/"), "/")
+	return service, method
+}
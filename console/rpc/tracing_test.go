@@ -0,0 +1,85 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"connectrpc.com/connect"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// withSpanRecorder installs a recording tracer provider and W3C propagator for
+// the duration of the test.
+func withSpanRecorder(t *testing.T) *tracetest.SpanRecorder {
+	t.Helper()
+	recorder := tracetest.NewSpanRecorder()
+	oldProvider, oldPropagator := otel.GetTracerProvider(), otel.GetTextMapPropagator()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() {
+		otel.SetTracerProvider(oldProvider)
+		otel.SetTextMapPropagator(oldPropagator)
+	})
+	return recorder
+}
+
+func spanAttr(span sdktrace.ReadOnlySpan, key attribute.Key) string {
+	for _, kv := range span.Attributes() {
+		if kv.Key == key {
+			return kv.Value.Emit()
+		}
+	}
+	return ""
+}
+
+func TestTracingInterceptor(t *testing.T) {
+	recorder := withSpanRecorder(t)
+
+	var handlerSpan trace.SpanContext
+	handler := TracingInterceptor()(func(ctx context.Context, _ connect.AnyRequest) (connect.AnyResponse, error) {
+		handlerSpan = trace.SpanContextFromContext(ctx)
+		return nil, connect.NewError(connect.CodePermissionDenied, errors.New("denied"))
+	})
+
+	req := connect.NewRequest[any](nil)
+	parentTraceID := "4bf92f3577b34da6a3ce929d0e0e4736"
+	req.Header().Set("traceparent", "00-"+parentTraceID+"-00f067aa0ba902b7-01")
+	if _, err := handler(context.Background(), req); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Fatalf("expected handler error to pass through, got %v", err)
+	}
+
+	spans := recorder.Ended()
+	if len(spans) != 1 {
+		t.Fatalf("expected 1 span, got %d", len(spans))
+	}
+	span := spans[0]
+	if span.SpanContext().TraceID().String() != parentTraceID {
+		t.Errorf("expected span to continue trace %s, got %s", parentTraceID, span.SpanContext().TraceID())
+	}
+	if handlerSpan.SpanID() != span.SpanContext().SpanID() {
+		t.Error("expected handler context to carry the RPC span")
+	}
+	if span.SpanKind() != trace.SpanKindServer {
+		t.Errorf("expected server span, got %v", span.SpanKind())
+	}
+	if span.Status().Code != codes.Error {
+		t.Errorf("expected error status, got %v", span.Status())
+	}
+	if got := spanAttr(span, "rpc.connect_rpc.error_code"); got != "permission_denied" {
+		t.Errorf("expected error code permission_denied, got %q", got)
+	}
+}
+
+func TestSplitProcedure(t *testing.T) {
+	service, method := splitProcedure("/holos.console.v1.SecretsService/GetSecret")
+	if service != "holos.console.v1.SecretsService" || method != "GetSecret" {
+		t.Fatalf("splitProcedure = %q, %q", service, method)
+	}
+}
@@ -0,0 +1,214 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/hmac"
+	_ "crypto/sha1" // registers crypto.SHA1 for oauth2-proxy signature keys
+	_ "crypto/sha256"
+	_ "crypto/sha512"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+)
+
+// Headers set by oauth2-proxy on authenticated upstream requests.
+const (
+	headerForwardedUser              = "X-Forwarded-User"
+	headerForwardedEmail             = "X-Forwarded-Email"
+	headerForwardedPreferredUsername = "X-Forwarded-Preferred-Username"
+	headerForwardedGroups            = "X-Forwarded-Groups"
+	headerGAPSignature               = "GAP-Signature"
+)
+
+// proxySignatureHeaders are the headers oauth2-proxy includes, in order, in
+// the GAP-Signature of each upstream request.
+var proxySignatureHeaders = []string{
+	"Content-Length",
+	"Content-Md5",
+	"Content-Type",
+	"Date",
+	"Authorization",
+	"X-Forwarded-User",
+	"X-Forwarded-Email",
+	"X-Forwarded-Preferred-User",
+	"X-Forwarded-Access-Token",
+	"Cookie",
+	"Gap-Auth",
+}
+
+// maxSignedBodyBytes bounds the request body read to verify a signature.
+const maxSignedBodyBytes = 32 << 20
+
+// PrincipalIssuerTrustedProxy is the Claims.Iss recorded for principals
+// authenticated by TrustedProxyMiddleware.
+const PrincipalIssuerTrustedProxy = "oauth2-proxy"
+
+// SignatureKey is an oauth2-proxy --signature-key: the HMAC hash and secret
+// used to sign upstream requests.
+type SignatureKey struct {
+	Hash   crypto.Hash
+	Secret []byte
+}
+
+// ParseSignatureKey parses an oauth2-proxy signature key of the form
+// "<algorithm>:<secret>", where algorithm is sha1, sha256, or sha512.
+func ParseSignatureKey(s string) (SignatureKey, error) {
+	algorithm, secret, ok := strings.Cut(strings.TrimSpace(s), ":")
+	if !ok || secret == "" {
+		return SignatureKey{}, fmt.Errorf("signature key must have the form <algorithm>:<secret>")
+	}
+	hashes := map[string]crypto.Hash{"sha1": crypto.SHA1, "sha256": crypto.SHA256, "sha512": crypto.SHA512}
+	hash, ok := hashes[algorithm]
+	if !ok {
+		return SignatureKey{}, fmt.Errorf("unsupported signature algorithm %q", algorithm)
+	}
+	return SignatureKey{Hash: hash, Secret: []byte(secret)}, nil
+}
+
+// TrustedProxyConfig configures authentication from oauth2-proxy headers.
+type TrustedProxyConfig struct {
+	// CIDRs are the networks the authenticating proxy connects from.
+	// Identity headers from any other peer are ignored.
+	CIDRs []netip.Prefix
+	// Key verifies the GAP-Signature header oauth2-proxy adds when run with
+	// --signature-key.
+	Key SignatureKey
+}
+
+type proxyClaimsKey struct{}
+
+func proxyClaimsFromContext(ctx context.Context) *Claims {
+	claims, _ := ctx.Value(proxyClaimsKey{}).(*Claims)
+	return claims
+}
+
+// TrustedProxyMiddleware authenticates requests forwarded by oauth2-proxy.
+// When a request from an allowed peer carries X-Forwarded-User and a valid
+// GAP-Signature, the identity is stored on the request context for
+// LazyAuthInterceptor configured WithTrustedProxy. Otherwise the identity
+// headers are ignored and the request falls through to bearer token
+// authentication. The console impersonates the identity under the proxy:
+// prefix.
+//
+// oauth2-proxy does not sign X-Forwarded-Groups or
+// X-Forwarded-Preferred-Username. They are trusted only because the peer is
+// in the CIDR allowlist, so the proxy must overwrite them on every request.
+func TrustedProxyMiddleware(cfg TrustedProxyConfig, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user := r.Header.Get(headerForwardedUser)
+		if user == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if !peerAllowed(r.RemoteAddr, cfg.CIDRs) {
+			slog.WarnContext(r.Context(), "ignoring proxy identity headers from untrusted peer",
+				slog.String("peer", r.RemoteAddr),
+			)
+			next.ServeHTTP(w, r)
+			return
+		}
+		body, err := io.ReadAll(io.LimitReader(r.Body, maxSignedBodyBytes+1))
+		_ = r.Body.Close()
+		if err != nil || len(body) > maxSignedBodyBytes {
+			http.Error(w, "request body too large", http.StatusRequestEntityTooLarge)
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+		if !validProxySignature(r, body, cfg.Key) {
+			slog.WarnContext(r.Context(), "ignoring proxy identity headers with invalid signature",
+				slog.String("peer", r.RemoteAddr),
+			)
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		claims := &Claims{
+			Iss:           PrincipalIssuerTrustedProxy,
+			Sub:           user,
+			Email:         r.Header.Get(headerForwardedEmail),
+			Name:          r.Header.Get(headerForwardedPreferredUsername),
+			Roles:         splitHeaderList(r.Header.Get(headerForwardedGroups)),
+			PrincipalType: PrincipalTypeUser,
+		}
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), proxyClaimsKey{}, claims)))
+	})
+}
+
+// WithTrustedProxy makes LazyAuthInterceptor accept identities verified by
+// TrustedProxyMiddleware in place of a bearer token.
+func WithTrustedProxy() AuthInterceptorOption {
+	return func(cfg *authInterceptorConfig) {
+		cfg.trustedProxy = true
+	}
+}
+
+func peerAllowed(remoteAddr string, cidrs []netip.Prefix) bool {
+	host, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		host = remoteAddr
+	}
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+	for _, prefix := range cidrs {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// validProxySignature checks the GAP-Signature header using the same string
+// to sign as oauth2-proxy: the method, each signed header's values, and the
+// request URI, one per line, followed by the body.
+func validProxySignature(r *http.Request, body []byte, key SignatureKey) bool {
+	algorithm, encoded, ok := strings.Cut(r.Header.Get(headerGAPSignature), " ")
+	if !ok || algorithm != strings.ToLower(strings.ReplaceAll(key.Hash.String(), "-", "")) {
+		return false
+	}
+	got, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return false
+	}
+	mac := hmac.New(key.Hash.New, key.Secret)
+	_, _ = io.WriteString(mac, proxyStringToSign(r))
+	_, _ = mac.Write(body)
+	return hmac.Equal(got, mac.Sum(nil))
+}
+
+func proxyStringToSign(r *http.Request) string {
+	var b strings.Builder
+	b.WriteString(r.Method)
+	b.WriteString("\n")
+	for _, header := range proxySignatureHeaders {
+		b.WriteString(strings.Join(r.Header.Values(header), ","))
+		b.WriteString("\n")
+	}
+	b.WriteString(r.URL.Path)
+	if r.URL.RawQuery != "" {
+		b.WriteString("?" + r.URL.RawQuery)
+	}
+	if r.URL.Fragment != "" {
+		b.WriteString("#" + r.URL.Fragment)
+	}
+	return b.String()
+}
+
+func splitHeaderList(value string) []string {
+	var out []string
+	for _, part := range strings.Split(value, ",") {
+		if part = strings.TrimSpace(part); part != "" {
+			out = append(out, part)
+		}
+	}
+	return out
+}
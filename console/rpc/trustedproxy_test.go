@@ -0,0 +1,162 @@
+package rpc
+
+import (
+	"context"
+	"crypto"
+	"crypto/hmac"
+	"encoding/base64"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strings"
+	"testing"
+
+	"connectrpc.com/connect"
+)
+
+func signProxyRequest(t *testing.T, r *http.Request, body string, key SignatureKey) {
+	t.Helper()
+	mac := hmac.New(key.Hash.New, key.Secret)
+	_, _ = io.WriteString(mac, proxyStringToSign(r))
+	_, _ = io.WriteString(mac, body)
+	r.Header.Set(headerGAPSignature, "sha256 "+base64.StdEncoding.EncodeToString(mac.Sum(nil)))
+}
+
+func TestParseSignatureKey(t *testing.T) {
+	key, err := ParseSignatureKey("sha256:s3cret\n")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if key.Hash != crypto.SHA256 || string(key.Secret) != "s3cret" {
+		t.Errorf("unexpected key %+v", key)
+	}
+	for _, bad := range []string{"s3cret", "sha256:", "md5:s3cret"} {
+		if _, err := ParseSignatureKey(bad); err == nil {
+			t.Errorf("expected error for %q", bad)
+		}
+	}
+}
+
+func TestTrustedProxyMiddleware(t *testing.T) {
+	key := SignatureKey{Hash: crypto.SHA256, Secret: []byte("s3cret")}
+	cfg := TrustedProxyConfig{CIDRs: []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8")}, Key: key}
+	const body = `{"project":"frontend"}`
+
+	var (
+		got     *Claims
+		gotBody string
+	)
+	handler := TrustedProxyMiddleware(cfg, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = proxyClaimsFromContext(r.Context())
+		b, _ := io.ReadAll(r.Body)
+		gotBody = string(b)
+	}))
+
+	newRequest := func(remoteAddr string) *http.Request {
+		r := httptest.NewRequest(http.MethodPost, "/holos.console.v1.SecretsService/ListSecrets", strings.NewReader(body))
+		r.RemoteAddr = remoteAddr
+		r.Header.Set("Content-Type", "application/json")
+		r.Header.Set(headerForwardedUser, "jane")
+		r.Header.Set(headerForwardedEmail, "jane@example.com")
+		r.Header.Set(headerForwardedPreferredUsername, "Jane")
+		r.Header.Set(headerForwardedGroups, "owner, dev")
+		return r
+	}
+
+	tests := []struct {
+		name       string
+		remoteAddr string
+		sign       func(*http.Request)
+		wantClaims bool
+	}{
+		{
+			name:       "signed request from trusted proxy",
+			remoteAddr: "10.1.2.3:41000",
+			sign:       func(r *http.Request) { signProxyRequest(t, r, body, key) },
+			wantClaims: true,
+		},
+		{
+			name:       "signed request from untrusted peer",
+			remoteAddr: "192.168.1.5:41000",
+			sign:       func(r *http.Request) { signProxyRequest(t, r, body, key) },
+		},
+		{
+			name:       "unsigned request from trusted proxy",
+			remoteAddr: "10.1.2.3:41000",
+			sign:       func(*http.Request) {},
+		},
+		{
+			name:       "signature with wrong key",
+			remoteAddr: "10.1.2.3:41000",
+			sign: func(r *http.Request) {
+				signProxyRequest(t, r, body, SignatureKey{Hash: crypto.SHA256, Secret: []byte("other")})
+			},
+		},
+		{
+			name:       "identity header changed after signing",
+			remoteAddr: "10.1.2.3:41000",
+			sign: func(r *http.Request) {
+				signProxyRequest(t, r, body, key)
+				r.Header.Set(headerForwardedUser, "mallory")
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotBody = nil, ""
+			r := newRequest(tt.remoteAddr)
+			tt.sign(r)
+			handler.ServeHTTP(httptest.NewRecorder(), r)
+
+			if gotBody != body {
+				t.Errorf("expected body to reach handler, got %q", gotBody)
+			}
+			if !tt.wantClaims {
+				if got != nil {
+					t.Errorf("expected no proxy claims, got %+v", got)
+				}
+				return
+			}
+			if got == nil {
+				t.Fatal("expected proxy claims")
+			}
+			if got.Sub != "jane" || got.Email != "jane@example.com" || got.Name != "Jane" || got.Iss != PrincipalIssuerTrustedProxy {
+				t.Errorf("unexpected claims %+v", got)
+			}
+			if len(got.Roles) != 2 || got.Roles[0] != "owner" || got.Roles[1] != "dev" {
+				t.Errorf("expected roles [owner dev], got %v", got.Roles)
+			}
+		})
+	}
+}
+
+func TestLazyAuthInterceptor_TrustedProxy(t *testing.T) {
+	fake := newFakeOIDCServer(t)
+	defer fake.Server.Close()
+	// Proxy identities must not depend on OIDC discovery.
+	fake.ShouldFail.Store(true)
+
+	proxyClaims := &Claims{Iss: PrincipalIssuerTrustedProxy, Sub: "jane", Email: "jane@example.com", PrincipalType: PrincipalTypeUser}
+	ctx := context.WithValue(context.Background(), proxyClaimsKey{}, proxyClaims)
+
+	var got *Claims
+	next := func(ctx context.Context, _ connect.AnyRequest) (connect.AnyResponse, error) {
+		got = ClaimsFromContext(ctx)
+		return nil, nil
+	}
+
+	interceptor := LazyAuthInterceptor(fake.Server.URL, "test-client", "groups", fake.Server.Client(), WithTrustedProxy())
+	if _, err := interceptor(next)(ctx, newTestRequest("")); err != nil {
+		t.Fatalf("proxy identity rejected: %v", err)
+	}
+	if got == nil || got.Sub != "jane" {
+		t.Fatalf("expected proxy claims, got %+v", got)
+	}
+
+	// Without WithTrustedProxy the context identity is ignored.
+	plain := LazyAuthInterceptor(fake.Server.URL, "test-client", "groups", fake.Server.Client())
+	if _, err := plain(next)(ctx, newTestRequest("")); connect.CodeOf(err) != connect.CodeUnavailable {
+		t.Fatalf("expected CodeUnavailable without trusted proxy, got %v", err)
+	}
+}
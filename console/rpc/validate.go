@@ -0,0 +1,68 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"buf.build/go/protovalidate"
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/holos-run/holos-console/console/validation"
+)
+
+// ValidationInterceptor rejects requests that violate the buf.validate rules
+// declared on the request messages before they reach a handler. Handlers
+// can rely on required fields being set and only check rules protovalidate
+// cannot express, such as principal formats or cross-resource invariants.
+func ValidationInterceptor() connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			if err := ValidateRequest(req.Any()); err != nil {
+				return nil, err
+			}
+			return next(ctx, req)
+		}
+	}
+}
+
+// ValidateRequest checks msg against its buf.validate rules. Violations are
+// returned as a connect InvalidArgument error carrying the same BadRequest
+// field violations the handlers report, so clients handle both alike.
+// Values that are not proto messages are accepted.
+func ValidateRequest(msg any) error {
+	m, ok := msg.(proto.Message)
+	if !ok {
+		return nil
+	}
+	err := protovalidate.Validate(m)
+	if err == nil {
+		return nil
+	}
+	var verr *protovalidate.ValidationError
+	if !errors.As(err, &verr) {
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("validating request: %w", err))
+	}
+	var v validation.Violations
+	for _, violation := range verr.Violations {
+		field := protovalidate.FieldPathString(violation.Proto.GetField())
+		message := violation.Proto.GetMessage()
+		if field != "" {
+			message = field + ": " + message
+		}
+		v.Add(field, ruleReason(violation.Proto.GetRuleId()), "%s", message)
+	}
+	return v.Err()
+}
+
+// ruleReason maps a protovalidate rule ID such as "string.pattern" to a
+// violation reason. Required fields share validation.ReasonRequired with
+// the handler checks; other rules become e.g. "STRING_PATTERN".
+func ruleReason(ruleID string) string {
+	if ruleID == "required" {
+		return validation.ReasonRequired
+	}
+	return strings.ToUpper(strings.NewReplacer(".", "_", "-", "_").Replace(ruleID))
+}
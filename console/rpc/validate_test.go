@@ -0,0 +1,69 @@
+package rpc_test
+
+import (
+	"testing"
+
+	"connectrpc.com/connect"
+
+	"github.com/holos-run/holos-console/console/rpc"
+	"github.com/holos-run/holos-console/console/validation"
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+)
+
+func TestValidateRequest(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		msg  any
+		want map[string]string // field -> reason
+	}{
+		{
+			name: "valid",
+			msg:  &consolev1.GetSecretRequest{Name: "db-creds", Project: "my-project"},
+		},
+		{
+			name: "not a proto message",
+			msg:  "plain value",
+		},
+		{
+			name: "required fields",
+			msg:  &consolev1.GetSecretRequest{},
+			want: map[string]string{
+				"name":    validation.ReasonRequired,
+				"project": validation.ReasonRequired,
+			},
+		},
+		{
+			name: "pattern",
+			msg:  &consolev1.GetSecretRequest{Name: "DB_Creds", Project: "my-project"},
+			want: map[string]string{"name": "STRING_PATTERN"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			err := rpc.ValidateRequest(tt.msg)
+			if len(tt.want) == 0 {
+				if err != nil {
+					t.Fatalf("expected no error, got %v", err)
+				}
+				return
+			}
+			if connect.CodeOf(err) != connect.CodeInvalidArgument {
+				t.Fatalf("expected InvalidArgument, got %v", err)
+			}
+			got := map[string]string{}
+			for _, fv := range validation.FieldViolations(err) {
+				got[fv.Field] = fv.Reason
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("expected violations %v, got %v", tt.want, got)
+			}
+			for field, reason := range tt.want {
+				if got[field] != reason {
+					t.Errorf("field %s: expected reason %s, got %q", field, reason, got[field])
+				}
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"strings"
 
 	v1alpha2 "github.com/holos-run/holos-console/api/v1alpha2"
+	"github.com/holos-run/holos-console/console/principal"
 	"github.com/holos-run/holos-console/console/rbacname"
 	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -39,7 +40,7 @@ var roleNames = map[string]string{
 func ProjectSecretRoles(namespace string, ownerRefs []metav1.OwnerReference) []*rbacv1.Role {
 	roles := []*rbacv1.Role{
 		projectSecretRole(namespace, RoleViewer, []string{"get", "list", "watch"}, nil, ownerRefs),
-		projectSecretRole(namespace, RoleEditor, []string{"get", "list", "watch", "create", "update", "patch"}, nil, ownerRefs),
+		projectSecretRole(namespace, RoleEditor, []string{"get", "list", "watch", "create", "update", "patch", "rotate"}, nil, ownerRefs),
 		projectSecretRole(namespace, RoleOwner, []string{"*"}, ownerRules(), ownerRefs),
 	}
 	return roles
@@ -105,10 +106,6 @@ func RoleBindingLabels(target, principal, role string) map[string]string {
 func RoleBinding(namespace, target, principal, role string, ownerRefs []metav1.OwnerReference) *rbacv1.RoleBinding {
 	target = NormalizeTarget(target)
 	role = NormalizeRole(role)
-	subjectKind := rbacv1.UserKind
-	if target == ShareTargetGroup {
-		subjectKind = rbacv1.GroupKind
-	}
 	roleName := RoleName(role)
 	return &rbacv1.RoleBinding{
 		ObjectMeta: metav1.ObjectMeta{
@@ -118,11 +115,7 @@ func RoleBinding(namespace, target, principal, role string, ownerRefs []metav1.O
 			Annotations:     map[string]string{AnnotationShareTargetName: OIDCPrincipal(principal)},
 			OwnerReferences: ownerRefs,
 		},
-		Subjects: []rbacv1.Subject{{
-			Kind:     subjectKind,
-			APIGroup: rbacv1.GroupName,
-			Name:     OIDCPrincipal(principal),
-		}},
+		Subjects: []rbacv1.Subject{Subject(target, principal)},
 		RoleRef: rbacv1.RoleRef{
 			APIGroup: rbacv1.GroupName,
 			Kind:     "Role",
@@ -136,6 +129,19 @@ func RoleBindingName(role, target, principal string) string {
 	return rbacname.RoleBindingName(rolePurpose, target, OIDCPrincipal(principal))
 }
 
+// Subject returns the RBAC subject a share grant binds: the oidc:-prefixed
+// user or group, or for a "*" or "*@example.com" user grant the group the
+// console adds to every matching user it impersonates.
+func Subject(target, name string) rbacv1.Subject {
+	kind := rbacv1.UserKind
+	if NormalizeTarget(target) == ShareTargetGroup {
+		kind = rbacv1.GroupKind
+	} else if group, ok := principal.Group(strings.TrimSpace(name)); ok {
+		return rbacv1.Subject{Kind: rbacv1.GroupKind, APIGroup: rbacv1.GroupName, Name: group}
+	}
+	return rbacv1.Subject{Kind: kind, APIGroup: rbacv1.GroupName, Name: OIDCPrincipal(name)}
+}
+
 func OIDCPrincipal(principal string) string {
 	principal = strings.TrimSpace(principal)
 	if principal == "" || strings.HasPrefix(principal, OIDCPrefix) {
@@ -36,3 +36,19 @@ func TestRoleBindingUsesOIDCGroups(t *testing.T) {
 		t.Fatalf("subject name = %q, want %q", got, want)
 	}
 }
+
+func TestRoleBindingBindsPatternUserGrantsToGroups(t *testing.T) {
+	cases := map[string]string{
+		"*@example.com": "holos:email-domain:example.com",
+		"*":             "holos:authenticated",
+	}
+	for principal, want := range cases {
+		rb := RoleBinding("holos-prj-demo", ShareTargetUser, principal, RoleViewer, nil)
+		if got := rb.Subjects[0].Kind; got != rbacv1.GroupKind {
+			t.Fatalf("%s: subject kind = %q, want %q", principal, got, rbacv1.GroupKind)
+		}
+		if got := rb.Subjects[0].Name; got != want {
+			t.Fatalf("%s: subject name = %q, want %q", principal, got, want)
+		}
+	}
+}
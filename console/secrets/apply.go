@@ -0,0 +1,257 @@
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"maps"
+	"slices"
+	"strings"
+
+	"connectrpc.com/connect"
+	"go.opentelemetry.io/otel/attribute"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/yaml"
+
+	v1alpha2 "github.com/holos-run/holos-console/api/v1alpha2"
+	"github.com/holos-run/holos-console/console/envelope"
+	"github.com/holos-run/holos-console/console/rpc"
+	"github.com/holos-run/holos-console/console/tracing"
+	"github.com/holos-run/holos-console/console/validation"
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+)
+
+// consolePrefix is the prefix of the labels and annotations the console
+// owns.
+const consolePrefix = "console.holos.run/"
+
+// applyAnnotations are the console annotations a manifest may set. Every
+// other console annotation records grants or server state, which have their
+// own RPCs.
+var applyAnnotations = []string{
+	v1alpha2.AnnotationDescription,
+	v1alpha2.AnnotationURL,
+	v1alpha2.AnnotationContentTypes,
+}
+
+// ApplySecretRaw creates or updates a secret from a Secret manifest with
+// server-side apply. The impersonated client authorizes the write, deny
+// grants on an existing secret apply as they do to UpdateSecret, and the
+// project quota and size limits are checked before anything is written.
+func (h *Handler) ApplySecretRaw(
+	ctx context.Context,
+	req *connect.Request[consolev1.ApplySecretRawRequest],
+) (*connect.Response[consolev1.ApplySecretRawResponse], error) {
+	project := req.Msg.Project
+	k8s := h.requestK8s(ctx)
+	secret, err := parseSecretManifest(req.Msg.Manifest, k8s.Resolver.ProjectNamespace(project))
+	if err != nil {
+		return nil, err
+	}
+
+	// Get claims from context (set by AuthInterceptor)
+	claims := rpc.MustClaims(ctx)
+	if req.Msg.DryRun {
+		ctx = rpc.ContextWithDryRun(ctx)
+	}
+
+	var current int64
+	existing, err := k8s.GetSecret(ctx, project, secret.Name)
+	created := errors.IsNotFound(err)
+	switch {
+	case created:
+		if claims.Email != "" {
+			secret.Annotations[v1alpha2.AnnotationCreatorEmail] = claims.Email
+		}
+		if claims.Sub != "" {
+			secret.Annotations[v1alpha2.AnnotationCreatorSubject] = claims.Sub
+		}
+	case err != nil:
+		return nil, mapK8sError(err)
+	default:
+		if err := requireManaged(existing); err != nil {
+			return nil, mapK8sError(err)
+		}
+		if err := requireLocalData(existing); err != nil {
+			return nil, mapK8sError(err)
+		}
+		if err := checkDenied(ctx, claims, existing, project); err != nil {
+			return nil, err
+		}
+		// Carry the creator forward: an annotation the apply field manager
+		// owns but a later manifest omits would otherwise be removed.
+		for _, key := range []string{v1alpha2.AnnotationCreatorEmail, v1alpha2.AnnotationCreatorSubject} {
+			if value, ok := existing.Annotations[key]; ok {
+				secret.Annotations[key] = value
+			}
+		}
+		if secret.Type == "" {
+			secret.Type = existing.Type
+		}
+		current = secretObjectSize(existing)
+	}
+	if err := requireValidType(secret); err != nil {
+		return nil, mapK8sError(err)
+	}
+	if err := requireObjectSize(secret, current); err != nil {
+		return nil, mapK8sError(err)
+	}
+	if err := h.checkQuota(ctx, project, secret.Name, func(d map[string][]byte) { maps.Copy(d, secret.Data) }); err != nil {
+		return nil, err
+	}
+
+	if _, err := k8s.ApplySecret(ctx, project, secret); err != nil {
+		return nil, mapK8sError(err)
+	}
+
+	slog.InfoContext(ctx, "secret applied",
+		slog.String("action", "secret_apply"),
+		slog.String("resource_type", auditResourceType),
+		slog.String("secret", secret.Name),
+		slog.String("project", project),
+		slog.String("sub", claims.Sub),
+		slog.String("email", claims.Email),
+		slog.Bool("created", created),
+		slog.Any("keys", slices.Sorted(maps.Keys(secret.Data))),
+		slog.Bool("dry_run", req.Msg.DryRun),
+	)
+
+	return connect.NewResponse(&consolev1.ApplySecretRawResponse{
+		Name:    secret.Name,
+		Created: created,
+	}), nil
+}
+
+// parseSecretManifest decodes a JSON or YAML Secret manifest for the
+// project namespace ns and returns it ready to apply: stringData is merged
+// into data, server-populated metadata is cleared, and the namespace is set.
+// Every problem with the manifest is reported at once as InvalidArgument.
+func parseSecretManifest(manifest, ns string) (*corev1.Secret, error) {
+	var secret corev1.Secret
+	if err := yaml.UnmarshalStrict([]byte(manifest), &secret); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("manifest is not a valid Secret: %w", err))
+	}
+
+	var v validation.Violations
+	if secret.APIVersion != "v1" {
+		v.Add("manifest.apiVersion", validation.ReasonEnum, "apiVersion must be v1, got %q", secret.APIVersion)
+	}
+	if secret.Kind != "Secret" {
+		v.Add("manifest.kind", validation.ReasonEnum, "kind must be Secret, got %q", secret.Kind)
+	}
+	v.DNSSubdomain("manifest.metadata.name", secret.Name)
+	if secret.GenerateName != "" {
+		v.Add("manifest.metadata.generateName", validation.ReasonConflict, "generateName is not supported; set metadata.name")
+	}
+	if secret.Namespace != "" && secret.Namespace != ns {
+		v.Add("manifest.metadata.namespace", validation.ReasonConflict, "namespace %q is not the project namespace %q", secret.Namespace, ns)
+	}
+	for _, key := range slices.Sorted(maps.Keys(secret.Labels)) {
+		if key == v1alpha2.LabelManagedBy && secret.Labels[key] != v1alpha2.ManagedByValue {
+			v.Add(validation.Key("manifest.metadata.labels", key), validation.ReasonConflict, "label %s must be %q", key, v1alpha2.ManagedByValue)
+		}
+		if strings.HasPrefix(key, consolePrefix) {
+			v.Add(validation.Key("manifest.metadata.labels", key), validation.ReasonReserved, "label %s is reserved for the console", key)
+		}
+	}
+	for _, key := range slices.Sorted(maps.Keys(secret.Annotations)) {
+		if strings.HasPrefix(key, consolePrefix) && !slices.Contains(applyAnnotations, key) {
+			v.Add(validation.Key("manifest.metadata.annotations", key), validation.ReasonReserved, "annotation %s is reserved for the console", key)
+		}
+	}
+	if url, ok := secret.Annotations[v1alpha2.AnnotationURL]; ok {
+		v.URL(validation.Key("manifest.metadata.annotations", v1alpha2.AnnotationURL), url)
+	}
+	secret.Data = mergeStringData(secret.Data, secret.StringData)
+	secret.StringData = nil
+	if len(secret.Data) == 0 {
+		v.Add("manifest.data", validation.ReasonRequired, "secret data is required")
+	}
+	validateDataKeys(&v, "manifest.data", slices.Collect(maps.Keys(secret.Data)))
+	if err := v.Err(); err != nil {
+		return nil, err
+	}
+
+	secret.ObjectMeta = metav1.ObjectMeta{
+		Name:        secret.Name,
+		Namespace:   ns,
+		Labels:      secret.Labels,
+		Annotations: secret.Annotations,
+	}
+	if secret.Labels == nil {
+		secret.Labels = map[string]string{}
+	}
+	if secret.Annotations == nil {
+		secret.Annotations = map[string]string{}
+	}
+	return &secret, nil
+}
+
+// ApplySecret server-side applies secret, which parseSecretManifest
+// prepared, to the project's namespace as FieldManagerApply, taking over
+// fields other writers own. Data keys the console or earlier manifests
+// set through other field managers are kept.
+func (c *K8sClient) ApplySecret(ctx context.Context, project string, secret *corev1.Secret) (*corev1.Secret, error) {
+	ctx, span := tracing.Start(ctx, "secrets.K8sClient.ApplySecret", attribute.String("project", project), attribute.String("name", secret.Name))
+	defer span.End()
+	ns := c.Resolver.ProjectNamespace(project)
+	slog.DebugContext(ctx, "applying secret in kubernetes",
+		slog.String("project", project),
+		slog.String("namespace", ns),
+		slog.String("name", secret.Name),
+	)
+	apply := secret.DeepCopy()
+	apply.TypeMeta = metav1.TypeMeta{APIVersion: "v1", Kind: "Secret"}
+	apply.Namespace = ns
+	if apply.Labels == nil {
+		apply.Labels = map[string]string{}
+	}
+	apply.Labels[v1alpha2.LabelManagedBy] = v1alpha2.ManagedByValue
+	// Keys the manifest omits stay encrypted under the existing data key,
+	// so the applied keys must be encrypted under it too.
+	key, err := c.appliedKey(ctx, ns, secret.Name)
+	if err != nil {
+		return nil, err
+	}
+	if apply, err = c.sealSecret(ctx, apply, key); err != nil {
+		return nil, err
+	}
+	body, err := json.Marshal(apply)
+	if err != nil {
+		return nil, fmt.Errorf("encoding secret %q: %w", secret.Name, err)
+	}
+	force := true
+	applied, err := c.client.CoreV1().Secrets(ns).Patch(ctx, secret.Name, types.ApplyPatchType, body, metav1.PatchOptions{
+		FieldManager: v1alpha2.FieldManagerApply,
+		Force:        &force,
+		DryRun:       rpc.DryRunFromContext(ctx),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return applied, c.openSecret(ctx, applied)
+}
+
+// appliedKey returns the data key the stored secret named name is
+// encrypted under, or nil when it does not exist or is not encrypted.
+func (c *K8sClient) appliedKey(ctx context.Context, ns, name string) (*envelope.Key, error) {
+	if c.Envelope == nil {
+		return nil, nil
+	}
+	existing, err := c.client.CoreV1().Secrets(ns).Get(ctx, name, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	key, ok, err := encryptionKey(existing)
+	if !ok || err != nil {
+		return nil, err
+	}
+	return &key, nil
+}
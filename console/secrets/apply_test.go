@@ -0,0 +1,155 @@
+package secrets
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	v1alpha2 "github.com/holos-run/holos-console/api/v1alpha2"
+	"github.com/holos-run/holos-console/console/rpc"
+	"github.com/holos-run/holos-console/console/validation"
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+)
+
+func TestHandler_ApplySecretRaw(t *testing.T) {
+	claims := &rpc.Claims{Sub: "user-123", Email: "owner@example.com"}
+	apply := func(t *testing.T, client *fake.Clientset, manifest string) (*consolev1.ApplySecretRawResponse, error) {
+		t.Helper()
+		handler := NewProjectScopedHandler(NewK8sClient(client, testResolver()), nil)
+		ctx := contextWithImpersonatedClient(context.Background(), claims, client)
+		resp, err := handler.ApplySecretRaw(ctx, connect.NewRequest(&consolev1.ApplySecretRawRequest{
+			Project:  "test-namespace",
+			Manifest: manifest,
+		}))
+		if err != nil {
+			return nil, err
+		}
+		return resp.Msg, nil
+	}
+	get := func(t *testing.T, client *fake.Clientset) *corev1.Secret {
+		t.Helper()
+		secret, err := client.CoreV1().Secrets("prj-test-namespace").Get(context.Background(), "db-creds", metav1.GetOptions{})
+		if err != nil {
+			t.Fatalf("getting secret: %v", err)
+		}
+		return secret
+	}
+
+	t.Run("creates a secret from YAML", func(t *testing.T) {
+		client := fake.NewClientset(testProjectNS())
+		resp, err := apply(t, client, `
+apiVersion: v1
+kind: Secret
+metadata:
+  name: db-creds
+  uid: copied-from-another-cluster
+  resourceVersion: "42"
+  annotations:
+    console.holos.run/description: Database credentials
+stringData:
+  password: hunter2
+`)
+		if err != nil {
+			t.Fatalf("ApplySecretRaw: %v", err)
+		}
+		if resp.Name != "db-creds" || !resp.Created {
+			t.Errorf("expected db-creds to be created, got %+v", resp)
+		}
+		secret := get(t, client)
+		if string(secret.Data["password"]) != "hunter2" {
+			t.Errorf("expected stringData to be applied as data, got %v", secret.Data)
+		}
+		if secret.Labels[v1alpha2.LabelManagedBy] != v1alpha2.ManagedByValue {
+			t.Errorf("expected the managed-by label, got %v", secret.Labels)
+		}
+		if secret.Annotations[v1alpha2.AnnotationCreatorEmail] != claims.Email {
+			t.Errorf("expected the creator to be recorded, got %v", secret.Annotations)
+		}
+		if secret.UID == "copied-from-another-cluster" {
+			t.Error("expected server-populated metadata to be ignored")
+		}
+	})
+
+	t.Run("updates a managed secret from JSON", func(t *testing.T) {
+		client := fake.NewClientset(testProjectNS(), &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "db-creds",
+				Namespace:   "prj-test-namespace",
+				Labels:      map[string]string{v1alpha2.LabelManagedBy: v1alpha2.ManagedByValue},
+				Annotations: map[string]string{v1alpha2.AnnotationCreatorEmail: "creator@example.com"},
+			},
+			Data: map[string][]byte{"password": []byte("old")},
+		})
+		resp, err := apply(t, client, `{"apiVersion":"v1","kind":"Secret","metadata":{"name":"db-creds","namespace":"prj-test-namespace"},"data":{"password":"bmV3"}}`)
+		if err != nil {
+			t.Fatalf("ApplySecretRaw: %v", err)
+		}
+		if resp.Created {
+			t.Error("expected an update, got created")
+		}
+		secret := get(t, client)
+		if string(secret.Data["password"]) != "new" {
+			t.Errorf("expected the new value, got %q", secret.Data["password"])
+		}
+		if secret.Annotations[v1alpha2.AnnotationCreatorEmail] != "creator@example.com" {
+			t.Errorf("expected the original creator to be kept, got %v", secret.Annotations)
+		}
+	})
+
+	t.Run("rejects invalid manifests", func(t *testing.T) {
+		cases := map[string]struct {
+			manifest string
+			field    string
+		}{
+			"grant annotation": {
+				manifest: "apiVersion: v1\nkind: Secret\nmetadata:\n  name: db-creds\n  annotations:\n    console.holos.run/share-users: '[]'\nstringData:\n  k: v\n",
+				field:    `manifest.metadata.annotations["console.holos.run/share-users"]`,
+			},
+			"other namespace": {
+				manifest: "apiVersion: v1\nkind: Secret\nmetadata:\n  name: db-creds\n  namespace: kube-system\nstringData:\n  k: v\n",
+				field:    "manifest.metadata.namespace",
+			},
+			"wrong kind": {
+				manifest: "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: db-creds\nstringData:\n  k: v\n",
+				field:    "manifest.kind",
+			},
+		}
+		for name, tc := range cases {
+			client := fake.NewClientset(testProjectNS())
+			_, err := apply(t, client, tc.manifest)
+			if connect.CodeOf(err) != connect.CodeInvalidArgument {
+				t.Fatalf("%s: expected InvalidArgument, got %v", name, err)
+			}
+			violations := validation.FieldViolations(err)
+			if len(violations) != 1 || violations[0].Field != tc.field {
+				t.Errorf("%s: expected a violation of %s, got %v", name, tc.field, violations)
+			}
+		}
+	})
+
+	t.Run("rejects unknown fields", func(t *testing.T) {
+		client := fake.NewClientset(testProjectNS())
+		_, err := apply(t, client, "apiVersion: v1\nkind: Secret\nmetadata:\n  name: db-creds\nspec:\n  k: v\n")
+		if connect.CodeOf(err) != connect.CodeInvalidArgument {
+			t.Fatalf("expected InvalidArgument, got %v", err)
+		}
+	})
+
+	t.Run("refuses unmanaged secrets", func(t *testing.T) {
+		client := fake.NewClientset(testProjectNS(), &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "db-creds", Namespace: "prj-test-namespace"},
+			Data:       map[string][]byte{"password": []byte("old")},
+		})
+		_, err := apply(t, client, "apiVersion: v1\nkind: Secret\nmetadata:\n  name: db-creds\nstringData:\n  password: new\n")
+		if connect.CodeOf(err) != connect.CodeFailedPrecondition {
+			t.Fatalf("expected FailedPrecondition, got %v", err)
+		}
+		if got := get(t, client); string(got.Data["password"]) != "old" {
+			t.Errorf("expected the unmanaged secret to be unchanged, got %q", got.Data["password"])
+		}
+	})
+}
@@ -0,0 +1,70 @@
+package secrets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+
+	"connectrpc.com/connect"
+	corev1 "k8s.io/api/core/v1"
+
+	v1alpha2 "github.com/holos-run/holos-console/api/v1alpha2"
+)
+
+// ErrExternalData is returned when a write would change the values of a
+// secret whose values live in an external backend.
+var ErrExternalData = errors.New("secret values are stored in an external backend")
+
+// Backend supplies the values of secrets stored outside Kubernetes. A
+// Secret opts in with the console.holos.run/vault-path annotation; its
+// metadata and sharing grants stay on the Kubernetes object.
+type Backend interface {
+	// Read returns the key-value pairs at path. The error wraps
+	// fs.ErrNotExist when path has no secret.
+	Read(ctx context.Context, path string) (map[string][]byte, error)
+}
+
+// WithBackend sets the backend that GetSecret, GetSecretKey and
+// ExportSecretSealed read the values of backend-stored secrets from.
+func (h *Handler) WithBackend(b Backend) *Handler {
+	h.backend = b
+	return h
+}
+
+// BackendPath returns the backend path the secret's values are read from, or
+// "" when they are stored on the Secret itself.
+func BackendPath(secret *corev1.Secret) string {
+	return secret.Annotations[v1alpha2.AnnotationVaultPath]
+}
+
+// requireLocalData returns an error wrapping ErrExternalData if the secret's
+// values are stored in a backend, which the console only reads.
+func requireLocalData(secret *corev1.Secret) error {
+	if path := BackendPath(secret); path != "" {
+		return fmt.Errorf("%w: secret %q reads its values from vault path %q; change them in Vault", ErrExternalData, secret.Name, path)
+	}
+	return nil
+}
+
+// readBackend replaces the data of a backend-stored secret with the values
+// read from the backend. Call it only after the caller is authorized to read
+// the secret. Secrets without a backend path are left unchanged.
+func (h *Handler) readBackend(ctx context.Context, secret *corev1.Secret) error {
+	path := BackendPath(secret)
+	if path == "" {
+		return nil
+	}
+	if h.backend == nil {
+		return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("secret %q reads its values from vault path %q but no Vault backend is configured", secret.Name, path))
+	}
+	data, err := h.backend.Read(ctx, path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return connect.NewError(connect.CodeNotFound, err)
+		}
+		return connect.NewError(connect.CodeUnavailable, err)
+	}
+	secret.Data = data
+	return nil
+}
@@ -0,0 +1,116 @@
+package secrets
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"testing"
+
+	"connectrpc.com/connect"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	v1alpha2 "github.com/holos-run/holos-console/api/v1alpha2"
+	"github.com/holos-run/holos-console/console/rpc"
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+)
+
+// fakeBackend serves secret values from a map keyed by path.
+type fakeBackend map[string]map[string][]byte
+
+func (b fakeBackend) Read(_ context.Context, path string) (map[string][]byte, error) {
+	data, ok := b[path]
+	if !ok {
+		return nil, fmt.Errorf("vault path %q: %w", path, fs.ErrNotExist)
+	}
+	return data, nil
+}
+
+func TestHandler_Backend(t *testing.T) {
+	fakeClient := fake.NewClientset(testProjectNS())
+	handler := NewProjectScopedHandler(NewK8sClient(fakeClient, testResolver()), nil)
+	ctx := rpc.ContextWithClaims(context.Background(), &rpc.Claims{Sub: "u1", Email: "user@example.com"})
+	if _, err := handler.CreateSecret(ctx, connect.NewRequest(&consolev1.CreateSecretRequest{
+		Name:    "db",
+		Project: "test-namespace",
+	})); err != nil {
+		t.Fatalf("CreateSecret: %v", err)
+	}
+	setVaultPath := func(path string) {
+		t.Helper()
+		secrets := fakeClient.CoreV1().Secrets("prj-test-namespace")
+		secret, err := secrets.Get(ctx, "db", metav1.GetOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		secret.Annotations[v1alpha2.AnnotationVaultPath] = path
+		if _, err := secrets.Update(ctx, secret, metav1.UpdateOptions{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	getReq := &consolev1.GetSecretRequest{Name: "db", Project: "test-namespace"}
+	setVaultPath("secret/data/team/db")
+
+	t.Run("requires a configured backend", func(t *testing.T) {
+		_, err := handler.GetSecret(ctx, connect.NewRequest(getReq))
+		if connect.CodeOf(err) != connect.CodeFailedPrecondition {
+			t.Fatalf("expected FailedPrecondition, got %v", err)
+		}
+	})
+
+	handler.WithBackend(fakeBackend{"secret/data/team/db": {"password": []byte("hunter2")}})
+
+	t.Run("reads values from the backend", func(t *testing.T) {
+		resp, err := handler.GetSecret(ctx, connect.NewRequest(getReq))
+		if err != nil {
+			t.Fatalf("GetSecret: %v", err)
+		}
+		if string(resp.Msg.Data["password"]) != "hunter2" {
+			t.Errorf("data = %q", resp.Msg.Data)
+		}
+		key, err := handler.GetSecretKey(ctx, connect.NewRequest(&consolev1.GetSecretKeyRequest{Name: "db", Project: "test-namespace", Key: "password"}))
+		if err != nil {
+			t.Fatalf("GetSecretKey: %v", err)
+		}
+		if string(key.Msg.Value) != "hunter2" {
+			t.Errorf("value = %q", key.Msg.Value)
+		}
+	})
+
+	t.Run("reports the path in metadata", func(t *testing.T) {
+		resp, err := handler.ListSecrets(ctx, connect.NewRequest(&consolev1.ListSecretsRequest{Project: "test-namespace"}))
+		if err != nil {
+			t.Fatalf("ListSecrets: %v", err)
+		}
+		if got := resp.Msg.Secrets[0].VaultPath; got != "secret/data/team/db" {
+			t.Errorf("VaultPath = %q", got)
+		}
+	})
+
+	t.Run("rejects writes to the values", func(t *testing.T) {
+		_, err := handler.UpdateSecret(ctx, connect.NewRequest(&consolev1.UpdateSecretRequest{
+			Name:    "db",
+			Project: "test-namespace",
+			Data:    map[string][]byte{"password": []byte("changed")},
+		}))
+		if connect.CodeOf(err) != connect.CodeFailedPrecondition {
+			t.Errorf("UpdateSecret: expected FailedPrecondition, got %v", err)
+		}
+		_, err = handler.PatchSecret(ctx, connect.NewRequest(&consolev1.PatchSecretRequest{
+			Name:    "db",
+			Project: "test-namespace",
+			Data:    map[string][]byte{"password": []byte("changed")},
+		}))
+		if connect.CodeOf(err) != connect.CodeFailedPrecondition {
+			t.Errorf("PatchSecret: expected FailedPrecondition, got %v", err)
+		}
+	})
+
+	t.Run("missing path is not found", func(t *testing.T) {
+		setVaultPath("secret/data/team/missing")
+		_, err := handler.GetSecret(ctx, connect.NewRequest(getReq))
+		if connect.CodeOf(err) != connect.CodeNotFound {
+			t.Fatalf("expected NotFound, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,68 @@
+package secrets
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+)
+
+// BatchGetSecrets retrieves each named secret through GetSecret, so every
+// secret is authorized, access-stamped, and audited individually. Failures
+// are reported per secret.
+func (h *Handler) BatchGetSecrets(
+	ctx context.Context,
+	req *connect.Request[consolev1.BatchGetSecretsRequest],
+) (*connect.Response[consolev1.BatchGetSecretsResponse], error) {
+	results := make([]*consolev1.BatchGetSecretResult, 0, len(req.Msg.Names))
+	for _, name := range req.Msg.Names {
+		result := &consolev1.BatchGetSecretResult{Name: name}
+		resp, err := h.GetSecret(ctx, connect.NewRequest(&consolev1.GetSecretRequest{
+			Name:    name,
+			Project: req.Msg.Project,
+		}))
+		if err != nil {
+			result.Error = batchItemError(err)
+		} else {
+			result.Data = resp.Msg.Data
+		}
+		results = append(results, result)
+	}
+	return connect.NewResponse(&consolev1.BatchGetSecretsResponse{Results: results}), nil
+}
+
+// BatchDeleteSecrets deletes each named secret through DeleteSecret, in
+// request order. A failed deletion is reported in its result and does not
+// stop the others.
+func (h *Handler) BatchDeleteSecrets(
+	ctx context.Context,
+	req *connect.Request[consolev1.BatchDeleteSecretsRequest],
+) (*connect.Response[consolev1.BatchDeleteSecretsResponse], error) {
+	results := make([]*consolev1.BatchDeleteSecretResult, 0, len(req.Msg.Names))
+	for _, name := range req.Msg.Names {
+		result := &consolev1.BatchDeleteSecretResult{Name: name}
+		_, err := h.DeleteSecret(ctx, connect.NewRequest(&consolev1.DeleteSecretRequest{
+			Name:    name,
+			Project: req.Msg.Project,
+			DryRun:  req.Msg.DryRun,
+			Force:   req.Msg.Force,
+		}))
+		if err != nil {
+			result.Error = batchItemError(err)
+		}
+		results = append(results, result)
+	}
+	return connect.NewResponse(&consolev1.BatchDeleteSecretsResponse{Results: results}), nil
+}
+
+// batchItemError reports err, as the single-item RPC would have returned
+// it, in a batch result.
+func batchItemError(err error) *consolev1.BatchItemError {
+	var cerr *connect.Error
+	if errors.As(err, &cerr) {
+		return &consolev1.BatchItemError{Code: cerr.Code().String(), Message: cerr.Message()}
+	}
+	return &consolev1.BatchItemError{Code: connect.CodeInternal.String(), Message: err.Error()}
+}
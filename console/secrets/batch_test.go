@@ -0,0 +1,62 @@
+package secrets
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/holos-run/holos-console/console/rpc"
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+)
+
+func TestBatchSecrets(t *testing.T) {
+	fakeClient := fake.NewClientset(testProjectNS())
+	handler := NewProjectScopedHandler(NewK8sClient(fakeClient, testResolver()), nil)
+	ctx := rpc.ContextWithClaims(context.Background(), &rpc.Claims{Sub: "u1", Email: "user@example.com"})
+	for _, name := range []string{"a", "b"} {
+		if _, err := handler.CreateSecret(ctx, connect.NewRequest(&consolev1.CreateSecretRequest{
+			Name:       name,
+			Project:    "test-namespace",
+			StringData: map[string]string{"value": name},
+		})); err != nil {
+			t.Fatalf("CreateSecret %s: %v", name, err)
+		}
+	}
+
+	got, err := handler.BatchGetSecrets(ctx, connect.NewRequest(&consolev1.BatchGetSecretsRequest{
+		Names:   []string{"b", "missing", "a"},
+		Project: "test-namespace",
+	}))
+	if err != nil {
+		t.Fatalf("BatchGetSecrets: %v", err)
+	}
+	results := got.Msg.Results
+	if len(results) != 3 || results[0].Name != "b" || results[2].Name != "a" {
+		t.Fatalf("expected results in request order, got %v", results)
+	}
+	if string(results[0].Data["value"]) != "b" || results[0].Error != nil {
+		t.Errorf("unexpected result for b: %v", results[0])
+	}
+	if results[1].Error.GetCode() != connect.CodeNotFound.String() || len(results[1].Data) != 0 {
+		t.Errorf("expected not_found for the missing secret, got %v", results[1])
+	}
+
+	deleted, err := handler.BatchDeleteSecrets(ctx, connect.NewRequest(&consolev1.BatchDeleteSecretsRequest{
+		Names:   []string{"missing", "a"},
+		Project: "test-namespace",
+	}))
+	if err != nil {
+		t.Fatalf("BatchDeleteSecrets: %v", err)
+	}
+	if r := deleted.Msg.Results; r[0].Error.GetCode() != connect.CodeNotFound.String() || r[1].Error != nil {
+		t.Errorf("expected the failure to be reported without stopping the batch, got %v", r)
+	}
+	if _, err := handler.k8s.GetSecret(ctx, "test-namespace", "a"); err == nil {
+		t.Error("expected secret a to be deleted")
+	}
+	if _, err := handler.k8s.GetSecret(ctx, "test-namespace", "b"); err != nil {
+		t.Errorf("expected secret b to remain, got %v", err)
+	}
+}
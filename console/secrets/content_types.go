@@ -0,0 +1,73 @@
+package secrets
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+
+	corev1 "k8s.io/api/core/v1"
+
+	v1alpha2 "github.com/holos-run/holos-console/api/v1alpha2"
+)
+
+// DefaultContentType is the media type of a key with no recorded type.
+const DefaultContentType = "application/octet-stream"
+
+// GetContentTypes returns the media types recorded for the secret's data
+// keys. Returns nil if the annotation is absent.
+func GetContentTypes(secret *corev1.Secret) (map[string]string, error) {
+	if secret == nil || secret.Annotations == nil {
+		return nil, nil
+	}
+	value, ok := secret.Annotations[v1alpha2.AnnotationContentTypes]
+	if !ok || value == "" {
+		return nil, nil
+	}
+	var types map[string]string
+	if err := json.Unmarshal([]byte(value), &types); err != nil {
+		return nil, fmt.Errorf("parsing %s annotation: %w", v1alpha2.AnnotationContentTypes, err)
+	}
+	return types, nil
+}
+
+// ContentType returns the media type recorded for key, or
+// DefaultContentType when none was recorded.
+func ContentType(secret *corev1.Secret, key string) string {
+	types, _ := GetContentTypes(secret)
+	if t, ok := types[key]; ok {
+		return t
+	}
+	return DefaultContentType
+}
+
+// mergeContentTypes overlays types on the secret's recorded media types and
+// drops the types of keys the secret no longer holds. The annotation is
+// removed when no types remain.
+func mergeContentTypes(secret *corev1.Secret, types map[string]string) error {
+	merged, err := GetContentTypes(secret)
+	if err != nil {
+		// A corrupt annotation is replaced rather than blocking the write.
+		merged = nil
+	}
+	if merged == nil {
+		merged = make(map[string]string, len(types))
+	}
+	maps.Copy(merged, types)
+	maps.DeleteFunc(merged, func(key, _ string) bool {
+		_, ok := secret.Data[key]
+		return !ok
+	})
+	if len(merged) == 0 {
+		delete(secret.Annotations, v1alpha2.AnnotationContentTypes)
+		return nil
+	}
+	encoded, err := json.Marshal(merged)
+	if err != nil {
+		return fmt.Errorf("marshaling %s annotation: %w", v1alpha2.AnnotationContentTypes, err)
+	}
+	if secret.Annotations == nil {
+		secret.Annotations = make(map[string]string, 1)
+	}
+	secret.Annotations[v1alpha2.AnnotationContentTypes] = string(encoded)
+	return nil
+}
@@ -0,0 +1,73 @@
+package secrets
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/holos-run/holos-console/console/rpc"
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+)
+
+func TestSecretContentTypes(t *testing.T) {
+	fakeClient := fake.NewClientset(testProjectNS())
+	handler := NewProjectScopedHandler(NewK8sClient(fakeClient, testResolver()), nil)
+	ctx := rpc.ContextWithClaims(context.Background(), &rpc.Claims{Sub: "u1", Email: "user@example.com"})
+
+	contentTypes := func() map[string]string {
+		t.Helper()
+		resp, err := handler.ListSecrets(ctx, connect.NewRequest(&consolev1.ListSecretsRequest{Project: "test-namespace"}))
+		if err != nil {
+			t.Fatalf("ListSecrets: %v", err)
+		}
+		return resp.Msg.Secrets[0].ContentTypes
+	}
+
+	if _, err := handler.CreateSecret(ctx, connect.NewRequest(&consolev1.CreateSecretRequest{
+		Name:         "tls",
+		Project:      "test-namespace",
+		Data:         map[string][]byte{"keystore.p12": keystore, "tls.key": []byte("key")},
+		ContentTypes: map[string]string{"keystore.p12": "application/x-pkcs12"},
+	})); err != nil {
+		t.Fatalf("CreateSecret: %v", err)
+	}
+	if got := contentTypes(); got["keystore.p12"] != "application/x-pkcs12" || len(got) != 1 {
+		t.Errorf("after create: %v", got)
+	}
+
+	if _, err := handler.PatchSecret(ctx, connect.NewRequest(&consolev1.PatchSecretRequest{
+		Name:         "tls",
+		Project:      "test-namespace",
+		Data:         map[string][]byte{"tls.crt": []byte("crt")},
+		ContentTypes: map[string]string{"tls.crt": "application/x-pem-file"},
+		RemoveKeys:   []string{"keystore.p12"},
+	})); err != nil {
+		t.Fatalf("PatchSecret: %v", err)
+	}
+	if got := contentTypes(); got["tls.crt"] != "application/x-pem-file" || len(got) != 1 {
+		t.Errorf("after patch: %v", got)
+	}
+
+	if _, err := handler.UpdateSecret(ctx, connect.NewRequest(&consolev1.UpdateSecretRequest{
+		Name:    "tls",
+		Project: "test-namespace",
+		Data:    map[string][]byte{"tls.crt": []byte("crt2")},
+	})); err != nil {
+		t.Fatalf("UpdateSecret: %v", err)
+	}
+	if got := contentTypes(); got["tls.crt"] != "application/x-pem-file" {
+		t.Errorf("expected update without content_types to keep recorded types, got %v", got)
+	}
+
+	_, err := handler.PatchSecret(ctx, connect.NewRequest(&consolev1.PatchSecretRequest{
+		Name:         "tls",
+		Project:      "test-namespace",
+		Data:         map[string][]byte{"a": []byte("a")},
+		ContentTypes: map[string]string{"a": "not a type", "b": "text/plain"},
+	}))
+	if connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Fatalf("expected InvalidArgument, got %v", err)
+	}
+}
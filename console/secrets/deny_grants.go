@@ -0,0 +1,145 @@
+package secrets
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	v1alpha2 "github.com/holos-run/holos-console/api/v1alpha2"
+	"github.com/holos-run/holos-console/console/principal"
+	"github.com/holos-run/holos-console/console/rbac"
+	"github.com/holos-run/holos-console/console/rpc"
+	"github.com/holos-run/holos-console/console/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+)
+
+// SplitDenyGrants partitions grants into deny grants and allow grants. Deny
+// grants apply to the whole Secret, so their Role and Keys are cleared.
+func SplitDenyGrants(grants []AnnotationGrant) (denyGrants, allowGrants []AnnotationGrant) {
+	seen := make(map[string]bool)
+	for _, g := range grants {
+		if !g.Deny {
+			allowGrants = append(allowGrants, g)
+			continue
+		}
+		if g.Principal == "" || seen[g.Principal] {
+			continue
+		}
+		seen[g.Principal] = true
+		g.Role, g.Keys = "", nil
+		denyGrants = append(denyGrants, g)
+	}
+	return denyGrants, allowGrants
+}
+
+// GetDenyShareUsers returns the user deny grants stored on a secret.
+func GetDenyShareUsers(secret *corev1.Secret) ([]AnnotationGrant, error) {
+	return parseGrantsAnnotation(secret, v1alpha2.AnnotationShareDenyUsers)
+}
+
+// GetDenyShareRoles returns the role deny grants stored on a secret.
+func GetDenyShareRoles(secret *corev1.Secret) ([]AnnotationGrant, error) {
+	return parseGrantsAnnotation(secret, v1alpha2.AnnotationShareDenyRoles)
+}
+
+// UpdateDenySharing replaces the deny grants stored on a secret. Like
+// UpdateKeySharing the Secret is only written when the grants change.
+// Returns FailedPrecondition if the secret does not have the console managed-by label.
+func (c *K8sClient) UpdateDenySharing(ctx context.Context, project, name string, denyUsers, denyRoles []AnnotationGrant) (*corev1.Secret, error) {
+	ctx, span := tracing.Start(ctx, "secrets.K8sClient.UpdateDenySharing", attribute.String("project", project), attribute.String("name", name))
+	defer span.End()
+	slog.DebugContext(ctx, "updating deny sharing on kubernetes secret",
+		slog.String("project", project),
+		slog.String("name", name),
+	)
+	secret, err := c.GetSecret(ctx, project, name)
+	if err != nil {
+		return nil, err
+	}
+	current := secretObjectSize(secret)
+	if err := requireManaged(secret); err != nil {
+		return nil, err
+	}
+	changedUsers, err := setGrantsAnnotation(secret, v1alpha2.AnnotationShareDenyUsers, denyUsers)
+	if err != nil {
+		return nil, err
+	}
+	changedRoles, err := setGrantsAnnotation(secret, v1alpha2.AnnotationShareDenyRoles, denyRoles)
+	if err != nil {
+		return nil, err
+	}
+	if !changedUsers && !changedRoles {
+		return secret, nil
+	}
+	if err := requireObjectSize(secret, current); err != nil {
+		return nil, err
+	}
+	return c.updateSecretObject(ctx, secret)
+}
+
+// DenyGrantMatches reports whether an active deny grant on the secret
+// excludes the principal identified by email, sub, and roles. User grants
+// match the email as principal.Matches does, so "*" and "*@domain" deny
+// every user they name, or the OIDC subject; role grants match any of
+// roles. A malformed annotation denies, failing closed.
+func DenyGrantMatches(secret *corev1.Secret, email, sub string, emailVerified bool, roles []string, now time.Time) bool {
+	denyUsers, usersErr := GetDenyShareUsers(secret)
+	denyRoles, rolesErr := GetDenyShareRoles(secret)
+	if usersErr != nil || rolesErr != nil {
+		return true
+	}
+	users := ActiveGrantsMap(denyUsers, now)
+	for p := range users {
+		p = strings.TrimPrefix(p, "oidc:")
+		if principal.Matches(p, email, emailVerified) != principal.NoMatch || (sub != "" && p == sub) {
+			return true
+		}
+	}
+	return rbac.DeniedByGrants("", false, roles, nil, ActiveGrantsMap(denyRoles, now))
+}
+
+// requireNotDenied returns PermissionDenied when a deny grant on the named
+// secret excludes the caller. The secret is read with the console service
+// account because the caller may hold no access to it at all; a missing
+// secret is left for the operation itself to report.
+func (h *Handler) requireNotDenied(ctx context.Context, claims *rpc.Claims, project, name string) error {
+	secret, err := h.k8s.GetSecret(ctx, project, name)
+	if errors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return mapK8sError(err)
+	}
+	return checkDenied(ctx, claims, secret, project)
+}
+
+// checkDenied returns PermissionDenied, and logs the denial, when a deny
+// grant on secret excludes the caller.
+func checkDenied(ctx context.Context, claims *rpc.Claims, secret *corev1.Secret, project string) error {
+	if !DenyGrantMatches(secret, claims.Email, claims.Sub, claims.EmailVerified, claims.Roles, time.Now()) {
+		return nil
+	}
+	slog.WarnContext(ctx, "secret access denied by deny grant",
+		slog.String("action", "secret_access_denied"),
+		slog.String("resource_type", auditResourceType),
+		slog.String("secret", secret.Name),
+		slog.String("project", project),
+		slog.String("sub", claims.Sub),
+		slog.String("email", claims.Email),
+	)
+	return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("access to secret %q is denied", secret.Name))
+}
+
+// denyGrantsName reports whether any deny grant names the caller directly.
+func denyGrantsName(denyUsers []AnnotationGrant, claims *rpc.Claims) bool {
+	return slices.ContainsFunc(denyUsers, func(g AnnotationGrant) bool {
+		principal := strings.TrimPrefix(g.Principal, "oidc:")
+		return strings.EqualFold(principal, claims.Email) || (claims.Sub != "" && principal == claims.Sub)
+	})
+}
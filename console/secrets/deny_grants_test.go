@@ -0,0 +1,192 @@
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	v1alpha2 "github.com/holos-run/holos-console/api/v1alpha2"
+	"github.com/holos-run/holos-console/console/rpc"
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+)
+
+func TestSplitDenyGrants(t *testing.T) {
+	deny, allow := SplitDenyGrants([]AnnotationGrant{
+		{Principal: "alice@example.com", Role: "owner"},
+		{Principal: "bob@example.com", Role: "viewer", Keys: []string{"username"}, Deny: true},
+		{Principal: "bob@example.com", Deny: true},
+	})
+	if len(allow) != 1 || allow[0].Principal != "alice@example.com" {
+		t.Fatalf("expected alice as the only allow grant, got %+v", allow)
+	}
+	if len(deny) != 1 || deny[0].Principal != "bob@example.com" || deny[0].Role != "" || deny[0].Keys != nil {
+		t.Fatalf("expected one bare deny grant for bob, got %+v", deny)
+	}
+}
+
+func TestDeduplicateGrants_KeepsDeny(t *testing.T) {
+	got := DeduplicateGrants([]AnnotationGrant{
+		{Principal: "bob@example.com", Role: "owner"},
+		{Principal: "bob@example.com", Deny: true},
+	})
+	if len(got) != 2 {
+		t.Fatalf("expected the deny grant to survive deduplication, got %+v", got)
+	}
+}
+
+func TestDenyGrantMatches(t *testing.T) {
+	now := time.Unix(2000, 0)
+	past := int64(1000)
+	secret := denyGrantSecret(t,
+		[]AnnotationGrant{
+			{Principal: "bob@example.com", Deny: true},
+			{Principal: "oidc:sub-carol", Deny: true},
+			{Principal: "dave@example.com", Deny: true, Exp: &past},
+			{Principal: "*@contractor.example", Deny: true},
+		},
+		[]AnnotationGrant{{Principal: "contractors", Deny: true}},
+	)
+	cases := []struct {
+		name     string
+		email    string
+		sub      string
+		verified bool
+		roles    []string
+		want     bool
+	}{
+		{name: "email match", email: "BOB@example.com", want: true},
+		{name: "subject match", email: "carol@example.com", sub: "sub-carol", want: true},
+		{name: "role match", email: "erin@example.com", roles: []string{"contractors"}, want: true},
+		{name: "expired deny", email: "dave@example.com"},
+		{name: "domain match", email: "frank@contractor.example", verified: true, want: true},
+		{name: "domain needs a verified email", email: "frank@contractor.example"},
+		{name: "no match", email: "alice@example.com", roles: []string{"devs"}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := DenyGrantMatches(secret, tc.email, tc.sub, tc.verified, tc.roles, now); got != tc.want {
+				t.Fatalf("DenyGrantMatches = %v, want %v", got, tc.want)
+			}
+		})
+	}
+
+	everyone := denyGrantSecret(t, []AnnotationGrant{{Principal: "*", Deny: true}}, nil)
+	if !DenyGrantMatches(everyone, "alice@example.com", "", false, nil, now) {
+		t.Error("expected a wildcard deny to match every user")
+	}
+
+	malformed := denyGrantSecret(t, nil, nil)
+	malformed.Annotations[v1alpha2.AnnotationShareDenyUsers] = "not json"
+	if !DenyGrantMatches(malformed, "alice@example.com", "", false, nil, now) {
+		t.Fatal("expected a malformed deny annotation to deny")
+	}
+}
+
+func denyGrantSecret(t *testing.T, users, roles []AnnotationGrant) *corev1.Secret {
+	t.Helper()
+	annotations := map[string]string{}
+	for annotation, grants := range map[string][]AnnotationGrant{
+		v1alpha2.AnnotationShareDenyUsers: users,
+		v1alpha2.AnnotationShareDenyRoles: roles,
+	} {
+		if len(grants) == 0 {
+			continue
+		}
+		value, err := json.Marshal(grants)
+		if err != nil {
+			t.Fatal(err)
+		}
+		annotations[annotation] = string(value)
+	}
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "db-creds",
+			Namespace:   "prj-test-namespace",
+			Labels:      map[string]string{v1alpha2.LabelManagedBy: v1alpha2.ManagedByValue},
+			Annotations: annotations,
+		},
+		Data: map[string][]byte{"username": []byte("admin")},
+	}
+}
+
+func TestHandler_DenyGrantOverridesAccess(t *testing.T) {
+	secret := denyGrantSecret(t, []AnnotationGrant{{Principal: "bob@example.com", Deny: true}}, nil)
+	// The impersonated client can read everything, standing in for a broad
+	// project grant the deny must override.
+	client := fake.NewClientset(testProjectNS(), secret)
+	handler := NewProjectScopedHandler(NewK8sClient(client, testResolver()), nil)
+	bob := contextWithImpersonatedClient(context.Background(), &rpc.Claims{Sub: "sub-bob", Email: "bob@example.com"}, client)
+	alice := contextWithImpersonatedClient(context.Background(), &rpc.Claims{Sub: "sub-alice", Email: "alice@example.com"}, client)
+
+	if _, err := handler.GetSecret(bob, connect.NewRequest(&consolev1.GetSecretRequest{Name: "db-creds", Project: "test-namespace"})); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Fatalf("expected GetSecret PermissionDenied, got %v", err)
+	}
+	if _, err := handler.GetSecretKey(bob, connect.NewRequest(&consolev1.GetSecretKeyRequest{Name: "db-creds", Project: "test-namespace", Key: "username"})); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Fatalf("expected GetSecretKey PermissionDenied, got %v", err)
+	}
+	if _, err := handler.DeleteSecret(bob, connect.NewRequest(&consolev1.DeleteSecretRequest{Name: "db-creds", Project: "test-namespace"})); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Fatalf("expected DeleteSecret PermissionDenied, got %v", err)
+	}
+	if _, err := handler.GetSecret(alice, connect.NewRequest(&consolev1.GetSecretRequest{Name: "db-creds", Project: "test-namespace"})); err != nil {
+		t.Fatalf("expected alice to read the secret, got %v", err)
+	}
+
+	list, err := handler.ListSecrets(bob, connect.NewRequest(&consolev1.ListSecretsRequest{Project: "test-namespace"}))
+	if err != nil {
+		t.Fatalf("ListSecrets: %v", err)
+	}
+	if len(list.Msg.Secrets) != 1 || list.Msg.Secrets[0].Accessible {
+		t.Fatalf("expected the secret listed as inaccessible, got %+v", list.Msg.Secrets)
+	}
+}
+
+func TestHandler_UpdateSharing_DenyGrants(t *testing.T) {
+	secret := denyGrantSecret(t, nil, nil)
+	client := fake.NewClientset(testProjectNS(), secret)
+	handler := NewProjectScopedHandler(NewK8sClient(client, testResolver()), nil)
+	ctx := contextWithImpersonatedClient(context.Background(), &rpc.Claims{Sub: "sub-owner", Email: "owner@example.com"}, client)
+
+	resp, err := handler.UpdateSharing(ctx, connect.NewRequest(&consolev1.UpdateSharingRequest{
+		Name:       "db-creds",
+		Project:    "test-namespace",
+		UserGrants: []*consolev1.ShareGrant{{Principal: "bob@example.com", Deny: true}},
+	}))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	stored, err := client.CoreV1().Secrets("prj-test-namespace").Get(ctx, "db-creds", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	denyUsers, err := GetDenyShareUsers(stored)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(denyUsers) != 1 || denyUsers[0].Principal != "bob@example.com" || !denyUsers[0].Deny {
+		t.Fatalf("expected bob's deny grant stored on secret, got %+v", denyUsers)
+	}
+	var found bool
+	for _, g := range resp.Msg.Metadata.UserGrants {
+		if g.Principal == "bob@example.com" && g.Deny {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatalf("expected deny grant in response metadata, got %v", resp.Msg.Metadata.UserGrants)
+	}
+
+	_, err = handler.UpdateSharing(ctx, connect.NewRequest(&consolev1.UpdateSharingRequest{
+		Name:       "db-creds",
+		Project:    "test-namespace",
+		UserGrants: []*consolev1.ShareGrant{{Principal: "owner@example.com", Deny: true}},
+	}))
+	if connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Fatalf("expected InvalidArgument denying the caller, got %v", err)
+	}
+}
@@ -0,0 +1,96 @@
+package secrets
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"maps"
+	"slices"
+
+	"connectrpc.com/connect"
+
+	"github.com/holos-run/holos-console/console/rpc"
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+)
+
+// DiffSecret compares the data an UpdateSecret would write with the
+// secret's current data and returns the changed keys with their value
+// sizes. Nothing is written, and no value leaves the server, but reading
+// the current data still requires read access to the secret.
+func (h *Handler) DiffSecret(
+	ctx context.Context,
+	req *connect.Request[consolev1.DiffSecretRequest],
+) (*connect.Response[consolev1.DiffSecretResponse], error) {
+	if err := validateDiffSecret(req.Msg); err != nil {
+		return nil, err
+	}
+	project := req.Msg.Project
+
+	// Get claims from context (set by AuthInterceptor)
+	claims := rpc.MustClaims(ctx)
+
+	secret, err := h.requestK8s(ctx).GetSecret(ctx, project, req.Msg.Name)
+	if err != nil {
+		return nil, mapK8sError(err)
+	}
+	if err := requireManaged(secret); err != nil {
+		return nil, mapK8sError(err)
+	}
+	if err := requireLocalData(secret); err != nil {
+		return nil, mapK8sError(err)
+	}
+	if err := checkDenied(ctx, claims, secret, project); err != nil {
+		return nil, err
+	}
+
+	proposed := mergeStringData(req.Msg.ProposedData, req.Msg.ProposedStringData)
+	changes, unchanged := diffData(secret.Data, proposed)
+
+	slog.InfoContext(ctx, "secret diffed",
+		slog.String("action", "secret_diff"),
+		slog.String("resource_type", auditResourceType),
+		slog.String("secret", req.Msg.Name),
+		slog.String("project", project),
+		slog.String("sub", claims.Sub),
+		slog.String("email", claims.Email),
+		slog.Int("changes", len(changes)),
+	)
+
+	return connect.NewResponse(&consolev1.DiffSecretResponse{
+		Changes:       changes,
+		UnchangedKeys: int32(unchanged),
+	}), nil
+}
+
+// diffData returns the changes that replacing current with proposed makes,
+// in key order, and the number of keys both hold with equal values.
+func diffData(current, proposed map[string][]byte) ([]*consolev1.SecretKeyChange, int) {
+	keys := slices.Collect(maps.Keys(current))
+	for key := range proposed {
+		if _, ok := current[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	slices.Sort(keys)
+
+	var changes []*consolev1.SecretKeyChange
+	unchanged := 0
+	for _, key := range keys {
+		old, inCurrent := current[key]
+		value, inProposed := proposed[key]
+		change := &consolev1.SecretKeyChange{Key: key, OldSize: int64(len(old)), NewSize: int64(len(value))}
+		switch {
+		case !inCurrent:
+			change.Change = consolev1.ChangeType_CHANGE_TYPE_ADDED
+		case !inProposed:
+			change.Change = consolev1.ChangeType_CHANGE_TYPE_REMOVED
+		case !bytes.Equal(old, value):
+			change.Change = consolev1.ChangeType_CHANGE_TYPE_MODIFIED
+		default:
+			unchanged++
+			continue
+		}
+		changes = append(changes, change)
+	}
+	return changes, unchanged
+}
@@ -0,0 +1,70 @@
+package secrets
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	v1alpha2 "github.com/holos-run/holos-console/api/v1alpha2"
+	"github.com/holos-run/holos-console/console/rpc"
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+)
+
+func TestHandler_DiffSecret(t *testing.T) {
+	claims := &rpc.Claims{Sub: "user-123", Email: "user@example.com"}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-secret",
+			Namespace: "prj-test-namespace",
+			Labels:    map[string]string{v1alpha2.LabelManagedBy: v1alpha2.ManagedByValue},
+		},
+		Data: map[string][]byte{
+			"keep":   []byte("same"),
+			"change": []byte("old"),
+			"remove": []byte("gone"),
+		},
+	}
+	client := fake.NewClientset(testProjectNS(), secret)
+	handler := NewProjectScopedHandler(NewK8sClient(client, testResolver()), nil)
+	ctx := contextWithImpersonatedClient(context.Background(), claims, client)
+
+	resp, err := handler.DiffSecret(ctx, connect.NewRequest(&consolev1.DiffSecretRequest{
+		Name:               "my-secret",
+		Project:            "test-namespace",
+		ProposedData:       map[string][]byte{"keep": []byte("same"), "change": []byte("longer")},
+		ProposedStringData: map[string]string{"add": "new"},
+	}))
+	if err != nil {
+		t.Fatalf("DiffSecret: %v", err)
+	}
+
+	want := []*consolev1.SecretKeyChange{
+		{Key: "add", Change: consolev1.ChangeType_CHANGE_TYPE_ADDED, NewSize: 3},
+		{Key: "change", Change: consolev1.ChangeType_CHANGE_TYPE_MODIFIED, OldSize: 3, NewSize: 6},
+		{Key: "remove", Change: consolev1.ChangeType_CHANGE_TYPE_REMOVED, OldSize: 4},
+	}
+	if len(resp.Msg.Changes) != len(want) {
+		t.Fatalf("expected %d changes, got %v", len(want), resp.Msg.Changes)
+	}
+	for i, w := range want {
+		got := resp.Msg.Changes[i]
+		if got.Key != w.Key || got.Change != w.Change || got.OldSize != w.OldSize || got.NewSize != w.NewSize {
+			t.Errorf("change %d: expected %v, got %v", i, w, got)
+		}
+	}
+	if resp.Msg.UnchangedKeys != 1 {
+		t.Errorf("expected 1 unchanged key, got %d", resp.Msg.UnchangedKeys)
+	}
+
+	stored, err := client.CoreV1().Secrets("prj-test-namespace").Get(context.Background(), "my-secret", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("getting secret: %v", err)
+	}
+	if string(stored.Data["change"]) != "old" {
+		t.Error("expected DiffSecret not to write the secret")
+	}
+}
@@ -0,0 +1,115 @@
+package secrets
+
+import (
+	"bytes"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"google.golang.org/protobuf/proto"
+
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+	"github.com/holos-run/holos-console/gen/holos/console/v1/consolev1connect"
+)
+
+// DownloadPath is the HTTP path of the secret key download endpoint.
+const DownloadPath = "/api/secrets/download"
+
+// NewDownloadHandler returns an http.Handler that serves a single secret key
+// as a file attachment, so browsers can save binary values such as .p12 and
+// .pem files. It accepts GET requests with project, name, key and an optional
+// cluster query parameter.
+//
+// The download is dispatched in-process to GetSecretKey on service, the
+// mounted SecretsService handler, so it passes through the same
+// authentication, impersonation, rate limiting and audit path as the RPC.
+// Connect errors are returned verbatim with their HTTP status.
+func NewDownloadHandler(service http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			w.Header().Set("Allow", http.MethodGet)
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		query := r.URL.Query()
+		msg := &consolev1.GetSecretKeyRequest{
+			Project: query.Get("project"),
+			Name:    query.Get("name"),
+			Key:     query.Get("key"),
+			Cluster: query.Get("cluster"),
+		}
+		body, err := proto.Marshal(msg)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		inner, err := http.NewRequestWithContext(r.Context(), http.MethodPost, consolev1connect.SecretsServiceGetSecretKeyProcedure, bytes.NewReader(body))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		// Forward credentials and request metadata, but negotiate an
+		// uncompressed binary protobuf exchange.
+		inner.Header = r.Header.Clone()
+		inner.Header.Del("Accept-Encoding")
+		inner.Header.Del("Connect-Accept-Encoding")
+		inner.Header.Set("Content-Type", "application/proto")
+		inner.Header.Set("Connect-Protocol-Version", "1")
+		inner.RemoteAddr = r.RemoteAddr
+
+		rec := &bufferedResponse{header: make(http.Header), status: http.StatusOK}
+		service.ServeHTTP(rec, inner)
+		if rec.status != http.StatusOK {
+			if ct := rec.header.Get("Content-Type"); ct != "" {
+				w.Header().Set("Content-Type", ct)
+			}
+			w.WriteHeader(rec.status)
+			_, _ = w.Write(rec.body.Bytes())
+			return
+		}
+
+		var resp consolev1.GetSecretKeyResponse
+		if err := proto.Unmarshal(rec.body.Bytes(), &resp); err != nil {
+			http.Error(w, "decoding secret key response: "+err.Error(), http.StatusBadGateway)
+			return
+		}
+		contentType := resp.ContentType
+		if contentType == "" {
+			contentType = DefaultContentType
+		}
+		disposition := mime.FormatMediaType("attachment", map[string]string{"filename": msg.Key})
+		if disposition == "" {
+			disposition = "attachment"
+		}
+		h := w.Header()
+		h.Set("Content-Type", contentType)
+		h.Set("Content-Disposition", disposition)
+		h.Set("Content-Length", strconv.Itoa(len(resp.Value)))
+		h.Set("Cache-Control", "no-store")
+		h.Set("X-Content-Type-Options", "nosniff")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(resp.Value)
+	})
+}
+
+// bufferedResponse captures the response of an in-process handler call.
+type bufferedResponse struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+	wrote  bool
+}
+
+func (b *bufferedResponse) Header() http.Header { return b.header }
+
+func (b *bufferedResponse) WriteHeader(status int) {
+	if !b.wrote {
+		b.status = status
+		b.wrote = true
+	}
+}
+
+func (b *bufferedResponse) Write(p []byte) (int, error) {
+	b.wrote = true
+	return b.body.Write(p)
+}
@@ -0,0 +1,119 @@
+package secrets
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"connectrpc.com/connect"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/holos-run/holos-console/console/rpc"
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+	"github.com/holos-run/holos-console/gen/holos/console/v1/consolev1connect"
+)
+
+// keystore is a value that is not valid UTF-8, standing in for a PKCS #12
+// file.
+var keystore = []byte{0x30, 0x82, 0x0a, 0xff, 0xfe, 0x00, 0x01, 0x80}
+
+func newDownloadServer(t *testing.T) (*httptest.Server, *Handler) {
+	t.Helper()
+	fakeClient := fake.NewClientset(testProjectNS())
+	handler := NewProjectScopedHandler(NewK8sClient(fakeClient, testResolver()), nil)
+	claims := connect.UnaryInterceptorFunc(func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			if req.Header().Get("Authorization") != "Bearer test" {
+				return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+			}
+			return next(rpc.ContextWithClaims(ctx, &rpc.Claims{Sub: "u1", Email: "user@example.com"}), req)
+		}
+	})
+	_, service := consolev1connect.NewSecretsServiceHandler(handler, connect.WithInterceptors(claims))
+	server := httptest.NewServer(NewDownloadHandler(service))
+	t.Cleanup(server.Close)
+	return server, handler
+}
+
+func download(t *testing.T, server *httptest.Server, method, query string) *http.Response {
+	t.Helper()
+	req, err := http.NewRequest(method, server.URL+DownloadPath+"?"+query, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Authorization", "Bearer test")
+	resp, err := server.Client().Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { resp.Body.Close() })
+	return resp
+}
+
+func TestDownloadHandler(t *testing.T) {
+	server, handler := newDownloadServer(t)
+	ctx := rpc.ContextWithClaims(context.Background(), &rpc.Claims{Sub: "u1", Email: "user@example.com"})
+	if _, err := handler.CreateSecret(ctx, connect.NewRequest(&consolev1.CreateSecretRequest{
+		Name:         "tls",
+		Project:      "test-namespace",
+		Data:         map[string][]byte{"keystore.p12": keystore, "ca.pem": []byte("-----BEGIN CERTIFICATE-----\n")},
+		ContentTypes: map[string]string{"keystore.p12": "application/x-pkcs12"},
+	})); err != nil {
+		t.Fatalf("CreateSecret: %v", err)
+	}
+
+	t.Run("serves binary value as attachment", func(t *testing.T) {
+		resp := download(t, server, http.MethodGet, "project=test-namespace&name=tls&key=keystore.p12")
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("expected 200, got %d", resp.StatusCode)
+		}
+		var body bytes.Buffer
+		if _, err := body.ReadFrom(resp.Body); err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(body.Bytes(), keystore) {
+			t.Errorf("body = %x, want %x", body.Bytes(), keystore)
+		}
+		if got := resp.Header.Get("Content-Type"); got != "application/x-pkcs12" {
+			t.Errorf("Content-Type = %q", got)
+		}
+		if got := resp.Header.Get("Content-Disposition"); got != "attachment; filename=keystore.p12" {
+			t.Errorf("Content-Disposition = %q", got)
+		}
+	})
+
+	t.Run("defaults content type", func(t *testing.T) {
+		resp := download(t, server, http.MethodGet, "project=test-namespace&name=tls&key=ca.pem")
+		if got := resp.Header.Get("Content-Type"); got != DefaultContentType {
+			t.Errorf("Content-Type = %q, want %q", got, DefaultContentType)
+		}
+	})
+
+	t.Run("passes through connect errors", func(t *testing.T) {
+		resp := download(t, server, http.MethodGet, "project=test-namespace&name=tls&key=missing")
+		if resp.StatusCode != http.StatusNotFound {
+			t.Errorf("expected 404, got %d", resp.StatusCode)
+		}
+	})
+
+	t.Run("requires the interceptor chain", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodGet, server.URL+DownloadPath+"?project=test-namespace&name=tls&key=ca.pem", nil)
+		resp, err := server.Client().Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusUnauthorized {
+			t.Errorf("expected 401, got %d", resp.StatusCode)
+		}
+	})
+
+	t.Run("rejects other methods", func(t *testing.T) {
+		resp := download(t, server, http.MethodPost, "project=test-namespace&name=tls&key=ca.pem")
+		if resp.StatusCode != http.StatusMethodNotAllowed {
+			t.Errorf("expected 405, got %d", resp.StatusCode)
+		}
+	})
+}
@@ -0,0 +1,174 @@
+package secrets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+
+	"connectrpc.com/connect"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1alpha2 "github.com/holos-run/holos-console/api/v1alpha2"
+	"github.com/holos-run/holos-console/console/envelope"
+	"github.com/holos-run/holos-console/console/rpc"
+)
+
+// ErrEncrypted is returned when a secret's values are encrypted at rest and
+// cannot be decrypted, e.g. because no key encryption key is configured or
+// the one that wrapped its data key was removed.
+var ErrEncrypted = errors.New("secret values are encrypted at rest")
+
+// encryptionKey returns the wrapped data key recorded on secret, if any.
+func encryptionKey(secret *corev1.Secret) (envelope.Key, bool, error) {
+	wrapped, ok := secret.Annotations[v1alpha2.AnnotationEncryptionKey]
+	if !ok {
+		return envelope.Key{}, false, nil
+	}
+	key, err := envelope.ParseKey(secret.Annotations[v1alpha2.AnnotationEncryptionKeyID], wrapped)
+	if err != nil {
+		return envelope.Key{}, false, fmt.Errorf("%w: secret %q: %w", ErrEncrypted, secret.Name, err)
+	}
+	return key, true, nil
+}
+
+// openSecret decrypts the values of secret in place as read from the API
+// server and removes the encryption annotations, so callers only ever see
+// plaintext. Values stored before encryption was enabled are returned as
+// they are.
+func (c *K8sClient) openSecret(ctx context.Context, secret *corev1.Secret) error {
+	key, ok, err := encryptionKey(secret)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		if envelope.Sealed(secret.Data) {
+			return fmt.Errorf("%w: secret %q has no data key", ErrEncrypted, secret.Name)
+		}
+		return nil
+	}
+	if envelope.Sealed(secret.Data) {
+		if c.Envelope == nil {
+			return fmt.Errorf("%w: secret %q: no key encryption key is configured", ErrEncrypted, secret.Name)
+		}
+		data, err := c.Envelope.Open(ctx, secret.Data, key, sealedID(secret))
+		if err != nil {
+			return fmt.Errorf("%w: secret %q: %w", ErrEncrypted, secret.Name, err)
+		}
+		secret.Data = data
+	}
+	delete(secret.Annotations, v1alpha2.AnnotationEncryptionKey)
+	delete(secret.Annotations, v1alpha2.AnnotationEncryptionKeyID)
+	return nil
+}
+
+// openListed decrypts only the value ListSecrets describes to every
+// caller: a TLS secret's certificate, or an SSH secret's public key, or
+// the private key it is derived from. Other values stay sealed, so listing
+// a project does not unwrap a data key per secret. The encryption
+// annotations are kept so the secret is never written back without them.
+func (c *K8sClient) openListed(ctx context.Context, secret *corev1.Secret) error {
+	name := listedKey(secret)
+	if name == "" || !envelope.IsSealed(secret.Data[name]) {
+		return nil
+	}
+	key, ok, err := encryptionKey(secret)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf("%w: secret %q has no data key", ErrEncrypted, secret.Name)
+	}
+	if c.Envelope == nil {
+		return fmt.Errorf("%w: secret %q: no key encryption key is configured", ErrEncrypted, secret.Name)
+	}
+	data, err := c.Envelope.Open(ctx, map[string][]byte{name: secret.Data[name]}, key, sealedID(secret))
+	if err != nil {
+		return fmt.Errorf("%w: secret %q: %w", ErrEncrypted, secret.Name, err)
+	}
+	secret.Data = maps.Clone(secret.Data)
+	secret.Data[name] = data[name]
+	return nil
+}
+
+// listedKey returns the data key openListed decrypts for secret, or "".
+func listedKey(secret *corev1.Secret) string {
+	switch secret.Type {
+	case corev1.SecretTypeTLS:
+		return corev1.TLSCertKey
+	case corev1.SecretTypeSSHAuth:
+		if _, ok := secret.Data[sshPublicKeyKey]; ok {
+			return sshPublicKeyKey
+		}
+		return corev1.SSHAuthPrivateKey
+	}
+	return ""
+}
+
+// sealedID identifies the Secret a sealed value belongs to.
+func sealedID(secret *corev1.Secret) string {
+	return secret.Namespace + "/" + secret.Name
+}
+
+// sealSecret returns a copy of secret with its values encrypted under key,
+// or under a new data key when key is nil. Without an Envelope secret is
+// returned unchanged. Values that start with the marker of sealed values
+// are rejected with CodeInvalidArgument either way, since reads would
+// mistake them for sealed ones.
+func (c *K8sClient) sealSecret(ctx context.Context, secret *corev1.Secret, key *envelope.Key) (*corev1.Secret, error) {
+	if err := envelope.CheckPlaintext(secret.Data); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if c.Envelope == nil {
+		return secret, nil
+	}
+	if key == nil {
+		newKey, err := c.Envelope.NewKey(ctx)
+		if err != nil {
+			return nil, err
+		}
+		key = &newKey
+	}
+	sealed := secret.DeepCopy()
+	data, err := c.Envelope.Seal(ctx, secret.Data, *key, sealedID(secret))
+	if err != nil {
+		return nil, err
+	}
+	sealed.Data = data
+	if sealed.Annotations == nil {
+		sealed.Annotations = map[string]string{}
+	}
+	sealed.Annotations[v1alpha2.AnnotationEncryptionKey] = key.String()
+	sealed.Annotations[v1alpha2.AnnotationEncryptionKeyID] = key.ID
+	return sealed, nil
+}
+
+// createSecretObject seals and creates secret, returning the created secret
+// with plaintext values.
+func (c *K8sClient) createSecretObject(ctx context.Context, secret *corev1.Secret) (*corev1.Secret, error) {
+	sealed, err := c.sealSecret(ctx, secret, nil)
+	if err != nil {
+		return nil, err
+	}
+	created, err := c.client.CoreV1().Secrets(secret.Namespace).Create(ctx, sealed, metav1.CreateOptions{DryRun: rpc.DryRunFromContext(ctx)})
+	if err != nil {
+		return nil, err
+	}
+	return created, c.openSecret(ctx, created)
+}
+
+// updateSecretObject seals secret, read by GetSecret and modified, under a
+// new data key and updates it, returning the updated secret with plaintext
+// values.
+func (c *K8sClient) updateSecretObject(ctx context.Context, secret *corev1.Secret) (*corev1.Secret, error) {
+	sealed, err := c.sealSecret(ctx, secret, nil)
+	if err != nil {
+		return nil, err
+	}
+	updated, err := c.client.CoreV1().Secrets(secret.Namespace).Update(ctx, sealed, metav1.UpdateOptions{DryRun: rpc.DryRunFromContext(ctx)})
+	if err != nil {
+		return nil, err
+	}
+	return updated, c.openSecret(ctx, updated)
+}
@@ -0,0 +1,218 @@
+package secrets
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"connectrpc.com/connect"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	v1alpha2 "github.com/holos-run/holos-console/api/v1alpha2"
+	"github.com/holos-run/holos-console/console/envelope"
+)
+
+func testWrapper(t *testing.T) *envelope.AESKeyWrapper {
+	t.Helper()
+	w, err := envelope.NewAESKeyWrapper(make([]byte, 32))
+	if err != nil {
+		t.Fatalf("NewAESKeyWrapper: %v", err)
+	}
+	return w
+}
+
+func testEnvelope(t *testing.T) *envelope.Envelope {
+	t.Helper()
+	return envelope.New(testWrapper(t))
+}
+
+// countingWrapper counts the data keys it unwraps.
+type countingWrapper struct {
+	envelope.KeyWrapper
+	unwraps int
+}
+
+func (w *countingWrapper) Unwrap(ctx context.Context, wrapped []byte) ([]byte, error) {
+	w.unwraps++
+	return w.KeyWrapper.Unwrap(ctx, wrapped)
+}
+
+func TestK8sClient_Envelope(t *testing.T) {
+	ctx := context.Background()
+	stored := func(t *testing.T, client *fake.Clientset, name string) *corev1.Secret {
+		t.Helper()
+		secret, err := client.CoreV1().Secrets("prj-test-namespace").Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			t.Fatalf("getting stored secret: %v", err)
+		}
+		return secret
+	}
+
+	t.Run("stores ciphertext and reads plaintext", func(t *testing.T) {
+		client := fake.NewClientset(testProjectNS())
+		k8s := NewK8sClient(client, testResolver())
+		k8s.Envelope = testEnvelope(t)
+		created, err := k8s.CreateSecret(ctx, "test-namespace", "db-creds", map[string][]byte{"password": []byte("hunter2")}, nil, nil, "", "", "", "", nil, "")
+		if err != nil {
+			t.Fatalf("CreateSecret: %v", err)
+		}
+		if string(created.Data["password"]) != "hunter2" {
+			t.Errorf("expected CreateSecret to return plaintext, got %q", created.Data["password"])
+		}
+		raw := stored(t, client, "db-creds")
+		if !envelope.IsSealed(raw.Data["password"]) {
+			t.Errorf("expected the stored value to be encrypted, got %q", raw.Data["password"])
+		}
+		if raw.Annotations[v1alpha2.AnnotationEncryptionKey] == "" {
+			t.Error("expected the wrapped data key to be stored")
+		}
+
+		if _, err := k8s.UpdateSecret(ctx, "test-namespace", "db-creds", map[string][]byte{"password": []byte("new")}, nil, nil, nil); err != nil {
+			t.Fatalf("UpdateSecret: %v", err)
+		}
+		got, err := k8s.GetSecret(ctx, "test-namespace", "db-creds")
+		if err != nil {
+			t.Fatalf("GetSecret: %v", err)
+		}
+		if string(got.Data["password"]) != "new" {
+			t.Errorf("expected the updated plaintext, got %q", got.Data["password"])
+		}
+		if _, ok := got.Annotations[v1alpha2.AnnotationEncryptionKey]; ok {
+			t.Error("expected the encryption annotations to be hidden from readers")
+		}
+	})
+
+	t.Run("lists without unwrapping data keys", func(t *testing.T) {
+		client := fake.NewClientset(testProjectNS())
+		k8s := NewK8sClient(client, testResolver())
+		k8s.Envelope = testEnvelope(t)
+		if _, err := k8s.CreateSecret(ctx, "test-namespace", "db-creds", map[string][]byte{"password": []byte("hunter2")}, nil, nil, "", "", "", "", nil, ""); err != nil {
+			t.Fatalf("CreateSecret: %v", err)
+		}
+		w := &countingWrapper{KeyWrapper: testWrapper(t)}
+		k8s.Envelope = envelope.New(w)
+		list, err := k8s.ListSecrets(ctx, "test-namespace")
+		if err != nil {
+			t.Fatalf("ListSecrets: %v", err)
+		}
+		if len(list.Items) != 1 || !envelope.IsSealed(list.Items[0].Data["password"]) {
+			t.Errorf("expected ListSecrets to leave values sealed, got %+v", list.Items)
+		}
+		if w.unwraps != 0 {
+			t.Errorf("expected no data key to be unwrapped, got %d", w.unwraps)
+		}
+	})
+
+	t.Run("lists TLS certificates in plaintext", func(t *testing.T) {
+		client := fake.NewClientset(testProjectNS())
+		k8s := NewK8sClient(client, testResolver())
+		k8s.Envelope = testEnvelope(t)
+		if _, err := k8s.CreateSecret(ctx, "test-namespace", "tls", map[string][]byte{corev1.TLSCertKey: []byte("cert"), corev1.TLSPrivateKeyKey: []byte("key")}, nil, nil, "", "", "", "", nil, corev1.SecretTypeTLS); err != nil {
+			t.Fatalf("CreateSecret: %v", err)
+		}
+		list, err := k8s.ListSecrets(ctx, "test-namespace")
+		if err != nil {
+			t.Fatalf("ListSecrets: %v", err)
+		}
+		got := list.Items[0].Data
+		if string(got[corev1.TLSCertKey]) != "cert" || !envelope.IsSealed(got[corev1.TLSPrivateKeyKey]) {
+			t.Errorf("expected only the certificate to be decrypted, got %v", got)
+		}
+	})
+
+	t.Run("refuses values copied from another secret", func(t *testing.T) {
+		client := fake.NewClientset(testProjectNS())
+		k8s := NewK8sClient(client, testResolver())
+		k8s.Envelope = testEnvelope(t)
+		for _, name := range []string{"db-creds", "other"} {
+			if _, err := k8s.CreateSecret(ctx, "test-namespace", name, map[string][]byte{"password": []byte(name)}, nil, nil, "", "", "", "", nil, ""); err != nil {
+				t.Fatalf("CreateSecret: %v", err)
+			}
+		}
+		src, dst := stored(t, client, "db-creds"), stored(t, client, "other")
+		dst.Data = src.Data
+		dst.Annotations[v1alpha2.AnnotationEncryptionKey] = src.Annotations[v1alpha2.AnnotationEncryptionKey]
+		if _, err := client.CoreV1().Secrets("prj-test-namespace").Update(ctx, dst, metav1.UpdateOptions{}); err != nil {
+			t.Fatalf("copying secret: %v", err)
+		}
+		if _, err := k8s.GetSecret(ctx, "test-namespace", "other"); !errors.Is(err, ErrEncrypted) {
+			t.Fatalf("expected ErrEncrypted, got %v", err)
+		}
+	})
+
+	t.Run("rejects plaintext that looks encrypted", func(t *testing.T) {
+		for _, env := range []*envelope.Envelope{nil, testEnvelope(t)} {
+			client := fake.NewClientset(testProjectNS())
+			k8s := NewK8sClient(client, testResolver())
+			k8s.Envelope = env
+			_, err := k8s.CreateSecret(ctx, "test-namespace", "db-creds", map[string][]byte{"note": []byte("\x00hce1:not encrypted")}, nil, nil, "", "", "", "", nil, "")
+			if connect.CodeOf(err) != connect.CodeInvalidArgument {
+				t.Errorf("expected InvalidArgument with envelope %v, got %v", env != nil, err)
+			}
+		}
+	})
+
+	t.Run("reads and encrypts plaintext secrets", func(t *testing.T) {
+		client := fake.NewClientset(testProjectNS(), &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "legacy",
+				Namespace: "prj-test-namespace",
+				Labels:    map[string]string{v1alpha2.LabelManagedBy: v1alpha2.ManagedByValue},
+			},
+			Data: map[string][]byte{"a": []byte("1"), "b": []byte("2")},
+		})
+		k8s := NewK8sClient(client, testResolver())
+		k8s.Envelope = testEnvelope(t)
+		got, err := k8s.GetSecret(ctx, "test-namespace", "legacy")
+		if err != nil {
+			t.Fatalf("GetSecret: %v", err)
+		}
+		if string(got.Data["a"]) != "1" {
+			t.Errorf("expected the plaintext value, got %q", got.Data["a"])
+		}
+		if _, err := k8s.PatchSecret(ctx, "test-namespace", "legacy", map[string][]byte{"a": []byte("3")}, nil, nil); err != nil {
+			t.Fatalf("PatchSecret: %v", err)
+		}
+		raw := stored(t, client, "legacy")
+		if !envelope.IsSealed(raw.Data["a"]) || !envelope.IsSealed(raw.Data["b"]) {
+			t.Errorf("expected every value to be encrypted on write, got %v", raw.Data)
+		}
+	})
+
+	t.Run("applies under the existing data key", func(t *testing.T) {
+		client := fake.NewClientset(testProjectNS())
+		k8s := NewK8sClient(client, testResolver())
+		k8s.Envelope = testEnvelope(t)
+		if _, err := k8s.CreateSecret(ctx, "test-namespace", "db-creds", map[string][]byte{"user": []byte("admin")}, nil, nil, "", "", "", "", nil, ""); err != nil {
+			t.Fatalf("CreateSecret: %v", err)
+		}
+		if _, err := k8s.ApplySecret(ctx, "test-namespace", &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "db-creds"},
+			Data:       map[string][]byte{"password": []byte("hunter2")},
+		}); err != nil {
+			t.Fatalf("ApplySecret: %v", err)
+		}
+		got, err := k8s.GetSecret(ctx, "test-namespace", "db-creds")
+		if err != nil {
+			t.Fatalf("GetSecret: %v", err)
+		}
+		if string(got.Data["user"]) != "admin" || string(got.Data["password"]) != "hunter2" {
+			t.Errorf("expected both keys to decrypt, got %v", got.Data)
+		}
+	})
+
+	t.Run("refuses to read without the key", func(t *testing.T) {
+		client := fake.NewClientset(testProjectNS())
+		k8s := NewK8sClient(client, testResolver())
+		k8s.Envelope = testEnvelope(t)
+		if _, err := k8s.CreateSecret(ctx, "test-namespace", "db-creds", map[string][]byte{"password": []byte("hunter2")}, nil, nil, "", "", "", "", nil, ""); err != nil {
+			t.Fatalf("CreateSecret: %v", err)
+		}
+		_, err := NewK8sClient(client, testResolver()).GetSecret(ctx, "test-namespace", "db-creds")
+		if !errors.Is(err, ErrEncrypted) {
+			t.Fatalf("expected ErrEncrypted, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,93 @@
+package secrets
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+)
+
+const (
+	// defaultGenerateLength is used when a GenerateSpec leaves length unset.
+	defaultGenerateLength = 32
+	// maxGenerateLength bounds the size of a single generated value.
+	maxGenerateLength = 4096
+	// defaultPasswordCharset is used when a password GenerateSpec leaves
+	// charset unset.
+	defaultPasswordCharset = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789!#$%&*+-=?@^_"
+)
+
+// GenerateValue returns a random value described by spec using crypto/rand.
+// A nil spec generates a default password.
+func GenerateValue(spec *consolev1.GenerateSpec) (string, error) {
+	length := int(spec.GetLength())
+	if length < 0 || length > maxGenerateLength {
+		return "", fmt.Errorf("length must be between 1 and %d, got %d", maxGenerateLength, length)
+	}
+	if length == 0 {
+		length = defaultGenerateLength
+	}
+	switch spec.GetFormat() {
+	case consolev1.GenerateFormat_GENERATE_FORMAT_UNSPECIFIED, consolev1.GenerateFormat_GENERATE_FORMAT_PASSWORD:
+		charset := spec.GetCharset()
+		if charset == "" {
+			charset = defaultPasswordCharset
+		}
+		return randomString([]rune(charset), length)
+	case consolev1.GenerateFormat_GENERATE_FORMAT_HEX:
+		b, err := randomBytes(length)
+		if err != nil {
+			return "", err
+		}
+		return hex.EncodeToString(b), nil
+	case consolev1.GenerateFormat_GENERATE_FORMAT_BASE64:
+		b, err := randomBytes(length)
+		if err != nil {
+			return "", err
+		}
+		return base64.StdEncoding.EncodeToString(b), nil
+	case consolev1.GenerateFormat_GENERATE_FORMAT_UUID:
+		return randomUUID()
+	default:
+		return "", fmt.Errorf("unsupported format %v", spec.GetFormat())
+	}
+}
+
+func randomBytes(n int) ([]byte, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return nil, fmt.Errorf("reading random bytes: %w", err)
+	}
+	return b, nil
+}
+
+// randomString draws length runes uniformly from charset.
+func randomString(charset []rune, length int) (string, error) {
+	if len(charset) < 2 {
+		return "", fmt.Errorf("charset must contain at least 2 characters")
+	}
+	size := big.NewInt(int64(len(charset)))
+	out := make([]rune, length)
+	for i := range out {
+		n, err := rand.Int(rand.Reader, size)
+		if err != nil {
+			return "", fmt.Errorf("reading random index: %w", err)
+		}
+		out[i] = charset[n.Int64()]
+	}
+	return string(out), nil
+}
+
+// randomUUID returns an RFC 9562 version 4 UUID.
+func randomUUID() (string, error) {
+	b, err := randomBytes(16)
+	if err != nil {
+		return "", err
+	}
+	b[6] = (b[6] & 0x0f) | 0x40 // version 4
+	b[8] = (b[8] & 0x3f) | 0x80 // RFC 9562 variant
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16]), nil
+}
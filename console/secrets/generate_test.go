@@ -0,0 +1,135 @@
+package secrets
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"regexp"
+	"strings"
+	"testing"
+
+	"connectrpc.com/connect"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/holos-run/holos-console/console/rpc"
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+)
+
+var uuidPattern = regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`)
+
+func TestGenerateValue(t *testing.T) {
+	cases := []struct {
+		name    string
+		spec    *consolev1.GenerateSpec
+		check   func(t *testing.T, value string)
+		wantErr bool
+	}{
+		{
+			name: "default password",
+			spec: nil,
+			check: func(t *testing.T, value string) {
+				if len(value) != defaultGenerateLength {
+					t.Errorf("expected %d characters, got %d", defaultGenerateLength, len(value))
+				}
+			},
+		},
+		{
+			name: "password with charset",
+			spec: &consolev1.GenerateSpec{Format: consolev1.GenerateFormat_GENERATE_FORMAT_PASSWORD, Length: 64, Charset: "ab"},
+			check: func(t *testing.T, value string) {
+				if len(value) != 64 || strings.Trim(value, "ab") != "" {
+					t.Errorf("expected 64 characters from [ab], got %q", value)
+				}
+			},
+		},
+		{
+			name: "hex",
+			spec: &consolev1.GenerateSpec{Format: consolev1.GenerateFormat_GENERATE_FORMAT_HEX, Length: 16},
+			check: func(t *testing.T, value string) {
+				if b, err := hex.DecodeString(value); err != nil || len(b) != 16 {
+					t.Errorf("expected 16 hex-encoded bytes, got %q", value)
+				}
+			},
+		},
+		{
+			name: "base64",
+			spec: &consolev1.GenerateSpec{Format: consolev1.GenerateFormat_GENERATE_FORMAT_BASE64, Length: 24},
+			check: func(t *testing.T, value string) {
+				if b, err := base64.StdEncoding.DecodeString(value); err != nil || len(b) != 24 {
+					t.Errorf("expected 24 base64-encoded bytes, got %q", value)
+				}
+			},
+		},
+		{
+			name: "uuid",
+			spec: &consolev1.GenerateSpec{Format: consolev1.GenerateFormat_GENERATE_FORMAT_UUID},
+			check: func(t *testing.T, value string) {
+				if !uuidPattern.MatchString(value) {
+					t.Errorf("expected version 4 UUID, got %q", value)
+				}
+			},
+		},
+		{name: "length too large", spec: &consolev1.GenerateSpec{Length: maxGenerateLength + 1}, wantErr: true},
+		{name: "negative length", spec: &consolev1.GenerateSpec{Length: -1}, wantErr: true},
+		{name: "single character charset", spec: &consolev1.GenerateSpec{Charset: "a"}, wantErr: true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			value, err := GenerateValue(tc.spec)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got %q", value)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("GenerateValue: %v", err)
+			}
+			tc.check(t, value)
+		})
+	}
+}
+
+func TestHandler_CreateSecret_Generate(t *testing.T) {
+	client := fake.NewClientset(testProjectNS())
+	handler := NewProjectScopedHandler(NewK8sClient(client, testResolver()), nil)
+	ctx := contextWithImpersonatedClient(context.Background(), &rpc.Claims{Sub: "user-123", Email: "user@example.com"}, client)
+
+	resp, err := handler.CreateSecret(ctx, connect.NewRequest(&consolev1.CreateSecretRequest{
+		Name:       "db-creds",
+		Project:    "test-namespace",
+		StringData: map[string]string{"username": "admin"},
+		Generate: map[string]*consolev1.GenerateSpec{
+			"password": {Format: consolev1.GenerateFormat_GENERATE_FORMAT_PASSWORD, Length: 20},
+		},
+	}))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	generated := resp.Msg.GeneratedValues["password"]
+	if len(generated) != 20 {
+		t.Fatalf("expected a 20 character generated password, got %q", generated)
+	}
+
+	stored, err := client.CoreV1().Secrets("prj-test-namespace").Get(ctx, "db-creds", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(stored.Data["password"]) != generated {
+		t.Errorf("expected stored password to match the generated value")
+	}
+	if string(stored.Data["username"]) != "admin" {
+		t.Errorf("expected supplied username to be stored, got %q", stored.Data["username"])
+	}
+
+	_, err = handler.CreateSecret(ctx, connect.NewRequest(&consolev1.CreateSecretRequest{
+		Name:       "conflict",
+		Project:    "test-namespace",
+		StringData: map[string]string{"password": "supplied"},
+		Generate:   map[string]*consolev1.GenerateSpec{"password": {}},
+	}))
+	if connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Fatalf("expected InvalidArgument for supplied and generated key, got %v", err)
+	}
+}
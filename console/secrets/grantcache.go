@@ -0,0 +1,82 @@
+package secrets
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/holos-run/holos-console/console/rpc"
+)
+
+// grantCacheTTL bounds how long a caller's view of a project's sharing
+// grants is reused. The cache is local to each console replica: changes
+// made through a replica are seen there at once, while other replicas, and
+// bindings changed elsewhere, e.g. by an organization membership update,
+// catch up within the TTL. Only listings use the cache; reading a secret
+// is authorized by the API server and never sees a stale grant.
+const grantCacheTTL = 5 * time.Second
+
+type grantCacheKey struct {
+	iss, sub, cluster, project string
+}
+
+type grantCacheEntry struct {
+	users, roles []AnnotationGrant
+	expires      time.Time
+}
+
+// grantCache remembers the project sharing grants each caller resolved, so
+// the listings and reads a page issues together list the project's
+// RoleBindings once. Entries are kept per caller because the bindings are
+// listed with the caller's impersonated client.
+type grantCache struct {
+	now func() time.Time
+
+	mu      sync.Mutex
+	entries map[grantCacheKey]grantCacheEntry
+}
+
+func newGrantCache() *grantCache {
+	return &grantCache{now: time.Now, entries: map[grantCacheKey]grantCacheEntry{}}
+}
+
+// projectGrants returns the sharing grants of project as seen by claims,
+// listing them with k8s when the cache holds no fresh entry.
+func (c *grantCache) projectGrants(ctx context.Context, k8s *K8sClient, claims *rpc.Claims, project string) ([]AnnotationGrant, []AnnotationGrant, error) {
+	key := grantCacheKey{iss: claims.Iss, sub: claims.Sub, cluster: rpc.ClusterFromContext(ctx), project: project}
+	c.mu.Lock()
+	entry, ok := c.entries[key]
+	c.mu.Unlock()
+	if ok && c.now().Before(entry.expires) {
+		return slices.Clone(entry.users), slices.Clone(entry.roles), nil
+	}
+	users, roles, err := k8s.ListSharing(ctx, project)
+	if err != nil {
+		return nil, nil, err
+	}
+	now := c.now()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	// Drop expired entries so callers who have gone away do not pile up.
+	for k, e := range c.entries {
+		if !now.Before(e.expires) {
+			delete(c.entries, k)
+		}
+	}
+	c.entries[key] = grantCacheEntry{users: users, roles: roles, expires: now.Add(grantCacheTTL)}
+	return slices.Clone(users), slices.Clone(roles), nil
+}
+
+// invalidate drops every caller's entry for project after its grants
+// changed. It only reaches this replica's cache.
+func (c *grantCache) invalidate(ctx context.Context, project string) {
+	cluster := rpc.ClusterFromContext(ctx)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for k := range c.entries {
+		if k.project == project && k.cluster == cluster {
+			delete(c.entries, k)
+		}
+	}
+}
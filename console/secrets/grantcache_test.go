@@ -0,0 +1,120 @@
+package secrets
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	v1alpha2 "github.com/holos-run/holos-console/api/v1alpha2"
+	"github.com/holos-run/holos-console/console/rpc"
+	"github.com/holos-run/holos-console/console/secretrbac"
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+)
+
+func TestHandler_GrantCache(t *testing.T) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "db",
+			Namespace: "prj-test-namespace",
+			Labels:    map[string]string{v1alpha2.LabelManagedBy: v1alpha2.ManagedByValue},
+		},
+		Data: map[string][]byte{"password": []byte("hunter2")},
+	}
+	fakeClient := fake.NewClientset(testProjectNS(), secret,
+		secretrbac.RoleBinding("prj-test-namespace", secretrbac.ShareTargetUser, "alice@example.com", secretrbac.RoleOwner, nil))
+	handler := NewProjectScopedHandler(NewK8sClient(fakeClient, testResolver()), nil)
+	now := time.Now()
+	handler.grants.now = func() time.Time { return now }
+	alice := rpc.ContextWithClaims(context.Background(), &rpc.Claims{Sub: "alice-sub", Email: "alice@example.com"})
+	bob := rpc.ContextWithClaims(context.Background(), &rpc.Claims{Sub: "bob-sub", Email: "bob@example.com"})
+
+	lookups := func() int {
+		n := 0
+		for _, a := range fakeClient.Actions() {
+			if a.Matches("list", "rolebindings") {
+				n++
+			}
+		}
+		return n
+	}
+	list := func(t *testing.T, ctx context.Context) *consolev1.ListSecretsResponse {
+		t.Helper()
+		resp, err := handler.ListSecrets(ctx, connect.NewRequest(&consolev1.ListSecretsRequest{Project: "test-namespace"}))
+		if err != nil {
+			t.Fatalf("ListSecrets: %v", err)
+		}
+		return resp.Msg
+	}
+
+	t.Run("listings share one lookup", func(t *testing.T) {
+		before := lookups()
+		if got := list(t, alice).Secrets[0].UserRole; got != consolev1.Role_ROLE_OWNER {
+			t.Errorf("expected owner, got %v", got)
+		}
+		list(t, alice)
+		if got := lookups() - before; got != 1 {
+			t.Errorf("expected 1 grant lookup, got %d", got)
+		}
+	})
+
+	t.Run("a read makes no lookup", func(t *testing.T) {
+		handler.grants.invalidate(context.Background(), "test-namespace")
+		before := lookups()
+		if _, err := handler.GetSecret(alice, connect.NewRequest(&consolev1.GetSecretRequest{Name: "db", Project: "test-namespace"})); err != nil {
+			t.Fatalf("GetSecret: %v", err)
+		}
+		if got := lookups() - before; got != 0 {
+			t.Errorf("expected no grant lookup, got %d", got)
+		}
+	})
+
+	t.Run("each caller resolves their own grants", func(t *testing.T) {
+		before := lookups()
+		if got := list(t, bob).Secrets[0].UserRole; got != consolev1.Role_ROLE_UNSPECIFIED {
+			t.Errorf("expected no role for bob, got %v", got)
+		}
+		if got := lookups() - before; got != 1 {
+			t.Errorf("expected bob's listing to look grants up, got %d lookups", got)
+		}
+	})
+
+	t.Run("entries expire", func(t *testing.T) {
+		before := lookups()
+		now = now.Add(grantCacheTTL)
+		list(t, alice)
+		if got := lookups() - before; got != 1 {
+			t.Errorf("expected an expired entry to be looked up again, got %d lookups", got)
+		}
+		list(t, alice)
+		if got := lookups() - before; got != 1 {
+			t.Errorf("expected the refreshed entry to be reused, got %d lookups", got)
+		}
+	})
+
+	t.Run("sharing updates are seen at once", func(t *testing.T) {
+		list(t, bob)
+		if _, err := handler.UpdateSharing(alice, connect.NewRequest(&consolev1.UpdateSharingRequest{
+			Name:    "db",
+			Project: "test-namespace",
+			UserGrants: []*consolev1.ShareGrant{
+				{Principal: "alice@example.com", Role: consolev1.Role_ROLE_OWNER},
+				{Principal: "bob@example.com", Role: consolev1.Role_ROLE_VIEWER},
+			},
+		})); err != nil {
+			t.Fatalf("UpdateSharing: %v", err)
+		}
+		got := list(t, bob).Secrets[0]
+		if got.UserRole != consolev1.Role_ROLE_VIEWER {
+			t.Errorf("expected bob to see the new viewer grant, got %v", got.UserRole)
+		}
+		if !slices.ContainsFunc(got.UserGrants, func(g *consolev1.ShareGrant) bool { return g.Principal == "bob@example.com" }) {
+			t.Errorf("expected bob's grant in the listing, got %v", got.UserGrants)
+		}
+	})
+}
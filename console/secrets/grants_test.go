@@ -2,6 +2,7 @@ package secrets
 
 import (
 	"testing"
+	"time"
 )
 
 func TestDeduplicateGrants_MergesDuplicates(t *testing.T) {
@@ -88,3 +89,27 @@ func TestDeduplicateGrants_EmptyPrincipal(t *testing.T) {
 		t.Errorf("expected principal alice@example.com, got %s", result[0].Principal)
 	}
 }
+
+func TestHasActiveOwner(t *testing.T) {
+	now := time.Now()
+	past := now.Add(-time.Hour).Unix()
+	tests := []struct {
+		name  string
+		users []AnnotationGrant
+		roles []AnnotationGrant
+		want  bool
+	}{
+		{name: "user owner", users: []AnnotationGrant{{Principal: "alice@example.com", Role: "owner"}}, want: true},
+		{name: "role owner", roles: []AnnotationGrant{{Principal: "platform", Role: "owner"}}, want: true},
+		{name: "no owner", users: []AnnotationGrant{{Principal: "alice@example.com", Role: "editor"}}, want: false},
+		{name: "expired owner", users: []AnnotationGrant{{Principal: "alice@example.com", Role: "owner", Exp: &past}}, want: false},
+		{name: "empty", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := HasActiveOwner(tt.users, tt.roles, now); got != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
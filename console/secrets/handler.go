@@ -3,15 +3,25 @@ package secrets
 import (
 	"context"
 	"encoding/json"
+	stderrors "errors"
 	"fmt"
 	"log/slog"
+	"maps"
+	"net/http"
+	"slices"
 	"strings"
 	"time"
 
 	"connectrpc.com/connect"
+	"golang.org/x/sync/errgroup"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 
+	v1alpha2 "github.com/holos-run/holos-console/api/v1alpha2"
+	"github.com/holos-run/holos-console/console/listfilter"
+	"github.com/holos-run/holos-console/console/maintenance"
+	"github.com/holos-run/holos-console/console/rawobject"
+	"github.com/holos-run/holos-console/console/rbac"
 	"github.com/holos-run/holos-console/console/rpc"
 	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
 	"github.com/holos-run/holos-console/gen/holos/console/v1/consolev1connect"
@@ -36,12 +46,43 @@ type Handler struct {
 	consolev1connect.UnimplementedSecretsServiceHandler
 	k8s             *K8sClient
 	projectResolver ProjectResolver
+	webhookClient   *http.Client
+	trashRetention  time.Duration
+	sealer          *Sealer
+	backend         Backend
+	inviter         Inviter
+	shareLinks      *ShareLinks
+	metrics         *SecretMetrics
+	grants          *grantCache
+	maintenance     *maintenance.Mode
 }
 
 // NewProjectScopedHandler creates a SecretsService handler that resolves access
 // from project grants when per-secret grants are insufficient.
 func NewProjectScopedHandler(k8s *K8sClient, projectResolver ProjectResolver) *Handler {
-	return &Handler{k8s: k8s, projectResolver: projectResolver}
+	return &Handler{
+		k8s:             k8s,
+		projectResolver: projectResolver,
+		webhookClient:   &http.Client{Timeout: defaultWebhookTimeout},
+		grants:          newGrantCache(),
+	}
+}
+
+// WithWebhookClient sets the HTTP client used to call rotation webhooks.
+func (h *Handler) WithWebhookClient(c *http.Client) *Handler {
+	h.webhookClient = c
+	return h
+}
+
+// WithMaintenance sets the maintenance mode that suspends access tracking:
+// while it is enabled reads must not write to the cluster.
+func (h *Handler) WithMaintenance(m *maintenance.Mode) *Handler {
+	h.maintenance = m
+	return h
+}
+
+func (h *Handler) inMaintenance() bool {
+	return h.maintenance != nil && h.maintenance.State().Enabled
 }
 
 // ListSecrets returns all secrets with accessibility info for the current user.
@@ -50,31 +91,95 @@ func (h *Handler) ListSecrets(
 	req *connect.Request[consolev1.ListSecretsRequest],
 ) (*connect.Response[consolev1.ListSecretsResponse], error) {
 	// Get claims from context (set by AuthInterceptor)
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	claims := rpc.MustClaims(ctx)
+	filter, err := listfilter.New("filter", req.Msg.Filter)
+	if err != nil {
+		return nil, err
+	}
+	order, err := listfilter.NewOrder("order_by", req.Msg.OrderBy)
+	if err != nil {
+		return nil, err
 	}
 
 	project := req.Msg.Project
-	if project == "" {
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("project is required"))
-	}
 
+	// The secrets and the project's sharing grants are independent reads, so
+	// fetch them concurrently; the grants are resolved once per request,
+	// shared by every secret in the listing, and cached briefly for the
+	// caller's next request.
 	k8s := h.requestK8s(ctx)
-	secretList, err := k8s.ListSecrets(ctx, project)
-	if err != nil {
+	var (
+		secretList             *corev1.SecretList
+		shareUsers, shareRoles []AnnotationGrant
+	)
+	g, gctx := errgroup.WithContext(ctx)
+	g.Go(func() (err error) {
+		secretList, err = k8s.ListSecrets(gctx, project)
+		return err
+	})
+	g.Go(func() (err error) {
+		shareUsers, shareRoles, err = h.grants.projectGrants(gctx, k8s, claims, project)
+		return err
+	})
+	if err := g.Wait(); err != nil {
 		return nil, mapK8sError(err)
 	}
-	shareUsers, shareRoles, err := k8s.ListSharing(ctx, project)
+	// The tag is derived from the secrets' resource versions and the
+	// grants, so an unchanged listing is answered before any metadata is
+	// built.
+	grants, err := json.Marshal([][]AnnotationGrant{shareUsers, shareRoles})
 	if err != nil {
-		return nil, mapK8sError(err)
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	versions := []string{string(grants)}
+	for i := range secretList.Items {
+		versions = append(versions, rpc.ObjectVersion(&secretList.Items[i]))
+	}
+	etag, err := rpc.ReadETag(claims, req.Msg, versions...)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if rpc.NotModified(req.Msg.IfNoneMatch, etag) {
+		res := connect.NewResponse(&consolev1.ListSecretsResponse{Etag: etag, NotModified: true})
+		rpc.SetETag(res.Header(), etag)
+		return res, nil
 	}
+	displayUsers := displayUserGrants(shareUsers, claims)
 
 	var secrets []*consolev1.SecretMetadata
+	accessibleCount := 0
+	now := time.Now()
+	// Secret sharing grants are bound per project, so the caller's role is
+	// the same for every secret a deny grant does not exclude them from.
+	projectRole, projectRoleSource := callerRole(claims, project, shareUsers, shareRoles, now)
+	listfilter.Sort(order, secretList.Items, func(s corev1.Secret) listfilter.SortKey {
+		return listfilter.KeyOf(s.Name, &s)
+	})
 	for _, secret := range secretList.Items {
-		metadata := h.buildSecretMetadata(&secret, displayUserGrants(shareUsers, claims), shareRoles, true)
+		accessible := !DenyGrantMatches(&secret, claims.Email, claims.Sub, claims.EmailVerified, claims.Roles, now)
+		role := projectRole
+		if !accessible {
+			role = consolev1.Role_ROLE_UNSPECIFIED
+		}
+		if !filter.Match(listfilter.Item{
+			Name:        secret.Name,
+			Description: GetDescription(&secret),
+			Labels:      secret.Labels,
+			Role:        role,
+			Accessible:  accessible,
+		}) {
+			continue
+		}
+		if accessible {
+			accessibleCount++
+		}
+		metadata := h.buildSecretMetadata(&secret, displayUsers, shareRoles, accessible)
+		if accessible {
+			metadata.UserRole, metadata.UserRoleSource = projectRole, projectRoleSource
+		}
 		secrets = append(secrets, metadata)
 	}
+	h.markPending(ctx, secrets...)
 
 	slog.InfoContext(ctx, "secrets listed",
 		slog.String("action", "secrets_list"),
@@ -83,42 +188,69 @@ func (h *Handler) ListSecrets(
 		slog.String("sub", claims.Sub),
 		slog.String("email", claims.Email),
 		slog.Int("total", len(secrets)),
-		slog.Int("accessible", len(secrets)),
+		slog.Int("accessible", accessibleCount),
 	)
 
-	return connect.NewResponse(&consolev1.ListSecretsResponse{
-		Secrets: secrets,
-	}), nil
+	res := connect.NewResponse(&consolev1.ListSecretsResponse{Secrets: secrets, Etag: etag})
+	rpc.SetETag(res.Header(), etag)
+	return res, nil
 }
 
 // GetSecret retrieves a secret by name with RBAC authorization.
 func (h *Handler) GetSecret(
 	ctx context.Context,
 	req *connect.Request[consolev1.GetSecretRequest],
-) (*connect.Response[consolev1.GetSecretResponse], error) {
+) (resp *connect.Response[consolev1.GetSecretResponse], err error) {
+	defer func() { h.metrics.observeRead("GetSecret", req.Msg.Project, req.Msg.Name, err) }()
 	// Validate request
-	if req.Msg.Name == "" {
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("secret name is required"))
-	}
 
 	project := req.Msg.Project
-	if project == "" {
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("project is required"))
-	}
 
 	// Get claims from context (set by AuthInterceptor)
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
-	}
+	claims := rpc.MustClaims(ctx)
 
 	// Get secret from Kubernetes
 	secret, err := h.requestK8s(ctx).GetSecret(ctx, project, req.Msg.Name)
 	if err != nil {
 		return nil, mapK8sError(err)
 	}
+	if err := checkDenied(ctx, claims, secret, project); err != nil {
+		return nil, err
+	}
+	if err := h.readBackend(ctx, secret); err != nil {
+		return nil, err
+	}
+	// Access tracking is written by the console service account because the
+	// caller may only hold read access. It is best effort: a failed stamp
+	// must not hide the secret from a caller allowed to read it. It is
+	// skipped in maintenance mode, which keeps reads from writing.
+	if !h.inMaintenance() {
+		if err := h.k8s.RecordAccess(ctx, secret, claims.Email, time.Now()); err != nil {
+			slog.WarnContext(ctx, "recording secret access failed",
+				slog.String("project", project),
+				slog.String("secret", secret.Name),
+				slog.Any("error", err),
+			)
+		}
+	}
 
-	return h.returnSecret(ctx, claims, secret, project)
+	resp, err = h.returnSecret(ctx, claims, secret, project)
+	if err != nil {
+		return nil, err
+	}
+	// Backend values change without touching the Secret, so only secrets
+	// holding their own data get an etag. It is derived from the resource
+	// version so the tag reveals nothing about the values.
+	if BackendPath(secret) == "" {
+		etag := rpc.ETagOf(string(secret.UID), secret.ResourceVersion)
+		if rpc.NotModified(req.Msg.IfNoneMatch, etag) {
+			resp.Msg.Data = nil
+			resp.Msg.NotModified = true
+		}
+		resp.Msg.Etag = etag
+		rpc.SetETag(resp.Header(), etag)
+	}
+	return resp, nil
 }
 
 // DeleteSecret deletes a secret with RBAC authorization.
@@ -127,24 +259,39 @@ func (h *Handler) DeleteSecret(
 	req *connect.Request[consolev1.DeleteSecretRequest],
 ) (*connect.Response[consolev1.DeleteSecretResponse], error) {
 	// Validate request
-	if req.Msg.Name == "" {
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("secret name is required"))
-	}
 
 	project := req.Msg.Project
-	if project == "" {
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("project is required"))
-	}
 
 	// Get claims from context (set by AuthInterceptor)
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	claims := rpc.MustClaims(ctx)
+	if req.Msg.DryRun {
+		ctx = rpc.ContextWithDryRun(ctx)
 	}
 
-	if err := h.requestK8s(ctx).DeleteSecret(ctx, project, req.Msg.Name); err != nil {
+	if err := h.requireNotDenied(ctx, claims, project, req.Msg.Name); err != nil {
+		return nil, err
+	}
+	if !req.Msg.Force {
+		if err := h.requireNotInUse(ctx, claims, project, req.Msg.Name); err != nil {
+			return nil, err
+		}
+	}
+	k8s := h.requestK8s(ctx)
+	var err error
+	if h.trashRetention > 0 {
+		if err := h.authorizeDelete(ctx, claims, project, req.Msg.Name, "delete"); err != nil {
+			return nil, err
+		}
+		err = k8s.TrashSecret(ctx, project, req.Msg.Name, claims.Email)
+	} else {
+		err = k8s.DeleteSecret(ctx, project, req.Msg.Name)
+	}
+	if err != nil {
 		return nil, mapK8sError(err)
 	}
+	if !req.Msg.DryRun {
+		forgetTLSCertificate(k8s.Resolver.ProjectNamespace(project), req.Msg.Name)
+	}
 
 	slog.InfoContext(ctx, "secret deleted",
 		slog.String("action", "secret_delete"),
@@ -153,6 +300,9 @@ func (h *Handler) DeleteSecret(
 		slog.String("project", project),
 		slog.String("sub", claims.Sub),
 		slog.String("email", claims.Email),
+		slog.Bool("dry_run", req.Msg.DryRun),
+		slog.Bool("force", req.Msg.Force),
+		slog.Bool("soft", h.trashRetention > 0),
 	)
 
 	return connect.NewResponse(&consolev1.DeleteSecretResponse{}), nil
@@ -165,28 +315,61 @@ func (h *Handler) CreateSecret(
 	ctx context.Context,
 	req *connect.Request[consolev1.CreateSecretRequest],
 ) (*connect.Response[consolev1.CreateSecretResponse], error) {
-	// Validate request
-	if req.Msg.Name == "" {
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("secret name is required"))
+	if err := validateCreateSecret(req.Msg); err != nil {
+		return nil, err
 	}
-
 	project := req.Msg.Project
-	if project == "" {
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("project is required"))
-	}
 
 	// Get claims from context (set by AuthInterceptor)
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	claims := rpc.MustClaims(ctx)
+	if req.Msg.DryRun {
+		ctx = rpc.ContextWithDryRun(ctx)
 	}
 
-	shareUsers := shareGrantsToAnnotations(req.Msg.UserGrants)
-	shareRoles := shareGrantsToAnnotations(req.Msg.RoleGrants)
+	denyUsers, allowUsers := SplitDenyGrants(shareGrantsToAnnotations(req.Msg.UserGrants))
+	denyRoles, allowRoles := SplitDenyGrants(shareGrantsToAnnotations(req.Msg.RoleGrants))
+	if denyGrantsName(denyUsers, claims) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("a deny grant cannot name the caller"))
+	}
+	keyUsers, shareUsers := SplitKeyGrants(allowUsers)
+	keyRoles, shareRoles := SplitKeyGrants(allowRoles)
 
 	// Merge string_data into data (string_data takes precedence)
 	data := mergeStringData(req.Msg.Data, req.Msg.StringData)
 
+	// Generate server-side random values for the requested keys.
+	var generated map[string]string
+	if len(req.Msg.Generate) > 0 {
+		generated = make(map[string]string, len(req.Msg.Generate))
+		if data == nil {
+			data = make(map[string][]byte, len(req.Msg.Generate))
+		}
+		for key, spec := range req.Msg.Generate {
+			value, err := GenerateValue(spec)
+			if err != nil {
+				return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("generating value for key %q: %w", key, err))
+			}
+			generated[key] = value
+		}
+		for key, value := range generated {
+			data[key] = []byte(value)
+		}
+	}
+
+	// Render registry credentials as an image pull secret.
+	secretType := corev1.SecretType(req.Msg.Type)
+	if creds := req.Msg.DockerRegistry; creds != nil {
+		config, err := buildDockerConfigJSON(creds)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		if data == nil {
+			data = make(map[string][]byte, 1)
+		}
+		data[corev1.DockerConfigJsonKey] = config
+		secretType = corev1.SecretTypeDockerConfigJson
+	}
+
 	// Extract description and url
 	var description, url string
 	if req.Msg.Description != nil {
@@ -196,15 +379,39 @@ func (h *Handler) CreateSecret(
 		url = *req.Msg.Url
 	}
 
+	if err := h.checkQuota(ctx, project, req.Msg.Name, func(d map[string][]byte) { maps.Copy(d, data) }); err != nil {
+		return nil, err
+	}
+
 	// Create the secret
 	k8s := h.requestK8s(ctx)
-	_, err := k8s.CreateSecret(ctx, project, req.Msg.Name, data, nil, nil, description, url)
+	_, err := k8s.CreateSecret(ctx, project, req.Msg.Name, data, nil, nil, description, url, claims.Email, claims.Sub, req.Msg.ContentTypes, secretType)
 	if err != nil {
 		return nil, mapK8sError(err)
 	}
 	if len(shareUsers) > 0 || len(shareRoles) > 0 {
 		shareUsers = rbacUserGrantsForClaims(shareUsers, claims)
-		if _, err := k8s.UpdateSharing(ctx, project, req.Msg.Name, shareUsers, shareRoles); err != nil {
+		if req.Msg.DryRun {
+			// A dry-run create does not persist the secret, so UpdateSharing
+			// would report NotFound. Dry-run the RoleBindings directly.
+			err = k8s.reconcileProjectSecretRoleBindings(ctx, k8s.Resolver.ProjectNamespace(project), shareUsers, shareRoles)
+		} else {
+			_, err = k8s.UpdateSharing(ctx, project, req.Msg.Name, shareUsers, shareRoles)
+			h.grants.invalidate(ctx, project)
+		}
+		if err != nil {
+			return nil, mapK8sError(err)
+		}
+	}
+	// Key and deny grants are stored on the secret itself, which a dry run
+	// never writes, so there is nothing further to check.
+	if !req.Msg.DryRun && (len(keyUsers) > 0 || len(keyRoles) > 0) {
+		if _, err := k8s.UpdateKeySharing(ctx, project, req.Msg.Name, keyUsers, keyRoles); err != nil {
+			return nil, mapK8sError(err)
+		}
+	}
+	if !req.Msg.DryRun && (len(denyUsers) > 0 || len(denyRoles) > 0) {
+		if _, err := k8s.UpdateDenySharing(ctx, project, req.Msg.Name, denyUsers, denyRoles); err != nil {
 			return nil, mapK8sError(err)
 		}
 	}
@@ -216,10 +423,14 @@ func (h *Handler) CreateSecret(
 		slog.String("project", project),
 		slog.String("sub", claims.Sub),
 		slog.String("email", claims.Email),
+		slog.String("type", string(secretType)),
+		slog.Any("generated_keys", slices.Sorted(maps.Keys(generated))),
+		slog.Bool("dry_run", req.Msg.DryRun),
 	)
 
 	return connect.NewResponse(&consolev1.CreateSecretResponse{
-		Name: req.Msg.Name,
+		Name:            req.Msg.Name,
+		GeneratedValues: generated,
 	}), nil
 }
 
@@ -228,29 +439,32 @@ func (h *Handler) UpdateSecret(
 	ctx context.Context,
 	req *connect.Request[consolev1.UpdateSecretRequest],
 ) (*connect.Response[consolev1.UpdateSecretResponse], error) {
-	// Validate request
-	if req.Msg.Name == "" {
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("secret name is required"))
-	}
-	if len(req.Msg.Data) == 0 && len(req.Msg.StringData) == 0 {
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("secret data is required"))
+	if err := validateUpdateSecret(req.Msg); err != nil {
+		return nil, err
 	}
-
 	project := req.Msg.Project
-	if project == "" {
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("project is required"))
-	}
 
 	// Get claims from context (set by AuthInterceptor)
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	claims := rpc.MustClaims(ctx)
+	if req.Msg.DryRun {
+		ctx = rpc.ContextWithDryRun(ctx)
 	}
 
 	// Merge string_data into data (string_data takes precedence)
 	data := mergeStringData(req.Msg.Data, req.Msg.StringData)
 
-	if _, err := h.requestK8s(ctx).UpdateSecret(ctx, project, req.Msg.Name, data, req.Msg.Description, req.Msg.Url); err != nil {
+	err := h.checkQuota(ctx, project, req.Msg.Name, func(d map[string][]byte) {
+		clear(d)
+		maps.Copy(d, data)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.requireNotDenied(ctx, claims, project, req.Msg.Name); err != nil {
+		return nil, err
+	}
+	if _, err := h.requestK8s(ctx).UpdateSecret(ctx, project, req.Msg.Name, data, req.Msg.Description, req.Msg.Url, req.Msg.ContentTypes); err != nil {
 		return nil, mapK8sError(err)
 	}
 
@@ -261,41 +475,169 @@ func (h *Handler) UpdateSecret(
 		slog.String("project", project),
 		slog.String("sub", claims.Sub),
 		slog.String("email", claims.Email),
+		slog.Bool("dry_run", req.Msg.DryRun),
 	)
 
 	return connect.NewResponse(&consolev1.UpdateSecretResponse{}), nil
 }
 
+// PatchSecret adds, replaces, or removes individual keys of an existing secret
+// with RBAC authorization. Keys not named in the request are preserved.
+func (h *Handler) PatchSecret(
+	ctx context.Context,
+	req *connect.Request[consolev1.PatchSecretRequest],
+) (*connect.Response[consolev1.PatchSecretResponse], error) {
+	if err := validatePatchSecret(req.Msg); err != nil {
+		return nil, err
+	}
+	project := req.Msg.Project
+
+	// Merge string_data into data (string_data takes precedence)
+	data := mergeStringData(req.Msg.Data, req.Msg.StringData)
+
+	// Get claims from context (set by AuthInterceptor)
+	claims := rpc.MustClaims(ctx)
+	if req.Msg.DryRun {
+		ctx = rpc.ContextWithDryRun(ctx)
+	}
+
+	err := h.checkQuota(ctx, project, req.Msg.Name, func(d map[string][]byte) {
+		for _, key := range req.Msg.RemoveKeys {
+			delete(d, key)
+		}
+		maps.Copy(d, data)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.requireNotDenied(ctx, claims, project, req.Msg.Name); err != nil {
+		return nil, err
+	}
+	patched, err := h.requestK8s(ctx).PatchSecret(ctx, project, req.Msg.Name, data, req.Msg.RemoveKeys, req.Msg.ContentTypes)
+	if err != nil {
+		return nil, mapK8sError(err)
+	}
+
+	setKeys := slices.Sorted(maps.Keys(data))
+	slog.InfoContext(ctx, "secret patched",
+		slog.String("action", "secret_patch"),
+		slog.String("resource_type", auditResourceType),
+		slog.String("secret", req.Msg.Name),
+		slog.String("project", project),
+		slog.String("sub", claims.Sub),
+		slog.String("email", claims.Email),
+		slog.Any("set_keys", setKeys),
+		slog.Any("removed_keys", req.Msg.RemoveKeys),
+		slog.Bool("dry_run", req.Msg.DryRun),
+	)
+
+	return connect.NewResponse(&consolev1.PatchSecretResponse{
+		Keys: slices.Sorted(maps.Keys(patched.Data)),
+	}), nil
+}
+
+// AppendSecretKey uploads one chunk of a key's value, so values near the
+// Secret size limit can be sent in requests well under the request size
+// limit. The first chunk is checked against the size limit and the project
+// quota for the complete value before anything is written.
+func (h *Handler) AppendSecretKey(
+	ctx context.Context,
+	req *connect.Request[consolev1.AppendSecretKeyRequest],
+) (*connect.Response[consolev1.AppendSecretKeyResponse], error) {
+	if err := validateAppendSecretKey(req.Msg); err != nil {
+		return nil, err
+	}
+	project := req.Msg.Project
+
+	// Get claims from context (set by AuthInterceptor)
+	claims := rpc.MustClaims(ctx)
+
+	if req.Msg.Offset == 0 {
+		// Reserve the complete value so an upload the quota cannot hold
+		// fails on its first chunk.
+		err := h.checkQuota(ctx, project, req.Msg.Name, func(d map[string][]byte) {
+			d[req.Msg.Key] = make([]byte, req.Msg.TotalSize)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := h.requireNotDenied(ctx, claims, project, req.Msg.Name); err != nil {
+		return nil, err
+	}
+	updated, err := h.requestK8s(ctx).AppendSecretKey(ctx, project, req.Msg.Name, req.Msg.Key, req.Msg.Offset, req.Msg.TotalSize, req.Msg.Chunk, req.Msg.ContentType)
+	if err != nil {
+		return nil, mapK8sError(err)
+	}
+
+	size := int64(len(updated.Data[req.Msg.Key]))
+	complete := size == req.Msg.TotalSize
+	if complete {
+		slog.InfoContext(ctx, "secret key uploaded",
+			slog.String("action", "secret_upload"),
+			slog.String("resource_type", auditResourceType),
+			slog.String("secret", req.Msg.Name),
+			slog.String("project", project),
+			slog.String("sub", claims.Sub),
+			slog.String("email", claims.Email),
+			slog.String("key", req.Msg.Key),
+			slog.Int64("size", size),
+		)
+	}
+
+	return connect.NewResponse(&consolev1.AppendSecretKeyResponse{
+		Size:     size,
+		Complete: complete,
+	}), nil
+}
+
 // UpdateSharing updates the sharing grants on a secret without touching its data.
 // Requires ROLE_OWNER on the secret (via any grant source).
 func (h *Handler) UpdateSharing(
 	ctx context.Context,
 	req *connect.Request[consolev1.UpdateSharingRequest],
 ) (*connect.Response[consolev1.UpdateSharingResponse], error) {
-	// Validate request
-	if req.Msg.Name == "" {
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("secret name is required"))
+	if err := validateUpdateSharing(req.Msg); err != nil {
+		return nil, err
 	}
-
 	project := req.Msg.Project
-	if project == "" {
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("project is required"))
-	}
 
 	// Get claims from context (set by AuthInterceptor)
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	claims := rpc.MustClaims(ctx)
+	if req.Msg.DryRun {
+		ctx = rpc.ContextWithDryRun(ctx)
 	}
 
 	k8s := h.requestK8s(ctx)
 
-	// Convert proto ShareGrant slices to annotation grants
-	newShareUsers := shareGrantsToAnnotations(req.Msg.UserGrants)
-	newShareRoles := shareGrantsToAnnotations(req.Msg.RoleGrants)
+	// Convert proto ShareGrant slices to annotation grants. Key-scoped and
+	// deny grants are stored on the Secret; whole-secret grants become
+	// RoleBindings.
+	denyUsers, allowUsers := SplitDenyGrants(shareGrantsToAnnotations(req.Msg.UserGrants))
+	denyRoles, allowRoles := SplitDenyGrants(shareGrantsToAnnotations(req.Msg.RoleGrants))
+	if denyGrantsName(denyUsers, claims) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("a deny grant cannot name the caller"))
+	}
+	keyUsers, newShareUsers := SplitKeyGrants(allowUsers)
+	keyRoles, newShareRoles := SplitKeyGrants(allowRoles)
 	newShareUsers = rbacUserGrantsForClaims(newShareUsers, claims)
 
-	updated, err := k8s.UpdateSharing(ctx, project, req.Msg.Name, newShareUsers, newShareRoles)
+	if err := h.requireNotDenied(ctx, claims, project, req.Msg.Name); err != nil {
+		return nil, err
+	}
+	_, err := k8s.UpdateSharing(ctx, project, req.Msg.Name, newShareUsers, newShareRoles)
+	if !req.Msg.DryRun {
+		h.grants.invalidate(ctx, project)
+	}
+	if err != nil {
+		return nil, mapK8sError(err)
+	}
+	if _, err := k8s.UpdateKeySharing(ctx, project, req.Msg.Name, keyUsers, keyRoles); err != nil {
+		return nil, mapK8sError(err)
+	}
+	updated, err := k8s.UpdateDenySharing(ctx, project, req.Msg.Name, denyUsers, denyRoles)
 	if err != nil {
 		return nil, mapK8sError(err)
 	}
@@ -307,45 +649,45 @@ func (h *Handler) UpdateSharing(
 		slog.String("project", project),
 		slog.String("sub", claims.Sub),
 		slog.String("email", claims.Email),
+		slog.Bool("dry_run", req.Msg.DryRun),
 	)
+	if req.Msg.InviteUsers && !req.Msg.DryRun {
+		h.inviteUsers(ctx, project, req.Msg.Name, req.Msg.UserGrants)
+	}
 
 	updatedUsers, updatedRoles, err := k8s.ListSharing(ctx, project)
 	if err != nil {
 		return nil, mapK8sError(err)
 	}
 	metadata := h.buildSecretMetadata(updated, displayUserGrants(updatedUsers, claims), updatedRoles, true)
+	metadata.UserRole, metadata.UserRoleSource = callerRole(claims, project, updatedUsers, updatedRoles, time.Now())
+	h.markPending(ctx, metadata)
 
 	return connect.NewResponse(&consolev1.UpdateSharingResponse{
 		Metadata: metadata,
 	}), nil
 }
 
-// GetSecretRaw retrieves the full Kubernetes Secret object as verbatim JSON.
+// GetSecretRaw retrieves the full Kubernetes Secret object as JSON or YAML.
 func (h *Handler) GetSecretRaw(
 	ctx context.Context,
 	req *connect.Request[consolev1.GetSecretRawRequest],
 ) (*connect.Response[consolev1.GetSecretRawResponse], error) {
 	// Validate request
-	if req.Msg.Name == "" {
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("secret name is required"))
-	}
 
 	project := req.Msg.Project
-	if project == "" {
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("project is required"))
-	}
 
 	// Get claims from context (set by AuthInterceptor)
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
-	}
+	claims := rpc.MustClaims(ctx)
 
 	// Get secret from Kubernetes
 	secret, err := h.requestK8s(ctx).GetSecret(ctx, project, req.Msg.Name)
 	if err != nil {
 		return nil, mapK8sError(err)
 	}
+	if err := checkDenied(ctx, claims, secret, project); err != nil {
+		return nil, err
+	}
 
 	logAuditAllowed(ctx, claims, secret.Name, project)
 
@@ -354,9 +696,9 @@ func (h *Handler) GetSecretRaw(
 	secret.Kind = "Secret"
 
 	// Marshal the full object to JSON
-	raw, err := json.Marshal(secret)
+	raw, err := rawobject.Marshal(secret, req.Msg.Format, req.Msg.StripManagedFields)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("marshaling secret to JSON: %w", err))
+		return nil, err
 	}
 
 	return connect.NewResponse(&consolev1.GetSecretRawResponse{
@@ -364,6 +706,97 @@ func (h *Handler) GetSecretRaw(
 	}), nil
 }
 
+// GetSecretKey retrieves the value of a single data key. Callers who can read
+// the whole Secret through the impersonating client may read any key. When the
+// API server denies the read, key-scoped grants stored on the Secret are
+// consulted using the console service account, because Kubernetes RBAC cannot
+// express access to individual data keys.
+func (h *Handler) GetSecretKey(
+	ctx context.Context,
+	req *connect.Request[consolev1.GetSecretKeyRequest],
+) (resp *connect.Response[consolev1.GetSecretKeyResponse], err error) {
+	defer func() { h.metrics.observeRead("GetSecretKey", req.Msg.Project, req.Msg.Name, err) }()
+	// Validate request
+
+	project := req.Msg.Project
+
+	// Get claims from context (set by AuthInterceptor)
+	claims := rpc.MustClaims(ctx)
+
+	secret, err := h.requestK8s(ctx).GetSecret(ctx, project, req.Msg.Name)
+	if err != nil {
+		if !errors.IsForbidden(err) || !rpc.HasImpersonatedClients(ctx) || h.k8s == nil {
+			return nil, mapK8sError(err)
+		}
+		secret, err = h.keyGrantedSecret(ctx, claims, project, req.Msg.Name, req.Msg.Key)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := checkDenied(ctx, claims, secret, project); err != nil {
+		return nil, err
+	}
+	if err := h.readBackend(ctx, secret); err != nil {
+		return nil, err
+	}
+
+	value, ok := secret.Data[req.Msg.Key]
+	if !ok {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("key %q not found in secret %q", req.Msg.Key, req.Msg.Name))
+	}
+
+	slog.InfoContext(ctx, "secret key access granted",
+		slog.String("action", "secret_key_access"),
+		slog.String("resource_type", auditResourceType),
+		slog.String("secret", secret.Name),
+		slog.String("key", req.Msg.Key),
+		slog.String("project", project),
+		slog.String("sub", claims.Sub),
+		slog.String("email", claims.Email),
+	)
+
+	return connect.NewResponse(&consolev1.GetSecretKeyResponse{
+		Value:       value,
+		ContentType: ContentType(secret, req.Msg.Key),
+	}), nil
+}
+
+// keyGrantedSecret reads a secret with the console service account and
+// returns it only when an active key-scoped grant lets the caller read key.
+// Every failure maps to PermissionDenied so the fallback does not reveal
+// whether the secret exists.
+func (h *Handler) keyGrantedSecret(ctx context.Context, claims *rpc.Claims, project, name, key string) (*corev1.Secret, error) {
+	denied := connect.NewError(connect.CodePermissionDenied, fmt.Errorf("not allowed to read key %q of secret %q", key, name))
+	secret, err := h.k8s.GetSecret(ctx, project, name)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, denied
+		}
+		return nil, mapK8sError(err)
+	}
+	allowed := false
+	if secret.Labels[v1alpha2.LabelManagedBy] == v1alpha2.ManagedByValue {
+		keyUsers, usersErr := GetKeyShareUsers(secret)
+		keyRoles, rolesErr := GetKeyShareRoles(secret)
+		if usersErr == nil && rolesErr == nil {
+			allowed = KeyGrantAllows(keyUsers, keyRoles, claims.Email, claims.Sub, claims.Roles, key, time.Now())
+		}
+	}
+	if !allowed {
+		slog.WarnContext(ctx, "secret key access denied",
+			slog.String("action", "secret_key_access_denied"),
+			slog.String("resource_type", auditResourceType),
+			slog.String("secret", name),
+			slog.String("key", key),
+			slog.String("project", project),
+			slog.String("sub", claims.Sub),
+			slog.String("email", claims.Email),
+		)
+		return nil, denied
+	}
+	return secret, nil
+}
+
 // mergeStringData merges string_data values into data. string_data keys take
 // precedence over data keys, matching Kubernetes stringData semantics.
 func mergeStringData(data map[string][]byte, stringData map[string]string) map[string][]byte {
@@ -438,6 +871,8 @@ func shareGrantsToAnnotations(grants []*consolev1.ShareGrant) []AnnotationGrant
 			ag := AnnotationGrant{
 				Principal: g.Principal,
 				Role:      strings.ToLower(g.Role.String()[len("ROLE_"):]),
+				Keys:      g.Keys,
+				Deny:      g.Deny,
 			}
 			if g.Nbf != nil {
 				nbf := *g.Nbf
@@ -453,19 +888,73 @@ func shareGrantsToAnnotations(grants []*consolev1.ShareGrant) []AnnotationGrant
 	return DeduplicateGrants(result)
 }
 
+// callerRole returns the caller's role on the secrets of project, whose
+// sharing grants are users and roles, and the grant it comes from.
+func callerRole(claims *rpc.Claims, project string, users, roles []AnnotationGrant, now time.Time) (rbac.Role, *consolev1.RoleSource) {
+	activeUsers, activeRoles := ActiveGrantsMap(users, now), ActiveGrantsMap(roles, now)
+	role := rbac.BestRoleFromGrants(claims.Email, claims.EmailVerified, claims.Roles, activeUsers, activeRoles)
+	return role, rbac.RoleSourceFromGrants(role, claims.Email, claims.EmailVerified, claims.Roles, rbac.GrantScope{
+		ResourceType: v1alpha2.ResourceTypeProject,
+		ResourceName: project,
+		Users:        activeUsers,
+		Roles:        activeRoles,
+	})
+}
+
 // buildSecretMetadata creates SecretMetadata for a secret from the caller's perspective.
 func (h *Handler) buildSecretMetadata(secret *corev1.Secret, shareUsers, shareRoles []AnnotationGrant, accessible bool) *consolev1.SecretMetadata {
 	// Build user grants (all grants, including expired, for display)
 	userGrants := annotationGrantsToProto(shareUsers)
 	// Build role grants
 	roleGrants := annotationGrantsToProto(shareRoles)
+	// Append key-scoped grants stored on the secret. A malformed annotation
+	// is treated as no key grants so listing never fails on one bad secret.
+	if keyUsers, err := GetKeyShareUsers(secret); err == nil {
+		userGrants = append(userGrants, annotationGrantsToProto(keyUsers)...)
+	}
+	if keyRoles, err := GetKeyShareRoles(secret); err == nil {
+		roleGrants = append(roleGrants, annotationGrantsToProto(keyRoles)...)
+	}
+	if denyUsers, err := GetDenyShareUsers(secret); err == nil {
+		userGrants = append(userGrants, annotationGrantsToProto(denyUsers)...)
+	}
+	if denyRoles, err := GetDenyShareRoles(secret); err == nil {
+		roleGrants = append(roleGrants, annotationGrantsToProto(denyRoles)...)
+	}
 
 	md := &consolev1.SecretMetadata{
-		Name:       secret.Name,
-		Accessible: accessible,
-		UserGrants: userGrants,
-		RoleGrants: roleGrants,
-		CreatedAt:  secret.CreationTimestamp.UTC().Format(time.RFC3339),
+		Name:           secret.Name,
+		Accessible:     accessible,
+		UserGrants:     userGrants,
+		RoleGrants:     roleGrants,
+		CreatedAt:      secret.CreationTimestamp.UTC().Format(time.RFC3339),
+		UpdatedAt:      listfilter.LastModified(secret).UTC().Format(time.RFC3339),
+		CreatorEmail:   secret.Annotations[v1alpha2.AnnotationCreatorEmail],
+		LastAccessedAt: secret.Annotations[v1alpha2.AnnotationLastAccessedAt],
+		LastAccessedBy: secret.Annotations[v1alpha2.AnnotationLastAccessedBy],
+		Source:         Source(secret),
+		Type:           string(secret.Type),
+		VaultPath:      BackendPath(secret),
+		SizeBytes:      secretObjectSize(secret),
+		SizeLimitBytes: MaxSecretObjectBytes,
+		KeyCount:       int32(len(secret.Data)),
+	}
+	if secret.Type == corev1.SecretTypeTLS {
+		// Record expiry even for callers who cannot read the secret, so the
+		// gauge covers every TLS secret the console observes.
+		if cert, err := ParseTLSCertificate(secret); err == nil {
+			tlsCertificateExpiry.WithLabelValues(secret.Namespace, secret.Name).Set(float64(cert.NotAfter.Unix()))
+			if accessible {
+				md.TlsCertificate = tlsCertificateToProto(cert)
+			}
+		}
+	}
+	if secret.Type == corev1.SecretTypeSSHAuth {
+		// The public key is not secret, so it is shown to every caller.
+		if line, fingerprint, err := SSHPublicKey(secret); err == nil {
+			md.SshPublicKey = line
+			md.SshFingerprint = fingerprint
+		}
 	}
 	if desc := GetDescription(secret); desc != "" {
 		md.Description = &desc
@@ -473,6 +962,9 @@ func (h *Handler) buildSecretMetadata(secret *corev1.Secret, shareUsers, shareRo
 	if u := GetURL(secret); u != "" {
 		md.Url = &u
 	}
+	if types, err := GetContentTypes(secret); err == nil {
+		md.ContentTypes = types
+	}
 	return md
 }
 
@@ -483,6 +975,8 @@ func annotationGrantsToProto(grants []AnnotationGrant) []*consolev1.ShareGrant {
 		sg := &consolev1.ShareGrant{
 			Principal: g.Principal,
 			Role:      protoRoleFromString(g.Role),
+			Keys:      g.Keys,
+			Deny:      g.Deny,
 		}
 		if g.Nbf != nil {
 			nbf := *g.Nbf
@@ -525,13 +1019,32 @@ func (h *Handler) requestK8s(ctx context.Context) *K8sClient {
 		return h.k8s
 	}
 	return &K8sClient{
-		client:   rpc.ImpersonatedClientsetFromContext(ctx),
-		Resolver: h.k8s.Resolver,
+		client:          rpc.ImpersonatedClientsetFromContext(ctx),
+		Resolver:        h.k8s.Resolver,
+		ExternalSecrets: h.k8s.ExternalSecrets,
+		Retry:           h.k8s.Retry,
+		Envelope:        h.k8s.Envelope,
 	}
 }
 
 // mapK8sError converts Kubernetes API errors to ConnectRPC errors.
 func mapK8sError(err error) error {
+	if stderrors.Is(err, ErrNotManaged) || stderrors.Is(err, ErrExternalData) || stderrors.Is(err, ErrEncrypted) {
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+	var sizeErr *SizeError
+	if stderrors.As(err, &sizeErr) {
+		return sizeErr.connectError()
+	}
+	if stderrors.Is(err, ErrQuotaExceeded) {
+		return connect.NewError(connect.CodeResourceExhausted, err)
+	}
+	if stderrors.Is(err, ErrUploadOffset) {
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+	if stderrors.Is(err, ErrInvalidTLS) || stderrors.Is(err, ErrInvalidDockerConfig) || stderrors.Is(err, ErrInvalidSSHKey) {
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	}
 	if errors.IsNotFound(err) {
 		return connect.NewError(connect.CodeNotFound, err)
 	}
@@ -544,13 +1057,16 @@ func mapK8sError(err error) error {
 	if errors.IsUnauthorized(err) {
 		return connect.NewError(connect.CodeUnauthenticated, err)
 	}
-	if errors.IsBadRequest(err) {
+	if errors.IsBadRequest(err) || errors.IsInvalid(err) {
 		return connect.NewError(connect.CodeInvalidArgument, err)
 	}
 	return connect.NewError(connect.CodeInternal, err)
 }
 
-// logAuditAllowed logs a successful secret access.
+// logAuditAllowed logs a successful secret access. Reads are authorized by
+// Kubernetes RBAC through the impersonated client without resolving the
+// project's sharing grants, and the log does not resolve them either, so
+// it adds no lookup to the read path.
 func logAuditAllowed(ctx context.Context, claims *rpc.Claims, secret, project string) {
 	slog.InfoContext(ctx, "secret access granted",
 		slog.String("action", "secret_access"),
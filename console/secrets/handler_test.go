@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"log/slog"
+	"maps"
+	"slices"
 	"testing"
 	"time"
 
@@ -18,7 +20,10 @@ import (
 	k8stesting "k8s.io/client-go/testing"
 
 	v1alpha2 "github.com/holos-run/holos-console/api/v1alpha2"
+	"github.com/holos-run/holos-console/console/maintenance"
 	"github.com/holos-run/holos-console/console/rpc"
+	"github.com/holos-run/holos-console/console/rpc/rpctesting"
+	"github.com/holos-run/holos-console/console/secretrbac"
 	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
 )
 
@@ -208,41 +213,6 @@ func TestHandler_GetSecret(t *testing.T) {
 		}
 	})
 
-	t.Run("returns Unauthenticated for missing auth", func(t *testing.T) {
-		// Given: Request without claims in context (no Authorization header)
-		secret := &corev1.Secret{
-			ObjectMeta: metav1.ObjectMeta{
-				Name:      "my-secret",
-				Namespace: "prj-test-namespace",
-			},
-		}
-		fakeClient := fake.NewClientset(testProjectNS(), secret)
-		k8sClient := NewK8sClient(fakeClient, testResolver())
-		handler := NewProjectScopedHandler(k8sClient, nil)
-
-		// Context without claims
-		ctx := context.Background()
-		req := connect.NewRequest(&consolev1.GetSecretRequest{
-			Name:    "my-secret",
-			Project: "test-namespace",
-		})
-
-		// When: GetSecret RPC is called
-		_, err := handler.GetSecret(ctx, req)
-
-		// Then: Returns Unauthenticated error
-		if err == nil {
-			t.Fatal("expected Unauthenticated error, got nil")
-		}
-		connectErr, ok := err.(*connect.Error)
-		if !ok {
-			t.Fatalf("expected *connect.Error, got %T", err)
-		}
-		if connectErr.Code() != connect.CodeUnauthenticated {
-			t.Errorf("expected CodeUnauthenticated, got %v", connectErr.Code())
-		}
-	})
-
 	t.Run("returns PermissionDenied for unauthorized user", func(t *testing.T) {
 		t.Skip("obsolete: Kubernetes RBAC denial is covered by TestHandler_ForbiddenFromAPIServerMapsToPermissionDenied")
 		// Given: Authenticated user NOT in sharing annotations
@@ -344,7 +314,7 @@ func TestHandler_GetSecret(t *testing.T) {
 		})
 
 		// When: GetSecret RPC is called
-		_, err := handler.GetSecret(ctx, req)
+		_, err := rpctesting.Validated(ctx, req, handler.GetSecret)
 
 		// Then: Returns InvalidArgument error
 		if err == nil {
@@ -635,28 +605,6 @@ func TestHandler_DeleteSecret(t *testing.T) {
 		}
 	})
 
-	t.Run("returns Unauthenticated for missing auth", func(t *testing.T) {
-		fakeClient := fake.NewClientset(testProjectNS())
-		k8sClient := NewK8sClient(fakeClient, testResolver())
-		handler := NewProjectScopedHandler(k8sClient, nil)
-
-		ctx := context.Background()
-		req := connect.NewRequest(&consolev1.DeleteSecretRequest{Name: "my-secret", Project: "test-namespace"})
-
-		_, err := handler.DeleteSecret(ctx, req)
-
-		if err == nil {
-			t.Fatal("expected error, got nil")
-		}
-		connectErr, ok := err.(*connect.Error)
-		if !ok {
-			t.Fatalf("expected *connect.Error, got %T", err)
-		}
-		if connectErr.Code() != connect.CodeUnauthenticated {
-			t.Errorf("expected CodeUnauthenticated, got %v", connectErr.Code())
-		}
-	})
-
 	t.Run("returns PermissionDenied for editor", func(t *testing.T) {
 		t.Skip("obsolete: Kubernetes RBAC denial is covered by API-server forbidden tests")
 		// Editor lacks PERMISSION_SECRETS_DELETE
@@ -782,7 +730,7 @@ func TestHandler_DeleteSecret(t *testing.T) {
 
 		req := connect.NewRequest(&consolev1.DeleteSecretRequest{Name: "", Project: "test-namespace"})
 
-		_, err := handler.DeleteSecret(ctx, req)
+		_, err := rpctesting.Validated(ctx, req, handler.DeleteSecret)
 
 		if err == nil {
 			t.Fatal("expected error, got nil")
@@ -927,35 +875,6 @@ func TestHandler_CreateSecret(t *testing.T) {
 		}
 	})
 
-	t.Run("returns Unauthenticated for missing auth", func(t *testing.T) {
-		fakeClient := fake.NewClientset(testProjectNS())
-		k8sClient := NewK8sClient(fakeClient, testResolver())
-		handler := NewProjectScopedHandler(k8sClient, nil)
-
-		ctx := context.Background()
-		req := connect.NewRequest(&consolev1.CreateSecretRequest{
-			Name:    "new-secret",
-			Project: "test-namespace",
-			Data:    map[string][]byte{"k": []byte("v")},
-			UserGrants: []*consolev1.ShareGrant{
-				{Principal: "user@example.com", Role: consolev1.Role_ROLE_EDITOR},
-			},
-		})
-
-		_, err := handler.CreateSecret(ctx, req)
-
-		if err == nil {
-			t.Fatal("expected error, got nil")
-		}
-		connectErr, ok := err.(*connect.Error)
-		if !ok {
-			t.Fatalf("expected *connect.Error, got %T", err)
-		}
-		if connectErr.Code() != connect.CodeUnauthenticated {
-			t.Errorf("expected CodeUnauthenticated, got %v", connectErr.Code())
-		}
-	})
-
 	t.Run("returns PermissionDenied for viewer", func(t *testing.T) {
 		t.Skip("obsolete: Kubernetes RBAC denial is covered by API-server forbidden tests")
 		fakeClient := fake.NewClientset(testProjectNS())
@@ -1014,7 +933,7 @@ func TestHandler_CreateSecret(t *testing.T) {
 			},
 		})
 
-		_, err := handler.CreateSecret(ctx, req)
+		_, err := rpctesting.Validated(ctx, req, handler.CreateSecret)
 
 		if err == nil {
 			t.Fatal("expected error, got nil")
@@ -1270,35 +1189,6 @@ func TestHandler_UpdateSecret(t *testing.T) {
 		}
 	})
 
-	t.Run("returns Unauthenticated for missing auth", func(t *testing.T) {
-		// Given: Request without claims
-		fakeClient := fake.NewClientset(testProjectNS())
-		k8sClient := NewK8sClient(fakeClient, testResolver())
-		handler := NewProjectScopedHandler(k8sClient, nil)
-
-		ctx := context.Background()
-		req := connect.NewRequest(&consolev1.UpdateSecretRequest{
-			Name:    "my-secret",
-			Project: "test-namespace",
-			Data:    map[string][]byte{"k": []byte("v")},
-		})
-
-		// When: UpdateSecret RPC is called
-		_, err := handler.UpdateSecret(ctx, req)
-
-		// Then: Returns Unauthenticated
-		if err == nil {
-			t.Fatal("expected error, got nil")
-		}
-		connectErr, ok := err.(*connect.Error)
-		if !ok {
-			t.Fatalf("expected *connect.Error, got %T", err)
-		}
-		if connectErr.Code() != connect.CodeUnauthenticated {
-			t.Errorf("expected CodeUnauthenticated, got %v", connectErr.Code())
-		}
-	})
-
 	t.Run("returns PermissionDenied for viewer", func(t *testing.T) {
 		t.Skip("obsolete: Kubernetes RBAC denial is covered by API-server forbidden tests")
 		// Given: Secret shared with user as viewer, user lacks editor permission
@@ -1403,7 +1293,7 @@ func TestHandler_UpdateSecret(t *testing.T) {
 		})
 
 		// When: UpdateSecret RPC is called
-		_, err := handler.UpdateSecret(ctx, req)
+		_, err := rpctesting.Validated(ctx, req, handler.UpdateSecret)
 
 		// Then: Returns InvalidArgument
 		if err == nil {
@@ -1755,31 +1645,6 @@ func TestHandler_ListSecrets(t *testing.T) {
 		}
 	})
 
-	t.Run("returns Unauthenticated for missing auth", func(t *testing.T) {
-		// Given: Request without claims in context
-		fakeClient := fake.NewClientset(testProjectNS())
-		k8sClient := NewK8sClient(fakeClient, testResolver())
-		handler := NewProjectScopedHandler(k8sClient, nil)
-
-		ctx := context.Background()
-		req := connect.NewRequest(&consolev1.ListSecretsRequest{Project: "test-namespace"})
-
-		// When: ListSecrets RPC is called
-		_, err := handler.ListSecrets(ctx, req)
-
-		// Then: Returns Unauthenticated error
-		if err == nil {
-			t.Fatal("expected Unauthenticated error, got nil")
-		}
-		connectErr, ok := err.(*connect.Error)
-		if !ok {
-			t.Fatalf("expected *connect.Error, got %T", err)
-		}
-		if connectErr.Code() != connect.CodeUnauthenticated {
-			t.Errorf("expected CodeUnauthenticated, got %v", connectErr.Code())
-		}
-	})
-
 	t.Run("returns empty list when no secrets match", func(t *testing.T) {
 		// Given: No secrets with console label
 		secretWithoutLabel := &corev1.Secret{
@@ -1949,31 +1814,6 @@ func TestHandler_UpdateSharing(t *testing.T) {
 		}
 	})
 
-	t.Run("returns Unauthenticated for missing auth", func(t *testing.T) {
-		fakeClient := fake.NewClientset(testProjectNS())
-		k8sClient := NewK8sClient(fakeClient, testResolver())
-		handler := NewProjectScopedHandler(k8sClient, nil)
-
-		ctx := context.Background()
-		req := connect.NewRequest(&consolev1.UpdateSharingRequest{
-			Name:    "my-secret",
-			Project: "test-namespace",
-		})
-
-		_, err := handler.UpdateSharing(ctx, req)
-
-		if err == nil {
-			t.Fatal("expected error, got nil")
-		}
-		connectErr, ok := err.(*connect.Error)
-		if !ok {
-			t.Fatalf("expected *connect.Error, got %T", err)
-		}
-		if connectErr.Code() != connect.CodeUnauthenticated {
-			t.Errorf("expected CodeUnauthenticated, got %v", connectErr.Code())
-		}
-	})
-
 	t.Run("returns InvalidArgument for empty name", func(t *testing.T) {
 		fakeClient := fake.NewClientset(testProjectNS())
 		k8sClient := NewK8sClient(fakeClient, testResolver())
@@ -1991,7 +1831,7 @@ func TestHandler_UpdateSharing(t *testing.T) {
 			Project: "test-namespace",
 		})
 
-		_, err := handler.UpdateSharing(ctx, req)
+		_, err := rpctesting.Validated(ctx, req, handler.UpdateSharing)
 
 		if err == nil {
 			t.Fatal("expected error, got nil")
@@ -2178,28 +2018,6 @@ func TestHandler_GetSecretRaw(t *testing.T) {
 		}
 	})
 
-	t.Run("returns Unauthenticated for missing auth", func(t *testing.T) {
-		fakeClient := fake.NewClientset(testProjectNS())
-		k8sClient := NewK8sClient(fakeClient, testResolver())
-		handler := NewProjectScopedHandler(k8sClient, nil)
-
-		ctx := context.Background()
-		req := connect.NewRequest(&consolev1.GetSecretRawRequest{Name: "my-secret", Project: "test-namespace"})
-
-		_, err := handler.GetSecretRaw(ctx, req)
-
-		if err == nil {
-			t.Fatal("expected error, got nil")
-		}
-		connectErr, ok := err.(*connect.Error)
-		if !ok {
-			t.Fatalf("expected *connect.Error, got %T", err)
-		}
-		if connectErr.Code() != connect.CodeUnauthenticated {
-			t.Errorf("expected CodeUnauthenticated, got %v", connectErr.Code())
-		}
-	})
-
 	t.Run("returns InvalidArgument for empty name", func(t *testing.T) {
 		fakeClient := fake.NewClientset(testProjectNS())
 		k8sClient := NewK8sClient(fakeClient, testResolver())
@@ -2214,7 +2032,7 @@ func TestHandler_GetSecretRaw(t *testing.T) {
 
 		req := connect.NewRequest(&consolev1.GetSecretRawRequest{Name: "", Project: "test-namespace"})
 
-		_, err := handler.GetSecretRaw(ctx, req)
+		_, err := rpctesting.Validated(ctx, req, handler.GetSecretRaw)
 
 		if err == nil {
 			t.Fatal("expected error, got nil")
@@ -2591,6 +2409,56 @@ func TestHandler_ListSecrets_AuditLogging(t *testing.T) {
 	})
 }
 
+func TestListSecrets_Filter(t *testing.T) {
+	secret := func(name, description string, annotations map[string]string) *corev1.Secret {
+		s := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   "prj-test-namespace",
+			Labels:      map[string]string{v1alpha2.LabelManagedBy: v1alpha2.ManagedByValue, "tier": "db"},
+			Annotations: map[string]string{v1alpha2.AnnotationDescription: description},
+		}}
+		maps.Copy(s.Annotations, annotations)
+		return s
+	}
+	apiKey := secret("api-key", "Payment provider", nil)
+	apiKey.Labels["tier"] = "edge"
+	fakeClient := fake.NewClientset(testProjectNS(),
+		secret("orders-db", "Orders database", nil),
+		secret("billing-db", "Billing database", map[string]string{
+			v1alpha2.AnnotationShareDenyUsers: `[{"principal":"user@example.com","deny":true}]`,
+		}),
+		apiKey,
+	)
+	handler := NewProjectScopedHandler(NewK8sClient(fakeClient, testResolver()), nil)
+	ctx := rpc.ContextWithClaims(context.Background(), &rpc.Claims{Sub: "u1", Email: "user@example.com"})
+
+	tests := []struct {
+		name   string
+		filter *consolev1.ListFilter
+		want   []string
+	}{
+		{"description contains", &consolev1.ListFilter{DescriptionContains: "DATABASE"}, []string{"billing-db", "orders-db"}},
+		{"accessible only", &consolev1.ListFilter{DescriptionContains: "database", AccessibleOnly: true}, []string{"orders-db"}},
+		{"name and labels", &consolev1.ListFilter{NameContains: "-", LabelSelector: "tier=edge"}, []string{"api-key"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := handler.ListSecrets(ctx, connect.NewRequest(&consolev1.ListSecretsRequest{Project: "test-namespace", Filter: tt.filter}))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			var got []string
+			for _, md := range resp.Msg.Secrets {
+				got = append(got, md.Name)
+			}
+			slices.Sort(got)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("secrets = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestHandler_DescriptionAndURL(t *testing.T) {
 	t.Run("ListSecrets returns description from annotation", func(t *testing.T) {
 		secret := &corev1.Secret{
@@ -3172,3 +3040,499 @@ func TestHandler_ListSecrets_CreatedAt(t *testing.T) {
 		})
 	}
 }
+
+// TestHandler_ListSecrets_Provenance asserts that SecretMetadata carries the
+// latest managedFields write as UpdatedAt and the creator stamped by
+// CreateSecret.
+func TestHandler_ListSecrets_Provenance(t *testing.T) {
+	created := time.Date(2026, 4, 22, 19, 51, 10, 0, time.UTC)
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "edited",
+			Namespace: "prj-test-namespace",
+			Labels: map[string]string{
+				v1alpha2.LabelManagedBy: v1alpha2.ManagedByValue,
+			},
+			CreationTimestamp: metav1.NewTime(created),
+			ManagedFields: []metav1.ManagedFieldsEntry{
+				{Manager: "console", Time: &metav1.Time{Time: created}},
+				{Manager: "console", Time: &metav1.Time{Time: created.Add(90 * time.Minute)}},
+			},
+		},
+	}
+	fakeClient := fake.NewClientset(testProjectNS(), secret)
+	handler := NewProjectScopedHandler(NewK8sClient(fakeClient, testResolver()), nil)
+	ctx := rpc.ContextWithClaims(context.Background(), &rpc.Claims{Sub: "u1", Email: "user@example.com"})
+
+	if _, err := handler.CreateSecret(ctx, connect.NewRequest(&consolev1.CreateSecretRequest{
+		Name:    "fresh",
+		Project: "test-namespace",
+		Data:    map[string][]byte{"key": []byte("value")},
+	})); err != nil {
+		t.Fatalf("CreateSecret: %v", err)
+	}
+
+	resp, err := handler.ListSecrets(ctx, connect.NewRequest(&consolev1.ListSecretsRequest{Project: "test-namespace"}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got := map[string]*consolev1.SecretMetadata{}
+	for _, md := range resp.Msg.Secrets {
+		got[md.Name] = md
+	}
+	if md := got["edited"]; md.UpdatedAt != "2026-04-22T21:21:10Z" || md.CreatorEmail != "" {
+		t.Errorf("edited: UpdatedAt %q CreatorEmail %q", md.UpdatedAt, md.CreatorEmail)
+	}
+	if md := got["fresh"]; md.CreatorEmail != "user@example.com" {
+		t.Errorf("fresh: CreatorEmail %q", md.CreatorEmail)
+	}
+	stored, err := fakeClient.CoreV1().Secrets("prj-test-namespace").Get(ctx, "fresh", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	if stored.Annotations[v1alpha2.AnnotationCreatorSubject] != "u1" {
+		t.Errorf("expected creator subject annotation, got %v", stored.Annotations)
+	}
+}
+
+// TestHandler_GetSecret_RecordsAccess asserts that GetSecret stamps the
+// last-accessed annotations at most once per interval, that ListSecrets
+// surfaces them, and that the stamp does not advance UpdatedAt.
+func TestHandler_GetSecret_RecordsAccess(t *testing.T) {
+	created := time.Date(2026, 4, 22, 19, 51, 10, 0, time.UTC)
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "db",
+			Namespace: "prj-test-namespace",
+			Labels: map[string]string{
+				v1alpha2.LabelManagedBy: v1alpha2.ManagedByValue,
+			},
+			CreationTimestamp: metav1.NewTime(created),
+		},
+		Data: map[string][]byte{"password": []byte("hunter2")},
+	}
+	fakeClient := fake.NewClientset(testProjectNS(), secret)
+	handler := NewProjectScopedHandler(NewK8sClient(fakeClient, testResolver()), nil)
+	ctx := rpc.ContextWithClaims(context.Background(), &rpc.Claims{Sub: "u1", Email: "reader@example.com"})
+
+	patches := func() int {
+		n := 0
+		for _, a := range fakeClient.Actions() {
+			if a.GetVerb() == "patch" {
+				n++
+			}
+		}
+		return n
+	}
+	for range 2 {
+		if _, err := handler.GetSecret(ctx, connect.NewRequest(&consolev1.GetSecretRequest{Name: "db", Project: "test-namespace"})); err != nil {
+			t.Fatalf("GetSecret: %v", err)
+		}
+	}
+	if got := patches(); got != 1 {
+		t.Errorf("expected a single access patch for reads within the interval, got %d", got)
+	}
+
+	resp, err := handler.ListSecrets(ctx, connect.NewRequest(&consolev1.ListSecretsRequest{Project: "test-namespace"}))
+	if err != nil {
+		t.Fatalf("ListSecrets: %v", err)
+	}
+	md := resp.Msg.Secrets[0]
+	if md.LastAccessedAt == "" || md.LastAccessedBy != "reader@example.com" {
+		t.Errorf("unexpected access metadata at %q by %q", md.LastAccessedAt, md.LastAccessedBy)
+	}
+	if md.UpdatedAt != md.CreatedAt {
+		t.Errorf("access stamp advanced UpdatedAt to %q", md.UpdatedAt)
+	}
+
+	t.Run("maintenance mode", func(t *testing.T) {
+		client := fake.NewClientset(testProjectNS(), secret)
+		readOnly := NewProjectScopedHandler(NewK8sClient(client, testResolver()), nil).WithMaintenance(maintenance.NewMode(true, ""))
+		if _, err := readOnly.GetSecret(ctx, connect.NewRequest(&consolev1.GetSecretRequest{Name: "db", Project: "test-namespace"})); err != nil {
+			t.Fatalf("GetSecret: %v", err)
+		}
+		for _, a := range client.Actions() {
+			if a.GetVerb() != "get" && a.GetVerb() != "list" {
+				t.Errorf("expected no writes in maintenance mode, got %s %s", a.GetVerb(), a.GetResource().Resource)
+			}
+		}
+	})
+
+	stale := secret.DeepCopy()
+	stale.Annotations = map[string]string{v1alpha2.AnnotationLastAccessedAt: created.Format(time.RFC3339)}
+	if err := handler.k8s.RecordAccess(ctx, stale, "reader@example.com", created.Add(2*time.Hour)); err != nil {
+		t.Fatalf("RecordAccess: %v", err)
+	}
+	if got := patches(); got != 2 {
+		t.Errorf("expected a stale stamp to be refreshed, got %d patches", got)
+	}
+}
+
+// assertDryRunWrites fails unless client recorded at least one write and every
+// create, update, and delete carried DryRun=[All].
+func assertDryRunWrites(t *testing.T, client *fake.Clientset) {
+	t.Helper()
+	writes := 0
+	for _, action := range client.Actions() {
+		var dryRun []string
+		switch a := action.(type) {
+		case k8stesting.CreateActionImpl:
+			dryRun = a.GetCreateOptions().DryRun
+		case k8stesting.UpdateActionImpl:
+			dryRun = a.GetUpdateOptions().DryRun
+		case k8stesting.DeleteActionImpl:
+			dryRun = a.GetDeleteOptions().DryRun
+		default:
+			continue
+		}
+		writes++
+		if len(dryRun) != 1 || dryRun[0] != metav1.DryRunAll {
+			t.Errorf("%s %s: expected DryRun=[All], got %v", action.GetVerb(), action.GetResource().Resource, dryRun)
+		}
+	}
+	if writes == 0 {
+		t.Fatal("expected at least one dry-run write")
+	}
+}
+
+func TestHandler_SecretMutations_DryRun(t *testing.T) {
+	grants := []*consolev1.ShareGrant{{Principal: "bob@example.com", Role: consolev1.Role_ROLE_VIEWER}}
+	cases := []struct {
+		name   string
+		action string
+		call   func(ctx context.Context, h *Handler) error
+	}{
+		{
+			name:   "create",
+			action: "secret_create",
+			call: func(ctx context.Context, h *Handler) error {
+				_, err := h.CreateSecret(ctx, connect.NewRequest(&consolev1.CreateSecretRequest{
+					Name:       "new-secret",
+					Project:    "test-namespace",
+					Data:       map[string][]byte{"key": []byte("value")},
+					UserGrants: grants,
+					DryRun:     true,
+				}))
+				return err
+			},
+		},
+		{
+			name:   "update",
+			action: "secret_update",
+			call: func(ctx context.Context, h *Handler) error {
+				_, err := h.UpdateSecret(ctx, connect.NewRequest(&consolev1.UpdateSecretRequest{
+					Name:    "my-secret",
+					Project: "test-namespace",
+					Data:    map[string][]byte{"key": []byte("changed")},
+					DryRun:  true,
+				}))
+				return err
+			},
+		},
+		{
+			name:   "delete",
+			action: "secret_delete",
+			call: func(ctx context.Context, h *Handler) error {
+				_, err := h.DeleteSecret(ctx, connect.NewRequest(&consolev1.DeleteSecretRequest{
+					Name:    "my-secret",
+					Project: "test-namespace",
+					DryRun:  true,
+				}))
+				return err
+			},
+		},
+		{
+			name:   "update sharing",
+			action: "sharing_update",
+			call: func(ctx context.Context, h *Handler) error {
+				_, err := h.UpdateSharing(ctx, connect.NewRequest(&consolev1.UpdateSharingRequest{
+					Name:       "my-secret",
+					Project:    "test-namespace",
+					UserGrants: grants,
+					DryRun:     true,
+				}))
+				return err
+			},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-secret",
+					Namespace: "prj-test-namespace",
+					Labels:    map[string]string{v1alpha2.LabelManagedBy: v1alpha2.ManagedByValue},
+				},
+				Data: map[string][]byte{"key": []byte("value")},
+			}
+			client := fake.NewClientset(testProjectNS(), secret)
+			handler := NewProjectScopedHandler(NewK8sClient(client, testResolver()), nil)
+
+			logHandler := &testLogHandler{}
+			oldLogger := slog.Default()
+			slog.SetDefault(slog.New(logHandler))
+			defer slog.SetDefault(oldLogger)
+
+			ctx := contextWithImpersonatedClient(context.Background(), &rpc.Claims{Sub: "sub-owner", Email: "owner@example.com"}, client)
+			if err := tc.call(ctx, handler); err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			assertDryRunWrites(t, client)
+
+			record := logHandler.findRecord(tc.action)
+			if record == nil {
+				t.Fatalf("expected %s audit log", tc.action)
+			}
+			if got := findAttr(record, "dry_run"); got != "true" {
+				t.Errorf("expected dry_run=true, got %q", got)
+			}
+		})
+	}
+}
+
+func TestHandler_PatchSecret(t *testing.T) {
+	cases := []struct {
+		name       string
+		req        *consolev1.PatchSecretRequest
+		wantCode   connect.Code
+		wantData   map[string]string
+		wantKeys   []string
+		wantAction bool
+	}{
+		{
+			name: "sets and removes keys leaving others untouched",
+			req: &consolev1.PatchSecretRequest{
+				Data:       map[string][]byte{"password": []byte("rotated")},
+				StringData: map[string]string{"host": "db.internal"},
+				RemoveKeys: []string{"legacy", "missing"},
+			},
+			wantData:   map[string]string{"username": "admin", "password": "rotated", "host": "db.internal"},
+			wantKeys:   []string{"host", "password", "username"},
+			wantAction: true,
+		},
+		{
+			name:     "requires a change",
+			req:      &consolev1.PatchSecretRequest{},
+			wantCode: connect.CodeInvalidArgument,
+		},
+		{
+			name: "rejects setting and removing the same key",
+			req: &consolev1.PatchSecretRequest{
+				StringData: map[string]string{"legacy": "x"},
+				RemoveKeys: []string{"legacy"},
+			},
+			wantCode: connect.CodeInvalidArgument,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "db-creds",
+					Namespace: "prj-test-namespace",
+					Labels:    map[string]string{v1alpha2.LabelManagedBy: v1alpha2.ManagedByValue},
+				},
+				Data: map[string][]byte{
+					"username": []byte("admin"),
+					"password": []byte("hunter2"),
+					"legacy":   []byte("old"),
+				},
+			}
+			client := fake.NewClientset(testProjectNS(), secret)
+			handler := NewProjectScopedHandler(NewK8sClient(client, testResolver()), nil)
+
+			logHandler := &testLogHandler{}
+			oldLogger := slog.Default()
+			slog.SetDefault(slog.New(logHandler))
+			defer slog.SetDefault(oldLogger)
+
+			ctx := contextWithImpersonatedClient(context.Background(), &rpc.Claims{Sub: "user-123", Email: "user@example.com"}, client)
+			tc.req.Name = "db-creds"
+			tc.req.Project = "test-namespace"
+			resp, err := handler.PatchSecret(ctx, connect.NewRequest(tc.req))
+			if tc.wantCode != 0 {
+				if connect.CodeOf(err) != tc.wantCode {
+					t.Fatalf("expected %v, got %v", tc.wantCode, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if !slices.Equal(resp.Msg.Keys, tc.wantKeys) {
+				t.Errorf("expected keys %v, got %v", tc.wantKeys, resp.Msg.Keys)
+			}
+
+			stored, err := client.CoreV1().Secrets("prj-test-namespace").Get(ctx, "db-creds", metav1.GetOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(stored.Data) != len(tc.wantData) {
+				t.Errorf("expected %d keys, got %v", len(tc.wantData), stored.Data)
+			}
+			for k, v := range tc.wantData {
+				if string(stored.Data[k]) != v {
+					t.Errorf("expected %s=%q, got %q", k, v, stored.Data[k])
+				}
+			}
+
+			record := logHandler.findRecord("secret_patch")
+			if tc.wantAction && record == nil {
+				t.Fatal("expected secret_patch audit log")
+			}
+			assertResourceType(t, record)
+		})
+	}
+}
+
+func TestHandler_ExternalSecrets(t *testing.T) {
+	managed := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "console-secret",
+			Namespace: "prj-test-namespace",
+			Labels:    map[string]string{v1alpha2.LabelManagedBy: v1alpha2.ManagedByValue},
+		},
+	}
+	external := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "synced-secret",
+			Namespace: "prj-test-namespace",
+			Labels:    map[string]string{v1alpha2.LabelExternalSecretsManaged: v1alpha2.ExternalSecretsManagedValue},
+		},
+		Data: map[string][]byte{"token": []byte("from-vault")},
+	}
+	claims := &rpc.Claims{Sub: "user-123", Email: "user@example.com"}
+
+	newHandler := func(enabled bool) (*Handler, context.Context) {
+		client := fake.NewClientset(testProjectNS(), managed.DeepCopy(), external.DeepCopy())
+		k8s := NewK8sClient(client, testResolver())
+		k8s.ExternalSecrets = enabled
+		return NewProjectScopedHandler(k8s, nil), contextWithImpersonatedClient(context.Background(), claims, client)
+	}
+
+	t.Run("lists external secrets read-only when enabled", func(t *testing.T) {
+		handler, ctx := newHandler(true)
+		resp, err := handler.ListSecrets(ctx, connect.NewRequest(&consolev1.ListSecretsRequest{Project: "test-namespace"}))
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		sources := map[string]string{}
+		for _, s := range resp.Msg.Secrets {
+			sources[s.Name] = s.Source
+		}
+		if sources["console-secret"] != SourceConsole || sources["synced-secret"] != SourceExternal || len(sources) != 2 {
+			t.Errorf("expected console and external sources, got %v", sources)
+		}
+	})
+
+	t.Run("omits external secrets when disabled", func(t *testing.T) {
+		handler, ctx := newHandler(false)
+		resp, err := handler.ListSecrets(ctx, connect.NewRequest(&consolev1.ListSecretsRequest{Project: "test-namespace"}))
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(resp.Msg.Secrets) != 1 || resp.Msg.Secrets[0].Name != "console-secret" {
+			t.Errorf("expected only the console secret, got %v", resp.Msg.Secrets)
+		}
+	})
+
+	t.Run("rejects writes with FailedPrecondition", func(t *testing.T) {
+		handler, ctx := newHandler(true)
+		_, err := handler.UpdateSecret(ctx, connect.NewRequest(&consolev1.UpdateSecretRequest{
+			Name:    "synced-secret",
+			Project: "test-namespace",
+			Data:    map[string][]byte{"token": []byte("overwrite")},
+		}))
+		if connect.CodeOf(err) != connect.CodeFailedPrecondition {
+			t.Errorf("UpdateSecret: expected FailedPrecondition, got %v", err)
+		}
+		_, err = handler.DeleteSecret(ctx, connect.NewRequest(&consolev1.DeleteSecretRequest{
+			Name:    "synced-secret",
+			Project: "test-namespace",
+		}))
+		if connect.CodeOf(err) != connect.CodeFailedPrecondition {
+			t.Errorf("DeleteSecret: expected FailedPrecondition, got %v", err)
+		}
+
+		resp, err := handler.GetSecret(ctx, connect.NewRequest(&consolev1.GetSecretRequest{
+			Name:    "synced-secret",
+			Project: "test-namespace",
+		}))
+		if err != nil {
+			t.Fatalf("GetSecret: expected no error, got %v", err)
+		}
+		if string(resp.Msg.Data["token"]) != "from-vault" {
+			t.Errorf("expected external secret data to be readable, got %q", resp.Msg.Data["token"])
+		}
+	})
+}
+
+func TestHandler_ListSecrets_NotModified(t *testing.T) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "my-secret",
+			Namespace:       "prj-test-namespace",
+			Labels:          map[string]string{v1alpha2.LabelManagedBy: v1alpha2.ManagedByValue},
+			ResourceVersion: "1",
+		},
+		Data: map[string][]byte{"k": []byte("v")},
+	}
+	fakeClient := fake.NewClientset(testProjectNS(), secret,
+		secretrbac.RoleBinding("prj-test-namespace", secretrbac.ShareTargetUser, "user@example.com", secretrbac.RoleViewer, nil))
+	inviter := &fakeInviter{}
+	handler := NewProjectScopedHandler(NewK8sClient(fakeClient, testResolver()), nil).WithInviter(inviter)
+	ctx := rpc.ContextWithClaims(context.Background(), &rpc.Claims{Sub: "user-123", Email: "user@example.com"})
+
+	first, err := handler.ListSecrets(ctx, connect.NewRequest(&consolev1.ListSecretsRequest{Project: "test-namespace"}))
+	if err != nil {
+		t.Fatalf("ListSecrets: %v", err)
+	}
+	if first.Msg.Etag == "" || first.Header().Get("ETag") != `"`+first.Msg.Etag+`"` {
+		t.Fatalf("expected an etag field and header, got %q and %q", first.Msg.Etag, first.Header().Get("ETag"))
+	}
+
+	// A browser revalidating a GET adds If-None-Match on its own; the
+	// client never asked for an empty not-modified body, so it gets the
+	// listing.
+	req := connect.NewRequest(&consolev1.ListSecretsRequest{Project: "test-namespace"})
+	req.Header().Set("If-None-Match", first.Header().Get("ETag"))
+	revalidated, err := handler.ListSecrets(ctx, req)
+	if err != nil {
+		t.Fatalf("ListSecrets: %v", err)
+	}
+	if revalidated.Msg.NotModified || len(revalidated.Msg.Secrets) != 1 {
+		t.Errorf("expected the If-None-Match header to be ignored, got %v", revalidated.Msg)
+	}
+
+	lookups := len(inviter.lookups)
+	if lookups == 0 {
+		t.Fatal("expected the full listing to look up pending grants")
+	}
+	second, err := handler.ListSecrets(ctx, connect.NewRequest(&consolev1.ListSecretsRequest{Project: "test-namespace", IfNoneMatch: first.Msg.Etag}))
+	if err != nil {
+		t.Fatalf("ListSecrets: %v", err)
+	}
+	if !second.Msg.NotModified || len(second.Msg.Secrets) != 0 || second.Msg.Etag != first.Msg.Etag {
+		t.Errorf("expected a not-modified response, got %v", second.Msg)
+	}
+	// The tag is checked before the listing is built, so pending grants
+	// are not looked up.
+	if got := len(inviter.lookups); got != lookups {
+		t.Errorf("expected no pending-grant lookups for a not-modified listing, got %d", got-lookups)
+	}
+
+	// The fake clientset leaves resource versions alone; bump it the way
+	// the apiserver would.
+	secret.Annotations = map[string]string{v1alpha2.AnnotationDescription: "changed"}
+	secret.ResourceVersion = "2"
+	if _, err := fakeClient.CoreV1().Secrets("prj-test-namespace").Update(context.Background(), secret, metav1.UpdateOptions{}); err != nil {
+		t.Fatalf("updating secret: %v", err)
+	}
+	third, err := handler.ListSecrets(ctx, connect.NewRequest(&consolev1.ListSecretsRequest{Project: "test-namespace", IfNoneMatch: first.Msg.Etag}))
+	if err != nil {
+		t.Fatalf("ListSecrets: %v", err)
+	}
+	if third.Msg.NotModified || len(third.Msg.Secrets) != 1 {
+		t.Errorf("expected the changed listing, got %v", third.Msg)
+	}
+}
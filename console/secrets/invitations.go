@@ -0,0 +1,105 @@
+package secrets
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/holos-run/holos-console/console/principal"
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+)
+
+// Inviter tracks which users have signed in to the console and invites
+// those who have not.
+type Inviter interface {
+	// Known reports whether each of emails has signed in, keyed by email.
+	Known(ctx context.Context, emails []string) (map[string]bool, error)
+	// Invite records a pending invitation of email to resource and notifies
+	// the invitee. It does nothing for users who have already signed in.
+	Invite(ctx context.Context, email, resource string) error
+}
+
+// WithInviter enables pending grant reporting and the invite_users option
+// of UpdateSharing.
+func (h *Handler) WithInviter(i Inviter) *Handler {
+	h.inviter = i
+	return h
+}
+
+// invitableEmail reports whether a user grant principal is an email address
+// that can be invited, as opposed to an OIDC subject or a domain grant.
+func invitableEmail(name string) bool {
+	return !strings.HasPrefix(name, "oidc:") && strings.Contains(name, "@") && !principal.IsPattern(name)
+}
+
+// inviteUsers invites every allow grant principal that has never signed in.
+// Failures are logged: the grants are already stored and take effect on the
+// invitee's first sign-in regardless.
+func (h *Handler) inviteUsers(ctx context.Context, project, name string, grants []*consolev1.ShareGrant) {
+	if h.inviter == nil {
+		return
+	}
+	var emails []string
+	for _, g := range grants {
+		if !g.Deny && invitableEmail(g.Principal) {
+			emails = append(emails, strings.ToLower(g.Principal))
+		}
+	}
+	if len(emails) == 0 {
+		return
+	}
+	known, err := h.inviter.Known(ctx, emails)
+	if err != nil {
+		slog.WarnContext(ctx, "could not look up invitees", slog.Any("error", err))
+		return
+	}
+	resource := "secret/" + project + "/" + name
+	for _, email := range emails {
+		if known[email] {
+			continue
+		}
+		if err := h.inviter.Invite(ctx, email, resource); err != nil {
+			slog.WarnContext(ctx, "could not invite user",
+				slog.String("resource", resource),
+				slog.String("invitee", email),
+				slog.Any("error", err),
+			)
+		}
+	}
+}
+
+// markPending sets pending on the user grants of metadata whose principal
+// has never signed in. It is best effort: on error grants are left as is.
+func (h *Handler) markPending(ctx context.Context, metadata ...*consolev1.SecretMetadata) {
+	if h.inviter == nil {
+		return
+	}
+	var emails []string
+	for _, m := range metadata {
+		for _, g := range m.GetUserGrants() {
+			if !g.Deny && invitableEmail(g.Principal) {
+				emails = append(emails, strings.ToLower(g.Principal))
+			}
+		}
+	}
+	if len(emails) == 0 {
+		return
+	}
+	// A listing repeats the project's grants on every secret; look each
+	// address up once.
+	slices.Sort(emails)
+	emails = slices.Compact(emails)
+	known, err := h.inviter.Known(ctx, emails)
+	if err != nil {
+		slog.WarnContext(ctx, "could not look up pending grants", slog.Any("error", err))
+		return
+	}
+	for _, m := range metadata {
+		for _, g := range m.GetUserGrants() {
+			if !g.Deny && invitableEmail(g.Principal) {
+				g.Pending = !known[strings.ToLower(g.Principal)]
+			}
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package secrets
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"connectrpc.com/connect"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/holos-run/holos-console/console/rpc"
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+)
+
+// fakeInviter knows a fixed set of emails and records invitations.
+type fakeInviter struct {
+	known   map[string]bool
+	invited []string
+	lookups [][]string
+}
+
+func (f *fakeInviter) Known(_ context.Context, emails []string) (map[string]bool, error) {
+	f.lookups = append(f.lookups, emails)
+	result := make(map[string]bool, len(emails))
+	for _, email := range emails {
+		result[email] = f.known[email]
+	}
+	return result, nil
+}
+
+func (f *fakeInviter) Invite(_ context.Context, email, resource string) error {
+	f.invited = append(f.invited, email+" "+resource)
+	return nil
+}
+
+func TestHandler_InviteUsers(t *testing.T) {
+	fakeClient := fake.NewClientset(testProjectNS())
+	inviter := &fakeInviter{known: map[string]bool{"user@example.com": true, "bob@example.com": true}}
+	handler := NewProjectScopedHandler(NewK8sClient(fakeClient, testResolver()), nil).WithInviter(inviter)
+	ctx := rpc.ContextWithClaims(context.Background(), &rpc.Claims{Sub: "u1", Email: "user@example.com"})
+	if _, err := handler.CreateSecret(ctx, connect.NewRequest(&consolev1.CreateSecretRequest{
+		Name:    "db",
+		Project: "test-namespace",
+	})); err != nil {
+		t.Fatalf("CreateSecret: %v", err)
+	}
+	grants := []*consolev1.ShareGrant{
+		{Principal: "bob@example.com", Role: consolev1.Role_ROLE_VIEWER},
+		{Principal: "Carol@example.com", Role: consolev1.Role_ROLE_VIEWER},
+		{Principal: "mallory@example.com", Deny: true},
+	}
+	pending := func(metadata *consolev1.SecretMetadata) []string {
+		var out []string
+		for _, g := range metadata.UserGrants {
+			if g.Pending {
+				out = append(out, g.Principal)
+			}
+		}
+		return out
+	}
+
+	t.Run("reports unknown users as pending", func(t *testing.T) {
+		resp, err := handler.UpdateSharing(ctx, connect.NewRequest(&consolev1.UpdateSharingRequest{
+			Name:       "db",
+			Project:    "test-namespace",
+			UserGrants: grants,
+		}))
+		if err != nil {
+			t.Fatalf("UpdateSharing: %v", err)
+		}
+		if got := pending(resp.Msg.Metadata); !slices.Equal(got, []string{"Carol@example.com"}) {
+			t.Errorf("expected carol to be pending, got %v", got)
+		}
+		if len(inviter.invited) != 0 {
+			t.Errorf("expected no invitations without invite_users, got %v", inviter.invited)
+		}
+	})
+
+	t.Run("invites unknown users", func(t *testing.T) {
+		if _, err := handler.UpdateSharing(ctx, connect.NewRequest(&consolev1.UpdateSharingRequest{
+			Name:        "db",
+			Project:     "test-namespace",
+			UserGrants:  grants,
+			InviteUsers: true,
+		})); err != nil {
+			t.Fatalf("UpdateSharing: %v", err)
+		}
+		if want := []string{"carol@example.com secret/test-namespace/db"}; !slices.Equal(inviter.invited, want) {
+			t.Errorf("expected %v, got %v", want, inviter.invited)
+		}
+	})
+
+	t.Run("lists pending grants", func(t *testing.T) {
+		resp, err := handler.ListSecrets(ctx, connect.NewRequest(&consolev1.ListSecretsRequest{Project: "test-namespace"}))
+		if err != nil {
+			t.Fatalf("ListSecrets: %v", err)
+		}
+		if len(resp.Msg.Secrets) != 1 || len(pending(resp.Msg.Secrets[0])) != 1 {
+			t.Errorf("expected one pending grant, got %v", resp.Msg.Secrets)
+		}
+	})
+
+	t.Run("looks up each address once per listing", func(t *testing.T) {
+		if _, err := handler.CreateSecret(ctx, connect.NewRequest(&consolev1.CreateSecretRequest{
+			Name:    "cache",
+			Project: "test-namespace",
+		})); err != nil {
+			t.Fatalf("CreateSecret: %v", err)
+		}
+		if _, err := handler.ListSecrets(ctx, connect.NewRequest(&consolev1.ListSecretsRequest{Project: "test-namespace"})); err != nil {
+			t.Fatalf("ListSecrets: %v", err)
+		}
+		last := inviter.lookups[len(inviter.lookups)-1]
+		if want := []string{"bob@example.com", "carol@example.com", "user@example.com"}; !slices.Equal(last, want) {
+			t.Errorf("expected %v, got %v", want, last)
+		}
+	})
+}
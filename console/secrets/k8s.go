@@ -2,18 +2,29 @@ package secrets
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
 	"time"
 
 	v1alpha2 "github.com/holos-run/holos-console/api/v1alpha2"
+	"github.com/holos-run/holos-console/console/envelope"
+	"github.com/holos-run/holos-console/console/k8sretry"
+	"github.com/holos-run/holos-console/console/principal"
+	"github.com/holos-run/holos-console/console/rbac"
 	"github.com/holos-run/holos-console/console/resolver"
+	"github.com/holos-run/holos-console/console/rpc"
 	"github.com/holos-run/holos-console/console/secretrbac"
+	"github.com/holos-run/holos-console/console/tracing"
+	"github.com/holos-run/holos-console/console/trash"
+	"go.opentelemetry.io/otel/attribute"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes"
 )
 
@@ -25,21 +36,28 @@ var roleRank = map[string]int{
 }
 
 // DeduplicateGrants merges duplicate principals, keeping the grant with the
-// highest role. Entries with empty principals are dropped. Insertion order of
-// first-seen principals is preserved.
+// highest role. Deny grants are deduplicated separately so a deny is never
+// merged away by an allow for the same principal. Entries with empty
+// principals are dropped. Insertion order of first-seen principals is
+// preserved.
 func DeduplicateGrants(grants []AnnotationGrant) []AnnotationGrant {
-	seen := make(map[string]int) // principal -> index in result
+	type grantKey struct {
+		principal string
+		deny      bool
+	}
+	seen := make(map[grantKey]int) // principal -> index in result
 	result := make([]AnnotationGrant, 0, len(grants))
 	for _, g := range grants {
 		if g.Principal == "" {
 			continue
 		}
-		if idx, ok := seen[g.Principal]; ok {
+		key := grantKey{g.Principal, g.Deny}
+		if idx, ok := seen[key]; ok {
 			if roleRank[g.Role] > roleRank[result[idx].Role] {
 				result[idx] = g
 			}
 		} else {
-			seen[g.Principal] = len(result)
+			seen[key] = len(result)
 			result = append(result, g)
 		}
 	}
@@ -52,12 +70,39 @@ type AnnotationGrant struct {
 	Role      string `json:"role"`
 	Nbf       *int64 `json:"nbf,omitempty"`
 	Exp       *int64 `json:"exp,omitempty"`
+	// Keys restricts the grant to the listed Secret data keys. Empty means
+	// the grant applies to the whole Secret.
+	Keys []string `json:"keys,omitempty"`
+	// Deny excludes the principal instead of granting a role, overriding
+	// any grant that would otherwise apply. Role and Keys are ignored.
+	Deny bool `json:"deny,omitempty"`
 }
 
+// Secret sources reported in SecretMetadata.source.
+const (
+	SourceConsole  = "console"
+	SourceExternal = "external"
+)
+
+// ErrNotManaged is returned when a write targets a secret the console does
+// not manage, including read-only secrets synced by External Secrets Operator.
+var ErrNotManaged = errors.New("secret is not managed by the console")
+
 // K8sClient wraps Kubernetes client operations for secrets.
 type K8sClient struct {
 	client   kubernetes.Interface
 	Resolver *resolver.Resolver
+	// ExternalSecrets includes Secrets synced by External Secrets Operator
+	// in ListSecrets as read-only entries.
+	ExternalSecrets bool
+	// Retry retries reads and read-modify-write updates that fail for
+	// transient reasons. The zero value makes a single attempt.
+	Retry k8sretry.Policy
+	// Envelope, when set, encrypts secret values at rest: writes store them
+	// encrypted under a per-Secret data key and reads decrypt them, so the
+	// API server and etcd only hold ciphertext. Workloads that mount the
+	// Secret directly see the ciphertext too.
+	Envelope *envelope.Envelope
 }
 
 // NewK8sClient creates a client for secrets operations.
@@ -65,35 +110,144 @@ func NewK8sClient(client kubernetes.Interface, r *resolver.Resolver) *K8sClient
 	return &K8sClient{client: client, Resolver: r}
 }
 
+// IsExternal reports whether secret was synced by External Secrets Operator.
+func IsExternal(secret *corev1.Secret) bool {
+	return secret.Labels[v1alpha2.LabelExternalSecretsManaged] == v1alpha2.ExternalSecretsManagedValue
+}
+
+// Source returns the SecretMetadata.source value for secret.
+func Source(secret *corev1.Secret) string {
+	if IsExternal(secret) && secret.Labels[v1alpha2.LabelManagedBy] != v1alpha2.ManagedByValue {
+		return SourceExternal
+	}
+	return SourceConsole
+}
+
+// requireManaged returns an error wrapping ErrNotManaged unless secret carries
+// the console managed-by label.
+func requireManaged(secret *corev1.Secret) error {
+	if secret.Labels[v1alpha2.LabelManagedBy] == v1alpha2.ManagedByValue {
+		return nil
+	}
+	if IsExternal(secret) {
+		return fmt.Errorf("%w: secret %q is synced by External Secrets Operator and is read-only", ErrNotManaged, secret.Name)
+	}
+	return fmt.Errorf("%w: secret %q is not managed by %s", ErrNotManaged, secret.Name, v1alpha2.ManagedByValue)
+}
+
+// requireValidType returns an error if the data of a typed secret no longer
+// satisfies its type, e.g. a kubernetes.io/tls secret without a key pair.
+func requireValidType(secret *corev1.Secret) error {
+	switch secret.Type {
+	case corev1.SecretTypeTLS:
+		return checkTLSData(secret.Data)
+	case corev1.SecretTypeDockerConfigJson:
+		return checkDockerConfigJSON(secret.Data)
+	case corev1.SecretTypeSSHAuth:
+		return checkSSHAuthData(secret.Data)
+	}
+	return nil
+}
+
 // GetSecret retrieves a secret by name from the project's namespace.
-func (c *K8sClient) GetSecret(ctx context.Context, project, name string) (*corev1.Secret, error) {
+// Soft-deleted secrets are reported as NotFound.
+func (c *K8sClient) GetSecret(ctx context.Context, project, name string) (result *corev1.Secret, err error) {
+	err = c.Retry.Do(ctx, "GetSecret", func(ctx context.Context) error {
+		result, err = c.getSecret(ctx, project, name)
+		return err
+	})
+	return result, err
+}
+
+// getSecret makes a single GetSecret attempt.
+func (c *K8sClient) getSecret(ctx context.Context, project, name string) (*corev1.Secret, error) {
+	ctx, span := tracing.Start(ctx, "secrets.K8sClient.GetSecret", attribute.String("project", project), attribute.String("name", name))
+	defer span.End()
 	ns := c.Resolver.ProjectNamespace(project)
 	slog.DebugContext(ctx, "getting secret from kubernetes",
 		slog.String("project", project),
 		slog.String("namespace", ns),
 		slog.String("name", name),
 	)
-	return c.client.CoreV1().Secrets(ns).Get(ctx, name, metav1.GetOptions{})
+	secret, err := c.client.CoreV1().Secrets(ns).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if trash.IsDeleted(secret) {
+		return nil, apierrors.NewNotFound(corev1.Resource("secrets"), name)
+	}
+	if err := c.openSecret(ctx, secret); err != nil {
+		return nil, err
+	}
+	return secret, nil
 }
 
-// ListSecrets retrieves secrets with the console label from the project's namespace.
-func (c *K8sClient) ListSecrets(ctx context.Context, project string) (*corev1.SecretList, error) {
+// ListSecrets retrieves secrets with the console label from the project's
+// namespace, omitting soft-deleted secrets. When ExternalSecrets is set,
+// Secrets synced by External Secrets Operator are appended.
+func (c *K8sClient) ListSecrets(ctx context.Context, project string) (result *corev1.SecretList, err error) {
+	err = c.Retry.Do(ctx, "ListSecrets", func(ctx context.Context) error {
+		result, err = c.listSecrets(ctx, project)
+		return err
+	})
+	return result, err
+}
+
+// listSecrets makes a single ListSecrets attempt.
+func (c *K8sClient) listSecrets(ctx context.Context, project string) (*corev1.SecretList, error) {
+	ctx, span := tracing.Start(ctx, "secrets.K8sClient.ListSecrets", attribute.String("project", project))
+	defer span.End()
 	ns := c.Resolver.ProjectNamespace(project)
-	labelSelector := v1alpha2.LabelManagedBy + "=" + v1alpha2.ManagedByValue
+	labelSelector := v1alpha2.LabelManagedBy + "=" + v1alpha2.ManagedByValue + "," +
+		v1alpha2.LabelDeleted + "!=" + v1alpha2.DeletedValue
 	slog.DebugContext(ctx, "listing secrets from kubernetes",
 		slog.String("project", project),
 		slog.String("namespace", ns),
 		slog.String("labelSelector", labelSelector),
 	)
-	return c.client.CoreV1().Secrets(ns).List(ctx, metav1.ListOptions{
+	list, err := c.client.CoreV1().Secrets(ns).List(ctx, metav1.ListOptions{
 		LabelSelector: labelSelector,
 	})
+	if err != nil {
+		return nil, err
+	}
+	for i := range list.Items {
+		// A secret that cannot be decrypted is still listed so it can be
+		// found and deleted; reading its values reports the error.
+		if err := c.openListed(ctx, &list.Items[i]); err != nil {
+			slog.WarnContext(ctx, "could not decrypt secret",
+				slog.String("project", project),
+				slog.String("name", list.Items[i].Name),
+				slog.Any("error", err),
+			)
+		}
+	}
+	if !c.ExternalSecrets {
+		return list, nil
+	}
+	external, err := c.client.CoreV1().Secrets(ns).List(ctx, metav1.ListOptions{
+		LabelSelector: v1alpha2.LabelExternalSecretsManaged + "=" + v1alpha2.ExternalSecretsManagedValue,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, secret := range external.Items {
+		if Source(&secret) == SourceExternal {
+			list.Items = append(list.Items, secret)
+		}
+	}
+	return list, nil
 }
 
 // CreateSecret creates a new secret with the console managed-by label. Sharing
 // grants are accepted for the stable RPC surface but materialize as
-// RoleBindings through UpdateSharing rather than Secret annotations.
-func (c *K8sClient) CreateSecret(ctx context.Context, project, name string, data map[string][]byte, shareUsers, shareRoles []AnnotationGrant, description, url string) (*corev1.Secret, error) {
+// RoleBindings through UpdateSharing rather than Secret annotations. The
+// creator is recorded in annotations so listings can show provenance, and
+// contentTypes records the media type of uploaded values. An empty
+// secretType creates an Opaque secret.
+func (c *K8sClient) CreateSecret(ctx context.Context, project, name string, data map[string][]byte, shareUsers, shareRoles []AnnotationGrant, description, url, creatorEmail, creatorSubject string, contentTypes map[string]string, secretType corev1.SecretType) (*corev1.Secret, error) {
+	ctx, span := tracing.Start(ctx, "secrets.K8sClient.CreateSecret", attribute.String("project", project), attribute.String("name", name))
+	defer span.End()
 	ns := c.Resolver.ProjectNamespace(project)
 	slog.DebugContext(ctx, "creating secret in kubernetes",
 		slog.String("project", project),
@@ -107,6 +261,12 @@ func (c *K8sClient) CreateSecret(ctx context.Context, project, name string, data
 	if url != "" {
 		annotations[v1alpha2.AnnotationURL] = url
 	}
+	if creatorEmail != "" {
+		annotations[v1alpha2.AnnotationCreatorEmail] = creatorEmail
+	}
+	if creatorSubject != "" {
+		annotations[v1alpha2.AnnotationCreatorSubject] = creatorSubject
+	}
 	secret := &corev1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      name,
@@ -116,25 +276,49 @@ func (c *K8sClient) CreateSecret(ctx context.Context, project, name string, data
 			},
 			Annotations: annotations,
 		},
+		Type: secretType,
 		Data: data,
 	}
-	return c.client.CoreV1().Secrets(ns).Create(ctx, secret, metav1.CreateOptions{})
+	if err := mergeContentTypes(secret, contentTypes); err != nil {
+		return nil, err
+	}
+	if err := requireObjectSize(secret, 0); err != nil {
+		return nil, err
+	}
+	return c.createSecretObject(ctx, secret)
 }
 
 // UpdateSecret replaces the data of an existing secret.
 // Returns FailedPrecondition if the secret does not have the console managed-by label.
 // description and url are optional pointers: nil preserves the existing value, non-nil updates it.
-func (c *K8sClient) UpdateSecret(ctx context.Context, project, name string, data map[string][]byte, description, url *string) (*corev1.Secret, error) {
+// A non-empty contentTypes replaces the recorded media types; an empty one
+// keeps the types of keys that remain in data.
+func (c *K8sClient) UpdateSecret(ctx context.Context, project, name string, data map[string][]byte, description, url *string, contentTypes map[string]string) (result *corev1.Secret, err error) {
+	err = c.Retry.Do(ctx, "UpdateSecret", func(ctx context.Context) error {
+		result, err = c.updateSecret(ctx, project, name, data, description, url, contentTypes)
+		return err
+	})
+	return result, err
+}
+
+// updateSecret makes a single UpdateSecret attempt.
+func (c *K8sClient) updateSecret(ctx context.Context, project, name string, data map[string][]byte, description, url *string, contentTypes map[string]string) (*corev1.Secret, error) {
+	ctx, span := tracing.Start(ctx, "secrets.K8sClient.UpdateSecret", attribute.String("project", project), attribute.String("name", name))
+	defer span.End()
 	slog.DebugContext(ctx, "updating secret in kubernetes",
 		slog.String("project", project),
 		slog.String("name", name),
 	)
-	secret, err := c.GetSecret(ctx, project, name)
+	secret, err := c.getSecret(ctx, project, name)
 	if err != nil {
 		return nil, err
 	}
-	if secret.Labels == nil || secret.Labels[v1alpha2.LabelManagedBy] != v1alpha2.ManagedByValue {
-		return nil, fmt.Errorf("secret %q is not managed by %s", name, v1alpha2.ManagedByValue)
+	current := secretObjectSize(secret)
+	if err := requireManaged(secret); err != nil {
+		return nil, err
+	}
+	if err := requireLocalData(secret); err != nil {
+		return nil, err
 	}
 	secret.Data = data
 	if description != nil || url != nil {
@@ -156,24 +340,184 @@ func (c *K8sClient) UpdateSecret(ctx context.Context, project, name string, data
 			}
 		}
 	}
-	return c.client.CoreV1().Secrets(secret.Namespace).Update(ctx, secret, metav1.UpdateOptions{})
+	if len(contentTypes) > 0 {
+		delete(secret.Annotations, v1alpha2.AnnotationContentTypes)
+	}
+	if err := mergeContentTypes(secret, contentTypes); err != nil {
+		return nil, err
+	}
+	if err := requireValidType(secret); err != nil {
+		return nil, err
+	}
+	if err := requireObjectSize(secret, current); err != nil {
+		return nil, err
+	}
+	return c.updateSecretObject(ctx, secret)
+}
+
+// PatchSecret sets the keys in data and removes the keys in removeKeys,
+// leaving every other key of the secret untouched. contentTypes is merged
+// into the recorded media types. The read-modify-write
+// carries the observed resourceVersion, so a concurrent writer causes a
+// Conflict rather than a lost update; Retry re-applies the patch to a fresh
+// read.
+// Returns FailedPrecondition if the secret does not have the console managed-by label.
+func (c *K8sClient) PatchSecret(ctx context.Context, project, name string, data map[string][]byte, removeKeys []string, contentTypes map[string]string) (result *corev1.Secret, err error) {
+	err = c.Retry.Do(ctx, "PatchSecret", func(ctx context.Context) error {
+		result, err = c.patchSecret(ctx, project, name, data, removeKeys, contentTypes)
+		return err
+	})
+	return result, err
+}
+
+// patchSecret makes a single PatchSecret attempt.
+func (c *K8sClient) patchSecret(ctx context.Context, project, name string, data map[string][]byte, removeKeys []string, contentTypes map[string]string) (*corev1.Secret, error) {
+	ctx, span := tracing.Start(ctx, "secrets.K8sClient.PatchSecret", attribute.String("project", project), attribute.String("name", name))
+	defer span.End()
+	slog.DebugContext(ctx, "patching secret in kubernetes",
+		slog.String("project", project),
+		slog.String("name", name),
+	)
+	secret, err := c.getSecret(ctx, project, name)
+	if err != nil {
+		return nil, err
+	}
+	current := secretObjectSize(secret)
+	if err := requireManaged(secret); err != nil {
+		return nil, err
+	}
+	if err := requireLocalData(secret); err != nil {
+		return nil, err
+	}
+	if secret.Data == nil {
+		secret.Data = make(map[string][]byte, len(data))
+	}
+	for _, key := range removeKeys {
+		delete(secret.Data, key)
+	}
+	for key, value := range data {
+		secret.Data[key] = value
+	}
+	if err := mergeContentTypes(secret, contentTypes); err != nil {
+		return nil, err
+	}
+	if err := requireValidType(secret); err != nil {
+		return nil, err
+	}
+	if err := requireObjectSize(secret, current); err != nil {
+		return nil, err
+	}
+	return c.updateSecretObject(ctx, secret)
+}
+
+// RotateSecret replaces the values of the keys in data and records rotatedAt
+// in the rotated-at annotation. Every key in data must already exist.
+// Returns FailedPrecondition if the secret does not have the console managed-by label.
+func (c *K8sClient) RotateSecret(ctx context.Context, project, name string, data map[string][]byte, rotatedAt time.Time) (result *corev1.Secret, err error) {
+	err = c.Retry.Do(ctx, "RotateSecret", func(ctx context.Context) error {
+		result, err = c.rotateSecret(ctx, project, name, data, rotatedAt)
+		return err
+	})
+	return result, err
+}
+
+// rotateSecret makes a single RotateSecret attempt.
+func (c *K8sClient) rotateSecret(ctx context.Context, project, name string, data map[string][]byte, rotatedAt time.Time) (*corev1.Secret, error) {
+	ctx, span := tracing.Start(ctx, "secrets.K8sClient.RotateSecret", attribute.String("project", project), attribute.String("name", name))
+	defer span.End()
+	slog.DebugContext(ctx, "rotating secret in kubernetes",
+		slog.String("project", project),
+		slog.String("name", name),
+	)
+	secret, err := c.getSecret(ctx, project, name)
+	if err != nil {
+		return nil, err
+	}
+	current := secretObjectSize(secret)
+	if err := requireManaged(secret); err != nil {
+		return nil, err
+	}
+	if err := requireLocalData(secret); err != nil {
+		return nil, err
+	}
+	for key, value := range data {
+		if _, ok := secret.Data[key]; !ok {
+			return nil, apierrors.NewBadRequest(fmt.Sprintf("secret %q has no key %q to rotate", name, key))
+		}
+		secret.Data[key] = value
+	}
+	if secret.Annotations == nil {
+		secret.Annotations = make(map[string]string, 1)
+	}
+	secret.Annotations[v1alpha2.AnnotationRotatedAt] = rotatedAt.UTC().Format(time.RFC3339)
+	if err := requireValidType(secret); err != nil {
+		return nil, err
+	}
+	if err := requireObjectSize(secret, current); err != nil {
+		return nil, err
+	}
+	return c.updateSecretObject(ctx, secret)
+}
+
+// accessRecordInterval is the minimum time between two RecordAccess writes
+// to the same secret, so frequent reads do not turn into frequent writes.
+const accessRecordInterval = time.Hour
+
+// RecordAccess stamps the last-accessed annotations on secret with email and
+// now. It is a no-op for secrets the console does not manage and for secrets
+// already stamped within accessRecordInterval. The annotations are merged in
+// as FieldManagerAccessTracking so the write leaves concurrent updates and
+// the secret's modification time alone.
+func (c *K8sClient) RecordAccess(ctx context.Context, secret *corev1.Secret, email string, now time.Time) error {
+	if requireManaged(secret) != nil {
+		return nil
+	}
+	if last, err := time.Parse(time.RFC3339, secret.Annotations[v1alpha2.AnnotationLastAccessedAt]); err == nil && now.Sub(last) < accessRecordInterval {
+		return nil
+	}
+	ctx, span := tracing.Start(ctx, "secrets.K8sClient.RecordAccess", attribute.String("namespace", secret.Namespace), attribute.String("name", secret.Name))
+	defer span.End()
+	patch, err := json.Marshal(map[string]any{
+		"metadata": map[string]any{
+			"annotations": map[string]string{
+				v1alpha2.AnnotationLastAccessedAt: now.UTC().Format(time.RFC3339),
+				v1alpha2.AnnotationLastAccessedBy: email,
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("marshaling access patch: %w", err)
+	}
+	_, err = c.client.CoreV1().Secrets(secret.Namespace).Patch(ctx, secret.Name, types.MergePatchType, patch, metav1.PatchOptions{
+		FieldManager: v1alpha2.FieldManagerAccessTracking,
+	})
+	return err
 }
 
 // DeleteSecret deletes a secret by name.
 // Returns FailedPrecondition if the secret does not have the console managed-by label.
 func (c *K8sClient) DeleteSecret(ctx context.Context, project, name string) error {
+	return c.Retry.Do(ctx, "DeleteSecret", func(ctx context.Context) error {
+		return c.deleteSecret(ctx, project, name)
+	})
+}
+
+// deleteSecret makes a single DeleteSecret attempt.
+func (c *K8sClient) deleteSecret(ctx context.Context, project, name string) error {
+	ctx, span := tracing.Start(ctx, "secrets.K8sClient.DeleteSecret", attribute.String("project", project), attribute.String("name", name))
+	defer span.End()
 	slog.DebugContext(ctx, "deleting secret from kubernetes",
 		slog.String("project", project),
 		slog.String("name", name),
 	)
-	secret, err := c.GetSecret(ctx, project, name)
+	secret, err := c.getSecret(ctx, project, name)
 	if err != nil {
 		return err
 	}
-	if secret.Labels == nil || secret.Labels[v1alpha2.LabelManagedBy] != v1alpha2.ManagedByValue {
-		return fmt.Errorf("secret %q is not managed by %s", name, v1alpha2.ManagedByValue)
+	if err := requireManaged(secret); err != nil {
+		return err
 	}
-	return c.client.CoreV1().Secrets(secret.Namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	return c.client.CoreV1().Secrets(secret.Namespace).Delete(ctx, name, metav1.DeleteOptions{DryRun: rpc.DryRunFromContext(ctx)})
 }
 
 // UpdateSharing reconciles the project-level Secret RoleBindings represented by
@@ -182,6 +526,8 @@ func (c *K8sClient) DeleteSecret(ctx context.Context, project, name string) erro
 // the RoleBinding objects.
 // Returns FailedPrecondition if the secret does not have the console managed-by label.
 func (c *K8sClient) UpdateSharing(ctx context.Context, project, name string, shareUsers, shareRoles []AnnotationGrant) (*corev1.Secret, error) {
+	ctx, span := tracing.Start(ctx, "secrets.K8sClient.UpdateSharing", attribute.String("project", project), attribute.String("name", name))
+	defer span.End()
 	slog.DebugContext(ctx, "updating sharing on kubernetes secret",
 		slog.String("project", project),
 		slog.String("name", name),
@@ -190,8 +536,8 @@ func (c *K8sClient) UpdateSharing(ctx context.Context, project, name string, sha
 	if err != nil {
 		return nil, err
 	}
-	if secret.Labels == nil || secret.Labels[v1alpha2.LabelManagedBy] != v1alpha2.ManagedByValue {
-		return nil, fmt.Errorf("secret %q is not managed by %s", name, v1alpha2.ManagedByValue)
+	if err := requireManaged(secret); err != nil {
+		return nil, err
 	}
 	if err := c.reconcileProjectSecretRoleBindings(ctx, secret.Namespace, shareUsers, shareRoles); err != nil {
 		return nil, err
@@ -200,6 +546,8 @@ func (c *K8sClient) UpdateSharing(ctx context.Context, project, name string, sha
 }
 
 func (c *K8sClient) ListSharing(ctx context.Context, project string) ([]AnnotationGrant, []AnnotationGrant, error) {
+	ctx, span := tracing.Start(ctx, "secrets.K8sClient.ListSharing", attribute.String("project", project))
+	defer span.End()
 	ns := c.Resolver.ProjectNamespace(project)
 	selector := labels.SelectorFromSet(labels.Set{
 		v1alpha2.LabelManagedBy:     v1alpha2.ManagedByValue,
@@ -209,7 +557,14 @@ func (c *K8sClient) ListSharing(ctx context.Context, project string) ([]Annotati
 	if err != nil {
 		return nil, nil, err
 	}
-	return roleBindingsToGrants(list.Items), roleBindingsToGroupGrants(list.Items), nil
+	users, roles := SharingFromRoleBindings(list.Items)
+	return users, roles, nil
+}
+
+// SharingFromRoleBindings converts project secret sharing RoleBindings, all
+// from one namespace, into user and group grants.
+func SharingFromRoleBindings(bindings []rbacv1.RoleBinding) ([]AnnotationGrant, []AnnotationGrant) {
+	return roleBindingsToGrants(bindings), roleBindingsToGroupGrants(bindings)
 }
 
 func (c *K8sClient) reconcileProjectSecretRoleBindings(ctx context.Context, namespace string, shareUsers, shareRoles []AnnotationGrant) error {
@@ -241,7 +596,7 @@ func (c *K8sClient) reconcileProjectSecretRoleBindings(ctx context.Context, name
 		if _, ok := desired[existing.Name]; ok {
 			continue
 		}
-		if err := c.client.RbacV1().RoleBindings(namespace).Delete(ctx, existing.Name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+		if err := c.client.RbacV1().RoleBindings(namespace).Delete(ctx, existing.Name, metav1.DeleteOptions{DryRun: rpc.DryRunFromContext(ctx)}); err != nil && !apierrors.IsNotFound(err) {
 			return err
 		}
 	}
@@ -254,7 +609,7 @@ func (c *K8sClient) reconcileProjectSecretRoleBindings(ctx context.Context, name
 }
 
 func (c *K8sClient) applyRoleBinding(ctx context.Context, binding *rbacv1.RoleBinding) error {
-	created, err := c.client.RbacV1().RoleBindings(binding.Namespace).Create(ctx, binding, metav1.CreateOptions{})
+	created, err := c.client.RbacV1().RoleBindings(binding.Namespace).Create(ctx, binding, metav1.CreateOptions{DryRun: rpc.DryRunFromContext(ctx)})
 	if err == nil {
 		*binding = *created
 		return nil
@@ -267,10 +622,15 @@ func (c *K8sClient) applyRoleBinding(ctx context.Context, binding *rbacv1.RoleBi
 		return err
 	}
 	if existing.RoleRef != binding.RoleRef {
-		if err := c.client.RbacV1().RoleBindings(binding.Namespace).Delete(ctx, binding.Name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+		if err := c.client.RbacV1().RoleBindings(binding.Namespace).Delete(ctx, binding.Name, metav1.DeleteOptions{DryRun: rpc.DryRunFromContext(ctx)}); err != nil && !apierrors.IsNotFound(err) {
 			return err
 		}
-		created, err := c.client.RbacV1().RoleBindings(binding.Namespace).Create(ctx, binding, metav1.CreateOptions{})
+		if rpc.IsDryRun(ctx) {
+			// The dry-run delete left the binding in place, so a create would
+			// report AlreadyExists. The delete already exercised authorization.
+			return nil
+		}
+		created, err := c.client.RbacV1().RoleBindings(binding.Namespace).Create(ctx, binding, metav1.CreateOptions{DryRun: rpc.DryRunFromContext(ctx)})
 		if err == nil {
 			*binding = *created
 		}
@@ -278,7 +638,7 @@ func (c *K8sClient) applyRoleBinding(ctx context.Context, binding *rbacv1.RoleBi
 	}
 	existing.Labels = binding.Labels
 	existing.Subjects = binding.Subjects
-	updated, err := c.client.RbacV1().RoleBindings(binding.Namespace).Update(ctx, existing, metav1.UpdateOptions{})
+	updated, err := c.client.RbacV1().RoleBindings(binding.Namespace).Update(ctx, existing, metav1.UpdateOptions{DryRun: rpc.DryRunFromContext(ctx)})
 	if err == nil {
 		*binding = *updated
 	}
@@ -298,11 +658,16 @@ func roleBindingsToTargetGrants(bindings []rbacv1.RoleBinding, kind string) []An
 	for _, binding := range bindings {
 		role := secretrbac.RoleFromLabels(binding.Labels, binding.RoleRef.Name)
 		for _, subject := range binding.Subjects {
-			if subject.Kind != kind {
+			subjectKind, name := subject.Kind, secretrbac.UnprefixedPrincipal(subject.Name)
+			if pattern, ok := principal.FromGroup(subject.Name); ok {
+				// A "*" or "*@example.com" user grant is bound to a group.
+				subjectKind, name = rbacv1.UserKind, pattern
+			}
+			if subjectKind != kind {
 				continue
 			}
 			grants = append(grants, AnnotationGrant{
-				Principal: secretrbac.UnprefixedPrincipal(subject.Name),
+				Principal: name,
 				Role:      role,
 			})
 		}
@@ -330,7 +695,8 @@ func GetURL(secret *corev1.Secret) string {
 
 // ActiveGrantsMap filters grants by time window and returns a map of principal → role
 // suitable for passing to CheckAccessGrants. Grants with nbf > now or exp <= now are
-// excluded. Grants with nil nbf/exp have no time restriction.
+// excluded. Grants with nil nbf/exp have no time restriction. Deny grants map
+// to rbac.DenyRole and win over any other grant for the same principal.
 func ActiveGrantsMap(grants []AnnotationGrant, now time.Time) map[string]string {
 	nowUnix := now.Unix()
 	result := make(map[string]string)
@@ -341,7 +707,12 @@ func ActiveGrantsMap(grants []AnnotationGrant, now time.Time) map[string]string
 		if g.Exp != nil && *g.Exp <= nowUnix {
 			continue // expired
 		}
-		if g.Principal != "" {
+		switch {
+		case g.Principal == "" || result[g.Principal] == rbac.DenyRole:
+			// Nothing to record, or already denied.
+		case g.Deny:
+			result[g.Principal] = rbac.DenyRole
+		default:
 			result[g.Principal] = g.Role
 		}
 	}
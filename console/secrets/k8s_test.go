@@ -9,9 +9,12 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
 
 	v1alpha2 "github.com/holos-run/holos-console/api/v1alpha2"
+	"github.com/holos-run/holos-console/console/k8sretry"
 	"github.com/holos-run/holos-console/console/oidc"
 	"github.com/holos-run/holos-console/console/resolver"
 )
@@ -172,7 +175,7 @@ func TestUpdateSecret(t *testing.T) {
 		newData := map[string][]byte{
 			"new-key": []byte("new-value"),
 		}
-		result, err := k8sClient.UpdateSecret(context.Background(), "test-namespace", "my-secret", newData, nil, nil)
+		result, err := k8sClient.UpdateSecret(context.Background(), "test-namespace", "my-secret", newData, nil, nil, nil)
 
 		// Then: Returns updated secret with new data
 		if err != nil {
@@ -193,7 +196,7 @@ func TestUpdateSecret(t *testing.T) {
 		k8sClient := NewK8sClient(fakeClient, testResolver())
 
 		// When: UpdateSecret is called
-		_, err := k8sClient.UpdateSecret(context.Background(), "test-namespace", "missing", map[string][]byte{"k": []byte("v")}, nil, nil)
+		_, err := k8sClient.UpdateSecret(context.Background(), "test-namespace", "missing", map[string][]byte{"k": []byte("v")}, nil, nil, nil)
 
 		// Then: Returns NotFound error
 		if err == nil {
@@ -220,7 +223,7 @@ func TestUpdateSecret(t *testing.T) {
 		k8sClient := NewK8sClient(fakeClient, testResolver())
 
 		// When: UpdateSecret is called
-		_, err := k8sClient.UpdateSecret(context.Background(), "test-namespace", "unmanaged-secret", map[string][]byte{"k": []byte("v")}, nil, nil)
+		_, err := k8sClient.UpdateSecret(context.Background(), "test-namespace", "unmanaged-secret", map[string][]byte{"k": []byte("v")}, nil, nil, nil)
 
 		// Then: Returns error about managed-by label
 		if err == nil {
@@ -243,7 +246,7 @@ func TestCreateSecret(t *testing.T) {
 		data := map[string][]byte{"key": []byte("value")}
 		shareUsers := []AnnotationGrant{{Principal: "alice@example.com", Role: "owner"}}
 		shareRoles := []AnnotationGrant{{Principal: "dev-team", Role: "editor"}}
-		result, err := k8sClient.CreateSecret(context.Background(), "test-namespace", "new-secret", data, shareUsers, shareRoles, "", "")
+		result, err := k8sClient.CreateSecret(context.Background(), "test-namespace", "new-secret", data, shareUsers, shareRoles, "", "", "", "", nil, "")
 
 		// Then: Returns created secret with labels. Sharing is represented by
 		// RoleBindings, not Secret annotations.
@@ -280,7 +283,7 @@ func TestCreateSecret(t *testing.T) {
 		k8sClient := NewK8sClient(fakeClient, testResolver())
 
 		// When: CreateSecret with same name
-		_, err := k8sClient.CreateSecret(context.Background(), "test-namespace", "existing-secret", map[string][]byte{"k": []byte("v")}, nil, nil, "", "")
+		_, err := k8sClient.CreateSecret(context.Background(), "test-namespace", "existing-secret", map[string][]byte{"k": []byte("v")}, nil, nil, "", "", "", "", nil, "")
 
 		// Then: Returns AlreadyExists error
 		if err == nil {
@@ -547,7 +550,7 @@ func TestCreateSecretWithDescriptionAndURL(t *testing.T) {
 		k8sClient := NewK8sClient(fakeClient, testResolver())
 
 		data := map[string][]byte{"key": []byte("value")}
-		result, err := k8sClient.CreateSecret(context.Background(), "test-namespace", "my-secret", data, nil, nil, "DB creds", "https://db.example.com")
+		result, err := k8sClient.CreateSecret(context.Background(), "test-namespace", "my-secret", data, nil, nil, "DB creds", "https://db.example.com", "", "", nil, "")
 		if err != nil {
 			t.Fatalf("expected no error, got %v", err)
 		}
@@ -565,7 +568,7 @@ func TestCreateSecretWithDescriptionAndURL(t *testing.T) {
 		k8sClient := NewK8sClient(fakeClient, testResolver())
 
 		data := map[string][]byte{"key": []byte("value")}
-		result, err := k8sClient.CreateSecret(context.Background(), "test-namespace", "my-secret", data, nil, nil, "", "")
+		result, err := k8sClient.CreateSecret(context.Background(), "test-namespace", "my-secret", data, nil, nil, "", "", "", "", nil, "")
 		if err != nil {
 			t.Fatalf("expected no error, got %v", err)
 		}
@@ -594,7 +597,7 @@ func TestUpdateSecretWithDescriptionAndURL(t *testing.T) {
 
 		desc := "Updated description"
 		url := "https://updated.example.com"
-		result, err := k8sClient.UpdateSecret(context.Background(), "test-namespace", "my-secret", secret.Data, &desc, &url)
+		result, err := k8sClient.UpdateSecret(context.Background(), "test-namespace", "my-secret", secret.Data, &desc, &url, nil)
 		if err != nil {
 			t.Fatalf("expected no error, got %v", err)
 		}
@@ -623,7 +626,7 @@ func TestUpdateSecretWithDescriptionAndURL(t *testing.T) {
 		fakeClient := fake.NewClientset(ns, secret)
 		k8sClient := NewK8sClient(fakeClient, testResolver())
 
-		result, err := k8sClient.UpdateSecret(context.Background(), "test-namespace", "my-secret", secret.Data, nil, nil)
+		result, err := k8sClient.UpdateSecret(context.Background(), "test-namespace", "my-secret", secret.Data, nil, nil, nil)
 		if err != nil {
 			t.Fatalf("expected no error, got %v", err)
 		}
@@ -653,7 +656,7 @@ func TestUpdateSecretWithDescriptionAndURL(t *testing.T) {
 		k8sClient := NewK8sClient(fakeClient, testResolver())
 
 		empty := ""
-		result, err := k8sClient.UpdateSecret(context.Background(), "test-namespace", "my-secret", secret.Data, &empty, &empty)
+		result, err := k8sClient.UpdateSecret(context.Background(), "test-namespace", "my-secret", secret.Data, &empty, &empty, nil)
 		if err != nil {
 			t.Fatalf("expected no error, got %v", err)
 		}
@@ -665,3 +668,36 @@ func TestUpdateSecretWithDescriptionAndURL(t *testing.T) {
 		}
 	})
 }
+
+func TestPatchSecretRetriesConflicts(t *testing.T) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-secret",
+			Namespace: "prj-test-namespace",
+			Labels:    map[string]string{v1alpha2.LabelManagedBy: v1alpha2.ManagedByValue},
+		},
+		Data: map[string][]byte{"a": []byte("1")},
+	}
+	fakeClient := fake.NewClientset(projectNS("test-namespace"), secret)
+	updates := 0
+	fakeClient.PrependReactor("update", "secrets", func(k8stesting.Action) (bool, runtime.Object, error) {
+		updates++
+		if updates == 1 {
+			return true, nil, errors.NewConflict(corev1.Resource("secrets"), "my-secret", nil)
+		}
+		return false, nil, nil
+	})
+	k8sClient := NewK8sClient(fakeClient, testResolver())
+	k8sClient.Retry = k8sretry.Policy{MaxAttempts: 2}
+
+	result, err := k8sClient.PatchSecret(context.Background(), "test-namespace", "my-secret", map[string][]byte{"b": []byte("2")}, nil, nil)
+	if err != nil {
+		t.Fatalf("expected the conflict to be retried, got %v", err)
+	}
+	if updates != 2 {
+		t.Errorf("expected 2 update attempts, got %d", updates)
+	}
+	if string(result.Data["a"]) != "1" || string(result.Data["b"]) != "2" {
+		t.Errorf("expected the patch merged into the re-read secret, got %v", result.Data)
+	}
+}
@@ -0,0 +1,177 @@
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	v1alpha2 "github.com/holos-run/holos-console/api/v1alpha2"
+	"github.com/holos-run/holos-console/console/rbac"
+	"github.com/holos-run/holos-console/console/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// SplitKeyGrants partitions grants into key-scoped grants (non-empty Keys) and
+// whole-secret grants. Key-scoped grants are normalized to the viewer role
+// because they only confer read access through GetSecretKey.
+func SplitKeyGrants(grants []AnnotationGrant) (keyGrants, secretGrants []AnnotationGrant) {
+	for _, g := range grants {
+		if len(g.Keys) == 0 {
+			secretGrants = append(secretGrants, g)
+			continue
+		}
+		g.Role = "viewer"
+		keyGrants = append(keyGrants, g)
+	}
+	return DeduplicateKeyGrants(keyGrants), secretGrants
+}
+
+// DeduplicateKeyGrants merges key-scoped grants for the same principal into a
+// single grant covering the union of their keys. Entries with empty
+// principals or no keys are dropped. Insertion order of first-seen principals
+// is preserved and keys are sorted.
+func DeduplicateKeyGrants(grants []AnnotationGrant) []AnnotationGrant {
+	seen := make(map[string]int) // principal -> index in result
+	result := make([]AnnotationGrant, 0, len(grants))
+	for _, g := range grants {
+		if g.Principal == "" || len(g.Keys) == 0 {
+			continue
+		}
+		if idx, ok := seen[g.Principal]; ok {
+			result[idx].Keys = append(result[idx].Keys, g.Keys...)
+			continue
+		}
+		g.Keys = append([]string(nil), g.Keys...)
+		seen[g.Principal] = len(result)
+		result = append(result, g)
+	}
+	for i := range result {
+		slices.Sort(result[i].Keys)
+		result[i].Keys = slices.Compact(result[i].Keys)
+	}
+	return result
+}
+
+// GetKeyShareUsers returns the key-scoped user grants stored on a secret.
+func GetKeyShareUsers(secret *corev1.Secret) ([]AnnotationGrant, error) {
+	return parseGrantsAnnotation(secret, v1alpha2.AnnotationShareKeyUsers)
+}
+
+// GetKeyShareRoles returns the key-scoped role grants stored on a secret.
+func GetKeyShareRoles(secret *corev1.Secret) ([]AnnotationGrant, error) {
+	return parseGrantsAnnotation(secret, v1alpha2.AnnotationShareKeyRoles)
+}
+
+func parseGrantsAnnotation(secret *corev1.Secret, annotation string) ([]AnnotationGrant, error) {
+	if secret == nil || secret.Annotations == nil {
+		return nil, nil
+	}
+	value, ok := secret.Annotations[annotation]
+	if !ok || value == "" {
+		return nil, nil
+	}
+	var grants []AnnotationGrant
+	if err := json.Unmarshal([]byte(value), &grants); err != nil {
+		return nil, fmt.Errorf("invalid %s annotation on secret %q: %w", annotation, secret.Name, err)
+	}
+	return grants, nil
+}
+
+// UpdateKeySharing replaces the key-scoped sharing grants stored on a secret.
+// The Secret is only written when the grants change, so callers may invoke it
+// unconditionally from UpdateSharing.
+// Returns FailedPrecondition if the secret does not have the console managed-by label.
+func (c *K8sClient) UpdateKeySharing(ctx context.Context, project, name string, keyUsers, keyRoles []AnnotationGrant) (*corev1.Secret, error) {
+	ctx, span := tracing.Start(ctx, "secrets.K8sClient.UpdateKeySharing", attribute.String("project", project), attribute.String("name", name))
+	defer span.End()
+	slog.DebugContext(ctx, "updating key sharing on kubernetes secret",
+		slog.String("project", project),
+		slog.String("name", name),
+	)
+	secret, err := c.GetSecret(ctx, project, name)
+	if err != nil {
+		return nil, err
+	}
+	current := secretObjectSize(secret)
+	if err := requireManaged(secret); err != nil {
+		return nil, err
+	}
+	changedUsers, err := setGrantsAnnotation(secret, v1alpha2.AnnotationShareKeyUsers, DeduplicateKeyGrants(keyUsers))
+	if err != nil {
+		return nil, err
+	}
+	changedRoles, err := setGrantsAnnotation(secret, v1alpha2.AnnotationShareKeyRoles, DeduplicateKeyGrants(keyRoles))
+	if err != nil {
+		return nil, err
+	}
+	if !changedUsers && !changedRoles {
+		return secret, nil
+	}
+	if err := requireObjectSize(secret, current); err != nil {
+		return nil, err
+	}
+	return c.updateSecretObject(ctx, secret)
+}
+
+// setGrantsAnnotation stores grants under annotation, removing the
+// annotation when grants is empty. It reports whether the secret changed.
+func setGrantsAnnotation(secret *corev1.Secret, annotation string, grants []AnnotationGrant) (bool, error) {
+	current, exists := secret.Annotations[annotation]
+	if len(grants) == 0 {
+		if !exists {
+			return false, nil
+		}
+		delete(secret.Annotations, annotation)
+		return true, nil
+	}
+	value, err := json.Marshal(grants)
+	if err != nil {
+		return false, fmt.Errorf("marshaling %s annotation: %w", annotation, err)
+	}
+	if exists && current == string(value) {
+		return false, nil
+	}
+	if secret.Annotations == nil {
+		secret.Annotations = make(map[string]string)
+	}
+	secret.Annotations[annotation] = string(value)
+	return true, nil
+}
+
+// KeyGrantAllows reports whether an active key-scoped grant lets the
+// principal identified by email, sub, and roles read key. User grants match
+// the email case-insensitively or the OIDC subject; role grants match any of
+// roles.
+func KeyGrantAllows(keyUsers, keyRoles []AnnotationGrant, email, sub string, roles []string, key string, now time.Time) bool {
+	for _, g := range keyUsers {
+		principal := strings.TrimPrefix(g.Principal, "oidc:")
+		if !strings.EqualFold(principal, email) && (sub == "" || principal != sub) {
+			continue
+		}
+		if keyGrantActive(g, key, now) {
+			return true
+		}
+	}
+	for _, g := range keyRoles {
+		if !slices.ContainsFunc(roles, func(group string) bool { return rbac.GroupPrincipalMatches(g.Principal, group) }) {
+			continue
+		}
+		if keyGrantActive(g, key, now) {
+			return true
+		}
+	}
+	return false
+}
+
+func keyGrantActive(g AnnotationGrant, key string, now time.Time) bool {
+	if !slices.Contains(g.Keys, key) {
+		return false
+	}
+	_, active := ActiveGrantsMap([]AnnotationGrant{g}, now)[g.Principal]
+	return active
+}
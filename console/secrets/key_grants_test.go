@@ -0,0 +1,255 @@
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	v1alpha2 "github.com/holos-run/holos-console/api/v1alpha2"
+	"github.com/holos-run/holos-console/console/rpc"
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+)
+
+func TestSplitKeyGrants(t *testing.T) {
+	keyGrants, secretGrants := SplitKeyGrants([]AnnotationGrant{
+		{Principal: "alice@example.com", Role: "owner"},
+		{Principal: "bob@example.com", Role: "editor", Keys: []string{"username"}},
+		{Principal: "bob@example.com", Role: "viewer", Keys: []string{"host", "username"}},
+	})
+	if len(secretGrants) != 1 || secretGrants[0].Principal != "alice@example.com" {
+		t.Fatalf("expected alice as the only whole-secret grant, got %+v", secretGrants)
+	}
+	if len(keyGrants) != 1 {
+		t.Fatalf("expected 1 merged key grant, got %+v", keyGrants)
+	}
+	got := keyGrants[0]
+	if got.Role != "viewer" {
+		t.Errorf("expected key grant role viewer, got %q", got.Role)
+	}
+	if len(got.Keys) != 2 || got.Keys[0] != "host" || got.Keys[1] != "username" {
+		t.Errorf("expected keys [host username], got %v", got.Keys)
+	}
+}
+
+func TestKeyGrantAllows(t *testing.T) {
+	now := time.Unix(2000, 0)
+	past := int64(1000)
+	future := int64(3000)
+	users := []AnnotationGrant{
+		{Principal: "alice@example.com", Role: "viewer", Keys: []string{"username"}},
+		{Principal: "oidc:sub-bob", Role: "viewer", Keys: []string{"username"}},
+		{Principal: "carol@example.com", Role: "viewer", Keys: []string{"username"}, Exp: &past},
+		{Principal: "dave@example.com", Role: "viewer", Keys: []string{"username"}, Nbf: &future},
+	}
+	roles := []AnnotationGrant{
+		{Principal: "dba", Role: "viewer", Keys: []string{"password"}},
+		{Principal: "oidc:SRE", Role: "viewer", Keys: []string{"username"}},
+	}
+	cases := []struct {
+		name  string
+		email string
+		sub   string
+		roles []string
+		key   string
+		want  bool
+	}{
+		{name: "email match", email: "Alice@Example.com", key: "username", want: true},
+		{name: "email match other key", email: "alice@example.com", key: "password", want: false},
+		{name: "subject match", email: "bob@example.com", sub: "sub-bob", key: "username", want: true},
+		{name: "expired grant", email: "carol@example.com", key: "username", want: false},
+		{name: "not yet active grant", email: "dave@example.com", key: "username", want: false},
+		{name: "role match", email: "eve@example.com", roles: []string{"dba"}, key: "password", want: true},
+		{name: "no grant", email: "eve@example.com", roles: []string{"dev"}, key: "password", want: false},
+		{name: "prefixed role match ignores case", email: "eve@example.com", roles: []string{"sre"}, key: "username", want: true},
+		{name: "prefixed role other key", email: "eve@example.com", roles: []string{"sre"}, key: "password", want: false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := KeyGrantAllows(users, roles, tc.email, tc.sub, tc.roles, tc.key, now); got != tc.want {
+				t.Fatalf("KeyGrantAllows() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+// keyGrantSecret returns a managed secret carrying a key-scoped grant for
+// viewer@example.com on the username key.
+func keyGrantSecret(t *testing.T) *corev1.Secret {
+	t.Helper()
+	grants, err := json.Marshal([]AnnotationGrant{{Principal: "viewer@example.com", Role: "viewer", Keys: []string{"username"}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "db-creds",
+			Namespace:   "prj-test-namespace",
+			Labels:      map[string]string{v1alpha2.LabelManagedBy: v1alpha2.ManagedByValue},
+			Annotations: map[string]string{v1alpha2.AnnotationShareKeyUsers: string(grants)},
+		},
+		Data: map[string][]byte{"username": []byte("admin"), "password": []byte("hunter2")},
+	}
+}
+
+// forbiddenSecretsClient returns an impersonated client whose secret reads are
+// denied by the API server.
+func forbiddenSecretsClient() *fake.Clientset {
+	client := fake.NewClientset(testProjectNS())
+	client.PrependReactor("get", "secrets", func(k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, k8serrors.NewForbidden(schema.GroupResource{Resource: "secrets"}, "db-creds", nil)
+	})
+	return client
+}
+
+func TestHandler_GetSecretKey(t *testing.T) {
+	cases := []struct {
+		name      string
+		whole     bool
+		email     string
+		key       string
+		wantValue string
+		wantCode  connect.Code
+	}{
+		{name: "whole-secret reader reads any key", whole: true, email: "owner@example.com", key: "password", wantValue: "hunter2"},
+		{name: "key grant reads granted key", email: "viewer@example.com", key: "username", wantValue: "admin"},
+		{name: "key grant cannot read other key", email: "viewer@example.com", key: "password", wantCode: connect.CodePermissionDenied},
+		{name: "no grant denied", email: "stranger@example.com", key: "username", wantCode: connect.CodePermissionDenied},
+		{name: "missing key not found", whole: true, email: "owner@example.com", key: "token", wantCode: connect.CodeNotFound},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			secret := keyGrantSecret(t)
+			impersonated := forbiddenSecretsClient()
+			if tc.whole {
+				impersonated = fake.NewClientset(testProjectNS(), secret)
+			}
+			handler := NewProjectScopedHandler(NewK8sClient(fake.NewClientset(testProjectNS(), secret), testResolver()), nil)
+			ctx := contextWithImpersonatedClient(context.Background(), &rpc.Claims{Sub: "sub-x", Email: tc.email}, impersonated)
+
+			resp, err := handler.GetSecretKey(ctx, connect.NewRequest(&consolev1.GetSecretKeyRequest{
+				Name:    "db-creds",
+				Project: "test-namespace",
+				Key:     tc.key,
+			}))
+			if tc.wantCode != 0 {
+				connectErr, ok := err.(*connect.Error)
+				if !ok {
+					t.Fatalf("expected *connect.Error, got %v", err)
+				}
+				if connectErr.Code() != tc.wantCode {
+					t.Fatalf("expected %v, got %v", tc.wantCode, connectErr.Code())
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if string(resp.Msg.Value) != tc.wantValue {
+				t.Fatalf("expected value %q, got %q", tc.wantValue, resp.Msg.Value)
+			}
+		})
+	}
+}
+
+func TestHandler_GetSecretKey_AuditLogging(t *testing.T) {
+	logHandler := &testLogHandler{}
+	oldLogger := slog.Default()
+	slog.SetDefault(slog.New(logHandler))
+	defer slog.SetDefault(oldLogger)
+
+	secret := keyGrantSecret(t)
+	handler := NewProjectScopedHandler(NewK8sClient(fake.NewClientset(testProjectNS(), secret), testResolver()), nil)
+
+	ctx := contextWithImpersonatedClient(context.Background(), &rpc.Claims{Sub: "sub-viewer", Email: "viewer@example.com"}, forbiddenSecretsClient())
+	if _, err := handler.GetSecretKey(ctx, connect.NewRequest(&consolev1.GetSecretKeyRequest{Name: "db-creds", Project: "test-namespace", Key: "username"})); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	record := logHandler.findRecord("secret_key_access")
+	if record == nil {
+		t.Fatal("expected secret_key_access audit log")
+	}
+	assertResourceType(t, record)
+	if got := findAttr(record, "key"); got != "username" {
+		t.Errorf("expected key=username, got %q", got)
+	}
+
+	if _, err := handler.GetSecretKey(ctx, connect.NewRequest(&consolev1.GetSecretKeyRequest{Name: "db-creds", Project: "test-namespace", Key: "password"})); err == nil {
+		t.Fatal("expected PermissionDenied, got nil")
+	}
+	if record := logHandler.findRecord("secret_key_access_denied"); record == nil {
+		t.Fatal("expected secret_key_access_denied audit log")
+	}
+}
+
+func TestHandler_UpdateSharing_KeyGrants(t *testing.T) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "db-creds",
+			Namespace: "prj-test-namespace",
+			Labels:    map[string]string{v1alpha2.LabelManagedBy: v1alpha2.ManagedByValue},
+		},
+		Data: map[string][]byte{"username": []byte("admin")},
+	}
+	client := fake.NewClientset(testProjectNS(), secret)
+	handler := NewProjectScopedHandler(NewK8sClient(client, testResolver()), nil)
+	ctx := contextWithImpersonatedClient(context.Background(), &rpc.Claims{Sub: "sub-owner", Email: "owner@example.com"}, client)
+
+	resp, err := handler.UpdateSharing(ctx, connect.NewRequest(&consolev1.UpdateSharingRequest{
+		Name:    "db-creds",
+		Project: "test-namespace",
+		UserGrants: []*consolev1.ShareGrant{
+			{Principal: "viewer@example.com", Role: consolev1.Role_ROLE_EDITOR, Keys: []string{"username"}},
+		},
+	}))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	stored, err := client.CoreV1().Secrets("prj-test-namespace").Get(ctx, "db-creds", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyUsers, err := GetKeyShareUsers(stored)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keyUsers) != 1 || keyUsers[0].Principal != "viewer@example.com" || keyUsers[0].Role != "viewer" {
+		t.Fatalf("expected viewer key grant stored on secret, got %+v", keyUsers)
+	}
+
+	var found bool
+	for _, g := range resp.Msg.Metadata.UserGrants {
+		if g.Principal == "viewer@example.com" && len(g.Keys) == 1 && g.Keys[0] == "username" {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatalf("expected key grant in response metadata, got %v", resp.Msg.Metadata.UserGrants)
+	}
+
+	// Clearing the grants removes the annotation.
+	if _, err := handler.UpdateSharing(ctx, connect.NewRequest(&consolev1.UpdateSharingRequest{
+		Name:    "db-creds",
+		Project: "test-namespace",
+	})); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	stored, err = client.CoreV1().Secrets("prj-test-namespace").Get(ctx, "db-creds", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := stored.Annotations[v1alpha2.AnnotationShareKeyUsers]; ok {
+		t.Fatal("expected key grant annotation to be removed")
+	}
+}
@@ -0,0 +1,141 @@
+package secrets
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"connectrpc.com/connect"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Secret metric labels that may be included with NewSecretMetrics. Every
+// counter also carries a method label naming the read path.
+const (
+	MetricLabelProject = "project"
+	MetricLabelSecret  = "secret"
+)
+
+// otherSecret is the secret label value of secrets read after the series
+// limit is reached.
+const otherSecret = "_other"
+
+// SecretMetrics counts secret reads and denied reads for dashboards of hot
+// secrets and anomalous access. The project and secret labels are opt-in,
+// and the secret label is capped at a number of distinct secrets, because
+// every secret would otherwise add a series per method.
+type SecretMetrics struct {
+	reads   *prometheus.CounterVec
+	denials *prometheus.CounterVec
+
+	project    bool
+	secret     bool
+	maxSecrets int
+
+	mu   sync.Mutex
+	seen map[string]bool
+}
+
+// NewSecretMetrics registers the secret read counters with reg. labels
+// selects which of MetricLabelProject and MetricLabelSecret the counters
+// carry. With the secret label, reads of more than maxSecrets distinct
+// secrets are counted under the secret "_other"; zero means no limit.
+func NewSecretMetrics(reg prometheus.Registerer, labels []string, maxSecrets int) (*SecretMetrics, error) {
+	m := &SecretMetrics{maxSecrets: maxSecrets, seen: make(map[string]bool)}
+	names := []string{"method"}
+	for _, l := range labels {
+		switch l {
+		case MetricLabelProject:
+			m.project = true
+		case MetricLabelSecret:
+			m.secret = true
+		default:
+			return nil, fmt.Errorf("unknown secret metric label %q: must be %q or %q", l, MetricLabelProject, MetricLabelSecret)
+		}
+	}
+	if m.project {
+		names = append(names, MetricLabelProject)
+	}
+	if m.secret {
+		names = append(names, MetricLabelSecret)
+	}
+	var err error
+	if m.reads, err = registerCounter(reg, prometheus.CounterOpts{
+		Name: "console_secret_reads_total",
+		Help: "Total number of secret values read, by read method and, when enabled, project and secret.",
+	}, names); err != nil {
+		return nil, err
+	}
+	if m.denials, err = registerCounter(reg, prometheus.CounterOpts{
+		Name: "console_secret_read_denials_total",
+		Help: "Total number of secret reads denied, by read method and, when enabled, project and secret.",
+	}, names); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// registerCounter registers a counter vec, reusing an identical one already
+// registered so the server can be constructed more than once per process.
+func registerCounter(reg prometheus.Registerer, opts prometheus.CounterOpts, labels []string) (*prometheus.CounterVec, error) {
+	c := prometheus.NewCounterVec(opts, labels)
+	if err := reg.Register(c); err != nil {
+		var are prometheus.AlreadyRegisteredError
+		if errors.As(err, &are) {
+			if existing, ok := are.ExistingCollector.(*prometheus.CounterVec); ok {
+				return existing, nil
+			}
+		}
+		return nil, err
+	}
+	return c, nil
+}
+
+// WithMetrics enables the secret read counters.
+func (h *Handler) WithMetrics(m *SecretMetrics) *Handler {
+	h.metrics = m
+	return h
+}
+
+// observeRead counts a read of secret through method: a success as a read,
+// a PermissionDenied error as a denial, and any other error not at all.
+func (m *SecretMetrics) observeRead(method, project, secret string, err error) {
+	if m == nil {
+		return
+	}
+	var counter *prometheus.CounterVec
+	switch {
+	case err == nil:
+		counter = m.reads
+	case connect.CodeOf(err) == connect.CodePermissionDenied:
+		counter = m.denials
+	default:
+		return
+	}
+	values := []string{method}
+	if m.project {
+		values = append(values, project)
+	}
+	if m.secret {
+		values = append(values, m.secretLabel(project, secret))
+	}
+	counter.WithLabelValues(values...).Inc()
+}
+
+// secretLabel returns secret, or otherSecret once maxSecrets other secrets
+// have been counted.
+func (m *SecretMetrics) secretLabel(project, secret string) string {
+	if m.maxSecrets <= 0 {
+		return secret
+	}
+	key := project + "/" + secret
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if !m.seen[key] {
+		if len(m.seen) >= m.maxSecrets {
+			return otherSecret
+		}
+		m.seen[key] = true
+	}
+	return secret
+}
@@ -0,0 +1,96 @@
+package secrets
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	v1alpha2 "github.com/holos-run/holos-console/api/v1alpha2"
+	"github.com/holos-run/holos-console/console/rpc"
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+)
+
+func TestSecretMetrics(t *testing.T) {
+	t.Run("counts reads and denials", func(t *testing.T) {
+		m, err := NewSecretMetrics(prometheus.NewRegistry(), []string{MetricLabelProject, MetricLabelSecret}, 0)
+		if err != nil {
+			t.Fatalf("NewSecretMetrics: %v", err)
+		}
+		m.observeRead("GetSecret", "web", "db", nil)
+		m.observeRead("GetSecret", "web", "db", nil)
+		m.observeRead("GetSecret", "web", "db", connect.NewError(connect.CodePermissionDenied, nil))
+		m.observeRead("GetSecret", "web", "db", connect.NewError(connect.CodeNotFound, nil))
+
+		if got := testutil.ToFloat64(m.reads.WithLabelValues("GetSecret", "web", "db")); got != 2 {
+			t.Errorf("expected 2 reads, got %v", got)
+		}
+		if got := testutil.ToFloat64(m.denials.WithLabelValues("GetSecret", "web", "db")); got != 1 {
+			t.Errorf("expected 1 denial, got %v", got)
+		}
+	})
+
+	t.Run("omits excluded labels", func(t *testing.T) {
+		m, err := NewSecretMetrics(prometheus.NewRegistry(), nil, 0)
+		if err != nil {
+			t.Fatalf("NewSecretMetrics: %v", err)
+		}
+		m.observeRead("GetSecretKey", "web", "db", nil)
+		m.observeRead("GetSecretKey", "api", "token", nil)
+		if got := testutil.ToFloat64(m.reads.WithLabelValues("GetSecretKey")); got != 2 {
+			t.Errorf("expected 2 reads, got %v", got)
+		}
+	})
+
+	t.Run("folds secrets past the limit into _other", func(t *testing.T) {
+		m, err := NewSecretMetrics(prometheus.NewRegistry(), []string{MetricLabelSecret}, 1)
+		if err != nil {
+			t.Fatalf("NewSecretMetrics: %v", err)
+		}
+		m.observeRead("GetSecret", "web", "db", nil)
+		m.observeRead("GetSecret", "web", "cache", nil)
+		m.observeRead("GetSecret", "web", "db", nil)
+		if got := testutil.ToFloat64(m.reads.WithLabelValues("GetSecret", "db")); got != 2 {
+			t.Errorf("expected 2 reads of db, got %v", got)
+		}
+		if got := testutil.ToFloat64(m.reads.WithLabelValues("GetSecret", otherSecret)); got != 1 {
+			t.Errorf("expected 1 read of %s, got %v", otherSecret, got)
+		}
+	})
+
+	t.Run("rejects unknown labels", func(t *testing.T) {
+		if _, err := NewSecretMetrics(prometheus.NewRegistry(), []string{"key"}, 0); err == nil {
+			t.Fatal("expected an error")
+		}
+	})
+}
+
+func TestHandler_GetSecretCountsReads(t *testing.T) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "db-creds",
+			Namespace: "prj-test-namespace",
+			Labels:    map[string]string{v1alpha2.LabelManagedBy: v1alpha2.ManagedByValue},
+		},
+		Data: map[string][]byte{"password": []byte("hunter2")},
+	}
+	client := fake.NewClientset(testProjectNS(), secret)
+	m, err := NewSecretMetrics(prometheus.NewRegistry(), []string{MetricLabelProject, MetricLabelSecret}, 0)
+	if err != nil {
+		t.Fatalf("NewSecretMetrics: %v", err)
+	}
+	handler := NewProjectScopedHandler(NewK8sClient(client, testResolver()), nil).WithMetrics(m)
+	ctx := contextWithImpersonatedClient(context.Background(), &rpc.Claims{Sub: "user-123", Email: "alice@example.com"}, client)
+
+	if _, err := handler.GetSecret(ctx, connect.NewRequest(&consolev1.GetSecretRequest{Name: "db-creds", Project: "test-namespace"})); err != nil {
+		t.Fatalf("GetSecret: %v", err)
+	}
+	if got := testutil.ToFloat64(m.reads.WithLabelValues("GetSecret", "test-namespace", "db-creds")); got != 1 {
+		t.Errorf("expected 1 read, got %v", got)
+	}
+}
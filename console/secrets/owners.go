@@ -0,0 +1,42 @@
+package secrets
+
+import (
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+
+	"github.com/holos-run/holos-console/console/rpc"
+)
+
+// HasActiveOwner reports whether users or roles hold an owner grant that is
+// active at now, so the resource they are stored on can still be managed.
+func HasActiveOwner(users, roles []AnnotationGrant, now time.Time) bool {
+	for _, grants := range [][]AnnotationGrant{users, roles} {
+		for _, role := range ActiveGrantsMap(grants, now) {
+			if role == "owner" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// RequireActiveOwner rejects a grant set for resource, e.g. "organization
+// acme", that leaves no active owner, because recovering an orphaned
+// resource takes direct cluster access. force overrides the check, but only
+// for a platform owner.
+func RequireActiveOwner(claims *rpc.Claims, owners rpc.PlatformOwners, resource string, users, roles []AnnotationGrant, force bool) error {
+	if HasActiveOwner(users, roles, time.Now()) {
+		return nil
+	}
+	if !force {
+		return connect.NewError(connect.CodeFailedPrecondition,
+			fmt.Errorf("the grants leave %s without an active owner; grant owner to a user or group, or have a platform owner set force", resource))
+	}
+	if !owners.Includes(claims) {
+		return connect.NewError(connect.CodePermissionDenied,
+			fmt.Errorf("only platform owners may leave %s without an active owner", resource))
+	}
+	return nil
+}
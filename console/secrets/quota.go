@@ -0,0 +1,200 @@
+package secrets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"strconv"
+
+	"connectrpc.com/connect"
+	"go.opentelemetry.io/otel/attribute"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1alpha2 "github.com/holos-run/holos-console/api/v1alpha2"
+	"github.com/holos-run/holos-console/console/rpc"
+	"github.com/holos-run/holos-console/console/tracing"
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+)
+
+// ErrQuotaExceeded is returned when a write would exceed the project's secret
+// quota.
+var ErrQuotaExceeded = errors.New("project secret quota exceeded")
+
+// GetProjectQuota returns the effective secret quota for project. Each limit
+// is read from the project Namespace annotation, falling back to the same
+// annotation on the organization Namespace. A missing project Namespace
+// yields an unlimited quota so the caller's write reports the real error.
+func (c *K8sClient) GetProjectQuota(ctx context.Context, project string) (*consolev1.ProjectQuota, error) {
+	ctx, span := tracing.Start(ctx, "secrets.K8sClient.GetProjectQuota", attribute.String("project", project))
+	defer span.End()
+	quota := &consolev1.ProjectQuota{}
+	ns, err := c.client.CoreV1().Namespaces().Get(ctx, c.Resolver.ProjectNamespace(project), metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return quota, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var defaults map[string]string
+	if org := ns.Labels[v1alpha2.LabelOrganization]; org != "" {
+		orgNS, err := c.client.CoreV1().Namespaces().Get(ctx, c.Resolver.OrgNamespace(org), metav1.GetOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return nil, err
+		}
+		if err == nil {
+			defaults = orgNS.Annotations
+		}
+	}
+	if quota.MaxSecrets, err = quotaLimit(ns.Annotations, defaults, v1alpha2.AnnotationQuotaMaxSecrets); err != nil {
+		return nil, err
+	}
+	if quota.MaxSecretBytes, err = quotaLimit(ns.Annotations, defaults, v1alpha2.AnnotationQuotaMaxSecretBytes); err != nil {
+		return nil, err
+	}
+	return quota, nil
+}
+
+// GetProjectQuotaUsage measures the console-managed secrets in project.
+func (c *K8sClient) GetProjectQuotaUsage(ctx context.Context, project string) (*consolev1.ProjectQuotaUsage, error) {
+	ctx, span := tracing.Start(ctx, "secrets.K8sClient.GetProjectQuotaUsage", attribute.String("project", project))
+	defer span.End()
+	list, err := c.client.CoreV1().Secrets(c.Resolver.ProjectNamespace(project)).List(ctx, metav1.ListOptions{
+		LabelSelector: v1alpha2.LabelManagedBy + "=" + v1alpha2.ManagedByValue,
+	})
+	if err != nil {
+		return nil, err
+	}
+	usage := &consolev1.ProjectQuotaUsage{Secrets: int64(len(list.Items))}
+	for _, secret := range list.Items {
+		usage.SecretBytes += secretDataSize(secret.Data)
+	}
+	return usage, nil
+}
+
+// CheckProjectQuota returns an error wrapping ErrQuotaExceeded if applying
+// mutate to the data of the secret name would exceed the project's quota.
+// mutate receives a copy of the secret's current data, or an empty map when
+// the secret does not exist yet. Writes that do not grow usage are always
+// allowed, so a project already over a lowered quota can still shrink.
+func (c *K8sClient) CheckProjectQuota(ctx context.Context, project, name string, mutate func(data map[string][]byte)) error {
+	ctx, span := tracing.Start(ctx, "secrets.K8sClient.CheckProjectQuota", attribute.String("project", project), attribute.String("name", name))
+	defer span.End()
+	quota, err := c.GetProjectQuota(ctx, project)
+	if err != nil {
+		return err
+	}
+	if quota.MaxSecrets == 0 && quota.MaxSecretBytes == 0 {
+		return nil
+	}
+	list, err := c.client.CoreV1().Secrets(c.Resolver.ProjectNamespace(project)).List(ctx, metav1.ListOptions{
+		LabelSelector: v1alpha2.LabelManagedBy + "=" + v1alpha2.ManagedByValue,
+	})
+	if err != nil {
+		return err
+	}
+
+	var total, before int64
+	exists := false
+	data := map[string][]byte{}
+	for _, secret := range list.Items {
+		size := secretDataSize(secret.Data)
+		total += size
+		if secret.Name == name {
+			exists = true
+			before = size
+			data = maps.Clone(secret.Data)
+		}
+	}
+	mutate(data)
+	after := secretDataSize(data)
+	count := int64(len(list.Items))
+
+	if !exists && quota.MaxSecrets > 0 && count+1 > quota.MaxSecrets {
+		return fmt.Errorf("%w: project %q allows at most %d secrets", ErrQuotaExceeded, project, quota.MaxSecrets)
+	}
+	if after > before && quota.MaxSecretBytes > 0 && total-before+after > quota.MaxSecretBytes {
+		return fmt.Errorf("%w: project %q allows at most %d bytes of secret data, write would use %d", ErrQuotaExceeded, project, quota.MaxSecretBytes, total-before+after)
+	}
+	return nil
+}
+
+// quotaLimit parses the quota annotation key from annotations, falling back
+// to defaults. A missing annotation is unlimited.
+func quotaLimit(annotations, defaults map[string]string, key string) (int64, error) {
+	raw, ok := annotations[key]
+	if !ok {
+		raw, ok = defaults[key]
+	}
+	if !ok || raw == "" {
+		return 0, nil
+	}
+	n, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("invalid %s annotation %q: must be a non-negative integer", key, raw)
+	}
+	return n, nil
+}
+
+// secretDataSize is the number of bytes quota counts for data: the length of
+// every key plus its value.
+func secretDataSize(data map[string][]byte) int64 {
+	var n int64
+	for key, value := range data {
+		n += int64(len(key) + len(value))
+	}
+	return n
+}
+
+// GetProjectQuota returns the project's effective secret quota and current
+// usage. Usage is measured with the caller's credentials, so the caller must
+// be allowed to list secrets in the project.
+func (h *Handler) GetProjectQuota(
+	ctx context.Context,
+	req *connect.Request[consolev1.GetProjectQuotaRequest],
+) (*connect.Response[consolev1.GetProjectQuotaResponse], error) {
+	project := req.Msg.Project
+
+	// Get claims from context (set by AuthInterceptor)
+	claims := rpc.MustClaims(ctx)
+
+	usage, err := h.requestK8s(ctx).GetProjectQuotaUsage(ctx, project)
+	if err != nil {
+		return nil, mapK8sError(err)
+	}
+	limit, err := h.k8s.GetProjectQuota(ctx, project)
+	if err != nil {
+		return nil, mapK8sError(err)
+	}
+
+	slog.InfoContext(ctx, "project quota read",
+		slog.String("action", "project_quota_read"),
+		slog.String("resource_type", auditResourceType),
+		slog.String("project", project),
+		slog.String("sub", claims.Sub),
+		slog.String("email", claims.Email),
+	)
+
+	return connect.NewResponse(&consolev1.GetProjectQuotaResponse{
+		Limit: limit,
+		Usage: usage,
+	}), nil
+}
+
+// checkQuota enforces the project quota with the console's own credentials,
+// so callers cannot evade it by lacking read access to the quota annotations.
+func (h *Handler) checkQuota(ctx context.Context, project, name string, mutate func(data map[string][]byte)) error {
+	if err := h.k8s.CheckProjectQuota(ctx, project, name, mutate); err != nil {
+		if errors.Is(err, ErrQuotaExceeded) {
+			slog.WarnContext(ctx, "secret quota exceeded",
+				slog.String("project", project),
+				slog.String("secret", name),
+				slog.Any("error", err),
+			)
+		}
+		return mapK8sError(err)
+	}
+	return nil
+}
@@ -0,0 +1,156 @@
+package secrets
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	v1alpha2 "github.com/holos-run/holos-console/api/v1alpha2"
+	"github.com/holos-run/holos-console/console/rpc"
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+)
+
+// quotaFixture returns a fake clientset with an organization Namespace
+// carrying orgQuota, a project Namespace in that organization carrying
+// projectQuota, and one 10-byte console-managed secret named "existing".
+func quotaFixture(orgQuota, projectQuota map[string]string) *fake.Clientset {
+	org := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: "org-acme", Annotations: orgQuota},
+	}
+	project := testProjectNS()
+	project.Labels[v1alpha2.LabelOrganization] = "acme"
+	project.Annotations = projectQuota
+	existing := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "existing",
+			Namespace: "prj-test-namespace",
+			Labels:    map[string]string{v1alpha2.LabelManagedBy: v1alpha2.ManagedByValue},
+		},
+		Data: map[string][]byte{"password": []byte("00")}, // 8 + 2 bytes
+	}
+	return fake.NewClientset(org, project, existing)
+}
+
+func TestK8sClient_GetProjectQuota(t *testing.T) {
+	cases := []struct {
+		name    string
+		org     map[string]string
+		project map[string]string
+		want    *consolev1.ProjectQuota
+		wantErr bool
+	}{
+		{
+			name: "unlimited without annotations",
+			want: &consolev1.ProjectQuota{},
+		},
+		{
+			name: "organization defaults apply",
+			org: map[string]string{
+				v1alpha2.AnnotationQuotaMaxSecrets:     "10",
+				v1alpha2.AnnotationQuotaMaxSecretBytes: "1024",
+			},
+			want: &consolev1.ProjectQuota{MaxSecrets: 10, MaxSecretBytes: 1024},
+		},
+		{
+			name:    "project annotations override organization defaults per limit",
+			org:     map[string]string{v1alpha2.AnnotationQuotaMaxSecrets: "10", v1alpha2.AnnotationQuotaMaxSecretBytes: "1024"},
+			project: map[string]string{v1alpha2.AnnotationQuotaMaxSecrets: "3"},
+			want:    &consolev1.ProjectQuota{MaxSecrets: 3, MaxSecretBytes: 1024},
+		},
+		{
+			name:    "rejects malformed annotation",
+			project: map[string]string{v1alpha2.AnnotationQuotaMaxSecrets: "lots"},
+			wantErr: true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			k8s := NewK8sClient(quotaFixture(tc.org, tc.project), testResolver())
+			got, err := k8s.GetProjectQuota(context.Background(), "test-namespace")
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got %v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("GetProjectQuota: %v", err)
+			}
+			if got.MaxSecrets != tc.want.MaxSecrets || got.MaxSecretBytes != tc.want.MaxSecretBytes {
+				t.Errorf("expected %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestHandler_ProjectQuota(t *testing.T) {
+	claims := &rpc.Claims{Sub: "user-123", Email: "user@example.com"}
+	newHandler := func(projectQuota map[string]string) (*Handler, context.Context) {
+		client := quotaFixture(nil, projectQuota)
+		handler := NewProjectScopedHandler(NewK8sClient(client, testResolver()), nil)
+		return handler, contextWithImpersonatedClient(context.Background(), claims, client)
+	}
+
+	t.Run("GetProjectQuota reports limit and usage", func(t *testing.T) {
+		handler, ctx := newHandler(map[string]string{v1alpha2.AnnotationQuotaMaxSecrets: "5"})
+		resp, err := handler.GetProjectQuota(ctx, connect.NewRequest(&consolev1.GetProjectQuotaRequest{Project: "test-namespace"}))
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if resp.Msg.Limit.MaxSecrets != 5 {
+			t.Errorf("expected max_secrets 5, got %d", resp.Msg.Limit.MaxSecrets)
+		}
+		if resp.Msg.Usage.Secrets != 1 || resp.Msg.Usage.SecretBytes != 10 {
+			t.Errorf("expected 1 secret using 10 bytes, got %v", resp.Msg.Usage)
+		}
+	})
+
+	t.Run("CreateSecret rejects exceeding max secrets", func(t *testing.T) {
+		handler, ctx := newHandler(map[string]string{v1alpha2.AnnotationQuotaMaxSecrets: "1"})
+		_, err := handler.CreateSecret(ctx, connect.NewRequest(&consolev1.CreateSecretRequest{
+			Name:       "second",
+			Project:    "test-namespace",
+			StringData: map[string]string{"k": "v"},
+		}))
+		if connect.CodeOf(err) != connect.CodeResourceExhausted {
+			t.Fatalf("expected ResourceExhausted, got %v", err)
+		}
+	})
+
+	t.Run("UpdateSecret rejects exceeding max bytes", func(t *testing.T) {
+		handler, ctx := newHandler(map[string]string{v1alpha2.AnnotationQuotaMaxSecretBytes: "16"})
+		_, err := handler.UpdateSecret(ctx, connect.NewRequest(&consolev1.UpdateSecretRequest{
+			Name:       "existing",
+			Project:    "test-namespace",
+			StringData: map[string]string{"password": "0123456789"},
+		}))
+		if connect.CodeOf(err) != connect.CodeResourceExhausted {
+			t.Fatalf("expected ResourceExhausted, got %v", err)
+		}
+
+		_, err = handler.UpdateSecret(ctx, connect.NewRequest(&consolev1.UpdateSecretRequest{
+			Name:       "existing",
+			Project:    "test-namespace",
+			StringData: map[string]string{"password": "01234567"},
+		}))
+		if err != nil {
+			t.Fatalf("expected update within quota to succeed, got %v", err)
+		}
+	})
+
+	t.Run("writes that shrink usage are allowed over quota", func(t *testing.T) {
+		handler, ctx := newHandler(map[string]string{v1alpha2.AnnotationQuotaMaxSecretBytes: "4"})
+		_, err := handler.PatchSecret(ctx, connect.NewRequest(&consolev1.PatchSecretRequest{
+			Name:       "existing",
+			Project:    "test-namespace",
+			StringData: map[string]string{"password": "0"},
+		}))
+		if err != nil {
+			t.Fatalf("expected shrinking patch to succeed, got %v", err)
+		}
+	})
+}
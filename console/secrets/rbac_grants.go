@@ -4,6 +4,7 @@ import (
 	"strings"
 
 	"github.com/holos-run/holos-console/console/oidc"
+	"github.com/holos-run/holos-console/console/principal"
 )
 
 // UserIdentity maps a display email principal to the OIDC subject Kubernetes
@@ -30,19 +31,23 @@ func RBACUserGrantsForSubjects(shareUsers []AnnotationGrant, identities ...UserI
 
 	result := make([]AnnotationGrant, 0, len(shareUsers))
 	for _, grant := range shareUsers {
-		principal := strings.TrimSpace(grant.Principal)
-		if principal == "" {
+		name := strings.TrimSpace(grant.Principal)
+		if name == "" {
 			continue
 		}
-		unprefixed := strings.TrimPrefix(principal, "oidc:")
-		if strings.Contains(unprefixed, "@") {
-			if subject := subjectsByEmail[strings.ToLower(unprefixed)]; subject != "" {
-				grant.Principal = subject
-			} else {
+		unprefixed := strings.TrimPrefix(name, "oidc:")
+		switch {
+		case principal.IsPattern(name):
+			// Bound to a group every matching user is impersonated with.
+			grant.Principal = name
+		case strings.Contains(unprefixed, "@"):
+			subject := subjectsByEmail[strings.ToLower(unprefixed)]
+			if subject == "" {
 				continue
 			}
-		} else {
-			grant.Principal = principal
+			grant.Principal = subject
+		default:
+			grant.Principal = name
 		}
 		result = append(result, grant)
 	}
@@ -0,0 +1,58 @@
+package secrets
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+)
+
+// ErrInvalidDockerConfig is returned when a write would leave a
+// kubernetes.io/dockerconfigjson secret without a valid .dockerconfigjson.
+var ErrInvalidDockerConfig = errors.New("invalid docker config secret data")
+
+// dockerConfigJSON is the .dockerconfigjson document of an image pull secret,
+// the format kubectl create secret docker-registry writes.
+type dockerConfigJSON struct {
+	Auths map[string]dockerConfigEntry `json:"auths"`
+}
+
+type dockerConfigEntry struct {
+	Username string `json:"username,omitempty"`
+	Password string `json:"password,omitempty"`
+	Email    string `json:"email,omitempty"`
+	Auth     string `json:"auth,omitempty"`
+}
+
+// buildDockerConfigJSON renders creds as a .dockerconfigjson document.
+func buildDockerConfigJSON(creds *consolev1.RegistryCredentials) ([]byte, error) {
+	return json.Marshal(dockerConfigJSON{Auths: map[string]dockerConfigEntry{
+		creds.Server: {
+			Username: creds.Username,
+			Password: creds.Password,
+			Email:    creds.Email,
+			Auth:     base64.StdEncoding.EncodeToString([]byte(creds.Username + ":" + creds.Password)),
+		},
+	}})
+}
+
+// checkDockerConfigJSON reports whether data holds a .dockerconfigjson
+// document with at least one registry.
+func checkDockerConfigJSON(data map[string][]byte) error {
+	raw, ok := data[corev1.DockerConfigJsonKey]
+	if !ok {
+		return fmt.Errorf("%w: missing %s", ErrInvalidDockerConfig, corev1.DockerConfigJsonKey)
+	}
+	var config dockerConfigJSON
+	if err := json.Unmarshal(raw, &config); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidDockerConfig, err)
+	}
+	if len(config.Auths) == 0 {
+		return fmt.Errorf("%w: %s has no auths", ErrInvalidDockerConfig, corev1.DockerConfigJsonKey)
+	}
+	return nil
+}
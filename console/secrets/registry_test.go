@@ -0,0 +1,123 @@
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"connectrpc.com/connect"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/holos-run/holos-console/console/rpc"
+	"github.com/holos-run/holos-console/console/validation"
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+)
+
+func TestValidateCreateSecret_DockerConfig(t *testing.T) {
+	creds := &consolev1.RegistryCredentials{Server: "ghcr.io", Username: "bot", Password: "token"}
+	config := []byte(`{"auths":{"ghcr.io":{"auth":"Ym90OnRva2Vu"}}}`)
+
+	tests := []struct {
+		name   string
+		msg    *consolev1.CreateSecretRequest
+		field  string
+		reason string
+	}{
+		{
+			name: "registry credentials",
+			msg:  &consolev1.CreateSecretRequest{DockerRegistry: creds},
+		},
+		{
+			name: "supplied document",
+			msg:  &consolev1.CreateSecretRequest{Type: "kubernetes.io/dockerconfigjson", Data: map[string][]byte{".dockerconfigjson": config}},
+		},
+		{
+			name:   "credentials with another type",
+			msg:    &consolev1.CreateSecretRequest{Type: "Opaque", DockerRegistry: creds},
+			field:  "type",
+			reason: validation.ReasonConflict,
+		},
+		{
+			name:   "credentials and document",
+			msg:    &consolev1.CreateSecretRequest{DockerRegistry: creds, Data: map[string][]byte{".dockerconfigjson": config}},
+			field:  validation.Key("data", ".dockerconfigjson"),
+			reason: validation.ReasonConflict,
+		},
+		{
+			name:   "neither",
+			msg:    &consolev1.CreateSecretRequest{Type: "kubernetes.io/dockerconfigjson"},
+			field:  "docker_registry",
+			reason: validation.ReasonRequired,
+		},
+		{
+			name:   "malformed document",
+			msg:    &consolev1.CreateSecretRequest{Type: "kubernetes.io/dockerconfigjson", StringData: map[string]string{".dockerconfigjson": `{"ghcr.io":{}}`}},
+			field:  validation.Key("data", ".dockerconfigjson"),
+			reason: validation.ReasonDockerConfig,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var v validation.Violations
+			validateSecretType(&v, tt.msg)
+			violations := validation.FieldViolations(v.Err())
+			if tt.field == "" {
+				if len(violations) != 0 {
+					t.Fatalf("unexpected violations: %v", violations)
+				}
+				return
+			}
+			if len(violations) != 1 || violations[0].Field != tt.field || violations[0].Reason != tt.reason {
+				t.Fatalf("expected %s %s, got %v", tt.field, tt.reason, violations)
+			}
+		})
+	}
+}
+
+func TestHandler_CreateRegistrySecret(t *testing.T) {
+	fakeClient := fake.NewClientset(testProjectNS())
+	handler := NewProjectScopedHandler(NewK8sClient(fakeClient, testResolver()), nil)
+	ctx := rpc.ContextWithClaims(context.Background(), &rpc.Claims{Sub: "u1", Email: "user@example.com"})
+
+	if _, err := handler.CreateSecret(ctx, connect.NewRequest(&consolev1.CreateSecretRequest{
+		Name:    "pull",
+		Project: "test-namespace",
+		DockerRegistry: &consolev1.RegistryCredentials{
+			Server:   "ghcr.io",
+			Username: "bot",
+			Password: "token",
+			Email:    "bot@example.com",
+		},
+	})); err != nil {
+		t.Fatalf("CreateSecret: %v", err)
+	}
+
+	stored, err := fakeClient.CoreV1().Secrets("prj-test-namespace").Get(ctx, "pull", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	if stored.Type != corev1.SecretTypeDockerConfigJson {
+		t.Errorf("Type = %q", stored.Type)
+	}
+	var config dockerConfigJSON
+	if err := json.Unmarshal(stored.Data[corev1.DockerConfigJsonKey], &config); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	want := dockerConfigEntry{Username: "bot", Password: "token", Email: "bot@example.com", Auth: "Ym90OnRva2Vu"}
+	if got := config.Auths["ghcr.io"]; got != want {
+		t.Errorf("auths[ghcr.io] = %+v, want %+v", got, want)
+	}
+
+	t.Run("patch cannot remove the document", func(t *testing.T) {
+		_, err := handler.PatchSecret(ctx, connect.NewRequest(&consolev1.PatchSecretRequest{
+			Name:       "pull",
+			Project:    "test-namespace",
+			RemoveKeys: []string{corev1.DockerConfigJsonKey},
+		}))
+		if connect.CodeOf(err) != connect.CodeInvalidArgument {
+			t.Fatalf("expected InvalidArgument, got %v", err)
+		}
+	})
+}
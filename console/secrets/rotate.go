@@ -0,0 +1,192 @@
+package secrets
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"maps"
+	"net/http"
+	"net/url"
+	"slices"
+	"time"
+
+	"connectrpc.com/connect"
+
+	v1alpha2 "github.com/holos-run/holos-console/api/v1alpha2"
+	"github.com/holos-run/holos-console/console/permissions"
+	"github.com/holos-run/holos-console/console/rpc"
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+)
+
+const (
+	// rotateVerb is the custom RBAC verb on secrets that gates RotateSecret.
+	rotateVerb = "rotate"
+	// defaultWebhookTimeout bounds a single rotation webhook call.
+	defaultWebhookTimeout = 10 * time.Second
+)
+
+// rotationEvent is the JSON body POSTed to a secret's rotation webhook. It
+// names the rotated keys but never carries their values.
+type rotationEvent struct {
+	Type      string   `json:"type"`
+	Secret    string   `json:"secret"`
+	Project   string   `json:"project"`
+	Keys      []string `json:"keys"`
+	RotatedAt string   `json:"rotatedAt"`
+}
+
+// RotateSecret replaces the values of existing keys with newly generated
+// values, then notifies the secret's rotation webhook, if any. The caller must
+// be allowed the custom rotate verb on the secret.
+func (h *Handler) RotateSecret(
+	ctx context.Context,
+	req *connect.Request[consolev1.RotateSecretRequest],
+) (*connect.Response[consolev1.RotateSecretResponse], error) {
+	// Validate request
+	project := req.Msg.Project
+	if len(req.Msg.Keys) == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("at least one key to rotate is required"))
+	}
+
+	// Get claims from context (set by AuthInterceptor)
+	claims := rpc.MustClaims(ctx)
+	if req.Msg.DryRun {
+		ctx = rpc.ContextWithDryRun(ctx)
+	}
+
+	if err := h.authorizeRotate(ctx, claims, project, req.Msg.Name); err != nil {
+		return nil, err
+	}
+	if err := h.requireNotDenied(ctx, claims, project, req.Msg.Name); err != nil {
+		return nil, err
+	}
+
+	k8s := h.requestK8s(ctx)
+	secret, err := k8s.GetSecret(ctx, project, req.Msg.Name)
+	if err != nil {
+		return nil, mapK8sError(err)
+	}
+	keys := slices.Sorted(maps.Keys(req.Msg.Keys))
+	values := make(map[string]string, len(keys))
+	data := make(map[string][]byte, len(keys))
+	for _, key := range keys {
+		if _, ok := secret.Data[key]; !ok {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("secret %q has no key %q to rotate", req.Msg.Name, key))
+		}
+		value, err := GenerateValue(req.Msg.Keys[key])
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("key %q: %w", key, err))
+		}
+		values[key] = value
+		data[key] = []byte(value)
+	}
+
+	if err := h.checkQuota(ctx, project, req.Msg.Name, func(d map[string][]byte) { maps.Copy(d, data) }); err != nil {
+		return nil, err
+	}
+
+	rotatedAt := time.Now().UTC()
+	if _, err := k8s.RotateSecret(ctx, project, req.Msg.Name, data, rotatedAt); err != nil {
+		return nil, mapK8sError(err)
+	}
+
+	resp := &consolev1.RotateSecretResponse{RotatedValues: values}
+	if webhook := secret.Annotations[v1alpha2.AnnotationRotationWebhook]; webhook != "" && !req.Msg.DryRun {
+		err := h.notifyRotationWebhook(ctx, webhook, rotationEvent{
+			Type:      "secret.rotated",
+			Secret:    req.Msg.Name,
+			Project:   project,
+			Keys:      keys,
+			RotatedAt: rotatedAt.Format(time.RFC3339),
+		})
+		if err != nil {
+			slog.WarnContext(ctx, "rotation webhook failed",
+				slog.String("secret", req.Msg.Name),
+				slog.String("project", project),
+				slog.Any("error", err),
+			)
+			resp.WebhookError = err.Error()
+		} else {
+			resp.WebhookNotified = true
+		}
+	}
+
+	slog.InfoContext(ctx, "secret rotated",
+		slog.String("action", "secret_rotate"),
+		slog.String("resource_type", auditResourceType),
+		slog.String("secret", req.Msg.Name),
+		slog.String("project", project),
+		slog.String("sub", claims.Sub),
+		slog.String("email", claims.Email),
+		slog.Any("keys", keys),
+		slog.Bool("webhook_notified", resp.WebhookNotified),
+		slog.Bool("dry_run", req.Msg.DryRun),
+	)
+
+	return connect.NewResponse(resp), nil
+}
+
+// authorizeRotate asks the API server whether the caller may use the custom
+// rotate verb on the secret. Kubernetes does not enforce custom verbs itself,
+// so the console must check before writing.
+func (h *Handler) authorizeRotate(ctx context.Context, claims *rpc.Claims, project, name string) error {
+	clientset := rpc.ImpersonatedClientsetFromContext(ctx)
+	if clientset == nil {
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("no kubernetes client on request context"))
+	}
+	start := time.Now()
+	perm, err := permissions.Review(ctx, clientset, &consolev1.ResourceAttributes{
+		Verb:      rotateVerb,
+		Resource:  "secrets",
+		Namespace: h.k8s.Resolver.ProjectNamespace(project),
+		Name:      name,
+	})
+	rpc.ObserveGrantResolution(ctx, start)
+	if err != nil {
+		return rpc.MapK8sError(err)
+	}
+	if !perm.Allowed {
+		slog.WarnContext(ctx, "secret rotation denied",
+			slog.String("action", "secret_rotate_denied"),
+			slog.String("resource_type", auditResourceType),
+			slog.String("secret", name),
+			slog.String("project", project),
+			slog.String("sub", claims.Sub),
+			slog.String("email", claims.Email),
+		)
+		return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("not allowed to rotate secret %q", name))
+	}
+	return nil
+}
+
+// notifyRotationWebhook POSTs event to the HTTPS endpoint rawURL. Any non-2xx
+// response is an error.
+func (h *Handler) notifyRotationWebhook(ctx context.Context, rawURL string, event rotationEvent) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return fmt.Errorf("invalid rotation webhook: %w", err)
+	}
+	if u.Scheme != "https" {
+		return fmt.Errorf("rotation webhook must use https, got %q", u.Scheme)
+	}
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("encoding rotation event: %w", err)
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("building rotation webhook request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	resp, err := h.webhookClient.Do(httpReq)
+	if err != nil {
+		return fmt.Errorf("calling rotation webhook: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("rotation webhook returned %s", resp.Status)
+	}
+	return nil
+}
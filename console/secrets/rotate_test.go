@@ -0,0 +1,176 @@
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"connectrpc.com/connect"
+	authzv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	v1alpha2 "github.com/holos-run/holos-console/api/v1alpha2"
+	"github.com/holos-run/holos-console/console/rpc"
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+)
+
+// rotateFixture returns a fake clientset holding a managed secret with the
+// given webhook annotation whose SelfSubjectAccessReviews answer allowed.
+func rotateFixture(webhook string, allowed bool) *fake.Clientset {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "db-creds",
+			Namespace: "prj-test-namespace",
+			Labels:    map[string]string{v1alpha2.LabelManagedBy: v1alpha2.ManagedByValue},
+		},
+		Data: map[string][]byte{"username": []byte("admin"), "password": []byte("old")},
+	}
+	if webhook != "" {
+		secret.Annotations = map[string]string{v1alpha2.AnnotationRotationWebhook: webhook}
+	}
+	client := fake.NewClientset(testProjectNS(), secret)
+	client.PrependReactor("create", "selfsubjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		ssar := action.(k8stesting.CreateAction).GetObject().(*authzv1.SelfSubjectAccessReview)
+		if ssar.Spec.ResourceAttributes.Verb != rotateVerb {
+			return false, nil, nil
+		}
+		ssar.Status = authzv1.SubjectAccessReviewStatus{Allowed: allowed}
+		return true, ssar, nil
+	})
+	return client
+}
+
+func TestHandler_RotateSecret(t *testing.T) {
+	claims := &rpc.Claims{Sub: "user-123", Email: "user@example.com"}
+	keys := map[string]*consolev1.GenerateSpec{"password": {Format: consolev1.GenerateFormat_GENERATE_FORMAT_HEX, Length: 8}}
+
+	t.Run("rotates keys and notifies webhook", func(t *testing.T) {
+		var event rotationEvent
+		server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if err := json.NewDecoder(r.Body).Decode(&event); err != nil {
+				t.Errorf("decoding webhook body: %v", err)
+			}
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		client := rotateFixture(server.URL, true)
+		handler := NewProjectScopedHandler(NewK8sClient(client, testResolver()), nil).WithWebhookClient(server.Client())
+		logHandler := &testLogHandler{}
+		oldLogger := slog.Default()
+		slog.SetDefault(slog.New(logHandler))
+		defer slog.SetDefault(oldLogger)
+
+		ctx := contextWithImpersonatedClient(context.Background(), claims, client)
+		resp, err := handler.RotateSecret(ctx, connect.NewRequest(&consolev1.RotateSecretRequest{
+			Name:    "db-creds",
+			Project: "test-namespace",
+			Keys:    keys,
+		}))
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		rotated := resp.Msg.RotatedValues["password"]
+		if len(rotated) != 16 {
+			t.Fatalf("expected 8 hex-encoded bytes, got %q", rotated)
+		}
+		if !resp.Msg.WebhookNotified || resp.Msg.WebhookError != "" {
+			t.Errorf("expected webhook to be notified, got notified=%v error=%q", resp.Msg.WebhookNotified, resp.Msg.WebhookError)
+		}
+		if event.Secret != "db-creds" || event.Project != "test-namespace" || len(event.Keys) != 1 || event.Keys[0] != "password" {
+			t.Errorf("unexpected webhook event %+v", event)
+		}
+
+		stored, err := client.CoreV1().Secrets("prj-test-namespace").Get(ctx, "db-creds", metav1.GetOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(stored.Data["password"]) != rotated {
+			t.Errorf("expected stored password to match the rotated value")
+		}
+		if string(stored.Data["username"]) != "admin" {
+			t.Errorf("expected username to be untouched, got %q", stored.Data["username"])
+		}
+		if stored.Annotations[v1alpha2.AnnotationRotatedAt] != event.RotatedAt {
+			t.Errorf("expected rotated-at %q, got %q", event.RotatedAt, stored.Annotations[v1alpha2.AnnotationRotatedAt])
+		}
+
+		record := logHandler.findRecord("secret_rotate")
+		if record == nil {
+			t.Fatal("expected secret_rotate audit log")
+		}
+		assertResourceType(t, record)
+		if findAttr(record, "webhook_notified") != "true" {
+			t.Errorf("expected webhook_notified=true, got %q", findAttr(record, "webhook_notified"))
+		}
+	})
+
+	t.Run("webhook failure is reported without failing the rotation", func(t *testing.T) {
+		client := rotateFixture("http://hooks.example.com/rotated", true)
+		handler := NewProjectScopedHandler(NewK8sClient(client, testResolver()), nil)
+		ctx := contextWithImpersonatedClient(context.Background(), claims, client)
+		resp, err := handler.RotateSecret(ctx, connect.NewRequest(&consolev1.RotateSecretRequest{
+			Name:    "db-creds",
+			Project: "test-namespace",
+			Keys:    keys,
+		}))
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if resp.Msg.WebhookNotified || resp.Msg.WebhookError == "" {
+			t.Errorf("expected webhook error for non-https URL, got notified=%v error=%q", resp.Msg.WebhookNotified, resp.Msg.WebhookError)
+		}
+	})
+
+	t.Run("denied without rotate verb", func(t *testing.T) {
+		client := rotateFixture("", false)
+		handler := NewProjectScopedHandler(NewK8sClient(client, testResolver()), nil)
+		logHandler := &testLogHandler{}
+		oldLogger := slog.Default()
+		slog.SetDefault(slog.New(logHandler))
+		defer slog.SetDefault(oldLogger)
+
+		ctx := contextWithImpersonatedClient(context.Background(), claims, client)
+		_, err := handler.RotateSecret(ctx, connect.NewRequest(&consolev1.RotateSecretRequest{
+			Name:    "db-creds",
+			Project: "test-namespace",
+			Keys:    keys,
+		}))
+		if connect.CodeOf(err) != connect.CodePermissionDenied {
+			t.Fatalf("expected PermissionDenied, got %v", err)
+		}
+		record := logHandler.findRecord("secret_rotate_denied")
+		if record == nil {
+			t.Fatal("expected secret_rotate_denied audit log")
+		}
+		assertResourceType(t, record)
+		stored, err := client.CoreV1().Secrets("prj-test-namespace").Get(ctx, "db-creds", metav1.GetOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(stored.Data["password"]) != "old" {
+			t.Errorf("expected password to be unchanged, got %q", stored.Data["password"])
+		}
+	})
+
+	t.Run("rejects keys missing from the secret", func(t *testing.T) {
+		client := rotateFixture("", true)
+		handler := NewProjectScopedHandler(NewK8sClient(client, testResolver()), nil)
+		ctx := contextWithImpersonatedClient(context.Background(), claims, client)
+		_, err := handler.RotateSecret(ctx, connect.NewRequest(&consolev1.RotateSecretRequest{
+			Name:    "db-creds",
+			Project: "test-namespace",
+			Keys:    map[string]*consolev1.GenerateSpec{"api-key": {}},
+		}))
+		if connect.CodeOf(err) != connect.CodeInvalidArgument {
+			t.Fatalf("expected InvalidArgument, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,82 @@
+package secrets
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	v1alpha2 "github.com/holos-run/holos-console/api/v1alpha2"
+	"github.com/holos-run/holos-console/console/nsscope"
+	"github.com/holos-run/holos-console/console/rpc"
+	"github.com/holos-run/holos-console/console/secretrbac"
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+)
+
+// scoped runs call behind the interceptor that keeps requests inside the
+// managed project namespaces.
+func scoped[Req, Res any](p *nsscope.Projects, call func(context.Context, *connect.Request[Req]) (*connect.Response[Res], error)) func(context.Context, *connect.Request[Req]) (*connect.Response[Res], error) {
+	next := nsscope.Interceptor(p)(func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		return call(ctx, req.(*connect.Request[Req]))
+	})
+	return func(ctx context.Context, req *connect.Request[Req]) (*connect.Response[Res], error) {
+		resp, err := next(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		return resp.(*connect.Response[Res]), nil
+	}
+}
+
+func TestHandler_OutOfScopeProject(t *testing.T) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "db",
+			Namespace: "prj-denied",
+			Labels:    map[string]string{v1alpha2.LabelManagedBy: v1alpha2.ManagedByValue},
+		},
+		Data: map[string][]byte{"password": []byte("hunter2")},
+	}
+	fakeClient := fake.NewClientset(projectNS("denied"), secret,
+		secretrbac.RoleBinding("prj-denied", secretrbac.ShareTargetUser, "alice@example.com", secretrbac.RoleOwner, nil))
+	handler := NewProjectScopedHandler(NewK8sClient(fakeClient, testResolver()), nil)
+	scope, err := nsscope.New(nil, []string{"prj-denied"}, "")
+	if err != nil {
+		t.Fatalf("nsscope.New: %v", err)
+	}
+	projects := nsscope.NewProjects(scope, nil, testResolver(), fakeClient)
+	ctx := rpc.ContextWithClaims(context.Background(), &rpc.Claims{Sub: "alice-sub", Email: "alice@example.com"})
+
+	// The caller's grants would allow both calls without the scope.
+	if _, err := handler.GetSecret(ctx, connect.NewRequest(&consolev1.GetSecretRequest{Name: "db", Project: "denied"})); err != nil {
+		t.Fatalf("expected GetSecret to succeed without the scope, got %v", err)
+	}
+
+	t.Run("GetSecret", func(t *testing.T) {
+		_, err := scoped(projects, handler.GetSecret)(ctx, connect.NewRequest(&consolev1.GetSecretRequest{Name: "db", Project: "denied"}))
+		if connect.CodeOf(err) != connect.CodeNotFound {
+			t.Fatalf("expected NotFound, got %v", err)
+		}
+	})
+
+	t.Run("UpdateSecret", func(t *testing.T) {
+		_, err := scoped(projects, handler.UpdateSecret)(ctx, connect.NewRequest(&consolev1.UpdateSecretRequest{
+			Name:    "db",
+			Project: "denied",
+			Data:    map[string][]byte{"password": []byte("changed")},
+		}))
+		if connect.CodeOf(err) != connect.CodeNotFound {
+			t.Fatalf("expected NotFound, got %v", err)
+		}
+		got, err := fakeClient.CoreV1().Secrets("prj-denied").Get(context.Background(), "db", metav1.GetOptions{})
+		if err != nil {
+			t.Fatalf("reading secret: %v", err)
+		}
+		if string(got.Data["password"]) != "hunter2" {
+			t.Errorf("expected the secret to be unchanged, got %q", got.Data["password"])
+		}
+	})
+}
@@ -0,0 +1,208 @@
+package secrets
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"log/slog"
+	"maps"
+	"os"
+
+	"connectrpc.com/connect"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	v1alpha2 "github.com/holos-run/holos-console/api/v1alpha2"
+	"github.com/holos-run/holos-console/console/rpc"
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+)
+
+// sealedSessionKeyBytes is the AES-256 session key size used by the
+// SealedSecrets controller's hybrid encryption.
+const sealedSessionKeyBytes = 32
+
+// Sealer encrypts secrets to a SealedSecrets controller certificate.
+type Sealer struct {
+	cert *x509.Certificate
+	key  *rsa.PublicKey
+}
+
+// NewSealer returns a Sealer for the PEM certificate of a SealedSecrets
+// controller, as printed by kubeseal --fetch-cert.
+func NewSealer(certPEM []byte) (*Sealer, error) {
+	block, _ := pem.Decode(certPEM)
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, fmt.Errorf("sealing certificate: no PEM CERTIFICATE block")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("sealing certificate: %w", err)
+	}
+	key, ok := cert.PublicKey.(*rsa.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("sealing certificate: public key is %T, not RSA", cert.PublicKey)
+	}
+	return &Sealer{cert: cert, key: key}, nil
+}
+
+// LoadSealer reads a SealedSecrets controller certificate from path.
+func LoadSealer(path string) (*Sealer, error) {
+	certPEM, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading sealing certificate: %w", err)
+	}
+	return NewSealer(certPEM)
+}
+
+// Fingerprint returns the hex SHA-256 digest of the certificate.
+func (s *Sealer) Fingerprint() string {
+	sum := sha256.Sum256(s.cert.Raw)
+	return hex.EncodeToString(sum[:])
+}
+
+// sealedSecret is the bitnami.com/v1alpha1 SealedSecret resource.
+type sealedSecret struct {
+	APIVersion string            `json:"apiVersion"`
+	Kind       string            `json:"kind"`
+	Metadata   metav1.ObjectMeta `json:"metadata"`
+	Spec       sealedSecretSpec  `json:"spec"`
+}
+
+type sealedSecretSpec struct {
+	EncryptedData map[string]string    `json:"encryptedData"`
+	Template      sealedSecretTemplate `json:"template"`
+}
+
+type sealedSecretTemplate struct {
+	Metadata metav1.ObjectMeta `json:"metadata"`
+	Type     corev1.SecretType `json:"type,omitempty"`
+}
+
+// Seal returns secret as a strict-scoped SealedSecret manifest. Each value
+// is encrypted with the label namespace/name, so the controller only
+// decrypts it back into the same Secret. The template keeps the secret's
+// labels and annotations except the volatile access-tracking stamps and the
+// Vault path, since the manifest carries the values themselves.
+func (s *Sealer) Seal(secret *corev1.Secret) ([]byte, error) {
+	label := []byte(secret.Namespace + "/" + secret.Name)
+	encrypted := make(map[string]string, len(secret.Data))
+	for key, value := range secret.Data {
+		ciphertext, err := hybridEncrypt(rand.Reader, s.key, value, label)
+		if err != nil {
+			return nil, fmt.Errorf("sealing key %q: %w", key, err)
+		}
+		encrypted[key] = base64.StdEncoding.EncodeToString(ciphertext)
+	}
+	annotations := maps.Clone(secret.Annotations)
+	delete(annotations, v1alpha2.AnnotationLastAccessedAt)
+	delete(annotations, v1alpha2.AnnotationLastAccessedBy)
+	delete(annotations, v1alpha2.AnnotationVaultPath)
+	if len(annotations) == 0 {
+		annotations = nil
+	}
+	return yaml.Marshal(sealedSecret{
+		APIVersion: "bitnami.com/v1alpha1",
+		Kind:       "SealedSecret",
+		Metadata:   metav1.ObjectMeta{Name: secret.Name, Namespace: secret.Namespace},
+		Spec: sealedSecretSpec{
+			EncryptedData: encrypted,
+			Template: sealedSecretTemplate{
+				Metadata: metav1.ObjectMeta{
+					Name:        secret.Name,
+					Namespace:   secret.Namespace,
+					Labels:      secret.Labels,
+					Annotations: annotations,
+				},
+				Type: secret.Type,
+			},
+		},
+	})
+}
+
+// hybridEncrypt implements the SealedSecrets wire format: a random AES-GCM
+// session key is encrypted with RSA-OAEP (SHA-256, with label), prefixed by
+// its big-endian 16-bit length, followed by the plaintext sealed under the
+// session key with a zero nonce. The nonce is safe because every session key
+// encrypts exactly one value.
+func hybridEncrypt(rnd io.Reader, key *rsa.PublicKey, plaintext, label []byte) ([]byte, error) {
+	sessionKey := make([]byte, sealedSessionKeyBytes)
+	if _, err := io.ReadFull(rnd, sessionKey); err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(sessionKey)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	wrapped, err := rsa.EncryptOAEP(sha256.New(), rnd, key, sessionKey, label)
+	if err != nil {
+		return nil, err
+	}
+	out := binary.BigEndian.AppendUint16(nil, uint16(len(wrapped)))
+	out = append(out, wrapped...)
+	return aead.Seal(out, make([]byte, aead.NonceSize()), plaintext, nil), nil
+}
+
+// WithSealer enables ExportSecretSealed with the given certificate.
+func (h *Handler) WithSealer(s *Sealer) *Handler {
+	h.sealer = s
+	return h
+}
+
+// ExportSecretSealed returns the secret sealed to the configured
+// SealedSecrets certificate. The caller must be able to read the secret,
+// since the manifest is derived from its plaintext.
+func (h *Handler) ExportSecretSealed(
+	ctx context.Context,
+	req *connect.Request[consolev1.ExportSecretSealedRequest],
+) (resp *connect.Response[consolev1.ExportSecretSealedResponse], err error) {
+	defer func() { h.metrics.observeRead("ExportSecretSealed", req.Msg.Project, req.Msg.Name, err) }()
+	if h.sealer == nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("sealed export is not configured"))
+	}
+	project := req.Msg.Project
+	claims := rpc.MustClaims(ctx)
+
+	secret, err := h.requestK8s(ctx).GetSecret(ctx, project, req.Msg.Name)
+	if err != nil {
+		return nil, mapK8sError(err)
+	}
+	if err := checkDenied(ctx, claims, secret, project); err != nil {
+		return nil, err
+	}
+	if err := h.readBackend(ctx, secret); err != nil {
+		return nil, err
+	}
+	manifest, err := h.sealer.Seal(secret)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	slog.InfoContext(ctx, "secret exported sealed",
+		slog.String("action", "secret_export_sealed"),
+		slog.String("resource_type", auditResourceType),
+		slog.String("secret", req.Msg.Name),
+		slog.String("project", project),
+		slog.String("sub", claims.Sub),
+		slog.String("email", claims.Email),
+		slog.String("certificate_fingerprint", h.sealer.Fingerprint()),
+	)
+	return connect.NewResponse(&consolev1.ExportSecretSealedResponse{
+		Manifest:               string(manifest),
+		CertificateFingerprint: h.sealer.Fingerprint(),
+	}), nil
+}
@@ -0,0 +1,135 @@
+package secrets
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"k8s.io/client-go/kubernetes/fake"
+	"sigs.k8s.io/yaml"
+
+	"github.com/holos-run/holos-console/console/rpc"
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+)
+
+// testSealer returns a Sealer and the controller private key that opens
+// what it seals.
+func testSealer(t *testing.T) (*Sealer, *rsa.PrivateKey) {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "sealed-secret"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sealer, err := NewSealer(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+	if err != nil {
+		t.Fatalf("NewSealer: %v", err)
+	}
+	return sealer, key
+}
+
+// hybridDecrypt reverses hybridEncrypt as the SealedSecrets controller does.
+func hybridDecrypt(t *testing.T, key *rsa.PrivateKey, ciphertext, label []byte) ([]byte, error) {
+	t.Helper()
+	n := int(binary.BigEndian.Uint16(ciphertext))
+	sessionKey, err := rsa.DecryptOAEP(sha256.New(), rand.Reader, key, ciphertext[2:2+n], label)
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(sessionKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return aead.Open(nil, make([]byte, aead.NonceSize()), ciphertext[2+n:], nil)
+}
+
+func TestHandler_ExportSecretSealed(t *testing.T) {
+	fakeClient := fake.NewClientset(testProjectNS())
+	handler := NewProjectScopedHandler(NewK8sClient(fakeClient, testResolver()), nil)
+	ctx := rpc.ContextWithClaims(context.Background(), &rpc.Claims{Sub: "u1", Email: "user@example.com"})
+	desc := "database credentials"
+	if _, err := handler.CreateSecret(ctx, connect.NewRequest(&consolev1.CreateSecretRequest{
+		Name:        "db",
+		Project:     "test-namespace",
+		Data:        map[string][]byte{"password": []byte("hunter2")},
+		Description: &desc,
+	})); err != nil {
+		t.Fatalf("CreateSecret: %v", err)
+	}
+	req := &consolev1.ExportSecretSealedRequest{Name: "db", Project: "test-namespace"}
+
+	t.Run("requires a certificate", func(t *testing.T) {
+		_, err := handler.ExportSecretSealed(ctx, connect.NewRequest(req))
+		if connect.CodeOf(err) != connect.CodeFailedPrecondition {
+			t.Fatalf("expected FailedPrecondition, got %v", err)
+		}
+	})
+
+	t.Run("seals to the certificate", func(t *testing.T) {
+		sealer, key := testSealer(t)
+		handler.WithSealer(sealer)
+		resp, err := handler.ExportSecretSealed(ctx, connect.NewRequest(req))
+		if err != nil {
+			t.Fatalf("ExportSecretSealed: %v", err)
+		}
+		if resp.Msg.CertificateFingerprint != sealer.Fingerprint() {
+			t.Errorf("CertificateFingerprint = %q", resp.Msg.CertificateFingerprint)
+		}
+		var manifest sealedSecret
+		if err := yaml.Unmarshal([]byte(resp.Msg.Manifest), &manifest); err != nil {
+			t.Fatalf("unmarshal manifest: %v", err)
+		}
+		if manifest.Kind != "SealedSecret" || manifest.Metadata.Namespace != "prj-test-namespace" || manifest.Metadata.Name != "db" {
+			t.Errorf("manifest header: %s %s/%s", manifest.Kind, manifest.Metadata.Namespace, manifest.Metadata.Name)
+		}
+		if got := manifest.Spec.Template.Metadata.Annotations["console.holos.run/description"]; got != "database credentials" {
+			t.Errorf("template description = %q", got)
+		}
+		ciphertext, err := base64.StdEncoding.DecodeString(manifest.Spec.EncryptedData["password"])
+		if err != nil {
+			t.Fatalf("decoding ciphertext: %v", err)
+		}
+		plaintext, err := hybridDecrypt(t, key, ciphertext, []byte("prj-test-namespace/db"))
+		if err != nil || string(plaintext) != "hunter2" {
+			t.Errorf("decrypt = %q, %v", plaintext, err)
+		}
+		if _, err := hybridDecrypt(t, key, ciphertext, []byte("prj-test-namespace/other")); err == nil {
+			t.Error("expected strict scope to reject a different name")
+		}
+	})
+}
+
+func TestNewSealer_RejectsNonRSA(t *testing.T) {
+	if _, err := NewSealer([]byte("not pem")); err == nil {
+		t.Error("expected error for non-PEM input")
+	}
+	certPEM, _ := testKeyPair(t, time.Now().Add(time.Hour))
+	if _, err := NewSealer(certPEM); err == nil {
+		t.Error("expected error for an ECDSA certificate")
+	}
+}
@@ -0,0 +1,380 @@
+package secrets
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"log/slog"
+	"mime"
+	"net/http"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/holos-run/holos-console/console/permissions"
+	"github.com/holos-run/holos-console/console/rpc"
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+)
+
+const (
+	// ShareLinkPath is the HTTP path prefix share links are served under. The
+	// token follows it.
+	ShareLinkPath = "/api/secrets/share/"
+
+	// shareVerb is the custom verb on secrets that permits creating share
+	// links. Only the secret owner role grants it, through its "*" verbs.
+	shareVerb = "share"
+	// shareLinkPrefix marks share link tokens so secret scanners can
+	// recognize them.
+	shareLinkPrefix = "hcs_"
+	// minShareLinkKey is the shortest key file LoadShareLinkKey accepts.
+	minShareLinkKey = 32
+
+	defaultShareLinkTTL = 24 * time.Hour
+	maxShareLinkTTL     = 7 * 24 * time.Hour
+)
+
+// ShareLinks signs and verifies share link tokens. A token is its claims,
+// base64url JSON, followed by an HMAC-SHA256 over them, so links need no
+// storage and every replica sharing the key serves them.
+type ShareLinks struct {
+	key    []byte
+	origin string
+}
+
+// NewShareLinks returns ShareLinks signing with key and building URLs on
+// origin, the public base URL of the console.
+func NewShareLinks(key []byte, origin string) *ShareLinks {
+	return &ShareLinks{key: key, origin: strings.TrimSuffix(origin, "/")}
+}
+
+// LoadShareLinkKey reads a share link signing key file. Any secret of at
+// least 32 bytes is accepted, so `openssl rand -base64 32` output works as
+// is.
+func LoadShareLinkKey(path string) ([]byte, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading share link key: %w", err)
+	}
+	if len(raw) < minShareLinkKey {
+		return nil, fmt.Errorf("share link key %s must hold at least %d bytes", path, minShareLinkKey)
+	}
+	return raw, nil
+}
+
+// GenerateShareLinkKey returns a random share link signing key.
+func GenerateShareLinkKey() []byte {
+	key := make([]byte, minShareLinkKey)
+	_, _ = rand.Read(key)
+	return key
+}
+
+// shareLinkClaims are the claims a share link token carries. UID binds the
+// link to one Secret object, so deleting and re-creating the secret
+// invalidates its links.
+type shareLinkClaims struct {
+	ID        string    `json:"jti"`
+	Project   string    `json:"prj"`
+	Secret    string    `json:"sec"`
+	UID       types.UID `json:"uid"`
+	Keys      []string  `json:"keys,omitempty"`
+	CreatedBy string    `json:"by"`
+	Expires   int64     `json:"exp"`
+}
+
+var (
+	errShareLinkInvalid = stderrors.New("share link is invalid")
+	errShareLinkExpired = stderrors.New("share link has expired")
+)
+
+func (s *ShareLinks) mac(payload string) string {
+	m := hmac.New(sha256.New, s.key)
+	m.Write([]byte(payload))
+	return base64.RawURLEncoding.EncodeToString(m.Sum(nil))
+}
+
+func (s *ShareLinks) sign(c shareLinkClaims) (string, error) {
+	raw, err := json.Marshal(c)
+	if err != nil {
+		return "", err
+	}
+	payload := base64.RawURLEncoding.EncodeToString(raw)
+	return shareLinkPrefix + payload + "." + s.mac(payload), nil
+}
+
+// verify returns the claims of token if its signature is valid and it has
+// not expired at now.
+func (s *ShareLinks) verify(token string, now time.Time) (*shareLinkClaims, error) {
+	payload, sig, ok := strings.Cut(strings.TrimPrefix(token, shareLinkPrefix), ".")
+	if !ok || !strings.HasPrefix(token, shareLinkPrefix) {
+		return nil, errShareLinkInvalid
+	}
+	if !hmac.Equal([]byte(sig), []byte(s.mac(payload))) {
+		return nil, errShareLinkInvalid
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(payload)
+	if err != nil {
+		return nil, errShareLinkInvalid
+	}
+	var c shareLinkClaims
+	if err := json.Unmarshal(raw, &c); err != nil {
+		return nil, errShareLinkInvalid
+	}
+	if !now.Before(time.Unix(c.Expires, 0)) {
+		return nil, errShareLinkExpired
+	}
+	return &c, nil
+}
+
+// url returns the share link for token.
+func (s *ShareLinks) url(token string) string {
+	return s.origin + ShareLinkPath + token
+}
+
+// WithShareLinks enables CreateShareLink and the share link endpoint.
+func (h *Handler) WithShareLinks(s *ShareLinks) *Handler {
+	h.shareLinks = s
+	return h
+}
+
+// CreateShareLink signs a share link for a secret the caller owns.
+func (h *Handler) CreateShareLink(
+	ctx context.Context,
+	req *connect.Request[consolev1.CreateShareLinkRequest],
+) (*connect.Response[consolev1.CreateShareLinkResponse], error) {
+	if h.shareLinks == nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("share links are not enabled"))
+	}
+	project := req.Msg.Project
+	claims := rpc.MustClaims(ctx)
+	// A link reads the secret without any identity, so it must not outlive
+	// or escape the authentication of the user who made it.
+	if claims.TokenID != "" {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("api tokens cannot create share links"))
+	}
+	ttl := time.Duration(req.Msg.TtlSeconds) * time.Second
+	if ttl == 0 {
+		ttl = defaultShareLinkTTL
+	}
+	if ttl > maxShareLinkTTL {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("ttl_seconds must be at most %d", int64(maxShareLinkTTL/time.Second)))
+	}
+
+	secret, err := h.requestK8s(ctx).GetSecret(ctx, project, req.Msg.Name)
+	if err != nil {
+		return nil, mapK8sError(err)
+	}
+	if err := checkDenied(ctx, claims, secret, project); err != nil {
+		return nil, err
+	}
+	if err := h.authorizeShare(ctx, claims, project, req.Msg.Name); err != nil {
+		return nil, err
+	}
+	if BackendPath(secret) == "" {
+		for _, key := range req.Msg.Keys {
+			if _, ok := secret.Data[key]; !ok {
+				return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("secret %q has no key %q", req.Msg.Name, key))
+			}
+		}
+	}
+
+	id := make([]byte, 8)
+	_, _ = rand.Read(id)
+	expires := time.Now().Add(ttl).Truncate(time.Second)
+	token, err := h.shareLinks.sign(shareLinkClaims{
+		ID:        hex.EncodeToString(id),
+		Project:   project,
+		Secret:    req.Msg.Name,
+		UID:       secret.UID,
+		Keys:      req.Msg.Keys,
+		CreatedBy: claims.Email,
+		Expires:   expires.Unix(),
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	slog.InfoContext(ctx, "secret share link created",
+		slog.String("action", "secret_share_link_create"),
+		slog.String("resource_type", auditResourceType),
+		slog.String("secret", req.Msg.Name),
+		slog.String("project", project),
+		slog.String("sub", claims.Sub),
+		slog.String("email", claims.Email),
+		slog.String("link_id", hex.EncodeToString(id)),
+		slog.Any("keys", req.Msg.Keys),
+		slog.String("expires_at", expires.UTC().Format(time.RFC3339)),
+	)
+
+	return connect.NewResponse(&consolev1.CreateShareLinkResponse{
+		Url:       h.shareLinks.url(token),
+		ExpiresAt: expires.UTC().Format(time.RFC3339),
+	}), nil
+}
+
+// authorizeShare asks the API server whether the caller may use the custom
+// share verb on the secret.
+func (h *Handler) authorizeShare(ctx context.Context, claims *rpc.Claims, project, name string) error {
+	clientset := rpc.ImpersonatedClientsetFromContext(ctx)
+	if clientset == nil {
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("no kubernetes client on request context"))
+	}
+	start := time.Now()
+	perm, err := permissions.Review(ctx, clientset, &consolev1.ResourceAttributes{
+		Verb:      shareVerb,
+		Resource:  "secrets",
+		Namespace: h.k8s.Resolver.ProjectNamespace(project),
+		Name:      name,
+	})
+	rpc.ObserveGrantResolution(ctx, start)
+	if err != nil {
+		return rpc.MapK8sError(err)
+	}
+	if !perm.Allowed {
+		slog.WarnContext(ctx, "secret share link denied",
+			slog.String("action", "secret_share_link_denied"),
+			slog.String("resource_type", auditResourceType),
+			slog.String("secret", name),
+			slog.String("project", project),
+			slog.String("sub", claims.Sub),
+			slog.String("email", claims.Email),
+		)
+		return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("not allowed to share secret %q", name))
+	}
+	return nil
+}
+
+// shareLinkResponse is the JSON body of a share link read.
+type shareLinkResponse struct {
+	Project   string            `json:"project"`
+	Secret    string            `json:"secret"`
+	ExpiresAt string            `json:"expiresAt"`
+	Data      map[string][]byte `json:"data"`
+}
+
+// ShareLinkHandler serves GET ShareLinkPath + token. It responds with the
+// shared keys as JSON, values base64 encoded, or with ?key= the raw value of
+// one key as a file attachment. The secret is read with the console's own
+// credentials: the signed link is the authorization.
+func (h *Handler) ShareLinkHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			w.Header().Set("Allow", http.MethodGet)
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		w.Header().Set("Cache-Control", "no-store")
+		w.Header().Set("Referrer-Policy", "no-referrer")
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+		if h.shareLinks == nil {
+			http.NotFound(w, r)
+			return
+		}
+		ctx := r.Context()
+		link, err := h.shareLinks.verify(strings.TrimPrefix(r.URL.Path, ShareLinkPath), time.Now())
+		switch {
+		case stderrors.Is(err, errShareLinkExpired):
+			http.Error(w, err.Error(), http.StatusGone)
+			return
+		case err != nil:
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		secret, err := h.sharedSecret(ctx, link)
+		h.metrics.observeRead("ShareLink", link.Project, link.Secret, err)
+		if err != nil {
+			http.Error(w, err.Error(), connectHTTPStatus(err))
+			return
+		}
+
+		key := r.URL.Query().Get("key")
+		slog.InfoContext(ctx, "secret read through share link",
+			slog.String("action", "secret_share_link_read"),
+			slog.String("resource_type", auditResourceType),
+			slog.String("secret", link.Secret),
+			slog.String("project", link.Project),
+			slog.String("link_id", link.ID),
+			slog.String("created_by", link.CreatedBy),
+			slog.String("key", key),
+			slog.String("remote_addr", r.RemoteAddr),
+		)
+
+		if key != "" {
+			value, ok := secret.Data[key]
+			if !ok {
+				http.Error(w, fmt.Sprintf("share link has no key %q", key), http.StatusNotFound)
+				return
+			}
+			disposition := mime.FormatMediaType("attachment", map[string]string{"filename": key})
+			if disposition == "" {
+				disposition = "attachment"
+			}
+			w.Header().Set("Content-Type", ContentType(secret, key))
+			w.Header().Set("Content-Disposition", disposition)
+			w.Header().Set("Content-Length", strconv.Itoa(len(value)))
+			_, _ = w.Write(value)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(shareLinkResponse{
+			Project:   link.Project,
+			Secret:    link.Secret,
+			ExpiresAt: time.Unix(link.Expires, 0).UTC().Format(time.RFC3339),
+			Data:      secret.Data,
+		})
+	})
+}
+
+// sharedSecret reads the secret a verified link names, restricted to the
+// link's keys. A secret deleted, re-created, or soft-deleted since the link
+// was made is not found.
+func (h *Handler) sharedSecret(ctx context.Context, link *shareLinkClaims) (*corev1.Secret, error) {
+	secret, err := h.k8s.GetSecret(ctx, link.Project, link.Secret)
+	if errors.IsNotFound(err) || (err == nil && secret.UID != link.UID) {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("the shared secret no longer exists"))
+	}
+	if err != nil {
+		return nil, mapK8sError(err)
+	}
+	if err := h.readBackend(ctx, secret); err != nil {
+		return nil, err
+	}
+	if len(link.Keys) > 0 {
+		for key := range secret.Data {
+			if !slices.Contains(link.Keys, key) {
+				delete(secret.Data, key)
+			}
+		}
+	}
+	return secret, nil
+}
+
+// connectHTTPStatus maps a connect error code to the HTTP status the Connect
+// protocol uses for it.
+func connectHTTPStatus(err error) int {
+	switch connect.CodeOf(err) {
+	case connect.CodeNotFound:
+		return http.StatusNotFound
+	case connect.CodeFailedPrecondition:
+		return http.StatusPreconditionFailed
+	case connect.CodePermissionDenied:
+		return http.StatusForbidden
+	case connect.CodeUnavailable:
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,146 @@
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	authzv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	v1alpha2 "github.com/holos-run/holos-console/api/v1alpha2"
+	"github.com/holos-run/holos-console/console/rpc"
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+)
+
+// shareFixture returns a fake clientset holding a managed secret whose
+// SelfSubjectAccessReviews for the share verb answer allowed.
+func shareFixture(allowed bool) *fake.Clientset {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "db-creds",
+			Namespace: "prj-test-namespace",
+			UID:       "uid-1",
+			Labels:    map[string]string{v1alpha2.LabelManagedBy: v1alpha2.ManagedByValue},
+		},
+		Data: map[string][]byte{"username": []byte("admin"), "password": []byte("hunter2")},
+	}
+	client := fake.NewClientset(testProjectNS(), secret)
+	client.PrependReactor("create", "selfsubjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		ssar := action.(k8stesting.CreateAction).GetObject().(*authzv1.SelfSubjectAccessReview)
+		if ssar.Spec.ResourceAttributes.Verb != shareVerb {
+			return false, nil, nil
+		}
+		ssar.Status = authzv1.SubjectAccessReviewStatus{Allowed: allowed}
+		return true, ssar, nil
+	})
+	return client
+}
+
+func readShareLink(t *testing.T, h *Handler, link string) *httptest.ResponseRecorder {
+	t.Helper()
+	path := strings.TrimPrefix(link, "https://console.example.com")
+	rec := httptest.NewRecorder()
+	h.ShareLinkHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+	return rec
+}
+
+func TestHandler_CreateShareLink(t *testing.T) {
+	claims := &rpc.Claims{Sub: "user-123", Email: "owner@example.com"}
+	links := NewShareLinks([]byte(strings.Repeat("k", 32)), "https://console.example.com/")
+
+	t.Run("link reads the shared keys without credentials", func(t *testing.T) {
+		client := shareFixture(true)
+		handler := NewProjectScopedHandler(NewK8sClient(client, testResolver()), nil).WithShareLinks(links)
+		ctx := contextWithImpersonatedClient(context.Background(), claims, client)
+		resp, err := handler.CreateShareLink(ctx, connect.NewRequest(&consolev1.CreateShareLinkRequest{
+			Name:    "db-creds",
+			Project: "test-namespace",
+			Keys:    []string{"password"},
+		}))
+		if err != nil {
+			t.Fatalf("CreateShareLink: %v", err)
+		}
+		if !strings.HasPrefix(resp.Msg.Url, "https://console.example.com"+ShareLinkPath+shareLinkPrefix) {
+			t.Fatalf("unexpected url %q", resp.Msg.Url)
+		}
+
+		rec := readShareLink(t, handler, resp.Msg.Url)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body)
+		}
+		var body shareLinkResponse
+		if err := json.NewDecoder(rec.Body).Decode(&body); err != nil {
+			t.Fatalf("decoding body: %v", err)
+		}
+		if string(body.Data["password"]) != "hunter2" {
+			t.Errorf("expected the shared key, got %v", body.Data)
+		}
+		if _, ok := body.Data["username"]; ok {
+			t.Error("expected keys outside the link to be withheld")
+		}
+
+		rec = readShareLink(t, handler, resp.Msg.Url+"?key=password")
+		if rec.Code != http.StatusOK || rec.Body.String() != "hunter2" {
+			t.Fatalf("expected the raw value, got %d %q", rec.Code, rec.Body)
+		}
+		if got := rec.Header().Get("Cache-Control"); got != "no-store" {
+			t.Errorf("expected Cache-Control no-store, got %q", got)
+		}
+	})
+
+	t.Run("rejects tampered, expired, and stale links", func(t *testing.T) {
+		client := shareFixture(true)
+		handler := NewProjectScopedHandler(NewK8sClient(client, testResolver()), nil).WithShareLinks(links)
+		valid, _ := links.sign(shareLinkClaims{Project: "test-namespace", Secret: "db-creds", UID: "uid-1", Expires: time.Now().Add(time.Hour).Unix()})
+		expired, _ := links.sign(shareLinkClaims{Project: "test-namespace", Secret: "db-creds", UID: "uid-1", Expires: time.Now().Add(-time.Minute).Unix()})
+		stale, _ := links.sign(shareLinkClaims{Project: "test-namespace", Secret: "db-creds", UID: "uid-0", Expires: time.Now().Add(time.Hour).Unix()})
+		other := NewShareLinks([]byte(strings.Repeat("x", 32)), "https://console.example.com")
+		forged, _ := other.sign(shareLinkClaims{Project: "test-namespace", Secret: "db-creds", UID: "uid-1", Expires: time.Now().Add(time.Hour).Unix()})
+
+		cases := map[string]struct {
+			token string
+			want  int
+		}{
+			"valid":   {valid, http.StatusOK},
+			"expired": {expired, http.StatusGone},
+			"stale":   {stale, http.StatusNotFound},
+			"forged":  {forged, http.StatusNotFound},
+			"garbage": {"hcs_nope", http.StatusNotFound},
+		}
+		for name, tc := range cases {
+			if rec := readShareLink(t, handler, ShareLinkPath+tc.token); rec.Code != tc.want {
+				t.Errorf("%s: expected %d, got %d: %s", name, tc.want, rec.Code, rec.Body)
+			}
+		}
+	})
+
+	t.Run("requires the share verb", func(t *testing.T) {
+		client := shareFixture(false)
+		handler := NewProjectScopedHandler(NewK8sClient(client, testResolver()), nil).WithShareLinks(links)
+		ctx := contextWithImpersonatedClient(context.Background(), claims, client)
+		_, err := handler.CreateShareLink(ctx, connect.NewRequest(&consolev1.CreateShareLinkRequest{Name: "db-creds", Project: "test-namespace"}))
+		if connect.CodeOf(err) != connect.CodePermissionDenied {
+			t.Fatalf("expected PermissionDenied, got %v", err)
+		}
+	})
+
+	t.Run("is disabled by default", func(t *testing.T) {
+		client := shareFixture(true)
+		handler := NewProjectScopedHandler(NewK8sClient(client, testResolver()), nil)
+		ctx := contextWithImpersonatedClient(context.Background(), claims, client)
+		_, err := handler.CreateShareLink(ctx, connect.NewRequest(&consolev1.CreateShareLinkRequest{Name: "db-creds", Project: "test-namespace"}))
+		if connect.CodeOf(err) != connect.CodeFailedPrecondition {
+			t.Fatalf("expected FailedPrecondition, got %v", err)
+		}
+	})
+}
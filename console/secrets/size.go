@@ -0,0 +1,92 @@
+package secrets
+
+import (
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+	corev1 "k8s.io/api/core/v1"
+
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+)
+
+// MaxSecretObjectBytes is the most a secret may hold, measured by
+// secretObjectSize. The Kubernetes API server rejects Secrets larger than
+// 1MiB with an opaque error; checking before the write fails fast with the
+// numbers the caller needs instead.
+const MaxSecretObjectBytes = 1 << 20
+
+// MaxSecretKeys is the most data keys a secret may hold. Kubernetes sets no
+// limit, but a secret with more keys is unwieldy to mount or share by key.
+const MaxSecretKeys = 512
+
+// ErrSecretTooLarge is returned when a write would grow a secret past
+// MaxSecretObjectBytes or MaxSecretKeys.
+var ErrSecretTooLarge = errors.New("secret too large")
+
+// SizeError wraps ErrSecretTooLarge with the sizes involved.
+type SizeError struct {
+	Name string
+	// Size is the size the secret would have after the write.
+	Size int64
+	// Current is the size of the secret before the write, 0 when creating.
+	Current int64
+	// Keys is the number of keys the secret would hold after the write.
+	Keys int
+}
+
+func (e *SizeError) Error() string {
+	if e.Keys > MaxSecretKeys {
+		return fmt.Sprintf("%v: secret %q would hold %d keys, the limit is %d", ErrSecretTooLarge, e.Name, e.Keys, MaxSecretKeys)
+	}
+	return fmt.Sprintf("%v: secret %q would grow to %d bytes, the limit is %d and %d bytes remain",
+		ErrSecretTooLarge, e.Name, e.Size, MaxSecretObjectBytes, max(MaxSecretObjectBytes-e.Current, 0))
+}
+
+func (e *SizeError) Unwrap() error { return ErrSecretTooLarge }
+
+// connectError returns e as ResourceExhausted with a SecretTooLarge detail.
+func (e *SizeError) connectError() *connect.Error {
+	cerr := connect.NewError(connect.CodeResourceExhausted, e)
+	detail, err := connect.NewErrorDetail(&consolev1.SecretTooLarge{
+		SizeBytes:      e.Size,
+		CurrentBytes:   e.Current,
+		LimitBytes:     MaxSecretObjectBytes,
+		RemainingBytes: max(MaxSecretObjectBytes-e.Current, 0),
+		KeyCount:       int32(e.Keys),
+		MaxKeys:        MaxSecretKeys,
+	})
+	if err == nil {
+		cerr.AddDetail(detail)
+	}
+	return cerr
+}
+
+// secretObjectSize approximates the stored size of secret: its name, data,
+// labels, and annotations, which include the console's grant annotations.
+// The fixed overhead of the object's encoding is not counted.
+func secretObjectSize(secret *corev1.Secret) int64 {
+	n := int64(len(secret.Name)+len(secret.Namespace)) + secretDataSize(secret.Data)
+	for key, value := range secret.StringData {
+		n += int64(len(key) + len(value))
+	}
+	for key, value := range secret.Labels {
+		n += int64(len(key) + len(value))
+	}
+	for key, value := range secret.Annotations {
+		n += int64(len(key) + len(value))
+	}
+	return n
+}
+
+// requireObjectSize returns a *SizeError if secret exceeds
+// MaxSecretObjectBytes or MaxSecretKeys. current is the size of the secret
+// before the write, reported back so the caller knows its budget.
+func requireObjectSize(secret *corev1.Secret, current int64) error {
+	size := secretObjectSize(secret)
+	keys := len(secret.Data) + len(secret.StringData)
+	if size > MaxSecretObjectBytes || keys > MaxSecretKeys {
+		return &SizeError{Name: secret.Name, Size: size, Current: current, Keys: keys}
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package secrets
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"connectrpc.com/connect"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/holos-run/holos-console/console/rpc"
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+)
+
+// sizeDetail returns the SecretTooLarge detail of err.
+func sizeDetail(t *testing.T, err error) *consolev1.SecretTooLarge {
+	t.Helper()
+	var cerr *connect.Error
+	if !errors.As(err, &cerr) || cerr.Code() != connect.CodeResourceExhausted {
+		t.Fatalf("expected ResourceExhausted, got %v", err)
+	}
+	for _, d := range cerr.Details() {
+		msg, err := d.Value()
+		if err != nil {
+			t.Fatalf("decoding detail: %v", err)
+		}
+		if detail, ok := msg.(*consolev1.SecretTooLarge); ok {
+			return detail
+		}
+	}
+	t.Fatalf("expected a SecretTooLarge detail on %v", err)
+	return nil
+}
+
+func TestSecretSizeLimit(t *testing.T) {
+	fakeClient := fake.NewClientset(testProjectNS())
+	handler := NewProjectScopedHandler(NewK8sClient(fakeClient, testResolver()), nil)
+	ctx := rpc.ContextWithClaims(context.Background(), &rpc.Claims{Sub: "u1", Email: "user@example.com"})
+
+	half := bytes.Repeat([]byte("a"), MaxSecretObjectBytes/2)
+	_, err := handler.CreateSecret(ctx, connect.NewRequest(&consolev1.CreateSecretRequest{
+		Name:    "large",
+		Project: "test-namespace",
+		Data:    map[string][]byte{"a": half, "b": half},
+	}))
+	detail := sizeDetail(t, err)
+	if detail.CurrentBytes != 0 || detail.SizeBytes <= MaxSecretObjectBytes || detail.RemainingBytes != MaxSecretObjectBytes {
+		t.Errorf("unexpected detail for a create %v", detail)
+	}
+
+	if _, err := handler.CreateSecret(ctx, connect.NewRequest(&consolev1.CreateSecretRequest{
+		Name:    "large",
+		Project: "test-namespace",
+		Data:    map[string][]byte{"a": half},
+	})); err != nil {
+		t.Fatalf("CreateSecret: %v", err)
+	}
+	resp, err := handler.ListSecrets(ctx, connect.NewRequest(&consolev1.ListSecretsRequest{Project: "test-namespace"}))
+	if err != nil {
+		t.Fatalf("ListSecrets: %v", err)
+	}
+	md := resp.Msg.Secrets[0]
+	if md.SizeBytes <= MaxSecretObjectBytes/2 || md.SizeLimitBytes != MaxSecretObjectBytes || md.KeyCount != 1 {
+		t.Errorf("unexpected size metadata %d/%d, %d keys", md.SizeBytes, md.SizeLimitBytes, md.KeyCount)
+	}
+
+	_, err = handler.UpdateSecret(ctx, connect.NewRequest(&consolev1.UpdateSecretRequest{
+		Name:    "large",
+		Project: "test-namespace",
+		Data:    map[string][]byte{"a": half, "b": half},
+	}))
+	detail = sizeDetail(t, err)
+	if detail.CurrentBytes != md.SizeBytes || detail.RemainingBytes != MaxSecretObjectBytes-md.SizeBytes {
+		t.Errorf("expected the budget left by the stored secret, got %v", detail)
+	}
+
+	data := make(map[string][]byte, MaxSecretKeys+1)
+	for i := range MaxSecretKeys + 1 {
+		data[fmt.Sprintf("k%d", i)] = []byte("v")
+	}
+	_, err = handler.CreateSecret(ctx, connect.NewRequest(&consolev1.CreateSecretRequest{
+		Name:    "many",
+		Project: "test-namespace",
+		Data:    data,
+	}))
+	if detail := sizeDetail(t, err); detail.KeyCount != MaxSecretKeys+1 || detail.MaxKeys != MaxSecretKeys {
+		t.Errorf("unexpected key count detail %v", detail)
+	}
+}
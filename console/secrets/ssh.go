@@ -0,0 +1,140 @@
+package secrets
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"connectrpc.com/connect"
+	"golang.org/x/crypto/ssh"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/holos-run/holos-console/console/rpc"
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+)
+
+// sshPublicKeyKey is the data key holding the public half of a generated
+// kubernetes.io/ssh-auth key pair. Kubernetes only defines ssh-privatekey.
+const sshPublicKeyKey = "ssh-publickey"
+
+// ErrInvalidSSHKey is returned when a write would leave a
+// kubernetes.io/ssh-auth secret without a parseable private key.
+var ErrInvalidSSHKey = errors.New("invalid SSH secret data")
+
+// CreateSSHKeySecret generates an ed25519 key pair and stores it as a
+// kubernetes.io/ssh-auth secret through CreateSecret, so the usual grants,
+// quota and audit apply. Only the public key is returned.
+func (h *Handler) CreateSSHKeySecret(
+	ctx context.Context,
+	req *connect.Request[consolev1.CreateSSHKeySecretRequest],
+) (*connect.Response[consolev1.CreateSSHKeySecretResponse], error) {
+	claims := rpc.MustClaims(ctx)
+	msg := req.Msg
+
+	privateKey, publicKey, err := generateSSHKeyPair(msg.Comment)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if _, err := h.CreateSecret(ctx, connect.NewRequest(&consolev1.CreateSecretRequest{
+		Name:    msg.Name,
+		Project: msg.Project,
+		Type:    string(corev1.SecretTypeSSHAuth),
+		Data: map[string][]byte{
+			corev1.SSHAuthPrivateKey: privateKey,
+			sshPublicKeyKey:          publicKey,
+		},
+		UserGrants:  msg.UserGrants,
+		RoleGrants:  msg.RoleGrants,
+		Description: msg.Description,
+		Url:         msg.Url,
+		DryRun:      msg.DryRun,
+		Cluster:     msg.Cluster,
+	})); err != nil {
+		return nil, err
+	}
+	parsed, _, _, _, err := ssh.ParseAuthorizedKey(publicKey)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	fingerprint := ssh.FingerprintSHA256(parsed)
+
+	slog.InfoContext(ctx, "ssh key generated",
+		slog.String("action", "secret_ssh_key_generate"),
+		slog.String("resource_type", auditResourceType),
+		slog.String("secret", msg.Name),
+		slog.String("project", msg.Project),
+		slog.String("sub", claims.Sub),
+		slog.String("email", claims.Email),
+		slog.String("fingerprint", fingerprint),
+		slog.Bool("dry_run", msg.DryRun),
+	)
+	return connect.NewResponse(&consolev1.CreateSSHKeySecretResponse{
+		Name:        msg.Name,
+		PublicKey:   strings.TrimSpace(string(publicKey)),
+		Fingerprint: fingerprint,
+	}), nil
+}
+
+// generateSSHKeyPair returns a new ed25519 private key in OpenSSH PEM format
+// and its public key as an authorized_keys line ending in comment.
+func generateSSHKeyPair(comment string) (privateKey, publicKey []byte, err error) {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("generating ed25519 key: %w", err)
+	}
+	block, err := ssh.MarshalPrivateKey(priv, comment)
+	if err != nil {
+		return nil, nil, fmt.Errorf("marshaling private key: %w", err)
+	}
+	sshPub, err := ssh.NewPublicKey(pub)
+	if err != nil {
+		return nil, nil, fmt.Errorf("marshaling public key: %w", err)
+	}
+	line := strings.TrimSuffix(string(ssh.MarshalAuthorizedKey(sshPub)), "\n")
+	if comment != "" {
+		line += " " + comment
+	}
+	return pem.EncodeToMemory(block), []byte(line + "\n"), nil
+}
+
+// checkSSHAuthData reports whether data holds the ssh-privatekey of a valid
+// kubernetes.io/ssh-auth secret.
+func checkSSHAuthData(data map[string][]byte) error {
+	if len(data[corev1.SSHAuthPrivateKey]) == 0 {
+		return fmt.Errorf("%w: missing %s", ErrInvalidSSHKey, corev1.SSHAuthPrivateKey)
+	}
+	_, err := ssh.ParseRawPrivateKey(data[corev1.SSHAuthPrivateKey])
+	// An encrypted key is well formed; the console just cannot read it.
+	var passphrase *ssh.PassphraseMissingError
+	if err != nil && !errors.As(err, &passphrase) {
+		return fmt.Errorf("%w: %w", ErrInvalidSSHKey, err)
+	}
+	return nil
+}
+
+// SSHPublicKey returns the authorized_keys line and SHA256 fingerprint of a
+// kubernetes.io/ssh-auth secret's public key: the ssh-publickey value when
+// present, otherwise the key derived from ssh-privatekey.
+func SSHPublicKey(secret *corev1.Secret) (line, fingerprint string, err error) {
+	if secret.Type != corev1.SecretTypeSSHAuth {
+		return "", "", fmt.Errorf("secret %q has type %q, not %q", secret.Name, secret.Type, corev1.SecretTypeSSHAuth)
+	}
+	if raw := secret.Data[sshPublicKeyKey]; len(raw) > 0 {
+		key, _, _, _, err := ssh.ParseAuthorizedKey(raw)
+		if err != nil {
+			return "", "", err
+		}
+		return strings.TrimSpace(string(raw)), ssh.FingerprintSHA256(key), nil
+	}
+	signer, err := ssh.ParsePrivateKey(secret.Data[corev1.SSHAuthPrivateKey])
+	if err != nil {
+		return "", "", err
+	}
+	key := signer.PublicKey()
+	return strings.TrimSpace(string(ssh.MarshalAuthorizedKey(key))), ssh.FingerprintSHA256(key), nil
+}
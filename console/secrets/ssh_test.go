@@ -0,0 +1,115 @@
+package secrets
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"connectrpc.com/connect"
+	"golang.org/x/crypto/ssh"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/holos-run/holos-console/console/rpc"
+	"github.com/holos-run/holos-console/console/validation"
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+)
+
+func TestHandler_CreateSSHKeySecret(t *testing.T) {
+	fakeClient := fake.NewClientset(testProjectNS())
+	handler := NewProjectScopedHandler(NewK8sClient(fakeClient, testResolver()), nil)
+	ctx := rpc.ContextWithClaims(context.Background(), &rpc.Claims{Sub: "u1", Email: "user@example.com"})
+
+	resp, err := handler.CreateSSHKeySecret(ctx, connect.NewRequest(&consolev1.CreateSSHKeySecretRequest{
+		Name:    "deploy-key",
+		Project: "test-namespace",
+		Comment: "deploy@example.com",
+	}))
+	if err != nil {
+		t.Fatalf("CreateSSHKeySecret: %v", err)
+	}
+	if !strings.HasPrefix(resp.Msg.PublicKey, "ssh-ed25519 ") || !strings.HasSuffix(resp.Msg.PublicKey, " deploy@example.com") {
+		t.Errorf("PublicKey = %q", resp.Msg.PublicKey)
+	}
+	if !strings.HasPrefix(resp.Msg.Fingerprint, "SHA256:") {
+		t.Errorf("Fingerprint = %q", resp.Msg.Fingerprint)
+	}
+
+	stored, err := fakeClient.CoreV1().Secrets("prj-test-namespace").Get(ctx, "deploy-key", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	if stored.Type != corev1.SecretTypeSSHAuth {
+		t.Errorf("Type = %q", stored.Type)
+	}
+	signer, err := ssh.ParsePrivateKey(stored.Data[corev1.SSHAuthPrivateKey])
+	if err != nil {
+		t.Fatalf("parsing stored private key: %v", err)
+	}
+	if got := ssh.FingerprintSHA256(signer.PublicKey()); got != resp.Msg.Fingerprint {
+		t.Errorf("stored key fingerprint %q, response %q", got, resp.Msg.Fingerprint)
+	}
+	if bytes.Contains([]byte(resp.Msg.PublicKey), stored.Data[corev1.SSHAuthPrivateKey]) {
+		t.Error("response leaks the private key")
+	}
+
+	list, err := handler.ListSecrets(ctx, connect.NewRequest(&consolev1.ListSecretsRequest{Project: "test-namespace"}))
+	if err != nil {
+		t.Fatalf("ListSecrets: %v", err)
+	}
+	if md := list.Msg.Secrets[0]; md.SshPublicKey != resp.Msg.PublicKey || md.SshFingerprint != resp.Msg.Fingerprint {
+		t.Errorf("metadata public key %q fingerprint %q", md.SshPublicKey, md.SshFingerprint)
+	}
+}
+
+func TestSSHPublicKey_DerivedFromPrivateKey(t *testing.T) {
+	privateKey, publicKey, err := generateSSHKeyPair("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	secret := &corev1.Secret{
+		Type: corev1.SecretTypeSSHAuth,
+		Data: map[string][]byte{corev1.SSHAuthPrivateKey: privateKey},
+	}
+	line, _, err := SSHPublicKey(secret)
+	if err != nil {
+		t.Fatalf("SSHPublicKey: %v", err)
+	}
+	if line != strings.TrimSpace(string(publicKey)) {
+		t.Errorf("line = %q, want %q", line, publicKey)
+	}
+}
+
+func TestValidateCreateSecret_SSHAuth(t *testing.T) {
+	privateKey, _, err := generateSSHKeyPair("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name   string
+		data   map[string][]byte
+		reason string
+	}{
+		{name: "valid", data: map[string][]byte{"ssh-privatekey": privateKey}},
+		{name: "missing", data: nil, reason: validation.ReasonRequired},
+		{name: "malformed", data: map[string][]byte{"ssh-privatekey": []byte("not a key")}, reason: validation.ReasonSSHKey},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var v validation.Violations
+			validateSecretType(&v, &consolev1.CreateSecretRequest{Type: "kubernetes.io/ssh-auth", Data: tt.data})
+			violations := validation.FieldViolations(v.Err())
+			if tt.reason == "" {
+				if len(violations) != 0 {
+					t.Fatalf("unexpected violations: %v", violations)
+				}
+				return
+			}
+			if len(violations) != 1 || violations[0].Field != validation.Key("data", "ssh-privatekey") || violations[0].Reason != tt.reason {
+				t.Fatalf("expected data[ssh-privatekey] %s, got %v", tt.reason, violations)
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package secrets
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	corev1 "k8s.io/api/core/v1"
+
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+)
+
+// ErrInvalidTLS is returned when a write would leave a kubernetes.io/tls
+// secret without a parseable certificate and matching private key.
+var ErrInvalidTLS = errors.New("invalid TLS secret data")
+
+// tlsCertificateExpiry records the expiry of every TLS secret the console
+// has listed or read, so alerts can fire on certificates nearing expiry,
+// e.g. secret_tls_certificate_expiry_timestamp_seconds - time() < 14 * 86400.
+var tlsCertificateExpiry = promauto.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Name: "secret_tls_certificate_expiry_timestamp_seconds",
+		Help: "Unix time at which the leaf certificate of a kubernetes.io/tls secret expires, by namespace and secret.",
+	},
+	[]string{"namespace", "secret"},
+)
+
+// checkTLSData reports whether data holds the tls.crt and tls.key of a valid
+// kubernetes.io/tls secret: a PEM certificate chain and its private key.
+func checkTLSData(data map[string][]byte) error {
+	for _, key := range []string{corev1.TLSCertKey, corev1.TLSPrivateKeyKey} {
+		if len(data[key]) == 0 {
+			return fmt.Errorf("%w: missing %s", ErrInvalidTLS, key)
+		}
+	}
+	if _, err := tls.X509KeyPair(data[corev1.TLSCertKey], data[corev1.TLSPrivateKeyKey]); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidTLS, err)
+	}
+	return nil
+}
+
+// ParseTLSCertificate returns the leaf certificate of a kubernetes.io/tls
+// secret, the first CERTIFICATE block of tls.crt.
+func ParseTLSCertificate(secret *corev1.Secret) (*x509.Certificate, error) {
+	if secret.Type != corev1.SecretTypeTLS {
+		return nil, fmt.Errorf("secret %q has type %q, not %q", secret.Name, secret.Type, corev1.SecretTypeTLS)
+	}
+	rest := secret.Data[corev1.TLSCertKey]
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			return nil, fmt.Errorf("secret %q: no certificate in %s", secret.Name, corev1.TLSCertKey)
+		}
+		if block.Type == "CERTIFICATE" {
+			return x509.ParseCertificate(block.Bytes)
+		}
+	}
+}
+
+// tlsCertificateToProto converts a parsed certificate to its proto summary.
+func tlsCertificateToProto(cert *x509.Certificate) *consolev1.TLSCertificate {
+	pb := &consolev1.TLSCertificate{
+		Subject:        cert.Subject.String(),
+		Issuer:         cert.Issuer.String(),
+		DnsNames:       cert.DNSNames,
+		EmailAddresses: cert.EmailAddresses,
+		NotBefore:      cert.NotBefore.UTC().Format(time.RFC3339),
+		NotAfter:       cert.NotAfter.UTC().Format(time.RFC3339),
+	}
+	for _, ip := range cert.IPAddresses {
+		pb.IpAddresses = append(pb.IpAddresses, ip.String())
+	}
+	for _, u := range cert.URIs {
+		pb.Uris = append(pb.Uris, u.String())
+	}
+	return pb
+}
+
+// forgetTLSCertificate drops the expiry gauge of a deleted secret.
+func forgetTLSCertificate(namespace, name string) {
+	tlsCertificateExpiry.DeleteLabelValues(namespace, name)
+}
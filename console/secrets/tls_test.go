@@ -0,0 +1,180 @@
+package secrets
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/holos-run/holos-console/console/rpc"
+	"github.com/holos-run/holos-console/console/validation"
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+)
+
+// testKeyPair returns a PEM self-signed certificate for example.com that
+// expires at notAfter, and its PEM private key.
+func testKeyPair(t *testing.T, notAfter time.Time) (certPEM, keyPEM []byte) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "example.com", Organization: []string{"Holos"}},
+		DNSNames:     []string{"example.com", "www.example.com"},
+		IPAddresses:  []net.IP{net.ParseIP("10.0.0.1")},
+		NotBefore:    notAfter.Add(-90 * 24 * time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+func TestValidateCreateSecret_Type(t *testing.T) {
+	certPEM, keyPEM := testKeyPair(t, time.Now().Add(time.Hour))
+	_, otherKey := testKeyPair(t, time.Now().Add(time.Hour))
+
+	tests := []struct {
+		name   string
+		msg    *consolev1.CreateSecretRequest
+		field  string
+		reason string
+	}{
+		{
+			name: "opaque",
+			msg:  &consolev1.CreateSecretRequest{Type: "Opaque", Data: map[string][]byte{"k": []byte("v")}},
+		},
+		{
+			name: "tls",
+			msg:  &consolev1.CreateSecretRequest{Type: "kubernetes.io/tls", Data: map[string][]byte{"tls.crt": certPEM}, StringData: map[string]string{"tls.key": string(keyPEM)}},
+		},
+		{
+			name:   "unknown type",
+			msg:    &consolev1.CreateSecretRequest{Type: "kubernetes.io/basic-auth"},
+			field:  "type",
+			reason: validation.ReasonEnum,
+		},
+		{
+			name:   "missing key",
+			msg:    &consolev1.CreateSecretRequest{Type: "kubernetes.io/tls", Data: map[string][]byte{"tls.crt": certPEM}},
+			field:  validation.Key("data", "tls.key"),
+			reason: validation.ReasonRequired,
+		},
+		{
+			name:   "generated key",
+			msg:    &consolev1.CreateSecretRequest{Type: "kubernetes.io/tls", Data: map[string][]byte{"tls.crt": certPEM}, Generate: map[string]*consolev1.GenerateSpec{"tls.key": {}}},
+			field:  validation.Key("generate", "tls.key"),
+			reason: validation.ReasonConflict,
+		},
+		{
+			name:   "mismatched key",
+			msg:    &consolev1.CreateSecretRequest{Type: "kubernetes.io/tls", Data: map[string][]byte{"tls.crt": certPEM, "tls.key": otherKey}},
+			field:  validation.Key("data", "tls.crt"),
+			reason: validation.ReasonCertificate,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var v validation.Violations
+			validateSecretType(&v, tt.msg)
+			violations := validation.FieldViolations(v.Err())
+			if tt.field == "" {
+				if len(violations) != 0 {
+					t.Fatalf("unexpected violations: %v", violations)
+				}
+				return
+			}
+			if len(violations) != 1 || violations[0].Field != tt.field || violations[0].Reason != tt.reason {
+				t.Fatalf("expected %s %s, got %v", tt.field, tt.reason, violations)
+			}
+		})
+	}
+}
+
+func TestHandler_TLSSecret(t *testing.T) {
+	notAfter := time.Date(2027, 1, 2, 3, 4, 5, 0, time.UTC)
+	certPEM, keyPEM := testKeyPair(t, notAfter)
+	fakeClient := fake.NewClientset(testProjectNS())
+	handler := NewProjectScopedHandler(NewK8sClient(fakeClient, testResolver()), nil)
+	ctx := rpc.ContextWithClaims(context.Background(), &rpc.Claims{Sub: "u1", Email: "user@example.com"})
+
+	if _, err := handler.CreateSecret(ctx, connect.NewRequest(&consolev1.CreateSecretRequest{
+		Name:    "web-tls",
+		Project: "test-namespace",
+		Type:    string(corev1.SecretTypeTLS),
+		Data:    map[string][]byte{corev1.TLSCertKey: certPEM, corev1.TLSPrivateKeyKey: keyPEM},
+	})); err != nil {
+		t.Fatalf("CreateSecret: %v", err)
+	}
+
+	t.Run("list surfaces certificate metadata", func(t *testing.T) {
+		resp, err := handler.ListSecrets(ctx, connect.NewRequest(&consolev1.ListSecretsRequest{Project: "test-namespace"}))
+		if err != nil {
+			t.Fatalf("ListSecrets: %v", err)
+		}
+		if len(resp.Msg.Secrets) != 1 {
+			t.Fatalf("expected 1 secret, got %d", len(resp.Msg.Secrets))
+		}
+		md := resp.Msg.Secrets[0]
+		if md.Type != string(corev1.SecretTypeTLS) {
+			t.Errorf("Type = %q", md.Type)
+		}
+		cert := md.TlsCertificate
+		if cert == nil {
+			t.Fatal("expected tls_certificate")
+		}
+		if cert.Subject != "CN=example.com,O=Holos" || cert.Issuer != cert.Subject {
+			t.Errorf("Subject %q Issuer %q", cert.Subject, cert.Issuer)
+		}
+		if len(cert.DnsNames) != 2 || cert.DnsNames[1] != "www.example.com" || len(cert.IpAddresses) != 1 || cert.IpAddresses[0] != "10.0.0.1" {
+			t.Errorf("SANs: %v %v", cert.DnsNames, cert.IpAddresses)
+		}
+		if cert.NotAfter != "2027-01-02T03:04:05Z" {
+			t.Errorf("NotAfter = %q", cert.NotAfter)
+		}
+		if got := testutil.ToFloat64(tlsCertificateExpiry.WithLabelValues("prj-test-namespace", "web-tls")); got != float64(notAfter.Unix()) {
+			t.Errorf("expiry gauge = %v, want %v", got, notAfter.Unix())
+		}
+	})
+
+	t.Run("patch cannot break the key pair", func(t *testing.T) {
+		_, err := handler.PatchSecret(ctx, connect.NewRequest(&consolev1.PatchSecretRequest{
+			Name:       "web-tls",
+			Project:    "test-namespace",
+			RemoveKeys: []string{corev1.TLSPrivateKeyKey},
+		}))
+		if connect.CodeOf(err) != connect.CodeInvalidArgument {
+			t.Fatalf("expected InvalidArgument, got %v", err)
+		}
+	})
+
+	t.Run("delete forgets the expiry gauge", func(t *testing.T) {
+		if _, err := handler.DeleteSecret(ctx, connect.NewRequest(&consolev1.DeleteSecretRequest{Name: "web-tls", Project: "test-namespace"})); err != nil {
+			t.Fatalf("DeleteSecret: %v", err)
+		}
+		if n := testutil.CollectAndCount(tlsCertificateExpiry); n != 0 {
+			t.Errorf("expected no expiry series, got %d", n)
+		}
+	})
+}
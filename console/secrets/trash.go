@@ -0,0 +1,198 @@
+package secrets
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"connectrpc.com/connect"
+	"go.opentelemetry.io/otel/attribute"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1alpha2 "github.com/holos-run/holos-console/api/v1alpha2"
+	"github.com/holos-run/holos-console/console/permissions"
+	"github.com/holos-run/holos-console/console/rpc"
+	"github.com/holos-run/holos-console/console/tracing"
+	"github.com/holos-run/holos-console/console/trash"
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+)
+
+// secretGrantAnnotations are the per-secret grant annotations stripped from
+// a soft-deleted secret. Deny grants stay in place.
+var secretGrantAnnotations = []string{
+	v1alpha2.AnnotationShareUsers,
+	v1alpha2.AnnotationShareRoles,
+	v1alpha2.AnnotationShareKeyUsers,
+	v1alpha2.AnnotationShareKeyRoles,
+}
+
+// WithTrashRetention switches DeleteSecret to soft deletion. Deleted
+// secrets can be restored until retention has passed, after which the
+// trash.Reaper purges them. Zero keeps hard deletion.
+func (h *Handler) WithTrashRetention(retention time.Duration) *Handler {
+	h.trashRetention = retention
+	return h
+}
+
+// authorizeDelete asks the API server whether the caller may delete the
+// secret. Soft deletion and restore are updates as far as Kubernetes is
+// concerned, so the console checks the delete verb itself to require the
+// same RBAC as a hard delete.
+func (h *Handler) authorizeDelete(ctx context.Context, claims *rpc.Claims, project, name, action string) error {
+	clientset := rpc.ImpersonatedClientsetFromContext(ctx)
+	if clientset == nil {
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("no kubernetes client on request context"))
+	}
+	start := time.Now()
+	perm, err := permissions.Review(ctx, clientset, &consolev1.ResourceAttributes{
+		Verb:      "delete",
+		Resource:  "secrets",
+		Namespace: h.k8s.Resolver.ProjectNamespace(project),
+		Name:      name,
+	})
+	rpc.ObserveGrantResolution(ctx, start)
+	if err != nil {
+		return rpc.MapK8sError(err)
+	}
+	if !perm.Allowed {
+		slog.WarnContext(ctx, "secret "+action+" denied",
+			slog.String("action", "secret_"+action+"_denied"),
+			slog.String("resource_type", auditResourceType),
+			slog.String("secret", name),
+			slog.String("project", project),
+			slog.String("sub", claims.Sub),
+			slog.String("email", claims.Email),
+		)
+		return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("not allowed to %s secret %q", action, name))
+	}
+	return nil
+}
+
+// TrashSecret soft-deletes a secret: it is labeled deleted, its grant
+// annotations are stashed, and it is hidden from GetSecret and ListSecrets.
+// Callers must authorize the delete first. Returns FailedPrecondition if
+// the secret does not have the console managed-by label.
+func (c *K8sClient) TrashSecret(ctx context.Context, project, name, deletedBy string) error {
+	ctx, span := tracing.Start(ctx, "secrets.K8sClient.TrashSecret", attribute.String("project", project), attribute.String("name", name))
+	defer span.End()
+	secret, err := c.GetSecret(ctx, project, name)
+	if err != nil {
+		return err
+	}
+	if err := requireManaged(secret); err != nil {
+		return err
+	}
+	if err := trash.Mark(secret, deletedBy, time.Now(), secretGrantAnnotations...); err != nil {
+		return err
+	}
+	_, err = c.updateSecretObject(ctx, secret)
+	return err
+}
+
+// ListDeletedSecrets returns the soft-deleted secrets in the project's
+// namespace.
+func (c *K8sClient) ListDeletedSecrets(ctx context.Context, project string) ([]corev1.Secret, error) {
+	ctx, span := tracing.Start(ctx, "secrets.K8sClient.ListDeletedSecrets", attribute.String("project", project))
+	defer span.End()
+	list, err := c.client.CoreV1().Secrets(c.Resolver.ProjectNamespace(project)).List(ctx, metav1.ListOptions{LabelSelector: trash.Selector})
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// RestoreSecret undoes TrashSecret. Callers must authorize the restore
+// first. Secrets that exist but are not soft-deleted are reported as
+// NotFound.
+func (c *K8sClient) RestoreSecret(ctx context.Context, project, name string) error {
+	ctx, span := tracing.Start(ctx, "secrets.K8sClient.RestoreSecret", attribute.String("project", project), attribute.String("name", name))
+	defer span.End()
+	ns := c.Resolver.ProjectNamespace(project)
+	secret, err := c.client.CoreV1().Secrets(ns).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	if !trash.IsDeleted(secret) {
+		return apierrors.NewNotFound(corev1.Resource("secrets"), name)
+	}
+	if err := trash.Restore(secret); err != nil {
+		return err
+	}
+	_, err = c.client.CoreV1().Secrets(ns).Update(ctx, secret, metav1.UpdateOptions{})
+	return err
+}
+
+// ListDeletedSecrets lists the project's soft-deleted secrets, most
+// recently deleted first.
+func (h *Handler) ListDeletedSecrets(
+	ctx context.Context,
+	req *connect.Request[consolev1.ListDeletedSecretsRequest],
+) (*connect.Response[consolev1.ListDeletedSecretsResponse], error) {
+	project := req.Msg.Project
+
+	claims := rpc.MustClaims(ctx)
+
+	items, err := h.requestK8s(ctx).ListDeletedSecrets(ctx, project)
+	if err != nil {
+		return nil, mapK8sError(err)
+	}
+	slices.SortFunc(items, func(a, b corev1.Secret) int {
+		return cmp.Or(trash.DeletedAt(&b).Compare(trash.DeletedAt(&a)), cmp.Compare(a.Name, b.Name))
+	})
+	resp := &consolev1.ListDeletedSecretsResponse{}
+	for i := range items {
+		s := &items[i]
+		deletedAt := trash.DeletedAt(s)
+		deleted := &consolev1.DeletedSecret{
+			Name:      s.Name,
+			DeletedAt: deletedAt.UTC().Format(time.RFC3339),
+			DeletedBy: trash.DeletedBy(s),
+		}
+		if h.trashRetention > 0 {
+			deleted.PurgeAt = trash.PurgeAt(deletedAt, h.trashRetention).UTC().Format(time.RFC3339)
+		}
+		resp.Secrets = append(resp.Secrets, deleted)
+	}
+
+	slog.InfoContext(ctx, "deleted secrets listed",
+		slog.String("action", "secret_trash_list"),
+		slog.String("resource_type", auditResourceType),
+		slog.String("project", project),
+		slog.String("sub", claims.Sub),
+		slog.String("email", claims.Email),
+		slog.Int("count", len(resp.Secrets)),
+	)
+	return connect.NewResponse(resp), nil
+}
+
+// RestoreSecret restores a soft-deleted secret and its grants.
+func (h *Handler) RestoreSecret(
+	ctx context.Context,
+	req *connect.Request[consolev1.RestoreSecretRequest],
+) (*connect.Response[consolev1.RestoreSecretResponse], error) {
+	project := req.Msg.Project
+
+	claims := rpc.MustClaims(ctx)
+
+	if err := h.authorizeDelete(ctx, claims, project, req.Msg.Name, "restore"); err != nil {
+		return nil, err
+	}
+	if err := h.requestK8s(ctx).RestoreSecret(ctx, project, req.Msg.Name); err != nil {
+		return nil, mapK8sError(err)
+	}
+
+	slog.InfoContext(ctx, "secret restored",
+		slog.String("action", "secret_restore"),
+		slog.String("resource_type", auditResourceType),
+		slog.String("secret", req.Msg.Name),
+		slog.String("project", project),
+		slog.String("sub", claims.Sub),
+		slog.String("email", claims.Email),
+	)
+	return connect.NewResponse(&consolev1.RestoreSecretResponse{}), nil
+}
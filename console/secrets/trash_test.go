@@ -0,0 +1,161 @@
+package secrets
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	authzv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	v1alpha2 "github.com/holos-run/holos-console/api/v1alpha2"
+	"github.com/holos-run/holos-console/console/rpc"
+	"github.com/holos-run/holos-console/console/trash"
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+)
+
+const keyGrants = `[{"principal":"bob@example.com","role":"viewer","keys":["password"]}]`
+
+// trashFixture returns a fake clientset holding a managed secret with a key
+// grant whose SelfSubjectAccessReviews for delete answer allowed.
+func trashFixture(allowed bool) *fake.Clientset {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "db-creds",
+			Namespace:   "prj-test-namespace",
+			Labels:      map[string]string{v1alpha2.LabelManagedBy: v1alpha2.ManagedByValue},
+			Annotations: map[string]string{v1alpha2.AnnotationShareKeyUsers: keyGrants},
+		},
+		Data: map[string][]byte{"password": []byte("secret")},
+	}
+	client := fake.NewClientset(testProjectNS(), secret)
+	client.PrependReactor("create", "selfsubjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		ssar := action.(k8stesting.CreateAction).GetObject().(*authzv1.SelfSubjectAccessReview)
+		if ssar.Spec.ResourceAttributes.Verb != "delete" {
+			return false, nil, nil
+		}
+		ssar.Status = authzv1.SubjectAccessReviewStatus{Allowed: allowed}
+		return true, ssar, nil
+	})
+	return client
+}
+
+func TestHandler_SoftDeleteSecret(t *testing.T) {
+	claims := &rpc.Claims{Sub: "user-123", Email: "user@example.com"}
+	retention := 24 * time.Hour
+
+	t.Run("hides the secret and strips grants", func(t *testing.T) {
+		client := trashFixture(true)
+		handler := NewProjectScopedHandler(NewK8sClient(client, testResolver()), nil).WithTrashRetention(retention)
+		ctx := contextWithImpersonatedClient(context.Background(), claims, client)
+
+		if _, err := handler.DeleteSecret(ctx, connect.NewRequest(&consolev1.DeleteSecretRequest{Name: "db-creds", Project: "test-namespace"})); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		stored, err := client.CoreV1().Secrets("prj-test-namespace").Get(ctx, "db-creds", metav1.GetOptions{})
+		if err != nil {
+			t.Fatalf("expected secret to remain in the trash, got %v", err)
+		}
+		if !trash.IsDeleted(stored) || trash.DeletedBy(stored) != "user@example.com" {
+			t.Errorf("expected secret marked deleted by user@example.com, got labels=%v annotations=%v", stored.Labels, stored.Annotations)
+		}
+		if _, ok := stored.Annotations[v1alpha2.AnnotationShareKeyUsers]; ok {
+			t.Error("expected key grants to be stripped")
+		}
+
+		_, err = handler.GetSecret(ctx, connect.NewRequest(&consolev1.GetSecretRequest{Name: "db-creds", Project: "test-namespace"}))
+		if connect.CodeOf(err) != connect.CodeNotFound {
+			t.Errorf("expected NotFound for a deleted secret, got %v", err)
+		}
+		list, err := handler.ListSecrets(ctx, connect.NewRequest(&consolev1.ListSecretsRequest{Project: "test-namespace"}))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(list.Msg.Secrets) != 0 {
+			t.Errorf("expected deleted secret to be hidden, got %v", list.Msg.Secrets)
+		}
+
+		deleted, err := handler.ListDeletedSecrets(ctx, connect.NewRequest(&consolev1.ListDeletedSecretsRequest{Project: "test-namespace"}))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(deleted.Msg.Secrets) != 1 {
+			t.Fatalf("expected 1 deleted secret, got %v", deleted.Msg.Secrets)
+		}
+		got := deleted.Msg.Secrets[0]
+		wantPurge := trash.PurgeAt(trash.DeletedAt(stored), retention).UTC().Format(time.RFC3339)
+		if got.Name != "db-creds" || got.DeletedBy != "user@example.com" || got.PurgeAt != wantPurge {
+			t.Errorf("unexpected deleted secret %v, want purge_at %s", got, wantPurge)
+		}
+	})
+
+	t.Run("restores the secret and its grants", func(t *testing.T) {
+		client := trashFixture(true)
+		handler := NewProjectScopedHandler(NewK8sClient(client, testResolver()), nil).WithTrashRetention(retention)
+		ctx := contextWithImpersonatedClient(context.Background(), claims, client)
+
+		if _, err := handler.DeleteSecret(ctx, connect.NewRequest(&consolev1.DeleteSecretRequest{Name: "db-creds", Project: "test-namespace"})); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := handler.RestoreSecret(ctx, connect.NewRequest(&consolev1.RestoreSecretRequest{Name: "db-creds", Project: "test-namespace"})); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		stored, err := handler.k8s.GetSecret(ctx, "test-namespace", "db-creds")
+		if err != nil {
+			t.Fatalf("expected restored secret to be readable, got %v", err)
+		}
+		if stored.Annotations[v1alpha2.AnnotationShareKeyUsers] != keyGrants {
+			t.Errorf("expected key grants restored, got %q", stored.Annotations[v1alpha2.AnnotationShareKeyUsers])
+		}
+		if _, ok := stored.Annotations[v1alpha2.AnnotationDeletedAt]; ok {
+			t.Error("expected deleted-at annotation to be removed")
+		}
+	})
+
+	t.Run("restore requires a deleted secret", func(t *testing.T) {
+		client := trashFixture(true)
+		handler := NewProjectScopedHandler(NewK8sClient(client, testResolver()), nil).WithTrashRetention(retention)
+		ctx := contextWithImpersonatedClient(context.Background(), claims, client)
+
+		_, err := handler.RestoreSecret(ctx, connect.NewRequest(&consolev1.RestoreSecretRequest{Name: "db-creds", Project: "test-namespace"}))
+		if connect.CodeOf(err) != connect.CodeNotFound {
+			t.Errorf("expected NotFound, got %v", err)
+		}
+	})
+
+	t.Run("requires delete permission", func(t *testing.T) {
+		client := trashFixture(false)
+		handler := NewProjectScopedHandler(NewK8sClient(client, testResolver()), nil).WithTrashRetention(retention)
+		ctx := contextWithImpersonatedClient(context.Background(), claims, client)
+
+		_, err := handler.DeleteSecret(ctx, connect.NewRequest(&consolev1.DeleteSecretRequest{Name: "db-creds", Project: "test-namespace"}))
+		if connect.CodeOf(err) != connect.CodePermissionDenied {
+			t.Fatalf("expected PermissionDenied, got %v", err)
+		}
+		if _, err := handler.k8s.GetSecret(ctx, "test-namespace", "db-creds"); err != nil {
+			t.Errorf("expected secret to remain visible, got %v", err)
+		}
+	})
+
+	t.Run("hard deletes without a retention window", func(t *testing.T) {
+		client := trashFixture(true)
+		handler := NewProjectScopedHandler(NewK8sClient(client, testResolver()), nil)
+		ctx := contextWithImpersonatedClient(context.Background(), claims, client)
+
+		if _, err := handler.DeleteSecret(ctx, connect.NewRequest(&consolev1.DeleteSecretRequest{Name: "db-creds", Project: "test-namespace"})); err != nil {
+			t.Fatal(err)
+		}
+		secrets, err := client.CoreV1().Secrets("prj-test-namespace").List(ctx, metav1.ListOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(secrets.Items) != 0 {
+			t.Errorf("expected secret to be deleted, got %d secrets", len(secrets.Items))
+		}
+	})
+}
@@ -0,0 +1,88 @@
+package secrets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"go.opentelemetry.io/otel/attribute"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/holos-run/holos-console/console/tracing"
+)
+
+// ErrUploadOffset is returned when an uploaded chunk does not start where
+// the stored value ends.
+var ErrUploadOffset = errors.New("upload offset does not match the stored value")
+
+// AppendSecretKey writes chunk into the value of key at offset. Offset 0
+// starts a new upload of totalSize bytes, replacing any previous value, and
+// records contentType when it is set; the resulting secret must have room
+// for the whole value. Any other offset must equal the size of the stored
+// value, or the error wraps ErrUploadOffset. Like PatchSecret, the
+// read-modify-write carries the observed resourceVersion.
+// Returns FailedPrecondition if the secret does not have the console managed-by label.
+func (c *K8sClient) AppendSecretKey(ctx context.Context, project, name, key string, offset, totalSize int64, chunk []byte, contentType string) (*corev1.Secret, error) {
+	ctx, span := tracing.Start(ctx, "secrets.K8sClient.AppendSecretKey", attribute.String("project", project), attribute.String("name", name))
+	defer span.End()
+	slog.DebugContext(ctx, "appending to secret key in kubernetes",
+		slog.String("project", project),
+		slog.String("name", name),
+		slog.String("key", key),
+		slog.Int64("offset", offset),
+	)
+	secret, err := c.GetSecret(ctx, project, name)
+	if err != nil {
+		return nil, err
+	}
+	if err := requireManaged(secret); err != nil {
+		return nil, err
+	}
+	if err := requireLocalData(secret); err != nil {
+		return nil, err
+	}
+	if secret.Data == nil {
+		secret.Data = make(map[string][]byte, 1)
+	}
+	current := secretObjectSize(secret)
+
+	var types map[string]string
+	if offset == 0 {
+		// Reserve room for the whole value before writing the first chunk.
+		stored, exists := secret.Data[key]
+		size := current - int64(len(stored)) + totalSize
+		keys := len(secret.Data)
+		if !exists {
+			size += int64(len(key))
+			keys++
+		}
+		if size > MaxSecretObjectBytes || keys > MaxSecretKeys {
+			return nil, &SizeError{Name: name, Size: size, Current: current, Keys: keys}
+		}
+		secret.Data[key] = chunk
+		if contentType != "" {
+			types = map[string]string{key: contentType}
+		}
+	} else {
+		stored := secret.Data[key]
+		if int64(len(stored)) != offset {
+			return nil, fmt.Errorf("%w: key %q holds %d bytes, the chunk starts at %d", ErrUploadOffset, key, len(stored), offset)
+		}
+		secret.Data[key] = append(stored, chunk...)
+	}
+	if err := mergeContentTypes(secret, types); err != nil {
+		return nil, err
+	}
+	if err := requireObjectSize(secret, current); err != nil {
+		return nil, err
+	}
+	if int64(len(secret.Data[key])) == totalSize {
+		// The intermediate states of a typed secret need not satisfy the
+		// type, but the complete value must.
+		if err := requireValidType(secret); err != nil {
+			return nil, err
+		}
+	}
+	return c.updateSecretObject(ctx, secret)
+}
@@ -0,0 +1,100 @@
+package secrets
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"connectrpc.com/connect"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/holos-run/holos-console/console/rpc"
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+)
+
+func TestAppendSecretKey(t *testing.T) {
+	newHandler := func(t *testing.T) (*Handler, context.Context) {
+		t.Helper()
+		fakeClient := fake.NewClientset(testProjectNS())
+		handler := NewProjectScopedHandler(NewK8sClient(fakeClient, testResolver()), nil)
+		ctx := rpc.ContextWithClaims(context.Background(), &rpc.Claims{Sub: "u1", Email: "user@example.com"})
+		if _, err := handler.CreateSecret(ctx, connect.NewRequest(&consolev1.CreateSecretRequest{
+			Name:       "bundle",
+			Project:    "test-namespace",
+			StringData: map[string]string{"note": "hi"},
+		})); err != nil {
+			t.Fatalf("CreateSecret: %v", err)
+		}
+		return handler, ctx
+	}
+	appendChunk := func(handler *Handler, ctx context.Context, offset, total int64, chunk []byte) (*consolev1.AppendSecretKeyResponse, error) {
+		resp, err := handler.AppendSecretKey(ctx, connect.NewRequest(&consolev1.AppendSecretKeyRequest{
+			Name:        "bundle",
+			Project:     "test-namespace",
+			Key:         "ca.pem",
+			Offset:      offset,
+			TotalSize:   total,
+			Chunk:       chunk,
+			ContentType: "application/x-pem-file",
+		}))
+		if err != nil {
+			return nil, err
+		}
+		return resp.Msg, nil
+	}
+
+	t.Run("assembles the value from chunks", func(t *testing.T) {
+		handler, ctx := newHandler(t)
+		value := []byte(strings.Repeat("0123456789", 10))
+		var offset int64
+		for len(value[offset:]) > 0 {
+			end := min(offset+32, int64(len(value)))
+			resp, err := appendChunk(handler, ctx, offset, int64(len(value)), value[offset:end])
+			if err != nil {
+				t.Fatalf("AppendSecretKey at %d: %v", offset, err)
+			}
+			if resp.Size != end || resp.Complete != (end == int64(len(value))) {
+				t.Fatalf("unexpected progress %+v at %d", resp, offset)
+			}
+			offset = end
+		}
+		got, err := handler.k8s.GetSecret(ctx, "test-namespace", "bundle")
+		if err != nil {
+			t.Fatalf("GetSecret: %v", err)
+		}
+		if !bytes.Equal(got.Data["ca.pem"], value) || string(got.Data["note"]) != "hi" {
+			t.Errorf("unexpected data %q", got.Data)
+		}
+		if types, _ := GetContentTypes(got); types["ca.pem"] != "application/x-pem-file" {
+			t.Errorf("expected the content type of the first chunk, got %v", types)
+		}
+	})
+
+	t.Run("rejects a chunk at the wrong offset", func(t *testing.T) {
+		handler, ctx := newHandler(t)
+		if _, err := appendChunk(handler, ctx, 0, 8, []byte("abcd")); err != nil {
+			t.Fatalf("AppendSecretKey: %v", err)
+		}
+		_, err := appendChunk(handler, ctx, 2, 8, []byte("efgh"))
+		if connect.CodeOf(err) != connect.CodeFailedPrecondition {
+			t.Fatalf("expected FailedPrecondition, got %v", err)
+		}
+	})
+
+	t.Run("rejects an oversized upload on the first chunk", func(t *testing.T) {
+		handler, ctx := newHandler(t)
+		_, err := appendChunk(handler, ctx, 0, MaxSecretObjectBytes, []byte("a"))
+		if connect.CodeOf(err) != connect.CodeResourceExhausted {
+			t.Fatalf("expected ResourceExhausted, got %v", err)
+		}
+	})
+
+	t.Run("rejects a chunk past total_size", func(t *testing.T) {
+		handler, ctx := newHandler(t)
+		_, err := appendChunk(handler, ctx, 0, 2, []byte("abc"))
+		if connect.CodeOf(err) != connect.CodeInvalidArgument {
+			t.Fatalf("expected InvalidArgument, got %v", err)
+		}
+	})
+}
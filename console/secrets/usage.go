@@ -0,0 +1,246 @@
+package secrets
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"connectrpc.com/connect"
+	"go.opentelemetry.io/otel/attribute"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/holos-run/holos-console/console/rpc"
+	"github.com/holos-run/holos-console/console/tracing"
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+)
+
+// Secret reference types reported by GetSecretUsage.
+const (
+	refEnv             = "env"
+	refEnvFrom         = "envFrom"
+	refVolume          = "volume"
+	refImagePullSecret = "imagePullSecret"
+)
+
+// FindConsumers scans the pod templates of the workloads in the project
+// namespace for references to the named secret. Workload kinds the client
+// may not list are skipped and returned in unscanned.
+func (c *K8sClient) FindConsumers(ctx context.Context, project, name string) (consumers []*consolev1.SecretConsumer, unscanned []string, err error) {
+	ctx, span := tracing.Start(ctx, "secrets.K8sClient.FindConsumers", attribute.String("project", project), attribute.String("name", name))
+	defer span.End()
+	ns := c.Resolver.ProjectNamespace(project)
+	opts := metav1.ListOptions{}
+
+	scan := func(kind string, list func() ([]workload, error)) error {
+		workloads, err := list()
+		if k8serrors.IsForbidden(err) {
+			unscanned = append(unscanned, kind)
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		slices.SortFunc(workloads, func(a, b workload) int { return cmp.Compare(a.name, b.name) })
+		for _, w := range workloads {
+			if refs := secretReferences(&w.spec, name); len(refs) > 0 {
+				consumers = append(consumers, &consolev1.SecretConsumer{Kind: kind, Name: w.name, References: refs})
+			}
+		}
+		return nil
+	}
+
+	apps := c.client.AppsV1()
+	if err := scan("Deployment", func() ([]workload, error) {
+		list, err := apps.Deployments(ns).List(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		out := make([]workload, len(list.Items))
+		for i, d := range list.Items {
+			out[i] = workload{d.Name, d.Spec.Template.Spec}
+		}
+		return out, nil
+	}); err != nil {
+		return nil, nil, err
+	}
+	if err := scan("StatefulSet", func() ([]workload, error) {
+		list, err := apps.StatefulSets(ns).List(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		out := make([]workload, len(list.Items))
+		for i, s := range list.Items {
+			out[i] = workload{s.Name, s.Spec.Template.Spec}
+		}
+		return out, nil
+	}); err != nil {
+		return nil, nil, err
+	}
+	if err := scan("DaemonSet", func() ([]workload, error) {
+		list, err := apps.DaemonSets(ns).List(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		out := make([]workload, len(list.Items))
+		for i, d := range list.Items {
+			out[i] = workload{d.Name, d.Spec.Template.Spec}
+		}
+		return out, nil
+	}); err != nil {
+		return nil, nil, err
+	}
+	if err := scan("CronJob", func() ([]workload, error) {
+		list, err := c.client.BatchV1().CronJobs(ns).List(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		out := make([]workload, len(list.Items))
+		for i, j := range list.Items {
+			out[i] = workload{j.Name, j.Spec.JobTemplate.Spec.Template.Spec}
+		}
+		return out, nil
+	}); err != nil {
+		return nil, nil, err
+	}
+	return consumers, unscanned, nil
+}
+
+// workload is the name and pod template of a scanned object.
+type workload struct {
+	name string
+	spec corev1.PodSpec
+}
+
+// secretReferences returns every reference to secret in spec.
+func secretReferences(spec *corev1.PodSpec, secret string) []*consolev1.SecretReference {
+	var refs []*consolev1.SecretReference
+	containers := func(name string, env []corev1.EnvVar, envFrom []corev1.EnvFromSource) {
+		for _, e := range envFrom {
+			if e.SecretRef != nil && e.SecretRef.Name == secret {
+				refs = append(refs, &consolev1.SecretReference{Type: refEnvFrom, Container: name})
+			}
+		}
+		for _, e := range env {
+			if e.ValueFrom != nil && e.ValueFrom.SecretKeyRef != nil && e.ValueFrom.SecretKeyRef.Name == secret {
+				refs = append(refs, &consolev1.SecretReference{Type: refEnv, Container: name, Name: e.Name, Key: e.ValueFrom.SecretKeyRef.Key})
+			}
+		}
+	}
+	for _, c := range spec.InitContainers {
+		containers(c.Name, c.Env, c.EnvFrom)
+	}
+	for _, c := range spec.Containers {
+		containers(c.Name, c.Env, c.EnvFrom)
+	}
+	for _, c := range spec.EphemeralContainers {
+		containers(c.Name, c.Env, c.EnvFrom)
+	}
+	for _, v := range spec.Volumes {
+		used := v.Secret != nil && v.Secret.SecretName == secret
+		if v.Projected != nil {
+			for _, source := range v.Projected.Sources {
+				used = used || (source.Secret != nil && source.Secret.Name == secret)
+			}
+		}
+		if used {
+			refs = append(refs, &consolev1.SecretReference{Type: refVolume, Name: v.Name})
+		}
+	}
+	for _, s := range spec.ImagePullSecrets {
+		if s.Name == secret {
+			refs = append(refs, &consolev1.SecretReference{Type: refImagePullSecret})
+		}
+	}
+	return refs
+}
+
+// requireNotInUse returns FailedPrecondition, with a SecretInUse detail
+// listing the references, when workloads in the project reference the
+// secret. It fails closed: when the caller may not list a workload kind the
+// secret cannot be shown to be unused, and the delete needs force.
+func (h *Handler) requireNotInUse(ctx context.Context, claims *rpc.Claims, project, name string) error {
+	consumers, unscanned, err := h.requestK8s(ctx).FindConsumers(ctx, project, name)
+	if err != nil {
+		return mapK8sError(err)
+	}
+	if len(unscanned) > 0 {
+		slog.WarnContext(ctx, "secret delete blocked by unscanned workloads",
+			slog.String("action", "secret_delete_blocked"),
+			slog.String("resource_type", auditResourceType),
+			slog.String("secret", name),
+			slog.String("project", project),
+			slog.String("sub", claims.Sub),
+			slog.String("email", claims.Email),
+			slog.Any("unscanned", unscanned),
+		)
+		return connect.NewError(connect.CodeFailedPrecondition,
+			fmt.Errorf("cannot check whether secret %q is in use: not permitted to list %s; set force to delete it anyway", name, strings.Join(unscanned, ", ")))
+	}
+	if len(consumers) == 0 {
+		return nil
+	}
+	workloads := make([]string, len(consumers))
+	for i, c := range consumers {
+		workloads[i] = c.Kind + "/" + c.Name
+	}
+	slog.WarnContext(ctx, "secret delete blocked by consuming workloads",
+		slog.String("action", "secret_delete_blocked"),
+		slog.String("resource_type", auditResourceType),
+		slog.String("secret", name),
+		slog.String("project", project),
+		slog.String("sub", claims.Sub),
+		slog.String("email", claims.Email),
+		slog.Any("consumers", workloads),
+	)
+	cerr := connect.NewError(connect.CodeFailedPrecondition,
+		fmt.Errorf("secret %q is in use by %s; set force to delete it anyway", name, strings.Join(workloads, ", ")))
+	if detail, err := connect.NewErrorDetail(&consolev1.SecretInUse{Consumers: consumers}); err == nil {
+		cerr.AddDetail(detail)
+	}
+	return cerr
+}
+
+// GetSecretUsage lists the workloads in the project that reference a
+// secret. The caller must be able to read the secret; workloads are listed
+// as the caller, so RBAC on each workload kind still applies.
+func (h *Handler) GetSecretUsage(
+	ctx context.Context,
+	req *connect.Request[consolev1.GetSecretUsageRequest],
+) (*connect.Response[consolev1.GetSecretUsageResponse], error) {
+	project := req.Msg.Project
+
+	claims := rpc.MustClaims(ctx)
+
+	k8s := h.requestK8s(ctx)
+	secret, err := k8s.GetSecret(ctx, project, req.Msg.Name)
+	if err != nil {
+		return nil, mapK8sError(err)
+	}
+	if err := checkDenied(ctx, claims, secret, project); err != nil {
+		return nil, err
+	}
+
+	consumers, unscanned, err := k8s.FindConsumers(ctx, project, req.Msg.Name)
+	if err != nil {
+		return nil, mapK8sError(err)
+	}
+
+	slog.InfoContext(ctx, "secret usage listed",
+		slog.String("action", "secret_usage"),
+		slog.String("resource_type", auditResourceType),
+		slog.String("secret", req.Msg.Name),
+		slog.String("project", project),
+		slog.String("sub", claims.Sub),
+		slog.String("email", claims.Email),
+		slog.Int("consumers", len(consumers)),
+	)
+	return connect.NewResponse(&consolev1.GetSecretUsageResponse{
+		Consumers:      consumers,
+		UnscannedKinds: unscanned,
+	}), nil
+}
@@ -0,0 +1,255 @@
+package secrets
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"connectrpc.com/connect"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	v1alpha2 "github.com/holos-run/holos-console/api/v1alpha2"
+	"github.com/holos-run/holos-console/console/rpc"
+	"github.com/holos-run/holos-console/console/rpc/rpctesting"
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+)
+
+// usageFixture returns a fake clientset holding the db-creds secret and
+// workloads referencing it in each supported way, plus one that does not.
+func usageFixture() *fake.Clientset {
+	ns := "prj-test-namespace"
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "db-creds",
+			Namespace: ns,
+			Labels:    map[string]string{v1alpha2.LabelManagedBy: v1alpha2.ManagedByValue},
+		},
+		Data: map[string][]byte{"password": []byte("secret")},
+	}
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "api", Namespace: ns},
+		Spec: appsv1.DeploymentSpec{Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{
+				Name: "server",
+				Env: []corev1.EnvVar{{
+					Name: "DB_PASSWORD",
+					ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "db-creds"},
+						Key:                  "password",
+					}},
+				}},
+				EnvFrom: []corev1.EnvFromSource{{
+					SecretRef: &corev1.SecretEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: "db-creds"}},
+				}},
+			}},
+		}}},
+	}
+	statefulSet := &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: ns},
+		Spec: appsv1.StatefulSetSpec{Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{
+			Volumes: []corev1.Volume{{
+				Name:         "creds",
+				VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: "db-creds"}},
+			}},
+		}}},
+	}
+	cronJob := &batchv1.CronJob{
+		ObjectMeta: metav1.ObjectMeta{Name: "backup", Namespace: ns},
+		Spec: batchv1.CronJobSpec{JobTemplate: batchv1.JobTemplateSpec{Spec: batchv1.JobSpec{Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{
+			ImagePullSecrets: []corev1.LocalObjectReference{{Name: "db-creds"}},
+		}}}}},
+	}
+	unrelated := &appsv1.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "agent", Namespace: ns},
+		Spec: appsv1.DaemonSetSpec{Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{
+			Volumes: []corev1.Volume{{
+				Name:         "other",
+				VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: "other"}},
+			}},
+		}}},
+	}
+	return fake.NewClientset(testProjectNS(), secret, deployment, statefulSet, cronJob, unrelated)
+}
+
+func TestHandler_GetSecretUsage(t *testing.T) {
+	claims := &rpc.Claims{Sub: "user-123", Email: "user@example.com"}
+	request := func() *connect.Request[consolev1.GetSecretUsageRequest] {
+		return connect.NewRequest(&consolev1.GetSecretUsageRequest{Name: "db-creds", Project: "test-namespace"})
+	}
+
+	t.Run("lists consuming workloads", func(t *testing.T) {
+		client := usageFixture()
+		handler := NewProjectScopedHandler(NewK8sClient(client, testResolver()), nil)
+		logHandler := &testLogHandler{}
+		oldLogger := slog.Default()
+		slog.SetDefault(slog.New(logHandler))
+		defer slog.SetDefault(oldLogger)
+
+		resp, err := handler.GetSecretUsage(contextWithImpersonatedClient(context.Background(), claims, client), request())
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		consumers := resp.Msg.Consumers
+		if len(consumers) != 3 {
+			t.Fatalf("expected 3 consumers, got %d: %v", len(consumers), consumers)
+		}
+		want := []struct{ kind, name string }{{"Deployment", "api"}, {"StatefulSet", "db"}, {"CronJob", "backup"}}
+		for i, w := range want {
+			if consumers[i].Kind != w.kind || consumers[i].Name != w.name {
+				t.Errorf("consumer %d: expected %s/%s, got %s/%s", i, w.kind, w.name, consumers[i].Kind, consumers[i].Name)
+			}
+		}
+
+		refs := consumers[0].References
+		if len(refs) != 2 {
+			t.Fatalf("expected 2 deployment references, got %v", refs)
+		}
+		if refs[0].Type != refEnvFrom || refs[0].Container != "server" {
+			t.Errorf("expected envFrom reference in server, got %v", refs[0])
+		}
+		if refs[1].Type != refEnv || refs[1].Name != "DB_PASSWORD" || refs[1].Key != "password" {
+			t.Errorf("expected env reference DB_PASSWORD=password, got %v", refs[1])
+		}
+		if ref := consumers[1].References[0]; ref.Type != refVolume || ref.Name != "creds" {
+			t.Errorf("expected volume reference creds, got %v", ref)
+		}
+		if ref := consumers[2].References[0]; ref.Type != refImagePullSecret {
+			t.Errorf("expected imagePullSecret reference, got %v", ref)
+		}
+		if len(resp.Msg.UnscannedKinds) != 0 {
+			t.Errorf("expected every kind scanned, got %v", resp.Msg.UnscannedKinds)
+		}
+
+		record := logHandler.findRecord("secret_usage")
+		if record == nil {
+			t.Fatal("expected secret_usage audit log")
+		}
+		assertResourceType(t, record)
+	})
+
+	t.Run("reports kinds the caller may not list", func(t *testing.T) {
+		client := usageFixture()
+		client.PrependReactor("list", "daemonsets", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			return true, nil, k8serrors.NewForbidden(schema.GroupResource{Group: "apps", Resource: "daemonsets"}, "", errors.New("denied"))
+		})
+		handler := NewProjectScopedHandler(NewK8sClient(client, testResolver()), nil)
+
+		resp, err := handler.GetSecretUsage(contextWithImpersonatedClient(context.Background(), claims, client), request())
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(resp.Msg.Consumers) != 3 {
+			t.Errorf("expected 3 consumers, got %d", len(resp.Msg.Consumers))
+		}
+		if got := resp.Msg.UnscannedKinds; len(got) != 1 || got[0] != "DaemonSet" {
+			t.Errorf("expected DaemonSet unscanned, got %v", got)
+		}
+	})
+
+	t.Run("returns NotFound for a missing secret", func(t *testing.T) {
+		client := usageFixture()
+		handler := NewProjectScopedHandler(NewK8sClient(client, testResolver()), nil)
+
+		_, err := handler.GetSecretUsage(contextWithImpersonatedClient(context.Background(), claims, client),
+			connect.NewRequest(&consolev1.GetSecretUsageRequest{Name: "missing", Project: "test-namespace"}))
+		if connect.CodeOf(err) != connect.CodeNotFound {
+			t.Errorf("expected NotFound, got %v", err)
+		}
+	})
+
+	t.Run("requires name and project", func(t *testing.T) {
+		handler := NewProjectScopedHandler(NewK8sClient(usageFixture(), testResolver()), nil)
+		for _, msg := range []*consolev1.GetSecretUsageRequest{{Project: "test-namespace"}, {Name: "db-creds"}} {
+			_, err := rpctesting.Validated(rpc.ContextWithClaims(context.Background(), claims), connect.NewRequest(msg), handler.GetSecretUsage)
+			if connect.CodeOf(err) != connect.CodeInvalidArgument {
+				t.Errorf("expected InvalidArgument for %v, got %v", msg, err)
+			}
+		}
+	})
+}
+
+func TestHandler_DeleteSecret_InUse(t *testing.T) {
+	claims := &rpc.Claims{Sub: "user-123", Email: "user@example.com"}
+
+	t.Run("refuses while workloads reference the secret", func(t *testing.T) {
+		client := usageFixture()
+		handler := NewProjectScopedHandler(NewK8sClient(client, testResolver()), nil)
+		logHandler := &testLogHandler{}
+		oldLogger := slog.Default()
+		slog.SetDefault(slog.New(logHandler))
+		defer slog.SetDefault(oldLogger)
+
+		ctx := contextWithImpersonatedClient(context.Background(), claims, client)
+		_, err := handler.DeleteSecret(ctx, connect.NewRequest(&consolev1.DeleteSecretRequest{Name: "db-creds", Project: "test-namespace"}))
+		if connect.CodeOf(err) != connect.CodeFailedPrecondition {
+			t.Fatalf("expected FailedPrecondition, got %v", err)
+		}
+		var cerr *connect.Error
+		if !errors.As(err, &cerr) || len(cerr.Details()) != 1 {
+			t.Fatalf("expected one error detail, got %v", err)
+		}
+		value, err := cerr.Details()[0].Value()
+		if err != nil {
+			t.Fatal(err)
+		}
+		inUse, ok := value.(*consolev1.SecretInUse)
+		if !ok || len(inUse.Consumers) != 3 {
+			t.Fatalf("expected SecretInUse with 3 consumers, got %v", value)
+		}
+
+		if _, err := client.CoreV1().Secrets("prj-test-namespace").Get(ctx, "db-creds", metav1.GetOptions{}); err != nil {
+			t.Errorf("expected secret to remain, got %v", err)
+		}
+		record := logHandler.findRecord("secret_delete_blocked")
+		if record == nil {
+			t.Fatal("expected secret_delete_blocked audit log")
+		}
+		assertResourceType(t, record)
+	})
+
+	t.Run("refuses when workloads cannot be listed", func(t *testing.T) {
+		client := fake.NewClientset(testProjectNS(), &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "db-creds",
+				Namespace: "prj-test-namespace",
+				Labels:    map[string]string{v1alpha2.LabelManagedBy: v1alpha2.ManagedByValue},
+			},
+		})
+		client.PrependReactor("list", "deployments", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			return true, nil, k8serrors.NewForbidden(schema.GroupResource{Group: "apps", Resource: "deployments"}, "", errors.New("denied"))
+		})
+		handler := NewProjectScopedHandler(NewK8sClient(client, testResolver()), nil)
+
+		ctx := contextWithImpersonatedClient(context.Background(), claims, client)
+		_, err := handler.DeleteSecret(ctx, connect.NewRequest(&consolev1.DeleteSecretRequest{Name: "db-creds", Project: "test-namespace"}))
+		if connect.CodeOf(err) != connect.CodeFailedPrecondition {
+			t.Fatalf("expected FailedPrecondition, got %v", err)
+		}
+		if _, err := client.CoreV1().Secrets("prj-test-namespace").Get(ctx, "db-creds", metav1.GetOptions{}); err != nil {
+			t.Errorf("expected secret to remain, got %v", err)
+		}
+	})
+
+	t.Run("deletes with force", func(t *testing.T) {
+		client := usageFixture()
+		handler := NewProjectScopedHandler(NewK8sClient(client, testResolver()), nil)
+
+		ctx := contextWithImpersonatedClient(context.Background(), claims, client)
+		_, err := handler.DeleteSecret(ctx, connect.NewRequest(&consolev1.DeleteSecretRequest{Name: "db-creds", Project: "test-namespace", Force: true}))
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if _, err := client.CoreV1().Secrets("prj-test-namespace").Get(ctx, "db-creds", metav1.GetOptions{}); !k8serrors.IsNotFound(err) {
+			t.Errorf("expected secret to be deleted, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,224 @@
+package secrets
+
+import (
+	"maps"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/holos-run/holos-console/console/validation"
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+)
+
+// validateCreateSecret checks every field of a CreateSecretRequest and
+// returns all violations at once.
+func validateCreateSecret(msg *consolev1.CreateSecretRequest) error {
+	var v validation.Violations
+	validateDataKeys(&v, "data", slices.Collect(maps.Keys(msg.Data)))
+	validateDataKeys(&v, "string_data", slices.Collect(maps.Keys(msg.StringData)))
+	validateDataKeys(&v, "generate", slices.Collect(maps.Keys(msg.Generate)))
+	for _, key := range slices.Sorted(maps.Keys(msg.Generate)) {
+		_, inData := msg.Data[key]
+		_, inStringData := msg.StringData[key]
+		if inData || inStringData {
+			v.Add(validation.Key("generate", key), validation.ReasonConflict, "key %q cannot be both supplied and generated", key)
+		}
+	}
+	validateShareGrants(&v, msg.UserGrants, msg.RoleGrants)
+	v.URL("url", msg.GetUrl())
+	validateContentTypes(&v, msg.ContentTypes, msg.Data, msg.StringData, msg.Generate)
+	validateSecretType(&v, msg)
+	return v.Err()
+}
+
+// validateUpdateSecret checks every field of an UpdateSecretRequest.
+func validateUpdateSecret(msg *consolev1.UpdateSecretRequest) error {
+	var v validation.Violations
+	if len(msg.Data) == 0 && len(msg.StringData) == 0 {
+		v.Add("data", validation.ReasonRequired, "secret data is required")
+	}
+	validateDataKeys(&v, "data", slices.Collect(maps.Keys(msg.Data)))
+	validateDataKeys(&v, "string_data", slices.Collect(maps.Keys(msg.StringData)))
+	v.URL("url", msg.GetUrl())
+	validateContentTypes(&v, msg.ContentTypes, msg.Data, msg.StringData, nil)
+	return v.Err()
+}
+
+// validateDiffSecret checks every field of a DiffSecretRequest.
+func validateDiffSecret(msg *consolev1.DiffSecretRequest) error {
+	var v validation.Violations
+	validateDataKeys(&v, "proposed_data", slices.Collect(maps.Keys(msg.ProposedData)))
+	validateDataKeys(&v, "proposed_string_data", slices.Collect(maps.Keys(msg.ProposedStringData)))
+	return v.Err()
+}
+
+// validatePatchSecret checks every field of a PatchSecretRequest.
+func validatePatchSecret(msg *consolev1.PatchSecretRequest) error {
+	var v validation.Violations
+	if len(msg.Data) == 0 && len(msg.StringData) == 0 && len(msg.RemoveKeys) == 0 {
+		v.Add("data", validation.ReasonRequired, "at least one key to set or remove is required")
+	}
+	validateDataKeys(&v, "data", slices.Collect(maps.Keys(msg.Data)))
+	validateDataKeys(&v, "string_data", slices.Collect(maps.Keys(msg.StringData)))
+	for i, key := range msg.RemoveKeys {
+		_, inData := msg.Data[key]
+		_, inStringData := msg.StringData[key]
+		if inData || inStringData {
+			v.Add(validation.Index("remove_keys", i), validation.ReasonConflict, "key %q cannot be both set and removed", key)
+		}
+	}
+	validateContentTypes(&v, msg.ContentTypes, msg.Data, msg.StringData, nil)
+	return v.Err()
+}
+
+// validateAppendSecretKey checks every field of an AppendSecretKeyRequest.
+func validateAppendSecretKey(msg *consolev1.AppendSecretKeyRequest) error {
+	var v validation.Violations
+	v.DataKey("key", msg.Key)
+	if msg.Offset+int64(len(msg.Chunk)) > msg.TotalSize {
+		v.Add("chunk", validation.ReasonConflict, "chunk ends at %d, past total_size %d", msg.Offset+int64(len(msg.Chunk)), msg.TotalSize)
+	}
+	if msg.ContentType != "" {
+		v.MediaType("content_type", msg.ContentType)
+	}
+	return v.Err()
+}
+
+// validateUpdateSharing checks every field of an UpdateSharingRequest.
+func validateUpdateSharing(msg *consolev1.UpdateSharingRequest) error {
+	var v validation.Violations
+	validateShareGrants(&v, msg.UserGrants, msg.RoleGrants)
+	return v.Err()
+}
+
+// validateDataKeys checks data keys in sorted order so violations are
+// reported deterministically.
+func validateDataKeys(v *validation.Violations, field string, keys []string) {
+	slices.Sort(keys)
+	for _, key := range keys {
+		v.DataKey(validation.Key(field, key), key)
+	}
+}
+
+// validateContentTypes checks that every content_types entry is a media type
+// for a key the request sets.
+func validateContentTypes(v *validation.Violations, types map[string]string, data map[string][]byte, stringData map[string]string, generate map[string]*consolev1.GenerateSpec) {
+	for _, key := range slices.Sorted(maps.Keys(types)) {
+		field := validation.Key("content_types", key)
+		_, inData := data[key]
+		_, inStringData := stringData[key]
+		_, inGenerate := generate[key]
+		if !inData && !inStringData && !inGenerate {
+			v.Add(field, validation.ReasonConflict, "content type set for key %q the request does not set", key)
+			continue
+		}
+		v.MediaType(field, types[key])
+	}
+}
+
+// validateSecretType checks the requested Kubernetes secret type and that
+// the request supplies the keys the type requires.
+func validateSecretType(v *validation.Violations, msg *consolev1.CreateSecretRequest) {
+	secretType := corev1.SecretType(msg.Type)
+	if msg.DockerRegistry != nil {
+		if secretType == "" {
+			secretType = corev1.SecretTypeDockerConfigJson
+		}
+		if secretType != corev1.SecretTypeDockerConfigJson {
+			v.Add("type", validation.ReasonConflict, "docker_registry requires type %q", corev1.SecretTypeDockerConfigJson)
+			return
+		}
+	}
+	switch secretType {
+	case "", corev1.SecretTypeOpaque:
+	case corev1.SecretTypeTLS:
+		validateTLSKeys(v, msg)
+	case corev1.SecretTypeDockerConfigJson:
+		validateDockerConfigKeys(v, msg)
+	case corev1.SecretTypeSSHAuth:
+		validateSSHAuthKeys(v, msg)
+	default:
+		v.Add("type", validation.ReasonEnum, "type must be one of %q, %q, %q or %q", corev1.SecretTypeOpaque, corev1.SecretTypeTLS, corev1.SecretTypeDockerConfigJson, corev1.SecretTypeSSHAuth)
+	}
+}
+
+// validateTLSKeys checks that a kubernetes.io/tls request supplies a
+// certificate chain and its matching private key. TLS keys cannot be
+// generated.
+func validateTLSKeys(v *validation.Violations, msg *consolev1.CreateSecretRequest) {
+	data := mergeStringData(maps.Clone(msg.Data), msg.StringData)
+	missing := false
+	for _, key := range []string{corev1.TLSCertKey, corev1.TLSPrivateKeyKey} {
+		if _, ok := msg.Generate[key]; ok {
+			v.Add(validation.Key("generate", key), validation.ReasonConflict, "key %q of a TLS secret cannot be generated", key)
+			missing = true
+		} else if len(data[key]) == 0 {
+			v.Add(validation.Key("data", key), validation.ReasonRequired, "a TLS secret requires key %q", key)
+			missing = true
+		}
+	}
+	if missing {
+		return
+	}
+	if err := checkTLSData(data); err != nil {
+		v.Add(validation.Key("data", corev1.TLSCertKey), validation.ReasonCertificate, "%s and %s must be a PEM certificate and its private key: %v", corev1.TLSCertKey, corev1.TLSPrivateKeyKey, err)
+	}
+}
+
+// validateDockerConfigKeys checks that a kubernetes.io/dockerconfigjson
+// request supplies exactly one of docker_registry and a .dockerconfigjson
+// document.
+func validateDockerConfigKeys(v *validation.Violations, msg *consolev1.CreateSecretRequest) {
+	key := corev1.DockerConfigJsonKey
+	if _, ok := msg.Generate[key]; ok {
+		v.Add(validation.Key("generate", key), validation.ReasonConflict, "key %q cannot be generated", key)
+		return
+	}
+	data := mergeStringData(maps.Clone(msg.Data), msg.StringData)
+	_, supplied := data[key]
+	switch {
+	case msg.DockerRegistry != nil && supplied:
+		v.Add(validation.Key("data", key), validation.ReasonConflict, "key %q cannot be supplied with docker_registry", key)
+	case msg.DockerRegistry == nil && !supplied:
+		v.Add("docker_registry", validation.ReasonRequired, "a docker config secret requires docker_registry or key %q", key)
+	case supplied:
+		if err := checkDockerConfigJSON(data); err != nil {
+			v.Add(validation.Key("data", key), validation.ReasonDockerConfig, "%s must be a docker config document with auths: %v", key, err)
+		}
+	}
+}
+
+// validateSSHAuthKeys checks that a kubernetes.io/ssh-auth request supplies
+// a private key. CreateSSHKeySecret generates one server-side.
+func validateSSHAuthKeys(v *validation.Violations, msg *consolev1.CreateSecretRequest) {
+	key := corev1.SSHAuthPrivateKey
+	if _, ok := msg.Generate[key]; ok {
+		v.Add(validation.Key("generate", key), validation.ReasonConflict, "key %q cannot be generated; use CreateSSHKeySecret", key)
+		return
+	}
+	data := mergeStringData(maps.Clone(msg.Data), msg.StringData)
+	if len(data[key]) == 0 {
+		v.Add(validation.Key("data", key), validation.ReasonRequired, "an SSH auth secret requires key %q", key)
+		return
+	}
+	if err := checkSSHAuthData(data); err != nil {
+		v.Add(validation.Key("data", key), validation.ReasonSSHKey, "%s must be a PEM or OpenSSH private key: %v", key, err)
+	}
+}
+
+// validateShareGrants checks grant principals and the key names of
+// key-scoped grants.
+func validateShareGrants(v *validation.Violations, userGrants, roleGrants []*consolev1.ShareGrant) {
+	v.UserGrants("user_grants", userGrants)
+	v.RoleGrants("role_grants", roleGrants)
+	validateGrantKeys(v, "user_grants", userGrants)
+	validateGrantKeys(v, "role_grants", roleGrants)
+}
+
+func validateGrantKeys(v *validation.Violations, field string, grants []*consolev1.ShareGrant) {
+	for i, g := range grants {
+		for j, key := range g.Keys {
+			v.DataKey(validation.Index(validation.Index(field, i)+".keys", j), key)
+		}
+	}
+}
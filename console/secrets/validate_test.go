@@ -0,0 +1,58 @@
+package secrets
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/holos-run/holos-console/console/rpc"
+	"github.com/holos-run/holos-console/console/validation"
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+)
+
+func TestHandler_CreateSecret_FieldViolations(t *testing.T) {
+	handler := NewProjectScopedHandler(NewK8sClient(fake.NewClientset(testProjectNS()), testResolver()), nil)
+	ctx := rpc.ContextWithClaims(context.Background(), &rpc.Claims{Sub: "user-123", Email: "user@example.com"})
+	badURL := "not a url"
+
+	_, err := handler.CreateSecret(ctx, connect.NewRequest(&consolev1.CreateSecretRequest{
+		Name:    "db-creds",
+		Project: "test-namespace",
+		Data:    map[string][]byte{"pass word": []byte("x"), "token": []byte("y")},
+		Generate: map[string]*consolev1.GenerateSpec{
+			"token": {},
+		},
+		UserGrants: []*consolev1.ShareGrant{
+			{Principal: "user@example.com", Role: consolev1.Role_ROLE_OWNER},
+			{Principal: "bob", Role: consolev1.Role_ROLE_VIEWER, Keys: []string{"bad/key"}},
+		},
+		RoleGrants: []*consolev1.ShareGrant{{Principal: "dev team", Role: consolev1.Role_ROLE_VIEWER}},
+		Url:        &badURL,
+	}))
+	if connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Fatalf("expected InvalidArgument, got %v", err)
+	}
+
+	want := map[string]string{
+		`data["pass word"]`:        validation.ReasonDataKey,
+		`generate["token"]`:        validation.ReasonConflict,
+		"user_grants[1].principal": validation.ReasonPrincipal,
+		"user_grants[1].keys[0]":   validation.ReasonDataKey,
+		"role_grants[0].principal": validation.ReasonPrincipal,
+		"url":                      validation.ReasonURL,
+	}
+	got := map[string]string{}
+	for _, fv := range validation.FieldViolations(err) {
+		got[fv.Field] = fv.Reason
+	}
+	if len(got) != len(want) {
+		t.Fatalf("expected violations %v, got %v", want, got)
+	}
+	for field, reason := range want {
+		if got[field] != reason {
+			t.Errorf("field %s: expected reason %s, got %q", field, reason, got[field])
+		}
+	}
+}
@@ -0,0 +1,439 @@
+// Package secrettemplates implements SecretTemplatesService: named
+// blueprints that standardize the keys, generation rules and default
+// grants of project secrets. Templates are defined at organization or
+// project scope, and CreateSecretFromTemplate lays out a new secret from
+// the template a project sees by a given name.
+package secrettemplates
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"maps"
+	"slices"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/holos-run/holos-console/console/permissions"
+	"github.com/holos-run/holos-console/console/rpc"
+	"github.com/holos-run/holos-console/console/secrets"
+	"github.com/holos-run/holos-console/console/validation"
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+	"github.com/holos-run/holos-console/gen/holos/console/v1/consolev1connect"
+)
+
+// auditResourceType is the resource_type value for secret template audit
+// log events.
+const auditResourceType = "secret_template"
+
+const (
+	// verbRead is the namespace verb required to read the templates of a
+	// scope.
+	verbRead = "get"
+	// verbManage is the namespace verb required to create, update and
+	// delete the templates of a scope.
+	verbManage = "update"
+)
+
+// SecretCreator creates project secrets. *secrets.Handler implements it,
+// so secrets created from templates get the same validation, quota and
+// authorization as any other new secret.
+type SecretCreator interface {
+	CreateSecret(ctx context.Context, req *connect.Request[consolev1.CreateSecretRequest]) (*connect.Response[consolev1.CreateSecretResponse], error)
+}
+
+// Handler implements consolev1connect.SecretTemplatesServiceHandler.
+type Handler struct {
+	consolev1connect.UnimplementedSecretTemplatesServiceHandler
+	k8s     *K8sClient
+	secrets SecretCreator
+}
+
+// NewHandler returns a SecretTemplatesService handler. Templates are stored
+// with k8s; secrets are created with secretCreator.
+func NewHandler(k8s *K8sClient, secretCreator SecretCreator) *Handler {
+	return &Handler{k8s: k8s, secrets: secretCreator}
+}
+
+// ListSecretTemplates returns the templates of a scope, followed by the
+// inherited organization templates when the scope is a project.
+func (h *Handler) ListSecretTemplates(
+	ctx context.Context,
+	req *connect.Request[consolev1.ListSecretTemplatesRequest],
+) (*connect.Response[consolev1.ListSecretTemplatesResponse], error) {
+	claims := rpc.MustClaims(ctx)
+	scope, err := scopeOf(req.Msg.Organization, req.Msg.Project)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.authorize(ctx, scope, verbRead); err != nil {
+		return nil, err
+	}
+	templates, err := h.k8s.ListTemplates(ctx, scope)
+	if err != nil {
+		return nil, rpc.MapK8sError(err)
+	}
+	if scope.Project != "" {
+		inherited, err := h.inheritedTemplates(ctx, scope.Project, templates)
+		if err != nil {
+			return nil, err
+		}
+		templates = append(templates, inherited...)
+	}
+
+	slog.InfoContext(ctx, "secret templates listed",
+		slog.String("action", "secret_template_list"),
+		slog.String("resource_type", auditResourceType),
+		slog.String("organization", scope.Organization),
+		slog.String("project", scope.Project),
+		slog.String("sub", claims.Sub),
+		slog.String("email", claims.Email),
+		slog.Int("count", len(templates)),
+	)
+	return connect.NewResponse(&consolev1.ListSecretTemplatesResponse{Templates: templates}), nil
+}
+
+// GetSecretTemplate returns a single template of a scope.
+func (h *Handler) GetSecretTemplate(
+	ctx context.Context,
+	req *connect.Request[consolev1.GetSecretTemplateRequest],
+) (*connect.Response[consolev1.GetSecretTemplateResponse], error) {
+	rpc.MustClaims(ctx)
+	scope, err := scopeOf(req.Msg.Organization, req.Msg.Project)
+	if err != nil {
+		return nil, err
+	}
+	if req.Msg.Name == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("name is required"))
+	}
+	if err := h.authorize(ctx, scope, verbRead); err != nil {
+		return nil, err
+	}
+	tmpl, err := h.k8s.GetTemplate(ctx, scope, req.Msg.Name)
+	if err != nil {
+		return nil, rpc.MapK8sError(err)
+	}
+	return connect.NewResponse(&consolev1.GetSecretTemplateResponse{Template: tmpl}), nil
+}
+
+// CreateSecretTemplate defines a new template.
+func (h *Handler) CreateSecretTemplate(
+	ctx context.Context,
+	req *connect.Request[consolev1.CreateSecretTemplateRequest],
+) (*connect.Response[consolev1.CreateSecretTemplateResponse], error) {
+	claims := rpc.MustClaims(ctx)
+	tmpl := proto.CloneOf(req.Msg.Template)
+	if err := validateTemplate(tmpl); err != nil {
+		return nil, err
+	}
+	if err := h.authorize(ctx, tmpl, verbManage); err != nil {
+		return nil, err
+	}
+	tmpl.CreatorEmail = claims.Email
+	tmpl.CreatedAt = timestamppb.Now()
+	created, err := h.k8s.CreateTemplate(ctx, tmpl)
+	if err != nil {
+		return nil, rpc.MapK8sError(err)
+	}
+
+	slog.InfoContext(ctx, "secret template created",
+		slog.String("action", "secret_template_create"),
+		slog.String("resource_type", auditResourceType),
+		slog.String("name", created.Name),
+		slog.String("organization", created.Organization),
+		slog.String("project", created.Project),
+		slog.String("sub", claims.Sub),
+		slog.String("email", claims.Email),
+	)
+	return connect.NewResponse(&consolev1.CreateSecretTemplateResponse{Template: created}), nil
+}
+
+// UpdateSecretTemplate replaces the definition of an existing template.
+func (h *Handler) UpdateSecretTemplate(
+	ctx context.Context,
+	req *connect.Request[consolev1.UpdateSecretTemplateRequest],
+) (*connect.Response[consolev1.UpdateSecretTemplateResponse], error) {
+	claims := rpc.MustClaims(ctx)
+	tmpl := req.Msg.Template
+	if err := validateTemplate(tmpl); err != nil {
+		return nil, err
+	}
+	if err := h.authorize(ctx, tmpl, verbManage); err != nil {
+		return nil, err
+	}
+	updated, err := h.k8s.UpdateTemplate(ctx, tmpl)
+	if err != nil {
+		return nil, rpc.MapK8sError(err)
+	}
+
+	slog.InfoContext(ctx, "secret template updated",
+		slog.String("action", "secret_template_update"),
+		slog.String("resource_type", auditResourceType),
+		slog.String("name", updated.Name),
+		slog.String("organization", updated.Organization),
+		slog.String("project", updated.Project),
+		slog.String("sub", claims.Sub),
+		slog.String("email", claims.Email),
+	)
+	return connect.NewResponse(&consolev1.UpdateSecretTemplateResponse{Template: updated}), nil
+}
+
+// DeleteSecretTemplate removes a template.
+func (h *Handler) DeleteSecretTemplate(
+	ctx context.Context,
+	req *connect.Request[consolev1.DeleteSecretTemplateRequest],
+) (*connect.Response[consolev1.DeleteSecretTemplateResponse], error) {
+	claims := rpc.MustClaims(ctx)
+	scope, err := scopeOf(req.Msg.Organization, req.Msg.Project)
+	if err != nil {
+		return nil, err
+	}
+	if req.Msg.Name == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("name is required"))
+	}
+	if err := h.authorize(ctx, scope, verbManage); err != nil {
+		return nil, err
+	}
+	if err := h.k8s.DeleteTemplate(ctx, scope, req.Msg.Name); err != nil {
+		return nil, rpc.MapK8sError(err)
+	}
+
+	slog.InfoContext(ctx, "secret template deleted",
+		slog.String("action", "secret_template_delete"),
+		slog.String("resource_type", auditResourceType),
+		slog.String("name", req.Msg.Name),
+		slog.String("organization", scope.Organization),
+		slog.String("project", scope.Project),
+		slog.String("sub", claims.Sub),
+		slog.String("email", claims.Email),
+	)
+	return connect.NewResponse(&consolev1.DeleteSecretTemplateResponse{}), nil
+}
+
+// CreateSecretFromTemplate creates a project secret laid out by the named
+// template. Supplied values take precedence over generated and default
+// values; keys the template does not define are rejected.
+func (h *Handler) CreateSecretFromTemplate(
+	ctx context.Context,
+	req *connect.Request[consolev1.CreateSecretFromTemplateRequest],
+) (*connect.Response[consolev1.CreateSecretFromTemplateResponse], error) {
+	claims := rpc.MustClaims(ctx)
+	msg := req.Msg
+	var v validation.Violations
+	v.Required("project", msg.Project)
+	v.Required("template", msg.Template)
+	v.DNSSubdomain("name", msg.Name)
+	if err := v.Err(); err != nil {
+		return nil, err
+	}
+	scope := &consolev1.SecretTemplate{Project: msg.Project}
+	if err := h.authorize(ctx, scope, verbRead); err != nil {
+		return nil, err
+	}
+	tmpl, err := h.resolveTemplate(ctx, msg.Project, msg.Template)
+	if err != nil {
+		return nil, err
+	}
+	create, err := secretFromTemplate(tmpl, msg)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := h.secrets.CreateSecret(ctx, connect.NewRequest(create))
+	if err != nil {
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "secret created from template",
+		slog.String("action", "secret_template_apply"),
+		slog.String("resource_type", auditResourceType),
+		slog.String("name", tmpl.Name),
+		slog.String("organization", tmpl.Organization),
+		slog.String("project", msg.Project),
+		slog.String("secret", create.Name),
+		slog.String("sub", claims.Sub),
+		slog.String("email", claims.Email),
+		slog.Bool("dry_run", msg.DryRun),
+	)
+	return connect.NewResponse(&consolev1.CreateSecretFromTemplateResponse{
+		Name:            resp.Msg.Name,
+		GeneratedValues: resp.Msg.GeneratedValues,
+	}), nil
+}
+
+// secretFromTemplate returns the CreateSecretRequest laying out msg's
+// secret by tmpl.
+func secretFromTemplate(tmpl *consolev1.SecretTemplate, msg *consolev1.CreateSecretFromTemplateRequest) (*consolev1.CreateSecretRequest, error) {
+	var v validation.Violations
+	create := &consolev1.CreateSecretRequest{
+		Name:        msg.Name,
+		Project:     msg.Project,
+		StringData:  make(map[string]string, len(tmpl.Keys)),
+		Generate:    make(map[string]*consolev1.GenerateSpec),
+		Description: msg.Description,
+		Url:         msg.Url,
+		UserGrants:  slices.Concat(tmpl.DefaultUserGrants, msg.UserGrants),
+		RoleGrants:  slices.Concat(tmpl.DefaultRoleGrants, msg.RoleGrants),
+		DryRun:      msg.DryRun,
+	}
+	if create.Description == nil && tmpl.Description != "" {
+		create.Description = &tmpl.Description
+	}
+	defined := make(map[string]bool, len(tmpl.Keys))
+	for _, key := range tmpl.Keys {
+		defined[key.Name] = true
+		value, ok := msg.StringData[key.Name]
+		switch {
+		case ok:
+			create.StringData[key.Name] = value
+		case key.Generate != nil:
+			create.Generate[key.Name] = key.Generate
+		case key.DefaultValue != "":
+			create.StringData[key.Name] = key.DefaultValue
+		case key.Required:
+			v.Add(validation.Key("string_data", key.Name), validation.ReasonRequired, "template %q requires a value for key %q", tmpl.Name, key.Name)
+		}
+	}
+	for _, key := range slices.Sorted(maps.Keys(msg.StringData)) {
+		if !defined[key] {
+			v.Add(validation.Key("string_data", key), validation.ReasonConflict, "template %q does not define key %q", tmpl.Name, key)
+		}
+	}
+	if err := v.Err(); err != nil {
+		return nil, err
+	}
+	return create, nil
+}
+
+// resolveTemplate returns the template a project sees by name: its own, or
+// its organization's when the project defines none by that name.
+func (h *Handler) resolveTemplate(ctx context.Context, project, name string) (*consolev1.SecretTemplate, error) {
+	tmpl, err := h.k8s.GetTemplate(ctx, &consolev1.SecretTemplate{Project: project}, name)
+	if err == nil {
+		return tmpl, nil
+	}
+	if !isNotFound(err) {
+		return nil, rpc.MapK8sError(err)
+	}
+	org, err := h.k8s.ProjectOrganization(ctx, project)
+	if err != nil {
+		return nil, rpc.MapK8sError(err)
+	}
+	if org != "" {
+		tmpl, err = h.k8s.GetTemplate(ctx, &consolev1.SecretTemplate{Organization: org}, name)
+		if err == nil {
+			return tmpl, nil
+		}
+		if !isNotFound(err) {
+			return nil, rpc.MapK8sError(err)
+		}
+	}
+	return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("secret template %q not found in project %q or its organization", name, project))
+}
+
+// inheritedTemplates returns the templates of project's organization that
+// own does not shadow.
+func (h *Handler) inheritedTemplates(ctx context.Context, project string, own []*consolev1.SecretTemplate) ([]*consolev1.SecretTemplate, error) {
+	org, err := h.k8s.ProjectOrganization(ctx, project)
+	if err != nil {
+		return nil, rpc.MapK8sError(err)
+	}
+	if org == "" {
+		return nil, nil
+	}
+	orgTemplates, err := h.k8s.ListTemplates(ctx, &consolev1.SecretTemplate{Organization: org})
+	if err != nil {
+		return nil, rpc.MapK8sError(err)
+	}
+	return slices.DeleteFunc(orgTemplates, func(t *consolev1.SecretTemplate) bool {
+		return slices.ContainsFunc(own, func(o *consolev1.SecretTemplate) bool { return o.Name == t.Name })
+	}), nil
+}
+
+// authorize checks that the caller holds verb on the namespace of scope.
+func (h *Handler) authorize(ctx context.Context, scope *consolev1.SecretTemplate, verb string) error {
+	clientset := rpc.ImpersonatedClientsetFromContext(ctx)
+	if clientset == nil {
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("no kubernetes client on request context"))
+	}
+	perm, err := permissions.Review(ctx, clientset, &consolev1.ResourceAttributes{
+		Verb:     verb,
+		Resource: "namespaces",
+		Name:     h.k8s.Namespace(scope),
+	})
+	if err != nil {
+		return rpc.MapK8sError(err)
+	}
+	if !perm.Allowed {
+		kind, name := "organization", scope.Organization
+		if scope.Project != "" {
+			kind, name = "project", scope.Project
+		}
+		return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("%s access to %s %q is required", verb, kind, name))
+	}
+	return nil
+}
+
+// scopeOf returns the template scope selected by exactly one of org and
+// project.
+func scopeOf(org, project string) (*consolev1.SecretTemplate, error) {
+	if (org == "") == (project == "") {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("exactly one of organization or project is required"))
+	}
+	return &consolev1.SecretTemplate{Organization: org, Project: project}, nil
+}
+
+// validateTemplate checks every field of a template definition and returns
+// all violations at once.
+func validateTemplate(tmpl *consolev1.SecretTemplate) error {
+	var v validation.Violations
+	if tmpl == nil {
+		v.Add("template", validation.ReasonRequired, "template is required")
+		return v.Err()
+	}
+	v.Name("template.name", tmpl.Name)
+	if (tmpl.Organization == "") == (tmpl.Project == "") {
+		v.Add("template.organization", validation.ReasonConflict, "exactly one of template.organization or template.project is required")
+	}
+	if len(tmpl.Keys) == 0 {
+		v.Add("template.keys", validation.ReasonRequired, "template.keys is required")
+	}
+	seen := make(map[string]bool, len(tmpl.Keys))
+	for i, key := range tmpl.Keys {
+		field := validation.Index("template.keys", i)
+		if !v.Required(field+".name", key.Name) {
+			continue
+		}
+		v.DataKey(field+".name", key.Name)
+		if seen[key.Name] {
+			v.Add(field+".name", validation.ReasonConflict, "key %q is defined more than once", key.Name)
+		}
+		seen[key.Name] = true
+		rules := 0
+		for _, set := range []bool{key.Generate != nil, key.DefaultValue != "", key.Required} {
+			if set {
+				rules++
+			}
+		}
+		if rules > 1 {
+			v.Add(field, validation.ReasonConflict, "key %q may set only one of generate, default_value and required", key.Name)
+		}
+		if key.Generate != nil {
+			// Generating a throwaway value checks the rule exactly as
+			// CreateSecret will.
+			if _, err := secrets.GenerateValue(key.Generate); err != nil {
+				v.Add(field+".generate", validation.ReasonConflict, "key %q: %v", key.Name, err)
+			}
+		}
+	}
+	v.UserGrants("template.default_user_grants", tmpl.DefaultUserGrants)
+	v.RoleGrants("template.default_role_grants", tmpl.DefaultRoleGrants)
+	return v.Err()
+}
+
+// isNotFound reports whether err is a Kubernetes or connect NotFound error.
+func isNotFound(err error) bool {
+	return apierrors.IsNotFound(err) || connect.CodeOf(err) == connect.CodeNotFound
+}
@@ -0,0 +1,253 @@
+package secrettemplates
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	authzv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	clienttesting "k8s.io/client-go/testing"
+
+	v1alpha2 "github.com/holos-run/holos-console/api/v1alpha2"
+	"github.com/holos-run/holos-console/console/resolver"
+	"github.com/holos-run/holos-console/console/rpc"
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+)
+
+func testResolver() *resolver.Resolver {
+	return &resolver.Resolver{NamespacePrefix: "holos-", OrganizationPrefix: "org-", FolderPrefix: "fld-", ProjectPrefix: "prj-"}
+}
+
+// fakeSecretCreator records the CreateSecret requests it receives.
+type fakeSecretCreator struct {
+	created *consolev1.CreateSecretRequest
+}
+
+func (f *fakeSecretCreator) CreateSecret(_ context.Context, req *connect.Request[consolev1.CreateSecretRequest]) (*connect.Response[consolev1.CreateSecretResponse], error) {
+	f.created = req.Msg
+	generated := make(map[string]string, len(req.Msg.Generate))
+	for key := range req.Msg.Generate {
+		generated[key] = "generated"
+	}
+	return connect.NewResponse(&consolev1.CreateSecretResponse{Name: req.Msg.Name, GeneratedValues: generated}), nil
+}
+
+// testEnv is a fake cluster holding the acme organization and its billing
+// project. allowed maps the namespace verbs the caller holds.
+type testEnv struct {
+	client  *fake.Clientset
+	handler *Handler
+	secrets *fakeSecretCreator
+	allowed map[string]bool
+}
+
+func newTestEnv(t *testing.T) *testEnv {
+	t.Helper()
+	r := testResolver()
+	client := fake.NewClientset(
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+			Name: r.OrgNamespace("acme"),
+			Labels: map[string]string{
+				v1alpha2.LabelManagedBy:    v1alpha2.ManagedByValue,
+				v1alpha2.LabelResourceType: v1alpha2.ResourceTypeOrganization,
+				v1alpha2.LabelOrganization: "acme",
+			},
+		}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+			Name: r.ProjectNamespace("billing"),
+			Labels: map[string]string{
+				v1alpha2.LabelManagedBy:    v1alpha2.ManagedByValue,
+				v1alpha2.LabelResourceType: v1alpha2.ResourceTypeProject,
+				v1alpha2.LabelOrganization: "acme",
+				v1alpha2.LabelProject:      "billing",
+			},
+		}},
+	)
+	e := &testEnv{
+		client:  client,
+		secrets: &fakeSecretCreator{},
+		allowed: map[string]bool{verbRead: true, verbManage: true},
+	}
+	e.handler = NewHandler(NewK8sClient(client, r), e.secrets)
+	client.PrependReactor("create", "selfsubjectaccessreviews", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		ssar := action.(clienttesting.CreateAction).GetObject().(*authzv1.SelfSubjectAccessReview)
+		ssar.Status.Allowed = e.allowed[ssar.Spec.ResourceAttributes.Verb]
+		return true, ssar, nil
+	})
+	return e
+}
+
+func (e *testEnv) ctx() context.Context {
+	ctx := rpc.ContextWithClaims(context.Background(), &rpc.Claims{Sub: "sub-alice", Email: "alice@example.com"})
+	return rpc.ContextWithImpersonatedClients(ctx, &rpc.ImpersonatedClients{Clientset: e.client})
+}
+
+func (e *testEnv) create(t *testing.T, tmpl *consolev1.SecretTemplate) {
+	t.Helper()
+	if _, err := e.handler.CreateSecretTemplate(e.ctx(), connect.NewRequest(&consolev1.CreateSecretTemplateRequest{Template: tmpl})); err != nil {
+		t.Fatalf("CreateSecretTemplate: %v", err)
+	}
+}
+
+func postgresTemplate() *consolev1.SecretTemplate {
+	return &consolev1.SecretTemplate{
+		Name:         "postgres",
+		Organization: "acme",
+		Description:  "PostgreSQL credentials",
+		Keys: []*consolev1.SecretTemplateKey{
+			{Name: "username", Required: true},
+			{Name: "password", Generate: &consolev1.GenerateSpec{Length: 24}},
+			{Name: "host", Description: "database host"},
+			{Name: "port", DefaultValue: "5432"},
+		},
+		DefaultRoleGrants: []*consolev1.ShareGrant{{Principal: "dba", Role: consolev1.Role_ROLE_OWNER}},
+	}
+}
+
+func TestSecretTemplates_CRUDAndInheritance(t *testing.T) {
+	e := newTestEnv(t)
+	ctx := e.ctx()
+	e.create(t, postgresTemplate())
+	e.create(t, &consolev1.SecretTemplate{Name: "redis", Organization: "acme", Keys: []*consolev1.SecretTemplateKey{{Name: "password", Required: true}}})
+	e.create(t, &consolev1.SecretTemplate{Name: "redis", Project: "billing", Keys: []*consolev1.SecretTemplateKey{{Name: "url", Required: true}}})
+
+	got, err := e.handler.GetSecretTemplate(ctx, connect.NewRequest(&consolev1.GetSecretTemplateRequest{Organization: "acme", Name: "postgres"}))
+	if err != nil {
+		t.Fatalf("GetSecretTemplate: %v", err)
+	}
+	if tmpl := got.Msg.Template; len(tmpl.Keys) != 4 || tmpl.CreatorEmail != "alice@example.com" || tmpl.Organization != "acme" {
+		t.Errorf("unexpected template %v", tmpl)
+	}
+
+	list, err := e.handler.ListSecretTemplates(ctx, connect.NewRequest(&consolev1.ListSecretTemplatesRequest{Project: "billing"}))
+	if err != nil {
+		t.Fatalf("ListSecretTemplates: %v", err)
+	}
+	var names []string
+	for _, tmpl := range list.Msg.Templates {
+		names = append(names, tmpl.Project+"/"+tmpl.Organization+"/"+tmpl.Name)
+	}
+	if want := []string{"billing//redis", "/acme/postgres"}; len(names) != len(want) || names[0] != want[0] || names[1] != want[1] {
+		t.Errorf("templates = %v, want %v", names, want)
+	}
+
+	update := postgresTemplate()
+	update.Description = "Postgres"
+	updated, err := e.handler.UpdateSecretTemplate(ctx, connect.NewRequest(&consolev1.UpdateSecretTemplateRequest{Template: update}))
+	if err != nil {
+		t.Fatalf("UpdateSecretTemplate: %v", err)
+	}
+	if updated.Msg.Template.Description != "Postgres" || updated.Msg.Template.CreatedAt == nil {
+		t.Errorf("unexpected updated template %v", updated.Msg.Template)
+	}
+
+	if _, err := e.handler.DeleteSecretTemplate(ctx, connect.NewRequest(&consolev1.DeleteSecretTemplateRequest{Organization: "acme", Name: "postgres"})); err != nil {
+		t.Fatalf("DeleteSecretTemplate: %v", err)
+	}
+	_, err = e.handler.GetSecretTemplate(ctx, connect.NewRequest(&consolev1.GetSecretTemplateRequest{Organization: "acme", Name: "postgres"}))
+	if connect.CodeOf(err) != connect.CodeNotFound {
+		t.Errorf("expected NotFound after delete, got %v", err)
+	}
+}
+
+func TestCreateSecretTemplate_Validation(t *testing.T) {
+	e := newTestEnv(t)
+	for _, tt := range []struct {
+		name string
+		tmpl *consolev1.SecretTemplate
+	}{
+		{"no scope", &consolev1.SecretTemplate{Name: "pg", Keys: []*consolev1.SecretTemplateKey{{Name: "a"}}}},
+		{"both scopes", &consolev1.SecretTemplate{Name: "pg", Organization: "acme", Project: "billing", Keys: []*consolev1.SecretTemplateKey{{Name: "a"}}}},
+		{"no keys", &consolev1.SecretTemplate{Name: "pg", Organization: "acme"}},
+		{"bad name", &consolev1.SecretTemplate{Name: "Postgres", Organization: "acme", Keys: []*consolev1.SecretTemplateKey{{Name: "a"}}}},
+		{"duplicate key", &consolev1.SecretTemplate{Name: "pg", Organization: "acme", Keys: []*consolev1.SecretTemplateKey{{Name: "a"}, {Name: "a"}}}},
+		{"bad key", &consolev1.SecretTemplate{Name: "pg", Organization: "acme", Keys: []*consolev1.SecretTemplateKey{{Name: "a/b"}}}},
+		{"conflicting rules", &consolev1.SecretTemplate{Name: "pg", Organization: "acme", Keys: []*consolev1.SecretTemplateKey{{Name: "a", Required: true, DefaultValue: "x"}}}},
+		{"bad generate", &consolev1.SecretTemplate{Name: "pg", Organization: "acme", Keys: []*consolev1.SecretTemplateKey{{Name: "a", Generate: &consolev1.GenerateSpec{Length: 5000}}}}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := e.handler.CreateSecretTemplate(e.ctx(), connect.NewRequest(&consolev1.CreateSecretTemplateRequest{Template: tt.tmpl}))
+			if connect.CodeOf(err) != connect.CodeInvalidArgument {
+				t.Fatalf("expected InvalidArgument, got %v", err)
+			}
+		})
+	}
+}
+
+func TestSecretTemplates_RequireNamespaceAccess(t *testing.T) {
+	e := newTestEnv(t)
+	e.allowed[verbManage] = false
+	_, err := e.handler.CreateSecretTemplate(e.ctx(), connect.NewRequest(&consolev1.CreateSecretTemplateRequest{Template: postgresTemplate()}))
+	if connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Fatalf("expected PermissionDenied creating without update access, got %v", err)
+	}
+	e.allowed[verbRead] = false
+	_, err = e.handler.ListSecretTemplates(e.ctx(), connect.NewRequest(&consolev1.ListSecretTemplatesRequest{Organization: "acme"}))
+	if connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Fatalf("expected PermissionDenied listing without get access, got %v", err)
+	}
+}
+
+func TestCreateSecretFromTemplate(t *testing.T) {
+	e := newTestEnv(t)
+	e.create(t, postgresTemplate())
+
+	resp, err := e.handler.CreateSecretFromTemplate(e.ctx(), connect.NewRequest(&consolev1.CreateSecretFromTemplateRequest{
+		Project:    "billing",
+		Template:   "postgres",
+		Name:       "orders-db",
+		StringData: map[string]string{"username": "orders", "host": "db.internal"},
+		UserGrants: []*consolev1.ShareGrant{{Principal: "bob@example.com", Role: consolev1.Role_ROLE_VIEWER}},
+	}))
+	if err != nil {
+		t.Fatalf("CreateSecretFromTemplate: %v", err)
+	}
+	if resp.Msg.Name != "orders-db" || resp.Msg.GeneratedValues["password"] != "generated" {
+		t.Errorf("unexpected response %v", resp.Msg)
+	}
+	got := e.secrets.created
+	want := map[string]string{"username": "orders", "host": "db.internal", "port": "5432"}
+	if len(got.StringData) != len(want) {
+		t.Errorf("string_data = %v, want %v", got.StringData, want)
+	}
+	for k, v := range want {
+		if got.StringData[k] != v {
+			t.Errorf("string_data[%q] = %q, want %q", k, got.StringData[k], v)
+		}
+	}
+	if len(got.Generate) != 1 || got.Generate["password"].GetLength() != 24 {
+		t.Errorf("unexpected generate %v", got.Generate)
+	}
+	if got.GetDescription() != "PostgreSQL credentials" || got.Project != "billing" {
+		t.Errorf("unexpected request %v", got)
+	}
+	if len(got.RoleGrants) != 1 || got.RoleGrants[0].Principal != "dba" || len(got.UserGrants) != 1 {
+		t.Errorf("expected template and request grants, got users %v roles %v", got.UserGrants, got.RoleGrants)
+	}
+}
+
+func TestCreateSecretFromTemplate_Errors(t *testing.T) {
+	e := newTestEnv(t)
+	e.create(t, postgresTemplate())
+	for _, tt := range []struct {
+		name string
+		msg  *consolev1.CreateSecretFromTemplateRequest
+		code connect.Code
+	}{
+		{"missing required key", &consolev1.CreateSecretFromTemplateRequest{Project: "billing", Template: "postgres", Name: "db"}, connect.CodeInvalidArgument},
+		{"undefined key", &consolev1.CreateSecretFromTemplateRequest{Project: "billing", Template: "postgres", Name: "db", StringData: map[string]string{"username": "u", "sslmode": "require"}}, connect.CodeInvalidArgument},
+		{"bad secret name", &consolev1.CreateSecretFromTemplateRequest{Project: "billing", Template: "postgres", Name: "DB"}, connect.CodeInvalidArgument},
+		{"unknown template", &consolev1.CreateSecretFromTemplateRequest{Project: "billing", Template: "mysql", Name: "db"}, connect.CodeNotFound},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := e.handler.CreateSecretFromTemplate(e.ctx(), connect.NewRequest(tt.msg))
+			if connect.CodeOf(err) != tt.code {
+				t.Fatalf("expected %v, got %v", tt.code, err)
+			}
+		})
+	}
+}
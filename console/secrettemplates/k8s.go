@@ -0,0 +1,215 @@
+package secrettemplates
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+
+	"connectrpc.com/connect"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+
+	v1alpha2 "github.com/holos-run/holos-console/api/v1alpha2"
+	"github.com/holos-run/holos-console/console/resolver"
+	"github.com/holos-run/holos-console/console/tracing"
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+)
+
+const (
+	// namePrefix prefixes the ConfigMap name of every secret template so
+	// templates cannot collide with other ConfigMaps in the namespace.
+	namePrefix = "secret-template-"
+	// dataKey is the ConfigMap data key holding the protojson-encoded
+	// SecretTemplate.
+	dataKey = "template.json"
+)
+
+// K8sClient stores secret templates as ConfigMaps in the organization or
+// project namespace that defines them. Every call uses the console service
+// account; the handler authorizes callers with SelfSubjectAccessReviews
+// first.
+type K8sClient struct {
+	client   kubernetes.Interface
+	Resolver *resolver.Resolver
+}
+
+// NewK8sClient creates a client for secret template storage.
+func NewK8sClient(client kubernetes.Interface, r *resolver.Resolver) *K8sClient {
+	return &K8sClient{client: client, Resolver: r}
+}
+
+// Namespace returns the namespace holding the templates of the scope tmpl
+// names: the project namespace when project is set, otherwise the
+// organization namespace.
+func (c *K8sClient) Namespace(tmpl *consolev1.SecretTemplate) string {
+	if tmpl.Project != "" {
+		return c.Resolver.ProjectNamespace(tmpl.Project)
+	}
+	return c.Resolver.OrgNamespace(tmpl.Organization)
+}
+
+// ProjectOrganization returns the organization a console-managed project
+// belongs to.
+func (c *K8sClient) ProjectOrganization(ctx context.Context, project string) (string, error) {
+	ns, err := c.client.CoreV1().Namespaces().Get(ctx, c.Resolver.ProjectNamespace(project), metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+	if ns.Labels[v1alpha2.LabelManagedBy] != v1alpha2.ManagedByValue ||
+		ns.Labels[v1alpha2.LabelResourceType] != v1alpha2.ResourceTypeProject {
+		return "", connect.NewError(connect.CodeNotFound, fmt.Errorf("project %q not found", project))
+	}
+	return ns.Labels[v1alpha2.LabelOrganization], nil
+}
+
+// ListTemplates returns the templates of scope, sorted by name.
+func (c *K8sClient) ListTemplates(ctx context.Context, scope *consolev1.SecretTemplate) ([]*consolev1.SecretTemplate, error) {
+	ns := c.Namespace(scope)
+	ctx, span := tracing.Start(ctx, "secrettemplates.K8sClient.ListTemplates", attribute.String("namespace", ns))
+	defer span.End()
+	selector := labels.SelectorFromSet(labels.Set{
+		v1alpha2.LabelManagedBy:    v1alpha2.ManagedByValue,
+		v1alpha2.LabelResourceType: v1alpha2.ResourceTypeSecretTemplate,
+	})
+	list, err := c.client.CoreV1().ConfigMaps(ns).List(ctx, metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return nil, err
+	}
+	templates := make([]*consolev1.SecretTemplate, 0, len(list.Items))
+	for i := range list.Items {
+		tmpl, err := decode(&list.Items[i], scope)
+		if err != nil {
+			slog.WarnContext(ctx, "skipping malformed secret template",
+				slog.String("namespace", ns),
+				slog.String("name", list.Items[i].Name),
+				slog.Any("error", err),
+			)
+			continue
+		}
+		templates = append(templates, tmpl)
+	}
+	sort.Slice(templates, func(i, j int) bool { return templates[i].Name < templates[j].Name })
+	return templates, nil
+}
+
+// GetTemplate returns the named template of scope.
+func (c *K8sClient) GetTemplate(ctx context.Context, scope *consolev1.SecretTemplate, name string) (*consolev1.SecretTemplate, error) {
+	ns := c.Namespace(scope)
+	ctx, span := tracing.Start(ctx, "secrettemplates.K8sClient.GetTemplate", attribute.String("namespace", ns), attribute.String("name", name))
+	defer span.End()
+	cm, err := c.client.CoreV1().ConfigMaps(ns).Get(ctx, namePrefix+name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if cm.Labels[v1alpha2.LabelResourceType] != v1alpha2.ResourceTypeSecretTemplate {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("secret template %q not found", name))
+	}
+	return decode(cm, scope)
+}
+
+// CreateTemplate stores a new template in the namespace of its scope.
+func (c *K8sClient) CreateTemplate(ctx context.Context, tmpl *consolev1.SecretTemplate) (*consolev1.SecretTemplate, error) {
+	ns := c.Namespace(tmpl)
+	ctx, span := tracing.Start(ctx, "secrettemplates.K8sClient.CreateTemplate", attribute.String("namespace", ns), attribute.String("name", tmpl.Name))
+	defer span.End()
+	slog.DebugContext(ctx, "creating secret template in kubernetes",
+		slog.String("namespace", ns),
+		slog.String("name", tmpl.Name),
+	)
+	lbls := map[string]string{
+		v1alpha2.LabelManagedBy:    v1alpha2.ManagedByValue,
+		v1alpha2.LabelResourceType: v1alpha2.ResourceTypeSecretTemplate,
+	}
+	if tmpl.Project != "" {
+		lbls[v1alpha2.LabelProject] = tmpl.Project
+	} else {
+		lbls[v1alpha2.LabelOrganization] = tmpl.Organization
+	}
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      namePrefix + tmpl.Name,
+			Namespace: ns,
+			Labels:    lbls,
+		},
+	}
+	if err := encode(cm, tmpl); err != nil {
+		return nil, err
+	}
+	created, err := c.client.CoreV1().ConfigMaps(ns).Create(ctx, cm, metav1.CreateOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return decode(created, tmpl)
+}
+
+// UpdateTemplate replaces the stored definition of an existing template.
+// The creator and creation time of the stored template are kept.
+func (c *K8sClient) UpdateTemplate(ctx context.Context, tmpl *consolev1.SecretTemplate) (*consolev1.SecretTemplate, error) {
+	ns := c.Namespace(tmpl)
+	ctx, span := tracing.Start(ctx, "secrettemplates.K8sClient.UpdateTemplate", attribute.String("namespace", ns), attribute.String("name", tmpl.Name))
+	defer span.End()
+	cm, err := c.client.CoreV1().ConfigMaps(ns).Get(ctx, namePrefix+tmpl.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if cm.Labels[v1alpha2.LabelResourceType] != v1alpha2.ResourceTypeSecretTemplate {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("secret template %q not found", tmpl.Name))
+	}
+	existing, err := decode(cm, tmpl)
+	if err != nil {
+		return nil, err
+	}
+	next := proto.CloneOf(tmpl)
+	next.CreatorEmail = existing.CreatorEmail
+	next.CreatedAt = existing.CreatedAt
+	if err := encode(cm, next); err != nil {
+		return nil, err
+	}
+	updated, err := c.client.CoreV1().ConfigMaps(ns).Update(ctx, cm, metav1.UpdateOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return decode(updated, tmpl)
+}
+
+// DeleteTemplate removes the named template of scope.
+func (c *K8sClient) DeleteTemplate(ctx context.Context, scope *consolev1.SecretTemplate, name string) error {
+	if _, err := c.GetTemplate(ctx, scope, name); err != nil {
+		return err
+	}
+	ns := c.Namespace(scope)
+	ctx, span := tracing.Start(ctx, "secrettemplates.K8sClient.DeleteTemplate", attribute.String("namespace", ns), attribute.String("name", name))
+	defer span.End()
+	return c.client.CoreV1().ConfigMaps(ns).Delete(ctx, namePrefix+name, metav1.DeleteOptions{})
+}
+
+// encode stores tmpl in cm. The scope is carried by the namespace and
+// labels, so it is not duplicated in the stored JSON.
+func encode(cm *corev1.ConfigMap, tmpl *consolev1.SecretTemplate) error {
+	stored := proto.CloneOf(tmpl)
+	stored.Name, stored.Organization, stored.Project = "", "", ""
+	raw, err := protojson.Marshal(stored)
+	if err != nil {
+		return fmt.Errorf("marshaling secret template: %w", err)
+	}
+	cm.Data = map[string]string{dataKey: string(raw)}
+	return nil
+}
+
+// decode reads the template stored in cm, taking its scope from scope.
+func decode(cm *corev1.ConfigMap, scope *consolev1.SecretTemplate) (*consolev1.SecretTemplate, error) {
+	var tmpl consolev1.SecretTemplate
+	if err := protojson.Unmarshal([]byte(cm.Data[dataKey]), &tmpl); err != nil {
+		return nil, fmt.Errorf("parsing secret template %q: %w", cm.Name, err)
+	}
+	tmpl.Name = strings.TrimPrefix(cm.Name, namePrefix)
+	tmpl.Organization, tmpl.Project = scope.Organization, scope.Project
+	return &tmpl, nil
+}
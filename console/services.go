@@ -0,0 +1,76 @@
+package console
+
+import (
+	"net/http"
+	"strings"
+
+	"connectrpc.com/grpcreflect"
+
+	"github.com/holos-run/holos-console/console/readiness"
+	"github.com/holos-run/holos-console/gen/holos/console/v1/consolev1connect"
+)
+
+// publicServices are the services callable without authentication. Every
+// other service is protected: rpc.RequireClaimsInterceptor, installed in both
+// interceptor chains, rejects protected calls that carry no claims, so
+// protected handlers read them with rpc.MustClaims.
+var publicServices = []string{consolev1connect.VersionServiceName}
+
+// serviceRegistry mounts Connect service handlers on a mux and records each
+// service's fully-qualified name so gRPC reflection advertises every service
+// the server actually serves.
+type serviceRegistry struct {
+	mux      *http.ServeMux
+	services []string
+	// middleware, when set, wraps every API handler mounted through the
+	// registry, e.g. to require client certificates on API routes only.
+	middleware func(http.Handler) http.Handler
+}
+
+func newServiceRegistry(mux *http.ServeMux) *serviceRegistry {
+	return &serviceRegistry{mux: mux}
+}
+
+// handle mounts a handler returned by a consolev1connect New*ServiceHandler
+// constructor. The constructor's path is "/<service name>/".
+func (r *serviceRegistry) handle(path string, handler http.Handler) {
+	r.handleAPI(path, handler)
+	r.services = append(r.services, strings.Trim(path, "/"))
+}
+
+// handleAPI mounts an API handler that is not a Connect service, such as a
+// download endpoint, behind the same middleware as the services.
+func (r *serviceRegistry) handleAPI(path string, handler http.Handler) {
+	if r.middleware != nil {
+		handler = r.middleware(handler)
+	}
+	r.mux.Handle(path, handler)
+}
+
+// handlePublic mounts a handler for callers that hold no API credentials,
+// such as a share link opened in a browser, bypassing the registry
+// middleware so client certificate auth does not apply to it. The handler
+// must authorize requests itself.
+func (r *serviceRegistry) handlePublic(path string, handler http.Handler) {
+	r.mux.Handle(path, handler)
+}
+
+// handleHealth mounts the grpc.health.v1 service, which reports checker's
+// results for every service registered so far. Probes carry no credentials,
+// as with /healthz, so the handler bypasses the registry middleware. Call
+// it after all services are handled and before handleReflection.
+func (r *serviceRegistry) handleHealth(checker *readiness.Checker) {
+	path, handler := checker.HealthHandler(r.services...)
+	r.mux.Handle(path, handler)
+	r.services = append(r.services, readiness.HealthServiceName)
+}
+
+// handleReflection mounts the gRPC reflection v1 and v1alpha handlers for
+// every service registered so far. Call it after all services are handled.
+func (r *serviceRegistry) handleReflection() {
+	reflector := grpcreflect.NewStaticReflector(r.services...)
+	reflectPath, reflectHandler := grpcreflect.NewHandlerV1(reflector)
+	r.handleAPI(reflectPath, reflectHandler)
+	reflectAlphaPath, reflectAlphaHandler := grpcreflect.NewHandlerV1Alpha(reflector)
+	r.handleAPI(reflectAlphaPath, reflectAlphaHandler)
+}
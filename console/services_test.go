@@ -0,0 +1,57 @@
+package console
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"connectrpc.com/grpcreflect"
+
+	"github.com/holos-run/holos-console/gen/holos/console/v1/consolev1connect"
+)
+
+func TestServiceRegistry_Reflection(t *testing.T) {
+	mux := http.NewServeMux()
+	services := newServiceRegistry(mux)
+	services.handle(consolev1connect.NewVersionServiceHandler(consolev1connect.UnimplementedVersionServiceHandler{}))
+	services.handle(consolev1connect.NewProjectServiceHandler(consolev1connect.UnimplementedProjectServiceHandler{}))
+	services.handle(consolev1connect.NewOrganizationServiceHandler(consolev1connect.UnimplementedOrganizationServiceHandler{}))
+	services.handle(consolev1connect.NewSecretsServiceHandler(consolev1connect.UnimplementedSecretsServiceHandler{}))
+	services.handleReflection()
+
+	// Reflection is a bidirectional stream, which requires HTTP/2.
+	server := httptest.NewUnstartedServer(mux)
+	server.EnableHTTP2 = true
+	server.StartTLS()
+	defer server.Close()
+
+	stream := grpcreflect.NewClient(server.Client(), server.URL).NewStream(context.Background())
+	defer stream.Close()
+	names, err := stream.ListServices()
+	if err != nil {
+		t.Fatalf("ListServices: %v", err)
+	}
+	var got []string
+	for _, name := range names {
+		got = append(got, string(name))
+	}
+	slices.Sort(got)
+	want := []string{
+		consolev1connect.OrganizationServiceName,
+		consolev1connect.ProjectServiceName,
+		consolev1connect.SecretsServiceName,
+		consolev1connect.VersionServiceName,
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("expected reflection to list %v, got %v", want, got)
+	}
+
+	// Every listed service must resolve to its descriptor.
+	for _, name := range names {
+		if _, err := stream.FileContainingSymbol(name); err != nil {
+			t.Errorf("FileContainingSymbol(%s): %v", name, err)
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package session
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+)
+
+// KeySize is the length in bytes of a session encryption key.
+const KeySize = 32
+
+// minKeyMaterial is the shortest key file LoadKey accepts.
+const minKeyMaterial = 32
+
+// LoadKey reads a session key file. Any secret of at least 32 bytes is
+// accepted and hashed to a KeySize key, so `openssl rand -base64 32` output
+// works as is. Every replica must share the same file for sessions to
+// survive restarts and load balancing.
+func LoadKey(path string) ([]byte, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading session key: %w", err)
+	}
+	if len(raw) < minKeyMaterial {
+		return nil, fmt.Errorf("session key %s must hold at least %d bytes", path, minKeyMaterial)
+	}
+	sum := sha256.Sum256(raw)
+	return sum[:], nil
+}
+
+// GenerateKey returns a random session key.
+func GenerateKey() []byte {
+	key := make([]byte, KeySize)
+	_, _ = rand.Read(key)
+	return key
+}
+
+// sealer encrypts and authenticates cookie values with AES-GCM. The cookie
+// name is bound as additional data so a login cookie cannot be replayed as
+// a session cookie.
+type sealer struct {
+	aead cipher.AEAD
+}
+
+func newSealer(key []byte) (*sealer, error) {
+	if len(key) != KeySize {
+		return nil, fmt.Errorf("session key must be %d bytes, got %d", KeySize, len(key))
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &sealer{aead: aead}, nil
+}
+
+func (s *sealer) seal(name string, v any) (string, error) {
+	plaintext, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, s.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(s.aead.Seal(nonce, nonce, plaintext, []byte(name))), nil
+}
+
+func (s *sealer) open(name, value string, v any) error {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return err
+	}
+	if len(data) < s.aead.NonceSize() {
+		return errors.New("cookie too short")
+	}
+	nonce, ciphertext := data[:s.aead.NonceSize()], data[s.aead.NonceSize():]
+	plaintext, err := s.aead.Open(nil, nonce, ciphertext, []byte(name))
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(plaintext, v)
+}
@@ -0,0 +1,486 @@
+// Package session implements a backend-for-frontend (BFF) login session for
+// the web UI. The server runs the OIDC authorization code flow itself and
+// keeps the resulting tokens in an encrypted, HTTP-only cookie, so the SPA
+// never holds raw ID or refresh tokens in browser storage.
+//
+// Handler serves the /api/session endpoints and Middleware translates the
+// session cookie into the Authorization header the RPC auth interceptor
+// already verifies, refreshing the ID token when it is about to expire.
+package session
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"golang.org/x/oauth2"
+	"golang.org/x/sync/singleflight"
+
+	"github.com/holos-run/holos-console/console/rpc"
+)
+
+const (
+	// CookieName holds the encrypted session tokens.
+	CookieName = "holos_session"
+	// loginCookieName holds the state and PKCE verifier of an in-flight login.
+	loginCookieName = "holos_session_login"
+
+	// BasePath is the path prefix of the session endpoints.
+	BasePath = "/api/session"
+
+	// loginTTL bounds how long a user may take to complete the IdP login.
+	loginTTL = 10 * time.Minute
+	// refreshSkew refreshes ID tokens this long before they expire so a
+	// request never reaches the verifier with a token about to lapse.
+	refreshSkew = time.Minute
+	// maxCookieBytes is the largest cookie browsers reliably accept.
+	maxCookieBytes = 4096
+)
+
+// DefaultScopes are requested when Config.Scopes is empty. offline_access
+// yields the refresh token the session depends on.
+var DefaultScopes = []string{oidc.ScopeOpenID, "profile", "email", "groups", oidc.ScopeOfflineAccess}
+
+// Config configures the session subsystem.
+type Config struct {
+	// Issuer is the OIDC issuer the console authenticates against.
+	Issuer string
+	// ClientID is the OAuth2 client the console acts as.
+	ClientID string
+	// ClientSecret authenticates a confidential client. Empty uses PKCE
+	// alone, which the embedded Dex public client requires.
+	ClientSecret string
+	// Origin is the public base URL of the console. The IdP redirects back
+	// to Origin + /api/session/callback.
+	Origin string
+	// Scopes are requested at login. Default: DefaultScopes.
+	Scopes []string
+	// RolesClaim is the ID token claim listing the user's groups.
+	// Default: "groups"
+	RolesClaim string
+	// Key encrypts session cookies. It must be 32 bytes; see LoadKey.
+	Key []byte
+	// HTTPClient is used for OIDC discovery and token requests and must
+	// trust the issuer's TLS certificate.
+	HTTPClient *http.Client
+}
+
+// CallbackURL returns the OAuth2 redirect URI for the given console origin.
+// It must be registered with the IdP.
+func CallbackURL(origin string) string {
+	return strings.TrimSuffix(origin, "/") + BasePath + "/callback"
+}
+
+// tokens is the plaintext stored in the session cookie.
+type tokens struct {
+	IDToken      string    `json:"id_token"`
+	RefreshToken string    `json:"refresh_token,omitempty"`
+	Expiry       time.Time `json:"expiry"`
+}
+
+// loginState is the plaintext stored in the login cookie.
+type loginState struct {
+	State    string `json:"state"`
+	Nonce    string `json:"nonce"`
+	Verifier string `json:"verifier"`
+	ReturnTo string `json:"return_to"`
+}
+
+// Info describes the signed-in user. It is the body of GET /api/session.
+type Info struct {
+	Sub       string   `json:"sub"`
+	Email     string   `json:"email,omitempty"`
+	Name      string   `json:"name,omitempty"`
+	Groups    []string `json:"groups,omitempty"`
+	ExpiresAt int64    `json:"expiresAt"`
+}
+
+// Handler serves the session endpoints. Construct it with NewHandler.
+type Handler struct {
+	cfg    Config
+	sealer *sealer
+	secure bool
+	mux    *http.ServeMux
+
+	// OIDC discovery is deferred to first use because the embedded Dex
+	// provider is not serving yet when the handler is built. Failures are
+	// not cached.
+	mu       sync.Mutex
+	provider *oidc.Provider
+
+	// refreshes coalesces concurrent refreshes of the same session so a
+	// rotating refresh token is redeemed once.
+	refreshes singleflight.Group
+}
+
+// NewHandler returns a session handler for cfg.
+func NewHandler(cfg Config) (*Handler, error) {
+	if cfg.Issuer == "" || cfg.ClientID == "" || cfg.Origin == "" {
+		return nil, fmt.Errorf("sessions require an issuer, client ID, and origin")
+	}
+	s, err := newSealer(cfg.Key)
+	if err != nil {
+		return nil, err
+	}
+	if len(cfg.Scopes) == 0 {
+		cfg.Scopes = DefaultScopes
+	}
+	if cfg.HTTPClient == nil {
+		cfg.HTTPClient = http.DefaultClient
+	}
+	h := &Handler{
+		cfg:    cfg,
+		sealer: s,
+		secure: strings.HasPrefix(cfg.Origin, "https://"),
+		mux:    http.NewServeMux(),
+	}
+	h.mux.HandleFunc("GET "+BasePath, h.handleInfo)
+	h.mux.HandleFunc("GET "+BasePath+"/login", h.handleLogin)
+	h.mux.HandleFunc("GET "+BasePath+"/callback", h.handleCallback)
+	h.mux.HandleFunc("POST "+BasePath+"/logout", h.handleLogout)
+	return h, nil
+}
+
+// ServeHTTP implements http.Handler for the paths under BasePath.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.mux.ServeHTTP(w, r)
+}
+
+// Middleware authenticates requests that carry a session cookie but no
+// Authorization header by forwarding the session's ID token as a bearer
+// token, refreshing it first when it is near expiry. Requests with an
+// explicit Authorization header are passed through untouched. A session
+// that can no longer be refreshed is cleared and the request proceeds
+// unauthenticated.
+//
+// The cookie is SameSite=Lax, so browsers do not attach it to cross-site
+// POSTs. Lax still sends it on cross-site top-level GETs, and ConnectRPC
+// serves NO_SIDE_EFFECTS methods over GET, so a GET only uses the session
+// when the browser's Sec-Fetch-Site header shows the request came from the
+// console itself or from the user typing the URL. Requests without the
+// header, from clients that are not browsers, must send a bearer token.
+func (h *Handler) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "" || strings.HasPrefix(r.URL.Path, BasePath) || !sessionAllowed(r) {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if t, ok := h.session(w, r); ok {
+			r = r.Clone(r.Context())
+			r.Header.Set("Authorization", "Bearer "+t.IDToken)
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// sessionAllowed reports whether r may be authenticated by the session
+// cookie. POSTs rely on SameSite; any other method must be same-origin by
+// its fetch metadata.
+func sessionAllowed(r *http.Request) bool {
+	if r.Method == http.MethodPost {
+		return true
+	}
+	switch r.Header.Get("Sec-Fetch-Site") {
+	case "same-origin", "none":
+		return true
+	}
+	return false
+}
+
+// session returns the request's session tokens, refreshing them and
+// rewriting the cookie when the ID token is near expiry.
+func (h *Handler) session(w http.ResponseWriter, r *http.Request) (*tokens, bool) {
+	cookie, err := r.Cookie(CookieName)
+	if err != nil {
+		return nil, false
+	}
+	var t tokens
+	if err := h.sealer.open(CookieName, cookie.Value, &t); err != nil {
+		h.clearCookie(w, CookieName, "/")
+		return nil, false
+	}
+	if time.Until(t.Expiry) > refreshSkew {
+		return &t, true
+	}
+	if t.RefreshToken == "" {
+		h.clearCookie(w, CookieName, "/")
+		return nil, false
+	}
+	v, err, _ := h.refreshes.Do(t.RefreshToken, func() (any, error) {
+		return h.refresh(r.Context(), t.RefreshToken)
+	})
+	if err != nil {
+		slog.WarnContext(r.Context(), "session refresh failed", "error", err)
+		h.clearCookie(w, CookieName, "/")
+		return nil, false
+	}
+	refreshed := v.(*tokens)
+	if err := h.setSessionCookie(w, refreshed); err != nil {
+		slog.ErrorContext(r.Context(), "failed to store refreshed session", "error", err)
+		return nil, false
+	}
+	return refreshed, true
+}
+
+// refresh redeems refreshToken for a new ID token.
+func (h *Handler) refresh(ctx context.Context, refreshToken string) (*tokens, error) {
+	provider, err := h.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ctx = h.clientContext(ctx)
+	tok, err := h.oauth2Config(provider).TokenSource(ctx, &oauth2.Token{RefreshToken: refreshToken}).Token()
+	if err != nil {
+		return nil, err
+	}
+	rawIDToken, _ := tok.Extra("id_token").(string)
+	if rawIDToken == "" {
+		return nil, fmt.Errorf("token response has no id_token")
+	}
+	idToken, err := rpc.VerifyIDToken(ctx, h.verifier(provider), rawIDToken)
+	if err != nil {
+		return nil, err
+	}
+	next := &tokens{IDToken: rawIDToken, RefreshToken: tok.RefreshToken, Expiry: idToken.Expiry}
+	if next.RefreshToken == "" {
+		next.RefreshToken = refreshToken
+	}
+	return next, nil
+}
+
+// handleInfo reports the signed-in user, or 401 without a session.
+func (h *Handler) handleInfo(w http.ResponseWriter, r *http.Request) {
+	t, ok := h.session(w, r)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "no session")
+		return
+	}
+	provider, err := h.discover(r.Context())
+	if err != nil {
+		writeError(w, http.StatusServiceUnavailable, "identity provider unavailable")
+		return
+	}
+	idToken, err := rpc.VerifyIDToken(h.clientContext(r.Context()), h.verifier(provider), t.IDToken)
+	if err != nil {
+		h.clearCookie(w, CookieName, "/")
+		writeError(w, http.StatusUnauthorized, "session expired")
+		return
+	}
+	var claims struct {
+		Email  string   `json:"email"`
+		Name   string   `json:"name"`
+		Groups []string `json:"groups"`
+	}
+	_ = idToken.Claims(&claims)
+	groups := claims.Groups
+	if h.cfg.RolesClaim != "" && h.cfg.RolesClaim != "groups" {
+		var raw map[string]any
+		if err := idToken.Claims(&raw); err == nil {
+			groups = rpc.ExtractRoles(raw, h.cfg.RolesClaim)
+		}
+	}
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(Info{
+		Sub:       idToken.Subject,
+		Email:     claims.Email,
+		Name:      claims.Name,
+		Groups:    groups,
+		ExpiresAt: idToken.Expiry.Unix(),
+	})
+}
+
+// handleLogin redirects to the IdP. The optional return_to query parameter
+// is the path the user lands on afterwards.
+func (h *Handler) handleLogin(w http.ResponseWriter, r *http.Request) {
+	provider, err := h.discover(r.Context())
+	if err != nil {
+		writeError(w, http.StatusServiceUnavailable, "identity provider unavailable")
+		return
+	}
+	login := loginState{
+		State:    rand.Text(),
+		Nonce:    rand.Text(),
+		Verifier: oauth2.GenerateVerifier(),
+		ReturnTo: safeReturnTo(r.URL.Query().Get("return_to")),
+	}
+	value, err := h.sealer.seal(loginCookieName, login)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to start login")
+		return
+	}
+	http.SetCookie(w, &http.Cookie{
+		Name:     loginCookieName,
+		Value:    value,
+		Path:     BasePath,
+		MaxAge:   int(loginTTL / time.Second),
+		HttpOnly: true,
+		Secure:   h.secure,
+		SameSite: http.SameSiteLaxMode,
+	})
+	http.Redirect(w, r, h.oauth2Config(provider).AuthCodeURL(
+		login.State,
+		oauth2.S256ChallengeOption(login.Verifier),
+		oidc.Nonce(login.Nonce),
+	), http.StatusFound)
+}
+
+// handleCallback completes the authorization code flow and starts the
+// session.
+func (h *Handler) handleCallback(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	cookie, err := r.Cookie(loginCookieName)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "no login in progress")
+		return
+	}
+	h.clearCookie(w, loginCookieName, BasePath)
+	var login loginState
+	if err := h.sealer.open(loginCookieName, cookie.Value, &login); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid login state")
+		return
+	}
+	query := r.URL.Query()
+	if query.Get("state") != login.State {
+		writeError(w, http.StatusBadRequest, "login state mismatch")
+		return
+	}
+	if e := query.Get("error"); e != "" {
+		slog.WarnContext(ctx, "login rejected by identity provider", "error", e, "description", query.Get("error_description"))
+		writeError(w, http.StatusUnauthorized, "login failed: "+e)
+		return
+	}
+	provider, err := h.discover(ctx)
+	if err != nil {
+		writeError(w, http.StatusServiceUnavailable, "identity provider unavailable")
+		return
+	}
+	clientCtx := h.clientContext(ctx)
+	tok, err := h.oauth2Config(provider).Exchange(clientCtx, query.Get("code"), oauth2.VerifierOption(login.Verifier))
+	if err != nil {
+		slog.WarnContext(ctx, "authorization code exchange failed", "error", err)
+		writeError(w, http.StatusUnauthorized, "login failed")
+		return
+	}
+	rawIDToken, _ := tok.Extra("id_token").(string)
+	idToken, err := rpc.VerifyIDToken(clientCtx, h.verifier(provider), rawIDToken)
+	if err != nil || idToken.Nonce != login.Nonce {
+		writeError(w, http.StatusUnauthorized, "invalid id token")
+		return
+	}
+	if err := h.setSessionCookie(w, &tokens{IDToken: rawIDToken, RefreshToken: tok.RefreshToken, Expiry: idToken.Expiry}); err != nil {
+		slog.ErrorContext(ctx, "failed to store session", "error", err)
+		writeError(w, http.StatusInternalServerError, "failed to store session")
+		return
+	}
+	slog.InfoContext(ctx, "session started",
+		slog.String("action", "session_login"),
+		slog.String("sub", idToken.Subject),
+	)
+	http.Redirect(w, r, login.ReturnTo, http.StatusFound)
+}
+
+// handleLogout ends the session. The response carries the IdP's
+// end_session_endpoint, when it advertises one, for the SPA to visit.
+func (h *Handler) handleLogout(w http.ResponseWriter, r *http.Request) {
+	h.clearCookie(w, CookieName, "/")
+	var body struct {
+		EndSessionURL string `json:"endSessionUrl,omitempty"`
+	}
+	if provider, err := h.discover(r.Context()); err == nil {
+		var metadata struct {
+			EndSessionEndpoint string `json:"end_session_endpoint"`
+		}
+		if err := provider.Claims(&metadata); err == nil {
+			body.EndSessionURL = metadata.EndSessionEndpoint
+		}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// discover returns the OIDC provider, performing discovery on first use.
+func (h *Handler) discover(ctx context.Context) (*oidc.Provider, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.provider != nil {
+		return h.provider, nil
+	}
+	provider, err := rpc.DiscoverProvider(h.clientContext(ctx), h.cfg.Issuer)
+	if err != nil {
+		return nil, err
+	}
+	h.provider = provider
+	return provider, nil
+}
+
+func (h *Handler) clientContext(ctx context.Context) context.Context {
+	return oidc.ClientContext(ctx, h.cfg.HTTPClient)
+}
+
+func (h *Handler) oauth2Config(provider *oidc.Provider) *oauth2.Config {
+	return &oauth2.Config{
+		ClientID:     h.cfg.ClientID,
+		ClientSecret: h.cfg.ClientSecret,
+		Endpoint:     provider.Endpoint(),
+		RedirectURL:  CallbackURL(h.cfg.Origin),
+		Scopes:       h.cfg.Scopes,
+	}
+}
+
+func (h *Handler) verifier(provider *oidc.Provider) *oidc.IDTokenVerifier {
+	return provider.Verifier(&oidc.Config{ClientID: h.cfg.ClientID})
+}
+
+func (h *Handler) setSessionCookie(w http.ResponseWriter, t *tokens) error {
+	value, err := h.sealer.seal(CookieName, t)
+	if err != nil {
+		return err
+	}
+	if len(CookieName)+len(value) > maxCookieBytes {
+		return errors.New("session tokens exceed the browser cookie size limit")
+	}
+	http.SetCookie(w, &http.Cookie{
+		Name:     CookieName,
+		Value:    value,
+		Path:     "/",
+		HttpOnly: true,
+		Secure:   h.secure,
+		SameSite: http.SameSiteLaxMode,
+	})
+	return nil
+}
+
+func (h *Handler) clearCookie(w http.ResponseWriter, name, path string) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     name,
+		Path:     path,
+		MaxAge:   -1,
+		HttpOnly: true,
+		Secure:   h.secure,
+		SameSite: http.SameSiteLaxMode,
+	})
+}
+
+// safeReturnTo limits post-login redirects to local paths so the login
+// endpoint cannot be used as an open redirect.
+func safeReturnTo(path string) string {
+	if !strings.HasPrefix(path, "/") || strings.HasPrefix(path, "//") || strings.HasPrefix(path, "/\\") {
+		return "/"
+	}
+	return path
+}
+
+func writeError(w http.ResponseWriter, status int, msg string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]string{"error": msg})
+}
@@ -0,0 +1,158 @@
+package session
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newTestHandler(t *testing.T) *Handler {
+	t.Helper()
+	h, err := NewHandler(Config{
+		Issuer:   "https://idp.example.com",
+		ClientID: "holos-console",
+		Origin:   "https://console.example.com",
+		Key:      GenerateKey(),
+	})
+	if err != nil {
+		t.Fatalf("NewHandler: %v", err)
+	}
+	return h
+}
+
+func sessionCookie(t *testing.T, h *Handler, tok tokens) *http.Cookie {
+	t.Helper()
+	value, err := h.sealer.seal(CookieName, tok)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &http.Cookie{Name: CookieName, Value: value}
+}
+
+// authorization runs a request through Middleware and returns the
+// Authorization header the wrapped handler saw.
+func authorization(h *Handler, req *http.Request) (string, *httptest.ResponseRecorder) {
+	var got string
+	rec := httptest.NewRecorder()
+	h.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Get("Authorization")
+	})).ServeHTTP(rec, req)
+	return got, rec
+}
+
+func TestMiddleware_ForwardsSessionToken(t *testing.T) {
+	h := newTestHandler(t)
+	req := httptest.NewRequest(http.MethodPost, "/holos.console.v1.ProjectService/ListProjects", nil)
+	req.AddCookie(sessionCookie(t, h, tokens{IDToken: "id-token", RefreshToken: "refresh", Expiry: time.Now().Add(time.Hour)}))
+
+	if got, _ := authorization(h, req); got != "Bearer id-token" {
+		t.Fatalf("expected session token forwarded, got %q", got)
+	}
+}
+
+func TestMiddleware_GetRequiresSameOrigin(t *testing.T) {
+	h := newTestHandler(t)
+	for _, tc := range []struct {
+		site string
+		want string
+	}{
+		{"same-origin", "Bearer id-token"},
+		{"none", "Bearer id-token"},
+		{"same-site", ""},
+		{"cross-site", ""},
+		{"", ""},
+	} {
+		t.Run("Sec-Fetch-Site="+tc.site, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/holos.console.v1.ProjectService/ListProjects?connect=v1", nil)
+			if tc.site != "" {
+				req.Header.Set("Sec-Fetch-Site", tc.site)
+			}
+			req.AddCookie(sessionCookie(t, h, tokens{IDToken: "id-token", Expiry: time.Now().Add(time.Hour)}))
+			if got, _ := authorization(h, req); got != tc.want {
+				t.Fatalf("expected Authorization %q, got %q", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestMiddleware_ExplicitAuthorizationWins(t *testing.T) {
+	h := newTestHandler(t)
+	req := httptest.NewRequest(http.MethodPost, "/holos.console.v1.ProjectService/ListProjects", nil)
+	req.Header.Set("Authorization", "Bearer explicit")
+	req.AddCookie(sessionCookie(t, h, tokens{IDToken: "id-token", Expiry: time.Now().Add(time.Hour)}))
+
+	if got, _ := authorization(h, req); got != "Bearer explicit" {
+		t.Fatalf("expected explicit token untouched, got %q", got)
+	}
+}
+
+func TestMiddleware_ClearsUnusableSessions(t *testing.T) {
+	h := newTestHandler(t)
+	other := newTestHandler(t)
+	cases := map[string]*http.Cookie{
+		"expired without refresh token": sessionCookie(t, h, tokens{IDToken: "id-token", Expiry: time.Now().Add(-time.Minute)}),
+		"sealed with another key":       sessionCookie(t, other, tokens{IDToken: "id-token", Expiry: time.Now().Add(time.Hour)}),
+		"garbage":                       {Name: CookieName, Value: "not-a-session"},
+	}
+	for name, cookie := range cases {
+		t.Run(name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/holos.console.v1.ProjectService/ListProjects", nil)
+			req.AddCookie(cookie)
+			got, rec := authorization(h, req)
+			if got != "" {
+				t.Fatalf("expected no Authorization header, got %q", got)
+			}
+			if setCookie := rec.Header().Get("Set-Cookie"); !strings.Contains(setCookie, CookieName+"=;") || !strings.Contains(setCookie, "Max-Age=0") {
+				t.Fatalf("expected the session cookie to be cleared, got %q", setCookie)
+			}
+		})
+	}
+}
+
+func TestSealer_BindsCookieName(t *testing.T) {
+	h := newTestHandler(t)
+	value, err := h.sealer.seal(loginCookieName, loginState{State: "s"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var tok tokens
+	if err := h.sealer.open(CookieName, value, &tok); err == nil {
+		t.Fatal("expected a login cookie to be rejected as a session cookie")
+	}
+	var login loginState
+	if err := h.sealer.open(loginCookieName, value, &login); err != nil || login.State != "s" {
+		t.Fatalf("expected round trip, got %+v, %v", login, err)
+	}
+}
+
+func TestHandleCallback_RejectsStateMismatch(t *testing.T) {
+	h := newTestHandler(t)
+	value, err := h.sealer.seal(loginCookieName, loginState{State: "expected", Verifier: "v", ReturnTo: "/"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	req := httptest.NewRequest(http.MethodGet, BasePath+"/callback?state=forged&code=abc", nil)
+	req.AddCookie(&http.Cookie{Name: loginCookieName, Value: value})
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected 400, got %d: %s", rec.Code, rec.Body)
+	}
+}
+
+func TestSafeReturnTo(t *testing.T) {
+	cases := map[string]string{
+		"":                          "/",
+		"/projects/billing":         "/projects/billing",
+		"//evil.example.com":        "/",
+		"/\\evil.example.com":       "/",
+		"https://evil.example.com/": "/",
+	}
+	for in, want := range cases {
+		if got := safeReturnTo(in); got != want {
+			t.Errorf("safeReturnTo(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
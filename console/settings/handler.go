@@ -38,6 +38,7 @@ type Handler struct {
 	projectResolver    ProjectResolver
 	orgResolver        OrgResolver
 	projectOrgResolver ProjectOrgResolver
+	authorizer         rbac.Authorizer
 }
 
 // NewHandler creates a ProjectSettingsService handler.
@@ -55,10 +56,7 @@ func (h *Handler) GetProjectSettings(
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("project is required"))
 	}
 
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
-	}
+	claims := rpc.MustClaims(ctx)
 
 	// Check RBAC: requires PROJECT_SETTINGS_READ via project cascade grants
 	if err := h.checkProjectAccess(ctx, claims, project, rbac.PermissionProjectSettingsRead); err != nil {
@@ -96,10 +94,7 @@ func (h *Handler) UpdateProjectSettings(
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("settings is required"))
 	}
 
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
-	}
+	claims := rpc.MustClaims(ctx)
 
 	// Check RBAC: requires PERMISSION_PROJECT_DEPLOYMENTS_ENABLE via org-level grants
 	if err := h.checkOrgAccess(ctx, claims, project, rbac.PermissionProjectDeploymentsEnable); err != nil {
@@ -146,10 +141,7 @@ func (h *Handler) GetProjectSettingsRaw(
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("project is required"))
 	}
 
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
-	}
+	claims := rpc.MustClaims(ctx)
 
 	// Check RBAC: requires PROJECT_SETTINGS_READ via project cascade grants
 	if err := h.checkProjectAccess(ctx, claims, project, rbac.PermissionProjectSettingsRead); err != nil {
@@ -194,7 +186,7 @@ func (h *Handler) checkProjectAccess(ctx context.Context, claims *rpc.Claims, pr
 		)
 		return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("RBAC: authorization denied"))
 	}
-	return rbac.CheckAccessGrants(claims.Email, claims.Roles, users, roles, permission)
+	return h.authorizer.Authorize(ctx, claims, &rbac.Resource{Users: users, Roles: roles}, permission)
 }
 
 // checkOrgAccess verifies the user has the given permission via org-level grants
@@ -225,7 +217,11 @@ func (h *Handler) checkOrgAccess(ctx context.Context, claims *rpc.Claims, projec
 		return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("RBAC: authorization denied"))
 	}
 
-	return rbac.CheckCascadeAccess(claims.Email, claims.Roles, users, roles, permission, rbac.OrgCascadeProjectSettingsPerms)
+	resource := &rbac.Resource{
+		Parent:  &rbac.Resource{Users: users, Roles: roles},
+		Cascade: rbac.OrgCascadeProjectSettingsPerms,
+	}
+	return h.authorizer.Authorize(ctx, claims, resource, permission)
 }
 
 // mapK8sError converts Kubernetes API errors to ConnectRPC errors.
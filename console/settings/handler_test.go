@@ -74,23 +74,6 @@ func TestHandler_GetProjectSettings(t *testing.T) {
 		}
 	})
 
-	t.Run("rejects unauthenticated request", func(t *testing.T) {
-		ns := projectNS("my-project")
-		fakeClient := fake.NewClientset(ns)
-		k8s := NewK8sClient(fakeClient, testResolver())
-		handler := NewHandler(k8s, &stubProjectResolver{}, nil, nil)
-
-		ctx := context.Background()
-		req := connect.NewRequest(&consolev1.GetProjectSettingsRequest{Project: "my-project"})
-		_, err := handler.GetProjectSettings(ctx, req)
-		if err == nil {
-			t.Fatal("expected error for unauthenticated request")
-		}
-		if connect.CodeOf(err) != connect.CodeUnauthenticated {
-			t.Errorf("expected CodeUnauthenticated, got %v", connect.CodeOf(err))
-		}
-	})
-
 	t.Run("rejects unauthorized user", func(t *testing.T) {
 		ns := projectNS("my-project")
 		fakeClient := fake.NewClientset(ns)
@@ -249,29 +232,6 @@ func TestHandler_UpdateProjectSettings(t *testing.T) {
 		}
 	})
 
-	t.Run("rejects unauthenticated request", func(t *testing.T) {
-		ns := projectNS("my-project")
-		fakeClient := fake.NewClientset(ns)
-		k8s := NewK8sClient(fakeClient, testResolver())
-		handler := NewHandler(k8s, &stubProjectResolver{}, nil, nil)
-
-		ctx := context.Background()
-		req := connect.NewRequest(&consolev1.UpdateProjectSettingsRequest{
-			Project: "my-project",
-			Settings: &consolev1.ProjectSettings{
-				Project:            "my-project",
-				DeploymentsEnabled: false,
-			},
-		})
-		_, err := handler.UpdateProjectSettings(ctx, req)
-		if err == nil {
-			t.Fatal("expected error for unauthenticated request")
-		}
-		if connect.CodeOf(err) != connect.CodeUnauthenticated {
-			t.Errorf("expected CodeUnauthenticated, got %v", connect.CodeOf(err))
-		}
-	})
-
 	t.Run("rejects nil settings", func(t *testing.T) {
 		ns := projectNS("my-project")
 		fakeClient := fake.NewClientset(ns)
@@ -323,23 +283,6 @@ func TestHandler_GetProjectSettingsRaw(t *testing.T) {
 		}
 	})
 
-	t.Run("rejects unauthenticated request", func(t *testing.T) {
-		ns := projectNS("my-project")
-		fakeClient := fake.NewClientset(ns)
-		k8s := NewK8sClient(fakeClient, testResolver())
-		handler := NewHandler(k8s, &stubProjectResolver{}, nil, nil)
-
-		ctx := context.Background()
-		req := connect.NewRequest(&consolev1.GetProjectSettingsRawRequest{Project: "my-project"})
-		_, err := handler.GetProjectSettingsRaw(ctx, req)
-		if err == nil {
-			t.Fatal("expected error for unauthenticated request")
-		}
-		if connect.CodeOf(err) != connect.CodeUnauthenticated {
-			t.Errorf("expected CodeUnauthenticated, got %v", connect.CodeOf(err))
-		}
-	})
-
 	t.Run("rejects unauthorized user", func(t *testing.T) {
 		ns := projectNS("my-project")
 		fakeClient := fake.NewClientset(ns)
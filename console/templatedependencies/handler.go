@@ -5,7 +5,6 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
-	"regexp"
 	"sort"
 
 	"connectrpc.com/connect"
@@ -20,14 +19,13 @@ import (
 	v1alpha2 "github.com/holos-run/holos-console/api/v1alpha2"
 	"github.com/holos-run/holos-console/console/resolver"
 	"github.com/holos-run/holos-console/console/rpc"
+	"github.com/holos-run/holos-console/console/validation"
 	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
 	"github.com/holos-run/holos-console/gen/holos/console/v1/consolev1connect"
 )
 
 const auditResourceType = "template-dependency"
 
-var dnsLabelRe = regexp.MustCompile(`^[a-z][a-z0-9-]*[a-z0-9]$`)
-
 // ProjectGrantResolver resolves project namespace grants for access checks.
 type ProjectGrantResolver interface {
 	GetProjectGrants(ctx context.Context, project string) (shareUsers, shareRoles map[string]string, err error)
@@ -152,10 +150,7 @@ func (h *Handler) ListTemplateDependencies(
 	if err != nil {
 		return nil, err
 	}
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
-	}
+	claims := rpc.MustClaims(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -195,10 +190,7 @@ func (h *Handler) GetTemplateDependency(
 	if name == "" {
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("name is required"))
 	}
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
-	}
+	claims := rpc.MustClaims(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -237,10 +229,7 @@ func (h *Handler) CreateTemplateDependency(
 	if err := validateDependency(req.Msg.GetNamespace(), dep); err != nil {
 		return nil, err
 	}
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
-	}
+	claims := rpc.MustClaims(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -277,10 +266,7 @@ func (h *Handler) UpdateTemplateDependency(
 	if err := validateDependency(req.Msg.GetNamespace(), dep); err != nil {
 		return nil, err
 	}
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
-	}
+	claims := rpc.MustClaims(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -313,10 +299,7 @@ func (h *Handler) DeleteTemplateDependency(
 	if name == "" {
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("name is required"))
 	}
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
-	}
+	claims := rpc.MustClaims(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -397,16 +380,9 @@ func validateDependency(reqNamespace string, dep *consolev1.TemplateDependency)
 }
 
 func validateDependencyName(name string) error {
-	if name == "" {
-		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("name is required"))
-	}
-	if len(name) > 63 {
-		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("name must be at most 63 characters"))
-	}
-	if !dnsLabelRe.MatchString(name) {
-		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("name must be a valid DNS label (lowercase alphanumeric and hyphens, starting with a letter)"))
-	}
-	return nil
+	var v validation.Violations
+	v.Name("name", name)
+	return v.Err()
 }
 
 func templateDependencyCRDToProto(d *templatesv1alpha1.TemplateDependency) *consolev1.TemplateDependency {
@@ -5,7 +5,6 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
-	"regexp"
 	"sort"
 
 	"connectrpc.com/connect"
@@ -20,14 +19,13 @@ import (
 	v1alpha2 "github.com/holos-run/holos-console/api/v1alpha2"
 	"github.com/holos-run/holos-console/console/resolver"
 	"github.com/holos-run/holos-console/console/rpc"
+	"github.com/holos-run/holos-console/console/validation"
 	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
 	"github.com/holos-run/holos-console/gen/holos/console/v1/consolev1connect"
 )
 
 const auditResourceType = "template-grant"
 
-var dnsLabelRe = regexp.MustCompile(`^[a-z][a-z0-9-]*[a-z0-9]$`)
-
 // OrgGrantResolver resolves organization-level grants for access checks.
 type OrgGrantResolver interface {
 	GetOrgGrants(ctx context.Context, org string) (users, roles map[string]string, err error)
@@ -160,10 +158,7 @@ func (h *Handler) ListTemplateGrants(
 	if err != nil {
 		return nil, err
 	}
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
-	}
+	claims := rpc.MustClaims(ctx)
 
 	items, err := h.k8s.ListGrants(ctx, req.Msg.GetNamespace())
 	if err != nil {
@@ -201,10 +196,7 @@ func (h *Handler) GetTemplateGrant(
 	if name == "" {
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("name is required"))
 	}
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
-	}
+	claims := rpc.MustClaims(ctx)
 
 	grantObj, err := h.k8s.GetGrant(ctx, req.Msg.GetNamespace(), name)
 	if err != nil {
@@ -241,10 +233,7 @@ func (h *Handler) CreateTemplateGrant(
 	if err := validateGrant(req.Msg.GetNamespace(), grant); err != nil {
 		return nil, err
 	}
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
-	}
+	claims := rpc.MustClaims(ctx)
 
 	_, err = h.k8s.CreateGrant(ctx, grant, claims.Email)
 	if err != nil {
@@ -279,10 +268,7 @@ func (h *Handler) UpdateTemplateGrant(
 	if err := validateGrant(req.Msg.GetNamespace(), grant); err != nil {
 		return nil, err
 	}
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
-	}
+	claims := rpc.MustClaims(ctx)
 
 	if _, err := h.k8s.UpdateGrant(ctx, req.Msg.GetNamespace(), grant); err != nil {
 		return nil, mapK8sError(err)
@@ -313,10 +299,7 @@ func (h *Handler) DeleteTemplateGrant(
 	if name == "" {
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("name is required"))
 	}
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
-	}
+	claims := rpc.MustClaims(ctx)
 
 	if err := h.k8s.DeleteGrant(ctx, req.Msg.GetNamespace(), name); err != nil {
 		return nil, mapK8sError(err)
@@ -386,16 +369,9 @@ func validateGrant(reqNamespace string, grant *consolev1.TemplateGrant) error {
 }
 
 func validateGrantName(name string) error {
-	if name == "" {
-		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("name is required"))
-	}
-	if len(name) > 63 {
-		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("name must be at most 63 characters"))
-	}
-	if !dnsLabelRe.MatchString(name) {
-		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("name must be a valid DNS label (lowercase alphanumeric and hyphens, starting with a letter)"))
-	}
-	return nil
+	var v validation.Violations
+	v.Name("name", name)
+	return v.Err()
 }
 
 func templateGrantCRDToProto(g *templatesv1alpha1.TemplateGrant) *consolev1.TemplateGrant {
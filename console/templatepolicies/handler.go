@@ -43,7 +43,6 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
-	"regexp"
 	"sort"
 
 	"connectrpc.com/connect"
@@ -54,15 +53,13 @@ import (
 	v1alpha2 "github.com/holos-run/holos-console/api/v1alpha2"
 	"github.com/holos-run/holos-console/console/resolver"
 	"github.com/holos-run/holos-console/console/rpc"
+	"github.com/holos-run/holos-console/console/validation"
 	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
 	"github.com/holos-run/holos-console/gen/holos/console/v1/consolev1connect"
 )
 
 const auditResourceType = "template-policy"
 
-// dnsLabelRe validates policy names as DNS labels (mirrors console/templates).
-var dnsLabelRe = regexp.MustCompile(`^[a-z][a-z0-9-]*[a-z0-9]$`)
-
 // scopeKind is a local discriminator for RBAC routing. The namespace is
 // authoritative for storage; this discriminator classifies the namespace for
 // access-check cascades.
@@ -167,10 +164,7 @@ func (h *Handler) ListTemplatePolicies(
 		return nil, err
 	}
 
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
-	}
+	claims := rpc.MustClaims(ctx)
 
 	items, err := h.k8s.ListPolicies(ctx, req.Msg.GetNamespace())
 	if err != nil {
@@ -212,10 +206,7 @@ func (h *Handler) GetTemplatePolicy(
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("name is required"))
 	}
 
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
-	}
+	claims := rpc.MustClaims(ctx)
 
 	p, err := h.k8s.GetPolicy(ctx, req.Msg.GetNamespace(), name)
 	if err != nil {
@@ -260,10 +251,7 @@ func (h *Handler) CreateTemplatePolicy(
 		return nil, err
 	}
 
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
-	}
+	claims := rpc.MustClaims(ctx)
 
 	// Best-effort template-existence probe. Log but do not block on transient
 	// errors so the control plane can still author a policy when the template
@@ -321,10 +309,7 @@ func (h *Handler) UpdateTemplatePolicy(
 		return nil, err
 	}
 
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
-	}
+	claims := rpc.MustClaims(ctx)
 
 	// Fetch the existing policy so we can distinguish "unset" from "set to
 	// empty" for the top-level metadata fields. The previous read is also
@@ -390,10 +375,7 @@ func (h *Handler) DeleteTemplatePolicy(
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("name is required"))
 	}
 
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
-	}
+	claims := rpc.MustClaims(ctx)
 
 	if err := h.k8s.DeletePolicy(ctx, req.Msg.GetNamespace(), name); err != nil {
 		return nil, mapK8sError(err)
@@ -430,10 +412,7 @@ func (h *Handler) ListLinkableTemplatePolicies(
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("namespace is required"))
 	}
 
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
-	}
+	claims := rpc.MustClaims(ctx)
 
 	scope, scopeName, err := h.extractPolicyScope(namespace)
 	if err != nil {
@@ -523,16 +502,9 @@ func validatePolicyNamespace(policyNamespace, reqNamespace string) error {
 // validatePolicyName enforces DNS-label rules and the 63-character limit so
 // the generated ConfigMap name is always valid Kubernetes.
 func validatePolicyName(name string) error {
-	if name == "" {
-		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("name is required"))
-	}
-	if len(name) > 63 {
-		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("name must be at most 63 characters"))
-	}
-	if !dnsLabelRe.MatchString(name) {
-		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("name must be a valid DNS label (lowercase alphanumeric and hyphens, starting with a letter)"))
-	}
-	return nil
+	var v validation.Violations
+	v.Name("name", name)
+	return v.Err()
 }
 
 // validatePolicyRules enforces kind and template-reference invariants. The
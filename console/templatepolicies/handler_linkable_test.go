@@ -81,25 +81,6 @@ func TestListLinkableTemplatePolicies_MissingNamespace(t *testing.T) {
 	}
 }
 
-// TestListLinkableTemplatePolicies_Unauthenticated asserts that a missing
-// claims context returns CodeUnauthenticated.
-func TestListLinkableTemplatePolicies_Unauthenticated(t *testing.T) {
-	h := newSimpleLinkableHandler(t, "owner@example.com")
-
-	_, err := h.ListLinkableTemplatePolicies(
-		context.Background(), // no claims injected
-		connect.NewRequest(&consolev1.ListLinkableTemplatePoliciesRequest{
-			Namespace: newFolderScope("payments"),
-		}),
-	)
-	if err == nil {
-		t.Fatal("expected unauthenticated error")
-	}
-	if connect.CodeOf(err) != connect.CodeUnauthenticated {
-		t.Errorf("expected CodeUnauthenticated, got %v: %v", connect.CodeOf(err), err)
-	}
-}
-
 // TestListLinkableTemplatePolicies_RejectsProjectScope asserts that a project
 // namespace returns CodeInvalidArgument (storage-isolation guardrail).
 func TestListLinkableTemplatePolicies_RejectsProjectScope(t *testing.T) {
@@ -15,6 +15,7 @@ import (
 	"github.com/holos-run/holos-console/console/policyresolver"
 	"github.com/holos-run/holos-console/console/resolver"
 	"github.com/holos-run/holos-console/console/rpc"
+	"github.com/holos-run/holos-console/console/validation"
 	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
 	"github.com/holos-run/holos-console/gen/holos/console/v1/consolev1connect"
 )
@@ -186,10 +187,7 @@ func (h *Handler) ListTemplatePolicyBindings(
 		return nil, err
 	}
 
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
-	}
+	claims := rpc.MustClaims(ctx)
 
 	items, err := h.k8s.ListBindings(ctx, req.Msg.GetNamespace())
 	if err != nil {
@@ -228,10 +226,7 @@ func (h *Handler) GetTemplatePolicyBinding(
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("name is required"))
 	}
 
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
-	}
+	claims := rpc.MustClaims(ctx)
 
 	b, err := h.k8s.GetBinding(ctx, req.Msg.GetNamespace(), name)
 	if err != nil {
@@ -279,10 +274,7 @@ func (h *Handler) CreateTemplatePolicyBinding(
 		return nil, err
 	}
 
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
-	}
+	claims := rpc.MustClaims(ctx)
 
 	// HOL-595: a binding's policy_ref MUST point at a policy that exists
 	// in the binding's own scope or an ancestor scope. Rejecting an
@@ -366,10 +358,7 @@ func (h *Handler) UpdateTemplatePolicyBinding(
 		return nil, err
 	}
 
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
-	}
+	claims := rpc.MustClaims(ctx)
 
 	// Fetch the existing binding so we can surface NotFound before we
 	// attempt the Update (the K8s API would otherwise return a less
@@ -451,10 +440,7 @@ func (h *Handler) DeleteTemplatePolicyBinding(
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("name is required"))
 	}
 
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
-	}
+	claims := rpc.MustClaims(ctx)
 
 	if err := h.k8s.DeleteBinding(ctx, req.Msg.GetNamespace(), name); err != nil {
 		return nil, mapK8sError(err)
@@ -522,16 +508,9 @@ func validateBindingNamespace(bindingNamespace, reqNamespace string) error {
 // validateBindingName enforces DNS-label rules and the 63-character limit so
 // the generated ConfigMap name is always valid Kubernetes.
 func validateBindingName(name string) error {
-	if name == "" {
-		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("name is required"))
-	}
-	if len(name) > 63 {
-		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("name must be at most 63 characters"))
-	}
-	if !dnsLabelRe.MatchString(name) {
-		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("name must be a valid DNS label (lowercase alphanumeric and hyphens, starting with a letter)"))
-	}
-	return nil
+	var v validation.Violations
+	v.Name("name", name)
+	return v.Err()
 }
 
 // validatePolicyRef enforces the proto contract on the LinkedTemplatePolicyRef
@@ -622,19 +601,17 @@ func validateTargetRefs(refs []*consolev1.TemplatePolicyBindingTargetRef) error
 // storage-isolation guardrail), so allowing an empty stored value would
 // silently produce a binding that matches nothing.
 func validateTargetRefField(i int, field, value string) error {
-	if value == "" {
-		return connect.NewError(connect.CodeInvalidArgument,
-			fmt.Errorf("target_refs[%d]: %s is required", i, field))
-	}
-	if value == policyresolver.WildcardAny {
-		return nil
-	}
-	if !dnsLabelRe.MatchString(value) {
-		return connect.NewError(connect.CodeInvalidArgument,
-			fmt.Errorf("target_refs[%d]: %s must be a valid DNS label or %q, got %q",
-				i, field, policyresolver.WildcardAny, value))
-	}
-	return nil
+	var v validation.Violations
+	path := validation.Index("target_refs", i) + "." + field
+	switch {
+	case value == "":
+		v.Add(path, validation.ReasonRequired, "target_refs[%d]: %s is required", i, field)
+	case value == policyresolver.WildcardAny:
+	case !dnsLabelRe.MatchString(value):
+		v.Add(path, validation.ReasonDNSLabel, "target_refs[%d]: %s must be a valid DNS label or %q, got %q",
+			i, field, policyresolver.WildcardAny, value)
+	}
+	return v.Err()
 }
 
 // validatePolicyRefReachable confirms the binding's policy_ref points at a
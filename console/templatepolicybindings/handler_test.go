@@ -358,35 +358,6 @@ func TestCreateHappyPath(t *testing.T) {
 	}
 }
 
-// TestCreateRBACDenial confirms a caller with no grants sees
-// PermissionDenied and never writes a binding. The stub grant resolver
-// returns an empty map so the cascade evaluation yields
-// RoleUnspecified -> no permissions.
-// TestCreateUnauthenticated confirms a missing Claims context yields
-// Unauthenticated — not a silent no-op. The handler must reject an
-// unauthenticated call *after* the proto-shape validation so the error
-// hierarchy surfaces the right reason.
-func TestCreateUnauthenticated(t *testing.T) {
-	h, _ := newTestHandler(t, map[string]string{"owner@example.com": "owner"})
-	folder := newFolderScopeRef("payments")
-	binding := basicBinding(folder)
-	binding.PolicyRef = &consolev1.LinkedTemplatePolicyRef{
-		Namespace: folder,
-		Name:      "local-policy",
-	}
-
-	_, err := h.CreateTemplatePolicyBinding(context.Background(), connect.NewRequest(&consolev1.CreateTemplatePolicyBindingRequest{
-		Namespace: folder,
-		Binding:   binding,
-	}))
-	if err == nil {
-		t.Fatal("expected unauthenticated rejection")
-	}
-	if connect.CodeOf(err) != connect.CodeUnauthenticated {
-		t.Errorf("expected CodeUnauthenticated, got %v: %v", connect.CodeOf(err), err)
-	}
-}
-
 // TestCreateValidation is the main table-driven proto-shape validation
 // test. Every case here must be rejected with CodeInvalidArgument before
 // any K8s write occurs — the fake clientset is ignored by these cases
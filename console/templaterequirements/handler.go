@@ -21,6 +21,7 @@ import (
 	"github.com/holos-run/holos-console/console/policyresolver"
 	"github.com/holos-run/holos-console/console/resolver"
 	"github.com/holos-run/holos-console/console/rpc"
+	"github.com/holos-run/holos-console/console/validation"
 	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
 	"github.com/holos-run/holos-console/gen/holos/console/v1/consolev1connect"
 )
@@ -177,10 +178,7 @@ func (h *Handler) ListTemplateRequirements(
 	if err != nil {
 		return nil, err
 	}
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
-	}
+	claims := rpc.MustClaims(ctx)
 
 	items, err := h.k8s.ListRequirements(ctx, req.Msg.GetNamespace())
 	if err != nil {
@@ -218,10 +216,7 @@ func (h *Handler) GetTemplateRequirement(
 	if name == "" {
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("name is required"))
 	}
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
-	}
+	claims := rpc.MustClaims(ctx)
 
 	reqObj, err := h.k8s.GetRequirement(ctx, req.Msg.GetNamespace(), name)
 	if err != nil {
@@ -258,10 +253,7 @@ func (h *Handler) CreateTemplateRequirement(
 	if err := validateRequirement(req.Msg.GetNamespace(), requirement); err != nil {
 		return nil, err
 	}
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
-	}
+	claims := rpc.MustClaims(ctx)
 
 	_, err = h.k8s.CreateRequirement(ctx, requirement, claims.Email)
 	if err != nil {
@@ -296,10 +288,7 @@ func (h *Handler) UpdateTemplateRequirement(
 	if err := validateRequirement(req.Msg.GetNamespace(), requirement); err != nil {
 		return nil, err
 	}
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
-	}
+	claims := rpc.MustClaims(ctx)
 
 	if _, err := h.k8s.UpdateRequirement(ctx, req.Msg.GetNamespace(), requirement); err != nil {
 		return nil, mapK8sError(err)
@@ -330,10 +319,7 @@ func (h *Handler) DeleteTemplateRequirement(
 	if name == "" {
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("name is required"))
 	}
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
-	}
+	claims := rpc.MustClaims(ctx)
 
 	if err := h.k8s.DeleteRequirement(ctx, req.Msg.GetNamespace(), name); err != nil {
 		return nil, mapK8sError(err)
@@ -402,16 +388,9 @@ func validateRequirement(reqNamespace string, requirement *consolev1.TemplateReq
 }
 
 func validateRequirementName(name string) error {
-	if name == "" {
-		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("name is required"))
-	}
-	if len(name) > 63 {
-		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("name must be at most 63 characters"))
-	}
-	if !dnsLabelRe.MatchString(name) {
-		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("name must be a valid DNS label (lowercase alphanumeric and hyphens, starting with a letter)"))
-	}
-	return nil
+	var v validation.Violations
+	v.Name("name", name)
+	return v.Err()
 }
 
 func validateTargetRefs(refs []*consolev1.TemplateRequirementTargetRef) error {
@@ -449,19 +428,17 @@ func validateTargetRefs(refs []*consolev1.TemplateRequirementTargetRef) error {
 }
 
 func validateTargetRefField(i int, field, value string) error {
-	if value == "" {
-		return connect.NewError(connect.CodeInvalidArgument,
-			fmt.Errorf("target_refs[%d]: %s is required", i, field))
-	}
-	if value == policyresolver.WildcardAny {
-		return nil
-	}
-	if !dnsLabelRe.MatchString(value) {
-		return connect.NewError(connect.CodeInvalidArgument,
-			fmt.Errorf("target_refs[%d]: %s must be a valid DNS label or %q, got %q",
-				i, field, policyresolver.WildcardAny, value))
-	}
-	return nil
+	var v validation.Violations
+	path := validation.Index("target_refs", i) + "." + field
+	switch {
+	case value == "":
+		v.Add(path, validation.ReasonRequired, "target_refs[%d]: %s is required", i, field)
+	case value == policyresolver.WildcardAny:
+	case !dnsLabelRe.MatchString(value):
+		v.Add(path, validation.ReasonDNSLabel, "target_refs[%d]: %s must be a valid DNS label or %q, got %q",
+			i, field, policyresolver.WildcardAny, value)
+	}
+	return v.Err()
 }
 
 func templateRequirementCRDToProto(req *templatesv1alpha1.TemplateRequirement) *consolev1.TemplateRequirement {
@@ -9,7 +9,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"log/slog"
-	"regexp"
 	"sort"
 	"strings"
 
@@ -20,19 +19,18 @@ import (
 	templatesv1alpha1 "github.com/holos-run/holos-console/api/templates/v1alpha1"
 	v1alpha2 "github.com/holos-run/holos-console/api/v1alpha2"
 	"github.com/holos-run/holos-console/console/deployments"
+	"github.com/holos-run/holos-console/console/listfilter"
 	"github.com/holos-run/holos-console/console/policyresolver"
 	"github.com/holos-run/holos-console/console/resolver"
 	"github.com/holos-run/holos-console/console/rpc"
 	"github.com/holos-run/holos-console/console/templates/examples"
+	"github.com/holos-run/holos-console/console/validation"
 	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
 	"github.com/holos-run/holos-console/gen/holos/console/v1/consolev1connect"
 )
 
 const auditResourceType = "template"
 
-// dnsLabelRe validates template names as DNS labels.
-var dnsLabelRe = regexp.MustCompile(`^[a-z][a-z0-9-]*[a-z0-9]$`)
-
 // scopeKind is a local discriminator for RBAC routing. Every handler
 // classifies an incoming namespace into one of these three values via
 // the resolver; the namespace remains authoritative for storage.
@@ -368,9 +366,10 @@ func (h *Handler) ListTemplates(
 		return nil, err
 	}
 
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	claims := rpc.MustClaims(ctx)
+	order, err := listfilter.NewOrder("order_by", req.Msg.OrderBy)
+	if err != nil {
+		return nil, err
 	}
 
 	ns := scopeNamespace(h.k8s.Resolver, scope, scopeName)
@@ -378,6 +377,9 @@ func (h *Handler) ListTemplates(
 	if err != nil {
 		return nil, mapK8sError(err)
 	}
+	listfilter.Sort(order, crds, func(t templatesv1alpha1.Template) listfilter.SortKey {
+		return listfilter.KeyOf(t.Name, &t)
+	})
 
 	templates := make([]*consolev1.Template, 0, len(crds))
 	for i := range crds {
@@ -425,10 +427,7 @@ func (h *Handler) SearchTemplates(
 	ctx context.Context,
 	req *connect.Request[consolev1.SearchTemplatesRequest],
 ) (*connect.Response[consolev1.SearchTemplatesResponse], error) {
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
-	}
+	claims := rpc.MustClaims(ctx)
 	if h.k8s == nil || h.k8s.Resolver == nil {
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("namespace resolver not wired"))
 	}
@@ -574,10 +573,7 @@ func (h *Handler) GetTemplate(
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("name is required"))
 	}
 
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
-	}
+	claims := rpc.MustClaims(ctx)
 
 	ns := scopeNamespace(h.k8s.Resolver, scope, scopeName)
 	tmpl, err := h.k8s.GetTemplate(ctx, ns, name)
@@ -625,10 +621,7 @@ func (h *Handler) GetTemplateDefaults(
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("name is required"))
 	}
 
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
-	}
+	claims := rpc.MustClaims(ctx)
 
 	// Defaults are a project-scope concept (ADR 027). For org/folder scopes,
 	// return an empty TemplateDefaults so the UI can call uniformly without
@@ -697,10 +690,7 @@ func (h *Handler) CreateTemplate(
 		}
 	}
 
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
-	}
+	claims := rpc.MustClaims(ctx)
 
 	// The `mandatory` annotation and its Go/proto projections were removed in
 	// HOL-565. Ancestor templates that must always apply to every project now
@@ -760,10 +750,7 @@ func (h *Handler) UpdateTemplate(
 		}
 	}
 
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
-	}
+	claims := rpc.MustClaims(ctx)
 
 	displayName := tmpl.DisplayName
 	description := tmpl.Description
@@ -863,10 +850,7 @@ func (h *Handler) DeleteTemplate(
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("name is required"))
 	}
 
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
-	}
+	claims := rpc.MustClaims(ctx)
 
 	ns := scopeNamespace(h.k8s.Resolver, scope, scopeName)
 	if err := h.k8s.DeleteTemplate(ctx, ns, name); err != nil {
@@ -904,10 +888,7 @@ func (h *Handler) CloneTemplate(
 		return nil, err
 	}
 
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
-	}
+	claims := rpc.MustClaims(ctx)
 
 	ns := scopeNamespace(h.k8s.Resolver, scope, scopeName)
 	_, err = h.k8s.CloneTemplate(ctx, ns, sourceName, newName, req.Msg.DisplayName)
@@ -940,11 +921,6 @@ func (h *Handler) RenderTemplate(
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("cue_template is required"))
 	}
 
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
-	}
-
 	if h.renderer == nil {
 		return nil, connect.NewError(connect.CodeUnimplemented, fmt.Errorf("renderer not configured"))
 	}
@@ -1125,10 +1101,7 @@ func (h *Handler) ListLinkableTemplates(
 	}
 	includeSelfScope := req.Msg.GetIncludeSelfScope()
 
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
-	}
+	claims := rpc.MustClaims(ctx)
 
 	if h.walker == nil {
 		return connect.NewResponse(&consolev1.ListLinkableTemplatesResponse{}), nil
@@ -1220,11 +1193,6 @@ func (h *Handler) ListAncestorTemplates(
 		return nil, err
 	}
 
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
-	}
-
 	templates, err := h.collectAncestorTemplates(ctx, scope, scopeName)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
@@ -1316,10 +1284,7 @@ func (h *Handler) CreateRelease(
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
-	}
+	claims := rpc.MustClaims(ctx)
 
 	ns, nsErr := h.mustNamespaceFor(scope, scopeName)
 	if nsErr != nil {
@@ -1364,10 +1329,7 @@ func (h *Handler) ListReleases(
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("template_name is required"))
 	}
 
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
-	}
+	claims := rpc.MustClaims(ctx)
 
 	ns, nsErr := h.mustNamespaceFor(scope, scopeName)
 	if nsErr != nil {
@@ -1421,10 +1383,7 @@ func (h *Handler) GetRelease(
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
-	}
+	claims := rpc.MustClaims(ctx)
 
 	ns, nsErr := h.mustNamespaceFor(scope, scopeName)
 	if nsErr != nil {
@@ -1489,16 +1448,9 @@ func (h *Handler) extractScope(namespace string) (scopeKind, string, error) {
 
 // validateTemplateName checks that the name is a valid DNS label.
 func validateTemplateName(name string) error {
-	if name == "" {
-		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("name is required"))
-	}
-	if len(name) > 63 {
-		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("name must be at most 63 characters"))
-	}
-	if !dnsLabelRe.MatchString(name) {
-		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("name must be a valid DNS label (lowercase alphanumeric and hyphens, starting with a letter)"))
-	}
-	return nil
+	var v validation.Violations
+	v.Name("name", name)
+	return v.Err()
 }
 
 // validateCueSyntax parses the CUE source to verify it is syntactically valid.
@@ -1584,11 +1536,6 @@ func (h *Handler) GetProjectTemplatePolicyState(
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("project slug is required"))
 	}
 
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
-	}
-
 	// Verify the template exists before delegating to the checker so the
 	// handler returns NotFound instead of an empty-state response when the
 	// caller supplies an invalid name.
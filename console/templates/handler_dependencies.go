@@ -59,10 +59,7 @@ func (h *Handler) ListTemplateDependents(
 	ctx context.Context,
 	req *connect.Request[consolev1.ListTemplateDependentsRequest],
 ) (*connect.Response[consolev1.ListTemplateDependentsResponse], error) {
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
-	}
+	claims := rpc.MustClaims(ctx)
 
 	reqNs := req.Msg.GetNamespace()
 	reqName := req.Msg.GetName()
@@ -237,11 +234,6 @@ func (h *Handler) ListDeploymentDependents(
 	ctx context.Context,
 	req *connect.Request[consolev1.ListDeploymentDependentsRequest],
 ) (*connect.Response[consolev1.ListDeploymentDependentsResponse], error) {
-	claims := rpc.ClaimsFromContext(ctx)
-	if claims == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
-	}
-
 	reqNs := req.Msg.GetNamespace()
 	reqName := req.Msg.GetName()
 
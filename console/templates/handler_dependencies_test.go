@@ -13,7 +13,6 @@
 package templates
 
 import (
-	"context"
 	"testing"
 
 	"connectrpc.com/connect"
@@ -170,20 +169,6 @@ func makeSingletonDeployment(ns, name string, dependentNames []string) *deployme
 // ListTemplateDependents tests
 // ---------------------------------------------------------------------------
 
-func TestListTemplateDependents_Unauthenticated(t *testing.T) {
-	h := newDepsHandler(t, map[string]string{"owner@localhost": "owner"})
-	_, err := h.ListTemplateDependents(context.Background(), connect.NewRequest(&consolev1.ListTemplateDependentsRequest{
-		Namespace: "org-acme",
-		Name:      "waypoint",
-	}))
-	if err == nil {
-		t.Fatal("expected error, got nil")
-	}
-	if got := connect.CodeOf(err); got != connect.CodeUnauthenticated {
-		t.Errorf("expected Unauthenticated, got %v", got)
-	}
-}
-
 func TestListTemplateDependents_MissingNamespace(t *testing.T) {
 	h := newDepsHandler(t, map[string]string{"owner@localhost": "owner"})
 	ctx := authedCtx("owner@localhost", nil)
@@ -429,20 +414,6 @@ func TestListTemplateDependents_SkipsProjectScopeRequirement(t *testing.T) {
 // ListDeploymentDependents tests
 // ---------------------------------------------------------------------------
 
-func TestListDeploymentDependents_Unauthenticated(t *testing.T) {
-	h := newDepsHandler(t, map[string]string{"owner@localhost": "owner"})
-	_, err := h.ListDeploymentDependents(context.Background(), connect.NewRequest(&consolev1.ListDeploymentDependentsRequest{
-		Namespace: "prj-checkout",
-		Name:      "waypoint-shared",
-	}))
-	if err == nil {
-		t.Fatal("expected error, got nil")
-	}
-	if got := connect.CodeOf(err); got != connect.CodeUnauthenticated {
-		t.Errorf("expected Unauthenticated, got %v", got)
-	}
-}
-
 func TestListDeploymentDependents_NotFound(t *testing.T) {
 	prjNs := makeNS("prj-checkout", v1alpha2.ResourceTypeProject)
 	const owner = "owner@localhost"
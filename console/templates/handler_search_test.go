@@ -134,17 +134,6 @@ func templateNamespaces(tmpls []*consolev1.Template) []string {
 	return out
 }
 
-func TestSearchTemplates_Unauthenticated(t *testing.T) {
-	handler := newSearchTestHandler(t, crossScopeFixture(), nil)
-	_, err := handler.SearchTemplates(context.Background(), connect.NewRequest(&consolev1.SearchTemplatesRequest{}))
-	if err == nil {
-		t.Fatal("expected error, got nil")
-	}
-	if got, want := connect.CodeOf(err), connect.CodeUnauthenticated; got != want {
-		t.Errorf("expected code %v, got %v", want, got)
-	}
-}
-
 func TestSearchTemplates_NoFiltersReturnsCrossScope(t *testing.T) {
 	const owner = "platform@localhost"
 	handler := newSearchTestHandler(t, crossScopeFixture(), map[string]string{owner: "owner"})
@@ -750,22 +750,6 @@ func TestGetTemplateDefaultsValidation(t *testing.T) {
 		}
 	})
 
-	t.Run("missing claims returns CodeUnauthenticated", func(t *testing.T) {
-		_, err := handler.GetTemplateDefaults(
-			context.Background(),
-			connect.NewRequest(&consolev1.GetTemplateDefaultsRequest{
-				Namespace: projectScopeRef("p"),
-				Name:      "foo",
-			}),
-		)
-		if err == nil {
-			t.Fatal("expected error, got nil")
-		}
-		if connect.CodeOf(err) != connect.CodeUnauthenticated {
-			t.Errorf("expected CodeUnauthenticated, got %v", connect.CodeOf(err))
-		}
-	})
-
 	t.Run("missing namespace returns CodeInvalidArgument", func(t *testing.T) {
 		// HOL-619 replaced GetTemplateDefaultsRequest.Scope with
 		// .Namespace. A zero value here must produce
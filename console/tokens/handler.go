@@ -0,0 +1,290 @@
+// Package tokens implements the TokensService: personal API tokens that
+// authenticate as the user who created them, for automation that cannot run
+// an interactive OIDC flow.
+package tokens
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/holos-run/holos-console/console/rpc"
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+	"github.com/holos-run/holos-console/gen/holos/console/v1/consolev1connect"
+)
+
+const (
+	auditResourceType = "api_token"
+
+	// tokenPrefix marks console API tokens so they are recognizable in
+	// logs and secret scanners, and so the reviewer can skip other tokens
+	// without a lookup.
+	tokenPrefix = "hct_"
+	// idBytes and secretBytes size the random token parts.
+	idBytes     = 8
+	secretBytes = 32
+
+	// defaultTTL applies when a request does not set ttl_seconds.
+	defaultTTL = 7 * 24 * time.Hour
+	// maxTTL caps how long a token may last. A token carries the groups its
+	// creator held when it was minted, and the identity provider cannot be
+	// asked for fresh ones without the user, so the cap bounds how long a
+	// removed group membership outlives its removal.
+	maxTTL = 30 * 24 * time.Hour
+)
+
+// Handler implements the TokensService and authenticates the tokens it
+// issues as an rpc.TokenReviewer.
+type Handler struct {
+	consolev1connect.UnimplementedTokensServiceHandler
+	k8s *K8sClient
+	now func() time.Time
+}
+
+// NewHandler creates a TokensService handler.
+func NewHandler(k8s *K8sClient) *Handler {
+	return &Handler{k8s: k8s, now: time.Now}
+}
+
+// CreateToken mints a token that authenticates as the caller.
+func (h *Handler) CreateToken(
+	ctx context.Context,
+	req *connect.Request[consolev1.CreateTokenRequest],
+) (*connect.Response[consolev1.CreateTokenResponse], error) {
+	claims := rpc.MustClaims(ctx)
+	msg := req.Msg
+	// A token minting tokens could outlive its own expiry or shed its
+	// permission subset.
+	if claims.TokenID != "" {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("api tokens cannot create api tokens"))
+	}
+	if claims.IsServiceAccount() || claims.Impersonator != nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("api tokens can only be created by users for themselves"))
+	}
+	ttl := time.Duration(msg.TtlSeconds) * time.Second
+	if msg.TtlSeconds == 0 {
+		ttl = defaultTTL
+	}
+	if ttl <= 0 || ttl > maxTTL {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("ttl_seconds must be between 1 and %d", int64(maxTTL/time.Second)))
+	}
+
+	id := make([]byte, idBytes)
+	secret := make([]byte, secretBytes)
+	if _, err := rand.Read(id); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("generating api token: %w", err))
+	}
+	if _, err := rand.Read(secret); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("generating api token: %w", err))
+	}
+	secretPart := base64.RawURLEncoding.EncodeToString(secret)
+	now := h.now().UTC().Truncate(time.Second)
+	rec := &record{
+		ID:            hex.EncodeToString(id),
+		Name:          msg.Name,
+		Hash:          hashSecret(secretPart),
+		Issuer:        claims.Iss,
+		Subject:       claims.Sub,
+		Email:         claims.Email,
+		EmailVerified: claims.EmailVerified,
+		DisplayName:   claims.Name,
+		Groups:        claims.Roles,
+		CreatedAt:     now,
+		ExpiresAt:     now.Add(ttl),
+	}
+	for _, p := range msg.Permissions {
+		if !slices.Contains(rec.Permissions, p.String()) {
+			rec.Permissions = append(rec.Permissions, p.String())
+		}
+	}
+	if err := h.k8s.Create(ctx, rec); err != nil {
+		return nil, rpc.MapK8sError(err)
+	}
+
+	slog.InfoContext(ctx, "api token created",
+		slog.String("action", "api_token_create"),
+		slog.String("resource_type", auditResourceType),
+		slog.String("id", rec.ID),
+		slog.String("name", rec.Name),
+		slog.String("permissions", strings.Join(rec.Permissions, ",")),
+		slog.String("expires_at", rec.ExpiresAt.Format(time.RFC3339)),
+		slog.String("sub", claims.Sub),
+		slog.String("email", claims.Email),
+	)
+
+	return connect.NewResponse(&consolev1.CreateTokenResponse{
+		Token:    tokenPrefix + rec.ID + "_" + secretPart,
+		ApiToken: toProto(rec),
+	}), nil
+}
+
+// ListTokens returns the caller's unexpired tokens. Expired tokens found
+// along the way are deleted.
+func (h *Handler) ListTokens(
+	ctx context.Context,
+	req *connect.Request[consolev1.ListTokensRequest],
+) (*connect.Response[consolev1.ListTokensResponse], error) {
+	claims := rpc.MustClaims(ctx)
+	records, err := h.k8s.List(ctx, claims.Iss, claims.Sub)
+	if err != nil {
+		return nil, rpc.MapK8sError(err)
+	}
+	now := h.now()
+	resp := &consolev1.ListTokensResponse{}
+	for _, rec := range records {
+		if rec.expired(now) {
+			h.prune(ctx, rec)
+			continue
+		}
+		resp.Tokens = append(resp.Tokens, toProto(rec))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// RevokeToken deletes one of the caller's tokens.
+func (h *Handler) RevokeToken(
+	ctx context.Context,
+	req *connect.Request[consolev1.RevokeTokenRequest],
+) (*connect.Response[consolev1.RevokeTokenResponse], error) {
+	claims := rpc.MustClaims(ctx)
+	notFound := connect.NewError(connect.CodeNotFound, fmt.Errorf("api token %q not found", req.Msg.Id))
+	if !validID(req.Msg.Id) {
+		return nil, notFound
+	}
+	rec, err := h.k8s.Get(ctx, req.Msg.Id)
+	if apierrors.IsNotFound(err) {
+		return nil, notFound
+	}
+	if err != nil {
+		return nil, rpc.MapK8sError(err)
+	}
+	// Other users' tokens are reported as missing so their IDs do not leak.
+	if !rec.ownedBy(claims) {
+		return nil, notFound
+	}
+	if err := h.k8s.Delete(ctx, rec.ID); err != nil && !apierrors.IsNotFound(err) {
+		return nil, rpc.MapK8sError(err)
+	}
+
+	slog.InfoContext(ctx, "api token revoked",
+		slog.String("action", "api_token_revoke"),
+		slog.String("resource_type", auditResourceType),
+		slog.String("id", rec.ID),
+		slog.String("name", rec.Name),
+		slog.String("sub", claims.Sub),
+		slog.String("email", claims.Email),
+	)
+	return connect.NewResponse(&consolev1.RevokeTokenResponse{}), nil
+}
+
+// ReviewToken implements rpc.TokenReviewer. It accepts unexpired tokens
+// issued by CreateToken and returns the creator's claims as of the token's
+// creation, limited to the token's permission subset. The issuer is the
+// creator's, so a token acts as the same principal its creator does.
+func (h *Handler) ReviewToken(ctx context.Context, token string) (*rpc.Claims, error) {
+	id, secretPart, ok := parseToken(token)
+	if !ok {
+		return nil, errors.New("not a console api token")
+	}
+	rec, err := h.k8s.Get(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("looking up api token: %w", err)
+	}
+	if subtle.ConstantTimeCompare([]byte(hashSecret(secretPart)), []byte(rec.Hash)) != 1 {
+		return nil, errors.New("invalid api token")
+	}
+	if rec.expired(h.now()) {
+		h.prune(ctx, rec)
+		return nil, errors.New("api token expired")
+	}
+	claims := &rpc.Claims{
+		Iss:           rec.Issuer,
+		Sub:           rec.Subject,
+		Exp:           rec.ExpiresAt.Unix(),
+		Iat:           rec.CreatedAt.Unix(),
+		Email:         rec.Email,
+		EmailVerified: rec.Email != "" && rec.EmailVerified,
+		Name:          rec.DisplayName,
+		Roles:         rec.Groups,
+		PrincipalType: rpc.PrincipalTypeUser,
+		TokenID:       rec.ID,
+	}
+	if len(rec.Permissions) > 0 {
+		claims.Permissions = make([]consolev1.Permission, 0, len(rec.Permissions))
+		for _, name := range rec.Permissions {
+			if value, ok := consolev1.Permission_value[name]; ok {
+				claims.Permissions = append(claims.Permissions, consolev1.Permission(value))
+			}
+		}
+	}
+	return claims, nil
+}
+
+// prune deletes an expired token. Failures are logged and retried on the
+// next encounter.
+func (h *Handler) prune(ctx context.Context, rec *record) {
+	if err := h.k8s.Delete(ctx, rec.ID); err != nil && !apierrors.IsNotFound(err) {
+		slog.WarnContext(ctx, "could not delete expired api token",
+			slog.String("id", rec.ID),
+			slog.Any("error", err),
+		)
+		return
+	}
+	slog.InfoContext(ctx, "api token expired",
+		slog.String("action", "api_token_expire"),
+		slog.String("resource_type", auditResourceType),
+		slog.String("id", rec.ID),
+		slog.String("name", rec.Name),
+		slog.String("sub", rec.Subject),
+		slog.String("email", rec.Email),
+	)
+}
+
+// parseToken splits a token into its ID and secret parts.
+func parseToken(token string) (id, secretPart string, ok bool) {
+	rest, ok := strings.CutPrefix(token, tokenPrefix)
+	if !ok || len(rest) < 2*idBytes+2 || rest[2*idBytes] != '_' {
+		return "", "", false
+	}
+	id, secretPart = rest[:2*idBytes], rest[2*idBytes+1:]
+	return id, secretPart, validID(id)
+}
+
+// validID reports whether id has the form CreateToken assigns.
+func validID(id string) bool {
+	b, err := hex.DecodeString(id)
+	return err == nil && len(b) == idBytes && strings.ToLower(id) == id
+}
+
+func hashSecret(secretPart string) string {
+	sum := sha256.Sum256([]byte(secretPart))
+	return hex.EncodeToString(sum[:])
+}
+
+func toProto(rec *record) *consolev1.APIToken {
+	t := &consolev1.APIToken{
+		Id:        rec.ID,
+		Name:      rec.Name,
+		CreatedAt: timestamppb.New(rec.CreatedAt),
+		ExpiresAt: timestamppb.New(rec.ExpiresAt),
+	}
+	for _, name := range rec.Permissions {
+		if value, ok := consolev1.Permission_value[name]; ok {
+			t.Permissions = append(t.Permissions, consolev1.Permission(value))
+		}
+	}
+	return t
+}
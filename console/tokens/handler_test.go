@@ -0,0 +1,240 @@
+package tokens
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/holos-run/holos-console/console/rpc"
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+)
+
+const testNamespace = "holos-system"
+
+func newTestHandler() (*Handler, *fake.Clientset, *time.Time) {
+	client := fake.NewClientset()
+	h := NewHandler(NewK8sClient(client, testNamespace))
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	h.now = func() time.Time { return now }
+	return h, client, &now
+}
+
+const testIssuer = "https://login.example.com"
+
+func as(sub, email string) context.Context {
+	return asIssuer(testIssuer, sub, email)
+}
+
+func asIssuer(iss, sub, email string) context.Context {
+	return rpc.ContextWithClaims(context.Background(), &rpc.Claims{
+		Iss:           iss,
+		Sub:           sub,
+		Email:         email,
+		Roles:         []string{"devs"},
+		PrincipalType: rpc.PrincipalTypeUser,
+	})
+}
+
+func TestCreateToken(t *testing.T) {
+	h, client, _ := newTestHandler()
+	resp, err := h.CreateToken(as("alice", "alice@example.com"), connect.NewRequest(&consolev1.CreateTokenRequest{
+		Name:        "ci",
+		Permissions: []consolev1.Permission{consolev1.Permission_PERMISSION_SECRETS_READ},
+	}))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !strings.HasPrefix(resp.Msg.Token, tokenPrefix+resp.Msg.ApiToken.Id+"_") {
+		t.Errorf("unexpected token format %q", resp.Msg.Token)
+	}
+	if got := resp.Msg.ApiToken.ExpiresAt.AsTime().Sub(resp.Msg.ApiToken.CreatedAt.AsTime()); got != defaultTTL {
+		t.Errorf("expected default ttl, got %v", got)
+	}
+
+	secret, err := client.CoreV1().Secrets(testNamespace).Get(context.Background(), namePrefix+resp.Msg.ApiToken.Id, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("expected token secret, got %v", err)
+	}
+	if strings.Contains(string(secret.Data[dataKey]), strings.TrimPrefix(resp.Msg.Token, tokenPrefix+resp.Msg.ApiToken.Id+"_")) {
+		t.Error("token secret must not contain the token value")
+	}
+
+	t.Run("rejects an out of range ttl", func(t *testing.T) {
+		_, err := h.CreateToken(as("alice", "alice@example.com"), connect.NewRequest(&consolev1.CreateTokenRequest{
+			Name:       "ci",
+			TtlSeconds: int64(maxTTL/time.Second) + 1,
+		}))
+		if connect.CodeOf(err) != connect.CodeInvalidArgument {
+			t.Fatalf("expected InvalidArgument, got %v", err)
+		}
+	})
+
+	t.Run("tokens cannot create tokens", func(t *testing.T) {
+		claims, err := h.ReviewToken(context.Background(), resp.Msg.Token)
+		if err != nil {
+			t.Fatalf("expected token to be accepted, got %v", err)
+		}
+		_, err = h.CreateToken(rpc.ContextWithClaims(context.Background(), claims), connect.NewRequest(&consolev1.CreateTokenRequest{Name: "nested"}))
+		if connect.CodeOf(err) != connect.CodePermissionDenied {
+			t.Fatalf("expected PermissionDenied, got %v", err)
+		}
+	})
+}
+
+func TestReviewToken(t *testing.T) {
+	h, _, now := newTestHandler()
+	resp, err := h.CreateToken(as("alice", "alice@example.com"), connect.NewRequest(&consolev1.CreateTokenRequest{
+		Name:        "ci",
+		TtlSeconds:  3600,
+		Permissions: []consolev1.Permission{consolev1.Permission_PERMISSION_SECRETS_READ},
+	}))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	token := resp.Msg.Token
+
+	claims, err := h.ReviewToken(context.Background(), token)
+	if err != nil {
+		t.Fatalf("expected token to be accepted, got %v", err)
+	}
+	if claims.Iss != testIssuer || claims.Sub != "alice" || claims.Email != "alice@example.com" || claims.TokenID != resp.Msg.ApiToken.Id {
+		t.Errorf("unexpected claims %+v", claims)
+	}
+	if len(claims.Roles) != 1 || claims.Roles[0] != "devs" {
+		t.Errorf("expected creator groups, got %v", claims.Roles)
+	}
+	if !claims.Allows(consolev1.Permission_PERMISSION_SECRETS_READ) || claims.Allows(consolev1.Permission_PERMISSION_SECRETS_WRITE) {
+		t.Errorf("unexpected permission subset %v", claims.Permissions)
+	}
+
+	for name, bad := range map[string]string{
+		"wrong secret":     token[:len(token)-4] + "AAAA",
+		"unknown id":       tokenPrefix + "0000000000000000_" + strings.SplitN(token, "_", 3)[2],
+		"not a console id": "eyJhbGciOiJSUzI1NiJ9.e30.sig",
+	} {
+		if _, err := h.ReviewToken(context.Background(), bad); err == nil {
+			t.Errorf("%s: expected token to be rejected", name)
+		}
+	}
+
+	// Expired tokens are rejected and deleted.
+	*now = now.Add(time.Hour)
+	if _, err := h.ReviewToken(context.Background(), token); err == nil {
+		t.Fatal("expected expired token to be rejected")
+	}
+	list, err := h.ListTokens(as("alice", "alice@example.com"), connect.NewRequest(&consolev1.ListTokensRequest{}))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(list.Msg.Tokens) != 0 {
+		t.Errorf("expected expired token to be removed, got %v", list.Msg.Tokens)
+	}
+}
+
+func TestListAndRevokeTokens(t *testing.T) {
+	h, _, now := newTestHandler()
+	create := func(ctx context.Context, name string) *consolev1.CreateTokenResponse {
+		t.Helper()
+		resp, err := h.CreateToken(ctx, connect.NewRequest(&consolev1.CreateTokenRequest{Name: name}))
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		*now = now.Add(time.Minute)
+		return resp.Msg
+	}
+	alice, bob := as("alice", "alice@example.com"), as("bob", "bob@example.com")
+	first := create(alice, "first")
+	second := create(alice, "second")
+	create(bob, "bobs")
+
+	list, err := h.ListTokens(alice, connect.NewRequest(&consolev1.ListTokensRequest{}))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(list.Msg.Tokens) != 2 || list.Msg.Tokens[0].Name != "second" || list.Msg.Tokens[1].Name != "first" {
+		t.Fatalf("expected alice's tokens newest first, got %v", list.Msg.Tokens)
+	}
+
+	// Bob cannot see or revoke alice's tokens.
+	_, err = h.RevokeToken(bob, connect.NewRequest(&consolev1.RevokeTokenRequest{Id: first.ApiToken.Id}))
+	if connect.CodeOf(err) != connect.CodeNotFound {
+		t.Fatalf("expected NotFound, got %v", err)
+	}
+
+	// A subject from another issuer is a different user.
+	impostor := asIssuer(rpc.PrincipalIssuerClientCertificate, "alice", "alice@example.com")
+	list, err = h.ListTokens(impostor, connect.NewRequest(&consolev1.ListTokensRequest{}))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(list.Msg.Tokens) != 0 {
+		t.Errorf("expected no tokens for another issuer's alice, got %v", list.Msg.Tokens)
+	}
+	_, err = h.RevokeToken(impostor, connect.NewRequest(&consolev1.RevokeTokenRequest{Id: first.ApiToken.Id}))
+	if connect.CodeOf(err) != connect.CodeNotFound {
+		t.Fatalf("expected NotFound for another issuer's alice, got %v", err)
+	}
+
+	if _, err := h.RevokeToken(alice, connect.NewRequest(&consolev1.RevokeTokenRequest{Id: first.ApiToken.Id})); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if _, err := h.ReviewToken(context.Background(), first.Token); err == nil {
+		t.Error("expected revoked token to be rejected")
+	}
+	if _, err := h.ReviewToken(context.Background(), second.Token); err != nil {
+		t.Errorf("expected other token to keep working, got %v", err)
+	}
+}
+
+func TestRequiredPermission(t *testing.T) {
+	for _, tt := range []struct {
+		procedure string
+		want      consolev1.Permission
+		ok        bool
+	}{
+		{"/holos.console.v1.SecretsService/ListSecrets", consolev1.Permission_PERMISSION_SECRETS_LIST, true},
+		{"/holos.console.v1.SecretsService/GetSecret", consolev1.Permission_PERMISSION_SECRETS_READ, true},
+		{"/holos.console.v1.SecretsService/UpdateSharing", consolev1.Permission_PERMISSION_SECRETS_ADMIN, true},
+		{"/holos.console.v1.SecretsService/BatchGetSecrets", consolev1.Permission_PERMISSION_SECRETS_READ, true},
+		{"/holos.console.v1.SecretsService/BatchDeleteSecrets", consolev1.Permission_PERMISSION_SECRETS_DELETE, true},
+		{"/holos.console.v1.ProjectService/CreateProject", consolev1.Permission_PERMISSION_PROJECTS_CREATE, true},
+		{"/holos.console.v1.DeploymentService/GetDeploymentLogs", consolev1.Permission_PERMISSION_DEPLOYMENTS_LOGS, true},
+		{"/holos.console.v1.IdentityService/GetIdentity", consolev1.Permission_PERMISSION_UNSPECIFIED, true},
+		{"/holos.console.v1.UnknownService/List", consolev1.Permission_PERMISSION_UNSPECIFIED, false},
+	} {
+		got, ok := requiredPermission(tt.procedure)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("requiredPermission(%q) = %v, %v; want %v, %v", tt.procedure, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestReviewToken_EmailVerified(t *testing.T) {
+	h, _, _ := newTestHandler()
+	for _, verified := range []bool{false, true} {
+		ctx := rpc.ContextWithClaims(context.Background(), &rpc.Claims{
+			Sub:           "alice",
+			Email:         "alice@example.com",
+			EmailVerified: verified,
+			PrincipalType: rpc.PrincipalTypeUser,
+		})
+		resp, err := h.CreateToken(ctx, connect.NewRequest(&consolev1.CreateTokenRequest{Name: "ci"}))
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		claims, err := h.ReviewToken(context.Background(), resp.Msg.Token)
+		if err != nil {
+			t.Fatalf("expected token to be accepted, got %v", err)
+		}
+		// An unverified email must not pick up email domain grants through
+		// a token.
+		if claims.EmailVerified != verified {
+			t.Errorf("expected email_verified %v to carry over to the token, got %v", verified, claims.EmailVerified)
+		}
+	}
+}
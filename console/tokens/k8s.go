@@ -0,0 +1,173 @@
+package tokens
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+
+	v1alpha2 "github.com/holos-run/holos-console/api/v1alpha2"
+	"github.com/holos-run/holos-console/console/rpc"
+	"github.com/holos-run/holos-console/console/tracing"
+)
+
+const (
+	// namePrefix prefixes the Secret name of every token.
+	namePrefix = "api-token-"
+	// dataKey is the Secret data key holding the JSON-encoded record.
+	dataKey = "token.json"
+	// labelOwner carries a digest of the creator's issuer and subject so a
+	// user's tokens can be listed with a label selector. Subjects are not
+	// valid label values in general.
+	labelOwner = "console.holos.run/token-owner"
+)
+
+// record is the stored form of a token. Hash is the hex SHA-256 digest of
+// the token's secret part; the token itself is never stored.
+type record struct {
+	ID   string `json:"-"`
+	Name string `json:"name"`
+	Hash string `json:"hash"`
+	// Issuer and Subject identify the creator. A subject is only unique
+	// within its issuer.
+	Issuer  string `json:"iss"`
+	Subject string `json:"sub"`
+	Email   string `json:"email,omitempty"`
+	// EmailVerified is the creator's email_verified claim. Tokens stored
+	// without it are treated as unverified.
+	EmailVerified bool      `json:"emailVerified,omitempty"`
+	DisplayName   string    `json:"displayName,omitempty"`
+	Groups        []string  `json:"groups,omitempty"`
+	Permissions   []string  `json:"permissions,omitempty"`
+	CreatedAt     time.Time `json:"createdAt"`
+	ExpiresAt     time.Time `json:"expiresAt"`
+}
+
+// expired reports whether the token is no longer accepted at now.
+func (r *record) expired(now time.Time) bool {
+	return !now.Before(r.ExpiresAt)
+}
+
+// ownedBy reports whether claims identify the token's creator.
+func (r *record) ownedBy(claims *rpc.Claims) bool {
+	return r.Issuer == claims.Iss && r.Subject == claims.Sub
+}
+
+// K8sClient stores tokens as Secrets in the console namespace. Every call
+// uses the console service account: tokens belong to the console, not to
+// any namespace their creators can read.
+type K8sClient struct {
+	client    kubernetes.Interface
+	namespace string
+}
+
+// NewK8sClient creates a client that stores tokens in namespace.
+func NewK8sClient(client kubernetes.Interface, namespace string) *K8sClient {
+	return &K8sClient{client: client, namespace: namespace}
+}
+
+// ownerLabel returns the owner label value for the issuer and subject.
+func ownerLabel(issuer, subject string) string {
+	sum := sha256.Sum256([]byte(issuer + "\x00" + subject))
+	return hex.EncodeToString(sum[:20])
+}
+
+// Create stores rec under its ID.
+func (c *K8sClient) Create(ctx context.Context, rec *record) error {
+	ctx, span := tracing.Start(ctx, "tokens.K8sClient.Create", attribute.String("id", rec.ID))
+	defer span.End()
+	raw, err := json.Marshal(rec)
+	if err != nil {
+		return fmt.Errorf("marshaling api token: %w", err)
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      namePrefix + rec.ID,
+			Namespace: c.namespace,
+			Labels: map[string]string{
+				v1alpha2.LabelManagedBy:    v1alpha2.ManagedByValue,
+				v1alpha2.LabelResourceType: v1alpha2.ResourceTypeAPIToken,
+				labelOwner:                 ownerLabel(rec.Issuer, rec.Subject),
+			},
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: map[string][]byte{dataKey: raw},
+	}
+	_, err = c.client.CoreV1().Secrets(c.namespace).Create(ctx, secret, metav1.CreateOptions{})
+	return err
+}
+
+// Get returns the token with id.
+func (c *K8sClient) Get(ctx context.Context, id string) (*record, error) {
+	ctx, span := tracing.Start(ctx, "tokens.K8sClient.Get", attribute.String("id", id))
+	defer span.End()
+	secret, err := c.client.CoreV1().Secrets(c.namespace).Get(ctx, namePrefix+id, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if secret.Labels[v1alpha2.LabelResourceType] != v1alpha2.ResourceTypeAPIToken {
+		return nil, fmt.Errorf("secret %q is not an api token", secret.Name)
+	}
+	return decode(secret)
+}
+
+// List returns the tokens created by subject of issuer, newest first.
+func (c *K8sClient) List(ctx context.Context, issuer, subject string) ([]*record, error) {
+	ctx, span := tracing.Start(ctx, "tokens.K8sClient.List")
+	defer span.End()
+	selector := labels.SelectorFromSet(labels.Set{
+		v1alpha2.LabelManagedBy:    v1alpha2.ManagedByValue,
+		v1alpha2.LabelResourceType: v1alpha2.ResourceTypeAPIToken,
+		labelOwner:                 ownerLabel(issuer, subject),
+	})
+	list, err := c.client.CoreV1().Secrets(c.namespace).List(ctx, metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return nil, err
+	}
+	records := make([]*record, 0, len(list.Items))
+	for i := range list.Items {
+		rec, err := decode(&list.Items[i])
+		if err != nil {
+			slog.WarnContext(ctx, "skipping malformed api token",
+				slog.String("name", list.Items[i].Name),
+				slog.Any("error", err),
+			)
+			continue
+		}
+		// The label is a digest; confirm the creator itself matches.
+		if rec.Issuer == issuer && rec.Subject == subject {
+			records = append(records, rec)
+		}
+	}
+	sort.SliceStable(records, func(i, j int) bool {
+		return records[i].CreatedAt.After(records[j].CreatedAt)
+	})
+	return records, nil
+}
+
+// Delete removes the token with id.
+func (c *K8sClient) Delete(ctx context.Context, id string) error {
+	ctx, span := tracing.Start(ctx, "tokens.K8sClient.Delete", attribute.String("id", id))
+	defer span.End()
+	return c.client.CoreV1().Secrets(c.namespace).Delete(ctx, namePrefix+id, metav1.DeleteOptions{})
+}
+
+func decode(secret *corev1.Secret) (*record, error) {
+	var rec record
+	if err := json.Unmarshal(secret.Data[dataKey], &rec); err != nil {
+		return nil, fmt.Errorf("parsing api token %q: %w", secret.Name, err)
+	}
+	rec.ID = strings.TrimPrefix(secret.Name, namePrefix)
+	return &rec, nil
+}
@@ -0,0 +1,128 @@
+package tokens
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"connectrpc.com/connect"
+
+	"github.com/holos-run/holos-console/console/rpc"
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+	"github.com/holos-run/holos-console/gen/holos/console/v1/consolev1connect"
+)
+
+// serviceScopes maps each service to the resource segment of the
+// permissions that guard it, e.g. SECRETS for PERMISSION_SECRETS_READ.
+var serviceScopes = map[string]string{
+	consolev1connect.SecretsServiceName:               "SECRETS",
+	consolev1connect.SecretTemplatesServiceName:       "SECRETS",
+	consolev1connect.ProjectServiceName:               "PROJECTS",
+	consolev1connect.AccessRequestServiceName:         "PROJECTS",
+	consolev1connect.ProjectSettingsServiceName:       "PROJECT_SETTINGS",
+	consolev1connect.OrganizationServiceName:          "ORGANIZATIONS",
+	consolev1connect.AuditServiceName:                 "ORGANIZATIONS",
+	consolev1connect.GroupsServiceName:                "ORGANIZATIONS",
+	consolev1connect.FolderServiceName:                "FOLDERS",
+	consolev1connect.DeploymentServiceName:            "DEPLOYMENTS",
+	consolev1connect.TemplateServiceName:              "TEMPLATES",
+	consolev1connect.TemplateDependencyServiceName:    "TEMPLATES",
+	consolev1connect.TemplateRequirementServiceName:   "TEMPLATES",
+	consolev1connect.TemplateGrantServiceName:         "TEMPLATES",
+	consolev1connect.TemplatePolicyServiceName:        "TEMPLATE_POLICIES",
+	consolev1connect.TemplatePolicyBindingServiceName: "TEMPLATE_POLICIES",
+}
+
+// unscopedServices describe the caller rather than act on resources, or
+// filter their own responses by the token's permissions, so a scoped token
+// may always call them.
+var unscopedServices = map[string]bool{
+	consolev1connect.DashboardServiceName:   true,
+	consolev1connect.VersionServiceName:     true,
+	consolev1connect.IdentityServiceName:    true,
+	consolev1connect.PermissionsServiceName: true,
+	consolev1connect.TokensServiceName:      true,
+}
+
+// methodVerbs maps method name prefixes to the permission verbs that cover
+// them, most specific first. Methods matching no prefix require ADMIN. A
+// batch method is matched by the verb after its batchPrefix, so
+// BatchGetSecrets needs READ and BatchDeleteSecrets DELETE.
+var methodVerbs = []struct {
+	prefix string
+	verbs  []string
+}{
+	{"List", []string{"LIST"}},
+	{"Get", []string{"READ"}},
+	{"Check", []string{"READ"}},
+	{"Export", []string{"READ"}},
+	{"Download", []string{"READ"}},
+	{"Stream", []string{"READ"}},
+	{"Diff", []string{"READ"}},
+	{"Create", []string{"CREATE", "WRITE"}},
+	{"Update", []string{"WRITE"}},
+	{"Patch", []string{"WRITE"}},
+	{"Apply", []string{"WRITE"}},
+	{"Restore", []string{"WRITE"}},
+	{"Delete", []string{"DELETE"}},
+	{"Rotate", []string{"ROTATE", "WRITE"}},
+}
+
+// batchPrefix starts the name of a method applying its verb to several
+// resources.
+const batchPrefix = "Batch"
+
+// ScopeInterceptor rejects calls made with an API token whose permission
+// subset does not cover the procedure. It is a coarse check by service and
+// method name that backs up the per-resource check in rbac.Authorizer for
+// handlers that do not go through it. Requests without a permission subset
+// pass through. Install it after RequireClaimsInterceptor.
+func ScopeInterceptor() connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			claims := rpc.ClaimsFromContext(ctx)
+			if claims == nil || claims.Permissions == nil {
+				return next(ctx, req)
+			}
+			permission, ok := requiredPermission(req.Spec().Procedure)
+			if ok && (permission == consolev1.Permission_PERMISSION_UNSPECIFIED || claims.Allows(permission)) {
+				return next(ctx, req)
+			}
+			return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("api token does not permit %s", req.Spec().Procedure))
+		}
+	}
+}
+
+// requiredPermission returns the permission a scoped token needs to call
+// procedure. PERMISSION_UNSPECIFIED means no permission is needed; ok is
+// false when the procedure is unknown and must be denied.
+func requiredPermission(procedure string) (consolev1.Permission, bool) {
+	service, method, _ := strings.Cut(strings.TrimPrefix(procedure, "/"), "/")
+	if unscopedServices[service] {
+		return consolev1.Permission_PERMISSION_UNSPECIFIED, true
+	}
+	resource, ok := serviceScopes[service]
+	if !ok {
+		return consolev1.Permission_PERMISSION_UNSPECIFIED, false
+	}
+	verbs := []string{"ADMIN"}
+	switch {
+	case strings.Contains(method, "Logs"):
+		verbs = []string{"LOGS", "READ"}
+	case strings.Contains(method, "Sharing"):
+	default:
+		for _, mv := range methodVerbs {
+			if strings.HasPrefix(strings.TrimPrefix(method, batchPrefix), mv.prefix) {
+				verbs = slices.Concat(mv.verbs, verbs)
+				break
+			}
+		}
+	}
+	for _, verb := range verbs {
+		if value, ok := consolev1.Permission_value["PERMISSION_"+resource+"_"+verb]; ok {
+			return consolev1.Permission(value), true
+		}
+	}
+	return consolev1.Permission_PERMISSION_UNSPECIFIED, false
+}
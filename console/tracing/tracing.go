@@ -0,0 +1,109 @@
+// Package tracing configures OpenTelemetry tracing for the console and
+// provides helpers for instrumenting storage clients.
+//
+// Tracing is disabled unless an OTLP endpoint is configured. When disabled
+// the global tracer provider is the OpenTelemetry no-op provider, so the
+// spans started by Start and the RPC and Kubernetes instrumentation cost
+// almost nothing.
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+	"k8s.io/client-go/rest"
+)
+
+// ServiceName is the OpenTelemetry service.name reported by the console.
+const ServiceName = "holos-console"
+
+// instrumentationName identifies spans created through this package.
+const instrumentationName = "github.com/holos-run/holos-console/console/tracing"
+
+// Config configures the OTLP trace exporter.
+type Config struct {
+	// Endpoint is the OTLP gRPC collector address (host:port). Empty
+	// disables tracing.
+	Endpoint string
+	// Insecure disables TLS on the connection to the collector.
+	Insecure bool
+	// SampleRatio is the fraction of new traces recorded, from 0 to 1.
+	// Requests that arrive with a sampled parent span are always recorded.
+	SampleRatio float64
+	// ServiceVersion is reported as the service.version resource attribute.
+	ServiceVersion string
+}
+
+// Enabled reports whether cfg configures an exporter.
+func (cfg Config) Enabled() bool {
+	return cfg.Endpoint != ""
+}
+
+// Setup installs the global tracer provider and W3C trace context
+// propagator. The returned shutdown function flushes buffered spans and must
+// be called before the process exits. When cfg is not enabled, Setup leaves
+// the no-op provider in place and returns a no-op shutdown.
+func Setup(ctx context.Context, cfg Config) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	if !cfg.Enabled() {
+		return func(context.Context) error { return nil }, nil
+	}
+	if cfg.SampleRatio < 0 || cfg.SampleRatio > 1 {
+		return nil, fmt.Errorf("trace sample ratio must be between 0 and 1, got %v", cfg.SampleRatio)
+	}
+
+	opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(cfg.Endpoint)}
+	if cfg.Insecure {
+		opts = append(opts, otlptracegrpc.WithInsecure())
+	}
+	exporter, err := otlptracegrpc.New(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("creating OTLP trace exporter: %w", err)
+	}
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.ServiceName(ServiceName),
+		semconv.ServiceVersion(cfg.ServiceVersion),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("building trace resource: %w", err)
+	}
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+	)
+	otel.SetTracerProvider(provider)
+	return provider.Shutdown, nil
+}
+
+// Start starts a span named name as a child of any span in ctx. Callers must
+// end the returned span, typically with defer span.End().
+func Start(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(instrumentationName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// WrapRestConfig instruments every request made through clients built from
+// cfg, including per-request impersonating clients copied from it, with an
+// HTTP client span. A nil cfg is returned unchanged.
+func WrapRestConfig(cfg *rest.Config) *rest.Config {
+	if cfg == nil {
+		return nil
+	}
+	cfg.Wrap(func(rt http.RoundTripper) http.RoundTripper {
+		return otelhttp.NewTransport(rt, otelhttp.WithSpanNameFormatter(func(_ string, r *http.Request) string {
+			return "k8s " + r.Method
+		}))
+	})
+	return cfg
+}
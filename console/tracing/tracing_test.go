@@ -0,0 +1,39 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"k8s.io/client-go/rest"
+)
+
+func TestSetup(t *testing.T) {
+	t.Run("disabled without endpoint", func(t *testing.T) {
+		shutdown, err := Setup(context.Background(), Config{})
+		if err != nil {
+			t.Fatalf("Setup: %v", err)
+		}
+		if err := shutdown(context.Background()); err != nil {
+			t.Fatalf("shutdown: %v", err)
+		}
+	})
+
+	t.Run("rejects invalid sample ratio", func(t *testing.T) {
+		if _, err := Setup(context.Background(), Config{Endpoint: "localhost:4317", SampleRatio: 2}); err == nil {
+			t.Fatal("expected error for sample ratio above 1")
+		}
+	})
+}
+
+func TestWrapRestConfig(t *testing.T) {
+	if WrapRestConfig(nil) != nil {
+		t.Fatal("expected nil config to stay nil")
+	}
+	cfg := WrapRestConfig(&rest.Config{Host: "https://example.invalid"})
+	if cfg.WrapTransport == nil {
+		t.Fatal("expected WrapTransport to be set")
+	}
+	if copied := rest.CopyConfig(cfg); copied.WrapTransport == nil {
+		t.Fatal("expected copied configs to keep the tracing transport")
+	}
+}
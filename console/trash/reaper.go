@@ -0,0 +1,105 @@
+package trash
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	v1alpha2 "github.com/holos-run/holos-console/api/v1alpha2"
+)
+
+// Reaper purges soft-deleted Secrets and project Namespaces whose retention
+// window has passed. It acts with the console service account.
+type Reaper struct {
+	client    kubernetes.Interface
+	retention time.Duration
+	interval  time.Duration
+	now       func() time.Time
+}
+
+// NewReaper returns a Reaper that purges resources soft-deleted more than
+// retention ago, checking every interval.
+func NewReaper(client kubernetes.Interface, retention, interval time.Duration) *Reaper {
+	return &Reaper{client: client, retention: retention, interval: interval, now: time.Now}
+}
+
+// PurgeAt returns when a resource soft-deleted at deletedAt becomes
+// eligible for purging.
+func PurgeAt(deletedAt time.Time, retention time.Duration) time.Time {
+	return deletedAt.Add(retention)
+}
+
+// Run purges expired resources immediately and then each interval until
+// ctx is cancelled. Errors are logged and retried on the next pass.
+func (r *Reaper) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+	for {
+		if err := r.PurgeNow(ctx); err != nil {
+			slog.ErrorContext(ctx, "purging expired trash", slog.Any("error", err))
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// PurgeNow deletes every soft-deleted Secret and project Namespace whose
+// retention window has passed.
+func (r *Reaper) PurgeNow(ctx context.Context) error {
+	now := r.now()
+	opts := metav1.ListOptions{LabelSelector: Selector}
+	var errs []error
+
+	secrets, err := r.client.CoreV1().Secrets(metav1.NamespaceAll).List(ctx, opts)
+	if err != nil {
+		errs = append(errs, err)
+	} else {
+		for i := range secrets.Items {
+			s := &secrets.Items[i]
+			if now.Before(PurgeAt(DeletedAt(s), r.retention)) {
+				continue
+			}
+			err := r.client.CoreV1().Secrets(s.Namespace).Delete(ctx, s.Name, metav1.DeleteOptions{})
+			errs = append(errs, r.purged(ctx, err, "secret", s.Namespace, s.Name))
+		}
+	}
+
+	namespaces, err := r.client.CoreV1().Namespaces().List(ctx, metav1.ListOptions{
+		LabelSelector: Selector + "," + v1alpha2.LabelResourceType + "=" + v1alpha2.ResourceTypeProject,
+	})
+	if err != nil {
+		errs = append(errs, err)
+	} else {
+		for i := range namespaces.Items {
+			ns := &namespaces.Items[i]
+			if ns.DeletionTimestamp != nil || now.Before(PurgeAt(DeletedAt(ns), r.retention)) {
+				continue
+			}
+			err := r.client.CoreV1().Namespaces().Delete(ctx, ns.Name, metav1.DeleteOptions{})
+			errs = append(errs, r.purged(ctx, err, "project", ns.Name, ns.Labels[v1alpha2.LabelProject]))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// purged logs the outcome of one purge. Objects already gone are not errors.
+func (r *Reaper) purged(ctx context.Context, err error, resourceType, namespace, name string) error {
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return err
+	}
+	slog.InfoContext(ctx, "purged soft-deleted resource",
+		slog.String("action", resourceType+"_purge"),
+		slog.String("resource_type", resourceType),
+		slog.String("namespace", namespace),
+		slog.String("name", name),
+	)
+	return nil
+}
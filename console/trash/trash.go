@@ -0,0 +1,112 @@
+// Package trash implements soft deletion for console-managed Secrets and
+// project Namespaces.
+//
+// A soft-deleted resource keeps existing in Kubernetes but carries
+// v1alpha2.LabelDeleted, so the console hides it from reads and lists. Its
+// grant annotations are moved aside into v1alpha2.AnnotationDeletedGrants,
+// which revokes access until the resource is restored. A Reaper purges
+// soft-deleted resources once the retention window has passed.
+package trash
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1alpha2 "github.com/holos-run/holos-console/api/v1alpha2"
+)
+
+// Selector matches soft-deleted console-managed resources.
+const Selector = v1alpha2.LabelManagedBy + "=" + v1alpha2.ManagedByValue + "," +
+	v1alpha2.LabelDeleted + "=" + v1alpha2.DeletedValue
+
+// IsDeleted reports whether obj is soft-deleted.
+func IsDeleted(obj metav1.Object) bool {
+	return obj.GetLabels()[v1alpha2.LabelDeleted] == v1alpha2.DeletedValue
+}
+
+// DeletedAt returns when obj was soft-deleted. Objects with a missing or
+// malformed annotation report the zero time, so the Reaper purges them on
+// its next pass.
+func DeletedAt(obj metav1.Object) time.Time {
+	t, _ := time.Parse(time.RFC3339, obj.GetAnnotations()[v1alpha2.AnnotationDeletedAt])
+	return t
+}
+
+// DeletedBy returns the email of the principal who soft-deleted obj.
+func DeletedBy(obj metav1.Object) string {
+	return obj.GetAnnotations()[v1alpha2.AnnotationDeletedBy]
+}
+
+// Mark soft-deletes obj in memory, recording who deleted it and when. The
+// grant annotations named by grantKeys are removed from obj and stashed so
+// Restore can put them back.
+func Mark(obj metav1.Object, by string, now time.Time, grantKeys ...string) error {
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	stashed := make(map[string]string)
+	for _, key := range grantKeys {
+		if value, ok := annotations[key]; ok {
+			stashed[key] = value
+			delete(annotations, key)
+		}
+	}
+	data, err := json.Marshal(stashed)
+	if err != nil {
+		return fmt.Errorf("marshaling stashed grants: %w", err)
+	}
+	annotations[v1alpha2.AnnotationDeletedAt] = now.UTC().Format(time.RFC3339)
+	annotations[v1alpha2.AnnotationDeletedBy] = by
+	annotations[v1alpha2.AnnotationDeletedGrants] = string(data)
+	obj.SetAnnotations(annotations)
+
+	labels := obj.GetLabels()
+	if labels == nil {
+		labels = make(map[string]string)
+	}
+	labels[v1alpha2.LabelDeleted] = v1alpha2.DeletedValue
+	obj.SetLabels(labels)
+	return nil
+}
+
+// StashedGrants returns the grant annotations Mark removed from obj, keyed
+// by annotation name.
+func StashedGrants(obj metav1.Object) (map[string]string, error) {
+	stashed := make(map[string]string)
+	raw := obj.GetAnnotations()[v1alpha2.AnnotationDeletedGrants]
+	if raw == "" {
+		return stashed, nil
+	}
+	if err := json.Unmarshal([]byte(raw), &stashed); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", v1alpha2.AnnotationDeletedGrants, err)
+	}
+	return stashed, nil
+}
+
+// Restore undoes Mark in memory, putting the stashed grant annotations back.
+func Restore(obj metav1.Object) error {
+	stashed, err := StashedGrants(obj)
+	if err != nil {
+		return err
+	}
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	for key, value := range stashed {
+		annotations[key] = value
+	}
+	delete(annotations, v1alpha2.AnnotationDeletedAt)
+	delete(annotations, v1alpha2.AnnotationDeletedBy)
+	delete(annotations, v1alpha2.AnnotationDeletedGrants)
+	obj.SetAnnotations(annotations)
+
+	labels := obj.GetLabels()
+	delete(labels, v1alpha2.LabelDeleted)
+	obj.SetLabels(labels)
+	return nil
+}
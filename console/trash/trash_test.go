@@ -0,0 +1,98 @@
+package trash
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	v1alpha2 "github.com/holos-run/holos-console/api/v1alpha2"
+)
+
+func TestMarkRestore(t *testing.T) {
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{
+		Name:        "db-creds",
+		Labels:      map[string]string{v1alpha2.LabelManagedBy: v1alpha2.ManagedByValue},
+		Annotations: map[string]string{v1alpha2.AnnotationShareUsers: `[{"principal":"alice@example.com","role":"owner"}]`},
+	}}
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	if err := Mark(secret, "alice@example.com", now, v1alpha2.AnnotationShareUsers, v1alpha2.AnnotationShareRoles); err != nil {
+		t.Fatal(err)
+	}
+	if !IsDeleted(secret) || !DeletedAt(secret).Equal(now) || DeletedBy(secret) != "alice@example.com" {
+		t.Errorf("expected secret marked deleted at %s, got labels=%v annotations=%v", now, secret.Labels, secret.Annotations)
+	}
+	if _, ok := secret.Annotations[v1alpha2.AnnotationShareUsers]; ok {
+		t.Error("expected share-users to be stashed")
+	}
+	if _, ok := secret.Annotations[v1alpha2.AnnotationShareRoles]; ok {
+		t.Error("expected absent share-roles to stay absent")
+	}
+
+	if err := Restore(secret); err != nil {
+		t.Fatal(err)
+	}
+	if IsDeleted(secret) {
+		t.Error("expected deleted label to be removed")
+	}
+	want := map[string]string{v1alpha2.AnnotationShareUsers: `[{"principal":"alice@example.com","role":"owner"}]`}
+	if len(secret.Annotations) != len(want) || secret.Annotations[v1alpha2.AnnotationShareUsers] != want[v1alpha2.AnnotationShareUsers] {
+		t.Errorf("expected annotations %v, got %v", want, secret.Annotations)
+	}
+}
+
+func TestReaper_PurgeNow(t *testing.T) {
+	now := time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC)
+	retention := 7 * 24 * time.Hour
+	deleted := func(obj metav1.Object, at time.Time) {
+		if err := Mark(obj, "alice@example.com", at); err != nil {
+			t.Fatal(err)
+		}
+	}
+	project := func(name string) *corev1.Namespace {
+		return &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{
+			v1alpha2.LabelManagedBy:    v1alpha2.ManagedByValue,
+			v1alpha2.LabelResourceType: v1alpha2.ResourceTypeProject,
+		}}}
+	}
+
+	expiredSecret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "expired", Namespace: "prj-a", Labels: map[string]string{v1alpha2.LabelManagedBy: v1alpha2.ManagedByValue}}}
+	deleted(expiredSecret, now.Add(-8*24*time.Hour))
+	recentSecret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "recent", Namespace: "prj-a", Labels: map[string]string{v1alpha2.LabelManagedBy: v1alpha2.ManagedByValue}}}
+	deleted(recentSecret, now.Add(-time.Hour))
+	liveSecret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "live", Namespace: "prj-a", Labels: map[string]string{v1alpha2.LabelManagedBy: v1alpha2.ManagedByValue}}}
+	expiredProject := project("prj-old")
+	deleted(expiredProject, now.Add(-30*24*time.Hour))
+	recentProject := project("prj-new")
+	deleted(recentProject, now.Add(-24*time.Hour))
+
+	client := fake.NewClientset(expiredSecret, recentSecret, liveSecret, expiredProject, recentProject)
+	reaper := NewReaper(client, retention, time.Hour)
+	reaper.now = func() time.Time { return now }
+	ctx := context.Background()
+	if err := reaper.PurgeNow(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	secrets, err := client.CoreV1().Secrets("prj-a").List(ctx, metav1.ListOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := map[string]bool{}
+	for _, s := range secrets.Items {
+		got[s.Name] = true
+	}
+	if got["expired"] || !got["recent"] || !got["live"] {
+		t.Errorf("expected only the expired secret purged, remaining %v", got)
+	}
+	if _, err := client.CoreV1().Namespaces().Get(ctx, "prj-old", metav1.GetOptions{}); err == nil {
+		t.Error("expected expired project to be purged")
+	}
+	if _, err := client.CoreV1().Namespaces().Get(ctx, "prj-new", metav1.GetOptions{}); err != nil {
+		t.Errorf("expected recent project to remain, got %v", err)
+	}
+}
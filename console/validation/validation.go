@@ -0,0 +1,228 @@
+// Package validation reports request validation failures as structured
+// field violations. Handlers collect every problem with a request into a
+// Violations value and return Violations.Err, which is a connect
+// InvalidArgument error carrying a google.rpc.BadRequest detail. The UI
+// reads the detail to highlight the offending form fields; the error
+// message joins the violation descriptions for clients that ignore details.
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"mime"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"connectrpc.com/connect"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	k8svalidation "k8s.io/apimachinery/pkg/util/validation"
+
+	"github.com/holos-run/holos-console/console/principal"
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+)
+
+// Reasons identify the constraint a field violated. They are stable and
+// safe for clients to switch on.
+const (
+	ReasonRequired     = "REQUIRED"
+	ReasonMaxLength    = "MAX_LENGTH"
+	ReasonDNSLabel     = "DNS_LABEL"
+	ReasonDNSSubdomain = "DNS_SUBDOMAIN"
+	ReasonDataKey      = "DATA_KEY"
+	ReasonPrincipal    = "PRINCIPAL"
+	ReasonURL          = "URL"
+	ReasonConflict     = "CONFLICT"
+	ReasonSelector     = "SELECTOR"
+	ReasonEnum         = "ENUM"
+	ReasonMediaType    = "MEDIA_TYPE"
+	ReasonCertificate  = "CERTIFICATE"
+	ReasonDockerConfig = "DOCKER_CONFIG"
+	ReasonSSHKey       = "SSH_KEY"
+	ReasonReserved     = "RESERVED"
+)
+
+// maxNameLength is the Kubernetes limit for DNS labels.
+const maxNameLength = 63
+
+// nameRe matches console resource names: DNS labels that start with a
+// letter, so the name is also a valid Kubernetes object name.
+var nameRe = regexp.MustCompile(`^[a-z][a-z0-9-]*[a-z0-9]$`)
+
+// Violations collects the field violations found in a single request. The
+// zero value is ready to use.
+type Violations struct {
+	list []*errdetails.BadRequest_FieldViolation
+}
+
+// Add records a violation of field. Field paths use the proto field names,
+// e.g. "user_grants[0].principal" or `data["tls.crt"]`.
+func (v *Violations) Add(field, reason, format string, args ...any) {
+	v.list = append(v.list, &errdetails.BadRequest_FieldViolation{
+		Field:       field,
+		Reason:      reason,
+		Description: fmt.Sprintf(format, args...),
+	})
+}
+
+// Len returns the number of violations recorded so far.
+func (v *Violations) Len() int {
+	return len(v.list)
+}
+
+// Err returns nil when no violations were recorded, otherwise a connect
+// InvalidArgument error whose message joins the descriptions and whose
+// detail is a BadRequest listing every violation.
+func (v *Violations) Err() error {
+	if len(v.list) == 0 {
+		return nil
+	}
+	descriptions := make([]string, len(v.list))
+	for i, fv := range v.list {
+		descriptions[i] = fv.Description
+	}
+	err := connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("%s", strings.Join(descriptions, "; ")))
+	if detail, detailErr := connect.NewErrorDetail(&errdetails.BadRequest{FieldViolations: v.list}); detailErr == nil {
+		err.AddDetail(detail)
+	}
+	return err
+}
+
+// Required records a violation when value is empty and reports whether it
+// was present, so callers can skip format checks on missing fields.
+func (v *Violations) Required(field, value string) bool {
+	if value == "" {
+		v.Add(field, ReasonRequired, "%s is required", field)
+		return false
+	}
+	return true
+}
+
+// Name checks a required console resource name: a DNS label of at most 63
+// characters that starts with a letter.
+func (v *Violations) Name(field, name string) {
+	if !v.Required(field, name) {
+		return
+	}
+	if len(name) > maxNameLength {
+		v.Add(field, ReasonMaxLength, "%s must be at most %d characters", field, maxNameLength)
+		return
+	}
+	if !nameRe.MatchString(name) {
+		v.Add(field, ReasonDNSLabel, "%s must be a valid DNS label (lowercase alphanumeric and hyphens, starting with a letter)", field)
+	}
+}
+
+// DNSSubdomain checks a required name against the RFC 1123 subdomain rules
+// Kubernetes applies to Secret and ConfigMap names.
+func (v *Violations) DNSSubdomain(field, name string) {
+	if !v.Required(field, name) {
+		return
+	}
+	if errs := k8svalidation.IsDNS1123Subdomain(name); len(errs) > 0 {
+		v.Add(field, ReasonDNSSubdomain, "%s must be a valid DNS-1123 subdomain: %s", field, strings.Join(errs, "; "))
+	}
+}
+
+// DataKey checks a Secret or ConfigMap data key: alphanumerics, '-', '_'
+// and '.', at most 253 characters.
+func (v *Violations) DataKey(field, key string) {
+	if errs := k8svalidation.IsConfigMapKey(key); len(errs) > 0 {
+		v.Add(field, ReasonDataKey, "%s is not a valid data key: %s", field, strings.Join(errs, "; "))
+	}
+}
+
+// UserPrincipal checks that a user grant names a bare email address, an
+// email domain as "*@example.com", or every user as "*".
+func (v *Violations) UserPrincipal(field, name string) {
+	if !v.Required(field, name) || principal.IsPattern(name) {
+		return
+	}
+	addr, err := mail.ParseAddress(name)
+	if err != nil || addr.Name != "" || addr.Address != name {
+		v.Add(field, ReasonPrincipal, "%s must be an email address, *@domain, or *, got %q", field, name)
+	}
+}
+
+// RolePrincipal checks that a role grant names an OIDC group: non-empty and
+// free of whitespace and control characters.
+func (v *Violations) RolePrincipal(field, principal string) {
+	if !v.Required(field, principal) {
+		return
+	}
+	if strings.IndexFunc(principal, func(r rune) bool { return unicode.IsSpace(r) || unicode.IsControl(r) }) >= 0 {
+		v.Add(field, ReasonPrincipal, "%s must be a role name without whitespace, got %q", field, principal)
+	}
+}
+
+// UserGrants checks the principals of the user share grants in field.
+// Grants with an empty principal are dropped when grants are converted to
+// annotations and are not reported.
+func (v *Violations) UserGrants(field string, grants []*consolev1.ShareGrant) {
+	for i, g := range grants {
+		if g.GetPrincipal() != "" {
+			v.UserPrincipal(Index(field, i)+".principal", g.GetPrincipal())
+		}
+	}
+}
+
+// RoleGrants checks the principals of the role share grants in field.
+func (v *Violations) RoleGrants(field string, grants []*consolev1.ShareGrant) {
+	for i, g := range grants {
+		if g.GetPrincipal() != "" {
+			v.RolePrincipal(Index(field, i)+".principal", g.GetPrincipal())
+		}
+	}
+}
+
+// URL checks an optional absolute http or https URL. An empty value is
+// allowed so callers can clear the field.
+func (v *Violations) URL(field, raw string) {
+	if raw == "" {
+		return
+	}
+	u, err := url.Parse(raw)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		v.Add(field, ReasonURL, "%s must be an absolute http or https URL", field)
+	}
+}
+
+// MediaType checks a MIME media type such as "application/x-pkcs12".
+func (v *Violations) MediaType(field, value string) {
+	if _, _, err := mime.ParseMediaType(value); err != nil || !strings.Contains(value, "/") {
+		v.Add(field, ReasonMediaType, "%s must be a media type such as application/octet-stream", field)
+	}
+}
+
+// Index returns the path of element i of a repeated field, e.g.
+// Index("user_grants", 0) is "user_grants[0]".
+func Index(field string, i int) string {
+	return fmt.Sprintf("%s[%d]", field, i)
+}
+
+// Key returns the path of a map entry, e.g. Key("data", "tls.crt") is
+// `data["tls.crt"]`.
+func Key(field, key string) string {
+	return fmt.Sprintf("%s[%q]", field, key)
+}
+
+// FieldViolations extracts the BadRequest field violations carried by err,
+// or nil if err has none.
+func FieldViolations(err error) []*errdetails.BadRequest_FieldViolation {
+	var ce *connect.Error
+	if !errors.As(err, &ce) {
+		return nil
+	}
+	for _, d := range ce.Details() {
+		msg, valueErr := d.Value()
+		if valueErr != nil {
+			continue
+		}
+		if br, ok := msg.(*errdetails.BadRequest); ok {
+			return br.FieldViolations
+		}
+	}
+	return nil
+}
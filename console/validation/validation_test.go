@@ -0,0 +1,113 @@
+package validation
+
+import (
+	"strings"
+	"testing"
+
+	"connectrpc.com/connect"
+
+	consolev1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+)
+
+func TestViolations(t *testing.T) {
+	tests := []struct {
+		name   string
+		check  func(v *Violations)
+		want   map[string]string // field -> reason
+		wantOK bool
+	}{
+		{
+			name:   "valid request",
+			check:  func(v *Violations) { v.Name("name", "web-app"); v.URL("url", "https://example.com/x") },
+			wantOK: true,
+		},
+		{
+			name:  "required",
+			check: func(v *Violations) { v.Name("name", "") },
+			want:  map[string]string{"name": ReasonRequired},
+		},
+		{
+			name:  "name too long",
+			check: func(v *Violations) { v.Name("name", "a"+strings.Repeat("b", 63)) },
+			want:  map[string]string{"name": ReasonMaxLength},
+		},
+		{
+			name:  "name not a DNS label",
+			check: func(v *Violations) { v.Name("name", "1-App") },
+			want:  map[string]string{"name": ReasonDNSLabel},
+		},
+		{
+			name:   "subdomain allows dots",
+			check:  func(v *Violations) { v.DNSSubdomain("name", "tls.example-com") },
+			wantOK: true,
+		},
+		{
+			name:  "subdomain rejects uppercase",
+			check: func(v *Violations) { v.DNSSubdomain("name", "DB") },
+			want:  map[string]string{"name": ReasonDNSSubdomain},
+		},
+		{
+			name: "data key charset",
+			check: func(v *Violations) {
+				v.DataKey(Key("data", "a b"), "a b")
+				v.DataKey(Key("data", "tls.crt"), "tls.crt")
+			},
+			want: map[string]string{`data["a b"]`: ReasonDataKey},
+		},
+		{
+			name: "grant principals",
+			check: func(v *Violations) {
+				v.UserGrants("user_grants", []*consolev1.ShareGrant{
+					{Principal: "alice@example.com"},
+					{Principal: ""},
+					{Principal: "Bob <bob@example.com>"},
+					{Principal: "*@example.com"},
+					{Principal: "*"},
+					{Principal: "*@"},
+				})
+				v.RoleGrants("role_grants", []*consolev1.ShareGrant{{Principal: "platform admins"}, {Principal: "dev-team"}})
+			},
+			want: map[string]string{
+				"user_grants[2].principal": ReasonPrincipal,
+				"user_grants[5].principal": ReasonPrincipal,
+				"role_grants[0].principal": ReasonPrincipal,
+			},
+		},
+		{
+			name:  "url",
+			check: func(v *Violations) { v.URL("url", "example.com/path"); v.URL("other", "") },
+			want:  map[string]string{"url": ReasonURL},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var v Violations
+			tt.check(&v)
+			err := v.Err()
+			if tt.wantOK {
+				if err != nil {
+					t.Fatalf("expected no error, got %v", err)
+				}
+				return
+			}
+			if connect.CodeOf(err) != connect.CodeInvalidArgument {
+				t.Fatalf("expected InvalidArgument, got %v", err)
+			}
+			got := map[string]string{}
+			for _, fv := range FieldViolations(err) {
+				got[fv.Field] = fv.Reason
+				if !strings.Contains(err.Error(), fv.Description) {
+					t.Errorf("expected message %q to include %q", err.Error(), fv.Description)
+				}
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("expected violations %v, got %v", tt.want, got)
+			}
+			for field, reason := range tt.want {
+				if got[field] != reason {
+					t.Errorf("field %s: expected reason %s, got %q", field, reason, got[field])
+				}
+			}
+		})
+	}
+}
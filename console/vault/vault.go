@@ -0,0 +1,228 @@
+// Package vault reads secret values from HashiCorp Vault, logging in with
+// the console's Kubernetes service account token through Vault's
+// Kubernetes auth method.
+package vault
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultAuthMount is the default mount path of the Kubernetes auth
+	// method.
+	DefaultAuthMount = "kubernetes"
+	// DefaultTokenPath is where Kubernetes mounts the pod's service account
+	// token.
+	DefaultTokenPath = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+	// defaultTimeout bounds a single Vault request.
+	defaultTimeout = 10 * time.Second
+)
+
+// Config configures a Client.
+type Config struct {
+	// Address is the Vault server URL, e.g. "https://vault.example.com:8200".
+	Address string
+	// Role is the Vault Kubernetes auth role to log in as.
+	Role string
+	// AuthMount is the mount path of the Kubernetes auth method.
+	// Default: DefaultAuthMount
+	AuthMount string
+	// TokenPath is the file holding the service account JWT presented to
+	// Vault. It is re-read at every login so projected tokens can rotate.
+	// Default: DefaultTokenPath
+	TokenPath string
+	// HTTPClient sends Vault requests.
+	// Default: a client with a 10s timeout
+	HTTPClient *http.Client
+}
+
+// Client reads secrets from Vault. It caches its Vault token until shortly
+// before the lease expires and logs in again when Vault rejects it.
+type Client struct {
+	cfg  Config
+	base *url.URL
+	now  func() time.Time
+
+	mu      sync.Mutex
+	token   string
+	expires time.Time
+}
+
+// New returns a Client for cfg.
+func New(cfg Config) (*Client, error) {
+	base, err := url.Parse(cfg.Address)
+	if err != nil || (base.Scheme != "http" && base.Scheme != "https") || base.Host == "" {
+		return nil, fmt.Errorf("vault address %q must be an absolute http or https URL", cfg.Address)
+	}
+	if cfg.Role == "" {
+		return nil, fmt.Errorf("vault role is required")
+	}
+	if cfg.AuthMount == "" {
+		cfg.AuthMount = DefaultAuthMount
+	}
+	if cfg.TokenPath == "" {
+		cfg.TokenPath = DefaultTokenPath
+	}
+	if cfg.HTTPClient == nil {
+		cfg.HTTPClient = &http.Client{Timeout: defaultTimeout}
+	}
+	return &Client{cfg: cfg, base: base, now: time.Now}, nil
+}
+
+// Read returns the key-value pairs stored at path, an API path such as
+// "secret/data/team/db" for a KV version 2 engine or "kv/team/db" for
+// version 1. String values are returned verbatim and other JSON values
+// encoded as JSON. The error wraps fs.ErrNotExist when path has no secret.
+func (c *Client) Read(ctx context.Context, path string) (map[string][]byte, error) {
+	token, err := c.login(ctx)
+	if err != nil {
+		return nil, err
+	}
+	body, status, err := c.do(ctx, http.MethodGet, "v1/"+strings.TrimPrefix(path, "/"), token, nil)
+	if err == nil && status == http.StatusForbidden {
+		// The cached token may have been revoked; log in once more.
+		c.forget(token)
+		if token, err = c.login(ctx); err != nil {
+			return nil, err
+		}
+		body, status, err = c.do(ctx, http.MethodGet, "v1/"+strings.TrimPrefix(path, "/"), token, nil)
+	}
+	if err != nil {
+		return nil, err
+	}
+	switch status {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, fmt.Errorf("vault path %q: %w", path, fs.ErrNotExist)
+	default:
+		return nil, fmt.Errorf("reading vault path %q: %s", path, vaultError(status, body))
+	}
+	var resp struct {
+		Data map[string]json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("decoding vault path %q: %w", path, err)
+	}
+	fields := resp.Data
+	// A KV version 2 read nests the values under data.data beside
+	// data.metadata.
+	if inner, ok := fields["data"]; ok {
+		if _, ok := fields["metadata"]; ok {
+			fields = nil
+			if err := json.Unmarshal(inner, &fields); err != nil {
+				return nil, fmt.Errorf("decoding vault path %q: %w", path, err)
+			}
+		}
+	}
+	data := make(map[string][]byte, len(fields))
+	for key, raw := range fields {
+		var s string
+		if err := json.Unmarshal(raw, &s); err == nil {
+			data[key] = []byte(s)
+		} else {
+			data[key] = []byte(raw)
+		}
+	}
+	return data, nil
+}
+
+// login returns a cached Vault token, logging in when none is valid.
+func (c *Client) login(ctx context.Context) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.token != "" && c.now().Before(c.expires) {
+		return c.token, nil
+	}
+	jwt, err := os.ReadFile(c.cfg.TokenPath)
+	if err != nil {
+		return "", fmt.Errorf("reading service account token: %w", err)
+	}
+	payload, err := json.Marshal(map[string]string{"role": c.cfg.Role, "jwt": strings.TrimSpace(string(jwt))})
+	if err != nil {
+		return "", err
+	}
+	body, status, err := c.do(ctx, http.MethodPost, "v1/auth/"+c.cfg.AuthMount+"/login", "", payload)
+	if err != nil {
+		return "", err
+	}
+	if status != http.StatusOK {
+		return "", fmt.Errorf("vault login: %s", vaultError(status, body))
+	}
+	var resp struct {
+		Auth struct {
+			ClientToken   string `json:"client_token"`
+			LeaseDuration int64  `json:"lease_duration"`
+		} `json:"auth"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return "", fmt.Errorf("decoding vault login: %w", err)
+	}
+	if resp.Auth.ClientToken == "" {
+		return "", fmt.Errorf("vault login returned no token")
+	}
+	// Renew at three quarters of the lease so a token never expires mid
+	// request.
+	lease := time.Duration(resp.Auth.LeaseDuration) * time.Second
+	c.token = resp.Auth.ClientToken
+	c.expires = c.now().Add(lease * 3 / 4)
+	return c.token, nil
+}
+
+// forget drops token from the cache unless another request already
+// replaced it.
+func (c *Client) forget(token string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.token == token {
+		c.token = ""
+	}
+}
+
+func (c *Client) do(ctx context.Context, method, path, token string, payload []byte) ([]byte, int, error) {
+	var reqBody io.Reader
+	if payload != nil {
+		reqBody = bytes.NewReader(payload)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, c.base.JoinPath(path).String(), reqBody)
+	if err != nil {
+		return nil, 0, err
+	}
+	if token != "" {
+		req.Header.Set("X-Vault-Token", token)
+	}
+	if payload != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := c.cfg.HTTPClient.Do(req)
+	if err != nil {
+		return nil, 0, fmt.Errorf("vault request: %w", err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 4<<20))
+	if err != nil {
+		return nil, 0, fmt.Errorf("reading vault response: %w", err)
+	}
+	return body, resp.StatusCode, nil
+}
+
+// vaultError formats a Vault error response.
+func vaultError(status int, body []byte) string {
+	var resp struct {
+		Errors []string `json:"errors"`
+	}
+	if json.Unmarshal(body, &resp) == nil && len(resp.Errors) > 0 {
+		return fmt.Sprintf("%d %s", status, strings.Join(resp.Errors, "; "))
+	}
+	return fmt.Sprintf("%d %s", status, http.StatusText(status))
+}
@@ -0,0 +1,130 @@
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+)
+
+// fakeVault serves the Kubernetes auth login and a KV version 1 and 2 read.
+type fakeVault struct {
+	logins  atomic.Int32
+	revoked atomic.Bool
+}
+
+func (f *fakeVault) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.URL.Path {
+	case "/v1/auth/kubernetes/login":
+		var body map[string]string
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		if body["role"] != "console" || body["jwt"] != "sa-token" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"errors":["invalid role or jwt"]}`))
+			return
+		}
+		n := f.logins.Add(1)
+		f.revoked.Store(false)
+		_ = json.NewEncoder(w).Encode(map[string]any{"auth": map[string]any{"client_token": fmt.Sprintf("t%d", n), "lease_duration": 3600}})
+		return
+	}
+	if r.Header.Get("X-Vault-Token") == "" || f.revoked.Load() {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"errors":["permission denied"]}`))
+		return
+	}
+	switch r.URL.Path {
+	case "/v1/secret/data/team/db":
+		_, _ = w.Write([]byte(`{"data":{"data":{"password":"hunter2","port":5432},"metadata":{"version":3}}}`))
+	case "/v1/kv/team/db":
+		_, _ = w.Write([]byte(`{"data":{"password":"hunter2"}}`))
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"errors":[]}`))
+	}
+}
+
+func newTestClient(t *testing.T) (*Client, *fakeVault) {
+	t.Helper()
+	fake := &fakeVault{}
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+	tokenPath := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(tokenPath, []byte("sa-token\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	client, err := New(Config{Address: server.URL, Role: "console", TokenPath: tokenPath})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	return client, fake
+}
+
+func TestClient_Read(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("kv v2", func(t *testing.T) {
+		client, _ := newTestClient(t)
+		data, err := client.Read(ctx, "secret/data/team/db")
+		if err != nil {
+			t.Fatalf("Read: %v", err)
+		}
+		if string(data["password"]) != "hunter2" || string(data["port"]) != "5432" || len(data) != 2 {
+			t.Errorf("data = %q", data)
+		}
+	})
+
+	t.Run("kv v1", func(t *testing.T) {
+		client, _ := newTestClient(t)
+		data, err := client.Read(ctx, "/kv/team/db")
+		if err != nil {
+			t.Fatalf("Read: %v", err)
+		}
+		if string(data["password"]) != "hunter2" {
+			t.Errorf("data = %q", data)
+		}
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		client, _ := newTestClient(t)
+		_, err := client.Read(ctx, "secret/data/missing")
+		if !errors.Is(err, fs.ErrNotExist) {
+			t.Fatalf("expected fs.ErrNotExist, got %v", err)
+		}
+	})
+
+	t.Run("caches the token and logs in again when revoked", func(t *testing.T) {
+		client, fake := newTestClient(t)
+		for range 3 {
+			if _, err := client.Read(ctx, "kv/team/db"); err != nil {
+				t.Fatalf("Read: %v", err)
+			}
+		}
+		if n := fake.logins.Load(); n != 1 {
+			t.Fatalf("expected 1 login, got %d", n)
+		}
+		fake.revoked.Store(true)
+		if _, err := client.Read(ctx, "kv/team/db"); err != nil {
+			t.Fatalf("Read after revocation: %v", err)
+		}
+		if n := fake.logins.Load(); n != 2 {
+			t.Errorf("expected 2 logins, got %d", n)
+		}
+	})
+}
+
+func TestNew_Validates(t *testing.T) {
+	if _, err := New(Config{Address: "vault:8200", Role: "console"}); err == nil {
+		t.Error("expected error for an address without scheme")
+	}
+	if _, err := New(Config{Address: "https://vault:8200"}); err == nil {
+		t.Error("expected error without a role")
+	}
+}
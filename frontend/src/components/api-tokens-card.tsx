@@ -0,0 +1,180 @@
+/**
+ * ApiTokensCard — lists, creates, and revokes the signed-in user's personal
+ * API tokens. A new token is shown once, right after it is created; only a
+ * hash is stored server-side.
+ */
+
+import { useState } from 'react'
+import { Copy, Trash2 } from 'lucide-react'
+import { toast } from 'sonner'
+import { Card, CardContent, CardHeader, CardTitle } from '@/components/ui/card'
+import { Button } from '@/components/ui/button'
+import { Input } from '@/components/ui/input'
+import { Label } from '@/components/ui/label'
+import { Badge } from '@/components/ui/badge'
+import { Alert, AlertDescription, AlertTitle } from '@/components/ui/alert'
+import { Permission } from '@/gen/holos/console/v1/rbac_pb.js'
+import { connectErrorMessage } from '@/lib/connect-toast'
+import { useCreateToken, useListTokens, useRevokeToken } from '@/queries/tokens'
+
+const SECONDS_PER_DAY = 24 * 60 * 60
+// MAX_DAYS matches the server's cap: a token keeps the groups its creator
+// holds when it is created.
+const MAX_DAYS = 30
+
+function formatTimestamp(ts: { seconds: bigint } | undefined): string {
+  if (!ts) return 'N/A'
+  return new Date(Number(ts.seconds) * 1000).toLocaleString()
+}
+
+function permissionLabel(permission: Permission): string {
+  return (Permission[permission] ?? String(permission)).replace(/^PERMISSION_/, '')
+}
+
+export function ApiTokensCard() {
+  const { data: tokens, isPending, error } = useListTokens()
+  const createToken = useCreateToken()
+  const revokeToken = useRevokeToken()
+  const [name, setName] = useState('')
+  const [days, setDays] = useState('7')
+  const [minted, setMinted] = useState<string | null>(null)
+
+  const handleCreate = async () => {
+    try {
+      const response = await createToken.mutateAsync({
+        name: name.trim(),
+        ttlSeconds: BigInt(Number(days) * SECONDS_PER_DAY),
+      })
+      setMinted(response.token)
+      setName('')
+    } catch (err) {
+      toast.error(connectErrorMessage(err))
+    }
+  }
+
+  const handleRevoke = async (id: string) => {
+    try {
+      await revokeToken.mutateAsync({ id })
+      toast.success('Token revoked')
+    } catch (err) {
+      toast.error(connectErrorMessage(err))
+    }
+  }
+
+  const handleCopy = () => {
+    if (!minted) return
+    navigator.clipboard.writeText(minted)
+    toast.success('Copied to clipboard')
+  }
+
+  const daysValid = Number.isInteger(Number(days)) && Number(days) >= 1 && Number(days) <= MAX_DAYS
+
+  return (
+    <Card>
+      <CardHeader>
+        <CardTitle>API Tokens</CardTitle>
+      </CardHeader>
+      <CardContent className="space-y-4">
+        <p className="text-sm text-muted-foreground">
+          Personal API tokens act as you, with the groups you hold now, until they expire or are
+          revoked. They last at most {MAX_DAYS} days. Use them for automation that cannot sign in
+          interactively.
+        </p>
+
+        {error ? (
+          <Alert>
+            <AlertDescription>API tokens are unavailable: {connectErrorMessage(error)}</AlertDescription>
+          </Alert>
+        ) : (
+          <>
+            {minted && (
+              <Alert>
+                <AlertTitle>Copy your new token now</AlertTitle>
+                <AlertDescription className="space-y-2">
+                  <p>It will not be shown again.</p>
+                  <div className="flex items-center gap-2">
+                    <code className="font-mono text-xs break-all">{minted}</code>
+                    <Button variant="ghost" size="icon" aria-label="Copy token" onClick={handleCopy}>
+                      <Copy className="h-3.5 w-3.5" />
+                    </Button>
+                  </div>
+                </AlertDescription>
+              </Alert>
+            )}
+
+            <div className="flex flex-wrap items-end gap-2">
+              <div className="space-y-1">
+                <Label htmlFor="api-token-name">Name</Label>
+                <Input
+                  id="api-token-name"
+                  value={name}
+                  maxLength={63}
+                  placeholder="ci-pipeline"
+                  onChange={(e) => setName(e.target.value)}
+                />
+              </div>
+              <div className="space-y-1">
+                <Label htmlFor="api-token-days">Expires in (days)</Label>
+                <Input
+                  id="api-token-days"
+                  type="number"
+                  min={1}
+                  max={MAX_DAYS}
+                  className="w-28"
+                  value={days}
+                  onChange={(e) => setDays(e.target.value)}
+                />
+              </div>
+              <Button
+                onClick={handleCreate}
+                disabled={!name.trim() || !daysValid || createToken.isPending}
+              >
+                {createToken.isPending ? 'Creating...' : 'Create Token'}
+              </Button>
+            </div>
+
+            {isPending ? (
+              <span className="text-sm">Loading...</span>
+            ) : tokens && tokens.length > 0 ? (
+              <ul className="divide-y rounded-md border">
+                {tokens.map((token) => (
+                  <li key={token.id} className="flex items-center justify-between gap-2 p-3">
+                    <div className="space-y-1">
+                      <p className="font-medium">{token.name}</p>
+                      <p className="text-xs text-muted-foreground">
+                        Created {formatTimestamp(token.createdAt)} · Expires{' '}
+                        {formatTimestamp(token.expiresAt)}
+                      </p>
+                      <div className="flex flex-wrap gap-1">
+                        {token.permissions.length === 0 ? (
+                          <Badge variant="outline">All permissions</Badge>
+                        ) : (
+                          token.permissions.map((p) => (
+                            <Badge key={p} variant="outline">
+                              {permissionLabel(p)}
+                            </Badge>
+                          ))
+                        )}
+                      </div>
+                    </div>
+                    <Button
+                      variant="ghost"
+                      size="icon"
+                      aria-label={`Revoke ${token.name}`}
+                      onClick={() => handleRevoke(token.id)}
+                      disabled={revokeToken.isPending}
+                    >
+                      <Trash2 className="h-4 w-4" />
+                    </Button>
+                  </li>
+                ))}
+              </ul>
+            ) : (
+              <p className="text-sm text-muted-foreground">No API tokens.</p>
+            )}
+          </>
+        )}
+      </CardContent>
+    </Card>
+  )
+}
@@ -0,0 +1,17 @@
+import { Alert, AlertDescription, AlertTitle } from '@/components/ui/alert'
+import { useGetMaintenance } from '@/queries/maintenance'
+
+// MaintenanceBanner tells users the console is read-only while platform
+// owners have maintenance mode enabled. Changes fail until it is turned off.
+export function MaintenanceBanner() {
+  const { data: maintenance } = useGetMaintenance()
+  if (!maintenance?.enabled) {
+    return null
+  }
+  return (
+    <Alert className="rounded-none border-x-0 border-t-0" role="status">
+      <AlertTitle>Read-only maintenance</AlertTitle>
+      <AlertDescription>{maintenance.message}</AlertDescription>
+    </Alert>
+  )
+}
@@ -270,3 +270,62 @@ describe('SecretDataGrid readOnly', () => {
     expect(screen.getByText(/no data/i)).toBeInTheDocument()
   })
 })
+
+describe('SecretDataGrid binary values', () => {
+  // Not valid UTF-8, like the header of a PKCS #12 keystore.
+  const keystore = new Uint8Array([0x30, 0x82, 0x0a, 0xff, 0xfe, 0x00])
+
+  it('summarizes binary values instead of revealing them', () => {
+    render(
+      <SecretDataGrid
+        data={{ 'keystore.p12': keystore }}
+        contentTypes={{ 'keystore.p12': 'application/x-pkcs12' }}
+        onChange={vi.fn()}
+        readOnly
+      />,
+    )
+
+    expect(screen.getByText('Binary file · 6 B · application/x-pkcs12')).toBeInTheDocument()
+    expect(screen.queryByLabelText('reveal')).not.toBeInTheDocument()
+  })
+
+  it('download button calls onDownload with the key', () => {
+    const onDownload = vi.fn()
+    render(<SecretDataGrid data={{ 'keystore.p12': keystore }} onChange={vi.fn()} readOnly onDownload={onDownload} />)
+
+    fireEvent.click(screen.getByLabelText('download keystore.p12'))
+    expect(onDownload).toHaveBeenCalledWith('keystore.p12')
+  })
+
+  it('preserves binary bytes and content types through edits', () => {
+    const onChange = vi.fn()
+    render(
+      <SecretDataGrid
+        data={{ 'keystore.p12': keystore, token: encode('abc') }}
+        contentTypes={{ 'keystore.p12': 'application/x-pkcs12' }}
+        onChange={onChange}
+      />,
+    )
+
+    fireEvent.change(screen.getByPlaceholderText('value'), { target: { value: 'xyz' } })
+
+    const [data, contentTypes] = onChange.mock.calls[onChange.mock.calls.length - 1]
+    expect(data['keystore.p12']).toEqual(keystore)
+    expect(contentTypes).toEqual({ 'keystore.p12': 'application/x-pkcs12' })
+  })
+
+  it('upload stores the file bytes and type, defaulting the key to the file name', async () => {
+    const onChange = vi.fn()
+    const { container } = render(<SecretDataGrid data={{}} onChange={onChange} />)
+
+    const input = container.querySelector('input[type="file"]') as HTMLInputElement
+    const file = new File([keystore], 'keystore.p12', { type: 'application/x-pkcs12' })
+    fireEvent.change(input, { target: { files: [file] } })
+
+    await waitFor(() => expect(onChange).toHaveBeenCalled())
+    const [data, contentTypes] = onChange.mock.calls[onChange.mock.calls.length - 1]
+    expect(data['keystore.p12']).toEqual(keystore)
+    expect(contentTypes).toEqual({ 'keystore.p12': 'application/x-pkcs12' })
+    expect((screen.getByPlaceholderText('key') as HTMLInputElement).value).toBe('keystore.p12')
+  })
+})
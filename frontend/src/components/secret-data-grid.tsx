@@ -3,28 +3,52 @@ import { toast } from 'sonner'
 import { Button } from '@/components/ui/button'
 import { Input } from '@/components/ui/input'
 import { Checkbox } from '@/components/ui/checkbox'
-import { Eye, EyeOff, Copy, Plus, Trash2 } from 'lucide-react'
+import { Eye, EyeOff, Copy, Download, FileUp, Plus, Trash2 } from 'lucide-react'
+import { formatByteSize, isText } from '@/lib/secret-bytes'
 
 interface Entry {
   id: string
   key: string
   value: string
   trailingNewline: boolean
+  // binary holds a value that is stored verbatim rather than edited as text:
+  // an uploaded file, or an existing value that is not valid UTF-8.
+  binary: Uint8Array | null
+  contentType: string
 }
 
 export interface SecretDataGridProps {
   data: Record<string, Uint8Array>
-  onChange: (data: Record<string, Uint8Array>) => void
+  // contentTypes maps keys to the media type recorded for their value.
+  contentTypes?: Record<string, string>
+  onChange: (data: Record<string, Uint8Array>, contentTypes: Record<string, string>) => void
   readOnly?: boolean
+  // onDownload saves a key's value as a file. The download button is hidden
+  // when unset.
+  onDownload?: (key: string) => void
 }
 
 const encoder = new TextEncoder()
 const decoder = new TextDecoder()
 
-function dataToEntries(data: Record<string, Uint8Array>, genId: () => string): Entry[] {
+const defaultContentType = 'application/octet-stream'
+
+function emptyEntry(genId: () => string): Entry {
+  return { id: genId(), key: '', value: '', trailingNewline: false, binary: null, contentType: '' }
+}
+
+function dataToEntries(
+  data: Record<string, Uint8Array>,
+  contentTypes: Record<string, string>,
+  genId: () => string,
+): Entry[] {
   return Object.entries(data)
     .sort(([a], [b]) => a.localeCompare(b))
     .map(([key, rawValue]) => {
+      const contentType = contentTypes[key] ?? ''
+      if (!isText(rawValue)) {
+        return { id: genId(), key, value: '', trailingNewline: false, binary: rawValue, contentType }
+      }
       let value = decoder.decode(rawValue)
       const baseValue = value.endsWith('\n') ? value.slice(0, -1) : value
       const isMultiLine = baseValue.includes('\n')
@@ -33,23 +57,41 @@ function dataToEntries(data: Record<string, Uint8Array>, genId: () => string): E
         trailingNewline = true
         value = baseValue
       }
-      return { id: genId(), key, value, trailingNewline }
+      return { id: genId(), key, value, trailingNewline, binary: null, contentType }
     })
 }
 
-function entriesToData(entries: Entry[]): Record<string, Uint8Array> {
-  const result: Record<string, Uint8Array> = {}
+function entriesToData(entries: Entry[]): {
+  data: Record<string, Uint8Array>
+  contentTypes: Record<string, string>
+} {
+  const data: Record<string, Uint8Array> = {}
+  const contentTypes: Record<string, string> = {}
   for (const entry of entries) {
-    if (entry.key !== '') {
+    if (entry.key === '') continue
+    if (entry.binary) {
+      data[entry.key] = entry.binary
+    } else {
       let value = entry.value
       const isMultiLine = value.includes('\n')
       if (isMultiLine && entry.trailingNewline && value.length > 0 && !value.endsWith('\n')) {
         value += '\n'
       }
-      result[entry.key] = encoder.encode(value)
+      data[entry.key] = encoder.encode(value)
+    }
+    if (entry.contentType) {
+      contentTypes[entry.key] = entry.contentType
     }
   }
-  return result
+  return { data, contentTypes }
+}
+
+function BinarySummary({ size, contentType }: { size: number; contentType: string }) {
+  return (
+    <p className="font-mono text-sm text-muted-foreground py-1">
+      {`Binary file · ${formatByteSize(size)} · ${contentType || defaultContentType}`}
+    </p>
+  )
 }
 
 function AutoExpandTextarea({
@@ -88,21 +130,28 @@ function AutoExpandTextarea({
   )
 }
 
-export function SecretDataGrid({ data, onChange, readOnly = false }: SecretDataGridProps) {
+export function SecretDataGrid({
+  data,
+  contentTypes = {},
+  onChange,
+  readOnly = false,
+  onDownload,
+}: SecretDataGridProps) {
   const nextIdRef = useRef(0)
   const genId = useCallback(() => `grid-${++nextIdRef.current}`, [])
 
   const [entries, setEntries] = useState<Entry[]>(() => {
-    const parsed = dataToEntries(data, genId)
+    const parsed = dataToEntries(data, contentTypes, genId)
     // Show one empty row by default when no data
-    return parsed.length > 0 ? parsed : [{ id: genId(), key: '', value: '', trailingNewline: false }]
+    return parsed.length > 0 ? parsed : [emptyEntry(genId)]
   })
   const [revealedKeys, setRevealedKeys] = useState<Set<string>>(new Set())
 
   const emitChange = useCallback(
     (newEntries: Entry[]) => {
       setEntries(newEntries)
-      onChange(entriesToData(newEntries))
+      const next = entriesToData(newEntries)
+      onChange(next.data, next.contentTypes)
     },
     [onChange],
   )
@@ -128,13 +177,34 @@ export function SecretDataGrid({ data, onChange, readOnly = false }: SecretDataG
     )
   }
 
+  // handleUpload stores a file's bytes verbatim as the row's value, keeping
+  // its media type so the value downloads as the same kind of file. An empty
+  // key defaults to the file name.
+  const handleUpload = async (id: string, file: File) => {
+    const bytes = new Uint8Array(await file.arrayBuffer())
+    emitChange(
+      entries.map((e) =>
+        e.id === id
+          ? {
+              ...e,
+              key: e.key || file.name,
+              value: '',
+              trailingNewline: false,
+              binary: bytes,
+              contentType: file.type || defaultContentType,
+            }
+          : e,
+      ),
+    )
+  }
+
   const handleAddRow = () => {
-    emitChange([...entries, { id: genId(), key: '', value: '', trailingNewline: false }])
+    emitChange([...entries, emptyEntry(genId)])
   }
 
   const handleRemoveRow = (id: string) => {
     const newEntries = entries.filter((e) => e.id !== id)
-    emitChange(newEntries.length > 0 ? newEntries : [{ id: genId(), key: '', value: '', trailingNewline: false }])
+    emitChange(newEntries.length > 0 ? newEntries : [emptyEntry(genId)])
   }
 
   const handleEntryTrailingNewlineChange = (id: string, checked: boolean) => {
@@ -178,12 +248,15 @@ export function SecretDataGrid({ data, onChange, readOnly = false }: SecretDataG
         </div>
         {keys.map((key) => {
           const isRevealed = revealedKeys.has(key)
-          const rawValue = decoder.decode(data[key])
+          const binary = !isText(data[key])
+          const rawValue = binary ? '' : decoder.decode(data[key])
           return (
             <div key={key} className="grid grid-cols-[1fr_2fr_auto] gap-2 items-start border rounded-md p-2">
               <span className="text-sm font-medium font-mono truncate py-1">{key}</span>
               <div className="min-w-0">
-                {isRevealed ? (
+                {binary ? (
+                  <BinarySummary size={data[key].length} contentType={contentTypes[key] ?? ''} />
+                ) : isRevealed ? (
                   <pre className="font-mono text-sm whitespace-pre-wrap break-all bg-muted p-2 rounded-md">
                     {rawValue}
                   </pre>
@@ -192,12 +265,21 @@ export function SecretDataGrid({ data, onChange, readOnly = false }: SecretDataG
                 )}
               </div>
               <div className="flex gap-1">
-                <Button variant="ghost" size="icon" aria-label={isRevealed ? 'hide' : 'reveal'} onClick={() => toggleReveal(key)}>
-                  {isRevealed ? <EyeOff className="h-4 w-4" /> : <Eye className="h-4 w-4" />}
-                </Button>
-                <Button variant="ghost" size="icon" aria-label="copy" onClick={() => handleCopy(rawValue)}>
-                  <Copy className="h-4 w-4" />
-                </Button>
+                {!binary && (
+                  <>
+                    <Button variant="ghost" size="icon" aria-label={isRevealed ? 'hide' : 'reveal'} onClick={() => toggleReveal(key)}>
+                      {isRevealed ? <EyeOff className="h-4 w-4" /> : <Eye className="h-4 w-4" />}
+                    </Button>
+                    <Button variant="ghost" size="icon" aria-label="copy" onClick={() => handleCopy(rawValue)}>
+                      <Copy className="h-4 w-4" />
+                    </Button>
+                  </>
+                )}
+                {onDownload && (
+                  <Button variant="ghost" size="icon" aria-label={`download ${key}`} onClick={() => onDownload(key)}>
+                    <Download className="h-4 w-4" />
+                  </Button>
+                )}
               </div>
             </div>
           )
@@ -212,7 +294,7 @@ export function SecretDataGrid({ data, onChange, readOnly = false }: SecretDataG
       <div className="grid grid-cols-[1fr_2fr_auto] gap-2 px-1">
         <span className="text-xs font-medium text-muted-foreground uppercase tracking-wider">Key</span>
         <span className="text-xs font-medium text-muted-foreground uppercase tracking-wider">Value</span>
-        <span className="w-9" />
+        <span className="w-18" />
       </div>
 
       {entries.map((entry) => {
@@ -231,12 +313,16 @@ export function SecretDataGrid({ data, onChange, readOnly = false }: SecretDataG
               )}
             </div>
             <div>
-              <AutoExpandTextarea
-                value={entry.value}
-                onChange={(v) => handleValueChange(entry.id, v)}
-                placeholder="value"
-              />
-              {entry.value.includes('\n') && (
+              {entry.binary ? (
+                <BinarySummary size={entry.binary.length} contentType={entry.contentType} />
+              ) : (
+                <AutoExpandTextarea
+                  value={entry.value}
+                  onChange={(v) => handleValueChange(entry.id, v)}
+                  placeholder="value"
+                />
+              )}
+              {!entry.binary && entry.value.includes('\n') && (
                 <div className="flex items-center gap-1.5 mt-1">
                   <Checkbox
                     id={`trailing-newline-${entry.id}`}
@@ -254,14 +340,31 @@ export function SecretDataGrid({ data, onChange, readOnly = false }: SecretDataG
                 </div>
               )}
             </div>
-            <Button
-              variant="ghost"
-              size="icon"
-              aria-label="remove row"
-              onClick={() => handleRemoveRow(entry.id)}
-            >
-              <Trash2 className="h-4 w-4" />
-            </Button>
+            <div className="flex">
+              <Button variant="ghost" size="icon" aria-label="upload file" asChild>
+                <label>
+                  <FileUp className="h-4 w-4" />
+                  <input
+                    type="file"
+                    className="sr-only"
+                    data-testid={`upload-${entry.id}`}
+                    onChange={(e) => {
+                      const file = e.target.files?.[0]
+                      if (file) void handleUpload(entry.id, file)
+                      e.target.value = ''
+                    }}
+                  />
+                </label>
+              </Button>
+              <Button
+                variant="ghost"
+                size="icon"
+                aria-label="remove row"
+                onClick={() => handleRemoveRow(entry.id)}
+              >
+                <Trash2 className="h-4 w-4" />
+              </Button>
+            </div>
           </div>
         )
       })}
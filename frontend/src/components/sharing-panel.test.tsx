@@ -39,6 +39,35 @@ describe('SharingPanel', () => {
       expect(screen.getByText('platform-team')).toBeInTheDocument()
     })
 
+    it('labels deny grants as denied', () => {
+      render(
+        <SharingPanel
+          userGrants={[{ principal: 'mallory@example.com', role: Role.UNSPECIFIED, deny: true }]}
+          roleGrants={[]}
+          isOwner={false}
+          onSave={vi.fn()}
+          isSaving={false}
+          allowDeny
+        />,
+      )
+
+      expect(screen.getByText(/^Denied/)).toBeInTheDocument()
+    })
+
+    it('labels users who have never signed in as pending', () => {
+      render(
+        <SharingPanel
+          userGrants={[{ principal: 'carol@example.com', role: Role.VIEWER, pending: true }, grant('bob@example.com', Role.VIEWER)]}
+          roleGrants={[]}
+          isOwner={false}
+          onSave={vi.fn()}
+          isSaving={false}
+        />,
+      )
+
+      expect(screen.getAllByText('Pending')).toHaveLength(1)
+    })
+
     it('shows empty state when no grants', () => {
       render(
         <SharingPanel
@@ -257,6 +286,33 @@ describe('SharingPanel', () => {
       })
     })
 
+    it('passes inviteUsers to onSave when invites are allowed', async () => {
+      const onSave = vi.fn().mockResolvedValue(undefined)
+
+      render(
+        <SharingPanel
+          userGrants={[grant('carol@example.com', Role.VIEWER)]}
+          roleGrants={[]}
+          isOwner={true}
+          onSave={onSave}
+          isSaving={false}
+          allowInvite
+        />,
+      )
+
+      fireEvent.click(screen.getByRole('button', { name: /edit/i }))
+      fireEvent.click(screen.getByLabelText(/invite users/i))
+      fireEvent.click(screen.getByRole('button', { name: /save/i }))
+
+      await waitFor(() => {
+        expect(onSave).toHaveBeenCalledWith(
+          [{ principal: 'carol@example.com', role: Role.VIEWER }],
+          [],
+          true,
+        )
+      })
+    })
+
     it('preserves nbf/exp through save', async () => {
       const onSave = vi.fn().mockResolvedValue(undefined)
       const nbf = BigInt(1704067200)
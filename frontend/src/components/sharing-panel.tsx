@@ -2,6 +2,8 @@ import { useState } from 'react'
 import { Button } from '@/components/ui/button'
 import { Input } from '@/components/ui/input'
 import { Label } from '@/components/ui/label'
+import { Badge } from '@/components/ui/badge'
+import { Checkbox } from '@/components/ui/checkbox'
 import { Alert, AlertDescription } from '@/components/ui/alert'
 import {
   Select,
@@ -19,18 +21,29 @@ export interface Grant {
   role: Role
   nbf?: bigint
   exp?: bigint
+  // deny excludes the principal instead of granting role. Only secrets
+  // honor deny grants.
+  deny?: boolean
+  // pending marks a user grant whose principal has never signed in.
+  pending?: boolean
 }
 
 export interface SharingPanelProps {
   userGrants: Grant[]
   roleGrants: Grant[]
   isOwner: boolean
-  onSave: (userGrants: Grant[], roleGrants: Grant[]) => Promise<void>
+  onSave: (userGrants: Grant[], roleGrants: Grant[], inviteUsers?: boolean) => Promise<void>
   isSaving: boolean
   title?: string
   description?: string
+  // allowDeny offers a Deny choice that excludes a principal.
+  allowDeny?: boolean
+  // allowInvite offers to invite users who have never signed in.
+  allowInvite?: boolean
 }
 
+const DENY_VALUE = 'deny'
+
 function roleName(role: Role): string {
   switch (role) {
     case Role.OWNER: return 'Owner'
@@ -45,8 +58,8 @@ function formatTimeBound(ts?: bigint): string {
   return new Date(Number(ts) * 1000).toLocaleString()
 }
 
-function grantSecondary(role: Role, nbf?: bigint, exp?: bigint): string {
-  const parts = [roleName(role)]
+function grantSecondary(role: Role, nbf?: bigint, exp?: bigint, deny?: boolean): string {
+  const parts = [deny ? 'Denied' : roleName(role)]
   parts.push(nbf != null ? `from ${formatTimeBound(nbf)}` : 'no start restriction')
   parts.push(exp != null ? `until ${formatTimeBound(exp)}` : 'no expiration')
   return parts.join(' \u00b7 ')
@@ -82,16 +95,27 @@ function defaultExpirationUTC(): bigint {
   return BigInt(Math.floor(lastDayOfNextMonth.getTime() / 1000))
 }
 
-export function SharingPanel({ userGrants, roleGrants, isOwner, onSave, isSaving, title = 'Sharing', description }: SharingPanelProps) {
+function grantSelectValue(g: Grant): string {
+  return g.deny ? DENY_VALUE : String(g.role)
+}
+
+function applySelectValue(g: Grant, value: string): Grant {
+  if (value === DENY_VALUE) return { ...g, deny: true }
+  return { ...g, role: Number(value) as Role, deny: false }
+}
+
+export function SharingPanel({ userGrants, roleGrants, isOwner, onSave, isSaving, title = 'Sharing', description, allowDeny = false, allowInvite = false }: SharingPanelProps) {
   const [editing, setEditing] = useState(false)
   const [editUserGrants, setEditUserGrants] = useState<Grant[]>([])
   const [editRoleGrants, setEditRoleGrants] = useState<Grant[]>([])
   const [saveError, setSaveError] = useState<string | null>(null)
+  const [inviteUsers, setInviteUsers] = useState(false)
 
   const handleEdit = () => {
     setEditUserGrants(userGrants.map((g) => ({ ...g })))
     setEditRoleGrants(roleGrants.map((g) => ({ ...g })))
     setSaveError(null)
+    setInviteUsers(false)
     setEditing(true)
   }
 
@@ -104,7 +128,11 @@ export function SharingPanel({ userGrants, roleGrants, isOwner, onSave, isSaving
     const users = editUserGrants.filter((g) => g.principal.trim() !== '')
     const roles = editRoleGrants.filter((g) => g.principal.trim() !== '')
     try {
-      await onSave(users, roles)
+      if (allowInvite) {
+        await onSave(users, roles, inviteUsers)
+      } else {
+        await onSave(users, roles)
+      }
       setEditing(false)
     } catch (err) {
       setSaveError(connectErrorMessage(err))
@@ -123,6 +151,18 @@ export function SharingPanel({ userGrants, roleGrants, isOwner, onSave, isSaving
     setEditRoleGrants(updated)
   }
 
+  const handleUserSelect = (index: number, value: string) => {
+    const updated = [...editUserGrants]
+    updated[index] = applySelectValue(updated[index], value)
+    setEditUserGrants(updated)
+  }
+
+  const handleRoleSelect = (index: number, value: string) => {
+    const updated = [...editRoleGrants]
+    updated[index] = applySelectValue(updated[index], value)
+    setEditRoleGrants(updated)
+  }
+
   const hasGrants = userGrants.length > 0 || roleGrants.length > 0
 
   if (!editing) {
@@ -146,9 +186,12 @@ export function SharingPanel({ userGrants, roleGrants, isOwner, onSave, isSaving
                 <p className="text-xs text-muted-foreground">Users</p>
                 <ul className="space-y-1">
                   {userGrants.map((g) => (
-                    <li key={g.principal} className="text-sm">
+                    <li key={`${g.principal}-${g.deny ?? false}`} className="text-sm">
                       <span className="font-medium">{g.principal}</span>
-                      <span className="text-muted-foreground ml-2">{grantSecondary(g.role, g.nbf, g.exp)}</span>
+                      <span className="text-muted-foreground ml-2">{grantSecondary(g.role, g.nbf, g.exp, g.deny)}</span>
+                      {g.pending && (
+                        <Badge variant="outline" className="ml-2" title="This user has never signed in">Pending</Badge>
+                      )}
                     </li>
                   ))}
                 </ul>
@@ -159,9 +202,9 @@ export function SharingPanel({ userGrants, roleGrants, isOwner, onSave, isSaving
                 <p className="text-xs text-muted-foreground">Roles</p>
                 <ul className="space-y-1">
                   {roleGrants.map((g) => (
-                    <li key={g.principal} className="text-sm">
+                    <li key={`${g.principal}-${g.deny ?? false}`} className="text-sm">
                       <span className="font-medium">{g.principal}</span>
-                      <span className="text-muted-foreground ml-2">{grantSecondary(g.role, g.nbf, g.exp)}</span>
+                      <span className="text-muted-foreground ml-2">{grantSecondary(g.role, g.nbf, g.exp, g.deny)}</span>
                     </li>
                   ))}
                 </ul>
@@ -192,8 +235,8 @@ export function SharingPanel({ userGrants, roleGrants, isOwner, onSave, isSaving
                 className="flex-1"
               />
               <Select
-                value={String(g.role)}
-                onValueChange={(v) => handleUserChange(i, 'role', Number(v) as Role)}
+                value={grantSelectValue(g)}
+                onValueChange={(v) => handleUserSelect(i, v)}
               >
                 <SelectTrigger className="w-full md:w-32">
                   <SelectValue />
@@ -202,6 +245,7 @@ export function SharingPanel({ userGrants, roleGrants, isOwner, onSave, isSaving
                   <SelectItem value={String(Role.VIEWER)}>Viewer</SelectItem>
                   <SelectItem value={String(Role.EDITOR)}>Editor</SelectItem>
                   <SelectItem value={String(Role.OWNER)}>Owner</SelectItem>
+                  {allowDeny && <SelectItem value={DENY_VALUE}>Deny</SelectItem>}
                 </SelectContent>
               </Select>
               <Button variant="ghost" size="icon" aria-label="remove" onClick={() => setEditUserGrants(editUserGrants.filter((_, j) => j !== i))}>
@@ -243,6 +287,18 @@ export function SharingPanel({ userGrants, roleGrants, isOwner, onSave, isSaving
         <Button variant="outline" size="sm" onClick={() => setEditUserGrants([...editUserGrants, { principal: '', role: Role.VIEWER }])}>
           Add User
         </Button>
+        {allowInvite && (
+          <div className="flex items-center gap-2 mt-3">
+            <Checkbox
+              id="sharing-invite-users"
+              checked={inviteUsers}
+              onCheckedChange={(checked) => setInviteUsers(checked === true)}
+            />
+            <Label htmlFor="sharing-invite-users" className="text-sm cursor-pointer">
+              Invite users who have never signed in
+            </Label>
+          </div>
+        )}
       </div>
 
       <div>
@@ -257,8 +313,8 @@ export function SharingPanel({ userGrants, roleGrants, isOwner, onSave, isSaving
                 className="flex-1"
               />
               <Select
-                value={String(g.role)}
-                onValueChange={(v) => handleRoleChange(i, 'role', Number(v) as Role)}
+                value={grantSelectValue(g)}
+                onValueChange={(v) => handleRoleSelect(i, v)}
               >
                 <SelectTrigger className="w-full md:w-32">
                   <SelectValue />
@@ -267,6 +323,7 @@ export function SharingPanel({ userGrants, roleGrants, isOwner, onSave, isSaving
                   <SelectItem value={String(Role.VIEWER)}>Viewer</SelectItem>
                   <SelectItem value={String(Role.EDITOR)}>Editor</SelectItem>
                   <SelectItem value={String(Role.OWNER)}>Owner</SelectItem>
+                  {allowDeny && <SelectItem value={DENY_VALUE}>Deny</SelectItem>}
                 </SelectContent>
               </Select>
               <Button variant="ghost" size="icon" aria-label="remove" onClick={() => setEditRoleGrants(editRoleGrants.filter((_, j) => j !== i))}>
@@ -0,0 +1,39 @@
+import { render, screen } from '@testing-library/react'
+import { describe, it, expect } from 'vitest'
+import { create } from '@bufbuild/protobuf'
+import { TLSCertificateSchema, type TLSCertificate } from '@/gen/holos/console/v1/secrets_pb'
+import { TLSCertificateSummary } from './tls-certificate-summary'
+
+const now = new Date('2026-10-01T00:00:00Z')
+
+function makeCertificate(partial: Partial<TLSCertificate> = {}): TLSCertificate {
+  return create(TLSCertificateSchema, {
+    subject: 'CN=example.com',
+    issuer: "CN=R11,O=Let's Encrypt,C=US",
+    dnsNames: ['example.com', 'www.example.com'],
+    ipAddresses: ['10.0.0.1'],
+    notBefore: '2026-07-01T00:00:00Z',
+    notAfter: '2027-01-01T00:00:00Z',
+    ...partial,
+  })
+}
+
+describe('TLSCertificateSummary', () => {
+  it('renders subject, issuer and subject alternative names', () => {
+    render(<TLSCertificateSummary certificate={makeCertificate()} now={now} />)
+    expect(screen.getByText('CN=example.com')).toBeInTheDocument()
+    expect(screen.getByText("CN=R11,O=Let's Encrypt,C=US")).toBeInTheDocument()
+    expect(screen.getByText('example.com, www.example.com, 10.0.0.1')).toBeInTheDocument()
+    expect(screen.getByText('Valid')).toBeInTheDocument()
+  })
+
+  it('flags certificates nearing expiry', () => {
+    render(<TLSCertificateSummary certificate={makeCertificate({ notAfter: '2026-10-11T12:00:00Z' })} now={now} />)
+    expect(screen.getByText('Expires in 10 days')).toBeInTheDocument()
+  })
+
+  it('flags expired certificates', () => {
+    render(<TLSCertificateSummary certificate={makeCertificate({ notAfter: '2026-09-01T00:00:00Z' })} now={now} />)
+    expect(screen.getByText('Expired')).toBeInTheDocument()
+  })
+})
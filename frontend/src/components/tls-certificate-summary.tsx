@@ -0,0 +1,67 @@
+import { Badge } from '@/components/ui/badge'
+import type { TLSCertificate } from '@/gen/holos/console/v1/secrets_pb'
+
+/** Certificates expiring within this many days are flagged. */
+const expiringSoonDays = 30
+
+const dayMs = 24 * 60 * 60 * 1000
+
+export interface TLSCertificateSummaryProps {
+  /** Parsed leaf certificate from SecretMetadata.tls_certificate. */
+  certificate: TLSCertificate
+  /** Current time, overridable for tests. */
+  now?: Date
+}
+
+function expiryBadge(notAfter: Date, now: Date) {
+  const days = Math.floor((notAfter.getTime() - now.getTime()) / dayMs)
+  if (days < 0) {
+    return <Badge variant="destructive">Expired</Badge>
+  }
+  if (days < expiringSoonDays) {
+    return (
+      <Badge className="bg-yellow-100 text-yellow-800 dark:bg-yellow-900 dark:text-yellow-200 border-transparent">
+        Expires in {days} {days === 1 ? 'day' : 'days'}
+      </Badge>
+    )
+  }
+  return <Badge variant="outline">Valid</Badge>
+}
+
+/**
+ * TLSCertificateSummary renders the subject, issuer, subject alternative
+ * names and validity window of a kubernetes.io/tls secret, with a badge
+ * flagging expired certificates and those expiring within 30 days.
+ */
+export function TLSCertificateSummary({ certificate, now = new Date() }: TLSCertificateSummaryProps) {
+  const sans = [
+    ...certificate.dnsNames,
+    ...certificate.ipAddresses,
+    ...certificate.emailAddresses,
+    ...certificate.uris,
+  ]
+  return (
+    <div className="rounded-md border p-3 space-y-1 text-sm">
+      <div className="flex items-center gap-2">
+        <span className="font-medium">TLS certificate</span>
+        {expiryBadge(new Date(certificate.notAfter), now)}
+      </div>
+      <dl className="grid grid-cols-[auto_1fr] gap-x-4 gap-y-1">
+        <dt className="text-muted-foreground">Subject</dt>
+        <dd className="font-mono break-all">{certificate.subject}</dd>
+        <dt className="text-muted-foreground">Issuer</dt>
+        <dd className="font-mono break-all">{certificate.issuer}</dd>
+        {sans.length > 0 && (
+          <>
+            <dt className="text-muted-foreground">Names</dt>
+            <dd className="font-mono break-all">{sans.join(', ')}</dd>
+          </>
+        )}
+        <dt className="text-muted-foreground">Validity</dt>
+        <dd>
+          {certificate.notBefore} to {certificate.notAfter}
+        </dd>
+      </dl>
+    </div>
+  )
+}
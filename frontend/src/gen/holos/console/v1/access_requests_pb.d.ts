@@ -0,0 +1,469 @@
+// @generated by protoc-gen-es v2.11.0
+// @generated from file holos/console/v1/access_requests.proto (package holos.console.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import type { Message } from "@bufbuild/protobuf";
+import type { Role } from "./rbac_pb";
+import type { Timestamp } from "@bufbuild/protobuf/wkt";
+
+/**
+ * Describes the file holos/console/v1/access_requests.proto.
+ */
+export declare const file_holos_console_v1_access_requests: GenFile;
+
+/**
+ * AccessRequest is a request for temporary access to a project or secret.
+ *
+ * @generated from message holos.console.v1.AccessRequest
+ */
+export declare type AccessRequest = Message<"holos.console.v1.AccessRequest"> & {
+  /**
+   * name is the server-assigned identifier of the request.
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * project is the project the requested resource belongs to.
+   *
+   * @generated from field: string project = 2;
+   */
+  project: string;
+
+  /**
+   * resource_type is "project" or "secret".
+   *
+   * @generated from field: string resource_type = 3;
+   */
+  resourceType: string;
+
+  /**
+   * resource_name is the project or secret name the request targets.
+   *
+   * @generated from field: string resource_name = 4;
+   */
+  resourceName: string;
+
+  /**
+   * role is the requested role. Secret requests support ROLE_VIEWER only;
+   * approving one installs a key-scoped grant covering every key the
+   * secret holds at that time.
+   *
+   * @generated from field: holos.console.v1.Role role = 5;
+   */
+  role: Role;
+
+  /**
+   * justification explains why access is needed.
+   *
+   * @generated from field: string justification = 6;
+   */
+  justification: string;
+
+  /**
+   * duration_seconds is how long the grant lasts once approved.
+   *
+   * @generated from field: int64 duration_seconds = 7;
+   */
+  durationSeconds: bigint;
+
+  /**
+   * requester_email is the email address of the user who filed the request.
+   *
+   * @generated from field: string requester_email = 8;
+   */
+  requesterEmail: string;
+
+  /**
+   * requester_sub is the OIDC subject of the user who filed the request.
+   *
+   * @generated from field: string requester_sub = 9;
+   */
+  requesterSub: string;
+
+  /**
+   * state is the lifecycle state of the request.
+   *
+   * @generated from field: holos.console.v1.AccessRequestState state = 10;
+   */
+  state: AccessRequestState;
+
+  /**
+   * created_at is when the request was filed.
+   *
+   * @generated from field: google.protobuf.Timestamp created_at = 11;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * decided_by is the email address of the owner who approved or denied
+   * the request.
+   *
+   * @generated from field: string decided_by = 12;
+   */
+  decidedBy: string;
+
+  /**
+   * decided_at is when the request was approved or denied.
+   *
+   * @generated from field: google.protobuf.Timestamp decided_at = 13;
+   */
+  decidedAt?: Timestamp;
+
+  /**
+   * reason is the owner's optional note on the decision.
+   *
+   * @generated from field: string reason = 14;
+   */
+  reason: string;
+
+  /**
+   * nbf is the Unix timestamp the approved grant becomes active.
+   *
+   * @generated from field: optional int64 nbf = 15;
+   */
+  nbf?: bigint;
+
+  /**
+   * exp is the Unix timestamp the approved grant expires.
+   *
+   * @generated from field: optional int64 exp = 16;
+   */
+  exp?: bigint;
+};
+
+/**
+ * Describes the message holos.console.v1.AccessRequest.
+ * Use `create(AccessRequestSchema)` to create a new message.
+ */
+export declare const AccessRequestSchema: GenMessage<AccessRequest>;
+
+/**
+ * CreateAccessRequestRequest files a request for temporary access.
+ *
+ * @generated from message holos.console.v1.CreateAccessRequestRequest
+ */
+export declare type CreateAccessRequestRequest = Message<"holos.console.v1.CreateAccessRequestRequest"> & {
+  /**
+   * project is the project the requested resource belongs to.
+   *
+   * @generated from field: string project = 1;
+   */
+  project: string;
+
+  /**
+   * resource_type is "project" or "secret".
+   *
+   * @generated from field: string resource_type = 2;
+   */
+  resourceType: string;
+
+  /**
+   * resource_name is the secret name for secret requests. Project requests
+   * may leave it empty; it defaults to project.
+   *
+   * @generated from field: string resource_name = 3;
+   */
+  resourceName: string;
+
+  /**
+   * role is the requested role.
+   *
+   * @generated from field: holos.console.v1.Role role = 4;
+   */
+  role: Role;
+
+  /**
+   * justification explains why access is needed. Required.
+   *
+   * @generated from field: string justification = 5;
+   */
+  justification: string;
+
+  /**
+   * duration_seconds is how long the grant should last. Zero selects the
+   * server default of one hour; the server caps it at 24 hours.
+   *
+   * @generated from field: int64 duration_seconds = 6;
+   */
+  durationSeconds: bigint;
+};
+
+/**
+ * Describes the message holos.console.v1.CreateAccessRequestRequest.
+ * Use `create(CreateAccessRequestRequestSchema)` to create a new message.
+ */
+export declare const CreateAccessRequestRequestSchema: GenMessage<CreateAccessRequestRequest>;
+
+/**
+ * CreateAccessRequestResponse contains the filed request.
+ *
+ * @generated from message holos.console.v1.CreateAccessRequestResponse
+ */
+export declare type CreateAccessRequestResponse = Message<"holos.console.v1.CreateAccessRequestResponse"> & {
+  /**
+   * @generated from field: holos.console.v1.AccessRequest access_request = 1;
+   */
+  accessRequest?: AccessRequest;
+};
+
+/**
+ * Describes the message holos.console.v1.CreateAccessRequestResponse.
+ * Use `create(CreateAccessRequestResponseSchema)` to create a new message.
+ */
+export declare const CreateAccessRequestResponseSchema: GenMessage<CreateAccessRequestResponse>;
+
+/**
+ * ListAccessRequestsRequest selects the requests to list.
+ *
+ * @generated from message holos.console.v1.ListAccessRequestsRequest
+ */
+export declare type ListAccessRequestsRequest = Message<"holos.console.v1.ListAccessRequestsRequest"> & {
+  /**
+   * project is the project whose requests to list.
+   *
+   * @generated from field: string project = 1;
+   */
+  project: string;
+
+  /**
+   * state restricts results to requests in this state. Unspecified matches
+   * every state.
+   *
+   * @generated from field: holos.console.v1.AccessRequestState state = 2;
+   */
+  state: AccessRequestState;
+};
+
+/**
+ * Describes the message holos.console.v1.ListAccessRequestsRequest.
+ * Use `create(ListAccessRequestsRequestSchema)` to create a new message.
+ */
+export declare const ListAccessRequestsRequestSchema: GenMessage<ListAccessRequestsRequest>;
+
+/**
+ * ListAccessRequestsResponse contains the matching requests, newest first.
+ *
+ * @generated from message holos.console.v1.ListAccessRequestsResponse
+ */
+export declare type ListAccessRequestsResponse = Message<"holos.console.v1.ListAccessRequestsResponse"> & {
+  /**
+   * @generated from field: repeated holos.console.v1.AccessRequest access_requests = 1;
+   */
+  accessRequests: AccessRequest[];
+};
+
+/**
+ * Describes the message holos.console.v1.ListAccessRequestsResponse.
+ * Use `create(ListAccessRequestsResponseSchema)` to create a new message.
+ */
+export declare const ListAccessRequestsResponseSchema: GenMessage<ListAccessRequestsResponse>;
+
+/**
+ * ApproveAccessRequestRequest approves a pending request.
+ *
+ * @generated from message holos.console.v1.ApproveAccessRequestRequest
+ */
+export declare type ApproveAccessRequestRequest = Message<"holos.console.v1.ApproveAccessRequestRequest"> & {
+  /**
+   * project is the project the request was filed in.
+   *
+   * @generated from field: string project = 1;
+   */
+  project: string;
+
+  /**
+   * name identifies the request.
+   *
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * reason is an optional note recorded with the decision.
+   *
+   * @generated from field: string reason = 3;
+   */
+  reason: string;
+};
+
+/**
+ * Describes the message holos.console.v1.ApproveAccessRequestRequest.
+ * Use `create(ApproveAccessRequestRequestSchema)` to create a new message.
+ */
+export declare const ApproveAccessRequestRequestSchema: GenMessage<ApproveAccessRequestRequest>;
+
+/**
+ * ApproveAccessRequestResponse contains the approved request.
+ *
+ * @generated from message holos.console.v1.ApproveAccessRequestResponse
+ */
+export declare type ApproveAccessRequestResponse = Message<"holos.console.v1.ApproveAccessRequestResponse"> & {
+  /**
+   * @generated from field: holos.console.v1.AccessRequest access_request = 1;
+   */
+  accessRequest?: AccessRequest;
+};
+
+/**
+ * Describes the message holos.console.v1.ApproveAccessRequestResponse.
+ * Use `create(ApproveAccessRequestResponseSchema)` to create a new message.
+ */
+export declare const ApproveAccessRequestResponseSchema: GenMessage<ApproveAccessRequestResponse>;
+
+/**
+ * DenyAccessRequestRequest denies a pending request.
+ *
+ * @generated from message holos.console.v1.DenyAccessRequestRequest
+ */
+export declare type DenyAccessRequestRequest = Message<"holos.console.v1.DenyAccessRequestRequest"> & {
+  /**
+   * project is the project the request was filed in.
+   *
+   * @generated from field: string project = 1;
+   */
+  project: string;
+
+  /**
+   * name identifies the request.
+   *
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * reason is an optional note recorded with the decision.
+   *
+   * @generated from field: string reason = 3;
+   */
+  reason: string;
+};
+
+/**
+ * Describes the message holos.console.v1.DenyAccessRequestRequest.
+ * Use `create(DenyAccessRequestRequestSchema)` to create a new message.
+ */
+export declare const DenyAccessRequestRequestSchema: GenMessage<DenyAccessRequestRequest>;
+
+/**
+ * DenyAccessRequestResponse contains the denied request.
+ *
+ * @generated from message holos.console.v1.DenyAccessRequestResponse
+ */
+export declare type DenyAccessRequestResponse = Message<"holos.console.v1.DenyAccessRequestResponse"> & {
+  /**
+   * @generated from field: holos.console.v1.AccessRequest access_request = 1;
+   */
+  accessRequest?: AccessRequest;
+};
+
+/**
+ * Describes the message holos.console.v1.DenyAccessRequestResponse.
+ * Use `create(DenyAccessRequestResponseSchema)` to create a new message.
+ */
+export declare const DenyAccessRequestResponseSchema: GenMessage<DenyAccessRequestResponse>;
+
+/**
+ * AccessRequestState is the lifecycle state of an access request.
+ *
+ * @generated from enum holos.console.v1.AccessRequestState
+ */
+export enum AccessRequestState {
+  /**
+   * ACCESS_REQUEST_STATE_UNSPECIFIED is the zero value and is never stored.
+   *
+   * @generated from enum value: ACCESS_REQUEST_STATE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * ACCESS_REQUEST_STATE_PENDING awaits an owner decision.
+   *
+   * @generated from enum value: ACCESS_REQUEST_STATE_PENDING = 1;
+   */
+  PENDING = 1,
+
+  /**
+   * ACCESS_REQUEST_STATE_APPROVED installed a time-limited grant.
+   *
+   * @generated from enum value: ACCESS_REQUEST_STATE_APPROVED = 2;
+   */
+  APPROVED = 2,
+
+  /**
+   * ACCESS_REQUEST_STATE_DENIED was rejected by an owner.
+   *
+   * @generated from enum value: ACCESS_REQUEST_STATE_DENIED = 3;
+   */
+  DENIED = 3,
+}
+
+/**
+ * Describes the enum holos.console.v1.AccessRequestState.
+ */
+export declare const AccessRequestStateSchema: GenEnum<AccessRequestState>;
+
+/**
+ * AccessRequestService implements time-boxed break-glass access. A user who
+ * lacks access to a project or secret files a request for a role with a
+ * justification; an owner of the project approves or denies it. Approval
+ * installs a share grant bounded by nbf/exp, so access lapses on its own
+ * when the requested duration elapses.
+ *
+ * Requests are stored in the project namespace by the console service
+ * account. Every transition emits an audit event (access_request_create,
+ * access_request_approve, access_request_deny).
+ *
+ * @generated from service holos.console.v1.AccessRequestService
+ */
+export declare const AccessRequestService: GenService<{
+  /**
+   * CreateAccessRequest files a pending request on behalf of the caller.
+   * Any authenticated user may file a request.
+   *
+   * @generated from rpc holos.console.v1.AccessRequestService.CreateAccessRequest
+   */
+  createAccessRequest: {
+    methodKind: "unary";
+    input: typeof CreateAccessRequestRequestSchema;
+    output: typeof CreateAccessRequestResponseSchema;
+  },
+  /**
+   * ListAccessRequests returns the requests filed in a project, newest
+   * first. Project owners see every request; other callers see only their
+   * own.
+   *
+   * @generated from rpc holos.console.v1.AccessRequestService.ListAccessRequests
+   */
+  listAccessRequests: {
+    methodKind: "unary";
+    input: typeof ListAccessRequestsRequestSchema;
+    output: typeof ListAccessRequestsResponseSchema;
+  },
+  /**
+   * ApproveAccessRequest grants the requested role until the requested
+   * duration elapses. Requires owner access to the project, checked as the
+   * "delete" verb on the project namespace.
+   *
+   * @generated from rpc holos.console.v1.AccessRequestService.ApproveAccessRequest
+   */
+  approveAccessRequest: {
+    methodKind: "unary";
+    input: typeof ApproveAccessRequestRequestSchema;
+    output: typeof ApproveAccessRequestResponseSchema;
+  },
+  /**
+   * DenyAccessRequest rejects a pending request. Requires owner access to
+   * the project.
+   *
+   * @generated from rpc holos.console.v1.AccessRequestService.DenyAccessRequest
+   */
+  denyAccessRequest: {
+    methodKind: "unary";
+    input: typeof DenyAccessRequestRequestSchema;
+    output: typeof DenyAccessRequestResponseSchema;
+  },
+}>;
+
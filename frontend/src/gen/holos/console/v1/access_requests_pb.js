@@ -0,0 +1,107 @@
+// @generated by protoc-gen-es v2.11.0
+// @generated from file holos/console/v1/access_requests.proto (package holos.console.v1, syntax proto3)
+/* eslint-disable */
+
+import { enumDesc, fileDesc, messageDesc, serviceDesc, tsEnum } from "@bufbuild/protobuf/codegenv2";
+import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import { file_holos_console_v1_rbac } from "./rbac_pb";
+
+/**
+ * Describes the file holos/console/v1/access_requests.proto.
+ */
+export const file_holos_console_v1_access_requests = /*@__PURE__*/
+  fileDesc("CiZob2xvcy9jb25zb2xlL3YxL2FjY2Vzc19yZXF1ZXN0cy5wcm90bxIQaG9sb3MuY29uc29sZS52MSLQAwoNQWNjZXNzUmVxdWVzdBIMCgRuYW1lGAEgASgJEg8KB3Byb2plY3QYAiABKAkSFQoNcmVzb3VyY2VfdHlwZRgDIAEoCRIVCg1yZXNvdXJjZV9uYW1lGAQgASgJEiQKBHJvbGUYBSABKA4yFi5ob2xvcy5jb25zb2xlLnYxLlJvbGUSFQoNanVzdGlmaWNhdGlvbhgGIAEoCRIYChBkdXJhdGlvbl9zZWNvbmRzGAcgASgDEhcKD3JlcXVlc3Rlcl9lbWFpbBgIIAEoCRIVCg1yZXF1ZXN0ZXJfc3ViGAkgASgJEjMKBXN0YXRlGAogASgOMiQuaG9sb3MuY29uc29sZS52MS5BY2Nlc3NSZXF1ZXN0U3RhdGUSLgoKY3JlYXRlZF9hdBgLIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASEgoKZGVjaWRlZF9ieRgMIAEoCRIuCgpkZWNpZGVkX2F0GA0gASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIOCgZyZWFzb24YDiABKAkSEAoDbmJmGA8gASgDSACIAQESEAoDZXhwGBAgASgDSAGIAQFCBgoEX25iZkIGCgRfZXhwIrIBChpDcmVhdGVBY2Nlc3NSZXF1ZXN0UmVxdWVzdBIPCgdwcm9qZWN0GAEgASgJEhUKDXJlc291cmNlX3R5cGUYAiABKAkSFQoNcmVzb3VyY2VfbmFtZRgDIAEoCRIkCgRyb2xlGAQgASgOMhYuaG9sb3MuY29uc29sZS52MS5Sb2xlEhUKDWp1c3RpZmljYXRpb24YBSABKAkSGAoQZHVyYXRpb25fc2Vjb25kcxgGIAEoAyJWChtDcmVhdGVBY2Nlc3NSZXF1ZXN0UmVzcG9uc2USNwoOYWNjZXNzX3JlcXVlc3QYASABKAsyHy5ob2xvcy5jb25zb2xlLnYxLkFjY2Vzc1JlcXVlc3QiYQoZTGlzdEFjY2Vzc1JlcXVlc3RzUmVxdWVzdBIPCgdwcm9qZWN0GAEgASgJEjMKBXN0YXRlGAIgASgOMiQuaG9sb3MuY29uc29sZS52MS5BY2Nlc3NSZXF1ZXN0U3RhdGUiVgoaTGlzdEFjY2Vzc1JlcXVlc3RzUmVzcG9uc2USOAoPYWNjZXNzX3JlcXVlc3RzGAEgAygLMh8uaG9sb3MuY29uc29sZS52MS5BY2Nlc3NSZXF1ZXN0IkwKG0FwcHJvdmVBY2Nlc3NSZXF1ZXN0UmVxdWVzdBIPCgdwcm9qZWN0GAEgASgJEgwKBG5hbWUYAiABKAkSDgoGcmVhc29uGAMgASgJIlcKHEFwcHJvdmVBY2Nlc3NSZXF1ZXN0UmVzcG9uc2USNwoOYWNjZXNzX3JlcXVlc3QYASABKAsyHy5ob2xvcy5jb25zb2xlLnYxLkFjY2Vzc1JlcXVlc3QiSQoYRGVueUFjY2Vzc1JlcXVlc3RSZXF1ZXN0Eg8KB3Byb2plY3QYASABKAkSDAoEbmFtZRgCIAEoCRIOCgZyZWFzb24YAyABKAkiVAoZRGVueUFjY2Vzc1JlcXVlc3RSZXNwb25zZRI3Cg5hY2Nlc3NfcmVxdWVzdBgBIAEoCzIfLmhvbG9zLmNvbnNvbGUudjEuQWNjZXNzUmVxdWVzdCqgAQoSQWNjZXNzUmVxdWVzdFN0YXRlEiQKIEFDQ0VTU19SRVFVRVNUX1NUQVRFX1VOU1BFQ0lGSUVEEAASIAocQUNDRVNTX1JFUVVFU1RfU1RBVEVfUEVORElORxABEiEKHUFDQ0VTU19SRVFVRVNUX1NUQVRFX0FQUFJPVkVEEAISHwobQUNDRVNTX1JFUVVFU1RfU1RBVEVfREVOSUVEEAMy5QMKFEFjY2Vzc1JlcXVlc3RTZXJ2aWNlEnIKE0NyZWF0ZUFjY2Vzc1JlcXVlc3QSLC5ob2xvcy5jb25zb2xlLnYxLkNyZWF0ZUFjY2Vzc1JlcXVlc3RSZXF1ZXN0Gi0uaG9sb3MuY29uc29sZS52MS5DcmVhdGVBY2Nlc3NSZXF1ZXN0UmVzcG9uc2USdAoSTGlzdEFjY2Vzc1JlcXVlc3RzEisuaG9sb3MuY29uc29sZS52MS5MaXN0QWNjZXNzUmVxdWVzdHNSZXF1ZXN0GiwuaG9sb3MuY29uc29sZS52MS5MaXN0QWNjZXNzUmVxdWVzdHNSZXNwb25zZSIDkAIBEnUKFEFwcHJvdmVBY2Nlc3NSZXF1ZXN0Ei0uaG9sb3MuY29uc29sZS52MS5BcHByb3ZlQWNjZXNzUmVxdWVzdFJlcXVlc3QaLi5ob2xvcy5jb25zb2xlLnYxLkFwcHJvdmVBY2Nlc3NSZXF1ZXN0UmVzcG9uc2USbAoRRGVueUFjY2Vzc1JlcXVlc3QSKi5ob2xvcy5jb25zb2xlLnYxLkRlbnlBY2Nlc3NSZXF1ZXN0UmVxdWVzdBorLmhvbG9zLmNvbnNvbGUudjEuRGVueUFjY2Vzc1JlcXVlc3RSZXNwb25zZUJDWkFnaXRodWIuY29tL2hvbG9zLXJ1bi9ob2xvcy1jb25zb2xlL2dlbi9ob2xvcy9jb25zb2xlL3YxO2NvbnNvbGV2MWIGcHJvdG8z", [file_google_protobuf_timestamp, file_holos_console_v1_rbac]);
+
+/**
+ * Describes the message holos.console.v1.AccessRequest.
+ * Use `create(AccessRequestSchema)` to create a new message.
+ */
+export const AccessRequestSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_access_requests, 0);
+
+/**
+ * Describes the message holos.console.v1.CreateAccessRequestRequest.
+ * Use `create(CreateAccessRequestRequestSchema)` to create a new message.
+ */
+export const CreateAccessRequestRequestSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_access_requests, 1);
+
+/**
+ * Describes the message holos.console.v1.CreateAccessRequestResponse.
+ * Use `create(CreateAccessRequestResponseSchema)` to create a new message.
+ */
+export const CreateAccessRequestResponseSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_access_requests, 2);
+
+/**
+ * Describes the message holos.console.v1.ListAccessRequestsRequest.
+ * Use `create(ListAccessRequestsRequestSchema)` to create a new message.
+ */
+export const ListAccessRequestsRequestSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_access_requests, 3);
+
+/**
+ * Describes the message holos.console.v1.ListAccessRequestsResponse.
+ * Use `create(ListAccessRequestsResponseSchema)` to create a new message.
+ */
+export const ListAccessRequestsResponseSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_access_requests, 4);
+
+/**
+ * Describes the message holos.console.v1.ApproveAccessRequestRequest.
+ * Use `create(ApproveAccessRequestRequestSchema)` to create a new message.
+ */
+export const ApproveAccessRequestRequestSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_access_requests, 5);
+
+/**
+ * Describes the message holos.console.v1.ApproveAccessRequestResponse.
+ * Use `create(ApproveAccessRequestResponseSchema)` to create a new message.
+ */
+export const ApproveAccessRequestResponseSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_access_requests, 6);
+
+/**
+ * Describes the message holos.console.v1.DenyAccessRequestRequest.
+ * Use `create(DenyAccessRequestRequestSchema)` to create a new message.
+ */
+export const DenyAccessRequestRequestSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_access_requests, 7);
+
+/**
+ * Describes the message holos.console.v1.DenyAccessRequestResponse.
+ * Use `create(DenyAccessRequestResponseSchema)` to create a new message.
+ */
+export const DenyAccessRequestResponseSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_access_requests, 8);
+
+/**
+ * Describes the enum holos.console.v1.AccessRequestState.
+ */
+export const AccessRequestStateSchema = /*@__PURE__*/
+  enumDesc(file_holos_console_v1_access_requests, 0);
+
+/**
+ * AccessRequestState is the lifecycle state of an access request.
+ *
+ * @generated from enum holos.console.v1.AccessRequestState
+ */
+export const AccessRequestState = /*@__PURE__*/
+  tsEnum(AccessRequestStateSchema);
+
+/**
+ * AccessRequestService implements time-boxed break-glass access. A user who
+ * lacks access to a project or secret files a request for a role with a
+ * justification; an owner of the project approves or denies it. Approval
+ * installs a share grant bounded by nbf/exp, so access lapses on its own
+ * when the requested duration elapses.
+ *
+ * Requests are stored in the project namespace by the console service
+ * account. Every transition emits an audit event (access_request_create,
+ * access_request_approve, access_request_deny).
+ *
+ * @generated from service holos.console.v1.AccessRequestService
+ */
+export const AccessRequestService = /*@__PURE__*/
+  serviceDesc(file_holos_console_v1_access_requests, 0);
+
@@ -0,0 +1,240 @@
+// @generated by protoc-gen-es v2.11.0
+// @generated from file holos/console/v1/audit.proto (package holos.console.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import type { Message } from "@bufbuild/protobuf";
+import type { Timestamp } from "@bufbuild/protobuf/wkt";
+
+/**
+ * Describes the file holos/console/v1/audit.proto.
+ */
+export declare const file_holos_console_v1_audit: GenFile;
+
+/**
+ * AuditEvent is a single structured audit log record.
+ *
+ * @generated from message holos.console.v1.AuditEvent
+ */
+export declare type AuditEvent = Message<"holos.console.v1.AuditEvent"> & {
+  /**
+   * time is when the event was recorded.
+   *
+   * @generated from field: google.protobuf.Timestamp time = 1;
+   */
+  time?: Timestamp;
+
+  /**
+   * action is the audit action, e.g. "secret_access" or "secret_delete".
+   *
+   * @generated from field: string action = 2;
+   */
+  action: string;
+
+  /**
+   * resource_type is the kind of resource acted on, e.g. "secret".
+   *
+   * @generated from field: string resource_type = 3;
+   */
+  resourceType: string;
+
+  /**
+   * resource_name is the name of the resource acted on, when known.
+   *
+   * @generated from field: string resource_name = 4;
+   */
+  resourceName: string;
+
+  /**
+   * project is the project the resource belongs to, when known.
+   *
+   * @generated from field: string project = 5;
+   */
+  project: string;
+
+  /**
+   * sub is the OIDC subject of the principal that performed the action.
+   *
+   * @generated from field: string sub = 6;
+   */
+  sub: string;
+
+  /**
+   * email is the email address of the principal that performed the action.
+   *
+   * @generated from field: string email = 7;
+   */
+  email: string;
+
+  /**
+   * message is the human-readable log message.
+   *
+   * @generated from field: string message = 8;
+   */
+  message: string;
+
+  /**
+   * attributes carries every other attribute attached to the log record,
+   * rendered as strings.
+   *
+   * @generated from field: map<string, string> attributes = 9;
+   */
+  attributes: { [key: string]: string };
+
+  /**
+   * impersonator_sub is the OIDC subject of the real principal when the
+   * action was performed while acting as sub with X-Impersonate-User.
+   *
+   * @generated from field: string impersonator_sub = 10;
+   */
+  impersonatorSub: string;
+
+  /**
+   * impersonator_email is the email address of the real principal when the
+   * action was performed while impersonating.
+   *
+   * @generated from field: string impersonator_email = 11;
+   */
+  impersonatorEmail: string;
+
+  /**
+   * cluster is the registered cluster the action targeted, empty for the
+   * cluster the console runs in.
+   *
+   * @generated from field: string cluster = 12;
+   */
+  cluster: string;
+
+  /**
+   * request_id identifies the request that produced the event. It matches
+   * the X-Request-Id response header and the request_id of the access log
+   * line for the request.
+   *
+   * @generated from field: string request_id = 13;
+   */
+  requestId: string;
+};
+
+/**
+ * Describes the message holos.console.v1.AuditEvent.
+ * Use `create(AuditEventSchema)` to create a new message.
+ */
+export declare const AuditEventSchema: GenMessage<AuditEvent>;
+
+/**
+ * ListAuditEventsRequest contains optional filters for listing audit events.
+ * Unset filters match every event.
+ *
+ * @generated from message holos.console.v1.ListAuditEventsRequest
+ */
+export declare type ListAuditEventsRequest = Message<"holos.console.v1.ListAuditEventsRequest"> & {
+  /**
+   * project restricts results to events recorded for this project.
+   *
+   * @generated from field: string project = 1;
+   */
+  project: string;
+
+  /**
+   * resource_type restricts results to events for this resource type.
+   *
+   * @generated from field: string resource_type = 2;
+   */
+  resourceType: string;
+
+  /**
+   * action restricts results to events with this action.
+   *
+   * @generated from field: string action = 3;
+   */
+  action: string;
+
+  /**
+   * principal restricts results to events performed by this principal. The
+   * value is matched case-insensitively against sub, email, and the
+   * impersonator fields, so an admin's act-as activity is included.
+   *
+   * @generated from field: string principal = 4;
+   */
+  principal: string;
+
+  /**
+   * start_time restricts results to events recorded at or after this time.
+   *
+   * @generated from field: google.protobuf.Timestamp start_time = 5;
+   */
+  startTime?: Timestamp;
+
+  /**
+   * end_time restricts results to events recorded before this time.
+   *
+   * @generated from field: google.protobuf.Timestamp end_time = 6;
+   */
+  endTime?: Timestamp;
+
+  /**
+   * limit caps the number of events returned. Zero selects the server
+   * default of 100.
+   *
+   * @generated from field: int32 limit = 7;
+   */
+  limit: number;
+};
+
+/**
+ * Describes the message holos.console.v1.ListAuditEventsRequest.
+ * Use `create(ListAuditEventsRequestSchema)` to create a new message.
+ */
+export declare const ListAuditEventsRequestSchema: GenMessage<ListAuditEventsRequest>;
+
+/**
+ * ListAuditEventsResponse contains matching audit events, newest first.
+ *
+ * @generated from message holos.console.v1.ListAuditEventsResponse
+ */
+export declare type ListAuditEventsResponse = Message<"holos.console.v1.ListAuditEventsResponse"> & {
+  /**
+   * events contains the matching audit events.
+   *
+   * @generated from field: repeated holos.console.v1.AuditEvent events = 1;
+   */
+  events: AuditEvent[];
+};
+
+/**
+ * Describes the message holos.console.v1.ListAuditEventsResponse.
+ * Use `create(ListAuditEventsResponseSchema)` to create a new message.
+ */
+export declare const ListAuditEventsResponseSchema: GenMessage<ListAuditEventsResponse>;
+
+/**
+ * AuditService exposes the structured audit events the console records while
+ * serving RPCs (secret_access, secret_create, sharing_update, ...) so admins
+ * can review who accessed which resource without grepping pod logs.
+ *
+ * Events are held in a bounded in-memory ring buffer owned by each server
+ * replica. The buffer is a convenience view of recent activity, not a durable
+ * audit store: restarts clear it and each replica only reports the requests
+ * it served. Ship the JSON logs to a log pipeline for long-term retention.
+ *
+ * @generated from service holos.console.v1.AuditService
+ */
+export declare const AuditService: GenService<{
+  /**
+   * ListAuditEvents returns recent audit events, newest first, matching every
+   * filter set on the request.
+   *
+   * Access is arbitrated by the Kubernetes API server (ADR 036): the caller
+   * must be allowed to "list" the virtual resource
+   * auditevents.console.holos.run in the project namespace when project is
+   * set, or cluster-wide when it is not.
+   *
+   * @generated from rpc holos.console.v1.AuditService.ListAuditEvents
+   */
+  listAuditEvents: {
+    methodKind: "unary";
+    input: typeof ListAuditEventsRequestSchema;
+    output: typeof ListAuditEventsResponseSchema;
+  },
+}>;
+
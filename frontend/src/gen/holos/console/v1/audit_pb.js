@@ -0,0 +1,49 @@
+// @generated by protoc-gen-es v2.11.0
+// @generated from file holos/console/v1/audit.proto (package holos.console.v1, syntax proto3)
+/* eslint-disable */
+
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+
+/**
+ * Describes the file holos/console/v1/audit.proto.
+ */
+export const file_holos_console_v1_audit = /*@__PURE__*/
+  fileDesc("Chxob2xvcy9jb25zb2xlL3YxL2F1ZGl0LnByb3RvEhBob2xvcy5jb25zb2xlLnYxIoIDCgpBdWRpdEV2ZW50EigKBHRpbWUYASABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEg4KBmFjdGlvbhgCIAEoCRIVCg1yZXNvdXJjZV90eXBlGAMgASgJEhUKDXJlc291cmNlX25hbWUYBCABKAkSDwoHcHJvamVjdBgFIAEoCRILCgNzdWIYBiABKAkSDQoFZW1haWwYByABKAkSDwoHbWVzc2FnZRgIIAEoCRJACgphdHRyaWJ1dGVzGAkgAygLMiwuaG9sb3MuY29uc29sZS52MS5BdWRpdEV2ZW50LkF0dHJpYnV0ZXNFbnRyeRIYChBpbXBlcnNvbmF0b3Jfc3ViGAogASgJEhoKEmltcGVyc29uYXRvcl9lbWFpbBgLIAEoCRIPCgdjbHVzdGVyGAwgASgJEhIKCnJlcXVlc3RfaWQYDSABKAkaMQoPQXR0cmlidXRlc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEi0AEKFkxpc3RBdWRpdEV2ZW50c1JlcXVlc3QSDwoHcHJvamVjdBgBIAEoCRIVCg1yZXNvdXJjZV90eXBlGAIgASgJEg4KBmFjdGlvbhgDIAEoCRIRCglwcmluY2lwYWwYBCABKAkSLgoKc3RhcnRfdGltZRgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLAoIZW5kX3RpbWUYBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEg0KBWxpbWl0GAcgASgFIkcKF0xpc3RBdWRpdEV2ZW50c1Jlc3BvbnNlEiwKBmV2ZW50cxgBIAMoCzIcLmhvbG9zLmNvbnNvbGUudjEuQXVkaXRFdmVudDJ7CgxBdWRpdFNlcnZpY2USawoPTGlzdEF1ZGl0RXZlbnRzEiguaG9sb3MuY29uc29sZS52MS5MaXN0QXVkaXRFdmVudHNSZXF1ZXN0GikuaG9sb3MuY29uc29sZS52MS5MaXN0QXVkaXRFdmVudHNSZXNwb25zZSIDkAIBQkNaQWdpdGh1Yi5jb20vaG9sb3MtcnVuL2hvbG9zLWNvbnNvbGUvZ2VuL2hvbG9zL2NvbnNvbGUvdjE7Y29uc29sZXYxYgZwcm90bzM", [file_google_protobuf_timestamp]);
+
+/**
+ * Describes the message holos.console.v1.AuditEvent.
+ * Use `create(AuditEventSchema)` to create a new message.
+ */
+export const AuditEventSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_audit, 0);
+
+/**
+ * Describes the message holos.console.v1.ListAuditEventsRequest.
+ * Use `create(ListAuditEventsRequestSchema)` to create a new message.
+ */
+export const ListAuditEventsRequestSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_audit, 1);
+
+/**
+ * Describes the message holos.console.v1.ListAuditEventsResponse.
+ * Use `create(ListAuditEventsResponseSchema)` to create a new message.
+ */
+export const ListAuditEventsResponseSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_audit, 2);
+
+/**
+ * AuditService exposes the structured audit events the console records while
+ * serving RPCs (secret_access, secret_create, sharing_update, ...) so admins
+ * can review who accessed which resource without grepping pod logs.
+ *
+ * Events are held in a bounded in-memory ring buffer owned by each server
+ * replica. The buffer is a convenience view of recent activity, not a durable
+ * audit store: restarts clear it and each replica only reports the requests
+ * it served. Ship the JSON logs to a log pipeline for long-term retention.
+ *
+ * @generated from service holos.console.v1.AuditService
+ */
+export const AuditService = /*@__PURE__*/
+  serviceDesc(file_holos_console_v1_audit, 0);
+
@@ -0,0 +1,302 @@
+// @generated by protoc-gen-es v2.11.0
+// @generated from file holos/console/v1/dashboard.proto (package holos.console.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import type { Message } from "@bufbuild/protobuf";
+import type { Role, RoleSource } from "./rbac_pb";
+import type { PrincipalKind } from "./permissions_pb";
+
+/**
+ * Describes the file holos/console/v1/dashboard.proto.
+ */
+export declare const file_holos_console_v1_dashboard: GenFile;
+
+/**
+ * AccessibleResource is one resource the caller can access.
+ *
+ * @generated from message holos.console.v1.AccessibleResource
+ */
+export declare type AccessibleResource = Message<"holos.console.v1.AccessibleResource"> & {
+  /**
+   * @generated from field: holos.console.v1.AccessibleResourceType type = 1;
+   */
+  type: AccessibleResourceType;
+
+  /**
+   * name is the resource name: the organization, folder, project, or secret
+   * name.
+   *
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * display_name is the human-readable name, empty for secrets.
+   *
+   * @generated from field: string display_name = 3;
+   */
+  displayName: string;
+
+  /**
+   * organization is the organization the resource belongs to. For
+   * organizations it equals name.
+   *
+   * @generated from field: string organization = 4;
+   */
+  organization: string;
+
+  /**
+   * project is the project a secret belongs to, or name for projects.
+   * Empty for organizations.
+   *
+   * @generated from field: string project = 5;
+   */
+  project: string;
+
+  /**
+   * role is the caller's effective role on the resource.
+   *
+   * @generated from field: holos.console.v1.Role role = 6;
+   */
+  role: Role;
+};
+
+/**
+ * Describes the message holos.console.v1.AccessibleResource.
+ * Use `create(AccessibleResourceSchema)` to create a new message.
+ */
+export declare const AccessibleResourceSchema: GenMessage<AccessibleResource>;
+
+/**
+ * ListAccessibleResourcesRequest lists the caller's accessible resources.
+ *
+ * @generated from message holos.console.v1.ListAccessibleResourcesRequest
+ */
+export declare type ListAccessibleResourcesRequest = Message<"holos.console.v1.ListAccessibleResourcesRequest"> & {
+  /**
+   * types limits the response to the given resource types. Empty returns
+   * every type.
+   *
+   * @generated from field: repeated holos.console.v1.AccessibleResourceType types = 1;
+   */
+  types: AccessibleResourceType[];
+};
+
+/**
+ * Describes the message holos.console.v1.ListAccessibleResourcesRequest.
+ * Use `create(ListAccessibleResourcesRequestSchema)` to create a new message.
+ */
+export declare const ListAccessibleResourcesRequestSchema: GenMessage<ListAccessibleResourcesRequest>;
+
+/**
+ * ListAccessibleResourcesResponse lists organizations first, then projects,
+ * then secrets, each sorted by organization, project, and name.
+ *
+ * @generated from message holos.console.v1.ListAccessibleResourcesResponse
+ */
+export declare type ListAccessibleResourcesResponse = Message<"holos.console.v1.ListAccessibleResourcesResponse"> & {
+  /**
+   * @generated from field: repeated holos.console.v1.AccessibleResource resources = 1;
+   */
+  resources: AccessibleResource[];
+};
+
+/**
+ * Describes the message holos.console.v1.ListAccessibleResourcesResponse.
+ * Use `create(ListAccessibleResourcesResponseSchema)` to create a new message.
+ */
+export declare const ListAccessibleResourcesResponseSchema: GenMessage<ListAccessibleResourcesResponse>;
+
+/**
+ * GetPrincipalAccessReportRequest names the principal to report on.
+ *
+ * @generated from message holos.console.v1.GetPrincipalAccessReportRequest
+ */
+export declare type GetPrincipalAccessReportRequest = Message<"holos.console.v1.GetPrincipalAccessReportRequest"> & {
+  /**
+   * principal is the user's email address or the group name. An "oidc:"
+   * prefix is accepted and ignored.
+   *
+   * @generated from field: string principal = 1;
+   */
+  principal: string;
+
+  /**
+   * kind is PRINCIPAL_KIND_USER or PRINCIPAL_KIND_GROUP.
+   *
+   * @generated from field: holos.console.v1.PrincipalKind kind = 2;
+   */
+  kind: PrincipalKind;
+
+  /**
+   * groups are the groups a user belongs to, so grants naming them are
+   * included. The console does not store group membership; leave empty to
+   * report only the grants naming the user. Ignored for groups.
+   *
+   * @generated from field: repeated string groups = 3;
+   */
+  groups: string[];
+
+  /**
+   * subject is the user's OIDC subject, matching secret sharing bindings
+   * that name the subject rather than the email address. Optional; ignored
+   * for groups.
+   *
+   * @generated from field: string subject = 4;
+   */
+  subject: string;
+};
+
+/**
+ * Describes the message holos.console.v1.GetPrincipalAccessReportRequest.
+ * Use `create(GetPrincipalAccessReportRequestSchema)` to create a new message.
+ */
+export declare const GetPrincipalAccessReportRequestSchema: GenMessage<GetPrincipalAccessReportRequest>;
+
+/**
+ * PrincipalAccess is one resource the principal holds a role on.
+ *
+ * @generated from message holos.console.v1.PrincipalAccess
+ */
+export declare type PrincipalAccess = Message<"holos.console.v1.PrincipalAccess"> & {
+  /**
+   * resource is the resource and the principal's role on it. Deny grants
+   * win, so denied resources are not reported.
+   *
+   * @generated from field: holos.console.v1.AccessibleResource resource = 1;
+   */
+  resource?: AccessibleResource;
+
+  /**
+   * sources lists every active grant naming the principal, its email
+   * domain, every user, or one of its groups on the resource. A source on
+   * the project of a secret is the project's secret sharing; a source on
+   * the secret itself is a key grant.
+   *
+   * @generated from field: repeated holos.console.v1.RoleSource sources = 2;
+   */
+  sources: RoleSource[];
+
+  /**
+   * keys lists the secret data keys the principal may read when its access
+   * to a secret comes from key grants alone. Empty when the access covers
+   * the whole resource.
+   *
+   * @generated from field: repeated string keys = 3;
+   */
+  keys: string[];
+};
+
+/**
+ * Describes the message holos.console.v1.PrincipalAccess.
+ * Use `create(PrincipalAccessSchema)` to create a new message.
+ */
+export declare const PrincipalAccessSchema: GenMessage<PrincipalAccess>;
+
+/**
+ * GetPrincipalAccessReportResponse lists organizations first, then folders,
+ * projects, and secrets, each sorted by organization, project, and name.
+ *
+ * @generated from message holos.console.v1.GetPrincipalAccessReportResponse
+ */
+export declare type GetPrincipalAccessReportResponse = Message<"holos.console.v1.GetPrincipalAccessReportResponse"> & {
+  /**
+   * @generated from field: repeated holos.console.v1.PrincipalAccess access = 1;
+   */
+  access: PrincipalAccess[];
+};
+
+/**
+ * Describes the message holos.console.v1.GetPrincipalAccessReportResponse.
+ * Use `create(GetPrincipalAccessReportResponseSchema)` to create a new message.
+ */
+export declare const GetPrincipalAccessReportResponseSchema: GenMessage<GetPrincipalAccessReportResponse>;
+
+/**
+ * AccessibleResourceType is the kind of an AccessibleResource.
+ *
+ * @generated from enum holos.console.v1.AccessibleResourceType
+ */
+export enum AccessibleResourceType {
+  /**
+   * @generated from enum value: ACCESSIBLE_RESOURCE_TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: ACCESSIBLE_RESOURCE_TYPE_ORGANIZATION = 1;
+   */
+  ORGANIZATION = 1,
+
+  /**
+   * @generated from enum value: ACCESSIBLE_RESOURCE_TYPE_PROJECT = 2;
+   */
+  PROJECT = 2,
+
+  /**
+   * @generated from enum value: ACCESSIBLE_RESOURCE_TYPE_SECRET = 3;
+   */
+  SECRET = 3,
+
+  /**
+   * ACCESSIBLE_RESOURCE_TYPE_FOLDER is reported by GetPrincipalAccessReport
+   * only.
+   *
+   * @generated from enum value: ACCESSIBLE_RESOURCE_TYPE_FOLDER = 4;
+   */
+  FOLDER = 4,
+}
+
+/**
+ * Describes the enum holos.console.v1.AccessibleResourceType.
+ */
+export declare const AccessibleResourceTypeSchema: GenEnum<AccessibleResourceType>;
+
+/**
+ * DashboardService answers cross-project questions for the home dashboard in
+ * a single call, so the UI does not list organizations, then projects per
+ * organization, then secrets per project.
+ *
+ * @generated from service holos.console.v1.DashboardService
+ */
+export declare const DashboardService: GenService<{
+  /**
+   * ListAccessibleResources returns every organization, project, and secret
+   * the caller can access, with the role they hold on each. Organization and
+   * project namespaces are read from the console's informer cache, and the
+   * secrets and their sharing bindings with one list each, so the cost does
+   * not grow with the number of projects.
+   *
+   * Roles come from the share grants on each resource. When the request
+   * carries impersonated clients, a cluster-wide grant on namespaces
+   * confirmed by SelfSubjectAccessReview raises the role on every
+   * organization and project. Deny grants always win.
+   *
+   * @generated from rpc holos.console.v1.DashboardService.ListAccessibleResources
+   */
+  listAccessibleResources: {
+    methodKind: "unary";
+    input: typeof ListAccessibleResourcesRequestSchema;
+    output: typeof ListAccessibleResourcesResponseSchema;
+  },
+  /**
+   * GetPrincipalAccessReport lists every organization, folder, project, and
+   * secret a user or group holds a role on, with the grants conferring it,
+   * for offboarding and access reviews. It reads the same informer cache and
+   * cluster-wide lists as ListAccessibleResources, evaluated for the named
+   * principal instead of the caller.
+   *
+   * Only the console's sharing grants are evaluated: Kubernetes RBAC bound
+   * outside them, such as a platform group's ClusterRoleBinding, is not
+   * reported. Requires the caller to be a platform owner.
+   *
+   * @generated from rpc holos.console.v1.DashboardService.GetPrincipalAccessReport
+   */
+  getPrincipalAccessReport: {
+    methodKind: "unary";
+    input: typeof GetPrincipalAccessReportRequestSchema;
+    output: typeof GetPrincipalAccessReportResponseSchema;
+  },
+}>;
+
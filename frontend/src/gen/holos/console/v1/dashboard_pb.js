@@ -0,0 +1,80 @@
+// @generated by protoc-gen-es v2.11.0
+// @generated from file holos/console/v1/dashboard.proto (package holos.console.v1, syntax proto3)
+/* eslint-disable */
+
+import { enumDesc, fileDesc, messageDesc, serviceDesc, tsEnum } from "@bufbuild/protobuf/codegenv2";
+import { file_holos_console_v1_permissions } from "./permissions_pb";
+import { file_holos_console_v1_rbac } from "./rbac_pb";
+
+/**
+ * Describes the file holos/console/v1/dashboard.proto.
+ */
+export const file_holos_console_v1_dashboard = /*@__PURE__*/
+  fileDesc("CiBob2xvcy9jb25zb2xlL3YxL2Rhc2hib2FyZC5wcm90bxIQaG9sb3MuY29uc29sZS52MSK9AQoSQWNjZXNzaWJsZVJlc291cmNlEjYKBHR5cGUYASABKA4yKC5ob2xvcy5jb25zb2xlLnYxLkFjY2Vzc2libGVSZXNvdXJjZVR5cGUSDAoEbmFtZRgCIAEoCRIUCgxkaXNwbGF5X25hbWUYAyABKAkSFAoMb3JnYW5pemF0aW9uGAQgASgJEg8KB3Byb2plY3QYBSABKAkSJAoEcm9sZRgGIAEoDjIWLmhvbG9zLmNvbnNvbGUudjEuUm9sZSJZCh5MaXN0QWNjZXNzaWJsZVJlc291cmNlc1JlcXVlc3QSNwoFdHlwZXMYASADKA4yKC5ob2xvcy5jb25zb2xlLnYxLkFjY2Vzc2libGVSZXNvdXJjZVR5cGUiWgofTGlzdEFjY2Vzc2libGVSZXNvdXJjZXNSZXNwb25zZRI3CglyZXNvdXJjZXMYASADKAsyJC5ob2xvcy5jb25zb2xlLnYxLkFjY2Vzc2libGVSZXNvdXJjZSKEAQofR2V0UHJpbmNpcGFsQWNjZXNzUmVwb3J0UmVxdWVzdBIRCglwcmluY2lwYWwYASABKAkSLQoEa2luZBgCIAEoDjIfLmhvbG9zLmNvbnNvbGUudjEuUHJpbmNpcGFsS2luZBIOCgZncm91cHMYAyADKAkSDwoHc3ViamVjdBgEIAEoCSKGAQoPUHJpbmNpcGFsQWNjZXNzEjYKCHJlc291cmNlGAEgASgLMiQuaG9sb3MuY29uc29sZS52MS5BY2Nlc3NpYmxlUmVzb3VyY2USLQoHc291cmNlcxgCIAMoCzIcLmhvbG9zLmNvbnNvbGUudjEuUm9sZVNvdXJjZRIMCgRrZXlzGAMgAygJIlUKIEdldFByaW5jaXBhbEFjY2Vzc1JlcG9ydFJlc3BvbnNlEjEKBmFjY2VzcxgBIAMoCzIhLmhvbG9zLmNvbnNvbGUudjEuUHJpbmNpcGFsQWNjZXNzKt0BChZBY2Nlc3NpYmxlUmVzb3VyY2VUeXBlEigKJEFDQ0VTU0lCTEVfUkVTT1VSQ0VfVFlQRV9VTlNQRUNJRklFRBAAEikKJUFDQ0VTU0lCTEVfUkVTT1VSQ0VfVFlQRV9PUkdBTklaQVRJT04QARIkCiBBQ0NFU1NJQkxFX1JFU09VUkNFX1RZUEVfUFJPSkVDVBACEiMKH0FDQ0VTU0lCTEVfUkVTT1VSQ0VfVFlQRV9TRUNSRVQQAxIjCh9BQ0NFU1NJQkxFX1JFU09VUkNFX1RZUEVfRk9MREVSEAQyoQIKEERhc2hib2FyZFNlcnZpY2USgwEKF0xpc3RBY2Nlc3NpYmxlUmVzb3VyY2VzEjAuaG9sb3MuY29uc29sZS52MS5MaXN0QWNjZXNzaWJsZVJlc291cmNlc1JlcXVlc3QaMS5ob2xvcy5jb25zb2xlLnYxLkxpc3RBY2Nlc3NpYmxlUmVzb3VyY2VzUmVzcG9uc2UiA5ACARKGAQoYR2V0UHJpbmNpcGFsQWNjZXNzUmVwb3J0EjEuaG9sb3MuY29uc29sZS52MS5HZXRQcmluY2lwYWxBY2Nlc3NSZXBvcnRSZXF1ZXN0GjIuaG9sb3MuY29uc29sZS52MS5HZXRQcmluY2lwYWxBY2Nlc3NSZXBvcnRSZXNwb25zZSIDkAIBQkNaQWdpdGh1Yi5jb20vaG9sb3MtcnVuL2hvbG9zLWNvbnNvbGUvZ2VuL2hvbG9zL2NvbnNvbGUvdjE7Y29uc29sZXYxYgZwcm90bzM", [file_holos_console_v1_permissions, file_holos_console_v1_rbac]);
+
+/**
+ * Describes the message holos.console.v1.AccessibleResource.
+ * Use `create(AccessibleResourceSchema)` to create a new message.
+ */
+export const AccessibleResourceSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_dashboard, 0);
+
+/**
+ * Describes the message holos.console.v1.ListAccessibleResourcesRequest.
+ * Use `create(ListAccessibleResourcesRequestSchema)` to create a new message.
+ */
+export const ListAccessibleResourcesRequestSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_dashboard, 1);
+
+/**
+ * Describes the message holos.console.v1.ListAccessibleResourcesResponse.
+ * Use `create(ListAccessibleResourcesResponseSchema)` to create a new message.
+ */
+export const ListAccessibleResourcesResponseSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_dashboard, 2);
+
+/**
+ * Describes the message holos.console.v1.GetPrincipalAccessReportRequest.
+ * Use `create(GetPrincipalAccessReportRequestSchema)` to create a new message.
+ */
+export const GetPrincipalAccessReportRequestSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_dashboard, 3);
+
+/**
+ * Describes the message holos.console.v1.PrincipalAccess.
+ * Use `create(PrincipalAccessSchema)` to create a new message.
+ */
+export const PrincipalAccessSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_dashboard, 4);
+
+/**
+ * Describes the message holos.console.v1.GetPrincipalAccessReportResponse.
+ * Use `create(GetPrincipalAccessReportResponseSchema)` to create a new message.
+ */
+export const GetPrincipalAccessReportResponseSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_dashboard, 5);
+
+/**
+ * Describes the enum holos.console.v1.AccessibleResourceType.
+ */
+export const AccessibleResourceTypeSchema = /*@__PURE__*/
+  enumDesc(file_holos_console_v1_dashboard, 0);
+
+/**
+ * AccessibleResourceType is the kind of an AccessibleResource.
+ *
+ * @generated from enum holos.console.v1.AccessibleResourceType
+ */
+export const AccessibleResourceType = /*@__PURE__*/
+  tsEnum(AccessibleResourceTypeSchema);
+
+/**
+ * DashboardService answers cross-project questions for the home dashboard in
+ * a single call, so the UI does not list organizations, then projects per
+ * organization, then secrets per project.
+ *
+ * @generated from service holos.console.v1.DashboardService
+ */
+export const DashboardService = /*@__PURE__*/
+  serviceDesc(file_holos_console_v1_dashboard, 0);
+
@@ -5,6 +5,7 @@
 import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
 import type { Message } from "@bufbuild/protobuf";
 import type { GetDeploymentPolicyStateRequestSchema, GetDeploymentPolicyStateResponseSchema, LinkedTemplateRef } from "./policy_state_pb";
+import type { ListOrder } from "./list_filter_pb";
 import type { Timestamp } from "@bufbuild/protobuf/wkt";
 
 /**
@@ -343,6 +344,13 @@ export declare type ListDeploymentsRequest = Message<"holos.console.v1.ListDeplo
    * @generated from field: string project = 1;
    */
   project: string;
+
+  /**
+   * order_by sorts the returned deployments.
+   *
+   * @generated from field: holos.console.v1.ListOrder order_by = 2;
+   */
+  orderBy?: ListOrder;
 };
 
 /**
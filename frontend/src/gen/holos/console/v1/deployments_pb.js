@@ -4,6 +4,7 @@
 
 import { enumDesc, fileDesc, messageDesc, serviceDesc, tsEnum } from "@bufbuild/protobuf/codegenv2";
 import { file_holos_console_v1_policy_state } from "./policy_state_pb";
+import { file_holos_console_v1_list_filter } from "./list_filter_pb";
 import { file_holos_console_v1_rbac } from "./rbac_pb";
 import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
 
@@ -11,7 +12,7 @@ import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
  * Describes the file holos/console/v1/deployments.proto.
  */
 export const file_holos_console_v1_deployments = /*@__PURE__*/
-  fileDesc("CiJob2xvcy9jb25zb2xlL3YxL2RlcGxveW1lbnRzLnByb3RvEhBob2xvcy5jb25zb2xlLnYxIrgDCgpEZXBsb3ltZW50EgwKBG5hbWUYASABKAkSDwoHcHJvamVjdBgCIAEoCRINCgVpbWFnZRgDIAEoCRILCgN0YWcYBCABKAkSEAoIdGVtcGxhdGUYBSABKAkSFAoMZGlzcGxheV9uYW1lGAYgASgJEhMKC2Rlc2NyaXB0aW9uGAcgASgJEjQKBXBoYXNlGAggASgOMiEuaG9sb3MuY29uc29sZS52MS5EZXBsb3ltZW50UGhhc2VCAhgBEhMKB21lc3NhZ2UYCSABKAlCAhgBEg8KB2NvbW1hbmQYCiADKAkSDAoEYXJncxgLIAMoCRIlCgNlbnYYDCADKAsyGC5ob2xvcy5jb25zb2xlLnYxLkVudlZhchIMCgRwb3J0GA0gASgFEkEKDnN0YXR1c19zdW1tYXJ5GA4gASgLMikuaG9sb3MuY29uc29sZS52MS5EZXBsb3ltZW50U3RhdHVzU3VtbWFyeRISCgpjcmVhdGVkX2F0GA8gASgJEjwKDGRlcGVuZGVuY2llcxgQIAMoCzImLmhvbG9zLmNvbnNvbGUudjEuRGVwbG95bWVudERlcGVuZGVuY3kinwEKFERlcGxveW1lbnREZXBlbmRlbmN5EjUKCHRlbXBsYXRlGAEgASgLMiMuaG9sb3MuY29uc29sZS52MS5MaW5rZWRUZW1wbGF0ZVJlZhIPCgd2ZXJzaW9uGAIgASgJEj8KEm9yaWdpbmF0aW5nX29iamVjdBgDIAEoCzIjLmhvbG9zLmNvbnNvbGUudjEuT3JpZ2luYXRpbmdPYmplY3QiQgoRT3JpZ2luYXRpbmdPYmplY3QSEQoJbmFtZXNwYWNlGAEgASgJEgwKBG5hbWUYAiABKAkSDAoEa2luZBgDIAEoCSKsAQoGRW52VmFyEgwKBG5hbWUYASABKAkSDwoFdmFsdWUYAiABKAlIABI4Cg5zZWNyZXRfa2V5X3JlZhgDIAEoCzIeLmhvbG9zLmNvbnNvbGUudjEuU2VjcmV0S2V5UmVmSAASPwoSY29uZmlnX21hcF9rZXlfcmVmGAQgASgLMiEuaG9sb3MuY29uc29sZS52MS5Db25maWdNYXBLZXlSZWZIAEIICgZzb3VyY2UiKQoMU2VjcmV0S2V5UmVmEgwKBG5hbWUYASABKAkSCwoDa2V5GAIgASgJIiwKD0NvbmZpZ01hcEtleVJlZhIMCgRuYW1lGAEgASgJEgsKA2tleRgCIAEoCSIpChZMaXN0RGVwbG95bWVudHNSZXF1ZXN0Eg8KB3Byb2plY3QYASABKAkiTAoXTGlzdERlcGxveW1lbnRzUmVzcG9uc2USMQoLZGVwbG95bWVudHMYASADKAsyHC5ob2xvcy5jb25zb2xlLnYxLkRlcGxveW1lbnQiNQoUR2V0RGVwbG95bWVudFJlcXVlc3QSDAoEbmFtZRgBIAEoCRIPCgdwcm9qZWN0GAIgASgJIkkKFUdldERlcGxveW1lbnRSZXNwb25zZRIwCgpkZXBsb3ltZW50GAEgASgLMhwuaG9sb3MuY29uc29sZS52MS5EZXBsb3ltZW50IpACChdDcmVhdGVEZXBsb3ltZW50UmVxdWVzdBIMCgRuYW1lGAEgASgJEg8KB3Byb2plY3QYAiABKAkSDQoFaW1hZ2UYAyABKAkSCwoDdGFnGAQgASgJEhAKCHRlbXBsYXRlGAUgASgJEhkKDGRpc3BsYXlfbmFtZRgGIAEoCUgAiAEBEhgKC2Rlc2NyaXB0aW9uGAcgASgJSAGIAQESDwoHY29tbWFuZBgIIAMoCRIMCgRhcmdzGAkgAygJEiUKA2VudhgKIAMoCzIYLmhvbG9zLmNvbnNvbGUudjEuRW52VmFyEgwKBHBvcnQYCyABKAVCDwoNX2Rpc3BsYXlfbmFtZUIOCgxfZGVzY3JpcHRpb24iKAoYQ3JlYXRlRGVwbG95bWVudFJlc3BvbnNlEgwKBG5hbWUYASABKAkiqAIKF1VwZGF0ZURlcGxveW1lbnRSZXF1ZXN0EgwKBG5hbWUYASABKAkSDwoHcHJvamVjdBgCIAEoCRISCgVpbWFnZRgDIAEoCUgAiAEBEhAKA3RhZxgEIAEoCUgBiAEBEhkKDGRpc3BsYXlfbmFtZRgFIAEoCUgCiAEBEhgKC2Rlc2NyaXB0aW9uGAYgASgJSAOIAQESDwoHY29tbWFuZBgHIAMoCRIMCgRhcmdzGAggAygJEiUKA2VudhgJIAMoCzIYLmhvbG9zLmNvbnNvbGUudjEuRW52VmFyEhEKBHBvcnQYCiABKAVIBIgBAUIICgZfaW1hZ2VCBgoEX3RhZ0IPCg1fZGlzcGxheV9uYW1lQg4KDF9kZXNjcmlwdGlvbkIHCgVfcG9ydCIaChhVcGRhdGVEZXBsb3ltZW50UmVzcG9uc2UiOAoXRGVsZXRlRGVwbG95bWVudFJlcXVlc3QSDAoEbmFtZRgBIAEoCRIPCgdwcm9qZWN0GAIgASgJIhoKGERlbGV0ZURlcGxveW1lbnRSZXNwb25zZSI7ChpHZXREZXBsb3ltZW50U3RhdHVzUmVxdWVzdBIMCgRuYW1lGAEgASgJEg8KB3Byb2plY3QYAiABKAkiqwIKEERlcGxveW1lbnRTdGF0dXMSFgoOcmVhZHlfcmVwbGljYXMYASABKAUSGAoQZGVzaXJlZF9yZXBsaWNhcxgCIAEoBRIaChJhdmFpbGFibGVfcmVwbGljYXMYAyABKAUSOQoKY29uZGl0aW9ucxgEIAMoCzIlLmhvbG9zLmNvbnNvbGUudjEuRGVwbG95bWVudENvbmRpdGlvbhIpCgRwb2RzGAUgAygLMhsuaG9sb3MuY29uc29sZS52MS5Qb2RTdGF0dXMSJwoGZXZlbnRzGAYgAygLMhcuaG9sb3MuY29uc29sZS52MS5FdmVudBI6CgdzdW1tYXJ5GAcgASgLMikuaG9sb3MuY29uc29sZS52MS5EZXBsb3ltZW50U3RhdHVzU3VtbWFyeSJUChNEZXBsb3ltZW50Q29uZGl0aW9uEgwKBHR5cGUYASABKAkSDgoGc3RhdHVzGAIgASgJEg4KBnJlYXNvbhgDIAEoCRIPCgdtZXNzYWdlGAQgASgJIrYBCglQb2RTdGF0dXMSDAoEbmFtZRgBIAEoCRINCgVwaGFzZRgCIAEoCRINCgVyZWFkeRgDIAEoCBIVCg1yZXN0YXJ0X2NvdW50GAQgASgFEj0KEmNvbnRhaW5lcl9zdGF0dXNlcxgFIAMoCzIhLmhvbG9zLmNvbnNvbGUudjEuQ29udGFpbmVyU3RhdHVzEicKBmV2ZW50cxgGIAMoCzIXLmhvbG9zLmNvbnNvbGUudjEuRXZlbnQi0gEKBUV2ZW50EgwKBHR5cGUYASABKAkSDgoGcmVhc29uGAIgASgJEg8KB21lc3NhZ2UYAyABKAkSDgoGc291cmNlGAQgASgJEg0KBWNvdW50GAUgASgFEi4KCmZpcnN0X3NlZW4YBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi0KCWxhc3Rfc2VlbhgHIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASHAoUaW52b2x2ZWRfb2JqZWN0X25hbWUYCCABKAkitAEKD0NvbnRhaW5lclN0YXR1cxIMCgRuYW1lGAEgASgJEg0KBXN0YXRlGAIgASgJEg4KBnJlYXNvbhgDIAEoCRIPCgdtZXNzYWdlGAQgASgJEg0KBWltYWdlGAUgASgJEg0KBXJlYWR5GAYgASgIEhUKDXJlc3RhcnRfY291bnQYByABKAUSLgoKc3RhcnRlZF9hdBgIIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiUQobR2V0RGVwbG95bWVudFN0YXR1c1Jlc3BvbnNlEjIKBnN0YXR1cxgBIAEoCzIiLmhvbG9zLmNvbnNvbGUudjEuRGVwbG95bWVudFN0YXR1cyLRAgoXRGVwbG95bWVudFN0YXR1c1N1bW1hcnkSMAoFcGhhc2UYASABKA4yIS5ob2xvcy5jb25zb2xlLnYxLkRlcGxveW1lbnRQaGFzZRIWCg5yZWFkeV9yZXBsaWNhcxgCIAEoBRIYChBkZXNpcmVkX3JlcGxpY2FzGAMgASgFEhoKEmF2YWlsYWJsZV9yZXBsaWNhcxgEIAEoBRIYChB1cGRhdGVkX3JlcGxpY2FzGAUgASgFEhsKE29ic2VydmVkX2dlbmVyYXRpb24YBiABKAMSDwoHbWVzc2FnZRgHIAEoCRI3CgZvdXRwdXQYCCABKAsyIi5ob2xvcy5jb25zb2xlLnYxLkRlcGxveW1lbnRPdXRwdXRIAIgBARIZCgxwb2xpY3lfZHJpZnQYCSABKAhIAYgBAUIJCgdfb3V0cHV0Qg8KDV9wb2xpY3lfZHJpZnQiQgohR2V0RGVwbG95bWVudFN0YXR1c1N1bW1hcnlSZXF1ZXN0EgwKBG5hbWUYASABKAkSDwoHcHJvamVjdBgCIAEoCSJgCiJHZXREZXBsb3ltZW50U3RhdHVzU3VtbWFyeVJlc3BvbnNlEjoKB3N1bW1hcnkYASABKAsyKS5ob2xvcy5jb25zb2xlLnYxLkRlcGxveW1lbnRTdGF0dXNTdW1tYXJ5InIKGEdldERlcGxveW1lbnRMb2dzUmVxdWVzdBIMCgRuYW1lGAEgASgJEg8KB3Byb2plY3QYAiABKAkSEQoJY29udGFpbmVyGAMgASgJEhIKCnRhaWxfbGluZXMYBCABKAUSEAoIcHJldmlvdXMYBSABKAgiKQoZR2V0RGVwbG95bWVudExvZ3NSZXNwb25zZRIMCgRsb2dzGAEgASgJIi8KEU5hbWVzcGFjZVJlc291cmNlEgwKBG5hbWUYASABKAkSDAoEa2V5cxgCIAMoCSIuChtMaXN0TmFtZXNwYWNlU2VjcmV0c1JlcXVlc3QSDwoHcHJvamVjdBgBIAEoCSJUChxMaXN0TmFtZXNwYWNlU2VjcmV0c1Jlc3BvbnNlEjQKB3NlY3JldHMYASADKAsyIy5ob2xvcy5jb25zb2xlLnYxLk5hbWVzcGFjZVJlc291cmNlIjEKHkxpc3ROYW1lc3BhY2VDb25maWdNYXBzUmVxdWVzdBIPCgdwcm9qZWN0GAEgASgJIlsKH0xpc3ROYW1lc3BhY2VDb25maWdNYXBzUmVzcG9uc2USOAoLY29uZmlnX21hcHMYASADKAsyIy5ob2xvcy5jb25zb2xlLnYxLk5hbWVzcGFjZVJlc291cmNlIkIKIUdldERlcGxveW1lbnRSZW5kZXJQcmV2aWV3UmVxdWVzdBIPCgdwcm9qZWN0GAEgASgJEgwKBG5hbWUYAiABKAkiswUKIkdldERlcGxveW1lbnRSZW5kZXJQcmV2aWV3UmVzcG9uc2USFAoMY3VlX3RlbXBsYXRlGAEgASgJEhoKEmN1ZV9wbGF0Zm9ybV9pbnB1dBgCIAEoCRIZChFjdWVfcHJvamVjdF9pbnB1dBgDIAEoCRIVCg1yZW5kZXJlZF95YW1sGAQgASgJEhUKDXJlbmRlcmVkX2pzb24YBSABKAkSHwoXcGxhdGZvcm1fcmVzb3VyY2VzX3lhbWwYBiABKAkSHwoXcGxhdGZvcm1fcmVzb3VyY2VzX2pzb24YByABKAkSHgoWcHJvamVjdF9yZXNvdXJjZXNfeWFtbBgIIAEoCRIeChZwcm9qZWN0X3Jlc291cmNlc19qc29uGAkgASgJEhoKDWRlZmF1bHRzX2pzb24YCiABKAlIAIgBARIgChNwbGF0Zm9ybV9pbnB1dF9qc29uGAsgASgJSAGIAQESHwoScHJvamVjdF9pbnB1dF9qc29uGAwgASgJSAKIAQESLwoicGxhdGZvcm1fcmVzb3VyY2VzX3N0cnVjdHVyZWRfanNvbhgNIAEoCUgDiAEBEi4KIXByb2plY3RfcmVzb3VyY2VzX3N0cnVjdHVyZWRfanNvbhgOIAEoCUgEiAEBEjcKBm91dHB1dBgPIAEoCzIiLmhvbG9zLmNvbnNvbGUudjEuRGVwbG95bWVudE91dHB1dEgFiAEBQhAKDl9kZWZhdWx0c19qc29uQhYKFF9wbGF0Zm9ybV9pbnB1dF9qc29uQhUKE19wcm9qZWN0X2lucHV0X2pzb25CJQojX3BsYXRmb3JtX3Jlc291cmNlc19zdHJ1Y3R1cmVkX2pzb25CJAoiX3Byb2plY3RfcmVzb3VyY2VzX3N0cnVjdHVyZWRfanNvbkIJCgdfb3V0cHV0IkYKEERlcGxveW1lbnRPdXRwdXQSCwoDdXJsGAEgASgJEiUKBWxpbmtzGAIgAygLMhYuaG9sb3MuY29uc29sZS52MS5MaW5rIlUKBExpbmsSCwoDdXJsGAEgASgJEg0KBXRpdGxlGAIgASgJEhMKC2Rlc2NyaXB0aW9uGAMgASgJEg4KBnNvdXJjZRgEIAEoCRIMCgRuYW1lGAUgASgJIn8KEVBsYW5uZWREZXBsb3ltZW50EgwKBG5hbWUYASABKAkSQAoTbGlua2VkX3RlbXBsYXRlX3JlZhgCIAEoCzIjLmhvbG9zLmNvbnNvbGUudjEuTGlua2VkVGVtcGxhdGVSZWYSGgoSdmVyc2lvbl9jb25zdHJhaW50GAMgASgJIlEKD0NvbGxpc2lvbkRldGFpbBIUCgxwbGFubmVkX25hbWUYASABKAkSGAoQY29uZmxpY3RpbmdfbmFtZRgCIAEoCRIOCgZhZHZpY2UYAyABKAkieAoVVmVyc2lvbkNvbmZsaWN0RGV0YWlsEhoKEnRlbXBsYXRlX25hbWVzcGFjZRgBIAEoCRIVCg10ZW1wbGF0ZV9uYW1lGAIgASgJEhMKC2NvbnN0cmFpbnRzGAMgAygJEhcKD2RlcGVuZGVudF9uYW1lcxgEIAMoCSJqChVQcmVmbGlnaHRDaGVja1JlcXVlc3QSDwoHcHJvamVjdBgBIAEoCRJAChNwbGFubmVkX2RlcGxveW1lbnRzGAIgAygLMiMuaG9sb3MuY29uc29sZS52MS5QbGFubmVkRGVwbG95bWVudCKTAQoWUHJlZmxpZ2h0Q2hlY2tSZXNwb25zZRI1Cgpjb2xsaXNpb25zGAEgAygLMiEuaG9sb3MuY29uc29sZS52MS5Db2xsaXNpb25EZXRhaWwSQgoRdmVyc2lvbl9jb25mbGljdHMYAiADKAsyJy5ob2xvcy5jb25zb2xlLnYxLlZlcnNpb25Db25mbGljdERldGFpbCJ5CiVHZXREZXBlbmRlbmN5RWRnZUNhc2NhZGVEZWxldGVSZXF1ZXN0Eg8KB3Byb2plY3QYASABKAkSPwoSb3JpZ2luYXRpbmdfb2JqZWN0GAIgASgLMiMuaG9sb3MuY29uc29sZS52MS5PcmlnaW5hdGluZ09iamVjdCJACiZHZXREZXBlbmRlbmN5RWRnZUNhc2NhZGVEZWxldGVSZXNwb25zZRIWCg5jYXNjYWRlX2RlbGV0ZRgBIAEoCCKRAQolU2V0RGVwZW5kZW5jeUVkZ2VDYXNjYWRlRGVsZXRlUmVxdWVzdBIPCgdwcm9qZWN0GAEgASgJEj8KEm9yaWdpbmF0aW5nX29iamVjdBgCIAEoCzIjLmhvbG9zLmNvbnNvbGUudjEuT3JpZ2luYXRpbmdPYmplY3QSFgoOY2FzY2FkZV9kZWxldGUYAyABKAgiQAomU2V0RGVwZW5kZW5jeUVkZ2VDYXNjYWRlRGVsZXRlUmVzcG9uc2USFgoOY2FzY2FkZV9kZWxldGUYASABKAgqrAEKD0RlcGxveW1lbnRQaGFzZRIgChxERVBMT1lNRU5UX1BIQVNFX1VOU1BFQ0lGSUVEEAASHAoYREVQTE9ZTUVOVF9QSEFTRV9QRU5ESU5HEAESHAoYREVQTE9ZTUVOVF9QSEFTRV9SVU5OSU5HEAISGwoXREVQTE9ZTUVOVF9QSEFTRV9GQUlMRUQQAxIeChpERVBMT1lNRU5UX1BIQVNFX1NVQ0NFRURFRBAEMqAOChFEZXBsb3ltZW50U2VydmljZRJmCg9MaXN0RGVwbG95bWVudHMSKC5ob2xvcy5jb25zb2xlLnYxLkxpc3REZXBsb3ltZW50c1JlcXVlc3QaKS5ob2xvcy5jb25zb2xlLnYxLkxpc3REZXBsb3ltZW50c1Jlc3BvbnNlEmAKDUdldERlcGxveW1lbnQSJi5ob2xvcy5jb25zb2xlLnYxLkdldERlcGxveW1lbnRSZXF1ZXN0GicuaG9sb3MuY29uc29sZS52MS5HZXREZXBsb3ltZW50UmVzcG9uc2USaQoQQ3JlYXRlRGVwbG95bWVudBIpLmhvbG9zLmNvbnNvbGUudjEuQ3JlYXRlRGVwbG95bWVudFJlcXVlc3QaKi5ob2xvcy5jb25zb2xlLnYxLkNyZWF0ZURlcGxveW1lbnRSZXNwb25zZRJpChBVcGRhdGVEZXBsb3ltZW50EikuaG9sb3MuY29uc29sZS52MS5VcGRhdGVEZXBsb3ltZW50UmVxdWVzdBoqLmhvbG9zLmNvbnNvbGUudjEuVXBkYXRlRGVwbG95bWVudFJlc3BvbnNlEmkKEERlbGV0ZURlcGxveW1lbnQSKS5ob2xvcy5jb25zb2xlLnYxLkRlbGV0ZURlcGxveW1lbnRSZXF1ZXN0GiouaG9sb3MuY29uc29sZS52MS5EZWxldGVEZXBsb3ltZW50UmVzcG9uc2UScgoTR2V0RGVwbG95bWVudFN0YXR1cxIsLmhvbG9zLmNvbnNvbGUudjEuR2V0RGVwbG95bWVudFN0YXR1c1JlcXVlc3QaLS5ob2xvcy5jb25zb2xlLnYxLkdldERlcGxveW1lbnRTdGF0dXNSZXNwb25zZRKHAQoaR2V0RGVwbG95bWVudFN0YXR1c1N1bW1hcnkSMy5ob2xvcy5jb25zb2xlLnYxLkdldERlcGxveW1lbnRTdGF0dXNTdW1tYXJ5UmVxdWVzdBo0LmhvbG9zLmNvbnNvbGUudjEuR2V0RGVwbG95bWVudFN0YXR1c1N1bW1hcnlSZXNwb25zZRJsChFHZXREZXBsb3ltZW50TG9ncxIqLmhvbG9zLmNvbnNvbGUudjEuR2V0RGVwbG95bWVudExvZ3NSZXF1ZXN0GisuaG9sb3MuY29uc29sZS52MS5HZXREZXBsb3ltZW50TG9nc1Jlc3BvbnNlEnUKFExpc3ROYW1lc3BhY2VTZWNyZXRzEi0uaG9sb3MuY29uc29sZS52MS5MaXN0TmFtZXNwYWNlU2VjcmV0c1JlcXVlc3QaLi5ob2xvcy5jb25zb2xlLnYxLkxpc3ROYW1lc3BhY2VTZWNyZXRzUmVzcG9uc2USfgoXTGlzdE5hbWVzcGFjZUNvbmZpZ01hcHMSMC5ob2xvcy5jb25zb2xlLnYxLkxpc3ROYW1lc3BhY2VDb25maWdNYXBzUmVxdWVzdBoxLmhvbG9zLmNvbnNvbGUudjEuTGlzdE5hbWVzcGFjZUNvbmZpZ01hcHNSZXNwb25zZRKHAQoaR2V0RGVwbG95bWVudFJlbmRlclByZXZpZXcSMy5ob2xvcy5jb25zb2xlLnYxLkdldERlcGxveW1lbnRSZW5kZXJQcmV2aWV3UmVxdWVzdBo0LmhvbG9zLmNvbnNvbGUudjEuR2V0RGVwbG95bWVudFJlbmRlclByZXZpZXdSZXNwb25zZRKBAQoYR2V0RGVwbG95bWVudFBvbGljeVN0YXRlEjEuaG9sb3MuY29uc29sZS52MS5HZXREZXBsb3ltZW50UG9saWN5U3RhdGVSZXF1ZXN0GjIuaG9sb3MuY29uc29sZS52MS5HZXREZXBsb3ltZW50UG9saWN5U3RhdGVSZXNwb25zZRJjCg5QcmVmbGlnaHRDaGVjaxInLmhvbG9zLmNvbnNvbGUudjEuUHJlZmxpZ2h0Q2hlY2tSZXF1ZXN0GiguaG9sb3MuY29uc29sZS52MS5QcmVmbGlnaHRDaGVja1Jlc3BvbnNlEpMBCh5HZXREZXBlbmRlbmN5RWRnZUNhc2NhZGVEZWxldGUSNy5ob2xvcy5jb25zb2xlLnYxLkdldERlcGVuZGVuY3lFZGdlQ2FzY2FkZURlbGV0ZVJlcXVlc3QaOC5ob2xvcy5jb25zb2xlLnYxLkdldERlcGVuZGVuY3lFZGdlQ2FzY2FkZURlbGV0ZVJlc3BvbnNlEpMBCh5TZXREZXBlbmRlbmN5RWRnZUNhc2NhZGVEZWxldGUSNy5ob2xvcy5jb25zb2xlLnYxLlNldERlcGVuZGVuY3lFZGdlQ2FzY2FkZURlbGV0ZVJlcXVlc3QaOC5ob2xvcy5jb25zb2xlLnYxLlNldERlcGVuZGVuY3lFZGdlQ2FzY2FkZURlbGV0ZVJlc3BvbnNlQkNaQWdpdGh1Yi5jb20vaG9sb3MtcnVuL2hvbG9zLWNvbnNvbGUvZ2VuL2hvbG9zL2NvbnNvbGUvdjE7Y29uc29sZXYxYgZwcm90bzM", [file_holos_console_v1_policy_state, file_holos_console_v1_rbac, file_google_protobuf_timestamp]);
+  fileDesc("CiJob2xvcy9jb25zb2xlL3YxL2RlcGxveW1lbnRzLnByb3RvEhBob2xvcy5jb25zb2xlLnYxIrgDCgpEZXBsb3ltZW50EgwKBG5hbWUYASABKAkSDwoHcHJvamVjdBgCIAEoCRINCgVpbWFnZRgDIAEoCRILCgN0YWcYBCABKAkSEAoIdGVtcGxhdGUYBSABKAkSFAoMZGlzcGxheV9uYW1lGAYgASgJEhMKC2Rlc2NyaXB0aW9uGAcgASgJEjQKBXBoYXNlGAggASgOMiEuaG9sb3MuY29uc29sZS52MS5EZXBsb3ltZW50UGhhc2VCAhgBEhMKB21lc3NhZ2UYCSABKAlCAhgBEg8KB2NvbW1hbmQYCiADKAkSDAoEYXJncxgLIAMoCRIlCgNlbnYYDCADKAsyGC5ob2xvcy5jb25zb2xlLnYxLkVudlZhchIMCgRwb3J0GA0gASgFEkEKDnN0YXR1c19zdW1tYXJ5GA4gASgLMikuaG9sb3MuY29uc29sZS52MS5EZXBsb3ltZW50U3RhdHVzU3VtbWFyeRISCgpjcmVhdGVkX2F0GA8gASgJEjwKDGRlcGVuZGVuY2llcxgQIAMoCzImLmhvbG9zLmNvbnNvbGUudjEuRGVwbG95bWVudERlcGVuZGVuY3kinwEKFERlcGxveW1lbnREZXBlbmRlbmN5EjUKCHRlbXBsYXRlGAEgASgLMiMuaG9sb3MuY29uc29sZS52MS5MaW5rZWRUZW1wbGF0ZVJlZhIPCgd2ZXJzaW9uGAIgASgJEj8KEm9yaWdpbmF0aW5nX29iamVjdBgDIAEoCzIjLmhvbG9zLmNvbnNvbGUudjEuT3JpZ2luYXRpbmdPYmplY3QiQgoRT3JpZ2luYXRpbmdPYmplY3QSEQoJbmFtZXNwYWNlGAEgASgJEgwKBG5hbWUYAiABKAkSDAoEa2luZBgDIAEoCSKsAQoGRW52VmFyEgwKBG5hbWUYASABKAkSDwoFdmFsdWUYAiABKAlIABI4Cg5zZWNyZXRfa2V5X3JlZhgDIAEoCzIeLmhvbG9zLmNvbnNvbGUudjEuU2VjcmV0S2V5UmVmSAASPwoSY29uZmlnX21hcF9rZXlfcmVmGAQgASgLMiEuaG9sb3MuY29uc29sZS52MS5Db25maWdNYXBLZXlSZWZIAEIICgZzb3VyY2UiKQoMU2VjcmV0S2V5UmVmEgwKBG5hbWUYASABKAkSCwoDa2V5GAIgASgJIiwKD0NvbmZpZ01hcEtleVJlZhIMCgRuYW1lGAEgASgJEgsKA2tleRgCIAEoCSJYChZMaXN0RGVwbG95bWVudHNSZXF1ZXN0Eg8KB3Byb2plY3QYASABKAkSLQoIb3JkZXJfYnkYAiABKAsyGy5ob2xvcy5jb25zb2xlLnYxLkxpc3RPcmRlciJMChdMaXN0RGVwbG95bWVudHNSZXNwb25zZRIxCgtkZXBsb3ltZW50cxgBIAMoCzIcLmhvbG9zLmNvbnNvbGUudjEuRGVwbG95bWVudCI1ChRHZXREZXBsb3ltZW50UmVxdWVzdBIMCgRuYW1lGAEgASgJEg8KB3Byb2plY3QYAiABKAkiSQoVR2V0RGVwbG95bWVudFJlc3BvbnNlEjAKCmRlcGxveW1lbnQYASABKAsyHC5ob2xvcy5jb25zb2xlLnYxLkRlcGxveW1lbnQikAIKF0NyZWF0ZURlcGxveW1lbnRSZXF1ZXN0EgwKBG5hbWUYASABKAkSDwoHcHJvamVjdBgCIAEoCRINCgVpbWFnZRgDIAEoCRILCgN0YWcYBCABKAkSEAoIdGVtcGxhdGUYBSABKAkSGQoMZGlzcGxheV9uYW1lGAYgASgJSACIAQESGAoLZGVzY3JpcHRpb24YByABKAlIAYgBARIPCgdjb21tYW5kGAggAygJEgwKBGFyZ3MYCSADKAkSJQoDZW52GAogAygLMhguaG9sb3MuY29uc29sZS52MS5FbnZWYXISDAoEcG9ydBgLIAEoBUIPCg1fZGlzcGxheV9uYW1lQg4KDF9kZXNjcmlwdGlvbiIoChhDcmVhdGVEZXBsb3ltZW50UmVzcG9uc2USDAoEbmFtZRgBIAEoCSKoAgoXVXBkYXRlRGVwbG95bWVudFJlcXVlc3QSDAoEbmFtZRgBIAEoCRIPCgdwcm9qZWN0GAIgASgJEhIKBWltYWdlGAMgASgJSACIAQESEAoDdGFnGAQgASgJSAGIAQESGQoMZGlzcGxheV9uYW1lGAUgASgJSAKIAQESGAoLZGVzY3JpcHRpb24YBiABKAlIA4gBARIPCgdjb21tYW5kGAcgAygJEgwKBGFyZ3MYCCADKAkSJQoDZW52GAkgAygLMhguaG9sb3MuY29uc29sZS52MS5FbnZWYXISEQoEcG9ydBgKIAEoBUgEiAEBQggKBl9pbWFnZUIGCgRfdGFnQg8KDV9kaXNwbGF5X25hbWVCDgoMX2Rlc2NyaXB0aW9uQgcKBV9wb3J0IhoKGFVwZGF0ZURlcGxveW1lbnRSZXNwb25zZSI4ChdEZWxldGVEZXBsb3ltZW50UmVxdWVzdBIMCgRuYW1lGAEgASgJEg8KB3Byb2plY3QYAiABKAkiGgoYRGVsZXRlRGVwbG95bWVudFJlc3BvbnNlIjsKGkdldERlcGxveW1lbnRTdGF0dXNSZXF1ZXN0EgwKBG5hbWUYASABKAkSDwoHcHJvamVjdBgCIAEoCSKrAgoQRGVwbG95bWVudFN0YXR1cxIWCg5yZWFkeV9yZXBsaWNhcxgBIAEoBRIYChBkZXNpcmVkX3JlcGxpY2FzGAIgASgFEhoKEmF2YWlsYWJsZV9yZXBsaWNhcxgDIAEoBRI5Cgpjb25kaXRpb25zGAQgAygLMiUuaG9sb3MuY29uc29sZS52MS5EZXBsb3ltZW50Q29uZGl0aW9uEikKBHBvZHMYBSADKAsyGy5ob2xvcy5jb25zb2xlLnYxLlBvZFN0YXR1cxInCgZldmVudHMYBiADKAsyFy5ob2xvcy5jb25zb2xlLnYxLkV2ZW50EjoKB3N1bW1hcnkYByABKAsyKS5ob2xvcy5jb25zb2xlLnYxLkRlcGxveW1lbnRTdGF0dXNTdW1tYXJ5IlQKE0RlcGxveW1lbnRDb25kaXRpb24SDAoEdHlwZRgBIAEoCRIOCgZzdGF0dXMYAiABKAkSDgoGcmVhc29uGAMgASgJEg8KB21lc3NhZ2UYBCABKAkitgEKCVBvZFN0YXR1cxIMCgRuYW1lGAEgASgJEg0KBXBoYXNlGAIgASgJEg0KBXJlYWR5GAMgASgIEhUKDXJlc3RhcnRfY291bnQYBCABKAUSPQoSY29udGFpbmVyX3N0YXR1c2VzGAUgAygLMiEuaG9sb3MuY29uc29sZS52MS5Db250YWluZXJTdGF0dXMSJwoGZXZlbnRzGAYgAygLMhcuaG9sb3MuY29uc29sZS52MS5FdmVudCLSAQoFRXZlbnQSDAoEdHlwZRgBIAEoCRIOCgZyZWFzb24YAiABKAkSDwoHbWVzc2FnZRgDIAEoCRIOCgZzb3VyY2UYBCABKAkSDQoFY291bnQYBSABKAUSLgoKZmlyc3Rfc2VlbhgGIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLQoJbGFzdF9zZWVuGAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIcChRpbnZvbHZlZF9vYmplY3RfbmFtZRgIIAEoCSK0AQoPQ29udGFpbmVyU3RhdHVzEgwKBG5hbWUYASABKAkSDQoFc3RhdGUYAiABKAkSDgoGcmVhc29uGAMgASgJEg8KB21lc3NhZ2UYBCABKAkSDQoFaW1hZ2UYBSABKAkSDQoFcmVhZHkYBiABKAgSFQoNcmVzdGFydF9jb3VudBgHIAEoBRIuCgpzdGFydGVkX2F0GAggASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCJRChtHZXREZXBsb3ltZW50U3RhdHVzUmVzcG9uc2USMgoGc3RhdHVzGAEgASgLMiIuaG9sb3MuY29uc29sZS52MS5EZXBsb3ltZW50U3RhdHVzItECChdEZXBsb3ltZW50U3RhdHVzU3VtbWFyeRIwCgVwaGFzZRgBIAEoDjIhLmhvbG9zLmNvbnNvbGUudjEuRGVwbG95bWVudFBoYXNlEhYKDnJlYWR5X3JlcGxpY2FzGAIgASgFEhgKEGRlc2lyZWRfcmVwbGljYXMYAyABKAUSGgoSYXZhaWxhYmxlX3JlcGxpY2FzGAQgASgFEhgKEHVwZGF0ZWRfcmVwbGljYXMYBSABKAUSGwoTb2JzZXJ2ZWRfZ2VuZXJhdGlvbhgGIAEoAxIPCgdtZXNzYWdlGAcgASgJEjcKBm91dHB1dBgIIAEoCzIiLmhvbG9zLmNvbnNvbGUudjEuRGVwbG95bWVudE91dHB1dEgAiAEBEhkKDHBvbGljeV9kcmlmdBgJIAEoCEgBiAEBQgkKB19vdXRwdXRCDwoNX3BvbGljeV9kcmlmdCJCCiFHZXREZXBsb3ltZW50U3RhdHVzU3VtbWFyeVJlcXVlc3QSDAoEbmFtZRgBIAEoCRIPCgdwcm9qZWN0GAIgASgJImAKIkdldERlcGxveW1lbnRTdGF0dXNTdW1tYXJ5UmVzcG9uc2USOgoHc3VtbWFyeRgBIAEoCzIpLmhvbG9zLmNvbnNvbGUudjEuRGVwbG95bWVudFN0YXR1c1N1bW1hcnkicgoYR2V0RGVwbG95bWVudExvZ3NSZXF1ZXN0EgwKBG5hbWUYASABKAkSDwoHcHJvamVjdBgCIAEoCRIRCgljb250YWluZXIYAyABKAkSEgoKdGFpbF9saW5lcxgEIAEoBRIQCghwcmV2aW91cxgFIAEoCCIpChlHZXREZXBsb3ltZW50TG9nc1Jlc3BvbnNlEgwKBGxvZ3MYASABKAkiLwoRTmFtZXNwYWNlUmVzb3VyY2USDAoEbmFtZRgBIAEoCRIMCgRrZXlzGAIgAygJIi4KG0xpc3ROYW1lc3BhY2VTZWNyZXRzUmVxdWVzdBIPCgdwcm9qZWN0GAEgASgJIlQKHExpc3ROYW1lc3BhY2VTZWNyZXRzUmVzcG9uc2USNAoHc2VjcmV0cxgBIAMoCzIjLmhvbG9zLmNvbnNvbGUudjEuTmFtZXNwYWNlUmVzb3VyY2UiMQoeTGlzdE5hbWVzcGFjZUNvbmZpZ01hcHNSZXF1ZXN0Eg8KB3Byb2plY3QYASABKAkiWwofTGlzdE5hbWVzcGFjZUNvbmZpZ01hcHNSZXNwb25zZRI4Cgtjb25maWdfbWFwcxgBIAMoCzIjLmhvbG9zLmNvbnNvbGUudjEuTmFtZXNwYWNlUmVzb3VyY2UiQgohR2V0RGVwbG95bWVudFJlbmRlclByZXZpZXdSZXF1ZXN0Eg8KB3Byb2plY3QYASABKAkSDAoEbmFtZRgCIAEoCSKzBQoiR2V0RGVwbG95bWVudFJlbmRlclByZXZpZXdSZXNwb25zZRIUCgxjdWVfdGVtcGxhdGUYASABKAkSGgoSY3VlX3BsYXRmb3JtX2lucHV0GAIgASgJEhkKEWN1ZV9wcm9qZWN0X2lucHV0GAMgASgJEhUKDXJlbmRlcmVkX3lhbWwYBCABKAkSFQoNcmVuZGVyZWRfanNvbhgFIAEoCRIfChdwbGF0Zm9ybV9yZXNvdXJjZXNfeWFtbBgGIAEoCRIfChdwbGF0Zm9ybV9yZXNvdXJjZXNfanNvbhgHIAEoCRIeChZwcm9qZWN0X3Jlc291cmNlc195YW1sGAggASgJEh4KFnByb2plY3RfcmVzb3VyY2VzX2pzb24YCSABKAkSGgoNZGVmYXVsdHNfanNvbhgKIAEoCUgAiAEBEiAKE3BsYXRmb3JtX2lucHV0X2pzb24YCyABKAlIAYgBARIfChJwcm9qZWN0X2lucHV0X2pzb24YDCABKAlIAogBARIvCiJwbGF0Zm9ybV9yZXNvdXJjZXNfc3RydWN0dXJlZF9qc29uGA0gASgJSAOIAQESLgohcHJvamVjdF9yZXNvdXJjZXNfc3RydWN0dXJlZF9qc29uGA4gASgJSASIAQESNwoGb3V0cHV0GA8gASgLMiIuaG9sb3MuY29uc29sZS52MS5EZXBsb3ltZW50T3V0cHV0SAWIAQFCEAoOX2RlZmF1bHRzX2pzb25CFgoUX3BsYXRmb3JtX2lucHV0X2pzb25CFQoTX3Byb2plY3RfaW5wdXRfanNvbkIlCiNfcGxhdGZvcm1fcmVzb3VyY2VzX3N0cnVjdHVyZWRfanNvbkIkCiJfcHJvamVjdF9yZXNvdXJjZXNfc3RydWN0dXJlZF9qc29uQgkKB19vdXRwdXQiRgoQRGVwbG95bWVudE91dHB1dBILCgN1cmwYASABKAkSJQoFbGlua3MYAiADKAsyFi5ob2xvcy5jb25zb2xlLnYxLkxpbmsiVQoETGluaxILCgN1cmwYASABKAkSDQoFdGl0bGUYAiABKAkSEwoLZGVzY3JpcHRpb24YAyABKAkSDgoGc291cmNlGAQgASgJEgwKBG5hbWUYBSABKAkifwoRUGxhbm5lZERlcGxveW1lbnQSDAoEbmFtZRgBIAEoCRJAChNsaW5rZWRfdGVtcGxhdGVfcmVmGAIgASgLMiMuaG9sb3MuY29uc29sZS52MS5MaW5rZWRUZW1wbGF0ZVJlZhIaChJ2ZXJzaW9uX2NvbnN0cmFpbnQYAyABKAkiUQoPQ29sbGlzaW9uRGV0YWlsEhQKDHBsYW5uZWRfbmFtZRgBIAEoCRIYChBjb25mbGljdGluZ19uYW1lGAIgASgJEg4KBmFkdmljZRgDIAEoCSJ4ChVWZXJzaW9uQ29uZmxpY3REZXRhaWwSGgoSdGVtcGxhdGVfbmFtZXNwYWNlGAEgASgJEhUKDXRlbXBsYXRlX25hbWUYAiABKAkSEwoLY29uc3RyYWludHMYAyADKAkSFwoPZGVwZW5kZW50X25hbWVzGAQgAygJImoKFVByZWZsaWdodENoZWNrUmVxdWVzdBIPCgdwcm9qZWN0GAEgASgJEkAKE3BsYW5uZWRfZGVwbG95bWVudHMYAiADKAsyIy5ob2xvcy5jb25zb2xlLnYxLlBsYW5uZWREZXBsb3ltZW50IpMBChZQcmVmbGlnaHRDaGVja1Jlc3BvbnNlEjUKCmNvbGxpc2lvbnMYASADKAsyIS5ob2xvcy5jb25zb2xlLnYxLkNvbGxpc2lvbkRldGFpbBJCChF2ZXJzaW9uX2NvbmZsaWN0cxgCIAMoCzInLmhvbG9zLmNvbnNvbGUudjEuVmVyc2lvbkNvbmZsaWN0RGV0YWlsInkKJUdldERlcGVuZGVuY3lFZGdlQ2FzY2FkZURlbGV0ZVJlcXVlc3QSDwoHcHJvamVjdBgBIAEoCRI/ChJvcmlnaW5hdGluZ19vYmplY3QYAiABKAsyIy5ob2xvcy5jb25zb2xlLnYxLk9yaWdpbmF0aW5nT2JqZWN0IkAKJkdldERlcGVuZGVuY3lFZGdlQ2FzY2FkZURlbGV0ZVJlc3BvbnNlEhYKDmNhc2NhZGVfZGVsZXRlGAEgASgIIpEBCiVTZXREZXBlbmRlbmN5RWRnZUNhc2NhZGVEZWxldGVSZXF1ZXN0Eg8KB3Byb2plY3QYASABKAkSPwoSb3JpZ2luYXRpbmdfb2JqZWN0GAIgASgLMiMuaG9sb3MuY29uc29sZS52MS5PcmlnaW5hdGluZ09iamVjdBIWCg5jYXNjYWRlX2RlbGV0ZRgDIAEoCCJACiZTZXREZXBlbmRlbmN5RWRnZUNhc2NhZGVEZWxldGVSZXNwb25zZRIWCg5jYXNjYWRlX2RlbGV0ZRgBIAEoCCqsAQoPRGVwbG95bWVudFBoYXNlEiAKHERFUExPWU1FTlRfUEhBU0VfVU5TUEVDSUZJRUQQABIcChhERVBMT1lNRU5UX1BIQVNFX1BFTkRJTkcQARIcChhERVBMT1lNRU5UX1BIQVNFX1JVTk5JTkcQAhIbChdERVBMT1lNRU5UX1BIQVNFX0ZBSUxFRBADEh4KGkRFUExPWU1FTlRfUEhBU0VfU1VDQ0VFREVEEAQyyQ4KEURlcGxveW1lbnRTZXJ2aWNlEmsKD0xpc3REZXBsb3ltZW50cxIoLmhvbG9zLmNvbnNvbGUudjEuTGlzdERlcGxveW1lbnRzUmVxdWVzdBopLmhvbG9zLmNvbnNvbGUudjEuTGlzdERlcGxveW1lbnRzUmVzcG9uc2UiA5ACARJlCg1HZXREZXBsb3ltZW50EiYuaG9sb3MuY29uc29sZS52MS5HZXREZXBsb3ltZW50UmVxdWVzdBonLmhvbG9zLmNvbnNvbGUudjEuR2V0RGVwbG95bWVudFJlc3BvbnNlIgOQAgESaQoQQ3JlYXRlRGVwbG95bWVudBIpLmhvbG9zLmNvbnNvbGUudjEuQ3JlYXRlRGVwbG95bWVudFJlcXVlc3QaKi5ob2xvcy5jb25zb2xlLnYxLkNyZWF0ZURlcGxveW1lbnRSZXNwb25zZRJpChBVcGRhdGVEZXBsb3ltZW50EikuaG9sb3MuY29uc29sZS52MS5VcGRhdGVEZXBsb3ltZW50UmVxdWVzdBoqLmhvbG9zLmNvbnNvbGUudjEuVXBkYXRlRGVwbG95bWVudFJlc3BvbnNlEmkKEERlbGV0ZURlcGxveW1lbnQSKS5ob2xvcy5jb25zb2xlLnYxLkRlbGV0ZURlcGxveW1lbnRSZXF1ZXN0GiouaG9sb3MuY29uc29sZS52MS5EZWxldGVEZXBsb3ltZW50UmVzcG9uc2USdwoTR2V0RGVwbG95bWVudFN0YXR1cxIsLmhvbG9zLmNvbnNvbGUudjEuR2V0RGVwbG95bWVudFN0YXR1c1JlcXVlc3QaLS5ob2xvcy5jb25zb2xlLnYxLkdldERlcGxveW1lbnRTdGF0dXNSZXNwb25zZSIDkAIBEowBChpHZXREZXBsb3ltZW50U3RhdHVzU3VtbWFyeRIzLmhvbG9zLmNvbnNvbGUudjEuR2V0RGVwbG95bWVudFN0YXR1c1N1bW1hcnlSZXF1ZXN0GjQuaG9sb3MuY29uc29sZS52MS5HZXREZXBsb3ltZW50U3RhdHVzU3VtbWFyeVJlc3BvbnNlIgOQAgESbAoRR2V0RGVwbG95bWVudExvZ3MSKi5ob2xvcy5jb25zb2xlLnYxLkdldERlcGxveW1lbnRMb2dzUmVxdWVzdBorLmhvbG9zLmNvbnNvbGUudjEuR2V0RGVwbG95bWVudExvZ3NSZXNwb25zZRJ6ChRMaXN0TmFtZXNwYWNlU2VjcmV0cxItLmhvbG9zLmNvbnNvbGUudjEuTGlzdE5hbWVzcGFjZVNlY3JldHNSZXF1ZXN0Gi4uaG9sb3MuY29uc29sZS52MS5MaXN0TmFtZXNwYWNlU2VjcmV0c1Jlc3BvbnNlIgOQAgESgwEKF0xpc3ROYW1lc3BhY2VDb25maWdNYXBzEjAuaG9sb3MuY29uc29sZS52MS5MaXN0TmFtZXNwYWNlQ29uZmlnTWFwc1JlcXVlc3QaMS5ob2xvcy5jb25zb2xlLnYxLkxpc3ROYW1lc3BhY2VDb25maWdNYXBzUmVzcG9uc2UiA5ACARKHAQoaR2V0RGVwbG95bWVudFJlbmRlclByZXZpZXcSMy5ob2xvcy5jb25zb2xlLnYxLkdldERlcGxveW1lbnRSZW5kZXJQcmV2aWV3UmVxdWVzdBo0LmhvbG9zLmNvbnNvbGUudjEuR2V0RGVwbG95bWVudFJlbmRlclByZXZpZXdSZXNwb25zZRKGAQoYR2V0RGVwbG95bWVudFBvbGljeVN0YXRlEjEuaG9sb3MuY29uc29sZS52MS5HZXREZXBsb3ltZW50UG9saWN5U3RhdGVSZXF1ZXN0GjIuaG9sb3MuY29uc29sZS52MS5HZXREZXBsb3ltZW50UG9saWN5U3RhdGVSZXNwb25zZSIDkAIBEmMKDlByZWZsaWdodENoZWNrEicuaG9sb3MuY29uc29sZS52MS5QcmVmbGlnaHRDaGVja1JlcXVlc3QaKC5ob2xvcy5jb25zb2xlLnYxLlByZWZsaWdodENoZWNrUmVzcG9uc2USmAEKHkdldERlcGVuZGVuY3lFZGdlQ2FzY2FkZURlbGV0ZRI3LmhvbG9zLmNvbnNvbGUudjEuR2V0RGVwZW5kZW5jeUVkZ2VDYXNjYWRlRGVsZXRlUmVxdWVzdBo4LmhvbG9zLmNvbnNvbGUudjEuR2V0RGVwZW5kZW5jeUVkZ2VDYXNjYWRlRGVsZXRlUmVzcG9uc2UiA5ACARKTAQoeU2V0RGVwZW5kZW5jeUVkZ2VDYXNjYWRlRGVsZXRlEjcuaG9sb3MuY29uc29sZS52MS5TZXREZXBlbmRlbmN5RWRnZUNhc2NhZGVEZWxldGVSZXF1ZXN0GjguaG9sb3MuY29uc29sZS52MS5TZXREZXBlbmRlbmN5RWRnZUNhc2NhZGVEZWxldGVSZXNwb25zZUJDWkFnaXRodWIuY29tL2hvbG9zLXJ1bi9ob2xvcy1jb25zb2xlL2dlbi9ob2xvcy9jb25zb2xlL3YxO2NvbnNvbGV2MWIGcHJvdG8z", [file_holos_console_v1_policy_state, file_holos_console_v1_list_filter, file_holos_console_v1_rbac, file_google_protobuf_timestamp]);
 
 /**
  * Describes the message holos.console.v1.Deployment.
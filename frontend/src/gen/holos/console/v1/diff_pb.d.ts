@@ -0,0 +1,93 @@
+// @generated by protoc-gen-es v2.11.0
+// @generated from file holos/console/v1/diff.proto (package holos.console.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenEnum, GenFile, GenMessage } from "@bufbuild/protobuf/codegenv2";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file holos/console/v1/diff.proto.
+ */
+export declare const file_holos_console_v1_diff: GenFile;
+
+/**
+ * FieldChange describes how an update would change one metadata field.
+ *
+ * @generated from message holos.console.v1.FieldChange
+ */
+export declare type FieldChange = Message<"holos.console.v1.FieldChange"> & {
+  /**
+   * field is the request field name, e.g. "display_name".
+   *
+   * @generated from field: string field = 1;
+   */
+  field: string;
+
+  /**
+   * change classifies the change.
+   *
+   * @generated from field: holos.console.v1.ChangeType change = 2;
+   */
+  change: ChangeType;
+
+  /**
+   * old_value is the current value, empty when change is ADDED.
+   *
+   * @generated from field: string old_value = 3;
+   */
+  oldValue: string;
+
+  /**
+   * new_value is the proposed value, empty when change is REMOVED.
+   *
+   * @generated from field: string new_value = 4;
+   */
+  newValue: string;
+};
+
+/**
+ * Describes the message holos.console.v1.FieldChange.
+ * Use `create(FieldChangeSchema)` to create a new message.
+ */
+export declare const FieldChangeSchema: GenMessage<FieldChange>;
+
+/**
+ * ChangeType classifies one entry of a Diff* RPC response.
+ *
+ * @generated from enum holos.console.v1.ChangeType
+ */
+export enum ChangeType {
+  /**
+   * CHANGE_TYPE_UNSPECIFIED is never returned.
+   *
+   * @generated from enum value: CHANGE_TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * CHANGE_TYPE_ADDED means the update sets an entry that does not exist.
+   *
+   * @generated from enum value: CHANGE_TYPE_ADDED = 1;
+   */
+  ADDED = 1,
+
+  /**
+   * CHANGE_TYPE_REMOVED means the update removes an existing entry.
+   *
+   * @generated from enum value: CHANGE_TYPE_REMOVED = 2;
+   */
+  REMOVED = 2,
+
+  /**
+   * CHANGE_TYPE_MODIFIED means the update changes an existing entry.
+   *
+   * @generated from enum value: CHANGE_TYPE_MODIFIED = 3;
+   */
+  MODIFIED = 3,
+}
+
+/**
+ * Describes the enum holos.console.v1.ChangeType.
+ */
+export declare const ChangeTypeSchema: GenEnum<ChangeType>;
+
@@ -0,0 +1,33 @@
+// @generated by protoc-gen-es v2.11.0
+// @generated from file holos/console/v1/diff.proto (package holos.console.v1, syntax proto3)
+/* eslint-disable */
+
+import { enumDesc, fileDesc, messageDesc, tsEnum } from "@bufbuild/protobuf/codegenv2";
+
+/**
+ * Describes the file holos/console/v1/diff.proto.
+ */
+export const file_holos_console_v1_diff = /*@__PURE__*/
+  fileDesc("Chtob2xvcy9jb25zb2xlL3YxL2RpZmYucHJvdG8SEGhvbG9zLmNvbnNvbGUudjEicAoLRmllbGRDaGFuZ2USDQoFZmllbGQYASABKAkSLAoGY2hhbmdlGAIgASgOMhwuaG9sb3MuY29uc29sZS52MS5DaGFuZ2VUeXBlEhEKCW9sZF92YWx1ZRgDIAEoCRIRCgluZXdfdmFsdWUYBCABKAkqcwoKQ2hhbmdlVHlwZRIbChdDSEFOR0VfVFlQRV9VTlNQRUNJRklFRBAAEhUKEUNIQU5HRV9UWVBFX0FEREVEEAESFwoTQ0hBTkdFX1RZUEVfUkVNT1ZFRBACEhgKFENIQU5HRV9UWVBFX01PRElGSUVEEANCQ1pBZ2l0aHViLmNvbS9ob2xvcy1ydW4vaG9sb3MtY29uc29sZS9nZW4vaG9sb3MvY29uc29sZS92MTtjb25zb2xldjFiBnByb3RvMw");
+
+/**
+ * Describes the message holos.console.v1.FieldChange.
+ * Use `create(FieldChangeSchema)` to create a new message.
+ */
+export const FieldChangeSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_diff, 0);
+
+/**
+ * Describes the enum holos.console.v1.ChangeType.
+ */
+export const ChangeTypeSchema = /*@__PURE__*/
+  enumDesc(file_holos_console_v1_diff, 0);
+
+/**
+ * ChangeType classifies one entry of a Diff* RPC response.
+ *
+ * @generated from enum holos.console.v1.ChangeType
+ */
+export const ChangeType = /*@__PURE__*/
+  tsEnum(ChangeTypeSchema);
+
@@ -6,6 +6,7 @@ import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobu
 import type { Message } from "@bufbuild/protobuf";
 import type { ShareGrant } from "./secrets_pb";
 import type { Role } from "./rbac_pb";
+import type { ListOrder } from "./list_filter_pb";
 
 /**
  * Describes the file holos/console/v1/folders.proto.
@@ -142,6 +143,13 @@ export declare type ListFoldersRequest = Message<"holos.console.v1.ListFoldersRe
    * @generated from field: string parent_name = 3;
    */
   parentName: string;
+
+  /**
+   * order_by sorts the returned folders.
+   *
+   * @generated from field: holos.console.v1.ListOrder order_by = 4;
+   */
+  orderBy?: ListOrder;
 };
 
 /**
@@ -3,6 +3,8 @@
 /* eslint-disable */
 
 import { enumDesc, fileDesc, messageDesc, serviceDesc, tsEnum } from "@bufbuild/protobuf/codegenv2";
+import { file_buf_validate_validate } from "../../../buf/validate/validate_pb";
+import { file_holos_console_v1_list_filter } from "./list_filter_pb";
 import { file_holos_console_v1_rbac } from "./rbac_pb";
 import { file_holos_console_v1_secrets } from "./secrets_pb";
 
@@ -10,7 +12,7 @@ import { file_holos_console_v1_secrets } from "./secrets_pb";
  * Describes the file holos/console/v1/folders.proto.
  */
 export const file_holos_console_v1_folders = /*@__PURE__*/
-  fileDesc("Ch5ob2xvcy9jb25zb2xlL3YxL2ZvbGRlcnMucHJvdG8SEGhvbG9zLmNvbnNvbGUudjEi0QMKBkZvbGRlchIMCgRuYW1lGAEgASgJEhQKDGRpc3BsYXlfbmFtZRgCIAEoCRITCgtkZXNjcmlwdGlvbhgDIAEoCRIUCgxvcmdhbml6YXRpb24YBCABKAkSMQoLcGFyZW50X3R5cGUYBSABKA4yHC5ob2xvcy5jb25zb2xlLnYxLlBhcmVudFR5cGUSEwoLcGFyZW50X25hbWUYBiABKAkSMQoLdXNlcl9ncmFudHMYByADKAsyHC5ob2xvcy5jb25zb2xlLnYxLlNoYXJlR3JhbnQSMQoLcm9sZV9ncmFudHMYCCADKAsyHC5ob2xvcy5jb25zb2xlLnYxLlNoYXJlR3JhbnQSOQoTZGVmYXVsdF91c2VyX2dyYW50cxgJIAMoCzIcLmhvbG9zLmNvbnNvbGUudjEuU2hhcmVHcmFudBI5ChNkZWZhdWx0X3JvbGVfZ3JhbnRzGAogAygLMhwuaG9sb3MuY29uc29sZS52MS5TaGFyZUdyYW50EikKCXVzZXJfcm9sZRgLIAEoDjIWLmhvbG9zLmNvbnNvbGUudjEuUm9sZRIVCg1jcmVhdG9yX2VtYWlsGAwgASgJEhIKCmNyZWF0ZWRfYXQYDSABKAkicgoSTGlzdEZvbGRlcnNSZXF1ZXN0EhQKDG9yZ2FuaXphdGlvbhgBIAEoCRIxCgtwYXJlbnRfdHlwZRgCIAEoDjIcLmhvbG9zLmNvbnNvbGUudjEuUGFyZW50VHlwZRITCgtwYXJlbnRfbmFtZRgDIAEoCSJAChNMaXN0Rm9sZGVyc1Jlc3BvbnNlEikKB2ZvbGRlcnMYASADKAsyGC5ob2xvcy5jb25zb2xlLnYxLkZvbGRlciI2ChBHZXRGb2xkZXJSZXF1ZXN0EgwKBG5hbWUYASABKAkSFAoMb3JnYW5pemF0aW9uGAIgASgJIj0KEUdldEZvbGRlclJlc3BvbnNlEigKBmZvbGRlchgBIAEoCzIYLmhvbG9zLmNvbnNvbGUudjEuRm9sZGVyIpICChNDcmVhdGVGb2xkZXJSZXF1ZXN0EgwKBG5hbWUYASABKAkSFAoMZGlzcGxheV9uYW1lGAIgASgJEhMKC2Rlc2NyaXB0aW9uGAMgASgJEhQKDG9yZ2FuaXphdGlvbhgEIAEoCRIxCgtwYXJlbnRfdHlwZRgFIAEoDjIcLmhvbG9zLmNvbnNvbGUudjEuUGFyZW50VHlwZRITCgtwYXJlbnRfbmFtZRgGIAEoCRIxCgt1c2VyX2dyYW50cxgHIAMoCzIcLmhvbG9zLmNvbnNvbGUudjEuU2hhcmVHcmFudBIxCgtyb2xlX2dyYW50cxgIIAMoCzIcLmhvbG9zLmNvbnNvbGUudjEuU2hhcmVHcmFudCIkChRDcmVhdGVGb2xkZXJSZXNwb25zZRIMCgRuYW1lGAEgASgJIoECChNVcGRhdGVGb2xkZXJSZXF1ZXN0EgwKBG5hbWUYASABKAkSFAoMb3JnYW5pemF0aW9uGAIgASgJEhkKDGRpc3BsYXlfbmFtZRgDIAEoCUgAiAEBEhgKC2Rlc2NyaXB0aW9uGAQgASgJSAGIAQESNgoLcGFyZW50X3R5cGUYBSABKA4yHC5ob2xvcy5jb25zb2xlLnYxLlBhcmVudFR5cGVIAogBARIYCgtwYXJlbnRfbmFtZRgGIAEoCUgDiAEBQg8KDV9kaXNwbGF5X25hbWVCDgoMX2Rlc2NyaXB0aW9uQg4KDF9wYXJlbnRfdHlwZUIOCgxfcGFyZW50X25hbWUiFgoUVXBkYXRlRm9sZGVyUmVzcG9uc2UiOQoTRGVsZXRlRm9sZGVyUmVxdWVzdBIMCgRuYW1lGAEgASgJEhQKDG9yZ2FuaXphdGlvbhgCIAEoCSIWChREZWxldGVGb2xkZXJSZXNwb25zZSKmAQoaVXBkYXRlRm9sZGVyU2hhcmluZ1JlcXVlc3QSDAoEbmFtZRgBIAEoCRIUCgxvcmdhbml6YXRpb24YAiABKAkSMQoLdXNlcl9ncmFudHMYAyADKAsyHC5ob2xvcy5jb25zb2xlLnYxLlNoYXJlR3JhbnQSMQoLcm9sZV9ncmFudHMYBCADKAsyHC5ob2xvcy5jb25zb2xlLnYxLlNoYXJlR3JhbnQiRwobVXBkYXRlRm9sZGVyU2hhcmluZ1Jlc3BvbnNlEigKBmZvbGRlchgBIAEoCzIYLmhvbG9zLmNvbnNvbGUudjEuRm9sZGVyIr0BCiFVcGRhdGVGb2xkZXJEZWZhdWx0U2hhcmluZ1JlcXVlc3QSDAoEbmFtZRgBIAEoCRIUCgxvcmdhbml6YXRpb24YAiABKAkSOQoTZGVmYXVsdF91c2VyX2dyYW50cxgDIAMoCzIcLmhvbG9zLmNvbnNvbGUudjEuU2hhcmVHcmFudBI5ChNkZWZhdWx0X3JvbGVfZ3JhbnRzGAQgAygLMhwuaG9sb3MuY29uc29sZS52MS5TaGFyZUdyYW50Ik4KIlVwZGF0ZUZvbGRlckRlZmF1bHRTaGFyaW5nUmVzcG9uc2USKAoGZm9sZGVyGAEgASgLMhguaG9sb3MuY29uc29sZS52MS5Gb2xkZXIiOQoTR2V0Rm9sZGVyUmF3UmVxdWVzdBIMCgRuYW1lGAEgASgJEhQKDG9yZ2FuaXphdGlvbhgCIAEoCSIjChRHZXRGb2xkZXJSYXdSZXNwb25zZRILCgNyYXcYASABKAkiMgocQ2hlY2tGb2xkZXJJZGVudGlmaWVyUmVxdWVzdBISCgppZGVudGlmaWVyGAEgASgJIlAKHUNoZWNrRm9sZGVySWRlbnRpZmllclJlc3BvbnNlEhEKCWF2YWlsYWJsZRgBIAEoCBIcChRzdWdnZXN0ZWRfaWRlbnRpZmllchgCIAEoCSpfCgpQYXJlbnRUeXBlEhsKF1BBUkVOVF9UWVBFX1VOU1BFQ0lGSUVEEAASHAoYUEFSRU5UX1RZUEVfT1JHQU5JWkFUSU9OEAESFgoSUEFSRU5UX1RZUEVfRk9MREVSEAIytQcKDUZvbGRlclNlcnZpY2USWgoLTGlzdEZvbGRlcnMSJC5ob2xvcy5jb25zb2xlLnYxLkxpc3RGb2xkZXJzUmVxdWVzdBolLmhvbG9zLmNvbnNvbGUudjEuTGlzdEZvbGRlcnNSZXNwb25zZRJUCglHZXRGb2xkZXISIi5ob2xvcy5jb25zb2xlLnYxLkdldEZvbGRlclJlcXVlc3QaIy5ob2xvcy5jb25zb2xlLnYxLkdldEZvbGRlclJlc3BvbnNlEl0KDENyZWF0ZUZvbGRlchIlLmhvbG9zLmNvbnNvbGUudjEuQ3JlYXRlRm9sZGVyUmVxdWVzdBomLmhvbG9zLmNvbnNvbGUudjEuQ3JlYXRlRm9sZGVyUmVzcG9uc2USXQoMVXBkYXRlRm9sZGVyEiUuaG9sb3MuY29uc29sZS52MS5VcGRhdGVGb2xkZXJSZXF1ZXN0GiYuaG9sb3MuY29uc29sZS52MS5VcGRhdGVGb2xkZXJSZXNwb25zZRJdCgxEZWxldGVGb2xkZXISJS5ob2xvcy5jb25zb2xlLnYxLkRlbGV0ZUZvbGRlclJlcXVlc3QaJi5ob2xvcy5jb25zb2xlLnYxLkRlbGV0ZUZvbGRlclJlc3BvbnNlEnIKE1VwZGF0ZUZvbGRlclNoYXJpbmcSLC5ob2xvcy5jb25zb2xlLnYxLlVwZGF0ZUZvbGRlclNoYXJpbmdSZXF1ZXN0Gi0uaG9sb3MuY29uc29sZS52MS5VcGRhdGVGb2xkZXJTaGFyaW5nUmVzcG9uc2UShwEKGlVwZGF0ZUZvbGRlckRlZmF1bHRTaGFyaW5nEjMuaG9sb3MuY29uc29sZS52MS5VcGRhdGVGb2xkZXJEZWZhdWx0U2hhcmluZ1JlcXVlc3QaNC5ob2xvcy5jb25zb2xlLnYxLlVwZGF0ZUZvbGRlckRlZmF1bHRTaGFyaW5nUmVzcG9uc2USXQoMR2V0Rm9sZGVyUmF3EiUuaG9sb3MuY29uc29sZS52MS5HZXRGb2xkZXJSYXdSZXF1ZXN0GiYuaG9sb3MuY29uc29sZS52MS5HZXRGb2xkZXJSYXdSZXNwb25zZRJ4ChVDaGVja0ZvbGRlcklkZW50aWZpZXISLi5ob2xvcy5jb25zb2xlLnYxLkNoZWNrRm9sZGVySWRlbnRpZmllclJlcXVlc3QaLy5ob2xvcy5jb25zb2xlLnYxLkNoZWNrRm9sZGVySWRlbnRpZmllclJlc3BvbnNlQkNaQWdpdGh1Yi5jb20vaG9sb3MtcnVuL2hvbG9zLWNvbnNvbGUvZ2VuL2hvbG9zL2NvbnNvbGUvdjE7Y29uc29sZXYxYgZwcm90bzM", [file_holos_console_v1_rbac, file_holos_console_v1_secrets]);
+  fileDesc("Ch5ob2xvcy9jb25zb2xlL3YxL2ZvbGRlcnMucHJvdG8SEGhvbG9zLmNvbnNvbGUudjEi0QMKBkZvbGRlchIMCgRuYW1lGAEgASgJEhQKDGRpc3BsYXlfbmFtZRgCIAEoCRITCgtkZXNjcmlwdGlvbhgDIAEoCRIUCgxvcmdhbml6YXRpb24YBCABKAkSMQoLcGFyZW50X3R5cGUYBSABKA4yHC5ob2xvcy5jb25zb2xlLnYxLlBhcmVudFR5cGUSEwoLcGFyZW50X25hbWUYBiABKAkSMQoLdXNlcl9ncmFudHMYByADKAsyHC5ob2xvcy5jb25zb2xlLnYxLlNoYXJlR3JhbnQSMQoLcm9sZV9ncmFudHMYCCADKAsyHC5ob2xvcy5jb25zb2xlLnYxLlNoYXJlR3JhbnQSOQoTZGVmYXVsdF91c2VyX2dyYW50cxgJIAMoCzIcLmhvbG9zLmNvbnNvbGUudjEuU2hhcmVHcmFudBI5ChNkZWZhdWx0X3JvbGVfZ3JhbnRzGAogAygLMhwuaG9sb3MuY29uc29sZS52MS5TaGFyZUdyYW50EikKCXVzZXJfcm9sZRgLIAEoDjIWLmhvbG9zLmNvbnNvbGUudjEuUm9sZRIVCg1jcmVhdG9yX2VtYWlsGAwgASgJEhIKCmNyZWF0ZWRfYXQYDSABKAkioQEKEkxpc3RGb2xkZXJzUmVxdWVzdBIUCgxvcmdhbml6YXRpb24YASABKAkSMQoLcGFyZW50X3R5cGUYAiABKA4yHC5ob2xvcy5jb25zb2xlLnYxLlBhcmVudFR5cGUSEwoLcGFyZW50X25hbWUYAyABKAkSLQoIb3JkZXJfYnkYBCABKAsyGy5ob2xvcy5jb25zb2xlLnYxLkxpc3RPcmRlciJAChNMaXN0Rm9sZGVyc1Jlc3BvbnNlEikKB2ZvbGRlcnMYASADKAsyGC5ob2xvcy5jb25zb2xlLnYxLkZvbGRlciI+ChBHZXRGb2xkZXJSZXF1ZXN0EhQKBG5hbWUYASABKAlCBrpIA8gBARIUCgxvcmdhbml6YXRpb24YAiABKAkiPQoRR2V0Rm9sZGVyUmVzcG9uc2USKAoGZm9sZGVyGAEgASgLMhguaG9sb3MuY29uc29sZS52MS5Gb2xkZXIi0QMKE0NyZWF0ZUZvbGRlclJlcXVlc3QSMwoEbmFtZRgBIAEoCUIlukgi2AEBch0YPzIZXlthLXpdW2EtejAtOS1dKlthLXowLTldJBIUCgxkaXNwbGF5X25hbWUYAiABKAkSHQoLZGVzY3JpcHRpb24YAyABKAlCCLpIBXIDGIAgEhwKDG9yZ2FuaXphdGlvbhgEIAEoCUIGukgDyAEBEj0KC3BhcmVudF90eXBlGAUgASgOMhwuaG9sb3MuY29uc29sZS52MS5QYXJlbnRUeXBlQgq6SAeCAQQQASAAEhsKC3BhcmVudF9uYW1lGAYgASgJQga6SAPIAQESMQoLdXNlcl9ncmFudHMYByADKAsyHC5ob2xvcy5jb25zb2xlLnYxLlNoYXJlR3JhbnQSMQoLcm9sZV9ncmFudHMYCCADKAsyHC5ob2xvcy5jb25zb2xlLnYxLlNoYXJlR3JhbnQ6cLpIbRprChRuYW1lX29yX2Rpc3BsYXlfbmFtZRInZm9sZGVyIG5hbWUgb3IgZGlzcGxheV9uYW1lIGlzIHJlcXVpcmVkGip0aGlzLm5hbWUgIT0gJycgfHwgdGhpcy5kaXNwbGF5X25hbWUgIT0gJyciJAoUQ3JlYXRlRm9sZGVyUmVzcG9uc2USDAoEbmFtZRgBIAEoCSKTAgoTVXBkYXRlRm9sZGVyUmVxdWVzdBIUCgRuYW1lGAEgASgJQga6SAPIAQESFAoMb3JnYW5pemF0aW9uGAIgASgJEhkKDGRpc3BsYXlfbmFtZRgDIAEoCUgAiAEBEiIKC2Rlc2NyaXB0aW9uGAQgASgJQgi6SAVyAxiAIEgBiAEBEjYKC3BhcmVudF90eXBlGAUgASgOMhwuaG9sb3MuY29uc29sZS52MS5QYXJlbnRUeXBlSAKIAQESGAoLcGFyZW50X25hbWUYBiABKAlIA4gBAUIPCg1fZGlzcGxheV9uYW1lQg4KDF9kZXNjcmlwdGlvbkIOCgxfcGFyZW50X3R5cGVCDgoMX3BhcmVudF9uYW1lIhYKFFVwZGF0ZUZvbGRlclJlc3BvbnNlIkEKE0RlbGV0ZUZvbGRlclJlcXVlc3QSFAoEbmFtZRgBIAEoCUIGukgDyAEBEhQKDG9yZ2FuaXphdGlvbhgCIAEoCSIWChREZWxldGVGb2xkZXJSZXNwb25zZSKuAQoaVXBkYXRlRm9sZGVyU2hhcmluZ1JlcXVlc3QSFAoEbmFtZRgBIAEoCUIGukgDyAEBEhQKDG9yZ2FuaXphdGlvbhgCIAEoCRIxCgt1c2VyX2dyYW50cxgDIAMoCzIcLmhvbG9zLmNvbnNvbGUudjEuU2hhcmVHcmFudBIxCgtyb2xlX2dyYW50cxgEIAMoCzIcLmhvbG9zLmNvbnNvbGUudjEuU2hhcmVHcmFudCJHChtVcGRhdGVGb2xkZXJTaGFyaW5nUmVzcG9uc2USKAoGZm9sZGVyGAEgASgLMhguaG9sb3MuY29uc29sZS52MS5Gb2xkZXIixQEKIVVwZGF0ZUZvbGRlckRlZmF1bHRTaGFyaW5nUmVxdWVzdBIUCgRuYW1lGAEgASgJQga6SAPIAQESFAoMb3JnYW5pemF0aW9uGAIgASgJEjkKE2RlZmF1bHRfdXNlcl9ncmFudHMYAyADKAsyHC5ob2xvcy5jb25zb2xlLnYxLlNoYXJlR3JhbnQSOQoTZGVmYXVsdF9yb2xlX2dyYW50cxgEIAMoCzIcLmhvbG9zLmNvbnNvbGUudjEuU2hhcmVHcmFudCJOCiJVcGRhdGVGb2xkZXJEZWZhdWx0U2hhcmluZ1Jlc3BvbnNlEigKBmZvbGRlchgBIAEoCzIYLmhvbG9zLmNvbnNvbGUudjEuRm9sZGVyIkEKE0dldEZvbGRlclJhd1JlcXVlc3QSFAoEbmFtZRgBIAEoCUIGukgDyAEBEhQKDG9yZ2FuaXphdGlvbhgCIAEoCSIjChRHZXRGb2xkZXJSYXdSZXNwb25zZRILCgNyYXcYASABKAkiOgocQ2hlY2tGb2xkZXJJZGVudGlmaWVyUmVxdWVzdBIaCgppZGVudGlmaWVyGAEgASgJQga6SAPIAQEiUAodQ2hlY2tGb2xkZXJJZGVudGlmaWVyUmVzcG9uc2USEQoJYXZhaWxhYmxlGAEgASgIEhwKFHN1Z2dlc3RlZF9pZGVudGlmaWVyGAIgASgJKl8KClBhcmVudFR5cGUSGwoXUEFSRU5UX1RZUEVfVU5TUEVDSUZJRUQQABIcChhQQVJFTlRfVFlQRV9PUkdBTklaQVRJT04QARIWChJQQVJFTlRfVFlQRV9GT0xERVIQAjLJBwoNRm9sZGVyU2VydmljZRJfCgtMaXN0Rm9sZGVycxIkLmhvbG9zLmNvbnNvbGUudjEuTGlzdEZvbGRlcnNSZXF1ZXN0GiUuaG9sb3MuY29uc29sZS52MS5MaXN0Rm9sZGVyc1Jlc3BvbnNlIgOQAgESWQoJR2V0Rm9sZGVyEiIuaG9sb3MuY29uc29sZS52MS5HZXRGb2xkZXJSZXF1ZXN0GiMuaG9sb3MuY29uc29sZS52MS5HZXRGb2xkZXJSZXNwb25zZSIDkAIBEl0KDENyZWF0ZUZvbGRlchIlLmhvbG9zLmNvbnNvbGUudjEuQ3JlYXRlRm9sZGVyUmVxdWVzdBomLmhvbG9zLmNvbnNvbGUudjEuQ3JlYXRlRm9sZGVyUmVzcG9uc2USXQoMVXBkYXRlRm9sZGVyEiUuaG9sb3MuY29uc29sZS52MS5VcGRhdGVGb2xkZXJSZXF1ZXN0GiYuaG9sb3MuY29uc29sZS52MS5VcGRhdGVGb2xkZXJSZXNwb25zZRJdCgxEZWxldGVGb2xkZXISJS5ob2xvcy5jb25zb2xlLnYxLkRlbGV0ZUZvbGRlclJlcXVlc3QaJi5ob2xvcy5jb25zb2xlLnYxLkRlbGV0ZUZvbGRlclJlc3BvbnNlEnIKE1VwZGF0ZUZvbGRlclNoYXJpbmcSLC5ob2xvcy5jb25zb2xlLnYxLlVwZGF0ZUZvbGRlclNoYXJpbmdSZXF1ZXN0Gi0uaG9sb3MuY29uc29sZS52MS5VcGRhdGVGb2xkZXJTaGFyaW5nUmVzcG9uc2UShwEKGlVwZGF0ZUZvbGRlckRlZmF1bHRTaGFyaW5nEjMuaG9sb3MuY29uc29sZS52MS5VcGRhdGVGb2xkZXJEZWZhdWx0U2hhcmluZ1JlcXVlc3QaNC5ob2xvcy5jb25zb2xlLnYxLlVwZGF0ZUZvbGRlckRlZmF1bHRTaGFyaW5nUmVzcG9uc2USYgoMR2V0Rm9sZGVyUmF3EiUuaG9sb3MuY29uc29sZS52MS5HZXRGb2xkZXJSYXdSZXF1ZXN0GiYuaG9sb3MuY29uc29sZS52MS5HZXRGb2xkZXJSYXdSZXNwb25zZSIDkAIBEn0KFUNoZWNrRm9sZGVySWRlbnRpZmllchIuLmhvbG9zLmNvbnNvbGUudjEuQ2hlY2tGb2xkZXJJZGVudGlmaWVyUmVxdWVzdBovLmhvbG9zLmNvbnNvbGUudjEuQ2hlY2tGb2xkZXJJZGVudGlmaWVyUmVzcG9uc2UiA5ACAUJDWkFnaXRodWIuY29tL2hvbG9zLXJ1bi9ob2xvcy1jb25zb2xlL2dlbi9ob2xvcy9jb25zb2xlL3YxO2NvbnNvbGV2MWIGcHJvdG8z", [file_buf_validate_validate, file_holos_console_v1_list_filter, file_holos_console_v1_rbac, file_holos_console_v1_secrets]);
 
 /**
  * Describes the message holos.console.v1.Folder.
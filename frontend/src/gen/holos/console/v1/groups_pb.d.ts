@@ -0,0 +1,213 @@
+// @generated by protoc-gen-es v2.11.0
+// @generated from file holos/console/v1/groups.proto (package holos.console.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file holos/console/v1/groups.proto.
+ */
+export declare const file_holos_console_v1_groups: GenFile;
+
+/**
+ * Group is a console-local group.
+ *
+ * @generated from message holos.console.v1.Group
+ */
+export declare type Group = Message<"holos.console.v1.Group"> & {
+  /**
+   * name is the group name as it appears in the groups claim.
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * members are the email addresses of the group's members, sorted.
+   *
+   * @generated from field: repeated string members = 2;
+   */
+  members: string[];
+};
+
+/**
+ * Describes the message holos.console.v1.Group.
+ * Use `create(GroupSchema)` to create a new message.
+ */
+export declare const GroupSchema: GenMessage<Group>;
+
+/**
+ * ListGroupsRequest lists console-local groups.
+ *
+ * @generated from message holos.console.v1.ListGroupsRequest
+ */
+export declare type ListGroupsRequest = Message<"holos.console.v1.ListGroupsRequest"> & {
+};
+
+/**
+ * Describes the message holos.console.v1.ListGroupsRequest.
+ * Use `create(ListGroupsRequestSchema)` to create a new message.
+ */
+export declare const ListGroupsRequestSchema: GenMessage<ListGroupsRequest>;
+
+/**
+ * ListGroupsResponse contains every console-local group, sorted by name.
+ *
+ * @generated from message holos.console.v1.ListGroupsResponse
+ */
+export declare type ListGroupsResponse = Message<"holos.console.v1.ListGroupsResponse"> & {
+  /**
+   * @generated from field: repeated holos.console.v1.Group groups = 1;
+   */
+  groups: Group[];
+};
+
+/**
+ * Describes the message holos.console.v1.ListGroupsResponse.
+ * Use `create(ListGroupsResponseSchema)` to create a new message.
+ */
+export declare const ListGroupsResponseSchema: GenMessage<ListGroupsResponse>;
+
+/**
+ * AddGroupMemberRequest adds a member to a group.
+ *
+ * @generated from message holos.console.v1.AddGroupMemberRequest
+ */
+export declare type AddGroupMemberRequest = Message<"holos.console.v1.AddGroupMemberRequest"> & {
+  /**
+   * group is the group name. It must be a valid Kubernetes label value so it
+   * can be bound in RBAC.
+   *
+   * @generated from field: string group = 1;
+   */
+  group: string;
+
+  /**
+   * member is the email address of the user to add.
+   *
+   * @generated from field: string member = 2;
+   */
+  member: string;
+};
+
+/**
+ * Describes the message holos.console.v1.AddGroupMemberRequest.
+ * Use `create(AddGroupMemberRequestSchema)` to create a new message.
+ */
+export declare const AddGroupMemberRequestSchema: GenMessage<AddGroupMemberRequest>;
+
+/**
+ * AddGroupMemberResponse returns the updated group.
+ *
+ * @generated from message holos.console.v1.AddGroupMemberResponse
+ */
+export declare type AddGroupMemberResponse = Message<"holos.console.v1.AddGroupMemberResponse"> & {
+  /**
+   * @generated from field: holos.console.v1.Group group = 1;
+   */
+  group?: Group;
+};
+
+/**
+ * Describes the message holos.console.v1.AddGroupMemberResponse.
+ * Use `create(AddGroupMemberResponseSchema)` to create a new message.
+ */
+export declare const AddGroupMemberResponseSchema: GenMessage<AddGroupMemberResponse>;
+
+/**
+ * RemoveGroupMemberRequest removes a member from a group.
+ *
+ * @generated from message holos.console.v1.RemoveGroupMemberRequest
+ */
+export declare type RemoveGroupMemberRequest = Message<"holos.console.v1.RemoveGroupMemberRequest"> & {
+  /**
+   * group is the group name.
+   *
+   * @generated from field: string group = 1;
+   */
+  group: string;
+
+  /**
+   * member is the email address of the user to remove.
+   *
+   * @generated from field: string member = 2;
+   */
+  member: string;
+};
+
+/**
+ * Describes the message holos.console.v1.RemoveGroupMemberRequest.
+ * Use `create(RemoveGroupMemberRequestSchema)` to create a new message.
+ */
+export declare const RemoveGroupMemberRequestSchema: GenMessage<RemoveGroupMemberRequest>;
+
+/**
+ * RemoveGroupMemberResponse returns the updated group, empty when the group
+ * was deleted.
+ *
+ * @generated from message holos.console.v1.RemoveGroupMemberResponse
+ */
+export declare type RemoveGroupMemberResponse = Message<"holos.console.v1.RemoveGroupMemberResponse"> & {
+  /**
+   * @generated from field: holos.console.v1.Group group = 1;
+   */
+  group?: Group;
+};
+
+/**
+ * Describes the message holos.console.v1.RemoveGroupMemberResponse.
+ * Use `create(RemoveGroupMemberResponseSchema)` to create a new message.
+ */
+export declare const RemoveGroupMemberResponseSchema: GenMessage<RemoveGroupMemberResponse>;
+
+/**
+ * GroupsService manages console-local group membership for installs whose
+ * identity provider has no groups, such as embedded Dex with static users.
+ * Memberships are stored in a console-managed ConfigMap and merged into the
+ * groups of every user authenticated by email, so they work anywhere an IdP
+ * group does: sharing grants, cascades, and Kubernetes RBAC bindings on
+ * oidc:-prefixed groups.
+ *
+ * Access is arbitrated by the Kubernetes API server (ADR 036): listing
+ * requires "list" and changing membership requires "update" on the virtual
+ * cluster-scoped resource groups.console.holos.run. Every change emits an
+ * audit event (group_member_add, group_member_remove).
+ *
+ * @generated from service holos.console.v1.GroupsService
+ */
+export declare const GroupsService: GenService<{
+  /**
+   * ListGroups returns every console-local group and its members.
+   *
+   * @generated from rpc holos.console.v1.GroupsService.ListGroups
+   */
+  listGroups: {
+    methodKind: "unary";
+    input: typeof ListGroupsRequestSchema;
+    output: typeof ListGroupsResponseSchema;
+  },
+  /**
+   * AddGroupMember adds member to group, creating the group if needed.
+   * Adding an existing member is a no-op.
+   *
+   * @generated from rpc holos.console.v1.GroupsService.AddGroupMember
+   */
+  addGroupMember: {
+    methodKind: "unary";
+    input: typeof AddGroupMemberRequestSchema;
+    output: typeof AddGroupMemberResponseSchema;
+  },
+  /**
+   * RemoveGroupMember removes member from group. A group with no members
+   * left is deleted.
+   *
+   * @generated from rpc holos.console.v1.GroupsService.RemoveGroupMember
+   */
+  removeGroupMember: {
+    methodKind: "unary";
+    input: typeof RemoveGroupMemberRequestSchema;
+    output: typeof RemoveGroupMemberResponseSchema;
+  },
+}>;
+
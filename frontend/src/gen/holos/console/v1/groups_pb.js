@@ -0,0 +1,80 @@
+// @generated by protoc-gen-es v2.11.0
+// @generated from file holos/console/v1/groups.proto (package holos.console.v1, syntax proto3)
+/* eslint-disable */
+
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import { file_buf_validate_validate } from "../../../buf/validate/validate_pb";
+
+/**
+ * Describes the file holos/console/v1/groups.proto.
+ */
+export const file_holos_console_v1_groups = /*@__PURE__*/
+  fileDesc("Ch1ob2xvcy9jb25zb2xlL3YxL2dyb3Vwcy5wcm90bxIQaG9sb3MuY29uc29sZS52MSImCgVHcm91cBIMCgRuYW1lGAEgASgJEg8KB21lbWJlcnMYAiADKAkiEwoRTGlzdEdyb3Vwc1JlcXVlc3QiPQoSTGlzdEdyb3Vwc1Jlc3BvbnNlEicKBmdyb3VwcxgBIAMoCzIXLmhvbG9zLmNvbnNvbGUudjEuR3JvdXAicQoVQWRkR3JvdXBNZW1iZXJSZXF1ZXN0EjwKBWdyb3VwGAEgASgJQi26SCrIAQFyJRg/MiFeW2EtejAtOV0oW2EtejAtOS5fLV0qW2EtejAtOV0pPyQSGgoGbWVtYmVyGAIgASgJQgq6SAfIAQFyAmABIkAKFkFkZEdyb3VwTWVtYmVyUmVzcG9uc2USJgoFZ3JvdXAYASABKAsyFy5ob2xvcy5jb25zb2xlLnYxLkdyb3VwIkkKGFJlbW92ZUdyb3VwTWVtYmVyUmVxdWVzdBIVCgVncm91cBgBIAEoCUIGukgDyAEBEhYKBm1lbWJlchgCIAEoCUIGukgDyAEBIkMKGVJlbW92ZUdyb3VwTWVtYmVyUmVzcG9uc2USJgoFZ3JvdXAYASABKAsyFy5ob2xvcy5jb25zb2xlLnYxLkdyb3VwMsACCg1Hcm91cHNTZXJ2aWNlElwKCkxpc3RHcm91cHMSIy5ob2xvcy5jb25zb2xlLnYxLkxpc3RHcm91cHNSZXF1ZXN0GiQuaG9sb3MuY29uc29sZS52MS5MaXN0R3JvdXBzUmVzcG9uc2UiA5ACARJjCg5BZGRHcm91cE1lbWJlchInLmhvbG9zLmNvbnNvbGUudjEuQWRkR3JvdXBNZW1iZXJSZXF1ZXN0GiguaG9sb3MuY29uc29sZS52MS5BZGRHcm91cE1lbWJlclJlc3BvbnNlEmwKEVJlbW92ZUdyb3VwTWVtYmVyEiouaG9sb3MuY29uc29sZS52MS5SZW1vdmVHcm91cE1lbWJlclJlcXVlc3QaKy5ob2xvcy5jb25zb2xlLnYxLlJlbW92ZUdyb3VwTWVtYmVyUmVzcG9uc2VCQ1pBZ2l0aHViLmNvbS9ob2xvcy1ydW4vaG9sb3MtY29uc29sZS9nZW4vaG9sb3MvY29uc29sZS92MTtjb25zb2xldjFiBnByb3RvMw", [file_buf_validate_validate]);
+
+/**
+ * Describes the message holos.console.v1.Group.
+ * Use `create(GroupSchema)` to create a new message.
+ */
+export const GroupSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_groups, 0);
+
+/**
+ * Describes the message holos.console.v1.ListGroupsRequest.
+ * Use `create(ListGroupsRequestSchema)` to create a new message.
+ */
+export const ListGroupsRequestSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_groups, 1);
+
+/**
+ * Describes the message holos.console.v1.ListGroupsResponse.
+ * Use `create(ListGroupsResponseSchema)` to create a new message.
+ */
+export const ListGroupsResponseSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_groups, 2);
+
+/**
+ * Describes the message holos.console.v1.AddGroupMemberRequest.
+ * Use `create(AddGroupMemberRequestSchema)` to create a new message.
+ */
+export const AddGroupMemberRequestSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_groups, 3);
+
+/**
+ * Describes the message holos.console.v1.AddGroupMemberResponse.
+ * Use `create(AddGroupMemberResponseSchema)` to create a new message.
+ */
+export const AddGroupMemberResponseSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_groups, 4);
+
+/**
+ * Describes the message holos.console.v1.RemoveGroupMemberRequest.
+ * Use `create(RemoveGroupMemberRequestSchema)` to create a new message.
+ */
+export const RemoveGroupMemberRequestSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_groups, 5);
+
+/**
+ * Describes the message holos.console.v1.RemoveGroupMemberResponse.
+ * Use `create(RemoveGroupMemberResponseSchema)` to create a new message.
+ */
+export const RemoveGroupMemberResponseSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_groups, 6);
+
+/**
+ * GroupsService manages console-local group membership for installs whose
+ * identity provider has no groups, such as embedded Dex with static users.
+ * Memberships are stored in a console-managed ConfigMap and merged into the
+ * groups of every user authenticated by email, so they work anywhere an IdP
+ * group does: sharing grants, cascades, and Kubernetes RBAC bindings on
+ * oidc:-prefixed groups.
+ *
+ * Access is arbitrated by the Kubernetes API server (ADR 036): listing
+ * requires "list" and changing membership requires "update" on the virtual
+ * cluster-scoped resource groups.console.holos.run. Every change emits an
+ * audit event (group_member_add, group_member_remove).
+ *
+ * @generated from service holos.console.v1.GroupsService
+ */
+export const GroupsService = /*@__PURE__*/
+  serviceDesc(file_holos_console_v1_groups, 0);
+
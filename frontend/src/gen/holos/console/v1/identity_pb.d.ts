@@ -0,0 +1,278 @@
+// @generated by protoc-gen-es v2.11.0
+// @generated from file holos/console/v1/identity.proto (package holos.console.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import type { Message } from "@bufbuild/protobuf";
+import type { Timestamp } from "@bufbuild/protobuf/wkt";
+
+/**
+ * Describes the file holos/console/v1/identity.proto.
+ */
+export declare const file_holos_console_v1_identity: GenFile;
+
+/**
+ * WhoAmIRequest is empty as no parameters are needed.
+ *
+ * @generated from message holos.console.v1.WhoAmIRequest
+ */
+export declare type WhoAmIRequest = Message<"holos.console.v1.WhoAmIRequest"> & {
+};
+
+/**
+ * Describes the message holos.console.v1.WhoAmIRequest.
+ * Use `create(WhoAmIRequestSchema)` to create a new message.
+ */
+export declare const WhoAmIRequestSchema: GenMessage<WhoAmIRequest>;
+
+/**
+ * WhoAmIResponse describes the authenticated caller.
+ *
+ * @generated from message holos.console.v1.WhoAmIResponse
+ */
+export declare type WhoAmIResponse = Message<"holos.console.v1.WhoAmIResponse"> & {
+  /**
+   * issuer is the iss claim of the caller's token. Empty for Kubernetes
+   * ServiceAccounts.
+   *
+   * @generated from field: string issuer = 1;
+   */
+  issuer: string;
+
+  /**
+   * subject is the sub claim, or the ServiceAccount username
+   * (system:serviceaccount:<namespace>:<name>).
+   *
+   * @generated from field: string subject = 2;
+   */
+  subject: string;
+
+  /**
+   * email is the email claim.
+   *
+   * @generated from field: string email = 3;
+   */
+  email: string;
+
+  /**
+   * email_verified is the email_verified claim.
+   *
+   * @generated from field: bool email_verified = 4;
+   */
+  emailVerified: boolean;
+
+  /**
+   * name is the name claim.
+   *
+   * @generated from field: string name = 5;
+   */
+  name: string;
+
+  /**
+   * groups are the values of the configured roles claim (--roles-claim),
+   * or the ServiceAccount's groups.
+   *
+   * @generated from field: repeated string groups = 6;
+   */
+  groups: string[];
+
+  /**
+   * principal_type is "user" for OIDC users and "serviceaccount" for
+   * Kubernetes ServiceAccounts.
+   *
+   * @generated from field: string principal_type = 7;
+   */
+  principalType: string;
+
+  /**
+   * issued_at is the iat claim. Unset when the token has none.
+   *
+   * @generated from field: google.protobuf.Timestamp issued_at = 8;
+   */
+  issuedAt?: Timestamp;
+
+  /**
+   * expires_at is the exp claim. Unset when the token has none.
+   *
+   * @generated from field: google.protobuf.Timestamp expires_at = 9;
+   */
+  expiresAt?: Timestamp;
+
+  /**
+   * kubernetes_user is the username the console impersonates for the
+   * caller's Kubernetes requests, e.g. "oidc:<sub>".
+   *
+   * @generated from field: string kubernetes_user = 10;
+   */
+  kubernetesUser: string;
+
+  /**
+   * kubernetes_groups are the groups the console impersonates for the
+   * caller's Kubernetes requests, e.g. "oidc:<group>".
+   *
+   * @generated from field: repeated string kubernetes_groups = 11;
+   */
+  kubernetesGroups: string[];
+
+  /**
+   * platform_roles are the console-wide roles the caller holds, e.g.
+   * "organization-creator". Organization, folder and project roles are
+   * reported by the resource's own RPCs.
+   *
+   * @generated from field: repeated string platform_roles = 12;
+   */
+  platformRoles: string[];
+
+  /**
+   * impersonator is the email (or subject) of the real principal when an
+   * administrator is acting as this caller via X-Impersonate-User.
+   *
+   * @generated from field: string impersonator = 13;
+   */
+  impersonator: string;
+};
+
+/**
+ * Describes the message holos.console.v1.WhoAmIResponse.
+ * Use `create(WhoAmIResponseSchema)` to create a new message.
+ */
+export declare const WhoAmIResponseSchema: GenMessage<WhoAmIResponse>;
+
+/**
+ * GetSessionRequest is empty as no parameters are needed.
+ *
+ * @generated from message holos.console.v1.GetSessionRequest
+ */
+export declare type GetSessionRequest = Message<"holos.console.v1.GetSessionRequest"> & {
+};
+
+/**
+ * Describes the message holos.console.v1.GetSessionRequest.
+ * Use `create(GetSessionRequestSchema)` to create a new message.
+ */
+export declare const GetSessionRequestSchema: GenMessage<GetSessionRequest>;
+
+/**
+ * GetSessionResponse describes the caller's session.
+ *
+ * @generated from message holos.console.v1.GetSessionResponse
+ */
+export declare type GetSessionResponse = Message<"holos.console.v1.GetSessionResponse"> & {
+  /**
+   * expires_at is when the console stops accepting the caller's token: the
+   * earlier of the exp claim and --max-token-age after the iat claim. Unset
+   * when neither applies, e.g. for ServiceAccount tokens.
+   *
+   * @generated from field: google.protobuf.Timestamp expires_at = 1;
+   */
+  expiresAt?: Timestamp;
+
+  /**
+   * remaining_seconds is the time left until expires_at, zero when unset
+   * or already passed.
+   *
+   * @generated from field: int64 remaining_seconds = 2;
+   */
+  remainingSeconds: bigint;
+
+  /**
+   * authenticated_at is when the caller last signed in: the auth_time claim
+   * when present, otherwise iat.
+   *
+   * @generated from field: google.protobuf.Timestamp authenticated_at = 3;
+   */
+  authenticatedAt?: Timestamp;
+
+  /**
+   * max_token_age_seconds is the configured --max-token-age, zero when
+   * unlimited.
+   *
+   * @generated from field: int64 max_token_age_seconds = 4;
+   */
+  maxTokenAgeSeconds: bigint;
+
+  /**
+   * step_up lists the RPCs that require a recent sign-in.
+   *
+   * @generated from field: repeated holos.console.v1.StepUpRequirement step_up = 5;
+   */
+  stepUp: StepUpRequirement[];
+};
+
+/**
+ * Describes the message holos.console.v1.GetSessionResponse.
+ * Use `create(GetSessionResponseSchema)` to create a new message.
+ */
+export declare const GetSessionResponseSchema: GenMessage<GetSessionResponse>;
+
+/**
+ * StepUpRequirement is an RPC that requires the caller to have signed in
+ * recently.
+ *
+ * @generated from message holos.console.v1.StepUpRequirement
+ */
+export declare type StepUpRequirement = Message<"holos.console.v1.StepUpRequirement"> & {
+  /**
+   * procedure is the RPC, e.g. "/holos.console.v1.SecretsService/GetSecret".
+   *
+   * @generated from field: string procedure = 1;
+   */
+  procedure: string;
+
+  /**
+   * max_age_seconds is how recently the caller must have signed in.
+   *
+   * @generated from field: int64 max_age_seconds = 2;
+   */
+  maxAgeSeconds: bigint;
+
+  /**
+   * satisfied_until is when the caller's current sign-in stops satisfying
+   * the requirement.
+   *
+   * @generated from field: google.protobuf.Timestamp satisfied_until = 3;
+   */
+  satisfiedUntil?: Timestamp;
+};
+
+/**
+ * Describes the message holos.console.v1.StepUpRequirement.
+ * Use `create(StepUpRequirementSchema)` to create a new message.
+ */
+export declare const StepUpRequirementSchema: GenMessage<StepUpRequirement>;
+
+/**
+ * IdentityService reports how the console sees the authenticated caller.
+ *
+ * @generated from service holos.console.v1.IdentityService
+ */
+export declare const IdentityService: GenService<{
+  /**
+   * WhoAmI returns the caller's claims as resolved by the auth interceptor:
+   * the token's subject, email and groups after the configured roles claim
+   * is applied, the identity the console impersonates in Kubernetes, and
+   * the platform roles the console maps the caller to. It is the supported
+   * way to debug group-claim mapping; any authenticated caller may call it.
+   *
+   * @generated from rpc holos.console.v1.IdentityService.WhoAmI
+   */
+  whoAmI: {
+    methodKind: "unary";
+    input: typeof WhoAmIRequestSchema;
+    output: typeof WhoAmIResponseSchema;
+  },
+  /**
+   * GetSession reports how long the caller's token remains usable under the
+   * console's session policy (--max-token-age), and which RPCs require a
+   * more recent sign-in (--step-up), so the UI can warn before the session
+   * ends and prompt for sign-in ahead of a step-up RPC.
+   *
+   * @generated from rpc holos.console.v1.IdentityService.GetSession
+   */
+  getSession: {
+    methodKind: "unary";
+    input: typeof GetSessionRequestSchema;
+    output: typeof GetSessionResponseSchema;
+  },
+}>;
+
@@ -0,0 +1,56 @@
+// @generated by protoc-gen-es v2.11.0
+// @generated from file holos/console/v1/identity.proto (package holos.console.v1, syntax proto3)
+/* eslint-disable */
+
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+
+/**
+ * Describes the file holos/console/v1/identity.proto.
+ */
+export const file_holos_console_v1_identity = /*@__PURE__*/
+  fileDesc("Ch9ob2xvcy9jb25zb2xlL3YxL2lkZW50aXR5LnByb3RvEhBob2xvcy5jb25zb2xlLnYxIg8KDVdob0FtSVJlcXVlc3QizwIKDldob0FtSVJlc3BvbnNlEg4KBmlzc3VlchgBIAEoCRIPCgdzdWJqZWN0GAIgASgJEg0KBWVtYWlsGAMgASgJEhYKDmVtYWlsX3ZlcmlmaWVkGAQgASgIEgwKBG5hbWUYBSABKAkSDgoGZ3JvdXBzGAYgAygJEhYKDnByaW5jaXBhbF90eXBlGAcgASgJEi0KCWlzc3VlZF9hdBgIIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKZXhwaXJlc19hdBgJIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASFwoPa3ViZXJuZXRlc191c2VyGAogASgJEhkKEWt1YmVybmV0ZXNfZ3JvdXBzGAsgAygJEhYKDnBsYXRmb3JtX3JvbGVzGAwgAygJEhQKDGltcGVyc29uYXRvchgNIAEoCSITChFHZXRTZXNzaW9uUmVxdWVzdCLqAQoSR2V0U2Vzc2lvblJlc3BvbnNlEi4KCmV4cGlyZXNfYXQYASABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhkKEXJlbWFpbmluZ19zZWNvbmRzGAIgASgDEjQKEGF1dGhlbnRpY2F0ZWRfYXQYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEh0KFW1heF90b2tlbl9hZ2Vfc2Vjb25kcxgEIAEoAxI0CgdzdGVwX3VwGAUgAygLMiMuaG9sb3MuY29uc29sZS52MS5TdGVwVXBSZXF1aXJlbWVudCJ0ChFTdGVwVXBSZXF1aXJlbWVudBIRCglwcm9jZWR1cmUYASABKAkSFwoPbWF4X2FnZV9zZWNvbmRzGAIgASgDEjMKD3NhdGlzZmllZF91bnRpbBgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAyvAEKD0lkZW50aXR5U2VydmljZRJQCgZXaG9BbUkSHy5ob2xvcy5jb25zb2xlLnYxLldob0FtSVJlcXVlc3QaIC5ob2xvcy5jb25zb2xlLnYxLldob0FtSVJlc3BvbnNlIgOQAgESVwoKR2V0U2Vzc2lvbhIjLmhvbG9zLmNvbnNvbGUudjEuR2V0U2Vzc2lvblJlcXVlc3QaJC5ob2xvcy5jb25zb2xlLnYxLkdldFNlc3Npb25SZXNwb25zZUJDWkFnaXRodWIuY29tL2hvbG9zLXJ1bi9ob2xvcy1jb25zb2xlL2dlbi9ob2xvcy9jb25zb2xlL3YxO2NvbnNvbGV2MWIGcHJvdG8z", [file_google_protobuf_timestamp]);
+
+/**
+ * Describes the message holos.console.v1.WhoAmIRequest.
+ * Use `create(WhoAmIRequestSchema)` to create a new message.
+ */
+export const WhoAmIRequestSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_identity, 0);
+
+/**
+ * Describes the message holos.console.v1.WhoAmIResponse.
+ * Use `create(WhoAmIResponseSchema)` to create a new message.
+ */
+export const WhoAmIResponseSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_identity, 1);
+
+/**
+ * Describes the message holos.console.v1.GetSessionRequest.
+ * Use `create(GetSessionRequestSchema)` to create a new message.
+ */
+export const GetSessionRequestSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_identity, 2);
+
+/**
+ * Describes the message holos.console.v1.GetSessionResponse.
+ * Use `create(GetSessionResponseSchema)` to create a new message.
+ */
+export const GetSessionResponseSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_identity, 3);
+
+/**
+ * Describes the message holos.console.v1.StepUpRequirement.
+ * Use `create(StepUpRequirementSchema)` to create a new message.
+ */
+export const StepUpRequirementSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_identity, 4);
+
+/**
+ * IdentityService reports how the console sees the authenticated caller.
+ *
+ * @generated from service holos.console.v1.IdentityService
+ */
+export const IdentityService = /*@__PURE__*/
+  serviceDesc(file_holos_console_v1_identity, 0);
+
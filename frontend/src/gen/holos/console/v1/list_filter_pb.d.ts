@@ -0,0 +1,141 @@
+// @generated by protoc-gen-es v2.11.0
+// @generated from file holos/console/v1/list_filter.proto (package holos.console.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenEnum, GenFile, GenMessage } from "@bufbuild/protobuf/codegenv2";
+import type { Message } from "@bufbuild/protobuf";
+import type { Role } from "./rbac_pb";
+
+/**
+ * Describes the file holos/console/v1/list_filter.proto.
+ */
+export declare const file_holos_console_v1_list_filter: GenFile;
+
+/**
+ * ListFilter narrows the results of a list RPC on the server so clients do
+ * not need to download every resource to search them. Every set field must
+ * match; an unset filter returns everything the caller can list.
+ *
+ * @generated from message holos.console.v1.ListFilter
+ */
+export declare type ListFilter = Message<"holos.console.v1.ListFilter"> & {
+  /**
+   * name_contains keeps resources whose name or display name contains the
+   * value, ignoring case.
+   *
+   * @generated from field: string name_contains = 1;
+   */
+  nameContains: string;
+
+  /**
+   * label_selector keeps resources whose Kubernetes labels match the
+   * selector, in the kubectl --selector syntax (e.g. "env=prod,tier!=db").
+   *
+   * @generated from field: string label_selector = 2;
+   */
+  labelSelector: string;
+
+  /**
+   * description_contains keeps resources whose description contains the
+   * value, ignoring case.
+   *
+   * @generated from field: string description_contains = 3;
+   */
+  descriptionContains: string;
+
+  /**
+   * accessible_only keeps only resources the caller can read. Resources
+   * that are listed but not readable, such as secrets behind a deny grant,
+   * are dropped.
+   *
+   * @generated from field: bool accessible_only = 4;
+   */
+  accessibleOnly: boolean;
+
+  /**
+   * min_role keeps resources on which the caller's role is at least
+   * min_role, e.g. ROLE_OWNER for "resources I own".
+   *
+   * @generated from field: holos.console.v1.Role min_role = 5;
+   */
+  minRole: Role;
+};
+
+/**
+ * Describes the message holos.console.v1.ListFilter.
+ * Use `create(ListFilterSchema)` to create a new message.
+ */
+export declare const ListFilterSchema: GenMessage<ListFilter>;
+
+/**
+ * ListOrder sorts the results of a list RPC on the server, after
+ * filtering, so tables show a stable order. Resources that compare equal
+ * are ordered by name. When a list request leaves order_by unset the
+ * server's default order applies.
+ *
+ * @generated from message holos.console.v1.ListOrder
+ */
+export declare type ListOrder = Message<"holos.console.v1.ListOrder"> & {
+  /**
+   * field is the attribute to sort by.
+   *
+   * @generated from field: holos.console.v1.ListOrderField field = 1;
+   */
+  field: ListOrderField;
+
+  /**
+   * descending reverses the order, e.g. newest first for
+   * LIST_ORDER_FIELD_CREATED.
+   *
+   * @generated from field: bool descending = 2;
+   */
+  descending: boolean;
+};
+
+/**
+ * Describes the message holos.console.v1.ListOrder.
+ * Use `create(ListOrderSchema)` to create a new message.
+ */
+export declare const ListOrderSchema: GenMessage<ListOrder>;
+
+/**
+ * ListOrderField selects the attribute a list RPC sorts its results by.
+ *
+ * @generated from enum holos.console.v1.ListOrderField
+ */
+export enum ListOrderField {
+  /**
+   * LIST_ORDER_FIELD_UNSPECIFIED sorts by name.
+   *
+   * @generated from enum value: LIST_ORDER_FIELD_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * LIST_ORDER_FIELD_NAME sorts by resource name.
+   *
+   * @generated from enum value: LIST_ORDER_FIELD_NAME = 1;
+   */
+  NAME = 1,
+
+  /**
+   * LIST_ORDER_FIELD_CREATED sorts by creation time.
+   *
+   * @generated from enum value: LIST_ORDER_FIELD_CREATED = 2;
+   */
+  CREATED = 2,
+
+  /**
+   * LIST_ORDER_FIELD_MODIFIED sorts by the time of the most recent write
+   * to the backing Kubernetes object, falling back to its creation time.
+   *
+   * @generated from enum value: LIST_ORDER_FIELD_MODIFIED = 3;
+   */
+  MODIFIED = 3,
+}
+
+/**
+ * Describes the enum holos.console.v1.ListOrderField.
+ */
+export declare const ListOrderFieldSchema: GenEnum<ListOrderField>;
+
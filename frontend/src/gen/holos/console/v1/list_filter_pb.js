@@ -0,0 +1,41 @@
+// @generated by protoc-gen-es v2.11.0
+// @generated from file holos/console/v1/list_filter.proto (package holos.console.v1, syntax proto3)
+/* eslint-disable */
+
+import { enumDesc, fileDesc, messageDesc, tsEnum } from "@bufbuild/protobuf/codegenv2";
+import { file_holos_console_v1_rbac } from "./rbac_pb";
+
+/**
+ * Describes the file holos/console/v1/list_filter.proto.
+ */
+export const file_holos_console_v1_list_filter = /*@__PURE__*/
+  fileDesc("CiJob2xvcy9jb25zb2xlL3YxL2xpc3RfZmlsdGVyLnByb3RvEhBob2xvcy5jb25zb2xlLnYxIpwBCgpMaXN0RmlsdGVyEhUKDW5hbWVfY29udGFpbnMYASABKAkSFgoObGFiZWxfc2VsZWN0b3IYAiABKAkSHAoUZGVzY3JpcHRpb25fY29udGFpbnMYAyABKAkSFwoPYWNjZXNzaWJsZV9vbmx5GAQgASgIEigKCG1pbl9yb2xlGAUgASgOMhYuaG9sb3MuY29uc29sZS52MS5Sb2xlIlAKCUxpc3RPcmRlchIvCgVmaWVsZBgBIAEoDjIgLmhvbG9zLmNvbnNvbGUudjEuTGlzdE9yZGVyRmllbGQSEgoKZGVzY2VuZGluZxgCIAEoCCqKAQoOTGlzdE9yZGVyRmllbGQSIAocTElTVF9PUkRFUl9GSUVMRF9VTlNQRUNJRklFRBAAEhkKFUxJU1RfT1JERVJfRklFTERfTkFNRRABEhwKGExJU1RfT1JERVJfRklFTERfQ1JFQVRFRBACEh0KGUxJU1RfT1JERVJfRklFTERfTU9ESUZJRUQQA0JDWkFnaXRodWIuY29tL2hvbG9zLXJ1bi9ob2xvcy1jb25zb2xlL2dlbi9ob2xvcy9jb25zb2xlL3YxO2NvbnNvbGV2MWIGcHJvdG8z", [file_holos_console_v1_rbac]);
+
+/**
+ * Describes the message holos.console.v1.ListFilter.
+ * Use `create(ListFilterSchema)` to create a new message.
+ */
+export const ListFilterSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_list_filter, 0);
+
+/**
+ * Describes the message holos.console.v1.ListOrder.
+ * Use `create(ListOrderSchema)` to create a new message.
+ */
+export const ListOrderSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_list_filter, 1);
+
+/**
+ * Describes the enum holos.console.v1.ListOrderField.
+ */
+export const ListOrderFieldSchema = /*@__PURE__*/
+  enumDesc(file_holos_console_v1_list_filter, 0);
+
+/**
+ * ListOrderField selects the attribute a list RPC sorts its results by.
+ *
+ * @generated from enum holos.console.v1.ListOrderField
+ */
+export const ListOrderField = /*@__PURE__*/
+  tsEnum(ListOrderFieldSchema);
+
@@ -0,0 +1,170 @@
+// @generated by protoc-gen-es v2.11.0
+// @generated from file holos/console/v1/maintenance.proto (package holos.console.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import type { Message } from "@bufbuild/protobuf";
+import type { Timestamp } from "@bufbuild/protobuf/wkt";
+
+/**
+ * Describes the file holos/console/v1/maintenance.proto.
+ */
+export declare const file_holos_console_v1_maintenance: GenFile;
+
+/**
+ * Maintenance describes the maintenance mode state.
+ *
+ * @generated from message holos.console.v1.Maintenance
+ */
+export declare type Maintenance = Message<"holos.console.v1.Maintenance"> & {
+  /**
+   * enabled is true while mutating RPCs are rejected.
+   *
+   * @generated from field: bool enabled = 1;
+   */
+  enabled: boolean;
+
+  /**
+   * message is returned in the error of rejected RPCs and shown in the UI.
+   *
+   * @generated from field: string message = 2;
+   */
+  message: string;
+
+  /**
+   * changed_at is when the state was last changed. Unset for the state set
+   * by flags at startup.
+   *
+   * @generated from field: google.protobuf.Timestamp changed_at = 3;
+   */
+  changedAt?: Timestamp;
+
+  /**
+   * changed_by is the email, or subject, of the platform owner who last
+   * changed the state. Empty for the state set by flags at startup.
+   *
+   * @generated from field: string changed_by = 4;
+   */
+  changedBy: string;
+};
+
+/**
+ * Describes the message holos.console.v1.Maintenance.
+ * Use `create(MaintenanceSchema)` to create a new message.
+ */
+export declare const MaintenanceSchema: GenMessage<Maintenance>;
+
+/**
+ * GetMaintenanceRequest is empty as no parameters are needed.
+ *
+ * @generated from message holos.console.v1.GetMaintenanceRequest
+ */
+export declare type GetMaintenanceRequest = Message<"holos.console.v1.GetMaintenanceRequest"> & {
+};
+
+/**
+ * Describes the message holos.console.v1.GetMaintenanceRequest.
+ * Use `create(GetMaintenanceRequestSchema)` to create a new message.
+ */
+export declare const GetMaintenanceRequestSchema: GenMessage<GetMaintenanceRequest>;
+
+/**
+ * GetMaintenanceResponse contains the maintenance state.
+ *
+ * @generated from message holos.console.v1.GetMaintenanceResponse
+ */
+export declare type GetMaintenanceResponse = Message<"holos.console.v1.GetMaintenanceResponse"> & {
+  /**
+   * @generated from field: holos.console.v1.Maintenance maintenance = 1;
+   */
+  maintenance?: Maintenance;
+};
+
+/**
+ * Describes the message holos.console.v1.GetMaintenanceResponse.
+ * Use `create(GetMaintenanceResponseSchema)` to create a new message.
+ */
+export declare const GetMaintenanceResponseSchema: GenMessage<GetMaintenanceResponse>;
+
+/**
+ * SetMaintenanceRequest changes the maintenance state.
+ *
+ * @generated from message holos.console.v1.SetMaintenanceRequest
+ */
+export declare type SetMaintenanceRequest = Message<"holos.console.v1.SetMaintenanceRequest"> & {
+  /**
+   * enabled turns maintenance mode on or off.
+   *
+   * @generated from field: bool enabled = 1;
+   */
+  enabled: boolean;
+
+  /**
+   * message replaces the message returned to rejected RPCs. Empty keeps the
+   * default message.
+   *
+   * @generated from field: string message = 2;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message holos.console.v1.SetMaintenanceRequest.
+ * Use `create(SetMaintenanceRequestSchema)` to create a new message.
+ */
+export declare const SetMaintenanceRequestSchema: GenMessage<SetMaintenanceRequest>;
+
+/**
+ * SetMaintenanceResponse contains the new maintenance state.
+ *
+ * @generated from message holos.console.v1.SetMaintenanceResponse
+ */
+export declare type SetMaintenanceResponse = Message<"holos.console.v1.SetMaintenanceResponse"> & {
+  /**
+   * @generated from field: holos.console.v1.Maintenance maintenance = 1;
+   */
+  maintenance?: Maintenance;
+};
+
+/**
+ * Describes the message holos.console.v1.SetMaintenanceResponse.
+ * Use `create(SetMaintenanceResponseSchema)` to create a new message.
+ */
+export declare const SetMaintenanceResponseSchema: GenMessage<SetMaintenanceResponse>;
+
+/**
+ * MaintenanceService reports and toggles the console's read-only
+ * maintenance mode. While it is enabled every RPC that changes state fails
+ * with FailedPrecondition and the configured message, and reads keep
+ * working.
+ *
+ * @generated from service holos.console.v1.MaintenanceService
+ */
+export declare const MaintenanceService: GenService<{
+  /**
+   * GetMaintenance returns the current maintenance state. Any authenticated
+   * caller may call it so the UI can show a banner.
+   *
+   * @generated from rpc holos.console.v1.MaintenanceService.GetMaintenance
+   */
+  getMaintenance: {
+    methodKind: "unary";
+    input: typeof GetMaintenanceRequestSchema;
+    output: typeof GetMaintenanceResponseSchema;
+  },
+  /**
+   * SetMaintenance enables or disables maintenance mode. Only platform
+   * owners (--platform-owner-users, --platform-owner-roles) may call it, and
+   * it remains callable while maintenance mode is enabled. The state is held
+   * in memory by the replica serving the request; multi-replica deployments
+   * should set --maintenance instead.
+   *
+   * @generated from rpc holos.console.v1.MaintenanceService.SetMaintenance
+   */
+  setMaintenance: {
+    methodKind: "unary";
+    input: typeof SetMaintenanceRequestSchema;
+    output: typeof SetMaintenanceResponseSchema;
+  },
+}>;
+
@@ -0,0 +1,59 @@
+// @generated by protoc-gen-es v2.11.0
+// @generated from file holos/console/v1/maintenance.proto (package holos.console.v1, syntax proto3)
+/* eslint-disable */
+
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+
+/**
+ * Describes the file holos/console/v1/maintenance.proto.
+ */
+export const file_holos_console_v1_maintenance = /*@__PURE__*/
+  fileDesc("CiJob2xvcy9jb25zb2xlL3YxL21haW50ZW5hbmNlLnByb3RvEhBob2xvcy5jb25zb2xlLnYxInMKC01haW50ZW5hbmNlEg8KB2VuYWJsZWQYASABKAgSDwoHbWVzc2FnZRgCIAEoCRIuCgpjaGFuZ2VkX2F0GAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBISCgpjaGFuZ2VkX2J5GAQgASgJIhcKFUdldE1haW50ZW5hbmNlUmVxdWVzdCJMChZHZXRNYWludGVuYW5jZVJlc3BvbnNlEjIKC21haW50ZW5hbmNlGAEgASgLMh0uaG9sb3MuY29uc29sZS52MS5NYWludGVuYW5jZSI5ChVTZXRNYWludGVuYW5jZVJlcXVlc3QSDwoHZW5hYmxlZBgBIAEoCBIPCgdtZXNzYWdlGAIgASgJIkwKFlNldE1haW50ZW5hbmNlUmVzcG9uc2USMgoLbWFpbnRlbmFuY2UYASABKAsyHS5ob2xvcy5jb25zb2xlLnYxLk1haW50ZW5hbmNlMuMBChJNYWludGVuYW5jZVNlcnZpY2USaAoOR2V0TWFpbnRlbmFuY2USJy5ob2xvcy5jb25zb2xlLnYxLkdldE1haW50ZW5hbmNlUmVxdWVzdBooLmhvbG9zLmNvbnNvbGUudjEuR2V0TWFpbnRlbmFuY2VSZXNwb25zZSIDkAIBEmMKDlNldE1haW50ZW5hbmNlEicuaG9sb3MuY29uc29sZS52MS5TZXRNYWludGVuYW5jZVJlcXVlc3QaKC5ob2xvcy5jb25zb2xlLnYxLlNldE1haW50ZW5hbmNlUmVzcG9uc2VCQ1pBZ2l0aHViLmNvbS9ob2xvcy1ydW4vaG9sb3MtY29uc29sZS9nZW4vaG9sb3MvY29uc29sZS92MTtjb25zb2xldjFiBnByb3RvMw", [file_google_protobuf_timestamp]);
+
+/**
+ * Describes the message holos.console.v1.Maintenance.
+ * Use `create(MaintenanceSchema)` to create a new message.
+ */
+export const MaintenanceSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_maintenance, 0);
+
+/**
+ * Describes the message holos.console.v1.GetMaintenanceRequest.
+ * Use `create(GetMaintenanceRequestSchema)` to create a new message.
+ */
+export const GetMaintenanceRequestSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_maintenance, 1);
+
+/**
+ * Describes the message holos.console.v1.GetMaintenanceResponse.
+ * Use `create(GetMaintenanceResponseSchema)` to create a new message.
+ */
+export const GetMaintenanceResponseSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_maintenance, 2);
+
+/**
+ * Describes the message holos.console.v1.SetMaintenanceRequest.
+ * Use `create(SetMaintenanceRequestSchema)` to create a new message.
+ */
+export const SetMaintenanceRequestSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_maintenance, 3);
+
+/**
+ * Describes the message holos.console.v1.SetMaintenanceResponse.
+ * Use `create(SetMaintenanceResponseSchema)` to create a new message.
+ */
+export const SetMaintenanceResponseSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_maintenance, 4);
+
+/**
+ * MaintenanceService reports and toggles the console's read-only
+ * maintenance mode. While it is enabled every RPC that changes state fails
+ * with FailedPrecondition and the configured message, and reads keep
+ * working.
+ *
+ * @generated from service holos.console.v1.MaintenanceService
+ */
+export const MaintenanceService = /*@__PURE__*/
+  serviceDesc(file_holos_console_v1_maintenance, 0);
+
@@ -5,7 +5,11 @@
 import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
 import type { Message } from "@bufbuild/protobuf";
 import type { ShareGrant } from "./secrets_pb";
-import type { Role } from "./rbac_pb";
+import type { Role, RoleSource } from "./rbac_pb";
+import type { ListFilter, ListOrder } from "./list_filter_pb";
+import type { RawFormat } from "./raw_format_pb";
+import type { Timestamp } from "@bufbuild/protobuf/wkt";
+import type { PrincipalKind } from "./permissions_pb";
 
 /**
  * Describes the file holos/console/v1/organizations.proto.
@@ -97,6 +101,22 @@ export declare type Organization = Message<"holos.console.v1.Organization"> & {
    * @generated from field: string gateway_namespace = 12;
    */
   gatewayNamespace: string;
+
+  /**
+   * updated_at is the RFC3339-formatted timestamp of the most recent write to
+   * this organization's namespace, sourced from metadata.managedFields.
+   *
+   * @generated from field: string updated_at = 13;
+   */
+  updatedAt: string;
+
+  /**
+   * user_role_source explains where user_role comes from: a grant on this
+   * organization or the platform. Unset when user_role is ROLE_UNSPECIFIED.
+   *
+   * @generated from field: holos.console.v1.RoleSource user_role_source = 14;
+   */
+  userRoleSource?: RoleSource;
 };
 
 /**
@@ -111,6 +131,20 @@ export declare const OrganizationSchema: GenMessage<Organization>;
  * @generated from message holos.console.v1.ListOrganizationsRequest
  */
 export declare type ListOrganizationsRequest = Message<"holos.console.v1.ListOrganizationsRequest"> & {
+  /**
+   * filter narrows the returned organizations. Labels are the organization
+   * namespace's labels.
+   *
+   * @generated from field: holos.console.v1.ListFilter filter = 1;
+   */
+  filter?: ListFilter;
+
+  /**
+   * order_by sorts the returned organizations.
+   *
+   * @generated from field: holos.console.v1.ListOrder order_by = 2;
+   */
+  orderBy?: ListOrder;
 };
 
 /**
@@ -375,6 +409,14 @@ export declare type UpdateOrganizationSharingRequest = Message<"holos.console.v1
    * @generated from field: repeated holos.console.v1.ShareGrant role_grants = 3;
    */
   roleGrants: ShareGrant[];
+
+  /**
+   * force accepts grants that leave the organization without an active
+   * owner. Only platform owners may set it.
+   *
+   * @generated from field: bool force = 4;
+   */
+  force: boolean;
 };
 
 /**
@@ -404,7 +446,7 @@ export declare type UpdateOrganizationSharingResponse = Message<"holos.console.v
 export declare const UpdateOrganizationSharingResponseSchema: GenMessage<UpdateOrganizationSharingResponse>;
 
 /**
- * GetOrganizationRawRequest contains the name of the organization to retrieve as raw JSON.
+ * GetOrganizationRawRequest contains the name of the organization to retrieve as a raw manifest.
  *
  * @generated from message holos.console.v1.GetOrganizationRawRequest
  */
@@ -415,6 +457,23 @@ export declare type GetOrganizationRawRequest = Message<"holos.console.v1.GetOrg
    * @generated from field: string name = 1;
    */
   name: string;
+
+  /**
+   * format selects JSON (the default) or YAML output.
+   *
+   * @generated from field: holos.console.v1.RawFormat format = 2;
+   */
+  format: RawFormat;
+
+  /**
+   * strip_managed_fields omits the server-populated fields (uid,
+   * resourceVersion, generation, creationTimestamp, managedFields,
+   * ownerReferences, and status) so the manifest can be applied to another
+   * cluster with kubectl apply.
+   *
+   * @generated from field: bool strip_managed_fields = 3;
+   */
+  stripManagedFields: boolean;
 };
 
 /**
@@ -424,13 +483,14 @@ export declare type GetOrganizationRawRequest = Message<"holos.console.v1.GetOrg
 export declare const GetOrganizationRawRequestSchema: GenMessage<GetOrganizationRawRequest>;
 
 /**
- * GetOrganizationRawResponse contains the full Kubernetes Namespace object as JSON.
+ * GetOrganizationRawResponse contains the full Kubernetes Namespace object as JSON or YAML.
  *
  * @generated from message holos.console.v1.GetOrganizationRawResponse
  */
 export declare type GetOrganizationRawResponse = Message<"holos.console.v1.GetOrganizationRawResponse"> & {
   /**
-   * raw is the verbatim JSON-serialized Namespace object from the K8s API.
+   * raw is the Namespace object from the K8s API, serialized in the
+   * requested format.
    *
    * @generated from field: string raw = 1;
    */
@@ -497,6 +557,222 @@ export declare type UpdateOrganizationDefaultSharingResponse = Message<"holos.co
  */
 export declare const UpdateOrganizationDefaultSharingResponseSchema: GenMessage<UpdateOrganizationDefaultSharingResponse>;
 
+/**
+ * GetOrganizationStatsRequest names the organization to summarize.
+ *
+ * @generated from message holos.console.v1.GetOrganizationStatsRequest
+ */
+export declare type GetOrganizationStatsRequest = Message<"holos.console.v1.GetOrganizationStatsRequest"> & {
+  /**
+   * name is the name of the organization.
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message holos.console.v1.GetOrganizationStatsRequest.
+ * Use `create(GetOrganizationStatsRequestSchema)` to create a new message.
+ */
+export declare const GetOrganizationStatsRequestSchema: GenMessage<GetOrganizationStatsRequest>;
+
+/**
+ * ActivitySummary aggregates the recent audit events of one action.
+ *
+ * @generated from message holos.console.v1.ActivitySummary
+ */
+export declare type ActivitySummary = Message<"holos.console.v1.ActivitySummary"> & {
+  /**
+   * action is the audit action, e.g. "secret_update".
+   *
+   * @generated from field: string action = 1;
+   */
+  action: string;
+
+  /**
+   * count is the number of events in the activity window.
+   *
+   * @generated from field: int32 count = 2;
+   */
+  count: number;
+
+  /**
+   * last_time is when the most recent event was recorded.
+   *
+   * @generated from field: google.protobuf.Timestamp last_time = 3;
+   */
+  lastTime?: Timestamp;
+};
+
+/**
+ * Describes the message holos.console.v1.ActivitySummary.
+ * Use `create(ActivitySummarySchema)` to create a new message.
+ */
+export declare const ActivitySummarySchema: GenMessage<ActivitySummary>;
+
+/**
+ * GetOrganizationStatsResponse contains the organization's usage counts.
+ *
+ * @generated from message holos.console.v1.GetOrganizationStatsResponse
+ */
+export declare type GetOrganizationStatsResponse = Message<"holos.console.v1.GetOrganizationStatsResponse"> & {
+  /**
+   * project_count is the number of projects in the organization.
+   *
+   * @generated from field: int32 project_count = 1;
+   */
+  projectCount: number;
+
+  /**
+   * secret_count is the number of console-managed secrets across the
+   * organization's projects.
+   *
+   * @generated from field: int32 secret_count = 2;
+   */
+  secretCount: number;
+
+  /**
+   * member_count is the number of distinct users and groups granted a role
+   * on the organization, its folders, or its projects. Deny and expired
+   * grants are not counted.
+   *
+   * @generated from field: int32 member_count = 3;
+   */
+  memberCount: number;
+
+  /**
+   * recent_activity summarizes audit events for the organization and its
+   * projects within activity_window_seconds, most frequent first. Events
+   * come from the serving replica's in-memory audit buffer, so older or
+   * other replicas' events may be missing.
+   *
+   * @generated from field: repeated holos.console.v1.ActivitySummary recent_activity = 4;
+   */
+  recentActivity: ActivitySummary[];
+
+  /**
+   * activity_window_seconds is the length of the window recent_activity
+   * covers.
+   *
+   * @generated from field: int64 activity_window_seconds = 5;
+   */
+  activityWindowSeconds: bigint;
+};
+
+/**
+ * Describes the message holos.console.v1.GetOrganizationStatsResponse.
+ * Use `create(GetOrganizationStatsResponseSchema)` to create a new message.
+ */
+export declare const GetOrganizationStatsResponseSchema: GenMessage<GetOrganizationStatsResponse>;
+
+/**
+ * ListOrganizationMembersRequest names the organization whose members to
+ * list.
+ *
+ * @generated from message holos.console.v1.ListOrganizationMembersRequest
+ */
+export declare type ListOrganizationMembersRequest = Message<"holos.console.v1.ListOrganizationMembersRequest"> & {
+  /**
+   * name is the name of the organization.
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message holos.console.v1.ListOrganizationMembersRequest.
+ * Use `create(ListOrganizationMembersRequestSchema)` to create a new message.
+ */
+export declare const ListOrganizationMembersRequestSchema: GenMessage<ListOrganizationMembersRequest>;
+
+/**
+ * OrganizationMember is a principal holding grants in an organization and
+ * how many it holds at each level. Deny and inactive grants are not counted.
+ *
+ * @generated from message holos.console.v1.OrganizationMember
+ */
+export declare type OrganizationMember = Message<"holos.console.v1.OrganizationMember"> & {
+  /**
+   * principal is the email address, "*@domain", "*", or group name the
+   * grants name, without the "oidc:" prefix.
+   *
+   * @generated from field: string principal = 1;
+   */
+  principal: string;
+
+  /**
+   * kind is PRINCIPAL_KIND_USER for user grants and PRINCIPAL_KIND_GROUP
+   * for role grants.
+   *
+   * @generated from field: holos.console.v1.PrincipalKind kind = 2;
+   */
+  kind: PrincipalKind;
+
+  /**
+   * highest_role is the highest role any of the principal's grants confers.
+   *
+   * @generated from field: holos.console.v1.Role highest_role = 3;
+   */
+  highestRole: Role;
+
+  /**
+   * organization_grants is 1 when the organization itself grants the
+   * principal a role.
+   *
+   * @generated from field: int32 organization_grants = 4;
+   */
+  organizationGrants: number;
+
+  /**
+   * folder_grants is the number of folders granting the principal a role.
+   *
+   * @generated from field: int32 folder_grants = 5;
+   */
+  folderGrants: number;
+
+  /**
+   * project_grants is the number of projects granting the principal a role.
+   *
+   * @generated from field: int32 project_grants = 6;
+   */
+  projectGrants: number;
+
+  /**
+   * secret_grants is the number of secret grants the principal holds:
+   * project-wide secret sharing grants and per-secret key grants.
+   *
+   * @generated from field: int32 secret_grants = 7;
+   */
+  secretGrants: number;
+};
+
+/**
+ * Describes the message holos.console.v1.OrganizationMember.
+ * Use `create(OrganizationMemberSchema)` to create a new message.
+ */
+export declare const OrganizationMemberSchema: GenMessage<OrganizationMember>;
+
+/**
+ * ListOrganizationMembersResponse lists the organization's members, highest
+ * role first and then by principal.
+ *
+ * @generated from message holos.console.v1.ListOrganizationMembersResponse
+ */
+export declare type ListOrganizationMembersResponse = Message<"holos.console.v1.ListOrganizationMembersResponse"> & {
+  /**
+   * @generated from field: repeated holos.console.v1.OrganizationMember members = 1;
+   */
+  members: OrganizationMember[];
+};
+
+/**
+ * Describes the message holos.console.v1.ListOrganizationMembersResponse.
+ * Use `create(ListOrganizationMembersResponseSchema)` to create a new message.
+ */
+export declare const ListOrganizationMembersResponseSchema: GenMessage<ListOrganizationMembersResponse>;
+
 /**
  * OrganizationService provides CRUD operations for organizations.
  * An organization is a top-level administrative boundary backed by a Kubernetes
@@ -561,6 +837,8 @@ export declare const OrganizationService: GenService<{
   /**
    * UpdateOrganizationSharing updates the sharing grants on an organization.
    * Requires PERMISSION_ORGANIZATIONS_ADMIN on the organization.
+   * A grant set without an active owner fails with FailedPrecondition unless
+   * a platform owner sets force.
    *
    * @generated from rpc holos.console.v1.OrganizationService.UpdateOrganizationSharing
    */
@@ -570,9 +848,10 @@ export declare const OrganizationService: GenService<{
     output: typeof UpdateOrganizationSharingResponseSchema;
   },
   /**
-   * GetOrganizationRaw retrieves the full Kubernetes Namespace object as verbatim JSON.
-   * The backend returns the Namespace exactly as the K8s API provides it, with no
-   * field filtering. Requires authentication and PERMISSION_ORGANIZATIONS_READ.
+   * GetOrganizationRaw retrieves the full Kubernetes Namespace object as JSON or YAML.
+   * The backend returns the Namespace exactly as the K8s API provides it unless
+   * strip_managed_fields is set. Requires authentication and
+   * PERMISSION_ORGANIZATIONS_READ.
    *
    * @generated from rpc holos.console.v1.OrganizationService.GetOrganizationRaw
    */
@@ -593,5 +872,30 @@ export declare const OrganizationService: GenService<{
     input: typeof UpdateOrganizationDefaultSharingRequestSchema;
     output: typeof UpdateOrganizationDefaultSharingResponseSchema;
   },
+  /**
+   * GetOrganizationStats returns usage counts and a summary of recent
+   * activity for an organization overview page. Counts cover the whole
+   * organization, not only the resources the caller can see.
+   * Requires PERMISSION_ORGANIZATIONS_READ.
+   *
+   * @generated from rpc holos.console.v1.OrganizationService.GetOrganizationStats
+   */
+  getOrganizationStats: {
+    methodKind: "unary";
+    input: typeof GetOrganizationStatsRequestSchema;
+    output: typeof GetOrganizationStatsResponseSchema;
+  },
+  /**
+   * ListOrganizationMembers lists the distinct users and groups granted a
+   * role anywhere in an organization: on the organization, its folders, its
+   * projects, or their secrets. Requires PERMISSION_ORGANIZATIONS_ADMIN.
+   *
+   * @generated from rpc holos.console.v1.OrganizationService.ListOrganizationMembers
+   */
+  listOrganizationMembers: {
+    methodKind: "unary";
+    input: typeof ListOrganizationMembersRequestSchema;
+    output: typeof ListOrganizationMembersResponseSchema;
+  },
 }>;
 
@@ -3,6 +3,11 @@
 /* eslint-disable */
 
 import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import { file_buf_validate_validate } from "../../../buf/validate/validate_pb";
+import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import { file_holos_console_v1_list_filter } from "./list_filter_pb";
+import { file_holos_console_v1_permissions } from "./permissions_pb";
+import { file_holos_console_v1_raw_format } from "./raw_format_pb";
 import { file_holos_console_v1_rbac } from "./rbac_pb";
 import { file_holos_console_v1_secrets } from "./secrets_pb";
 
@@ -10,7 +15,7 @@ import { file_holos_console_v1_secrets } from "./secrets_pb";
  * Describes the file holos/console/v1/organizations.proto.
  */
 export const file_holos_console_v1_organizations = /*@__PURE__*/
-  fileDesc("CiRob2xvcy9jb25zb2xlL3YxL29yZ2FuaXphdGlvbnMucHJvdG8SEGhvbG9zLmNvbnNvbGUudjEimgMKDE9yZ2FuaXphdGlvbhIMCgRuYW1lGAEgASgJEhQKDGRpc3BsYXlfbmFtZRgCIAEoCRITCgtkZXNjcmlwdGlvbhgDIAEoCRIxCgt1c2VyX2dyYW50cxgEIAMoCzIcLmhvbG9zLmNvbnNvbGUudjEuU2hhcmVHcmFudBIxCgtyb2xlX2dyYW50cxgFIAMoCzIcLmhvbG9zLmNvbnNvbGUudjEuU2hhcmVHcmFudBIpCgl1c2VyX3JvbGUYBiABKA4yFi5ob2xvcy5jb25zb2xlLnYxLlJvbGUSOQoTZGVmYXVsdF91c2VyX2dyYW50cxgHIAMoCzIcLmhvbG9zLmNvbnNvbGUudjEuU2hhcmVHcmFudBI5ChNkZWZhdWx0X3JvbGVfZ3JhbnRzGAggAygLMhwuaG9sb3MuY29uc29sZS52MS5TaGFyZUdyYW50EhUKDWNyZWF0b3JfZW1haWwYCSABKAkSEgoKY3JlYXRlZF9hdBgKIAEoCRIZChFnYXRld2F5X25hbWVzcGFjZRgMIAEoCUoECAsQDCIaChhMaXN0T3JnYW5pemF0aW9uc1JlcXVlc3QiUgoZTGlzdE9yZ2FuaXphdGlvbnNSZXNwb25zZRI1Cg1vcmdhbml6YXRpb25zGAEgAygLMh4uaG9sb3MuY29uc29sZS52MS5Pcmdhbml6YXRpb24iJgoWR2V0T3JnYW5pemF0aW9uUmVxdWVzdBIMCgRuYW1lGAEgASgJIk8KF0dldE9yZ2FuaXphdGlvblJlc3BvbnNlEjQKDG9yZ2FuaXphdGlvbhgBIAEoCzIeLmhvbG9zLmNvbnNvbGUudjEuT3JnYW5pemF0aW9uIvYBChlDcmVhdGVPcmdhbml6YXRpb25SZXF1ZXN0EgwKBG5hbWUYASABKAkSFAoMZGlzcGxheV9uYW1lGAIgASgJEhMKC2Rlc2NyaXB0aW9uGAMgASgJEjEKC3VzZXJfZ3JhbnRzGAQgAygLMhwuaG9sb3MuY29uc29sZS52MS5TaGFyZUdyYW50EjEKC3JvbGVfZ3JhbnRzGAUgAygLMhwuaG9sb3MuY29uc29sZS52MS5TaGFyZUdyYW50Eh4KEXBvcHVsYXRlX2RlZmF1bHRzGAcgASgISACIAQFCFAoSX3BvcHVsYXRlX2RlZmF1bHRzSgQIBhAHIioKGkNyZWF0ZU9yZ2FuaXphdGlvblJlc3BvbnNlEgwKBG5hbWUYASABKAkiuwEKGVVwZGF0ZU9yZ2FuaXphdGlvblJlcXVlc3QSDAoEbmFtZRgBIAEoCRIZCgxkaXNwbGF5X25hbWUYAiABKAlIAIgBARIYCgtkZXNjcmlwdGlvbhgDIAEoCUgBiAEBEh4KEWdhdGV3YXlfbmFtZXNwYWNlGAUgASgJSAKIAQFCDwoNX2Rpc3BsYXlfbmFtZUIOCgxfZGVzY3JpcHRpb25CFAoSX2dhdGV3YXlfbmFtZXNwYWNlSgQIBBAFIhwKGlVwZGF0ZU9yZ2FuaXphdGlvblJlc3BvbnNlIikKGURlbGV0ZU9yZ2FuaXphdGlvblJlcXVlc3QSDAoEbmFtZRgBIAEoCSIcChpEZWxldGVPcmdhbml6YXRpb25SZXNwb25zZSKWAQogVXBkYXRlT3JnYW5pemF0aW9uU2hhcmluZ1JlcXVlc3QSDAoEbmFtZRgBIAEoCRIxCgt1c2VyX2dyYW50cxgCIAMoCzIcLmhvbG9zLmNvbnNvbGUudjEuU2hhcmVHcmFudBIxCgtyb2xlX2dyYW50cxgDIAMoCzIcLmhvbG9zLmNvbnNvbGUudjEuU2hhcmVHcmFudCJZCiFVcGRhdGVPcmdhbml6YXRpb25TaGFyaW5nUmVzcG9uc2USNAoMb3JnYW5pemF0aW9uGAEgASgLMh4uaG9sb3MuY29uc29sZS52MS5Pcmdhbml6YXRpb24iKQoZR2V0T3JnYW5pemF0aW9uUmF3UmVxdWVzdBIMCgRuYW1lGAEgASgJIikKGkdldE9yZ2FuaXphdGlvblJhd1Jlc3BvbnNlEgsKA3JhdxgBIAEoCSKtAQonVXBkYXRlT3JnYW5pemF0aW9uRGVmYXVsdFNoYXJpbmdSZXF1ZXN0EgwKBG5hbWUYASABKAkSOQoTZGVmYXVsdF91c2VyX2dyYW50cxgCIAMoCzIcLmhvbG9zLmNvbnNvbGUudjEuU2hhcmVHcmFudBI5ChNkZWZhdWx0X3JvbGVfZ3JhbnRzGAMgAygLMhwuaG9sb3MuY29uc29sZS52MS5TaGFyZUdyYW50ImAKKFVwZGF0ZU9yZ2FuaXphdGlvbkRlZmF1bHRTaGFyaW5nUmVzcG9uc2USNAoMb3JnYW5pemF0aW9uGAEgASgLMh4uaG9sb3MuY29uc29sZS52MS5Pcmdhbml6YXRpb24y0gcKE09yZ2FuaXphdGlvblNlcnZpY2USbAoRTGlzdE9yZ2FuaXphdGlvbnMSKi5ob2xvcy5jb25zb2xlLnYxLkxpc3RPcmdhbml6YXRpb25zUmVxdWVzdBorLmhvbG9zLmNvbnNvbGUudjEuTGlzdE9yZ2FuaXphdGlvbnNSZXNwb25zZRJmCg9HZXRPcmdhbml6YXRpb24SKC5ob2xvcy5jb25zb2xlLnYxLkdldE9yZ2FuaXphdGlvblJlcXVlc3QaKS5ob2xvcy5jb25zb2xlLnYxLkdldE9yZ2FuaXphdGlvblJlc3BvbnNlEm8KEkNyZWF0ZU9yZ2FuaXphdGlvbhIrLmhvbG9zLmNvbnNvbGUudjEuQ3JlYXRlT3JnYW5pemF0aW9uUmVxdWVzdBosLmhvbG9zLmNvbnNvbGUudjEuQ3JlYXRlT3JnYW5pemF0aW9uUmVzcG9uc2USbwoSVXBkYXRlT3JnYW5pemF0aW9uEisuaG9sb3MuY29uc29sZS52MS5VcGRhdGVPcmdhbml6YXRpb25SZXF1ZXN0GiwuaG9sb3MuY29uc29sZS52MS5VcGRhdGVPcmdhbml6YXRpb25SZXNwb25zZRJvChJEZWxldGVPcmdhbml6YXRpb24SKy5ob2xvcy5jb25zb2xlLnYxLkRlbGV0ZU9yZ2FuaXphdGlvblJlcXVlc3QaLC5ob2xvcy5jb25zb2xlLnYxLkRlbGV0ZU9yZ2FuaXphdGlvblJlc3BvbnNlEoQBChlVcGRhdGVPcmdhbml6YXRpb25TaGFyaW5nEjIuaG9sb3MuY29uc29sZS52MS5VcGRhdGVPcmdhbml6YXRpb25TaGFyaW5nUmVxdWVzdBozLmhvbG9zLmNvbnNvbGUudjEuVXBkYXRlT3JnYW5pemF0aW9uU2hhcmluZ1Jlc3BvbnNlEm8KEkdldE9yZ2FuaXphdGlvblJhdxIrLmhvbG9zLmNvbnNvbGUudjEuR2V0T3JnYW5pemF0aW9uUmF3UmVxdWVzdBosLmhvbG9zLmNvbnNvbGUudjEuR2V0T3JnYW5pemF0aW9uUmF3UmVzcG9uc2USmQEKIFVwZGF0ZU9yZ2FuaXphdGlvbkRlZmF1bHRTaGFyaW5nEjkuaG9sb3MuY29uc29sZS52MS5VcGRhdGVPcmdhbml6YXRpb25EZWZhdWx0U2hhcmluZ1JlcXVlc3QaOi5ob2xvcy5jb25zb2xlLnYxLlVwZGF0ZU9yZ2FuaXphdGlvbkRlZmF1bHRTaGFyaW5nUmVzcG9uc2VCQ1pBZ2l0aHViLmNvbS9ob2xvcy1ydW4vaG9sb3MtY29uc29sZS9nZW4vaG9sb3MvY29uc29sZS92MTtjb25zb2xldjFiBnByb3RvMw", [file_holos_console_v1_rbac, file_holos_console_v1_secrets]);
+  fileDesc("CiRob2xvcy9jb25zb2xlL3YxL29yZ2FuaXphdGlvbnMucHJvdG8SEGhvbG9zLmNvbnNvbGUudjEi5gMKDE9yZ2FuaXphdGlvbhIMCgRuYW1lGAEgASgJEhQKDGRpc3BsYXlfbmFtZRgCIAEoCRITCgtkZXNjcmlwdGlvbhgDIAEoCRIxCgt1c2VyX2dyYW50cxgEIAMoCzIcLmhvbG9zLmNvbnNvbGUudjEuU2hhcmVHcmFudBIxCgtyb2xlX2dyYW50cxgFIAMoCzIcLmhvbG9zLmNvbnNvbGUudjEuU2hhcmVHcmFudBIpCgl1c2VyX3JvbGUYBiABKA4yFi5ob2xvcy5jb25zb2xlLnYxLlJvbGUSOQoTZGVmYXVsdF91c2VyX2dyYW50cxgHIAMoCzIcLmhvbG9zLmNvbnNvbGUudjEuU2hhcmVHcmFudBI5ChNkZWZhdWx0X3JvbGVfZ3JhbnRzGAggAygLMhwuaG9sb3MuY29uc29sZS52MS5TaGFyZUdyYW50EhUKDWNyZWF0b3JfZW1haWwYCSABKAkSEgoKY3JlYXRlZF9hdBgKIAEoCRIZChFnYXRld2F5X25hbWVzcGFjZRgMIAEoCRISCgp1cGRhdGVkX2F0GA0gASgJEjYKEHVzZXJfcm9sZV9zb3VyY2UYDiABKAsyHC5ob2xvcy5jb25zb2xlLnYxLlJvbGVTb3VyY2VKBAgLEAwidwoYTGlzdE9yZ2FuaXphdGlvbnNSZXF1ZXN0EiwKBmZpbHRlchgBIAEoCzIcLmhvbG9zLmNvbnNvbGUudjEuTGlzdEZpbHRlchItCghvcmRlcl9ieRgCIAEoCzIbLmhvbG9zLmNvbnNvbGUudjEuTGlzdE9yZGVyIlIKGUxpc3RPcmdhbml6YXRpb25zUmVzcG9uc2USNQoNb3JnYW5pemF0aW9ucxgBIAMoCzIeLmhvbG9zLmNvbnNvbGUudjEuT3JnYW5pemF0aW9uIi4KFkdldE9yZ2FuaXphdGlvblJlcXVlc3QSFAoEbmFtZRgBIAEoCUIGukgDyAEBIk8KF0dldE9yZ2FuaXphdGlvblJlc3BvbnNlEjQKDG9yZ2FuaXphdGlvbhgBIAEoCzIeLmhvbG9zLmNvbnNvbGUudjEuT3JnYW5pemF0aW9uIqcCChlDcmVhdGVPcmdhbml6YXRpb25SZXF1ZXN0EjMKBG5hbWUYASABKAlCJbpIIsgBAXIdGD8yGV5bYS16XVthLXowLTktXSpbYS16MC05XSQSFAoMZGlzcGxheV9uYW1lGAIgASgJEh0KC2Rlc2NyaXB0aW9uGAMgASgJQgi6SAVyAxiAIBIxCgt1c2VyX2dyYW50cxgEIAMoCzIcLmhvbG9zLmNvbnNvbGUudjEuU2hhcmVHcmFudBIxCgtyb2xlX2dyYW50cxgFIAMoCzIcLmhvbG9zLmNvbnNvbGUudjEuU2hhcmVHcmFudBIeChFwb3B1bGF0ZV9kZWZhdWx0cxgHIAEoCEgAiAEBQhQKEl9wb3B1bGF0ZV9kZWZhdWx0c0oECAYQByIqChpDcmVhdGVPcmdhbml6YXRpb25SZXNwb25zZRIMCgRuYW1lGAEgASgJIs0BChlVcGRhdGVPcmdhbml6YXRpb25SZXF1ZXN0EhQKBG5hbWUYASABKAlCBrpIA8gBARIZCgxkaXNwbGF5X25hbWUYAiABKAlIAIgBARIiCgtkZXNjcmlwdGlvbhgDIAEoCUIIukgFcgMYgCBIAYgBARIeChFnYXRld2F5X25hbWVzcGFjZRgFIAEoCUgCiAEBQg8KDV9kaXNwbGF5X25hbWVCDgoMX2Rlc2NyaXB0aW9uQhQKEl9nYXRld2F5X25hbWVzcGFjZUoECAQQBSIcChpVcGRhdGVPcmdhbml6YXRpb25SZXNwb25zZSIxChlEZWxldGVPcmdhbml6YXRpb25SZXF1ZXN0EhQKBG5hbWUYASABKAlCBrpIA8gBASIcChpEZWxldGVPcmdhbml6YXRpb25SZXNwb25zZSKtAQogVXBkYXRlT3JnYW5pemF0aW9uU2hhcmluZ1JlcXVlc3QSFAoEbmFtZRgBIAEoCUIGukgDyAEBEjEKC3VzZXJfZ3JhbnRzGAIgAygLMhwuaG9sb3MuY29uc29sZS52MS5TaGFyZUdyYW50EjEKC3JvbGVfZ3JhbnRzGAMgAygLMhwuaG9sb3MuY29uc29sZS52MS5TaGFyZUdyYW50Eg0KBWZvcmNlGAQgASgIIlkKIVVwZGF0ZU9yZ2FuaXphdGlvblNoYXJpbmdSZXNwb25zZRI0Cgxvcmdhbml6YXRpb24YASABKAsyHi5ob2xvcy5jb25zb2xlLnYxLk9yZ2FuaXphdGlvbiJ8ChlHZXRPcmdhbml6YXRpb25SYXdSZXF1ZXN0EhQKBG5hbWUYASABKAlCBrpIA8gBARIrCgZmb3JtYXQYAiABKA4yGy5ob2xvcy5jb25zb2xlLnYxLlJhd0Zvcm1hdBIcChRzdHJpcF9tYW5hZ2VkX2ZpZWxkcxgDIAEoCCIpChpHZXRPcmdhbml6YXRpb25SYXdSZXNwb25zZRILCgNyYXcYASABKAkitQEKJ1VwZGF0ZU9yZ2FuaXphdGlvbkRlZmF1bHRTaGFyaW5nUmVxdWVzdBIUCgRuYW1lGAEgASgJQga6SAPIAQESOQoTZGVmYXVsdF91c2VyX2dyYW50cxgCIAMoCzIcLmhvbG9zLmNvbnNvbGUudjEuU2hhcmVHcmFudBI5ChNkZWZhdWx0X3JvbGVfZ3JhbnRzGAMgAygLMhwuaG9sb3MuY29uc29sZS52MS5TaGFyZUdyYW50ImAKKFVwZGF0ZU9yZ2FuaXphdGlvbkRlZmF1bHRTaGFyaW5nUmVzcG9uc2USNAoMb3JnYW5pemF0aW9uGAEgASgLMh4uaG9sb3MuY29uc29sZS52MS5Pcmdhbml6YXRpb24iMwobR2V0T3JnYW5pemF0aW9uU3RhdHNSZXF1ZXN0EhQKBG5hbWUYASABKAlCBrpIA8gBASJfCg9BY3Rpdml0eVN1bW1hcnkSDgoGYWN0aW9uGAEgASgJEg0KBWNvdW50GAIgASgFEi0KCWxhc3RfdGltZRgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAivgEKHEdldE9yZ2FuaXphdGlvblN0YXRzUmVzcG9uc2USFQoNcHJvamVjdF9jb3VudBgBIAEoBRIUCgxzZWNyZXRfY291bnQYAiABKAUSFAoMbWVtYmVyX2NvdW50GAMgASgFEjoKD3JlY2VudF9hY3Rpdml0eRgEIAMoCzIhLmhvbG9zLmNvbnNvbGUudjEuQWN0aXZpdHlTdW1tYXJ5Eh8KF2FjdGl2aXR5X3dpbmRvd19zZWNvbmRzGAUgASgDIjYKHkxpc3RPcmdhbml6YXRpb25NZW1iZXJzUmVxdWVzdBIUCgRuYW1lGAEgASgJQga6SAPIAQEi5wEKEk9yZ2FuaXphdGlvbk1lbWJlchIRCglwcmluY2lwYWwYASABKAkSLQoEa2luZBgCIAEoDjIfLmhvbG9zLmNvbnNvbGUudjEuUHJpbmNpcGFsS2luZBIsCgxoaWdoZXN0X3JvbGUYAyABKA4yFi5ob2xvcy5jb25zb2xlLnYxLlJvbGUSGwoTb3JnYW5pemF0aW9uX2dyYW50cxgEIAEoBRIVCg1mb2xkZXJfZ3JhbnRzGAUgASgFEhYKDnByb2plY3RfZ3JhbnRzGAYgASgFEhUKDXNlY3JldF9ncmFudHMYByABKAUiWAofTGlzdE9yZ2FuaXphdGlvbk1lbWJlcnNSZXNwb25zZRI1CgdtZW1iZXJzGAEgAygLMiQuaG9sb3MuY29uc29sZS52MS5Pcmdhbml6YXRpb25NZW1iZXIy4wkKE09yZ2FuaXphdGlvblNlcnZpY2UScQoRTGlzdE9yZ2FuaXphdGlvbnMSKi5ob2xvcy5jb25zb2xlLnYxLkxpc3RPcmdhbml6YXRpb25zUmVxdWVzdBorLmhvbG9zLmNvbnNvbGUudjEuTGlzdE9yZ2FuaXphdGlvbnNSZXNwb25zZSIDkAIBEmsKD0dldE9yZ2FuaXphdGlvbhIoLmhvbG9zLmNvbnNvbGUudjEuR2V0T3JnYW5pemF0aW9uUmVxdWVzdBopLmhvbG9zLmNvbnNvbGUudjEuR2V0T3JnYW5pemF0aW9uUmVzcG9uc2UiA5ACARJvChJDcmVhdGVPcmdhbml6YXRpb24SKy5ob2xvcy5jb25zb2xlLnYxLkNyZWF0ZU9yZ2FuaXphdGlvblJlcXVlc3QaLC5ob2xvcy5jb25zb2xlLnYxLkNyZWF0ZU9yZ2FuaXphdGlvblJlc3BvbnNlEm8KElVwZGF0ZU9yZ2FuaXphdGlvbhIrLmhvbG9zLmNvbnNvbGUudjEuVXBkYXRlT3JnYW5pemF0aW9uUmVxdWVzdBosLmhvbG9zLmNvbnNvbGUudjEuVXBkYXRlT3JnYW5pemF0aW9uUmVzcG9uc2USbwoSRGVsZXRlT3JnYW5pemF0aW9uEisuaG9sb3MuY29uc29sZS52MS5EZWxldGVPcmdhbml6YXRpb25SZXF1ZXN0GiwuaG9sb3MuY29uc29sZS52MS5EZWxldGVPcmdhbml6YXRpb25SZXNwb25zZRKEAQoZVXBkYXRlT3JnYW5pemF0aW9uU2hhcmluZxIyLmhvbG9zLmNvbnNvbGUudjEuVXBkYXRlT3JnYW5pemF0aW9uU2hhcmluZ1JlcXVlc3QaMy5ob2xvcy5jb25zb2xlLnYxLlVwZGF0ZU9yZ2FuaXphdGlvblNoYXJpbmdSZXNwb25zZRJ0ChJHZXRPcmdhbml6YXRpb25SYXcSKy5ob2xvcy5jb25zb2xlLnYxLkdldE9yZ2FuaXphdGlvblJhd1JlcXVlc3QaLC5ob2xvcy5jb25zb2xlLnYxLkdldE9yZ2FuaXphdGlvblJhd1Jlc3BvbnNlIgOQAgESmQEKIFVwZGF0ZU9yZ2FuaXphdGlvbkRlZmF1bHRTaGFyaW5nEjkuaG9sb3MuY29uc29sZS52MS5VcGRhdGVPcmdhbml6YXRpb25EZWZhdWx0U2hhcmluZ1JlcXVlc3QaOi5ob2xvcy5jb25zb2xlLnYxLlVwZGF0ZU9yZ2FuaXphdGlvbkRlZmF1bHRTaGFyaW5nUmVzcG9uc2USegoUR2V0T3JnYW5pemF0aW9uU3RhdHMSLS5ob2xvcy5jb25zb2xlLnYxLkdldE9yZ2FuaXphdGlvblN0YXRzUmVxdWVzdBouLmhvbG9zLmNvbnNvbGUudjEuR2V0T3JnYW5pemF0aW9uU3RhdHNSZXNwb25zZSIDkAIBEoMBChdMaXN0T3JnYW5pemF0aW9uTWVtYmVycxIwLmhvbG9zLmNvbnNvbGUudjEuTGlzdE9yZ2FuaXphdGlvbk1lbWJlcnNSZXF1ZXN0GjEuaG9sb3MuY29uc29sZS52MS5MaXN0T3JnYW5pemF0aW9uTWVtYmVyc1Jlc3BvbnNlIgOQAgFCQ1pBZ2l0aHViLmNvbS9ob2xvcy1ydW4vaG9sb3MtY29uc29sZS9nZW4vaG9sb3MvY29uc29sZS92MTtjb25zb2xldjFiBnByb3RvMw", [file_buf_validate_validate, file_google_protobuf_timestamp, file_holos_console_v1_list_filter, file_holos_console_v1_permissions, file_holos_console_v1_raw_format, file_holos_console_v1_rbac, file_holos_console_v1_secrets]);
 
 /**
  * Describes the message holos.console.v1.Organization.
@@ -131,6 +136,48 @@ export const UpdateOrganizationDefaultSharingRequestSchema = /*@__PURE__*/
 export const UpdateOrganizationDefaultSharingResponseSchema = /*@__PURE__*/
   messageDesc(file_holos_console_v1_organizations, 16);
 
+/**
+ * Describes the message holos.console.v1.GetOrganizationStatsRequest.
+ * Use `create(GetOrganizationStatsRequestSchema)` to create a new message.
+ */
+export const GetOrganizationStatsRequestSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_organizations, 17);
+
+/**
+ * Describes the message holos.console.v1.ActivitySummary.
+ * Use `create(ActivitySummarySchema)` to create a new message.
+ */
+export const ActivitySummarySchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_organizations, 18);
+
+/**
+ * Describes the message holos.console.v1.GetOrganizationStatsResponse.
+ * Use `create(GetOrganizationStatsResponseSchema)` to create a new message.
+ */
+export const GetOrganizationStatsResponseSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_organizations, 19);
+
+/**
+ * Describes the message holos.console.v1.ListOrganizationMembersRequest.
+ * Use `create(ListOrganizationMembersRequestSchema)` to create a new message.
+ */
+export const ListOrganizationMembersRequestSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_organizations, 20);
+
+/**
+ * Describes the message holos.console.v1.OrganizationMember.
+ * Use `create(OrganizationMemberSchema)` to create a new message.
+ */
+export const OrganizationMemberSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_organizations, 21);
+
+/**
+ * Describes the message holos.console.v1.ListOrganizationMembersResponse.
+ * Use `create(ListOrganizationMembersResponseSchema)` to create a new message.
+ */
+export const ListOrganizationMembersResponseSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_organizations, 22);
+
 /**
  * OrganizationService provides CRUD operations for organizations.
  * An organization is a top-level administrative boundary backed by a Kubernetes
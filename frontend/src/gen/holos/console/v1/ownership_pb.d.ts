@@ -0,0 +1,111 @@
+// @generated by protoc-gen-es v2.11.0
+// @generated from file holos/console/v1/ownership.proto (package holos.console.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import type { Message } from "@bufbuild/protobuf";
+import type { Role } from "./rbac_pb";
+import type { ShareGrant } from "./secrets_pb";
+
+/**
+ * Describes the file holos/console/v1/ownership.proto.
+ */
+export declare const file_holos_console_v1_ownership: GenFile;
+
+/**
+ * TransferOwnershipRequest identifies the resource and its new owner.
+ *
+ * @generated from message holos.console.v1.TransferOwnershipRequest
+ */
+export declare type TransferOwnershipRequest = Message<"holos.console.v1.TransferOwnershipRequest"> & {
+  /**
+   * resource_type is "organization" or "project". Secrets are transferred
+   * with their project: project owners own every secret in the project.
+   *
+   * @generated from field: string resource_type = 1;
+   */
+  resourceType: string;
+
+  /**
+   * name is the organization or project name.
+   *
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * new_owner is the email address of the user who becomes an owner. Any
+   * grant they already hold is replaced by the owner grant.
+   *
+   * @generated from field: string new_owner = 3;
+   */
+  newOwner: string;
+
+  /**
+   * caller_role is the role the caller keeps after the transfer.
+   * ROLE_UNSPECIFIED leaves the caller an owner; ROLE_EDITOR or ROLE_VIEWER
+   * demotes the caller's user grant. A caller who owns the resource through
+   * a role grant keeps that grant.
+   *
+   * @generated from field: holos.console.v1.Role caller_role = 4;
+   */
+  callerRole: Role;
+};
+
+/**
+ * Describes the message holos.console.v1.TransferOwnershipRequest.
+ * Use `create(TransferOwnershipRequestSchema)` to create a new message.
+ */
+export declare const TransferOwnershipRequestSchema: GenMessage<TransferOwnershipRequest>;
+
+/**
+ * TransferOwnershipResponse contains the sharing grants after the transfer.
+ *
+ * @generated from message holos.console.v1.TransferOwnershipResponse
+ */
+export declare type TransferOwnershipResponse = Message<"holos.console.v1.TransferOwnershipResponse"> & {
+  /**
+   * user_grants are the per-user sharing grants on the resource.
+   *
+   * @generated from field: repeated holos.console.v1.ShareGrant user_grants = 1;
+   */
+  userGrants: ShareGrant[];
+
+  /**
+   * role_grants are the per-role sharing grants on the resource.
+   *
+   * @generated from field: repeated holos.console.v1.ShareGrant role_grants = 2;
+   */
+  roleGrants: ShareGrant[];
+};
+
+/**
+ * Describes the message holos.console.v1.TransferOwnershipResponse.
+ * Use `create(TransferOwnershipResponseSchema)` to create a new message.
+ */
+export declare const TransferOwnershipResponseSchema: GenMessage<TransferOwnershipResponse>;
+
+/**
+ * OwnershipService hands an organization or project to a new owner. A
+ * transfer adds the new owner and demotes the caller in a single sharing
+ * update, so the resource is never left without an owner, or with both
+ * users as owners, between two UpdateSharing calls.
+ *
+ * @generated from service holos.console.v1.OwnershipService
+ */
+export declare const OwnershipService: GenService<{
+  /**
+   * TransferOwnership grants owner to new_owner and, when caller_role is
+   * set, demotes the caller's user grant to it. Requires owner access to the
+   * resource, checked exactly as the resource's sharing update checks it.
+   * Emits an ownership_transfer audit event.
+   *
+   * @generated from rpc holos.console.v1.OwnershipService.TransferOwnership
+   */
+  transferOwnership: {
+    methodKind: "unary";
+    input: typeof TransferOwnershipRequestSchema;
+    output: typeof TransferOwnershipResponseSchema;
+  },
+}>;
+
@@ -0,0 +1,40 @@
+// @generated by protoc-gen-es v2.11.0
+// @generated from file holos/console/v1/ownership.proto (package holos.console.v1, syntax proto3)
+/* eslint-disable */
+
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import { file_buf_validate_validate } from "../../../buf/validate/validate_pb";
+import { file_holos_console_v1_rbac } from "./rbac_pb";
+import { file_holos_console_v1_secrets } from "./secrets_pb";
+
+/**
+ * Describes the file holos/console/v1/ownership.proto.
+ */
+export const file_holos_console_v1_ownership = /*@__PURE__*/
+  fileDesc("CiBob2xvcy9jb25zb2xlL3YxL293bmVyc2hpcC5wcm90bxIQaG9sb3MuY29uc29sZS52MSK+AQoYVHJhbnNmZXJPd25lcnNoaXBSZXF1ZXN0EjYKDXJlc291cmNlX3R5cGUYASABKAlCH7pIHMgBAXIXUgxvcmdhbml6YXRpb25SB3Byb2plY3QSFAoEbmFtZRgCIAEoCUIGukgDyAEBEh0KCW5ld19vd25lchgDIAEoCUIKukgHyAEBcgJgARI1CgtjYWxsZXJfcm9sZRgEIAEoDjIWLmhvbG9zLmNvbnNvbGUudjEuUm9sZUIIukgFggECEAEigQEKGVRyYW5zZmVyT3duZXJzaGlwUmVzcG9uc2USMQoLdXNlcl9ncmFudHMYASADKAsyHC5ob2xvcy5jb25zb2xlLnYxLlNoYXJlR3JhbnQSMQoLcm9sZV9ncmFudHMYAiADKAsyHC5ob2xvcy5jb25zb2xlLnYxLlNoYXJlR3JhbnQygAEKEE93bmVyc2hpcFNlcnZpY2USbAoRVHJhbnNmZXJPd25lcnNoaXASKi5ob2xvcy5jb25zb2xlLnYxLlRyYW5zZmVyT3duZXJzaGlwUmVxdWVzdBorLmhvbG9zLmNvbnNvbGUudjEuVHJhbnNmZXJPd25lcnNoaXBSZXNwb25zZUJDWkFnaXRodWIuY29tL2hvbG9zLXJ1bi9ob2xvcy1jb25zb2xlL2dlbi9ob2xvcy9jb25zb2xlL3YxO2NvbnNvbGV2MWIGcHJvdG8z", [file_buf_validate_validate, file_holos_console_v1_rbac, file_holos_console_v1_secrets]);
+
+/**
+ * Describes the message holos.console.v1.TransferOwnershipRequest.
+ * Use `create(TransferOwnershipRequestSchema)` to create a new message.
+ */
+export const TransferOwnershipRequestSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_ownership, 0);
+
+/**
+ * Describes the message holos.console.v1.TransferOwnershipResponse.
+ * Use `create(TransferOwnershipResponseSchema)` to create a new message.
+ */
+export const TransferOwnershipResponseSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_ownership, 1);
+
+/**
+ * OwnershipService hands an organization or project to a new owner. A
+ * transfer adds the new owner and demotes the caller in a single sharing
+ * update, so the resource is never left without an owner, or with both
+ * users as owners, between two UpdateSharing calls.
+ *
+ * @generated from service holos.console.v1.OwnershipService
+ */
+export const OwnershipService = /*@__PURE__*/
+  serviceDesc(file_holos_console_v1_ownership, 0);
+
@@ -2,8 +2,9 @@
 // @generated from file holos/console/v1/permissions.proto (package holos.console.v1, syntax proto3)
 /* eslint-disable */
 
-import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
 import type { Message } from "@bufbuild/protobuf";
+import type { Permission, Role } from "./rbac_pb";
 
 /**
  * Describes the file holos/console/v1/permissions.proto.
@@ -163,6 +164,358 @@ export declare type ListResourcePermissionsResponse = Message<"holos.console.v1.
  */
 export declare const ListResourcePermissionsResponseSchema: GenMessage<ListResourcePermissionsResponse>;
 
+/**
+ * GetAccessReviewRequest identifies the resource to review.
+ *
+ * @generated from message holos.console.v1.GetAccessReviewRequest
+ */
+export declare type GetAccessReviewRequest = Message<"holos.console.v1.GetAccessReviewRequest"> & {
+  /**
+   * resource_type is "secret", "project", "folder", or "organization".
+   *
+   * @generated from field: string resource_type = 1;
+   */
+  resourceType: string;
+
+  /**
+   * name is the resource name.
+   *
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * project is the project that owns the secret. Required when
+   * resource_type is "secret" and ignored otherwise.
+   *
+   * @generated from field: string project = 3;
+   */
+  project: string;
+};
+
+/**
+ * Describes the message holos.console.v1.GetAccessReviewRequest.
+ * Use `create(GetAccessReviewRequestSchema)` to create a new message.
+ */
+export declare const GetAccessReviewRequestSchema: GenMessage<GetAccessReviewRequest>;
+
+/**
+ * AccessGrant is the effective role one principal holds on the resource.
+ *
+ * @generated from message holos.console.v1.AccessGrant
+ */
+export declare type AccessGrant = Message<"holos.console.v1.AccessGrant"> & {
+  /**
+   * principal is the subject name without the "oidc:" prefix, e.g.
+   * "jane@example.com" or "platform-admins". ServiceAccounts are reported as
+   * "system:serviceaccount:<namespace>:<name>".
+   *
+   * @generated from field: string principal = 1;
+   */
+  principal: string;
+
+  /**
+   * kind is the kind of subject.
+   *
+   * @generated from field: holos.console.v1.PrincipalKind kind = 2;
+   */
+  kind: PrincipalKind;
+
+  /**
+   * role is the highest role granted: ROLE_OWNER when the principal may
+   * delete the resource, ROLE_EDITOR when it may update it, and ROLE_VIEWER
+   * when it may only read it.
+   *
+   * @generated from field: holos.console.v1.Role role = 3;
+   */
+  role: Role;
+
+  /**
+   * sources lists every binding that grants access, as
+   * "RoleBinding/<namespace>/<name>" or "ClusterRoleBinding/<name>".
+   *
+   * @generated from field: repeated string sources = 4;
+   */
+  sources: string[];
+};
+
+/**
+ * Describes the message holos.console.v1.AccessGrant.
+ * Use `create(AccessGrantSchema)` to create a new message.
+ */
+export declare const AccessGrantSchema: GenMessage<AccessGrant>;
+
+/**
+ * GetAccessReviewResponse lists every principal with access, ordered by role
+ * (owners first) and then by principal.
+ *
+ * @generated from message holos.console.v1.GetAccessReviewResponse
+ */
+export declare type GetAccessReviewResponse = Message<"holos.console.v1.GetAccessReviewResponse"> & {
+  /**
+   * @generated from field: repeated holos.console.v1.AccessGrant grants = 1;
+   */
+  grants: AccessGrant[];
+};
+
+/**
+ * Describes the message holos.console.v1.GetAccessReviewResponse.
+ * Use `create(GetAccessReviewResponseSchema)` to create a new message.
+ */
+export declare const GetAccessReviewResponseSchema: GenMessage<GetAccessReviewResponse>;
+
+/**
+ * CanIRequest names a permission and the resource to check it against.
+ *
+ * @generated from message holos.console.v1.CanIRequest
+ */
+export declare type CanIRequest = Message<"holos.console.v1.CanIRequest"> & {
+  /**
+   * permission is the console permission to check. Supported permissions are
+   * the PERMISSION_SECRETS_*, PERMISSION_PROJECTS_*, PERMISSION_FOLDERS_*,
+   * and PERMISSION_ORGANIZATIONS_* families.
+   *
+   * @generated from field: holos.console.v1.Permission permission = 1;
+   */
+  permission: Permission;
+
+  /**
+   * resource_type is "secret", "project", "folder", or "organization" and
+   * must match the permission's family.
+   *
+   * @generated from field: string resource_type = 2;
+   */
+  resourceType: string;
+
+  /**
+   * name is the resource name. Leave empty for LIST and CREATE permissions,
+   * which apply to the collection rather than a single resource.
+   *
+   * @generated from field: string name = 3;
+   */
+  name: string;
+
+  /**
+   * project is the project that owns the secret. Required when
+   * resource_type is "secret".
+   *
+   * @generated from field: string project = 4;
+   */
+  project: string;
+};
+
+/**
+ * Describes the message holos.console.v1.CanIRequest.
+ * Use `create(CanIRequestSchema)` to create a new message.
+ */
+export declare const CanIRequestSchema: GenMessage<CanIRequest>;
+
+/**
+ * CanIResponse is the access decision.
+ *
+ * @generated from message holos.console.v1.CanIResponse
+ */
+export declare type CanIResponse = Message<"holos.console.v1.CanIResponse"> & {
+  /**
+   * allowed reports whether the caller holds the permission.
+   *
+   * @generated from field: bool allowed = 1;
+   */
+  allowed: boolean;
+
+  /**
+   * reason carries the decision reason text from the API server when present.
+   *
+   * @generated from field: string reason = 2;
+   */
+  reason: string;
+
+  /**
+   * attributes is the SubjectAccessReview the permission was translated to.
+   *
+   * @generated from field: holos.console.v1.ResourceAttributes attributes = 3;
+   */
+  attributes?: ResourceAttributes;
+};
+
+/**
+ * Describes the message holos.console.v1.CanIResponse.
+ * Use `create(CanIResponseSchema)` to create a new message.
+ */
+export declare const CanIResponseSchema: GenMessage<CanIResponse>;
+
+/**
+ * ListMyGroupsRequest has no fields; the caller's groups come from the
+ * validated ID token.
+ *
+ * @generated from message holos.console.v1.ListMyGroupsRequest
+ */
+export declare type ListMyGroupsRequest = Message<"holos.console.v1.ListMyGroupsRequest"> & {
+};
+
+/**
+ * Describes the message holos.console.v1.ListMyGroupsRequest.
+ * Use `create(ListMyGroupsRequestSchema)` to create a new message.
+ */
+export declare const ListMyGroupsRequestSchema: GenMessage<ListMyGroupsRequest>;
+
+/**
+ * GroupGrant is one binding that activates access for a group.
+ *
+ * @generated from message holos.console.v1.GroupGrant
+ */
+export declare type GroupGrant = Message<"holos.console.v1.GroupGrant"> & {
+  /**
+   * source is the binding, as "RoleBinding/<namespace>/<name>" or
+   * "ClusterRoleBinding/<name>".
+   *
+   * @generated from field: string source = 1;
+   */
+  source: string;
+
+  /**
+   * role_ref is the bound role, as "Role/<name>" or "ClusterRole/<name>".
+   *
+   * @generated from field: string role_ref = 2;
+   */
+  roleRef: string;
+
+  /**
+   * namespace is the RoleBinding namespace, empty for ClusterRoleBindings.
+   *
+   * @generated from field: string namespace = 3;
+   */
+  namespace: string;
+
+  /**
+   * resource_type is "organization", "folder", or "project" when namespace
+   * backs a console resource, and empty otherwise.
+   *
+   * @generated from field: string resource_type = 4;
+   */
+  resourceType: string;
+
+  /**
+   * resource_name is the console resource namespace backs, when known.
+   *
+   * @generated from field: string resource_name = 5;
+   */
+  resourceName: string;
+
+  /**
+   * role is the console role the binding confers on namespace, or on every
+   * namespace for a ClusterRoleBinding: ROLE_OWNER for delete, ROLE_EDITOR
+   * for update, ROLE_VIEWER for get. ROLE_UNSPECIFIED means the binding
+   * grants narrower access, such as secrets only.
+   *
+   * @generated from field: holos.console.v1.Role role = 6;
+   */
+  role: Role;
+};
+
+/**
+ * Describes the message holos.console.v1.GroupGrant.
+ * Use `create(GroupGrantSchema)` to create a new message.
+ */
+export declare const GroupGrantSchema: GenMessage<GroupGrant>;
+
+/**
+ * GroupMembership is one group from the caller's ID token.
+ *
+ * @generated from message holos.console.v1.GroupMembership
+ */
+export declare type GroupMembership = Message<"holos.console.v1.GroupMembership"> & {
+  /**
+   * group is the group name exactly as the ID token carries it.
+   *
+   * @generated from field: string group = 1;
+   */
+  group: string;
+
+  /**
+   * principal is the name the group is impersonated as, e.g.
+   * "oidc:platform-admins". Empty for system: groups, which are never
+   * forwarded.
+   *
+   * @generated from field: string principal = 2;
+   */
+  principal: string;
+
+  /**
+   * grants lists every binding naming principal, cluster-wide bindings
+   * first.
+   *
+   * @generated from field: repeated holos.console.v1.GroupGrant grants = 3;
+   */
+  grants: GroupGrant[];
+};
+
+/**
+ * Describes the message holos.console.v1.GroupMembership.
+ * Use `create(GroupMembershipSchema)` to create a new message.
+ */
+export declare const GroupMembershipSchema: GenMessage<GroupMembership>;
+
+/**
+ * ListMyGroupsResponse reports the caller's resolved groups.
+ *
+ * @generated from message holos.console.v1.ListMyGroupsResponse
+ */
+export declare type ListMyGroupsResponse = Message<"holos.console.v1.ListMyGroupsResponse"> & {
+  /**
+   * groups is in ID token order.
+   *
+   * @generated from field: repeated holos.console.v1.GroupMembership groups = 1;
+   */
+  groups: GroupMembership[];
+};
+
+/**
+ * Describes the message holos.console.v1.ListMyGroupsResponse.
+ * Use `create(ListMyGroupsResponseSchema)` to create a new message.
+ */
+export declare const ListMyGroupsResponseSchema: GenMessage<ListMyGroupsResponse>;
+
+/**
+ * PrincipalKind is the kind of Kubernetes RBAC subject an access grant names.
+ *
+ * @generated from enum holos.console.v1.PrincipalKind
+ */
+export enum PrincipalKind {
+  /**
+   * PRINCIPAL_KIND_UNSPECIFIED indicates no kind was specified.
+   *
+   * @generated from enum value: PRINCIPAL_KIND_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * PRINCIPAL_KIND_USER is an OIDC user.
+   *
+   * @generated from enum value: PRINCIPAL_KIND_USER = 1;
+   */
+  USER = 1,
+
+  /**
+   * PRINCIPAL_KIND_GROUP is an OIDC group or Kubernetes group.
+   *
+   * @generated from enum value: PRINCIPAL_KIND_GROUP = 2;
+   */
+  GROUP = 2,
+
+  /**
+   * PRINCIPAL_KIND_SERVICE_ACCOUNT is a Kubernetes ServiceAccount.
+   *
+   * @generated from enum value: PRINCIPAL_KIND_SERVICE_ACCOUNT = 3;
+   */
+  SERVICE_ACCOUNT = 3,
+}
+
+/**
+ * Describes the enum holos.console.v1.PrincipalKind.
+ */
+export declare const PrincipalKindSchema: GenEnum<PrincipalKind>;
+
 /**
  * PermissionsService exposes Kubernetes SubjectAccessReview decisions to the
  * frontend so the UI can decide which action buttons to render before the
@@ -190,5 +543,50 @@ export declare const PermissionsService: GenService<{
     input: typeof ListResourcePermissionsRequestSchema;
     output: typeof ListResourcePermissionsResponseSchema;
   },
+  /**
+   * GetAccessReview answers "who can access this resource?" for a secret,
+   * project, folder, or organization. The backend resolves every RoleBinding
+   * in the resource's namespace and every ClusterRoleBinding, so per-resource
+   * sharing, namespace-wide grants, and platform groups bound cluster-wide
+   * are all reported with the highest role each principal holds.
+   * Requires owner access, checked as the "delete" verb on the resource.
+   *
+   * @generated from rpc holos.console.v1.PermissionsService.GetAccessReview
+   */
+  getAccessReview: {
+    methodKind: "unary";
+    input: typeof GetAccessReviewRequestSchema;
+    output: typeof GetAccessReviewResponseSchema;
+  },
+  /**
+   * CanI reports whether the caller holds a console Permission on a secret,
+   * project, folder, or organization. The backend translates the permission
+   * into the same SelfSubjectAccessReview the owning handler relies on, so
+   * the frontend does not need to know which Kubernetes verb and resource
+   * back each permission.
+   *
+   * @generated from rpc holos.console.v1.PermissionsService.CanI
+   */
+  canI: {
+    methodKind: "unary";
+    input: typeof CanIRequestSchema;
+    output: typeof CanIResponseSchema;
+  },
+  /**
+   * ListMyGroups reports the groups the caller's ID token carries, the
+   * oidc:-prefixed principal each is forwarded to the API server as, and
+   * every RoleBinding and ClusterRoleBinding that names the group. It is a
+   * self-service answer to "I'm in the right group but denied": a group
+   * with no grants is either missing from the bindings or misspelled in the
+   * IdP. Any authenticated caller may list their own groups; bindings are
+   * read with the console's service account.
+   *
+   * @generated from rpc holos.console.v1.PermissionsService.ListMyGroups
+   */
+  listMyGroups: {
+    methodKind: "unary";
+    input: typeof ListMyGroupsRequestSchema;
+    output: typeof ListMyGroupsResponseSchema;
+  },
 }>;
 
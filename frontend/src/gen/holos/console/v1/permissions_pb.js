@@ -2,13 +2,14 @@
 // @generated from file holos/console/v1/permissions.proto (package holos.console.v1, syntax proto3)
 /* eslint-disable */
 
-import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import { enumDesc, fileDesc, messageDesc, serviceDesc, tsEnum } from "@bufbuild/protobuf/codegenv2";
+import { file_holos_console_v1_rbac } from "./rbac_pb";
 
 /**
  * Describes the file holos/console/v1/permissions.proto.
  */
 export const file_holos_console_v1_permissions = /*@__PURE__*/
-  fileDesc("CiJob2xvcy9jb25zb2xlL3YxL3Blcm1pc3Npb25zLnByb3RvEhBob2xvcy5jb25zb2xlLnYxInkKElJlc291cmNlQXR0cmlidXRlcxIMCgR2ZXJiGAEgASgJEg0KBWdyb3VwGAIgASgJEhAKCHJlc291cmNlGAMgASgJEhMKC3N1YnJlc291cmNlGAQgASgJEhEKCW5hbWVzcGFjZRgFIAEoCRIMCgRuYW1lGAYgASgJIloKHkxpc3RSZXNvdXJjZVBlcm1pc3Npb25zUmVxdWVzdBI4CgphdHRyaWJ1dGVzGAEgAygLMiQuaG9sb3MuY29uc29sZS52MS5SZXNvdXJjZUF0dHJpYnV0ZXMijAEKElJlc291cmNlUGVybWlzc2lvbhI4CgphdHRyaWJ1dGVzGAEgASgLMiQuaG9sb3MuY29uc29sZS52MS5SZXNvdXJjZUF0dHJpYnV0ZXMSDwoHYWxsb3dlZBgCIAEoCBIOCgZkZW5pZWQYAyABKAgSDgoGcmVhc29uGAQgASgJEgsKA2tleRgFIAEoCSJcCh9MaXN0UmVzb3VyY2VQZXJtaXNzaW9uc1Jlc3BvbnNlEjkKC3Blcm1pc3Npb25zGAEgAygLMiQuaG9sb3MuY29uc29sZS52MS5SZXNvdXJjZVBlcm1pc3Npb24ylAEKElBlcm1pc3Npb25zU2VydmljZRJ+ChdMaXN0UmVzb3VyY2VQZXJtaXNzaW9ucxIwLmhvbG9zLmNvbnNvbGUudjEuTGlzdFJlc291cmNlUGVybWlzc2lvbnNSZXF1ZXN0GjEuaG9sb3MuY29uc29sZS52MS5MaXN0UmVzb3VyY2VQZXJtaXNzaW9uc1Jlc3BvbnNlQkNaQWdpdGh1Yi5jb20vaG9sb3MtcnVuL2hvbG9zLWNvbnNvbGUvZ2VuL2hvbG9zL2NvbnNvbGUvdjE7Y29uc29sZXYxYgZwcm90bzM");
+  fileDesc("CiJob2xvcy9jb25zb2xlL3YxL3Blcm1pc3Npb25zLnByb3RvEhBob2xvcy5jb25zb2xlLnYxInkKElJlc291cmNlQXR0cmlidXRlcxIMCgR2ZXJiGAEgASgJEg0KBWdyb3VwGAIgASgJEhAKCHJlc291cmNlGAMgASgJEhMKC3N1YnJlc291cmNlGAQgASgJEhEKCW5hbWVzcGFjZRgFIAEoCRIMCgRuYW1lGAYgASgJIloKHkxpc3RSZXNvdXJjZVBlcm1pc3Npb25zUmVxdWVzdBI4CgphdHRyaWJ1dGVzGAEgAygLMiQuaG9sb3MuY29uc29sZS52MS5SZXNvdXJjZUF0dHJpYnV0ZXMijAEKElJlc291cmNlUGVybWlzc2lvbhI4CgphdHRyaWJ1dGVzGAEgASgLMiQuaG9sb3MuY29uc29sZS52MS5SZXNvdXJjZUF0dHJpYnV0ZXMSDwoHYWxsb3dlZBgCIAEoCBIOCgZkZW5pZWQYAyABKAgSDgoGcmVhc29uGAQgASgJEgsKA2tleRgFIAEoCSJcCh9MaXN0UmVzb3VyY2VQZXJtaXNzaW9uc1Jlc3BvbnNlEjkKC3Blcm1pc3Npb25zGAEgAygLMiQuaG9sb3MuY29uc29sZS52MS5SZXNvdXJjZVBlcm1pc3Npb24iTgoWR2V0QWNjZXNzUmV2aWV3UmVxdWVzdBIVCg1yZXNvdXJjZV90eXBlGAEgASgJEgwKBG5hbWUYAiABKAkSDwoHcHJvamVjdBgDIAEoCSKGAQoLQWNjZXNzR3JhbnQSEQoJcHJpbmNpcGFsGAEgASgJEi0KBGtpbmQYAiABKA4yHy5ob2xvcy5jb25zb2xlLnYxLlByaW5jaXBhbEtpbmQSJAoEcm9sZRgDIAEoDjIWLmhvbG9zLmNvbnNvbGUudjEuUm9sZRIPCgdzb3VyY2VzGAQgAygJIkgKF0dldEFjY2Vzc1Jldmlld1Jlc3BvbnNlEi0KBmdyYW50cxgBIAMoCzIdLmhvbG9zLmNvbnNvbGUudjEuQWNjZXNzR3JhbnQidQoLQ2FuSVJlcXVlc3QSMAoKcGVybWlzc2lvbhgBIAEoDjIcLmhvbG9zLmNvbnNvbGUudjEuUGVybWlzc2lvbhIVCg1yZXNvdXJjZV90eXBlGAIgASgJEgwKBG5hbWUYAyABKAkSDwoHcHJvamVjdBgEIAEoCSJpCgxDYW5JUmVzcG9uc2USDwoHYWxsb3dlZBgBIAEoCBIOCgZyZWFzb24YAiABKAkSOAoKYXR0cmlidXRlcxgDIAEoCzIkLmhvbG9zLmNvbnNvbGUudjEuUmVzb3VyY2VBdHRyaWJ1dGVzIhUKE0xpc3RNeUdyb3Vwc1JlcXVlc3QilQEKCkdyb3VwR3JhbnQSDgoGc291cmNlGAEgASgJEhAKCHJvbGVfcmVmGAIgASgJEhEKCW5hbWVzcGFjZRgDIAEoCRIVCg1yZXNvdXJjZV90eXBlGAQgASgJEhUKDXJlc291cmNlX25hbWUYBSABKAkSJAoEcm9sZRgGIAEoDjIWLmhvbG9zLmNvbnNvbGUudjEuUm9sZSJhCg9Hcm91cE1lbWJlcnNoaXASDQoFZ3JvdXAYASABKAkSEQoJcHJpbmNpcGFsGAIgASgJEiwKBmdyYW50cxgDIAMoCzIcLmhvbG9zLmNvbnNvbGUudjEuR3JvdXBHcmFudCJJChRMaXN0TXlHcm91cHNSZXNwb25zZRIxCgZncm91cHMYASADKAsyIS5ob2xvcy5jb25zb2xlLnYxLkdyb3VwTWVtYmVyc2hpcCqGAQoNUHJpbmNpcGFsS2luZBIeChpQUklOQ0lQQUxfS0lORF9VTlNQRUNJRklFRBAAEhcKE1BSSU5DSVBBTF9LSU5EX1VTRVIQARIYChRQUklOQ0lQQUxfS0lORF9HUk9VUBACEiIKHlBSSU5DSVBBTF9LSU5EX1NFUlZJQ0VfQUNDT1VOVBADMrEDChJQZXJtaXNzaW9uc1NlcnZpY2USfgoXTGlzdFJlc291cmNlUGVybWlzc2lvbnMSMC5ob2xvcy5jb25zb2xlLnYxLkxpc3RSZXNvdXJjZVBlcm1pc3Npb25zUmVxdWVzdBoxLmhvbG9zLmNvbnNvbGUudjEuTGlzdFJlc291cmNlUGVybWlzc2lvbnNSZXNwb25zZRJrCg9HZXRBY2Nlc3NSZXZpZXcSKC5ob2xvcy5jb25zb2xlLnYxLkdldEFjY2Vzc1Jldmlld1JlcXVlc3QaKS5ob2xvcy5jb25zb2xlLnYxLkdldEFjY2Vzc1Jldmlld1Jlc3BvbnNlIgOQAgESSgoEQ2FuSRIdLmhvbG9zLmNvbnNvbGUudjEuQ2FuSVJlcXVlc3QaHi5ob2xvcy5jb25zb2xlLnYxLkNhbklSZXNwb25zZSIDkAIBEmIKDExpc3RNeUdyb3VwcxIlLmhvbG9zLmNvbnNvbGUudjEuTGlzdE15R3JvdXBzUmVxdWVzdBomLmhvbG9zLmNvbnNvbGUudjEuTGlzdE15R3JvdXBzUmVzcG9uc2UiA5ACAUJDWkFnaXRodWIuY29tL2hvbG9zLXJ1bi9ob2xvcy1jb25zb2xlL2dlbi9ob2xvcy9jb25zb2xlL3YxO2NvbnNvbGV2MWIGcHJvdG8z", [file_holos_console_v1_rbac]);
 
 /**
  * Describes the message holos.console.v1.ResourceAttributes.
@@ -38,6 +39,83 @@ export const ResourcePermissionSchema = /*@__PURE__*/
 export const ListResourcePermissionsResponseSchema = /*@__PURE__*/
   messageDesc(file_holos_console_v1_permissions, 3);
 
+/**
+ * Describes the message holos.console.v1.GetAccessReviewRequest.
+ * Use `create(GetAccessReviewRequestSchema)` to create a new message.
+ */
+export const GetAccessReviewRequestSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_permissions, 4);
+
+/**
+ * Describes the message holos.console.v1.AccessGrant.
+ * Use `create(AccessGrantSchema)` to create a new message.
+ */
+export const AccessGrantSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_permissions, 5);
+
+/**
+ * Describes the message holos.console.v1.GetAccessReviewResponse.
+ * Use `create(GetAccessReviewResponseSchema)` to create a new message.
+ */
+export const GetAccessReviewResponseSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_permissions, 6);
+
+/**
+ * Describes the message holos.console.v1.CanIRequest.
+ * Use `create(CanIRequestSchema)` to create a new message.
+ */
+export const CanIRequestSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_permissions, 7);
+
+/**
+ * Describes the message holos.console.v1.CanIResponse.
+ * Use `create(CanIResponseSchema)` to create a new message.
+ */
+export const CanIResponseSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_permissions, 8);
+
+/**
+ * Describes the message holos.console.v1.ListMyGroupsRequest.
+ * Use `create(ListMyGroupsRequestSchema)` to create a new message.
+ */
+export const ListMyGroupsRequestSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_permissions, 9);
+
+/**
+ * Describes the message holos.console.v1.GroupGrant.
+ * Use `create(GroupGrantSchema)` to create a new message.
+ */
+export const GroupGrantSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_permissions, 10);
+
+/**
+ * Describes the message holos.console.v1.GroupMembership.
+ * Use `create(GroupMembershipSchema)` to create a new message.
+ */
+export const GroupMembershipSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_permissions, 11);
+
+/**
+ * Describes the message holos.console.v1.ListMyGroupsResponse.
+ * Use `create(ListMyGroupsResponseSchema)` to create a new message.
+ */
+export const ListMyGroupsResponseSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_permissions, 12);
+
+/**
+ * Describes the enum holos.console.v1.PrincipalKind.
+ */
+export const PrincipalKindSchema = /*@__PURE__*/
+  enumDesc(file_holos_console_v1_permissions, 0);
+
+/**
+ * PrincipalKind is the kind of Kubernetes RBAC subject an access grant names.
+ *
+ * @generated from enum holos.console.v1.PrincipalKind
+ */
+export const PrincipalKind = /*@__PURE__*/
+  tsEnum(PrincipalKindSchema);
+
 /**
  * PermissionsService exposes Kubernetes SubjectAccessReview decisions to the
  * frontend so the UI can decide which action buttons to render before the
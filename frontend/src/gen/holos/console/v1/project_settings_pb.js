@@ -9,7 +9,7 @@ import { file_holos_console_v1_rbac } from "./rbac_pb";
  * Describes the file holos/console/v1/project_settings.proto.
  */
 export const file_holos_console_v1_project_settings = /*@__PURE__*/
-  fileDesc("Cidob2xvcy9jb25zb2xlL3YxL3Byb2plY3Rfc2V0dGluZ3MucHJvdG8SEGhvbG9zLmNvbnNvbGUudjEiPwoPUHJvamVjdFNldHRpbmdzEg8KB3Byb2plY3QYASABKAkSGwoTZGVwbG95bWVudHNfZW5hYmxlZBgCIAEoCCIsChlHZXRQcm9qZWN0U2V0dGluZ3NSZXF1ZXN0Eg8KB3Byb2plY3QYASABKAkiUQoaR2V0UHJvamVjdFNldHRpbmdzUmVzcG9uc2USMwoIc2V0dGluZ3MYASABKAsyIS5ob2xvcy5jb25zb2xlLnYxLlByb2plY3RTZXR0aW5ncyJkChxVcGRhdGVQcm9qZWN0U2V0dGluZ3NSZXF1ZXN0Eg8KB3Byb2plY3QYASABKAkSMwoIc2V0dGluZ3MYAiABKAsyIS5ob2xvcy5jb25zb2xlLnYxLlByb2plY3RTZXR0aW5ncyJUCh1VcGRhdGVQcm9qZWN0U2V0dGluZ3NSZXNwb25zZRIzCghzZXR0aW5ncxgBIAEoCzIhLmhvbG9zLmNvbnNvbGUudjEuUHJvamVjdFNldHRpbmdzIi8KHEdldFByb2plY3RTZXR0aW5nc1Jhd1JlcXVlc3QSDwoHcHJvamVjdBgBIAEoCSIsCh1HZXRQcm9qZWN0U2V0dGluZ3NSYXdSZXNwb25zZRILCgNyYXcYASABKAky/QIKFlByb2plY3RTZXR0aW5nc1NlcnZpY2USbwoSR2V0UHJvamVjdFNldHRpbmdzEisuaG9sb3MuY29uc29sZS52MS5HZXRQcm9qZWN0U2V0dGluZ3NSZXF1ZXN0GiwuaG9sb3MuY29uc29sZS52MS5HZXRQcm9qZWN0U2V0dGluZ3NSZXNwb25zZRJ4ChVVcGRhdGVQcm9qZWN0U2V0dGluZ3MSLi5ob2xvcy5jb25zb2xlLnYxLlVwZGF0ZVByb2plY3RTZXR0aW5nc1JlcXVlc3QaLy5ob2xvcy5jb25zb2xlLnYxLlVwZGF0ZVByb2plY3RTZXR0aW5nc1Jlc3BvbnNlEngKFUdldFByb2plY3RTZXR0aW5nc1JhdxIuLmhvbG9zLmNvbnNvbGUudjEuR2V0UHJvamVjdFNldHRpbmdzUmF3UmVxdWVzdBovLmhvbG9zLmNvbnNvbGUudjEuR2V0UHJvamVjdFNldHRpbmdzUmF3UmVzcG9uc2VCQ1pBZ2l0aHViLmNvbS9ob2xvcy1ydW4vaG9sb3MtY29uc29sZS9nZW4vaG9sb3MvY29uc29sZS92MTtjb25zb2xldjFiBnByb3RvMw", [file_holos_console_v1_rbac]);
+  fileDesc("Cidob2xvcy9jb25zb2xlL3YxL3Byb2plY3Rfc2V0dGluZ3MucHJvdG8SEGhvbG9zLmNvbnNvbGUudjEiPwoPUHJvamVjdFNldHRpbmdzEg8KB3Byb2plY3QYASABKAkSGwoTZGVwbG95bWVudHNfZW5hYmxlZBgCIAEoCCIsChlHZXRQcm9qZWN0U2V0dGluZ3NSZXF1ZXN0Eg8KB3Byb2plY3QYASABKAkiUQoaR2V0UHJvamVjdFNldHRpbmdzUmVzcG9uc2USMwoIc2V0dGluZ3MYASABKAsyIS5ob2xvcy5jb25zb2xlLnYxLlByb2plY3RTZXR0aW5ncyJkChxVcGRhdGVQcm9qZWN0U2V0dGluZ3NSZXF1ZXN0Eg8KB3Byb2plY3QYASABKAkSMwoIc2V0dGluZ3MYAiABKAsyIS5ob2xvcy5jb25zb2xlLnYxLlByb2plY3RTZXR0aW5ncyJUCh1VcGRhdGVQcm9qZWN0U2V0dGluZ3NSZXNwb25zZRIzCghzZXR0aW5ncxgBIAEoCzIhLmhvbG9zLmNvbnNvbGUudjEuUHJvamVjdFNldHRpbmdzIi8KHEdldFByb2plY3RTZXR0aW5nc1Jhd1JlcXVlc3QSDwoHcHJvamVjdBgBIAEoCSIsCh1HZXRQcm9qZWN0U2V0dGluZ3NSYXdSZXNwb25zZRILCgNyYXcYASABKAkyhwMKFlByb2plY3RTZXR0aW5nc1NlcnZpY2USdAoSR2V0UHJvamVjdFNldHRpbmdzEisuaG9sb3MuY29uc29sZS52MS5HZXRQcm9qZWN0U2V0dGluZ3NSZXF1ZXN0GiwuaG9sb3MuY29uc29sZS52MS5HZXRQcm9qZWN0U2V0dGluZ3NSZXNwb25zZSIDkAIBEngKFVVwZGF0ZVByb2plY3RTZXR0aW5ncxIuLmhvbG9zLmNvbnNvbGUudjEuVXBkYXRlUHJvamVjdFNldHRpbmdzUmVxdWVzdBovLmhvbG9zLmNvbnNvbGUudjEuVXBkYXRlUHJvamVjdFNldHRpbmdzUmVzcG9uc2USfQoVR2V0UHJvamVjdFNldHRpbmdzUmF3Ei4uaG9sb3MuY29uc29sZS52MS5HZXRQcm9qZWN0U2V0dGluZ3NSYXdSZXF1ZXN0Gi8uaG9sb3MuY29uc29sZS52MS5HZXRQcm9qZWN0U2V0dGluZ3NSYXdSZXNwb25zZSIDkAIBQkNaQWdpdGh1Yi5jb20vaG9sb3MtcnVuL2hvbG9zLWNvbnNvbGUvZ2VuL2hvbG9zL2NvbnNvbGUvdjE7Y29uc29sZXYxYgZwcm90bzM", [file_holos_console_v1_rbac]);
 
 /**
  * Describes the message holos.console.v1.ProjectSettings.
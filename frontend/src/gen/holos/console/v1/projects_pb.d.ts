@@ -5,8 +5,11 @@
 import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
 import type { Message } from "@bufbuild/protobuf";
 import type { ShareGrant } from "./secrets_pb";
-import type { Role } from "./rbac_pb";
+import type { Role, RoleSource } from "./rbac_pb";
 import type { ParentType } from "./folders_pb";
+import type { ListFilter, ListOrder } from "./list_filter_pb";
+import type { FieldChange } from "./diff_pb";
+import type { RawFormat } from "./raw_format_pb";
 
 /**
  * Describes the file holos/console/v1/projects.proto.
@@ -111,6 +114,23 @@ export declare type Project = Message<"holos.console.v1.Project"> & {
    * @generated from field: string parent_name = 13;
    */
   parentName: string;
+
+  /**
+   * updated_at is the RFC3339-formatted timestamp of the most recent write to
+   * this project's namespace, sourced from metadata.managedFields.
+   *
+   * @generated from field: string updated_at = 14;
+   */
+  updatedAt: string;
+
+  /**
+   * user_role_source explains where user_role comes from: a grant on this
+   * project, a grant on its organization, or the platform. Unset when
+   * user_role is ROLE_UNSPECIFIED.
+   *
+   * @generated from field: holos.console.v1.RoleSource user_role_source = 15;
+   */
+  userRoleSource?: RoleSource;
 };
 
 /**
@@ -145,6 +165,30 @@ export declare type ListProjectsRequest = Message<"holos.console.v1.ListProjects
    * @generated from field: string parent_name = 3;
    */
   parentName: string;
+
+  /**
+   * filter narrows the returned projects. Labels are the project
+   * namespace's labels.
+   *
+   * @generated from field: holos.console.v1.ListFilter filter = 4;
+   */
+  filter?: ListFilter;
+
+  /**
+   * order_by sorts the returned projects.
+   *
+   * @generated from field: holos.console.v1.ListOrder order_by = 5;
+   */
+  orderBy?: ListOrder;
+
+  /**
+   * if_none_match is the etag of a previous response. When the listing is
+   * unchanged the response carries only etag and not_modified. The
+   * If-None-Match header is ignored, since browsers send it on their own.
+   *
+   * @generated from field: string if_none_match = 6;
+   */
+  ifNoneMatch: string;
 };
 
 /**
@@ -165,6 +209,22 @@ export declare type ListProjectsResponse = Message<"holos.console.v1.ListProject
    * @generated from field: repeated holos.console.v1.Project projects = 1;
    */
   projects: Project[];
+
+  /**
+   * etag identifies this listing for if_none_match. It is derived from the
+   * caller, the request, and the projects' resource versions, and changes at
+   * least once a minute so access granted elsewhere is picked up.
+   *
+   * @generated from field: string etag = 2;
+   */
+  etag: string;
+
+  /**
+   * not_modified is true when if_none_match matched and projects is omitted.
+   *
+   * @generated from field: bool not_modified = 3;
+   */
+  notModified: boolean;
 };
 
 /**
@@ -185,6 +245,15 @@ export declare type GetProjectRequest = Message<"holos.console.v1.GetProjectRequ
    * @generated from field: string name = 1;
    */
   name: string;
+
+  /**
+   * if_none_match is the etag of a previous response. When the project is
+   * unchanged the response carries only etag and not_modified. The
+   * If-None-Match header is ignored, since browsers send it on their own.
+   *
+   * @generated from field: string if_none_match = 2;
+   */
+  ifNoneMatch: string;
 };
 
 /**
@@ -205,6 +274,21 @@ export declare type GetProjectResponse = Message<"holos.console.v1.GetProjectRes
    * @generated from field: holos.console.v1.Project project = 1;
    */
   project?: Project;
+
+  /**
+   * etag identifies this version of the response for if_none_match. It is
+   * derived like ListProjectsResponse.etag.
+   *
+   * @generated from field: string etag = 2;
+   */
+  etag: string;
+
+  /**
+   * not_modified is true when if_none_match matched and project is omitted.
+   *
+   * @generated from field: bool not_modified = 3;
+   */
+  notModified: boolean;
 };
 
 /**
@@ -276,6 +360,14 @@ export declare type CreateProjectRequest = Message<"holos.console.v1.CreateProje
    * @generated from field: string parent_name = 8;
    */
   parentName: string;
+
+  /**
+   * dry_run runs validation and authorization, and submits every Kubernetes
+   * write with server-side dry-run, without persisting any change.
+   *
+   * @generated from field: bool dry_run = 9;
+   */
+  dryRun: boolean;
 };
 
 /**
@@ -347,6 +439,14 @@ export declare type UpdateProjectRequest = Message<"holos.console.v1.UpdateProje
    * @generated from field: optional string parent_name = 5;
    */
   parentName?: string;
+
+  /**
+   * dry_run runs validation and authorization, and submits every Kubernetes
+   * write with server-side dry-run, without persisting any change.
+   *
+   * @generated from field: bool dry_run = 6;
+   */
+  dryRun: boolean;
 };
 
 /**
@@ -369,6 +469,77 @@ export declare type UpdateProjectResponse = Message<"holos.console.v1.UpdateProj
  */
 export declare const UpdateProjectResponseSchema: GenMessage<UpdateProjectResponse>;
 
+/**
+ * DiffProjectRequest carries the fields of an UpdateProjectRequest to
+ * compare with the project. Unset fields are unchanged, as in UpdateProject.
+ *
+ * @generated from message holos.console.v1.DiffProjectRequest
+ */
+export declare type DiffProjectRequest = Message<"holos.console.v1.DiffProjectRequest"> & {
+  /**
+   * name is the name of the project to compare.
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * display_name is the proposed display name.
+   *
+   * @generated from field: optional string display_name = 2;
+   */
+  displayName?: string;
+
+  /**
+   * description is the proposed description.
+   *
+   * @generated from field: optional string description = 3;
+   */
+  description?: string;
+
+  /**
+   * parent_type is the proposed parent type. Must be set together with
+   * parent_name.
+   *
+   * @generated from field: optional holos.console.v1.ParentType parent_type = 4;
+   */
+  parentType?: ParentType;
+
+  /**
+   * parent_name is the proposed parent name.
+   *
+   * @generated from field: optional string parent_name = 5;
+   */
+  parentName?: string;
+};
+
+/**
+ * Describes the message holos.console.v1.DiffProjectRequest.
+ * Use `create(DiffProjectRequestSchema)` to create a new message.
+ */
+export declare const DiffProjectRequestSchema: GenMessage<DiffProjectRequest>;
+
+/**
+ * DiffProjectResponse lists the changes a proposed update would make.
+ *
+ * @generated from message holos.console.v1.DiffProjectResponse
+ */
+export declare type DiffProjectResponse = Message<"holos.console.v1.DiffProjectResponse"> & {
+  /**
+   * changes lists the changed fields in request field order. It is empty
+   * when the update would not change the project.
+   *
+   * @generated from field: repeated holos.console.v1.FieldChange changes = 1;
+   */
+  changes: FieldChange[];
+};
+
+/**
+ * Describes the message holos.console.v1.DiffProjectResponse.
+ * Use `create(DiffProjectResponseSchema)` to create a new message.
+ */
+export declare const DiffProjectResponseSchema: GenMessage<DiffProjectResponse>;
+
 /**
  * DeleteProjectRequest contains the name of the project to delete.
  *
@@ -381,6 +552,14 @@ export declare type DeleteProjectRequest = Message<"holos.console.v1.DeleteProje
    * @generated from field: string name = 1;
    */
   name: string;
+
+  /**
+   * dry_run runs validation and authorization, and submits every Kubernetes
+   * write with server-side dry-run, without persisting any change.
+   *
+   * @generated from field: bool dry_run = 2;
+   */
+  dryRun: boolean;
 };
 
 /**
@@ -429,6 +608,22 @@ export declare type UpdateProjectSharingRequest = Message<"holos.console.v1.Upda
    * @generated from field: repeated holos.console.v1.ShareGrant role_grants = 3;
    */
   roleGrants: ShareGrant[];
+
+  /**
+   * dry_run runs validation and authorization, and submits every Kubernetes
+   * write with server-side dry-run, without persisting any change.
+   *
+   * @generated from field: bool dry_run = 4;
+   */
+  dryRun: boolean;
+
+  /**
+   * force accepts grants that leave the project without an active owner.
+   * Only platform owners may set it.
+   *
+   * @generated from field: bool force = 5;
+   */
+  force: boolean;
 };
 
 /**
@@ -458,7 +653,7 @@ export declare type UpdateProjectSharingResponse = Message<"holos.console.v1.Upd
 export declare const UpdateProjectSharingResponseSchema: GenMessage<UpdateProjectSharingResponse>;
 
 /**
- * GetProjectRawRequest contains the name of the project to retrieve as raw JSON.
+ * GetProjectRawRequest contains the name of the project to retrieve as a raw manifest.
  *
  * @generated from message holos.console.v1.GetProjectRawRequest
  */
@@ -469,6 +664,23 @@ export declare type GetProjectRawRequest = Message<"holos.console.v1.GetProjectR
    * @generated from field: string name = 1;
    */
   name: string;
+
+  /**
+   * format selects JSON (the default) or YAML output.
+   *
+   * @generated from field: holos.console.v1.RawFormat format = 2;
+   */
+  format: RawFormat;
+
+  /**
+   * strip_managed_fields omits the server-populated fields (uid,
+   * resourceVersion, generation, creationTimestamp, managedFields,
+   * ownerReferences, and status) so the manifest can be applied to another
+   * cluster with kubectl apply.
+   *
+   * @generated from field: bool strip_managed_fields = 3;
+   */
+  stripManagedFields: boolean;
 };
 
 /**
@@ -478,13 +690,14 @@ export declare type GetProjectRawRequest = Message<"holos.console.v1.GetProjectR
 export declare const GetProjectRawRequestSchema: GenMessage<GetProjectRawRequest>;
 
 /**
- * GetProjectRawResponse contains the full Kubernetes Namespace object as JSON.
+ * GetProjectRawResponse contains the full Kubernetes Namespace object as JSON or YAML.
  *
  * @generated from message holos.console.v1.GetProjectRawResponse
  */
 export declare type GetProjectRawResponse = Message<"holos.console.v1.GetProjectRawResponse"> & {
   /**
-   * raw is the verbatim JSON-serialized Namespace object from the K8s API.
+   * raw is the Namespace object from the K8s API, serialized in the
+   * requested format.
    *
    * @generated from field: string raw = 1;
    */
@@ -599,6 +812,464 @@ export declare type CheckProjectIdentifierResponse = Message<"holos.console.v1.C
  */
 export declare const CheckProjectIdentifierResponseSchema: GenMessage<CheckProjectIdentifierResponse>;
 
+/**
+ * ListProjectResourcesRequest names the project whose workloads to list.
+ *
+ * @generated from message holos.console.v1.ListProjectResourcesRequest
+ */
+export declare type ListProjectResourcesRequest = Message<"holos.console.v1.ListProjectResourcesRequest"> & {
+  /**
+   * project is the name of the project to list workloads in.
+   *
+   * @generated from field: string project = 1;
+   */
+  project: string;
+};
+
+/**
+ * Describes the message holos.console.v1.ListProjectResourcesRequest.
+ * Use `create(ListProjectResourcesRequestSchema)` to create a new message.
+ */
+export declare const ListProjectResourcesRequestSchema: GenMessage<ListProjectResourcesRequest>;
+
+/**
+ * ProjectResource summarizes one workload object in a project namespace.
+ *
+ * @generated from message holos.console.v1.ProjectResource
+ */
+export declare type ProjectResource = Message<"holos.console.v1.ProjectResource"> & {
+  /**
+   * kind is the Kubernetes kind: Deployment, StatefulSet, Service, or Ingress.
+   *
+   * @generated from field: string kind = 1;
+   */
+  kind: string;
+
+  /**
+   * name is the object name.
+   *
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * ready is true when the object has reached its desired state: all
+   * replicas ready for Deployments and StatefulSets, an address assigned for
+   * LoadBalancer Services and Ingresses. Other Services are always ready.
+   *
+   * @generated from field: bool ready = 3;
+   */
+  ready: boolean;
+
+  /**
+   * status is a short human-readable status, e.g. "2/3 ready" or
+   * "ClusterIP 10.96.0.10".
+   *
+   * @generated from field: string status = 4;
+   */
+  status: string;
+
+  /**
+   * created_at is the RFC3339-formatted creation timestamp.
+   *
+   * @generated from field: string created_at = 5;
+   */
+  createdAt: string;
+};
+
+/**
+ * Describes the message holos.console.v1.ProjectResource.
+ * Use `create(ProjectResourceSchema)` to create a new message.
+ */
+export declare const ProjectResourceSchema: GenMessage<ProjectResource>;
+
+/**
+ * ListProjectResourcesResponse lists the project's workloads ordered by kind,
+ * then name.
+ *
+ * @generated from message holos.console.v1.ListProjectResourcesResponse
+ */
+export declare type ListProjectResourcesResponse = Message<"holos.console.v1.ListProjectResourcesResponse"> & {
+  /**
+   * resources are the workloads in the project namespace.
+   *
+   * @generated from field: repeated holos.console.v1.ProjectResource resources = 1;
+   */
+  resources: ProjectResource[];
+};
+
+/**
+ * Describes the message holos.console.v1.ListProjectResourcesResponse.
+ * Use `create(ListProjectResourcesResponseSchema)` to create a new message.
+ */
+export declare const ListProjectResourcesResponseSchema: GenMessage<ListProjectResourcesResponse>;
+
+/**
+ * ListProjectEventsRequest selects and pages through a project's events.
+ * Unset filters match every event.
+ *
+ * @generated from message holos.console.v1.ListProjectEventsRequest
+ */
+export declare type ListProjectEventsRequest = Message<"holos.console.v1.ListProjectEventsRequest"> & {
+  /**
+   * project is the name of the project to list events in.
+   *
+   * @generated from field: string project = 1;
+   */
+  project: string;
+
+  /**
+   * types restricts results to these event types, e.g. "Warning".
+   *
+   * @generated from field: repeated string types = 2;
+   */
+  types: string[];
+
+  /**
+   * involved_kind restricts results to events about objects of this kind,
+   * e.g. "Deployment" or "Pod".
+   *
+   * @generated from field: string involved_kind = 3;
+   */
+  involvedKind: string;
+
+  /**
+   * involved_name restricts results to events about the object with this
+   * name.
+   *
+   * @generated from field: string involved_name = 4;
+   */
+  involvedName: string;
+
+  /**
+   * page_size caps the number of events returned. Zero selects the server
+   * default of 50.
+   *
+   * @generated from field: int32 page_size = 5;
+   */
+  pageSize: number;
+
+  /**
+   * page_token is the next_page_token of a previous response.
+   *
+   * @generated from field: string page_token = 6;
+   */
+  pageToken: string;
+};
+
+/**
+ * Describes the message holos.console.v1.ListProjectEventsRequest.
+ * Use `create(ListProjectEventsRequestSchema)` to create a new message.
+ */
+export declare const ListProjectEventsRequestSchema: GenMessage<ListProjectEventsRequest>;
+
+/**
+ * ProjectEvent is a Kubernetes Event recorded in a project namespace.
+ *
+ * @generated from message holos.console.v1.ProjectEvent
+ */
+export declare type ProjectEvent = Message<"holos.console.v1.ProjectEvent"> & {
+  /**
+   * type is the event type, "Normal" or "Warning".
+   *
+   * @generated from field: string type = 1;
+   */
+  type: string;
+
+  /**
+   * reason is the short machine-readable reason, e.g. "BackOff".
+   *
+   * @generated from field: string reason = 2;
+   */
+  reason: string;
+
+  /**
+   * message is the human-readable description.
+   *
+   * @generated from field: string message = 3;
+   */
+  message: string;
+
+  /**
+   * involved_kind is the kind of the object the event is about.
+   *
+   * @generated from field: string involved_kind = 4;
+   */
+  involvedKind: string;
+
+  /**
+   * involved_name is the name of the object the event is about.
+   *
+   * @generated from field: string involved_name = 5;
+   */
+  involvedName: string;
+
+  /**
+   * count is how many times the event has occurred.
+   *
+   * @generated from field: int32 count = 6;
+   */
+  count: number;
+
+  /**
+   * source is the component that reported the event.
+   *
+   * @generated from field: string source = 7;
+   */
+  source: string;
+
+  /**
+   * first_seen is the RFC3339-formatted time the event first occurred.
+   *
+   * @generated from field: string first_seen = 8;
+   */
+  firstSeen: string;
+
+  /**
+   * last_seen is the RFC3339-formatted time the event most recently occurred.
+   *
+   * @generated from field: string last_seen = 9;
+   */
+  lastSeen: string;
+};
+
+/**
+ * Describes the message holos.console.v1.ProjectEvent.
+ * Use `create(ProjectEventSchema)` to create a new message.
+ */
+export declare const ProjectEventSchema: GenMessage<ProjectEvent>;
+
+/**
+ * ListProjectEventsResponse contains one page of matching events, most
+ * recent first.
+ *
+ * @generated from message holos.console.v1.ListProjectEventsResponse
+ */
+export declare type ListProjectEventsResponse = Message<"holos.console.v1.ListProjectEventsResponse"> & {
+  /**
+   * events contains the matching events.
+   *
+   * @generated from field: repeated holos.console.v1.ProjectEvent events = 1;
+   */
+  events: ProjectEvent[];
+
+  /**
+   * next_page_token fetches the following page. Empty on the last page.
+   *
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken: string;
+};
+
+/**
+ * Describes the message holos.console.v1.ListProjectEventsResponse.
+ * Use `create(ListProjectEventsResponseSchema)` to create a new message.
+ */
+export declare const ListProjectEventsResponseSchema: GenMessage<ListProjectEventsResponse>;
+
+/**
+ * ListDeletedProjectsRequest filters the soft-deleted projects to list.
+ *
+ * @generated from message holos.console.v1.ListDeletedProjectsRequest
+ */
+export declare type ListDeletedProjectsRequest = Message<"holos.console.v1.ListDeletedProjectsRequest"> & {
+  /**
+   * organization limits the list to projects in this organization. Empty
+   * lists every organization.
+   *
+   * @generated from field: string organization = 1;
+   */
+  organization: string;
+};
+
+/**
+ * Describes the message holos.console.v1.ListDeletedProjectsRequest.
+ * Use `create(ListDeletedProjectsRequestSchema)` to create a new message.
+ */
+export declare const ListDeletedProjectsRequestSchema: GenMessage<ListDeletedProjectsRequest>;
+
+/**
+ * DeletedProject describes a soft-deleted project.
+ *
+ * @generated from message holos.console.v1.DeletedProject
+ */
+export declare type DeletedProject = Message<"holos.console.v1.DeletedProject"> & {
+  /**
+   * name is the name of the project.
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * display_name is the project's display name.
+   *
+   * @generated from field: string display_name = 2;
+   */
+  displayName: string;
+
+  /**
+   * organization is the organization the project belongs to.
+   *
+   * @generated from field: string organization = 3;
+   */
+  organization: string;
+
+  /**
+   * deleted_at is when the project was deleted, in RFC 3339 format.
+   *
+   * @generated from field: string deleted_at = 4;
+   */
+  deletedAt: string;
+
+  /**
+   * deleted_by is the email of the principal who deleted the project.
+   *
+   * @generated from field: string deleted_by = 5;
+   */
+  deletedBy: string;
+
+  /**
+   * purge_at is when the project will be permanently deleted, in RFC 3339
+   * format.
+   *
+   * @generated from field: string purge_at = 6;
+   */
+  purgeAt: string;
+};
+
+/**
+ * Describes the message holos.console.v1.DeletedProject.
+ * Use `create(DeletedProjectSchema)` to create a new message.
+ */
+export declare const DeletedProjectSchema: GenMessage<DeletedProject>;
+
+/**
+ * ListDeletedProjectsResponse lists soft-deleted projects, most recently
+ * deleted first.
+ *
+ * @generated from message holos.console.v1.ListDeletedProjectsResponse
+ */
+export declare type ListDeletedProjectsResponse = Message<"holos.console.v1.ListDeletedProjectsResponse"> & {
+  /**
+   * @generated from field: repeated holos.console.v1.DeletedProject projects = 1;
+   */
+  projects: DeletedProject[];
+};
+
+/**
+ * Describes the message holos.console.v1.ListDeletedProjectsResponse.
+ * Use `create(ListDeletedProjectsResponseSchema)` to create a new message.
+ */
+export declare const ListDeletedProjectsResponseSchema: GenMessage<ListDeletedProjectsResponse>;
+
+/**
+ * RestoreProjectRequest identifies the soft-deleted project to restore.
+ *
+ * @generated from message holos.console.v1.RestoreProjectRequest
+ */
+export declare type RestoreProjectRequest = Message<"holos.console.v1.RestoreProjectRequest"> & {
+  /**
+   * name is the name of the project to restore.
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message holos.console.v1.RestoreProjectRequest.
+ * Use `create(RestoreProjectRequestSchema)` to create a new message.
+ */
+export declare const RestoreProjectRequestSchema: GenMessage<RestoreProjectRequest>;
+
+/**
+ * RestoreProjectResponse is empty on success.
+ *
+ * @generated from message holos.console.v1.RestoreProjectResponse
+ */
+export declare type RestoreProjectResponse = Message<"holos.console.v1.RestoreProjectResponse"> & {
+};
+
+/**
+ * Describes the message holos.console.v1.RestoreProjectResponse.
+ * Use `create(RestoreProjectResponseSchema)` to create a new message.
+ */
+export declare const RestoreProjectResponseSchema: GenMessage<RestoreProjectResponse>;
+
+/**
+ * CreateProjectTokenRequest selects the project and the token's lifetime and
+ * audience.
+ *
+ * @generated from message holos.console.v1.CreateProjectTokenRequest
+ */
+export declare type CreateProjectTokenRequest = Message<"holos.console.v1.CreateProjectTokenRequest"> & {
+  /**
+   * project is the name of the project to mint a token for.
+   *
+   * @generated from field: string project = 1;
+   */
+  project: string;
+
+  /**
+   * ttl_seconds is how long the token is valid. Zero selects the server
+   * default of one hour; the server accepts 10 minutes to 24 hours.
+   *
+   * @generated from field: int64 ttl_seconds = 2;
+   */
+  ttlSeconds: bigint;
+
+  /**
+   * audience is the intended audience of the token. Empty selects the API
+   * server's default audiences.
+   *
+   * @generated from field: string audience = 3;
+   */
+  audience: string;
+};
+
+/**
+ * Describes the message holos.console.v1.CreateProjectTokenRequest.
+ * Use `create(CreateProjectTokenRequestSchema)` to create a new message.
+ */
+export declare const CreateProjectTokenRequestSchema: GenMessage<CreateProjectTokenRequest>;
+
+/**
+ * CreateProjectTokenResponse carries the minted token. The token is not
+ * stored by the console and cannot be retrieved again.
+ *
+ * @generated from message holos.console.v1.CreateProjectTokenResponse
+ */
+export declare type CreateProjectTokenResponse = Message<"holos.console.v1.CreateProjectTokenResponse"> & {
+  /**
+   * token is the bearer token.
+   *
+   * @generated from field: string token = 1;
+   */
+  token: string;
+
+  /**
+   * service_account is the ServiceAccount the token authenticates as, in
+   * the form system:serviceaccount:<namespace>:<name>.
+   *
+   * @generated from field: string service_account = 2;
+   */
+  serviceAccount: string;
+
+  /**
+   * expires_at is when the token expires, in RFC 3339 format.
+   *
+   * @generated from field: string expires_at = 3;
+   */
+  expiresAt: string;
+};
+
+/**
+ * Describes the message holos.console.v1.CreateProjectTokenResponse.
+ * Use `create(CreateProjectTokenResponseSchema)` to create a new message.
+ */
+export declare const CreateProjectTokenResponseSchema: GenMessage<CreateProjectTokenResponse>;
+
 /**
  * ProjectService provides CRUD operations for projects.
  * A project is a Kubernetes Namespace labeled app.kubernetes.io/managed-by=console.holos.run.
@@ -648,9 +1319,23 @@ export declare const ProjectService: GenService<{
     input: typeof UpdateProjectRequestSchema;
     output: typeof UpdateProjectResponseSchema;
   },
+  /**
+   * DiffProject previews an UpdateProject without persisting it, reporting
+   * the metadata fields the update would change.
+   * Requires read access to the project.
+   *
+   * @generated from rpc holos.console.v1.ProjectService.DiffProject
+   */
+  diffProject: {
+    methodKind: "unary";
+    input: typeof DiffProjectRequestSchema;
+    output: typeof DiffProjectResponseSchema;
+  },
   /**
    * DeleteProject deletes a managed namespace.
-   * Requires PERMISSION_PROJECTS_DELETE on the project.
+   * Requires PERMISSION_PROJECTS_DELETE on the project. When the console runs
+   * with a trash retention window the project is soft-deleted: it is hidden
+   * and its grants are stripped until RestoreProject or the window passes.
    *
    * @generated from rpc holos.console.v1.ProjectService.DeleteProject
    */
@@ -662,6 +1347,8 @@ export declare const ProjectService: GenService<{
   /**
    * UpdateProjectSharing updates the sharing grants on a project.
    * Requires PERMISSION_PROJECTS_ADMIN on the project.
+   * A grant set without an active owner fails with FailedPrecondition unless
+   * a platform owner sets force.
    *
    * @generated from rpc holos.console.v1.ProjectService.UpdateProjectSharing
    */
@@ -671,9 +1358,10 @@ export declare const ProjectService: GenService<{
     output: typeof UpdateProjectSharingResponseSchema;
   },
   /**
-   * GetProjectRaw retrieves the full Kubernetes Namespace object as verbatim JSON.
-   * The backend returns the Namespace exactly as the K8s API provides it, with no
-   * field filtering. Requires authentication and PERMISSION_PROJECTS_READ.
+   * GetProjectRaw retrieves the full Kubernetes Namespace object as JSON or YAML.
+   * The backend returns the Namespace exactly as the K8s API provides it unless
+   * strip_managed_fields is set. Requires authentication and
+   * PERMISSION_PROJECTS_READ.
    *
    * @generated from rpc holos.console.v1.ProjectService.GetProjectRaw
    */
@@ -707,5 +1395,66 @@ export declare const ProjectService: GenService<{
     input: typeof CheckProjectIdentifierRequestSchema;
     output: typeof CheckProjectIdentifierResponseSchema;
   },
+  /**
+   * ListProjectResources summarizes the workloads (Deployments, StatefulSets,
+   * Services, and Ingresses) running in the project namespace.
+   * Requires PERMISSION_RESOURCES_READ on the project. Kinds the caller may
+   * not list are omitted from the response.
+   *
+   * @generated from rpc holos.console.v1.ProjectService.ListProjectResources
+   */
+  listProjectResources: {
+    methodKind: "unary";
+    input: typeof ListProjectResourcesRequestSchema;
+    output: typeof ListProjectResourcesResponseSchema;
+  },
+  /**
+   * ListProjectEvents returns recent Kubernetes Events in the project
+   * namespace, most recent first, so users can see why a workload is failing.
+   * Requires PERMISSION_PROJECTS_READ on the project.
+   *
+   * @generated from rpc holos.console.v1.ProjectService.ListProjectEvents
+   */
+  listProjectEvents: {
+    methodKind: "unary";
+    input: typeof ListProjectEventsRequestSchema;
+    output: typeof ListProjectEventsResponseSchema;
+  },
+  /**
+   * ListDeletedProjects lists the soft-deleted projects the caller owned
+   * that are still within the trash retention window.
+   *
+   * @generated from rpc holos.console.v1.ProjectService.ListDeletedProjects
+   */
+  listDeletedProjects: {
+    methodKind: "unary";
+    input: typeof ListDeletedProjectsRequestSchema;
+    output: typeof ListDeletedProjectsResponseSchema;
+  },
+  /**
+   * RestoreProject undoes a soft delete, restoring the project namespace and
+   * its grants. Requires the caller to have owned the project when it was
+   * deleted.
+   *
+   * @generated from rpc holos.console.v1.ProjectService.RestoreProject
+   */
+  restoreProject: {
+    methodKind: "unary";
+    input: typeof RestoreProjectRequestSchema;
+    output: typeof RestoreProjectResponseSchema;
+  },
+  /**
+   * CreateProjectToken mints a short-lived token for the project's
+   * ServiceAccount, which may edit resources in the project namespace, so
+   * CI systems can act on the project without a user's credentials.
+   * Requires PERMISSION_PROJECTS_ADMIN on the project.
+   *
+   * @generated from rpc holos.console.v1.ProjectService.CreateProjectToken
+   */
+  createProjectToken: {
+    methodKind: "unary";
+    input: typeof CreateProjectTokenRequestSchema;
+    output: typeof CreateProjectTokenResponseSchema;
+  },
 }>;
 
@@ -3,7 +3,11 @@
 /* eslint-disable */
 
 import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import { file_buf_validate_validate } from "../../../buf/validate/validate_pb";
+import { file_holos_console_v1_diff } from "./diff_pb";
 import { file_holos_console_v1_folders } from "./folders_pb";
+import { file_holos_console_v1_list_filter } from "./list_filter_pb";
+import { file_holos_console_v1_raw_format } from "./raw_format_pb";
 import { file_holos_console_v1_rbac } from "./rbac_pb";
 import { file_holos_console_v1_secrets } from "./secrets_pb";
 
@@ -11,7 +15,7 @@ import { file_holos_console_v1_secrets } from "./secrets_pb";
  * Describes the file holos/console/v1/projects.proto.
  */
 export const file_holos_console_v1_projects = /*@__PURE__*/
-  fileDesc("Ch9ob2xvcy9jb25zb2xlL3YxL3Byb2plY3RzLnByb3RvEhBob2xvcy5jb25zb2xlLnYxItIDCgdQcm9qZWN0EgwKBG5hbWUYASABKAkSFAoMZGlzcGxheV9uYW1lGAIgASgJEhMKC2Rlc2NyaXB0aW9uGAMgASgJEjEKC3VzZXJfZ3JhbnRzGAQgAygLMhwuaG9sb3MuY29uc29sZS52MS5TaGFyZUdyYW50EjEKC3JvbGVfZ3JhbnRzGAUgAygLMhwuaG9sb3MuY29uc29sZS52MS5TaGFyZUdyYW50EikKCXVzZXJfcm9sZRgGIAEoDjIWLmhvbG9zLmNvbnNvbGUudjEuUm9sZRIUCgxvcmdhbml6YXRpb24YByABKAkSOQoTZGVmYXVsdF91c2VyX2dyYW50cxgIIAMoCzIcLmhvbG9zLmNvbnNvbGUudjEuU2hhcmVHcmFudBI5ChNkZWZhdWx0X3JvbGVfZ3JhbnRzGAkgAygLMhwuaG9sb3MuY29uc29sZS52MS5TaGFyZUdyYW50EhUKDWNyZWF0b3JfZW1haWwYCiABKAkSEgoKY3JlYXRlZF9hdBgLIAEoCRIxCgtwYXJlbnRfdHlwZRgMIAEoDjIcLmhvbG9zLmNvbnNvbGUudjEuUGFyZW50VHlwZRITCgtwYXJlbnRfbmFtZRgNIAEoCSJzChNMaXN0UHJvamVjdHNSZXF1ZXN0EhQKDG9yZ2FuaXphdGlvbhgBIAEoCRIxCgtwYXJlbnRfdHlwZRgCIAEoDjIcLmhvbG9zLmNvbnNvbGUudjEuUGFyZW50VHlwZRITCgtwYXJlbnRfbmFtZRgDIAEoCSJDChRMaXN0UHJvamVjdHNSZXNwb25zZRIrCghwcm9qZWN0cxgBIAMoCzIZLmhvbG9zLmNvbnNvbGUudjEuUHJvamVjdCIhChFHZXRQcm9qZWN0UmVxdWVzdBIMCgRuYW1lGAEgASgJIkAKEkdldFByb2plY3RSZXNwb25zZRIqCgdwcm9qZWN0GAEgASgLMhkuaG9sb3MuY29uc29sZS52MS5Qcm9qZWN0IpMCChRDcmVhdGVQcm9qZWN0UmVxdWVzdBIMCgRuYW1lGAEgASgJEhQKDGRpc3BsYXlfbmFtZRgCIAEoCRITCgtkZXNjcmlwdGlvbhgDIAEoCRIxCgt1c2VyX2dyYW50cxgEIAMoCzIcLmhvbG9zLmNvbnNvbGUudjEuU2hhcmVHcmFudBIxCgtyb2xlX2dyYW50cxgFIAMoCzIcLmhvbG9zLmNvbnNvbGUudjEuU2hhcmVHcmFudBIUCgxvcmdhbml6YXRpb24YBiABKAkSMQoLcGFyZW50X3R5cGUYByABKA4yHC5ob2xvcy5jb25zb2xlLnYxLlBhcmVudFR5cGUSEwoLcGFyZW50X25hbWUYCCABKAkiJQoVQ3JlYXRlUHJvamVjdFJlc3BvbnNlEgwKBG5hbWUYASABKAki7AEKFFVwZGF0ZVByb2plY3RSZXF1ZXN0EgwKBG5hbWUYASABKAkSGQoMZGlzcGxheV9uYW1lGAIgASgJSACIAQESGAoLZGVzY3JpcHRpb24YAyABKAlIAYgBARI2CgtwYXJlbnRfdHlwZRgEIAEoDjIcLmhvbG9zLmNvbnNvbGUudjEuUGFyZW50VHlwZUgCiAEBEhgKC3BhcmVudF9uYW1lGAUgASgJSAOIAQFCDwoNX2Rpc3BsYXlfbmFtZUIOCgxfZGVzY3JpcHRpb25CDgoMX3BhcmVudF90eXBlQg4KDF9wYXJlbnRfbmFtZSIXChVVcGRhdGVQcm9qZWN0UmVzcG9uc2UiJAoURGVsZXRlUHJvamVjdFJlcXVlc3QSDAoEbmFtZRgBIAEoCSIXChVEZWxldGVQcm9qZWN0UmVzcG9uc2UikQEKG1VwZGF0ZVByb2plY3RTaGFyaW5nUmVxdWVzdBIMCgRuYW1lGAEgASgJEjEKC3VzZXJfZ3JhbnRzGAIgAygLMhwuaG9sb3MuY29uc29sZS52MS5TaGFyZUdyYW50EjEKC3JvbGVfZ3JhbnRzGAMgAygLMhwuaG9sb3MuY29uc29sZS52MS5TaGFyZUdyYW50IkoKHFVwZGF0ZVByb2plY3RTaGFyaW5nUmVzcG9uc2USKgoHcHJvamVjdBgBIAEoCzIZLmhvbG9zLmNvbnNvbGUudjEuUHJvamVjdCIkChRHZXRQcm9qZWN0UmF3UmVxdWVzdBIMCgRuYW1lGAEgASgJIiQKFUdldFByb2plY3RSYXdSZXNwb25zZRILCgNyYXcYASABKAkiqAEKIlVwZGF0ZVByb2plY3REZWZhdWx0U2hhcmluZ1JlcXVlc3QSDAoEbmFtZRgBIAEoCRI5ChNkZWZhdWx0X3VzZXJfZ3JhbnRzGAIgAygLMhwuaG9sb3MuY29uc29sZS52MS5TaGFyZUdyYW50EjkKE2RlZmF1bHRfcm9sZV9ncmFudHMYAyADKAsyHC5ob2xvcy5jb25zb2xlLnYxLlNoYXJlR3JhbnQiUQojVXBkYXRlUHJvamVjdERlZmF1bHRTaGFyaW5nUmVzcG9uc2USKgoHcHJvamVjdBgBIAEoCzIZLmhvbG9zLmNvbnNvbGUudjEuUHJvamVjdCIzCh1DaGVja1Byb2plY3RJZGVudGlmaWVyUmVxdWVzdBISCgppZGVudGlmaWVyGAEgASgJIlEKHkNoZWNrUHJvamVjdElkZW50aWZpZXJSZXNwb25zZRIRCglhdmFpbGFibGUYASABKAgSHAoUc3VnZ2VzdGVkX2lkZW50aWZpZXIYAiABKAky0QcKDlByb2plY3RTZXJ2aWNlEl0KDExpc3RQcm9qZWN0cxIlLmhvbG9zLmNvbnNvbGUudjEuTGlzdFByb2plY3RzUmVxdWVzdBomLmhvbG9zLmNvbnNvbGUudjEuTGlzdFByb2plY3RzUmVzcG9uc2USVwoKR2V0UHJvamVjdBIjLmhvbG9zLmNvbnNvbGUudjEuR2V0UHJvamVjdFJlcXVlc3QaJC5ob2xvcy5jb25zb2xlLnYxLkdldFByb2plY3RSZXNwb25zZRJgCg1DcmVhdGVQcm9qZWN0EiYuaG9sb3MuY29uc29sZS52MS5DcmVhdGVQcm9qZWN0UmVxdWVzdBonLmhvbG9zLmNvbnNvbGUudjEuQ3JlYXRlUHJvamVjdFJlc3BvbnNlEmAKDVVwZGF0ZVByb2plY3QSJi5ob2xvcy5jb25zb2xlLnYxLlVwZGF0ZVByb2plY3RSZXF1ZXN0GicuaG9sb3MuY29uc29sZS52MS5VcGRhdGVQcm9qZWN0UmVzcG9uc2USYAoNRGVsZXRlUHJvamVjdBImLmhvbG9zLmNvbnNvbGUudjEuRGVsZXRlUHJvamVjdFJlcXVlc3QaJy5ob2xvcy5jb25zb2xlLnYxLkRlbGV0ZVByb2plY3RSZXNwb25zZRJ1ChRVcGRhdGVQcm9qZWN0U2hhcmluZxItLmhvbG9zLmNvbnNvbGUudjEuVXBkYXRlUHJvamVjdFNoYXJpbmdSZXF1ZXN0Gi4uaG9sb3MuY29uc29sZS52MS5VcGRhdGVQcm9qZWN0U2hhcmluZ1Jlc3BvbnNlEmAKDUdldFByb2plY3RSYXcSJi5ob2xvcy5jb25zb2xlLnYxLkdldFByb2plY3RSYXdSZXF1ZXN0GicuaG9sb3MuY29uc29sZS52MS5HZXRQcm9qZWN0UmF3UmVzcG9uc2USigEKG1VwZGF0ZVByb2plY3REZWZhdWx0U2hhcmluZxI0LmhvbG9zLmNvbnNvbGUudjEuVXBkYXRlUHJvamVjdERlZmF1bHRTaGFyaW5nUmVxdWVzdBo1LmhvbG9zLmNvbnNvbGUudjEuVXBkYXRlUHJvamVjdERlZmF1bHRTaGFyaW5nUmVzcG9uc2USewoWQ2hlY2tQcm9qZWN0SWRlbnRpZmllchIvLmhvbG9zLmNvbnNvbGUudjEuQ2hlY2tQcm9qZWN0SWRlbnRpZmllclJlcXVlc3QaMC5ob2xvcy5jb25zb2xlLnYxLkNoZWNrUHJvamVjdElkZW50aWZpZXJSZXNwb25zZUJDWkFnaXRodWIuY29tL2hvbG9zLXJ1bi9ob2xvcy1jb25zb2xlL2dlbi9ob2xvcy9jb25zb2xlL3YxO2NvbnNvbGV2MWIGcHJvdG8z", [file_holos_console_v1_folders, file_holos_console_v1_rbac, file_holos_console_v1_secrets]);
+  fileDesc("Ch9ob2xvcy9jb25zb2xlL3YxL3Byb2plY3RzLnByb3RvEhBob2xvcy5jb25zb2xlLnYxIp4ECgdQcm9qZWN0EgwKBG5hbWUYASABKAkSFAoMZGlzcGxheV9uYW1lGAIgASgJEhMKC2Rlc2NyaXB0aW9uGAMgASgJEjEKC3VzZXJfZ3JhbnRzGAQgAygLMhwuaG9sb3MuY29uc29sZS52MS5TaGFyZUdyYW50EjEKC3JvbGVfZ3JhbnRzGAUgAygLMhwuaG9sb3MuY29uc29sZS52MS5TaGFyZUdyYW50EikKCXVzZXJfcm9sZRgGIAEoDjIWLmhvbG9zLmNvbnNvbGUudjEuUm9sZRIUCgxvcmdhbml6YXRpb24YByABKAkSOQoTZGVmYXVsdF91c2VyX2dyYW50cxgIIAMoCzIcLmhvbG9zLmNvbnNvbGUudjEuU2hhcmVHcmFudBI5ChNkZWZhdWx0X3JvbGVfZ3JhbnRzGAkgAygLMhwuaG9sb3MuY29uc29sZS52MS5TaGFyZUdyYW50EhUKDWNyZWF0b3JfZW1haWwYCiABKAkSEgoKY3JlYXRlZF9hdBgLIAEoCRIxCgtwYXJlbnRfdHlwZRgMIAEoDjIcLmhvbG9zLmNvbnNvbGUudjEuUGFyZW50VHlwZRITCgtwYXJlbnRfbmFtZRgNIAEoCRISCgp1cGRhdGVkX2F0GA4gASgJEjYKEHVzZXJfcm9sZV9zb3VyY2UYDyABKAsyHC5ob2xvcy5jb25zb2xlLnYxLlJvbGVTb3VyY2Ui5wEKE0xpc3RQcm9qZWN0c1JlcXVlc3QSFAoMb3JnYW5pemF0aW9uGAEgASgJEjEKC3BhcmVudF90eXBlGAIgASgOMhwuaG9sb3MuY29uc29sZS52MS5QYXJlbnRUeXBlEhMKC3BhcmVudF9uYW1lGAMgASgJEiwKBmZpbHRlchgEIAEoCzIcLmhvbG9zLmNvbnNvbGUudjEuTGlzdEZpbHRlchItCghvcmRlcl9ieRgFIAEoCzIbLmhvbG9zLmNvbnNvbGUudjEuTGlzdE9yZGVyEhUKDWlmX25vbmVfbWF0Y2gYBiABKAkiZwoUTGlzdFByb2plY3RzUmVzcG9uc2USKwoIcHJvamVjdHMYASADKAsyGS5ob2xvcy5jb25zb2xlLnYxLlByb2plY3QSDAoEZXRhZxgCIAEoCRIUCgxub3RfbW9kaWZpZWQYAyABKAgiQAoRR2V0UHJvamVjdFJlcXVlc3QSFAoEbmFtZRgBIAEoCUIGukgDyAEBEhUKDWlmX25vbmVfbWF0Y2gYAiABKAkiZAoSR2V0UHJvamVjdFJlc3BvbnNlEioKB3Byb2plY3QYASABKAsyGS5ob2xvcy5jb25zb2xlLnYxLlByb2plY3QSDAoEZXRhZxgCIAEoCRIUCgxub3RfbW9kaWZpZWQYAyABKAgi0AMKFENyZWF0ZVByb2plY3RSZXF1ZXN0EjMKBG5hbWUYASABKAlCJbpIItgBAXIdGD8yGV5bYS16XVthLXowLTktXSpbYS16MC05XSQSFAoMZGlzcGxheV9uYW1lGAIgASgJEh0KC2Rlc2NyaXB0aW9uGAMgASgJQgi6SAVyAxiAIBIxCgt1c2VyX2dyYW50cxgEIAMoCzIcLmhvbG9zLmNvbnNvbGUudjEuU2hhcmVHcmFudBIxCgtyb2xlX2dyYW50cxgFIAMoCzIcLmhvbG9zLmNvbnNvbGUudjEuU2hhcmVHcmFudBIcCgxvcmdhbml6YXRpb24YBiABKAlCBrpIA8gBARIxCgtwYXJlbnRfdHlwZRgHIAEoDjIcLmhvbG9zLmNvbnNvbGUudjEuUGFyZW50VHlwZRITCgtwYXJlbnRfbmFtZRgIIAEoCRIPCgdkcnlfcnVuGAkgASgIOnG6SG4abAoUbmFtZV9vcl9kaXNwbGF5X25hbWUSKHByb2plY3QgbmFtZSBvciBkaXNwbGF5X25hbWUgaXMgcmVxdWlyZWQaKnRoaXMubmFtZSAhPSAnJyB8fCB0aGlzLmRpc3BsYXlfbmFtZSAhPSAnJyIlChVDcmVhdGVQcm9qZWN0UmVzcG9uc2USDAoEbmFtZRgBIAEoCSKPAgoUVXBkYXRlUHJvamVjdFJlcXVlc3QSFAoEbmFtZRgBIAEoCUIGukgDyAEBEhkKDGRpc3BsYXlfbmFtZRgCIAEoCUgAiAEBEiIKC2Rlc2NyaXB0aW9uGAMgASgJQgi6SAVyAxiAIEgBiAEBEjYKC3BhcmVudF90eXBlGAQgASgOMhwuaG9sb3MuY29uc29sZS52MS5QYXJlbnRUeXBlSAKIAQESGAoLcGFyZW50X25hbWUYBSABKAlIA4gBARIPCgdkcnlfcnVuGAYgASgIQg8KDV9kaXNwbGF5X25hbWVCDgoMX2Rlc2NyaXB0aW9uQg4KDF9wYXJlbnRfdHlwZUIOCgxfcGFyZW50X25hbWUiFwoVVXBkYXRlUHJvamVjdFJlc3BvbnNlIvwBChJEaWZmUHJvamVjdFJlcXVlc3QSFAoEbmFtZRgBIAEoCUIGukgDyAEBEhkKDGRpc3BsYXlfbmFtZRgCIAEoCUgAiAEBEiIKC2Rlc2NyaXB0aW9uGAMgASgJQgi6SAVyAxiAIEgBiAEBEjYKC3BhcmVudF90eXBlGAQgASgOMhwuaG9sb3MuY29uc29sZS52MS5QYXJlbnRUeXBlSAKIAQESGAoLcGFyZW50X25hbWUYBSABKAlIA4gBAUIPCg1fZGlzcGxheV9uYW1lQg4KDF9kZXNjcmlwdGlvbkIOCgxfcGFyZW50X3R5cGVCDgoMX3BhcmVudF9uYW1lIkUKE0RpZmZQcm9qZWN0UmVzcG9uc2USLgoHY2hhbmdlcxgBIAMoCzIdLmhvbG9zLmNvbnNvbGUudjEuRmllbGRDaGFuZ2UiPQoURGVsZXRlUHJvamVjdFJlcXVlc3QSFAoEbmFtZRgBIAEoCUIGukgDyAEBEg8KB2RyeV9ydW4YAiABKAgiFwoVRGVsZXRlUHJvamVjdFJlc3BvbnNlIrkBChtVcGRhdGVQcm9qZWN0U2hhcmluZ1JlcXVlc3QSFAoEbmFtZRgBIAEoCUIGukgDyAEBEjEKC3VzZXJfZ3JhbnRzGAIgAygLMhwuaG9sb3MuY29uc29sZS52MS5TaGFyZUdyYW50EjEKC3JvbGVfZ3JhbnRzGAMgAygLMhwuaG9sb3MuY29uc29sZS52MS5TaGFyZUdyYW50Eg8KB2RyeV9ydW4YBCABKAgSDQoFZm9yY2UYBSABKAgiSgocVXBkYXRlUHJvamVjdFNoYXJpbmdSZXNwb25zZRIqCgdwcm9qZWN0GAEgASgLMhkuaG9sb3MuY29uc29sZS52MS5Qcm9qZWN0IncKFEdldFByb2plY3RSYXdSZXF1ZXN0EhQKBG5hbWUYASABKAlCBrpIA8gBARIrCgZmb3JtYXQYAiABKA4yGy5ob2xvcy5jb25zb2xlLnYxLlJhd0Zvcm1hdBIcChRzdHJpcF9tYW5hZ2VkX2ZpZWxkcxgDIAEoCCIkChVHZXRQcm9qZWN0UmF3UmVzcG9uc2USCwoDcmF3GAEgASgJIrABCiJVcGRhdGVQcm9qZWN0RGVmYXVsdFNoYXJpbmdSZXF1ZXN0EhQKBG5hbWUYASABKAlCBrpIA8gBARI5ChNkZWZhdWx0X3VzZXJfZ3JhbnRzGAIgAygLMhwuaG9sb3MuY29uc29sZS52MS5TaGFyZUdyYW50EjkKE2RlZmF1bHRfcm9sZV9ncmFudHMYAyADKAsyHC5ob2xvcy5jb25zb2xlLnYxLlNoYXJlR3JhbnQiUQojVXBkYXRlUHJvamVjdERlZmF1bHRTaGFyaW5nUmVzcG9uc2USKgoHcHJvamVjdBgBIAEoCzIZLmhvbG9zLmNvbnNvbGUudjEuUHJvamVjdCI7Ch1DaGVja1Byb2plY3RJZGVudGlmaWVyUmVxdWVzdBIaCgppZGVudGlmaWVyGAEgASgJQga6SAPIAQEiUQoeQ2hlY2tQcm9qZWN0SWRlbnRpZmllclJlc3BvbnNlEhEKCWF2YWlsYWJsZRgBIAEoCBIcChRzdWdnZXN0ZWRfaWRlbnRpZmllchgCIAEoCSI2ChtMaXN0UHJvamVjdFJlc291cmNlc1JlcXVlc3QSFwoHcHJvamVjdBgBIAEoCUIGukgDyAEBImAKD1Byb2plY3RSZXNvdXJjZRIMCgRraW5kGAEgASgJEgwKBG5hbWUYAiABKAkSDQoFcmVhZHkYAyABKAgSDgoGc3RhdHVzGAQgASgJEhIKCmNyZWF0ZWRfYXQYBSABKAkiVAocTGlzdFByb2plY3RSZXNvdXJjZXNSZXNwb25zZRI0CglyZXNvdXJjZXMYASADKAsyIS5ob2xvcy5jb25zb2xlLnYxLlByb2plY3RSZXNvdXJjZSKXAQoYTGlzdFByb2plY3RFdmVudHNSZXF1ZXN0EhcKB3Byb2plY3QYASABKAlCBrpIA8gBARINCgV0eXBlcxgCIAMoCRIVCg1pbnZvbHZlZF9raW5kGAMgASgJEhUKDWludm9sdmVkX25hbWUYBCABKAkSEQoJcGFnZV9zaXplGAUgASgFEhIKCnBhZ2VfdG9rZW4YBiABKAkisQEKDFByb2plY3RFdmVudBIMCgR0eXBlGAEgASgJEg4KBnJlYXNvbhgCIAEoCRIPCgdtZXNzYWdlGAMgASgJEhUKDWludm9sdmVkX2tpbmQYBCABKAkSFQoNaW52b2x2ZWRfbmFtZRgFIAEoCRINCgVjb3VudBgGIAEoBRIOCgZzb3VyY2UYByABKAkSEgoKZmlyc3Rfc2VlbhgIIAEoCRIRCglsYXN0X3NlZW4YCSABKAkiZAoZTGlzdFByb2plY3RFdmVudHNSZXNwb25zZRIuCgZldmVudHMYASADKAsyHi5ob2xvcy5jb25zb2xlLnYxLlByb2plY3RFdmVudBIXCg9uZXh0X3BhZ2VfdG9rZW4YAiABKAkiMgoaTGlzdERlbGV0ZWRQcm9qZWN0c1JlcXVlc3QSFAoMb3JnYW5pemF0aW9uGAEgASgJIoQBCg5EZWxldGVkUHJvamVjdBIMCgRuYW1lGAEgASgJEhQKDGRpc3BsYXlfbmFtZRgCIAEoCRIUCgxvcmdhbml6YXRpb24YAyABKAkSEgoKZGVsZXRlZF9hdBgEIAEoCRISCgpkZWxldGVkX2J5GAUgASgJEhAKCHB1cmdlX2F0GAYgASgJIlEKG0xpc3REZWxldGVkUHJvamVjdHNSZXNwb25zZRIyCghwcm9qZWN0cxgBIAMoCzIgLmhvbG9zLmNvbnNvbGUudjEuRGVsZXRlZFByb2plY3QiLQoVUmVzdG9yZVByb2plY3RSZXF1ZXN0EhQKBG5hbWUYASABKAlCBrpIA8gBASIYChZSZXN0b3JlUHJvamVjdFJlc3BvbnNlImUKGUNyZWF0ZVByb2plY3RUb2tlblJlcXVlc3QSFwoHcHJvamVjdBgBIAEoCUIGukgDyAEBEhMKC3R0bF9zZWNvbmRzGAIgASgDEhoKCGF1ZGllbmNlGAMgASgJQgi6SAVyAxj9ASJYChpDcmVhdGVQcm9qZWN0VG9rZW5SZXNwb25zZRINCgV0b2tlbhgBIAEoCRIXCg9zZXJ2aWNlX2FjY291bnQYAiABKAkSEgoKZXhwaXJlc19hdBgDIAEoCTKADQoOUHJvamVjdFNlcnZpY2USYgoMTGlzdFByb2plY3RzEiUuaG9sb3MuY29uc29sZS52MS5MaXN0UHJvamVjdHNSZXF1ZXN0GiYuaG9sb3MuY29uc29sZS52MS5MaXN0UHJvamVjdHNSZXNwb25zZSIDkAIBElwKCkdldFByb2plY3QSIy5ob2xvcy5jb25zb2xlLnYxLkdldFByb2plY3RSZXF1ZXN0GiQuaG9sb3MuY29uc29sZS52MS5HZXRQcm9qZWN0UmVzcG9uc2UiA5ACARJgCg1DcmVhdGVQcm9qZWN0EiYuaG9sb3MuY29uc29sZS52MS5DcmVhdGVQcm9qZWN0UmVxdWVzdBonLmhvbG9zLmNvbnNvbGUudjEuQ3JlYXRlUHJvamVjdFJlc3BvbnNlEmAKDVVwZGF0ZVByb2plY3QSJi5ob2xvcy5jb25zb2xlLnYxLlVwZGF0ZVByb2plY3RSZXF1ZXN0GicuaG9sb3MuY29uc29sZS52MS5VcGRhdGVQcm9qZWN0UmVzcG9uc2USWgoLRGlmZlByb2plY3QSJC5ob2xvcy5jb25zb2xlLnYxLkRpZmZQcm9qZWN0UmVxdWVzdBolLmhvbG9zLmNvbnNvbGUudjEuRGlmZlByb2plY3RSZXNwb25zZRJgCg1EZWxldGVQcm9qZWN0EiYuaG9sb3MuY29uc29sZS52MS5EZWxldGVQcm9qZWN0UmVxdWVzdBonLmhvbG9zLmNvbnNvbGUudjEuRGVsZXRlUHJvamVjdFJlc3BvbnNlEnUKFFVwZGF0ZVByb2plY3RTaGFyaW5nEi0uaG9sb3MuY29uc29sZS52MS5VcGRhdGVQcm9qZWN0U2hhcmluZ1JlcXVlc3QaLi5ob2xvcy5jb25zb2xlLnYxLlVwZGF0ZVByb2plY3RTaGFyaW5nUmVzcG9uc2USZQoNR2V0UHJvamVjdFJhdxImLmhvbG9zLmNvbnNvbGUudjEuR2V0UHJvamVjdFJhd1JlcXVlc3QaJy5ob2xvcy5jb25zb2xlLnYxLkdldFByb2plY3RSYXdSZXNwb25zZSIDkAIBEooBChtVcGRhdGVQcm9qZWN0RGVmYXVsdFNoYXJpbmcSNC5ob2xvcy5jb25zb2xlLnYxLlVwZGF0ZVByb2plY3REZWZhdWx0U2hhcmluZ1JlcXVlc3QaNS5ob2xvcy5jb25zb2xlLnYxLlVwZGF0ZVByb2plY3REZWZhdWx0U2hhcmluZ1Jlc3BvbnNlEoABChZDaGVja1Byb2plY3RJZGVudGlmaWVyEi8uaG9sb3MuY29uc29sZS52MS5DaGVja1Byb2plY3RJZGVudGlmaWVyUmVxdWVzdBowLmhvbG9zLmNvbnNvbGUudjEuQ2hlY2tQcm9qZWN0SWRlbnRpZmllclJlc3BvbnNlIgOQAgESegoUTGlzdFByb2plY3RSZXNvdXJjZXMSLS5ob2xvcy5jb25zb2xlLnYxLkxpc3RQcm9qZWN0UmVzb3VyY2VzUmVxdWVzdBouLmhvbG9zLmNvbnNvbGUudjEuTGlzdFByb2plY3RSZXNvdXJjZXNSZXNwb25zZSIDkAIBEnEKEUxpc3RQcm9qZWN0RXZlbnRzEiouaG9sb3MuY29uc29sZS52MS5MaXN0UHJvamVjdEV2ZW50c1JlcXVlc3QaKy5ob2xvcy5jb25zb2xlLnYxLkxpc3RQcm9qZWN0RXZlbnRzUmVzcG9uc2UiA5ACARJ3ChNMaXN0RGVsZXRlZFByb2plY3RzEiwuaG9sb3MuY29uc29sZS52MS5MaXN0RGVsZXRlZFByb2plY3RzUmVxdWVzdBotLmhvbG9zLmNvbnNvbGUudjEuTGlzdERlbGV0ZWRQcm9qZWN0c1Jlc3BvbnNlIgOQAgESYwoOUmVzdG9yZVByb2plY3QSJy5ob2xvcy5jb25zb2xlLnYxLlJlc3RvcmVQcm9qZWN0UmVxdWVzdBooLmhvbG9zLmNvbnNvbGUudjEuUmVzdG9yZVByb2plY3RSZXNwb25zZRJvChJDcmVhdGVQcm9qZWN0VG9rZW4SKy5ob2xvcy5jb25zb2xlLnYxLkNyZWF0ZVByb2plY3RUb2tlblJlcXVlc3QaLC5ob2xvcy5jb25zb2xlLnYxLkNyZWF0ZVByb2plY3RUb2tlblJlc3BvbnNlQkNaQWdpdGh1Yi5jb20vaG9sb3MtcnVuL2hvbG9zLWNvbnNvbGUvZ2VuL2hvbG9zL2NvbnNvbGUvdjE7Y29uc29sZXYxYgZwcm90bzM", [file_buf_validate_validate, file_holos_console_v1_diff, file_holos_console_v1_folders, file_holos_console_v1_list_filter, file_holos_console_v1_raw_format, file_holos_console_v1_rbac, file_holos_console_v1_secrets]);
 
 /**
  * Describes the message holos.console.v1.Project.
@@ -76,75 +80,180 @@ export const UpdateProjectRequestSchema = /*@__PURE__*/
 export const UpdateProjectResponseSchema = /*@__PURE__*/
   messageDesc(file_holos_console_v1_projects, 8);
 
+/**
+ * Describes the message holos.console.v1.DiffProjectRequest.
+ * Use `create(DiffProjectRequestSchema)` to create a new message.
+ */
+export const DiffProjectRequestSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_projects, 9);
+
+/**
+ * Describes the message holos.console.v1.DiffProjectResponse.
+ * Use `create(DiffProjectResponseSchema)` to create a new message.
+ */
+export const DiffProjectResponseSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_projects, 10);
+
 /**
  * Describes the message holos.console.v1.DeleteProjectRequest.
  * Use `create(DeleteProjectRequestSchema)` to create a new message.
  */
 export const DeleteProjectRequestSchema = /*@__PURE__*/
-  messageDesc(file_holos_console_v1_projects, 9);
+  messageDesc(file_holos_console_v1_projects, 11);
 
 /**
  * Describes the message holos.console.v1.DeleteProjectResponse.
  * Use `create(DeleteProjectResponseSchema)` to create a new message.
  */
 export const DeleteProjectResponseSchema = /*@__PURE__*/
-  messageDesc(file_holos_console_v1_projects, 10);
+  messageDesc(file_holos_console_v1_projects, 12);
 
 /**
  * Describes the message holos.console.v1.UpdateProjectSharingRequest.
  * Use `create(UpdateProjectSharingRequestSchema)` to create a new message.
  */
 export const UpdateProjectSharingRequestSchema = /*@__PURE__*/
-  messageDesc(file_holos_console_v1_projects, 11);
+  messageDesc(file_holos_console_v1_projects, 13);
 
 /**
  * Describes the message holos.console.v1.UpdateProjectSharingResponse.
  * Use `create(UpdateProjectSharingResponseSchema)` to create a new message.
  */
 export const UpdateProjectSharingResponseSchema = /*@__PURE__*/
-  messageDesc(file_holos_console_v1_projects, 12);
+  messageDesc(file_holos_console_v1_projects, 14);
 
 /**
  * Describes the message holos.console.v1.GetProjectRawRequest.
  * Use `create(GetProjectRawRequestSchema)` to create a new message.
  */
 export const GetProjectRawRequestSchema = /*@__PURE__*/
-  messageDesc(file_holos_console_v1_projects, 13);
+  messageDesc(file_holos_console_v1_projects, 15);
 
 /**
  * Describes the message holos.console.v1.GetProjectRawResponse.
  * Use `create(GetProjectRawResponseSchema)` to create a new message.
  */
 export const GetProjectRawResponseSchema = /*@__PURE__*/
-  messageDesc(file_holos_console_v1_projects, 14);
+  messageDesc(file_holos_console_v1_projects, 16);
 
 /**
  * Describes the message holos.console.v1.UpdateProjectDefaultSharingRequest.
  * Use `create(UpdateProjectDefaultSharingRequestSchema)` to create a new message.
  */
 export const UpdateProjectDefaultSharingRequestSchema = /*@__PURE__*/
-  messageDesc(file_holos_console_v1_projects, 15);
+  messageDesc(file_holos_console_v1_projects, 17);
 
 /**
  * Describes the message holos.console.v1.UpdateProjectDefaultSharingResponse.
  * Use `create(UpdateProjectDefaultSharingResponseSchema)` to create a new message.
  */
 export const UpdateProjectDefaultSharingResponseSchema = /*@__PURE__*/
-  messageDesc(file_holos_console_v1_projects, 16);
+  messageDesc(file_holos_console_v1_projects, 18);
 
 /**
  * Describes the message holos.console.v1.CheckProjectIdentifierRequest.
  * Use `create(CheckProjectIdentifierRequestSchema)` to create a new message.
  */
 export const CheckProjectIdentifierRequestSchema = /*@__PURE__*/
-  messageDesc(file_holos_console_v1_projects, 17);
+  messageDesc(file_holos_console_v1_projects, 19);
 
 /**
  * Describes the message holos.console.v1.CheckProjectIdentifierResponse.
  * Use `create(CheckProjectIdentifierResponseSchema)` to create a new message.
  */
 export const CheckProjectIdentifierResponseSchema = /*@__PURE__*/
-  messageDesc(file_holos_console_v1_projects, 18);
+  messageDesc(file_holos_console_v1_projects, 20);
+
+/**
+ * Describes the message holos.console.v1.ListProjectResourcesRequest.
+ * Use `create(ListProjectResourcesRequestSchema)` to create a new message.
+ */
+export const ListProjectResourcesRequestSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_projects, 21);
+
+/**
+ * Describes the message holos.console.v1.ProjectResource.
+ * Use `create(ProjectResourceSchema)` to create a new message.
+ */
+export const ProjectResourceSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_projects, 22);
+
+/**
+ * Describes the message holos.console.v1.ListProjectResourcesResponse.
+ * Use `create(ListProjectResourcesResponseSchema)` to create a new message.
+ */
+export const ListProjectResourcesResponseSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_projects, 23);
+
+/**
+ * Describes the message holos.console.v1.ListProjectEventsRequest.
+ * Use `create(ListProjectEventsRequestSchema)` to create a new message.
+ */
+export const ListProjectEventsRequestSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_projects, 24);
+
+/**
+ * Describes the message holos.console.v1.ProjectEvent.
+ * Use `create(ProjectEventSchema)` to create a new message.
+ */
+export const ProjectEventSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_projects, 25);
+
+/**
+ * Describes the message holos.console.v1.ListProjectEventsResponse.
+ * Use `create(ListProjectEventsResponseSchema)` to create a new message.
+ */
+export const ListProjectEventsResponseSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_projects, 26);
+
+/**
+ * Describes the message holos.console.v1.ListDeletedProjectsRequest.
+ * Use `create(ListDeletedProjectsRequestSchema)` to create a new message.
+ */
+export const ListDeletedProjectsRequestSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_projects, 27);
+
+/**
+ * Describes the message holos.console.v1.DeletedProject.
+ * Use `create(DeletedProjectSchema)` to create a new message.
+ */
+export const DeletedProjectSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_projects, 28);
+
+/**
+ * Describes the message holos.console.v1.ListDeletedProjectsResponse.
+ * Use `create(ListDeletedProjectsResponseSchema)` to create a new message.
+ */
+export const ListDeletedProjectsResponseSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_projects, 29);
+
+/**
+ * Describes the message holos.console.v1.RestoreProjectRequest.
+ * Use `create(RestoreProjectRequestSchema)` to create a new message.
+ */
+export const RestoreProjectRequestSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_projects, 30);
+
+/**
+ * Describes the message holos.console.v1.RestoreProjectResponse.
+ * Use `create(RestoreProjectResponseSchema)` to create a new message.
+ */
+export const RestoreProjectResponseSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_projects, 31);
+
+/**
+ * Describes the message holos.console.v1.CreateProjectTokenRequest.
+ * Use `create(CreateProjectTokenRequestSchema)` to create a new message.
+ */
+export const CreateProjectTokenRequestSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_projects, 32);
+
+/**
+ * Describes the message holos.console.v1.CreateProjectTokenResponse.
+ * Use `create(CreateProjectTokenResponseSchema)` to create a new message.
+ */
+export const CreateProjectTokenResponseSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_projects, 33);
 
 /**
  * ProjectService provides CRUD operations for projects.
@@ -0,0 +1,45 @@
+// @generated by protoc-gen-es v2.11.0
+// @generated from file holos/console/v1/raw_format.proto (package holos.console.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenEnum, GenFile } from "@bufbuild/protobuf/codegenv2";
+
+/**
+ * Describes the file holos/console/v1/raw_format.proto.
+ */
+export declare const file_holos_console_v1_raw_format: GenFile;
+
+/**
+ * RawFormat selects the serialization of the Kubernetes object a Get*Raw
+ * RPC returns.
+ *
+ * @generated from enum holos.console.v1.RawFormat
+ */
+export enum RawFormat {
+  /**
+   * RAW_FORMAT_UNSPECIFIED returns JSON.
+   *
+   * @generated from enum value: RAW_FORMAT_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * RAW_FORMAT_JSON returns the object as JSON.
+   *
+   * @generated from enum value: RAW_FORMAT_JSON = 1;
+   */
+  JSON = 1,
+
+  /**
+   * RAW_FORMAT_YAML returns the object as a YAML manifest.
+   *
+   * @generated from enum value: RAW_FORMAT_YAML = 2;
+   */
+  YAML = 2,
+}
+
+/**
+ * Describes the enum holos.console.v1.RawFormat.
+ */
+export declare const RawFormatSchema: GenEnum<RawFormat>;
+
@@ -0,0 +1,27 @@
+// @generated by protoc-gen-es v2.11.0
+// @generated from file holos/console/v1/raw_format.proto (package holos.console.v1, syntax proto3)
+/* eslint-disable */
+
+import { enumDesc, fileDesc, tsEnum } from "@bufbuild/protobuf/codegenv2";
+
+/**
+ * Describes the file holos/console/v1/raw_format.proto.
+ */
+export const file_holos_console_v1_raw_format = /*@__PURE__*/
+  fileDesc("CiFob2xvcy9jb25zb2xlL3YxL3Jhd19mb3JtYXQucHJvdG8SEGhvbG9zLmNvbnNvbGUudjEqUQoJUmF3Rm9ybWF0EhoKFlJBV19GT1JNQVRfVU5TUEVDSUZJRUQQABITCg9SQVdfRk9STUFUX0pTT04QARITCg9SQVdfRk9STUFUX1lBTUwQAkJDWkFnaXRodWIuY29tL2hvbG9zLXJ1bi9ob2xvcy1jb25zb2xlL2dlbi9ob2xvcy9jb25zb2xlL3YxO2NvbnNvbGV2MWIGcHJvdG8z");
+
+/**
+ * Describes the enum holos.console.v1.RawFormat.
+ */
+export const RawFormatSchema = /*@__PURE__*/
+  enumDesc(file_holos_console_v1_raw_format, 0);
+
+/**
+ * RawFormat selects the serialization of the Kubernetes object a Get*Raw
+ * RPC returns.
+ *
+ * @generated from enum holos.console.v1.RawFormat
+ */
+export const RawFormat = /*@__PURE__*/
+  tsEnum(RawFormatSchema);
+
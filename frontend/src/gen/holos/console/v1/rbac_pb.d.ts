@@ -2,13 +2,61 @@
 // @generated from file holos/console/v1/rbac.proto (package holos.console.v1, syntax proto3)
 /* eslint-disable */
 
-import type { GenEnum, GenFile } from "@bufbuild/protobuf/codegenv2";
+import type { GenEnum, GenFile, GenMessage } from "@bufbuild/protobuf/codegenv2";
+import type { Message } from "@bufbuild/protobuf";
 
 /**
  * Describes the file holos/console/v1/rbac.proto.
  */
 export declare const file_holos_console_v1_rbac: GenFile;
 
+/**
+ * RoleSource explains where a caller's effective role comes from, so a UI
+ * can say "you have Editor via group dev-team on project foo".
+ *
+ * @generated from message holos.console.v1.RoleSource
+ */
+export declare type RoleSource = Message<"holos.console.v1.RoleSource"> & {
+  /**
+   * type is how the role was granted.
+   *
+   * @generated from field: holos.console.v1.RoleSourceType type = 1;
+   */
+  type: RoleSourceType;
+
+  /**
+   * principal is the principal of the grant conferring the role: an email
+   * address, "*@domain", "*", or a group name. Empty for
+   * ROLE_SOURCE_TYPE_PLATFORM.
+   *
+   * @generated from field: string principal = 2;
+   */
+  principal: string;
+
+  /**
+   * resource_type is the type of the resource holding the grant,
+   * "organization", "folder", "project", or "secret". When it differs from the type of the
+   * resource described, the role cascades from that parent. Empty for
+   * ROLE_SOURCE_TYPE_PLATFORM.
+   *
+   * @generated from field: string resource_type = 3;
+   */
+  resourceType: string;
+
+  /**
+   * resource_name is the name of the resource holding the grant.
+   *
+   * @generated from field: string resource_name = 4;
+   */
+  resourceName: string;
+};
+
+/**
+ * Describes the message holos.console.v1.RoleSource.
+ * Use `create(RoleSourceSchema)` to create a new message.
+ */
+export declare const RoleSourceSchema: GenMessage<RoleSource>;
+
 /**
  * Role represents the primitive roles for RBAC.
  *
@@ -49,6 +97,49 @@ export enum Role {
  */
 export declare const RoleSchema: GenEnum<Role>;
 
+/**
+ * RoleSourceType is how a caller was granted their role on a resource.
+ *
+ * @generated from enum holos.console.v1.RoleSourceType
+ */
+export enum RoleSourceType {
+  /**
+   * ROLE_SOURCE_TYPE_UNSPECIFIED indicates the caller holds no role.
+   *
+   * @generated from enum value: ROLE_SOURCE_TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * ROLE_SOURCE_TYPE_USER_GRANT is a user grant naming the caller, their
+   * email domain, or every user.
+   *
+   * @generated from enum value: ROLE_SOURCE_TYPE_USER_GRANT = 1;
+   */
+  USER_GRANT = 1,
+
+  /**
+   * ROLE_SOURCE_TYPE_GROUP_GRANT is a role grant naming one of the caller's
+   * groups.
+   *
+   * @generated from enum value: ROLE_SOURCE_TYPE_GROUP_GRANT = 2;
+   */
+  GROUP_GRANT = 2,
+
+  /**
+   * ROLE_SOURCE_TYPE_PLATFORM is Kubernetes RBAC outside the console's
+   * sharing grants, such as a platform group bound cluster-wide.
+   *
+   * @generated from enum value: ROLE_SOURCE_TYPE_PLATFORM = 3;
+   */
+  PLATFORM = 3,
+}
+
+/**
+ * Describes the enum holos.console.v1.RoleSourceType.
+ */
+export declare const RoleSourceTypeSchema: GenEnum<RoleSourceType>;
+
 /**
  * Permission represents granular permissions for RBAC operations.
  * v1alpha2: template permissions are collapsed to a single set applied
@@ -373,6 +464,36 @@ export enum Permission {
    * @generated from enum value: PERMISSION_TEMPLATE_POLICIES_ADMIN = 51;
    */
   TEMPLATE_POLICIES_ADMIN = 51,
+
+  /**
+   * PERMISSION_SECRETS_ROTATE allows rotating secret values with RotateSecret.
+   * Enforced by the apiserver as the custom "rotate" verb on secrets, granted
+   * to secret editors and owners.
+   *
+   * @generated from enum value: PERMISSION_SECRETS_ROTATE = 52;
+   */
+  SECRETS_ROTATE = 52,
+
+  /**
+   * PERMISSION_IMPERSONATE allows acting as another user by sending the
+   * X-Impersonate-User request header. Enforced by the apiserver as the
+   * "impersonate" verb on users (groups, for X-Impersonate-Group, and the
+   * "email" userextra, for X-Impersonate-Email), so it is granted with the
+   * same RBAC that governs kubectl --as. Scoped API tokens must also list
+   * it.
+   *
+   * @generated from enum value: PERMISSION_IMPERSONATE = 53;
+   */
+  IMPERSONATE = 53,
+
+  /**
+   * PERMISSION_RESOURCES_READ allows listing the workloads running in a
+   * project namespace with ListProjectResources. Enforced by the apiserver as
+   * "list" on deployments.apps in the namespace.
+   *
+   * @generated from enum value: PERMISSION_RESOURCES_READ = 54;
+   */
+  RESOURCES_READ = 54,
 }
 
 /**
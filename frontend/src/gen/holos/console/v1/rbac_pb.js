@@ -2,13 +2,20 @@
 // @generated from file holos/console/v1/rbac.proto (package holos.console.v1, syntax proto3)
 /* eslint-disable */
 
-import { enumDesc, fileDesc, tsEnum } from "@bufbuild/protobuf/codegenv2";
+import { enumDesc, fileDesc, messageDesc, tsEnum } from "@bufbuild/protobuf/codegenv2";
 
 /**
  * Describes the file holos/console/v1/rbac.proto.
  */
 export const file_holos_console_v1_rbac = /*@__PURE__*/
-  fileDesc("Chtob2xvcy9jb25zb2xlL3YxL3JiYWMucHJvdG8SEGhvbG9zLmNvbnNvbGUudjEqTgoEUm9sZRIUChBST0xFX1VOU1BFQ0lGSUVEEAASDwoLUk9MRV9WSUVXRVIQARIPCgtST0xFX0VESVRPUhACEg4KClJPTEVfT1dORVIQAyqZDAoKUGVybWlzc2lvbhIaChZQRVJNSVNTSU9OX1VOU1BFQ0lGSUVEEAASGwoXUEVSTUlTU0lPTl9TRUNSRVRTX1JFQUQQARIbChdQRVJNSVNTSU9OX1NFQ1JFVFNfTElTVBACEhwKGFBFUk1JU1NJT05fU0VDUkVUU19XUklURRADEh0KGVBFUk1JU1NJT05fU0VDUkVUU19ERUxFVEUQBBIcChhQRVJNSVNTSU9OX1NFQ1JFVFNfQURNSU4QBRIcChhQRVJNSVNTSU9OX1BST0pFQ1RTX1JFQUQQBhIcChhQRVJNSVNTSU9OX1BST0pFQ1RTX0xJU1QQBxIdChlQRVJNSVNTSU9OX1BST0pFQ1RTX1dSSVRFEAgSHgoaUEVSTUlTU0lPTl9QUk9KRUNUU19ERUxFVEUQCRIdChlQRVJNSVNTSU9OX1BST0pFQ1RTX0FETUlOEAoSHgoaUEVSTUlTU0lPTl9QUk9KRUNUU19DUkVBVEUQCxIhCh1QRVJNSVNTSU9OX09SR0FOSVpBVElPTlNfUkVBRBAMEiEKHVBFUk1JU1NJT05fT1JHQU5JWkFUSU9OU19MSVNUEA0SIgoeUEVSTUlTU0lPTl9PUkdBTklaQVRJT05TX1dSSVRFEA4SIwofUEVSTUlTU0lPTl9PUkdBTklaQVRJT05TX0RFTEVURRAPEiIKHlBFUk1JU1NJT05fT1JHQU5JWkFUSU9OU19BRE1JThAQEiMKH1BFUk1JU1NJT05fT1JHQU5JWkFUSU9OU19DUkVBVEUQERIfChtQRVJNSVNTSU9OX0RFUExPWU1FTlRTX0xJU1QQEhIfChtQRVJNSVNTSU9OX0RFUExPWU1FTlRTX1JFQUQQExIgChxQRVJNSVNTSU9OX0RFUExPWU1FTlRTX1dSSVRFEBQSIQodUEVSTUlTU0lPTl9ERVBMT1lNRU5UU19ERUxFVEUQFRIgChxQRVJNSVNTSU9OX0RFUExPWU1FTlRTX0FETUlOEBYSHwobUEVSTUlTU0lPTl9ERVBMT1lNRU5UU19MT0dTEBcSJAogUEVSTUlTU0lPTl9QUk9KRUNUX1NFVFRJTkdTX1JFQUQQHRIlCiFQRVJNSVNTSU9OX1BST0pFQ1RfU0VUVElOR1NfV1JJVEUQHhIpCiVQRVJNSVNTSU9OX1BST0pFQ1RfREVQTE9ZTUVOVFNfRU5BQkxFEB8SGwoXUEVSTUlTU0lPTl9GT0xERVJTX0xJU1QQIRIbChdQRVJNSVNTSU9OX0ZPTERFUlNfUkVBRBAiEhwKGFBFUk1JU1NJT05fRk9MREVSU19XUklURRAjEh0KGVBFUk1JU1NJT05fRk9MREVSU19ERUxFVEUQJBIcChhQRVJNSVNTSU9OX0ZPTERFUlNfQURNSU4QJRIdChlQRVJNSVNTSU9OX0ZPTERFUlNfQ1JFQVRFECYSHQoZUEVSTUlTU0lPTl9URU1QTEFURVNfTElTVBAnEh0KGVBFUk1JU1NJT05fVEVNUExBVEVTX1JFQUQQKBIeChpQRVJNSVNTSU9OX1RFTVBMQVRFU19XUklURRApEh8KG1BFUk1JU1NJT05fVEVNUExBVEVTX0RFTEVURRAqEh4KGlBFUk1JU1NJT05fVEVNUExBVEVTX0FETUlOECsSFwoTUEVSTUlTU0lPTl9SRVBBUkVOVBAsEicKI1BFUk1JU1NJT05fVEVNUExBVEVTX0xJTktfT1JHX1dSSVRFEC0SKgomUEVSTUlTU0lPTl9URU1QTEFURVNfTElOS19GT0xERVJfV1JJVEUQLhIlCiFQRVJNSVNTSU9OX1RFTVBMQVRFX1BPTElDSUVTX0xJU1QQLxIlCiFQRVJNSVNTSU9OX1RFTVBMQVRFX1BPTElDSUVTX1JFQUQQMBImCiJQRVJNSVNTSU9OX1RFTVBMQVRFX1BPTElDSUVTX1dSSVRFEDESJwojUEVSTUlTU0lPTl9URU1QTEFURV9QT0xJQ0lFU19ERUxFVEUQMhImCiJQRVJNSVNTSU9OX1RFTVBMQVRFX1BPTElDSUVTX0FETUlOEDNCQ1pBZ2l0aHViLmNvbS9ob2xvcy1ydW4vaG9sb3MtY29uc29sZS9nZW4vaG9sb3MvY29uc29sZS92MTtjb25zb2xldjFiBnByb3RvMw");
+  fileDesc("Chtob2xvcy9jb25zb2xlL3YxL3JiYWMucHJvdG8SEGhvbG9zLmNvbnNvbGUudjEifQoKUm9sZVNvdXJjZRIuCgR0eXBlGAEgASgOMiAuaG9sb3MuY29uc29sZS52MS5Sb2xlU291cmNlVHlwZRIRCglwcmluY2lwYWwYAiABKAkSFQoNcmVzb3VyY2VfdHlwZRgDIAEoCRIVCg1yZXNvdXJjZV9uYW1lGAQgASgJKk4KBFJvbGUSFAoQUk9MRV9VTlNQRUNJRklFRBAAEg8KC1JPTEVfVklFV0VSEAESDwoLUk9MRV9FRElUT1IQAhIOCgpST0xFX09XTkVSEAMqlAEKDlJvbGVTb3VyY2VUeXBlEiAKHFJPTEVfU09VUkNFX1RZUEVfVU5TUEVDSUZJRUQQABIfChtST0xFX1NPVVJDRV9UWVBFX1VTRVJfR1JBTlQQARIgChxST0xFX1NPVVJDRV9UWVBFX0dST1VQX0dSQU5UEAISHQoZUk9MRV9TT1VSQ0VfVFlQRV9QTEFURk9STRADKvMMCgpQZXJtaXNzaW9uEhoKFlBFUk1JU1NJT05fVU5TUEVDSUZJRUQQABIbChdQRVJNSVNTSU9OX1NFQ1JFVFNfUkVBRBABEhsKF1BFUk1JU1NJT05fU0VDUkVUU19MSVNUEAISHAoYUEVSTUlTU0lPTl9TRUNSRVRTX1dSSVRFEAMSHQoZUEVSTUlTU0lPTl9TRUNSRVRTX0RFTEVURRAEEhwKGFBFUk1JU1NJT05fU0VDUkVUU19BRE1JThAFEhwKGFBFUk1JU1NJT05fUFJPSkVDVFNfUkVBRBAGEhwKGFBFUk1JU1NJT05fUFJPSkVDVFNfTElTVBAHEh0KGVBFUk1JU1NJT05fUFJPSkVDVFNfV1JJVEUQCBIeChpQRVJNSVNTSU9OX1BST0pFQ1RTX0RFTEVURRAJEh0KGVBFUk1JU1NJT05fUFJPSkVDVFNfQURNSU4QChIeChpQRVJNSVNTSU9OX1BST0pFQ1RTX0NSRUFURRALEiEKHVBFUk1JU1NJT05fT1JHQU5JWkFUSU9OU19SRUFEEAwSIQodUEVSTUlTU0lPTl9PUkdBTklaQVRJT05TX0xJU1QQDRIiCh5QRVJNSVNTSU9OX09SR0FOSVpBVElPTlNfV1JJVEUQDhIjCh9QRVJNSVNTSU9OX09SR0FOSVpBVElPTlNfREVMRVRFEA8SIgoeUEVSTUlTU0lPTl9PUkdBTklaQVRJT05TX0FETUlOEBASIwofUEVSTUlTU0lPTl9PUkdBTklaQVRJT05TX0NSRUFURRAREh8KG1BFUk1JU1NJT05fREVQTE9ZTUVOVFNfTElTVBASEh8KG1BFUk1JU1NJT05fREVQTE9ZTUVOVFNfUkVBRBATEiAKHFBFUk1JU1NJT05fREVQTE9ZTUVOVFNfV1JJVEUQFBIhCh1QRVJNSVNTSU9OX0RFUExPWU1FTlRTX0RFTEVURRAVEiAKHFBFUk1JU1NJT05fREVQTE9ZTUVOVFNfQURNSU4QFhIfChtQRVJNSVNTSU9OX0RFUExPWU1FTlRTX0xPR1MQFxIkCiBQRVJNSVNTSU9OX1BST0pFQ1RfU0VUVElOR1NfUkVBRBAdEiUKIVBFUk1JU1NJT05fUFJPSkVDVF9TRVRUSU5HU19XUklURRAeEikKJVBFUk1JU1NJT05fUFJPSkVDVF9ERVBMT1lNRU5UU19FTkFCTEUQHxIbChdQRVJNSVNTSU9OX0ZPTERFUlNfTElTVBAhEhsKF1BFUk1JU1NJT05fRk9MREVSU19SRUFEECISHAoYUEVSTUlTU0lPTl9GT0xERVJTX1dSSVRFECMSHQoZUEVSTUlTU0lPTl9GT0xERVJTX0RFTEVURRAkEhwKGFBFUk1JU1NJT05fRk9MREVSU19BRE1JThAlEh0KGVBFUk1JU1NJT05fRk9MREVSU19DUkVBVEUQJhIdChlQRVJNSVNTSU9OX1RFTVBMQVRFU19MSVNUECcSHQoZUEVSTUlTU0lPTl9URU1QTEFURVNfUkVBRBAoEh4KGlBFUk1JU1NJT05fVEVNUExBVEVTX1dSSVRFECkSHwobUEVSTUlTU0lPTl9URU1QTEFURVNfREVMRVRFECoSHgoaUEVSTUlTU0lPTl9URU1QTEFURVNfQURNSU4QKxIXChNQRVJNSVNTSU9OX1JFUEFSRU5UECwSJwojUEVSTUlTU0lPTl9URU1QTEFURVNfTElOS19PUkdfV1JJVEUQLRIqCiZQRVJNSVNTSU9OX1RFTVBMQVRFU19MSU5LX0ZPTERFUl9XUklURRAuEiUKIVBFUk1JU1NJT05fVEVNUExBVEVfUE9MSUNJRVNfTElTVBAvEiUKIVBFUk1JU1NJT05fVEVNUExBVEVfUE9MSUNJRVNfUkVBRBAwEiYKIlBFUk1JU1NJT05fVEVNUExBVEVfUE9MSUNJRVNfV1JJVEUQMRInCiNQRVJNSVNTSU9OX1RFTVBMQVRFX1BPTElDSUVTX0RFTEVURRAyEiYKIlBFUk1JU1NJT05fVEVNUExBVEVfUE9MSUNJRVNfQURNSU4QMxIdChlQRVJNSVNTSU9OX1NFQ1JFVFNfUk9UQVRFEDQSGgoWUEVSTUlTU0lPTl9JTVBFUlNPTkFURRA1Eh0KGVBFUk1JU1NJT05fUkVTT1VSQ0VTX1JFQUQQNkJDWkFnaXRodWIuY29tL2hvbG9zLXJ1bi9ob2xvcy1jb25zb2xlL2dlbi9ob2xvcy9jb25zb2xlL3YxO2NvbnNvbGV2MWIGcHJvdG8z");
+
+/**
+ * Describes the message holos.console.v1.RoleSource.
+ * Use `create(RoleSourceSchema)` to create a new message.
+ */
+export const RoleSourceSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_rbac, 0);
 
 /**
  * Describes the enum holos.console.v1.Role.
@@ -24,11 +31,25 @@ export const RoleSchema = /*@__PURE__*/
 export const Role = /*@__PURE__*/
   tsEnum(RoleSchema);
 
+/**
+ * Describes the enum holos.console.v1.RoleSourceType.
+ */
+export const RoleSourceTypeSchema = /*@__PURE__*/
+  enumDesc(file_holos_console_v1_rbac, 1);
+
+/**
+ * RoleSourceType is how a caller was granted their role on a resource.
+ *
+ * @generated from enum holos.console.v1.RoleSourceType
+ */
+export const RoleSourceType = /*@__PURE__*/
+  tsEnum(RoleSourceTypeSchema);
+
 /**
  * Describes the enum holos.console.v1.Permission.
  */
 export const PermissionSchema = /*@__PURE__*/
-  enumDesc(file_holos_console_v1_rbac, 1);
+  enumDesc(file_holos_console_v1_rbac, 2);
 
 /**
  * Permission represents granular permissions for RBAC operations.
@@ -0,0 +1,567 @@
+// @generated by protoc-gen-es v2.11.0
+// @generated from file holos/console/v1/secret_templates.proto (package holos.console.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import type { Message } from "@bufbuild/protobuf";
+import type { GenerateSpec, ShareGrant } from "./secrets_pb";
+import type { Timestamp } from "@bufbuild/protobuf/wkt";
+
+/**
+ * Describes the file holos/console/v1/secret_templates.proto.
+ */
+export declare const file_holos_console_v1_secret_templates: GenFile;
+
+/**
+ * SecretTemplateKey describes one data key of secrets created from a
+ * template. At most one of generate, default_value and required may be set.
+ *
+ * @generated from message holos.console.v1.SecretTemplateKey
+ */
+export declare type SecretTemplateKey = Message<"holos.console.v1.SecretTemplateKey"> & {
+  /**
+   * name is the secret data key.
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * description explains what the value is, for example "database host".
+   *
+   * @generated from field: string description = 2;
+   */
+  description: string;
+
+  /**
+   * generate makes the server generate a random value when the caller does
+   * not supply one.
+   *
+   * @generated from field: holos.console.v1.GenerateSpec generate = 3;
+   */
+  generate?: GenerateSpec;
+
+  /**
+   * default_value is stored when the caller does not supply a value.
+   *
+   * @generated from field: string default_value = 4;
+   */
+  defaultValue: string;
+
+  /**
+   * required makes the caller supply a value. Keys that are neither
+   * required, generated nor defaulted are left out when not supplied.
+   *
+   * @generated from field: bool required = 5;
+   */
+  required: boolean;
+};
+
+/**
+ * Describes the message holos.console.v1.SecretTemplateKey.
+ * Use `create(SecretTemplateKeySchema)` to create a new message.
+ */
+export declare const SecretTemplateKeySchema: GenMessage<SecretTemplateKey>;
+
+/**
+ * SecretTemplate is a named blueprint for project secrets.
+ *
+ * @generated from message holos.console.v1.SecretTemplate
+ */
+export declare type SecretTemplate = Message<"holos.console.v1.SecretTemplate"> & {
+  /**
+   * name identifies the template within its scope.
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * organization is set for organization templates.
+   *
+   * @generated from field: string organization = 2;
+   */
+  organization: string;
+
+  /**
+   * project is set for project templates.
+   *
+   * @generated from field: string project = 3;
+   */
+  project: string;
+
+  /**
+   * display_name is a human-readable name for the template.
+   *
+   * @generated from field: string display_name = 4;
+   */
+  displayName: string;
+
+  /**
+   * description explains what secrets created from the template are for.
+   *
+   * @generated from field: string description = 5;
+   */
+  description: string;
+
+  /**
+   * keys lays out the data keys of secrets created from the template.
+   *
+   * @generated from field: repeated holos.console.v1.SecretTemplateKey keys = 6;
+   */
+  keys: SecretTemplateKey[];
+
+  /**
+   * default_user_grants are the per-user sharing grants every secret
+   * created from the template starts with.
+   *
+   * @generated from field: repeated holos.console.v1.ShareGrant default_user_grants = 7;
+   */
+  defaultUserGrants: ShareGrant[];
+
+  /**
+   * default_role_grants are the per-role sharing grants every secret
+   * created from the template starts with.
+   *
+   * @generated from field: repeated holos.console.v1.ShareGrant default_role_grants = 8;
+   */
+  defaultRoleGrants: ShareGrant[];
+
+  /**
+   * creator_email is the email address of the user who created the
+   * template. Output only.
+   *
+   * @generated from field: string creator_email = 9;
+   */
+  creatorEmail: string;
+
+  /**
+   * created_at is when the template was created. Output only.
+   *
+   * @generated from field: google.protobuf.Timestamp created_at = 10;
+   */
+  createdAt?: Timestamp;
+};
+
+/**
+ * Describes the message holos.console.v1.SecretTemplate.
+ * Use `create(SecretTemplateSchema)` to create a new message.
+ */
+export declare const SecretTemplateSchema: GenMessage<SecretTemplate>;
+
+/**
+ * ListSecretTemplatesRequest selects a scope. Set exactly one of
+ * organization or project.
+ *
+ * @generated from message holos.console.v1.ListSecretTemplatesRequest
+ */
+export declare type ListSecretTemplatesRequest = Message<"holos.console.v1.ListSecretTemplatesRequest"> & {
+  /**
+   * organization lists the templates of an organization.
+   *
+   * @generated from field: string organization = 1;
+   */
+  organization: string;
+
+  /**
+   * project lists the templates of a project and its organization.
+   *
+   * @generated from field: string project = 2;
+   */
+  project: string;
+};
+
+/**
+ * Describes the message holos.console.v1.ListSecretTemplatesRequest.
+ * Use `create(ListSecretTemplatesRequestSchema)` to create a new message.
+ */
+export declare const ListSecretTemplatesRequestSchema: GenMessage<ListSecretTemplatesRequest>;
+
+/**
+ * ListSecretTemplatesResponse contains the templates of a scope.
+ *
+ * @generated from message holos.console.v1.ListSecretTemplatesResponse
+ */
+export declare type ListSecretTemplatesResponse = Message<"holos.console.v1.ListSecretTemplatesResponse"> & {
+  /**
+   * templates are sorted by name, project templates first.
+   *
+   * @generated from field: repeated holos.console.v1.SecretTemplate templates = 1;
+   */
+  templates: SecretTemplate[];
+};
+
+/**
+ * Describes the message holos.console.v1.ListSecretTemplatesResponse.
+ * Use `create(ListSecretTemplatesResponseSchema)` to create a new message.
+ */
+export declare const ListSecretTemplatesResponseSchema: GenMessage<ListSecretTemplatesResponse>;
+
+/**
+ * GetSecretTemplateRequest names a template. Set exactly one of
+ * organization or project.
+ *
+ * @generated from message holos.console.v1.GetSecretTemplateRequest
+ */
+export declare type GetSecretTemplateRequest = Message<"holos.console.v1.GetSecretTemplateRequest"> & {
+  /**
+   * organization is the scope of an organization template.
+   *
+   * @generated from field: string organization = 1;
+   */
+  organization: string;
+
+  /**
+   * project is the scope of a project template.
+   *
+   * @generated from field: string project = 2;
+   */
+  project: string;
+
+  /**
+   * name is the template name.
+   *
+   * @generated from field: string name = 3;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message holos.console.v1.GetSecretTemplateRequest.
+ * Use `create(GetSecretTemplateRequestSchema)` to create a new message.
+ */
+export declare const GetSecretTemplateRequestSchema: GenMessage<GetSecretTemplateRequest>;
+
+/**
+ * GetSecretTemplateResponse contains the requested template.
+ *
+ * @generated from message holos.console.v1.GetSecretTemplateResponse
+ */
+export declare type GetSecretTemplateResponse = Message<"holos.console.v1.GetSecretTemplateResponse"> & {
+  /**
+   * @generated from field: holos.console.v1.SecretTemplate template = 1;
+   */
+  template?: SecretTemplate;
+};
+
+/**
+ * Describes the message holos.console.v1.GetSecretTemplateResponse.
+ * Use `create(GetSecretTemplateResponseSchema)` to create a new message.
+ */
+export declare const GetSecretTemplateResponseSchema: GenMessage<GetSecretTemplateResponse>;
+
+/**
+ * CreateSecretTemplateRequest contains the template to create. Set exactly
+ * one of template.organization or template.project.
+ *
+ * @generated from message holos.console.v1.CreateSecretTemplateRequest
+ */
+export declare type CreateSecretTemplateRequest = Message<"holos.console.v1.CreateSecretTemplateRequest"> & {
+  /**
+   * @generated from field: holos.console.v1.SecretTemplate template = 1;
+   */
+  template?: SecretTemplate;
+};
+
+/**
+ * Describes the message holos.console.v1.CreateSecretTemplateRequest.
+ * Use `create(CreateSecretTemplateRequestSchema)` to create a new message.
+ */
+export declare const CreateSecretTemplateRequestSchema: GenMessage<CreateSecretTemplateRequest>;
+
+/**
+ * CreateSecretTemplateResponse contains the created template.
+ *
+ * @generated from message holos.console.v1.CreateSecretTemplateResponse
+ */
+export declare type CreateSecretTemplateResponse = Message<"holos.console.v1.CreateSecretTemplateResponse"> & {
+  /**
+   * @generated from field: holos.console.v1.SecretTemplate template = 1;
+   */
+  template?: SecretTemplate;
+};
+
+/**
+ * Describes the message holos.console.v1.CreateSecretTemplateResponse.
+ * Use `create(CreateSecretTemplateResponseSchema)` to create a new message.
+ */
+export declare const CreateSecretTemplateResponseSchema: GenMessage<CreateSecretTemplateResponse>;
+
+/**
+ * UpdateSecretTemplateRequest contains the new definition of an existing
+ * template, identified by its scope and name.
+ *
+ * @generated from message holos.console.v1.UpdateSecretTemplateRequest
+ */
+export declare type UpdateSecretTemplateRequest = Message<"holos.console.v1.UpdateSecretTemplateRequest"> & {
+  /**
+   * @generated from field: holos.console.v1.SecretTemplate template = 1;
+   */
+  template?: SecretTemplate;
+};
+
+/**
+ * Describes the message holos.console.v1.UpdateSecretTemplateRequest.
+ * Use `create(UpdateSecretTemplateRequestSchema)` to create a new message.
+ */
+export declare const UpdateSecretTemplateRequestSchema: GenMessage<UpdateSecretTemplateRequest>;
+
+/**
+ * UpdateSecretTemplateResponse contains the updated template.
+ *
+ * @generated from message holos.console.v1.UpdateSecretTemplateResponse
+ */
+export declare type UpdateSecretTemplateResponse = Message<"holos.console.v1.UpdateSecretTemplateResponse"> & {
+  /**
+   * @generated from field: holos.console.v1.SecretTemplate template = 1;
+   */
+  template?: SecretTemplate;
+};
+
+/**
+ * Describes the message holos.console.v1.UpdateSecretTemplateResponse.
+ * Use `create(UpdateSecretTemplateResponseSchema)` to create a new message.
+ */
+export declare const UpdateSecretTemplateResponseSchema: GenMessage<UpdateSecretTemplateResponse>;
+
+/**
+ * DeleteSecretTemplateRequest names the template to delete. Set exactly one
+ * of organization or project.
+ *
+ * @generated from message holos.console.v1.DeleteSecretTemplateRequest
+ */
+export declare type DeleteSecretTemplateRequest = Message<"holos.console.v1.DeleteSecretTemplateRequest"> & {
+  /**
+   * organization is the scope of an organization template.
+   *
+   * @generated from field: string organization = 1;
+   */
+  organization: string;
+
+  /**
+   * project is the scope of a project template.
+   *
+   * @generated from field: string project = 2;
+   */
+  project: string;
+
+  /**
+   * name is the template name.
+   *
+   * @generated from field: string name = 3;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message holos.console.v1.DeleteSecretTemplateRequest.
+ * Use `create(DeleteSecretTemplateRequestSchema)` to create a new message.
+ */
+export declare const DeleteSecretTemplateRequestSchema: GenMessage<DeleteSecretTemplateRequest>;
+
+/**
+ * DeleteSecretTemplateResponse is empty.
+ *
+ * @generated from message holos.console.v1.DeleteSecretTemplateResponse
+ */
+export declare type DeleteSecretTemplateResponse = Message<"holos.console.v1.DeleteSecretTemplateResponse"> & {
+};
+
+/**
+ * Describes the message holos.console.v1.DeleteSecretTemplateResponse.
+ * Use `create(DeleteSecretTemplateResponseSchema)` to create a new message.
+ */
+export declare const DeleteSecretTemplateResponseSchema: GenMessage<DeleteSecretTemplateResponse>;
+
+/**
+ * CreateSecretFromTemplateRequest creates a secret from a template visible
+ * to the project.
+ *
+ * @generated from message holos.console.v1.CreateSecretFromTemplateRequest
+ */
+export declare type CreateSecretFromTemplateRequest = Message<"holos.console.v1.CreateSecretFromTemplateRequest"> & {
+  /**
+   * project is the project to create the secret in.
+   *
+   * @generated from field: string project = 1;
+   */
+  project: string;
+
+  /**
+   * template names a template of the project or, when the project has none
+   * by that name, of its organization.
+   *
+   * @generated from field: string template = 2;
+   */
+  template: string;
+
+  /**
+   * name is the name of the secret to create.
+   *
+   * @generated from field: string name = 3;
+   */
+  name: string;
+
+  /**
+   * string_data supplies values for template keys. Keys the template does
+   * not define are rejected.
+   *
+   * @generated from field: map<string, string> string_data = 4;
+   */
+  stringData: { [key: string]: string };
+
+  /**
+   * description is a human-readable description of the secret's purpose.
+   * Defaults to the template description.
+   *
+   * @generated from field: optional string description = 5;
+   */
+  description?: string;
+
+  /**
+   * url is a URL associated with the secret.
+   *
+   * @generated from field: optional string url = 6;
+   */
+  url?: string;
+
+  /**
+   * user_grants are added to the template's default user grants.
+   *
+   * @generated from field: repeated holos.console.v1.ShareGrant user_grants = 7;
+   */
+  userGrants: ShareGrant[];
+
+  /**
+   * role_grants are added to the template's default role grants.
+   *
+   * @generated from field: repeated holos.console.v1.ShareGrant role_grants = 8;
+   */
+  roleGrants: ShareGrant[];
+
+  /**
+   * dry_run validates the request and the secret without creating it.
+   *
+   * @generated from field: bool dry_run = 9;
+   */
+  dryRun: boolean;
+};
+
+/**
+ * Describes the message holos.console.v1.CreateSecretFromTemplateRequest.
+ * Use `create(CreateSecretFromTemplateRequestSchema)` to create a new message.
+ */
+export declare const CreateSecretFromTemplateRequestSchema: GenMessage<CreateSecretFromTemplateRequest>;
+
+/**
+ * CreateSecretFromTemplateResponse contains the created secret.
+ *
+ * @generated from message holos.console.v1.CreateSecretFromTemplateResponse
+ */
+export declare type CreateSecretFromTemplateResponse = Message<"holos.console.v1.CreateSecretFromTemplateResponse"> & {
+  /**
+   * name is the name of the created secret.
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * generated_values holds the values generated for template keys. They
+   * are returned only once, in this response.
+   *
+   * @generated from field: map<string, string> generated_values = 2;
+   */
+  generatedValues: { [key: string]: string };
+};
+
+/**
+ * Describes the message holos.console.v1.CreateSecretFromTemplateResponse.
+ * Use `create(CreateSecretFromTemplateResponseSchema)` to create a new message.
+ */
+export declare const CreateSecretFromTemplateResponseSchema: GenMessage<CreateSecretFromTemplateResponse>;
+
+/**
+ * SecretTemplatesService manages secret templates: named blueprints that
+ * standardize the layout of a kind of credential, for example a "postgres"
+ * template with username, password and host keys. A template lists its keys
+ * with descriptions and generation rules, and the sharing grants every
+ * secret created from it starts with.
+ *
+ * Templates are defined at organization or project scope. A project sees
+ * its own templates and those of its organization; a project template
+ * shadows an organization template of the same name. Reading templates
+ * requires "get" on the scope namespace and managing them requires
+ * "update", checked with a SelfSubjectAccessReview.
+ *
+ * @generated from service holos.console.v1.SecretTemplatesService
+ */
+export declare const SecretTemplatesService: GenService<{
+  /**
+   * ListSecretTemplates returns the templates defined at a scope. With
+   * project set, the organization templates the project inherits are
+   * included after the project's own.
+   *
+   * @generated from rpc holos.console.v1.SecretTemplatesService.ListSecretTemplates
+   */
+  listSecretTemplates: {
+    methodKind: "unary";
+    input: typeof ListSecretTemplatesRequestSchema;
+    output: typeof ListSecretTemplatesResponseSchema;
+  },
+  /**
+   * GetSecretTemplate returns a single template defined at a scope.
+   *
+   * @generated from rpc holos.console.v1.SecretTemplatesService.GetSecretTemplate
+   */
+  getSecretTemplate: {
+    methodKind: "unary";
+    input: typeof GetSecretTemplateRequestSchema;
+    output: typeof GetSecretTemplateResponseSchema;
+  },
+  /**
+   * CreateSecretTemplate defines a new template.
+   *
+   * @generated from rpc holos.console.v1.SecretTemplatesService.CreateSecretTemplate
+   */
+  createSecretTemplate: {
+    methodKind: "unary";
+    input: typeof CreateSecretTemplateRequestSchema;
+    output: typeof CreateSecretTemplateResponseSchema;
+  },
+  /**
+   * UpdateSecretTemplate replaces the keys, descriptions and default grants
+   * of an existing template.
+   *
+   * @generated from rpc holos.console.v1.SecretTemplatesService.UpdateSecretTemplate
+   */
+  updateSecretTemplate: {
+    methodKind: "unary";
+    input: typeof UpdateSecretTemplateRequestSchema;
+    output: typeof UpdateSecretTemplateResponseSchema;
+  },
+  /**
+   * DeleteSecretTemplate removes a template. Secrets created from it are
+   * not affected.
+   *
+   * @generated from rpc holos.console.v1.SecretTemplatesService.DeleteSecretTemplate
+   */
+  deleteSecretTemplate: {
+    methodKind: "unary";
+    input: typeof DeleteSecretTemplateRequestSchema;
+    output: typeof DeleteSecretTemplateResponseSchema;
+  },
+  /**
+   * CreateSecretFromTemplate creates a project secret laid out by a
+   * template. The secret is created through SecretsService.CreateSecret, so
+   * the caller needs the same access as for any other new secret.
+   *
+   * @generated from rpc holos.console.v1.SecretTemplatesService.CreateSecretFromTemplate
+   */
+  createSecretFromTemplate: {
+    methodKind: "unary";
+    input: typeof CreateSecretFromTemplateRequestSchema;
+    output: typeof CreateSecretFromTemplateResponseSchema;
+  },
+}>;
+
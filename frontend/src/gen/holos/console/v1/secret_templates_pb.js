@@ -0,0 +1,130 @@
+// @generated by protoc-gen-es v2.11.0
+// @generated from file holos/console/v1/secret_templates.proto (package holos.console.v1, syntax proto3)
+/* eslint-disable */
+
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import { file_holos_console_v1_secrets } from "./secrets_pb";
+
+/**
+ * Describes the file holos/console/v1/secret_templates.proto.
+ */
+export const file_holos_console_v1_secret_templates = /*@__PURE__*/
+  fileDesc("Cidob2xvcy9jb25zb2xlL3YxL3NlY3JldF90ZW1wbGF0ZXMucHJvdG8SEGhvbG9zLmNvbnNvbGUudjEikQEKEVNlY3JldFRlbXBsYXRlS2V5EgwKBG5hbWUYASABKAkSEwoLZGVzY3JpcHRpb24YAiABKAkSMAoIZ2VuZXJhdGUYAyABKAsyHi5ob2xvcy5jb25zb2xlLnYxLkdlbmVyYXRlU3BlYxIVCg1kZWZhdWx0X3ZhbHVlGAQgASgJEhAKCHJlcXVpcmVkGAUgASgIIuACCg5TZWNyZXRUZW1wbGF0ZRIMCgRuYW1lGAEgASgJEhQKDG9yZ2FuaXphdGlvbhgCIAEoCRIPCgdwcm9qZWN0GAMgASgJEhQKDGRpc3BsYXlfbmFtZRgEIAEoCRITCgtkZXNjcmlwdGlvbhgFIAEoCRIxCgRrZXlzGAYgAygLMiMuaG9sb3MuY29uc29sZS52MS5TZWNyZXRUZW1wbGF0ZUtleRI5ChNkZWZhdWx0X3VzZXJfZ3JhbnRzGAcgAygLMhwuaG9sb3MuY29uc29sZS52MS5TaGFyZUdyYW50EjkKE2RlZmF1bHRfcm9sZV9ncmFudHMYCCADKAsyHC5ob2xvcy5jb25zb2xlLnYxLlNoYXJlR3JhbnQSFQoNY3JlYXRvcl9lbWFpbBgJIAEoCRIuCgpjcmVhdGVkX2F0GAogASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCJDChpMaXN0U2VjcmV0VGVtcGxhdGVzUmVxdWVzdBIUCgxvcmdhbml6YXRpb24YASABKAkSDwoHcHJvamVjdBgCIAEoCSJSChtMaXN0U2VjcmV0VGVtcGxhdGVzUmVzcG9uc2USMwoJdGVtcGxhdGVzGAEgAygLMiAuaG9sb3MuY29uc29sZS52MS5TZWNyZXRUZW1wbGF0ZSJPChhHZXRTZWNyZXRUZW1wbGF0ZVJlcXVlc3QSFAoMb3JnYW5pemF0aW9uGAEgASgJEg8KB3Byb2plY3QYAiABKAkSDAoEbmFtZRgDIAEoCSJPChlHZXRTZWNyZXRUZW1wbGF0ZVJlc3BvbnNlEjIKCHRlbXBsYXRlGAEgASgLMiAuaG9sb3MuY29uc29sZS52MS5TZWNyZXRUZW1wbGF0ZSJRChtDcmVhdGVTZWNyZXRUZW1wbGF0ZVJlcXVlc3QSMgoIdGVtcGxhdGUYASABKAsyIC5ob2xvcy5jb25zb2xlLnYxLlNlY3JldFRlbXBsYXRlIlIKHENyZWF0ZVNlY3JldFRlbXBsYXRlUmVzcG9uc2USMgoIdGVtcGxhdGUYASABKAsyIC5ob2xvcy5jb25zb2xlLnYxLlNlY3JldFRlbXBsYXRlIlEKG1VwZGF0ZVNlY3JldFRlbXBsYXRlUmVxdWVzdBIyCgh0ZW1wbGF0ZRgBIAEoCzIgLmhvbG9zLmNvbnNvbGUudjEuU2VjcmV0VGVtcGxhdGUiUgocVXBkYXRlU2VjcmV0VGVtcGxhdGVSZXNwb25zZRIyCgh0ZW1wbGF0ZRgBIAEoCzIgLmhvbG9zLmNvbnNvbGUudjEuU2VjcmV0VGVtcGxhdGUiUgobRGVsZXRlU2VjcmV0VGVtcGxhdGVSZXF1ZXN0EhQKDG9yZ2FuaXphdGlvbhgBIAEoCRIPCgdwcm9qZWN0GAIgASgJEgwKBG5hbWUYAyABKAkiHgocRGVsZXRlU2VjcmV0VGVtcGxhdGVSZXNwb25zZSKYAwofQ3JlYXRlU2VjcmV0RnJvbVRlbXBsYXRlUmVxdWVzdBIPCgdwcm9qZWN0GAEgASgJEhAKCHRlbXBsYXRlGAIgASgJEgwKBG5hbWUYAyABKAkSVgoLc3RyaW5nX2RhdGEYBCADKAsyQS5ob2xvcy5jb25zb2xlLnYxLkNyZWF0ZVNlY3JldEZyb21UZW1wbGF0ZVJlcXVlc3QuU3RyaW5nRGF0YUVudHJ5EhgKC2Rlc2NyaXB0aW9uGAUgASgJSACIAQESEAoDdXJsGAYgASgJSAGIAQESMQoLdXNlcl9ncmFudHMYByADKAsyHC5ob2xvcy5jb25zb2xlLnYxLlNoYXJlR3JhbnQSMQoLcm9sZV9ncmFudHMYCCADKAsyHC5ob2xvcy5jb25zb2xlLnYxLlNoYXJlR3JhbnQSDwoHZHJ5X3J1bhgJIAEoCBoxCg9TdHJpbmdEYXRhRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4AUIOCgxfZGVzY3JpcHRpb25CBgoEX3VybCLLAQogQ3JlYXRlU2VjcmV0RnJvbVRlbXBsYXRlUmVzcG9uc2USDAoEbmFtZRgBIAEoCRJhChBnZW5lcmF0ZWRfdmFsdWVzGAIgAygLMkcuaG9sb3MuY29uc29sZS52MS5DcmVhdGVTZWNyZXRGcm9tVGVtcGxhdGVSZXNwb25zZS5HZW5lcmF0ZWRWYWx1ZXNFbnRyeRo2ChRHZW5lcmF0ZWRWYWx1ZXNFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBMu0FChZTZWNyZXRUZW1wbGF0ZXNTZXJ2aWNlEncKE0xpc3RTZWNyZXRUZW1wbGF0ZXMSLC5ob2xvcy5jb25zb2xlLnYxLkxpc3RTZWNyZXRUZW1wbGF0ZXNSZXF1ZXN0Gi0uaG9sb3MuY29uc29sZS52MS5MaXN0U2VjcmV0VGVtcGxhdGVzUmVzcG9uc2UiA5ACARJxChFHZXRTZWNyZXRUZW1wbGF0ZRIqLmhvbG9zLmNvbnNvbGUudjEuR2V0U2VjcmV0VGVtcGxhdGVSZXF1ZXN0GisuaG9sb3MuY29uc29sZS52MS5HZXRTZWNyZXRUZW1wbGF0ZVJlc3BvbnNlIgOQAgESdQoUQ3JlYXRlU2VjcmV0VGVtcGxhdGUSLS5ob2xvcy5jb25zb2xlLnYxLkNyZWF0ZVNlY3JldFRlbXBsYXRlUmVxdWVzdBouLmhvbG9zLmNvbnNvbGUudjEuQ3JlYXRlU2VjcmV0VGVtcGxhdGVSZXNwb25zZRJ1ChRVcGRhdGVTZWNyZXRUZW1wbGF0ZRItLmhvbG9zLmNvbnNvbGUudjEuVXBkYXRlU2VjcmV0VGVtcGxhdGVSZXF1ZXN0Gi4uaG9sb3MuY29uc29sZS52MS5VcGRhdGVTZWNyZXRUZW1wbGF0ZVJlc3BvbnNlEnUKFERlbGV0ZVNlY3JldFRlbXBsYXRlEi0uaG9sb3MuY29uc29sZS52MS5EZWxldGVTZWNyZXRUZW1wbGF0ZVJlcXVlc3QaLi5ob2xvcy5jb25zb2xlLnYxLkRlbGV0ZVNlY3JldFRlbXBsYXRlUmVzcG9uc2USgQEKGENyZWF0ZVNlY3JldEZyb21UZW1wbGF0ZRIxLmhvbG9zLmNvbnNvbGUudjEuQ3JlYXRlU2VjcmV0RnJvbVRlbXBsYXRlUmVxdWVzdBoyLmhvbG9zLmNvbnNvbGUudjEuQ3JlYXRlU2VjcmV0RnJvbVRlbXBsYXRlUmVzcG9uc2VCQ1pBZ2l0aHViLmNvbS9ob2xvcy1ydW4vaG9sb3MtY29uc29sZS9nZW4vaG9sb3MvY29uc29sZS92MTtjb25zb2xldjFiBnByb3RvMw", [file_google_protobuf_timestamp, file_holos_console_v1_secrets]);
+
+/**
+ * Describes the message holos.console.v1.SecretTemplateKey.
+ * Use `create(SecretTemplateKeySchema)` to create a new message.
+ */
+export const SecretTemplateKeySchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_secret_templates, 0);
+
+/**
+ * Describes the message holos.console.v1.SecretTemplate.
+ * Use `create(SecretTemplateSchema)` to create a new message.
+ */
+export const SecretTemplateSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_secret_templates, 1);
+
+/**
+ * Describes the message holos.console.v1.ListSecretTemplatesRequest.
+ * Use `create(ListSecretTemplatesRequestSchema)` to create a new message.
+ */
+export const ListSecretTemplatesRequestSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_secret_templates, 2);
+
+/**
+ * Describes the message holos.console.v1.ListSecretTemplatesResponse.
+ * Use `create(ListSecretTemplatesResponseSchema)` to create a new message.
+ */
+export const ListSecretTemplatesResponseSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_secret_templates, 3);
+
+/**
+ * Describes the message holos.console.v1.GetSecretTemplateRequest.
+ * Use `create(GetSecretTemplateRequestSchema)` to create a new message.
+ */
+export const GetSecretTemplateRequestSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_secret_templates, 4);
+
+/**
+ * Describes the message holos.console.v1.GetSecretTemplateResponse.
+ * Use `create(GetSecretTemplateResponseSchema)` to create a new message.
+ */
+export const GetSecretTemplateResponseSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_secret_templates, 5);
+
+/**
+ * Describes the message holos.console.v1.CreateSecretTemplateRequest.
+ * Use `create(CreateSecretTemplateRequestSchema)` to create a new message.
+ */
+export const CreateSecretTemplateRequestSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_secret_templates, 6);
+
+/**
+ * Describes the message holos.console.v1.CreateSecretTemplateResponse.
+ * Use `create(CreateSecretTemplateResponseSchema)` to create a new message.
+ */
+export const CreateSecretTemplateResponseSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_secret_templates, 7);
+
+/**
+ * Describes the message holos.console.v1.UpdateSecretTemplateRequest.
+ * Use `create(UpdateSecretTemplateRequestSchema)` to create a new message.
+ */
+export const UpdateSecretTemplateRequestSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_secret_templates, 8);
+
+/**
+ * Describes the message holos.console.v1.UpdateSecretTemplateResponse.
+ * Use `create(UpdateSecretTemplateResponseSchema)` to create a new message.
+ */
+export const UpdateSecretTemplateResponseSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_secret_templates, 9);
+
+/**
+ * Describes the message holos.console.v1.DeleteSecretTemplateRequest.
+ * Use `create(DeleteSecretTemplateRequestSchema)` to create a new message.
+ */
+export const DeleteSecretTemplateRequestSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_secret_templates, 10);
+
+/**
+ * Describes the message holos.console.v1.DeleteSecretTemplateResponse.
+ * Use `create(DeleteSecretTemplateResponseSchema)` to create a new message.
+ */
+export const DeleteSecretTemplateResponseSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_secret_templates, 11);
+
+/**
+ * Describes the message holos.console.v1.CreateSecretFromTemplateRequest.
+ * Use `create(CreateSecretFromTemplateRequestSchema)` to create a new message.
+ */
+export const CreateSecretFromTemplateRequestSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_secret_templates, 12);
+
+/**
+ * Describes the message holos.console.v1.CreateSecretFromTemplateResponse.
+ * Use `create(CreateSecretFromTemplateResponseSchema)` to create a new message.
+ */
+export const CreateSecretFromTemplateResponseSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_secret_templates, 13);
+
+/**
+ * SecretTemplatesService manages secret templates: named blueprints that
+ * standardize the layout of a kind of credential, for example a "postgres"
+ * template with username, password and host keys. A template lists its keys
+ * with descriptions and generation rules, and the sharing grants every
+ * secret created from it starts with.
+ *
+ * Templates are defined at organization or project scope. A project sees
+ * its own templates and those of its organization; a project template
+ * shadows an organization template of the same name. Reading templates
+ * requires "get" on the scope namespace and managing them requires
+ * "update", checked with a SelfSubjectAccessReview.
+ *
+ * @generated from service holos.console.v1.SecretTemplatesService
+ */
+export const SecretTemplatesService = /*@__PURE__*/
+  serviceDesc(file_holos_console_v1_secret_templates, 0);
+
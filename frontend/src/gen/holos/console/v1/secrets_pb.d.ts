@@ -2,9 +2,12 @@
 // @generated from file holos/console/v1/secrets.proto (package holos.console.v1, syntax proto3)
 /* eslint-disable */
 
-import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
 import type { Message } from "@bufbuild/protobuf";
-import type { Role } from "./rbac_pb";
+import type { ListFilter, ListOrder } from "./list_filter_pb";
+import type { ChangeType } from "./diff_pb";
+import type { Role, RoleSource } from "./rbac_pb";
+import type { RawFormat } from "./raw_format_pb";
 
 /**
  * Describes the file holos/console/v1/secrets.proto.
@@ -30,6 +33,23 @@ export declare type GetSecretRequest = Message<"holos.console.v1.GetSecretReques
    * @generated from field: string project = 2;
    */
   project: string;
+
+  /**
+   * cluster names the registered cluster to act in. Empty targets the
+   * cluster the console runs in.
+   *
+   * @generated from field: string cluster = 3;
+   */
+  cluster: string;
+
+  /**
+   * if_none_match is the etag of a previous response. When the secret is
+   * unchanged the response carries only etag and not_modified. The
+   * If-None-Match header is ignored, since browsers send it on their own.
+   *
+   * @generated from field: string if_none_match = 4;
+   */
+  ifNoneMatch: string;
 };
 
 /**
@@ -51,6 +71,22 @@ export declare type GetSecretResponse = Message<"holos.console.v1.GetSecretRespo
    * @generated from field: map<string, bytes> data = 1;
    */
   data: { [key: string]: Uint8Array };
+
+  /**
+   * etag identifies this version of the response for if_none_match. It is
+   * derived from the secret's resource version, never its values, and is
+   * empty for secrets whose values live in an external backend.
+   *
+   * @generated from field: string etag = 2;
+   */
+  etag: string;
+
+  /**
+   * not_modified is true when if_none_match matched and data is omitted.
+   *
+   * @generated from field: bool not_modified = 3;
+   */
+  notModified: boolean;
 };
 
 /**
@@ -71,6 +107,37 @@ export declare type ListSecretsRequest = Message<"holos.console.v1.ListSecretsRe
    * @generated from field: string project = 1;
    */
   project: string;
+
+  /**
+   * cluster names the registered cluster to act in. Empty targets the
+   * cluster the console runs in.
+   *
+   * @generated from field: string cluster = 2;
+   */
+  cluster: string;
+
+  /**
+   * filter narrows the returned secrets. Labels are the Secret's labels.
+   *
+   * @generated from field: holos.console.v1.ListFilter filter = 3;
+   */
+  filter?: ListFilter;
+
+  /**
+   * order_by sorts the returned secrets.
+   *
+   * @generated from field: holos.console.v1.ListOrder order_by = 4;
+   */
+  orderBy?: ListOrder;
+
+  /**
+   * if_none_match is the etag of a previous response. When the listing is
+   * unchanged the response carries only etag and not_modified. The
+   * If-None-Match header is ignored, since browsers send it on their own.
+   *
+   * @generated from field: string if_none_match = 5;
+   */
+  ifNoneMatch: string;
 };
 
 /**
@@ -91,6 +158,23 @@ export declare type ListSecretsResponse = Message<"holos.console.v1.ListSecretsR
    * @generated from field: repeated holos.console.v1.SecretMetadata secrets = 1;
    */
   secrets: SecretMetadata[];
+
+  /**
+   * etag identifies this listing for if_none_match. It is derived from the
+   * caller, the request, the secrets' resource versions, and the project's
+   * sharing grants, and changes at least once a minute so other changes,
+   * such as a pending grant's user signing in, are picked up.
+   *
+   * @generated from field: string etag = 2;
+   */
+  etag: string;
+
+  /**
+   * not_modified is true when if_none_match matched and secrets is omitted.
+   *
+   * @generated from field: bool not_modified = 3;
+   */
+  notModified: boolean;
 };
 
 /**
@@ -152,6 +236,31 @@ export declare type UpdateSecretRequest = Message<"holos.console.v1.UpdateSecret
    * @generated from field: string project = 6;
    */
   project: string;
+
+  /**
+   * dry_run runs validation and authorization, and submits every Kubernetes
+   * write with server-side dry-run, without persisting any change.
+   *
+   * @generated from field: bool dry_run = 7;
+   */
+  dryRun: boolean;
+
+  /**
+   * cluster names the registered cluster to act in. Empty targets the
+   * cluster the console runs in.
+   *
+   * @generated from field: string cluster = 8;
+   */
+  cluster: string;
+
+  /**
+   * content_types replaces the media types recorded for the secret's keys.
+   * When empty, the recorded types of keys that remain in data are kept.
+   * Every key must be set by data or string_data.
+   *
+   * @generated from field: map<string, string> content_types = 9;
+   */
+  contentTypes: { [key: string]: string };
 };
 
 /**
@@ -175,106 +284,110 @@ export declare type UpdateSecretResponse = Message<"holos.console.v1.UpdateSecre
 export declare const UpdateSecretResponseSchema: GenMessage<UpdateSecretResponse>;
 
 /**
- * CreateSecretRequest contains the new secret's name, data, and sharing grants.
+ * PatchSecretRequest names the keys to set and remove on an existing secret.
  *
- * @generated from message holos.console.v1.CreateSecretRequest
+ * @generated from message holos.console.v1.PatchSecretRequest
  */
-export declare type CreateSecretRequest = Message<"holos.console.v1.CreateSecretRequest"> & {
+export declare type PatchSecretRequest = Message<"holos.console.v1.PatchSecretRequest"> & {
   /**
-   * name is the name of the secret to create.
+   * name is the name of the secret to patch.
    *
    * @generated from field: string name = 1;
    */
   name: string;
 
   /**
-   * data contains the secret key-value pairs.
-   * Values are the raw secret bytes (not base64 encoded).
+   * project is the project (namespace) containing the secret.
    *
-   * @generated from field: map<string, bytes> data = 2;
+   * @generated from field: string project = 2;
    */
-  data: { [key: string]: Uint8Array };
+  project: string;
 
   /**
-   * string_data contains plaintext string values that are merged into data.
-   * Values are encoded to bytes before storage, matching Kubernetes stringData
-   * semantics. When both data and string_data contain the same key, string_data
-   * takes precedence.
+   * data contains keys to add or replace.
+   * Values are the raw secret bytes (not base64 encoded).
    *
-   * @generated from field: map<string, string> string_data = 3;
+   * @generated from field: map<string, bytes> data = 3;
    */
-  stringData: { [key: string]: string };
+  data: { [key: string]: Uint8Array };
 
   /**
-   * user_grants are the per-user sharing grants to set on the created secret.
+   * string_data contains plaintext string values to add or replace. When both
+   * data and string_data contain the same key, string_data takes precedence.
    *
-   * @generated from field: repeated holos.console.v1.ShareGrant user_grants = 4;
+   * @generated from field: map<string, string> string_data = 4;
    */
-  userGrants: ShareGrant[];
+  stringData: { [key: string]: string };
 
   /**
-   * role_grants are the per-role sharing grants to set on the created secret.
+   * remove_keys lists keys to delete from the secret. A key may not appear in
+   * both remove_keys and data or string_data. Removing a key that does not
+   * exist is not an error.
    *
-   * @generated from field: repeated holos.console.v1.ShareGrant role_grants = 5;
+   * @generated from field: repeated string remove_keys = 5;
    */
-  roleGrants: ShareGrant[];
+  removeKeys: string[];
 
   /**
-   * description is a human-readable description of the secret's purpose.
+   * dry_run runs validation and authorization, and submits every Kubernetes
+   * write with server-side dry-run, without persisting any change.
    *
-   * @generated from field: optional string description = 6;
+   * @generated from field: bool dry_run = 6;
    */
-  description?: string;
+  dryRun: boolean;
 
   /**
-   * url is a URL associated with the secret (e.g. link to the service that uses it).
+   * cluster names the registered cluster to act in. Empty targets the
+   * cluster the console runs in.
    *
-   * @generated from field: optional string url = 7;
+   * @generated from field: string cluster = 7;
    */
-  url?: string;
+  cluster: string;
 
   /**
-   * project is the project (namespace) containing the secret.
+   * content_types sets the media types of keys in data or string_data. The
+   * recorded type of a key that is set without one is kept, and the type of
+   * a removed key is dropped.
    *
-   * @generated from field: string project = 8;
+   * @generated from field: map<string, string> content_types = 8;
    */
-  project: string;
+  contentTypes: { [key: string]: string };
 };
 
 /**
- * Describes the message holos.console.v1.CreateSecretRequest.
- * Use `create(CreateSecretRequestSchema)` to create a new message.
+ * Describes the message holos.console.v1.PatchSecretRequest.
+ * Use `create(PatchSecretRequestSchema)` to create a new message.
  */
-export declare const CreateSecretRequestSchema: GenMessage<CreateSecretRequest>;
+export declare const PatchSecretRequestSchema: GenMessage<PatchSecretRequest>;
 
 /**
- * CreateSecretResponse contains the name of the created secret.
+ * PatchSecretResponse lists the keys present on the secret after the patch.
  *
- * @generated from message holos.console.v1.CreateSecretResponse
+ * @generated from message holos.console.v1.PatchSecretResponse
  */
-export declare type CreateSecretResponse = Message<"holos.console.v1.CreateSecretResponse"> & {
+export declare type PatchSecretResponse = Message<"holos.console.v1.PatchSecretResponse"> & {
   /**
-   * name is the name of the created secret.
+   * keys are the secret's data keys after the patch, sorted.
    *
-   * @generated from field: string name = 1;
+   * @generated from field: repeated string keys = 1;
    */
-  name: string;
+  keys: string[];
 };
 
 /**
- * Describes the message holos.console.v1.CreateSecretResponse.
- * Use `create(CreateSecretResponseSchema)` to create a new message.
+ * Describes the message holos.console.v1.PatchSecretResponse.
+ * Use `create(PatchSecretResponseSchema)` to create a new message.
  */
-export declare const CreateSecretResponseSchema: GenMessage<CreateSecretResponse>;
+export declare const PatchSecretResponseSchema: GenMessage<PatchSecretResponse>;
 
 /**
- * DeleteSecretRequest contains the name of the secret to delete.
+ * AppendSecretKeyRequest carries one chunk of a key's value.
  *
- * @generated from message holos.console.v1.DeleteSecretRequest
+ * @generated from message holos.console.v1.AppendSecretKeyRequest
  */
-export declare type DeleteSecretRequest = Message<"holos.console.v1.DeleteSecretRequest"> & {
+export declare type AppendSecretKeyRequest = Message<"holos.console.v1.AppendSecretKeyRequest"> & {
   /**
-   * name is the name of the secret to delete.
+   * name is the name of the secret to upload into.
    *
    * @generated from field: string name = 1;
    */
@@ -286,203 +399,2117 @@ export declare type DeleteSecretRequest = Message<"holos.console.v1.DeleteSecret
    * @generated from field: string project = 2;
    */
   project: string;
+
+  /**
+   * key is the data key whose value is uploaded.
+   *
+   * @generated from field: string key = 3;
+   */
+  key: string;
+
+  /**
+   * offset is the position of chunk within the value. It is 0 for the first
+   * chunk and the size of the stored value for every later one.
+   *
+   * @generated from field: int64 offset = 4;
+   */
+  offset: bigint;
+
+  /**
+   * total_size is the size of the complete value, the same in every chunk.
+   *
+   * @generated from field: int64 total_size = 5;
+   */
+  totalSize: bigint;
+
+  /**
+   * chunk is the next part of the raw value (not base64 encoded).
+   *
+   * @generated from field: bytes chunk = 6;
+   */
+  chunk: Uint8Array;
+
+  /**
+   * content_type sets the media type of the key. It is read from the first
+   * chunk only; when empty the recorded type of the key is kept.
+   *
+   * @generated from field: string content_type = 7;
+   */
+  contentType: string;
+
+  /**
+   * cluster names the registered cluster to act in. Empty targets the
+   * cluster the console runs in.
+   *
+   * @generated from field: string cluster = 8;
+   */
+  cluster: string;
 };
 
 /**
- * Describes the message holos.console.v1.DeleteSecretRequest.
- * Use `create(DeleteSecretRequestSchema)` to create a new message.
+ * Describes the message holos.console.v1.AppendSecretKeyRequest.
+ * Use `create(AppendSecretKeyRequestSchema)` to create a new message.
  */
-export declare const DeleteSecretRequestSchema: GenMessage<DeleteSecretRequest>;
+export declare const AppendSecretKeyRequestSchema: GenMessage<AppendSecretKeyRequest>;
 
 /**
- * DeleteSecretResponse is empty on success.
+ * AppendSecretKeyResponse reports the upload's progress.
  *
- * @generated from message holos.console.v1.DeleteSecretResponse
+ * @generated from message holos.console.v1.AppendSecretKeyResponse
  */
-export declare type DeleteSecretResponse = Message<"holos.console.v1.DeleteSecretResponse"> & {
+export declare type AppendSecretKeyResponse = Message<"holos.console.v1.AppendSecretKeyResponse"> & {
+  /**
+   * size is the number of bytes of the value stored so far.
+   *
+   * @generated from field: int64 size = 1;
+   */
+  size: bigint;
+
+  /**
+   * complete is true once size reaches total_size.
+   *
+   * @generated from field: bool complete = 2;
+   */
+  complete: boolean;
 };
 
 /**
- * Describes the message holos.console.v1.DeleteSecretResponse.
- * Use `create(DeleteSecretResponseSchema)` to create a new message.
+ * Describes the message holos.console.v1.AppendSecretKeyResponse.
+ * Use `create(AppendSecretKeyResponseSchema)` to create a new message.
  */
-export declare const DeleteSecretResponseSchema: GenMessage<DeleteSecretResponse>;
+export declare const AppendSecretKeyResponseSchema: GenMessage<AppendSecretKeyResponse>;
 
 /**
- * SecretMetadata contains non-sensitive information about a secret.
+ * CreateSecretRequest contains the new secret's name, data, and sharing grants.
  *
- * @generated from message holos.console.v1.SecretMetadata
+ * @generated from message holos.console.v1.CreateSecretRequest
  */
-export declare type SecretMetadata = Message<"holos.console.v1.SecretMetadata"> & {
+export declare type CreateSecretRequest = Message<"holos.console.v1.CreateSecretRequest"> & {
   /**
-   * name is the name of the secret.
+   * name is the name of the secret to create.
    *
    * @generated from field: string name = 1;
    */
   name: string;
 
   /**
-   * accessible indicates whether the current user has permission to read this secret.
+   * data contains the secret key-value pairs.
+   * Values are the raw secret bytes (not base64 encoded).
    *
-   * @generated from field: bool accessible = 2;
+   * @generated from field: map<string, bytes> data = 2;
    */
-  accessible: boolean;
+  data: { [key: string]: Uint8Array };
 
   /**
-   * user_grants contains per-user sharing grants on this secret.
+   * string_data contains plaintext string values that are merged into data.
+   * Values are encoded to bytes before storage, matching Kubernetes stringData
+   * semantics. When both data and string_data contain the same key, string_data
+   * takes precedence.
    *
-   * @generated from field: repeated holos.console.v1.ShareGrant user_grants = 5;
+   * @generated from field: map<string, string> string_data = 3;
+   */
+  stringData: { [key: string]: string };
+
+  /**
+   * user_grants are the per-user sharing grants to set on the created secret.
+   *
+   * @generated from field: repeated holos.console.v1.ShareGrant user_grants = 4;
    */
   userGrants: ShareGrant[];
 
   /**
-   * role_grants contains per-role sharing grants on this secret.
+   * role_grants are the per-role sharing grants to set on the created secret.
    *
-   * @generated from field: repeated holos.console.v1.ShareGrant role_grants = 6;
+   * @generated from field: repeated holos.console.v1.ShareGrant role_grants = 5;
    */
   roleGrants: ShareGrant[];
 
   /**
    * description is a human-readable description of the secret's purpose.
    *
-   * @generated from field: optional string description = 7;
+   * @generated from field: optional string description = 6;
    */
   description?: string;
 
   /**
    * url is a URL associated with the secret (e.g. link to the service that uses it).
    *
-   * @generated from field: optional string url = 8;
+   * @generated from field: optional string url = 7;
    */
   url?: string;
 
   /**
-   * created_at is the RFC3339-formatted timestamp when the underlying Kubernetes
-   * Secret was created, sourced from metadata.creationTimestamp.
+   * project is the project (namespace) containing the secret.
    *
-   * @generated from field: string created_at = 9;
+   * @generated from field: string project = 8;
    */
-  createdAt: string;
-};
+  project: string;
 
-/**
- * Describes the message holos.console.v1.SecretMetadata.
- * Use `create(SecretMetadataSchema)` to create a new message.
- */
-export declare const SecretMetadataSchema: GenMessage<SecretMetadata>;
+  /**
+   * dry_run runs validation and authorization, and submits every Kubernetes
+   * write with server-side dry-run, without persisting any change.
+   *
+   * @generated from field: bool dry_run = 9;
+   */
+  dryRun: boolean;
 
-/**
- * ShareGrant represents a sharing grant for a principal (user email or role name).
- *
- * @generated from message holos.console.v1.ShareGrant
- */
-export declare type ShareGrant = Message<"holos.console.v1.ShareGrant"> & {
   /**
-   * principal is the email address (for users) or role name (for roles).
+   * generate maps secret keys to random values the server generates and
+   * stores. A key may not appear in both generate and data or string_data.
    *
-   * @generated from field: string principal = 1;
+   * @generated from field: map<string, holos.console.v1.GenerateSpec> generate = 10;
    */
-  principal: string;
+  generate: { [key: string]: GenerateSpec };
 
   /**
-   * role is the permission level granted to the principal.
+   * cluster names the registered cluster to act in. Empty targets the
+   * cluster the console runs in.
    *
-   * @generated from field: holos.console.v1.Role role = 2;
+   * @generated from field: string cluster = 11;
    */
-  role: Role;
+  cluster: string;
 
   /**
-   * nbf (not before) is the unix timestamp before which the grant is inactive.
-   * When unset, the grant has no start time restriction.
+   * content_types maps data keys to the media type of their value, e.g.
+   * "application/x-pkcs12" for an uploaded keystore. The secret key
+   * download endpoint serves a key with its recorded type. Every key must
+   * be set by data, string_data or generate.
    *
-   * @generated from field: optional int64 nbf = 3;
+   * @generated from field: map<string, string> content_types = 12;
    */
-  nbf?: bigint;
+  contentTypes: { [key: string]: string };
 
   /**
-   * exp (expiration) is the unix timestamp at or after which the grant is inactive.
-   * When unset, the grant has no expiration.
+   * type is the Kubernetes secret type. Empty or "Opaque" creates a generic
+   * secret. "kubernetes.io/tls" requires tls.crt and tls.key holding a PEM
+   * certificate chain and its matching private key.
+   * "kubernetes.io/dockerconfigjson" requires docker_registry or a
+   * .dockerconfigjson key.
+   * "kubernetes.io/ssh-auth" requires an ssh-privatekey key holding a PEM or
+   * OpenSSH private key.
    *
-   * @generated from field: optional int64 exp = 4;
+   * @generated from field: string type = 13;
    */
-  exp?: bigint;
+  type: string;
+
+  /**
+   * docker_registry generates the .dockerconfigjson key of a
+   * kubernetes.io/dockerconfigjson image pull secret from registry
+   * credentials. type defaults to "kubernetes.io/dockerconfigjson" when set,
+   * and the request may not also supply .dockerconfigjson.
+   *
+   * @generated from field: holos.console.v1.RegistryCredentials docker_registry = 14;
+   */
+  dockerRegistry?: RegistryCredentials;
 };
 
 /**
- * Describes the message holos.console.v1.ShareGrant.
- * Use `create(ShareGrantSchema)` to create a new message.
+ * Describes the message holos.console.v1.CreateSecretRequest.
+ * Use `create(CreateSecretRequestSchema)` to create a new message.
  */
-export declare const ShareGrantSchema: GenMessage<ShareGrant>;
+export declare const CreateSecretRequestSchema: GenMessage<CreateSecretRequest>;
 
 /**
- * UpdateSharingRequest contains the sharing grants to set on a secret.
+ * RegistryCredentials are the credentials for one container registry.
  *
- * @generated from message holos.console.v1.UpdateSharingRequest
+ * @generated from message holos.console.v1.RegistryCredentials
  */
-export declare type UpdateSharingRequest = Message<"holos.console.v1.UpdateSharingRequest"> & {
+export declare type RegistryCredentials = Message<"holos.console.v1.RegistryCredentials"> & {
   /**
-   * name is the name of the secret to update sharing for.
+   * server is the registry host, e.g. "ghcr.io", or the Docker Hub URL
+   * "https://index.docker.io/v1/".
    *
-   * @generated from field: string name = 1;
+   * @generated from field: string server = 1;
    */
-  name: string;
+  server: string;
 
   /**
-   * user_grants are the per-user sharing grants to set.
+   * username is the registry user name.
    *
-   * @generated from field: repeated holos.console.v1.ShareGrant user_grants = 2;
+   * @generated from field: string username = 2;
    */
-  userGrants: ShareGrant[];
+  username: string;
 
   /**
-   * role_grants are the per-role sharing grants to set.
+   * password is the registry password or access token.
    *
-   * @generated from field: repeated holos.console.v1.ShareGrant role_grants = 3;
+   * @generated from field: string password = 3;
    */
-  roleGrants: ShareGrant[];
+  password: string;
 
   /**
-   * project is the project (namespace) containing the secret.
+   * email is the optional email address recorded with the credentials.
    *
-   * @generated from field: string project = 4;
+   * @generated from field: string email = 4;
    */
-  project: string;
+  email: string;
 };
 
 /**
- * Describes the message holos.console.v1.UpdateSharingRequest.
- * Use `create(UpdateSharingRequestSchema)` to create a new message.
+ * Describes the message holos.console.v1.RegistryCredentials.
+ * Use `create(RegistryCredentialsSchema)` to create a new message.
  */
-export declare const UpdateSharingRequestSchema: GenMessage<UpdateSharingRequest>;
+export declare const RegistryCredentialsSchema: GenMessage<RegistryCredentials>;
 
 /**
- * UpdateSharingResponse contains the updated secret metadata.
+ * GenerateSpec describes a random value generated server-side for a secret key.
  *
- * @generated from message holos.console.v1.UpdateSharingResponse
+ * @generated from message holos.console.v1.GenerateSpec
  */
-export declare type UpdateSharingResponse = Message<"holos.console.v1.UpdateSharingResponse"> & {
+export declare type GenerateSpec = Message<"holos.console.v1.GenerateSpec"> & {
   /**
-   * metadata contains the updated secret metadata including new sharing grants.
+   * format selects the encoding of the generated value.
    *
-   * @generated from field: holos.console.v1.SecretMetadata metadata = 1;
+   * @generated from field: holos.console.v1.GenerateFormat format = 1;
    */
-  metadata?: SecretMetadata;
-};
+  format: GenerateFormat;
 
-/**
- * Describes the message holos.console.v1.UpdateSharingResponse.
- * Use `create(UpdateSharingResponseSchema)` to create a new message.
- */
-export declare const UpdateSharingResponseSchema: GenMessage<UpdateSharingResponse>;
+  /**
+   * length is the number of characters for passwords and the number of random
+   * bytes for hex and base64. Zero uses 32. The maximum is 4096.
+   *
+   * @generated from field: int32 length = 2;
+   */
+  length: number;
+
+  /**
+   * charset is the set of characters passwords are drawn from. Empty uses
+   * ASCII letters, digits, and the symbols !#$%&*+-=?@^_.
+   *
+   * @generated from field: string charset = 3;
+   */
+  charset: string;
+};
+
+/**
+ * Describes the message holos.console.v1.GenerateSpec.
+ * Use `create(GenerateSpecSchema)` to create a new message.
+ */
+export declare const GenerateSpecSchema: GenMessage<GenerateSpec>;
+
+/**
+ * CreateSecretResponse contains the name of the created secret.
+ *
+ * @generated from message holos.console.v1.CreateSecretResponse
+ */
+export declare type CreateSecretResponse = Message<"holos.console.v1.CreateSecretResponse"> & {
+  /**
+   * name is the name of the created secret.
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * generated_values holds the values generated for the keys in
+   * CreateSecretRequest.generate. They are returned only once, in this
+   * response, so callers can copy them.
+   *
+   * @generated from field: map<string, string> generated_values = 2;
+   */
+  generatedValues: { [key: string]: string };
+};
+
+/**
+ * Describes the message holos.console.v1.CreateSecretResponse.
+ * Use `create(CreateSecretResponseSchema)` to create a new message.
+ */
+export declare const CreateSecretResponseSchema: GenMessage<CreateSecretResponse>;
+
+/**
+ * CreateSSHKeySecretRequest names the SSH key secret to generate.
+ *
+ * @generated from message holos.console.v1.CreateSSHKeySecretRequest
+ */
+export declare type CreateSSHKeySecretRequest = Message<"holos.console.v1.CreateSSHKeySecretRequest"> & {
+  /**
+   * name is the name of the secret to create.
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * project is the project (namespace) to create the secret in.
+   *
+   * @generated from field: string project = 2;
+   */
+  project: string;
+
+  /**
+   * comment is appended to the public key, e.g. "deploy@example.com".
+   *
+   * @generated from field: string comment = 3;
+   */
+  comment: string;
+
+  /**
+   * user_grants are the per-user sharing grants to set on the created secret.
+   *
+   * @generated from field: repeated holos.console.v1.ShareGrant user_grants = 4;
+   */
+  userGrants: ShareGrant[];
+
+  /**
+   * role_grants are the per-role sharing grants to set on the created secret.
+   *
+   * @generated from field: repeated holos.console.v1.ShareGrant role_grants = 5;
+   */
+  roleGrants: ShareGrant[];
+
+  /**
+   * description is a human-readable description of the secret's purpose.
+   *
+   * @generated from field: optional string description = 6;
+   */
+  description?: string;
+
+  /**
+   * url is a URL associated with the secret.
+   *
+   * @generated from field: optional string url = 7;
+   */
+  url?: string;
+
+  /**
+   * dry_run runs validation and authorization without persisting the secret.
+   *
+   * @generated from field: bool dry_run = 8;
+   */
+  dryRun: boolean;
+
+  /**
+   * cluster names the registered cluster to act in. Empty targets the
+   * cluster the console runs in.
+   *
+   * @generated from field: string cluster = 9;
+   */
+  cluster: string;
+};
+
+/**
+ * Describes the message holos.console.v1.CreateSSHKeySecretRequest.
+ * Use `create(CreateSSHKeySecretRequestSchema)` to create a new message.
+ */
+export declare const CreateSSHKeySecretRequestSchema: GenMessage<CreateSSHKeySecretRequest>;
+
+/**
+ * CreateSSHKeySecretResponse carries the public half of the generated key.
+ *
+ * @generated from message holos.console.v1.CreateSSHKeySecretResponse
+ */
+export declare type CreateSSHKeySecretResponse = Message<"holos.console.v1.CreateSSHKeySecretResponse"> & {
+  /**
+   * name is the name of the created secret.
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * public_key is the public key in authorized_keys format.
+   *
+   * @generated from field: string public_key = 2;
+   */
+  publicKey: string;
+
+  /**
+   * fingerprint is the SHA256 fingerprint of the public key.
+   *
+   * @generated from field: string fingerprint = 3;
+   */
+  fingerprint: string;
+};
+
+/**
+ * Describes the message holos.console.v1.CreateSSHKeySecretResponse.
+ * Use `create(CreateSSHKeySecretResponseSchema)` to create a new message.
+ */
+export declare const CreateSSHKeySecretResponseSchema: GenMessage<CreateSSHKeySecretResponse>;
+
+/**
+ * ExportSecretSealedRequest names the secret to export.
+ *
+ * @generated from message holos.console.v1.ExportSecretSealedRequest
+ */
+export declare type ExportSecretSealedRequest = Message<"holos.console.v1.ExportSecretSealedRequest"> & {
+  /**
+   * name is the name of the secret.
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * project is the project (namespace) containing the secret.
+   *
+   * @generated from field: string project = 2;
+   */
+  project: string;
+
+  /**
+   * cluster names the registered cluster to act in. Empty targets the
+   * cluster the console runs in.
+   *
+   * @generated from field: string cluster = 3;
+   */
+  cluster: string;
+};
+
+/**
+ * Describes the message holos.console.v1.ExportSecretSealedRequest.
+ * Use `create(ExportSecretSealedRequestSchema)` to create a new message.
+ */
+export declare const ExportSecretSealedRequestSchema: GenMessage<ExportSecretSealedRequest>;
+
+/**
+ * ExportSecretSealedResponse carries the sealed manifest.
+ *
+ * @generated from message holos.console.v1.ExportSecretSealedResponse
+ */
+export declare type ExportSecretSealedResponse = Message<"holos.console.v1.ExportSecretSealedResponse"> & {
+  /**
+   * manifest is the SealedSecret as YAML.
+   *
+   * @generated from field: string manifest = 1;
+   */
+  manifest: string;
+
+  /**
+   * certificate_fingerprint is the SHA-256 fingerprint of the sealing
+   * certificate, hex encoded, so callers can check which controller key
+   * the manifest was sealed to.
+   *
+   * @generated from field: string certificate_fingerprint = 2;
+   */
+  certificateFingerprint: string;
+};
+
+/**
+ * Describes the message holos.console.v1.ExportSecretSealedResponse.
+ * Use `create(ExportSecretSealedResponseSchema)` to create a new message.
+ */
+export declare const ExportSecretSealedResponseSchema: GenMessage<ExportSecretSealedResponse>;
+
+/**
+ * CreateShareLinkRequest identifies the secret to share and for how long.
+ *
+ * @generated from message holos.console.v1.CreateShareLinkRequest
+ */
+export declare type CreateShareLinkRequest = Message<"holos.console.v1.CreateShareLinkRequest"> & {
+  /**
+   * name is the name of the secret.
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * project is the project (namespace) containing the secret.
+   *
+   * @generated from field: string project = 2;
+   */
+  project: string;
+
+  /**
+   * ttl_seconds is how long the link is valid. Defaults to one day when
+   * unset; at most seven days.
+   *
+   * @generated from field: int64 ttl_seconds = 3;
+   */
+  ttlSeconds: bigint;
+
+  /**
+   * keys restricts the link to the listed data keys. Every key must exist
+   * on the secret. When empty the link reads every key.
+   *
+   * @generated from field: repeated string keys = 4;
+   */
+  keys: string[];
+};
+
+/**
+ * Describes the message holos.console.v1.CreateShareLinkRequest.
+ * Use `create(CreateShareLinkRequestSchema)` to create a new message.
+ */
+export declare const CreateShareLinkRequestSchema: GenMessage<CreateShareLinkRequest>;
+
+/**
+ * CreateShareLinkResponse carries the share link.
+ *
+ * @generated from message holos.console.v1.CreateShareLinkResponse
+ */
+export declare type CreateShareLinkResponse = Message<"holos.console.v1.CreateShareLinkResponse"> & {
+  /**
+   * url is the share link. It embeds the capability token, so treat it as
+   * a secret.
+   *
+   * @generated from field: string url = 1;
+   */
+  url: string;
+
+  /**
+   * expires_at is the RFC3339-formatted time the link stops working.
+   *
+   * @generated from field: string expires_at = 2;
+   */
+  expiresAt: string;
+};
+
+/**
+ * Describes the message holos.console.v1.CreateShareLinkResponse.
+ * Use `create(CreateShareLinkResponseSchema)` to create a new message.
+ */
+export declare const CreateShareLinkResponseSchema: GenMessage<CreateShareLinkResponse>;
+
+/**
+ * ApplySecretRawRequest carries the Secret manifest to apply.
+ *
+ * @generated from message holos.console.v1.ApplySecretRawRequest
+ */
+export declare type ApplySecretRawRequest = Message<"holos.console.v1.ApplySecretRawRequest"> & {
+  /**
+   * project is the project (namespace) the secret belongs to. A namespace
+   * in the manifest must be the project's namespace.
+   *
+   * @generated from field: string project = 1;
+   */
+  project: string;
+
+  /**
+   * manifest is a single v1 Secret in JSON or YAML.
+   *
+   * @generated from field: string manifest = 2;
+   */
+  manifest: string;
+
+  /**
+   * dry_run runs validation and authorization, and submits the apply with
+   * server-side dry-run, without persisting any change.
+   *
+   * @generated from field: bool dry_run = 3;
+   */
+  dryRun: boolean;
+};
+
+/**
+ * Describes the message holos.console.v1.ApplySecretRawRequest.
+ * Use `create(ApplySecretRawRequestSchema)` to create a new message.
+ */
+export declare const ApplySecretRawRequestSchema: GenMessage<ApplySecretRawRequest>;
+
+/**
+ * ApplySecretRawResponse reports the applied secret.
+ *
+ * @generated from message holos.console.v1.ApplySecretRawResponse
+ */
+export declare type ApplySecretRawResponse = Message<"holos.console.v1.ApplySecretRawResponse"> & {
+  /**
+   * name is the name of the applied secret.
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * created is true when the apply created the secret.
+   *
+   * @generated from field: bool created = 2;
+   */
+  created: boolean;
+};
+
+/**
+ * Describes the message holos.console.v1.ApplySecretRawResponse.
+ * Use `create(ApplySecretRawResponseSchema)` to create a new message.
+ */
+export declare const ApplySecretRawResponseSchema: GenMessage<ApplySecretRawResponse>;
+
+/**
+ * DiffSecretRequest carries the data an UpdateSecret would write.
+ *
+ * @generated from message holos.console.v1.DiffSecretRequest
+ */
+export declare type DiffSecretRequest = Message<"holos.console.v1.DiffSecretRequest"> & {
+  /**
+   * name is the name of the secret to compare.
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * project is the project (namespace) the secret belongs to.
+   *
+   * @generated from field: string project = 2;
+   */
+  project: string;
+
+  /**
+   * proposed_data is the complete data UpdateSecret would write, as in
+   * UpdateSecretRequest.data.
+   *
+   * @generated from field: map<string, bytes> proposed_data = 3;
+   */
+  proposedData: { [key: string]: Uint8Array };
+
+  /**
+   * proposed_string_data is merged into proposed_data, taking precedence,
+   * as in UpdateSecretRequest.string_data.
+   *
+   * @generated from field: map<string, string> proposed_string_data = 4;
+   */
+  proposedStringData: { [key: string]: string };
+};
+
+/**
+ * Describes the message holos.console.v1.DiffSecretRequest.
+ * Use `create(DiffSecretRequestSchema)` to create a new message.
+ */
+export declare const DiffSecretRequestSchema: GenMessage<DiffSecretRequest>;
+
+/**
+ * SecretKeyChange describes how an update would change one data key.
+ *
+ * @generated from message holos.console.v1.SecretKeyChange
+ */
+export declare type SecretKeyChange = Message<"holos.console.v1.SecretKeyChange"> & {
+  /**
+   * key is the data key.
+   *
+   * @generated from field: string key = 1;
+   */
+  key: string;
+
+  /**
+   * change classifies the change.
+   *
+   * @generated from field: holos.console.v1.ChangeType change = 2;
+   */
+  change: ChangeType;
+
+  /**
+   * old_size is the current value's length in bytes, 0 when change is ADDED.
+   *
+   * @generated from field: int64 old_size = 3;
+   */
+  oldSize: bigint;
+
+  /**
+   * new_size is the proposed value's length in bytes, 0 when change is
+   * REMOVED.
+   *
+   * @generated from field: int64 new_size = 4;
+   */
+  newSize: bigint;
+};
+
+/**
+ * Describes the message holos.console.v1.SecretKeyChange.
+ * Use `create(SecretKeyChangeSchema)` to create a new message.
+ */
+export declare const SecretKeyChangeSchema: GenMessage<SecretKeyChange>;
+
+/**
+ * DiffSecretResponse is the redacted diff of a proposed update.
+ *
+ * @generated from message holos.console.v1.DiffSecretResponse
+ */
+export declare type DiffSecretResponse = Message<"holos.console.v1.DiffSecretResponse"> & {
+  /**
+   * changes lists the changed keys in key order. It is empty when the
+   * update would not change the data.
+   *
+   * @generated from field: repeated holos.console.v1.SecretKeyChange changes = 1;
+   */
+  changes: SecretKeyChange[];
+
+  /**
+   * unchanged_keys is the number of keys the update keeps as they are.
+   *
+   * @generated from field: int32 unchanged_keys = 2;
+   */
+  unchangedKeys: number;
+};
+
+/**
+ * Describes the message holos.console.v1.DiffSecretResponse.
+ * Use `create(DiffSecretResponseSchema)` to create a new message.
+ */
+export declare const DiffSecretResponseSchema: GenMessage<DiffSecretResponse>;
+
+/**
+ * DeleteSecretRequest contains the name of the secret to delete.
+ *
+ * @generated from message holos.console.v1.DeleteSecretRequest
+ */
+export declare type DeleteSecretRequest = Message<"holos.console.v1.DeleteSecretRequest"> & {
+  /**
+   * name is the name of the secret to delete.
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * project is the project (namespace) containing the secret.
+   *
+   * @generated from field: string project = 2;
+   */
+  project: string;
+
+  /**
+   * dry_run runs validation and authorization, and submits every Kubernetes
+   * write with server-side dry-run, without persisting any change.
+   *
+   * @generated from field: bool dry_run = 3;
+   */
+  dryRun: boolean;
+
+  /**
+   * cluster names the registered cluster to act in. Empty targets the
+   * cluster the console runs in.
+   *
+   * @generated from field: string cluster = 4;
+   */
+  cluster: string;
+
+  /**
+   * force deletes the secret even when workloads in the project reference
+   * it. Without force, DeleteSecret fails with FailedPrecondition and a
+   * SecretInUse error detail listing the references, or with
+   * FailedPrecondition when the caller may not list a workload kind and the
+   * secret cannot be shown to be unused.
+   *
+   * @generated from field: bool force = 5;
+   */
+  force: boolean;
+};
+
+/**
+ * Describes the message holos.console.v1.DeleteSecretRequest.
+ * Use `create(DeleteSecretRequestSchema)` to create a new message.
+ */
+export declare const DeleteSecretRequestSchema: GenMessage<DeleteSecretRequest>;
+
+/**
+ * DeleteSecretResponse is empty on success.
+ *
+ * @generated from message holos.console.v1.DeleteSecretResponse
+ */
+export declare type DeleteSecretResponse = Message<"holos.console.v1.DeleteSecretResponse"> & {
+};
+
+/**
+ * Describes the message holos.console.v1.DeleteSecretResponse.
+ * Use `create(DeleteSecretResponseSchema)` to create a new message.
+ */
+export declare const DeleteSecretResponseSchema: GenMessage<DeleteSecretResponse>;
+
+/**
+ * BatchItemError is why one item of a batch failed.
+ *
+ * @generated from message holos.console.v1.BatchItemError
+ */
+export declare type BatchItemError = Message<"holos.console.v1.BatchItemError"> & {
+  /**
+   * code is the ConnectRPC error code the single-item RPC would have
+   * returned, e.g. "permission_denied" or "not_found".
+   *
+   * @generated from field: string code = 1;
+   */
+  code: string;
+
+  /**
+   * message describes the failure.
+   *
+   * @generated from field: string message = 2;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message holos.console.v1.BatchItemError.
+ * Use `create(BatchItemErrorSchema)` to create a new message.
+ */
+export declare const BatchItemErrorSchema: GenMessage<BatchItemError>;
+
+/**
+ * BatchGetSecretsRequest names the secrets to retrieve.
+ *
+ * @generated from message holos.console.v1.BatchGetSecretsRequest
+ */
+export declare type BatchGetSecretsRequest = Message<"holos.console.v1.BatchGetSecretsRequest"> & {
+  /**
+   * names are the secrets to retrieve, at most 100.
+   *
+   * @generated from field: repeated string names = 1;
+   */
+  names: string[];
+
+  /**
+   * project is the project (namespace) containing the secrets.
+   *
+   * @generated from field: string project = 2;
+   */
+  project: string;
+
+  /**
+   * cluster names the registered cluster to act in. Empty targets the
+   * cluster the console runs in.
+   *
+   * @generated from field: string cluster = 3;
+   */
+  cluster: string;
+};
+
+/**
+ * Describes the message holos.console.v1.BatchGetSecretsRequest.
+ * Use `create(BatchGetSecretsRequestSchema)` to create a new message.
+ */
+export declare const BatchGetSecretsRequestSchema: GenMessage<BatchGetSecretsRequest>;
+
+/**
+ * BatchGetSecretsResponse holds one result per requested name, in request
+ * order.
+ *
+ * @generated from message holos.console.v1.BatchGetSecretsResponse
+ */
+export declare type BatchGetSecretsResponse = Message<"holos.console.v1.BatchGetSecretsResponse"> & {
+  /**
+   * @generated from field: repeated holos.console.v1.BatchGetSecretResult results = 1;
+   */
+  results: BatchGetSecretResult[];
+};
+
+/**
+ * Describes the message holos.console.v1.BatchGetSecretsResponse.
+ * Use `create(BatchGetSecretsResponseSchema)` to create a new message.
+ */
+export declare const BatchGetSecretsResponseSchema: GenMessage<BatchGetSecretsResponse>;
+
+/**
+ * BatchGetSecretResult is the outcome for one secret of a BatchGetSecrets
+ * call.
+ *
+ * @generated from message holos.console.v1.BatchGetSecretResult
+ */
+export declare type BatchGetSecretResult = Message<"holos.console.v1.BatchGetSecretResult"> & {
+  /**
+   * name is the requested secret.
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * data is the secret's data, as GetSecret returns it. Empty on error.
+   *
+   * @generated from field: map<string, bytes> data = 2;
+   */
+  data: { [key: string]: Uint8Array };
+
+  /**
+   * error is set when the secret could not be retrieved.
+   *
+   * @generated from field: holos.console.v1.BatchItemError error = 3;
+   */
+  error?: BatchItemError;
+};
+
+/**
+ * Describes the message holos.console.v1.BatchGetSecretResult.
+ * Use `create(BatchGetSecretResultSchema)` to create a new message.
+ */
+export declare const BatchGetSecretResultSchema: GenMessage<BatchGetSecretResult>;
+
+/**
+ * BatchDeleteSecretsRequest names the secrets to delete.
+ *
+ * @generated from message holos.console.v1.BatchDeleteSecretsRequest
+ */
+export declare type BatchDeleteSecretsRequest = Message<"holos.console.v1.BatchDeleteSecretsRequest"> & {
+  /**
+   * names are the secrets to delete, at most 100.
+   *
+   * @generated from field: repeated string names = 1;
+   */
+  names: string[];
+
+  /**
+   * project is the project (namespace) containing the secrets.
+   *
+   * @generated from field: string project = 2;
+   */
+  project: string;
+
+  /**
+   * dry_run runs validation and authorization, and submits every Kubernetes
+   * write with server-side dry-run, without persisting any change.
+   *
+   * @generated from field: bool dry_run = 3;
+   */
+  dryRun: boolean;
+
+  /**
+   * cluster names the registered cluster to act in. Empty targets the
+   * cluster the console runs in.
+   *
+   * @generated from field: string cluster = 4;
+   */
+  cluster: string;
+
+  /**
+   * force deletes secrets even when workloads in the project reference
+   * them, as DeleteSecretRequest.force does.
+   *
+   * @generated from field: bool force = 5;
+   */
+  force: boolean;
+};
+
+/**
+ * Describes the message holos.console.v1.BatchDeleteSecretsRequest.
+ * Use `create(BatchDeleteSecretsRequestSchema)` to create a new message.
+ */
+export declare const BatchDeleteSecretsRequestSchema: GenMessage<BatchDeleteSecretsRequest>;
+
+/**
+ * BatchDeleteSecretsResponse holds one result per requested name, in
+ * request order.
+ *
+ * @generated from message holos.console.v1.BatchDeleteSecretsResponse
+ */
+export declare type BatchDeleteSecretsResponse = Message<"holos.console.v1.BatchDeleteSecretsResponse"> & {
+  /**
+   * @generated from field: repeated holos.console.v1.BatchDeleteSecretResult results = 1;
+   */
+  results: BatchDeleteSecretResult[];
+};
+
+/**
+ * Describes the message holos.console.v1.BatchDeleteSecretsResponse.
+ * Use `create(BatchDeleteSecretsResponseSchema)` to create a new message.
+ */
+export declare const BatchDeleteSecretsResponseSchema: GenMessage<BatchDeleteSecretsResponse>;
+
+/**
+ * BatchDeleteSecretResult is the outcome for one secret of a
+ * BatchDeleteSecrets call.
+ *
+ * @generated from message holos.console.v1.BatchDeleteSecretResult
+ */
+export declare type BatchDeleteSecretResult = Message<"holos.console.v1.BatchDeleteSecretResult"> & {
+  /**
+   * name is the requested secret.
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * error is set when the secret was not deleted.
+   *
+   * @generated from field: holos.console.v1.BatchItemError error = 2;
+   */
+  error?: BatchItemError;
+};
+
+/**
+ * Describes the message holos.console.v1.BatchDeleteSecretResult.
+ * Use `create(BatchDeleteSecretResultSchema)` to create a new message.
+ */
+export declare const BatchDeleteSecretResultSchema: GenMessage<BatchDeleteSecretResult>;
+
+/**
+ * SecretInUse is the error detail attached when DeleteSecret refuses to
+ * delete a secret that workloads still reference.
+ *
+ * @generated from message holos.console.v1.SecretInUse
+ */
+export declare type SecretInUse = Message<"holos.console.v1.SecretInUse"> & {
+  /**
+   * consumers are the workloads referencing the secret.
+   *
+   * @generated from field: repeated holos.console.v1.SecretConsumer consumers = 1;
+   */
+  consumers: SecretConsumer[];
+};
+
+/**
+ * Describes the message holos.console.v1.SecretInUse.
+ * Use `create(SecretInUseSchema)` to create a new message.
+ */
+export declare const SecretInUseSchema: GenMessage<SecretInUse>;
+
+/**
+ * SecretTooLarge is the error detail attached when a write would grow a
+ * secret past the Kubernetes object size limit or the key limit.
+ *
+ * @generated from message holos.console.v1.SecretTooLarge
+ */
+export declare type SecretTooLarge = Message<"holos.console.v1.SecretTooLarge"> & {
+  /**
+   * size_bytes is the size the secret would have after the write.
+   *
+   * @generated from field: int64 size_bytes = 1;
+   */
+  sizeBytes: bigint;
+
+  /**
+   * current_bytes is the size of the secret before the write, 0 when creating.
+   *
+   * @generated from field: int64 current_bytes = 2;
+   */
+  currentBytes: bigint;
+
+  /**
+   * limit_bytes is the most a secret may hold.
+   *
+   * @generated from field: int64 limit_bytes = 3;
+   */
+  limitBytes: bigint;
+
+  /**
+   * remaining_bytes is how much the secret can still grow, limit_bytes
+   * minus current_bytes.
+   *
+   * @generated from field: int64 remaining_bytes = 4;
+   */
+  remainingBytes: bigint;
+
+  /**
+   * key_count is the number of keys the secret would hold after the write.
+   *
+   * @generated from field: int32 key_count = 5;
+   */
+  keyCount: number;
+
+  /**
+   * max_keys is the most keys a secret may hold.
+   *
+   * @generated from field: int32 max_keys = 6;
+   */
+  maxKeys: number;
+};
+
+/**
+ * Describes the message holos.console.v1.SecretTooLarge.
+ * Use `create(SecretTooLargeSchema)` to create a new message.
+ */
+export declare const SecretTooLargeSchema: GenMessage<SecretTooLarge>;
+
+/**
+ * SecretMetadata contains non-sensitive information about a secret.
+ *
+ * @generated from message holos.console.v1.SecretMetadata
+ */
+export declare type SecretMetadata = Message<"holos.console.v1.SecretMetadata"> & {
+  /**
+   * name is the name of the secret.
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * accessible indicates whether the current user has permission to read this secret.
+   *
+   * @generated from field: bool accessible = 2;
+   */
+  accessible: boolean;
+
+  /**
+   * user_grants contains per-user sharing grants on this secret.
+   *
+   * @generated from field: repeated holos.console.v1.ShareGrant user_grants = 5;
+   */
+  userGrants: ShareGrant[];
+
+  /**
+   * role_grants contains per-role sharing grants on this secret.
+   *
+   * @generated from field: repeated holos.console.v1.ShareGrant role_grants = 6;
+   */
+  roleGrants: ShareGrant[];
+
+  /**
+   * description is a human-readable description of the secret's purpose.
+   *
+   * @generated from field: optional string description = 7;
+   */
+  description?: string;
+
+  /**
+   * url is a URL associated with the secret (e.g. link to the service that uses it).
+   *
+   * @generated from field: optional string url = 8;
+   */
+  url?: string;
+
+  /**
+   * created_at is the RFC3339-formatted timestamp when the underlying Kubernetes
+   * Secret was created, sourced from metadata.creationTimestamp.
+   *
+   * @generated from field: string created_at = 9;
+   */
+  createdAt: string;
+
+  /**
+   * source identifies what manages the underlying Kubernetes Secret:
+   * "console" for secrets created through the console, or "external" for
+   * secrets synced by External Secrets Operator. External secrets are
+   * read-only; UpdateSecret, PatchSecret, RotateSecret, UpdateSharing, and
+   * DeleteSecret reject them with FAILED_PRECONDITION.
+   *
+   * @generated from field: string source = 10;
+   */
+  source: string;
+
+  /**
+   * updated_at is the RFC3339-formatted timestamp of the most recent write to
+   * the underlying Kubernetes Secret, sourced from metadata.managedFields.
+   * Equal to created_at when the Secret has not been modified.
+   *
+   * @generated from field: string updated_at = 11;
+   */
+  updatedAt: string;
+
+  /**
+   * creator_email is the email address of the user who created this secret
+   * through the console. Empty for external secrets and for secrets created
+   * before the console recorded its creator.
+   *
+   * @generated from field: string creator_email = 12;
+   */
+  creatorEmail: string;
+
+  /**
+   * last_accessed_at is the RFC3339-formatted timestamp of the most recent
+   * GetSecret that read this secret's values. It is refreshed at most once
+   * an hour, so it may trail the true last read by up to an hour. Empty when
+   * the secret has not been read since access tracking was introduced, and
+   * always empty for external secrets.
+   *
+   * @generated from field: string last_accessed_at = 13;
+   */
+  lastAccessedAt: string;
+
+  /**
+   * last_accessed_by is the email address of the caller recorded with
+   * last_accessed_at.
+   *
+   * @generated from field: string last_accessed_by = 14;
+   */
+  lastAccessedBy: string;
+
+  /**
+   * content_types maps data keys to the media type recorded when the value
+   * was uploaded. Keys without a recorded type are absent.
+   *
+   * @generated from field: map<string, string> content_types = 15;
+   */
+  contentTypes: { [key: string]: string };
+
+  /**
+   * type is the Kubernetes secret type, e.g. "Opaque" or "kubernetes.io/tls".
+   *
+   * @generated from field: string type = 16;
+   */
+  type: string;
+
+  /**
+   * tls_certificate describes the leaf certificate of a kubernetes.io/tls
+   * secret. Unset for other types or when tls.crt does not parse.
+   *
+   * @generated from field: holos.console.v1.TLSCertificate tls_certificate = 17;
+   */
+  tlsCertificate?: TLSCertificate;
+
+  /**
+   * ssh_public_key is the authorized_keys line of a kubernetes.io/ssh-auth
+   * secret. It is set for every caller, including those who cannot read the
+   * private key.
+   *
+   * @generated from field: string ssh_public_key = 18;
+   */
+  sshPublicKey: string;
+
+  /**
+   * ssh_fingerprint is the SHA256 fingerprint of ssh_public_key.
+   *
+   * @generated from field: string ssh_fingerprint = 19;
+   */
+  sshFingerprint: string;
+
+  /**
+   * vault_path is the HashiCorp Vault path the secret's values are read
+   * from, from the console.holos.run/vault-path annotation. Empty when the
+   * values are stored on the Kubernetes Secret. Values of a Vault-backed
+   * secret cannot be changed through the console.
+   *
+   * @generated from field: string vault_path = 20;
+   */
+  vaultPath: string;
+
+  /**
+   * size_bytes approximates the stored size of the Kubernetes Secret: its
+   * data, labels, and annotations, including grant annotations.
+   *
+   * @generated from field: int64 size_bytes = 21;
+   */
+  sizeBytes: bigint;
+
+  /**
+   * size_limit_bytes is the most size_bytes may reach; writes past it fail
+   * with RESOURCE_EXHAUSTED and a SecretTooLarge detail.
+   *
+   * @generated from field: int64 size_limit_bytes = 22;
+   */
+  sizeLimitBytes: bigint;
+
+  /**
+   * key_count is the number of data keys the secret holds.
+   *
+   * @generated from field: int32 key_count = 23;
+   */
+  keyCount: number;
+
+  /**
+   * user_role is the calling user's effective role on this secret.
+   * ROLE_UNSPECIFIED when a deny grant excludes them.
+   *
+   * @generated from field: holos.console.v1.Role user_role = 24;
+   */
+  userRole: Role;
+
+  /**
+   * user_role_source explains where user_role comes from. Secret sharing
+   * grants are held by the project, so the source names the project. Unset
+   * when user_role is ROLE_UNSPECIFIED.
+   *
+   * @generated from field: holos.console.v1.RoleSource user_role_source = 25;
+   */
+  userRoleSource?: RoleSource;
+};
+
+/**
+ * Describes the message holos.console.v1.SecretMetadata.
+ * Use `create(SecretMetadataSchema)` to create a new message.
+ */
+export declare const SecretMetadataSchema: GenMessage<SecretMetadata>;
+
+/**
+ * TLSCertificate is the parsed metadata of a TLS secret's leaf certificate.
+ *
+ * @generated from message holos.console.v1.TLSCertificate
+ */
+export declare type TLSCertificate = Message<"holos.console.v1.TLSCertificate"> & {
+  /**
+   * subject is the certificate subject distinguished name.
+   *
+   * @generated from field: string subject = 1;
+   */
+  subject: string;
+
+  /**
+   * issuer is the certificate issuer distinguished name.
+   *
+   * @generated from field: string issuer = 2;
+   */
+  issuer: string;
+
+  /**
+   * dns_names are the DNS subject alternative names.
+   *
+   * @generated from field: repeated string dns_names = 3;
+   */
+  dnsNames: string[];
+
+  /**
+   * ip_addresses are the IP address subject alternative names.
+   *
+   * @generated from field: repeated string ip_addresses = 4;
+   */
+  ipAddresses: string[];
+
+  /**
+   * email_addresses are the email subject alternative names.
+   *
+   * @generated from field: repeated string email_addresses = 5;
+   */
+  emailAddresses: string[];
+
+  /**
+   * uris are the URI subject alternative names.
+   *
+   * @generated from field: repeated string uris = 6;
+   */
+  uris: string[];
+
+  /**
+   * not_before is when the certificate becomes valid (RFC 3339).
+   *
+   * @generated from field: string not_before = 7;
+   */
+  notBefore: string;
+
+  /**
+   * not_after is when the certificate expires (RFC 3339).
+   *
+   * @generated from field: string not_after = 8;
+   */
+  notAfter: string;
+};
+
+/**
+ * Describes the message holos.console.v1.TLSCertificate.
+ * Use `create(TLSCertificateSchema)` to create a new message.
+ */
+export declare const TLSCertificateSchema: GenMessage<TLSCertificate>;
+
+/**
+ * ShareGrant represents a sharing grant for a principal (user email or role name).
+ *
+ * @generated from message holos.console.v1.ShareGrant
+ */
+export declare type ShareGrant = Message<"holos.console.v1.ShareGrant"> & {
+  /**
+   * principal is the email address (for users) or role name (for roles).
+   *
+   * @generated from field: string principal = 1;
+   */
+  principal: string;
+
+  /**
+   * role is the permission level granted to the principal.
+   *
+   * @generated from field: holos.console.v1.Role role = 2;
+   */
+  role: Role;
+
+  /**
+   * nbf (not before) is the unix timestamp before which the grant is inactive.
+   * When unset, the grant has no start time restriction.
+   *
+   * @generated from field: optional int64 nbf = 3;
+   */
+  nbf?: bigint;
+
+  /**
+   * exp (expiration) is the unix timestamp at or after which the grant is inactive.
+   * When unset, the grant has no expiration.
+   *
+   * @generated from field: optional int64 exp = 4;
+   */
+  exp?: bigint;
+
+  /**
+   * keys restricts a secret sharing grant to the listed data keys. A grant
+   * with keys confers read access to those keys through GetSecretKey only;
+   * the role is treated as ROLE_VIEWER. Empty means the grant applies to the
+   * whole secret. Only honored by SecretsService.
+   *
+   * @generated from field: repeated string keys = 5;
+   */
+  keys: string[];
+
+  /**
+   * deny excludes the principal from the secret even when a project or
+   * organization grant would otherwise allow access. role and keys are
+   * ignored for deny grants. Only honored by SecretsService.
+   *
+   * @generated from field: bool deny = 6;
+   */
+  deny: boolean;
+
+  /**
+   * pending is set on user grants whose principal has never signed in to the
+   * console, so an owner can spot a mistyped email address. The grant takes
+   * effect as soon as the user signs in. Output only; ignored on input.
+   *
+   * @generated from field: bool pending = 7;
+   */
+  pending: boolean;
+};
+
+/**
+ * Describes the message holos.console.v1.ShareGrant.
+ * Use `create(ShareGrantSchema)` to create a new message.
+ */
+export declare const ShareGrantSchema: GenMessage<ShareGrant>;
+
+/**
+ * UpdateSharingRequest contains the sharing grants to set on a secret.
+ *
+ * @generated from message holos.console.v1.UpdateSharingRequest
+ */
+export declare type UpdateSharingRequest = Message<"holos.console.v1.UpdateSharingRequest"> & {
+  /**
+   * name is the name of the secret to update sharing for.
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * user_grants are the per-user sharing grants to set.
+   *
+   * @generated from field: repeated holos.console.v1.ShareGrant user_grants = 2;
+   */
+  userGrants: ShareGrant[];
+
+  /**
+   * role_grants are the per-role sharing grants to set.
+   *
+   * @generated from field: repeated holos.console.v1.ShareGrant role_grants = 3;
+   */
+  roleGrants: ShareGrant[];
+
+  /**
+   * project is the project (namespace) containing the secret.
+   *
+   * @generated from field: string project = 4;
+   */
+  project: string;
+
+  /**
+   * dry_run runs validation and authorization, and submits every Kubernetes
+   * write with server-side dry-run, without persisting any change.
+   *
+   * @generated from field: bool dry_run = 5;
+   */
+  dryRun: boolean;
+
+  /**
+   * cluster names the registered cluster to act in. Empty targets the
+   * cluster the console runs in.
+   *
+   * @generated from field: string cluster = 6;
+   */
+  cluster: string;
+
+  /**
+   * invite_users records a pending invitation for every user grant naming
+   * an email address that has never signed in, and emails the invitee when
+   * the console has SMTP configured. The invitation is accepted on the
+   * invitee's first sign-in.
+   *
+   * @generated from field: bool invite_users = 7;
+   */
+  inviteUsers: boolean;
+};
+
+/**
+ * Describes the message holos.console.v1.UpdateSharingRequest.
+ * Use `create(UpdateSharingRequestSchema)` to create a new message.
+ */
+export declare const UpdateSharingRequestSchema: GenMessage<UpdateSharingRequest>;
+
+/**
+ * UpdateSharingResponse contains the updated secret metadata.
+ *
+ * @generated from message holos.console.v1.UpdateSharingResponse
+ */
+export declare type UpdateSharingResponse = Message<"holos.console.v1.UpdateSharingResponse"> & {
+  /**
+   * metadata contains the updated secret metadata including new sharing grants.
+   *
+   * @generated from field: holos.console.v1.SecretMetadata metadata = 1;
+   */
+  metadata?: SecretMetadata;
+};
+
+/**
+ * Describes the message holos.console.v1.UpdateSharingResponse.
+ * Use `create(UpdateSharingResponseSchema)` to create a new message.
+ */
+export declare const UpdateSharingResponseSchema: GenMessage<UpdateSharingResponse>;
+
+/**
+ * GetSecretRawRequest contains the name of the secret to retrieve as a raw manifest.
+ *
+ * @generated from message holos.console.v1.GetSecretRawRequest
+ */
+export declare type GetSecretRawRequest = Message<"holos.console.v1.GetSecretRawRequest"> & {
+  /**
+   * name is the name of the secret to retrieve.
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * project is the project (namespace) containing the secret.
+   *
+   * @generated from field: string project = 2;
+   */
+  project: string;
+
+  /**
+   * cluster names the registered cluster to act in. Empty targets the
+   * cluster the console runs in.
+   *
+   * @generated from field: string cluster = 3;
+   */
+  cluster: string;
+
+  /**
+   * format selects JSON (the default) or YAML output.
+   *
+   * @generated from field: holos.console.v1.RawFormat format = 4;
+   */
+  format: RawFormat;
+
+  /**
+   * strip_managed_fields omits the server-populated fields (uid,
+   * resourceVersion, generation, creationTimestamp, managedFields,
+   * ownerReferences, and status) so the manifest can be applied to another
+   * cluster with kubectl apply.
+   *
+   * @generated from field: bool strip_managed_fields = 5;
+   */
+  stripManagedFields: boolean;
+};
+
+/**
+ * Describes the message holos.console.v1.GetSecretRawRequest.
+ * Use `create(GetSecretRawRequestSchema)` to create a new message.
+ */
+export declare const GetSecretRawRequestSchema: GenMessage<GetSecretRawRequest>;
+
+/**
+ * GetSecretRawResponse contains the full Kubernetes Secret object as JSON or YAML.
+ *
+ * @generated from message holos.console.v1.GetSecretRawResponse
+ */
+export declare type GetSecretRawResponse = Message<"holos.console.v1.GetSecretRawResponse"> & {
+  /**
+   * raw is the Secret object from the K8s API, serialized in the
+   * requested format.
+   *
+   * @generated from field: string raw = 1;
+   */
+  raw: string;
+};
+
+/**
+ * Describes the message holos.console.v1.GetSecretRawResponse.
+ * Use `create(GetSecretRawResponseSchema)` to create a new message.
+ */
+export declare const GetSecretRawResponseSchema: GenMessage<GetSecretRawResponse>;
+
+/**
+ * GetSecretKeyRequest identifies a single data key within a secret.
+ *
+ * @generated from message holos.console.v1.GetSecretKeyRequest
+ */
+export declare type GetSecretKeyRequest = Message<"holos.console.v1.GetSecretKeyRequest"> & {
+  /**
+   * name is the name of the secret containing the key.
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * project is the project (namespace) containing the secret.
+   *
+   * @generated from field: string project = 2;
+   */
+  project: string;
+
+  /**
+   * key is the data key to retrieve.
+   *
+   * @generated from field: string key = 3;
+   */
+  key: string;
+
+  /**
+   * cluster names the registered cluster to act in. Empty targets the
+   * cluster the console runs in.
+   *
+   * @generated from field: string cluster = 4;
+   */
+  cluster: string;
+};
+
+/**
+ * Describes the message holos.console.v1.GetSecretKeyRequest.
+ * Use `create(GetSecretKeyRequestSchema)` to create a new message.
+ */
+export declare const GetSecretKeyRequestSchema: GenMessage<GetSecretKeyRequest>;
+
+/**
+ * GetSecretKeyResponse contains the value of a single secret data key.
+ *
+ * @generated from message holos.console.v1.GetSecretKeyResponse
+ */
+export declare type GetSecretKeyResponse = Message<"holos.console.v1.GetSecretKeyResponse"> & {
+  /**
+   * value is the raw secret bytes (not base64 encoded).
+   *
+   * @generated from field: bytes value = 1;
+   */
+  value: Uint8Array;
+
+  /**
+   * content_type is the media type recorded for the key, or
+   * "application/octet-stream" when none was recorded.
+   *
+   * @generated from field: string content_type = 2;
+   */
+  contentType: string;
+};
+
+/**
+ * Describes the message holos.console.v1.GetSecretKeyResponse.
+ * Use `create(GetSecretKeyResponseSchema)` to create a new message.
+ */
+export declare const GetSecretKeyResponseSchema: GenMessage<GetSecretKeyResponse>;
+
+/**
+ * RotateSecretRequest names the keys to rotate on an existing secret.
+ *
+ * @generated from message holos.console.v1.RotateSecretRequest
+ */
+export declare type RotateSecretRequest = Message<"holos.console.v1.RotateSecretRequest"> & {
+  /**
+   * name is the name of the secret to rotate.
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * project is the project (namespace) containing the secret.
+   *
+   * @generated from field: string project = 2;
+   */
+  project: string;
+
+  /**
+   * keys maps each key to rotate to the spec its new value is generated from.
+   * Every key must already exist on the secret.
+   *
+   * @generated from field: map<string, holos.console.v1.GenerateSpec> keys = 3;
+   */
+  keys: { [key: string]: GenerateSpec };
+
+  /**
+   * dry_run runs validation and authorization, and submits every Kubernetes
+   * write with server-side dry-run, without persisting any change. The
+   * rotation webhook is not called.
+   *
+   * @generated from field: bool dry_run = 4;
+   */
+  dryRun: boolean;
+
+  /**
+   * cluster names the registered cluster to act in. Empty targets the
+   * cluster the console runs in.
+   *
+   * @generated from field: string cluster = 5;
+   */
+  cluster: string;
+};
+
+/**
+ * Describes the message holos.console.v1.RotateSecretRequest.
+ * Use `create(RotateSecretRequestSchema)` to create a new message.
+ */
+export declare const RotateSecretRequestSchema: GenMessage<RotateSecretRequest>;
+
+/**
+ * RotateSecretResponse returns the rotated values and the webhook outcome.
+ *
+ * @generated from message holos.console.v1.RotateSecretResponse
+ */
+export declare type RotateSecretResponse = Message<"holos.console.v1.RotateSecretResponse"> & {
+  /**
+   * rotated_values holds the new value of each rotated key. They are returned
+   * only once, in this response.
+   *
+   * @generated from field: map<string, string> rotated_values = 1;
+   */
+  rotatedValues: { [key: string]: string };
+
+  /**
+   * webhook_notified is true when a rotation webhook is configured and
+   * acknowledged the notification with a 2xx response.
+   *
+   * @generated from field: bool webhook_notified = 2;
+   */
+  webhookNotified: boolean;
+
+  /**
+   * webhook_error describes why the rotation webhook could not be notified.
+   * The rotation itself is stored regardless.
+   *
+   * @generated from field: string webhook_error = 3;
+   */
+  webhookError: string;
+};
+
+/**
+ * Describes the message holos.console.v1.RotateSecretResponse.
+ * Use `create(RotateSecretResponseSchema)` to create a new message.
+ */
+export declare const RotateSecretResponseSchema: GenMessage<RotateSecretResponse>;
+
+/**
+ * ProjectQuota limits the console-managed secrets in a project. Zero means
+ * unlimited.
+ *
+ * @generated from message holos.console.v1.ProjectQuota
+ */
+export declare type ProjectQuota = Message<"holos.console.v1.ProjectQuota"> & {
+  /**
+   * max_secrets is the maximum number of console-managed secrets.
+   *
+   * @generated from field: int64 max_secrets = 1;
+   */
+  maxSecrets: bigint;
+
+  /**
+   * max_secret_bytes is the maximum total size of the keys and values of
+   * all console-managed secrets.
+   *
+   * @generated from field: int64 max_secret_bytes = 2;
+   */
+  maxSecretBytes: bigint;
+};
+
+/**
+ * Describes the message holos.console.v1.ProjectQuota.
+ * Use `create(ProjectQuotaSchema)` to create a new message.
+ */
+export declare const ProjectQuotaSchema: GenMessage<ProjectQuota>;
+
+/**
+ * ProjectQuotaUsage is the current consumption measured against a
+ * ProjectQuota.
+ *
+ * @generated from message holos.console.v1.ProjectQuotaUsage
+ */
+export declare type ProjectQuotaUsage = Message<"holos.console.v1.ProjectQuotaUsage"> & {
+  /**
+   * secrets is the number of console-managed secrets.
+   *
+   * @generated from field: int64 secrets = 1;
+   */
+  secrets: bigint;
+
+  /**
+   * secret_bytes is the total size of the keys and values of all
+   * console-managed secrets.
+   *
+   * @generated from field: int64 secret_bytes = 2;
+   */
+  secretBytes: bigint;
+};
+
+/**
+ * Describes the message holos.console.v1.ProjectQuotaUsage.
+ * Use `create(ProjectQuotaUsageSchema)` to create a new message.
+ */
+export declare const ProjectQuotaUsageSchema: GenMessage<ProjectQuotaUsage>;
+
+/**
+ * GetProjectQuotaRequest names the project to report on.
+ *
+ * @generated from message holos.console.v1.GetProjectQuotaRequest
+ */
+export declare type GetProjectQuotaRequest = Message<"holos.console.v1.GetProjectQuotaRequest"> & {
+  /**
+   * project is the project name.
+   *
+   * @generated from field: string project = 1;
+   */
+  project: string;
+
+  /**
+   * cluster names the registered cluster to act in. Empty targets the
+   * cluster the console runs in.
+   *
+   * @generated from field: string cluster = 2;
+   */
+  cluster: string;
+};
+
+/**
+ * Describes the message holos.console.v1.GetProjectQuotaRequest.
+ * Use `create(GetProjectQuotaRequestSchema)` to create a new message.
+ */
+export declare const GetProjectQuotaRequestSchema: GenMessage<GetProjectQuotaRequest>;
+
+/**
+ * GetProjectQuotaResponse returns the effective quota and current usage.
+ *
+ * @generated from message holos.console.v1.GetProjectQuotaResponse
+ */
+export declare type GetProjectQuotaResponse = Message<"holos.console.v1.GetProjectQuotaResponse"> & {
+  /**
+   * limit is the effective quota after applying organization defaults.
+   *
+   * @generated from field: holos.console.v1.ProjectQuota limit = 1;
+   */
+  limit?: ProjectQuota;
+
+  /**
+   * usage is the project's current consumption.
+   *
+   * @generated from field: holos.console.v1.ProjectQuotaUsage usage = 2;
+   */
+  usage?: ProjectQuotaUsage;
+};
+
+/**
+ * Describes the message holos.console.v1.GetProjectQuotaResponse.
+ * Use `create(GetProjectQuotaResponseSchema)` to create a new message.
+ */
+export declare const GetProjectQuotaResponseSchema: GenMessage<GetProjectQuotaResponse>;
+
+/**
+ * GetSecretUsageRequest names the secret to look up consumers for.
+ *
+ * @generated from message holos.console.v1.GetSecretUsageRequest
+ */
+export declare type GetSecretUsageRequest = Message<"holos.console.v1.GetSecretUsageRequest"> & {
+  /**
+   * name is the name of the secret.
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * project is the project (namespace) containing the secret.
+   *
+   * @generated from field: string project = 2;
+   */
+  project: string;
+
+  /**
+   * cluster names the registered cluster to act in. Empty targets the
+   * cluster the console runs in.
+   *
+   * @generated from field: string cluster = 3;
+   */
+  cluster: string;
+};
+
+/**
+ * Describes the message holos.console.v1.GetSecretUsageRequest.
+ * Use `create(GetSecretUsageRequestSchema)` to create a new message.
+ */
+export declare const GetSecretUsageRequestSchema: GenMessage<GetSecretUsageRequest>;
 
 /**
- * GetSecretRawRequest contains the name of the secret to retrieve as raw JSON.
+ * SecretReference is one place a workload's pod template uses a secret.
  *
- * @generated from message holos.console.v1.GetSecretRawRequest
+ * @generated from message holos.console.v1.SecretReference
  */
-export declare type GetSecretRawRequest = Message<"holos.console.v1.GetSecretRawRequest"> & {
+export declare type SecretReference = Message<"holos.console.v1.SecretReference"> & {
   /**
-   * name is the name of the secret to retrieve.
+   * type is how the secret is referenced: "env", "envFrom", "volume", or
+   * "imagePullSecret".
+   *
+   * @generated from field: string type = 1;
+   */
+  type: string;
+
+  /**
+   * container is the container holding an env or envFrom reference,
+   * including init and ephemeral containers. Empty for the other types.
+   *
+   * @generated from field: string container = 2;
+   */
+  container: string;
+
+  /**
+   * name is the environment variable for "env" and the volume name for
+   * "volume".
+   *
+   * @generated from field: string name = 3;
+   */
+  name: string;
+
+  /**
+   * key is the secret data key an "env" reference reads.
+   *
+   * @generated from field: string key = 4;
+   */
+  key: string;
+};
+
+/**
+ * Describes the message holos.console.v1.SecretReference.
+ * Use `create(SecretReferenceSchema)` to create a new message.
+ */
+export declare const SecretReferenceSchema: GenMessage<SecretReference>;
+
+/**
+ * SecretConsumer is a workload that references the secret.
+ *
+ * @generated from message holos.console.v1.SecretConsumer
+ */
+export declare type SecretConsumer = Message<"holos.console.v1.SecretConsumer"> & {
+  /**
+   * kind is Deployment, StatefulSet, DaemonSet, or CronJob.
+   *
+   * @generated from field: string kind = 1;
+   */
+  kind: string;
+
+  /**
+   * name is the workload name.
+   *
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * references lists every reference to the secret in the pod template.
+   *
+   * @generated from field: repeated holos.console.v1.SecretReference references = 3;
+   */
+  references: SecretReference[];
+};
+
+/**
+ * Describes the message holos.console.v1.SecretConsumer.
+ * Use `create(SecretConsumerSchema)` to create a new message.
+ */
+export declare const SecretConsumerSchema: GenMessage<SecretConsumer>;
+
+/**
+ * GetSecretUsageResponse lists the workloads that use the secret, ordered by
+ * kind, then name.
+ *
+ * @generated from message holos.console.v1.GetSecretUsageResponse
+ */
+export declare type GetSecretUsageResponse = Message<"holos.console.v1.GetSecretUsageResponse"> & {
+  /**
+   * consumers are the workloads referencing the secret.
+   *
+   * @generated from field: repeated holos.console.v1.SecretConsumer consumers = 1;
+   */
+  consumers: SecretConsumer[];
+
+  /**
+   * unscanned_kinds are the workload kinds the caller may not list. Their
+   * consumers, if any, are not reported.
+   *
+   * @generated from field: repeated string unscanned_kinds = 2;
+   */
+  unscannedKinds: string[];
+};
+
+/**
+ * Describes the message holos.console.v1.GetSecretUsageResponse.
+ * Use `create(GetSecretUsageResponseSchema)` to create a new message.
+ */
+export declare const GetSecretUsageResponseSchema: GenMessage<GetSecretUsageResponse>;
+
+/**
+ * ListDeletedSecretsRequest selects the project whose trash to list.
+ *
+ * @generated from message holos.console.v1.ListDeletedSecretsRequest
+ */
+export declare type ListDeletedSecretsRequest = Message<"holos.console.v1.ListDeletedSecretsRequest"> & {
+  /**
+   * project is the project (namespace) to list.
+   *
+   * @generated from field: string project = 1;
+   */
+  project: string;
+
+  /**
+   * cluster names the registered cluster to act in. Empty targets the
+   * cluster the console runs in.
+   *
+   * @generated from field: string cluster = 2;
+   */
+  cluster: string;
+};
+
+/**
+ * Describes the message holos.console.v1.ListDeletedSecretsRequest.
+ * Use `create(ListDeletedSecretsRequestSchema)` to create a new message.
+ */
+export declare const ListDeletedSecretsRequestSchema: GenMessage<ListDeletedSecretsRequest>;
+
+/**
+ * DeletedSecret describes a soft-deleted secret.
+ *
+ * @generated from message holos.console.v1.DeletedSecret
+ */
+export declare type DeletedSecret = Message<"holos.console.v1.DeletedSecret"> & {
+  /**
+   * name is the name of the secret.
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * deleted_at is when the secret was deleted, in RFC 3339 format.
+   *
+   * @generated from field: string deleted_at = 2;
+   */
+  deletedAt: string;
+
+  /**
+   * deleted_by is the email of the principal who deleted the secret.
+   *
+   * @generated from field: string deleted_by = 3;
+   */
+  deletedBy: string;
+
+  /**
+   * purge_at is when the secret will be permanently deleted, in RFC 3339
+   * format.
+   *
+   * @generated from field: string purge_at = 4;
+   */
+  purgeAt: string;
+};
+
+/**
+ * Describes the message holos.console.v1.DeletedSecret.
+ * Use `create(DeletedSecretSchema)` to create a new message.
+ */
+export declare const DeletedSecretSchema: GenMessage<DeletedSecret>;
+
+/**
+ * ListDeletedSecretsResponse lists a project's soft-deleted secrets, most
+ * recently deleted first.
+ *
+ * @generated from message holos.console.v1.ListDeletedSecretsResponse
+ */
+export declare type ListDeletedSecretsResponse = Message<"holos.console.v1.ListDeletedSecretsResponse"> & {
+  /**
+   * @generated from field: repeated holos.console.v1.DeletedSecret secrets = 1;
+   */
+  secrets: DeletedSecret[];
+};
+
+/**
+ * Describes the message holos.console.v1.ListDeletedSecretsResponse.
+ * Use `create(ListDeletedSecretsResponseSchema)` to create a new message.
+ */
+export declare const ListDeletedSecretsResponseSchema: GenMessage<ListDeletedSecretsResponse>;
+
+/**
+ * RestoreSecretRequest identifies the soft-deleted secret to restore.
+ *
+ * @generated from message holos.console.v1.RestoreSecretRequest
+ */
+export declare type RestoreSecretRequest = Message<"holos.console.v1.RestoreSecretRequest"> & {
+  /**
+   * name is the name of the secret to restore.
    *
    * @generated from field: string name = 1;
    */
@@ -494,33 +2521,83 @@ export declare type GetSecretRawRequest = Message<"holos.console.v1.GetSecretRaw
    * @generated from field: string project = 2;
    */
   project: string;
+
+  /**
+   * cluster names the registered cluster to act in. Empty targets the
+   * cluster the console runs in.
+   *
+   * @generated from field: string cluster = 3;
+   */
+  cluster: string;
 };
 
 /**
- * Describes the message holos.console.v1.GetSecretRawRequest.
- * Use `create(GetSecretRawRequestSchema)` to create a new message.
+ * Describes the message holos.console.v1.RestoreSecretRequest.
+ * Use `create(RestoreSecretRequestSchema)` to create a new message.
  */
-export declare const GetSecretRawRequestSchema: GenMessage<GetSecretRawRequest>;
+export declare const RestoreSecretRequestSchema: GenMessage<RestoreSecretRequest>;
 
 /**
- * GetSecretRawResponse contains the full Kubernetes Secret object as JSON.
+ * RestoreSecretResponse is empty on success.
  *
- * @generated from message holos.console.v1.GetSecretRawResponse
+ * @generated from message holos.console.v1.RestoreSecretResponse
  */
-export declare type GetSecretRawResponse = Message<"holos.console.v1.GetSecretRawResponse"> & {
+export declare type RestoreSecretResponse = Message<"holos.console.v1.RestoreSecretResponse"> & {
+};
+
+/**
+ * Describes the message holos.console.v1.RestoreSecretResponse.
+ * Use `create(RestoreSecretResponseSchema)` to create a new message.
+ */
+export declare const RestoreSecretResponseSchema: GenMessage<RestoreSecretResponse>;
+
+/**
+ * GenerateFormat selects how a generated secret value is encoded.
+ *
+ * @generated from enum holos.console.v1.GenerateFormat
+ */
+export enum GenerateFormat {
   /**
-   * raw is the verbatim JSON-serialized Secret object from the K8s API.
+   * GENERATE_FORMAT_UNSPECIFIED defaults to GENERATE_FORMAT_PASSWORD.
    *
-   * @generated from field: string raw = 1;
+   * @generated from enum value: GENERATE_FORMAT_UNSPECIFIED = 0;
    */
-  raw: string;
-};
+  UNSPECIFIED = 0,
+
+  /**
+   * GENERATE_FORMAT_PASSWORD draws length characters from the charset.
+   *
+   * @generated from enum value: GENERATE_FORMAT_PASSWORD = 1;
+   */
+  PASSWORD = 1,
+
+  /**
+   * GENERATE_FORMAT_HEX hex-encodes length random bytes.
+   *
+   * @generated from enum value: GENERATE_FORMAT_HEX = 2;
+   */
+  HEX = 2,
+
+  /**
+   * GENERATE_FORMAT_BASE64 standard base64-encodes length random bytes.
+   *
+   * @generated from enum value: GENERATE_FORMAT_BASE64 = 3;
+   */
+  BASE64 = 3,
+
+  /**
+   * GENERATE_FORMAT_UUID produces a random (version 4) UUID. length and
+   * charset are ignored.
+   *
+   * @generated from enum value: GENERATE_FORMAT_UUID = 4;
+   */
+  UUID = 4,
+}
 
 /**
- * Describes the message holos.console.v1.GetSecretRawResponse.
- * Use `create(GetSecretRawResponseSchema)` to create a new message.
+ * Describes the enum holos.console.v1.GenerateFormat.
  */
-export declare const GetSecretRawResponseSchema: GenMessage<GetSecretRawResponse>;
+export declare const GenerateFormatSchema: GenEnum<GenerateFormat>;
 
 /**
  * SecretsService provides access to Kubernetes secrets with RBAC.
@@ -565,6 +2642,53 @@ export declare const SecretsService: GenService<{
     input: typeof UpdateSecretRequestSchema;
     output: typeof UpdateSecretResponseSchema;
   },
+  /**
+   * DiffSecret previews an UpdateSecret without persisting it: it compares
+   * the proposed data with the secret's current data and reports the keys
+   * added, removed, and changed with their value sizes, never the values.
+   * Requires authentication and read access to the secret. Only operates on
+   * secrets with the console managed-by label.
+   *
+   * @generated from rpc holos.console.v1.SecretsService.DiffSecret
+   */
+  diffSecret: {
+    methodKind: "unary";
+    input: typeof DiffSecretRequestSchema;
+    output: typeof DiffSecretResponseSchema;
+  },
+  /**
+   * PatchSecret adds, replaces, or removes individual keys of an existing
+   * secret without resubmitting its entire data map. Keys not mentioned in
+   * the request are left untouched.
+   * Requires authentication and PERMISSION_SECRETS_WRITE.
+   * Only operates on secrets with the console managed-by label.
+   *
+   * @generated from rpc holos.console.v1.SecretsService.PatchSecret
+   */
+  patchSecret: {
+    methodKind: "unary";
+    input: typeof PatchSecretRequestSchema;
+    output: typeof PatchSecretResponseSchema;
+  },
+  /**
+   * AppendSecretKey uploads the value of one key of an existing secret in
+   * chunks, so a value approaching the 1MiB Secret size limit need not fit
+   * in a single request. The first chunk, at offset 0, replaces the value
+   * and is rejected with ResourceExhausted, before anything is written, when
+   * total_size would push the secret past the limit or the project quota.
+   * Each later chunk must start where the stored value ends; a mismatch
+   * fails with FailedPrecondition so a client can resume from the stored
+   * size. The key holds a partial value until the last chunk is appended.
+   * Requires authentication and PERMISSION_SECRETS_WRITE.
+   * Only operates on secrets with the console managed-by label.
+   *
+   * @generated from rpc holos.console.v1.SecretsService.AppendSecretKey
+   */
+  appendSecretKey: {
+    methodKind: "unary";
+    input: typeof AppendSecretKeyRequestSchema;
+    output: typeof AppendSecretKeyResponseSchema;
+  },
   /**
    * CreateSecret creates a new secret with the console managed-by label.
    * Requires authentication and PERMISSION_SECRETS_WRITE.
@@ -579,7 +2703,10 @@ export declare const SecretsService: GenService<{
   /**
    * DeleteSecret deletes a secret by name.
    * Requires authentication and PERMISSION_SECRETS_DELETE.
-   * Only operates on secrets with the console managed-by label.
+   * Only operates on secrets with the console managed-by label. When the
+   * console runs with a trash retention window the secret is soft-deleted:
+   * it is hidden and its grants are stripped until RestoreSecret or the
+   * window passes.
    *
    * @generated from rpc holos.console.v1.SecretsService.DeleteSecret
    */
@@ -588,9 +2715,36 @@ export declare const SecretsService: GenService<{
     input: typeof DeleteSecretRequestSchema;
     output: typeof DeleteSecretResponseSchema;
   },
+  /**
+   * BatchGetSecrets retrieves several secrets of a project in one call. Each
+   * secret is authorized and audited as by GetSecret; a secret the caller
+   * cannot read is reported in its result rather than failing the batch.
+   *
+   * @generated from rpc holos.console.v1.SecretsService.BatchGetSecrets
+   */
+  batchGetSecrets: {
+    methodKind: "unary";
+    input: typeof BatchGetSecretsRequestSchema;
+    output: typeof BatchGetSecretsResponseSchema;
+  },
+  /**
+   * BatchDeleteSecrets deletes several secrets of a project in one call.
+   * Each secret is authorized and deleted as by DeleteSecret, in the order
+   * given; a failure is reported in that secret's result and does not stop
+   * the remaining deletions.
+   *
+   * @generated from rpc holos.console.v1.SecretsService.BatchDeleteSecrets
+   */
+  batchDeleteSecrets: {
+    methodKind: "unary";
+    input: typeof BatchDeleteSecretsRequestSchema;
+    output: typeof BatchDeleteSecretsResponseSchema;
+  },
   /**
    * UpdateSharing updates the sharing grants on a secret without touching its data.
    * Requires ROLE_OWNER on the secret.
+   * User grants naming someone who has never signed in are reported as
+   * pending; set invite_users to invite them.
    *
    * @generated from rpc holos.console.v1.SecretsService.UpdateSharing
    */
@@ -600,9 +2754,10 @@ export declare const SecretsService: GenService<{
     output: typeof UpdateSharingResponseSchema;
   },
   /**
-   * GetSecretRaw retrieves the full Kubernetes Secret object as verbatim JSON.
-   * The backend returns the Secret exactly as the K8s API provides it, with no
-   * field filtering. Requires authentication and PERMISSION_SECRETS_READ.
+   * GetSecretRaw retrieves the full Kubernetes Secret object as JSON or YAML.
+   * The backend returns the Secret exactly as the K8s API provides it unless
+   * strip_managed_fields is set. Requires authentication and
+   * PERMISSION_SECRETS_READ.
    *
    * @generated from rpc holos.console.v1.SecretsService.GetSecretRaw
    */
@@ -611,5 +2766,149 @@ export declare const SecretsService: GenService<{
     input: typeof GetSecretRawRequestSchema;
     output: typeof GetSecretRawResponseSchema;
   },
+  /**
+   * GetSecretKey retrieves the value of a single data key from a secret.
+   * Callers with read access to the whole secret may read any key. Callers
+   * holding only a key-scoped sharing grant (see ShareGrant.keys) may read
+   * the keys listed in that grant. Returns PermissionDenied otherwise.
+   *
+   * @generated from rpc holos.console.v1.SecretsService.GetSecretKey
+   */
+  getSecretKey: {
+    methodKind: "unary";
+    input: typeof GetSecretKeyRequestSchema;
+    output: typeof GetSecretKeyResponseSchema;
+  },
+  /**
+   * RotateSecret replaces the values of existing keys with newly generated
+   * random values. When the secret carries the
+   * console.holos.run/rotation-webhook annotation, the server POSTs a
+   * notification (without secret values) to that HTTPS URL after the rotation
+   * is stored.
+   * Requires PERMISSION_SECRETS_ROTATE.
+   * Only operates on secrets with the console managed-by label.
+   *
+   * @generated from rpc holos.console.v1.SecretsService.RotateSecret
+   */
+  rotateSecret: {
+    methodKind: "unary";
+    input: typeof RotateSecretRequestSchema;
+    output: typeof RotateSecretResponseSchema;
+  },
+  /**
+   * GetProjectQuota returns the project's effective secret quota and current
+   * usage. Limits come from the console.holos.run/quota-* annotations on the
+   * project Namespace, falling back to its organization Namespace.
+   * CreateSecret, UpdateSecret, PatchSecret, and RotateSecret return
+   * RESOURCE_EXHAUSTED when a write would exceed the quota.
+   * Requires authentication and permission to list secrets in the project.
+   *
+   * @generated from rpc holos.console.v1.SecretsService.GetProjectQuota
+   */
+  getProjectQuota: {
+    methodKind: "unary";
+    input: typeof GetProjectQuotaRequestSchema;
+    output: typeof GetProjectQuotaResponseSchema;
+  },
+  /**
+   * GetSecretUsage lists the Deployments, StatefulSets, DaemonSets, and
+   * CronJobs in the project that reference the secret through env, envFrom,
+   * volumes, or imagePullSecrets, so owners can check before deleting or
+   * rotating it. Requires PERMISSION_SECRETS_READ on the secret. Workload
+   * kinds the caller may not list are reported in unscanned_kinds.
+   *
+   * @generated from rpc holos.console.v1.SecretsService.GetSecretUsage
+   */
+  getSecretUsage: {
+    methodKind: "unary";
+    input: typeof GetSecretUsageRequestSchema;
+    output: typeof GetSecretUsageResponseSchema;
+  },
+  /**
+   * ListDeletedSecrets lists the soft-deleted secrets in a project that are
+   * still within the trash retention window. Requires permission to list
+   * secrets in the project.
+   *
+   * @generated from rpc holos.console.v1.SecretsService.ListDeletedSecrets
+   */
+  listDeletedSecrets: {
+    methodKind: "unary";
+    input: typeof ListDeletedSecretsRequestSchema;
+    output: typeof ListDeletedSecretsResponseSchema;
+  },
+  /**
+   * RestoreSecret undoes a soft delete, restoring the secret and its grants.
+   * Requires PERMISSION_SECRETS_DELETE in the project.
+   *
+   * @generated from rpc holos.console.v1.SecretsService.RestoreSecret
+   */
+  restoreSecret: {
+    methodKind: "unary";
+    input: typeof RestoreSecretRequestSchema;
+    output: typeof RestoreSecretResponseSchema;
+  },
+  /**
+   * CreateSSHKeySecret generates an ed25519 key pair server-side and stores
+   * it as a kubernetes.io/ssh-auth secret, e.g. for a deploy key. The
+   * response and SecretMetadata carry the public key; the private key is
+   * only readable through the secret's read permission.
+   * Requires authentication and PERMISSION_SECRETS_WRITE.
+   *
+   * @generated from rpc holos.console.v1.SecretsService.CreateSSHKeySecret
+   */
+  createSSHKeySecret: {
+    methodKind: "unary";
+    input: typeof CreateSSHKeySecretRequestSchema;
+    output: typeof CreateSSHKeySecretResponseSchema;
+  },
+  /**
+   * ExportSecretSealed returns the secret as a Bitnami SealedSecret manifest
+   * encrypted to the console's configured sealing certificate, so it can be
+   * committed to a GitOps repository without exposing plaintext. The
+   * manifest is strict-scoped: it only decrypts under the same name and
+   * namespace. Returns FailedPrecondition when no certificate is configured.
+   * Requires PERMISSION_SECRETS_READ.
+   *
+   * @generated from rpc holos.console.v1.SecretsService.ExportSecretSealed
+   */
+  exportSecretSealed: {
+    methodKind: "unary";
+    input: typeof ExportSecretSealedRequestSchema;
+    output: typeof ExportSecretSealedResponseSchema;
+  },
+  /**
+   * CreateShareLink returns a signed, time-limited URL that reads the
+   * secret without an account, e.g. to hand a one-off credential to an
+   * external contractor. Anyone holding the URL can read the secret until it
+   * expires; links are not stored, so they cannot be revoked except by
+   * deleting the secret. Returns FailedPrecondition unless share links are
+   * enabled. Requires secret owner, checked as the custom "share" verb on
+   * the secret.
+   *
+   * @generated from rpc holos.console.v1.SecretsService.CreateShareLink
+   */
+  createShareLink: {
+    methodKind: "unary";
+    input: typeof CreateShareLinkRequestSchema;
+    output: typeof CreateShareLinkResponseSchema;
+  },
+  /**
+   * ApplySecretRaw creates or updates a secret from a Secret manifest in
+   * JSON or YAML, e.g. one copied from kubectl or GetSecretRaw, with
+   * server-side apply. Server-populated metadata in the manifest is ignored.
+   * Sharing grants and other console.holos.run annotations and labels are
+   * rejected, except the description, url, and content-types annotations,
+   * because grants are changed with UpdateSharing. Data keys the manifest
+   * omits are kept when another writer set them. Requires authentication
+   * and PERMISSION_SECRETS_WRITE, and only updates secrets with the console
+   * managed-by label.
+   *
+   * @generated from rpc holos.console.v1.SecretsService.ApplySecretRaw
+   */
+  applySecretRaw: {
+    methodKind: "unary";
+    input: typeof ApplySecretRawRequestSchema;
+    output: typeof ApplySecretRawResponseSchema;
+  },
 }>;
 
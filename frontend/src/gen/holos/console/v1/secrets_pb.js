@@ -2,14 +2,18 @@
 // @generated from file holos/console/v1/secrets.proto (package holos.console.v1, syntax proto3)
 /* eslint-disable */
 
-import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import { enumDesc, fileDesc, messageDesc, serviceDesc, tsEnum } from "@bufbuild/protobuf/codegenv2";
+import { file_buf_validate_validate } from "../../../buf/validate/validate_pb";
+import { file_holos_console_v1_diff } from "./diff_pb";
+import { file_holos_console_v1_list_filter } from "./list_filter_pb";
+import { file_holos_console_v1_raw_format } from "./raw_format_pb";
 import { file_holos_console_v1_rbac } from "./rbac_pb";
 
 /**
  * Describes the file holos/console/v1/secrets.proto.
  */
 export const file_holos_console_v1_secrets = /*@__PURE__*/
-  fileDesc("Ch5ob2xvcy9jb25zb2xlL3YxL3NlY3JldHMucHJvdG8SEGhvbG9zLmNvbnNvbGUudjEiMQoQR2V0U2VjcmV0UmVxdWVzdBIMCgRuYW1lGAEgASgJEg8KB3Byb2plY3QYAiABKAkifQoRR2V0U2VjcmV0UmVzcG9uc2USOwoEZGF0YRgBIAMoCzItLmhvbG9zLmNvbnNvbGUudjEuR2V0U2VjcmV0UmVzcG9uc2UuRGF0YUVudHJ5GisKCURhdGFFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAw6AjgBIiUKEkxpc3RTZWNyZXRzUmVxdWVzdBIPCgdwcm9qZWN0GAEgASgJIkgKE0xpc3RTZWNyZXRzUmVzcG9uc2USMQoHc2VjcmV0cxgBIAMoCzIgLmhvbG9zLmNvbnNvbGUudjEuU2VjcmV0TWV0YWRhdGEi4wIKE1VwZGF0ZVNlY3JldFJlcXVlc3QSDAoEbmFtZRgBIAEoCRI9CgRkYXRhGAIgAygLMi8uaG9sb3MuY29uc29sZS52MS5VcGRhdGVTZWNyZXRSZXF1ZXN0LkRhdGFFbnRyeRJKCgtzdHJpbmdfZGF0YRgDIAMoCzI1LmhvbG9zLmNvbnNvbGUudjEuVXBkYXRlU2VjcmV0UmVxdWVzdC5TdHJpbmdEYXRhRW50cnkSGAoLZGVzY3JpcHRpb24YBCABKAlIAIgBARIQCgN1cmwYBSABKAlIAYgBARIPCgdwcm9qZWN0GAYgASgJGisKCURhdGFFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAw6AjgBGjEKD1N0cmluZ0RhdGFFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBQg4KDF9kZXNjcmlwdGlvbkIGCgRfdXJsIhYKFFVwZGF0ZVNlY3JldFJlc3BvbnNlIskDChNDcmVhdGVTZWNyZXRSZXF1ZXN0EgwKBG5hbWUYASABKAkSPQoEZGF0YRgCIAMoCzIvLmhvbG9zLmNvbnNvbGUudjEuQ3JlYXRlU2VjcmV0UmVxdWVzdC5EYXRhRW50cnkSSgoLc3RyaW5nX2RhdGEYAyADKAsyNS5ob2xvcy5jb25zb2xlLnYxLkNyZWF0ZVNlY3JldFJlcXVlc3QuU3RyaW5nRGF0YUVudHJ5EjEKC3VzZXJfZ3JhbnRzGAQgAygLMhwuaG9sb3MuY29uc29sZS52MS5TaGFyZUdyYW50EjEKC3JvbGVfZ3JhbnRzGAUgAygLMhwuaG9sb3MuY29uc29sZS52MS5TaGFyZUdyYW50EhgKC2Rlc2NyaXB0aW9uGAYgASgJSACIAQESEAoDdXJsGAcgASgJSAGIAQESDwoHcHJvamVjdBgIIAEoCRorCglEYXRhRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgMOgI4ARoxCg9TdHJpbmdEYXRhRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4AUIOCgxfZGVzY3JpcHRpb25CBgoEX3VybCIkChRDcmVhdGVTZWNyZXRSZXNwb25zZRIMCgRuYW1lGAEgASgJIjQKE0RlbGV0ZVNlY3JldFJlcXVlc3QSDAoEbmFtZRgBIAEoCRIPCgdwcm9qZWN0GAIgASgJIhYKFERlbGV0ZVNlY3JldFJlc3BvbnNlIvABCg5TZWNyZXRNZXRhZGF0YRIMCgRuYW1lGAEgASgJEhIKCmFjY2Vzc2libGUYAiABKAgSMQoLdXNlcl9ncmFudHMYBSADKAsyHC5ob2xvcy5jb25zb2xlLnYxLlNoYXJlR3JhbnQSMQoLcm9sZV9ncmFudHMYBiADKAsyHC5ob2xvcy5jb25zb2xlLnYxLlNoYXJlR3JhbnQSGAoLZGVzY3JpcHRpb24YByABKAlIAIgBARIQCgN1cmwYCCABKAlIAYgBARISCgpjcmVhdGVkX2F0GAkgASgJQg4KDF9kZXNjcmlwdGlvbkIGCgRfdXJsInkKClNoYXJlR3JhbnQSEQoJcHJpbmNpcGFsGAEgASgJEiQKBHJvbGUYAiABKA4yFi5ob2xvcy5jb25zb2xlLnYxLlJvbGUSEAoDbmJmGAMgASgDSACIAQESEAoDZXhwGAQgASgDSAGIAQFCBgoEX25iZkIGCgRfZXhwIpsBChRVcGRhdGVTaGFyaW5nUmVxdWVzdBIMCgRuYW1lGAEgASgJEjEKC3VzZXJfZ3JhbnRzGAIgAygLMhwuaG9sb3MuY29uc29sZS52MS5TaGFyZUdyYW50EjEKC3JvbGVfZ3JhbnRzGAMgAygLMhwuaG9sb3MuY29uc29sZS52MS5TaGFyZUdyYW50Eg8KB3Byb2plY3QYBCABKAkiSwoVVXBkYXRlU2hhcmluZ1Jlc3BvbnNlEjIKCG1ldGFkYXRhGAEgASgLMiAuaG9sb3MuY29uc29sZS52MS5TZWNyZXRNZXRhZGF0YSI0ChNHZXRTZWNyZXRSYXdSZXF1ZXN0EgwKBG5hbWUYASABKAkSDwoHcHJvamVjdBgCIAEoCSIjChRHZXRTZWNyZXRSYXdSZXNwb25zZRILCgNyYXcYASABKAkyoAUKDlNlY3JldHNTZXJ2aWNlEloKC0xpc3RTZWNyZXRzEiQuaG9sb3MuY29uc29sZS52MS5MaXN0U2VjcmV0c1JlcXVlc3QaJS5ob2xvcy5jb25zb2xlLnYxLkxpc3RTZWNyZXRzUmVzcG9uc2USVAoJR2V0U2VjcmV0EiIuaG9sb3MuY29uc29sZS52MS5HZXRTZWNyZXRSZXF1ZXN0GiMuaG9sb3MuY29uc29sZS52MS5HZXRTZWNyZXRSZXNwb25zZRJdCgxVcGRhdGVTZWNyZXQSJS5ob2xvcy5jb25zb2xlLnYxLlVwZGF0ZVNlY3JldFJlcXVlc3QaJi5ob2xvcy5jb25zb2xlLnYxLlVwZGF0ZVNlY3JldFJlc3BvbnNlEl0KDENyZWF0ZVNlY3JldBIlLmhvbG9zLmNvbnNvbGUudjEuQ3JlYXRlU2VjcmV0UmVxdWVzdBomLmhvbG9zLmNvbnNvbGUudjEuQ3JlYXRlU2VjcmV0UmVzcG9uc2USXQoMRGVsZXRlU2VjcmV0EiUuaG9sb3MuY29uc29sZS52MS5EZWxldGVTZWNyZXRSZXF1ZXN0GiYuaG9sb3MuY29uc29sZS52MS5EZWxldGVTZWNyZXRSZXNwb25zZRJgCg1VcGRhdGVTaGFyaW5nEiYuaG9sb3MuY29uc29sZS52MS5VcGRhdGVTaGFyaW5nUmVxdWVzdBonLmhvbG9zLmNvbnNvbGUudjEuVXBkYXRlU2hhcmluZ1Jlc3BvbnNlEl0KDEdldFNlY3JldFJhdxIlLmhvbG9zLmNvbnNvbGUudjEuR2V0U2VjcmV0UmF3UmVxdWVzdBomLmhvbG9zLmNvbnNvbGUudjEuR2V0U2VjcmV0UmF3UmVzcG9uc2VCQ1pBZ2l0aHViLmNvbS9ob2xvcy1ydW4vaG9sb3MtY29uc29sZS9nZW4vaG9sb3MvY29uc29sZS92MTtjb25zb2xldjFiBnByb3RvMw", [file_holos_console_v1_rbac]);
+  fileDesc("Ch5ob2xvcy9jb25zb2xlL3YxL3NlY3JldHMucHJvdG8SEGhvbG9zLmNvbnNvbGUudjEisQEKEEdldFNlY3JldFJlcXVlc3QSXAoEbmFtZRgBIAEoCUJOukhLyAEBckYY/QEyQV5bYS16MC05XShbLWEtejAtOV0qW2EtejAtOV0pPyhcLlthLXowLTldKFstYS16MC05XSpbYS16MC05XSk/KSokEhcKB3Byb2plY3QYAiABKAlCBrpIA8gBARIPCgdjbHVzdGVyGAMgASgJEhUKDWlmX25vbmVfbWF0Y2gYBCABKAkioQEKEUdldFNlY3JldFJlc3BvbnNlEjsKBGRhdGEYASADKAsyLS5ob2xvcy5jb25zb2xlLnYxLkdldFNlY3JldFJlc3BvbnNlLkRhdGFFbnRyeRIMCgRldGFnGAIgASgJEhQKDG5vdF9tb2RpZmllZBgDIAEoCBorCglEYXRhRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgMOgI4ASKyAQoSTGlzdFNlY3JldHNSZXF1ZXN0EhcKB3Byb2plY3QYASABKAlCBrpIA8gBARIPCgdjbHVzdGVyGAIgASgJEiwKBmZpbHRlchgDIAEoCzIcLmhvbG9zLmNvbnNvbGUudjEuTGlzdEZpbHRlchItCghvcmRlcl9ieRgEIAEoCzIbLmhvbG9zLmNvbnNvbGUudjEuTGlzdE9yZGVyEhUKDWlmX25vbmVfbWF0Y2gYBSABKAkibAoTTGlzdFNlY3JldHNSZXNwb25zZRIxCgdzZWNyZXRzGAEgAygLMiAuaG9sb3MuY29uc29sZS52MS5TZWNyZXRNZXRhZGF0YRIMCgRldGFnGAIgASgJEhQKDG5vdF9tb2RpZmllZBgDIAEoCCKWBQoTVXBkYXRlU2VjcmV0UmVxdWVzdBJcCgRuYW1lGAEgASgJQk66SEvIAQFyRhj9ATJBXlthLXowLTldKFstYS16MC05XSpbYS16MC05XSk/KFwuW2EtejAtOV0oWy1hLXowLTldKlthLXowLTldKT8pKiQSTQoEZGF0YRgCIAMoCzIvLmhvbG9zLmNvbnNvbGUudjEuVXBkYXRlU2VjcmV0UmVxdWVzdC5EYXRhRW50cnlCDrpIC5oBCCoGegQYgIBAEloKC3N0cmluZ19kYXRhGAMgAygLMjUuaG9sb3MuY29uc29sZS52MS5VcGRhdGVTZWNyZXRSZXF1ZXN0LlN0cmluZ0RhdGFFbnRyeUIOukgLmgEIKgZyBCiAgEASIgoLZGVzY3JpcHRpb24YBCABKAlCCLpIBXIDGIAgSACIAQESGgoDdXJsGAUgASgJQgi6SAVyAxiAEEgBiAEBEhcKB3Byb2plY3QYBiABKAlCBrpIA8gBARIPCgdkcnlfcnVuGAcgASgIEg8KB2NsdXN0ZXIYCCABKAkSTgoNY29udGVudF90eXBlcxgJIAMoCzI3LmhvbG9zLmNvbnNvbGUudjEuVXBkYXRlU2VjcmV0UmVxdWVzdC5Db250ZW50VHlwZXNFbnRyeRorCglEYXRhRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgMOgI4ARoxCg9TdHJpbmdEYXRhRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ARozChFDb250ZW50VHlwZXNFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBQg4KDF9kZXNjcmlwdGlvbkIGCgRfdXJsIhYKFFVwZGF0ZVNlY3JldFJlc3BvbnNlIs8EChJQYXRjaFNlY3JldFJlcXVlc3QSXAoEbmFtZRgBIAEoCUJOukhLyAEBckYY/QEyQV5bYS16MC05XShbLWEtejAtOV0qW2EtejAtOV0pPyhcLlthLXowLTldKFstYS16MC05XSpbYS16MC05XSk/KSokEhcKB3Byb2plY3QYAiABKAlCBrpIA8gBARJMCgRkYXRhGAMgAygLMi4uaG9sb3MuY29uc29sZS52MS5QYXRjaFNlY3JldFJlcXVlc3QuRGF0YUVudHJ5Qg66SAuaAQgqBnoEGICAQBJZCgtzdHJpbmdfZGF0YRgEIAMoCzI0LmhvbG9zLmNvbnNvbGUudjEuUGF0Y2hTZWNyZXRSZXF1ZXN0LlN0cmluZ0RhdGFFbnRyeUIOukgLmgEIKgZyBCiAgEASEwoLcmVtb3ZlX2tleXMYBSADKAkSDwoHZHJ5X3J1bhgGIAEoCBIPCgdjbHVzdGVyGAcgASgJEk0KDWNvbnRlbnRfdHlwZXMYCCADKAsyNi5ob2xvcy5jb25zb2xlLnYxLlBhdGNoU2VjcmV0UmVxdWVzdC5Db250ZW50VHlwZXNFbnRyeRorCglEYXRhRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgMOgI4ARoxCg9TdHJpbmdEYXRhRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ARozChFDb250ZW50VHlwZXNFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBIiMKE1BhdGNoU2VjcmV0UmVzcG9uc2USDAoEa2V5cxgBIAMoCSKfAgoWQXBwZW5kU2VjcmV0S2V5UmVxdWVzdBJcCgRuYW1lGAEgASgJQk66SEvIAQFyRhj9ATJBXlthLXowLTldKFstYS16MC05XSpbYS16MC05XSk/KFwuW2EtejAtOV0oWy1hLXowLTldKlthLXowLTldKT8pKiQSFwoHcHJvamVjdBgCIAEoCUIGukgDyAEBEhMKA2tleRgDIAEoCUIGukgDyAEBEhcKBm9mZnNldBgEIAEoA0IHukgEIgIoABIfCgp0b3RhbF9zaXplGAUgASgDQgu6SAgiBhiAgEAgABIYCgVjaHVuaxgGIAEoDEIJukgGegQYgIAQEhQKDGNvbnRlbnRfdHlwZRgHIAEoCRIPCgdjbHVzdGVyGAggASgJIjkKF0FwcGVuZFNlY3JldEtleVJlc3BvbnNlEgwKBHNpemUYASABKAMSEAoIY29tcGxldGUYAiABKAgi4gcKE0NyZWF0ZVNlY3JldFJlcXVlc3QSXAoEbmFtZRgBIAEoCUJOukhLyAEBckYY/QEyQV5bYS16MC05XShbLWEtejAtOV0qW2EtejAtOV0pPyhcLlthLXowLTldKFstYS16MC05XSpbYS16MC05XSk/KSokEk0KBGRhdGEYAiADKAsyLy5ob2xvcy5jb25zb2xlLnYxLkNyZWF0ZVNlY3JldFJlcXVlc3QuRGF0YUVudHJ5Qg66SAuaAQgqBnoEGICAQBJaCgtzdHJpbmdfZGF0YRgDIAMoCzI1LmhvbG9zLmNvbnNvbGUudjEuQ3JlYXRlU2VjcmV0UmVxdWVzdC5TdHJpbmdEYXRhRW50cnlCDrpIC5oBCCoGcgQogIBAEjEKC3VzZXJfZ3JhbnRzGAQgAygLMhwuaG9sb3MuY29uc29sZS52MS5TaGFyZUdyYW50EjEKC3JvbGVfZ3JhbnRzGAUgAygLMhwuaG9sb3MuY29uc29sZS52MS5TaGFyZUdyYW50EiIKC2Rlc2NyaXB0aW9uGAYgASgJQgi6SAVyAxiAIEgAiAEBEhoKA3VybBgHIAEoCUIIukgFcgMYgBBIAYgBARIXCgdwcm9qZWN0GAggASgJQga6SAPIAQESDwoHZHJ5X3J1bhgJIAEoCBJFCghnZW5lcmF0ZRgKIAMoCzIzLmhvbG9zLmNvbnNvbGUudjEuQ3JlYXRlU2VjcmV0UmVxdWVzdC5HZW5lcmF0ZUVudHJ5Eg8KB2NsdXN0ZXIYCyABKAkSTgoNY29udGVudF90eXBlcxgMIAMoCzI3LmhvbG9zLmNvbnNvbGUudjEuQ3JlYXRlU2VjcmV0UmVxdWVzdC5Db250ZW50VHlwZXNFbnRyeRIMCgR0eXBlGA0gASgJEj4KD2RvY2tlcl9yZWdpc3RyeRgOIAEoCzIlLmhvbG9zLmNvbnNvbGUudjEuUmVnaXN0cnlDcmVkZW50aWFscxorCglEYXRhRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgMOgI4ARoxCg9TdHJpbmdEYXRhRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ARpPCg1HZW5lcmF0ZUVudHJ5EgsKA2tleRgBIAEoCRItCgV2YWx1ZRgCIAEoCzIeLmhvbG9zLmNvbnNvbGUudjEuR2VuZXJhdGVTcGVjOgI4ARozChFDb250ZW50VHlwZXNFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBQg4KDF9kZXNjcmlwdGlvbkIGCgRfdXJsInUKE1JlZ2lzdHJ5Q3JlZGVudGlhbHMSGwoGc2VydmVyGAEgASgJQgu6SAjIAQFyAxiAEBIYCgh1c2VybmFtZRgCIAEoCUIGukgDyAEBEhgKCHBhc3N3b3JkGAMgASgJQga6SAPIAQESDQoFZW1haWwYBCABKAkiYQoMR2VuZXJhdGVTcGVjEjAKBmZvcm1hdBgBIAEoDjIgLmhvbG9zLmNvbnNvbGUudjEuR2VuZXJhdGVGb3JtYXQSDgoGbGVuZ3RoGAIgASgFEg8KB2NoYXJzZXQYAyABKAkiswEKFENyZWF0ZVNlY3JldFJlc3BvbnNlEgwKBG5hbWUYASABKAkSVQoQZ2VuZXJhdGVkX3ZhbHVlcxgCIAMoCzI7LmhvbG9zLmNvbnNvbGUudjEuQ3JlYXRlU2VjcmV0UmVzcG9uc2UuR2VuZXJhdGVkVmFsdWVzRW50cnkaNgoUR2VuZXJhdGVkVmFsdWVzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASKNAwoZQ3JlYXRlU1NIS2V5U2VjcmV0UmVxdWVzdBJcCgRuYW1lGAEgASgJQk66SEvIAQFyRhj9ATJBXlthLXowLTldKFstYS16MC05XSpbYS16MC05XSk/KFwuW2EtejAtOV0oWy1hLXowLTldKlthLXowLTldKT8pKiQSFwoHcHJvamVjdBgCIAEoCUIGukgDyAEBEhkKB2NvbW1lbnQYAyABKAlCCLpIBXIDGIACEjEKC3VzZXJfZ3JhbnRzGAQgAygLMhwuaG9sb3MuY29uc29sZS52MS5TaGFyZUdyYW50EjEKC3JvbGVfZ3JhbnRzGAUgAygLMhwuaG9sb3MuY29uc29sZS52MS5TaGFyZUdyYW50EiIKC2Rlc2NyaXB0aW9uGAYgASgJQgi6SAVyAxiAIEgAiAEBEhoKA3VybBgHIAEoCUIIukgFcgMYgBBIAYgBARIPCgdkcnlfcnVuGAggASgIEg8KB2NsdXN0ZXIYCSABKAlCDgoMX2Rlc2NyaXB0aW9uQgYKBF91cmwiUwoaQ3JlYXRlU1NIS2V5U2VjcmV0UmVzcG9uc2USDAoEbmFtZRgBIAEoCRISCgpwdWJsaWNfa2V5GAIgASgJEhMKC2ZpbmdlcnByaW50GAMgASgJIqMBChlFeHBvcnRTZWNyZXRTZWFsZWRSZXF1ZXN0ElwKBG5hbWUYASABKAlCTrpIS8gBAXJGGP0BMkFeW2EtejAtOV0oWy1hLXowLTldKlthLXowLTldKT8oXC5bYS16MC05XShbLWEtejAtOV0qW2EtejAtOV0pPykqJBIXCgdwcm9qZWN0GAIgASgJQga6SAPIAQESDwoHY2x1c3RlchgDIAEoCSJPChpFeHBvcnRTZWNyZXRTZWFsZWRSZXNwb25zZRIQCghtYW5pZmVzdBgBIAEoCRIfChdjZXJ0aWZpY2F0ZV9maW5nZXJwcmludBgCIAEoCSK/AQoWQ3JlYXRlU2hhcmVMaW5rUmVxdWVzdBJcCgRuYW1lGAEgASgJQk66SEvIAQFyRhj9ATJBXlthLXowLTldKFstYS16MC05XSpbYS16MC05XSk/KFwuW2EtejAtOV0oWy1hLXowLTldKlthLXowLTldKT8pKiQSFwoHcHJvamVjdBgCIAEoCUIGukgDyAEBEiAKC3R0bF9zZWNvbmRzGAMgASgDQgu6SAgiBhiA9SQoABIMCgRrZXlzGAQgAygJIjoKF0NyZWF0ZVNoYXJlTGlua1Jlc3BvbnNlEgsKA3VybBgBIAEoCRISCgpleHBpcmVzX2F0GAIgASgJImIKFUFwcGx5U2VjcmV0UmF3UmVxdWVzdBIXCgdwcm9qZWN0GAEgASgJQga6SAPIAQESHwoIbWFuaWZlc3QYAiABKAlCDbpICsgBAXIFKICAgAISDwoHZHJ5X3J1bhgDIAEoCCI3ChZBcHBseVNlY3JldFJhd1Jlc3BvbnNlEgwKBG5hbWUYASABKAkSDwoHY3JlYXRlZBgCIAEoCCLbAgoRRGlmZlNlY3JldFJlcXVlc3QSFAoEbmFtZRgBIAEoCUIGukgDyAEBEhcKB3Byb2plY3QYAiABKAlCBrpIA8gBARJMCg1wcm9wb3NlZF9kYXRhGAMgAygLMjUuaG9sb3MuY29uc29sZS52MS5EaWZmU2VjcmV0UmVxdWVzdC5Qcm9wb3NlZERhdGFFbnRyeRJZChRwcm9wb3NlZF9zdHJpbmdfZGF0YRgEIAMoCzI7LmhvbG9zLmNvbnNvbGUudjEuRGlmZlNlY3JldFJlcXVlc3QuUHJvcG9zZWRTdHJpbmdEYXRhRW50cnkaMwoRUHJvcG9zZWREYXRhRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgMOgI4ARo5ChdQcm9wb3NlZFN0cmluZ0RhdGFFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBInAKD1NlY3JldEtleUNoYW5nZRILCgNrZXkYASABKAkSLAoGY2hhbmdlGAIgASgOMhwuaG9sb3MuY29uc29sZS52MS5DaGFuZ2VUeXBlEhAKCG9sZF9zaXplGAMgASgDEhAKCG5ld19zaXplGAQgASgDImAKEkRpZmZTZWNyZXRSZXNwb25zZRIyCgdjaGFuZ2VzGAEgAygLMiEuaG9sb3MuY29uc29sZS52MS5TZWNyZXRLZXlDaGFuZ2USFgoOdW5jaGFuZ2VkX2tleXMYAiABKAUivQEKE0RlbGV0ZVNlY3JldFJlcXVlc3QSXAoEbmFtZRgBIAEoCUJOukhLyAEBckYY/QEyQV5bYS16MC05XShbLWEtejAtOV0qW2EtejAtOV0pPyhcLlthLXowLTldKFstYS16MC05XSpbYS16MC05XSk/KSokEhcKB3Byb2plY3QYAiABKAlCBrpIA8gBARIPCgdkcnlfcnVuGAMgASgIEg8KB2NsdXN0ZXIYBCABKAkSDQoFZm9yY2UYBSABKAgiFgoURGVsZXRlU2VjcmV0UmVzcG9uc2UiLwoOQmF0Y2hJdGVtRXJyb3ISDAoEY29kZRgBIAEoCRIPCgdtZXNzYWdlGAIgASgJIqkBChZCYXRjaEdldFNlY3JldHNSZXF1ZXN0EmUKBW5hbWVzGAEgAygJQla6SFOSAVAIARBkGAEiSHJGGP0BMkFeW2EtejAtOV0oWy1hLXowLTldKlthLXowLTldKT8oXC5bYS16MC05XShbLWEtejAtOV0qW2EtejAtOV0pPykqJBIXCgdwcm9qZWN0GAIgASgJQga6SAPIAQESDwoHY2x1c3RlchgDIAEoCSJSChdCYXRjaEdldFNlY3JldHNSZXNwb25zZRI3CgdyZXN1bHRzGAEgAygLMiYuaG9sb3MuY29uc29sZS52MS5CYXRjaEdldFNlY3JldFJlc3VsdCLCAQoUQmF0Y2hHZXRTZWNyZXRSZXN1bHQSDAoEbmFtZRgBIAEoCRI+CgRkYXRhGAIgAygLMjAuaG9sb3MuY29uc29sZS52MS5CYXRjaEdldFNlY3JldFJlc3VsdC5EYXRhRW50cnkSLwoFZXJyb3IYAyABKAsyIC5ob2xvcy5jb25zb2xlLnYxLkJhdGNoSXRlbUVycm9yGisKCURhdGFFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAw6AjgBIswBChlCYXRjaERlbGV0ZVNlY3JldHNSZXF1ZXN0EmUKBW5hbWVzGAEgAygJQla6SFOSAVAIARBkGAEiSHJGGP0BMkFeW2EtejAtOV0oWy1hLXowLTldKlthLXowLTldKT8oXC5bYS16MC05XShbLWEtejAtOV0qW2EtejAtOV0pPykqJBIXCgdwcm9qZWN0GAIgASgJQga6SAPIAQESDwoHZHJ5X3J1bhgDIAEoCBIPCgdjbHVzdGVyGAQgASgJEg0KBWZvcmNlGAUgASgIIlgKGkJhdGNoRGVsZXRlU2VjcmV0c1Jlc3BvbnNlEjoKB3Jlc3VsdHMYASADKAsyKS5ob2xvcy5jb25zb2xlLnYxLkJhdGNoRGVsZXRlU2VjcmV0UmVzdWx0IlgKF0JhdGNoRGVsZXRlU2VjcmV0UmVzdWx0EgwKBG5hbWUYASABKAkSLwoFZXJyb3IYAiABKAsyIC5ob2xvcy5jb25zb2xlLnYxLkJhdGNoSXRlbUVycm9yIkIKC1NlY3JldEluVXNlEjMKCWNvbnN1bWVycxgBIAMoCzIgLmhvbG9zLmNvbnNvbGUudjEuU2VjcmV0Q29uc3VtZXIijgEKDlNlY3JldFRvb0xhcmdlEhIKCnNpemVfYnl0ZXMYASABKAMSFQoNY3VycmVudF9ieXRlcxgCIAEoAxITCgtsaW1pdF9ieXRlcxgDIAEoAxIXCg9yZW1haW5pbmdfYnl0ZXMYBCABKAMSEQoJa2V5X2NvdW50GAUgASgFEhAKCG1heF9rZXlzGAYgASgFIpEGCg5TZWNyZXRNZXRhZGF0YRIMCgRuYW1lGAEgASgJEhIKCmFjY2Vzc2libGUYAiABKAgSMQoLdXNlcl9ncmFudHMYBSADKAsyHC5ob2xvcy5jb25zb2xlLnYxLlNoYXJlR3JhbnQSMQoLcm9sZV9ncmFudHMYBiADKAsyHC5ob2xvcy5jb25zb2xlLnYxLlNoYXJlR3JhbnQSGAoLZGVzY3JpcHRpb24YByABKAlIAIgBARIQCgN1cmwYCCABKAlIAYgBARISCgpjcmVhdGVkX2F0GAkgASgJEg4KBnNvdXJjZRgKIAEoCRISCgp1cGRhdGVkX2F0GAsgASgJEhUKDWNyZWF0b3JfZW1haWwYDCABKAkSGAoQbGFzdF9hY2Nlc3NlZF9hdBgNIAEoCRIYChBsYXN0X2FjY2Vzc2VkX2J5GA4gASgJEkkKDWNvbnRlbnRfdHlwZXMYDyADKAsyMi5ob2xvcy5jb25zb2xlLnYxLlNlY3JldE1ldGFkYXRhLkNvbnRlbnRUeXBlc0VudHJ5EgwKBHR5cGUYECABKAkSOQoPdGxzX2NlcnRpZmljYXRlGBEgASgLMiAuaG9sb3MuY29uc29sZS52MS5UTFNDZXJ0aWZpY2F0ZRIWCg5zc2hfcHVibGljX2tleRgSIAEoCRIXCg9zc2hfZmluZ2VycHJpbnQYEyABKAkSEgoKdmF1bHRfcGF0aBgUIAEoCRISCgpzaXplX2J5dGVzGBUgASgDEhgKEHNpemVfbGltaXRfYnl0ZXMYFiABKAMSEQoJa2V5X2NvdW50GBcgASgFEikKCXVzZXJfcm9sZRgYIAEoDjIWLmhvbG9zLmNvbnNvbGUudjEuUm9sZRI2ChB1c2VyX3JvbGVfc291cmNlGBkgASgLMhwuaG9sb3MuY29uc29sZS52MS5Sb2xlU291cmNlGjMKEUNvbnRlbnRUeXBlc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAFCDgoMX2Rlc2NyaXB0aW9uQgYKBF91cmwiqAEKDlRMU0NlcnRpZmljYXRlEg8KB3N1YmplY3QYASABKAkSDgoGaXNzdWVyGAIgASgJEhEKCWRuc19uYW1lcxgDIAMoCRIUCgxpcF9hZGRyZXNzZXMYBCADKAkSFwoPZW1haWxfYWRkcmVzc2VzGAUgAygJEgwKBHVyaXMYBiADKAkSEgoKbm90X2JlZm9yZRgHIAEoCRIRCglub3RfYWZ0ZXIYCCABKAkipgEKClNoYXJlR3JhbnQSEQoJcHJpbmNpcGFsGAEgASgJEiQKBHJvbGUYAiABKA4yFi5ob2xvcy5jb25zb2xlLnYxLlJvbGUSEAoDbmJmGAMgASgDSACIAQESEAoDZXhwGAQgASgDSAGIAQESDAoEa2V5cxgFIAMoCRIMCgRkZW55GAYgASgIEg8KB3BlbmRpbmcYByABKAhCBgoEX25iZkIGCgRfZXhwIqsCChRVcGRhdGVTaGFyaW5nUmVxdWVzdBJcCgRuYW1lGAEgASgJQk66SEvIAQFyRhj9ATJBXlthLXowLTldKFstYS16MC05XSpbYS16MC05XSk/KFwuW2EtejAtOV0oWy1hLXowLTldKlthLXowLTldKT8pKiQSMQoLdXNlcl9ncmFudHMYAiADKAsyHC5ob2xvcy5jb25zb2xlLnYxLlNoYXJlR3JhbnQSMQoLcm9sZV9ncmFudHMYAyADKAsyHC5ob2xvcy5jb25zb2xlLnYxLlNoYXJlR3JhbnQSFwoHcHJvamVjdBgEIAEoCUIGukgDyAEBEg8KB2RyeV9ydW4YBSABKAgSDwoHY2x1c3RlchgGIAEoCRIUCgxpbnZpdGVfdXNlcnMYByABKAgiSwoVVXBkYXRlU2hhcmluZ1Jlc3BvbnNlEjIKCG1ldGFkYXRhGAEgASgLMiAuaG9sb3MuY29uc29sZS52MS5TZWNyZXRNZXRhZGF0YSLoAQoTR2V0U2VjcmV0UmF3UmVxdWVzdBJcCgRuYW1lGAEgASgJQk66SEvIAQFyRhj9ATJBXlthLXowLTldKFstYS16MC05XSpbYS16MC05XSk/KFwuW2EtejAtOV0oWy1hLXowLTldKlthLXowLTldKT8pKiQSFwoHcHJvamVjdBgCIAEoCUIGukgDyAEBEg8KB2NsdXN0ZXIYAyABKAkSKwoGZm9ybWF0GAQgASgOMhsuaG9sb3MuY29uc29sZS52MS5SYXdGb3JtYXQSHAoUc3RyaXBfbWFuYWdlZF9maWVsZHMYBSABKAgiIwoUR2V0U2VjcmV0UmF3UmVzcG9uc2USCwoDcmF3GAEgASgJIrIBChNHZXRTZWNyZXRLZXlSZXF1ZXN0ElwKBG5hbWUYASABKAlCTrpIS8gBAXJGGP0BMkFeW2EtejAtOV0oWy1hLXowLTldKlthLXowLTldKT8oXC5bYS16MC05XShbLWEtejAtOV0qW2EtejAtOV0pPykqJBIXCgdwcm9qZWN0GAIgASgJQga6SAPIAQESEwoDa2V5GAMgASgJQga6SAPIAQESDwoHY2x1c3RlchgEIAEoCSI7ChRHZXRTZWNyZXRLZXlSZXNwb25zZRINCgV2YWx1ZRgBIAEoDBIUCgxjb250ZW50X3R5cGUYAiABKAkiugIKE1JvdGF0ZVNlY3JldFJlcXVlc3QSXAoEbmFtZRgBIAEoCUJOukhLyAEBckYY/QEyQV5bYS16MC05XShbLWEtejAtOV0qW2EtejAtOV0pPyhcLlthLXowLTldKFstYS16MC05XSpbYS16MC05XSk/KSokEhcKB3Byb2plY3QYAiABKAlCBrpIA8gBARI9CgRrZXlzGAMgAygLMi8uaG9sb3MuY29uc29sZS52MS5Sb3RhdGVTZWNyZXRSZXF1ZXN0LktleXNFbnRyeRIPCgdkcnlfcnVuGAQgASgIEg8KB2NsdXN0ZXIYBSABKAkaSwoJS2V5c0VudHJ5EgsKA2tleRgBIAEoCRItCgV2YWx1ZRgCIAEoCzIeLmhvbG9zLmNvbnNvbGUudjEuR2VuZXJhdGVTcGVjOgI4ASLQAQoUUm90YXRlU2VjcmV0UmVzcG9uc2USUQoOcm90YXRlZF92YWx1ZXMYASADKAsyOS5ob2xvcy5jb25zb2xlLnYxLlJvdGF0ZVNlY3JldFJlc3BvbnNlLlJvdGF0ZWRWYWx1ZXNFbnRyeRIYChB3ZWJob29rX25vdGlmaWVkGAIgASgIEhUKDXdlYmhvb2tfZXJyb3IYAyABKAkaNAoSUm90YXRlZFZhbHVlc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEiPQoMUHJvamVjdFF1b3RhEhMKC21heF9zZWNyZXRzGAEgASgDEhgKEG1heF9zZWNyZXRfYnl0ZXMYAiABKAMiOgoRUHJvamVjdFF1b3RhVXNhZ2USDwoHc2VjcmV0cxgBIAEoAxIUCgxzZWNyZXRfYnl0ZXMYAiABKAMiQgoWR2V0UHJvamVjdFF1b3RhUmVxdWVzdBIXCgdwcm9qZWN0GAEgASgJQga6SAPIAQESDwoHY2x1c3RlchgCIAEoCSJ8ChdHZXRQcm9qZWN0UXVvdGFSZXNwb25zZRItCgVsaW1pdBgBIAEoCzIeLmhvbG9zLmNvbnNvbGUudjEuUHJvamVjdFF1b3RhEjIKBXVzYWdlGAIgASgLMiMuaG9sb3MuY29uc29sZS52MS5Qcm9qZWN0UXVvdGFVc2FnZSKfAQoVR2V0U2VjcmV0VXNhZ2VSZXF1ZXN0ElwKBG5hbWUYASABKAlCTrpIS8gBAXJGGP0BMkFeW2EtejAtOV0oWy1hLXowLTldKlthLXowLTldKT8oXC5bYS16MC05XShbLWEtejAtOV0qW2EtejAtOV0pPykqJBIXCgdwcm9qZWN0GAIgASgJQga6SAPIAQESDwoHY2x1c3RlchgDIAEoCSJNCg9TZWNyZXRSZWZlcmVuY2USDAoEdHlwZRgBIAEoCRIRCgljb250YWluZXIYAiABKAkSDAoEbmFtZRgDIAEoCRILCgNrZXkYBCABKAkiYwoOU2VjcmV0Q29uc3VtZXISDAoEa2luZBgBIAEoCRIMCgRuYW1lGAIgASgJEjUKCnJlZmVyZW5jZXMYAyADKAsyIS5ob2xvcy5jb25zb2xlLnYxLlNlY3JldFJlZmVyZW5jZSJmChZHZXRTZWNyZXRVc2FnZVJlc3BvbnNlEjMKCWNvbnN1bWVycxgBIAMoCzIgLmhvbG9zLmNvbnNvbGUudjEuU2VjcmV0Q29uc3VtZXISFwoPdW5zY2FubmVkX2tpbmRzGAIgAygJIkUKGUxpc3REZWxldGVkU2VjcmV0c1JlcXVlc3QSFwoHcHJvamVjdBgBIAEoCUIGukgDyAEBEg8KB2NsdXN0ZXIYAiABKAkiVwoNRGVsZXRlZFNlY3JldBIMCgRuYW1lGAEgASgJEhIKCmRlbGV0ZWRfYXQYAiABKAkSEgoKZGVsZXRlZF9ieRgDIAEoCRIQCghwdXJnZV9hdBgEIAEoCSJOChpMaXN0RGVsZXRlZFNlY3JldHNSZXNwb25zZRIwCgdzZWNyZXRzGAEgAygLMh8uaG9sb3MuY29uc29sZS52MS5EZWxldGVkU2VjcmV0Ip4BChRSZXN0b3JlU2VjcmV0UmVxdWVzdBJcCgRuYW1lGAEgASgJQk66SEvIAQFyRhj9ATJBXlthLXowLTldKFstYS16MC05XSpbYS16MC05XSk/KFwuW2EtejAtOV0oWy1hLXowLTldKlthLXowLTldKT8pKiQSFwoHcHJvamVjdBgCIAEoCUIGukgDyAEBEg8KB2NsdXN0ZXIYAyABKAkiFwoVUmVzdG9yZVNlY3JldFJlc3BvbnNlKp4BCg5HZW5lcmF0ZUZvcm1hdBIfChtHRU5FUkFURV9GT1JNQVRfVU5TUEVDSUZJRUQQABIcChhHRU5FUkFURV9GT1JNQVRfUEFTU1dPUkQQARIXChNHRU5FUkFURV9GT1JNQVRfSEVYEAISGgoWR0VORVJBVEVfRk9STUFUX0JBU0U2NBADEhgKFEdFTkVSQVRFX0ZPUk1BVF9VVUlEEAQytxEKDlNlY3JldHNTZXJ2aWNlEl8KC0xpc3RTZWNyZXRzEiQuaG9sb3MuY29uc29sZS52MS5MaXN0U2VjcmV0c1JlcXVlc3QaJS5ob2xvcy5jb25zb2xlLnYxLkxpc3RTZWNyZXRzUmVzcG9uc2UiA5ACARJUCglHZXRTZWNyZXQSIi5ob2xvcy5jb25zb2xlLnYxLkdldFNlY3JldFJlcXVlc3QaIy5ob2xvcy5jb25zb2xlLnYxLkdldFNlY3JldFJlc3BvbnNlEl0KDFVwZGF0ZVNlY3JldBIlLmhvbG9zLmNvbnNvbGUudjEuVXBkYXRlU2VjcmV0UmVxdWVzdBomLmhvbG9zLmNvbnNvbGUudjEuVXBkYXRlU2VjcmV0UmVzcG9uc2USVwoKRGlmZlNlY3JldBIjLmhvbG9zLmNvbnNvbGUudjEuRGlmZlNlY3JldFJlcXVlc3QaJC5ob2xvcy5jb25zb2xlLnYxLkRpZmZTZWNyZXRSZXNwb25zZRJaCgtQYXRjaFNlY3JldBIkLmhvbG9zLmNvbnNvbGUudjEuUGF0Y2hTZWNyZXRSZXF1ZXN0GiUuaG9sb3MuY29uc29sZS52MS5QYXRjaFNlY3JldFJlc3BvbnNlEmYKD0FwcGVuZFNlY3JldEtleRIoLmhvbG9zLmNvbnNvbGUudjEuQXBwZW5kU2VjcmV0S2V5UmVxdWVzdBopLmhvbG9zLmNvbnNvbGUudjEuQXBwZW5kU2VjcmV0S2V5UmVzcG9uc2USXQoMQ3JlYXRlU2VjcmV0EiUuaG9sb3MuY29uc29sZS52MS5DcmVhdGVTZWNyZXRSZXF1ZXN0GiYuaG9sb3MuY29uc29sZS52MS5DcmVhdGVTZWNyZXRSZXNwb25zZRJdCgxEZWxldGVTZWNyZXQSJS5ob2xvcy5jb25zb2xlLnYxLkRlbGV0ZVNlY3JldFJlcXVlc3QaJi5ob2xvcy5jb25zb2xlLnYxLkRlbGV0ZVNlY3JldFJlc3BvbnNlEmYKD0JhdGNoR2V0U2VjcmV0cxIoLmhvbG9zLmNvbnNvbGUudjEuQmF0Y2hHZXRTZWNyZXRzUmVxdWVzdBopLmhvbG9zLmNvbnNvbGUudjEuQmF0Y2hHZXRTZWNyZXRzUmVzcG9uc2USbwoSQmF0Y2hEZWxldGVTZWNyZXRzEisuaG9sb3MuY29uc29sZS52MS5CYXRjaERlbGV0ZVNlY3JldHNSZXF1ZXN0GiwuaG9sb3MuY29uc29sZS52MS5CYXRjaERlbGV0ZVNlY3JldHNSZXNwb25zZRJgCg1VcGRhdGVTaGFyaW5nEiYuaG9sb3MuY29uc29sZS52MS5VcGRhdGVTaGFyaW5nUmVxdWVzdBonLmhvbG9zLmNvbnNvbGUudjEuVXBkYXRlU2hhcmluZ1Jlc3BvbnNlEl0KDEdldFNlY3JldFJhdxIlLmhvbG9zLmNvbnNvbGUudjEuR2V0U2VjcmV0UmF3UmVxdWVzdBomLmhvbG9zLmNvbnNvbGUudjEuR2V0U2VjcmV0UmF3UmVzcG9uc2USXQoMR2V0U2VjcmV0S2V5EiUuaG9sb3MuY29uc29sZS52MS5HZXRTZWNyZXRLZXlSZXF1ZXN0GiYuaG9sb3MuY29uc29sZS52MS5HZXRTZWNyZXRLZXlSZXNwb25zZRJdCgxSb3RhdGVTZWNyZXQSJS5ob2xvcy5jb25zb2xlLnYxLlJvdGF0ZVNlY3JldFJlcXVlc3QaJi5ob2xvcy5jb25zb2xlLnYxLlJvdGF0ZVNlY3JldFJlc3BvbnNlEmsKD0dldFByb2plY3RRdW90YRIoLmhvbG9zLmNvbnNvbGUudjEuR2V0UHJvamVjdFF1b3RhUmVxdWVzdBopLmhvbG9zLmNvbnNvbGUudjEuR2V0UHJvamVjdFF1b3RhUmVzcG9uc2UiA5ACARJoCg5HZXRTZWNyZXRVc2FnZRInLmhvbG9zLmNvbnNvbGUudjEuR2V0U2VjcmV0VXNhZ2VSZXF1ZXN0GiguaG9sb3MuY29uc29sZS52MS5HZXRTZWNyZXRVc2FnZVJlc3BvbnNlIgOQAgESdAoSTGlzdERlbGV0ZWRTZWNyZXRzEisuaG9sb3MuY29uc29sZS52MS5MaXN0RGVsZXRlZFNlY3JldHNSZXF1ZXN0GiwuaG9sb3MuY29uc29sZS52MS5MaXN0RGVsZXRlZFNlY3JldHNSZXNwb25zZSIDkAIBEmAKDVJlc3RvcmVTZWNyZXQSJi5ob2xvcy5jb25zb2xlLnYxLlJlc3RvcmVTZWNyZXRSZXF1ZXN0GicuaG9sb3MuY29uc29sZS52MS5SZXN0b3JlU2VjcmV0UmVzcG9uc2USbwoSQ3JlYXRlU1NIS2V5U2VjcmV0EisuaG9sb3MuY29uc29sZS52MS5DcmVhdGVTU0hLZXlTZWNyZXRSZXF1ZXN0GiwuaG9sb3MuY29uc29sZS52MS5DcmVhdGVTU0hLZXlTZWNyZXRSZXNwb25zZRJvChJFeHBvcnRTZWNyZXRTZWFsZWQSKy5ob2xvcy5jb25zb2xlLnYxLkV4cG9ydFNlY3JldFNlYWxlZFJlcXVlc3QaLC5ob2xvcy5jb25zb2xlLnYxLkV4cG9ydFNlY3JldFNlYWxlZFJlc3BvbnNlEmYKD0NyZWF0ZVNoYXJlTGluaxIoLmhvbG9zLmNvbnNvbGUudjEuQ3JlYXRlU2hhcmVMaW5rUmVxdWVzdBopLmhvbG9zLmNvbnNvbGUudjEuQ3JlYXRlU2hhcmVMaW5rUmVzcG9uc2USYwoOQXBwbHlTZWNyZXRSYXcSJy5ob2xvcy5jb25zb2xlLnYxLkFwcGx5U2VjcmV0UmF3UmVxdWVzdBooLmhvbG9zLmNvbnNvbGUudjEuQXBwbHlTZWNyZXRSYXdSZXNwb25zZUJDWkFnaXRodWIuY29tL2hvbG9zLXJ1bi9ob2xvcy1jb25zb2xlL2dlbi9ob2xvcy9jb25zb2xlL3YxO2NvbnNvbGV2MWIGcHJvdG8z", [file_buf_validate_validate, file_holos_console_v1_diff, file_holos_console_v1_list_filter, file_holos_console_v1_raw_format, file_holos_console_v1_rbac]);
 
 /**
  * Describes the message holos.console.v1.GetSecretRequest.
@@ -53,75 +57,397 @@ export const UpdateSecretRequestSchema = /*@__PURE__*/
 export const UpdateSecretResponseSchema = /*@__PURE__*/
   messageDesc(file_holos_console_v1_secrets, 5);
 
+/**
+ * Describes the message holos.console.v1.PatchSecretRequest.
+ * Use `create(PatchSecretRequestSchema)` to create a new message.
+ */
+export const PatchSecretRequestSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_secrets, 6);
+
+/**
+ * Describes the message holos.console.v1.PatchSecretResponse.
+ * Use `create(PatchSecretResponseSchema)` to create a new message.
+ */
+export const PatchSecretResponseSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_secrets, 7);
+
+/**
+ * Describes the message holos.console.v1.AppendSecretKeyRequest.
+ * Use `create(AppendSecretKeyRequestSchema)` to create a new message.
+ */
+export const AppendSecretKeyRequestSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_secrets, 8);
+
+/**
+ * Describes the message holos.console.v1.AppendSecretKeyResponse.
+ * Use `create(AppendSecretKeyResponseSchema)` to create a new message.
+ */
+export const AppendSecretKeyResponseSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_secrets, 9);
+
 /**
  * Describes the message holos.console.v1.CreateSecretRequest.
  * Use `create(CreateSecretRequestSchema)` to create a new message.
  */
 export const CreateSecretRequestSchema = /*@__PURE__*/
-  messageDesc(file_holos_console_v1_secrets, 6);
+  messageDesc(file_holos_console_v1_secrets, 10);
+
+/**
+ * Describes the message holos.console.v1.RegistryCredentials.
+ * Use `create(RegistryCredentialsSchema)` to create a new message.
+ */
+export const RegistryCredentialsSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_secrets, 11);
+
+/**
+ * Describes the message holos.console.v1.GenerateSpec.
+ * Use `create(GenerateSpecSchema)` to create a new message.
+ */
+export const GenerateSpecSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_secrets, 12);
 
 /**
  * Describes the message holos.console.v1.CreateSecretResponse.
  * Use `create(CreateSecretResponseSchema)` to create a new message.
  */
 export const CreateSecretResponseSchema = /*@__PURE__*/
-  messageDesc(file_holos_console_v1_secrets, 7);
+  messageDesc(file_holos_console_v1_secrets, 13);
+
+/**
+ * Describes the message holos.console.v1.CreateSSHKeySecretRequest.
+ * Use `create(CreateSSHKeySecretRequestSchema)` to create a new message.
+ */
+export const CreateSSHKeySecretRequestSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_secrets, 14);
+
+/**
+ * Describes the message holos.console.v1.CreateSSHKeySecretResponse.
+ * Use `create(CreateSSHKeySecretResponseSchema)` to create a new message.
+ */
+export const CreateSSHKeySecretResponseSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_secrets, 15);
+
+/**
+ * Describes the message holos.console.v1.ExportSecretSealedRequest.
+ * Use `create(ExportSecretSealedRequestSchema)` to create a new message.
+ */
+export const ExportSecretSealedRequestSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_secrets, 16);
+
+/**
+ * Describes the message holos.console.v1.ExportSecretSealedResponse.
+ * Use `create(ExportSecretSealedResponseSchema)` to create a new message.
+ */
+export const ExportSecretSealedResponseSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_secrets, 17);
+
+/**
+ * Describes the message holos.console.v1.CreateShareLinkRequest.
+ * Use `create(CreateShareLinkRequestSchema)` to create a new message.
+ */
+export const CreateShareLinkRequestSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_secrets, 18);
+
+/**
+ * Describes the message holos.console.v1.CreateShareLinkResponse.
+ * Use `create(CreateShareLinkResponseSchema)` to create a new message.
+ */
+export const CreateShareLinkResponseSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_secrets, 19);
+
+/**
+ * Describes the message holos.console.v1.ApplySecretRawRequest.
+ * Use `create(ApplySecretRawRequestSchema)` to create a new message.
+ */
+export const ApplySecretRawRequestSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_secrets, 20);
+
+/**
+ * Describes the message holos.console.v1.ApplySecretRawResponse.
+ * Use `create(ApplySecretRawResponseSchema)` to create a new message.
+ */
+export const ApplySecretRawResponseSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_secrets, 21);
+
+/**
+ * Describes the message holos.console.v1.DiffSecretRequest.
+ * Use `create(DiffSecretRequestSchema)` to create a new message.
+ */
+export const DiffSecretRequestSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_secrets, 22);
+
+/**
+ * Describes the message holos.console.v1.SecretKeyChange.
+ * Use `create(SecretKeyChangeSchema)` to create a new message.
+ */
+export const SecretKeyChangeSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_secrets, 23);
+
+/**
+ * Describes the message holos.console.v1.DiffSecretResponse.
+ * Use `create(DiffSecretResponseSchema)` to create a new message.
+ */
+export const DiffSecretResponseSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_secrets, 24);
 
 /**
  * Describes the message holos.console.v1.DeleteSecretRequest.
  * Use `create(DeleteSecretRequestSchema)` to create a new message.
  */
 export const DeleteSecretRequestSchema = /*@__PURE__*/
-  messageDesc(file_holos_console_v1_secrets, 8);
+  messageDesc(file_holos_console_v1_secrets, 25);
 
 /**
  * Describes the message holos.console.v1.DeleteSecretResponse.
  * Use `create(DeleteSecretResponseSchema)` to create a new message.
  */
 export const DeleteSecretResponseSchema = /*@__PURE__*/
-  messageDesc(file_holos_console_v1_secrets, 9);
+  messageDesc(file_holos_console_v1_secrets, 26);
+
+/**
+ * Describes the message holos.console.v1.BatchItemError.
+ * Use `create(BatchItemErrorSchema)` to create a new message.
+ */
+export const BatchItemErrorSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_secrets, 27);
+
+/**
+ * Describes the message holos.console.v1.BatchGetSecretsRequest.
+ * Use `create(BatchGetSecretsRequestSchema)` to create a new message.
+ */
+export const BatchGetSecretsRequestSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_secrets, 28);
+
+/**
+ * Describes the message holos.console.v1.BatchGetSecretsResponse.
+ * Use `create(BatchGetSecretsResponseSchema)` to create a new message.
+ */
+export const BatchGetSecretsResponseSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_secrets, 29);
+
+/**
+ * Describes the message holos.console.v1.BatchGetSecretResult.
+ * Use `create(BatchGetSecretResultSchema)` to create a new message.
+ */
+export const BatchGetSecretResultSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_secrets, 30);
+
+/**
+ * Describes the message holos.console.v1.BatchDeleteSecretsRequest.
+ * Use `create(BatchDeleteSecretsRequestSchema)` to create a new message.
+ */
+export const BatchDeleteSecretsRequestSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_secrets, 31);
+
+/**
+ * Describes the message holos.console.v1.BatchDeleteSecretsResponse.
+ * Use `create(BatchDeleteSecretsResponseSchema)` to create a new message.
+ */
+export const BatchDeleteSecretsResponseSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_secrets, 32);
+
+/**
+ * Describes the message holos.console.v1.BatchDeleteSecretResult.
+ * Use `create(BatchDeleteSecretResultSchema)` to create a new message.
+ */
+export const BatchDeleteSecretResultSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_secrets, 33);
+
+/**
+ * Describes the message holos.console.v1.SecretInUse.
+ * Use `create(SecretInUseSchema)` to create a new message.
+ */
+export const SecretInUseSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_secrets, 34);
+
+/**
+ * Describes the message holos.console.v1.SecretTooLarge.
+ * Use `create(SecretTooLargeSchema)` to create a new message.
+ */
+export const SecretTooLargeSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_secrets, 35);
 
 /**
  * Describes the message holos.console.v1.SecretMetadata.
  * Use `create(SecretMetadataSchema)` to create a new message.
  */
 export const SecretMetadataSchema = /*@__PURE__*/
-  messageDesc(file_holos_console_v1_secrets, 10);
+  messageDesc(file_holos_console_v1_secrets, 36);
+
+/**
+ * Describes the message holos.console.v1.TLSCertificate.
+ * Use `create(TLSCertificateSchema)` to create a new message.
+ */
+export const TLSCertificateSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_secrets, 37);
 
 /**
  * Describes the message holos.console.v1.ShareGrant.
  * Use `create(ShareGrantSchema)` to create a new message.
  */
 export const ShareGrantSchema = /*@__PURE__*/
-  messageDesc(file_holos_console_v1_secrets, 11);
+  messageDesc(file_holos_console_v1_secrets, 38);
 
 /**
  * Describes the message holos.console.v1.UpdateSharingRequest.
  * Use `create(UpdateSharingRequestSchema)` to create a new message.
  */
 export const UpdateSharingRequestSchema = /*@__PURE__*/
-  messageDesc(file_holos_console_v1_secrets, 12);
+  messageDesc(file_holos_console_v1_secrets, 39);
 
 /**
  * Describes the message holos.console.v1.UpdateSharingResponse.
  * Use `create(UpdateSharingResponseSchema)` to create a new message.
  */
 export const UpdateSharingResponseSchema = /*@__PURE__*/
-  messageDesc(file_holos_console_v1_secrets, 13);
+  messageDesc(file_holos_console_v1_secrets, 40);
 
 /**
  * Describes the message holos.console.v1.GetSecretRawRequest.
  * Use `create(GetSecretRawRequestSchema)` to create a new message.
  */
 export const GetSecretRawRequestSchema = /*@__PURE__*/
-  messageDesc(file_holos_console_v1_secrets, 14);
+  messageDesc(file_holos_console_v1_secrets, 41);
 
 /**
  * Describes the message holos.console.v1.GetSecretRawResponse.
  * Use `create(GetSecretRawResponseSchema)` to create a new message.
  */
 export const GetSecretRawResponseSchema = /*@__PURE__*/
-  messageDesc(file_holos_console_v1_secrets, 15);
+  messageDesc(file_holos_console_v1_secrets, 42);
+
+/**
+ * Describes the message holos.console.v1.GetSecretKeyRequest.
+ * Use `create(GetSecretKeyRequestSchema)` to create a new message.
+ */
+export const GetSecretKeyRequestSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_secrets, 43);
+
+/**
+ * Describes the message holos.console.v1.GetSecretKeyResponse.
+ * Use `create(GetSecretKeyResponseSchema)` to create a new message.
+ */
+export const GetSecretKeyResponseSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_secrets, 44);
+
+/**
+ * Describes the message holos.console.v1.RotateSecretRequest.
+ * Use `create(RotateSecretRequestSchema)` to create a new message.
+ */
+export const RotateSecretRequestSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_secrets, 45);
+
+/**
+ * Describes the message holos.console.v1.RotateSecretResponse.
+ * Use `create(RotateSecretResponseSchema)` to create a new message.
+ */
+export const RotateSecretResponseSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_secrets, 46);
+
+/**
+ * Describes the message holos.console.v1.ProjectQuota.
+ * Use `create(ProjectQuotaSchema)` to create a new message.
+ */
+export const ProjectQuotaSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_secrets, 47);
+
+/**
+ * Describes the message holos.console.v1.ProjectQuotaUsage.
+ * Use `create(ProjectQuotaUsageSchema)` to create a new message.
+ */
+export const ProjectQuotaUsageSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_secrets, 48);
+
+/**
+ * Describes the message holos.console.v1.GetProjectQuotaRequest.
+ * Use `create(GetProjectQuotaRequestSchema)` to create a new message.
+ */
+export const GetProjectQuotaRequestSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_secrets, 49);
+
+/**
+ * Describes the message holos.console.v1.GetProjectQuotaResponse.
+ * Use `create(GetProjectQuotaResponseSchema)` to create a new message.
+ */
+export const GetProjectQuotaResponseSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_secrets, 50);
+
+/**
+ * Describes the message holos.console.v1.GetSecretUsageRequest.
+ * Use `create(GetSecretUsageRequestSchema)` to create a new message.
+ */
+export const GetSecretUsageRequestSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_secrets, 51);
+
+/**
+ * Describes the message holos.console.v1.SecretReference.
+ * Use `create(SecretReferenceSchema)` to create a new message.
+ */
+export const SecretReferenceSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_secrets, 52);
+
+/**
+ * Describes the message holos.console.v1.SecretConsumer.
+ * Use `create(SecretConsumerSchema)` to create a new message.
+ */
+export const SecretConsumerSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_secrets, 53);
+
+/**
+ * Describes the message holos.console.v1.GetSecretUsageResponse.
+ * Use `create(GetSecretUsageResponseSchema)` to create a new message.
+ */
+export const GetSecretUsageResponseSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_secrets, 54);
+
+/**
+ * Describes the message holos.console.v1.ListDeletedSecretsRequest.
+ * Use `create(ListDeletedSecretsRequestSchema)` to create a new message.
+ */
+export const ListDeletedSecretsRequestSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_secrets, 55);
+
+/**
+ * Describes the message holos.console.v1.DeletedSecret.
+ * Use `create(DeletedSecretSchema)` to create a new message.
+ */
+export const DeletedSecretSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_secrets, 56);
+
+/**
+ * Describes the message holos.console.v1.ListDeletedSecretsResponse.
+ * Use `create(ListDeletedSecretsResponseSchema)` to create a new message.
+ */
+export const ListDeletedSecretsResponseSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_secrets, 57);
+
+/**
+ * Describes the message holos.console.v1.RestoreSecretRequest.
+ * Use `create(RestoreSecretRequestSchema)` to create a new message.
+ */
+export const RestoreSecretRequestSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_secrets, 58);
+
+/**
+ * Describes the message holos.console.v1.RestoreSecretResponse.
+ * Use `create(RestoreSecretResponseSchema)` to create a new message.
+ */
+export const RestoreSecretResponseSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_secrets, 59);
+
+/**
+ * Describes the enum holos.console.v1.GenerateFormat.
+ */
+export const GenerateFormatSchema = /*@__PURE__*/
+  enumDesc(file_holos_console_v1_secrets, 0);
+
+/**
+ * GenerateFormat selects how a generated secret value is encoded.
+ *
+ * @generated from enum holos.console.v1.GenerateFormat
+ */
+export const GenerateFormat = /*@__PURE__*/
+  tsEnum(GenerateFormatSchema);
 
 /**
  * SecretsService provides access to Kubernetes secrets with RBAC.
@@ -10,7 +10,7 @@ import { file_holos_console_v1_policy_state } from "./policy_state_pb";
  * Describes the file holos/console/v1/template_dependencies.proto.
  */
 export const file_holos_console_v1_template_dependencies = /*@__PURE__*/
-  fileDesc("Cixob2xvcy9jb25zb2xlL3YxL3RlbXBsYXRlX2RlcGVuZGVuY2llcy5wcm90bxIQaG9sb3MuY29uc29sZS52MSLXAgoSVGVtcGxhdGVEZXBlbmRlbmN5EgwKBG5hbWUYASABKAkSEQoJbmFtZXNwYWNlGAIgASgJEjYKCWRlcGVuZGVudBgDIAEoCzIjLmhvbG9zLmNvbnNvbGUudjEuTGlua2VkVGVtcGxhdGVSZWYSNQoIcmVxdWlyZXMYBCABKAsyIy5ob2xvcy5jb25zb2xlLnYxLkxpbmtlZFRlbXBsYXRlUmVmEhsKDmNhc2NhZGVfZGVsZXRlGAUgASgISACIAQESFQoNY3JlYXRvcl9lbWFpbBgGIAEoCRIuCgpjcmVhdGVkX2F0GAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBI6CgZzdGF0dXMYCCABKAsyKi5ob2xvcy5jb25zb2xlLnYxLlRlbXBsYXRlRGVwZW5kZW5jeVN0YXR1c0IRCg9fY2FzY2FkZV9kZWxldGUiegoYVGVtcGxhdGVEZXBlbmRlbmN5U3RhdHVzEhsKE29ic2VydmVkX2dlbmVyYXRpb24YASABKAMSQQoKY29uZGl0aW9ucxgCIAMoCzItLmhvbG9zLmNvbnNvbGUudjEuVGVtcGxhdGVEZXBlbmRlbmN5Q29uZGl0aW9uIrMBChtUZW1wbGF0ZURlcGVuZGVuY3lDb25kaXRpb24SDAoEdHlwZRgBIAEoCRIOCgZzdGF0dXMYAiABKAkSDgoGcmVhc29uGAMgASgJEg8KB21lc3NhZ2UYBCABKAkSGwoTb2JzZXJ2ZWRfZ2VuZXJhdGlvbhgFIAEoAxI4ChRsYXN0X3RyYW5zaXRpb25fdGltZRgGIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiNAofTGlzdFRlbXBsYXRlRGVwZW5kZW5jaWVzUmVxdWVzdBIRCgluYW1lc3BhY2UYASABKAkiXgogTGlzdFRlbXBsYXRlRGVwZW5kZW5jaWVzUmVzcG9uc2USOgoMZGVwZW5kZW5jaWVzGAEgAygLMiQuaG9sb3MuY29uc29sZS52MS5UZW1wbGF0ZURlcGVuZGVuY3kiPwocR2V0VGVtcGxhdGVEZXBlbmRlbmN5UmVxdWVzdBIRCgluYW1lc3BhY2UYASABKAkSDAoEbmFtZRgCIAEoCSJZCh1HZXRUZW1wbGF0ZURlcGVuZGVuY3lSZXNwb25zZRI4CgpkZXBlbmRlbmN5GAEgASgLMiQuaG9sb3MuY29uc29sZS52MS5UZW1wbGF0ZURlcGVuZGVuY3kibgofQ3JlYXRlVGVtcGxhdGVEZXBlbmRlbmN5UmVxdWVzdBIRCgluYW1lc3BhY2UYASABKAkSOAoKZGVwZW5kZW5jeRgCIAEoCzIkLmhvbG9zLmNvbnNvbGUudjEuVGVtcGxhdGVEZXBlbmRlbmN5IjAKIENyZWF0ZVRlbXBsYXRlRGVwZW5kZW5jeVJlc3BvbnNlEgwKBG5hbWUYASABKAkibgofVXBkYXRlVGVtcGxhdGVEZXBlbmRlbmN5UmVxdWVzdBIRCgluYW1lc3BhY2UYASABKAkSOAoKZGVwZW5kZW5jeRgCIAEoCzIkLmhvbG9zLmNvbnNvbGUudjEuVGVtcGxhdGVEZXBlbmRlbmN5IiIKIFVwZGF0ZVRlbXBsYXRlRGVwZW5kZW5jeVJlc3BvbnNlIkIKH0RlbGV0ZVRlbXBsYXRlRGVwZW5kZW5jeVJlcXVlc3QSEQoJbmFtZXNwYWNlGAEgASgJEgwKBG5hbWUYAiABKAkiIgogRGVsZXRlVGVtcGxhdGVEZXBlbmRlbmN5UmVzcG9uc2UypQUKGVRlbXBsYXRlRGVwZW5kZW5jeVNlcnZpY2USgQEKGExpc3RUZW1wbGF0ZURlcGVuZGVuY2llcxIxLmhvbG9zLmNvbnNvbGUudjEuTGlzdFRlbXBsYXRlRGVwZW5kZW5jaWVzUmVxdWVzdBoyLmhvbG9zLmNvbnNvbGUudjEuTGlzdFRlbXBsYXRlRGVwZW5kZW5jaWVzUmVzcG9uc2USeAoVR2V0VGVtcGxhdGVEZXBlbmRlbmN5Ei4uaG9sb3MuY29uc29sZS52MS5HZXRUZW1wbGF0ZURlcGVuZGVuY3lSZXF1ZXN0Gi8uaG9sb3MuY29uc29sZS52MS5HZXRUZW1wbGF0ZURlcGVuZGVuY3lSZXNwb25zZRKBAQoYQ3JlYXRlVGVtcGxhdGVEZXBlbmRlbmN5EjEuaG9sb3MuY29uc29sZS52MS5DcmVhdGVUZW1wbGF0ZURlcGVuZGVuY3lSZXF1ZXN0GjIuaG9sb3MuY29uc29sZS52MS5DcmVhdGVUZW1wbGF0ZURlcGVuZGVuY3lSZXNwb25zZRKBAQoYVXBkYXRlVGVtcGxhdGVEZXBlbmRlbmN5EjEuaG9sb3MuY29uc29sZS52MS5VcGRhdGVUZW1wbGF0ZURlcGVuZGVuY3lSZXF1ZXN0GjIuaG9sb3MuY29uc29sZS52MS5VcGRhdGVUZW1wbGF0ZURlcGVuZGVuY3lSZXNwb25zZRKBAQoYRGVsZXRlVGVtcGxhdGVEZXBlbmRlbmN5EjEuaG9sb3MuY29uc29sZS52MS5EZWxldGVUZW1wbGF0ZURlcGVuZGVuY3lSZXF1ZXN0GjIuaG9sb3MuY29uc29sZS52MS5EZWxldGVUZW1wbGF0ZURlcGVuZGVuY3lSZXNwb25zZUJDWkFnaXRodWIuY29tL2hvbG9zLXJ1bi9ob2xvcy1jb25zb2xlL2dlbi9ob2xvcy9jb25zb2xlL3YxO2NvbnNvbGV2MWIGcHJvdG8z", [file_google_protobuf_timestamp, file_holos_console_v1_policy_state]);
+  fileDesc("Cixob2xvcy9jb25zb2xlL3YxL3RlbXBsYXRlX2RlcGVuZGVuY2llcy5wcm90bxIQaG9sb3MuY29uc29sZS52MSLXAgoSVGVtcGxhdGVEZXBlbmRlbmN5EgwKBG5hbWUYASABKAkSEQoJbmFtZXNwYWNlGAIgASgJEjYKCWRlcGVuZGVudBgDIAEoCzIjLmhvbG9zLmNvbnNvbGUudjEuTGlua2VkVGVtcGxhdGVSZWYSNQoIcmVxdWlyZXMYBCABKAsyIy5ob2xvcy5jb25zb2xlLnYxLkxpbmtlZFRlbXBsYXRlUmVmEhsKDmNhc2NhZGVfZGVsZXRlGAUgASgISACIAQESFQoNY3JlYXRvcl9lbWFpbBgGIAEoCRIuCgpjcmVhdGVkX2F0GAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBI6CgZzdGF0dXMYCCABKAsyKi5ob2xvcy5jb25zb2xlLnYxLlRlbXBsYXRlRGVwZW5kZW5jeVN0YXR1c0IRCg9fY2FzY2FkZV9kZWxldGUiegoYVGVtcGxhdGVEZXBlbmRlbmN5U3RhdHVzEhsKE29ic2VydmVkX2dlbmVyYXRpb24YASABKAMSQQoKY29uZGl0aW9ucxgCIAMoCzItLmhvbG9zLmNvbnNvbGUudjEuVGVtcGxhdGVEZXBlbmRlbmN5Q29uZGl0aW9uIrMBChtUZW1wbGF0ZURlcGVuZGVuY3lDb25kaXRpb24SDAoEdHlwZRgBIAEoCRIOCgZzdGF0dXMYAiABKAkSDgoGcmVhc29uGAMgASgJEg8KB21lc3NhZ2UYBCABKAkSGwoTb2JzZXJ2ZWRfZ2VuZXJhdGlvbhgFIAEoAxI4ChRsYXN0X3RyYW5zaXRpb25fdGltZRgGIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiNAofTGlzdFRlbXBsYXRlRGVwZW5kZW5jaWVzUmVxdWVzdBIRCgluYW1lc3BhY2UYASABKAkiXgogTGlzdFRlbXBsYXRlRGVwZW5kZW5jaWVzUmVzcG9uc2USOgoMZGVwZW5kZW5jaWVzGAEgAygLMiQuaG9sb3MuY29uc29sZS52MS5UZW1wbGF0ZURlcGVuZGVuY3kiPwocR2V0VGVtcGxhdGVEZXBlbmRlbmN5UmVxdWVzdBIRCgluYW1lc3BhY2UYASABKAkSDAoEbmFtZRgCIAEoCSJZCh1HZXRUZW1wbGF0ZURlcGVuZGVuY3lSZXNwb25zZRI4CgpkZXBlbmRlbmN5GAEgASgLMiQuaG9sb3MuY29uc29sZS52MS5UZW1wbGF0ZURlcGVuZGVuY3kibgofQ3JlYXRlVGVtcGxhdGVEZXBlbmRlbmN5UmVxdWVzdBIRCgluYW1lc3BhY2UYASABKAkSOAoKZGVwZW5kZW5jeRgCIAEoCzIkLmhvbG9zLmNvbnNvbGUudjEuVGVtcGxhdGVEZXBlbmRlbmN5IjAKIENyZWF0ZVRlbXBsYXRlRGVwZW5kZW5jeVJlc3BvbnNlEgwKBG5hbWUYASABKAkibgofVXBkYXRlVGVtcGxhdGVEZXBlbmRlbmN5UmVxdWVzdBIRCgluYW1lc3BhY2UYASABKAkSOAoKZGVwZW5kZW5jeRgCIAEoCzIkLmhvbG9zLmNvbnNvbGUudjEuVGVtcGxhdGVEZXBlbmRlbmN5IiIKIFVwZGF0ZVRlbXBsYXRlRGVwZW5kZW5jeVJlc3BvbnNlIkIKH0RlbGV0ZVRlbXBsYXRlRGVwZW5kZW5jeVJlcXVlc3QSEQoJbmFtZXNwYWNlGAEgASgJEgwKBG5hbWUYAiABKAkiIgogRGVsZXRlVGVtcGxhdGVEZXBlbmRlbmN5UmVzcG9uc2UyrwUKGVRlbXBsYXRlRGVwZW5kZW5jeVNlcnZpY2UShgEKGExpc3RUZW1wbGF0ZURlcGVuZGVuY2llcxIxLmhvbG9zLmNvbnNvbGUudjEuTGlzdFRlbXBsYXRlRGVwZW5kZW5jaWVzUmVxdWVzdBoyLmhvbG9zLmNvbnNvbGUudjEuTGlzdFRlbXBsYXRlRGVwZW5kZW5jaWVzUmVzcG9uc2UiA5ACARJ9ChVHZXRUZW1wbGF0ZURlcGVuZGVuY3kSLi5ob2xvcy5jb25zb2xlLnYxLkdldFRlbXBsYXRlRGVwZW5kZW5jeVJlcXVlc3QaLy5ob2xvcy5jb25zb2xlLnYxLkdldFRlbXBsYXRlRGVwZW5kZW5jeVJlc3BvbnNlIgOQAgESgQEKGENyZWF0ZVRlbXBsYXRlRGVwZW5kZW5jeRIxLmhvbG9zLmNvbnNvbGUudjEuQ3JlYXRlVGVtcGxhdGVEZXBlbmRlbmN5UmVxdWVzdBoyLmhvbG9zLmNvbnNvbGUudjEuQ3JlYXRlVGVtcGxhdGVEZXBlbmRlbmN5UmVzcG9uc2USgQEKGFVwZGF0ZVRlbXBsYXRlRGVwZW5kZW5jeRIxLmhvbG9zLmNvbnNvbGUudjEuVXBkYXRlVGVtcGxhdGVEZXBlbmRlbmN5UmVxdWVzdBoyLmhvbG9zLmNvbnNvbGUudjEuVXBkYXRlVGVtcGxhdGVEZXBlbmRlbmN5UmVzcG9uc2USgQEKGERlbGV0ZVRlbXBsYXRlRGVwZW5kZW5jeRIxLmhvbG9zLmNvbnNvbGUudjEuRGVsZXRlVGVtcGxhdGVEZXBlbmRlbmN5UmVxdWVzdBoyLmhvbG9zLmNvbnNvbGUudjEuRGVsZXRlVGVtcGxhdGVEZXBlbmRlbmN5UmVzcG9uc2VCQ1pBZ2l0aHViLmNvbS9ob2xvcy1ydW4vaG9sb3MtY29uc29sZS9nZW4vaG9sb3MvY29uc29sZS92MTtjb25zb2xldjFiBnByb3RvMw", [file_google_protobuf_timestamp, file_holos_console_v1_policy_state]);
 
 /**
  * Describes the message holos.console.v1.TemplateDependency.
@@ -9,7 +9,7 @@ import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
  * Describes the file holos/console/v1/template_grants.proto.
  */
 export const file_holos_console_v1_template_grants = /*@__PURE__*/
-  fileDesc("CiZob2xvcy9jb25zb2xlL3YxL3RlbXBsYXRlX2dyYW50cy5wcm90bxIQaG9sb3MuY29uc29sZS52MSJ3ChRUZW1wbGF0ZUdyYW50RnJvbVJlZhIRCgluYW1lc3BhY2UYASABKAkSTAoSbmFtZXNwYWNlX3NlbGVjdG9yGAIgASgLMjAuaG9sb3MuY29uc29sZS52MS5UZW1wbGF0ZUdyYW50TmFtZXNwYWNlU2VsZWN0b3IirQEKHlRlbXBsYXRlR3JhbnROYW1lc3BhY2VTZWxlY3RvchJXCgxtYXRjaF9sYWJlbHMYASADKAsyQS5ob2xvcy5jb25zb2xlLnYxLlRlbXBsYXRlR3JhbnROYW1lc3BhY2VTZWxlY3Rvci5NYXRjaExhYmVsc0VudHJ5GjIKEE1hdGNoTGFiZWxzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASKWAgoNVGVtcGxhdGVHcmFudBIMCgRuYW1lGAEgASgJEhEKCW5hbWVzcGFjZRgCIAEoCRI0CgRmcm9tGAMgAygLMiYuaG9sb3MuY29uc29sZS52MS5UZW1wbGF0ZUdyYW50RnJvbVJlZhIwCgJ0bxgEIAMoCzIkLmhvbG9zLmNvbnNvbGUudjEuVGVtcGxhdGVHcmFudFRvUmVmEhUKDWNyZWF0b3JfZW1haWwYBSABKAkSLgoKY3JlYXRlZF9hdBgGIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASNQoGc3RhdHVzGAcgASgLMiUuaG9sb3MuY29uc29sZS52MS5UZW1wbGF0ZUdyYW50U3RhdHVzIjUKElRlbXBsYXRlR3JhbnRUb1JlZhIRCgluYW1lc3BhY2UYASABKAkSDAoEbmFtZRgCIAEoCSJwChNUZW1wbGF0ZUdyYW50U3RhdHVzEhsKE29ic2VydmVkX2dlbmVyYXRpb24YASABKAMSPAoKY29uZGl0aW9ucxgCIAMoCzIoLmhvbG9zLmNvbnNvbGUudjEuVGVtcGxhdGVHcmFudENvbmRpdGlvbiKuAQoWVGVtcGxhdGVHcmFudENvbmRpdGlvbhIMCgR0eXBlGAEgASgJEg4KBnN0YXR1cxgCIAEoCRIOCgZyZWFzb24YAyABKAkSDwoHbWVzc2FnZRgEIAEoCRIbChNvYnNlcnZlZF9nZW5lcmF0aW9uGAUgASgDEjgKFGxhc3RfdHJhbnNpdGlvbl90aW1lGAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCIuChlMaXN0VGVtcGxhdGVHcmFudHNSZXF1ZXN0EhEKCW5hbWVzcGFjZRgBIAEoCSJNChpMaXN0VGVtcGxhdGVHcmFudHNSZXNwb25zZRIvCgZncmFudHMYASADKAsyHy5ob2xvcy5jb25zb2xlLnYxLlRlbXBsYXRlR3JhbnQiOgoXR2V0VGVtcGxhdGVHcmFudFJlcXVlc3QSEQoJbmFtZXNwYWNlGAEgASgJEgwKBG5hbWUYAiABKAkiSgoYR2V0VGVtcGxhdGVHcmFudFJlc3BvbnNlEi4KBWdyYW50GAEgASgLMh8uaG9sb3MuY29uc29sZS52MS5UZW1wbGF0ZUdyYW50Il8KGkNyZWF0ZVRlbXBsYXRlR3JhbnRSZXF1ZXN0EhEKCW5hbWVzcGFjZRgBIAEoCRIuCgVncmFudBgCIAEoCzIfLmhvbG9zLmNvbnNvbGUudjEuVGVtcGxhdGVHcmFudCIrChtDcmVhdGVUZW1wbGF0ZUdyYW50UmVzcG9uc2USDAoEbmFtZRgBIAEoCSJfChpVcGRhdGVUZW1wbGF0ZUdyYW50UmVxdWVzdBIRCgluYW1lc3BhY2UYASABKAkSLgoFZ3JhbnQYAiABKAsyHy5ob2xvcy5jb25zb2xlLnYxLlRlbXBsYXRlR3JhbnQiHQobVXBkYXRlVGVtcGxhdGVHcmFudFJlc3BvbnNlIj0KGkRlbGV0ZVRlbXBsYXRlR3JhbnRSZXF1ZXN0EhEKCW5hbWVzcGFjZRgBIAEoCRIMCgRuYW1lGAIgASgJIh0KG0RlbGV0ZVRlbXBsYXRlR3JhbnRSZXNwb25zZTLOBAoUVGVtcGxhdGVHcmFudFNlcnZpY2USbwoSTGlzdFRlbXBsYXRlR3JhbnRzEisuaG9sb3MuY29uc29sZS52MS5MaXN0VGVtcGxhdGVHcmFudHNSZXF1ZXN0GiwuaG9sb3MuY29uc29sZS52MS5MaXN0VGVtcGxhdGVHcmFudHNSZXNwb25zZRJpChBHZXRUZW1wbGF0ZUdyYW50EikuaG9sb3MuY29uc29sZS52MS5HZXRUZW1wbGF0ZUdyYW50UmVxdWVzdBoqLmhvbG9zLmNvbnNvbGUudjEuR2V0VGVtcGxhdGVHcmFudFJlc3BvbnNlEnIKE0NyZWF0ZVRlbXBsYXRlR3JhbnQSLC5ob2xvcy5jb25zb2xlLnYxLkNyZWF0ZVRlbXBsYXRlR3JhbnRSZXF1ZXN0Gi0uaG9sb3MuY29uc29sZS52MS5DcmVhdGVUZW1wbGF0ZUdyYW50UmVzcG9uc2UScgoTVXBkYXRlVGVtcGxhdGVHcmFudBIsLmhvbG9zLmNvbnNvbGUudjEuVXBkYXRlVGVtcGxhdGVHcmFudFJlcXVlc3QaLS5ob2xvcy5jb25zb2xlLnYxLlVwZGF0ZVRlbXBsYXRlR3JhbnRSZXNwb25zZRJyChNEZWxldGVUZW1wbGF0ZUdyYW50EiwuaG9sb3MuY29uc29sZS52MS5EZWxldGVUZW1wbGF0ZUdyYW50UmVxdWVzdBotLmhvbG9zLmNvbnNvbGUudjEuRGVsZXRlVGVtcGxhdGVHcmFudFJlc3BvbnNlQkNaQWdpdGh1Yi5jb20vaG9sb3MtcnVuL2hvbG9zLWNvbnNvbGUvZ2VuL2hvbG9zL2NvbnNvbGUvdjE7Y29uc29sZXYxYgZwcm90bzM", [file_google_protobuf_timestamp]);
+  fileDesc("CiZob2xvcy9jb25zb2xlL3YxL3RlbXBsYXRlX2dyYW50cy5wcm90bxIQaG9sb3MuY29uc29sZS52MSJ3ChRUZW1wbGF0ZUdyYW50RnJvbVJlZhIRCgluYW1lc3BhY2UYASABKAkSTAoSbmFtZXNwYWNlX3NlbGVjdG9yGAIgASgLMjAuaG9sb3MuY29uc29sZS52MS5UZW1wbGF0ZUdyYW50TmFtZXNwYWNlU2VsZWN0b3IirQEKHlRlbXBsYXRlR3JhbnROYW1lc3BhY2VTZWxlY3RvchJXCgxtYXRjaF9sYWJlbHMYASADKAsyQS5ob2xvcy5jb25zb2xlLnYxLlRlbXBsYXRlR3JhbnROYW1lc3BhY2VTZWxlY3Rvci5NYXRjaExhYmVsc0VudHJ5GjIKEE1hdGNoTGFiZWxzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASKWAgoNVGVtcGxhdGVHcmFudBIMCgRuYW1lGAEgASgJEhEKCW5hbWVzcGFjZRgCIAEoCRI0CgRmcm9tGAMgAygLMiYuaG9sb3MuY29uc29sZS52MS5UZW1wbGF0ZUdyYW50RnJvbVJlZhIwCgJ0bxgEIAMoCzIkLmhvbG9zLmNvbnNvbGUudjEuVGVtcGxhdGVHcmFudFRvUmVmEhUKDWNyZWF0b3JfZW1haWwYBSABKAkSLgoKY3JlYXRlZF9hdBgGIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASNQoGc3RhdHVzGAcgASgLMiUuaG9sb3MuY29uc29sZS52MS5UZW1wbGF0ZUdyYW50U3RhdHVzIjUKElRlbXBsYXRlR3JhbnRUb1JlZhIRCgluYW1lc3BhY2UYASABKAkSDAoEbmFtZRgCIAEoCSJwChNUZW1wbGF0ZUdyYW50U3RhdHVzEhsKE29ic2VydmVkX2dlbmVyYXRpb24YASABKAMSPAoKY29uZGl0aW9ucxgCIAMoCzIoLmhvbG9zLmNvbnNvbGUudjEuVGVtcGxhdGVHcmFudENvbmRpdGlvbiKuAQoWVGVtcGxhdGVHcmFudENvbmRpdGlvbhIMCgR0eXBlGAEgASgJEg4KBnN0YXR1cxgCIAEoCRIOCgZyZWFzb24YAyABKAkSDwoHbWVzc2FnZRgEIAEoCRIbChNvYnNlcnZlZF9nZW5lcmF0aW9uGAUgASgDEjgKFGxhc3RfdHJhbnNpdGlvbl90aW1lGAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCIuChlMaXN0VGVtcGxhdGVHcmFudHNSZXF1ZXN0EhEKCW5hbWVzcGFjZRgBIAEoCSJNChpMaXN0VGVtcGxhdGVHcmFudHNSZXNwb25zZRIvCgZncmFudHMYASADKAsyHy5ob2xvcy5jb25zb2xlLnYxLlRlbXBsYXRlR3JhbnQiOgoXR2V0VGVtcGxhdGVHcmFudFJlcXVlc3QSEQoJbmFtZXNwYWNlGAEgASgJEgwKBG5hbWUYAiABKAkiSgoYR2V0VGVtcGxhdGVHcmFudFJlc3BvbnNlEi4KBWdyYW50GAEgASgLMh8uaG9sb3MuY29uc29sZS52MS5UZW1wbGF0ZUdyYW50Il8KGkNyZWF0ZVRlbXBsYXRlR3JhbnRSZXF1ZXN0EhEKCW5hbWVzcGFjZRgBIAEoCRIuCgVncmFudBgCIAEoCzIfLmhvbG9zLmNvbnNvbGUudjEuVGVtcGxhdGVHcmFudCIrChtDcmVhdGVUZW1wbGF0ZUdyYW50UmVzcG9uc2USDAoEbmFtZRgBIAEoCSJfChpVcGRhdGVUZW1wbGF0ZUdyYW50UmVxdWVzdBIRCgluYW1lc3BhY2UYASABKAkSLgoFZ3JhbnQYAiABKAsyHy5ob2xvcy5jb25zb2xlLnYxLlRlbXBsYXRlR3JhbnQiHQobVXBkYXRlVGVtcGxhdGVHcmFudFJlc3BvbnNlIj0KGkRlbGV0ZVRlbXBsYXRlR3JhbnRSZXF1ZXN0EhEKCW5hbWVzcGFjZRgBIAEoCRIMCgRuYW1lGAIgASgJIh0KG0RlbGV0ZVRlbXBsYXRlR3JhbnRSZXNwb25zZTLYBAoUVGVtcGxhdGVHcmFudFNlcnZpY2USdAoSTGlzdFRlbXBsYXRlR3JhbnRzEisuaG9sb3MuY29uc29sZS52MS5MaXN0VGVtcGxhdGVHcmFudHNSZXF1ZXN0GiwuaG9sb3MuY29uc29sZS52MS5MaXN0VGVtcGxhdGVHcmFudHNSZXNwb25zZSIDkAIBEm4KEEdldFRlbXBsYXRlR3JhbnQSKS5ob2xvcy5jb25zb2xlLnYxLkdldFRlbXBsYXRlR3JhbnRSZXF1ZXN0GiouaG9sb3MuY29uc29sZS52MS5HZXRUZW1wbGF0ZUdyYW50UmVzcG9uc2UiA5ACARJyChNDcmVhdGVUZW1wbGF0ZUdyYW50EiwuaG9sb3MuY29uc29sZS52MS5DcmVhdGVUZW1wbGF0ZUdyYW50UmVxdWVzdBotLmhvbG9zLmNvbnNvbGUudjEuQ3JlYXRlVGVtcGxhdGVHcmFudFJlc3BvbnNlEnIKE1VwZGF0ZVRlbXBsYXRlR3JhbnQSLC5ob2xvcy5jb25zb2xlLnYxLlVwZGF0ZVRlbXBsYXRlR3JhbnRSZXF1ZXN0Gi0uaG9sb3MuY29uc29sZS52MS5VcGRhdGVUZW1wbGF0ZUdyYW50UmVzcG9uc2UScgoTRGVsZXRlVGVtcGxhdGVHcmFudBIsLmhvbG9zLmNvbnNvbGUudjEuRGVsZXRlVGVtcGxhdGVHcmFudFJlcXVlc3QaLS5ob2xvcy5jb25zb2xlLnYxLkRlbGV0ZVRlbXBsYXRlR3JhbnRSZXNwb25zZUJDWkFnaXRodWIuY29tL2hvbG9zLXJ1bi9ob2xvcy1jb25zb2xlL2dlbi9ob2xvcy9jb25zb2xlL3YxO2NvbnNvbGV2MWIGcHJvdG8z", [file_google_protobuf_timestamp]);
 
 /**
  * Describes the message holos.console.v1.TemplateGrantFromRef.
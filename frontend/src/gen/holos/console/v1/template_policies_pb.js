@@ -10,7 +10,7 @@ import { file_holos_console_v1_policy_state } from "./policy_state_pb";
  * Describes the file holos/console/v1/template_policies.proto.
  */
 export const file_holos_console_v1_template_policies = /*@__PURE__*/
-  fileDesc("Cihob2xvcy9jb25zb2xlL3YxL3RlbXBsYXRlX3BvbGljaWVzLnByb3RvEhBob2xvcy5jb25zb2xlLnYxIhoKFFRlbXBsYXRlUG9saWN5VGFyZ2V0OgIYASKNAQoSVGVtcGxhdGVQb2xpY3lSdWxlEjIKBGtpbmQYASABKA4yJC5ob2xvcy5jb25zb2xlLnYxLlRlbXBsYXRlUG9saWN5S2luZBI1Cgh0ZW1wbGF0ZRgCIAEoCzIjLmhvbG9zLmNvbnNvbGUudjEuTGlua2VkVGVtcGxhdGVSZWZKBAgDEARSBnRhcmdldCLYAQoOVGVtcGxhdGVQb2xpY3kSDAoEbmFtZRgBIAEoCRIRCgluYW1lc3BhY2UYAiABKAkSFAoMZGlzcGxheV9uYW1lGAMgASgJEhMKC2Rlc2NyaXB0aW9uGAQgASgJEjMKBXJ1bGVzGAUgAygLMiQuaG9sb3MuY29uc29sZS52MS5UZW1wbGF0ZVBvbGljeVJ1bGUSFQoNY3JlYXRvcl9lbWFpbBgGIAEoCRIuCgpjcmVhdGVkX2F0GAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCIwChtMaXN0VGVtcGxhdGVQb2xpY2llc1JlcXVlc3QSEQoJbmFtZXNwYWNlGAEgASgJIlIKHExpc3RUZW1wbGF0ZVBvbGljaWVzUmVzcG9uc2USMgoIcG9saWNpZXMYASADKAsyIC5ob2xvcy5jb25zb2xlLnYxLlRlbXBsYXRlUG9saWN5IjsKGEdldFRlbXBsYXRlUG9saWN5UmVxdWVzdBIRCgluYW1lc3BhY2UYASABKAkSDAoEbmFtZRgCIAEoCSJNChlHZXRUZW1wbGF0ZVBvbGljeVJlc3BvbnNlEjAKBnBvbGljeRgBIAEoCzIgLmhvbG9zLmNvbnNvbGUudjEuVGVtcGxhdGVQb2xpY3kiYgobQ3JlYXRlVGVtcGxhdGVQb2xpY3lSZXF1ZXN0EhEKCW5hbWVzcGFjZRgBIAEoCRIwCgZwb2xpY3kYAiABKAsyIC5ob2xvcy5jb25zb2xlLnYxLlRlbXBsYXRlUG9saWN5IiwKHENyZWF0ZVRlbXBsYXRlUG9saWN5UmVzcG9uc2USDAoEbmFtZRgBIAEoCSJiChtVcGRhdGVUZW1wbGF0ZVBvbGljeVJlcXVlc3QSEQoJbmFtZXNwYWNlGAEgASgJEjAKBnBvbGljeRgCIAEoCzIgLmhvbG9zLmNvbnNvbGUudjEuVGVtcGxhdGVQb2xpY3kiHgocVXBkYXRlVGVtcGxhdGVQb2xpY3lSZXNwb25zZSI+ChtEZWxldGVUZW1wbGF0ZVBvbGljeVJlcXVlc3QSEQoJbmFtZXNwYWNlGAEgASgJEgwKBG5hbWUYAiABKAkiHgocRGVsZXRlVGVtcGxhdGVQb2xpY3lSZXNwb25zZSJKChZMaW5rYWJsZVRlbXBsYXRlUG9saWN5EjAKBnBvbGljeRgBIAEoCzIgLmhvbG9zLmNvbnNvbGUudjEuVGVtcGxhdGVQb2xpY3kiOAojTGlzdExpbmthYmxlVGVtcGxhdGVQb2xpY2llc1JlcXVlc3QSEQoJbmFtZXNwYWNlGAEgASgJImIKJExpc3RMaW5rYWJsZVRlbXBsYXRlUG9saWNpZXNSZXNwb25zZRI6Cghwb2xpY2llcxgBIAMoCzIoLmhvbG9zLmNvbnNvbGUudjEuTGlua2FibGVUZW1wbGF0ZVBvbGljeSp+ChJUZW1wbGF0ZVBvbGljeUtpbmQSJAogVEVNUExBVEVfUE9MSUNZX0tJTkRfVU5TUEVDSUZJRUQQABIgChxURU1QTEFURV9QT0xJQ1lfS0lORF9SRVFVSVJFEAESIAocVEVNUExBVEVfUE9MSUNZX0tJTkRfRVhDTFVERRACMvEFChVUZW1wbGF0ZVBvbGljeVNlcnZpY2USdQoUTGlzdFRlbXBsYXRlUG9saWNpZXMSLS5ob2xvcy5jb25zb2xlLnYxLkxpc3RUZW1wbGF0ZVBvbGljaWVzUmVxdWVzdBouLmhvbG9zLmNvbnNvbGUudjEuTGlzdFRlbXBsYXRlUG9saWNpZXNSZXNwb25zZRJsChFHZXRUZW1wbGF0ZVBvbGljeRIqLmhvbG9zLmNvbnNvbGUudjEuR2V0VGVtcGxhdGVQb2xpY3lSZXF1ZXN0GisuaG9sb3MuY29uc29sZS52MS5HZXRUZW1wbGF0ZVBvbGljeVJlc3BvbnNlEnUKFENyZWF0ZVRlbXBsYXRlUG9saWN5Ei0uaG9sb3MuY29uc29sZS52MS5DcmVhdGVUZW1wbGF0ZVBvbGljeVJlcXVlc3QaLi5ob2xvcy5jb25zb2xlLnYxLkNyZWF0ZVRlbXBsYXRlUG9saWN5UmVzcG9uc2USdQoUVXBkYXRlVGVtcGxhdGVQb2xpY3kSLS5ob2xvcy5jb25zb2xlLnYxLlVwZGF0ZVRlbXBsYXRlUG9saWN5UmVxdWVzdBouLmhvbG9zLmNvbnNvbGUudjEuVXBkYXRlVGVtcGxhdGVQb2xpY3lSZXNwb25zZRJ1ChREZWxldGVUZW1wbGF0ZVBvbGljeRItLmhvbG9zLmNvbnNvbGUudjEuRGVsZXRlVGVtcGxhdGVQb2xpY3lSZXF1ZXN0Gi4uaG9sb3MuY29uc29sZS52MS5EZWxldGVUZW1wbGF0ZVBvbGljeVJlc3BvbnNlEo0BChxMaXN0TGlua2FibGVUZW1wbGF0ZVBvbGljaWVzEjUuaG9sb3MuY29uc29sZS52MS5MaXN0TGlua2FibGVUZW1wbGF0ZVBvbGljaWVzUmVxdWVzdBo2LmhvbG9zLmNvbnNvbGUudjEuTGlzdExpbmthYmxlVGVtcGxhdGVQb2xpY2llc1Jlc3BvbnNlQkNaQWdpdGh1Yi5jb20vaG9sb3MtcnVuL2hvbG9zLWNvbnNvbGUvZ2VuL2hvbG9zL2NvbnNvbGUvdjE7Y29uc29sZXYxYgZwcm90bzM", [file_google_protobuf_timestamp, file_holos_console_v1_policy_state]);
+  fileDesc("Cihob2xvcy9jb25zb2xlL3YxL3RlbXBsYXRlX3BvbGljaWVzLnByb3RvEhBob2xvcy5jb25zb2xlLnYxIhoKFFRlbXBsYXRlUG9saWN5VGFyZ2V0OgIYASKNAQoSVGVtcGxhdGVQb2xpY3lSdWxlEjIKBGtpbmQYASABKA4yJC5ob2xvcy5jb25zb2xlLnYxLlRlbXBsYXRlUG9saWN5S2luZBI1Cgh0ZW1wbGF0ZRgCIAEoCzIjLmhvbG9zLmNvbnNvbGUudjEuTGlua2VkVGVtcGxhdGVSZWZKBAgDEARSBnRhcmdldCLYAQoOVGVtcGxhdGVQb2xpY3kSDAoEbmFtZRgBIAEoCRIRCgluYW1lc3BhY2UYAiABKAkSFAoMZGlzcGxheV9uYW1lGAMgASgJEhMKC2Rlc2NyaXB0aW9uGAQgASgJEjMKBXJ1bGVzGAUgAygLMiQuaG9sb3MuY29uc29sZS52MS5UZW1wbGF0ZVBvbGljeVJ1bGUSFQoNY3JlYXRvcl9lbWFpbBgGIAEoCRIuCgpjcmVhdGVkX2F0GAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCIwChtMaXN0VGVtcGxhdGVQb2xpY2llc1JlcXVlc3QSEQoJbmFtZXNwYWNlGAEgASgJIlIKHExpc3RUZW1wbGF0ZVBvbGljaWVzUmVzcG9uc2USMgoIcG9saWNpZXMYASADKAsyIC5ob2xvcy5jb25zb2xlLnYxLlRlbXBsYXRlUG9saWN5IjsKGEdldFRlbXBsYXRlUG9saWN5UmVxdWVzdBIRCgluYW1lc3BhY2UYASABKAkSDAoEbmFtZRgCIAEoCSJNChlHZXRUZW1wbGF0ZVBvbGljeVJlc3BvbnNlEjAKBnBvbGljeRgBIAEoCzIgLmhvbG9zLmNvbnNvbGUudjEuVGVtcGxhdGVQb2xpY3kiYgobQ3JlYXRlVGVtcGxhdGVQb2xpY3lSZXF1ZXN0EhEKCW5hbWVzcGFjZRgBIAEoCRIwCgZwb2xpY3kYAiABKAsyIC5ob2xvcy5jb25zb2xlLnYxLlRlbXBsYXRlUG9saWN5IiwKHENyZWF0ZVRlbXBsYXRlUG9saWN5UmVzcG9uc2USDAoEbmFtZRgBIAEoCSJiChtVcGRhdGVUZW1wbGF0ZVBvbGljeVJlcXVlc3QSEQoJbmFtZXNwYWNlGAEgASgJEjAKBnBvbGljeRgCIAEoCzIgLmhvbG9zLmNvbnNvbGUudjEuVGVtcGxhdGVQb2xpY3kiHgocVXBkYXRlVGVtcGxhdGVQb2xpY3lSZXNwb25zZSI+ChtEZWxldGVUZW1wbGF0ZVBvbGljeVJlcXVlc3QSEQoJbmFtZXNwYWNlGAEgASgJEgwKBG5hbWUYAiABKAkiHgocRGVsZXRlVGVtcGxhdGVQb2xpY3lSZXNwb25zZSJKChZMaW5rYWJsZVRlbXBsYXRlUG9saWN5EjAKBnBvbGljeRgBIAEoCzIgLmhvbG9zLmNvbnNvbGUudjEuVGVtcGxhdGVQb2xpY3kiOAojTGlzdExpbmthYmxlVGVtcGxhdGVQb2xpY2llc1JlcXVlc3QSEQoJbmFtZXNwYWNlGAEgASgJImIKJExpc3RMaW5rYWJsZVRlbXBsYXRlUG9saWNpZXNSZXNwb25zZRI6Cghwb2xpY2llcxgBIAMoCzIoLmhvbG9zLmNvbnNvbGUudjEuTGlua2FibGVUZW1wbGF0ZVBvbGljeSp+ChJUZW1wbGF0ZVBvbGljeUtpbmQSJAogVEVNUExBVEVfUE9MSUNZX0tJTkRfVU5TUEVDSUZJRUQQABIgChxURU1QTEFURV9QT0xJQ1lfS0lORF9SRVFVSVJFEAESIAocVEVNUExBVEVfUE9MSUNZX0tJTkRfRVhDTFVERRACMoAGChVUZW1wbGF0ZVBvbGljeVNlcnZpY2USegoUTGlzdFRlbXBsYXRlUG9saWNpZXMSLS5ob2xvcy5jb25zb2xlLnYxLkxpc3RUZW1wbGF0ZVBvbGljaWVzUmVxdWVzdBouLmhvbG9zLmNvbnNvbGUudjEuTGlzdFRlbXBsYXRlUG9saWNpZXNSZXNwb25zZSIDkAIBEnEKEUdldFRlbXBsYXRlUG9saWN5EiouaG9sb3MuY29uc29sZS52MS5HZXRUZW1wbGF0ZVBvbGljeVJlcXVlc3QaKy5ob2xvcy5jb25zb2xlLnYxLkdldFRlbXBsYXRlUG9saWN5UmVzcG9uc2UiA5ACARJ1ChRDcmVhdGVUZW1wbGF0ZVBvbGljeRItLmhvbG9zLmNvbnNvbGUudjEuQ3JlYXRlVGVtcGxhdGVQb2xpY3lSZXF1ZXN0Gi4uaG9sb3MuY29uc29sZS52MS5DcmVhdGVUZW1wbGF0ZVBvbGljeVJlc3BvbnNlEnUKFFVwZGF0ZVRlbXBsYXRlUG9saWN5Ei0uaG9sb3MuY29uc29sZS52MS5VcGRhdGVUZW1wbGF0ZVBvbGljeVJlcXVlc3QaLi5ob2xvcy5jb25zb2xlLnYxLlVwZGF0ZVRlbXBsYXRlUG9saWN5UmVzcG9uc2USdQoURGVsZXRlVGVtcGxhdGVQb2xpY3kSLS5ob2xvcy5jb25zb2xlLnYxLkRlbGV0ZVRlbXBsYXRlUG9saWN5UmVxdWVzdBouLmhvbG9zLmNvbnNvbGUudjEuRGVsZXRlVGVtcGxhdGVQb2xpY3lSZXNwb25zZRKSAQocTGlzdExpbmthYmxlVGVtcGxhdGVQb2xpY2llcxI1LmhvbG9zLmNvbnNvbGUudjEuTGlzdExpbmthYmxlVGVtcGxhdGVQb2xpY2llc1JlcXVlc3QaNi5ob2xvcy5jb25zb2xlLnYxLkxpc3RMaW5rYWJsZVRlbXBsYXRlUG9saWNpZXNSZXNwb25zZSIDkAIBQkNaQWdpdGh1Yi5jb20vaG9sb3MtcnVuL2hvbG9zLWNvbnNvbGUvZ2VuL2hvbG9zL2NvbnNvbGUvdjE7Y29uc29sZXYxYgZwcm90bzM", [file_google_protobuf_timestamp, file_holos_console_v1_policy_state]);
 
 /**
  * Describes the message holos.console.v1.TemplatePolicyTarget.
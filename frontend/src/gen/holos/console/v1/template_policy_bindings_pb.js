@@ -9,7 +9,7 @@ import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
  * Describes the file holos/console/v1/template_policy_bindings.proto.
  */
 export const file_holos_console_v1_template_policy_bindings = /*@__PURE__*/
-  fileDesc("Ci9ob2xvcy9jb25zb2xlL3YxL3RlbXBsYXRlX3BvbGljeV9iaW5kaW5ncy5wcm90bxIQaG9sb3MuY29uc29sZS52MSI6ChdMaW5rZWRUZW1wbGF0ZVBvbGljeVJlZhIRCgluYW1lc3BhY2UYASABKAkSDAoEbmFtZRgCIAEoCSKFAQoeVGVtcGxhdGVQb2xpY3lCaW5kaW5nVGFyZ2V0UmVmEj8KBGtpbmQYASABKA4yMS5ob2xvcy5jb25zb2xlLnYxLlRlbXBsYXRlUG9saWN5QmluZGluZ1RhcmdldEtpbmQSDAoEbmFtZRgCIAEoCRIUCgxwcm9qZWN0X25hbWUYAyABKAkisAIKFVRlbXBsYXRlUG9saWN5QmluZGluZxIMCgRuYW1lGAEgASgJEhEKCW5hbWVzcGFjZRgCIAEoCRIUCgxkaXNwbGF5X25hbWUYAyABKAkSEwoLZGVzY3JpcHRpb24YBCABKAkSPQoKcG9saWN5X3JlZhgFIAEoCzIpLmhvbG9zLmNvbnNvbGUudjEuTGlua2VkVGVtcGxhdGVQb2xpY3lSZWYSRQoLdGFyZ2V0X3JlZnMYBiADKAsyMC5ob2xvcy5jb25zb2xlLnYxLlRlbXBsYXRlUG9saWN5QmluZGluZ1RhcmdldFJlZhIVCg1jcmVhdG9yX2VtYWlsGAcgASgJEi4KCmNyZWF0ZWRfYXQYCCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIjYKIUxpc3RUZW1wbGF0ZVBvbGljeUJpbmRpbmdzUmVxdWVzdBIRCgluYW1lc3BhY2UYASABKAkiXwoiTGlzdFRlbXBsYXRlUG9saWN5QmluZGluZ3NSZXNwb25zZRI5CghiaW5kaW5ncxgBIAMoCzInLmhvbG9zLmNvbnNvbGUudjEuVGVtcGxhdGVQb2xpY3lCaW5kaW5nIkIKH0dldFRlbXBsYXRlUG9saWN5QmluZGluZ1JlcXVlc3QSEQoJbmFtZXNwYWNlGAEgASgJEgwKBG5hbWUYAiABKAkiXAogR2V0VGVtcGxhdGVQb2xpY3lCaW5kaW5nUmVzcG9uc2USOAoHYmluZGluZxgBIAEoCzInLmhvbG9zLmNvbnNvbGUudjEuVGVtcGxhdGVQb2xpY3lCaW5kaW5nInEKIkNyZWF0ZVRlbXBsYXRlUG9saWN5QmluZGluZ1JlcXVlc3QSEQoJbmFtZXNwYWNlGAEgASgJEjgKB2JpbmRpbmcYAiABKAsyJy5ob2xvcy5jb25zb2xlLnYxLlRlbXBsYXRlUG9saWN5QmluZGluZyIzCiNDcmVhdGVUZW1wbGF0ZVBvbGljeUJpbmRpbmdSZXNwb25zZRIMCgRuYW1lGAEgASgJInEKIlVwZGF0ZVRlbXBsYXRlUG9saWN5QmluZGluZ1JlcXVlc3QSEQoJbmFtZXNwYWNlGAEgASgJEjgKB2JpbmRpbmcYAiABKAsyJy5ob2xvcy5jb25zb2xlLnYxLlRlbXBsYXRlUG9saWN5QmluZGluZyIlCiNVcGRhdGVUZW1wbGF0ZVBvbGljeUJpbmRpbmdSZXNwb25zZSJFCiJEZWxldGVUZW1wbGF0ZVBvbGljeUJpbmRpbmdSZXF1ZXN0EhEKCW5hbWVzcGFjZRgBIAEoCRIMCgRuYW1lGAIgASgJIiUKI0RlbGV0ZVRlbXBsYXRlUG9saWN5QmluZGluZ1Jlc3BvbnNlKv8BCh9UZW1wbGF0ZVBvbGljeUJpbmRpbmdUYXJnZXRLaW5kEjMKL1RFTVBMQVRFX1BPTElDWV9CSU5ESU5HX1RBUkdFVF9LSU5EX1VOU1BFQ0lGSUVEEAASOAo0VEVNUExBVEVfUE9MSUNZX0JJTkRJTkdfVEFSR0VUX0tJTkRfUFJPSkVDVF9URU1QTEFURRABEjIKLlRFTVBMQVRFX1BPTElDWV9CSU5ESU5HX1RBUkdFVF9LSU5EX0RFUExPWU1FTlQQAhI5CjVURU1QTEFURV9QT0xJQ1lfQklORElOR19UQVJHRVRfS0lORF9QUk9KRUNUX05BTUVTUEFDRRADMtMFChxUZW1wbGF0ZVBvbGljeUJpbmRpbmdTZXJ2aWNlEocBChpMaXN0VGVtcGxhdGVQb2xpY3lCaW5kaW5ncxIzLmhvbG9zLmNvbnNvbGUudjEuTGlzdFRlbXBsYXRlUG9saWN5QmluZGluZ3NSZXF1ZXN0GjQuaG9sb3MuY29uc29sZS52MS5MaXN0VGVtcGxhdGVQb2xpY3lCaW5kaW5nc1Jlc3BvbnNlEoEBChhHZXRUZW1wbGF0ZVBvbGljeUJpbmRpbmcSMS5ob2xvcy5jb25zb2xlLnYxLkdldFRlbXBsYXRlUG9saWN5QmluZGluZ1JlcXVlc3QaMi5ob2xvcy5jb25zb2xlLnYxLkdldFRlbXBsYXRlUG9saWN5QmluZGluZ1Jlc3BvbnNlEooBChtDcmVhdGVUZW1wbGF0ZVBvbGljeUJpbmRpbmcSNC5ob2xvcy5jb25zb2xlLnYxLkNyZWF0ZVRlbXBsYXRlUG9saWN5QmluZGluZ1JlcXVlc3QaNS5ob2xvcy5jb25zb2xlLnYxLkNyZWF0ZVRlbXBsYXRlUG9saWN5QmluZGluZ1Jlc3BvbnNlEooBChtVcGRhdGVUZW1wbGF0ZVBvbGljeUJpbmRpbmcSNC5ob2xvcy5jb25zb2xlLnYxLlVwZGF0ZVRlbXBsYXRlUG9saWN5QmluZGluZ1JlcXVlc3QaNS5ob2xvcy5jb25zb2xlLnYxLlVwZGF0ZVRlbXBsYXRlUG9saWN5QmluZGluZ1Jlc3BvbnNlEooBChtEZWxldGVUZW1wbGF0ZVBvbGljeUJpbmRpbmcSNC5ob2xvcy5jb25zb2xlLnYxLkRlbGV0ZVRlbXBsYXRlUG9saWN5QmluZGluZ1JlcXVlc3QaNS5ob2xvcy5jb25zb2xlLnYxLkRlbGV0ZVRlbXBsYXRlUG9saWN5QmluZGluZ1Jlc3BvbnNlQkNaQWdpdGh1Yi5jb20vaG9sb3MtcnVuL2hvbG9zLWNvbnNvbGUvZ2VuL2hvbG9zL2NvbnNvbGUvdjE7Y29uc29sZXYxYgZwcm90bzM", [file_google_protobuf_timestamp]);
+  fileDesc("Ci9ob2xvcy9jb25zb2xlL3YxL3RlbXBsYXRlX3BvbGljeV9iaW5kaW5ncy5wcm90bxIQaG9sb3MuY29uc29sZS52MSI6ChdMaW5rZWRUZW1wbGF0ZVBvbGljeVJlZhIRCgluYW1lc3BhY2UYASABKAkSDAoEbmFtZRgCIAEoCSKFAQoeVGVtcGxhdGVQb2xpY3lCaW5kaW5nVGFyZ2V0UmVmEj8KBGtpbmQYASABKA4yMS5ob2xvcy5jb25zb2xlLnYxLlRlbXBsYXRlUG9saWN5QmluZGluZ1RhcmdldEtpbmQSDAoEbmFtZRgCIAEoCRIUCgxwcm9qZWN0X25hbWUYAyABKAkisAIKFVRlbXBsYXRlUG9saWN5QmluZGluZxIMCgRuYW1lGAEgASgJEhEKCW5hbWVzcGFjZRgCIAEoCRIUCgxkaXNwbGF5X25hbWUYAyABKAkSEwoLZGVzY3JpcHRpb24YBCABKAkSPQoKcG9saWN5X3JlZhgFIAEoCzIpLmhvbG9zLmNvbnNvbGUudjEuTGlua2VkVGVtcGxhdGVQb2xpY3lSZWYSRQoLdGFyZ2V0X3JlZnMYBiADKAsyMC5ob2xvcy5jb25zb2xlLnYxLlRlbXBsYXRlUG9saWN5QmluZGluZ1RhcmdldFJlZhIVCg1jcmVhdG9yX2VtYWlsGAcgASgJEi4KCmNyZWF0ZWRfYXQYCCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIjYKIUxpc3RUZW1wbGF0ZVBvbGljeUJpbmRpbmdzUmVxdWVzdBIRCgluYW1lc3BhY2UYASABKAkiXwoiTGlzdFRlbXBsYXRlUG9saWN5QmluZGluZ3NSZXNwb25zZRI5CghiaW5kaW5ncxgBIAMoCzInLmhvbG9zLmNvbnNvbGUudjEuVGVtcGxhdGVQb2xpY3lCaW5kaW5nIkIKH0dldFRlbXBsYXRlUG9saWN5QmluZGluZ1JlcXVlc3QSEQoJbmFtZXNwYWNlGAEgASgJEgwKBG5hbWUYAiABKAkiXAogR2V0VGVtcGxhdGVQb2xpY3lCaW5kaW5nUmVzcG9uc2USOAoHYmluZGluZxgBIAEoCzInLmhvbG9zLmNvbnNvbGUudjEuVGVtcGxhdGVQb2xpY3lCaW5kaW5nInEKIkNyZWF0ZVRlbXBsYXRlUG9saWN5QmluZGluZ1JlcXVlc3QSEQoJbmFtZXNwYWNlGAEgASgJEjgKB2JpbmRpbmcYAiABKAsyJy5ob2xvcy5jb25zb2xlLnYxLlRlbXBsYXRlUG9saWN5QmluZGluZyIzCiNDcmVhdGVUZW1wbGF0ZVBvbGljeUJpbmRpbmdSZXNwb25zZRIMCgRuYW1lGAEgASgJInEKIlVwZGF0ZVRlbXBsYXRlUG9saWN5QmluZGluZ1JlcXVlc3QSEQoJbmFtZXNwYWNlGAEgASgJEjgKB2JpbmRpbmcYAiABKAsyJy5ob2xvcy5jb25zb2xlLnYxLlRlbXBsYXRlUG9saWN5QmluZGluZyIlCiNVcGRhdGVUZW1wbGF0ZVBvbGljeUJpbmRpbmdSZXNwb25zZSJFCiJEZWxldGVUZW1wbGF0ZVBvbGljeUJpbmRpbmdSZXF1ZXN0EhEKCW5hbWVzcGFjZRgBIAEoCRIMCgRuYW1lGAIgASgJIiUKI0RlbGV0ZVRlbXBsYXRlUG9saWN5QmluZGluZ1Jlc3BvbnNlKv8BCh9UZW1wbGF0ZVBvbGljeUJpbmRpbmdUYXJnZXRLaW5kEjMKL1RFTVBMQVRFX1BPTElDWV9CSU5ESU5HX1RBUkdFVF9LSU5EX1VOU1BFQ0lGSUVEEAASOAo0VEVNUExBVEVfUE9MSUNZX0JJTkRJTkdfVEFSR0VUX0tJTkRfUFJPSkVDVF9URU1QTEFURRABEjIKLlRFTVBMQVRFX1BPTElDWV9CSU5ESU5HX1RBUkdFVF9LSU5EX0RFUExPWU1FTlQQAhI5CjVURU1QTEFURV9QT0xJQ1lfQklORElOR19UQVJHRVRfS0lORF9QUk9KRUNUX05BTUVTUEFDRRADMt0FChxUZW1wbGF0ZVBvbGljeUJpbmRpbmdTZXJ2aWNlEowBChpMaXN0VGVtcGxhdGVQb2xpY3lCaW5kaW5ncxIzLmhvbG9zLmNvbnNvbGUudjEuTGlzdFRlbXBsYXRlUG9saWN5QmluZGluZ3NSZXF1ZXN0GjQuaG9sb3MuY29uc29sZS52MS5MaXN0VGVtcGxhdGVQb2xpY3lCaW5kaW5nc1Jlc3BvbnNlIgOQAgEShgEKGEdldFRlbXBsYXRlUG9saWN5QmluZGluZxIxLmhvbG9zLmNvbnNvbGUudjEuR2V0VGVtcGxhdGVQb2xpY3lCaW5kaW5nUmVxdWVzdBoyLmhvbG9zLmNvbnNvbGUudjEuR2V0VGVtcGxhdGVQb2xpY3lCaW5kaW5nUmVzcG9uc2UiA5ACARKKAQobQ3JlYXRlVGVtcGxhdGVQb2xpY3lCaW5kaW5nEjQuaG9sb3MuY29uc29sZS52MS5DcmVhdGVUZW1wbGF0ZVBvbGljeUJpbmRpbmdSZXF1ZXN0GjUuaG9sb3MuY29uc29sZS52MS5DcmVhdGVUZW1wbGF0ZVBvbGljeUJpbmRpbmdSZXNwb25zZRKKAQobVXBkYXRlVGVtcGxhdGVQb2xpY3lCaW5kaW5nEjQuaG9sb3MuY29uc29sZS52MS5VcGRhdGVUZW1wbGF0ZVBvbGljeUJpbmRpbmdSZXF1ZXN0GjUuaG9sb3MuY29uc29sZS52MS5VcGRhdGVUZW1wbGF0ZVBvbGljeUJpbmRpbmdSZXNwb25zZRKKAQobRGVsZXRlVGVtcGxhdGVQb2xpY3lCaW5kaW5nEjQuaG9sb3MuY29uc29sZS52MS5EZWxldGVUZW1wbGF0ZVBvbGljeUJpbmRpbmdSZXF1ZXN0GjUuaG9sb3MuY29uc29sZS52MS5EZWxldGVUZW1wbGF0ZVBvbGljeUJpbmRpbmdSZXNwb25zZUJDWkFnaXRodWIuY29tL2hvbG9zLXJ1bi9ob2xvcy1jb25zb2xlL2dlbi9ob2xvcy9jb25zb2xlL3YxO2NvbnNvbGV2MWIGcHJvdG8z", [file_google_protobuf_timestamp]);
 
 /**
  * Describes the message holos.console.v1.LinkedTemplatePolicyRef.
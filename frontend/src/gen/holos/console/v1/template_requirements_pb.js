@@ -11,7 +11,7 @@ import { file_holos_console_v1_template_policy_bindings } from "./template_polic
  * Describes the file holos/console/v1/template_requirements.proto.
  */
 export const file_holos_console_v1_template_requirements = /*@__PURE__*/
-  fileDesc("Cixob2xvcy9jb25zb2xlL3YxL3RlbXBsYXRlX3JlcXVpcmVtZW50cy5wcm90bxIQaG9sb3MuY29uc29sZS52MSKDAQocVGVtcGxhdGVSZXF1aXJlbWVudFRhcmdldFJlZhI/CgRraW5kGAEgASgOMjEuaG9sb3MuY29uc29sZS52MS5UZW1wbGF0ZVBvbGljeUJpbmRpbmdUYXJnZXRLaW5kEgwKBG5hbWUYAiABKAkSFAoMcHJvamVjdF9uYW1lGAMgASgJIuYCChNUZW1wbGF0ZVJlcXVpcmVtZW50EgwKBG5hbWUYASABKAkSEQoJbmFtZXNwYWNlGAIgASgJEjUKCHJlcXVpcmVzGAMgASgLMiMuaG9sb3MuY29uc29sZS52MS5MaW5rZWRUZW1wbGF0ZVJlZhJDCgt0YXJnZXRfcmVmcxgEIAMoCzIuLmhvbG9zLmNvbnNvbGUudjEuVGVtcGxhdGVSZXF1aXJlbWVudFRhcmdldFJlZhIbCg5jYXNjYWRlX2RlbGV0ZRgFIAEoCEgAiAEBEhUKDWNyZWF0b3JfZW1haWwYBiABKAkSLgoKY3JlYXRlZF9hdBgHIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASOwoGc3RhdHVzGAggASgLMisuaG9sb3MuY29uc29sZS52MS5UZW1wbGF0ZVJlcXVpcmVtZW50U3RhdHVzQhEKD19jYXNjYWRlX2RlbGV0ZSJ8ChlUZW1wbGF0ZVJlcXVpcmVtZW50U3RhdHVzEhsKE29ic2VydmVkX2dlbmVyYXRpb24YASABKAMSQgoKY29uZGl0aW9ucxgCIAMoCzIuLmhvbG9zLmNvbnNvbGUudjEuVGVtcGxhdGVSZXF1aXJlbWVudENvbmRpdGlvbiK0AQocVGVtcGxhdGVSZXF1aXJlbWVudENvbmRpdGlvbhIMCgR0eXBlGAEgASgJEg4KBnN0YXR1cxgCIAEoCRIOCgZyZWFzb24YAyABKAkSDwoHbWVzc2FnZRgEIAEoCRIbChNvYnNlcnZlZF9nZW5lcmF0aW9uGAUgASgDEjgKFGxhc3RfdHJhbnNpdGlvbl90aW1lGAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCI0Ch9MaXN0VGVtcGxhdGVSZXF1aXJlbWVudHNSZXF1ZXN0EhEKCW5hbWVzcGFjZRgBIAEoCSJfCiBMaXN0VGVtcGxhdGVSZXF1aXJlbWVudHNSZXNwb25zZRI7CgxyZXF1aXJlbWVudHMYASADKAsyJS5ob2xvcy5jb25zb2xlLnYxLlRlbXBsYXRlUmVxdWlyZW1lbnQiQAodR2V0VGVtcGxhdGVSZXF1aXJlbWVudFJlcXVlc3QSEQoJbmFtZXNwYWNlGAEgASgJEgwKBG5hbWUYAiABKAkiXAoeR2V0VGVtcGxhdGVSZXF1aXJlbWVudFJlc3BvbnNlEjoKC3JlcXVpcmVtZW50GAEgASgLMiUuaG9sb3MuY29uc29sZS52MS5UZW1wbGF0ZVJlcXVpcmVtZW50InEKIENyZWF0ZVRlbXBsYXRlUmVxdWlyZW1lbnRSZXF1ZXN0EhEKCW5hbWVzcGFjZRgBIAEoCRI6CgtyZXF1aXJlbWVudBgCIAEoCzIlLmhvbG9zLmNvbnNvbGUudjEuVGVtcGxhdGVSZXF1aXJlbWVudCIxCiFDcmVhdGVUZW1wbGF0ZVJlcXVpcmVtZW50UmVzcG9uc2USDAoEbmFtZRgBIAEoCSJxCiBVcGRhdGVUZW1wbGF0ZVJlcXVpcmVtZW50UmVxdWVzdBIRCgluYW1lc3BhY2UYASABKAkSOgoLcmVxdWlyZW1lbnQYAiABKAsyJS5ob2xvcy5jb25zb2xlLnYxLlRlbXBsYXRlUmVxdWlyZW1lbnQiIwohVXBkYXRlVGVtcGxhdGVSZXF1aXJlbWVudFJlc3BvbnNlIkMKIERlbGV0ZVRlbXBsYXRlUmVxdWlyZW1lbnRSZXF1ZXN0EhEKCW5hbWVzcGFjZRgBIAEoCRIMCgRuYW1lGAIgASgJIiMKIURlbGV0ZVRlbXBsYXRlUmVxdWlyZW1lbnRSZXNwb25zZTKyBQoaVGVtcGxhdGVSZXF1aXJlbWVudFNlcnZpY2USgQEKGExpc3RUZW1wbGF0ZVJlcXVpcmVtZW50cxIxLmhvbG9zLmNvbnNvbGUudjEuTGlzdFRlbXBsYXRlUmVxdWlyZW1lbnRzUmVxdWVzdBoyLmhvbG9zLmNvbnNvbGUudjEuTGlzdFRlbXBsYXRlUmVxdWlyZW1lbnRzUmVzcG9uc2USewoWR2V0VGVtcGxhdGVSZXF1aXJlbWVudBIvLmhvbG9zLmNvbnNvbGUudjEuR2V0VGVtcGxhdGVSZXF1aXJlbWVudFJlcXVlc3QaMC5ob2xvcy5jb25zb2xlLnYxLkdldFRlbXBsYXRlUmVxdWlyZW1lbnRSZXNwb25zZRKEAQoZQ3JlYXRlVGVtcGxhdGVSZXF1aXJlbWVudBIyLmhvbG9zLmNvbnNvbGUudjEuQ3JlYXRlVGVtcGxhdGVSZXF1aXJlbWVudFJlcXVlc3QaMy5ob2xvcy5jb25zb2xlLnYxLkNyZWF0ZVRlbXBsYXRlUmVxdWlyZW1lbnRSZXNwb25zZRKEAQoZVXBkYXRlVGVtcGxhdGVSZXF1aXJlbWVudBIyLmhvbG9zLmNvbnNvbGUudjEuVXBkYXRlVGVtcGxhdGVSZXF1aXJlbWVudFJlcXVlc3QaMy5ob2xvcy5jb25zb2xlLnYxLlVwZGF0ZVRlbXBsYXRlUmVxdWlyZW1lbnRSZXNwb25zZRKEAQoZRGVsZXRlVGVtcGxhdGVSZXF1aXJlbWVudBIyLmhvbG9zLmNvbnNvbGUudjEuRGVsZXRlVGVtcGxhdGVSZXF1aXJlbWVudFJlcXVlc3QaMy5ob2xvcy5jb25zb2xlLnYxLkRlbGV0ZVRlbXBsYXRlUmVxdWlyZW1lbnRSZXNwb25zZUJDWkFnaXRodWIuY29tL2hvbG9zLXJ1bi9ob2xvcy1jb25zb2xlL2dlbi9ob2xvcy9jb25zb2xlL3YxO2NvbnNvbGV2MWIGcHJvdG8z", [file_google_protobuf_timestamp, file_holos_console_v1_policy_state, file_holos_console_v1_template_policy_bindings]);
+  fileDesc("Cixob2xvcy9jb25zb2xlL3YxL3RlbXBsYXRlX3JlcXVpcmVtZW50cy5wcm90bxIQaG9sb3MuY29uc29sZS52MSKDAQocVGVtcGxhdGVSZXF1aXJlbWVudFRhcmdldFJlZhI/CgRraW5kGAEgASgOMjEuaG9sb3MuY29uc29sZS52MS5UZW1wbGF0ZVBvbGljeUJpbmRpbmdUYXJnZXRLaW5kEgwKBG5hbWUYAiABKAkSFAoMcHJvamVjdF9uYW1lGAMgASgJIuYCChNUZW1wbGF0ZVJlcXVpcmVtZW50EgwKBG5hbWUYASABKAkSEQoJbmFtZXNwYWNlGAIgASgJEjUKCHJlcXVpcmVzGAMgASgLMiMuaG9sb3MuY29uc29sZS52MS5MaW5rZWRUZW1wbGF0ZVJlZhJDCgt0YXJnZXRfcmVmcxgEIAMoCzIuLmhvbG9zLmNvbnNvbGUudjEuVGVtcGxhdGVSZXF1aXJlbWVudFRhcmdldFJlZhIbCg5jYXNjYWRlX2RlbGV0ZRgFIAEoCEgAiAEBEhUKDWNyZWF0b3JfZW1haWwYBiABKAkSLgoKY3JlYXRlZF9hdBgHIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASOwoGc3RhdHVzGAggASgLMisuaG9sb3MuY29uc29sZS52MS5UZW1wbGF0ZVJlcXVpcmVtZW50U3RhdHVzQhEKD19jYXNjYWRlX2RlbGV0ZSJ8ChlUZW1wbGF0ZVJlcXVpcmVtZW50U3RhdHVzEhsKE29ic2VydmVkX2dlbmVyYXRpb24YASABKAMSQgoKY29uZGl0aW9ucxgCIAMoCzIuLmhvbG9zLmNvbnNvbGUudjEuVGVtcGxhdGVSZXF1aXJlbWVudENvbmRpdGlvbiK0AQocVGVtcGxhdGVSZXF1aXJlbWVudENvbmRpdGlvbhIMCgR0eXBlGAEgASgJEg4KBnN0YXR1cxgCIAEoCRIOCgZyZWFzb24YAyABKAkSDwoHbWVzc2FnZRgEIAEoCRIbChNvYnNlcnZlZF9nZW5lcmF0aW9uGAUgASgDEjgKFGxhc3RfdHJhbnNpdGlvbl90aW1lGAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCI0Ch9MaXN0VGVtcGxhdGVSZXF1aXJlbWVudHNSZXF1ZXN0EhEKCW5hbWVzcGFjZRgBIAEoCSJfCiBMaXN0VGVtcGxhdGVSZXF1aXJlbWVudHNSZXNwb25zZRI7CgxyZXF1aXJlbWVudHMYASADKAsyJS5ob2xvcy5jb25zb2xlLnYxLlRlbXBsYXRlUmVxdWlyZW1lbnQiQAodR2V0VGVtcGxhdGVSZXF1aXJlbWVudFJlcXVlc3QSEQoJbmFtZXNwYWNlGAEgASgJEgwKBG5hbWUYAiABKAkiXAoeR2V0VGVtcGxhdGVSZXF1aXJlbWVudFJlc3BvbnNlEjoKC3JlcXVpcmVtZW50GAEgASgLMiUuaG9sb3MuY29uc29sZS52MS5UZW1wbGF0ZVJlcXVpcmVtZW50InEKIENyZWF0ZVRlbXBsYXRlUmVxdWlyZW1lbnRSZXF1ZXN0EhEKCW5hbWVzcGFjZRgBIAEoCRI6CgtyZXF1aXJlbWVudBgCIAEoCzIlLmhvbG9zLmNvbnNvbGUudjEuVGVtcGxhdGVSZXF1aXJlbWVudCIxCiFDcmVhdGVUZW1wbGF0ZVJlcXVpcmVtZW50UmVzcG9uc2USDAoEbmFtZRgBIAEoCSJxCiBVcGRhdGVUZW1wbGF0ZVJlcXVpcmVtZW50UmVxdWVzdBIRCgluYW1lc3BhY2UYASABKAkSOgoLcmVxdWlyZW1lbnQYAiABKAsyJS5ob2xvcy5jb25zb2xlLnYxLlRlbXBsYXRlUmVxdWlyZW1lbnQiIwohVXBkYXRlVGVtcGxhdGVSZXF1aXJlbWVudFJlc3BvbnNlIkMKIERlbGV0ZVRlbXBsYXRlUmVxdWlyZW1lbnRSZXF1ZXN0EhEKCW5hbWVzcGFjZRgBIAEoCRIMCgRuYW1lGAIgASgJIiMKIURlbGV0ZVRlbXBsYXRlUmVxdWlyZW1lbnRSZXNwb25zZTK9BQoaVGVtcGxhdGVSZXF1aXJlbWVudFNlcnZpY2UShgEKGExpc3RUZW1wbGF0ZVJlcXVpcmVtZW50cxIxLmhvbG9zLmNvbnNvbGUudjEuTGlzdFRlbXBsYXRlUmVxdWlyZW1lbnRzUmVxdWVzdBoyLmhvbG9zLmNvbnNvbGUudjEuTGlzdFRlbXBsYXRlUmVxdWlyZW1lbnRzUmVzcG9uc2UiA5ACARKAAQoWR2V0VGVtcGxhdGVSZXF1aXJlbWVudBIvLmhvbG9zLmNvbnNvbGUudjEuR2V0VGVtcGxhdGVSZXF1aXJlbWVudFJlcXVlc3QaMC5ob2xvcy5jb25zb2xlLnYxLkdldFRlbXBsYXRlUmVxdWlyZW1lbnRSZXNwb25zZSIDkAIBEoQBChlDcmVhdGVUZW1wbGF0ZVJlcXVpcmVtZW50EjIuaG9sb3MuY29uc29sZS52MS5DcmVhdGVUZW1wbGF0ZVJlcXVpcmVtZW50UmVxdWVzdBozLmhvbG9zLmNvbnNvbGUudjEuQ3JlYXRlVGVtcGxhdGVSZXF1aXJlbWVudFJlc3BvbnNlEoQBChlVcGRhdGVUZW1wbGF0ZVJlcXVpcmVtZW50EjIuaG9sb3MuY29uc29sZS52MS5VcGRhdGVUZW1wbGF0ZVJlcXVpcmVtZW50UmVxdWVzdBozLmhvbG9zLmNvbnNvbGUudjEuVXBkYXRlVGVtcGxhdGVSZXF1aXJlbWVudFJlc3BvbnNlEoQBChlEZWxldGVUZW1wbGF0ZVJlcXVpcmVtZW50EjIuaG9sb3MuY29uc29sZS52MS5EZWxldGVUZW1wbGF0ZVJlcXVpcmVtZW50UmVxdWVzdBozLmhvbG9zLmNvbnNvbGUudjEuRGVsZXRlVGVtcGxhdGVSZXF1aXJlbWVudFJlc3BvbnNlQkNaQWdpdGh1Yi5jb20vaG9sb3MtcnVuL2hvbG9zLWNvbnNvbGUvZ2VuL2hvbG9zL2NvbnNvbGUvdjE7Y29uc29sZXYxYgZwcm90bzM", [file_google_protobuf_timestamp, file_holos_console_v1_policy_state, file_holos_console_v1_template_policy_bindings]);
 
 /**
  * Describes the message holos.console.v1.TemplateRequirementTargetRef.
@@ -5,6 +5,7 @@
 import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
 import type { Message } from "@bufbuild/protobuf";
 import type { EnvVar } from "./deployments_pb";
+import type { ListOrder } from "./list_filter_pb";
 import type { Timestamp } from "@bufbuild/protobuf/wkt";
 import type { GetProjectTemplatePolicyStateRequestSchema, GetProjectTemplatePolicyStateResponseSchema } from "./policy_state_pb";
 
@@ -232,6 +233,13 @@ export declare type ListTemplatesRequest = Message<"holos.console.v1.ListTemplat
    * @generated from field: string namespace = 1;
    */
   namespace: string;
+
+  /**
+   * order_by sorts the returned templates.
+   *
+   * @generated from field: holos.console.v1.ListOrder order_by = 2;
+   */
+  orderBy?: ListOrder;
 };
 
 /**
@@ -5,13 +5,14 @@
 import { enumDesc, fileDesc, messageDesc, serviceDesc, tsEnum } from "@bufbuild/protobuf/codegenv2";
 import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
 import { file_holos_console_v1_deployments } from "./deployments_pb";
+import { file_holos_console_v1_list_filter } from "./list_filter_pb";
 import { file_holos_console_v1_policy_state } from "./policy_state_pb";
 
 /**
  * Describes the file holos/console/v1/templates.proto.
  */
 export const file_holos_console_v1_templates = /*@__PURE__*/
-  fileDesc("CiBob2xvcy9jb25zb2xlL3YxL3RlbXBsYXRlcy5wcm90bxIQaG9sb3MuY29uc29sZS52MSKlAQoQVGVtcGxhdGVEZWZhdWx0cxINCgVpbWFnZRgBIAEoCRILCgN0YWcYAiABKAkSDwoHY29tbWFuZBgDIAMoCRIMCgRhcmdzGAQgAygJEiUKA2VudhgFIAMoCzIYLmhvbG9zLmNvbnNvbGUudjEuRW52VmFyEgwKBHBvcnQYBiABKAUSDAoEbmFtZRgHIAEoCRITCgtkZXNjcmlwdGlvbhgIIAEoCSI9ChpHZXRUZW1wbGF0ZURlZmF1bHRzUmVxdWVzdBIRCgluYW1lc3BhY2UYASABKAkSDAoEbmFtZRgCIAEoCSJTChtHZXRUZW1wbGF0ZURlZmF1bHRzUmVzcG9uc2USNAoIZGVmYXVsdHMYASABKAsyIi5ob2xvcy5jb25zb2xlLnYxLlRlbXBsYXRlRGVmYXVsdHMigQIKCFRlbXBsYXRlEgwKBG5hbWUYASABKAkSEQoJbmFtZXNwYWNlGAIgASgJEhQKDGRpc3BsYXlfbmFtZRgDIAEoCRITCgtkZXNjcmlwdGlvbhgEIAEoCRIUCgxjdWVfdGVtcGxhdGUYBSABKAkSNAoIZGVmYXVsdHMYBiABKAsyIi5ob2xvcy5jb25zb2xlLnYxLlRlbXBsYXRlRGVmYXVsdHMSDwoHZW5hYmxlZBgJIAEoCBIPCgd2ZXJzaW9uGAogASgJEhIKCmNyZWF0ZWRfYXQYCyABKAlKBAgHEAhKBAgIEAlSEGxpbmtlZF90ZW1wbGF0ZXNSCW1hbmRhdG9yeSIpChRMaXN0VGVtcGxhdGVzUmVxdWVzdBIRCgluYW1lc3BhY2UYASABKAkiRgoVTGlzdFRlbXBsYXRlc1Jlc3BvbnNlEi0KCXRlbXBsYXRlcxgBIAMoCzIaLmhvbG9zLmNvbnNvbGUudjEuVGVtcGxhdGUiNQoSR2V0VGVtcGxhdGVSZXF1ZXN0EhEKCW5hbWVzcGFjZRgBIAEoCRIMCgRuYW1lGAIgASgJIkMKE0dldFRlbXBsYXRlUmVzcG9uc2USLAoIdGVtcGxhdGUYASABKAsyGi5ob2xvcy5jb25zb2xlLnYxLlRlbXBsYXRlIlgKFUNyZWF0ZVRlbXBsYXRlUmVxdWVzdBIRCgluYW1lc3BhY2UYASABKAkSLAoIdGVtcGxhdGUYAiABKAsyGi5ob2xvcy5jb25zb2xlLnYxLlRlbXBsYXRlIiYKFkNyZWF0ZVRlbXBsYXRlUmVzcG9uc2USDAoEbmFtZRgBIAEoCSJ3ChVVcGRhdGVUZW1wbGF0ZVJlcXVlc3QSEQoJbmFtZXNwYWNlGAEgASgJEiwKCHRlbXBsYXRlGAIgASgLMhouaG9sb3MuY29uc29sZS52MS5UZW1wbGF0ZUoECAMQBFIXdXBkYXRlX2xpbmtlZF90ZW1wbGF0ZXMiGAoWVXBkYXRlVGVtcGxhdGVSZXNwb25zZSI4ChVEZWxldGVUZW1wbGF0ZVJlcXVlc3QSEQoJbmFtZXNwYWNlGAEgASgJEgwKBG5hbWUYAiABKAkiGAoWRGVsZXRlVGVtcGxhdGVSZXNwb25zZSKPAQoVUmVuZGVyVGVtcGxhdGVSZXF1ZXN0EhEKCW5hbWVzcGFjZRgBIAEoCRIUCgxjdWVfdGVtcGxhdGUYAiABKAkSGgoSY3VlX3BsYXRmb3JtX2lucHV0GAMgASgJEhkKEWN1ZV9wcm9qZWN0X2lucHV0GAQgASgJSgQIBRAGUhBsaW5rZWRfdGVtcGxhdGVzIpYEChZSZW5kZXJUZW1wbGF0ZVJlc3BvbnNlEhUKDXJlbmRlcmVkX3lhbWwYASABKAkSFQoNcmVuZGVyZWRfanNvbhgCIAEoCRIfChdwbGF0Zm9ybV9yZXNvdXJjZXNfeWFtbBgDIAEoCRIfChdwbGF0Zm9ybV9yZXNvdXJjZXNfanNvbhgEIAEoCRIeChZwcm9qZWN0X3Jlc291cmNlc195YW1sGAUgASgJEh4KFnByb2plY3RfcmVzb3VyY2VzX2pzb24YBiABKAkSGgoNZGVmYXVsdHNfanNvbhgHIAEoCUgAiAEBEiAKE3BsYXRmb3JtX2lucHV0X2pzb24YCCABKAlIAYgBARIfChJwcm9qZWN0X2lucHV0X2pzb24YCSABKAlIAogBARIvCiJwbGF0Zm9ybV9yZXNvdXJjZXNfc3RydWN0dXJlZF9qc29uGAogASgJSAOIAQESLgohcHJvamVjdF9yZXNvdXJjZXNfc3RydWN0dXJlZF9qc29uGAsgASgJSASIAQFCEAoOX2RlZmF1bHRzX2pzb25CFgoUX3BsYXRmb3JtX2lucHV0X2pzb25CFQoTX3Byb2plY3RfaW5wdXRfanNvbkIlCiNfcGxhdGZvcm1fcmVzb3VyY2VzX3N0cnVjdHVyZWRfanNvbkIkCiJfcHJvamVjdF9yZXNvdXJjZXNfc3RydWN0dXJlZF9qc29uImIKFENsb25lVGVtcGxhdGVSZXF1ZXN0EhEKCW5hbWVzcGFjZRgBIAEoCRITCgtzb3VyY2VfbmFtZRgCIAEoCRIMCgRuYW1lGAMgASgJEhQKDGRpc3BsYXlfbmFtZRgEIAEoCSIlChVDbG9uZVRlbXBsYXRlUmVzcG9uc2USDAoEbmFtZRgBIAEoCSJNChxMaXN0TGlua2FibGVUZW1wbGF0ZXNSZXF1ZXN0EhEKCW5hbWVzcGFjZRgBIAEoCRIaChJpbmNsdWRlX3NlbGZfc2NvcGUYAiABKAgirAEKEExpbmthYmxlVGVtcGxhdGUSEQoJbmFtZXNwYWNlGAEgASgJEgwKBG5hbWUYAiABKAkSFAoMZGlzcGxheV9uYW1lGAMgASgJEhMKC2Rlc2NyaXB0aW9uGAQgASgJEisKCHJlbGVhc2VzGAYgAygLMhkuaG9sb3MuY29uc29sZS52MS5SZWxlYXNlEg4KBmZvcmNlZBgHIAEoCEoECAUQBlIJbWFuZGF0b3J5IlYKHUxpc3RMaW5rYWJsZVRlbXBsYXRlc1Jlc3BvbnNlEjUKCXRlbXBsYXRlcxgBIAMoCzIiLmhvbG9zLmNvbnNvbGUudjEuTGlua2FibGVUZW1wbGF0ZSIxChxMaXN0QW5jZXN0b3JUZW1wbGF0ZXNSZXF1ZXN0EhEKCW5hbWVzcGFjZRgBIAEoCSJOCh1MaXN0QW5jZXN0b3JUZW1wbGF0ZXNSZXNwb25zZRItCgl0ZW1wbGF0ZXMYASADKAsyGi5ob2xvcy5jb25zb2xlLnYxLlRlbXBsYXRlIusBCgdSZWxlYXNlEhUKDXRlbXBsYXRlX25hbWUYASABKAkSEQoJbmFtZXNwYWNlGAIgASgJEg8KB3ZlcnNpb24YAyABKAkSEQoJY2hhbmdlbG9nGAQgASgJEhYKDnVwZ3JhZGVfYWR2aWNlGAUgASgJEhQKDGN1ZV90ZW1wbGF0ZRgGIAEoCRI0CghkZWZhdWx0cxgHIAEoCzIiLmhvbG9zLmNvbnNvbGUudjEuVGVtcGxhdGVEZWZhdWx0cxIuCgpjcmVhdGVkX2F0GAggASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCJVChRDcmVhdGVSZWxlYXNlUmVxdWVzdBIRCgluYW1lc3BhY2UYASABKAkSKgoHcmVsZWFzZRgCIAEoCzIZLmhvbG9zLmNvbnNvbGUudjEuUmVsZWFzZSJDChVDcmVhdGVSZWxlYXNlUmVzcG9uc2USKgoHcmVsZWFzZRgBIAEoCzIZLmhvbG9zLmNvbnNvbGUudjEuUmVsZWFzZSI/ChNMaXN0UmVsZWFzZXNSZXF1ZXN0EhEKCW5hbWVzcGFjZRgBIAEoCRIVCg10ZW1wbGF0ZV9uYW1lGAIgASgJIkMKFExpc3RSZWxlYXNlc1Jlc3BvbnNlEisKCHJlbGVhc2VzGAEgAygLMhkuaG9sb3MuY29uc29sZS52MS5SZWxlYXNlIk4KEUdldFJlbGVhc2VSZXF1ZXN0EhEKCW5hbWVzcGFjZRgBIAEoCRIVCg10ZW1wbGF0ZV9uYW1lGAIgASgJEg8KB3ZlcnNpb24YAyABKAkiQAoSR2V0UmVsZWFzZVJlc3BvbnNlEioKB3JlbGVhc2UYASABKAsyGS5ob2xvcy5jb25zb2xlLnYxLlJlbGVhc2UibgoWU2VhcmNoVGVtcGxhdGVzUmVxdWVzdBIRCgluYW1lc3BhY2UYASABKAkSDAoEbmFtZRgCIAEoCRIdChVkaXNwbGF5X25hbWVfY29udGFpbnMYAyABKAkSFAoMb3JnYW5pemF0aW9uGAQgASgJIkgKF1NlYXJjaFRlbXBsYXRlc1Jlc3BvbnNlEi0KCXRlbXBsYXRlcxgBIAMoCzIaLmhvbG9zLmNvbnNvbGUudjEuVGVtcGxhdGUiYAoPVGVtcGxhdGVFeGFtcGxlEgwKBG5hbWUYASABKAkSFAoMZGlzcGxheV9uYW1lGAIgASgJEhMKC2Rlc2NyaXB0aW9uGAMgASgJEhQKDGN1ZV90ZW1wbGF0ZRgEIAEoCSIdChtMaXN0VGVtcGxhdGVFeGFtcGxlc1JlcXVlc3QiUwocTGlzdFRlbXBsYXRlRXhhbXBsZXNSZXNwb25zZRIzCghleGFtcGxlcxgBIAMoCzIhLmhvbG9zLmNvbnNvbGUudjEuVGVtcGxhdGVFeGFtcGxlItUBChdUZW1wbGF0ZURlcGVuZGVudFJlY29yZBIwCgVzY29wZRgBIAEoDjIhLmhvbG9zLmNvbnNvbGUudjEuRGVwZW5kZW5jeVNjb3BlEhsKE2RlcGVuZGVudF9uYW1lc3BhY2UYAiABKAkSFgoOZGVwZW5kZW50X25hbWUYAyABKAkSJAoccmVxdWlyaW5nX3RlbXBsYXRlX25hbWVzcGFjZRgEIAEoCRIfChdyZXF1aXJpbmdfdGVtcGxhdGVfbmFtZRgFIAEoCRIMCgRraW5kGAYgASgJIkAKHUxpc3RUZW1wbGF0ZURlcGVuZGVudHNSZXF1ZXN0EhEKCW5hbWVzcGFjZRgBIAEoCRIMCgRuYW1lGAIgASgJIl8KHkxpc3RUZW1wbGF0ZURlcGVuZGVudHNSZXNwb25zZRI9CgpkZXBlbmRlbnRzGAEgAygLMikuaG9sb3MuY29uc29sZS52MS5UZW1wbGF0ZURlcGVuZGVudFJlY29yZCJQChlEZXBsb3ltZW50RGVwZW5kZW50UmVjb3JkEhsKE2RlcGVuZGVudF9uYW1lc3BhY2UYASABKAkSFgoOZGVwZW5kZW50X25hbWUYAiABKAkiQgofTGlzdERlcGxveW1lbnREZXBlbmRlbnRzUmVxdWVzdBIRCgluYW1lc3BhY2UYASABKAkSDAoEbmFtZRgCIAEoCSJjCiBMaXN0RGVwbG95bWVudERlcGVuZGVudHNSZXNwb25zZRI/CgpkZXBlbmRlbnRzGAEgAygLMisuaG9sb3MuY29uc29sZS52MS5EZXBsb3ltZW50RGVwZW5kZW50UmVjb3JkKpUBCg9EZXBlbmRlbmN5U2NvcGUSIAocREVQRU5ERU5DWV9TQ09QRV9VTlNQRUNJRklFRBAAEh0KGURFUEVOREVOQ1lfU0NPUEVfSU5TVEFOQ0UQARIcChhERVBFTkRFTkNZX1NDT1BFX1BST0pFQ1QQAhIjCh9ERVBFTkRFTkNZX1NDT1BFX1JFTU9URV9QUk9KRUNUEAMyug8KD1RlbXBsYXRlU2VydmljZRJgCg1MaXN0VGVtcGxhdGVzEiYuaG9sb3MuY29uc29sZS52MS5MaXN0VGVtcGxhdGVzUmVxdWVzdBonLmhvbG9zLmNvbnNvbGUudjEuTGlzdFRlbXBsYXRlc1Jlc3BvbnNlEloKC0dldFRlbXBsYXRlEiQuaG9sb3MuY29uc29sZS52MS5HZXRUZW1wbGF0ZVJlcXVlc3QaJS5ob2xvcy5jb25zb2xlLnYxLkdldFRlbXBsYXRlUmVzcG9uc2USYwoOQ3JlYXRlVGVtcGxhdGUSJy5ob2xvcy5jb25zb2xlLnYxLkNyZWF0ZVRlbXBsYXRlUmVxdWVzdBooLmhvbG9zLmNvbnNvbGUudjEuQ3JlYXRlVGVtcGxhdGVSZXNwb25zZRJjCg5VcGRhdGVUZW1wbGF0ZRInLmhvbG9zLmNvbnNvbGUudjEuVXBkYXRlVGVtcGxhdGVSZXF1ZXN0GiguaG9sb3MuY29uc29sZS52MS5VcGRhdGVUZW1wbGF0ZVJlc3BvbnNlEmMKDkRlbGV0ZVRlbXBsYXRlEicuaG9sb3MuY29uc29sZS52MS5EZWxldGVUZW1wbGF0ZVJlcXVlc3QaKC5ob2xvcy5jb25zb2xlLnYxLkRlbGV0ZVRlbXBsYXRlUmVzcG9uc2USYwoOUmVuZGVyVGVtcGxhdGUSJy5ob2xvcy5jb25zb2xlLnYxLlJlbmRlclRlbXBsYXRlUmVxdWVzdBooLmhvbG9zLmNvbnNvbGUudjEuUmVuZGVyVGVtcGxhdGVSZXNwb25zZRJgCg1DbG9uZVRlbXBsYXRlEiYuaG9sb3MuY29uc29sZS52MS5DbG9uZVRlbXBsYXRlUmVxdWVzdBonLmhvbG9zLmNvbnNvbGUudjEuQ2xvbmVUZW1wbGF0ZVJlc3BvbnNlEngKFUxpc3RMaW5rYWJsZVRlbXBsYXRlcxIuLmhvbG9zLmNvbnNvbGUudjEuTGlzdExpbmthYmxlVGVtcGxhdGVzUmVxdWVzdBovLmhvbG9zLmNvbnNvbGUudjEuTGlzdExpbmthYmxlVGVtcGxhdGVzUmVzcG9uc2USeAoVTGlzdEFuY2VzdG9yVGVtcGxhdGVzEi4uaG9sb3MuY29uc29sZS52MS5MaXN0QW5jZXN0b3JUZW1wbGF0ZXNSZXF1ZXN0Gi8uaG9sb3MuY29uc29sZS52MS5MaXN0QW5jZXN0b3JUZW1wbGF0ZXNSZXNwb25zZRJgCg1DcmVhdGVSZWxlYXNlEiYuaG9sb3MuY29uc29sZS52MS5DcmVhdGVSZWxlYXNlUmVxdWVzdBonLmhvbG9zLmNvbnNvbGUudjEuQ3JlYXRlUmVsZWFzZVJlc3BvbnNlEl0KDExpc3RSZWxlYXNlcxIlLmhvbG9zLmNvbnNvbGUudjEuTGlzdFJlbGVhc2VzUmVxdWVzdBomLmhvbG9zLmNvbnNvbGUudjEuTGlzdFJlbGVhc2VzUmVzcG9uc2USVwoKR2V0UmVsZWFzZRIjLmhvbG9zLmNvbnNvbGUudjEuR2V0UmVsZWFzZVJlcXVlc3QaJC5ob2xvcy5jb25zb2xlLnYxLkdldFJlbGVhc2VSZXNwb25zZRJyChNHZXRUZW1wbGF0ZURlZmF1bHRzEiwuaG9sb3MuY29uc29sZS52MS5HZXRUZW1wbGF0ZURlZmF1bHRzUmVxdWVzdBotLmhvbG9zLmNvbnNvbGUudjEuR2V0VGVtcGxhdGVEZWZhdWx0c1Jlc3BvbnNlEpABCh1HZXRQcm9qZWN0VGVtcGxhdGVQb2xpY3lTdGF0ZRI2LmhvbG9zLmNvbnNvbGUudjEuR2V0UHJvamVjdFRlbXBsYXRlUG9saWN5U3RhdGVSZXF1ZXN0GjcuaG9sb3MuY29uc29sZS52MS5HZXRQcm9qZWN0VGVtcGxhdGVQb2xpY3lTdGF0ZVJlc3BvbnNlEmYKD1NlYXJjaFRlbXBsYXRlcxIoLmhvbG9zLmNvbnNvbGUudjEuU2VhcmNoVGVtcGxhdGVzUmVxdWVzdBopLmhvbG9zLmNvbnNvbGUudjEuU2VhcmNoVGVtcGxhdGVzUmVzcG9uc2USdQoUTGlzdFRlbXBsYXRlRXhhbXBsZXMSLS5ob2xvcy5jb25zb2xlLnYxLkxpc3RUZW1wbGF0ZUV4YW1wbGVzUmVxdWVzdBouLmhvbG9zLmNvbnNvbGUudjEuTGlzdFRlbXBsYXRlRXhhbXBsZXNSZXNwb25zZRJ7ChZMaXN0VGVtcGxhdGVEZXBlbmRlbnRzEi8uaG9sb3MuY29uc29sZS52MS5MaXN0VGVtcGxhdGVEZXBlbmRlbnRzUmVxdWVzdBowLmhvbG9zLmNvbnNvbGUudjEuTGlzdFRlbXBsYXRlRGVwZW5kZW50c1Jlc3BvbnNlEoEBChhMaXN0RGVwbG95bWVudERlcGVuZGVudHMSMS5ob2xvcy5jb25zb2xlLnYxLkxpc3REZXBsb3ltZW50RGVwZW5kZW50c1JlcXVlc3QaMi5ob2xvcy5jb25zb2xlLnYxLkxpc3REZXBsb3ltZW50RGVwZW5kZW50c1Jlc3BvbnNlQkNaQWdpdGh1Yi5jb20vaG9sb3MtcnVuL2hvbG9zLWNvbnNvbGUvZ2VuL2hvbG9zL2NvbnNvbGUvdjE7Y29uc29sZXYxYgZwcm90bzM", [file_google_protobuf_timestamp, file_holos_console_v1_deployments, file_holos_console_v1_policy_state]);
+  fileDesc("CiBob2xvcy9jb25zb2xlL3YxL3RlbXBsYXRlcy5wcm90bxIQaG9sb3MuY29uc29sZS52MSKlAQoQVGVtcGxhdGVEZWZhdWx0cxINCgVpbWFnZRgBIAEoCRILCgN0YWcYAiABKAkSDwoHY29tbWFuZBgDIAMoCRIMCgRhcmdzGAQgAygJEiUKA2VudhgFIAMoCzIYLmhvbG9zLmNvbnNvbGUudjEuRW52VmFyEgwKBHBvcnQYBiABKAUSDAoEbmFtZRgHIAEoCRITCgtkZXNjcmlwdGlvbhgIIAEoCSI9ChpHZXRUZW1wbGF0ZURlZmF1bHRzUmVxdWVzdBIRCgluYW1lc3BhY2UYASABKAkSDAoEbmFtZRgCIAEoCSJTChtHZXRUZW1wbGF0ZURlZmF1bHRzUmVzcG9uc2USNAoIZGVmYXVsdHMYASABKAsyIi5ob2xvcy5jb25zb2xlLnYxLlRlbXBsYXRlRGVmYXVsdHMigQIKCFRlbXBsYXRlEgwKBG5hbWUYASABKAkSEQoJbmFtZXNwYWNlGAIgASgJEhQKDGRpc3BsYXlfbmFtZRgDIAEoCRITCgtkZXNjcmlwdGlvbhgEIAEoCRIUCgxjdWVfdGVtcGxhdGUYBSABKAkSNAoIZGVmYXVsdHMYBiABKAsyIi5ob2xvcy5jb25zb2xlLnYxLlRlbXBsYXRlRGVmYXVsdHMSDwoHZW5hYmxlZBgJIAEoCBIPCgd2ZXJzaW9uGAogASgJEhIKCmNyZWF0ZWRfYXQYCyABKAlKBAgHEAhKBAgIEAlSEGxpbmtlZF90ZW1wbGF0ZXNSCW1hbmRhdG9yeSJYChRMaXN0VGVtcGxhdGVzUmVxdWVzdBIRCgluYW1lc3BhY2UYASABKAkSLQoIb3JkZXJfYnkYAiABKAsyGy5ob2xvcy5jb25zb2xlLnYxLkxpc3RPcmRlciJGChVMaXN0VGVtcGxhdGVzUmVzcG9uc2USLQoJdGVtcGxhdGVzGAEgAygLMhouaG9sb3MuY29uc29sZS52MS5UZW1wbGF0ZSI1ChJHZXRUZW1wbGF0ZVJlcXVlc3QSEQoJbmFtZXNwYWNlGAEgASgJEgwKBG5hbWUYAiABKAkiQwoTR2V0VGVtcGxhdGVSZXNwb25zZRIsCgh0ZW1wbGF0ZRgBIAEoCzIaLmhvbG9zLmNvbnNvbGUudjEuVGVtcGxhdGUiWAoVQ3JlYXRlVGVtcGxhdGVSZXF1ZXN0EhEKCW5hbWVzcGFjZRgBIAEoCRIsCgh0ZW1wbGF0ZRgCIAEoCzIaLmhvbG9zLmNvbnNvbGUudjEuVGVtcGxhdGUiJgoWQ3JlYXRlVGVtcGxhdGVSZXNwb25zZRIMCgRuYW1lGAEgASgJIncKFVVwZGF0ZVRlbXBsYXRlUmVxdWVzdBIRCgluYW1lc3BhY2UYASABKAkSLAoIdGVtcGxhdGUYAiABKAsyGi5ob2xvcy5jb25zb2xlLnYxLlRlbXBsYXRlSgQIAxAEUhd1cGRhdGVfbGlua2VkX3RlbXBsYXRlcyIYChZVcGRhdGVUZW1wbGF0ZVJlc3BvbnNlIjgKFURlbGV0ZVRlbXBsYXRlUmVxdWVzdBIRCgluYW1lc3BhY2UYASABKAkSDAoEbmFtZRgCIAEoCSIYChZEZWxldGVUZW1wbGF0ZVJlc3BvbnNlIo8BChVSZW5kZXJUZW1wbGF0ZVJlcXVlc3QSEQoJbmFtZXNwYWNlGAEgASgJEhQKDGN1ZV90ZW1wbGF0ZRgCIAEoCRIaChJjdWVfcGxhdGZvcm1faW5wdXQYAyABKAkSGQoRY3VlX3Byb2plY3RfaW5wdXQYBCABKAlKBAgFEAZSEGxpbmtlZF90ZW1wbGF0ZXMilgQKFlJlbmRlclRlbXBsYXRlUmVzcG9uc2USFQoNcmVuZGVyZWRfeWFtbBgBIAEoCRIVCg1yZW5kZXJlZF9qc29uGAIgASgJEh8KF3BsYXRmb3JtX3Jlc291cmNlc195YW1sGAMgASgJEh8KF3BsYXRmb3JtX3Jlc291cmNlc19qc29uGAQgASgJEh4KFnByb2plY3RfcmVzb3VyY2VzX3lhbWwYBSABKAkSHgoWcHJvamVjdF9yZXNvdXJjZXNfanNvbhgGIAEoCRIaCg1kZWZhdWx0c19qc29uGAcgASgJSACIAQESIAoTcGxhdGZvcm1faW5wdXRfanNvbhgIIAEoCUgBiAEBEh8KEnByb2plY3RfaW5wdXRfanNvbhgJIAEoCUgCiAEBEi8KInBsYXRmb3JtX3Jlc291cmNlc19zdHJ1Y3R1cmVkX2pzb24YCiABKAlIA4gBARIuCiFwcm9qZWN0X3Jlc291cmNlc19zdHJ1Y3R1cmVkX2pzb24YCyABKAlIBIgBAUIQCg5fZGVmYXVsdHNfanNvbkIWChRfcGxhdGZvcm1faW5wdXRfanNvbkIVChNfcHJvamVjdF9pbnB1dF9qc29uQiUKI19wbGF0Zm9ybV9yZXNvdXJjZXNfc3RydWN0dXJlZF9qc29uQiQKIl9wcm9qZWN0X3Jlc291cmNlc19zdHJ1Y3R1cmVkX2pzb24iYgoUQ2xvbmVUZW1wbGF0ZVJlcXVlc3QSEQoJbmFtZXNwYWNlGAEgASgJEhMKC3NvdXJjZV9uYW1lGAIgASgJEgwKBG5hbWUYAyABKAkSFAoMZGlzcGxheV9uYW1lGAQgASgJIiUKFUNsb25lVGVtcGxhdGVSZXNwb25zZRIMCgRuYW1lGAEgASgJIk0KHExpc3RMaW5rYWJsZVRlbXBsYXRlc1JlcXVlc3QSEQoJbmFtZXNwYWNlGAEgASgJEhoKEmluY2x1ZGVfc2VsZl9zY29wZRgCIAEoCCKsAQoQTGlua2FibGVUZW1wbGF0ZRIRCgluYW1lc3BhY2UYASABKAkSDAoEbmFtZRgCIAEoCRIUCgxkaXNwbGF5X25hbWUYAyABKAkSEwoLZGVzY3JpcHRpb24YBCABKAkSKwoIcmVsZWFzZXMYBiADKAsyGS5ob2xvcy5jb25zb2xlLnYxLlJlbGVhc2USDgoGZm9yY2VkGAcgASgISgQIBRAGUgltYW5kYXRvcnkiVgodTGlzdExpbmthYmxlVGVtcGxhdGVzUmVzcG9uc2USNQoJdGVtcGxhdGVzGAEgAygLMiIuaG9sb3MuY29uc29sZS52MS5MaW5rYWJsZVRlbXBsYXRlIjEKHExpc3RBbmNlc3RvclRlbXBsYXRlc1JlcXVlc3QSEQoJbmFtZXNwYWNlGAEgASgJIk4KHUxpc3RBbmNlc3RvclRlbXBsYXRlc1Jlc3BvbnNlEi0KCXRlbXBsYXRlcxgBIAMoCzIaLmhvbG9zLmNvbnNvbGUudjEuVGVtcGxhdGUi6wEKB1JlbGVhc2USFQoNdGVtcGxhdGVfbmFtZRgBIAEoCRIRCgluYW1lc3BhY2UYAiABKAkSDwoHdmVyc2lvbhgDIAEoCRIRCgljaGFuZ2Vsb2cYBCABKAkSFgoOdXBncmFkZV9hZHZpY2UYBSABKAkSFAoMY3VlX3RlbXBsYXRlGAYgASgJEjQKCGRlZmF1bHRzGAcgASgLMiIuaG9sb3MuY29uc29sZS52MS5UZW1wbGF0ZURlZmF1bHRzEi4KCmNyZWF0ZWRfYXQYCCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIlUKFENyZWF0ZVJlbGVhc2VSZXF1ZXN0EhEKCW5hbWVzcGFjZRgBIAEoCRIqCgdyZWxlYXNlGAIgASgLMhkuaG9sb3MuY29uc29sZS52MS5SZWxlYXNlIkMKFUNyZWF0ZVJlbGVhc2VSZXNwb25zZRIqCgdyZWxlYXNlGAEgASgLMhkuaG9sb3MuY29uc29sZS52MS5SZWxlYXNlIj8KE0xpc3RSZWxlYXNlc1JlcXVlc3QSEQoJbmFtZXNwYWNlGAEgASgJEhUKDXRlbXBsYXRlX25hbWUYAiABKAkiQwoUTGlzdFJlbGVhc2VzUmVzcG9uc2USKwoIcmVsZWFzZXMYASADKAsyGS5ob2xvcy5jb25zb2xlLnYxLlJlbGVhc2UiTgoRR2V0UmVsZWFzZVJlcXVlc3QSEQoJbmFtZXNwYWNlGAEgASgJEhUKDXRlbXBsYXRlX25hbWUYAiABKAkSDwoHdmVyc2lvbhgDIAEoCSJAChJHZXRSZWxlYXNlUmVzcG9uc2USKgoHcmVsZWFzZRgBIAEoCzIZLmhvbG9zLmNvbnNvbGUudjEuUmVsZWFzZSJuChZTZWFyY2hUZW1wbGF0ZXNSZXF1ZXN0EhEKCW5hbWVzcGFjZRgBIAEoCRIMCgRuYW1lGAIgASgJEh0KFWRpc3BsYXlfbmFtZV9jb250YWlucxgDIAEoCRIUCgxvcmdhbml6YXRpb24YBCABKAkiSAoXU2VhcmNoVGVtcGxhdGVzUmVzcG9uc2USLQoJdGVtcGxhdGVzGAEgAygLMhouaG9sb3MuY29uc29sZS52MS5UZW1wbGF0ZSJgCg9UZW1wbGF0ZUV4YW1wbGUSDAoEbmFtZRgBIAEoCRIUCgxkaXNwbGF5X25hbWUYAiABKAkSEwoLZGVzY3JpcHRpb24YAyABKAkSFAoMY3VlX3RlbXBsYXRlGAQgASgJIh0KG0xpc3RUZW1wbGF0ZUV4YW1wbGVzUmVxdWVzdCJTChxMaXN0VGVtcGxhdGVFeGFtcGxlc1Jlc3BvbnNlEjMKCGV4YW1wbGVzGAEgAygLMiEuaG9sb3MuY29uc29sZS52MS5UZW1wbGF0ZUV4YW1wbGUi1QEKF1RlbXBsYXRlRGVwZW5kZW50UmVjb3JkEjAKBXNjb3BlGAEgASgOMiEuaG9sb3MuY29uc29sZS52MS5EZXBlbmRlbmN5U2NvcGUSGwoTZGVwZW5kZW50X25hbWVzcGFjZRgCIAEoCRIWCg5kZXBlbmRlbnRfbmFtZRgDIAEoCRIkChxyZXF1aXJpbmdfdGVtcGxhdGVfbmFtZXNwYWNlGAQgASgJEh8KF3JlcXVpcmluZ190ZW1wbGF0ZV9uYW1lGAUgASgJEgwKBGtpbmQYBiABKAkiQAodTGlzdFRlbXBsYXRlRGVwZW5kZW50c1JlcXVlc3QSEQoJbmFtZXNwYWNlGAEgASgJEgwKBG5hbWUYAiABKAkiXwoeTGlzdFRlbXBsYXRlRGVwZW5kZW50c1Jlc3BvbnNlEj0KCmRlcGVuZGVudHMYASADKAsyKS5ob2xvcy5jb25zb2xlLnYxLlRlbXBsYXRlRGVwZW5kZW50UmVjb3JkIlAKGURlcGxveW1lbnREZXBlbmRlbnRSZWNvcmQSGwoTZGVwZW5kZW50X25hbWVzcGFjZRgBIAEoCRIWCg5kZXBlbmRlbnRfbmFtZRgCIAEoCSJCCh9MaXN0RGVwbG95bWVudERlcGVuZGVudHNSZXF1ZXN0EhEKCW5hbWVzcGFjZRgBIAEoCRIMCgRuYW1lGAIgASgJImMKIExpc3REZXBsb3ltZW50RGVwZW5kZW50c1Jlc3BvbnNlEj8KCmRlcGVuZGVudHMYASADKAsyKy5ob2xvcy5jb25zb2xlLnYxLkRlcGxveW1lbnREZXBlbmRlbnRSZWNvcmQqlQEKD0RlcGVuZGVuY3lTY29wZRIgChxERVBFTkRFTkNZX1NDT1BFX1VOU1BFQ0lGSUVEEAASHQoZREVQRU5ERU5DWV9TQ09QRV9JTlNUQU5DRRABEhwKGERFUEVOREVOQ1lfU0NPUEVfUFJPSkVDVBACEiMKH0RFUEVOREVOQ1lfU0NPUEVfUkVNT1RFX1BST0pFQ1QQAzL3DwoPVGVtcGxhdGVTZXJ2aWNlEmUKDUxpc3RUZW1wbGF0ZXMSJi5ob2xvcy5jb25zb2xlLnYxLkxpc3RUZW1wbGF0ZXNSZXF1ZXN0GicuaG9sb3MuY29uc29sZS52MS5MaXN0VGVtcGxhdGVzUmVzcG9uc2UiA5ACARJfCgtHZXRUZW1wbGF0ZRIkLmhvbG9zLmNvbnNvbGUudjEuR2V0VGVtcGxhdGVSZXF1ZXN0GiUuaG9sb3MuY29uc29sZS52MS5HZXRUZW1wbGF0ZVJlc3BvbnNlIgOQAgESYwoOQ3JlYXRlVGVtcGxhdGUSJy5ob2xvcy5jb25zb2xlLnYxLkNyZWF0ZVRlbXBsYXRlUmVxdWVzdBooLmhvbG9zLmNvbnNvbGUudjEuQ3JlYXRlVGVtcGxhdGVSZXNwb25zZRJjCg5VcGRhdGVUZW1wbGF0ZRInLmhvbG9zLmNvbnNvbGUudjEuVXBkYXRlVGVtcGxhdGVSZXF1ZXN0GiguaG9sb3MuY29uc29sZS52MS5VcGRhdGVUZW1wbGF0ZVJlc3BvbnNlEmMKDkRlbGV0ZVRlbXBsYXRlEicuaG9sb3MuY29uc29sZS52MS5EZWxldGVUZW1wbGF0ZVJlcXVlc3QaKC5ob2xvcy5jb25zb2xlLnYxLkRlbGV0ZVRlbXBsYXRlUmVzcG9uc2USYwoOUmVuZGVyVGVtcGxhdGUSJy5ob2xvcy5jb25zb2xlLnYxLlJlbmRlclRlbXBsYXRlUmVxdWVzdBooLmhvbG9zLmNvbnNvbGUudjEuUmVuZGVyVGVtcGxhdGVSZXNwb25zZRJgCg1DbG9uZVRlbXBsYXRlEiYuaG9sb3MuY29uc29sZS52MS5DbG9uZVRlbXBsYXRlUmVxdWVzdBonLmhvbG9zLmNvbnNvbGUudjEuQ2xvbmVUZW1wbGF0ZVJlc3BvbnNlEn0KFUxpc3RMaW5rYWJsZVRlbXBsYXRlcxIuLmhvbG9zLmNvbnNvbGUudjEuTGlzdExpbmthYmxlVGVtcGxhdGVzUmVxdWVzdBovLmhvbG9zLmNvbnNvbGUudjEuTGlzdExpbmthYmxlVGVtcGxhdGVzUmVzcG9uc2UiA5ACARJ9ChVMaXN0QW5jZXN0b3JUZW1wbGF0ZXMSLi5ob2xvcy5jb25zb2xlLnYxLkxpc3RBbmNlc3RvclRlbXBsYXRlc1JlcXVlc3QaLy5ob2xvcy5jb25zb2xlLnYxLkxpc3RBbmNlc3RvclRlbXBsYXRlc1Jlc3BvbnNlIgOQAgESYAoNQ3JlYXRlUmVsZWFzZRImLmhvbG9zLmNvbnNvbGUudjEuQ3JlYXRlUmVsZWFzZVJlcXVlc3QaJy5ob2xvcy5jb25zb2xlLnYxLkNyZWF0ZVJlbGVhc2VSZXNwb25zZRJiCgxMaXN0UmVsZWFzZXMSJS5ob2xvcy5jb25zb2xlLnYxLkxpc3RSZWxlYXNlc1JlcXVlc3QaJi5ob2xvcy5jb25zb2xlLnYxLkxpc3RSZWxlYXNlc1Jlc3BvbnNlIgOQAgESXAoKR2V0UmVsZWFzZRIjLmhvbG9zLmNvbnNvbGUudjEuR2V0UmVsZWFzZVJlcXVlc3QaJC5ob2xvcy5jb25zb2xlLnYxLkdldFJlbGVhc2VSZXNwb25zZSIDkAIBEncKE0dldFRlbXBsYXRlRGVmYXVsdHMSLC5ob2xvcy5jb25zb2xlLnYxLkdldFRlbXBsYXRlRGVmYXVsdHNSZXF1ZXN0Gi0uaG9sb3MuY29uc29sZS52MS5HZXRUZW1wbGF0ZURlZmF1bHRzUmVzcG9uc2UiA5ACARKVAQodR2V0UHJvamVjdFRlbXBsYXRlUG9saWN5U3RhdGUSNi5ob2xvcy5jb25zb2xlLnYxLkdldFByb2plY3RUZW1wbGF0ZVBvbGljeVN0YXRlUmVxdWVzdBo3LmhvbG9zLmNvbnNvbGUudjEuR2V0UHJvamVjdFRlbXBsYXRlUG9saWN5U3RhdGVSZXNwb25zZSIDkAIBEmsKD1NlYXJjaFRlbXBsYXRlcxIoLmhvbG9zLmNvbnNvbGUudjEuU2VhcmNoVGVtcGxhdGVzUmVxdWVzdBopLmhvbG9zLmNvbnNvbGUudjEuU2VhcmNoVGVtcGxhdGVzUmVzcG9uc2UiA5ACARJ6ChRMaXN0VGVtcGxhdGVFeGFtcGxlcxItLmhvbG9zLmNvbnNvbGUudjEuTGlzdFRlbXBsYXRlRXhhbXBsZXNSZXF1ZXN0Gi4uaG9sb3MuY29uc29sZS52MS5MaXN0VGVtcGxhdGVFeGFtcGxlc1Jlc3BvbnNlIgOQAgESgAEKFkxpc3RUZW1wbGF0ZURlcGVuZGVudHMSLy5ob2xvcy5jb25zb2xlLnYxLkxpc3RUZW1wbGF0ZURlcGVuZGVudHNSZXF1ZXN0GjAuaG9sb3MuY29uc29sZS52MS5MaXN0VGVtcGxhdGVEZXBlbmRlbnRzUmVzcG9uc2UiA5ACARKGAQoYTGlzdERlcGxveW1lbnREZXBlbmRlbnRzEjEuaG9sb3MuY29uc29sZS52MS5MaXN0RGVwbG95bWVudERlcGVuZGVudHNSZXF1ZXN0GjIuaG9sb3MuY29uc29sZS52MS5MaXN0RGVwbG95bWVudERlcGVuZGVudHNSZXNwb25zZSIDkAIBQkNaQWdpdGh1Yi5jb20vaG9sb3MtcnVuL2hvbG9zLWNvbnNvbGUvZ2VuL2hvbG9zL2NvbnNvbGUvdjE7Y29uc29sZXYxYgZwcm90bzM", [file_google_protobuf_timestamp, file_holos_console_v1_deployments, file_holos_console_v1_list_filter, file_holos_console_v1_policy_state]);
 
 /**
  * Describes the message holos.console.v1.TemplateDefaults.
@@ -0,0 +1,241 @@
+// @generated by protoc-gen-es v2.11.0
+// @generated from file holos/console/v1/tokens.proto (package holos.console.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import type { Message } from "@bufbuild/protobuf";
+import type { Permission } from "./rbac_pb";
+import type { Timestamp } from "@bufbuild/protobuf/wkt";
+
+/**
+ * Describes the file holos/console/v1/tokens.proto.
+ */
+export declare const file_holos_console_v1_tokens: GenFile;
+
+/**
+ * APIToken describes a token without its secret value.
+ *
+ * @generated from message holos.console.v1.APIToken
+ */
+export declare type APIToken = Message<"holos.console.v1.APIToken"> & {
+  /**
+   * id is the server-assigned identifier of the token.
+   *
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * name is the caller's label for the token.
+   *
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * permissions limits what the token may do. Empty means every permission
+   * the creator holds.
+   *
+   * @generated from field: repeated holos.console.v1.Permission permissions = 3;
+   */
+  permissions: Permission[];
+
+  /**
+   * created_at is when the token was created.
+   *
+   * @generated from field: google.protobuf.Timestamp created_at = 4;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * expires_at is when the token stops being accepted.
+   *
+   * @generated from field: google.protobuf.Timestamp expires_at = 5;
+   */
+  expiresAt?: Timestamp;
+};
+
+/**
+ * Describes the message holos.console.v1.APIToken.
+ * Use `create(APITokenSchema)` to create a new message.
+ */
+export declare const APITokenSchema: GenMessage<APIToken>;
+
+/**
+ * CreateTokenRequest describes the token to mint.
+ *
+ * @generated from message holos.console.v1.CreateTokenRequest
+ */
+export declare type CreateTokenRequest = Message<"holos.console.v1.CreateTokenRequest"> & {
+  /**
+   * name is a label that helps the caller recognize the token.
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * ttl_seconds is how long the token is valid. Zero selects the server
+   * default of 7 days; the server caps it at 30 days because the token keeps
+   * the groups the caller holds now.
+   *
+   * @generated from field: int64 ttl_seconds = 2;
+   */
+  ttlSeconds: bigint;
+
+  /**
+   * permissions limits what the token may do. Empty grants every permission
+   * the creator holds.
+   *
+   * @generated from field: repeated holos.console.v1.Permission permissions = 3;
+   */
+  permissions: Permission[];
+};
+
+/**
+ * Describes the message holos.console.v1.CreateTokenRequest.
+ * Use `create(CreateTokenRequestSchema)` to create a new message.
+ */
+export declare const CreateTokenRequestSchema: GenMessage<CreateTokenRequest>;
+
+/**
+ * CreateTokenResponse carries the minted token.
+ *
+ * @generated from message holos.console.v1.CreateTokenResponse
+ */
+export declare type CreateTokenResponse = Message<"holos.console.v1.CreateTokenResponse"> & {
+  /**
+   * token is the bearer token. It is shown once.
+   *
+   * @generated from field: string token = 1;
+   */
+  token: string;
+
+  /**
+   * api_token describes the stored token.
+   *
+   * @generated from field: holos.console.v1.APIToken api_token = 2;
+   */
+  apiToken?: APIToken;
+};
+
+/**
+ * Describes the message holos.console.v1.CreateTokenResponse.
+ * Use `create(CreateTokenResponseSchema)` to create a new message.
+ */
+export declare const CreateTokenResponseSchema: GenMessage<CreateTokenResponse>;
+
+/**
+ * ListTokensRequest lists the caller's tokens.
+ *
+ * @generated from message holos.console.v1.ListTokensRequest
+ */
+export declare type ListTokensRequest = Message<"holos.console.v1.ListTokensRequest"> & {
+};
+
+/**
+ * Describes the message holos.console.v1.ListTokensRequest.
+ * Use `create(ListTokensRequestSchema)` to create a new message.
+ */
+export declare const ListTokensRequestSchema: GenMessage<ListTokensRequest>;
+
+/**
+ * ListTokensResponse contains the caller's tokens, newest first.
+ *
+ * @generated from message holos.console.v1.ListTokensResponse
+ */
+export declare type ListTokensResponse = Message<"holos.console.v1.ListTokensResponse"> & {
+  /**
+   * @generated from field: repeated holos.console.v1.APIToken tokens = 1;
+   */
+  tokens: APIToken[];
+};
+
+/**
+ * Describes the message holos.console.v1.ListTokensResponse.
+ * Use `create(ListTokensResponseSchema)` to create a new message.
+ */
+export declare const ListTokensResponseSchema: GenMessage<ListTokensResponse>;
+
+/**
+ * RevokeTokenRequest identifies the token to revoke.
+ *
+ * @generated from message holos.console.v1.RevokeTokenRequest
+ */
+export declare type RevokeTokenRequest = Message<"holos.console.v1.RevokeTokenRequest"> & {
+  /**
+   * id is the identifier of the token to revoke.
+   *
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message holos.console.v1.RevokeTokenRequest.
+ * Use `create(RevokeTokenRequestSchema)` to create a new message.
+ */
+export declare const RevokeTokenRequestSchema: GenMessage<RevokeTokenRequest>;
+
+/**
+ * RevokeTokenResponse is empty on success.
+ *
+ * @generated from message holos.console.v1.RevokeTokenResponse
+ */
+export declare type RevokeTokenResponse = Message<"holos.console.v1.RevokeTokenResponse"> & {
+};
+
+/**
+ * Describes the message holos.console.v1.RevokeTokenResponse.
+ * Use `create(RevokeTokenResponseSchema)` to create a new message.
+ */
+export declare const RevokeTokenResponseSchema: GenMessage<RevokeTokenResponse>;
+
+/**
+ * TokensService manages personal API tokens for automation that cannot run
+ * an interactive OIDC flow. A token authenticates as the user who created
+ * it, with the groups they held at creation, optionally limited to a subset
+ * of permissions. Tokens expire on their own.
+ *
+ * Only a SHA-256 hash of each token is stored, as a console-managed Secret
+ * in the console namespace. Every change emits an audit event
+ * (api_token_create, api_token_revoke).
+ *
+ * @generated from service holos.console.v1.TokensService
+ */
+export declare const TokensService: GenService<{
+  /**
+   * CreateToken mints a token for the caller. The token value is returned
+   * once and cannot be retrieved again. Tokens cannot create further
+   * tokens.
+   *
+   * @generated from rpc holos.console.v1.TokensService.CreateToken
+   */
+  createToken: {
+    methodKind: "unary";
+    input: typeof CreateTokenRequestSchema;
+    output: typeof CreateTokenResponseSchema;
+  },
+  /**
+   * ListTokens returns the caller's unexpired tokens, newest first.
+   *
+   * @generated from rpc holos.console.v1.TokensService.ListTokens
+   */
+  listTokens: {
+    methodKind: "unary";
+    input: typeof ListTokensRequestSchema;
+    output: typeof ListTokensResponseSchema;
+  },
+  /**
+   * RevokeToken deletes one of the caller's tokens so it is no longer
+   * accepted.
+   *
+   * @generated from rpc holos.console.v1.TokensService.RevokeToken
+   */
+  revokeToken: {
+    methodKind: "unary";
+    input: typeof RevokeTokenRequestSchema;
+    output: typeof RevokeTokenResponseSchema;
+  },
+}>;
+
@@ -0,0 +1,79 @@
+// @generated by protoc-gen-es v2.11.0
+// @generated from file holos/console/v1/tokens.proto (package holos.console.v1, syntax proto3)
+/* eslint-disable */
+
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import { file_buf_validate_validate } from "../../../buf/validate/validate_pb";
+import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import { file_holos_console_v1_rbac } from "./rbac_pb";
+
+/**
+ * Describes the file holos/console/v1/tokens.proto.
+ */
+export const file_holos_console_v1_tokens = /*@__PURE__*/
+  fileDesc("Ch1ob2xvcy9jb25zb2xlL3YxL3Rva2Vucy5wcm90bxIQaG9sb3MuY29uc29sZS52MSK3AQoIQVBJVG9rZW4SCgoCaWQYASABKAkSDAoEbmFtZRgCIAEoCRIxCgtwZXJtaXNzaW9ucxgDIAMoDjIcLmhvbG9zLmNvbnNvbGUudjEuUGVybWlzc2lvbhIuCgpjcmVhdGVkX2F0GAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgpleHBpcmVzX2F0GAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCKHAQoSQ3JlYXRlVG9rZW5SZXF1ZXN0EhgKBG5hbWUYASABKAlCCrpIB8gBAXICGD8SEwoLdHRsX3NlY29uZHMYAiABKAMSQgoLcGVybWlzc2lvbnMYAyADKA4yHC5ob2xvcy5jb25zb2xlLnYxLlBlcm1pc3Npb25CD7pIDJIBCSIHggEEEAEgACJTChNDcmVhdGVUb2tlblJlc3BvbnNlEg0KBXRva2VuGAEgASgJEi0KCWFwaV90b2tlbhgCIAEoCzIaLmhvbG9zLmNvbnNvbGUudjEuQVBJVG9rZW4iEwoRTGlzdFRva2Vuc1JlcXVlc3QiQAoSTGlzdFRva2Vuc1Jlc3BvbnNlEioKBnRva2VucxgBIAMoCzIaLmhvbG9zLmNvbnNvbGUudjEuQVBJVG9rZW4iKAoSUmV2b2tlVG9rZW5SZXF1ZXN0EhIKAmlkGAEgASgJQga6SAPIAQEiFQoTUmV2b2tlVG9rZW5SZXNwb25zZTKlAgoNVG9rZW5zU2VydmljZRJaCgtDcmVhdGVUb2tlbhIkLmhvbG9zLmNvbnNvbGUudjEuQ3JlYXRlVG9rZW5SZXF1ZXN0GiUuaG9sb3MuY29uc29sZS52MS5DcmVhdGVUb2tlblJlc3BvbnNlElwKCkxpc3RUb2tlbnMSIy5ob2xvcy5jb25zb2xlLnYxLkxpc3RUb2tlbnNSZXF1ZXN0GiQuaG9sb3MuY29uc29sZS52MS5MaXN0VG9rZW5zUmVzcG9uc2UiA5ACARJaCgtSZXZva2VUb2tlbhIkLmhvbG9zLmNvbnNvbGUudjEuUmV2b2tlVG9rZW5SZXF1ZXN0GiUuaG9sb3MuY29uc29sZS52MS5SZXZva2VUb2tlblJlc3BvbnNlQkNaQWdpdGh1Yi5jb20vaG9sb3MtcnVuL2hvbG9zLWNvbnNvbGUvZ2VuL2hvbG9zL2NvbnNvbGUvdjE7Y29uc29sZXYxYgZwcm90bzM", [file_buf_validate_validate, file_google_protobuf_timestamp, file_holos_console_v1_rbac]);
+
+/**
+ * Describes the message holos.console.v1.APIToken.
+ * Use `create(APITokenSchema)` to create a new message.
+ */
+export const APITokenSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_tokens, 0);
+
+/**
+ * Describes the message holos.console.v1.CreateTokenRequest.
+ * Use `create(CreateTokenRequestSchema)` to create a new message.
+ */
+export const CreateTokenRequestSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_tokens, 1);
+
+/**
+ * Describes the message holos.console.v1.CreateTokenResponse.
+ * Use `create(CreateTokenResponseSchema)` to create a new message.
+ */
+export const CreateTokenResponseSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_tokens, 2);
+
+/**
+ * Describes the message holos.console.v1.ListTokensRequest.
+ * Use `create(ListTokensRequestSchema)` to create a new message.
+ */
+export const ListTokensRequestSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_tokens, 3);
+
+/**
+ * Describes the message holos.console.v1.ListTokensResponse.
+ * Use `create(ListTokensResponseSchema)` to create a new message.
+ */
+export const ListTokensResponseSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_tokens, 4);
+
+/**
+ * Describes the message holos.console.v1.RevokeTokenRequest.
+ * Use `create(RevokeTokenRequestSchema)` to create a new message.
+ */
+export const RevokeTokenRequestSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_tokens, 5);
+
+/**
+ * Describes the message holos.console.v1.RevokeTokenResponse.
+ * Use `create(RevokeTokenResponseSchema)` to create a new message.
+ */
+export const RevokeTokenResponseSchema = /*@__PURE__*/
+  messageDesc(file_holos_console_v1_tokens, 6);
+
+/**
+ * TokensService manages personal API tokens for automation that cannot run
+ * an interactive OIDC flow. A token authenticates as the user who created
+ * it, with the groups they held at creation, optionally limited to a subset
+ * of permissions. Tokens expire on their own.
+ *
+ * Only a SHA-256 hash of each token is stored, as a console-managed Secret
+ * in the console namespace. Every change emits an audit event
+ * (api_token_create, api_token_revoke).
+ *
+ * @generated from service holos.console.v1.TokensService
+ */
+export const TokensService = /*@__PURE__*/
+  serviceDesc(file_holos_console_v1_tokens, 0);
+
@@ -8,7 +8,7 @@ import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2
  * Describes the file holos/console/v1/version.proto.
  */
 export const file_holos_console_v1_version = /*@__PURE__*/
-  fileDesc("Ch5ob2xvcy9jb25zb2xlL3YxL3ZlcnNpb24ucHJvdG8SEGhvbG9zLmNvbnNvbGUudjEiEwoRR2V0VmVyc2lvblJlcXVlc3QiZQoSR2V0VmVyc2lvblJlc3BvbnNlEg8KB3ZlcnNpb24YASABKAkSEgoKZ2l0X2NvbW1pdBgCIAEoCRIWCg5naXRfdHJlZV9zdGF0ZRgDIAEoCRISCgpidWlsZF9kYXRlGAQgASgJMmkKDlZlcnNpb25TZXJ2aWNlElcKCkdldFZlcnNpb24SIy5ob2xvcy5jb25zb2xlLnYxLkdldFZlcnNpb25SZXF1ZXN0GiQuaG9sb3MuY29uc29sZS52MS5HZXRWZXJzaW9uUmVzcG9uc2VCQ1pBZ2l0aHViLmNvbS9ob2xvcy1ydW4vaG9sb3MtY29uc29sZS9nZW4vaG9sb3MvY29uc29sZS92MTtjb25zb2xldjFiBnByb3RvMw");
+  fileDesc("Ch5ob2xvcy9jb25zb2xlL3YxL3ZlcnNpb24ucHJvdG8SEGhvbG9zLmNvbnNvbGUudjEiEwoRR2V0VmVyc2lvblJlcXVlc3QiZQoSR2V0VmVyc2lvblJlc3BvbnNlEg8KB3ZlcnNpb24YASABKAkSEgoKZ2l0X2NvbW1pdBgCIAEoCRIWCg5naXRfdHJlZV9zdGF0ZRgDIAEoCRISCgpidWlsZF9kYXRlGAQgASgJMm4KDlZlcnNpb25TZXJ2aWNlElwKCkdldFZlcnNpb24SIy5ob2xvcy5jb25zb2xlLnYxLkdldFZlcnNpb25SZXF1ZXN0GiQuaG9sb3MuY29uc29sZS52MS5HZXRWZXJzaW9uUmVzcG9uc2UiA5ACAUJDWkFnaXRodWIuY29tL2hvbG9zLXJ1bi9ob2xvcy1jb25zb2xlL2dlbi9ob2xvcy9jb25zb2xlL3YxO2NvbnNvbGV2MWIGcHJvdG8z");
 
 /**
  * Describes the message holos.console.v1.GetVersionRequest.
@@ -23,4 +23,10 @@ describe('getConsoleConfig', () => {
     const config = getConsoleConfig()
     expect(config.devToolsEnabled).toBe(false)
   })
+
+  it('defaults sessionsEnabled to false', () => {
+    expect(getConsoleConfig().sessionsEnabled).toBe(false)
+    window.__CONSOLE_CONFIG__ = { sessionsEnabled: true }
+    expect(getConsoleConfig().sessionsEnabled).toBe(true)
+  })
 })
@@ -7,6 +7,9 @@
  * `--organization-prefix`, `--folder-prefix`, `--project-prefix`). The frontend
  * must consume these at runtime so operators who customize the namespace
  * layout see the UI match the server.
+ *
+ * `sessionsEnabled` reports that the server runs the OIDC login itself via
+ * `/api/session` (`--enable-sessions`) and keeps tokens in an HTTP-only cookie.
  */
 interface ConsoleConfig {
   devToolsEnabled: boolean
@@ -14,6 +17,7 @@ interface ConsoleConfig {
   organizationPrefix: string
   folderPrefix: string
   projectPrefix: string
+  sessionsEnabled: boolean
 }
 
 declare global {
@@ -34,6 +38,7 @@ const DEFAULT_CONFIG: ConsoleConfig = {
   organizationPrefix: 'org-',
   folderPrefix: 'fld-',
   projectPrefix: 'prj-',
+  sessionsEnabled: false,
 }
 
 /**
@@ -50,5 +55,6 @@ export function getConsoleConfig(): ConsoleConfig {
       injected.organizationPrefix ?? DEFAULT_CONFIG.organizationPrefix,
     folderPrefix: injected.folderPrefix ?? DEFAULT_CONFIG.folderPrefix,
     projectPrefix: injected.projectPrefix ?? DEFAULT_CONFIG.projectPrefix,
+    sessionsEnabled: injected.sessionsEnabled ?? DEFAULT_CONFIG.sessionsEnabled,
   }
 }
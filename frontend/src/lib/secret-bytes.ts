@@ -0,0 +1,33 @@
+// Helpers for secret values, which are raw bytes that may or may not be
+// UTF-8 text (e.g. a .p12 keystore next to a .pem certificate).
+
+const strictDecoder = new TextDecoder('utf-8', { fatal: true })
+
+// isText reports whether bytes decode as UTF-8 without loss, i.e. whether the
+// value can round-trip through a text field.
+export function isText(bytes: Uint8Array): boolean {
+  try {
+    strictDecoder.decode(bytes)
+    return true
+  } catch {
+    return false
+  }
+}
+
+// bytesFingerprint returns a string that is equal for two values exactly when
+// their bytes are equal. Unlike TextDecoder output it does not collapse
+// distinct binary values onto the same replacement characters.
+export function bytesFingerprint(bytes: Uint8Array): string {
+  let out = ''
+  for (let i = 0; i < bytes.length; i++) {
+    out += bytes[i].toString(16).padStart(2, '0')
+  }
+  return out
+}
+
+// formatByteSize renders a byte count for display, e.g. "2.4 KB".
+export function formatByteSize(n: number): string {
+  if (n < 1024) return `${n} B`
+  if (n < 1024 * 1024) return `${(n / 1024).toFixed(1)} KB`
+  return `${(n / (1024 * 1024)).toFixed(1)} MB`
+}
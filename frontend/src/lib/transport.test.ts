@@ -1,6 +1,6 @@
 import { ConnectError, Code } from '@connectrpc/connect'
 import type { UnaryRequest, UnaryResponse } from '@connectrpc/connect'
-import { tokenRef, readStoredToken, createAuthInterceptor } from './transport'
+import { tokenRef, readStoredToken, createAuthInterceptor, STEP_UP_HEADER } from './transport'
 
 // Mock getUserManager so tests don't need a real OIDC provider.
 vi.mock('@/lib/auth/userManager', () => ({
@@ -293,4 +293,23 @@ describe('createAuthInterceptor', () => {
     // Despite two concurrent 401s, signinSilent must only be called once.
     expect(mockSigninSilent).toHaveBeenCalledTimes(1)
   })
+
+  it('redirects to an interactive sign-in on a step-up 401 instead of renewing', async () => {
+    tokenRef.current = 'old-token'
+    const mockSigninSilent = vi.fn()
+    const mockSigninRedirect = vi.fn().mockResolvedValue(undefined)
+    vi.mocked(getUserManager).mockReturnValue({
+      signinSilent: mockSigninSilent,
+      signinRedirect: mockSigninRedirect,
+    } as never)
+
+    const interceptor = createAuthInterceptor()
+    const stepUpError = new ConnectError('step-up required', Code.Unauthenticated, new Headers({ [STEP_UP_HEADER]: '900' }))
+    const next = vi.fn().mockRejectedValue(stepUpError)
+
+    await expect(interceptor(next)(makeMockRequest())).rejects.toBe(stepUpError)
+    expect(mockSigninRedirect).toHaveBeenCalledWith(expect.objectContaining({ prompt: 'login', max_age: 900 }))
+    expect(mockSigninSilent).not.toHaveBeenCalled()
+    expect(next).toHaveBeenCalledTimes(1)
+  })
 })
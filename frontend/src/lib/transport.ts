@@ -59,12 +59,29 @@ async function renewToken(): Promise<string> {
   }
 }
 
+// STEP_UP_HEADER is set on Unauthenticated errors for RPCs that require a
+// recent sign-in. Its value is the maximum sign-in age in seconds.
+export const STEP_UP_HEADER = 'Holos-Step-Up-Max-Age'
+
+// stepUp sends the user through an interactive sign-in that satisfies
+// maxAge. A silent renewal would not prove a recent sign-in, so the user is
+// redirected and returns to the current page afterwards.
+async function stepUp(maxAge: number): Promise<void> {
+  await getUserManager().signinRedirect({
+    prompt: 'login',
+    max_age: maxAge,
+    state: { returnTo: window.location.pathname },
+  })
+}
+
 // createAuthInterceptor returns a ConnectRPC interceptor that:
 //   1. Attaches the current Bearer token to every outgoing request.
 //   2. On a 401/Unauthenticated response, performs a single serialized silent
 //      token renewal via oidc-client-ts and retries the request once.
 //   3. Coalesces concurrent 401s so only one signinSilent() call is made.
 //   4. Does not retry a second 401 to prevent infinite loops.
+//   5. On a step-up 401 (STEP_UP_HEADER set), redirects to an interactive
+//      sign-in instead of renewing silently.
 export function createAuthInterceptor(): Interceptor {
   return (next) => async (req) => {
     // Attach current token.
@@ -79,6 +96,11 @@ export function createAuthInterceptor(): Interceptor {
       // The retry is outside the try-catch so a second 401 propagates
       // directly without looping back here.
       if (err instanceof ConnectError && err.code === Code.Unauthenticated) {
+        const maxAge = err.metadata.get(STEP_UP_HEADER)
+        if (maxAge !== null) {
+          await stepUp(Number(maxAge))
+          throw err
+        }
         // Renew token (coalesced with other concurrent 401s).
         const freshToken = await renewToken()
         // Update the request header with the fresh token for the retry.
@@ -90,7 +112,11 @@ export function createAuthInterceptor(): Interceptor {
   }
 }
 
+// useHttpGet sends RPCs declared with idempotency_level = NO_SIDE_EFFECTS
+// as GET requests, so the browser can revalidate them and their URLs can be
+// copied for debugging. Every other RPC is still a POST.
 export const transport = createConnectTransport({
   baseUrl: '/',
+  useHttpGet: true,
   interceptors: [createAuthInterceptor()],
 })
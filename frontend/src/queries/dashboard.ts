@@ -0,0 +1,40 @@
+import { useMemo } from 'react'
+import { createClient } from '@connectrpc/connect'
+import { useTransport } from '@connectrpc/connect-query'
+import { useQuery } from '@tanstack/react-query'
+import { DashboardService } from '@/gen/holos/console/v1/dashboard_pb.js'
+import { PrincipalKind } from '@/gen/holos/console/v1/permissions_pb.js'
+import { useAuth } from '@/lib/auth'
+import { keys } from '@/queries/keys'
+
+// useListAccessibleResources returns every organization, project, and secret
+// the caller can access in one request, organizations first.
+export function useListAccessibleResources() {
+  const { isAuthenticated } = useAuth()
+  const transport = useTransport()
+  const client = useMemo(() => createClient(DashboardService, transport), [transport])
+  return useQuery({
+    queryKey: keys.dashboard.accessibleResources(),
+    queryFn: async () => {
+      const response = await client.listAccessibleResources({})
+      return response.resources
+    },
+    enabled: isAuthenticated,
+  })
+}
+
+// useGetPrincipalAccessReport lists everything a user or group holds a role
+// on, with the grants conferring it. Only platform owners may run it.
+export function useGetPrincipalAccessReport(principal: string, kind: PrincipalKind) {
+  const { isAuthenticated } = useAuth()
+  const transport = useTransport()
+  const client = useMemo(() => createClient(DashboardService, transport), [transport])
+  return useQuery({
+    queryKey: keys.dashboard.principalAccessReport(kind, principal),
+    queryFn: async () => {
+      const response = await client.getPrincipalAccessReport({ principal, kind })
+      return response.access
+    },
+    enabled: isAuthenticated && principal.length > 0 && kind !== PrincipalKind.UNSPECIFIED,
+  })
+}
@@ -0,0 +1,22 @@
+import { useMemo } from 'react'
+import { createClient } from '@connectrpc/connect'
+import { useTransport } from '@connectrpc/connect-query'
+import { useQuery } from '@tanstack/react-query'
+import { IdentityService } from '@/gen/holos/console/v1/identity_pb.js'
+import { useAuth } from '@/lib/auth'
+import { keys } from '@/queries/keys'
+
+// useGetSession returns when the caller's token stops being accepted and
+// which RPCs require a more recent sign-in. It refreshes every minute so a
+// countdown derived from expiresAt stays close to the server's view.
+export function useGetSession() {
+  const { isAuthenticated } = useAuth()
+  const transport = useTransport()
+  const client = useMemo(() => createClient(IdentityService, transport), [transport])
+  return useQuery({
+    queryKey: keys.identity.session(),
+    queryFn: async () => client.getSession({}),
+    enabled: isAuthenticated,
+    refetchInterval: 60_000,
+  })
+}
@@ -49,6 +49,8 @@ export const keys = {
     list: () => ['organizations', 'list'] as const,
     get: (name: string) => keys.connect.getOrganization(name),
     raw: (name: string) => keys.connect.getOrganizationRaw(name),
+    stats: (name: string) => ['organizations', 'stats', name] as const,
+    members: (name: string) => ['organizations', 'members', name] as const,
   },
   permissions: {
     // Bulk SelfSubjectAccessReview lookup. The cache key is intentionally
@@ -58,6 +60,11 @@ export const keys = {
     // the same cache entry.
     list: (permissionKeys: string[]) =>
       ['permissions', 'list', ...[...permissionKeys].sort()] as const,
+    accessReview: (resourceType: string, name: string, project?: string) =>
+      ['permissions', 'access-review', resourceType, name, project] as const,
+    canI: (permission: number, resourceType: string, name?: string, project?: string) =>
+      ['permissions', 'can-i', permission, resourceType, name, project] as const,
+    myGroups: () => ['permissions', 'my-groups'] as const,
   },
   projectSettings: {
     get: (project: string) => ['project-settings', 'get', project] as const,
@@ -70,6 +77,11 @@ export const keys = {
       parentName?: string,
     ) => ['projects', 'listByParent', organization, parentType, parentName] as const,
     get: (name: string) => keys.connect.getProject(name),
+    resources: (project: string) => ['projects', 'resources', project] as const,
+    events: (project: string, types: string[] = []) =>
+      ['projects', 'events', project, types] as const,
+    deleted: (organization: string) =>
+      ['projects', 'deleted', organization] as const,
   },
   secrets: {
     list: (project: string) => ['secrets', 'list', project] as const,
@@ -77,7 +89,28 @@ export const keys = {
       ['secrets', 'get', project, name] as const,
     raw: (project: string, name: string) =>
       ['secrets', 'raw', project, name] as const,
+    usage: (project: string, name: string) =>
+      ['secrets', 'usage', project, name] as const,
     fanout: (project: string) => ['secrets', 'list', project, 'fanout'] as const,
+    // Nested under list so invalidating the list after a write also
+    // refreshes quota usage.
+    quota: (project: string) => ['secrets', 'list', project, 'quota'] as const,
+    // Nested under list so deleting a secret also refreshes the trash.
+    deleted: (project: string) => ['secrets', 'list', project, 'deleted'] as const,
+  },
+  tokens: {
+    list: () => ['tokens', 'list'] as const,
+  },
+  dashboard: {
+    accessibleResources: () => ['dashboard', 'accessibleResources'] as const,
+    principalAccessReport: (kind: number, principal: string) =>
+      ['dashboard', 'principalAccessReport', kind, principal] as const,
+  },
+  identity: {
+    session: () => ['identity', 'session'] as const,
+  },
+  maintenance: {
+    get: () => ['maintenance', 'get'] as const,
   },
   templatePolicies: {
     list: (namespace: string) => ['templatePolicies', 'list', namespace] as const,
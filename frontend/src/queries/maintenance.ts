@@ -0,0 +1,24 @@
+import { useMemo } from 'react'
+import { createClient } from '@connectrpc/connect'
+import { useTransport } from '@connectrpc/connect-query'
+import { useQuery } from '@tanstack/react-query'
+import { MaintenanceService } from '@/gen/holos/console/v1/maintenance_pb.js'
+import { useAuth } from '@/lib/auth'
+import { keys } from '@/queries/keys'
+
+// useGetMaintenance returns the console's read-only maintenance state. It
+// refreshes every minute so the banner appears and clears without a reload.
+export function useGetMaintenance() {
+  const { isAuthenticated } = useAuth()
+  const transport = useTransport()
+  const client = useMemo(() => createClient(MaintenanceService, transport), [transport])
+  return useQuery({
+    queryKey: keys.maintenance.get(),
+    queryFn: async () => {
+      const response = await client.getMaintenance({})
+      return response.maintenance
+    },
+    enabled: isAuthenticated,
+    refetchInterval: 60_000,
+  })
+}
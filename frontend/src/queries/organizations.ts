@@ -54,6 +54,31 @@ export function useGetOrganization(name: string) {
   })
 }
 
+export function useGetOrganizationStats(name: string) {
+  const { isAuthenticated } = useAuth()
+  const transport = useTransport()
+  const client = useMemo(() => createClient(OrganizationService, transport), [transport])
+  return useTanstackQuery({
+    queryKey: keys.organizations.stats(name),
+    queryFn: async () => client.getOrganizationStats({ name }),
+    enabled: isAuthenticated && name.length > 0,
+  })
+}
+
+export function useListOrganizationMembers(name: string) {
+  const { isAuthenticated } = useAuth()
+  const transport = useTransport()
+  const client = useMemo(() => createClient(OrganizationService, transport), [transport])
+  return useTanstackQuery({
+    queryKey: keys.organizations.members(name),
+    queryFn: async () => {
+      const response = await client.listOrganizationMembers({ name })
+      return response.members
+    },
+    enabled: isAuthenticated && name.length > 0,
+  })
+}
+
 export function useGetOrganizationRaw(name: string) {
   const { isAuthenticated } = useAuth()
   const transport = useTransport()
@@ -0,0 +1,27 @@
+import { useMemo } from 'react'
+import { createClient } from '@connectrpc/connect'
+import { useTransport } from '@connectrpc/connect-query'
+import { useMutation, useQueryClient } from '@tanstack/react-query'
+import { OwnershipService } from '@/gen/holos/console/v1/ownership_pb.js'
+import { keys } from '@/queries/keys'
+
+/**
+ * Hands an organization or project to a new owner in one sharing update.
+ * callerRole demotes the caller; leave it unset to stay an owner.
+ */
+export function useTransferOwnership() {
+  const transport = useTransport()
+  const client = useMemo(() => createClient(OwnershipService, transport), [transport])
+  const queryClient = useQueryClient()
+  return useMutation({
+    mutationFn: (params: {
+      resourceType: 'organization' | 'project'
+      name: string
+      newOwner: string
+      callerRole?: number
+    }) => client.transferOwnership(params),
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: keys.connect.all() })
+    },
+  })
+}
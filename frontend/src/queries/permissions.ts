@@ -24,6 +24,7 @@ import {
   type ResourceAttributes,
   type ResourcePermission,
 } from '@/gen/holos/console/v1/permissions_pb.js'
+import type { Permission } from '@/gen/holos/console/v1/rbac_pb.js'
 import { useAuth } from '@/lib/auth'
 import { keys } from '@/queries/keys'
 
@@ -119,3 +120,67 @@ export function isAllowed(
 ): boolean {
   return !!perms?.[key]?.allowed
 }
+
+// useAccessReview lists every principal with access to a secret, project,
+// folder, or organization and the highest role each holds. Only owners of
+// the resource may call it; pass project when resourceType is 'secret'.
+export function useAccessReview(resourceType: string, name: string, project?: string) {
+  const { isAuthenticated } = useAuth()
+  const transport = useTransport()
+  const client = useMemo(
+    () => createClient(PermissionsService, transport),
+    [transport],
+  )
+  return useQuery({
+    queryKey: keys.permissions.accessReview(resourceType, name, project),
+    queryFn: async () => {
+      const response = await client.getAccessReview({ resourceType, name, project })
+      return response.grants
+    },
+    enabled: isAuthenticated && !!resourceType && !!name,
+  })
+}
+
+// useCanI asks the backend whether the caller holds a console permission on a
+// resource. The backend owns the permission → Kubernetes verb mapping, so
+// components can gate buttons on PERMISSION_* values without knowing which
+// SelfSubjectAccessReview backs each one. Returns false until resolved.
+export function useCanI(
+  permission: Permission,
+  resourceType: string,
+  name?: string,
+  project?: string,
+) {
+  const { isAuthenticated } = useAuth()
+  const transport = useTransport()
+  const client = useMemo(
+    () => createClient(PermissionsService, transport),
+    [transport],
+  )
+  const query = useQuery({
+    queryKey: keys.permissions.canI(permission, resourceType, name, project),
+    queryFn: async () => client.canI({ permission, resourceType, name, project }),
+    enabled: isAuthenticated && !!resourceType,
+  })
+  return { ...query, allowed: !!query.data?.allowed }
+}
+
+// useMyGroups lists the caller's ID token groups and every RoleBinding and
+// ClusterRoleBinding each activates, so users can check their effective
+// group resolution without filing a ticket.
+export function useMyGroups() {
+  const { isAuthenticated } = useAuth()
+  const transport = useTransport()
+  const client = useMemo(
+    () => createClient(PermissionsService, transport),
+    [transport],
+  )
+  return useQuery({
+    queryKey: keys.permissions.myGroups(),
+    queryFn: async () => {
+      const response = await client.listMyGroups({})
+      return response.groups
+    },
+    enabled: isAuthenticated,
+  })
+}
@@ -48,6 +48,69 @@ export function useGetProject(name: string) {
   })
 }
 
+// useListProjectResources summarizes the Deployments, StatefulSets,
+// Services, and Ingresses in the project namespace. Kinds the caller may not
+// list are omitted by the server.
+export function useListProjectResources(project: string) {
+  const { isAuthenticated } = useAuth()
+  const transport = useTransport()
+  const client = useMemo(() => createClient(ProjectService, transport), [transport])
+  return useTanstackQuery({
+    queryKey: keys.projects.resources(project),
+    queryFn: async () => {
+      const response = await client.listProjectResources({ project })
+      return response.resources
+    },
+    enabled: isAuthenticated && project.length > 0,
+  })
+}
+
+// useListProjectEvents returns the first page of recent Kubernetes Events in
+// the project namespace, most recent first, optionally limited to the given
+// event types (e.g. ['Warning']).
+export function useListProjectEvents(project: string, types: string[] = []) {
+  const { isAuthenticated } = useAuth()
+  const transport = useTransport()
+  const client = useMemo(() => createClient(ProjectService, transport), [transport])
+  return useTanstackQuery({
+    queryKey: keys.projects.events(project, types),
+    queryFn: async () => {
+      const response = await client.listProjectEvents({ project, types })
+      return response.events
+    },
+    enabled: isAuthenticated && project.length > 0,
+  })
+}
+
+// useListDeletedProjects lists the soft-deleted projects the caller owned.
+export function useListDeletedProjects(organization: string) {
+  const { isAuthenticated } = useAuth()
+  const transport = useTransport()
+  const client = useMemo(() => createClient(ProjectService, transport), [transport])
+  return useTanstackQuery({
+    queryKey: keys.projects.deleted(organization),
+    queryFn: async () => {
+      const response = await client.listDeletedProjects({ organization })
+      return response.projects
+    },
+    enabled: isAuthenticated,
+  })
+}
+
+export function useRestoreProject() {
+  const transport = useTransport()
+  const client = useMemo(() => createClient(ProjectService, transport), [transport])
+  const queryClient = useQueryClient()
+  return useMutation({
+    mutationFn: async (params: { name: string }) =>
+      client.restoreProject({ name: params.name }),
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: keys.connect.all() })
+      queryClient.invalidateQueries({ queryKey: ['projects', 'deleted'] })
+    },
+  })
+}
+
 export function useCreateProject() {
   const transport = useTransport()
   const client = useMemo(() => createClient(ProjectService, transport), [transport])
@@ -74,6 +137,17 @@ export function useUpdateProject() {
   })
 }
 
+// useDiffProject previews the fields an UpdateProject with the same params
+// would change, for a confirmation step. It writes nothing.
+export function useDiffProject() {
+  const transport = useTransport()
+  const client = useMemo(() => createClient(ProjectService, transport), [transport])
+  return useMutation({
+    mutationFn: (params: { name: string; displayName?: string; description?: string; parentType?: ParentType; parentName?: string }) =>
+      client.diffProject(params),
+  })
+}
+
 export function useUpdateProjectSharing() {
   const transport = useTransport()
   const client = useMemo(() => createClient(ProjectService, transport), [transport])
@@ -114,6 +188,7 @@ export function useDeleteProject() {
     mutationFn: (params: { name: string }) => client.deleteProject(params),
     onSuccess: () => {
       queryClient.invalidateQueries({ queryKey: keys.connect.all() })
+      queryClient.invalidateQueries({ queryKey: ['projects', 'deleted'] })
     },
   })
 }
@@ -14,6 +14,7 @@ import {
   useDeleteSecret,
   useGetSecretRaw,
   useListSecrets,
+  usePatchSecret,
   useUpdateSecret,
   useUpdateSecretSharing,
 } from '@/queries/secrets'
@@ -106,6 +107,7 @@ describe('secret mutation invalidation', () => {
       createSecret: vi.fn().mockResolvedValue({}),
       deleteSecret: vi.fn().mockResolvedValue({}),
       updateSecret: vi.fn().mockResolvedValue({}),
+      patchSecret: vi.fn().mockResolvedValue({ keys: [] }),
       updateSharing: vi.fn().mockResolvedValue({}),
     }
     ;(createClient as Mock).mockReturnValue(mockClient)
@@ -156,6 +158,24 @@ describe('secret mutation invalidation', () => {
     expectSecretInvalidation(invalidateSpy, 'demo-project', 'api-key')
   })
 
+  it('invalidates list and detail keys after patch', async () => {
+    const invalidateSpy = vi.spyOn(queryClient, 'invalidateQueries')
+    const { result } = renderHook(() => usePatchSecret('demo-project'), {
+      wrapper: makeWrapper(queryClient),
+    })
+
+    await act(async () => {
+      await result.current.mutateAsync({ name: 'api-key', removeKeys: ['old'] })
+    })
+
+    expect(mockClient.patchSecret).toHaveBeenCalledWith({
+      name: 'api-key',
+      removeKeys: ['old'],
+      project: 'demo-project',
+    })
+    expectSecretInvalidation(invalidateSpy, 'demo-project', 'api-key')
+  })
+
   it('invalidates list and detail keys after sharing update', async () => {
     const invalidateSpy = vi.spyOn(queryClient, 'invalidateQueries')
     const { result } = renderHook(() => useUpdateSecretSharing('demo-project'), {
@@ -1,5 +1,5 @@
 import { useMemo } from 'react'
-import { createClient } from '@connectrpc/connect'
+import { createClient, type Client } from '@connectrpc/connect'
 import { useTransport } from '@connectrpc/connect-query'
 import {
   keepPreviousData,
@@ -9,8 +9,10 @@ import {
   type QueryClient,
 } from '@tanstack/react-query'
 import { SecretsService } from '@/gen/holos/console/v1/secrets_pb.js'
-import type { SecretMetadata } from '@/gen/holos/console/v1/secrets_pb.js'
+import type { GenerateFormat, SecretMetadata } from '@/gen/holos/console/v1/secrets_pb.js'
 import { useAuth } from '@/lib/auth'
+import { tokenRef } from '@/lib/transport'
+import { saveBlob } from '@/lib/save-blob'
 import { aggregateFanOut, type FanOutAggregate, type FanOutQueryState } from '@/queries/templatePolicies'
 import { keys } from '@/queries/keys'
 
@@ -23,16 +25,35 @@ function invalidateSecretListAndDetail(
   queryClient.invalidateQueries({ queryKey: keys.secrets.get(project, name) })
 }
 
+// listETags remembers the etag of each cached secret listing so a refetch
+// can ask the server whether the listing changed.
+const listETags = new WeakMap<SecretMetadata[], string>()
+
+// fetchSecretList lists a project's secrets, returning the cached listing
+// without transferring it again when the server reports it unchanged.
+async function fetchSecretList(
+  client: Client<typeof SecretsService>,
+  queryClient: QueryClient,
+  project: string,
+): Promise<SecretMetadata[]> {
+  const cached = queryClient.getQueryData<SecretMetadata[]>(keys.secrets.list(project))
+  const ifNoneMatch = (cached && listETags.get(cached)) || ''
+  const response = await client.listSecrets({ project, ifNoneMatch })
+  if (response.notModified && cached) {
+    return cached
+  }
+  listETags.set(response.secrets, response.etag)
+  return response.secrets
+}
+
 export function useListSecrets(project: string) {
   const { isAuthenticated } = useAuth()
   const transport = useTransport()
   const client = useMemo(() => createClient(SecretsService, transport), [transport])
+  const queryClient = useQueryClient()
   return useQuery({
     queryKey: keys.secrets.list(project),
-    queryFn: async () => {
-      const response = await client.listSecrets({ project })
-      return response.secrets
-    },
+    queryFn: () => fetchSecretList(client, queryClient, project),
     enabled: isAuthenticated && !!project,
     placeholderData: keepPreviousData,
   })
@@ -52,6 +73,21 @@ export function useGetSecret(project: string, name: string) {
   })
 }
 
+/**
+ * useGetProjectQuota fetches the project's effective secret quota and current
+ * usage. Zero limits mean unlimited.
+ */
+export function useGetProjectQuota(project: string) {
+  const { isAuthenticated } = useAuth()
+  const transport = useTransport()
+  const client = useMemo(() => createClient(SecretsService, transport), [transport])
+  return useQuery({
+    queryKey: keys.secrets.quota(project),
+    queryFn: async () => client.getProjectQuota({ project }),
+    enabled: isAuthenticated && !!project,
+  })
+}
+
 /**
  * useGetSecretRaw fetches the full Kubernetes Secret object as verbatim JSON.
  * The query is disabled by default; pass enabled=true to trigger the fetch.
@@ -72,6 +108,48 @@ export function useGetSecretRaw(project: string, name: string, enabled: boolean)
   })
 }
 
+// useGetSecretUsage lists the workloads in the project that reference the
+// secret. unscannedKinds names workload kinds the caller may not list.
+export function useGetSecretUsage(project: string, name: string) {
+  const { isAuthenticated } = useAuth()
+  const transport = useTransport()
+  const client = useMemo(() => createClient(SecretsService, transport), [transport])
+  return useQuery({
+    queryKey: keys.secrets.usage(project, name),
+    queryFn: async () => client.getSecretUsage({ name, project }),
+    enabled: isAuthenticated && !!project && !!name,
+  })
+}
+
+// useListDeletedSecrets lists the project's soft-deleted secrets, most
+// recently deleted first.
+export function useListDeletedSecrets(project: string) {
+  const { isAuthenticated } = useAuth()
+  const transport = useTransport()
+  const client = useMemo(() => createClient(SecretsService, transport), [transport])
+  return useQuery({
+    queryKey: keys.secrets.deleted(project),
+    queryFn: async () => {
+      const response = await client.listDeletedSecrets({ project })
+      return response.secrets
+    },
+    enabled: isAuthenticated && !!project,
+  })
+}
+
+export function useRestoreSecret(project: string) {
+  const transport = useTransport()
+  const client = useMemo(() => createClient(SecretsService, transport), [transport])
+  const queryClient = useQueryClient()
+  return useMutation({
+    mutationFn: async (params: { name: string }) =>
+      client.restoreSecret({ name: params.name, project }),
+    onSuccess: (_data, params) => {
+      invalidateSecretListAndDetail(queryClient, project, params.name)
+    },
+  })
+}
+
 // GetSecret only returns data (bytes), not metadata (description, url, grants).
 // There is no dedicated GetSecretMetadata RPC, so we derive metadata from the
 // listSecrets cache. Uses the same query key as useListSecrets so TanStack Query
@@ -80,12 +158,10 @@ export function useGetSecretMetadata(project: string, name: string) {
   const { isAuthenticated } = useAuth()
   const transport = useTransport()
   const client = useMemo(() => createClient(SecretsService, transport), [transport])
+  const queryClient = useQueryClient()
   return useQuery({
     queryKey: keys.secrets.list(project),
-    queryFn: async () => {
-      const response = await client.listSecrets({ project })
-      return response.secrets
-    },
+    queryFn: () => fetchSecretList(client, queryClient, project),
     enabled: isAuthenticated && !!project && !!name,
     select: (secrets) => secrets.find((s: SecretMetadata) => s.name === name) ?? null,
   })
@@ -103,6 +179,14 @@ export function useCreateSecret(project: string) {
       roleGrants: { principal: string; role: number }[]
       description?: string
       url?: string
+      // contentTypes records the media type of uploaded values by key.
+      contentTypes?: Record<string, string>
+      // generate asks the server to create random values for these keys; the
+      // values come back once in the response's generatedValues.
+      generate?: Record<string, { format?: GenerateFormat; length?: number; charset?: string }>
+      // dockerRegistry makes the server render a kubernetes.io/dockerconfigjson
+      // image pull secret from registry credentials.
+      dockerRegistry?: { server: string; username: string; password: string; email?: string }
     }) => client.createSecret({ ...params, project }),
     onSuccess: (_data, variables) => {
       invalidateSecretListAndDetail(queryClient, project, variables.name)
@@ -110,6 +194,27 @@ export function useCreateSecret(project: string) {
   })
 }
 
+// useCreateSSHKeySecret generates an ed25519 deploy key server-side. Only the
+// public key and its fingerprint are returned.
+export function useCreateSSHKeySecret(project: string) {
+  const transport = useTransport()
+  const client = useMemo(() => createClient(SecretsService, transport), [transport])
+  const queryClient = useQueryClient()
+  return useMutation({
+    mutationFn: (params: {
+      name: string
+      comment?: string
+      userGrants: { principal: string; role: number }[]
+      roleGrants: { principal: string; role: number }[]
+      description?: string
+      url?: string
+    }) => client.createSSHKeySecret({ ...params, project }),
+    onSuccess: (_data, variables) => {
+      invalidateSecretListAndDetail(queryClient, project, variables.name)
+    },
+  })
+}
+
 export function useDeleteSecret(project: string) {
   const transport = useTransport()
   const client = useMemo(() => createClient(SecretsService, transport), [transport])
@@ -122,6 +227,23 @@ export function useDeleteSecret(project: string) {
   })
 }
 
+// useBatchDeleteSecrets deletes the secrets selected in a table in one
+// call. It resolves with a result per name; failed items carry an error
+// rather than rejecting the whole mutation.
+export function useBatchDeleteSecrets(project: string) {
+  const transport = useTransport()
+  const client = useMemo(() => createClient(SecretsService, transport), [transport])
+  const queryClient = useQueryClient()
+  return useMutation({
+    mutationFn: (names: string[]) => client.batchDeleteSecrets({ names, project }),
+    onSuccess: (_data, names) => {
+      for (const name of names) {
+        invalidateSecretListAndDetail(queryClient, project, name)
+      }
+    },
+  })
+}
+
 export function useUpdateSecret(project: string) {
   const transport = useTransport()
   const client = useMemo(() => createClient(SecretsService, transport), [transport])
@@ -132,6 +254,8 @@ export function useUpdateSecret(project: string) {
       data: Record<string, Uint8Array>
       description?: string
       url?: string
+      // contentTypes replaces the recorded media types when non-empty.
+      contentTypes?: Record<string, string>
     }) => client.updateSecret({ ...params, project }),
     onSuccess: (_data, variables) => {
       invalidateSecretListAndDetail(queryClient, project, variables.name)
@@ -139,6 +263,125 @@ export function useUpdateSecret(project: string) {
   })
 }
 
+/**
+ * downloadSecretKey saves one secret key as a file through the console's
+ * download endpoint, which serves the raw bytes with the recorded media type
+ * and a Content-Disposition filename. Binary values such as keystores survive
+ * intact because they never pass through a text field.
+ */
+export async function downloadSecretKey(project: string, name: string, key: string): Promise<void> {
+  const params = new URLSearchParams({ project, name, key })
+  const headers: Record<string, string> = {}
+  if (tokenRef.current) {
+    headers.Authorization = `Bearer ${tokenRef.current}`
+  }
+  const response = await fetch(`/api/secrets/download?${params}`, { headers })
+  if (!response.ok) {
+    let message = response.statusText
+    try {
+      const body = (await response.json()) as { message?: string }
+      if (body.message) message = body.message
+    } catch {
+      // Not a Connect error body; keep the status text.
+    }
+    throw new Error(`Download failed: ${message}`)
+  }
+  saveBlob(await response.blob(), key)
+}
+
+// useExportSecretSealed fetches the secret as a SealedSecret manifest
+// encrypted to the console's sealing certificate, for committing to Git.
+export function useExportSecretSealed(project: string) {
+  const transport = useTransport()
+  const client = useMemo(() => createClient(SecretsService, transport), [transport])
+  return useMutation({
+    mutationFn: (name: string) => client.exportSecretSealed({ name, project }),
+  })
+}
+
+// useCreateShareLink creates a signed, time-limited link that reads the
+// secret without an account. The URL is a credential; show it once.
+export function useCreateShareLink(project: string) {
+  const transport = useTransport()
+  const client = useMemo(() => createClient(SecretsService, transport), [transport])
+  return useMutation({
+    mutationFn: (params: { name: string; ttlSeconds?: bigint; keys?: string[] }) =>
+      client.createShareLink({ ...params, project }),
+  })
+}
+
+/**
+ * useDiffSecret previews an UpdateSecret with the given data. The response
+ * lists the keys that would be added, removed, or changed with their value
+ * sizes, never the values, and nothing is written.
+ */
+export function useDiffSecret(project: string) {
+  const transport = useTransport()
+  const client = useMemo(() => createClient(SecretsService, transport), [transport])
+  return useMutation({
+    mutationFn: (params: { name: string; proposedData: Record<string, Uint8Array> }) =>
+      client.diffSecret({ ...params, project }),
+  })
+}
+
+/**
+ * usePatchSecret adds, replaces, or removes individual keys without sending
+ * the rest of the secret's data, so the caller only holds the values the user
+ * actually edited.
+ */
+export function usePatchSecret(project: string) {
+  const transport = useTransport()
+  const client = useMemo(() => createClient(SecretsService, transport), [transport])
+  const queryClient = useQueryClient()
+  return useMutation({
+    mutationFn: (params: {
+      name: string
+      data?: Record<string, Uint8Array>
+      removeKeys?: string[]
+    }) => client.patchSecret({ ...params, project }),
+    onSuccess: (_data, variables) => {
+      invalidateSecretListAndDetail(queryClient, project, variables.name)
+    },
+  })
+}
+
+/**
+ * useApplySecretRaw creates or updates a secret from a pasted Secret manifest
+ * in JSON or YAML. The response reports the secret name and whether the apply
+ * created it.
+ */
+export function useApplySecretRaw(project: string) {
+  const transport = useTransport()
+  const client = useMemo(() => createClient(SecretsService, transport), [transport])
+  const queryClient = useQueryClient()
+  return useMutation({
+    mutationFn: (params: { manifest: string; dryRun?: boolean }) =>
+      client.applySecretRaw({ ...params, project }),
+    onSuccess: (data) => {
+      invalidateSecretListAndDetail(queryClient, project, data.name)
+    },
+  })
+}
+
+/**
+ * useRotateSecret replaces existing keys with server-generated values. The new
+ * values come back once in the response's rotatedValues.
+ */
+export function useRotateSecret(project: string) {
+  const transport = useTransport()
+  const client = useMemo(() => createClient(SecretsService, transport), [transport])
+  const queryClient = useQueryClient()
+  return useMutation({
+    mutationFn: (params: {
+      name: string
+      keys: Record<string, { format?: GenerateFormat; length?: number; charset?: string }>
+    }) => client.rotateSecret({ ...params, project }),
+    onSuccess: (_data, variables) => {
+      invalidateSecretListAndDetail(queryClient, project, variables.name)
+    },
+  })
+}
+
 export function useUpdateSecretSharing(project: string) {
   const transport = useTransport()
   const client = useMemo(() => createClient(SecretsService, transport), [transport])
@@ -146,8 +389,9 @@ export function useUpdateSecretSharing(project: string) {
   return useMutation({
     mutationFn: (params: {
       name: string
-      userGrants: { principal: string; role: number }[]
-      roleGrants: { principal: string; role: number }[]
+      userGrants: { principal: string; role: number; deny?: boolean }[]
+      roleGrants: { principal: string; role: number; deny?: boolean }[]
+      inviteUsers?: boolean
     }) => client.updateSharing({ ...params, project }),
     onSuccess: (_data, variables) => {
       invalidateSecretListAndDetail(queryClient, project, variables.name)
@@ -0,0 +1,48 @@
+import { useMemo } from 'react'
+import { createClient } from '@connectrpc/connect'
+import { useTransport } from '@connectrpc/connect-query'
+import { useQuery, useMutation, useQueryClient } from '@tanstack/react-query'
+import { TokensService } from '@/gen/holos/console/v1/tokens_pb.js'
+import type { Permission } from '@/gen/holos/console/v1/rbac_pb.js'
+import { useAuth } from '@/lib/auth'
+import { keys } from '@/queries/keys'
+
+export function useListTokens() {
+  const { isAuthenticated } = useAuth()
+  const transport = useTransport()
+  const client = useMemo(() => createClient(TokensService, transport), [transport])
+  return useQuery({
+    queryKey: keys.tokens.list(),
+    queryFn: async () => {
+      const response = await client.listTokens({})
+      return response.tokens
+    },
+    enabled: isAuthenticated,
+    retry: false,
+  })
+}
+
+export function useCreateToken() {
+  const transport = useTransport()
+  const client = useMemo(() => createClient(TokensService, transport), [transport])
+  const queryClient = useQueryClient()
+  return useMutation({
+    mutationFn: (params: { name: string; ttlSeconds?: bigint; permissions?: Permission[] }) =>
+      client.createToken(params),
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: keys.tokens.list() })
+    },
+  })
+}
+
+export function useRevokeToken() {
+  const transport = useTransport()
+  const client = useMemo(() => createClient(TokensService, transport), [transport])
+  const queryClient = useQueryClient()
+  return useMutation({
+    mutationFn: (params: { id: string }) => client.revokeToken(params),
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: keys.tokens.list() })
+    },
+  })
+}
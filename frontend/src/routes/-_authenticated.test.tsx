@@ -20,6 +20,8 @@ vi.mock('@/components/ui/sidebar', () => ({
 
 vi.mock('@/components/app-sidebar', () => ({ AppSidebar: () => null }))
 
+vi.mock('@/components/maintenance-banner', () => ({ MaintenanceBanner: () => null }))
+
 vi.mock('@/lib/org-context', () => ({
   OrgProvider: ({ children }: { children: React.ReactNode }) => <>{children}</>,
   useOrg: () => ({ organizations: [], selectedOrg: null, setSelectedOrg: vi.fn(), isLoading: false }),
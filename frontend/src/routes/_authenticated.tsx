@@ -3,6 +3,7 @@ import { useAuth } from '@/lib/auth'
 import { useEffect } from 'react'
 import { SidebarInset, SidebarProvider, SidebarTrigger } from '@/components/ui/sidebar'
 import { AppSidebar } from '@/components/app-sidebar'
+import { MaintenanceBanner } from '@/components/maintenance-banner'
 import { OrgProvider } from '@/lib/org-context'
 import { ProjectProvider } from '@/lib/project-context'
 import { Separator } from '@/components/ui/separator'
@@ -39,6 +40,7 @@ export function AuthenticatedLayout() {
             <Separator orientation="vertical" className="h-4" />
             <span className="font-semibold">Holos Console</span>
           </header>
+          <MaintenanceBanner />
           <main className="flex-1 p-4 md:p-6">
             <Outlet />
           </main>
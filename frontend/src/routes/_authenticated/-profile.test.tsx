@@ -16,9 +16,16 @@ vi.mock('@tanstack/react-router', async (importOriginal) => {
 
 vi.mock('@/lib/auth', () => ({ useAuth: vi.fn() }))
 
-vi.mock('sonner', () => ({ toast: { success: vi.fn() } }))
+vi.mock('sonner', () => ({ toast: { success: vi.fn(), error: vi.fn() } }))
+
+vi.mock('@/queries/tokens', () => ({
+  useListTokens: vi.fn(),
+  useCreateToken: vi.fn(),
+  useRevokeToken: vi.fn(),
+}))
 
 import { useAuth } from '@/lib/auth'
+import { useCreateToken, useListTokens, useRevokeToken } from '@/queries/tokens'
 import { ProfilePage } from './profile'
 
 function makeUser(
@@ -48,7 +55,14 @@ function makeUser(
   }
 }
 
+function setTokensState(tokens: unknown[] = [], createToken = vi.fn()) {
+  ;(useListTokens as Mock).mockReturnValue({ data: tokens, isPending: false, error: null })
+  ;(useCreateToken as Mock).mockReturnValue({ mutateAsync: createToken, isPending: false })
+  ;(useRevokeToken as Mock).mockReturnValue({ mutateAsync: vi.fn(), isPending: false })
+}
+
 function setAuthState(overrides: Record<string, unknown> = {}) {
+  setTokensState()
   ;(useAuth as Mock).mockReturnValue({
     isAuthenticated: true,
     isLoading: false,
@@ -321,3 +335,33 @@ describe('ProfilePage persona email rendering', () => {
     expect(screen.queryByText('admin@localhost')).not.toBeInTheDocument()
   })
 })
+
+describe('ProfilePage API Tokens section', () => {
+  beforeEach(() => {
+    vi.clearAllMocks()
+    setAuthState()
+  })
+
+  it('lists the caller tokens with their scope', () => {
+    setTokensState([
+      { id: 'a1', name: 'ci-pipeline', permissions: [], createdAt: { seconds: 1700000000n }, expiresAt: { seconds: 1702592000n } },
+      { id: 'b2', name: 'read-only', permissions: [1], createdAt: { seconds: 1700000000n }, expiresAt: { seconds: 1702592000n } },
+    ])
+    render(<ProfilePage />)
+    expect(screen.getByText('API Tokens')).toBeInTheDocument()
+    expect(screen.getByText('ci-pipeline')).toBeInTheDocument()
+    expect(screen.getByText('All permissions')).toBeInTheDocument()
+    expect(screen.getByText('SECRETS_READ')).toBeInTheDocument()
+  })
+
+  it('shows a newly created token once', async () => {
+    const createToken = vi.fn().mockResolvedValue({ token: 'hct_0123456789abcdef_secret' })
+    setTokensState([], createToken)
+    const user = userEvent.setup()
+    render(<ProfilePage />)
+    await user.type(screen.getByLabelText('Name'), 'ci-pipeline')
+    await user.click(screen.getByRole('button', { name: 'Create Token' }))
+    expect(createToken).toHaveBeenCalledWith({ name: 'ci-pipeline', ttlSeconds: BigInt(7 * 24 * 60 * 60) })
+    expect(await screen.findByText('hct_0123456789abcdef_secret')).toBeInTheDocument()
+  })
+})
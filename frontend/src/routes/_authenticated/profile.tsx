@@ -9,6 +9,7 @@ import { Tabs, TabsList, TabsTrigger, TabsContent } from '@/components/ui/tabs'
 import { Braces, Copy, Eye, EyeOff, List, TriangleAlert } from 'lucide-react'
 import { toast } from 'sonner'
 import { ViewModeToggle } from '@/components/view-mode-toggle'
+import { ApiTokensCard } from '@/components/api-tokens-card'
 import { useAuth } from '@/lib/auth'
 
 export const Route = createFileRoute('/_authenticated/profile')({
@@ -263,6 +264,8 @@ export function ProfilePage() {
       {user?.id_token && (
         <ApiAccessCard idToken={user.id_token} tokenRevealed={tokenRevealed} onToggleReveal={() => setTokenRevealed((v) => !v)} />
       )}
+
+      <ApiTokensCard />
     </div>
   )
 }
@@ -1,5 +1,6 @@
 import { useState, useEffect } from 'react'
 import { createFileRoute, useNavigate } from '@tanstack/react-router'
+import { toast } from 'sonner'
 import { Card, CardContent } from '@/components/ui/card'
 import { Button } from '@/components/ui/button'
 import { Input } from '@/components/ui/input'
@@ -12,32 +13,41 @@ import {
   DialogHeader,
   DialogTitle,
 } from '@/components/ui/dialog'
-import { Braces, Check, Pencil, X, ExternalLink, Table2 } from 'lucide-react'
+import { Braces, Check, Copy, Pencil, X, ExternalLink, Table2 } from 'lucide-react'
 import { ViewModeToggle } from '@/components/view-mode-toggle'
 import { useAuth } from '@/lib/auth'
 import { SecretDataGrid } from '@/components/secret-data-grid'
 import { RawView } from '@/components/raw-view'
+import { TLSCertificateSummary } from '@/components/tls-certificate-summary'
 import { SharingPanel, type Grant } from '@/components/sharing-panel'
 import { isSafeUrl } from '@/lib/utils'
-import { useGetSecret, useGetSecretMetadata, useGetSecretRaw, useUpdateSecret, useUpdateSecretSharing, useDeleteSecret } from '@/queries/secrets'
+import { downloadSecretKey, useExportSecretSealed, useGetSecret, useGetSecretMetadata, useGetSecretRaw, useUpdateSecret, useUpdateSecretSharing, useDeleteSecret } from '@/queries/secrets'
 import type { ShareGrant } from '@/gen/holos/console/v1/secrets_pb.js'
 import { isOwner as computeIsOwner } from '@/lib/isOwner'
 import { connectErrorMessage } from '@/lib/connect-toast'
+import { bytesFingerprint } from '@/lib/secret-bytes'
+import { saveBlob } from '@/lib/save-blob'
 
 export const Route = createFileRoute('/_authenticated/projects/$projectName/secrets/$name')({
   component: SecretPage,
 })
 
+// serializeData fingerprints the bytes of every key so binary values that
+// decode to the same replacement characters still compare as different.
 function serializeData(data: Record<string, Uint8Array>): string {
   const sorted = Object.keys(data).sort()
   const obj: Record<string, string> = {}
-  const decoder = new TextDecoder()
   for (const key of sorted) {
-    obj[key] = decoder.decode(data[key])
+    obj[key] = bytesFingerprint(data[key])
   }
   return JSON.stringify(obj)
 }
 
+// formatKiB renders a byte count in KiB with one decimal place.
+function formatKiB(bytes: bigint): string {
+  return (Number(bytes) / 1024).toFixed(1)
+}
+
 export function SecretPage() {
   const { projectName, name } = Route.useParams()
   const navigate = useNavigate()
@@ -49,8 +59,10 @@ export function SecretPage() {
   const updateMutation = useUpdateSecret(projectName)
   const updateSharingMutation = useUpdateSecretSharing(projectName)
   const deleteMutation = useDeleteSecret(projectName)
+  const exportSealedMutation = useExportSecretSealed(projectName)
 
   const [secretData, setSecretData] = useState<Record<string, Uint8Array> | null>(null)
+  const [contentTypes, setContentTypes] = useState<Record<string, string> | null>(null)
   const [description, setDescription] = useState<string | null>(null)
   const [url, setUrl] = useState<string | null>(null)
   const [originalDataSerialized, setOriginalDataSerialized] = useState<string | null>(null)
@@ -103,6 +115,14 @@ export function SecretPage() {
   }, [metadata, originalDescription, description, originalUrl, url])
 
   const effectiveData = secretData ?? fetchedData ?? {}
+  // The grid reports the types it knows about; recorded types of keys it
+  // never loaded are kept so a save does not drop them. The server only
+  // accepts types for keys that remain in the data.
+  const effectiveContentTypes = Object.fromEntries(
+    Object.entries({ ...(metadata?.contentTypes ?? {}), ...(contentTypes ?? {}) }).filter(
+      ([key]) => key in effectiveData,
+    ),
+  )
   const effectiveDescription = description ?? metadata?.description ?? ''
   const effectiveUrl = url ?? metadata?.url ?? ''
   const effectiveUserGrants = localUserGrants ?? metadata?.userGrants ?? []
@@ -120,11 +140,12 @@ export function SecretPage() {
     : []
   const isOwner = computeIsOwner(userEmail, userGroups, effectiveUserGrants, effectiveRoleGrants)
 
-  const handleSaveSharing = async (newUserGrants: Grant[], newRoleGrants: Grant[]) => {
+  const handleSaveSharing = async (newUserGrants: Grant[], newRoleGrants: Grant[], inviteUsers?: boolean) => {
     const response = await updateSharingMutation.mutateAsync({
       name,
       userGrants: newUserGrants,
       roleGrants: newRoleGrants,
+      inviteUsers,
     })
     if (response.metadata) {
       setLocalUserGrants(response.metadata.userGrants)
@@ -149,6 +170,7 @@ export function SecretPage() {
         data: effectiveData,
         description: effectiveDescription,
         url: effectiveUrl,
+        contentTypes: effectiveContentTypes,
       })
       setOriginalDataSerialized(serializeData(effectiveData))
       setOriginalDescription(effectiveDescription)
@@ -166,10 +188,28 @@ export function SecretPage() {
     setSaveError(null)
     // Revert data changes
     if (fetchedData) setSecretData(fetchedData)
+    setContentTypes(null)
     if (originalDescription !== null) setDescription(originalDescription)
     if (originalUrl !== null) setUrl(originalUrl)
   }
 
+  const handleDownload = async (key: string) => {
+    try {
+      await downloadSecretKey(projectName, name, key)
+    } catch (err) {
+      toast.error(connectErrorMessage(err))
+    }
+  }
+
+  const handleExportSealed = async () => {
+    try {
+      const response = await exportSealedMutation.mutateAsync(name)
+      saveBlob(new Blob([response.manifest], { type: 'application/yaml' }), `${name}-sealedsecret.yaml`)
+    } catch (err) {
+      toast.error(connectErrorMessage(err))
+    }
+  }
+
   const handleDelete = async () => {
     try {
       await deleteMutation.mutateAsync(name)
@@ -295,6 +335,44 @@ export function SecretPage() {
           )}
         </div>
 
+        {metadata?.tlsCertificate && <TLSCertificateSummary certificate={metadata.tlsCertificate} />}
+
+        {metadata?.sshPublicKey && (
+          <div className="rounded-md border p-3 space-y-1 text-sm">
+            <div className="flex items-center gap-2">
+              <span className="font-medium flex-1">SSH public key</span>
+              <Button
+                variant="ghost"
+                size="icon"
+                aria-label="copy public key"
+                onClick={() => {
+                  navigator.clipboard.writeText(metadata.sshPublicKey)
+                  toast.success('Copied to clipboard')
+                }}
+              >
+                <Copy className="h-4 w-4" />
+              </Button>
+            </div>
+            <p className="font-mono text-xs break-all">{metadata.sshPublicKey}</p>
+            <p className="text-xs text-muted-foreground">{metadata.sshFingerprint}</p>
+          </div>
+        )}
+
+        {metadata?.vaultPath && (
+          <Alert>
+            <AlertDescription>
+              Values are read from Vault at <span className="font-mono">{metadata.vaultPath}</span>. Change them in Vault.
+            </AlertDescription>
+          </Alert>
+        )}
+
+        {metadata && metadata.sizeLimitBytes > 0n && (
+          <p className="text-xs text-muted-foreground">
+            {metadata.keyCount} {metadata.keyCount === 1 ? 'key' : 'keys'}, {formatKiB(metadata.sizeBytes)} of{' '}
+            {formatKiB(metadata.sizeLimitBytes)} KiB
+          </p>
+        )}
+
         <div className="flex items-center gap-2">
           <ViewModeToggle
             value={viewMode}
@@ -305,7 +383,7 @@ export function SecretPage() {
             ]}
           />
           <div className="flex-1" />
-          {viewMode === 'editor' && !editMode && (
+          {viewMode === 'editor' && !editMode && !metadata?.vaultPath && (
             <Button variant="outline" size="sm" onClick={() => setEditMode(true)}>
               <Pencil className="h-4 w-4 mr-1" />
               Edit
@@ -321,6 +399,9 @@ export function SecretPage() {
               </Button>
             </>
           )}
+          <Button variant="outline" size="sm" onClick={handleExportSealed} disabled={exportSealedMutation.isPending}>
+            Export sealed
+          </Button>
           <Button variant="destructive" size="sm" onClick={() => setDeleteOpen(true)}>Delete</Button>
         </div>
 
@@ -329,7 +410,16 @@ export function SecretPage() {
             {saveError && (
               <Alert variant="destructive"><AlertDescription>{saveError}</AlertDescription></Alert>
             )}
-            <SecretDataGrid data={effectiveData} onChange={(newData) => setSecretData(newData)} readOnly={!editMode} />
+            <SecretDataGrid
+              data={effectiveData}
+              contentTypes={metadata?.contentTypes}
+              onChange={(newData, newContentTypes) => {
+                setSecretData(newData)
+                setContentTypes(newContentTypes)
+              }}
+              readOnly={!editMode}
+              onDownload={editMode ? undefined : handleDownload}
+            />
           </>
         )}
 
@@ -343,6 +433,8 @@ export function SecretPage() {
           isOwner={isOwner}
           onSave={handleSaveSharing}
           isSaving={updateSharingMutation.isPending}
+          allowDeny
+          allowInvite
         />
       </CardContent>
 
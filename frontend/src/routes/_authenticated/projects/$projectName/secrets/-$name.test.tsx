@@ -21,11 +21,13 @@ vi.mock('@/queries/secrets', () => ({
   useUpdateSecret: vi.fn(),
   useUpdateSecretSharing: vi.fn(),
   useDeleteSecret: vi.fn(),
+  downloadSecretKey: vi.fn(),
+  useExportSecretSealed: vi.fn(),
 }))
 
 vi.mock('@/lib/auth', () => ({ useAuth: vi.fn() }))
 
-import { useGetSecret, useGetSecretMetadata, useGetSecretRaw, useUpdateSecret, useUpdateSecretSharing, useDeleteSecret } from '@/queries/secrets'
+import { useGetSecret, useGetSecretMetadata, useGetSecretRaw, useUpdateSecret, useUpdateSecretSharing, useDeleteSecret, useExportSecretSealed } from '@/queries/secrets'
 import { useAuth } from '@/lib/auth'
 import { SecretPage } from './$name'
 
@@ -36,7 +38,7 @@ const mockMetadata = {
   roleGrants: [],
 }
 
-function setupMocks(overrides: { metadata?: typeof mockMetadata; isOwner?: boolean } = {}) {
+function setupMocks(overrides: { metadata?: typeof mockMetadata & { vaultPath?: string }; isOwner?: boolean } = {}) {
   const metadata = overrides.metadata ?? mockMetadata
 
   ;(useGetSecret as Mock).mockReturnValue({
@@ -65,6 +67,7 @@ function setupMocks(overrides: { metadata?: typeof mockMetadata; isOwner?: boole
     isPending: false,
     error: null,
   })
+  ;(useExportSecretSealed as Mock).mockReturnValue({ mutateAsync: vi.fn(), isPending: false })
   ;(useAuth as Mock).mockReturnValue({
     isAuthenticated: true,
     isLoading: false,
@@ -134,4 +137,12 @@ describe('SecretPage sharing panel', () => {
       false, // enabled must be false on initial mount
     )
   })
+
+  it('notes Vault-backed values and hides the data Edit button', () => {
+    setupMocks({ metadata: { ...mockMetadata, vaultPath: 'secret/data/team/db' } })
+    render(<SecretPage />)
+    expect(screen.getByText('secret/data/team/db')).toBeInTheDocument()
+    // Only the sharing panel Edit button remains.
+    expect(screen.getAllByRole('button', { name: /^edit$/i })).toHaveLength(1)
+  })
 })
@@ -14,7 +14,8 @@ import { Input } from '@/components/ui/input'
 import { Label } from '@/components/ui/label'
 import { Badge } from '@/components/ui/badge'
 import { Alert, AlertDescription } from '@/components/ui/alert'
-import { Trash2 } from 'lucide-react'
+import { Container, Table2, Trash2 } from 'lucide-react'
+import { ViewModeToggle } from '@/components/view-mode-toggle'
 import { SecretDataGrid } from '@/components/secret-data-grid'
 import { useAuth } from '@/lib/auth'
 import { useCreateSecret } from '@/queries/secrets'
@@ -47,6 +48,9 @@ export function SecretCreatePage({ projectName }: { projectName: string }) {
   const [description, setDescription] = useState('')
   const [url, setUrl] = useState('')
   const [data, setData] = useState<Record<string, Uint8Array>>({})
+  const [contentTypes, setContentTypes] = useState<Record<string, string>>({})
+  const [kind, setKind] = useState<'data' | 'registry'>('data')
+  const [registry, setRegistry] = useState({ server: '', username: '', password: '', email: '' })
   const [error, setError] = useState<string | null>(null)
   const [grantsInitialized, setGrantsInitialized] = useState(false)
   const [userGrants, setUserGrants] = useState<CreateGrant[]>([])
@@ -87,11 +91,25 @@ export function SecretCreatePage({ projectName }: { projectName: string }) {
       setError('Secret name is required')
       return
     }
+    if (kind === 'registry' && (!registry.server.trim() || !registry.username.trim() || !registry.password)) {
+      setError('Registry server, username and password are required')
+      return
+    }
     setError(null)
     try {
       await createMutation.mutateAsync({
         name: name.trim(),
-        data,
+        ...(kind === 'registry'
+          ? {
+              data: {},
+              dockerRegistry: {
+                server: registry.server.trim(),
+                username: registry.username.trim(),
+                password: registry.password,
+                email: registry.email.trim(),
+              },
+            }
+          : { data, contentTypes }),
         userGrants: userGrants.filter((g) => g.principal.trim() !== ''),
         roleGrants: roleGrants.filter((g) => g.principal.trim() !== ''),
         description: description.trim() || undefined,
@@ -142,8 +160,58 @@ export function SecretCreatePage({ projectName }: { projectName: string }) {
             />
           </div>
           <div>
-            <Label>Data</Label>
-            <SecretDataGrid data={data} onChange={setData} />
+            <div className="flex items-center gap-2 mb-2">
+              <Label>{kind === 'registry' ? 'Registry credentials' : 'Data'}</Label>
+              <div className="flex-1" />
+              <ViewModeToggle
+                value={kind}
+                onValueChange={(v) => setKind(v as 'data' | 'registry')}
+                options={[
+                  { value: 'data', label: 'Key/value', icon: <Table2 className="h-3.5 w-3.5" /> },
+                  { value: 'registry', label: 'Image pull', icon: <Container className="h-3.5 w-3.5" /> },
+                ]}
+              />
+            </div>
+            {kind === 'registry' ? (
+              <div className="space-y-2">
+                <Input
+                  aria-label="Registry server"
+                  value={registry.server}
+                  onChange={(e) => setRegistry({ ...registry, server: e.target.value })}
+                  placeholder="ghcr.io"
+                />
+                <Input
+                  aria-label="Registry username"
+                  value={registry.username}
+                  onChange={(e) => setRegistry({ ...registry, username: e.target.value })}
+                  placeholder="username"
+                />
+                <Input
+                  aria-label="Registry password"
+                  type="password"
+                  value={registry.password}
+                  onChange={(e) => setRegistry({ ...registry, password: e.target.value })}
+                  placeholder="password or access token"
+                />
+                <Input
+                  aria-label="Registry email"
+                  value={registry.email}
+                  onChange={(e) => setRegistry({ ...registry, email: e.target.value })}
+                  placeholder="email (optional)"
+                />
+                <p className="text-xs text-muted-foreground">
+                  Creates a kubernetes.io/dockerconfigjson secret for use as an imagePullSecret.
+                </p>
+              </div>
+            ) : (
+              <SecretDataGrid
+                data={data}
+                onChange={(newData, newContentTypes) => {
+                  setData(newData)
+                  setContentTypes(newContentTypes)
+                }}
+              />
+            )}
           </div>
           <div>
             <Label>Sharing</Label>
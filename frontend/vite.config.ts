@@ -5,6 +5,7 @@ import tailwindcss from '@tailwindcss/vite'
 import { TanStackRouterVite } from '@tanstack/router-plugin/vite'
 import path from 'path'
 import fs from 'fs'
+import zlib from 'zlib'
 
 const backendPort = process.env.HOLOS_BACKEND_PORT || '8443'
 const vitePort = process.env.HOLOS_VITE_PORT || '5173'
@@ -27,6 +28,32 @@ const injectOIDCConfig = (): Plugin => ({
   },
 })
 
+// Write .br and .gz variants next to each compressible build output so the
+// Go server can serve them from the embedded filesystem without compressing
+// per request. index.html is skipped because the server rewrites it on every
+// request to inject config.
+const precompressAssets = (): Plugin => ({
+  name: 'precompress-assets',
+  apply: 'build',
+  writeBundle(options, bundle) {
+    const outDir = options.dir ?? path.resolve(__dirname, '../console/dist')
+    for (const fileName of Object.keys(bundle)) {
+      if (!/\.(js|mjs|css|svg|json|map|txt|wasm)$/.test(fileName)) continue
+      const file = path.join(outDir, fileName)
+      const data = fs.readFileSync(file)
+      // Small files gain little and cost a round of decompression.
+      if (data.length < 1024) continue
+      fs.writeFileSync(
+        `${file}.br`,
+        zlib.brotliCompressSync(data, {
+          params: { [zlib.constants.BROTLI_PARAM_QUALITY]: zlib.constants.BROTLI_MAX_QUALITY },
+        }),
+      )
+      fs.writeFileSync(`${file}.gz`, zlib.gzipSync(data, { level: zlib.constants.Z_BEST_COMPRESSION }))
+    }
+  },
+})
+
 // https://vite.dev/config/
 export default defineConfig({
   plugins: [
@@ -34,6 +61,7 @@ export default defineConfig({
     TanStackRouterVite({ autoCodeSplitting: true }),
     injectOIDCConfig(),
     react(),
+    precompressAssets(),
   ],
   resolve: {
     alias: {
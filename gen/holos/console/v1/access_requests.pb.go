@@ -0,0 +1,867 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: holos/console/v1/access_requests.proto
+
+package consolev1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// AccessRequestState is the lifecycle state of an access request.
+type AccessRequestState int32
+
+const (
+	// ACCESS_REQUEST_STATE_UNSPECIFIED is the zero value and is never stored.
+	AccessRequestState_ACCESS_REQUEST_STATE_UNSPECIFIED AccessRequestState = 0
+	// ACCESS_REQUEST_STATE_PENDING awaits an owner decision.
+	AccessRequestState_ACCESS_REQUEST_STATE_PENDING AccessRequestState = 1
+	// ACCESS_REQUEST_STATE_APPROVED installed a time-limited grant.
+	AccessRequestState_ACCESS_REQUEST_STATE_APPROVED AccessRequestState = 2
+	// ACCESS_REQUEST_STATE_DENIED was rejected by an owner.
+	AccessRequestState_ACCESS_REQUEST_STATE_DENIED AccessRequestState = 3
+)
+
+// Enum value maps for AccessRequestState.
+var (
+	AccessRequestState_name = map[int32]string{
+		0: "ACCESS_REQUEST_STATE_UNSPECIFIED",
+		1: "ACCESS_REQUEST_STATE_PENDING",
+		2: "ACCESS_REQUEST_STATE_APPROVED",
+		3: "ACCESS_REQUEST_STATE_DENIED",
+	}
+	AccessRequestState_value = map[string]int32{
+		"ACCESS_REQUEST_STATE_UNSPECIFIED": 0,
+		"ACCESS_REQUEST_STATE_PENDING":     1,
+		"ACCESS_REQUEST_STATE_APPROVED":    2,
+		"ACCESS_REQUEST_STATE_DENIED":      3,
+	}
+)
+
+func (x AccessRequestState) Enum() *AccessRequestState {
+	p := new(AccessRequestState)
+	*p = x
+	return p
+}
+
+func (x AccessRequestState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AccessRequestState) Descriptor() protoreflect.EnumDescriptor {
+	return file_holos_console_v1_access_requests_proto_enumTypes[0].Descriptor()
+}
+
+func (AccessRequestState) Type() protoreflect.EnumType {
+	return &file_holos_console_v1_access_requests_proto_enumTypes[0]
+}
+
+func (x AccessRequestState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AccessRequestState.Descriptor instead.
+func (AccessRequestState) EnumDescriptor() ([]byte, []int) {
+	return file_holos_console_v1_access_requests_proto_rawDescGZIP(), []int{0}
+}
+
+// AccessRequest is a request for temporary access to a project or secret.
+type AccessRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name is the server-assigned identifier of the request.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// project is the project the requested resource belongs to.
+	Project string `protobuf:"bytes,2,opt,name=project,proto3" json:"project,omitempty"`
+	// resource_type is "project" or "secret".
+	ResourceType string `protobuf:"bytes,3,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	// resource_name is the project or secret name the request targets.
+	ResourceName string `protobuf:"bytes,4,opt,name=resource_name,json=resourceName,proto3" json:"resource_name,omitempty"`
+	// role is the requested role. Secret requests support ROLE_VIEWER only;
+	// approving one installs a key-scoped grant covering every key the
+	// secret holds at that time.
+	Role Role `protobuf:"varint,5,opt,name=role,proto3,enum=holos.console.v1.Role" json:"role,omitempty"`
+	// justification explains why access is needed.
+	Justification string `protobuf:"bytes,6,opt,name=justification,proto3" json:"justification,omitempty"`
+	// duration_seconds is how long the grant lasts once approved.
+	DurationSeconds int64 `protobuf:"varint,7,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	// requester_email is the email address of the user who filed the request.
+	RequesterEmail string `protobuf:"bytes,8,opt,name=requester_email,json=requesterEmail,proto3" json:"requester_email,omitempty"`
+	// requester_sub is the OIDC subject of the user who filed the request.
+	RequesterSub string `protobuf:"bytes,9,opt,name=requester_sub,json=requesterSub,proto3" json:"requester_sub,omitempty"`
+	// state is the lifecycle state of the request.
+	State AccessRequestState `protobuf:"varint,10,opt,name=state,proto3,enum=holos.console.v1.AccessRequestState" json:"state,omitempty"`
+	// created_at is when the request was filed.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// decided_by is the email address of the owner who approved or denied
+	// the request.
+	DecidedBy string `protobuf:"bytes,12,opt,name=decided_by,json=decidedBy,proto3" json:"decided_by,omitempty"`
+	// decided_at is when the request was approved or denied.
+	DecidedAt *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=decided_at,json=decidedAt,proto3" json:"decided_at,omitempty"`
+	// reason is the owner's optional note on the decision.
+	Reason string `protobuf:"bytes,14,opt,name=reason,proto3" json:"reason,omitempty"`
+	// nbf is the Unix timestamp the approved grant becomes active.
+	Nbf *int64 `protobuf:"varint,15,opt,name=nbf,proto3,oneof" json:"nbf,omitempty"`
+	// exp is the Unix timestamp the approved grant expires.
+	Exp           *int64 `protobuf:"varint,16,opt,name=exp,proto3,oneof" json:"exp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccessRequest) Reset() {
+	*x = AccessRequest{}
+	mi := &file_holos_console_v1_access_requests_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessRequest) ProtoMessage() {}
+
+func (x *AccessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_access_requests_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessRequest.ProtoReflect.Descriptor instead.
+func (*AccessRequest) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_access_requests_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AccessRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AccessRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *AccessRequest) GetResourceType() string {
+	if x != nil {
+		return x.ResourceType
+	}
+	return ""
+}
+
+func (x *AccessRequest) GetResourceName() string {
+	if x != nil {
+		return x.ResourceName
+	}
+	return ""
+}
+
+func (x *AccessRequest) GetRole() Role {
+	if x != nil {
+		return x.Role
+	}
+	return Role_ROLE_UNSPECIFIED
+}
+
+func (x *AccessRequest) GetJustification() string {
+	if x != nil {
+		return x.Justification
+	}
+	return ""
+}
+
+func (x *AccessRequest) GetDurationSeconds() int64 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+func (x *AccessRequest) GetRequesterEmail() string {
+	if x != nil {
+		return x.RequesterEmail
+	}
+	return ""
+}
+
+func (x *AccessRequest) GetRequesterSub() string {
+	if x != nil {
+		return x.RequesterSub
+	}
+	return ""
+}
+
+func (x *AccessRequest) GetState() AccessRequestState {
+	if x != nil {
+		return x.State
+	}
+	return AccessRequestState_ACCESS_REQUEST_STATE_UNSPECIFIED
+}
+
+func (x *AccessRequest) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *AccessRequest) GetDecidedBy() string {
+	if x != nil {
+		return x.DecidedBy
+	}
+	return ""
+}
+
+func (x *AccessRequest) GetDecidedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DecidedAt
+	}
+	return nil
+}
+
+func (x *AccessRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *AccessRequest) GetNbf() int64 {
+	if x != nil && x.Nbf != nil {
+		return *x.Nbf
+	}
+	return 0
+}
+
+func (x *AccessRequest) GetExp() int64 {
+	if x != nil && x.Exp != nil {
+		return *x.Exp
+	}
+	return 0
+}
+
+// CreateAccessRequestRequest files a request for temporary access.
+type CreateAccessRequestRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// project is the project the requested resource belongs to.
+	Project string `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	// resource_type is "project" or "secret".
+	ResourceType string `protobuf:"bytes,2,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	// resource_name is the secret name for secret requests. Project requests
+	// may leave it empty; it defaults to project.
+	ResourceName string `protobuf:"bytes,3,opt,name=resource_name,json=resourceName,proto3" json:"resource_name,omitempty"`
+	// role is the requested role.
+	Role Role `protobuf:"varint,4,opt,name=role,proto3,enum=holos.console.v1.Role" json:"role,omitempty"`
+	// justification explains why access is needed. Required.
+	Justification string `protobuf:"bytes,5,opt,name=justification,proto3" json:"justification,omitempty"`
+	// duration_seconds is how long the grant should last. Zero selects the
+	// server default of one hour; the server caps it at 24 hours.
+	DurationSeconds int64 `protobuf:"varint,6,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateAccessRequestRequest) Reset() {
+	*x = CreateAccessRequestRequest{}
+	mi := &file_holos_console_v1_access_requests_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAccessRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAccessRequestRequest) ProtoMessage() {}
+
+func (x *CreateAccessRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_access_requests_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAccessRequestRequest.ProtoReflect.Descriptor instead.
+func (*CreateAccessRequestRequest) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_access_requests_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateAccessRequestRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *CreateAccessRequestRequest) GetResourceType() string {
+	if x != nil {
+		return x.ResourceType
+	}
+	return ""
+}
+
+func (x *CreateAccessRequestRequest) GetResourceName() string {
+	if x != nil {
+		return x.ResourceName
+	}
+	return ""
+}
+
+func (x *CreateAccessRequestRequest) GetRole() Role {
+	if x != nil {
+		return x.Role
+	}
+	return Role_ROLE_UNSPECIFIED
+}
+
+func (x *CreateAccessRequestRequest) GetJustification() string {
+	if x != nil {
+		return x.Justification
+	}
+	return ""
+}
+
+func (x *CreateAccessRequestRequest) GetDurationSeconds() int64 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+// CreateAccessRequestResponse contains the filed request.
+type CreateAccessRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccessRequest *AccessRequest         `protobuf:"bytes,1,opt,name=access_request,json=accessRequest,proto3" json:"access_request,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAccessRequestResponse) Reset() {
+	*x = CreateAccessRequestResponse{}
+	mi := &file_holos_console_v1_access_requests_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAccessRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAccessRequestResponse) ProtoMessage() {}
+
+func (x *CreateAccessRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_access_requests_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAccessRequestResponse.ProtoReflect.Descriptor instead.
+func (*CreateAccessRequestResponse) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_access_requests_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateAccessRequestResponse) GetAccessRequest() *AccessRequest {
+	if x != nil {
+		return x.AccessRequest
+	}
+	return nil
+}
+
+// ListAccessRequestsRequest selects the requests to list.
+type ListAccessRequestsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// project is the project whose requests to list.
+	Project string `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	// state restricts results to requests in this state. Unspecified matches
+	// every state.
+	State         AccessRequestState `protobuf:"varint,2,opt,name=state,proto3,enum=holos.console.v1.AccessRequestState" json:"state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAccessRequestsRequest) Reset() {
+	*x = ListAccessRequestsRequest{}
+	mi := &file_holos_console_v1_access_requests_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccessRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccessRequestsRequest) ProtoMessage() {}
+
+func (x *ListAccessRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_access_requests_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccessRequestsRequest.ProtoReflect.Descriptor instead.
+func (*ListAccessRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_access_requests_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListAccessRequestsRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *ListAccessRequestsRequest) GetState() AccessRequestState {
+	if x != nil {
+		return x.State
+	}
+	return AccessRequestState_ACCESS_REQUEST_STATE_UNSPECIFIED
+}
+
+// ListAccessRequestsResponse contains the matching requests, newest first.
+type ListAccessRequestsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	AccessRequests []*AccessRequest       `protobuf:"bytes,1,rep,name=access_requests,json=accessRequests,proto3" json:"access_requests,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListAccessRequestsResponse) Reset() {
+	*x = ListAccessRequestsResponse{}
+	mi := &file_holos_console_v1_access_requests_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccessRequestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccessRequestsResponse) ProtoMessage() {}
+
+func (x *ListAccessRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_access_requests_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccessRequestsResponse.ProtoReflect.Descriptor instead.
+func (*ListAccessRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_access_requests_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListAccessRequestsResponse) GetAccessRequests() []*AccessRequest {
+	if x != nil {
+		return x.AccessRequests
+	}
+	return nil
+}
+
+// ApproveAccessRequestRequest approves a pending request.
+type ApproveAccessRequestRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// project is the project the request was filed in.
+	Project string `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	// name identifies the request.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// reason is an optional note recorded with the decision.
+	Reason        string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveAccessRequestRequest) Reset() {
+	*x = ApproveAccessRequestRequest{}
+	mi := &file_holos_console_v1_access_requests_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveAccessRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveAccessRequestRequest) ProtoMessage() {}
+
+func (x *ApproveAccessRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_access_requests_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveAccessRequestRequest.ProtoReflect.Descriptor instead.
+func (*ApproveAccessRequestRequest) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_access_requests_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ApproveAccessRequestRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *ApproveAccessRequestRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ApproveAccessRequestRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// ApproveAccessRequestResponse contains the approved request.
+type ApproveAccessRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccessRequest *AccessRequest         `protobuf:"bytes,1,opt,name=access_request,json=accessRequest,proto3" json:"access_request,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveAccessRequestResponse) Reset() {
+	*x = ApproveAccessRequestResponse{}
+	mi := &file_holos_console_v1_access_requests_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveAccessRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveAccessRequestResponse) ProtoMessage() {}
+
+func (x *ApproveAccessRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_access_requests_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveAccessRequestResponse.ProtoReflect.Descriptor instead.
+func (*ApproveAccessRequestResponse) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_access_requests_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ApproveAccessRequestResponse) GetAccessRequest() *AccessRequest {
+	if x != nil {
+		return x.AccessRequest
+	}
+	return nil
+}
+
+// DenyAccessRequestRequest denies a pending request.
+type DenyAccessRequestRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// project is the project the request was filed in.
+	Project string `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	// name identifies the request.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// reason is an optional note recorded with the decision.
+	Reason        string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DenyAccessRequestRequest) Reset() {
+	*x = DenyAccessRequestRequest{}
+	mi := &file_holos_console_v1_access_requests_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DenyAccessRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DenyAccessRequestRequest) ProtoMessage() {}
+
+func (x *DenyAccessRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_access_requests_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DenyAccessRequestRequest.ProtoReflect.Descriptor instead.
+func (*DenyAccessRequestRequest) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_access_requests_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DenyAccessRequestRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *DenyAccessRequestRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DenyAccessRequestRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// DenyAccessRequestResponse contains the denied request.
+type DenyAccessRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccessRequest *AccessRequest         `protobuf:"bytes,1,opt,name=access_request,json=accessRequest,proto3" json:"access_request,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DenyAccessRequestResponse) Reset() {
+	*x = DenyAccessRequestResponse{}
+	mi := &file_holos_console_v1_access_requests_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DenyAccessRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DenyAccessRequestResponse) ProtoMessage() {}
+
+func (x *DenyAccessRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_access_requests_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DenyAccessRequestResponse.ProtoReflect.Descriptor instead.
+func (*DenyAccessRequestResponse) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_access_requests_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DenyAccessRequestResponse) GetAccessRequest() *AccessRequest {
+	if x != nil {
+		return x.AccessRequest
+	}
+	return nil
+}
+
+var File_holos_console_v1_access_requests_proto protoreflect.FileDescriptor
+
+const file_holos_console_v1_access_requests_proto_rawDesc = "" +
+	"\n" +
+	"&holos/console/v1/access_requests.proto\x12\x10holos.console.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bholos/console/v1/rbac.proto\"\xf9\x04\n" +
+	"\rAccessRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\aproject\x18\x02 \x01(\tR\aproject\x12#\n" +
+	"\rresource_type\x18\x03 \x01(\tR\fresourceType\x12#\n" +
+	"\rresource_name\x18\x04 \x01(\tR\fresourceName\x12*\n" +
+	"\x04role\x18\x05 \x01(\x0e2\x16.holos.console.v1.RoleR\x04role\x12$\n" +
+	"\rjustification\x18\x06 \x01(\tR\rjustification\x12)\n" +
+	"\x10duration_seconds\x18\a \x01(\x03R\x0fdurationSeconds\x12'\n" +
+	"\x0frequester_email\x18\b \x01(\tR\x0erequesterEmail\x12#\n" +
+	"\rrequester_sub\x18\t \x01(\tR\frequesterSub\x12:\n" +
+	"\x05state\x18\n" +
+	" \x01(\x0e2$.holos.console.v1.AccessRequestStateR\x05state\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"decided_by\x18\f \x01(\tR\tdecidedBy\x129\n" +
+	"\n" +
+	"decided_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tdecidedAt\x12\x16\n" +
+	"\x06reason\x18\x0e \x01(\tR\x06reason\x12\x15\n" +
+	"\x03nbf\x18\x0f \x01(\x03H\x00R\x03nbf\x88\x01\x01\x12\x15\n" +
+	"\x03exp\x18\x10 \x01(\x03H\x01R\x03exp\x88\x01\x01B\x06\n" +
+	"\x04_nbfB\x06\n" +
+	"\x04_exp\"\xfd\x01\n" +
+	"\x1aCreateAccessRequestRequest\x12\x18\n" +
+	"\aproject\x18\x01 \x01(\tR\aproject\x12#\n" +
+	"\rresource_type\x18\x02 \x01(\tR\fresourceType\x12#\n" +
+	"\rresource_name\x18\x03 \x01(\tR\fresourceName\x12*\n" +
+	"\x04role\x18\x04 \x01(\x0e2\x16.holos.console.v1.RoleR\x04role\x12$\n" +
+	"\rjustification\x18\x05 \x01(\tR\rjustification\x12)\n" +
+	"\x10duration_seconds\x18\x06 \x01(\x03R\x0fdurationSeconds\"e\n" +
+	"\x1bCreateAccessRequestResponse\x12F\n" +
+	"\x0eaccess_request\x18\x01 \x01(\v2\x1f.holos.console.v1.AccessRequestR\raccessRequest\"q\n" +
+	"\x19ListAccessRequestsRequest\x12\x18\n" +
+	"\aproject\x18\x01 \x01(\tR\aproject\x12:\n" +
+	"\x05state\x18\x02 \x01(\x0e2$.holos.console.v1.AccessRequestStateR\x05state\"f\n" +
+	"\x1aListAccessRequestsResponse\x12H\n" +
+	"\x0faccess_requests\x18\x01 \x03(\v2\x1f.holos.console.v1.AccessRequestR\x0eaccessRequests\"c\n" +
+	"\x1bApproveAccessRequestRequest\x12\x18\n" +
+	"\aproject\x18\x01 \x01(\tR\aproject\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"f\n" +
+	"\x1cApproveAccessRequestResponse\x12F\n" +
+	"\x0eaccess_request\x18\x01 \x01(\v2\x1f.holos.console.v1.AccessRequestR\raccessRequest\"`\n" +
+	"\x18DenyAccessRequestRequest\x12\x18\n" +
+	"\aproject\x18\x01 \x01(\tR\aproject\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"c\n" +
+	"\x19DenyAccessRequestResponse\x12F\n" +
+	"\x0eaccess_request\x18\x01 \x01(\v2\x1f.holos.console.v1.AccessRequestR\raccessRequest*\xa0\x01\n" +
+	"\x12AccessRequestState\x12$\n" +
+	" ACCESS_REQUEST_STATE_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cACCESS_REQUEST_STATE_PENDING\x10\x01\x12!\n" +
+	"\x1dACCESS_REQUEST_STATE_APPROVED\x10\x02\x12\x1f\n" +
+	"\x1bACCESS_REQUEST_STATE_DENIED\x10\x032\xe5\x03\n" +
+	"\x14AccessRequestService\x12r\n" +
+	"\x13CreateAccessRequest\x12,.holos.console.v1.CreateAccessRequestRequest\x1a-.holos.console.v1.CreateAccessRequestResponse\x12t\n" +
+	"\x12ListAccessRequests\x12+.holos.console.v1.ListAccessRequestsRequest\x1a,.holos.console.v1.ListAccessRequestsResponse\"\x03\x90\x02\x01\x12u\n" +
+	"\x14ApproveAccessRequest\x12-.holos.console.v1.ApproveAccessRequestRequest\x1a..holos.console.v1.ApproveAccessRequestResponse\x12l\n" +
+	"\x11DenyAccessRequest\x12*.holos.console.v1.DenyAccessRequestRequest\x1a+.holos.console.v1.DenyAccessRequestResponseBCZAgithub.com/holos-run/holos-console/gen/holos/console/v1;consolev1b\x06proto3"
+
+var (
+	file_holos_console_v1_access_requests_proto_rawDescOnce sync.Once
+	file_holos_console_v1_access_requests_proto_rawDescData []byte
+)
+
+func file_holos_console_v1_access_requests_proto_rawDescGZIP() []byte {
+	file_holos_console_v1_access_requests_proto_rawDescOnce.Do(func() {
+		file_holos_console_v1_access_requests_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_holos_console_v1_access_requests_proto_rawDesc), len(file_holos_console_v1_access_requests_proto_rawDesc)))
+	})
+	return file_holos_console_v1_access_requests_proto_rawDescData
+}
+
+var file_holos_console_v1_access_requests_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_holos_console_v1_access_requests_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_holos_console_v1_access_requests_proto_goTypes = []any{
+	(AccessRequestState)(0),              // 0: holos.console.v1.AccessRequestState
+	(*AccessRequest)(nil),                // 1: holos.console.v1.AccessRequest
+	(*CreateAccessRequestRequest)(nil),   // 2: holos.console.v1.CreateAccessRequestRequest
+	(*CreateAccessRequestResponse)(nil),  // 3: holos.console.v1.CreateAccessRequestResponse
+	(*ListAccessRequestsRequest)(nil),    // 4: holos.console.v1.ListAccessRequestsRequest
+	(*ListAccessRequestsResponse)(nil),   // 5: holos.console.v1.ListAccessRequestsResponse
+	(*ApproveAccessRequestRequest)(nil),  // 6: holos.console.v1.ApproveAccessRequestRequest
+	(*ApproveAccessRequestResponse)(nil), // 7: holos.console.v1.ApproveAccessRequestResponse
+	(*DenyAccessRequestRequest)(nil),     // 8: holos.console.v1.DenyAccessRequestRequest
+	(*DenyAccessRequestResponse)(nil),    // 9: holos.console.v1.DenyAccessRequestResponse
+	(Role)(0),                            // 10: holos.console.v1.Role
+	(*timestamppb.Timestamp)(nil),        // 11: google.protobuf.Timestamp
+}
+var file_holos_console_v1_access_requests_proto_depIdxs = []int32{
+	10, // 0: holos.console.v1.AccessRequest.role:type_name -> holos.console.v1.Role
+	0,  // 1: holos.console.v1.AccessRequest.state:type_name -> holos.console.v1.AccessRequestState
+	11, // 2: holos.console.v1.AccessRequest.created_at:type_name -> google.protobuf.Timestamp
+	11, // 3: holos.console.v1.AccessRequest.decided_at:type_name -> google.protobuf.Timestamp
+	10, // 4: holos.console.v1.CreateAccessRequestRequest.role:type_name -> holos.console.v1.Role
+	1,  // 5: holos.console.v1.CreateAccessRequestResponse.access_request:type_name -> holos.console.v1.AccessRequest
+	0,  // 6: holos.console.v1.ListAccessRequestsRequest.state:type_name -> holos.console.v1.AccessRequestState
+	1,  // 7: holos.console.v1.ListAccessRequestsResponse.access_requests:type_name -> holos.console.v1.AccessRequest
+	1,  // 8: holos.console.v1.ApproveAccessRequestResponse.access_request:type_name -> holos.console.v1.AccessRequest
+	1,  // 9: holos.console.v1.DenyAccessRequestResponse.access_request:type_name -> holos.console.v1.AccessRequest
+	2,  // 10: holos.console.v1.AccessRequestService.CreateAccessRequest:input_type -> holos.console.v1.CreateAccessRequestRequest
+	4,  // 11: holos.console.v1.AccessRequestService.ListAccessRequests:input_type -> holos.console.v1.ListAccessRequestsRequest
+	6,  // 12: holos.console.v1.AccessRequestService.ApproveAccessRequest:input_type -> holos.console.v1.ApproveAccessRequestRequest
+	8,  // 13: holos.console.v1.AccessRequestService.DenyAccessRequest:input_type -> holos.console.v1.DenyAccessRequestRequest
+	3,  // 14: holos.console.v1.AccessRequestService.CreateAccessRequest:output_type -> holos.console.v1.CreateAccessRequestResponse
+	5,  // 15: holos.console.v1.AccessRequestService.ListAccessRequests:output_type -> holos.console.v1.ListAccessRequestsResponse
+	7,  // 16: holos.console.v1.AccessRequestService.ApproveAccessRequest:output_type -> holos.console.v1.ApproveAccessRequestResponse
+	9,  // 17: holos.console.v1.AccessRequestService.DenyAccessRequest:output_type -> holos.console.v1.DenyAccessRequestResponse
+	14, // [14:18] is the sub-list for method output_type
+	10, // [10:14] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_holos_console_v1_access_requests_proto_init() }
+func file_holos_console_v1_access_requests_proto_init() {
+	if File_holos_console_v1_access_requests_proto != nil {
+		return
+	}
+	file_holos_console_v1_rbac_proto_init()
+	file_holos_console_v1_access_requests_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_holos_console_v1_access_requests_proto_rawDesc), len(file_holos_console_v1_access_requests_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_holos_console_v1_access_requests_proto_goTypes,
+		DependencyIndexes: file_holos_console_v1_access_requests_proto_depIdxs,
+		EnumInfos:         file_holos_console_v1_access_requests_proto_enumTypes,
+		MessageInfos:      file_holos_console_v1_access_requests_proto_msgTypes,
+	}.Build()
+	File_holos_console_v1_access_requests_proto = out.File
+	file_holos_console_v1_access_requests_proto_goTypes = nil
+	file_holos_console_v1_access_requests_proto_depIdxs = nil
+}
@@ -0,0 +1,433 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: holos/console/v1/audit.proto
+
+package consolev1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// AuditEvent is a single structured audit log record.
+type AuditEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// time is when the event was recorded.
+	Time *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	// action is the audit action, e.g. "secret_access" or "secret_delete".
+	Action string `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	// resource_type is the kind of resource acted on, e.g. "secret".
+	ResourceType string `protobuf:"bytes,3,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	// resource_name is the name of the resource acted on, when known.
+	ResourceName string `protobuf:"bytes,4,opt,name=resource_name,json=resourceName,proto3" json:"resource_name,omitempty"`
+	// project is the project the resource belongs to, when known.
+	Project string `protobuf:"bytes,5,opt,name=project,proto3" json:"project,omitempty"`
+	// sub is the OIDC subject of the principal that performed the action.
+	Sub string `protobuf:"bytes,6,opt,name=sub,proto3" json:"sub,omitempty"`
+	// email is the email address of the principal that performed the action.
+	Email string `protobuf:"bytes,7,opt,name=email,proto3" json:"email,omitempty"`
+	// message is the human-readable log message.
+	Message string `protobuf:"bytes,8,opt,name=message,proto3" json:"message,omitempty"`
+	// attributes carries every other attribute attached to the log record,
+	// rendered as strings.
+	Attributes map[string]string `protobuf:"bytes,9,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// impersonator_sub is the OIDC subject of the real principal when the
+	// action was performed while acting as sub with X-Impersonate-User.
+	ImpersonatorSub string `protobuf:"bytes,10,opt,name=impersonator_sub,json=impersonatorSub,proto3" json:"impersonator_sub,omitempty"`
+	// impersonator_email is the email address of the real principal when the
+	// action was performed while impersonating.
+	ImpersonatorEmail string `protobuf:"bytes,11,opt,name=impersonator_email,json=impersonatorEmail,proto3" json:"impersonator_email,omitempty"`
+	// cluster is the registered cluster the action targeted, empty for the
+	// cluster the console runs in.
+	Cluster string `protobuf:"bytes,12,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	// request_id identifies the request that produced the event. It matches
+	// the X-Request-Id response header and the request_id of the access log
+	// line for the request.
+	RequestId     string `protobuf:"bytes,13,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditEvent) Reset() {
+	*x = AuditEvent{}
+	mi := &file_holos_console_v1_audit_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEvent) ProtoMessage() {}
+
+func (x *AuditEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_audit_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEvent.ProtoReflect.Descriptor instead.
+func (*AuditEvent) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_audit_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AuditEvent) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *AuditEvent) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetResourceType() string {
+	if x != nil {
+		return x.ResourceType
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetResourceName() string {
+	if x != nil {
+		return x.ResourceName
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetSub() string {
+	if x != nil {
+		return x.Sub
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *AuditEvent) GetImpersonatorSub() string {
+	if x != nil {
+		return x.ImpersonatorSub
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetImpersonatorEmail() string {
+	if x != nil {
+		return x.ImpersonatorEmail
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetCluster() string {
+	if x != nil {
+		return x.Cluster
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+// ListAuditEventsRequest contains optional filters for listing audit events.
+// Unset filters match every event.
+type ListAuditEventsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// project restricts results to events recorded for this project.
+	Project string `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	// resource_type restricts results to events for this resource type.
+	ResourceType string `protobuf:"bytes,2,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	// action restricts results to events with this action.
+	Action string `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	// principal restricts results to events performed by this principal. The
+	// value is matched case-insensitively against sub, email, and the
+	// impersonator fields, so an admin's act-as activity is included.
+	Principal string `protobuf:"bytes,4,opt,name=principal,proto3" json:"principal,omitempty"`
+	// start_time restricts results to events recorded at or after this time.
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// end_time restricts results to events recorded before this time.
+	EndTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// limit caps the number of events returned. Zero selects the server
+	// default of 100.
+	Limit         int32 `protobuf:"varint,7,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditEventsRequest) Reset() {
+	*x = ListAuditEventsRequest{}
+	mi := &file_holos_console_v1_audit_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsRequest) ProtoMessage() {}
+
+func (x *ListAuditEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_audit_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsRequest) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_audit_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListAuditEventsRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetResourceType() string {
+	if x != nil {
+		return x.ResourceType
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetPrincipal() string {
+	if x != nil {
+		return x.Principal
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *ListAuditEventsRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *ListAuditEventsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// ListAuditEventsResponse contains matching audit events, newest first.
+type ListAuditEventsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// events contains the matching audit events.
+	Events        []*AuditEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditEventsResponse) Reset() {
+	*x = ListAuditEventsResponse{}
+	mi := &file_holos_console_v1_audit_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsResponse) ProtoMessage() {}
+
+func (x *ListAuditEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_audit_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsResponse) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_audit_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListAuditEventsResponse) GetEvents() []*AuditEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+var File_holos_console_v1_audit_proto protoreflect.FileDescriptor
+
+const file_holos_console_v1_audit_proto_rawDesc = "" +
+	"\n" +
+	"\x1cholos/console/v1/audit.proto\x12\x10holos.console.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x9a\x04\n" +
+	"\n" +
+	"AuditEvent\x12.\n" +
+	"\x04time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x12\x16\n" +
+	"\x06action\x18\x02 \x01(\tR\x06action\x12#\n" +
+	"\rresource_type\x18\x03 \x01(\tR\fresourceType\x12#\n" +
+	"\rresource_name\x18\x04 \x01(\tR\fresourceName\x12\x18\n" +
+	"\aproject\x18\x05 \x01(\tR\aproject\x12\x10\n" +
+	"\x03sub\x18\x06 \x01(\tR\x03sub\x12\x14\n" +
+	"\x05email\x18\a \x01(\tR\x05email\x12\x18\n" +
+	"\amessage\x18\b \x01(\tR\amessage\x12L\n" +
+	"\n" +
+	"attributes\x18\t \x03(\v2,.holos.console.v1.AuditEvent.AttributesEntryR\n" +
+	"attributes\x12)\n" +
+	"\x10impersonator_sub\x18\n" +
+	" \x01(\tR\x0fimpersonatorSub\x12-\n" +
+	"\x12impersonator_email\x18\v \x01(\tR\x11impersonatorEmail\x12\x18\n" +
+	"\acluster\x18\f \x01(\tR\acluster\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\r \x01(\tR\trequestId\x1a=\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x95\x02\n" +
+	"\x16ListAuditEventsRequest\x12\x18\n" +
+	"\aproject\x18\x01 \x01(\tR\aproject\x12#\n" +
+	"\rresource_type\x18\x02 \x01(\tR\fresourceType\x12\x16\n" +
+	"\x06action\x18\x03 \x01(\tR\x06action\x12\x1c\n" +
+	"\tprincipal\x18\x04 \x01(\tR\tprincipal\x129\n" +
+	"\n" +
+	"start_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12\x14\n" +
+	"\x05limit\x18\a \x01(\x05R\x05limit\"O\n" +
+	"\x17ListAuditEventsResponse\x124\n" +
+	"\x06events\x18\x01 \x03(\v2\x1c.holos.console.v1.AuditEventR\x06events2{\n" +
+	"\fAuditService\x12k\n" +
+	"\x0fListAuditEvents\x12(.holos.console.v1.ListAuditEventsRequest\x1a).holos.console.v1.ListAuditEventsResponse\"\x03\x90\x02\x01BCZAgithub.com/holos-run/holos-console/gen/holos/console/v1;consolev1b\x06proto3"
+
+var (
+	file_holos_console_v1_audit_proto_rawDescOnce sync.Once
+	file_holos_console_v1_audit_proto_rawDescData []byte
+)
+
+func file_holos_console_v1_audit_proto_rawDescGZIP() []byte {
+	file_holos_console_v1_audit_proto_rawDescOnce.Do(func() {
+		file_holos_console_v1_audit_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_holos_console_v1_audit_proto_rawDesc), len(file_holos_console_v1_audit_proto_rawDesc)))
+	})
+	return file_holos_console_v1_audit_proto_rawDescData
+}
+
+var file_holos_console_v1_audit_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_holos_console_v1_audit_proto_goTypes = []any{
+	(*AuditEvent)(nil),              // 0: holos.console.v1.AuditEvent
+	(*ListAuditEventsRequest)(nil),  // 1: holos.console.v1.ListAuditEventsRequest
+	(*ListAuditEventsResponse)(nil), // 2: holos.console.v1.ListAuditEventsResponse
+	nil,                             // 3: holos.console.v1.AuditEvent.AttributesEntry
+	(*timestamppb.Timestamp)(nil),   // 4: google.protobuf.Timestamp
+}
+var file_holos_console_v1_audit_proto_depIdxs = []int32{
+	4, // 0: holos.console.v1.AuditEvent.time:type_name -> google.protobuf.Timestamp
+	3, // 1: holos.console.v1.AuditEvent.attributes:type_name -> holos.console.v1.AuditEvent.AttributesEntry
+	4, // 2: holos.console.v1.ListAuditEventsRequest.start_time:type_name -> google.protobuf.Timestamp
+	4, // 3: holos.console.v1.ListAuditEventsRequest.end_time:type_name -> google.protobuf.Timestamp
+	0, // 4: holos.console.v1.ListAuditEventsResponse.events:type_name -> holos.console.v1.AuditEvent
+	1, // 5: holos.console.v1.AuditService.ListAuditEvents:input_type -> holos.console.v1.ListAuditEventsRequest
+	2, // 6: holos.console.v1.AuditService.ListAuditEvents:output_type -> holos.console.v1.ListAuditEventsResponse
+	6, // [6:7] is the sub-list for method output_type
+	5, // [5:6] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_holos_console_v1_audit_proto_init() }
+func file_holos_console_v1_audit_proto_init() {
+	if File_holos_console_v1_audit_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_holos_console_v1_audit_proto_rawDesc), len(file_holos_console_v1_audit_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_holos_console_v1_audit_proto_goTypes,
+		DependencyIndexes: file_holos_console_v1_audit_proto_depIdxs,
+		MessageInfos:      file_holos_console_v1_audit_proto_msgTypes,
+	}.Build()
+	File_holos_console_v1_audit_proto = out.File
+	file_holos_console_v1_audit_proto_goTypes = nil
+	file_holos_console_v1_audit_proto_depIdxs = nil
+}
@@ -0,0 +1,219 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: holos/console/v1/access_requests.proto
+
+package consolev1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// AccessRequestServiceName is the fully-qualified name of the AccessRequestService service.
+	AccessRequestServiceName = "holos.console.v1.AccessRequestService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// AccessRequestServiceCreateAccessRequestProcedure is the fully-qualified name of the
+	// AccessRequestService's CreateAccessRequest RPC.
+	AccessRequestServiceCreateAccessRequestProcedure = "/holos.console.v1.AccessRequestService/CreateAccessRequest"
+	// AccessRequestServiceListAccessRequestsProcedure is the fully-qualified name of the
+	// AccessRequestService's ListAccessRequests RPC.
+	AccessRequestServiceListAccessRequestsProcedure = "/holos.console.v1.AccessRequestService/ListAccessRequests"
+	// AccessRequestServiceApproveAccessRequestProcedure is the fully-qualified name of the
+	// AccessRequestService's ApproveAccessRequest RPC.
+	AccessRequestServiceApproveAccessRequestProcedure = "/holos.console.v1.AccessRequestService/ApproveAccessRequest"
+	// AccessRequestServiceDenyAccessRequestProcedure is the fully-qualified name of the
+	// AccessRequestService's DenyAccessRequest RPC.
+	AccessRequestServiceDenyAccessRequestProcedure = "/holos.console.v1.AccessRequestService/DenyAccessRequest"
+)
+
+// AccessRequestServiceClient is a client for the holos.console.v1.AccessRequestService service.
+type AccessRequestServiceClient interface {
+	// CreateAccessRequest files a pending request on behalf of the caller.
+	// Any authenticated user may file a request.
+	CreateAccessRequest(context.Context, *connect.Request[v1.CreateAccessRequestRequest]) (*connect.Response[v1.CreateAccessRequestResponse], error)
+	// ListAccessRequests returns the requests filed in a project, newest
+	// first. Project owners see every request; other callers see only their
+	// own.
+	ListAccessRequests(context.Context, *connect.Request[v1.ListAccessRequestsRequest]) (*connect.Response[v1.ListAccessRequestsResponse], error)
+	// ApproveAccessRequest grants the requested role until the requested
+	// duration elapses. Requires owner access to the project, checked as the
+	// "delete" verb on the project namespace.
+	ApproveAccessRequest(context.Context, *connect.Request[v1.ApproveAccessRequestRequest]) (*connect.Response[v1.ApproveAccessRequestResponse], error)
+	// DenyAccessRequest rejects a pending request. Requires owner access to
+	// the project.
+	DenyAccessRequest(context.Context, *connect.Request[v1.DenyAccessRequestRequest]) (*connect.Response[v1.DenyAccessRequestResponse], error)
+}
+
+// NewAccessRequestServiceClient constructs a client for the holos.console.v1.AccessRequestService
+// service. By default, it uses the Connect protocol with the binary Protobuf Codec, asks for
+// gzipped responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply
+// the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewAccessRequestServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) AccessRequestServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	accessRequestServiceMethods := v1.File_holos_console_v1_access_requests_proto.Services().ByName("AccessRequestService").Methods()
+	return &accessRequestServiceClient{
+		createAccessRequest: connect.NewClient[v1.CreateAccessRequestRequest, v1.CreateAccessRequestResponse](
+			httpClient,
+			baseURL+AccessRequestServiceCreateAccessRequestProcedure,
+			connect.WithSchema(accessRequestServiceMethods.ByName("CreateAccessRequest")),
+			connect.WithClientOptions(opts...),
+		),
+		listAccessRequests: connect.NewClient[v1.ListAccessRequestsRequest, v1.ListAccessRequestsResponse](
+			httpClient,
+			baseURL+AccessRequestServiceListAccessRequestsProcedure,
+			connect.WithSchema(accessRequestServiceMethods.ByName("ListAccessRequests")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		approveAccessRequest: connect.NewClient[v1.ApproveAccessRequestRequest, v1.ApproveAccessRequestResponse](
+			httpClient,
+			baseURL+AccessRequestServiceApproveAccessRequestProcedure,
+			connect.WithSchema(accessRequestServiceMethods.ByName("ApproveAccessRequest")),
+			connect.WithClientOptions(opts...),
+		),
+		denyAccessRequest: connect.NewClient[v1.DenyAccessRequestRequest, v1.DenyAccessRequestResponse](
+			httpClient,
+			baseURL+AccessRequestServiceDenyAccessRequestProcedure,
+			connect.WithSchema(accessRequestServiceMethods.ByName("DenyAccessRequest")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// accessRequestServiceClient implements AccessRequestServiceClient.
+type accessRequestServiceClient struct {
+	createAccessRequest  *connect.Client[v1.CreateAccessRequestRequest, v1.CreateAccessRequestResponse]
+	listAccessRequests   *connect.Client[v1.ListAccessRequestsRequest, v1.ListAccessRequestsResponse]
+	approveAccessRequest *connect.Client[v1.ApproveAccessRequestRequest, v1.ApproveAccessRequestResponse]
+	denyAccessRequest    *connect.Client[v1.DenyAccessRequestRequest, v1.DenyAccessRequestResponse]
+}
+
+// CreateAccessRequest calls holos.console.v1.AccessRequestService.CreateAccessRequest.
+func (c *accessRequestServiceClient) CreateAccessRequest(ctx context.Context, req *connect.Request[v1.CreateAccessRequestRequest]) (*connect.Response[v1.CreateAccessRequestResponse], error) {
+	return c.createAccessRequest.CallUnary(ctx, req)
+}
+
+// ListAccessRequests calls holos.console.v1.AccessRequestService.ListAccessRequests.
+func (c *accessRequestServiceClient) ListAccessRequests(ctx context.Context, req *connect.Request[v1.ListAccessRequestsRequest]) (*connect.Response[v1.ListAccessRequestsResponse], error) {
+	return c.listAccessRequests.CallUnary(ctx, req)
+}
+
+// ApproveAccessRequest calls holos.console.v1.AccessRequestService.ApproveAccessRequest.
+func (c *accessRequestServiceClient) ApproveAccessRequest(ctx context.Context, req *connect.Request[v1.ApproveAccessRequestRequest]) (*connect.Response[v1.ApproveAccessRequestResponse], error) {
+	return c.approveAccessRequest.CallUnary(ctx, req)
+}
+
+// DenyAccessRequest calls holos.console.v1.AccessRequestService.DenyAccessRequest.
+func (c *accessRequestServiceClient) DenyAccessRequest(ctx context.Context, req *connect.Request[v1.DenyAccessRequestRequest]) (*connect.Response[v1.DenyAccessRequestResponse], error) {
+	return c.denyAccessRequest.CallUnary(ctx, req)
+}
+
+// AccessRequestServiceHandler is an implementation of the holos.console.v1.AccessRequestService
+// service.
+type AccessRequestServiceHandler interface {
+	// CreateAccessRequest files a pending request on behalf of the caller.
+	// Any authenticated user may file a request.
+	CreateAccessRequest(context.Context, *connect.Request[v1.CreateAccessRequestRequest]) (*connect.Response[v1.CreateAccessRequestResponse], error)
+	// ListAccessRequests returns the requests filed in a project, newest
+	// first. Project owners see every request; other callers see only their
+	// own.
+	ListAccessRequests(context.Context, *connect.Request[v1.ListAccessRequestsRequest]) (*connect.Response[v1.ListAccessRequestsResponse], error)
+	// ApproveAccessRequest grants the requested role until the requested
+	// duration elapses. Requires owner access to the project, checked as the
+	// "delete" verb on the project namespace.
+	ApproveAccessRequest(context.Context, *connect.Request[v1.ApproveAccessRequestRequest]) (*connect.Response[v1.ApproveAccessRequestResponse], error)
+	// DenyAccessRequest rejects a pending request. Requires owner access to
+	// the project.
+	DenyAccessRequest(context.Context, *connect.Request[v1.DenyAccessRequestRequest]) (*connect.Response[v1.DenyAccessRequestResponse], error)
+}
+
+// NewAccessRequestServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewAccessRequestServiceHandler(svc AccessRequestServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	accessRequestServiceMethods := v1.File_holos_console_v1_access_requests_proto.Services().ByName("AccessRequestService").Methods()
+	accessRequestServiceCreateAccessRequestHandler := connect.NewUnaryHandler(
+		AccessRequestServiceCreateAccessRequestProcedure,
+		svc.CreateAccessRequest,
+		connect.WithSchema(accessRequestServiceMethods.ByName("CreateAccessRequest")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accessRequestServiceListAccessRequestsHandler := connect.NewUnaryHandler(
+		AccessRequestServiceListAccessRequestsProcedure,
+		svc.ListAccessRequests,
+		connect.WithSchema(accessRequestServiceMethods.ByName("ListAccessRequests")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	accessRequestServiceApproveAccessRequestHandler := connect.NewUnaryHandler(
+		AccessRequestServiceApproveAccessRequestProcedure,
+		svc.ApproveAccessRequest,
+		connect.WithSchema(accessRequestServiceMethods.ByName("ApproveAccessRequest")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accessRequestServiceDenyAccessRequestHandler := connect.NewUnaryHandler(
+		AccessRequestServiceDenyAccessRequestProcedure,
+		svc.DenyAccessRequest,
+		connect.WithSchema(accessRequestServiceMethods.ByName("DenyAccessRequest")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/holos.console.v1.AccessRequestService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case AccessRequestServiceCreateAccessRequestProcedure:
+			accessRequestServiceCreateAccessRequestHandler.ServeHTTP(w, r)
+		case AccessRequestServiceListAccessRequestsProcedure:
+			accessRequestServiceListAccessRequestsHandler.ServeHTTP(w, r)
+		case AccessRequestServiceApproveAccessRequestProcedure:
+			accessRequestServiceApproveAccessRequestHandler.ServeHTTP(w, r)
+		case AccessRequestServiceDenyAccessRequestProcedure:
+			accessRequestServiceDenyAccessRequestHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedAccessRequestServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedAccessRequestServiceHandler struct{}
+
+func (UnimplementedAccessRequestServiceHandler) CreateAccessRequest(context.Context, *connect.Request[v1.CreateAccessRequestRequest]) (*connect.Response[v1.CreateAccessRequestResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("holos.console.v1.AccessRequestService.CreateAccessRequest is not implemented"))
+}
+
+func (UnimplementedAccessRequestServiceHandler) ListAccessRequests(context.Context, *connect.Request[v1.ListAccessRequestsRequest]) (*connect.Response[v1.ListAccessRequestsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("holos.console.v1.AccessRequestService.ListAccessRequests is not implemented"))
+}
+
+func (UnimplementedAccessRequestServiceHandler) ApproveAccessRequest(context.Context, *connect.Request[v1.ApproveAccessRequestRequest]) (*connect.Response[v1.ApproveAccessRequestResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("holos.console.v1.AccessRequestService.ApproveAccessRequest is not implemented"))
+}
+
+func (UnimplementedAccessRequestServiceHandler) DenyAccessRequest(context.Context, *connect.Request[v1.DenyAccessRequestRequest]) (*connect.Response[v1.DenyAccessRequestResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("holos.console.v1.AccessRequestService.DenyAccessRequest is not implemented"))
+}
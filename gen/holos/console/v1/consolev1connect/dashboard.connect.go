@@ -0,0 +1,180 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: holos/console/v1/dashboard.proto
+
+package consolev1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// DashboardServiceName is the fully-qualified name of the DashboardService service.
+	DashboardServiceName = "holos.console.v1.DashboardService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// DashboardServiceListAccessibleResourcesProcedure is the fully-qualified name of the
+	// DashboardService's ListAccessibleResources RPC.
+	DashboardServiceListAccessibleResourcesProcedure = "/holos.console.v1.DashboardService/ListAccessibleResources"
+	// DashboardServiceGetPrincipalAccessReportProcedure is the fully-qualified name of the
+	// DashboardService's GetPrincipalAccessReport RPC.
+	DashboardServiceGetPrincipalAccessReportProcedure = "/holos.console.v1.DashboardService/GetPrincipalAccessReport"
+)
+
+// DashboardServiceClient is a client for the holos.console.v1.DashboardService service.
+type DashboardServiceClient interface {
+	// ListAccessibleResources returns every organization, project, and secret
+	// the caller can access, with the role they hold on each. Organization and
+	// project namespaces are read from the console's informer cache, and the
+	// secrets and their sharing bindings with one list each, so the cost does
+	// not grow with the number of projects.
+	//
+	// Roles come from the share grants on each resource. When the request
+	// carries impersonated clients, a cluster-wide grant on namespaces
+	// confirmed by SelfSubjectAccessReview raises the role on every
+	// organization and project. Deny grants always win.
+	ListAccessibleResources(context.Context, *connect.Request[v1.ListAccessibleResourcesRequest]) (*connect.Response[v1.ListAccessibleResourcesResponse], error)
+	// GetPrincipalAccessReport lists every organization, folder, project, and
+	// secret a user or group holds a role on, with the grants conferring it,
+	// for offboarding and access reviews. It reads the same informer cache and
+	// cluster-wide lists as ListAccessibleResources, evaluated for the named
+	// principal instead of the caller.
+	//
+	// Only the console's sharing grants are evaluated: Kubernetes RBAC bound
+	// outside them, such as a platform group's ClusterRoleBinding, is not
+	// reported. Requires the caller to be a platform owner.
+	GetPrincipalAccessReport(context.Context, *connect.Request[v1.GetPrincipalAccessReportRequest]) (*connect.Response[v1.GetPrincipalAccessReportResponse], error)
+}
+
+// NewDashboardServiceClient constructs a client for the holos.console.v1.DashboardService service.
+// By default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped
+// responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewDashboardServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) DashboardServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	dashboardServiceMethods := v1.File_holos_console_v1_dashboard_proto.Services().ByName("DashboardService").Methods()
+	return &dashboardServiceClient{
+		listAccessibleResources: connect.NewClient[v1.ListAccessibleResourcesRequest, v1.ListAccessibleResourcesResponse](
+			httpClient,
+			baseURL+DashboardServiceListAccessibleResourcesProcedure,
+			connect.WithSchema(dashboardServiceMethods.ByName("ListAccessibleResources")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getPrincipalAccessReport: connect.NewClient[v1.GetPrincipalAccessReportRequest, v1.GetPrincipalAccessReportResponse](
+			httpClient,
+			baseURL+DashboardServiceGetPrincipalAccessReportProcedure,
+			connect.WithSchema(dashboardServiceMethods.ByName("GetPrincipalAccessReport")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// dashboardServiceClient implements DashboardServiceClient.
+type dashboardServiceClient struct {
+	listAccessibleResources  *connect.Client[v1.ListAccessibleResourcesRequest, v1.ListAccessibleResourcesResponse]
+	getPrincipalAccessReport *connect.Client[v1.GetPrincipalAccessReportRequest, v1.GetPrincipalAccessReportResponse]
+}
+
+// ListAccessibleResources calls holos.console.v1.DashboardService.ListAccessibleResources.
+func (c *dashboardServiceClient) ListAccessibleResources(ctx context.Context, req *connect.Request[v1.ListAccessibleResourcesRequest]) (*connect.Response[v1.ListAccessibleResourcesResponse], error) {
+	return c.listAccessibleResources.CallUnary(ctx, req)
+}
+
+// GetPrincipalAccessReport calls holos.console.v1.DashboardService.GetPrincipalAccessReport.
+func (c *dashboardServiceClient) GetPrincipalAccessReport(ctx context.Context, req *connect.Request[v1.GetPrincipalAccessReportRequest]) (*connect.Response[v1.GetPrincipalAccessReportResponse], error) {
+	return c.getPrincipalAccessReport.CallUnary(ctx, req)
+}
+
+// DashboardServiceHandler is an implementation of the holos.console.v1.DashboardService service.
+type DashboardServiceHandler interface {
+	// ListAccessibleResources returns every organization, project, and secret
+	// the caller can access, with the role they hold on each. Organization and
+	// project namespaces are read from the console's informer cache, and the
+	// secrets and their sharing bindings with one list each, so the cost does
+	// not grow with the number of projects.
+	//
+	// Roles come from the share grants on each resource. When the request
+	// carries impersonated clients, a cluster-wide grant on namespaces
+	// confirmed by SelfSubjectAccessReview raises the role on every
+	// organization and project. Deny grants always win.
+	ListAccessibleResources(context.Context, *connect.Request[v1.ListAccessibleResourcesRequest]) (*connect.Response[v1.ListAccessibleResourcesResponse], error)
+	// GetPrincipalAccessReport lists every organization, folder, project, and
+	// secret a user or group holds a role on, with the grants conferring it,
+	// for offboarding and access reviews. It reads the same informer cache and
+	// cluster-wide lists as ListAccessibleResources, evaluated for the named
+	// principal instead of the caller.
+	//
+	// Only the console's sharing grants are evaluated: Kubernetes RBAC bound
+	// outside them, such as a platform group's ClusterRoleBinding, is not
+	// reported. Requires the caller to be a platform owner.
+	GetPrincipalAccessReport(context.Context, *connect.Request[v1.GetPrincipalAccessReportRequest]) (*connect.Response[v1.GetPrincipalAccessReportResponse], error)
+}
+
+// NewDashboardServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewDashboardServiceHandler(svc DashboardServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	dashboardServiceMethods := v1.File_holos_console_v1_dashboard_proto.Services().ByName("DashboardService").Methods()
+	dashboardServiceListAccessibleResourcesHandler := connect.NewUnaryHandler(
+		DashboardServiceListAccessibleResourcesProcedure,
+		svc.ListAccessibleResources,
+		connect.WithSchema(dashboardServiceMethods.ByName("ListAccessibleResources")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	dashboardServiceGetPrincipalAccessReportHandler := connect.NewUnaryHandler(
+		DashboardServiceGetPrincipalAccessReportProcedure,
+		svc.GetPrincipalAccessReport,
+		connect.WithSchema(dashboardServiceMethods.ByName("GetPrincipalAccessReport")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/holos.console.v1.DashboardService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case DashboardServiceListAccessibleResourcesProcedure:
+			dashboardServiceListAccessibleResourcesHandler.ServeHTTP(w, r)
+		case DashboardServiceGetPrincipalAccessReportProcedure:
+			dashboardServiceGetPrincipalAccessReportHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedDashboardServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedDashboardServiceHandler struct{}
+
+func (UnimplementedDashboardServiceHandler) ListAccessibleResources(context.Context, *connect.Request[v1.ListAccessibleResourcesRequest]) (*connect.Response[v1.ListAccessibleResourcesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("holos.console.v1.DashboardService.ListAccessibleResources is not implemented"))
+}
+
+func (UnimplementedDashboardServiceHandler) GetPrincipalAccessReport(context.Context, *connect.Request[v1.GetPrincipalAccessReportRequest]) (*connect.Response[v1.GetPrincipalAccessReportResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("holos.console.v1.DashboardService.GetPrincipalAccessReport is not implemented"))
+}
@@ -111,16 +111,16 @@ type DeploymentServiceClient interface {
 	// PreflightCheck is a planning-time validation RPC that surfaces two
 	// classes of conflict before the user clicks Apply:
 	//
-	//	(a) Sibling-Deployment name collisions — a planned Deployment shares its
-	//	    computed singleton name with an existing user-named Deployment in the
-	//	    same project namespace.
+	//  (a) Sibling-Deployment name collisions — a planned Deployment shares its
+	//      computed singleton name with an existing user-named Deployment in the
+	//      same project namespace.
 	//
-	//	(b) versionConstraint conflicts — two or more dependents in the same
-	//	    project namespace pin incompatible version constraints on the same
-	//	    shared dependency template.  The conflict is detected with
-	//	    Go-module-style minimum version selection (Decision 9 in ADR 035):
-	//	    the resolver attempts to find a single version that satisfies every
-	//	    constraint; when none exists it reports a VersionConflictDetail.
+	//  (b) versionConstraint conflicts — two or more dependents in the same
+	//      project namespace pin incompatible version constraints on the same
+	//      shared dependency template.  The conflict is detected with
+	//      Go-module-style minimum version selection (Decision 9 in ADR 035):
+	//      the resolver attempts to find a single version that satisfies every
+	//      constraint; when none exists it reports a VersionConflictDetail.
 	//
 	// The RPC is safe to call before Apply. It MUST NOT mutate any cluster
 	// state. Phase 9 (HOL-963) wires the deployment form to call it before
@@ -158,12 +158,14 @@ func NewDeploymentServiceClient(httpClient connect.HTTPClient, baseURL string, o
 			httpClient,
 			baseURL+DeploymentServiceListDeploymentsProcedure,
 			connect.WithSchema(deploymentServiceMethods.ByName("ListDeployments")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 		getDeployment: connect.NewClient[v1.GetDeploymentRequest, v1.GetDeploymentResponse](
 			httpClient,
 			baseURL+DeploymentServiceGetDeploymentProcedure,
 			connect.WithSchema(deploymentServiceMethods.ByName("GetDeployment")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 		createDeployment: connect.NewClient[v1.CreateDeploymentRequest, v1.CreateDeploymentResponse](
@@ -188,12 +190,14 @@ func NewDeploymentServiceClient(httpClient connect.HTTPClient, baseURL string, o
 			httpClient,
 			baseURL+DeploymentServiceGetDeploymentStatusProcedure,
 			connect.WithSchema(deploymentServiceMethods.ByName("GetDeploymentStatus")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 		getDeploymentStatusSummary: connect.NewClient[v1.GetDeploymentStatusSummaryRequest, v1.GetDeploymentStatusSummaryResponse](
 			httpClient,
 			baseURL+DeploymentServiceGetDeploymentStatusSummaryProcedure,
 			connect.WithSchema(deploymentServiceMethods.ByName("GetDeploymentStatusSummary")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 		getDeploymentLogs: connect.NewClient[v1.GetDeploymentLogsRequest, v1.GetDeploymentLogsResponse](
@@ -206,12 +210,14 @@ func NewDeploymentServiceClient(httpClient connect.HTTPClient, baseURL string, o
 			httpClient,
 			baseURL+DeploymentServiceListNamespaceSecretsProcedure,
 			connect.WithSchema(deploymentServiceMethods.ByName("ListNamespaceSecrets")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 		listNamespaceConfigMaps: connect.NewClient[v1.ListNamespaceConfigMapsRequest, v1.ListNamespaceConfigMapsResponse](
 			httpClient,
 			baseURL+DeploymentServiceListNamespaceConfigMapsProcedure,
 			connect.WithSchema(deploymentServiceMethods.ByName("ListNamespaceConfigMaps")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 		getDeploymentRenderPreview: connect.NewClient[v1.GetDeploymentRenderPreviewRequest, v1.GetDeploymentRenderPreviewResponse](
@@ -224,6 +230,7 @@ func NewDeploymentServiceClient(httpClient connect.HTTPClient, baseURL string, o
 			httpClient,
 			baseURL+DeploymentServiceGetDeploymentPolicyStateProcedure,
 			connect.WithSchema(deploymentServiceMethods.ByName("GetDeploymentPolicyState")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 		preflightCheck: connect.NewClient[v1.PreflightCheckRequest, v1.PreflightCheckResponse](
@@ -236,6 +243,7 @@ func NewDeploymentServiceClient(httpClient connect.HTTPClient, baseURL string, o
 			httpClient,
 			baseURL+DeploymentServiceGetDependencyEdgeCascadeDeleteProcedure,
 			connect.WithSchema(deploymentServiceMethods.ByName("GetDependencyEdgeCascadeDelete")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 		setDependencyEdgeCascadeDelete: connect.NewClient[v1.SetDependencyEdgeCascadeDeleteRequest, v1.SetDependencyEdgeCascadeDeleteResponse](
@@ -374,16 +382,16 @@ type DeploymentServiceHandler interface {
 	// PreflightCheck is a planning-time validation RPC that surfaces two
 	// classes of conflict before the user clicks Apply:
 	//
-	//	(a) Sibling-Deployment name collisions — a planned Deployment shares its
-	//	    computed singleton name with an existing user-named Deployment in the
-	//	    same project namespace.
+	//  (a) Sibling-Deployment name collisions — a planned Deployment shares its
+	//      computed singleton name with an existing user-named Deployment in the
+	//      same project namespace.
 	//
-	//	(b) versionConstraint conflicts — two or more dependents in the same
-	//	    project namespace pin incompatible version constraints on the same
-	//	    shared dependency template.  The conflict is detected with
-	//	    Go-module-style minimum version selection (Decision 9 in ADR 035):
-	//	    the resolver attempts to find a single version that satisfies every
-	//	    constraint; when none exists it reports a VersionConflictDetail.
+	//  (b) versionConstraint conflicts — two or more dependents in the same
+	//      project namespace pin incompatible version constraints on the same
+	//      shared dependency template.  The conflict is detected with
+	//      Go-module-style minimum version selection (Decision 9 in ADR 035):
+	//      the resolver attempts to find a single version that satisfies every
+	//      constraint; when none exists it reports a VersionConflictDetail.
 	//
 	// The RPC is safe to call before Apply. It MUST NOT mutate any cluster
 	// state. Phase 9 (HOL-963) wires the deployment form to call it before
@@ -417,12 +425,14 @@ func NewDeploymentServiceHandler(svc DeploymentServiceHandler, opts ...connect.H
 		DeploymentServiceListDeploymentsProcedure,
 		svc.ListDeployments,
 		connect.WithSchema(deploymentServiceMethods.ByName("ListDeployments")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	deploymentServiceGetDeploymentHandler := connect.NewUnaryHandler(
 		DeploymentServiceGetDeploymentProcedure,
 		svc.GetDeployment,
 		connect.WithSchema(deploymentServiceMethods.ByName("GetDeployment")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	deploymentServiceCreateDeploymentHandler := connect.NewUnaryHandler(
@@ -447,12 +457,14 @@ func NewDeploymentServiceHandler(svc DeploymentServiceHandler, opts ...connect.H
 		DeploymentServiceGetDeploymentStatusProcedure,
 		svc.GetDeploymentStatus,
 		connect.WithSchema(deploymentServiceMethods.ByName("GetDeploymentStatus")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	deploymentServiceGetDeploymentStatusSummaryHandler := connect.NewUnaryHandler(
 		DeploymentServiceGetDeploymentStatusSummaryProcedure,
 		svc.GetDeploymentStatusSummary,
 		connect.WithSchema(deploymentServiceMethods.ByName("GetDeploymentStatusSummary")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	deploymentServiceGetDeploymentLogsHandler := connect.NewUnaryHandler(
@@ -465,12 +477,14 @@ func NewDeploymentServiceHandler(svc DeploymentServiceHandler, opts ...connect.H
 		DeploymentServiceListNamespaceSecretsProcedure,
 		svc.ListNamespaceSecrets,
 		connect.WithSchema(deploymentServiceMethods.ByName("ListNamespaceSecrets")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	deploymentServiceListNamespaceConfigMapsHandler := connect.NewUnaryHandler(
 		DeploymentServiceListNamespaceConfigMapsProcedure,
 		svc.ListNamespaceConfigMaps,
 		connect.WithSchema(deploymentServiceMethods.ByName("ListNamespaceConfigMaps")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	deploymentServiceGetDeploymentRenderPreviewHandler := connect.NewUnaryHandler(
@@ -483,6 +497,7 @@ func NewDeploymentServiceHandler(svc DeploymentServiceHandler, opts ...connect.H
 		DeploymentServiceGetDeploymentPolicyStateProcedure,
 		svc.GetDeploymentPolicyState,
 		connect.WithSchema(deploymentServiceMethods.ByName("GetDeploymentPolicyState")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	deploymentServicePreflightCheckHandler := connect.NewUnaryHandler(
@@ -495,6 +510,7 @@ func NewDeploymentServiceHandler(svc DeploymentServiceHandler, opts ...connect.H
 		DeploymentServiceGetDependencyEdgeCascadeDeleteProcedure,
 		svc.GetDependencyEdgeCascadeDelete,
 		connect.WithSchema(deploymentServiceMethods.ByName("GetDependencyEdgeCascadeDelete")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	deploymentServiceSetDependencyEdgeCascadeDeleteHandler := connect.NewUnaryHandler(
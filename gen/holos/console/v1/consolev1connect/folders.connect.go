@@ -111,12 +111,14 @@ func NewFolderServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			httpClient,
 			baseURL+FolderServiceListFoldersProcedure,
 			connect.WithSchema(folderServiceMethods.ByName("ListFolders")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 		getFolder: connect.NewClient[v1.GetFolderRequest, v1.GetFolderResponse](
 			httpClient,
 			baseURL+FolderServiceGetFolderProcedure,
 			connect.WithSchema(folderServiceMethods.ByName("GetFolder")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 		createFolder: connect.NewClient[v1.CreateFolderRequest, v1.CreateFolderResponse](
@@ -153,12 +155,14 @@ func NewFolderServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			httpClient,
 			baseURL+FolderServiceGetFolderRawProcedure,
 			connect.WithSchema(folderServiceMethods.ByName("GetFolderRaw")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 		checkFolderIdentifier: connect.NewClient[v1.CheckFolderIdentifierRequest, v1.CheckFolderIdentifierResponse](
 			httpClient,
 			baseURL+FolderServiceCheckFolderIdentifierProcedure,
 			connect.WithSchema(folderServiceMethods.ByName("CheckFolderIdentifier")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 	}
@@ -268,12 +272,14 @@ func NewFolderServiceHandler(svc FolderServiceHandler, opts ...connect.HandlerOp
 		FolderServiceListFoldersProcedure,
 		svc.ListFolders,
 		connect.WithSchema(folderServiceMethods.ByName("ListFolders")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	folderServiceGetFolderHandler := connect.NewUnaryHandler(
 		FolderServiceGetFolderProcedure,
 		svc.GetFolder,
 		connect.WithSchema(folderServiceMethods.ByName("GetFolder")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	folderServiceCreateFolderHandler := connect.NewUnaryHandler(
@@ -310,12 +316,14 @@ func NewFolderServiceHandler(svc FolderServiceHandler, opts ...connect.HandlerOp
 		FolderServiceGetFolderRawProcedure,
 		svc.GetFolderRaw,
 		connect.WithSchema(folderServiceMethods.ByName("GetFolderRaw")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	folderServiceCheckFolderIdentifierHandler := connect.NewUnaryHandler(
 		FolderServiceCheckFolderIdentifierProcedure,
 		svc.CheckFolderIdentifier,
 		connect.WithSchema(folderServiceMethods.ByName("CheckFolderIdentifier")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	return "/holos.console.v1.FolderService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -0,0 +1,179 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: holos/console/v1/groups.proto
+
+package consolev1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// GroupsServiceName is the fully-qualified name of the GroupsService service.
+	GroupsServiceName = "holos.console.v1.GroupsService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// GroupsServiceListGroupsProcedure is the fully-qualified name of the GroupsService's ListGroups
+	// RPC.
+	GroupsServiceListGroupsProcedure = "/holos.console.v1.GroupsService/ListGroups"
+	// GroupsServiceAddGroupMemberProcedure is the fully-qualified name of the GroupsService's
+	// AddGroupMember RPC.
+	GroupsServiceAddGroupMemberProcedure = "/holos.console.v1.GroupsService/AddGroupMember"
+	// GroupsServiceRemoveGroupMemberProcedure is the fully-qualified name of the GroupsService's
+	// RemoveGroupMember RPC.
+	GroupsServiceRemoveGroupMemberProcedure = "/holos.console.v1.GroupsService/RemoveGroupMember"
+)
+
+// GroupsServiceClient is a client for the holos.console.v1.GroupsService service.
+type GroupsServiceClient interface {
+	// ListGroups returns every console-local group and its members.
+	ListGroups(context.Context, *connect.Request[v1.ListGroupsRequest]) (*connect.Response[v1.ListGroupsResponse], error)
+	// AddGroupMember adds member to group, creating the group if needed.
+	// Adding an existing member is a no-op.
+	AddGroupMember(context.Context, *connect.Request[v1.AddGroupMemberRequest]) (*connect.Response[v1.AddGroupMemberResponse], error)
+	// RemoveGroupMember removes member from group. A group with no members
+	// left is deleted.
+	RemoveGroupMember(context.Context, *connect.Request[v1.RemoveGroupMemberRequest]) (*connect.Response[v1.RemoveGroupMemberResponse], error)
+}
+
+// NewGroupsServiceClient constructs a client for the holos.console.v1.GroupsService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewGroupsServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) GroupsServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	groupsServiceMethods := v1.File_holos_console_v1_groups_proto.Services().ByName("GroupsService").Methods()
+	return &groupsServiceClient{
+		listGroups: connect.NewClient[v1.ListGroupsRequest, v1.ListGroupsResponse](
+			httpClient,
+			baseURL+GroupsServiceListGroupsProcedure,
+			connect.WithSchema(groupsServiceMethods.ByName("ListGroups")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		addGroupMember: connect.NewClient[v1.AddGroupMemberRequest, v1.AddGroupMemberResponse](
+			httpClient,
+			baseURL+GroupsServiceAddGroupMemberProcedure,
+			connect.WithSchema(groupsServiceMethods.ByName("AddGroupMember")),
+			connect.WithClientOptions(opts...),
+		),
+		removeGroupMember: connect.NewClient[v1.RemoveGroupMemberRequest, v1.RemoveGroupMemberResponse](
+			httpClient,
+			baseURL+GroupsServiceRemoveGroupMemberProcedure,
+			connect.WithSchema(groupsServiceMethods.ByName("RemoveGroupMember")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// groupsServiceClient implements GroupsServiceClient.
+type groupsServiceClient struct {
+	listGroups        *connect.Client[v1.ListGroupsRequest, v1.ListGroupsResponse]
+	addGroupMember    *connect.Client[v1.AddGroupMemberRequest, v1.AddGroupMemberResponse]
+	removeGroupMember *connect.Client[v1.RemoveGroupMemberRequest, v1.RemoveGroupMemberResponse]
+}
+
+// ListGroups calls holos.console.v1.GroupsService.ListGroups.
+func (c *groupsServiceClient) ListGroups(ctx context.Context, req *connect.Request[v1.ListGroupsRequest]) (*connect.Response[v1.ListGroupsResponse], error) {
+	return c.listGroups.CallUnary(ctx, req)
+}
+
+// AddGroupMember calls holos.console.v1.GroupsService.AddGroupMember.
+func (c *groupsServiceClient) AddGroupMember(ctx context.Context, req *connect.Request[v1.AddGroupMemberRequest]) (*connect.Response[v1.AddGroupMemberResponse], error) {
+	return c.addGroupMember.CallUnary(ctx, req)
+}
+
+// RemoveGroupMember calls holos.console.v1.GroupsService.RemoveGroupMember.
+func (c *groupsServiceClient) RemoveGroupMember(ctx context.Context, req *connect.Request[v1.RemoveGroupMemberRequest]) (*connect.Response[v1.RemoveGroupMemberResponse], error) {
+	return c.removeGroupMember.CallUnary(ctx, req)
+}
+
+// GroupsServiceHandler is an implementation of the holos.console.v1.GroupsService service.
+type GroupsServiceHandler interface {
+	// ListGroups returns every console-local group and its members.
+	ListGroups(context.Context, *connect.Request[v1.ListGroupsRequest]) (*connect.Response[v1.ListGroupsResponse], error)
+	// AddGroupMember adds member to group, creating the group if needed.
+	// Adding an existing member is a no-op.
+	AddGroupMember(context.Context, *connect.Request[v1.AddGroupMemberRequest]) (*connect.Response[v1.AddGroupMemberResponse], error)
+	// RemoveGroupMember removes member from group. A group with no members
+	// left is deleted.
+	RemoveGroupMember(context.Context, *connect.Request[v1.RemoveGroupMemberRequest]) (*connect.Response[v1.RemoveGroupMemberResponse], error)
+}
+
+// NewGroupsServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewGroupsServiceHandler(svc GroupsServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	groupsServiceMethods := v1.File_holos_console_v1_groups_proto.Services().ByName("GroupsService").Methods()
+	groupsServiceListGroupsHandler := connect.NewUnaryHandler(
+		GroupsServiceListGroupsProcedure,
+		svc.ListGroups,
+		connect.WithSchema(groupsServiceMethods.ByName("ListGroups")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	groupsServiceAddGroupMemberHandler := connect.NewUnaryHandler(
+		GroupsServiceAddGroupMemberProcedure,
+		svc.AddGroupMember,
+		connect.WithSchema(groupsServiceMethods.ByName("AddGroupMember")),
+		connect.WithHandlerOptions(opts...),
+	)
+	groupsServiceRemoveGroupMemberHandler := connect.NewUnaryHandler(
+		GroupsServiceRemoveGroupMemberProcedure,
+		svc.RemoveGroupMember,
+		connect.WithSchema(groupsServiceMethods.ByName("RemoveGroupMember")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/holos.console.v1.GroupsService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case GroupsServiceListGroupsProcedure:
+			groupsServiceListGroupsHandler.ServeHTTP(w, r)
+		case GroupsServiceAddGroupMemberProcedure:
+			groupsServiceAddGroupMemberHandler.ServeHTTP(w, r)
+		case GroupsServiceRemoveGroupMemberProcedure:
+			groupsServiceRemoveGroupMemberHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedGroupsServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedGroupsServiceHandler struct{}
+
+func (UnimplementedGroupsServiceHandler) ListGroups(context.Context, *connect.Request[v1.ListGroupsRequest]) (*connect.Response[v1.ListGroupsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("holos.console.v1.GroupsService.ListGroups is not implemented"))
+}
+
+func (UnimplementedGroupsServiceHandler) AddGroupMember(context.Context, *connect.Request[v1.AddGroupMemberRequest]) (*connect.Response[v1.AddGroupMemberResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("holos.console.v1.GroupsService.AddGroupMember is not implemented"))
+}
+
+func (UnimplementedGroupsServiceHandler) RemoveGroupMember(context.Context, *connect.Request[v1.RemoveGroupMemberRequest]) (*connect.Response[v1.RemoveGroupMemberResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("holos.console.v1.GroupsService.RemoveGroupMember is not implemented"))
+}
@@ -0,0 +1,157 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: holos/console/v1/identity.proto
+
+package consolev1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// IdentityServiceName is the fully-qualified name of the IdentityService service.
+	IdentityServiceName = "holos.console.v1.IdentityService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// IdentityServiceWhoAmIProcedure is the fully-qualified name of the IdentityService's WhoAmI RPC.
+	IdentityServiceWhoAmIProcedure = "/holos.console.v1.IdentityService/WhoAmI"
+	// IdentityServiceGetSessionProcedure is the fully-qualified name of the IdentityService's
+	// GetSession RPC.
+	IdentityServiceGetSessionProcedure = "/holos.console.v1.IdentityService/GetSession"
+)
+
+// IdentityServiceClient is a client for the holos.console.v1.IdentityService service.
+type IdentityServiceClient interface {
+	// WhoAmI returns the caller's claims as resolved by the auth interceptor:
+	// the token's subject, email and groups after the configured roles claim
+	// is applied, the identity the console impersonates in Kubernetes, and
+	// the platform roles the console maps the caller to. It is the supported
+	// way to debug group-claim mapping; any authenticated caller may call it.
+	WhoAmI(context.Context, *connect.Request[v1.WhoAmIRequest]) (*connect.Response[v1.WhoAmIResponse], error)
+	// GetSession reports how long the caller's token remains usable under the
+	// console's session policy (--max-token-age), and which RPCs require a
+	// more recent sign-in (--step-up), so the UI can warn before the session
+	// ends and prompt for sign-in ahead of a step-up RPC.
+	GetSession(context.Context, *connect.Request[v1.GetSessionRequest]) (*connect.Response[v1.GetSessionResponse], error)
+}
+
+// NewIdentityServiceClient constructs a client for the holos.console.v1.IdentityService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewIdentityServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) IdentityServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	identityServiceMethods := v1.File_holos_console_v1_identity_proto.Services().ByName("IdentityService").Methods()
+	return &identityServiceClient{
+		whoAmI: connect.NewClient[v1.WhoAmIRequest, v1.WhoAmIResponse](
+			httpClient,
+			baseURL+IdentityServiceWhoAmIProcedure,
+			connect.WithSchema(identityServiceMethods.ByName("WhoAmI")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getSession: connect.NewClient[v1.GetSessionRequest, v1.GetSessionResponse](
+			httpClient,
+			baseURL+IdentityServiceGetSessionProcedure,
+			connect.WithSchema(identityServiceMethods.ByName("GetSession")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// identityServiceClient implements IdentityServiceClient.
+type identityServiceClient struct {
+	whoAmI     *connect.Client[v1.WhoAmIRequest, v1.WhoAmIResponse]
+	getSession *connect.Client[v1.GetSessionRequest, v1.GetSessionResponse]
+}
+
+// WhoAmI calls holos.console.v1.IdentityService.WhoAmI.
+func (c *identityServiceClient) WhoAmI(ctx context.Context, req *connect.Request[v1.WhoAmIRequest]) (*connect.Response[v1.WhoAmIResponse], error) {
+	return c.whoAmI.CallUnary(ctx, req)
+}
+
+// GetSession calls holos.console.v1.IdentityService.GetSession.
+func (c *identityServiceClient) GetSession(ctx context.Context, req *connect.Request[v1.GetSessionRequest]) (*connect.Response[v1.GetSessionResponse], error) {
+	return c.getSession.CallUnary(ctx, req)
+}
+
+// IdentityServiceHandler is an implementation of the holos.console.v1.IdentityService service.
+type IdentityServiceHandler interface {
+	// WhoAmI returns the caller's claims as resolved by the auth interceptor:
+	// the token's subject, email and groups after the configured roles claim
+	// is applied, the identity the console impersonates in Kubernetes, and
+	// the platform roles the console maps the caller to. It is the supported
+	// way to debug group-claim mapping; any authenticated caller may call it.
+	WhoAmI(context.Context, *connect.Request[v1.WhoAmIRequest]) (*connect.Response[v1.WhoAmIResponse], error)
+	// GetSession reports how long the caller's token remains usable under the
+	// console's session policy (--max-token-age), and which RPCs require a
+	// more recent sign-in (--step-up), so the UI can warn before the session
+	// ends and prompt for sign-in ahead of a step-up RPC.
+	GetSession(context.Context, *connect.Request[v1.GetSessionRequest]) (*connect.Response[v1.GetSessionResponse], error)
+}
+
+// NewIdentityServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewIdentityServiceHandler(svc IdentityServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	identityServiceMethods := v1.File_holos_console_v1_identity_proto.Services().ByName("IdentityService").Methods()
+	identityServiceWhoAmIHandler := connect.NewUnaryHandler(
+		IdentityServiceWhoAmIProcedure,
+		svc.WhoAmI,
+		connect.WithSchema(identityServiceMethods.ByName("WhoAmI")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	identityServiceGetSessionHandler := connect.NewUnaryHandler(
+		IdentityServiceGetSessionProcedure,
+		svc.GetSession,
+		connect.WithSchema(identityServiceMethods.ByName("GetSession")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/holos.console.v1.IdentityService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case IdentityServiceWhoAmIProcedure:
+			identityServiceWhoAmIHandler.ServeHTTP(w, r)
+		case IdentityServiceGetSessionProcedure:
+			identityServiceGetSessionHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedIdentityServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedIdentityServiceHandler struct{}
+
+func (UnimplementedIdentityServiceHandler) WhoAmI(context.Context, *connect.Request[v1.WhoAmIRequest]) (*connect.Response[v1.WhoAmIResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("holos.console.v1.IdentityService.WhoAmI is not implemented"))
+}
+
+func (UnimplementedIdentityServiceHandler) GetSession(context.Context, *connect.Request[v1.GetSessionRequest]) (*connect.Response[v1.GetSessionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("holos.console.v1.IdentityService.GetSession is not implemented"))
+}
@@ -0,0 +1,155 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: holos/console/v1/maintenance.proto
+
+package consolev1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// MaintenanceServiceName is the fully-qualified name of the MaintenanceService service.
+	MaintenanceServiceName = "holos.console.v1.MaintenanceService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// MaintenanceServiceGetMaintenanceProcedure is the fully-qualified name of the MaintenanceService's
+	// GetMaintenance RPC.
+	MaintenanceServiceGetMaintenanceProcedure = "/holos.console.v1.MaintenanceService/GetMaintenance"
+	// MaintenanceServiceSetMaintenanceProcedure is the fully-qualified name of the MaintenanceService's
+	// SetMaintenance RPC.
+	MaintenanceServiceSetMaintenanceProcedure = "/holos.console.v1.MaintenanceService/SetMaintenance"
+)
+
+// MaintenanceServiceClient is a client for the holos.console.v1.MaintenanceService service.
+type MaintenanceServiceClient interface {
+	// GetMaintenance returns the current maintenance state. Any authenticated
+	// caller may call it so the UI can show a banner.
+	GetMaintenance(context.Context, *connect.Request[v1.GetMaintenanceRequest]) (*connect.Response[v1.GetMaintenanceResponse], error)
+	// SetMaintenance enables or disables maintenance mode. Only platform
+	// owners (--platform-owner-users, --platform-owner-roles) may call it, and
+	// it remains callable while maintenance mode is enabled. The state is held
+	// in memory by the replica serving the request; multi-replica deployments
+	// should set --maintenance instead.
+	SetMaintenance(context.Context, *connect.Request[v1.SetMaintenanceRequest]) (*connect.Response[v1.SetMaintenanceResponse], error)
+}
+
+// NewMaintenanceServiceClient constructs a client for the holos.console.v1.MaintenanceService
+// service. By default, it uses the Connect protocol with the binary Protobuf Codec, asks for
+// gzipped responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply
+// the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewMaintenanceServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) MaintenanceServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	maintenanceServiceMethods := v1.File_holos_console_v1_maintenance_proto.Services().ByName("MaintenanceService").Methods()
+	return &maintenanceServiceClient{
+		getMaintenance: connect.NewClient[v1.GetMaintenanceRequest, v1.GetMaintenanceResponse](
+			httpClient,
+			baseURL+MaintenanceServiceGetMaintenanceProcedure,
+			connect.WithSchema(maintenanceServiceMethods.ByName("GetMaintenance")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		setMaintenance: connect.NewClient[v1.SetMaintenanceRequest, v1.SetMaintenanceResponse](
+			httpClient,
+			baseURL+MaintenanceServiceSetMaintenanceProcedure,
+			connect.WithSchema(maintenanceServiceMethods.ByName("SetMaintenance")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// maintenanceServiceClient implements MaintenanceServiceClient.
+type maintenanceServiceClient struct {
+	getMaintenance *connect.Client[v1.GetMaintenanceRequest, v1.GetMaintenanceResponse]
+	setMaintenance *connect.Client[v1.SetMaintenanceRequest, v1.SetMaintenanceResponse]
+}
+
+// GetMaintenance calls holos.console.v1.MaintenanceService.GetMaintenance.
+func (c *maintenanceServiceClient) GetMaintenance(ctx context.Context, req *connect.Request[v1.GetMaintenanceRequest]) (*connect.Response[v1.GetMaintenanceResponse], error) {
+	return c.getMaintenance.CallUnary(ctx, req)
+}
+
+// SetMaintenance calls holos.console.v1.MaintenanceService.SetMaintenance.
+func (c *maintenanceServiceClient) SetMaintenance(ctx context.Context, req *connect.Request[v1.SetMaintenanceRequest]) (*connect.Response[v1.SetMaintenanceResponse], error) {
+	return c.setMaintenance.CallUnary(ctx, req)
+}
+
+// MaintenanceServiceHandler is an implementation of the holos.console.v1.MaintenanceService
+// service.
+type MaintenanceServiceHandler interface {
+	// GetMaintenance returns the current maintenance state. Any authenticated
+	// caller may call it so the UI can show a banner.
+	GetMaintenance(context.Context, *connect.Request[v1.GetMaintenanceRequest]) (*connect.Response[v1.GetMaintenanceResponse], error)
+	// SetMaintenance enables or disables maintenance mode. Only platform
+	// owners (--platform-owner-users, --platform-owner-roles) may call it, and
+	// it remains callable while maintenance mode is enabled. The state is held
+	// in memory by the replica serving the request; multi-replica deployments
+	// should set --maintenance instead.
+	SetMaintenance(context.Context, *connect.Request[v1.SetMaintenanceRequest]) (*connect.Response[v1.SetMaintenanceResponse], error)
+}
+
+// NewMaintenanceServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewMaintenanceServiceHandler(svc MaintenanceServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	maintenanceServiceMethods := v1.File_holos_console_v1_maintenance_proto.Services().ByName("MaintenanceService").Methods()
+	maintenanceServiceGetMaintenanceHandler := connect.NewUnaryHandler(
+		MaintenanceServiceGetMaintenanceProcedure,
+		svc.GetMaintenance,
+		connect.WithSchema(maintenanceServiceMethods.ByName("GetMaintenance")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	maintenanceServiceSetMaintenanceHandler := connect.NewUnaryHandler(
+		MaintenanceServiceSetMaintenanceProcedure,
+		svc.SetMaintenance,
+		connect.WithSchema(maintenanceServiceMethods.ByName("SetMaintenance")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/holos.console.v1.MaintenanceService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case MaintenanceServiceGetMaintenanceProcedure:
+			maintenanceServiceGetMaintenanceHandler.ServeHTTP(w, r)
+		case MaintenanceServiceSetMaintenanceProcedure:
+			maintenanceServiceSetMaintenanceHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedMaintenanceServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedMaintenanceServiceHandler struct{}
+
+func (UnimplementedMaintenanceServiceHandler) GetMaintenance(context.Context, *connect.Request[v1.GetMaintenanceRequest]) (*connect.Response[v1.GetMaintenanceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("holos.console.v1.MaintenanceService.GetMaintenance is not implemented"))
+}
+
+func (UnimplementedMaintenanceServiceHandler) SetMaintenance(context.Context, *connect.Request[v1.SetMaintenanceRequest]) (*connect.Response[v1.SetMaintenanceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("holos.console.v1.MaintenanceService.SetMaintenance is not implemented"))
+}
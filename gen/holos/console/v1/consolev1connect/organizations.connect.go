@@ -57,6 +57,12 @@ const (
 	// OrganizationServiceUpdateOrganizationDefaultSharingProcedure is the fully-qualified name of the
 	// OrganizationService's UpdateOrganizationDefaultSharing RPC.
 	OrganizationServiceUpdateOrganizationDefaultSharingProcedure = "/holos.console.v1.OrganizationService/UpdateOrganizationDefaultSharing"
+	// OrganizationServiceGetOrganizationStatsProcedure is the fully-qualified name of the
+	// OrganizationService's GetOrganizationStats RPC.
+	OrganizationServiceGetOrganizationStatsProcedure = "/holos.console.v1.OrganizationService/GetOrganizationStats"
+	// OrganizationServiceListOrganizationMembersProcedure is the fully-qualified name of the
+	// OrganizationService's ListOrganizationMembers RPC.
+	OrganizationServiceListOrganizationMembersProcedure = "/holos.console.v1.OrganizationService/ListOrganizationMembers"
 )
 
 // OrganizationServiceClient is a client for the holos.console.v1.OrganizationService service.
@@ -76,15 +82,27 @@ type OrganizationServiceClient interface {
 	DeleteOrganization(context.Context, *connect.Request[v1.DeleteOrganizationRequest]) (*connect.Response[v1.DeleteOrganizationResponse], error)
 	// UpdateOrganizationSharing updates the sharing grants on an organization.
 	// Requires PERMISSION_ORGANIZATIONS_ADMIN on the organization.
+	// A grant set without an active owner fails with FailedPrecondition unless
+	// a platform owner sets force.
 	UpdateOrganizationSharing(context.Context, *connect.Request[v1.UpdateOrganizationSharingRequest]) (*connect.Response[v1.UpdateOrganizationSharingResponse], error)
-	// GetOrganizationRaw retrieves the full Kubernetes Namespace object as verbatim JSON.
-	// The backend returns the Namespace exactly as the K8s API provides it, with no
-	// field filtering. Requires authentication and PERMISSION_ORGANIZATIONS_READ.
+	// GetOrganizationRaw retrieves the full Kubernetes Namespace object as JSON or YAML.
+	// The backend returns the Namespace exactly as the K8s API provides it unless
+	// strip_managed_fields is set. Requires authentication and
+	// PERMISSION_ORGANIZATIONS_READ.
 	GetOrganizationRaw(context.Context, *connect.Request[v1.GetOrganizationRawRequest]) (*connect.Response[v1.GetOrganizationRawResponse], error)
 	// UpdateOrganizationDefaultSharing updates the default sharing grants on an organization.
 	// These grants are applied by default to new projects created in this organization.
 	// Requires PERMISSION_ORGANIZATIONS_ADMIN on the organization.
 	UpdateOrganizationDefaultSharing(context.Context, *connect.Request[v1.UpdateOrganizationDefaultSharingRequest]) (*connect.Response[v1.UpdateOrganizationDefaultSharingResponse], error)
+	// GetOrganizationStats returns usage counts and a summary of recent
+	// activity for an organization overview page. Counts cover the whole
+	// organization, not only the resources the caller can see.
+	// Requires PERMISSION_ORGANIZATIONS_READ.
+	GetOrganizationStats(context.Context, *connect.Request[v1.GetOrganizationStatsRequest]) (*connect.Response[v1.GetOrganizationStatsResponse], error)
+	// ListOrganizationMembers lists the distinct users and groups granted a
+	// role anywhere in an organization: on the organization, its folders, its
+	// projects, or their secrets. Requires PERMISSION_ORGANIZATIONS_ADMIN.
+	ListOrganizationMembers(context.Context, *connect.Request[v1.ListOrganizationMembersRequest]) (*connect.Response[v1.ListOrganizationMembersResponse], error)
 }
 
 // NewOrganizationServiceClient constructs a client for the holos.console.v1.OrganizationService
@@ -102,12 +120,14 @@ func NewOrganizationServiceClient(httpClient connect.HTTPClient, baseURL string,
 			httpClient,
 			baseURL+OrganizationServiceListOrganizationsProcedure,
 			connect.WithSchema(organizationServiceMethods.ByName("ListOrganizations")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 		getOrganization: connect.NewClient[v1.GetOrganizationRequest, v1.GetOrganizationResponse](
 			httpClient,
 			baseURL+OrganizationServiceGetOrganizationProcedure,
 			connect.WithSchema(organizationServiceMethods.ByName("GetOrganization")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 		createOrganization: connect.NewClient[v1.CreateOrganizationRequest, v1.CreateOrganizationResponse](
@@ -138,6 +158,7 @@ func NewOrganizationServiceClient(httpClient connect.HTTPClient, baseURL string,
 			httpClient,
 			baseURL+OrganizationServiceGetOrganizationRawProcedure,
 			connect.WithSchema(organizationServiceMethods.ByName("GetOrganizationRaw")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 		updateOrganizationDefaultSharing: connect.NewClient[v1.UpdateOrganizationDefaultSharingRequest, v1.UpdateOrganizationDefaultSharingResponse](
@@ -146,6 +167,20 @@ func NewOrganizationServiceClient(httpClient connect.HTTPClient, baseURL string,
 			connect.WithSchema(organizationServiceMethods.ByName("UpdateOrganizationDefaultSharing")),
 			connect.WithClientOptions(opts...),
 		),
+		getOrganizationStats: connect.NewClient[v1.GetOrganizationStatsRequest, v1.GetOrganizationStatsResponse](
+			httpClient,
+			baseURL+OrganizationServiceGetOrganizationStatsProcedure,
+			connect.WithSchema(organizationServiceMethods.ByName("GetOrganizationStats")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		listOrganizationMembers: connect.NewClient[v1.ListOrganizationMembersRequest, v1.ListOrganizationMembersResponse](
+			httpClient,
+			baseURL+OrganizationServiceListOrganizationMembersProcedure,
+			connect.WithSchema(organizationServiceMethods.ByName("ListOrganizationMembers")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -159,6 +194,8 @@ type organizationServiceClient struct {
 	updateOrganizationSharing        *connect.Client[v1.UpdateOrganizationSharingRequest, v1.UpdateOrganizationSharingResponse]
 	getOrganizationRaw               *connect.Client[v1.GetOrganizationRawRequest, v1.GetOrganizationRawResponse]
 	updateOrganizationDefaultSharing *connect.Client[v1.UpdateOrganizationDefaultSharingRequest, v1.UpdateOrganizationDefaultSharingResponse]
+	getOrganizationStats             *connect.Client[v1.GetOrganizationStatsRequest, v1.GetOrganizationStatsResponse]
+	listOrganizationMembers          *connect.Client[v1.ListOrganizationMembersRequest, v1.ListOrganizationMembersResponse]
 }
 
 // ListOrganizations calls holos.console.v1.OrganizationService.ListOrganizations.
@@ -202,6 +239,16 @@ func (c *organizationServiceClient) UpdateOrganizationDefaultSharing(ctx context
 	return c.updateOrganizationDefaultSharing.CallUnary(ctx, req)
 }
 
+// GetOrganizationStats calls holos.console.v1.OrganizationService.GetOrganizationStats.
+func (c *organizationServiceClient) GetOrganizationStats(ctx context.Context, req *connect.Request[v1.GetOrganizationStatsRequest]) (*connect.Response[v1.GetOrganizationStatsResponse], error) {
+	return c.getOrganizationStats.CallUnary(ctx, req)
+}
+
+// ListOrganizationMembers calls holos.console.v1.OrganizationService.ListOrganizationMembers.
+func (c *organizationServiceClient) ListOrganizationMembers(ctx context.Context, req *connect.Request[v1.ListOrganizationMembersRequest]) (*connect.Response[v1.ListOrganizationMembersResponse], error) {
+	return c.listOrganizationMembers.CallUnary(ctx, req)
+}
+
 // OrganizationServiceHandler is an implementation of the holos.console.v1.OrganizationService
 // service.
 type OrganizationServiceHandler interface {
@@ -220,15 +267,27 @@ type OrganizationServiceHandler interface {
 	DeleteOrganization(context.Context, *connect.Request[v1.DeleteOrganizationRequest]) (*connect.Response[v1.DeleteOrganizationResponse], error)
 	// UpdateOrganizationSharing updates the sharing grants on an organization.
 	// Requires PERMISSION_ORGANIZATIONS_ADMIN on the organization.
+	// A grant set without an active owner fails with FailedPrecondition unless
+	// a platform owner sets force.
 	UpdateOrganizationSharing(context.Context, *connect.Request[v1.UpdateOrganizationSharingRequest]) (*connect.Response[v1.UpdateOrganizationSharingResponse], error)
-	// GetOrganizationRaw retrieves the full Kubernetes Namespace object as verbatim JSON.
-	// The backend returns the Namespace exactly as the K8s API provides it, with no
-	// field filtering. Requires authentication and PERMISSION_ORGANIZATIONS_READ.
+	// GetOrganizationRaw retrieves the full Kubernetes Namespace object as JSON or YAML.
+	// The backend returns the Namespace exactly as the K8s API provides it unless
+	// strip_managed_fields is set. Requires authentication and
+	// PERMISSION_ORGANIZATIONS_READ.
 	GetOrganizationRaw(context.Context, *connect.Request[v1.GetOrganizationRawRequest]) (*connect.Response[v1.GetOrganizationRawResponse], error)
 	// UpdateOrganizationDefaultSharing updates the default sharing grants on an organization.
 	// These grants are applied by default to new projects created in this organization.
 	// Requires PERMISSION_ORGANIZATIONS_ADMIN on the organization.
 	UpdateOrganizationDefaultSharing(context.Context, *connect.Request[v1.UpdateOrganizationDefaultSharingRequest]) (*connect.Response[v1.UpdateOrganizationDefaultSharingResponse], error)
+	// GetOrganizationStats returns usage counts and a summary of recent
+	// activity for an organization overview page. Counts cover the whole
+	// organization, not only the resources the caller can see.
+	// Requires PERMISSION_ORGANIZATIONS_READ.
+	GetOrganizationStats(context.Context, *connect.Request[v1.GetOrganizationStatsRequest]) (*connect.Response[v1.GetOrganizationStatsResponse], error)
+	// ListOrganizationMembers lists the distinct users and groups granted a
+	// role anywhere in an organization: on the organization, its folders, its
+	// projects, or their secrets. Requires PERMISSION_ORGANIZATIONS_ADMIN.
+	ListOrganizationMembers(context.Context, *connect.Request[v1.ListOrganizationMembersRequest]) (*connect.Response[v1.ListOrganizationMembersResponse], error)
 }
 
 // NewOrganizationServiceHandler builds an HTTP handler from the service implementation. It returns
@@ -242,12 +301,14 @@ func NewOrganizationServiceHandler(svc OrganizationServiceHandler, opts ...conne
 		OrganizationServiceListOrganizationsProcedure,
 		svc.ListOrganizations,
 		connect.WithSchema(organizationServiceMethods.ByName("ListOrganizations")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	organizationServiceGetOrganizationHandler := connect.NewUnaryHandler(
 		OrganizationServiceGetOrganizationProcedure,
 		svc.GetOrganization,
 		connect.WithSchema(organizationServiceMethods.ByName("GetOrganization")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	organizationServiceCreateOrganizationHandler := connect.NewUnaryHandler(
@@ -278,6 +339,7 @@ func NewOrganizationServiceHandler(svc OrganizationServiceHandler, opts ...conne
 		OrganizationServiceGetOrganizationRawProcedure,
 		svc.GetOrganizationRaw,
 		connect.WithSchema(organizationServiceMethods.ByName("GetOrganizationRaw")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	organizationServiceUpdateOrganizationDefaultSharingHandler := connect.NewUnaryHandler(
@@ -286,6 +348,20 @@ func NewOrganizationServiceHandler(svc OrganizationServiceHandler, opts ...conne
 		connect.WithSchema(organizationServiceMethods.ByName("UpdateOrganizationDefaultSharing")),
 		connect.WithHandlerOptions(opts...),
 	)
+	organizationServiceGetOrganizationStatsHandler := connect.NewUnaryHandler(
+		OrganizationServiceGetOrganizationStatsProcedure,
+		svc.GetOrganizationStats,
+		connect.WithSchema(organizationServiceMethods.ByName("GetOrganizationStats")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	organizationServiceListOrganizationMembersHandler := connect.NewUnaryHandler(
+		OrganizationServiceListOrganizationMembersProcedure,
+		svc.ListOrganizationMembers,
+		connect.WithSchema(organizationServiceMethods.ByName("ListOrganizationMembers")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/holos.console.v1.OrganizationService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case OrganizationServiceListOrganizationsProcedure:
@@ -304,6 +380,10 @@ func NewOrganizationServiceHandler(svc OrganizationServiceHandler, opts ...conne
 			organizationServiceGetOrganizationRawHandler.ServeHTTP(w, r)
 		case OrganizationServiceUpdateOrganizationDefaultSharingProcedure:
 			organizationServiceUpdateOrganizationDefaultSharingHandler.ServeHTTP(w, r)
+		case OrganizationServiceGetOrganizationStatsProcedure:
+			organizationServiceGetOrganizationStatsHandler.ServeHTTP(w, r)
+		case OrganizationServiceListOrganizationMembersProcedure:
+			organizationServiceListOrganizationMembersHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -344,3 +424,11 @@ func (UnimplementedOrganizationServiceHandler) GetOrganizationRaw(context.Contex
 func (UnimplementedOrganizationServiceHandler) UpdateOrganizationDefaultSharing(context.Context, *connect.Request[v1.UpdateOrganizationDefaultSharingRequest]) (*connect.Response[v1.UpdateOrganizationDefaultSharingResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("holos.console.v1.OrganizationService.UpdateOrganizationDefaultSharing is not implemented"))
 }
+
+func (UnimplementedOrganizationServiceHandler) GetOrganizationStats(context.Context, *connect.Request[v1.GetOrganizationStatsRequest]) (*connect.Response[v1.GetOrganizationStatsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("holos.console.v1.OrganizationService.GetOrganizationStats is not implemented"))
+}
+
+func (UnimplementedOrganizationServiceHandler) ListOrganizationMembers(context.Context, *connect.Request[v1.ListOrganizationMembersRequest]) (*connect.Response[v1.ListOrganizationMembersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("holos.console.v1.OrganizationService.ListOrganizationMembers is not implemented"))
+}
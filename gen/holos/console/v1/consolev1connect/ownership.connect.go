@@ -0,0 +1,117 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: holos/console/v1/ownership.proto
+
+package consolev1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// OwnershipServiceName is the fully-qualified name of the OwnershipService service.
+	OwnershipServiceName = "holos.console.v1.OwnershipService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// OwnershipServiceTransferOwnershipProcedure is the fully-qualified name of the OwnershipService's
+	// TransferOwnership RPC.
+	OwnershipServiceTransferOwnershipProcedure = "/holos.console.v1.OwnershipService/TransferOwnership"
+)
+
+// OwnershipServiceClient is a client for the holos.console.v1.OwnershipService service.
+type OwnershipServiceClient interface {
+	// TransferOwnership grants owner to new_owner and, when caller_role is
+	// set, demotes the caller's user grant to it. Requires owner access to the
+	// resource, checked exactly as the resource's sharing update checks it.
+	// Emits an ownership_transfer audit event.
+	TransferOwnership(context.Context, *connect.Request[v1.TransferOwnershipRequest]) (*connect.Response[v1.TransferOwnershipResponse], error)
+}
+
+// NewOwnershipServiceClient constructs a client for the holos.console.v1.OwnershipService service.
+// By default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped
+// responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewOwnershipServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) OwnershipServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	ownershipServiceMethods := v1.File_holos_console_v1_ownership_proto.Services().ByName("OwnershipService").Methods()
+	return &ownershipServiceClient{
+		transferOwnership: connect.NewClient[v1.TransferOwnershipRequest, v1.TransferOwnershipResponse](
+			httpClient,
+			baseURL+OwnershipServiceTransferOwnershipProcedure,
+			connect.WithSchema(ownershipServiceMethods.ByName("TransferOwnership")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// ownershipServiceClient implements OwnershipServiceClient.
+type ownershipServiceClient struct {
+	transferOwnership *connect.Client[v1.TransferOwnershipRequest, v1.TransferOwnershipResponse]
+}
+
+// TransferOwnership calls holos.console.v1.OwnershipService.TransferOwnership.
+func (c *ownershipServiceClient) TransferOwnership(ctx context.Context, req *connect.Request[v1.TransferOwnershipRequest]) (*connect.Response[v1.TransferOwnershipResponse], error) {
+	return c.transferOwnership.CallUnary(ctx, req)
+}
+
+// OwnershipServiceHandler is an implementation of the holos.console.v1.OwnershipService service.
+type OwnershipServiceHandler interface {
+	// TransferOwnership grants owner to new_owner and, when caller_role is
+	// set, demotes the caller's user grant to it. Requires owner access to the
+	// resource, checked exactly as the resource's sharing update checks it.
+	// Emits an ownership_transfer audit event.
+	TransferOwnership(context.Context, *connect.Request[v1.TransferOwnershipRequest]) (*connect.Response[v1.TransferOwnershipResponse], error)
+}
+
+// NewOwnershipServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewOwnershipServiceHandler(svc OwnershipServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	ownershipServiceMethods := v1.File_holos_console_v1_ownership_proto.Services().ByName("OwnershipService").Methods()
+	ownershipServiceTransferOwnershipHandler := connect.NewUnaryHandler(
+		OwnershipServiceTransferOwnershipProcedure,
+		svc.TransferOwnership,
+		connect.WithSchema(ownershipServiceMethods.ByName("TransferOwnership")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/holos.console.v1.OwnershipService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case OwnershipServiceTransferOwnershipProcedure:
+			ownershipServiceTransferOwnershipHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedOwnershipServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedOwnershipServiceHandler struct{}
+
+func (UnimplementedOwnershipServiceHandler) TransferOwnership(context.Context, *connect.Request[v1.TransferOwnershipRequest]) (*connect.Response[v1.TransferOwnershipResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("holos.console.v1.OwnershipService.TransferOwnership is not implemented"))
+}
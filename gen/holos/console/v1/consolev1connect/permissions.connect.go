@@ -36,6 +36,14 @@ const (
 	// PermissionsServiceListResourcePermissionsProcedure is the fully-qualified name of the
 	// PermissionsService's ListResourcePermissions RPC.
 	PermissionsServiceListResourcePermissionsProcedure = "/holos.console.v1.PermissionsService/ListResourcePermissions"
+	// PermissionsServiceGetAccessReviewProcedure is the fully-qualified name of the
+	// PermissionsService's GetAccessReview RPC.
+	PermissionsServiceGetAccessReviewProcedure = "/holos.console.v1.PermissionsService/GetAccessReview"
+	// PermissionsServiceCanIProcedure is the fully-qualified name of the PermissionsService's CanI RPC.
+	PermissionsServiceCanIProcedure = "/holos.console.v1.PermissionsService/CanI"
+	// PermissionsServiceListMyGroupsProcedure is the fully-qualified name of the PermissionsService's
+	// ListMyGroups RPC.
+	PermissionsServiceListMyGroupsProcedure = "/holos.console.v1.PermissionsService/ListMyGroups"
 )
 
 // PermissionsServiceClient is a client for the holos.console.v1.PermissionsService service.
@@ -45,6 +53,27 @@ type PermissionsServiceClient interface {
 	// each decision by "verb:group/resource[:namespace[:name]]" so the frontend
 	// can look up a single button's allowed status in O(1).
 	ListResourcePermissions(context.Context, *connect.Request[v1.ListResourcePermissionsRequest]) (*connect.Response[v1.ListResourcePermissionsResponse], error)
+	// GetAccessReview answers "who can access this resource?" for a secret,
+	// project, folder, or organization. The backend resolves every RoleBinding
+	// in the resource's namespace and every ClusterRoleBinding, so per-resource
+	// sharing, namespace-wide grants, and platform groups bound cluster-wide
+	// are all reported with the highest role each principal holds.
+	// Requires owner access, checked as the "delete" verb on the resource.
+	GetAccessReview(context.Context, *connect.Request[v1.GetAccessReviewRequest]) (*connect.Response[v1.GetAccessReviewResponse], error)
+	// CanI reports whether the caller holds a console Permission on a secret,
+	// project, folder, or organization. The backend translates the permission
+	// into the same SelfSubjectAccessReview the owning handler relies on, so
+	// the frontend does not need to know which Kubernetes verb and resource
+	// back each permission.
+	CanI(context.Context, *connect.Request[v1.CanIRequest]) (*connect.Response[v1.CanIResponse], error)
+	// ListMyGroups reports the groups the caller's ID token carries, the
+	// oidc:-prefixed principal each is forwarded to the API server as, and
+	// every RoleBinding and ClusterRoleBinding that names the group. It is a
+	// self-service answer to "I'm in the right group but denied": a group
+	// with no grants is either missing from the bindings or misspelled in the
+	// IdP. Any authenticated caller may list their own groups; bindings are
+	// read with the console's service account.
+	ListMyGroups(context.Context, *connect.Request[v1.ListMyGroupsRequest]) (*connect.Response[v1.ListMyGroupsResponse], error)
 }
 
 // NewPermissionsServiceClient constructs a client for the holos.console.v1.PermissionsService
@@ -64,12 +93,36 @@ func NewPermissionsServiceClient(httpClient connect.HTTPClient, baseURL string,
 			connect.WithSchema(permissionsServiceMethods.ByName("ListResourcePermissions")),
 			connect.WithClientOptions(opts...),
 		),
+		getAccessReview: connect.NewClient[v1.GetAccessReviewRequest, v1.GetAccessReviewResponse](
+			httpClient,
+			baseURL+PermissionsServiceGetAccessReviewProcedure,
+			connect.WithSchema(permissionsServiceMethods.ByName("GetAccessReview")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		canI: connect.NewClient[v1.CanIRequest, v1.CanIResponse](
+			httpClient,
+			baseURL+PermissionsServiceCanIProcedure,
+			connect.WithSchema(permissionsServiceMethods.ByName("CanI")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		listMyGroups: connect.NewClient[v1.ListMyGroupsRequest, v1.ListMyGroupsResponse](
+			httpClient,
+			baseURL+PermissionsServiceListMyGroupsProcedure,
+			connect.WithSchema(permissionsServiceMethods.ByName("ListMyGroups")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // permissionsServiceClient implements PermissionsServiceClient.
 type permissionsServiceClient struct {
 	listResourcePermissions *connect.Client[v1.ListResourcePermissionsRequest, v1.ListResourcePermissionsResponse]
+	getAccessReview         *connect.Client[v1.GetAccessReviewRequest, v1.GetAccessReviewResponse]
+	canI                    *connect.Client[v1.CanIRequest, v1.CanIResponse]
+	listMyGroups            *connect.Client[v1.ListMyGroupsRequest, v1.ListMyGroupsResponse]
 }
 
 // ListResourcePermissions calls holos.console.v1.PermissionsService.ListResourcePermissions.
@@ -77,6 +130,21 @@ func (c *permissionsServiceClient) ListResourcePermissions(ctx context.Context,
 	return c.listResourcePermissions.CallUnary(ctx, req)
 }
 
+// GetAccessReview calls holos.console.v1.PermissionsService.GetAccessReview.
+func (c *permissionsServiceClient) GetAccessReview(ctx context.Context, req *connect.Request[v1.GetAccessReviewRequest]) (*connect.Response[v1.GetAccessReviewResponse], error) {
+	return c.getAccessReview.CallUnary(ctx, req)
+}
+
+// CanI calls holos.console.v1.PermissionsService.CanI.
+func (c *permissionsServiceClient) CanI(ctx context.Context, req *connect.Request[v1.CanIRequest]) (*connect.Response[v1.CanIResponse], error) {
+	return c.canI.CallUnary(ctx, req)
+}
+
+// ListMyGroups calls holos.console.v1.PermissionsService.ListMyGroups.
+func (c *permissionsServiceClient) ListMyGroups(ctx context.Context, req *connect.Request[v1.ListMyGroupsRequest]) (*connect.Response[v1.ListMyGroupsResponse], error) {
+	return c.listMyGroups.CallUnary(ctx, req)
+}
+
 // PermissionsServiceHandler is an implementation of the holos.console.v1.PermissionsService
 // service.
 type PermissionsServiceHandler interface {
@@ -85,6 +153,27 @@ type PermissionsServiceHandler interface {
 	// each decision by "verb:group/resource[:namespace[:name]]" so the frontend
 	// can look up a single button's allowed status in O(1).
 	ListResourcePermissions(context.Context, *connect.Request[v1.ListResourcePermissionsRequest]) (*connect.Response[v1.ListResourcePermissionsResponse], error)
+	// GetAccessReview answers "who can access this resource?" for a secret,
+	// project, folder, or organization. The backend resolves every RoleBinding
+	// in the resource's namespace and every ClusterRoleBinding, so per-resource
+	// sharing, namespace-wide grants, and platform groups bound cluster-wide
+	// are all reported with the highest role each principal holds.
+	// Requires owner access, checked as the "delete" verb on the resource.
+	GetAccessReview(context.Context, *connect.Request[v1.GetAccessReviewRequest]) (*connect.Response[v1.GetAccessReviewResponse], error)
+	// CanI reports whether the caller holds a console Permission on a secret,
+	// project, folder, or organization. The backend translates the permission
+	// into the same SelfSubjectAccessReview the owning handler relies on, so
+	// the frontend does not need to know which Kubernetes verb and resource
+	// back each permission.
+	CanI(context.Context, *connect.Request[v1.CanIRequest]) (*connect.Response[v1.CanIResponse], error)
+	// ListMyGroups reports the groups the caller's ID token carries, the
+	// oidc:-prefixed principal each is forwarded to the API server as, and
+	// every RoleBinding and ClusterRoleBinding that names the group. It is a
+	// self-service answer to "I'm in the right group but denied": a group
+	// with no grants is either missing from the bindings or misspelled in the
+	// IdP. Any authenticated caller may list their own groups; bindings are
+	// read with the console's service account.
+	ListMyGroups(context.Context, *connect.Request[v1.ListMyGroupsRequest]) (*connect.Response[v1.ListMyGroupsResponse], error)
 }
 
 // NewPermissionsServiceHandler builds an HTTP handler from the service implementation. It returns
@@ -100,10 +189,37 @@ func NewPermissionsServiceHandler(svc PermissionsServiceHandler, opts ...connect
 		connect.WithSchema(permissionsServiceMethods.ByName("ListResourcePermissions")),
 		connect.WithHandlerOptions(opts...),
 	)
+	permissionsServiceGetAccessReviewHandler := connect.NewUnaryHandler(
+		PermissionsServiceGetAccessReviewProcedure,
+		svc.GetAccessReview,
+		connect.WithSchema(permissionsServiceMethods.ByName("GetAccessReview")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	permissionsServiceCanIHandler := connect.NewUnaryHandler(
+		PermissionsServiceCanIProcedure,
+		svc.CanI,
+		connect.WithSchema(permissionsServiceMethods.ByName("CanI")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	permissionsServiceListMyGroupsHandler := connect.NewUnaryHandler(
+		PermissionsServiceListMyGroupsProcedure,
+		svc.ListMyGroups,
+		connect.WithSchema(permissionsServiceMethods.ByName("ListMyGroups")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/holos.console.v1.PermissionsService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case PermissionsServiceListResourcePermissionsProcedure:
 			permissionsServiceListResourcePermissionsHandler.ServeHTTP(w, r)
+		case PermissionsServiceGetAccessReviewProcedure:
+			permissionsServiceGetAccessReviewHandler.ServeHTTP(w, r)
+		case PermissionsServiceCanIProcedure:
+			permissionsServiceCanIHandler.ServeHTTP(w, r)
+		case PermissionsServiceListMyGroupsProcedure:
+			permissionsServiceListMyGroupsHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -116,3 +232,15 @@ type UnimplementedPermissionsServiceHandler struct{}
 func (UnimplementedPermissionsServiceHandler) ListResourcePermissions(context.Context, *connect.Request[v1.ListResourcePermissionsRequest]) (*connect.Response[v1.ListResourcePermissionsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("holos.console.v1.PermissionsService.ListResourcePermissions is not implemented"))
 }
+
+func (UnimplementedPermissionsServiceHandler) GetAccessReview(context.Context, *connect.Request[v1.GetAccessReviewRequest]) (*connect.Response[v1.GetAccessReviewResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("holos.console.v1.PermissionsService.GetAccessReview is not implemented"))
+}
+
+func (UnimplementedPermissionsServiceHandler) CanI(context.Context, *connect.Request[v1.CanIRequest]) (*connect.Response[v1.CanIResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("holos.console.v1.PermissionsService.CanI is not implemented"))
+}
+
+func (UnimplementedPermissionsServiceHandler) ListMyGroups(context.Context, *connect.Request[v1.ListMyGroupsRequest]) (*connect.Response[v1.ListMyGroupsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("holos.console.v1.PermissionsService.ListMyGroups is not implemented"))
+}
@@ -72,6 +72,7 @@ func NewProjectSettingsServiceClient(httpClient connect.HTTPClient, baseURL stri
 			httpClient,
 			baseURL+ProjectSettingsServiceGetProjectSettingsProcedure,
 			connect.WithSchema(projectSettingsServiceMethods.ByName("GetProjectSettings")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 		updateProjectSettings: connect.NewClient[v1.UpdateProjectSettingsRequest, v1.UpdateProjectSettingsResponse](
@@ -84,6 +85,7 @@ func NewProjectSettingsServiceClient(httpClient connect.HTTPClient, baseURL stri
 			httpClient,
 			baseURL+ProjectSettingsServiceGetProjectSettingsRawProcedure,
 			connect.WithSchema(projectSettingsServiceMethods.ByName("GetProjectSettingsRaw")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 	}
@@ -136,6 +138,7 @@ func NewProjectSettingsServiceHandler(svc ProjectSettingsServiceHandler, opts ..
 		ProjectSettingsServiceGetProjectSettingsProcedure,
 		svc.GetProjectSettings,
 		connect.WithSchema(projectSettingsServiceMethods.ByName("GetProjectSettings")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	projectSettingsServiceUpdateProjectSettingsHandler := connect.NewUnaryHandler(
@@ -148,6 +151,7 @@ func NewProjectSettingsServiceHandler(svc ProjectSettingsServiceHandler, opts ..
 		ProjectSettingsServiceGetProjectSettingsRawProcedure,
 		svc.GetProjectSettingsRaw,
 		connect.WithSchema(projectSettingsServiceMethods.ByName("GetProjectSettingsRaw")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	return "/holos.console.v1.ProjectSettingsService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
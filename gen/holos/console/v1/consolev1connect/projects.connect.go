@@ -45,6 +45,9 @@ const (
 	// ProjectServiceUpdateProjectProcedure is the fully-qualified name of the ProjectService's
 	// UpdateProject RPC.
 	ProjectServiceUpdateProjectProcedure = "/holos.console.v1.ProjectService/UpdateProject"
+	// ProjectServiceDiffProjectProcedure is the fully-qualified name of the ProjectService's
+	// DiffProject RPC.
+	ProjectServiceDiffProjectProcedure = "/holos.console.v1.ProjectService/DiffProject"
 	// ProjectServiceDeleteProjectProcedure is the fully-qualified name of the ProjectService's
 	// DeleteProject RPC.
 	ProjectServiceDeleteProjectProcedure = "/holos.console.v1.ProjectService/DeleteProject"
@@ -60,6 +63,21 @@ const (
 	// ProjectServiceCheckProjectIdentifierProcedure is the fully-qualified name of the ProjectService's
 	// CheckProjectIdentifier RPC.
 	ProjectServiceCheckProjectIdentifierProcedure = "/holos.console.v1.ProjectService/CheckProjectIdentifier"
+	// ProjectServiceListProjectResourcesProcedure is the fully-qualified name of the ProjectService's
+	// ListProjectResources RPC.
+	ProjectServiceListProjectResourcesProcedure = "/holos.console.v1.ProjectService/ListProjectResources"
+	// ProjectServiceListProjectEventsProcedure is the fully-qualified name of the ProjectService's
+	// ListProjectEvents RPC.
+	ProjectServiceListProjectEventsProcedure = "/holos.console.v1.ProjectService/ListProjectEvents"
+	// ProjectServiceListDeletedProjectsProcedure is the fully-qualified name of the ProjectService's
+	// ListDeletedProjects RPC.
+	ProjectServiceListDeletedProjectsProcedure = "/holos.console.v1.ProjectService/ListDeletedProjects"
+	// ProjectServiceRestoreProjectProcedure is the fully-qualified name of the ProjectService's
+	// RestoreProject RPC.
+	ProjectServiceRestoreProjectProcedure = "/holos.console.v1.ProjectService/RestoreProject"
+	// ProjectServiceCreateProjectTokenProcedure is the fully-qualified name of the ProjectService's
+	// CreateProjectToken RPC.
+	ProjectServiceCreateProjectTokenProcedure = "/holos.console.v1.ProjectService/CreateProjectToken"
 )
 
 // ProjectServiceClient is a client for the holos.console.v1.ProjectService service.
@@ -74,15 +92,24 @@ type ProjectServiceClient interface {
 	// UpdateProject updates project metadata (description, display name).
 	// Requires PERMISSION_PROJECTS_WRITE on the project.
 	UpdateProject(context.Context, *connect.Request[v1.UpdateProjectRequest]) (*connect.Response[v1.UpdateProjectResponse], error)
+	// DiffProject previews an UpdateProject without persisting it, reporting
+	// the metadata fields the update would change.
+	// Requires read access to the project.
+	DiffProject(context.Context, *connect.Request[v1.DiffProjectRequest]) (*connect.Response[v1.DiffProjectResponse], error)
 	// DeleteProject deletes a managed namespace.
-	// Requires PERMISSION_PROJECTS_DELETE on the project.
+	// Requires PERMISSION_PROJECTS_DELETE on the project. When the console runs
+	// with a trash retention window the project is soft-deleted: it is hidden
+	// and its grants are stripped until RestoreProject or the window passes.
 	DeleteProject(context.Context, *connect.Request[v1.DeleteProjectRequest]) (*connect.Response[v1.DeleteProjectResponse], error)
 	// UpdateProjectSharing updates the sharing grants on a project.
 	// Requires PERMISSION_PROJECTS_ADMIN on the project.
+	// A grant set without an active owner fails with FailedPrecondition unless
+	// a platform owner sets force.
 	UpdateProjectSharing(context.Context, *connect.Request[v1.UpdateProjectSharingRequest]) (*connect.Response[v1.UpdateProjectSharingResponse], error)
-	// GetProjectRaw retrieves the full Kubernetes Namespace object as verbatim JSON.
-	// The backend returns the Namespace exactly as the K8s API provides it, with no
-	// field filtering. Requires authentication and PERMISSION_PROJECTS_READ.
+	// GetProjectRaw retrieves the full Kubernetes Namespace object as JSON or YAML.
+	// The backend returns the Namespace exactly as the K8s API provides it unless
+	// strip_managed_fields is set. Requires authentication and
+	// PERMISSION_PROJECTS_READ.
 	GetProjectRaw(context.Context, *connect.Request[v1.GetProjectRawRequest]) (*connect.Response[v1.GetProjectRawResponse], error)
 	// UpdateProjectDefaultSharing updates the default sharing grants on a project.
 	// These grants are applied by default to new secrets created in this project.
@@ -93,6 +120,27 @@ type ProjectServiceClient interface {
 	// alternative with a random 6-digit suffix appended. The suggestion is NOT
 	// reserved -- the Create RPC handles the race with retry logic.
 	CheckProjectIdentifier(context.Context, *connect.Request[v1.CheckProjectIdentifierRequest]) (*connect.Response[v1.CheckProjectIdentifierResponse], error)
+	// ListProjectResources summarizes the workloads (Deployments, StatefulSets,
+	// Services, and Ingresses) running in the project namespace.
+	// Requires PERMISSION_RESOURCES_READ on the project. Kinds the caller may
+	// not list are omitted from the response.
+	ListProjectResources(context.Context, *connect.Request[v1.ListProjectResourcesRequest]) (*connect.Response[v1.ListProjectResourcesResponse], error)
+	// ListProjectEvents returns recent Kubernetes Events in the project
+	// namespace, most recent first, so users can see why a workload is failing.
+	// Requires PERMISSION_PROJECTS_READ on the project.
+	ListProjectEvents(context.Context, *connect.Request[v1.ListProjectEventsRequest]) (*connect.Response[v1.ListProjectEventsResponse], error)
+	// ListDeletedProjects lists the soft-deleted projects the caller owned
+	// that are still within the trash retention window.
+	ListDeletedProjects(context.Context, *connect.Request[v1.ListDeletedProjectsRequest]) (*connect.Response[v1.ListDeletedProjectsResponse], error)
+	// RestoreProject undoes a soft delete, restoring the project namespace and
+	// its grants. Requires the caller to have owned the project when it was
+	// deleted.
+	RestoreProject(context.Context, *connect.Request[v1.RestoreProjectRequest]) (*connect.Response[v1.RestoreProjectResponse], error)
+	// CreateProjectToken mints a short-lived token for the project's
+	// ServiceAccount, which may edit resources in the project namespace, so
+	// CI systems can act on the project without a user's credentials.
+	// Requires PERMISSION_PROJECTS_ADMIN on the project.
+	CreateProjectToken(context.Context, *connect.Request[v1.CreateProjectTokenRequest]) (*connect.Response[v1.CreateProjectTokenResponse], error)
 }
 
 // NewProjectServiceClient constructs a client for the holos.console.v1.ProjectService service. By
@@ -110,12 +158,14 @@ func NewProjectServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			httpClient,
 			baseURL+ProjectServiceListProjectsProcedure,
 			connect.WithSchema(projectServiceMethods.ByName("ListProjects")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 		getProject: connect.NewClient[v1.GetProjectRequest, v1.GetProjectResponse](
 			httpClient,
 			baseURL+ProjectServiceGetProjectProcedure,
 			connect.WithSchema(projectServiceMethods.ByName("GetProject")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 		createProject: connect.NewClient[v1.CreateProjectRequest, v1.CreateProjectResponse](
@@ -130,6 +180,12 @@ func NewProjectServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(projectServiceMethods.ByName("UpdateProject")),
 			connect.WithClientOptions(opts...),
 		),
+		diffProject: connect.NewClient[v1.DiffProjectRequest, v1.DiffProjectResponse](
+			httpClient,
+			baseURL+ProjectServiceDiffProjectProcedure,
+			connect.WithSchema(projectServiceMethods.ByName("DiffProject")),
+			connect.WithClientOptions(opts...),
+		),
 		deleteProject: connect.NewClient[v1.DeleteProjectRequest, v1.DeleteProjectResponse](
 			httpClient,
 			baseURL+ProjectServiceDeleteProjectProcedure,
@@ -146,6 +202,7 @@ func NewProjectServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			httpClient,
 			baseURL+ProjectServiceGetProjectRawProcedure,
 			connect.WithSchema(projectServiceMethods.ByName("GetProjectRaw")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 		updateProjectDefaultSharing: connect.NewClient[v1.UpdateProjectDefaultSharingRequest, v1.UpdateProjectDefaultSharingResponse](
@@ -158,6 +215,40 @@ func NewProjectServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			httpClient,
 			baseURL+ProjectServiceCheckProjectIdentifierProcedure,
 			connect.WithSchema(projectServiceMethods.ByName("CheckProjectIdentifier")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		listProjectResources: connect.NewClient[v1.ListProjectResourcesRequest, v1.ListProjectResourcesResponse](
+			httpClient,
+			baseURL+ProjectServiceListProjectResourcesProcedure,
+			connect.WithSchema(projectServiceMethods.ByName("ListProjectResources")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		listProjectEvents: connect.NewClient[v1.ListProjectEventsRequest, v1.ListProjectEventsResponse](
+			httpClient,
+			baseURL+ProjectServiceListProjectEventsProcedure,
+			connect.WithSchema(projectServiceMethods.ByName("ListProjectEvents")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		listDeletedProjects: connect.NewClient[v1.ListDeletedProjectsRequest, v1.ListDeletedProjectsResponse](
+			httpClient,
+			baseURL+ProjectServiceListDeletedProjectsProcedure,
+			connect.WithSchema(projectServiceMethods.ByName("ListDeletedProjects")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		restoreProject: connect.NewClient[v1.RestoreProjectRequest, v1.RestoreProjectResponse](
+			httpClient,
+			baseURL+ProjectServiceRestoreProjectProcedure,
+			connect.WithSchema(projectServiceMethods.ByName("RestoreProject")),
+			connect.WithClientOptions(opts...),
+		),
+		createProjectToken: connect.NewClient[v1.CreateProjectTokenRequest, v1.CreateProjectTokenResponse](
+			httpClient,
+			baseURL+ProjectServiceCreateProjectTokenProcedure,
+			connect.WithSchema(projectServiceMethods.ByName("CreateProjectToken")),
 			connect.WithClientOptions(opts...),
 		),
 	}
@@ -169,11 +260,17 @@ type projectServiceClient struct {
 	getProject                  *connect.Client[v1.GetProjectRequest, v1.GetProjectResponse]
 	createProject               *connect.Client[v1.CreateProjectRequest, v1.CreateProjectResponse]
 	updateProject               *connect.Client[v1.UpdateProjectRequest, v1.UpdateProjectResponse]
+	diffProject                 *connect.Client[v1.DiffProjectRequest, v1.DiffProjectResponse]
 	deleteProject               *connect.Client[v1.DeleteProjectRequest, v1.DeleteProjectResponse]
 	updateProjectSharing        *connect.Client[v1.UpdateProjectSharingRequest, v1.UpdateProjectSharingResponse]
 	getProjectRaw               *connect.Client[v1.GetProjectRawRequest, v1.GetProjectRawResponse]
 	updateProjectDefaultSharing *connect.Client[v1.UpdateProjectDefaultSharingRequest, v1.UpdateProjectDefaultSharingResponse]
 	checkProjectIdentifier      *connect.Client[v1.CheckProjectIdentifierRequest, v1.CheckProjectIdentifierResponse]
+	listProjectResources        *connect.Client[v1.ListProjectResourcesRequest, v1.ListProjectResourcesResponse]
+	listProjectEvents           *connect.Client[v1.ListProjectEventsRequest, v1.ListProjectEventsResponse]
+	listDeletedProjects         *connect.Client[v1.ListDeletedProjectsRequest, v1.ListDeletedProjectsResponse]
+	restoreProject              *connect.Client[v1.RestoreProjectRequest, v1.RestoreProjectResponse]
+	createProjectToken          *connect.Client[v1.CreateProjectTokenRequest, v1.CreateProjectTokenResponse]
 }
 
 // ListProjects calls holos.console.v1.ProjectService.ListProjects.
@@ -196,6 +293,11 @@ func (c *projectServiceClient) UpdateProject(ctx context.Context, req *connect.R
 	return c.updateProject.CallUnary(ctx, req)
 }
 
+// DiffProject calls holos.console.v1.ProjectService.DiffProject.
+func (c *projectServiceClient) DiffProject(ctx context.Context, req *connect.Request[v1.DiffProjectRequest]) (*connect.Response[v1.DiffProjectResponse], error) {
+	return c.diffProject.CallUnary(ctx, req)
+}
+
 // DeleteProject calls holos.console.v1.ProjectService.DeleteProject.
 func (c *projectServiceClient) DeleteProject(ctx context.Context, req *connect.Request[v1.DeleteProjectRequest]) (*connect.Response[v1.DeleteProjectResponse], error) {
 	return c.deleteProject.CallUnary(ctx, req)
@@ -221,6 +323,31 @@ func (c *projectServiceClient) CheckProjectIdentifier(ctx context.Context, req *
 	return c.checkProjectIdentifier.CallUnary(ctx, req)
 }
 
+// ListProjectResources calls holos.console.v1.ProjectService.ListProjectResources.
+func (c *projectServiceClient) ListProjectResources(ctx context.Context, req *connect.Request[v1.ListProjectResourcesRequest]) (*connect.Response[v1.ListProjectResourcesResponse], error) {
+	return c.listProjectResources.CallUnary(ctx, req)
+}
+
+// ListProjectEvents calls holos.console.v1.ProjectService.ListProjectEvents.
+func (c *projectServiceClient) ListProjectEvents(ctx context.Context, req *connect.Request[v1.ListProjectEventsRequest]) (*connect.Response[v1.ListProjectEventsResponse], error) {
+	return c.listProjectEvents.CallUnary(ctx, req)
+}
+
+// ListDeletedProjects calls holos.console.v1.ProjectService.ListDeletedProjects.
+func (c *projectServiceClient) ListDeletedProjects(ctx context.Context, req *connect.Request[v1.ListDeletedProjectsRequest]) (*connect.Response[v1.ListDeletedProjectsResponse], error) {
+	return c.listDeletedProjects.CallUnary(ctx, req)
+}
+
+// RestoreProject calls holos.console.v1.ProjectService.RestoreProject.
+func (c *projectServiceClient) RestoreProject(ctx context.Context, req *connect.Request[v1.RestoreProjectRequest]) (*connect.Response[v1.RestoreProjectResponse], error) {
+	return c.restoreProject.CallUnary(ctx, req)
+}
+
+// CreateProjectToken calls holos.console.v1.ProjectService.CreateProjectToken.
+func (c *projectServiceClient) CreateProjectToken(ctx context.Context, req *connect.Request[v1.CreateProjectTokenRequest]) (*connect.Response[v1.CreateProjectTokenResponse], error) {
+	return c.createProjectToken.CallUnary(ctx, req)
+}
+
 // ProjectServiceHandler is an implementation of the holos.console.v1.ProjectService service.
 type ProjectServiceHandler interface {
 	// ListProjects returns all projects the user has access to.
@@ -233,15 +360,24 @@ type ProjectServiceHandler interface {
 	// UpdateProject updates project metadata (description, display name).
 	// Requires PERMISSION_PROJECTS_WRITE on the project.
 	UpdateProject(context.Context, *connect.Request[v1.UpdateProjectRequest]) (*connect.Response[v1.UpdateProjectResponse], error)
+	// DiffProject previews an UpdateProject without persisting it, reporting
+	// the metadata fields the update would change.
+	// Requires read access to the project.
+	DiffProject(context.Context, *connect.Request[v1.DiffProjectRequest]) (*connect.Response[v1.DiffProjectResponse], error)
 	// DeleteProject deletes a managed namespace.
-	// Requires PERMISSION_PROJECTS_DELETE on the project.
+	// Requires PERMISSION_PROJECTS_DELETE on the project. When the console runs
+	// with a trash retention window the project is soft-deleted: it is hidden
+	// and its grants are stripped until RestoreProject or the window passes.
 	DeleteProject(context.Context, *connect.Request[v1.DeleteProjectRequest]) (*connect.Response[v1.DeleteProjectResponse], error)
 	// UpdateProjectSharing updates the sharing grants on a project.
 	// Requires PERMISSION_PROJECTS_ADMIN on the project.
+	// A grant set without an active owner fails with FailedPrecondition unless
+	// a platform owner sets force.
 	UpdateProjectSharing(context.Context, *connect.Request[v1.UpdateProjectSharingRequest]) (*connect.Response[v1.UpdateProjectSharingResponse], error)
-	// GetProjectRaw retrieves the full Kubernetes Namespace object as verbatim JSON.
-	// The backend returns the Namespace exactly as the K8s API provides it, with no
-	// field filtering. Requires authentication and PERMISSION_PROJECTS_READ.
+	// GetProjectRaw retrieves the full Kubernetes Namespace object as JSON or YAML.
+	// The backend returns the Namespace exactly as the K8s API provides it unless
+	// strip_managed_fields is set. Requires authentication and
+	// PERMISSION_PROJECTS_READ.
 	GetProjectRaw(context.Context, *connect.Request[v1.GetProjectRawRequest]) (*connect.Response[v1.GetProjectRawResponse], error)
 	// UpdateProjectDefaultSharing updates the default sharing grants on a project.
 	// These grants are applied by default to new secrets created in this project.
@@ -252,6 +388,27 @@ type ProjectServiceHandler interface {
 	// alternative with a random 6-digit suffix appended. The suggestion is NOT
 	// reserved -- the Create RPC handles the race with retry logic.
 	CheckProjectIdentifier(context.Context, *connect.Request[v1.CheckProjectIdentifierRequest]) (*connect.Response[v1.CheckProjectIdentifierResponse], error)
+	// ListProjectResources summarizes the workloads (Deployments, StatefulSets,
+	// Services, and Ingresses) running in the project namespace.
+	// Requires PERMISSION_RESOURCES_READ on the project. Kinds the caller may
+	// not list are omitted from the response.
+	ListProjectResources(context.Context, *connect.Request[v1.ListProjectResourcesRequest]) (*connect.Response[v1.ListProjectResourcesResponse], error)
+	// ListProjectEvents returns recent Kubernetes Events in the project
+	// namespace, most recent first, so users can see why a workload is failing.
+	// Requires PERMISSION_PROJECTS_READ on the project.
+	ListProjectEvents(context.Context, *connect.Request[v1.ListProjectEventsRequest]) (*connect.Response[v1.ListProjectEventsResponse], error)
+	// ListDeletedProjects lists the soft-deleted projects the caller owned
+	// that are still within the trash retention window.
+	ListDeletedProjects(context.Context, *connect.Request[v1.ListDeletedProjectsRequest]) (*connect.Response[v1.ListDeletedProjectsResponse], error)
+	// RestoreProject undoes a soft delete, restoring the project namespace and
+	// its grants. Requires the caller to have owned the project when it was
+	// deleted.
+	RestoreProject(context.Context, *connect.Request[v1.RestoreProjectRequest]) (*connect.Response[v1.RestoreProjectResponse], error)
+	// CreateProjectToken mints a short-lived token for the project's
+	// ServiceAccount, which may edit resources in the project namespace, so
+	// CI systems can act on the project without a user's credentials.
+	// Requires PERMISSION_PROJECTS_ADMIN on the project.
+	CreateProjectToken(context.Context, *connect.Request[v1.CreateProjectTokenRequest]) (*connect.Response[v1.CreateProjectTokenResponse], error)
 }
 
 // NewProjectServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -265,12 +422,14 @@ func NewProjectServiceHandler(svc ProjectServiceHandler, opts ...connect.Handler
 		ProjectServiceListProjectsProcedure,
 		svc.ListProjects,
 		connect.WithSchema(projectServiceMethods.ByName("ListProjects")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	projectServiceGetProjectHandler := connect.NewUnaryHandler(
 		ProjectServiceGetProjectProcedure,
 		svc.GetProject,
 		connect.WithSchema(projectServiceMethods.ByName("GetProject")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	projectServiceCreateProjectHandler := connect.NewUnaryHandler(
@@ -285,6 +444,12 @@ func NewProjectServiceHandler(svc ProjectServiceHandler, opts ...connect.Handler
 		connect.WithSchema(projectServiceMethods.ByName("UpdateProject")),
 		connect.WithHandlerOptions(opts...),
 	)
+	projectServiceDiffProjectHandler := connect.NewUnaryHandler(
+		ProjectServiceDiffProjectProcedure,
+		svc.DiffProject,
+		connect.WithSchema(projectServiceMethods.ByName("DiffProject")),
+		connect.WithHandlerOptions(opts...),
+	)
 	projectServiceDeleteProjectHandler := connect.NewUnaryHandler(
 		ProjectServiceDeleteProjectProcedure,
 		svc.DeleteProject,
@@ -301,6 +466,7 @@ func NewProjectServiceHandler(svc ProjectServiceHandler, opts ...connect.Handler
 		ProjectServiceGetProjectRawProcedure,
 		svc.GetProjectRaw,
 		connect.WithSchema(projectServiceMethods.ByName("GetProjectRaw")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	projectServiceUpdateProjectDefaultSharingHandler := connect.NewUnaryHandler(
@@ -313,6 +479,40 @@ func NewProjectServiceHandler(svc ProjectServiceHandler, opts ...connect.Handler
 		ProjectServiceCheckProjectIdentifierProcedure,
 		svc.CheckProjectIdentifier,
 		connect.WithSchema(projectServiceMethods.ByName("CheckProjectIdentifier")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	projectServiceListProjectResourcesHandler := connect.NewUnaryHandler(
+		ProjectServiceListProjectResourcesProcedure,
+		svc.ListProjectResources,
+		connect.WithSchema(projectServiceMethods.ByName("ListProjectResources")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	projectServiceListProjectEventsHandler := connect.NewUnaryHandler(
+		ProjectServiceListProjectEventsProcedure,
+		svc.ListProjectEvents,
+		connect.WithSchema(projectServiceMethods.ByName("ListProjectEvents")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	projectServiceListDeletedProjectsHandler := connect.NewUnaryHandler(
+		ProjectServiceListDeletedProjectsProcedure,
+		svc.ListDeletedProjects,
+		connect.WithSchema(projectServiceMethods.ByName("ListDeletedProjects")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	projectServiceRestoreProjectHandler := connect.NewUnaryHandler(
+		ProjectServiceRestoreProjectProcedure,
+		svc.RestoreProject,
+		connect.WithSchema(projectServiceMethods.ByName("RestoreProject")),
+		connect.WithHandlerOptions(opts...),
+	)
+	projectServiceCreateProjectTokenHandler := connect.NewUnaryHandler(
+		ProjectServiceCreateProjectTokenProcedure,
+		svc.CreateProjectToken,
+		connect.WithSchema(projectServiceMethods.ByName("CreateProjectToken")),
 		connect.WithHandlerOptions(opts...),
 	)
 	return "/holos.console.v1.ProjectService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -325,6 +525,8 @@ func NewProjectServiceHandler(svc ProjectServiceHandler, opts ...connect.Handler
 			projectServiceCreateProjectHandler.ServeHTTP(w, r)
 		case ProjectServiceUpdateProjectProcedure:
 			projectServiceUpdateProjectHandler.ServeHTTP(w, r)
+		case ProjectServiceDiffProjectProcedure:
+			projectServiceDiffProjectHandler.ServeHTTP(w, r)
 		case ProjectServiceDeleteProjectProcedure:
 			projectServiceDeleteProjectHandler.ServeHTTP(w, r)
 		case ProjectServiceUpdateProjectSharingProcedure:
@@ -335,6 +537,16 @@ func NewProjectServiceHandler(svc ProjectServiceHandler, opts ...connect.Handler
 			projectServiceUpdateProjectDefaultSharingHandler.ServeHTTP(w, r)
 		case ProjectServiceCheckProjectIdentifierProcedure:
 			projectServiceCheckProjectIdentifierHandler.ServeHTTP(w, r)
+		case ProjectServiceListProjectResourcesProcedure:
+			projectServiceListProjectResourcesHandler.ServeHTTP(w, r)
+		case ProjectServiceListProjectEventsProcedure:
+			projectServiceListProjectEventsHandler.ServeHTTP(w, r)
+		case ProjectServiceListDeletedProjectsProcedure:
+			projectServiceListDeletedProjectsHandler.ServeHTTP(w, r)
+		case ProjectServiceRestoreProjectProcedure:
+			projectServiceRestoreProjectHandler.ServeHTTP(w, r)
+		case ProjectServiceCreateProjectTokenProcedure:
+			projectServiceCreateProjectTokenHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -360,6 +572,10 @@ func (UnimplementedProjectServiceHandler) UpdateProject(context.Context, *connec
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("holos.console.v1.ProjectService.UpdateProject is not implemented"))
 }
 
+func (UnimplementedProjectServiceHandler) DiffProject(context.Context, *connect.Request[v1.DiffProjectRequest]) (*connect.Response[v1.DiffProjectResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("holos.console.v1.ProjectService.DiffProject is not implemented"))
+}
+
 func (UnimplementedProjectServiceHandler) DeleteProject(context.Context, *connect.Request[v1.DeleteProjectRequest]) (*connect.Response[v1.DeleteProjectResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("holos.console.v1.ProjectService.DeleteProject is not implemented"))
 }
@@ -379,3 +595,23 @@ func (UnimplementedProjectServiceHandler) UpdateProjectDefaultSharing(context.Co
 func (UnimplementedProjectServiceHandler) CheckProjectIdentifier(context.Context, *connect.Request[v1.CheckProjectIdentifierRequest]) (*connect.Response[v1.CheckProjectIdentifierResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("holos.console.v1.ProjectService.CheckProjectIdentifier is not implemented"))
 }
+
+func (UnimplementedProjectServiceHandler) ListProjectResources(context.Context, *connect.Request[v1.ListProjectResourcesRequest]) (*connect.Response[v1.ListProjectResourcesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("holos.console.v1.ProjectService.ListProjectResources is not implemented"))
+}
+
+func (UnimplementedProjectServiceHandler) ListProjectEvents(context.Context, *connect.Request[v1.ListProjectEventsRequest]) (*connect.Response[v1.ListProjectEventsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("holos.console.v1.ProjectService.ListProjectEvents is not implemented"))
+}
+
+func (UnimplementedProjectServiceHandler) ListDeletedProjects(context.Context, *connect.Request[v1.ListDeletedProjectsRequest]) (*connect.Response[v1.ListDeletedProjectsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("holos.console.v1.ProjectService.ListDeletedProjects is not implemented"))
+}
+
+func (UnimplementedProjectServiceHandler) RestoreProject(context.Context, *connect.Request[v1.RestoreProjectRequest]) (*connect.Response[v1.RestoreProjectResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("holos.console.v1.ProjectService.RestoreProject is not implemented"))
+}
+
+func (UnimplementedProjectServiceHandler) CreateProjectToken(context.Context, *connect.Request[v1.CreateProjectTokenRequest]) (*connect.Response[v1.CreateProjectTokenResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("holos.console.v1.ProjectService.CreateProjectToken is not implemented"))
+}
@@ -0,0 +1,283 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: holos/console/v1/secret_templates.proto
+
+package consolev1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// SecretTemplatesServiceName is the fully-qualified name of the SecretTemplatesService service.
+	SecretTemplatesServiceName = "holos.console.v1.SecretTemplatesService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// SecretTemplatesServiceListSecretTemplatesProcedure is the fully-qualified name of the
+	// SecretTemplatesService's ListSecretTemplates RPC.
+	SecretTemplatesServiceListSecretTemplatesProcedure = "/holos.console.v1.SecretTemplatesService/ListSecretTemplates"
+	// SecretTemplatesServiceGetSecretTemplateProcedure is the fully-qualified name of the
+	// SecretTemplatesService's GetSecretTemplate RPC.
+	SecretTemplatesServiceGetSecretTemplateProcedure = "/holos.console.v1.SecretTemplatesService/GetSecretTemplate"
+	// SecretTemplatesServiceCreateSecretTemplateProcedure is the fully-qualified name of the
+	// SecretTemplatesService's CreateSecretTemplate RPC.
+	SecretTemplatesServiceCreateSecretTemplateProcedure = "/holos.console.v1.SecretTemplatesService/CreateSecretTemplate"
+	// SecretTemplatesServiceUpdateSecretTemplateProcedure is the fully-qualified name of the
+	// SecretTemplatesService's UpdateSecretTemplate RPC.
+	SecretTemplatesServiceUpdateSecretTemplateProcedure = "/holos.console.v1.SecretTemplatesService/UpdateSecretTemplate"
+	// SecretTemplatesServiceDeleteSecretTemplateProcedure is the fully-qualified name of the
+	// SecretTemplatesService's DeleteSecretTemplate RPC.
+	SecretTemplatesServiceDeleteSecretTemplateProcedure = "/holos.console.v1.SecretTemplatesService/DeleteSecretTemplate"
+	// SecretTemplatesServiceCreateSecretFromTemplateProcedure is the fully-qualified name of the
+	// SecretTemplatesService's CreateSecretFromTemplate RPC.
+	SecretTemplatesServiceCreateSecretFromTemplateProcedure = "/holos.console.v1.SecretTemplatesService/CreateSecretFromTemplate"
+)
+
+// SecretTemplatesServiceClient is a client for the holos.console.v1.SecretTemplatesService service.
+type SecretTemplatesServiceClient interface {
+	// ListSecretTemplates returns the templates defined at a scope. With
+	// project set, the organization templates the project inherits are
+	// included after the project's own.
+	ListSecretTemplates(context.Context, *connect.Request[v1.ListSecretTemplatesRequest]) (*connect.Response[v1.ListSecretTemplatesResponse], error)
+	// GetSecretTemplate returns a single template defined at a scope.
+	GetSecretTemplate(context.Context, *connect.Request[v1.GetSecretTemplateRequest]) (*connect.Response[v1.GetSecretTemplateResponse], error)
+	// CreateSecretTemplate defines a new template.
+	CreateSecretTemplate(context.Context, *connect.Request[v1.CreateSecretTemplateRequest]) (*connect.Response[v1.CreateSecretTemplateResponse], error)
+	// UpdateSecretTemplate replaces the keys, descriptions and default grants
+	// of an existing template.
+	UpdateSecretTemplate(context.Context, *connect.Request[v1.UpdateSecretTemplateRequest]) (*connect.Response[v1.UpdateSecretTemplateResponse], error)
+	// DeleteSecretTemplate removes a template. Secrets created from it are
+	// not affected.
+	DeleteSecretTemplate(context.Context, *connect.Request[v1.DeleteSecretTemplateRequest]) (*connect.Response[v1.DeleteSecretTemplateResponse], error)
+	// CreateSecretFromTemplate creates a project secret laid out by a
+	// template. The secret is created through SecretsService.CreateSecret, so
+	// the caller needs the same access as for any other new secret.
+	CreateSecretFromTemplate(context.Context, *connect.Request[v1.CreateSecretFromTemplateRequest]) (*connect.Response[v1.CreateSecretFromTemplateResponse], error)
+}
+
+// NewSecretTemplatesServiceClient constructs a client for the
+// holos.console.v1.SecretTemplatesService service. By default, it uses the Connect protocol with
+// the binary Protobuf Codec, asks for gzipped responses, and sends uncompressed requests. To use
+// the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewSecretTemplatesServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) SecretTemplatesServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	secretTemplatesServiceMethods := v1.File_holos_console_v1_secret_templates_proto.Services().ByName("SecretTemplatesService").Methods()
+	return &secretTemplatesServiceClient{
+		listSecretTemplates: connect.NewClient[v1.ListSecretTemplatesRequest, v1.ListSecretTemplatesResponse](
+			httpClient,
+			baseURL+SecretTemplatesServiceListSecretTemplatesProcedure,
+			connect.WithSchema(secretTemplatesServiceMethods.ByName("ListSecretTemplates")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getSecretTemplate: connect.NewClient[v1.GetSecretTemplateRequest, v1.GetSecretTemplateResponse](
+			httpClient,
+			baseURL+SecretTemplatesServiceGetSecretTemplateProcedure,
+			connect.WithSchema(secretTemplatesServiceMethods.ByName("GetSecretTemplate")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		createSecretTemplate: connect.NewClient[v1.CreateSecretTemplateRequest, v1.CreateSecretTemplateResponse](
+			httpClient,
+			baseURL+SecretTemplatesServiceCreateSecretTemplateProcedure,
+			connect.WithSchema(secretTemplatesServiceMethods.ByName("CreateSecretTemplate")),
+			connect.WithClientOptions(opts...),
+		),
+		updateSecretTemplate: connect.NewClient[v1.UpdateSecretTemplateRequest, v1.UpdateSecretTemplateResponse](
+			httpClient,
+			baseURL+SecretTemplatesServiceUpdateSecretTemplateProcedure,
+			connect.WithSchema(secretTemplatesServiceMethods.ByName("UpdateSecretTemplate")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteSecretTemplate: connect.NewClient[v1.DeleteSecretTemplateRequest, v1.DeleteSecretTemplateResponse](
+			httpClient,
+			baseURL+SecretTemplatesServiceDeleteSecretTemplateProcedure,
+			connect.WithSchema(secretTemplatesServiceMethods.ByName("DeleteSecretTemplate")),
+			connect.WithClientOptions(opts...),
+		),
+		createSecretFromTemplate: connect.NewClient[v1.CreateSecretFromTemplateRequest, v1.CreateSecretFromTemplateResponse](
+			httpClient,
+			baseURL+SecretTemplatesServiceCreateSecretFromTemplateProcedure,
+			connect.WithSchema(secretTemplatesServiceMethods.ByName("CreateSecretFromTemplate")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// secretTemplatesServiceClient implements SecretTemplatesServiceClient.
+type secretTemplatesServiceClient struct {
+	listSecretTemplates      *connect.Client[v1.ListSecretTemplatesRequest, v1.ListSecretTemplatesResponse]
+	getSecretTemplate        *connect.Client[v1.GetSecretTemplateRequest, v1.GetSecretTemplateResponse]
+	createSecretTemplate     *connect.Client[v1.CreateSecretTemplateRequest, v1.CreateSecretTemplateResponse]
+	updateSecretTemplate     *connect.Client[v1.UpdateSecretTemplateRequest, v1.UpdateSecretTemplateResponse]
+	deleteSecretTemplate     *connect.Client[v1.DeleteSecretTemplateRequest, v1.DeleteSecretTemplateResponse]
+	createSecretFromTemplate *connect.Client[v1.CreateSecretFromTemplateRequest, v1.CreateSecretFromTemplateResponse]
+}
+
+// ListSecretTemplates calls holos.console.v1.SecretTemplatesService.ListSecretTemplates.
+func (c *secretTemplatesServiceClient) ListSecretTemplates(ctx context.Context, req *connect.Request[v1.ListSecretTemplatesRequest]) (*connect.Response[v1.ListSecretTemplatesResponse], error) {
+	return c.listSecretTemplates.CallUnary(ctx, req)
+}
+
+// GetSecretTemplate calls holos.console.v1.SecretTemplatesService.GetSecretTemplate.
+func (c *secretTemplatesServiceClient) GetSecretTemplate(ctx context.Context, req *connect.Request[v1.GetSecretTemplateRequest]) (*connect.Response[v1.GetSecretTemplateResponse], error) {
+	return c.getSecretTemplate.CallUnary(ctx, req)
+}
+
+// CreateSecretTemplate calls holos.console.v1.SecretTemplatesService.CreateSecretTemplate.
+func (c *secretTemplatesServiceClient) CreateSecretTemplate(ctx context.Context, req *connect.Request[v1.CreateSecretTemplateRequest]) (*connect.Response[v1.CreateSecretTemplateResponse], error) {
+	return c.createSecretTemplate.CallUnary(ctx, req)
+}
+
+// UpdateSecretTemplate calls holos.console.v1.SecretTemplatesService.UpdateSecretTemplate.
+func (c *secretTemplatesServiceClient) UpdateSecretTemplate(ctx context.Context, req *connect.Request[v1.UpdateSecretTemplateRequest]) (*connect.Response[v1.UpdateSecretTemplateResponse], error) {
+	return c.updateSecretTemplate.CallUnary(ctx, req)
+}
+
+// DeleteSecretTemplate calls holos.console.v1.SecretTemplatesService.DeleteSecretTemplate.
+func (c *secretTemplatesServiceClient) DeleteSecretTemplate(ctx context.Context, req *connect.Request[v1.DeleteSecretTemplateRequest]) (*connect.Response[v1.DeleteSecretTemplateResponse], error) {
+	return c.deleteSecretTemplate.CallUnary(ctx, req)
+}
+
+// CreateSecretFromTemplate calls holos.console.v1.SecretTemplatesService.CreateSecretFromTemplate.
+func (c *secretTemplatesServiceClient) CreateSecretFromTemplate(ctx context.Context, req *connect.Request[v1.CreateSecretFromTemplateRequest]) (*connect.Response[v1.CreateSecretFromTemplateResponse], error) {
+	return c.createSecretFromTemplate.CallUnary(ctx, req)
+}
+
+// SecretTemplatesServiceHandler is an implementation of the holos.console.v1.SecretTemplatesService
+// service.
+type SecretTemplatesServiceHandler interface {
+	// ListSecretTemplates returns the templates defined at a scope. With
+	// project set, the organization templates the project inherits are
+	// included after the project's own.
+	ListSecretTemplates(context.Context, *connect.Request[v1.ListSecretTemplatesRequest]) (*connect.Response[v1.ListSecretTemplatesResponse], error)
+	// GetSecretTemplate returns a single template defined at a scope.
+	GetSecretTemplate(context.Context, *connect.Request[v1.GetSecretTemplateRequest]) (*connect.Response[v1.GetSecretTemplateResponse], error)
+	// CreateSecretTemplate defines a new template.
+	CreateSecretTemplate(context.Context, *connect.Request[v1.CreateSecretTemplateRequest]) (*connect.Response[v1.CreateSecretTemplateResponse], error)
+	// UpdateSecretTemplate replaces the keys, descriptions and default grants
+	// of an existing template.
+	UpdateSecretTemplate(context.Context, *connect.Request[v1.UpdateSecretTemplateRequest]) (*connect.Response[v1.UpdateSecretTemplateResponse], error)
+	// DeleteSecretTemplate removes a template. Secrets created from it are
+	// not affected.
+	DeleteSecretTemplate(context.Context, *connect.Request[v1.DeleteSecretTemplateRequest]) (*connect.Response[v1.DeleteSecretTemplateResponse], error)
+	// CreateSecretFromTemplate creates a project secret laid out by a
+	// template. The secret is created through SecretsService.CreateSecret, so
+	// the caller needs the same access as for any other new secret.
+	CreateSecretFromTemplate(context.Context, *connect.Request[v1.CreateSecretFromTemplateRequest]) (*connect.Response[v1.CreateSecretFromTemplateResponse], error)
+}
+
+// NewSecretTemplatesServiceHandler builds an HTTP handler from the service implementation. It
+// returns the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewSecretTemplatesServiceHandler(svc SecretTemplatesServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	secretTemplatesServiceMethods := v1.File_holos_console_v1_secret_templates_proto.Services().ByName("SecretTemplatesService").Methods()
+	secretTemplatesServiceListSecretTemplatesHandler := connect.NewUnaryHandler(
+		SecretTemplatesServiceListSecretTemplatesProcedure,
+		svc.ListSecretTemplates,
+		connect.WithSchema(secretTemplatesServiceMethods.ByName("ListSecretTemplates")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	secretTemplatesServiceGetSecretTemplateHandler := connect.NewUnaryHandler(
+		SecretTemplatesServiceGetSecretTemplateProcedure,
+		svc.GetSecretTemplate,
+		connect.WithSchema(secretTemplatesServiceMethods.ByName("GetSecretTemplate")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	secretTemplatesServiceCreateSecretTemplateHandler := connect.NewUnaryHandler(
+		SecretTemplatesServiceCreateSecretTemplateProcedure,
+		svc.CreateSecretTemplate,
+		connect.WithSchema(secretTemplatesServiceMethods.ByName("CreateSecretTemplate")),
+		connect.WithHandlerOptions(opts...),
+	)
+	secretTemplatesServiceUpdateSecretTemplateHandler := connect.NewUnaryHandler(
+		SecretTemplatesServiceUpdateSecretTemplateProcedure,
+		svc.UpdateSecretTemplate,
+		connect.WithSchema(secretTemplatesServiceMethods.ByName("UpdateSecretTemplate")),
+		connect.WithHandlerOptions(opts...),
+	)
+	secretTemplatesServiceDeleteSecretTemplateHandler := connect.NewUnaryHandler(
+		SecretTemplatesServiceDeleteSecretTemplateProcedure,
+		svc.DeleteSecretTemplate,
+		connect.WithSchema(secretTemplatesServiceMethods.ByName("DeleteSecretTemplate")),
+		connect.WithHandlerOptions(opts...),
+	)
+	secretTemplatesServiceCreateSecretFromTemplateHandler := connect.NewUnaryHandler(
+		SecretTemplatesServiceCreateSecretFromTemplateProcedure,
+		svc.CreateSecretFromTemplate,
+		connect.WithSchema(secretTemplatesServiceMethods.ByName("CreateSecretFromTemplate")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/holos.console.v1.SecretTemplatesService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case SecretTemplatesServiceListSecretTemplatesProcedure:
+			secretTemplatesServiceListSecretTemplatesHandler.ServeHTTP(w, r)
+		case SecretTemplatesServiceGetSecretTemplateProcedure:
+			secretTemplatesServiceGetSecretTemplateHandler.ServeHTTP(w, r)
+		case SecretTemplatesServiceCreateSecretTemplateProcedure:
+			secretTemplatesServiceCreateSecretTemplateHandler.ServeHTTP(w, r)
+		case SecretTemplatesServiceUpdateSecretTemplateProcedure:
+			secretTemplatesServiceUpdateSecretTemplateHandler.ServeHTTP(w, r)
+		case SecretTemplatesServiceDeleteSecretTemplateProcedure:
+			secretTemplatesServiceDeleteSecretTemplateHandler.ServeHTTP(w, r)
+		case SecretTemplatesServiceCreateSecretFromTemplateProcedure:
+			secretTemplatesServiceCreateSecretFromTemplateHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedSecretTemplatesServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedSecretTemplatesServiceHandler struct{}
+
+func (UnimplementedSecretTemplatesServiceHandler) ListSecretTemplates(context.Context, *connect.Request[v1.ListSecretTemplatesRequest]) (*connect.Response[v1.ListSecretTemplatesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("holos.console.v1.SecretTemplatesService.ListSecretTemplates is not implemented"))
+}
+
+func (UnimplementedSecretTemplatesServiceHandler) GetSecretTemplate(context.Context, *connect.Request[v1.GetSecretTemplateRequest]) (*connect.Response[v1.GetSecretTemplateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("holos.console.v1.SecretTemplatesService.GetSecretTemplate is not implemented"))
+}
+
+func (UnimplementedSecretTemplatesServiceHandler) CreateSecretTemplate(context.Context, *connect.Request[v1.CreateSecretTemplateRequest]) (*connect.Response[v1.CreateSecretTemplateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("holos.console.v1.SecretTemplatesService.CreateSecretTemplate is not implemented"))
+}
+
+func (UnimplementedSecretTemplatesServiceHandler) UpdateSecretTemplate(context.Context, *connect.Request[v1.UpdateSecretTemplateRequest]) (*connect.Response[v1.UpdateSecretTemplateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("holos.console.v1.SecretTemplatesService.UpdateSecretTemplate is not implemented"))
+}
+
+func (UnimplementedSecretTemplatesServiceHandler) DeleteSecretTemplate(context.Context, *connect.Request[v1.DeleteSecretTemplateRequest]) (*connect.Response[v1.DeleteSecretTemplateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("holos.console.v1.SecretTemplatesService.DeleteSecretTemplate is not implemented"))
+}
+
+func (UnimplementedSecretTemplatesServiceHandler) CreateSecretFromTemplate(context.Context, *connect.Request[v1.CreateSecretFromTemplateRequest]) (*connect.Response[v1.CreateSecretFromTemplateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("holos.console.v1.SecretTemplatesService.CreateSecretFromTemplate is not implemented"))
+}
@@ -42,18 +42,63 @@ const (
 	// SecretsServiceUpdateSecretProcedure is the fully-qualified name of the SecretsService's
 	// UpdateSecret RPC.
 	SecretsServiceUpdateSecretProcedure = "/holos.console.v1.SecretsService/UpdateSecret"
+	// SecretsServiceDiffSecretProcedure is the fully-qualified name of the SecretsService's DiffSecret
+	// RPC.
+	SecretsServiceDiffSecretProcedure = "/holos.console.v1.SecretsService/DiffSecret"
+	// SecretsServicePatchSecretProcedure is the fully-qualified name of the SecretsService's
+	// PatchSecret RPC.
+	SecretsServicePatchSecretProcedure = "/holos.console.v1.SecretsService/PatchSecret"
+	// SecretsServiceAppendSecretKeyProcedure is the fully-qualified name of the SecretsService's
+	// AppendSecretKey RPC.
+	SecretsServiceAppendSecretKeyProcedure = "/holos.console.v1.SecretsService/AppendSecretKey"
 	// SecretsServiceCreateSecretProcedure is the fully-qualified name of the SecretsService's
 	// CreateSecret RPC.
 	SecretsServiceCreateSecretProcedure = "/holos.console.v1.SecretsService/CreateSecret"
 	// SecretsServiceDeleteSecretProcedure is the fully-qualified name of the SecretsService's
 	// DeleteSecret RPC.
 	SecretsServiceDeleteSecretProcedure = "/holos.console.v1.SecretsService/DeleteSecret"
+	// SecretsServiceBatchGetSecretsProcedure is the fully-qualified name of the SecretsService's
+	// BatchGetSecrets RPC.
+	SecretsServiceBatchGetSecretsProcedure = "/holos.console.v1.SecretsService/BatchGetSecrets"
+	// SecretsServiceBatchDeleteSecretsProcedure is the fully-qualified name of the SecretsService's
+	// BatchDeleteSecrets RPC.
+	SecretsServiceBatchDeleteSecretsProcedure = "/holos.console.v1.SecretsService/BatchDeleteSecrets"
 	// SecretsServiceUpdateSharingProcedure is the fully-qualified name of the SecretsService's
 	// UpdateSharing RPC.
 	SecretsServiceUpdateSharingProcedure = "/holos.console.v1.SecretsService/UpdateSharing"
 	// SecretsServiceGetSecretRawProcedure is the fully-qualified name of the SecretsService's
 	// GetSecretRaw RPC.
 	SecretsServiceGetSecretRawProcedure = "/holos.console.v1.SecretsService/GetSecretRaw"
+	// SecretsServiceGetSecretKeyProcedure is the fully-qualified name of the SecretsService's
+	// GetSecretKey RPC.
+	SecretsServiceGetSecretKeyProcedure = "/holos.console.v1.SecretsService/GetSecretKey"
+	// SecretsServiceRotateSecretProcedure is the fully-qualified name of the SecretsService's
+	// RotateSecret RPC.
+	SecretsServiceRotateSecretProcedure = "/holos.console.v1.SecretsService/RotateSecret"
+	// SecretsServiceGetProjectQuotaProcedure is the fully-qualified name of the SecretsService's
+	// GetProjectQuota RPC.
+	SecretsServiceGetProjectQuotaProcedure = "/holos.console.v1.SecretsService/GetProjectQuota"
+	// SecretsServiceGetSecretUsageProcedure is the fully-qualified name of the SecretsService's
+	// GetSecretUsage RPC.
+	SecretsServiceGetSecretUsageProcedure = "/holos.console.v1.SecretsService/GetSecretUsage"
+	// SecretsServiceListDeletedSecretsProcedure is the fully-qualified name of the SecretsService's
+	// ListDeletedSecrets RPC.
+	SecretsServiceListDeletedSecretsProcedure = "/holos.console.v1.SecretsService/ListDeletedSecrets"
+	// SecretsServiceRestoreSecretProcedure is the fully-qualified name of the SecretsService's
+	// RestoreSecret RPC.
+	SecretsServiceRestoreSecretProcedure = "/holos.console.v1.SecretsService/RestoreSecret"
+	// SecretsServiceCreateSSHKeySecretProcedure is the fully-qualified name of the SecretsService's
+	// CreateSSHKeySecret RPC.
+	SecretsServiceCreateSSHKeySecretProcedure = "/holos.console.v1.SecretsService/CreateSSHKeySecret"
+	// SecretsServiceExportSecretSealedProcedure is the fully-qualified name of the SecretsService's
+	// ExportSecretSealed RPC.
+	SecretsServiceExportSecretSealedProcedure = "/holos.console.v1.SecretsService/ExportSecretSealed"
+	// SecretsServiceCreateShareLinkProcedure is the fully-qualified name of the SecretsService's
+	// CreateShareLink RPC.
+	SecretsServiceCreateShareLinkProcedure = "/holos.console.v1.SecretsService/CreateShareLink"
+	// SecretsServiceApplySecretRawProcedure is the fully-qualified name of the SecretsService's
+	// ApplySecretRaw RPC.
+	SecretsServiceApplySecretRawProcedure = "/holos.console.v1.SecretsService/ApplySecretRaw"
 )
 
 // SecretsServiceClient is a client for the holos.console.v1.SecretsService service.
@@ -71,20 +116,122 @@ type SecretsServiceClient interface {
 	// Requires authentication and PERMISSION_SECRETS_WRITE.
 	// Only operates on secrets with the console managed-by label.
 	UpdateSecret(context.Context, *connect.Request[v1.UpdateSecretRequest]) (*connect.Response[v1.UpdateSecretResponse], error)
+	// DiffSecret previews an UpdateSecret without persisting it: it compares
+	// the proposed data with the secret's current data and reports the keys
+	// added, removed, and changed with their value sizes, never the values.
+	// Requires authentication and read access to the secret. Only operates on
+	// secrets with the console managed-by label.
+	DiffSecret(context.Context, *connect.Request[v1.DiffSecretRequest]) (*connect.Response[v1.DiffSecretResponse], error)
+	// PatchSecret adds, replaces, or removes individual keys of an existing
+	// secret without resubmitting its entire data map. Keys not mentioned in
+	// the request are left untouched.
+	// Requires authentication and PERMISSION_SECRETS_WRITE.
+	// Only operates on secrets with the console managed-by label.
+	PatchSecret(context.Context, *connect.Request[v1.PatchSecretRequest]) (*connect.Response[v1.PatchSecretResponse], error)
+	// AppendSecretKey uploads the value of one key of an existing secret in
+	// chunks, so a value approaching the 1MiB Secret size limit need not fit
+	// in a single request. The first chunk, at offset 0, replaces the value
+	// and is rejected with ResourceExhausted, before anything is written, when
+	// total_size would push the secret past the limit or the project quota.
+	// Each later chunk must start where the stored value ends; a mismatch
+	// fails with FailedPrecondition so a client can resume from the stored
+	// size. The key holds a partial value until the last chunk is appended.
+	// Requires authentication and PERMISSION_SECRETS_WRITE.
+	// Only operates on secrets with the console managed-by label.
+	AppendSecretKey(context.Context, *connect.Request[v1.AppendSecretKeyRequest]) (*connect.Response[v1.AppendSecretKeyResponse], error)
 	// CreateSecret creates a new secret with the console managed-by label.
 	// Requires authentication and PERMISSION_SECRETS_WRITE.
 	CreateSecret(context.Context, *connect.Request[v1.CreateSecretRequest]) (*connect.Response[v1.CreateSecretResponse], error)
 	// DeleteSecret deletes a secret by name.
 	// Requires authentication and PERMISSION_SECRETS_DELETE.
-	// Only operates on secrets with the console managed-by label.
+	// Only operates on secrets with the console managed-by label. When the
+	// console runs with a trash retention window the secret is soft-deleted:
+	// it is hidden and its grants are stripped until RestoreSecret or the
+	// window passes.
 	DeleteSecret(context.Context, *connect.Request[v1.DeleteSecretRequest]) (*connect.Response[v1.DeleteSecretResponse], error)
+	// BatchGetSecrets retrieves several secrets of a project in one call. Each
+	// secret is authorized and audited as by GetSecret; a secret the caller
+	// cannot read is reported in its result rather than failing the batch.
+	BatchGetSecrets(context.Context, *connect.Request[v1.BatchGetSecretsRequest]) (*connect.Response[v1.BatchGetSecretsResponse], error)
+	// BatchDeleteSecrets deletes several secrets of a project in one call.
+	// Each secret is authorized and deleted as by DeleteSecret, in the order
+	// given; a failure is reported in that secret's result and does not stop
+	// the remaining deletions.
+	BatchDeleteSecrets(context.Context, *connect.Request[v1.BatchDeleteSecretsRequest]) (*connect.Response[v1.BatchDeleteSecretsResponse], error)
 	// UpdateSharing updates the sharing grants on a secret without touching its data.
 	// Requires ROLE_OWNER on the secret.
+	// User grants naming someone who has never signed in are reported as
+	// pending; set invite_users to invite them.
 	UpdateSharing(context.Context, *connect.Request[v1.UpdateSharingRequest]) (*connect.Response[v1.UpdateSharingResponse], error)
-	// GetSecretRaw retrieves the full Kubernetes Secret object as verbatim JSON.
-	// The backend returns the Secret exactly as the K8s API provides it, with no
-	// field filtering. Requires authentication and PERMISSION_SECRETS_READ.
+	// GetSecretRaw retrieves the full Kubernetes Secret object as JSON or YAML.
+	// The backend returns the Secret exactly as the K8s API provides it unless
+	// strip_managed_fields is set. Requires authentication and
+	// PERMISSION_SECRETS_READ.
 	GetSecretRaw(context.Context, *connect.Request[v1.GetSecretRawRequest]) (*connect.Response[v1.GetSecretRawResponse], error)
+	// GetSecretKey retrieves the value of a single data key from a secret.
+	// Callers with read access to the whole secret may read any key. Callers
+	// holding only a key-scoped sharing grant (see ShareGrant.keys) may read
+	// the keys listed in that grant. Returns PermissionDenied otherwise.
+	GetSecretKey(context.Context, *connect.Request[v1.GetSecretKeyRequest]) (*connect.Response[v1.GetSecretKeyResponse], error)
+	// RotateSecret replaces the values of existing keys with newly generated
+	// random values. When the secret carries the
+	// console.holos.run/rotation-webhook annotation, the server POSTs a
+	// notification (without secret values) to that HTTPS URL after the rotation
+	// is stored.
+	// Requires PERMISSION_SECRETS_ROTATE.
+	// Only operates on secrets with the console managed-by label.
+	RotateSecret(context.Context, *connect.Request[v1.RotateSecretRequest]) (*connect.Response[v1.RotateSecretResponse], error)
+	// GetProjectQuota returns the project's effective secret quota and current
+	// usage. Limits come from the console.holos.run/quota-* annotations on the
+	// project Namespace, falling back to its organization Namespace.
+	// CreateSecret, UpdateSecret, PatchSecret, and RotateSecret return
+	// RESOURCE_EXHAUSTED when a write would exceed the quota.
+	// Requires authentication and permission to list secrets in the project.
+	GetProjectQuota(context.Context, *connect.Request[v1.GetProjectQuotaRequest]) (*connect.Response[v1.GetProjectQuotaResponse], error)
+	// GetSecretUsage lists the Deployments, StatefulSets, DaemonSets, and
+	// CronJobs in the project that reference the secret through env, envFrom,
+	// volumes, or imagePullSecrets, so owners can check before deleting or
+	// rotating it. Requires PERMISSION_SECRETS_READ on the secret. Workload
+	// kinds the caller may not list are reported in unscanned_kinds.
+	GetSecretUsage(context.Context, *connect.Request[v1.GetSecretUsageRequest]) (*connect.Response[v1.GetSecretUsageResponse], error)
+	// ListDeletedSecrets lists the soft-deleted secrets in a project that are
+	// still within the trash retention window. Requires permission to list
+	// secrets in the project.
+	ListDeletedSecrets(context.Context, *connect.Request[v1.ListDeletedSecretsRequest]) (*connect.Response[v1.ListDeletedSecretsResponse], error)
+	// RestoreSecret undoes a soft delete, restoring the secret and its grants.
+	// Requires PERMISSION_SECRETS_DELETE in the project.
+	RestoreSecret(context.Context, *connect.Request[v1.RestoreSecretRequest]) (*connect.Response[v1.RestoreSecretResponse], error)
+	// CreateSSHKeySecret generates an ed25519 key pair server-side and stores
+	// it as a kubernetes.io/ssh-auth secret, e.g. for a deploy key. The
+	// response and SecretMetadata carry the public key; the private key is
+	// only readable through the secret's read permission.
+	// Requires authentication and PERMISSION_SECRETS_WRITE.
+	CreateSSHKeySecret(context.Context, *connect.Request[v1.CreateSSHKeySecretRequest]) (*connect.Response[v1.CreateSSHKeySecretResponse], error)
+	// ExportSecretSealed returns the secret as a Bitnami SealedSecret manifest
+	// encrypted to the console's configured sealing certificate, so it can be
+	// committed to a GitOps repository without exposing plaintext. The
+	// manifest is strict-scoped: it only decrypts under the same name and
+	// namespace. Returns FailedPrecondition when no certificate is configured.
+	// Requires PERMISSION_SECRETS_READ.
+	ExportSecretSealed(context.Context, *connect.Request[v1.ExportSecretSealedRequest]) (*connect.Response[v1.ExportSecretSealedResponse], error)
+	// CreateShareLink returns a signed, time-limited URL that reads the
+	// secret without an account, e.g. to hand a one-off credential to an
+	// external contractor. Anyone holding the URL can read the secret until it
+	// expires; links are not stored, so they cannot be revoked except by
+	// deleting the secret. Returns FailedPrecondition unless share links are
+	// enabled. Requires secret owner, checked as the custom "share" verb on
+	// the secret.
+	CreateShareLink(context.Context, *connect.Request[v1.CreateShareLinkRequest]) (*connect.Response[v1.CreateShareLinkResponse], error)
+	// ApplySecretRaw creates or updates a secret from a Secret manifest in
+	// JSON or YAML, e.g. one copied from kubectl or GetSecretRaw, with
+	// server-side apply. Server-populated metadata in the manifest is ignored.
+	// Sharing grants and other console.holos.run annotations and labels are
+	// rejected, except the description, url, and content-types annotations,
+	// because grants are changed with UpdateSharing. Data keys the manifest
+	// omits are kept when another writer set them. Requires authentication
+	// and PERMISSION_SECRETS_WRITE, and only updates secrets with the console
+	// managed-by label.
+	ApplySecretRaw(context.Context, *connect.Request[v1.ApplySecretRawRequest]) (*connect.Response[v1.ApplySecretRawResponse], error)
 }
 
 // NewSecretsServiceClient constructs a client for the holos.console.v1.SecretsService service. By
@@ -102,6 +249,7 @@ func NewSecretsServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			httpClient,
 			baseURL+SecretsServiceListSecretsProcedure,
 			connect.WithSchema(secretsServiceMethods.ByName("ListSecrets")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 		getSecret: connect.NewClient[v1.GetSecretRequest, v1.GetSecretResponse](
@@ -116,6 +264,24 @@ func NewSecretsServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(secretsServiceMethods.ByName("UpdateSecret")),
 			connect.WithClientOptions(opts...),
 		),
+		diffSecret: connect.NewClient[v1.DiffSecretRequest, v1.DiffSecretResponse](
+			httpClient,
+			baseURL+SecretsServiceDiffSecretProcedure,
+			connect.WithSchema(secretsServiceMethods.ByName("DiffSecret")),
+			connect.WithClientOptions(opts...),
+		),
+		patchSecret: connect.NewClient[v1.PatchSecretRequest, v1.PatchSecretResponse](
+			httpClient,
+			baseURL+SecretsServicePatchSecretProcedure,
+			connect.WithSchema(secretsServiceMethods.ByName("PatchSecret")),
+			connect.WithClientOptions(opts...),
+		),
+		appendSecretKey: connect.NewClient[v1.AppendSecretKeyRequest, v1.AppendSecretKeyResponse](
+			httpClient,
+			baseURL+SecretsServiceAppendSecretKeyProcedure,
+			connect.WithSchema(secretsServiceMethods.ByName("AppendSecretKey")),
+			connect.WithClientOptions(opts...),
+		),
 		createSecret: connect.NewClient[v1.CreateSecretRequest, v1.CreateSecretResponse](
 			httpClient,
 			baseURL+SecretsServiceCreateSecretProcedure,
@@ -128,6 +294,18 @@ func NewSecretsServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(secretsServiceMethods.ByName("DeleteSecret")),
 			connect.WithClientOptions(opts...),
 		),
+		batchGetSecrets: connect.NewClient[v1.BatchGetSecretsRequest, v1.BatchGetSecretsResponse](
+			httpClient,
+			baseURL+SecretsServiceBatchGetSecretsProcedure,
+			connect.WithSchema(secretsServiceMethods.ByName("BatchGetSecrets")),
+			connect.WithClientOptions(opts...),
+		),
+		batchDeleteSecrets: connect.NewClient[v1.BatchDeleteSecretsRequest, v1.BatchDeleteSecretsResponse](
+			httpClient,
+			baseURL+SecretsServiceBatchDeleteSecretsProcedure,
+			connect.WithSchema(secretsServiceMethods.ByName("BatchDeleteSecrets")),
+			connect.WithClientOptions(opts...),
+		),
 		updateSharing: connect.NewClient[v1.UpdateSharingRequest, v1.UpdateSharingResponse](
 			httpClient,
 			baseURL+SecretsServiceUpdateSharingProcedure,
@@ -140,18 +318,96 @@ func NewSecretsServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(secretsServiceMethods.ByName("GetSecretRaw")),
 			connect.WithClientOptions(opts...),
 		),
+		getSecretKey: connect.NewClient[v1.GetSecretKeyRequest, v1.GetSecretKeyResponse](
+			httpClient,
+			baseURL+SecretsServiceGetSecretKeyProcedure,
+			connect.WithSchema(secretsServiceMethods.ByName("GetSecretKey")),
+			connect.WithClientOptions(opts...),
+		),
+		rotateSecret: connect.NewClient[v1.RotateSecretRequest, v1.RotateSecretResponse](
+			httpClient,
+			baseURL+SecretsServiceRotateSecretProcedure,
+			connect.WithSchema(secretsServiceMethods.ByName("RotateSecret")),
+			connect.WithClientOptions(opts...),
+		),
+		getProjectQuota: connect.NewClient[v1.GetProjectQuotaRequest, v1.GetProjectQuotaResponse](
+			httpClient,
+			baseURL+SecretsServiceGetProjectQuotaProcedure,
+			connect.WithSchema(secretsServiceMethods.ByName("GetProjectQuota")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getSecretUsage: connect.NewClient[v1.GetSecretUsageRequest, v1.GetSecretUsageResponse](
+			httpClient,
+			baseURL+SecretsServiceGetSecretUsageProcedure,
+			connect.WithSchema(secretsServiceMethods.ByName("GetSecretUsage")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		listDeletedSecrets: connect.NewClient[v1.ListDeletedSecretsRequest, v1.ListDeletedSecretsResponse](
+			httpClient,
+			baseURL+SecretsServiceListDeletedSecretsProcedure,
+			connect.WithSchema(secretsServiceMethods.ByName("ListDeletedSecrets")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		restoreSecret: connect.NewClient[v1.RestoreSecretRequest, v1.RestoreSecretResponse](
+			httpClient,
+			baseURL+SecretsServiceRestoreSecretProcedure,
+			connect.WithSchema(secretsServiceMethods.ByName("RestoreSecret")),
+			connect.WithClientOptions(opts...),
+		),
+		createSSHKeySecret: connect.NewClient[v1.CreateSSHKeySecretRequest, v1.CreateSSHKeySecretResponse](
+			httpClient,
+			baseURL+SecretsServiceCreateSSHKeySecretProcedure,
+			connect.WithSchema(secretsServiceMethods.ByName("CreateSSHKeySecret")),
+			connect.WithClientOptions(opts...),
+		),
+		exportSecretSealed: connect.NewClient[v1.ExportSecretSealedRequest, v1.ExportSecretSealedResponse](
+			httpClient,
+			baseURL+SecretsServiceExportSecretSealedProcedure,
+			connect.WithSchema(secretsServiceMethods.ByName("ExportSecretSealed")),
+			connect.WithClientOptions(opts...),
+		),
+		createShareLink: connect.NewClient[v1.CreateShareLinkRequest, v1.CreateShareLinkResponse](
+			httpClient,
+			baseURL+SecretsServiceCreateShareLinkProcedure,
+			connect.WithSchema(secretsServiceMethods.ByName("CreateShareLink")),
+			connect.WithClientOptions(opts...),
+		),
+		applySecretRaw: connect.NewClient[v1.ApplySecretRawRequest, v1.ApplySecretRawResponse](
+			httpClient,
+			baseURL+SecretsServiceApplySecretRawProcedure,
+			connect.WithSchema(secretsServiceMethods.ByName("ApplySecretRaw")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // secretsServiceClient implements SecretsServiceClient.
 type secretsServiceClient struct {
-	listSecrets   *connect.Client[v1.ListSecretsRequest, v1.ListSecretsResponse]
-	getSecret     *connect.Client[v1.GetSecretRequest, v1.GetSecretResponse]
-	updateSecret  *connect.Client[v1.UpdateSecretRequest, v1.UpdateSecretResponse]
-	createSecret  *connect.Client[v1.CreateSecretRequest, v1.CreateSecretResponse]
-	deleteSecret  *connect.Client[v1.DeleteSecretRequest, v1.DeleteSecretResponse]
-	updateSharing *connect.Client[v1.UpdateSharingRequest, v1.UpdateSharingResponse]
-	getSecretRaw  *connect.Client[v1.GetSecretRawRequest, v1.GetSecretRawResponse]
+	listSecrets        *connect.Client[v1.ListSecretsRequest, v1.ListSecretsResponse]
+	getSecret          *connect.Client[v1.GetSecretRequest, v1.GetSecretResponse]
+	updateSecret       *connect.Client[v1.UpdateSecretRequest, v1.UpdateSecretResponse]
+	diffSecret         *connect.Client[v1.DiffSecretRequest, v1.DiffSecretResponse]
+	patchSecret        *connect.Client[v1.PatchSecretRequest, v1.PatchSecretResponse]
+	appendSecretKey    *connect.Client[v1.AppendSecretKeyRequest, v1.AppendSecretKeyResponse]
+	createSecret       *connect.Client[v1.CreateSecretRequest, v1.CreateSecretResponse]
+	deleteSecret       *connect.Client[v1.DeleteSecretRequest, v1.DeleteSecretResponse]
+	batchGetSecrets    *connect.Client[v1.BatchGetSecretsRequest, v1.BatchGetSecretsResponse]
+	batchDeleteSecrets *connect.Client[v1.BatchDeleteSecretsRequest, v1.BatchDeleteSecretsResponse]
+	updateSharing      *connect.Client[v1.UpdateSharingRequest, v1.UpdateSharingResponse]
+	getSecretRaw       *connect.Client[v1.GetSecretRawRequest, v1.GetSecretRawResponse]
+	getSecretKey       *connect.Client[v1.GetSecretKeyRequest, v1.GetSecretKeyResponse]
+	rotateSecret       *connect.Client[v1.RotateSecretRequest, v1.RotateSecretResponse]
+	getProjectQuota    *connect.Client[v1.GetProjectQuotaRequest, v1.GetProjectQuotaResponse]
+	getSecretUsage     *connect.Client[v1.GetSecretUsageRequest, v1.GetSecretUsageResponse]
+	listDeletedSecrets *connect.Client[v1.ListDeletedSecretsRequest, v1.ListDeletedSecretsResponse]
+	restoreSecret      *connect.Client[v1.RestoreSecretRequest, v1.RestoreSecretResponse]
+	createSSHKeySecret *connect.Client[v1.CreateSSHKeySecretRequest, v1.CreateSSHKeySecretResponse]
+	exportSecretSealed *connect.Client[v1.ExportSecretSealedRequest, v1.ExportSecretSealedResponse]
+	createShareLink    *connect.Client[v1.CreateShareLinkRequest, v1.CreateShareLinkResponse]
+	applySecretRaw     *connect.Client[v1.ApplySecretRawRequest, v1.ApplySecretRawResponse]
 }
 
 // ListSecrets calls holos.console.v1.SecretsService.ListSecrets.
@@ -169,6 +425,21 @@ func (c *secretsServiceClient) UpdateSecret(ctx context.Context, req *connect.Re
 	return c.updateSecret.CallUnary(ctx, req)
 }
 
+// DiffSecret calls holos.console.v1.SecretsService.DiffSecret.
+func (c *secretsServiceClient) DiffSecret(ctx context.Context, req *connect.Request[v1.DiffSecretRequest]) (*connect.Response[v1.DiffSecretResponse], error) {
+	return c.diffSecret.CallUnary(ctx, req)
+}
+
+// PatchSecret calls holos.console.v1.SecretsService.PatchSecret.
+func (c *secretsServiceClient) PatchSecret(ctx context.Context, req *connect.Request[v1.PatchSecretRequest]) (*connect.Response[v1.PatchSecretResponse], error) {
+	return c.patchSecret.CallUnary(ctx, req)
+}
+
+// AppendSecretKey calls holos.console.v1.SecretsService.AppendSecretKey.
+func (c *secretsServiceClient) AppendSecretKey(ctx context.Context, req *connect.Request[v1.AppendSecretKeyRequest]) (*connect.Response[v1.AppendSecretKeyResponse], error) {
+	return c.appendSecretKey.CallUnary(ctx, req)
+}
+
 // CreateSecret calls holos.console.v1.SecretsService.CreateSecret.
 func (c *secretsServiceClient) CreateSecret(ctx context.Context, req *connect.Request[v1.CreateSecretRequest]) (*connect.Response[v1.CreateSecretResponse], error) {
 	return c.createSecret.CallUnary(ctx, req)
@@ -179,6 +450,16 @@ func (c *secretsServiceClient) DeleteSecret(ctx context.Context, req *connect.Re
 	return c.deleteSecret.CallUnary(ctx, req)
 }
 
+// BatchGetSecrets calls holos.console.v1.SecretsService.BatchGetSecrets.
+func (c *secretsServiceClient) BatchGetSecrets(ctx context.Context, req *connect.Request[v1.BatchGetSecretsRequest]) (*connect.Response[v1.BatchGetSecretsResponse], error) {
+	return c.batchGetSecrets.CallUnary(ctx, req)
+}
+
+// BatchDeleteSecrets calls holos.console.v1.SecretsService.BatchDeleteSecrets.
+func (c *secretsServiceClient) BatchDeleteSecrets(ctx context.Context, req *connect.Request[v1.BatchDeleteSecretsRequest]) (*connect.Response[v1.BatchDeleteSecretsResponse], error) {
+	return c.batchDeleteSecrets.CallUnary(ctx, req)
+}
+
 // UpdateSharing calls holos.console.v1.SecretsService.UpdateSharing.
 func (c *secretsServiceClient) UpdateSharing(ctx context.Context, req *connect.Request[v1.UpdateSharingRequest]) (*connect.Response[v1.UpdateSharingResponse], error) {
 	return c.updateSharing.CallUnary(ctx, req)
@@ -189,6 +470,56 @@ func (c *secretsServiceClient) GetSecretRaw(ctx context.Context, req *connect.Re
 	return c.getSecretRaw.CallUnary(ctx, req)
 }
 
+// GetSecretKey calls holos.console.v1.SecretsService.GetSecretKey.
+func (c *secretsServiceClient) GetSecretKey(ctx context.Context, req *connect.Request[v1.GetSecretKeyRequest]) (*connect.Response[v1.GetSecretKeyResponse], error) {
+	return c.getSecretKey.CallUnary(ctx, req)
+}
+
+// RotateSecret calls holos.console.v1.SecretsService.RotateSecret.
+func (c *secretsServiceClient) RotateSecret(ctx context.Context, req *connect.Request[v1.RotateSecretRequest]) (*connect.Response[v1.RotateSecretResponse], error) {
+	return c.rotateSecret.CallUnary(ctx, req)
+}
+
+// GetProjectQuota calls holos.console.v1.SecretsService.GetProjectQuota.
+func (c *secretsServiceClient) GetProjectQuota(ctx context.Context, req *connect.Request[v1.GetProjectQuotaRequest]) (*connect.Response[v1.GetProjectQuotaResponse], error) {
+	return c.getProjectQuota.CallUnary(ctx, req)
+}
+
+// GetSecretUsage calls holos.console.v1.SecretsService.GetSecretUsage.
+func (c *secretsServiceClient) GetSecretUsage(ctx context.Context, req *connect.Request[v1.GetSecretUsageRequest]) (*connect.Response[v1.GetSecretUsageResponse], error) {
+	return c.getSecretUsage.CallUnary(ctx, req)
+}
+
+// ListDeletedSecrets calls holos.console.v1.SecretsService.ListDeletedSecrets.
+func (c *secretsServiceClient) ListDeletedSecrets(ctx context.Context, req *connect.Request[v1.ListDeletedSecretsRequest]) (*connect.Response[v1.ListDeletedSecretsResponse], error) {
+	return c.listDeletedSecrets.CallUnary(ctx, req)
+}
+
+// RestoreSecret calls holos.console.v1.SecretsService.RestoreSecret.
+func (c *secretsServiceClient) RestoreSecret(ctx context.Context, req *connect.Request[v1.RestoreSecretRequest]) (*connect.Response[v1.RestoreSecretResponse], error) {
+	return c.restoreSecret.CallUnary(ctx, req)
+}
+
+// CreateSSHKeySecret calls holos.console.v1.SecretsService.CreateSSHKeySecret.
+func (c *secretsServiceClient) CreateSSHKeySecret(ctx context.Context, req *connect.Request[v1.CreateSSHKeySecretRequest]) (*connect.Response[v1.CreateSSHKeySecretResponse], error) {
+	return c.createSSHKeySecret.CallUnary(ctx, req)
+}
+
+// ExportSecretSealed calls holos.console.v1.SecretsService.ExportSecretSealed.
+func (c *secretsServiceClient) ExportSecretSealed(ctx context.Context, req *connect.Request[v1.ExportSecretSealedRequest]) (*connect.Response[v1.ExportSecretSealedResponse], error) {
+	return c.exportSecretSealed.CallUnary(ctx, req)
+}
+
+// CreateShareLink calls holos.console.v1.SecretsService.CreateShareLink.
+func (c *secretsServiceClient) CreateShareLink(ctx context.Context, req *connect.Request[v1.CreateShareLinkRequest]) (*connect.Response[v1.CreateShareLinkResponse], error) {
+	return c.createShareLink.CallUnary(ctx, req)
+}
+
+// ApplySecretRaw calls holos.console.v1.SecretsService.ApplySecretRaw.
+func (c *secretsServiceClient) ApplySecretRaw(ctx context.Context, req *connect.Request[v1.ApplySecretRawRequest]) (*connect.Response[v1.ApplySecretRawResponse], error) {
+	return c.applySecretRaw.CallUnary(ctx, req)
+}
+
 // SecretsServiceHandler is an implementation of the holos.console.v1.SecretsService service.
 type SecretsServiceHandler interface {
 	// ListSecrets returns all secrets in the current namespace with console label.
@@ -204,20 +535,122 @@ type SecretsServiceHandler interface {
 	// Requires authentication and PERMISSION_SECRETS_WRITE.
 	// Only operates on secrets with the console managed-by label.
 	UpdateSecret(context.Context, *connect.Request[v1.UpdateSecretRequest]) (*connect.Response[v1.UpdateSecretResponse], error)
+	// DiffSecret previews an UpdateSecret without persisting it: it compares
+	// the proposed data with the secret's current data and reports the keys
+	// added, removed, and changed with their value sizes, never the values.
+	// Requires authentication and read access to the secret. Only operates on
+	// secrets with the console managed-by label.
+	DiffSecret(context.Context, *connect.Request[v1.DiffSecretRequest]) (*connect.Response[v1.DiffSecretResponse], error)
+	// PatchSecret adds, replaces, or removes individual keys of an existing
+	// secret without resubmitting its entire data map. Keys not mentioned in
+	// the request are left untouched.
+	// Requires authentication and PERMISSION_SECRETS_WRITE.
+	// Only operates on secrets with the console managed-by label.
+	PatchSecret(context.Context, *connect.Request[v1.PatchSecretRequest]) (*connect.Response[v1.PatchSecretResponse], error)
+	// AppendSecretKey uploads the value of one key of an existing secret in
+	// chunks, so a value approaching the 1MiB Secret size limit need not fit
+	// in a single request. The first chunk, at offset 0, replaces the value
+	// and is rejected with ResourceExhausted, before anything is written, when
+	// total_size would push the secret past the limit or the project quota.
+	// Each later chunk must start where the stored value ends; a mismatch
+	// fails with FailedPrecondition so a client can resume from the stored
+	// size. The key holds a partial value until the last chunk is appended.
+	// Requires authentication and PERMISSION_SECRETS_WRITE.
+	// Only operates on secrets with the console managed-by label.
+	AppendSecretKey(context.Context, *connect.Request[v1.AppendSecretKeyRequest]) (*connect.Response[v1.AppendSecretKeyResponse], error)
 	// CreateSecret creates a new secret with the console managed-by label.
 	// Requires authentication and PERMISSION_SECRETS_WRITE.
 	CreateSecret(context.Context, *connect.Request[v1.CreateSecretRequest]) (*connect.Response[v1.CreateSecretResponse], error)
 	// DeleteSecret deletes a secret by name.
 	// Requires authentication and PERMISSION_SECRETS_DELETE.
-	// Only operates on secrets with the console managed-by label.
+	// Only operates on secrets with the console managed-by label. When the
+	// console runs with a trash retention window the secret is soft-deleted:
+	// it is hidden and its grants are stripped until RestoreSecret or the
+	// window passes.
 	DeleteSecret(context.Context, *connect.Request[v1.DeleteSecretRequest]) (*connect.Response[v1.DeleteSecretResponse], error)
+	// BatchGetSecrets retrieves several secrets of a project in one call. Each
+	// secret is authorized and audited as by GetSecret; a secret the caller
+	// cannot read is reported in its result rather than failing the batch.
+	BatchGetSecrets(context.Context, *connect.Request[v1.BatchGetSecretsRequest]) (*connect.Response[v1.BatchGetSecretsResponse], error)
+	// BatchDeleteSecrets deletes several secrets of a project in one call.
+	// Each secret is authorized and deleted as by DeleteSecret, in the order
+	// given; a failure is reported in that secret's result and does not stop
+	// the remaining deletions.
+	BatchDeleteSecrets(context.Context, *connect.Request[v1.BatchDeleteSecretsRequest]) (*connect.Response[v1.BatchDeleteSecretsResponse], error)
 	// UpdateSharing updates the sharing grants on a secret without touching its data.
 	// Requires ROLE_OWNER on the secret.
+	// User grants naming someone who has never signed in are reported as
+	// pending; set invite_users to invite them.
 	UpdateSharing(context.Context, *connect.Request[v1.UpdateSharingRequest]) (*connect.Response[v1.UpdateSharingResponse], error)
-	// GetSecretRaw retrieves the full Kubernetes Secret object as verbatim JSON.
-	// The backend returns the Secret exactly as the K8s API provides it, with no
-	// field filtering. Requires authentication and PERMISSION_SECRETS_READ.
+	// GetSecretRaw retrieves the full Kubernetes Secret object as JSON or YAML.
+	// The backend returns the Secret exactly as the K8s API provides it unless
+	// strip_managed_fields is set. Requires authentication and
+	// PERMISSION_SECRETS_READ.
 	GetSecretRaw(context.Context, *connect.Request[v1.GetSecretRawRequest]) (*connect.Response[v1.GetSecretRawResponse], error)
+	// GetSecretKey retrieves the value of a single data key from a secret.
+	// Callers with read access to the whole secret may read any key. Callers
+	// holding only a key-scoped sharing grant (see ShareGrant.keys) may read
+	// the keys listed in that grant. Returns PermissionDenied otherwise.
+	GetSecretKey(context.Context, *connect.Request[v1.GetSecretKeyRequest]) (*connect.Response[v1.GetSecretKeyResponse], error)
+	// RotateSecret replaces the values of existing keys with newly generated
+	// random values. When the secret carries the
+	// console.holos.run/rotation-webhook annotation, the server POSTs a
+	// notification (without secret values) to that HTTPS URL after the rotation
+	// is stored.
+	// Requires PERMISSION_SECRETS_ROTATE.
+	// Only operates on secrets with the console managed-by label.
+	RotateSecret(context.Context, *connect.Request[v1.RotateSecretRequest]) (*connect.Response[v1.RotateSecretResponse], error)
+	// GetProjectQuota returns the project's effective secret quota and current
+	// usage. Limits come from the console.holos.run/quota-* annotations on the
+	// project Namespace, falling back to its organization Namespace.
+	// CreateSecret, UpdateSecret, PatchSecret, and RotateSecret return
+	// RESOURCE_EXHAUSTED when a write would exceed the quota.
+	// Requires authentication and permission to list secrets in the project.
+	GetProjectQuota(context.Context, *connect.Request[v1.GetProjectQuotaRequest]) (*connect.Response[v1.GetProjectQuotaResponse], error)
+	// GetSecretUsage lists the Deployments, StatefulSets, DaemonSets, and
+	// CronJobs in the project that reference the secret through env, envFrom,
+	// volumes, or imagePullSecrets, so owners can check before deleting or
+	// rotating it. Requires PERMISSION_SECRETS_READ on the secret. Workload
+	// kinds the caller may not list are reported in unscanned_kinds.
+	GetSecretUsage(context.Context, *connect.Request[v1.GetSecretUsageRequest]) (*connect.Response[v1.GetSecretUsageResponse], error)
+	// ListDeletedSecrets lists the soft-deleted secrets in a project that are
+	// still within the trash retention window. Requires permission to list
+	// secrets in the project.
+	ListDeletedSecrets(context.Context, *connect.Request[v1.ListDeletedSecretsRequest]) (*connect.Response[v1.ListDeletedSecretsResponse], error)
+	// RestoreSecret undoes a soft delete, restoring the secret and its grants.
+	// Requires PERMISSION_SECRETS_DELETE in the project.
+	RestoreSecret(context.Context, *connect.Request[v1.RestoreSecretRequest]) (*connect.Response[v1.RestoreSecretResponse], error)
+	// CreateSSHKeySecret generates an ed25519 key pair server-side and stores
+	// it as a kubernetes.io/ssh-auth secret, e.g. for a deploy key. The
+	// response and SecretMetadata carry the public key; the private key is
+	// only readable through the secret's read permission.
+	// Requires authentication and PERMISSION_SECRETS_WRITE.
+	CreateSSHKeySecret(context.Context, *connect.Request[v1.CreateSSHKeySecretRequest]) (*connect.Response[v1.CreateSSHKeySecretResponse], error)
+	// ExportSecretSealed returns the secret as a Bitnami SealedSecret manifest
+	// encrypted to the console's configured sealing certificate, so it can be
+	// committed to a GitOps repository without exposing plaintext. The
+	// manifest is strict-scoped: it only decrypts under the same name and
+	// namespace. Returns FailedPrecondition when no certificate is configured.
+	// Requires PERMISSION_SECRETS_READ.
+	ExportSecretSealed(context.Context, *connect.Request[v1.ExportSecretSealedRequest]) (*connect.Response[v1.ExportSecretSealedResponse], error)
+	// CreateShareLink returns a signed, time-limited URL that reads the
+	// secret without an account, e.g. to hand a one-off credential to an
+	// external contractor. Anyone holding the URL can read the secret until it
+	// expires; links are not stored, so they cannot be revoked except by
+	// deleting the secret. Returns FailedPrecondition unless share links are
+	// enabled. Requires secret owner, checked as the custom "share" verb on
+	// the secret.
+	CreateShareLink(context.Context, *connect.Request[v1.CreateShareLinkRequest]) (*connect.Response[v1.CreateShareLinkResponse], error)
+	// ApplySecretRaw creates or updates a secret from a Secret manifest in
+	// JSON or YAML, e.g. one copied from kubectl or GetSecretRaw, with
+	// server-side apply. Server-populated metadata in the manifest is ignored.
+	// Sharing grants and other console.holos.run annotations and labels are
+	// rejected, except the description, url, and content-types annotations,
+	// because grants are changed with UpdateSharing. Data keys the manifest
+	// omits are kept when another writer set them. Requires authentication
+	// and PERMISSION_SECRETS_WRITE, and only updates secrets with the console
+	// managed-by label.
+	ApplySecretRaw(context.Context, *connect.Request[v1.ApplySecretRawRequest]) (*connect.Response[v1.ApplySecretRawResponse], error)
 }
 
 // NewSecretsServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -231,6 +664,7 @@ func NewSecretsServiceHandler(svc SecretsServiceHandler, opts ...connect.Handler
 		SecretsServiceListSecretsProcedure,
 		svc.ListSecrets,
 		connect.WithSchema(secretsServiceMethods.ByName("ListSecrets")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	secretsServiceGetSecretHandler := connect.NewUnaryHandler(
@@ -245,6 +679,24 @@ func NewSecretsServiceHandler(svc SecretsServiceHandler, opts ...connect.Handler
 		connect.WithSchema(secretsServiceMethods.ByName("UpdateSecret")),
 		connect.WithHandlerOptions(opts...),
 	)
+	secretsServiceDiffSecretHandler := connect.NewUnaryHandler(
+		SecretsServiceDiffSecretProcedure,
+		svc.DiffSecret,
+		connect.WithSchema(secretsServiceMethods.ByName("DiffSecret")),
+		connect.WithHandlerOptions(opts...),
+	)
+	secretsServicePatchSecretHandler := connect.NewUnaryHandler(
+		SecretsServicePatchSecretProcedure,
+		svc.PatchSecret,
+		connect.WithSchema(secretsServiceMethods.ByName("PatchSecret")),
+		connect.WithHandlerOptions(opts...),
+	)
+	secretsServiceAppendSecretKeyHandler := connect.NewUnaryHandler(
+		SecretsServiceAppendSecretKeyProcedure,
+		svc.AppendSecretKey,
+		connect.WithSchema(secretsServiceMethods.ByName("AppendSecretKey")),
+		connect.WithHandlerOptions(opts...),
+	)
 	secretsServiceCreateSecretHandler := connect.NewUnaryHandler(
 		SecretsServiceCreateSecretProcedure,
 		svc.CreateSecret,
@@ -257,6 +709,18 @@ func NewSecretsServiceHandler(svc SecretsServiceHandler, opts ...connect.Handler
 		connect.WithSchema(secretsServiceMethods.ByName("DeleteSecret")),
 		connect.WithHandlerOptions(opts...),
 	)
+	secretsServiceBatchGetSecretsHandler := connect.NewUnaryHandler(
+		SecretsServiceBatchGetSecretsProcedure,
+		svc.BatchGetSecrets,
+		connect.WithSchema(secretsServiceMethods.ByName("BatchGetSecrets")),
+		connect.WithHandlerOptions(opts...),
+	)
+	secretsServiceBatchDeleteSecretsHandler := connect.NewUnaryHandler(
+		SecretsServiceBatchDeleteSecretsProcedure,
+		svc.BatchDeleteSecrets,
+		connect.WithSchema(secretsServiceMethods.ByName("BatchDeleteSecrets")),
+		connect.WithHandlerOptions(opts...),
+	)
 	secretsServiceUpdateSharingHandler := connect.NewUnaryHandler(
 		SecretsServiceUpdateSharingProcedure,
 		svc.UpdateSharing,
@@ -269,6 +733,69 @@ func NewSecretsServiceHandler(svc SecretsServiceHandler, opts ...connect.Handler
 		connect.WithSchema(secretsServiceMethods.ByName("GetSecretRaw")),
 		connect.WithHandlerOptions(opts...),
 	)
+	secretsServiceGetSecretKeyHandler := connect.NewUnaryHandler(
+		SecretsServiceGetSecretKeyProcedure,
+		svc.GetSecretKey,
+		connect.WithSchema(secretsServiceMethods.ByName("GetSecretKey")),
+		connect.WithHandlerOptions(opts...),
+	)
+	secretsServiceRotateSecretHandler := connect.NewUnaryHandler(
+		SecretsServiceRotateSecretProcedure,
+		svc.RotateSecret,
+		connect.WithSchema(secretsServiceMethods.ByName("RotateSecret")),
+		connect.WithHandlerOptions(opts...),
+	)
+	secretsServiceGetProjectQuotaHandler := connect.NewUnaryHandler(
+		SecretsServiceGetProjectQuotaProcedure,
+		svc.GetProjectQuota,
+		connect.WithSchema(secretsServiceMethods.ByName("GetProjectQuota")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	secretsServiceGetSecretUsageHandler := connect.NewUnaryHandler(
+		SecretsServiceGetSecretUsageProcedure,
+		svc.GetSecretUsage,
+		connect.WithSchema(secretsServiceMethods.ByName("GetSecretUsage")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	secretsServiceListDeletedSecretsHandler := connect.NewUnaryHandler(
+		SecretsServiceListDeletedSecretsProcedure,
+		svc.ListDeletedSecrets,
+		connect.WithSchema(secretsServiceMethods.ByName("ListDeletedSecrets")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	secretsServiceRestoreSecretHandler := connect.NewUnaryHandler(
+		SecretsServiceRestoreSecretProcedure,
+		svc.RestoreSecret,
+		connect.WithSchema(secretsServiceMethods.ByName("RestoreSecret")),
+		connect.WithHandlerOptions(opts...),
+	)
+	secretsServiceCreateSSHKeySecretHandler := connect.NewUnaryHandler(
+		SecretsServiceCreateSSHKeySecretProcedure,
+		svc.CreateSSHKeySecret,
+		connect.WithSchema(secretsServiceMethods.ByName("CreateSSHKeySecret")),
+		connect.WithHandlerOptions(opts...),
+	)
+	secretsServiceExportSecretSealedHandler := connect.NewUnaryHandler(
+		SecretsServiceExportSecretSealedProcedure,
+		svc.ExportSecretSealed,
+		connect.WithSchema(secretsServiceMethods.ByName("ExportSecretSealed")),
+		connect.WithHandlerOptions(opts...),
+	)
+	secretsServiceCreateShareLinkHandler := connect.NewUnaryHandler(
+		SecretsServiceCreateShareLinkProcedure,
+		svc.CreateShareLink,
+		connect.WithSchema(secretsServiceMethods.ByName("CreateShareLink")),
+		connect.WithHandlerOptions(opts...),
+	)
+	secretsServiceApplySecretRawHandler := connect.NewUnaryHandler(
+		SecretsServiceApplySecretRawProcedure,
+		svc.ApplySecretRaw,
+		connect.WithSchema(secretsServiceMethods.ByName("ApplySecretRaw")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/holos.console.v1.SecretsService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case SecretsServiceListSecretsProcedure:
@@ -277,14 +804,44 @@ func NewSecretsServiceHandler(svc SecretsServiceHandler, opts ...connect.Handler
 			secretsServiceGetSecretHandler.ServeHTTP(w, r)
 		case SecretsServiceUpdateSecretProcedure:
 			secretsServiceUpdateSecretHandler.ServeHTTP(w, r)
+		case SecretsServiceDiffSecretProcedure:
+			secretsServiceDiffSecretHandler.ServeHTTP(w, r)
+		case SecretsServicePatchSecretProcedure:
+			secretsServicePatchSecretHandler.ServeHTTP(w, r)
+		case SecretsServiceAppendSecretKeyProcedure:
+			secretsServiceAppendSecretKeyHandler.ServeHTTP(w, r)
 		case SecretsServiceCreateSecretProcedure:
 			secretsServiceCreateSecretHandler.ServeHTTP(w, r)
 		case SecretsServiceDeleteSecretProcedure:
 			secretsServiceDeleteSecretHandler.ServeHTTP(w, r)
+		case SecretsServiceBatchGetSecretsProcedure:
+			secretsServiceBatchGetSecretsHandler.ServeHTTP(w, r)
+		case SecretsServiceBatchDeleteSecretsProcedure:
+			secretsServiceBatchDeleteSecretsHandler.ServeHTTP(w, r)
 		case SecretsServiceUpdateSharingProcedure:
 			secretsServiceUpdateSharingHandler.ServeHTTP(w, r)
 		case SecretsServiceGetSecretRawProcedure:
 			secretsServiceGetSecretRawHandler.ServeHTTP(w, r)
+		case SecretsServiceGetSecretKeyProcedure:
+			secretsServiceGetSecretKeyHandler.ServeHTTP(w, r)
+		case SecretsServiceRotateSecretProcedure:
+			secretsServiceRotateSecretHandler.ServeHTTP(w, r)
+		case SecretsServiceGetProjectQuotaProcedure:
+			secretsServiceGetProjectQuotaHandler.ServeHTTP(w, r)
+		case SecretsServiceGetSecretUsageProcedure:
+			secretsServiceGetSecretUsageHandler.ServeHTTP(w, r)
+		case SecretsServiceListDeletedSecretsProcedure:
+			secretsServiceListDeletedSecretsHandler.ServeHTTP(w, r)
+		case SecretsServiceRestoreSecretProcedure:
+			secretsServiceRestoreSecretHandler.ServeHTTP(w, r)
+		case SecretsServiceCreateSSHKeySecretProcedure:
+			secretsServiceCreateSSHKeySecretHandler.ServeHTTP(w, r)
+		case SecretsServiceExportSecretSealedProcedure:
+			secretsServiceExportSecretSealedHandler.ServeHTTP(w, r)
+		case SecretsServiceCreateShareLinkProcedure:
+			secretsServiceCreateShareLinkHandler.ServeHTTP(w, r)
+		case SecretsServiceApplySecretRawProcedure:
+			secretsServiceApplySecretRawHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -306,6 +863,18 @@ func (UnimplementedSecretsServiceHandler) UpdateSecret(context.Context, *connect
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("holos.console.v1.SecretsService.UpdateSecret is not implemented"))
 }
 
+func (UnimplementedSecretsServiceHandler) DiffSecret(context.Context, *connect.Request[v1.DiffSecretRequest]) (*connect.Response[v1.DiffSecretResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("holos.console.v1.SecretsService.DiffSecret is not implemented"))
+}
+
+func (UnimplementedSecretsServiceHandler) PatchSecret(context.Context, *connect.Request[v1.PatchSecretRequest]) (*connect.Response[v1.PatchSecretResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("holos.console.v1.SecretsService.PatchSecret is not implemented"))
+}
+
+func (UnimplementedSecretsServiceHandler) AppendSecretKey(context.Context, *connect.Request[v1.AppendSecretKeyRequest]) (*connect.Response[v1.AppendSecretKeyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("holos.console.v1.SecretsService.AppendSecretKey is not implemented"))
+}
+
 func (UnimplementedSecretsServiceHandler) CreateSecret(context.Context, *connect.Request[v1.CreateSecretRequest]) (*connect.Response[v1.CreateSecretResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("holos.console.v1.SecretsService.CreateSecret is not implemented"))
 }
@@ -314,6 +883,14 @@ func (UnimplementedSecretsServiceHandler) DeleteSecret(context.Context, *connect
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("holos.console.v1.SecretsService.DeleteSecret is not implemented"))
 }
 
+func (UnimplementedSecretsServiceHandler) BatchGetSecrets(context.Context, *connect.Request[v1.BatchGetSecretsRequest]) (*connect.Response[v1.BatchGetSecretsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("holos.console.v1.SecretsService.BatchGetSecrets is not implemented"))
+}
+
+func (UnimplementedSecretsServiceHandler) BatchDeleteSecrets(context.Context, *connect.Request[v1.BatchDeleteSecretsRequest]) (*connect.Response[v1.BatchDeleteSecretsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("holos.console.v1.SecretsService.BatchDeleteSecrets is not implemented"))
+}
+
 func (UnimplementedSecretsServiceHandler) UpdateSharing(context.Context, *connect.Request[v1.UpdateSharingRequest]) (*connect.Response[v1.UpdateSharingResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("holos.console.v1.SecretsService.UpdateSharing is not implemented"))
 }
@@ -321,3 +898,43 @@ func (UnimplementedSecretsServiceHandler) UpdateSharing(context.Context, *connec
 func (UnimplementedSecretsServiceHandler) GetSecretRaw(context.Context, *connect.Request[v1.GetSecretRawRequest]) (*connect.Response[v1.GetSecretRawResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("holos.console.v1.SecretsService.GetSecretRaw is not implemented"))
 }
+
+func (UnimplementedSecretsServiceHandler) GetSecretKey(context.Context, *connect.Request[v1.GetSecretKeyRequest]) (*connect.Response[v1.GetSecretKeyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("holos.console.v1.SecretsService.GetSecretKey is not implemented"))
+}
+
+func (UnimplementedSecretsServiceHandler) RotateSecret(context.Context, *connect.Request[v1.RotateSecretRequest]) (*connect.Response[v1.RotateSecretResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("holos.console.v1.SecretsService.RotateSecret is not implemented"))
+}
+
+func (UnimplementedSecretsServiceHandler) GetProjectQuota(context.Context, *connect.Request[v1.GetProjectQuotaRequest]) (*connect.Response[v1.GetProjectQuotaResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("holos.console.v1.SecretsService.GetProjectQuota is not implemented"))
+}
+
+func (UnimplementedSecretsServiceHandler) GetSecretUsage(context.Context, *connect.Request[v1.GetSecretUsageRequest]) (*connect.Response[v1.GetSecretUsageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("holos.console.v1.SecretsService.GetSecretUsage is not implemented"))
+}
+
+func (UnimplementedSecretsServiceHandler) ListDeletedSecrets(context.Context, *connect.Request[v1.ListDeletedSecretsRequest]) (*connect.Response[v1.ListDeletedSecretsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("holos.console.v1.SecretsService.ListDeletedSecrets is not implemented"))
+}
+
+func (UnimplementedSecretsServiceHandler) RestoreSecret(context.Context, *connect.Request[v1.RestoreSecretRequest]) (*connect.Response[v1.RestoreSecretResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("holos.console.v1.SecretsService.RestoreSecret is not implemented"))
+}
+
+func (UnimplementedSecretsServiceHandler) CreateSSHKeySecret(context.Context, *connect.Request[v1.CreateSSHKeySecretRequest]) (*connect.Response[v1.CreateSSHKeySecretResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("holos.console.v1.SecretsService.CreateSSHKeySecret is not implemented"))
+}
+
+func (UnimplementedSecretsServiceHandler) ExportSecretSealed(context.Context, *connect.Request[v1.ExportSecretSealedRequest]) (*connect.Response[v1.ExportSecretSealedResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("holos.console.v1.SecretsService.ExportSecretSealed is not implemented"))
+}
+
+func (UnimplementedSecretsServiceHandler) CreateShareLink(context.Context, *connect.Request[v1.CreateShareLinkRequest]) (*connect.Response[v1.CreateShareLinkResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("holos.console.v1.SecretsService.CreateShareLink is not implemented"))
+}
+
+func (UnimplementedSecretsServiceHandler) ApplySecretRaw(context.Context, *connect.Request[v1.ApplySecretRawRequest]) (*connect.Response[v1.ApplySecretRawResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("holos.console.v1.SecretsService.ApplySecretRaw is not implemented"))
+}
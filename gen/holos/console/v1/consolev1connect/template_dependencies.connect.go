@@ -86,12 +86,14 @@ func NewTemplateDependencyServiceClient(httpClient connect.HTTPClient, baseURL s
 			httpClient,
 			baseURL+TemplateDependencyServiceListTemplateDependenciesProcedure,
 			connect.WithSchema(templateDependencyServiceMethods.ByName("ListTemplateDependencies")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 		getTemplateDependency: connect.NewClient[v1.GetTemplateDependencyRequest, v1.GetTemplateDependencyResponse](
 			httpClient,
 			baseURL+TemplateDependencyServiceGetTemplateDependencyProcedure,
 			connect.WithSchema(templateDependencyServiceMethods.ByName("GetTemplateDependency")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 		createTemplateDependency: connect.NewClient[v1.CreateTemplateDependencyRequest, v1.CreateTemplateDependencyResponse](
@@ -184,12 +186,14 @@ func NewTemplateDependencyServiceHandler(svc TemplateDependencyServiceHandler, o
 		TemplateDependencyServiceListTemplateDependenciesProcedure,
 		svc.ListTemplateDependencies,
 		connect.WithSchema(templateDependencyServiceMethods.ByName("ListTemplateDependencies")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	templateDependencyServiceGetTemplateDependencyHandler := connect.NewUnaryHandler(
 		TemplateDependencyServiceGetTemplateDependencyProcedure,
 		svc.GetTemplateDependency,
 		connect.WithSchema(templateDependencyServiceMethods.ByName("GetTemplateDependency")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	templateDependencyServiceCreateTemplateDependencyHandler := connect.NewUnaryHandler(
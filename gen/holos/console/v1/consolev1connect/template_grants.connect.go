@@ -85,12 +85,14 @@ func NewTemplateGrantServiceClient(httpClient connect.HTTPClient, baseURL string
 			httpClient,
 			baseURL+TemplateGrantServiceListTemplateGrantsProcedure,
 			connect.WithSchema(templateGrantServiceMethods.ByName("ListTemplateGrants")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 		getTemplateGrant: connect.NewClient[v1.GetTemplateGrantRequest, v1.GetTemplateGrantResponse](
 			httpClient,
 			baseURL+TemplateGrantServiceGetTemplateGrantProcedure,
 			connect.WithSchema(templateGrantServiceMethods.ByName("GetTemplateGrant")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 		createTemplateGrant: connect.NewClient[v1.CreateTemplateGrantRequest, v1.CreateTemplateGrantResponse](
@@ -180,12 +182,14 @@ func NewTemplateGrantServiceHandler(svc TemplateGrantServiceHandler, opts ...con
 		TemplateGrantServiceListTemplateGrantsProcedure,
 		svc.ListTemplateGrants,
 		connect.WithSchema(templateGrantServiceMethods.ByName("ListTemplateGrants")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	templateGrantServiceGetTemplateGrantHandler := connect.NewUnaryHandler(
 		TemplateGrantServiceGetTemplateGrantProcedure,
 		svc.GetTemplateGrant,
 		connect.WithSchema(templateGrantServiceMethods.ByName("GetTemplateGrant")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	templateGrantServiceCreateTemplateGrantHandler := connect.NewUnaryHandler(
@@ -100,12 +100,14 @@ func NewTemplatePolicyServiceClient(httpClient connect.HTTPClient, baseURL strin
 			httpClient,
 			baseURL+TemplatePolicyServiceListTemplatePoliciesProcedure,
 			connect.WithSchema(templatePolicyServiceMethods.ByName("ListTemplatePolicies")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 		getTemplatePolicy: connect.NewClient[v1.GetTemplatePolicyRequest, v1.GetTemplatePolicyResponse](
 			httpClient,
 			baseURL+TemplatePolicyServiceGetTemplatePolicyProcedure,
 			connect.WithSchema(templatePolicyServiceMethods.ByName("GetTemplatePolicy")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 		createTemplatePolicy: connect.NewClient[v1.CreateTemplatePolicyRequest, v1.CreateTemplatePolicyResponse](
@@ -130,6 +132,7 @@ func NewTemplatePolicyServiceClient(httpClient connect.HTTPClient, baseURL strin
 			httpClient,
 			baseURL+TemplatePolicyServiceListLinkableTemplatePoliciesProcedure,
 			connect.WithSchema(templatePolicyServiceMethods.ByName("ListLinkableTemplatePolicies")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 	}
@@ -220,12 +223,14 @@ func NewTemplatePolicyServiceHandler(svc TemplatePolicyServiceHandler, opts ...c
 		TemplatePolicyServiceListTemplatePoliciesProcedure,
 		svc.ListTemplatePolicies,
 		connect.WithSchema(templatePolicyServiceMethods.ByName("ListTemplatePolicies")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	templatePolicyServiceGetTemplatePolicyHandler := connect.NewUnaryHandler(
 		TemplatePolicyServiceGetTemplatePolicyProcedure,
 		svc.GetTemplatePolicy,
 		connect.WithSchema(templatePolicyServiceMethods.ByName("GetTemplatePolicy")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	templatePolicyServiceCreateTemplatePolicyHandler := connect.NewUnaryHandler(
@@ -250,6 +255,7 @@ func NewTemplatePolicyServiceHandler(svc TemplatePolicyServiceHandler, opts ...c
 		TemplatePolicyServiceListLinkableTemplatePoliciesProcedure,
 		svc.ListLinkableTemplatePolicies,
 		connect.WithSchema(templatePolicyServiceMethods.ByName("ListLinkableTemplatePolicies")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	return "/holos.console.v1.TemplatePolicyService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
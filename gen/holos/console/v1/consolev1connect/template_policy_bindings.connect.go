@@ -93,12 +93,14 @@ func NewTemplatePolicyBindingServiceClient(httpClient connect.HTTPClient, baseUR
 			httpClient,
 			baseURL+TemplatePolicyBindingServiceListTemplatePolicyBindingsProcedure,
 			connect.WithSchema(templatePolicyBindingServiceMethods.ByName("ListTemplatePolicyBindings")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 		getTemplatePolicyBinding: connect.NewClient[v1.GetTemplatePolicyBindingRequest, v1.GetTemplatePolicyBindingResponse](
 			httpClient,
 			baseURL+TemplatePolicyBindingServiceGetTemplatePolicyBindingProcedure,
 			connect.WithSchema(templatePolicyBindingServiceMethods.ByName("GetTemplatePolicyBinding")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 		createTemplatePolicyBinding: connect.NewClient[v1.CreateTemplatePolicyBindingRequest, v1.CreateTemplatePolicyBindingResponse](
@@ -198,12 +200,14 @@ func NewTemplatePolicyBindingServiceHandler(svc TemplatePolicyBindingServiceHand
 		TemplatePolicyBindingServiceListTemplatePolicyBindingsProcedure,
 		svc.ListTemplatePolicyBindings,
 		connect.WithSchema(templatePolicyBindingServiceMethods.ByName("ListTemplatePolicyBindings")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	templatePolicyBindingServiceGetTemplatePolicyBindingHandler := connect.NewUnaryHandler(
 		TemplatePolicyBindingServiceGetTemplatePolicyBindingProcedure,
 		svc.GetTemplatePolicyBinding,
 		connect.WithSchema(templatePolicyBindingServiceMethods.ByName("GetTemplatePolicyBinding")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	templatePolicyBindingServiceCreateTemplatePolicyBindingHandler := connect.NewUnaryHandler(
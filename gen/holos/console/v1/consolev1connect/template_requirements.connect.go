@@ -89,12 +89,14 @@ func NewTemplateRequirementServiceClient(httpClient connect.HTTPClient, baseURL
 			httpClient,
 			baseURL+TemplateRequirementServiceListTemplateRequirementsProcedure,
 			connect.WithSchema(templateRequirementServiceMethods.ByName("ListTemplateRequirements")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 		getTemplateRequirement: connect.NewClient[v1.GetTemplateRequirementRequest, v1.GetTemplateRequirementResponse](
 			httpClient,
 			baseURL+TemplateRequirementServiceGetTemplateRequirementProcedure,
 			connect.WithSchema(templateRequirementServiceMethods.ByName("GetTemplateRequirement")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 		createTemplateRequirement: connect.NewClient[v1.CreateTemplateRequirementRequest, v1.CreateTemplateRequirementResponse](
@@ -189,12 +191,14 @@ func NewTemplateRequirementServiceHandler(svc TemplateRequirementServiceHandler,
 		TemplateRequirementServiceListTemplateRequirementsProcedure,
 		svc.ListTemplateRequirements,
 		connect.WithSchema(templateRequirementServiceMethods.ByName("ListTemplateRequirements")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	templateRequirementServiceGetTemplateRequirementHandler := connect.NewUnaryHandler(
 		TemplateRequirementServiceGetTemplateRequirementProcedure,
 		svc.GetTemplateRequirement,
 		connect.WithSchema(templateRequirementServiceMethods.ByName("GetTemplateRequirement")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	templateRequirementServiceCreateTemplateRequirementHandler := connect.NewUnaryHandler(
@@ -218,12 +218,14 @@ func NewTemplateServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 			httpClient,
 			baseURL+TemplateServiceListTemplatesProcedure,
 			connect.WithSchema(templateServiceMethods.ByName("ListTemplates")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 		getTemplate: connect.NewClient[v1.GetTemplateRequest, v1.GetTemplateResponse](
 			httpClient,
 			baseURL+TemplateServiceGetTemplateProcedure,
 			connect.WithSchema(templateServiceMethods.ByName("GetTemplate")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 		createTemplate: connect.NewClient[v1.CreateTemplateRequest, v1.CreateTemplateResponse](
@@ -260,12 +262,14 @@ func NewTemplateServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 			httpClient,
 			baseURL+TemplateServiceListLinkableTemplatesProcedure,
 			connect.WithSchema(templateServiceMethods.ByName("ListLinkableTemplates")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 		listAncestorTemplates: connect.NewClient[v1.ListAncestorTemplatesRequest, v1.ListAncestorTemplatesResponse](
 			httpClient,
 			baseURL+TemplateServiceListAncestorTemplatesProcedure,
 			connect.WithSchema(templateServiceMethods.ByName("ListAncestorTemplates")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 		createRelease: connect.NewClient[v1.CreateReleaseRequest, v1.CreateReleaseResponse](
@@ -278,48 +282,56 @@ func NewTemplateServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 			httpClient,
 			baseURL+TemplateServiceListReleasesProcedure,
 			connect.WithSchema(templateServiceMethods.ByName("ListReleases")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 		getRelease: connect.NewClient[v1.GetReleaseRequest, v1.GetReleaseResponse](
 			httpClient,
 			baseURL+TemplateServiceGetReleaseProcedure,
 			connect.WithSchema(templateServiceMethods.ByName("GetRelease")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 		getTemplateDefaults: connect.NewClient[v1.GetTemplateDefaultsRequest, v1.GetTemplateDefaultsResponse](
 			httpClient,
 			baseURL+TemplateServiceGetTemplateDefaultsProcedure,
 			connect.WithSchema(templateServiceMethods.ByName("GetTemplateDefaults")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 		getProjectTemplatePolicyState: connect.NewClient[v1.GetProjectTemplatePolicyStateRequest, v1.GetProjectTemplatePolicyStateResponse](
 			httpClient,
 			baseURL+TemplateServiceGetProjectTemplatePolicyStateProcedure,
 			connect.WithSchema(templateServiceMethods.ByName("GetProjectTemplatePolicyState")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 		searchTemplates: connect.NewClient[v1.SearchTemplatesRequest, v1.SearchTemplatesResponse](
 			httpClient,
 			baseURL+TemplateServiceSearchTemplatesProcedure,
 			connect.WithSchema(templateServiceMethods.ByName("SearchTemplates")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 		listTemplateExamples: connect.NewClient[v1.ListTemplateExamplesRequest, v1.ListTemplateExamplesResponse](
 			httpClient,
 			baseURL+TemplateServiceListTemplateExamplesProcedure,
 			connect.WithSchema(templateServiceMethods.ByName("ListTemplateExamples")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 		listTemplateDependents: connect.NewClient[v1.ListTemplateDependentsRequest, v1.ListTemplateDependentsResponse](
 			httpClient,
 			baseURL+TemplateServiceListTemplateDependentsProcedure,
 			connect.WithSchema(templateServiceMethods.ByName("ListTemplateDependents")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 		listDeploymentDependents: connect.NewClient[v1.ListDeploymentDependentsRequest, v1.ListDeploymentDependentsResponse](
 			httpClient,
 			baseURL+TemplateServiceListDeploymentDependentsProcedure,
 			connect.WithSchema(templateServiceMethods.ByName("ListDeploymentDependents")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 	}
@@ -563,12 +575,14 @@ func NewTemplateServiceHandler(svc TemplateServiceHandler, opts ...connect.Handl
 		TemplateServiceListTemplatesProcedure,
 		svc.ListTemplates,
 		connect.WithSchema(templateServiceMethods.ByName("ListTemplates")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	templateServiceGetTemplateHandler := connect.NewUnaryHandler(
 		TemplateServiceGetTemplateProcedure,
 		svc.GetTemplate,
 		connect.WithSchema(templateServiceMethods.ByName("GetTemplate")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	templateServiceCreateTemplateHandler := connect.NewUnaryHandler(
@@ -605,12 +619,14 @@ func NewTemplateServiceHandler(svc TemplateServiceHandler, opts ...connect.Handl
 		TemplateServiceListLinkableTemplatesProcedure,
 		svc.ListLinkableTemplates,
 		connect.WithSchema(templateServiceMethods.ByName("ListLinkableTemplates")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	templateServiceListAncestorTemplatesHandler := connect.NewUnaryHandler(
 		TemplateServiceListAncestorTemplatesProcedure,
 		svc.ListAncestorTemplates,
 		connect.WithSchema(templateServiceMethods.ByName("ListAncestorTemplates")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	templateServiceCreateReleaseHandler := connect.NewUnaryHandler(
@@ -623,48 +639,56 @@ func NewTemplateServiceHandler(svc TemplateServiceHandler, opts ...connect.Handl
 		TemplateServiceListReleasesProcedure,
 		svc.ListReleases,
 		connect.WithSchema(templateServiceMethods.ByName("ListReleases")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	templateServiceGetReleaseHandler := connect.NewUnaryHandler(
 		TemplateServiceGetReleaseProcedure,
 		svc.GetRelease,
 		connect.WithSchema(templateServiceMethods.ByName("GetRelease")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	templateServiceGetTemplateDefaultsHandler := connect.NewUnaryHandler(
 		TemplateServiceGetTemplateDefaultsProcedure,
 		svc.GetTemplateDefaults,
 		connect.WithSchema(templateServiceMethods.ByName("GetTemplateDefaults")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	templateServiceGetProjectTemplatePolicyStateHandler := connect.NewUnaryHandler(
 		TemplateServiceGetProjectTemplatePolicyStateProcedure,
 		svc.GetProjectTemplatePolicyState,
 		connect.WithSchema(templateServiceMethods.ByName("GetProjectTemplatePolicyState")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	templateServiceSearchTemplatesHandler := connect.NewUnaryHandler(
 		TemplateServiceSearchTemplatesProcedure,
 		svc.SearchTemplates,
 		connect.WithSchema(templateServiceMethods.ByName("SearchTemplates")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	templateServiceListTemplateExamplesHandler := connect.NewUnaryHandler(
 		TemplateServiceListTemplateExamplesProcedure,
 		svc.ListTemplateExamples,
 		connect.WithSchema(templateServiceMethods.ByName("ListTemplateExamples")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	templateServiceListTemplateDependentsHandler := connect.NewUnaryHandler(
 		TemplateServiceListTemplateDependentsProcedure,
 		svc.ListTemplateDependents,
 		connect.WithSchema(templateServiceMethods.ByName("ListTemplateDependents")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	templateServiceListDeploymentDependentsHandler := connect.NewUnaryHandler(
 		TemplateServiceListDeploymentDependentsProcedure,
 		svc.ListDeploymentDependents,
 		connect.WithSchema(templateServiceMethods.ByName("ListDeploymentDependents")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	return "/holos.console.v1.TemplateService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
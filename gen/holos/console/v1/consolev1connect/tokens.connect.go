@@ -0,0 +1,181 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: holos/console/v1/tokens.proto
+
+package consolev1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/holos-run/holos-console/gen/holos/console/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// TokensServiceName is the fully-qualified name of the TokensService service.
+	TokensServiceName = "holos.console.v1.TokensService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// TokensServiceCreateTokenProcedure is the fully-qualified name of the TokensService's CreateToken
+	// RPC.
+	TokensServiceCreateTokenProcedure = "/holos.console.v1.TokensService/CreateToken"
+	// TokensServiceListTokensProcedure is the fully-qualified name of the TokensService's ListTokens
+	// RPC.
+	TokensServiceListTokensProcedure = "/holos.console.v1.TokensService/ListTokens"
+	// TokensServiceRevokeTokenProcedure is the fully-qualified name of the TokensService's RevokeToken
+	// RPC.
+	TokensServiceRevokeTokenProcedure = "/holos.console.v1.TokensService/RevokeToken"
+)
+
+// TokensServiceClient is a client for the holos.console.v1.TokensService service.
+type TokensServiceClient interface {
+	// CreateToken mints a token for the caller. The token value is returned
+	// once and cannot be retrieved again. Tokens cannot create further
+	// tokens.
+	CreateToken(context.Context, *connect.Request[v1.CreateTokenRequest]) (*connect.Response[v1.CreateTokenResponse], error)
+	// ListTokens returns the caller's unexpired tokens, newest first.
+	ListTokens(context.Context, *connect.Request[v1.ListTokensRequest]) (*connect.Response[v1.ListTokensResponse], error)
+	// RevokeToken deletes one of the caller's tokens so it is no longer
+	// accepted.
+	RevokeToken(context.Context, *connect.Request[v1.RevokeTokenRequest]) (*connect.Response[v1.RevokeTokenResponse], error)
+}
+
+// NewTokensServiceClient constructs a client for the holos.console.v1.TokensService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewTokensServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) TokensServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	tokensServiceMethods := v1.File_holos_console_v1_tokens_proto.Services().ByName("TokensService").Methods()
+	return &tokensServiceClient{
+		createToken: connect.NewClient[v1.CreateTokenRequest, v1.CreateTokenResponse](
+			httpClient,
+			baseURL+TokensServiceCreateTokenProcedure,
+			connect.WithSchema(tokensServiceMethods.ByName("CreateToken")),
+			connect.WithClientOptions(opts...),
+		),
+		listTokens: connect.NewClient[v1.ListTokensRequest, v1.ListTokensResponse](
+			httpClient,
+			baseURL+TokensServiceListTokensProcedure,
+			connect.WithSchema(tokensServiceMethods.ByName("ListTokens")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		revokeToken: connect.NewClient[v1.RevokeTokenRequest, v1.RevokeTokenResponse](
+			httpClient,
+			baseURL+TokensServiceRevokeTokenProcedure,
+			connect.WithSchema(tokensServiceMethods.ByName("RevokeToken")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// tokensServiceClient implements TokensServiceClient.
+type tokensServiceClient struct {
+	createToken *connect.Client[v1.CreateTokenRequest, v1.CreateTokenResponse]
+	listTokens  *connect.Client[v1.ListTokensRequest, v1.ListTokensResponse]
+	revokeToken *connect.Client[v1.RevokeTokenRequest, v1.RevokeTokenResponse]
+}
+
+// CreateToken calls holos.console.v1.TokensService.CreateToken.
+func (c *tokensServiceClient) CreateToken(ctx context.Context, req *connect.Request[v1.CreateTokenRequest]) (*connect.Response[v1.CreateTokenResponse], error) {
+	return c.createToken.CallUnary(ctx, req)
+}
+
+// ListTokens calls holos.console.v1.TokensService.ListTokens.
+func (c *tokensServiceClient) ListTokens(ctx context.Context, req *connect.Request[v1.ListTokensRequest]) (*connect.Response[v1.ListTokensResponse], error) {
+	return c.listTokens.CallUnary(ctx, req)
+}
+
+// RevokeToken calls holos.console.v1.TokensService.RevokeToken.
+func (c *tokensServiceClient) RevokeToken(ctx context.Context, req *connect.Request[v1.RevokeTokenRequest]) (*connect.Response[v1.RevokeTokenResponse], error) {
+	return c.revokeToken.CallUnary(ctx, req)
+}
+
+// TokensServiceHandler is an implementation of the holos.console.v1.TokensService service.
+type TokensServiceHandler interface {
+	// CreateToken mints a token for the caller. The token value is returned
+	// once and cannot be retrieved again. Tokens cannot create further
+	// tokens.
+	CreateToken(context.Context, *connect.Request[v1.CreateTokenRequest]) (*connect.Response[v1.CreateTokenResponse], error)
+	// ListTokens returns the caller's unexpired tokens, newest first.
+	ListTokens(context.Context, *connect.Request[v1.ListTokensRequest]) (*connect.Response[v1.ListTokensResponse], error)
+	// RevokeToken deletes one of the caller's tokens so it is no longer
+	// accepted.
+	RevokeToken(context.Context, *connect.Request[v1.RevokeTokenRequest]) (*connect.Response[v1.RevokeTokenResponse], error)
+}
+
+// NewTokensServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewTokensServiceHandler(svc TokensServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	tokensServiceMethods := v1.File_holos_console_v1_tokens_proto.Services().ByName("TokensService").Methods()
+	tokensServiceCreateTokenHandler := connect.NewUnaryHandler(
+		TokensServiceCreateTokenProcedure,
+		svc.CreateToken,
+		connect.WithSchema(tokensServiceMethods.ByName("CreateToken")),
+		connect.WithHandlerOptions(opts...),
+	)
+	tokensServiceListTokensHandler := connect.NewUnaryHandler(
+		TokensServiceListTokensProcedure,
+		svc.ListTokens,
+		connect.WithSchema(tokensServiceMethods.ByName("ListTokens")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	tokensServiceRevokeTokenHandler := connect.NewUnaryHandler(
+		TokensServiceRevokeTokenProcedure,
+		svc.RevokeToken,
+		connect.WithSchema(tokensServiceMethods.ByName("RevokeToken")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/holos.console.v1.TokensService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case TokensServiceCreateTokenProcedure:
+			tokensServiceCreateTokenHandler.ServeHTTP(w, r)
+		case TokensServiceListTokensProcedure:
+			tokensServiceListTokensHandler.ServeHTTP(w, r)
+		case TokensServiceRevokeTokenProcedure:
+			tokensServiceRevokeTokenHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedTokensServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedTokensServiceHandler struct{}
+
+func (UnimplementedTokensServiceHandler) CreateToken(context.Context, *connect.Request[v1.CreateTokenRequest]) (*connect.Response[v1.CreateTokenResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("holos.console.v1.TokensService.CreateToken is not implemented"))
+}
+
+func (UnimplementedTokensServiceHandler) ListTokens(context.Context, *connect.Request[v1.ListTokensRequest]) (*connect.Response[v1.ListTokensResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("holos.console.v1.TokensService.ListTokens is not implemented"))
+}
+
+func (UnimplementedTokensServiceHandler) RevokeToken(context.Context, *connect.Request[v1.RevokeTokenRequest]) (*connect.Response[v1.RevokeTokenResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("holos.console.v1.TokensService.RevokeToken is not implemented"))
+}
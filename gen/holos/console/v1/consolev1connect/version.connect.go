@@ -59,6 +59,7 @@ func NewVersionServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			httpClient,
 			baseURL+VersionServiceGetVersionProcedure,
 			connect.WithSchema(versionServiceMethods.ByName("GetVersion")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 	}
@@ -91,6 +92,7 @@ func NewVersionServiceHandler(svc VersionServiceHandler, opts ...connect.Handler
 		VersionServiceGetVersionProcedure,
 		svc.GetVersion,
 		connect.WithSchema(versionServiceMethods.ByName("GetVersion")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	return "/holos.console.v1.VersionService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -0,0 +1,570 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: holos/console/v1/dashboard.proto
+
+package consolev1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// AccessibleResourceType is the kind of an AccessibleResource.
+type AccessibleResourceType int32
+
+const (
+	AccessibleResourceType_ACCESSIBLE_RESOURCE_TYPE_UNSPECIFIED  AccessibleResourceType = 0
+	AccessibleResourceType_ACCESSIBLE_RESOURCE_TYPE_ORGANIZATION AccessibleResourceType = 1
+	AccessibleResourceType_ACCESSIBLE_RESOURCE_TYPE_PROJECT      AccessibleResourceType = 2
+	AccessibleResourceType_ACCESSIBLE_RESOURCE_TYPE_SECRET       AccessibleResourceType = 3
+	// ACCESSIBLE_RESOURCE_TYPE_FOLDER is reported by GetPrincipalAccessReport
+	// only.
+	AccessibleResourceType_ACCESSIBLE_RESOURCE_TYPE_FOLDER AccessibleResourceType = 4
+)
+
+// Enum value maps for AccessibleResourceType.
+var (
+	AccessibleResourceType_name = map[int32]string{
+		0: "ACCESSIBLE_RESOURCE_TYPE_UNSPECIFIED",
+		1: "ACCESSIBLE_RESOURCE_TYPE_ORGANIZATION",
+		2: "ACCESSIBLE_RESOURCE_TYPE_PROJECT",
+		3: "ACCESSIBLE_RESOURCE_TYPE_SECRET",
+		4: "ACCESSIBLE_RESOURCE_TYPE_FOLDER",
+	}
+	AccessibleResourceType_value = map[string]int32{
+		"ACCESSIBLE_RESOURCE_TYPE_UNSPECIFIED":  0,
+		"ACCESSIBLE_RESOURCE_TYPE_ORGANIZATION": 1,
+		"ACCESSIBLE_RESOURCE_TYPE_PROJECT":      2,
+		"ACCESSIBLE_RESOURCE_TYPE_SECRET":       3,
+		"ACCESSIBLE_RESOURCE_TYPE_FOLDER":       4,
+	}
+)
+
+func (x AccessibleResourceType) Enum() *AccessibleResourceType {
+	p := new(AccessibleResourceType)
+	*p = x
+	return p
+}
+
+func (x AccessibleResourceType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AccessibleResourceType) Descriptor() protoreflect.EnumDescriptor {
+	return file_holos_console_v1_dashboard_proto_enumTypes[0].Descriptor()
+}
+
+func (AccessibleResourceType) Type() protoreflect.EnumType {
+	return &file_holos_console_v1_dashboard_proto_enumTypes[0]
+}
+
+func (x AccessibleResourceType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AccessibleResourceType.Descriptor instead.
+func (AccessibleResourceType) EnumDescriptor() ([]byte, []int) {
+	return file_holos_console_v1_dashboard_proto_rawDescGZIP(), []int{0}
+}
+
+// AccessibleResource is one resource the caller can access.
+type AccessibleResource struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Type  AccessibleResourceType `protobuf:"varint,1,opt,name=type,proto3,enum=holos.console.v1.AccessibleResourceType" json:"type,omitempty"`
+	// name is the resource name: the organization, folder, project, or secret
+	// name.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// display_name is the human-readable name, empty for secrets.
+	DisplayName string `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	// organization is the organization the resource belongs to. For
+	// organizations it equals name.
+	Organization string `protobuf:"bytes,4,opt,name=organization,proto3" json:"organization,omitempty"`
+	// project is the project a secret belongs to, or name for projects.
+	// Empty for organizations.
+	Project string `protobuf:"bytes,5,opt,name=project,proto3" json:"project,omitempty"`
+	// role is the caller's effective role on the resource.
+	Role          Role `protobuf:"varint,6,opt,name=role,proto3,enum=holos.console.v1.Role" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccessibleResource) Reset() {
+	*x = AccessibleResource{}
+	mi := &file_holos_console_v1_dashboard_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccessibleResource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessibleResource) ProtoMessage() {}
+
+func (x *AccessibleResource) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_dashboard_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessibleResource.ProtoReflect.Descriptor instead.
+func (*AccessibleResource) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_dashboard_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AccessibleResource) GetType() AccessibleResourceType {
+	if x != nil {
+		return x.Type
+	}
+	return AccessibleResourceType_ACCESSIBLE_RESOURCE_TYPE_UNSPECIFIED
+}
+
+func (x *AccessibleResource) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AccessibleResource) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *AccessibleResource) GetOrganization() string {
+	if x != nil {
+		return x.Organization
+	}
+	return ""
+}
+
+func (x *AccessibleResource) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *AccessibleResource) GetRole() Role {
+	if x != nil {
+		return x.Role
+	}
+	return Role_ROLE_UNSPECIFIED
+}
+
+// ListAccessibleResourcesRequest lists the caller's accessible resources.
+type ListAccessibleResourcesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// types limits the response to the given resource types. Empty returns
+	// every type.
+	Types         []AccessibleResourceType `protobuf:"varint,1,rep,packed,name=types,proto3,enum=holos.console.v1.AccessibleResourceType" json:"types,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAccessibleResourcesRequest) Reset() {
+	*x = ListAccessibleResourcesRequest{}
+	mi := &file_holos_console_v1_dashboard_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccessibleResourcesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccessibleResourcesRequest) ProtoMessage() {}
+
+func (x *ListAccessibleResourcesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_dashboard_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccessibleResourcesRequest.ProtoReflect.Descriptor instead.
+func (*ListAccessibleResourcesRequest) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_dashboard_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListAccessibleResourcesRequest) GetTypes() []AccessibleResourceType {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+// ListAccessibleResourcesResponse lists organizations first, then projects,
+// then secrets, each sorted by organization, project, and name.
+type ListAccessibleResourcesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Resources     []*AccessibleResource  `protobuf:"bytes,1,rep,name=resources,proto3" json:"resources,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAccessibleResourcesResponse) Reset() {
+	*x = ListAccessibleResourcesResponse{}
+	mi := &file_holos_console_v1_dashboard_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccessibleResourcesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccessibleResourcesResponse) ProtoMessage() {}
+
+func (x *ListAccessibleResourcesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_dashboard_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccessibleResourcesResponse.ProtoReflect.Descriptor instead.
+func (*ListAccessibleResourcesResponse) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_dashboard_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListAccessibleResourcesResponse) GetResources() []*AccessibleResource {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+// GetPrincipalAccessReportRequest names the principal to report on.
+type GetPrincipalAccessReportRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// principal is the user's email address or the group name. An "oidc:"
+	// prefix is accepted and ignored.
+	Principal string `protobuf:"bytes,1,opt,name=principal,proto3" json:"principal,omitempty"`
+	// kind is PRINCIPAL_KIND_USER or PRINCIPAL_KIND_GROUP.
+	Kind PrincipalKind `protobuf:"varint,2,opt,name=kind,proto3,enum=holos.console.v1.PrincipalKind" json:"kind,omitempty"`
+	// groups are the groups a user belongs to, so grants naming them are
+	// included. The console does not store group membership; leave empty to
+	// report only the grants naming the user. Ignored for groups.
+	Groups []string `protobuf:"bytes,3,rep,name=groups,proto3" json:"groups,omitempty"`
+	// subject is the user's OIDC subject, matching secret sharing bindings
+	// that name the subject rather than the email address. Optional; ignored
+	// for groups.
+	Subject       string `protobuf:"bytes,4,opt,name=subject,proto3" json:"subject,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPrincipalAccessReportRequest) Reset() {
+	*x = GetPrincipalAccessReportRequest{}
+	mi := &file_holos_console_v1_dashboard_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPrincipalAccessReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPrincipalAccessReportRequest) ProtoMessage() {}
+
+func (x *GetPrincipalAccessReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_dashboard_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPrincipalAccessReportRequest.ProtoReflect.Descriptor instead.
+func (*GetPrincipalAccessReportRequest) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_dashboard_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetPrincipalAccessReportRequest) GetPrincipal() string {
+	if x != nil {
+		return x.Principal
+	}
+	return ""
+}
+
+func (x *GetPrincipalAccessReportRequest) GetKind() PrincipalKind {
+	if x != nil {
+		return x.Kind
+	}
+	return PrincipalKind_PRINCIPAL_KIND_UNSPECIFIED
+}
+
+func (x *GetPrincipalAccessReportRequest) GetGroups() []string {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *GetPrincipalAccessReportRequest) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+// PrincipalAccess is one resource the principal holds a role on.
+type PrincipalAccess struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// resource is the resource and the principal's role on it. Deny grants
+	// win, so denied resources are not reported.
+	Resource *AccessibleResource `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	// sources lists every active grant naming the principal, its email
+	// domain, every user, or one of its groups on the resource. A source on
+	// the project of a secret is the project's secret sharing; a source on
+	// the secret itself is a key grant.
+	Sources []*RoleSource `protobuf:"bytes,2,rep,name=sources,proto3" json:"sources,omitempty"`
+	// keys lists the secret data keys the principal may read when its access
+	// to a secret comes from key grants alone. Empty when the access covers
+	// the whole resource.
+	Keys          []string `protobuf:"bytes,3,rep,name=keys,proto3" json:"keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PrincipalAccess) Reset() {
+	*x = PrincipalAccess{}
+	mi := &file_holos_console_v1_dashboard_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrincipalAccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrincipalAccess) ProtoMessage() {}
+
+func (x *PrincipalAccess) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_dashboard_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrincipalAccess.ProtoReflect.Descriptor instead.
+func (*PrincipalAccess) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_dashboard_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PrincipalAccess) GetResource() *AccessibleResource {
+	if x != nil {
+		return x.Resource
+	}
+	return nil
+}
+
+func (x *PrincipalAccess) GetSources() []*RoleSource {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
+}
+
+func (x *PrincipalAccess) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+// GetPrincipalAccessReportResponse lists organizations first, then folders,
+// projects, and secrets, each sorted by organization, project, and name.
+type GetPrincipalAccessReportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Access        []*PrincipalAccess     `protobuf:"bytes,1,rep,name=access,proto3" json:"access,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPrincipalAccessReportResponse) Reset() {
+	*x = GetPrincipalAccessReportResponse{}
+	mi := &file_holos_console_v1_dashboard_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPrincipalAccessReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPrincipalAccessReportResponse) ProtoMessage() {}
+
+func (x *GetPrincipalAccessReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_dashboard_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPrincipalAccessReportResponse.ProtoReflect.Descriptor instead.
+func (*GetPrincipalAccessReportResponse) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_dashboard_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetPrincipalAccessReportResponse) GetAccess() []*PrincipalAccess {
+	if x != nil {
+		return x.Access
+	}
+	return nil
+}
+
+var File_holos_console_v1_dashboard_proto protoreflect.FileDescriptor
+
+const file_holos_console_v1_dashboard_proto_rawDesc = "" +
+	"\n" +
+	" holos/console/v1/dashboard.proto\x12\x10holos.console.v1\x1a\"holos/console/v1/permissions.proto\x1a\x1bholos/console/v1/rbac.proto\"\xf3\x01\n" +
+	"\x12AccessibleResource\x12<\n" +
+	"\x04type\x18\x01 \x01(\x0e2(.holos.console.v1.AccessibleResourceTypeR\x04type\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12!\n" +
+	"\fdisplay_name\x18\x03 \x01(\tR\vdisplayName\x12\"\n" +
+	"\forganization\x18\x04 \x01(\tR\forganization\x12\x18\n" +
+	"\aproject\x18\x05 \x01(\tR\aproject\x12*\n" +
+	"\x04role\x18\x06 \x01(\x0e2\x16.holos.console.v1.RoleR\x04role\"`\n" +
+	"\x1eListAccessibleResourcesRequest\x12>\n" +
+	"\x05types\x18\x01 \x03(\x0e2(.holos.console.v1.AccessibleResourceTypeR\x05types\"e\n" +
+	"\x1fListAccessibleResourcesResponse\x12B\n" +
+	"\tresources\x18\x01 \x03(\v2$.holos.console.v1.AccessibleResourceR\tresources\"\xa6\x01\n" +
+	"\x1fGetPrincipalAccessReportRequest\x12\x1c\n" +
+	"\tprincipal\x18\x01 \x01(\tR\tprincipal\x123\n" +
+	"\x04kind\x18\x02 \x01(\x0e2\x1f.holos.console.v1.PrincipalKindR\x04kind\x12\x16\n" +
+	"\x06groups\x18\x03 \x03(\tR\x06groups\x12\x18\n" +
+	"\asubject\x18\x04 \x01(\tR\asubject\"\x9f\x01\n" +
+	"\x0fPrincipalAccess\x12@\n" +
+	"\bresource\x18\x01 \x01(\v2$.holos.console.v1.AccessibleResourceR\bresource\x126\n" +
+	"\asources\x18\x02 \x03(\v2\x1c.holos.console.v1.RoleSourceR\asources\x12\x12\n" +
+	"\x04keys\x18\x03 \x03(\tR\x04keys\"]\n" +
+	" GetPrincipalAccessReportResponse\x129\n" +
+	"\x06access\x18\x01 \x03(\v2!.holos.console.v1.PrincipalAccessR\x06access*\xdd\x01\n" +
+	"\x16AccessibleResourceType\x12(\n" +
+	"$ACCESSIBLE_RESOURCE_TYPE_UNSPECIFIED\x10\x00\x12)\n" +
+	"%ACCESSIBLE_RESOURCE_TYPE_ORGANIZATION\x10\x01\x12$\n" +
+	" ACCESSIBLE_RESOURCE_TYPE_PROJECT\x10\x02\x12#\n" +
+	"\x1fACCESSIBLE_RESOURCE_TYPE_SECRET\x10\x03\x12#\n" +
+	"\x1fACCESSIBLE_RESOURCE_TYPE_FOLDER\x10\x042\xa1\x02\n" +
+	"\x10DashboardService\x12\x83\x01\n" +
+	"\x17ListAccessibleResources\x120.holos.console.v1.ListAccessibleResourcesRequest\x1a1.holos.console.v1.ListAccessibleResourcesResponse\"\x03\x90\x02\x01\x12\x86\x01\n" +
+	"\x18GetPrincipalAccessReport\x121.holos.console.v1.GetPrincipalAccessReportRequest\x1a2.holos.console.v1.GetPrincipalAccessReportResponse\"\x03\x90\x02\x01BCZAgithub.com/holos-run/holos-console/gen/holos/console/v1;consolev1b\x06proto3"
+
+var (
+	file_holos_console_v1_dashboard_proto_rawDescOnce sync.Once
+	file_holos_console_v1_dashboard_proto_rawDescData []byte
+)
+
+func file_holos_console_v1_dashboard_proto_rawDescGZIP() []byte {
+	file_holos_console_v1_dashboard_proto_rawDescOnce.Do(func() {
+		file_holos_console_v1_dashboard_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_holos_console_v1_dashboard_proto_rawDesc), len(file_holos_console_v1_dashboard_proto_rawDesc)))
+	})
+	return file_holos_console_v1_dashboard_proto_rawDescData
+}
+
+var file_holos_console_v1_dashboard_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_holos_console_v1_dashboard_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_holos_console_v1_dashboard_proto_goTypes = []any{
+	(AccessibleResourceType)(0),              // 0: holos.console.v1.AccessibleResourceType
+	(*AccessibleResource)(nil),               // 1: holos.console.v1.AccessibleResource
+	(*ListAccessibleResourcesRequest)(nil),   // 2: holos.console.v1.ListAccessibleResourcesRequest
+	(*ListAccessibleResourcesResponse)(nil),  // 3: holos.console.v1.ListAccessibleResourcesResponse
+	(*GetPrincipalAccessReportRequest)(nil),  // 4: holos.console.v1.GetPrincipalAccessReportRequest
+	(*PrincipalAccess)(nil),                  // 5: holos.console.v1.PrincipalAccess
+	(*GetPrincipalAccessReportResponse)(nil), // 6: holos.console.v1.GetPrincipalAccessReportResponse
+	(Role)(0),                                // 7: holos.console.v1.Role
+	(PrincipalKind)(0),                       // 8: holos.console.v1.PrincipalKind
+	(*RoleSource)(nil),                       // 9: holos.console.v1.RoleSource
+}
+var file_holos_console_v1_dashboard_proto_depIdxs = []int32{
+	0,  // 0: holos.console.v1.AccessibleResource.type:type_name -> holos.console.v1.AccessibleResourceType
+	7,  // 1: holos.console.v1.AccessibleResource.role:type_name -> holos.console.v1.Role
+	0,  // 2: holos.console.v1.ListAccessibleResourcesRequest.types:type_name -> holos.console.v1.AccessibleResourceType
+	1,  // 3: holos.console.v1.ListAccessibleResourcesResponse.resources:type_name -> holos.console.v1.AccessibleResource
+	8,  // 4: holos.console.v1.GetPrincipalAccessReportRequest.kind:type_name -> holos.console.v1.PrincipalKind
+	1,  // 5: holos.console.v1.PrincipalAccess.resource:type_name -> holos.console.v1.AccessibleResource
+	9,  // 6: holos.console.v1.PrincipalAccess.sources:type_name -> holos.console.v1.RoleSource
+	5,  // 7: holos.console.v1.GetPrincipalAccessReportResponse.access:type_name -> holos.console.v1.PrincipalAccess
+	2,  // 8: holos.console.v1.DashboardService.ListAccessibleResources:input_type -> holos.console.v1.ListAccessibleResourcesRequest
+	4,  // 9: holos.console.v1.DashboardService.GetPrincipalAccessReport:input_type -> holos.console.v1.GetPrincipalAccessReportRequest
+	3,  // 10: holos.console.v1.DashboardService.ListAccessibleResources:output_type -> holos.console.v1.ListAccessibleResourcesResponse
+	6,  // 11: holos.console.v1.DashboardService.GetPrincipalAccessReport:output_type -> holos.console.v1.GetPrincipalAccessReportResponse
+	10, // [10:12] is the sub-list for method output_type
+	8,  // [8:10] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_holos_console_v1_dashboard_proto_init() }
+func file_holos_console_v1_dashboard_proto_init() {
+	if File_holos_console_v1_dashboard_proto != nil {
+		return
+	}
+	file_holos_console_v1_permissions_proto_init()
+	file_holos_console_v1_rbac_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_holos_console_v1_dashboard_proto_rawDesc), len(file_holos_console_v1_dashboard_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_holos_console_v1_dashboard_proto_goTypes,
+		DependencyIndexes: file_holos_console_v1_dashboard_proto_depIdxs,
+		EnumInfos:         file_holos_console_v1_dashboard_proto_enumTypes,
+		MessageInfos:      file_holos_console_v1_dashboard_proto_msgTypes,
+	}.Build()
+	File_holos_console_v1_dashboard_proto = out.File
+	file_holos_console_v1_dashboard_proto_goTypes = nil
+	file_holos_console_v1_dashboard_proto_depIdxs = nil
+}
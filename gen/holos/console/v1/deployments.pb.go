@@ -644,8 +644,10 @@ func (x *ConfigMapKeyRef) GetKey() string {
 }
 
 type ListDeploymentsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Project       string                 `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Project string                 `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	// order_by sorts the returned deployments.
+	OrderBy       *ListOrder `protobuf:"bytes,2,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -687,6 +689,13 @@ func (x *ListDeploymentsRequest) GetProject() string {
 	return ""
 }
 
+func (x *ListDeploymentsRequest) GetOrderBy() *ListOrder {
+	if x != nil {
+		return x.OrderBy
+	}
+	return nil
+}
+
 type ListDeploymentsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Deployments   []*Deployment          `protobuf:"bytes,1,rep,name=deployments,proto3" json:"deployments,omitempty"`
@@ -3401,7 +3410,7 @@ var File_holos_console_v1_deployments_proto protoreflect.FileDescriptor
 
 const file_holos_console_v1_deployments_proto_rawDesc = "" +
 	"\n" +
-	"\"holos/console/v1/deployments.proto\x12\x10holos.console.v1\x1a#holos/console/v1/policy_state.proto\x1a\x1bholos/console/v1/rbac.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xc9\x04\n" +
+	"\"holos/console/v1/deployments.proto\x12\x10holos.console.v1\x1a#holos/console/v1/policy_state.proto\x1a\"holos/console/v1/list_filter.proto\x1a\x1bholos/console/v1/rbac.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xc9\x04\n" +
 	"\n" +
 	"Deployment\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
@@ -3441,9 +3450,10 @@ const file_holos_console_v1_deployments_proto_rawDesc = "" +
 	"\x03key\x18\x02 \x01(\tR\x03key\"7\n" +
 	"\x0fConfigMapKeyRef\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x10\n" +
-	"\x03key\x18\x02 \x01(\tR\x03key\"2\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\"j\n" +
 	"\x16ListDeploymentsRequest\x12\x18\n" +
-	"\aproject\x18\x01 \x01(\tR\aproject\"Y\n" +
+	"\aproject\x18\x01 \x01(\tR\aproject\x126\n" +
+	"\border_by\x18\x02 \x01(\v2\x1b.holos.console.v1.ListOrderR\aorderBy\"Y\n" +
 	"\x17ListDeploymentsResponse\x12>\n" +
 	"\vdeployments\x18\x01 \x03(\v2\x1c.holos.console.v1.DeploymentR\vdeployments\"D\n" +
 	"\x14GetDeploymentRequest\x12\x12\n" +
@@ -3649,22 +3659,22 @@ const file_holos_console_v1_deployments_proto_rawDesc = "" +
 	"\x18DEPLOYMENT_PHASE_PENDING\x10\x01\x12\x1c\n" +
 	"\x18DEPLOYMENT_PHASE_RUNNING\x10\x02\x12\x1b\n" +
 	"\x17DEPLOYMENT_PHASE_FAILED\x10\x03\x12\x1e\n" +
-	"\x1aDEPLOYMENT_PHASE_SUCCEEDED\x10\x042\xa0\x0e\n" +
-	"\x11DeploymentService\x12f\n" +
-	"\x0fListDeployments\x12(.holos.console.v1.ListDeploymentsRequest\x1a).holos.console.v1.ListDeploymentsResponse\x12`\n" +
-	"\rGetDeployment\x12&.holos.console.v1.GetDeploymentRequest\x1a'.holos.console.v1.GetDeploymentResponse\x12i\n" +
+	"\x1aDEPLOYMENT_PHASE_SUCCEEDED\x10\x042\xc9\x0e\n" +
+	"\x11DeploymentService\x12k\n" +
+	"\x0fListDeployments\x12(.holos.console.v1.ListDeploymentsRequest\x1a).holos.console.v1.ListDeploymentsResponse\"\x03\x90\x02\x01\x12e\n" +
+	"\rGetDeployment\x12&.holos.console.v1.GetDeploymentRequest\x1a'.holos.console.v1.GetDeploymentResponse\"\x03\x90\x02\x01\x12i\n" +
 	"\x10CreateDeployment\x12).holos.console.v1.CreateDeploymentRequest\x1a*.holos.console.v1.CreateDeploymentResponse\x12i\n" +
 	"\x10UpdateDeployment\x12).holos.console.v1.UpdateDeploymentRequest\x1a*.holos.console.v1.UpdateDeploymentResponse\x12i\n" +
-	"\x10DeleteDeployment\x12).holos.console.v1.DeleteDeploymentRequest\x1a*.holos.console.v1.DeleteDeploymentResponse\x12r\n" +
-	"\x13GetDeploymentStatus\x12,.holos.console.v1.GetDeploymentStatusRequest\x1a-.holos.console.v1.GetDeploymentStatusResponse\x12\x87\x01\n" +
-	"\x1aGetDeploymentStatusSummary\x123.holos.console.v1.GetDeploymentStatusSummaryRequest\x1a4.holos.console.v1.GetDeploymentStatusSummaryResponse\x12l\n" +
-	"\x11GetDeploymentLogs\x12*.holos.console.v1.GetDeploymentLogsRequest\x1a+.holos.console.v1.GetDeploymentLogsResponse\x12u\n" +
-	"\x14ListNamespaceSecrets\x12-.holos.console.v1.ListNamespaceSecretsRequest\x1a..holos.console.v1.ListNamespaceSecretsResponse\x12~\n" +
-	"\x17ListNamespaceConfigMaps\x120.holos.console.v1.ListNamespaceConfigMapsRequest\x1a1.holos.console.v1.ListNamespaceConfigMapsResponse\x12\x87\x01\n" +
-	"\x1aGetDeploymentRenderPreview\x123.holos.console.v1.GetDeploymentRenderPreviewRequest\x1a4.holos.console.v1.GetDeploymentRenderPreviewResponse\x12\x81\x01\n" +
-	"\x18GetDeploymentPolicyState\x121.holos.console.v1.GetDeploymentPolicyStateRequest\x1a2.holos.console.v1.GetDeploymentPolicyStateResponse\x12c\n" +
-	"\x0ePreflightCheck\x12'.holos.console.v1.PreflightCheckRequest\x1a(.holos.console.v1.PreflightCheckResponse\x12\x93\x01\n" +
-	"\x1eGetDependencyEdgeCascadeDelete\x127.holos.console.v1.GetDependencyEdgeCascadeDeleteRequest\x1a8.holos.console.v1.GetDependencyEdgeCascadeDeleteResponse\x12\x93\x01\n" +
+	"\x10DeleteDeployment\x12).holos.console.v1.DeleteDeploymentRequest\x1a*.holos.console.v1.DeleteDeploymentResponse\x12w\n" +
+	"\x13GetDeploymentStatus\x12,.holos.console.v1.GetDeploymentStatusRequest\x1a-.holos.console.v1.GetDeploymentStatusResponse\"\x03\x90\x02\x01\x12\x8c\x01\n" +
+	"\x1aGetDeploymentStatusSummary\x123.holos.console.v1.GetDeploymentStatusSummaryRequest\x1a4.holos.console.v1.GetDeploymentStatusSummaryResponse\"\x03\x90\x02\x01\x12l\n" +
+	"\x11GetDeploymentLogs\x12*.holos.console.v1.GetDeploymentLogsRequest\x1a+.holos.console.v1.GetDeploymentLogsResponse\x12z\n" +
+	"\x14ListNamespaceSecrets\x12-.holos.console.v1.ListNamespaceSecretsRequest\x1a..holos.console.v1.ListNamespaceSecretsResponse\"\x03\x90\x02\x01\x12\x83\x01\n" +
+	"\x17ListNamespaceConfigMaps\x120.holos.console.v1.ListNamespaceConfigMapsRequest\x1a1.holos.console.v1.ListNamespaceConfigMapsResponse\"\x03\x90\x02\x01\x12\x87\x01\n" +
+	"\x1aGetDeploymentRenderPreview\x123.holos.console.v1.GetDeploymentRenderPreviewRequest\x1a4.holos.console.v1.GetDeploymentRenderPreviewResponse\x12\x86\x01\n" +
+	"\x18GetDeploymentPolicyState\x121.holos.console.v1.GetDeploymentPolicyStateRequest\x1a2.holos.console.v1.GetDeploymentPolicyStateResponse\"\x03\x90\x02\x01\x12c\n" +
+	"\x0ePreflightCheck\x12'.holos.console.v1.PreflightCheckRequest\x1a(.holos.console.v1.PreflightCheckResponse\x12\x98\x01\n" +
+	"\x1eGetDependencyEdgeCascadeDelete\x127.holos.console.v1.GetDependencyEdgeCascadeDeleteRequest\x1a8.holos.console.v1.GetDependencyEdgeCascadeDeleteResponse\"\x03\x90\x02\x01\x12\x93\x01\n" +
 	"\x1eSetDependencyEdgeCascadeDelete\x127.holos.console.v1.SetDependencyEdgeCascadeDeleteRequest\x1a8.holos.console.v1.SetDependencyEdgeCascadeDeleteResponseBCZAgithub.com/holos-run/holos-console/gen/holos/console/v1;consolev1b\x06proto3"
 
 var (
@@ -3730,9 +3740,10 @@ var file_holos_console_v1_deployments_proto_goTypes = []any{
 	(*SetDependencyEdgeCascadeDeleteRequest)(nil),  // 45: holos.console.v1.SetDependencyEdgeCascadeDeleteRequest
 	(*SetDependencyEdgeCascadeDeleteResponse)(nil), // 46: holos.console.v1.SetDependencyEdgeCascadeDeleteResponse
 	(*LinkedTemplateRef)(nil),                      // 47: holos.console.v1.LinkedTemplateRef
-	(*timestamppb.Timestamp)(nil),                  // 48: google.protobuf.Timestamp
-	(*GetDeploymentPolicyStateRequest)(nil),        // 49: holos.console.v1.GetDeploymentPolicyStateRequest
-	(*GetDeploymentPolicyStateResponse)(nil),       // 50: holos.console.v1.GetDeploymentPolicyStateResponse
+	(*ListOrder)(nil),                              // 48: holos.console.v1.ListOrder
+	(*timestamppb.Timestamp)(nil),                  // 49: google.protobuf.Timestamp
+	(*GetDeploymentPolicyStateRequest)(nil),        // 50: holos.console.v1.GetDeploymentPolicyStateRequest
+	(*GetDeploymentPolicyStateResponse)(nil),       // 51: holos.console.v1.GetDeploymentPolicyStateResponse
 }
 var file_holos_console_v1_deployments_proto_depIdxs = []int32{
 	0,  // 0: holos.console.v1.Deployment.phase:type_name -> holos.console.v1.DeploymentPhase
@@ -3743,68 +3754,69 @@ var file_holos_console_v1_deployments_proto_depIdxs = []int32{
 	3,  // 5: holos.console.v1.DeploymentDependency.originating_object:type_name -> holos.console.v1.OriginatingObject
 	5,  // 6: holos.console.v1.EnvVar.secret_key_ref:type_name -> holos.console.v1.SecretKeyRef
 	6,  // 7: holos.console.v1.EnvVar.config_map_key_ref:type_name -> holos.console.v1.ConfigMapKeyRef
-	1,  // 8: holos.console.v1.ListDeploymentsResponse.deployments:type_name -> holos.console.v1.Deployment
-	1,  // 9: holos.console.v1.GetDeploymentResponse.deployment:type_name -> holos.console.v1.Deployment
-	4,  // 10: holos.console.v1.CreateDeploymentRequest.env:type_name -> holos.console.v1.EnvVar
-	4,  // 11: holos.console.v1.UpdateDeploymentRequest.env:type_name -> holos.console.v1.EnvVar
-	19, // 12: holos.console.v1.DeploymentStatus.conditions:type_name -> holos.console.v1.DeploymentCondition
-	20, // 13: holos.console.v1.DeploymentStatus.pods:type_name -> holos.console.v1.PodStatus
-	21, // 14: holos.console.v1.DeploymentStatus.events:type_name -> holos.console.v1.Event
-	24, // 15: holos.console.v1.DeploymentStatus.summary:type_name -> holos.console.v1.DeploymentStatusSummary
-	22, // 16: holos.console.v1.PodStatus.container_statuses:type_name -> holos.console.v1.ContainerStatus
-	21, // 17: holos.console.v1.PodStatus.events:type_name -> holos.console.v1.Event
-	48, // 18: holos.console.v1.Event.first_seen:type_name -> google.protobuf.Timestamp
-	48, // 19: holos.console.v1.Event.last_seen:type_name -> google.protobuf.Timestamp
-	48, // 20: holos.console.v1.ContainerStatus.started_at:type_name -> google.protobuf.Timestamp
-	18, // 21: holos.console.v1.GetDeploymentStatusResponse.status:type_name -> holos.console.v1.DeploymentStatus
-	0,  // 22: holos.console.v1.DeploymentStatusSummary.phase:type_name -> holos.console.v1.DeploymentPhase
-	36, // 23: holos.console.v1.DeploymentStatusSummary.output:type_name -> holos.console.v1.DeploymentOutput
-	24, // 24: holos.console.v1.GetDeploymentStatusSummaryResponse.summary:type_name -> holos.console.v1.DeploymentStatusSummary
-	29, // 25: holos.console.v1.ListNamespaceSecretsResponse.secrets:type_name -> holos.console.v1.NamespaceResource
-	29, // 26: holos.console.v1.ListNamespaceConfigMapsResponse.config_maps:type_name -> holos.console.v1.NamespaceResource
-	36, // 27: holos.console.v1.GetDeploymentRenderPreviewResponse.output:type_name -> holos.console.v1.DeploymentOutput
-	37, // 28: holos.console.v1.DeploymentOutput.links:type_name -> holos.console.v1.Link
-	47, // 29: holos.console.v1.PlannedDeployment.linked_template_ref:type_name -> holos.console.v1.LinkedTemplateRef
-	38, // 30: holos.console.v1.PreflightCheckRequest.planned_deployments:type_name -> holos.console.v1.PlannedDeployment
-	39, // 31: holos.console.v1.PreflightCheckResponse.collisions:type_name -> holos.console.v1.CollisionDetail
-	40, // 32: holos.console.v1.PreflightCheckResponse.version_conflicts:type_name -> holos.console.v1.VersionConflictDetail
-	3,  // 33: holos.console.v1.GetDependencyEdgeCascadeDeleteRequest.originating_object:type_name -> holos.console.v1.OriginatingObject
-	3,  // 34: holos.console.v1.SetDependencyEdgeCascadeDeleteRequest.originating_object:type_name -> holos.console.v1.OriginatingObject
-	7,  // 35: holos.console.v1.DeploymentService.ListDeployments:input_type -> holos.console.v1.ListDeploymentsRequest
-	9,  // 36: holos.console.v1.DeploymentService.GetDeployment:input_type -> holos.console.v1.GetDeploymentRequest
-	11, // 37: holos.console.v1.DeploymentService.CreateDeployment:input_type -> holos.console.v1.CreateDeploymentRequest
-	13, // 38: holos.console.v1.DeploymentService.UpdateDeployment:input_type -> holos.console.v1.UpdateDeploymentRequest
-	15, // 39: holos.console.v1.DeploymentService.DeleteDeployment:input_type -> holos.console.v1.DeleteDeploymentRequest
-	17, // 40: holos.console.v1.DeploymentService.GetDeploymentStatus:input_type -> holos.console.v1.GetDeploymentStatusRequest
-	25, // 41: holos.console.v1.DeploymentService.GetDeploymentStatusSummary:input_type -> holos.console.v1.GetDeploymentStatusSummaryRequest
-	27, // 42: holos.console.v1.DeploymentService.GetDeploymentLogs:input_type -> holos.console.v1.GetDeploymentLogsRequest
-	30, // 43: holos.console.v1.DeploymentService.ListNamespaceSecrets:input_type -> holos.console.v1.ListNamespaceSecretsRequest
-	32, // 44: holos.console.v1.DeploymentService.ListNamespaceConfigMaps:input_type -> holos.console.v1.ListNamespaceConfigMapsRequest
-	34, // 45: holos.console.v1.DeploymentService.GetDeploymentRenderPreview:input_type -> holos.console.v1.GetDeploymentRenderPreviewRequest
-	49, // 46: holos.console.v1.DeploymentService.GetDeploymentPolicyState:input_type -> holos.console.v1.GetDeploymentPolicyStateRequest
-	41, // 47: holos.console.v1.DeploymentService.PreflightCheck:input_type -> holos.console.v1.PreflightCheckRequest
-	43, // 48: holos.console.v1.DeploymentService.GetDependencyEdgeCascadeDelete:input_type -> holos.console.v1.GetDependencyEdgeCascadeDeleteRequest
-	45, // 49: holos.console.v1.DeploymentService.SetDependencyEdgeCascadeDelete:input_type -> holos.console.v1.SetDependencyEdgeCascadeDeleteRequest
-	8,  // 50: holos.console.v1.DeploymentService.ListDeployments:output_type -> holos.console.v1.ListDeploymentsResponse
-	10, // 51: holos.console.v1.DeploymentService.GetDeployment:output_type -> holos.console.v1.GetDeploymentResponse
-	12, // 52: holos.console.v1.DeploymentService.CreateDeployment:output_type -> holos.console.v1.CreateDeploymentResponse
-	14, // 53: holos.console.v1.DeploymentService.UpdateDeployment:output_type -> holos.console.v1.UpdateDeploymentResponse
-	16, // 54: holos.console.v1.DeploymentService.DeleteDeployment:output_type -> holos.console.v1.DeleteDeploymentResponse
-	23, // 55: holos.console.v1.DeploymentService.GetDeploymentStatus:output_type -> holos.console.v1.GetDeploymentStatusResponse
-	26, // 56: holos.console.v1.DeploymentService.GetDeploymentStatusSummary:output_type -> holos.console.v1.GetDeploymentStatusSummaryResponse
-	28, // 57: holos.console.v1.DeploymentService.GetDeploymentLogs:output_type -> holos.console.v1.GetDeploymentLogsResponse
-	31, // 58: holos.console.v1.DeploymentService.ListNamespaceSecrets:output_type -> holos.console.v1.ListNamespaceSecretsResponse
-	33, // 59: holos.console.v1.DeploymentService.ListNamespaceConfigMaps:output_type -> holos.console.v1.ListNamespaceConfigMapsResponse
-	35, // 60: holos.console.v1.DeploymentService.GetDeploymentRenderPreview:output_type -> holos.console.v1.GetDeploymentRenderPreviewResponse
-	50, // 61: holos.console.v1.DeploymentService.GetDeploymentPolicyState:output_type -> holos.console.v1.GetDeploymentPolicyStateResponse
-	42, // 62: holos.console.v1.DeploymentService.PreflightCheck:output_type -> holos.console.v1.PreflightCheckResponse
-	44, // 63: holos.console.v1.DeploymentService.GetDependencyEdgeCascadeDelete:output_type -> holos.console.v1.GetDependencyEdgeCascadeDeleteResponse
-	46, // 64: holos.console.v1.DeploymentService.SetDependencyEdgeCascadeDelete:output_type -> holos.console.v1.SetDependencyEdgeCascadeDeleteResponse
-	50, // [50:65] is the sub-list for method output_type
-	35, // [35:50] is the sub-list for method input_type
-	35, // [35:35] is the sub-list for extension type_name
-	35, // [35:35] is the sub-list for extension extendee
-	0,  // [0:35] is the sub-list for field type_name
+	48, // 8: holos.console.v1.ListDeploymentsRequest.order_by:type_name -> holos.console.v1.ListOrder
+	1,  // 9: holos.console.v1.ListDeploymentsResponse.deployments:type_name -> holos.console.v1.Deployment
+	1,  // 10: holos.console.v1.GetDeploymentResponse.deployment:type_name -> holos.console.v1.Deployment
+	4,  // 11: holos.console.v1.CreateDeploymentRequest.env:type_name -> holos.console.v1.EnvVar
+	4,  // 12: holos.console.v1.UpdateDeploymentRequest.env:type_name -> holos.console.v1.EnvVar
+	19, // 13: holos.console.v1.DeploymentStatus.conditions:type_name -> holos.console.v1.DeploymentCondition
+	20, // 14: holos.console.v1.DeploymentStatus.pods:type_name -> holos.console.v1.PodStatus
+	21, // 15: holos.console.v1.DeploymentStatus.events:type_name -> holos.console.v1.Event
+	24, // 16: holos.console.v1.DeploymentStatus.summary:type_name -> holos.console.v1.DeploymentStatusSummary
+	22, // 17: holos.console.v1.PodStatus.container_statuses:type_name -> holos.console.v1.ContainerStatus
+	21, // 18: holos.console.v1.PodStatus.events:type_name -> holos.console.v1.Event
+	49, // 19: holos.console.v1.Event.first_seen:type_name -> google.protobuf.Timestamp
+	49, // 20: holos.console.v1.Event.last_seen:type_name -> google.protobuf.Timestamp
+	49, // 21: holos.console.v1.ContainerStatus.started_at:type_name -> google.protobuf.Timestamp
+	18, // 22: holos.console.v1.GetDeploymentStatusResponse.status:type_name -> holos.console.v1.DeploymentStatus
+	0,  // 23: holos.console.v1.DeploymentStatusSummary.phase:type_name -> holos.console.v1.DeploymentPhase
+	36, // 24: holos.console.v1.DeploymentStatusSummary.output:type_name -> holos.console.v1.DeploymentOutput
+	24, // 25: holos.console.v1.GetDeploymentStatusSummaryResponse.summary:type_name -> holos.console.v1.DeploymentStatusSummary
+	29, // 26: holos.console.v1.ListNamespaceSecretsResponse.secrets:type_name -> holos.console.v1.NamespaceResource
+	29, // 27: holos.console.v1.ListNamespaceConfigMapsResponse.config_maps:type_name -> holos.console.v1.NamespaceResource
+	36, // 28: holos.console.v1.GetDeploymentRenderPreviewResponse.output:type_name -> holos.console.v1.DeploymentOutput
+	37, // 29: holos.console.v1.DeploymentOutput.links:type_name -> holos.console.v1.Link
+	47, // 30: holos.console.v1.PlannedDeployment.linked_template_ref:type_name -> holos.console.v1.LinkedTemplateRef
+	38, // 31: holos.console.v1.PreflightCheckRequest.planned_deployments:type_name -> holos.console.v1.PlannedDeployment
+	39, // 32: holos.console.v1.PreflightCheckResponse.collisions:type_name -> holos.console.v1.CollisionDetail
+	40, // 33: holos.console.v1.PreflightCheckResponse.version_conflicts:type_name -> holos.console.v1.VersionConflictDetail
+	3,  // 34: holos.console.v1.GetDependencyEdgeCascadeDeleteRequest.originating_object:type_name -> holos.console.v1.OriginatingObject
+	3,  // 35: holos.console.v1.SetDependencyEdgeCascadeDeleteRequest.originating_object:type_name -> holos.console.v1.OriginatingObject
+	7,  // 36: holos.console.v1.DeploymentService.ListDeployments:input_type -> holos.console.v1.ListDeploymentsRequest
+	9,  // 37: holos.console.v1.DeploymentService.GetDeployment:input_type -> holos.console.v1.GetDeploymentRequest
+	11, // 38: holos.console.v1.DeploymentService.CreateDeployment:input_type -> holos.console.v1.CreateDeploymentRequest
+	13, // 39: holos.console.v1.DeploymentService.UpdateDeployment:input_type -> holos.console.v1.UpdateDeploymentRequest
+	15, // 40: holos.console.v1.DeploymentService.DeleteDeployment:input_type -> holos.console.v1.DeleteDeploymentRequest
+	17, // 41: holos.console.v1.DeploymentService.GetDeploymentStatus:input_type -> holos.console.v1.GetDeploymentStatusRequest
+	25, // 42: holos.console.v1.DeploymentService.GetDeploymentStatusSummary:input_type -> holos.console.v1.GetDeploymentStatusSummaryRequest
+	27, // 43: holos.console.v1.DeploymentService.GetDeploymentLogs:input_type -> holos.console.v1.GetDeploymentLogsRequest
+	30, // 44: holos.console.v1.DeploymentService.ListNamespaceSecrets:input_type -> holos.console.v1.ListNamespaceSecretsRequest
+	32, // 45: holos.console.v1.DeploymentService.ListNamespaceConfigMaps:input_type -> holos.console.v1.ListNamespaceConfigMapsRequest
+	34, // 46: holos.console.v1.DeploymentService.GetDeploymentRenderPreview:input_type -> holos.console.v1.GetDeploymentRenderPreviewRequest
+	50, // 47: holos.console.v1.DeploymentService.GetDeploymentPolicyState:input_type -> holos.console.v1.GetDeploymentPolicyStateRequest
+	41, // 48: holos.console.v1.DeploymentService.PreflightCheck:input_type -> holos.console.v1.PreflightCheckRequest
+	43, // 49: holos.console.v1.DeploymentService.GetDependencyEdgeCascadeDelete:input_type -> holos.console.v1.GetDependencyEdgeCascadeDeleteRequest
+	45, // 50: holos.console.v1.DeploymentService.SetDependencyEdgeCascadeDelete:input_type -> holos.console.v1.SetDependencyEdgeCascadeDeleteRequest
+	8,  // 51: holos.console.v1.DeploymentService.ListDeployments:output_type -> holos.console.v1.ListDeploymentsResponse
+	10, // 52: holos.console.v1.DeploymentService.GetDeployment:output_type -> holos.console.v1.GetDeploymentResponse
+	12, // 53: holos.console.v1.DeploymentService.CreateDeployment:output_type -> holos.console.v1.CreateDeploymentResponse
+	14, // 54: holos.console.v1.DeploymentService.UpdateDeployment:output_type -> holos.console.v1.UpdateDeploymentResponse
+	16, // 55: holos.console.v1.DeploymentService.DeleteDeployment:output_type -> holos.console.v1.DeleteDeploymentResponse
+	23, // 56: holos.console.v1.DeploymentService.GetDeploymentStatus:output_type -> holos.console.v1.GetDeploymentStatusResponse
+	26, // 57: holos.console.v1.DeploymentService.GetDeploymentStatusSummary:output_type -> holos.console.v1.GetDeploymentStatusSummaryResponse
+	28, // 58: holos.console.v1.DeploymentService.GetDeploymentLogs:output_type -> holos.console.v1.GetDeploymentLogsResponse
+	31, // 59: holos.console.v1.DeploymentService.ListNamespaceSecrets:output_type -> holos.console.v1.ListNamespaceSecretsResponse
+	33, // 60: holos.console.v1.DeploymentService.ListNamespaceConfigMaps:output_type -> holos.console.v1.ListNamespaceConfigMapsResponse
+	35, // 61: holos.console.v1.DeploymentService.GetDeploymentRenderPreview:output_type -> holos.console.v1.GetDeploymentRenderPreviewResponse
+	51, // 62: holos.console.v1.DeploymentService.GetDeploymentPolicyState:output_type -> holos.console.v1.GetDeploymentPolicyStateResponse
+	42, // 63: holos.console.v1.DeploymentService.PreflightCheck:output_type -> holos.console.v1.PreflightCheckResponse
+	44, // 64: holos.console.v1.DeploymentService.GetDependencyEdgeCascadeDelete:output_type -> holos.console.v1.GetDependencyEdgeCascadeDeleteResponse
+	46, // 65: holos.console.v1.DeploymentService.SetDependencyEdgeCascadeDelete:output_type -> holos.console.v1.SetDependencyEdgeCascadeDeleteResponse
+	51, // [51:66] is the sub-list for method output_type
+	36, // [36:51] is the sub-list for method input_type
+	36, // [36:36] is the sub-list for extension type_name
+	36, // [36:36] is the sub-list for extension extendee
+	0,  // [0:36] is the sub-list for field type_name
 }
 
 func init() { file_holos_console_v1_deployments_proto_init() }
@@ -3813,6 +3825,7 @@ func file_holos_console_v1_deployments_proto_init() {
 		return
 	}
 	file_holos_console_v1_policy_state_proto_init()
+	file_holos_console_v1_list_filter_proto_init()
 	file_holos_console_v1_rbac_proto_init()
 	file_holos_console_v1_deployments_proto_msgTypes[3].OneofWrappers = []any{
 		(*EnvVar_Value)(nil),
@@ -0,0 +1,221 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: holos/console/v1/diff.proto
+
+package consolev1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ChangeType classifies one entry of a Diff* RPC response.
+type ChangeType int32
+
+const (
+	// CHANGE_TYPE_UNSPECIFIED is never returned.
+	ChangeType_CHANGE_TYPE_UNSPECIFIED ChangeType = 0
+	// CHANGE_TYPE_ADDED means the update sets an entry that does not exist.
+	ChangeType_CHANGE_TYPE_ADDED ChangeType = 1
+	// CHANGE_TYPE_REMOVED means the update removes an existing entry.
+	ChangeType_CHANGE_TYPE_REMOVED ChangeType = 2
+	// CHANGE_TYPE_MODIFIED means the update changes an existing entry.
+	ChangeType_CHANGE_TYPE_MODIFIED ChangeType = 3
+)
+
+// Enum value maps for ChangeType.
+var (
+	ChangeType_name = map[int32]string{
+		0: "CHANGE_TYPE_UNSPECIFIED",
+		1: "CHANGE_TYPE_ADDED",
+		2: "CHANGE_TYPE_REMOVED",
+		3: "CHANGE_TYPE_MODIFIED",
+	}
+	ChangeType_value = map[string]int32{
+		"CHANGE_TYPE_UNSPECIFIED": 0,
+		"CHANGE_TYPE_ADDED":       1,
+		"CHANGE_TYPE_REMOVED":     2,
+		"CHANGE_TYPE_MODIFIED":    3,
+	}
+)
+
+func (x ChangeType) Enum() *ChangeType {
+	p := new(ChangeType)
+	*p = x
+	return p
+}
+
+func (x ChangeType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChangeType) Descriptor() protoreflect.EnumDescriptor {
+	return file_holos_console_v1_diff_proto_enumTypes[0].Descriptor()
+}
+
+func (ChangeType) Type() protoreflect.EnumType {
+	return &file_holos_console_v1_diff_proto_enumTypes[0]
+}
+
+func (x ChangeType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChangeType.Descriptor instead.
+func (ChangeType) EnumDescriptor() ([]byte, []int) {
+	return file_holos_console_v1_diff_proto_rawDescGZIP(), []int{0}
+}
+
+// FieldChange describes how an update would change one metadata field.
+type FieldChange struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// field is the request field name, e.g. "display_name".
+	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	// change classifies the change.
+	Change ChangeType `protobuf:"varint,2,opt,name=change,proto3,enum=holos.console.v1.ChangeType" json:"change,omitempty"`
+	// old_value is the current value, empty when change is ADDED.
+	OldValue string `protobuf:"bytes,3,opt,name=old_value,json=oldValue,proto3" json:"old_value,omitempty"`
+	// new_value is the proposed value, empty when change is REMOVED.
+	NewValue      string `protobuf:"bytes,4,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FieldChange) Reset() {
+	*x = FieldChange{}
+	mi := &file_holos_console_v1_diff_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FieldChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldChange) ProtoMessage() {}
+
+func (x *FieldChange) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_diff_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldChange.ProtoReflect.Descriptor instead.
+func (*FieldChange) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_diff_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *FieldChange) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *FieldChange) GetChange() ChangeType {
+	if x != nil {
+		return x.Change
+	}
+	return ChangeType_CHANGE_TYPE_UNSPECIFIED
+}
+
+func (x *FieldChange) GetOldValue() string {
+	if x != nil {
+		return x.OldValue
+	}
+	return ""
+}
+
+func (x *FieldChange) GetNewValue() string {
+	if x != nil {
+		return x.NewValue
+	}
+	return ""
+}
+
+var File_holos_console_v1_diff_proto protoreflect.FileDescriptor
+
+const file_holos_console_v1_diff_proto_rawDesc = "" +
+	"\n" +
+	"\x1bholos/console/v1/diff.proto\x12\x10holos.console.v1\"\x93\x01\n" +
+	"\vFieldChange\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x124\n" +
+	"\x06change\x18\x02 \x01(\x0e2\x1c.holos.console.v1.ChangeTypeR\x06change\x12\x1b\n" +
+	"\told_value\x18\x03 \x01(\tR\boldValue\x12\x1b\n" +
+	"\tnew_value\x18\x04 \x01(\tR\bnewValue*s\n" +
+	"\n" +
+	"ChangeType\x12\x1b\n" +
+	"\x17CHANGE_TYPE_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11CHANGE_TYPE_ADDED\x10\x01\x12\x17\n" +
+	"\x13CHANGE_TYPE_REMOVED\x10\x02\x12\x18\n" +
+	"\x14CHANGE_TYPE_MODIFIED\x10\x03BCZAgithub.com/holos-run/holos-console/gen/holos/console/v1;consolev1b\x06proto3"
+
+var (
+	file_holos_console_v1_diff_proto_rawDescOnce sync.Once
+	file_holos_console_v1_diff_proto_rawDescData []byte
+)
+
+func file_holos_console_v1_diff_proto_rawDescGZIP() []byte {
+	file_holos_console_v1_diff_proto_rawDescOnce.Do(func() {
+		file_holos_console_v1_diff_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_holos_console_v1_diff_proto_rawDesc), len(file_holos_console_v1_diff_proto_rawDesc)))
+	})
+	return file_holos_console_v1_diff_proto_rawDescData
+}
+
+var file_holos_console_v1_diff_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_holos_console_v1_diff_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_holos_console_v1_diff_proto_goTypes = []any{
+	(ChangeType)(0),     // 0: holos.console.v1.ChangeType
+	(*FieldChange)(nil), // 1: holos.console.v1.FieldChange
+}
+var file_holos_console_v1_diff_proto_depIdxs = []int32{
+	0, // 0: holos.console.v1.FieldChange.change:type_name -> holos.console.v1.ChangeType
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_holos_console_v1_diff_proto_init() }
+func file_holos_console_v1_diff_proto_init() {
+	if File_holos_console_v1_diff_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_holos_console_v1_diff_proto_rawDesc), len(file_holos_console_v1_diff_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_holos_console_v1_diff_proto_goTypes,
+		DependencyIndexes: file_holos_console_v1_diff_proto_depIdxs,
+		EnumInfos:         file_holos_console_v1_diff_proto_enumTypes,
+		MessageInfos:      file_holos_console_v1_diff_proto_msgTypes,
+	}.Build()
+	File_holos_console_v1_diff_proto = out.File
+	file_holos_console_v1_diff_proto_goTypes = nil
+	file_holos_console_v1_diff_proto_depIdxs = nil
+}
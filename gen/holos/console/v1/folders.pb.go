@@ -7,6 +7,7 @@
 package consolev1
 
 import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -235,8 +236,10 @@ type ListFoldersRequest struct {
 	// parent_type and parent_name together filter to immediate children of a
 	// specific parent. When both are empty, returns all accessible folders in
 	// the organization.
-	ParentType    ParentType `protobuf:"varint,2,opt,name=parent_type,json=parentType,proto3,enum=holos.console.v1.ParentType" json:"parent_type,omitempty"`
-	ParentName    string     `protobuf:"bytes,3,opt,name=parent_name,json=parentName,proto3" json:"parent_name,omitempty"`
+	ParentType ParentType `protobuf:"varint,2,opt,name=parent_type,json=parentType,proto3,enum=holos.console.v1.ParentType" json:"parent_type,omitempty"`
+	ParentName string     `protobuf:"bytes,3,opt,name=parent_name,json=parentName,proto3" json:"parent_name,omitempty"`
+	// order_by sorts the returned folders.
+	OrderBy       *ListOrder `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -292,6 +295,13 @@ func (x *ListFoldersRequest) GetParentName() string {
 	return ""
 }
 
+func (x *ListFoldersRequest) GetOrderBy() *ListOrder {
+	if x != nil {
+		return x.OrderBy
+	}
+	return nil
+}
+
 // ListFoldersResponse contains the list of folders the user can access.
 type ListFoldersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1257,7 +1267,7 @@ var File_holos_console_v1_folders_proto protoreflect.FileDescriptor
 
 const file_holos_console_v1_folders_proto_rawDesc = "" +
 	"\n" +
-	"\x1eholos/console/v1/folders.proto\x12\x10holos.console.v1\x1a\x1bholos/console/v1/rbac.proto\x1a\x1eholos/console/v1/secrets.proto\"\xf8\x04\n" +
+	"\x1eholos/console/v1/folders.proto\x12\x10holos.console.v1\x1a\x1bbuf/validate/validate.proto\x1a\"holos/console/v1/list_filter.proto\x1a\x1bholos/console/v1/rbac.proto\x1a\x1eholos/console/v1/secrets.proto\"\xf8\x04\n" +
 	"\x06Folder\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12!\n" +
 	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12 \n" +
@@ -1277,40 +1287,43 @@ const file_holos_console_v1_folders_proto_rawDesc = "" +
 	"\tuser_role\x18\v \x01(\x0e2\x16.holos.console.v1.RoleR\buserRole\x12#\n" +
 	"\rcreator_email\x18\f \x01(\tR\fcreatorEmail\x12\x1d\n" +
 	"\n" +
-	"created_at\x18\r \x01(\tR\tcreatedAt\"\x98\x01\n" +
+	"created_at\x18\r \x01(\tR\tcreatedAt\"\xd0\x01\n" +
 	"\x12ListFoldersRequest\x12\"\n" +
 	"\forganization\x18\x01 \x01(\tR\forganization\x12=\n" +
 	"\vparent_type\x18\x02 \x01(\x0e2\x1c.holos.console.v1.ParentTypeR\n" +
 	"parentType\x12\x1f\n" +
 	"\vparent_name\x18\x03 \x01(\tR\n" +
-	"parentName\"I\n" +
+	"parentName\x126\n" +
+	"\border_by\x18\x04 \x01(\v2\x1b.holos.console.v1.ListOrderR\aorderBy\"I\n" +
 	"\x13ListFoldersResponse\x122\n" +
-	"\afolders\x18\x01 \x03(\v2\x18.holos.console.v1.FolderR\afolders\"J\n" +
-	"\x10GetFolderRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12\"\n" +
+	"\afolders\x18\x01 \x03(\v2\x18.holos.console.v1.FolderR\afolders\"R\n" +
+	"\x10GetFolderRequest\x12\x1a\n" +
+	"\x04name\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x04name\x12\"\n" +
 	"\forganization\x18\x02 \x01(\tR\forganization\"E\n" +
 	"\x11GetFolderResponse\x120\n" +
-	"\x06folder\x18\x01 \x01(\v2\x18.holos.console.v1.FolderR\x06folder\"\xf0\x02\n" +
-	"\x13CreateFolderRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12!\n" +
-	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\"\n" +
-	"\forganization\x18\x04 \x01(\tR\forganization\x12=\n" +
-	"\vparent_type\x18\x05 \x01(\x0e2\x1c.holos.console.v1.ParentTypeR\n" +
-	"parentType\x12\x1f\n" +
-	"\vparent_name\x18\x06 \x01(\tR\n" +
+	"\x06folder\x18\x01 \x01(\v2\x18.holos.console.v1.FolderR\x06folder\"\xaf\x04\n" +
+	"\x13CreateFolderRequest\x129\n" +
+	"\x04name\x18\x01 \x01(\tB%\xbaH\"\xd8\x01\x01r\x1d\x18?2\x19^[a-z][a-z0-9-]*[a-z0-9]$R\x04name\x12!\n" +
+	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12*\n" +
+	"\vdescription\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\x80 R\vdescription\x12*\n" +
+	"\forganization\x18\x04 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\forganization\x12I\n" +
+	"\vparent_type\x18\x05 \x01(\x0e2\x1c.holos.console.v1.ParentTypeB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\n" +
+	"parentType\x12'\n" +
+	"\vparent_name\x18\x06 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\n" +
 	"parentName\x12=\n" +
 	"\vuser_grants\x18\a \x03(\v2\x1c.holos.console.v1.ShareGrantR\n" +
 	"userGrants\x12=\n" +
 	"\vrole_grants\x18\b \x03(\v2\x1c.holos.console.v1.ShareGrantR\n" +
-	"roleGrants\"*\n" +
+	"roleGrants:p\xbaHm\x1ak\n" +
+	"\x14name_or_display_name\x12'folder name or display_name is required\x1a*this.name != '' || this.display_name != ''\"*\n" +
 	"\x14CreateFolderResponse\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\"\xc7\x02\n" +
-	"\x13UpdateFolderRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12\"\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"\xd9\x02\n" +
+	"\x13UpdateFolderRequest\x12\x1a\n" +
+	"\x04name\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x04name\x12\"\n" +
 	"\forganization\x18\x02 \x01(\tR\forganization\x12&\n" +
-	"\fdisplay_name\x18\x03 \x01(\tH\x00R\vdisplayName\x88\x01\x01\x12%\n" +
-	"\vdescription\x18\x04 \x01(\tH\x01R\vdescription\x88\x01\x01\x12B\n" +
+	"\fdisplay_name\x18\x03 \x01(\tH\x00R\vdisplayName\x88\x01\x01\x12/\n" +
+	"\vdescription\x18\x04 \x01(\tB\b\xbaH\x05r\x03\x18\x80 H\x01R\vdescription\x88\x01\x01\x12B\n" +
 	"\vparent_type\x18\x05 \x01(\x0e2\x1c.holos.console.v1.ParentTypeH\x02R\n" +
 	"parentType\x88\x01\x01\x12$\n" +
 	"\vparent_name\x18\x06 \x01(\tH\x03R\n" +
@@ -1319,35 +1332,35 @@ const file_holos_console_v1_folders_proto_rawDesc = "" +
 	"\f_descriptionB\x0e\n" +
 	"\f_parent_typeB\x0e\n" +
 	"\f_parent_name\"\x16\n" +
-	"\x14UpdateFolderResponse\"M\n" +
-	"\x13DeleteFolderRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12\"\n" +
+	"\x14UpdateFolderResponse\"U\n" +
+	"\x13DeleteFolderRequest\x12\x1a\n" +
+	"\x04name\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x04name\x12\"\n" +
 	"\forganization\x18\x02 \x01(\tR\forganization\"\x16\n" +
-	"\x14DeleteFolderResponse\"\xd2\x01\n" +
-	"\x1aUpdateFolderSharingRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12\"\n" +
+	"\x14DeleteFolderResponse\"\xda\x01\n" +
+	"\x1aUpdateFolderSharingRequest\x12\x1a\n" +
+	"\x04name\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x04name\x12\"\n" +
 	"\forganization\x18\x02 \x01(\tR\forganization\x12=\n" +
 	"\vuser_grants\x18\x03 \x03(\v2\x1c.holos.console.v1.ShareGrantR\n" +
 	"userGrants\x12=\n" +
 	"\vrole_grants\x18\x04 \x03(\v2\x1c.holos.console.v1.ShareGrantR\n" +
 	"roleGrants\"O\n" +
 	"\x1bUpdateFolderSharingResponse\x120\n" +
-	"\x06folder\x18\x01 \x01(\v2\x18.holos.console.v1.FolderR\x06folder\"\xf7\x01\n" +
-	"!UpdateFolderDefaultSharingRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12\"\n" +
+	"\x06folder\x18\x01 \x01(\v2\x18.holos.console.v1.FolderR\x06folder\"\xff\x01\n" +
+	"!UpdateFolderDefaultSharingRequest\x12\x1a\n" +
+	"\x04name\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x04name\x12\"\n" +
 	"\forganization\x18\x02 \x01(\tR\forganization\x12L\n" +
 	"\x13default_user_grants\x18\x03 \x03(\v2\x1c.holos.console.v1.ShareGrantR\x11defaultUserGrants\x12L\n" +
 	"\x13default_role_grants\x18\x04 \x03(\v2\x1c.holos.console.v1.ShareGrantR\x11defaultRoleGrants\"V\n" +
 	"\"UpdateFolderDefaultSharingResponse\x120\n" +
-	"\x06folder\x18\x01 \x01(\v2\x18.holos.console.v1.FolderR\x06folder\"M\n" +
-	"\x13GetFolderRawRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12\"\n" +
+	"\x06folder\x18\x01 \x01(\v2\x18.holos.console.v1.FolderR\x06folder\"U\n" +
+	"\x13GetFolderRawRequest\x12\x1a\n" +
+	"\x04name\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x04name\x12\"\n" +
 	"\forganization\x18\x02 \x01(\tR\forganization\"(\n" +
 	"\x14GetFolderRawResponse\x12\x10\n" +
-	"\x03raw\x18\x01 \x01(\tR\x03raw\">\n" +
-	"\x1cCheckFolderIdentifierRequest\x12\x1e\n" +
+	"\x03raw\x18\x01 \x01(\tR\x03raw\"F\n" +
+	"\x1cCheckFolderIdentifierRequest\x12&\n" +
 	"\n" +
-	"identifier\x18\x01 \x01(\tR\n" +
+	"identifier\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\n" +
 	"identifier\"p\n" +
 	"\x1dCheckFolderIdentifierResponse\x12\x1c\n" +
 	"\tavailable\x18\x01 \x01(\bR\tavailable\x121\n" +
@@ -1356,17 +1369,17 @@ const file_holos_console_v1_folders_proto_rawDesc = "" +
 	"ParentType\x12\x1b\n" +
 	"\x17PARENT_TYPE_UNSPECIFIED\x10\x00\x12\x1c\n" +
 	"\x18PARENT_TYPE_ORGANIZATION\x10\x01\x12\x16\n" +
-	"\x12PARENT_TYPE_FOLDER\x10\x022\xb5\a\n" +
-	"\rFolderService\x12Z\n" +
-	"\vListFolders\x12$.holos.console.v1.ListFoldersRequest\x1a%.holos.console.v1.ListFoldersResponse\x12T\n" +
-	"\tGetFolder\x12\".holos.console.v1.GetFolderRequest\x1a#.holos.console.v1.GetFolderResponse\x12]\n" +
+	"\x12PARENT_TYPE_FOLDER\x10\x022\xc9\a\n" +
+	"\rFolderService\x12_\n" +
+	"\vListFolders\x12$.holos.console.v1.ListFoldersRequest\x1a%.holos.console.v1.ListFoldersResponse\"\x03\x90\x02\x01\x12Y\n" +
+	"\tGetFolder\x12\".holos.console.v1.GetFolderRequest\x1a#.holos.console.v1.GetFolderResponse\"\x03\x90\x02\x01\x12]\n" +
 	"\fCreateFolder\x12%.holos.console.v1.CreateFolderRequest\x1a&.holos.console.v1.CreateFolderResponse\x12]\n" +
 	"\fUpdateFolder\x12%.holos.console.v1.UpdateFolderRequest\x1a&.holos.console.v1.UpdateFolderResponse\x12]\n" +
 	"\fDeleteFolder\x12%.holos.console.v1.DeleteFolderRequest\x1a&.holos.console.v1.DeleteFolderResponse\x12r\n" +
 	"\x13UpdateFolderSharing\x12,.holos.console.v1.UpdateFolderSharingRequest\x1a-.holos.console.v1.UpdateFolderSharingResponse\x12\x87\x01\n" +
-	"\x1aUpdateFolderDefaultSharing\x123.holos.console.v1.UpdateFolderDefaultSharingRequest\x1a4.holos.console.v1.UpdateFolderDefaultSharingResponse\x12]\n" +
-	"\fGetFolderRaw\x12%.holos.console.v1.GetFolderRawRequest\x1a&.holos.console.v1.GetFolderRawResponse\x12x\n" +
-	"\x15CheckFolderIdentifier\x12..holos.console.v1.CheckFolderIdentifierRequest\x1a/.holos.console.v1.CheckFolderIdentifierResponseBCZAgithub.com/holos-run/holos-console/gen/holos/console/v1;consolev1b\x06proto3"
+	"\x1aUpdateFolderDefaultSharing\x123.holos.console.v1.UpdateFolderDefaultSharingRequest\x1a4.holos.console.v1.UpdateFolderDefaultSharingResponse\x12b\n" +
+	"\fGetFolderRaw\x12%.holos.console.v1.GetFolderRawRequest\x1a&.holos.console.v1.GetFolderRawResponse\"\x03\x90\x02\x01\x12}\n" +
+	"\x15CheckFolderIdentifier\x12..holos.console.v1.CheckFolderIdentifierRequest\x1a/.holos.console.v1.CheckFolderIdentifierResponse\"\x03\x90\x02\x01BCZAgithub.com/holos-run/holos-console/gen/holos/console/v1;consolev1b\x06proto3"
 
 var (
 	file_holos_console_v1_folders_proto_rawDescOnce sync.Once
@@ -1405,6 +1418,7 @@ var file_holos_console_v1_folders_proto_goTypes = []any{
 	(*CheckFolderIdentifierResponse)(nil),      // 19: holos.console.v1.CheckFolderIdentifierResponse
 	(*ShareGrant)(nil),                         // 20: holos.console.v1.ShareGrant
 	(Role)(0),                                  // 21: holos.console.v1.Role
+	(*ListOrder)(nil),                          // 22: holos.console.v1.ListOrder
 }
 var file_holos_console_v1_folders_proto_depIdxs = []int32{
 	0,  // 0: holos.console.v1.Folder.parent_type:type_name -> holos.console.v1.ParentType
@@ -1414,41 +1428,42 @@ var file_holos_console_v1_folders_proto_depIdxs = []int32{
 	20, // 4: holos.console.v1.Folder.default_role_grants:type_name -> holos.console.v1.ShareGrant
 	21, // 5: holos.console.v1.Folder.user_role:type_name -> holos.console.v1.Role
 	0,  // 6: holos.console.v1.ListFoldersRequest.parent_type:type_name -> holos.console.v1.ParentType
-	1,  // 7: holos.console.v1.ListFoldersResponse.folders:type_name -> holos.console.v1.Folder
-	1,  // 8: holos.console.v1.GetFolderResponse.folder:type_name -> holos.console.v1.Folder
-	0,  // 9: holos.console.v1.CreateFolderRequest.parent_type:type_name -> holos.console.v1.ParentType
-	20, // 10: holos.console.v1.CreateFolderRequest.user_grants:type_name -> holos.console.v1.ShareGrant
-	20, // 11: holos.console.v1.CreateFolderRequest.role_grants:type_name -> holos.console.v1.ShareGrant
-	0,  // 12: holos.console.v1.UpdateFolderRequest.parent_type:type_name -> holos.console.v1.ParentType
-	20, // 13: holos.console.v1.UpdateFolderSharingRequest.user_grants:type_name -> holos.console.v1.ShareGrant
-	20, // 14: holos.console.v1.UpdateFolderSharingRequest.role_grants:type_name -> holos.console.v1.ShareGrant
-	1,  // 15: holos.console.v1.UpdateFolderSharingResponse.folder:type_name -> holos.console.v1.Folder
-	20, // 16: holos.console.v1.UpdateFolderDefaultSharingRequest.default_user_grants:type_name -> holos.console.v1.ShareGrant
-	20, // 17: holos.console.v1.UpdateFolderDefaultSharingRequest.default_role_grants:type_name -> holos.console.v1.ShareGrant
-	1,  // 18: holos.console.v1.UpdateFolderDefaultSharingResponse.folder:type_name -> holos.console.v1.Folder
-	2,  // 19: holos.console.v1.FolderService.ListFolders:input_type -> holos.console.v1.ListFoldersRequest
-	4,  // 20: holos.console.v1.FolderService.GetFolder:input_type -> holos.console.v1.GetFolderRequest
-	6,  // 21: holos.console.v1.FolderService.CreateFolder:input_type -> holos.console.v1.CreateFolderRequest
-	8,  // 22: holos.console.v1.FolderService.UpdateFolder:input_type -> holos.console.v1.UpdateFolderRequest
-	10, // 23: holos.console.v1.FolderService.DeleteFolder:input_type -> holos.console.v1.DeleteFolderRequest
-	12, // 24: holos.console.v1.FolderService.UpdateFolderSharing:input_type -> holos.console.v1.UpdateFolderSharingRequest
-	14, // 25: holos.console.v1.FolderService.UpdateFolderDefaultSharing:input_type -> holos.console.v1.UpdateFolderDefaultSharingRequest
-	16, // 26: holos.console.v1.FolderService.GetFolderRaw:input_type -> holos.console.v1.GetFolderRawRequest
-	18, // 27: holos.console.v1.FolderService.CheckFolderIdentifier:input_type -> holos.console.v1.CheckFolderIdentifierRequest
-	3,  // 28: holos.console.v1.FolderService.ListFolders:output_type -> holos.console.v1.ListFoldersResponse
-	5,  // 29: holos.console.v1.FolderService.GetFolder:output_type -> holos.console.v1.GetFolderResponse
-	7,  // 30: holos.console.v1.FolderService.CreateFolder:output_type -> holos.console.v1.CreateFolderResponse
-	9,  // 31: holos.console.v1.FolderService.UpdateFolder:output_type -> holos.console.v1.UpdateFolderResponse
-	11, // 32: holos.console.v1.FolderService.DeleteFolder:output_type -> holos.console.v1.DeleteFolderResponse
-	13, // 33: holos.console.v1.FolderService.UpdateFolderSharing:output_type -> holos.console.v1.UpdateFolderSharingResponse
-	15, // 34: holos.console.v1.FolderService.UpdateFolderDefaultSharing:output_type -> holos.console.v1.UpdateFolderDefaultSharingResponse
-	17, // 35: holos.console.v1.FolderService.GetFolderRaw:output_type -> holos.console.v1.GetFolderRawResponse
-	19, // 36: holos.console.v1.FolderService.CheckFolderIdentifier:output_type -> holos.console.v1.CheckFolderIdentifierResponse
-	28, // [28:37] is the sub-list for method output_type
-	19, // [19:28] is the sub-list for method input_type
-	19, // [19:19] is the sub-list for extension type_name
-	19, // [19:19] is the sub-list for extension extendee
-	0,  // [0:19] is the sub-list for field type_name
+	22, // 7: holos.console.v1.ListFoldersRequest.order_by:type_name -> holos.console.v1.ListOrder
+	1,  // 8: holos.console.v1.ListFoldersResponse.folders:type_name -> holos.console.v1.Folder
+	1,  // 9: holos.console.v1.GetFolderResponse.folder:type_name -> holos.console.v1.Folder
+	0,  // 10: holos.console.v1.CreateFolderRequest.parent_type:type_name -> holos.console.v1.ParentType
+	20, // 11: holos.console.v1.CreateFolderRequest.user_grants:type_name -> holos.console.v1.ShareGrant
+	20, // 12: holos.console.v1.CreateFolderRequest.role_grants:type_name -> holos.console.v1.ShareGrant
+	0,  // 13: holos.console.v1.UpdateFolderRequest.parent_type:type_name -> holos.console.v1.ParentType
+	20, // 14: holos.console.v1.UpdateFolderSharingRequest.user_grants:type_name -> holos.console.v1.ShareGrant
+	20, // 15: holos.console.v1.UpdateFolderSharingRequest.role_grants:type_name -> holos.console.v1.ShareGrant
+	1,  // 16: holos.console.v1.UpdateFolderSharingResponse.folder:type_name -> holos.console.v1.Folder
+	20, // 17: holos.console.v1.UpdateFolderDefaultSharingRequest.default_user_grants:type_name -> holos.console.v1.ShareGrant
+	20, // 18: holos.console.v1.UpdateFolderDefaultSharingRequest.default_role_grants:type_name -> holos.console.v1.ShareGrant
+	1,  // 19: holos.console.v1.UpdateFolderDefaultSharingResponse.folder:type_name -> holos.console.v1.Folder
+	2,  // 20: holos.console.v1.FolderService.ListFolders:input_type -> holos.console.v1.ListFoldersRequest
+	4,  // 21: holos.console.v1.FolderService.GetFolder:input_type -> holos.console.v1.GetFolderRequest
+	6,  // 22: holos.console.v1.FolderService.CreateFolder:input_type -> holos.console.v1.CreateFolderRequest
+	8,  // 23: holos.console.v1.FolderService.UpdateFolder:input_type -> holos.console.v1.UpdateFolderRequest
+	10, // 24: holos.console.v1.FolderService.DeleteFolder:input_type -> holos.console.v1.DeleteFolderRequest
+	12, // 25: holos.console.v1.FolderService.UpdateFolderSharing:input_type -> holos.console.v1.UpdateFolderSharingRequest
+	14, // 26: holos.console.v1.FolderService.UpdateFolderDefaultSharing:input_type -> holos.console.v1.UpdateFolderDefaultSharingRequest
+	16, // 27: holos.console.v1.FolderService.GetFolderRaw:input_type -> holos.console.v1.GetFolderRawRequest
+	18, // 28: holos.console.v1.FolderService.CheckFolderIdentifier:input_type -> holos.console.v1.CheckFolderIdentifierRequest
+	3,  // 29: holos.console.v1.FolderService.ListFolders:output_type -> holos.console.v1.ListFoldersResponse
+	5,  // 30: holos.console.v1.FolderService.GetFolder:output_type -> holos.console.v1.GetFolderResponse
+	7,  // 31: holos.console.v1.FolderService.CreateFolder:output_type -> holos.console.v1.CreateFolderResponse
+	9,  // 32: holos.console.v1.FolderService.UpdateFolder:output_type -> holos.console.v1.UpdateFolderResponse
+	11, // 33: holos.console.v1.FolderService.DeleteFolder:output_type -> holos.console.v1.DeleteFolderResponse
+	13, // 34: holos.console.v1.FolderService.UpdateFolderSharing:output_type -> holos.console.v1.UpdateFolderSharingResponse
+	15, // 35: holos.console.v1.FolderService.UpdateFolderDefaultSharing:output_type -> holos.console.v1.UpdateFolderDefaultSharingResponse
+	17, // 36: holos.console.v1.FolderService.GetFolderRaw:output_type -> holos.console.v1.GetFolderRawResponse
+	19, // 37: holos.console.v1.FolderService.CheckFolderIdentifier:output_type -> holos.console.v1.CheckFolderIdentifierResponse
+	29, // [29:38] is the sub-list for method output_type
+	20, // [20:29] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
 }
 
 func init() { file_holos_console_v1_folders_proto_init() }
@@ -1456,6 +1471,7 @@ func file_holos_console_v1_folders_proto_init() {
 	if File_holos_console_v1_folders_proto != nil {
 		return
 	}
+	file_holos_console_v1_list_filter_proto_init()
 	file_holos_console_v1_rbac_proto_init()
 	file_holos_console_v1_secrets_proto_init()
 	file_holos_console_v1_folders_proto_msgTypes[7].OneofWrappers = []any{}
@@ -0,0 +1,453 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: holos/console/v1/groups.proto
+
+package consolev1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Group is a console-local group.
+type Group struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name is the group name as it appears in the groups claim.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// members are the email addresses of the group's members, sorted.
+	Members       []string `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Group) Reset() {
+	*x = Group{}
+	mi := &file_holos_console_v1_groups_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Group) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Group) ProtoMessage() {}
+
+func (x *Group) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_groups_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Group.ProtoReflect.Descriptor instead.
+func (*Group) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_groups_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Group) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Group) GetMembers() []string {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+// ListGroupsRequest lists console-local groups.
+type ListGroupsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGroupsRequest) Reset() {
+	*x = ListGroupsRequest{}
+	mi := &file_holos_console_v1_groups_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGroupsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGroupsRequest) ProtoMessage() {}
+
+func (x *ListGroupsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_groups_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGroupsRequest.ProtoReflect.Descriptor instead.
+func (*ListGroupsRequest) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_groups_proto_rawDescGZIP(), []int{1}
+}
+
+// ListGroupsResponse contains every console-local group, sorted by name.
+type ListGroupsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Groups        []*Group               `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGroupsResponse) Reset() {
+	*x = ListGroupsResponse{}
+	mi := &file_holos_console_v1_groups_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGroupsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGroupsResponse) ProtoMessage() {}
+
+func (x *ListGroupsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_groups_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGroupsResponse.ProtoReflect.Descriptor instead.
+func (*ListGroupsResponse) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_groups_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListGroupsResponse) GetGroups() []*Group {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+// AddGroupMemberRequest adds a member to a group.
+type AddGroupMemberRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// group is the group name. It must be a valid Kubernetes label value so it
+	// can be bound in RBAC.
+	Group string `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	// member is the email address of the user to add.
+	Member        string `protobuf:"bytes,2,opt,name=member,proto3" json:"member,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddGroupMemberRequest) Reset() {
+	*x = AddGroupMemberRequest{}
+	mi := &file_holos_console_v1_groups_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddGroupMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddGroupMemberRequest) ProtoMessage() {}
+
+func (x *AddGroupMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_groups_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddGroupMemberRequest.ProtoReflect.Descriptor instead.
+func (*AddGroupMemberRequest) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_groups_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AddGroupMemberRequest) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *AddGroupMemberRequest) GetMember() string {
+	if x != nil {
+		return x.Member
+	}
+	return ""
+}
+
+// AddGroupMemberResponse returns the updated group.
+type AddGroupMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Group         *Group                 `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddGroupMemberResponse) Reset() {
+	*x = AddGroupMemberResponse{}
+	mi := &file_holos_console_v1_groups_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddGroupMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddGroupMemberResponse) ProtoMessage() {}
+
+func (x *AddGroupMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_groups_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddGroupMemberResponse.ProtoReflect.Descriptor instead.
+func (*AddGroupMemberResponse) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_groups_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AddGroupMemberResponse) GetGroup() *Group {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+// RemoveGroupMemberRequest removes a member from a group.
+type RemoveGroupMemberRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// group is the group name.
+	Group string `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	// member is the email address of the user to remove.
+	Member        string `protobuf:"bytes,2,opt,name=member,proto3" json:"member,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveGroupMemberRequest) Reset() {
+	*x = RemoveGroupMemberRequest{}
+	mi := &file_holos_console_v1_groups_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveGroupMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveGroupMemberRequest) ProtoMessage() {}
+
+func (x *RemoveGroupMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_groups_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveGroupMemberRequest.ProtoReflect.Descriptor instead.
+func (*RemoveGroupMemberRequest) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_groups_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RemoveGroupMemberRequest) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *RemoveGroupMemberRequest) GetMember() string {
+	if x != nil {
+		return x.Member
+	}
+	return ""
+}
+
+// RemoveGroupMemberResponse returns the updated group, empty when the group
+// was deleted.
+type RemoveGroupMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Group         *Group                 `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveGroupMemberResponse) Reset() {
+	*x = RemoveGroupMemberResponse{}
+	mi := &file_holos_console_v1_groups_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveGroupMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveGroupMemberResponse) ProtoMessage() {}
+
+func (x *RemoveGroupMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_groups_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveGroupMemberResponse.ProtoReflect.Descriptor instead.
+func (*RemoveGroupMemberResponse) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_groups_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RemoveGroupMemberResponse) GetGroup() *Group {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+var File_holos_console_v1_groups_proto protoreflect.FileDescriptor
+
+const file_holos_console_v1_groups_proto_rawDesc = "" +
+	"\n" +
+	"\x1dholos/console/v1/groups.proto\x12\x10holos.console.v1\x1a\x1bbuf/validate/validate.proto\"5\n" +
+	"\x05Group\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\amembers\x18\x02 \x03(\tR\amembers\"\x13\n" +
+	"\x11ListGroupsRequest\"E\n" +
+	"\x12ListGroupsResponse\x12/\n" +
+	"\x06groups\x18\x01 \x03(\v2\x17.holos.console.v1.GroupR\x06groups\"\x80\x01\n" +
+	"\x15AddGroupMemberRequest\x12C\n" +
+	"\x05group\x18\x01 \x01(\tB-\xbaH*\xc8\x01\x01r%\x18?2!^[a-z0-9]([a-z0-9._-]*[a-z0-9])?$R\x05group\x12\"\n" +
+	"\x06member\x18\x02 \x01(\tB\n" +
+	"\xbaH\a\xc8\x01\x01r\x02`\x01R\x06member\"G\n" +
+	"\x16AddGroupMemberResponse\x12-\n" +
+	"\x05group\x18\x01 \x01(\v2\x17.holos.console.v1.GroupR\x05group\"X\n" +
+	"\x18RemoveGroupMemberRequest\x12\x1c\n" +
+	"\x05group\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x05group\x12\x1e\n" +
+	"\x06member\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x06member\"J\n" +
+	"\x19RemoveGroupMemberResponse\x12-\n" +
+	"\x05group\x18\x01 \x01(\v2\x17.holos.console.v1.GroupR\x05group2\xc0\x02\n" +
+	"\rGroupsService\x12\\\n" +
+	"\n" +
+	"ListGroups\x12#.holos.console.v1.ListGroupsRequest\x1a$.holos.console.v1.ListGroupsResponse\"\x03\x90\x02\x01\x12c\n" +
+	"\x0eAddGroupMember\x12'.holos.console.v1.AddGroupMemberRequest\x1a(.holos.console.v1.AddGroupMemberResponse\x12l\n" +
+	"\x11RemoveGroupMember\x12*.holos.console.v1.RemoveGroupMemberRequest\x1a+.holos.console.v1.RemoveGroupMemberResponseBCZAgithub.com/holos-run/holos-console/gen/holos/console/v1;consolev1b\x06proto3"
+
+var (
+	file_holos_console_v1_groups_proto_rawDescOnce sync.Once
+	file_holos_console_v1_groups_proto_rawDescData []byte
+)
+
+func file_holos_console_v1_groups_proto_rawDescGZIP() []byte {
+	file_holos_console_v1_groups_proto_rawDescOnce.Do(func() {
+		file_holos_console_v1_groups_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_holos_console_v1_groups_proto_rawDesc), len(file_holos_console_v1_groups_proto_rawDesc)))
+	})
+	return file_holos_console_v1_groups_proto_rawDescData
+}
+
+var file_holos_console_v1_groups_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_holos_console_v1_groups_proto_goTypes = []any{
+	(*Group)(nil),                     // 0: holos.console.v1.Group
+	(*ListGroupsRequest)(nil),         // 1: holos.console.v1.ListGroupsRequest
+	(*ListGroupsResponse)(nil),        // 2: holos.console.v1.ListGroupsResponse
+	(*AddGroupMemberRequest)(nil),     // 3: holos.console.v1.AddGroupMemberRequest
+	(*AddGroupMemberResponse)(nil),    // 4: holos.console.v1.AddGroupMemberResponse
+	(*RemoveGroupMemberRequest)(nil),  // 5: holos.console.v1.RemoveGroupMemberRequest
+	(*RemoveGroupMemberResponse)(nil), // 6: holos.console.v1.RemoveGroupMemberResponse
+}
+var file_holos_console_v1_groups_proto_depIdxs = []int32{
+	0, // 0: holos.console.v1.ListGroupsResponse.groups:type_name -> holos.console.v1.Group
+	0, // 1: holos.console.v1.AddGroupMemberResponse.group:type_name -> holos.console.v1.Group
+	0, // 2: holos.console.v1.RemoveGroupMemberResponse.group:type_name -> holos.console.v1.Group
+	1, // 3: holos.console.v1.GroupsService.ListGroups:input_type -> holos.console.v1.ListGroupsRequest
+	3, // 4: holos.console.v1.GroupsService.AddGroupMember:input_type -> holos.console.v1.AddGroupMemberRequest
+	5, // 5: holos.console.v1.GroupsService.RemoveGroupMember:input_type -> holos.console.v1.RemoveGroupMemberRequest
+	2, // 6: holos.console.v1.GroupsService.ListGroups:output_type -> holos.console.v1.ListGroupsResponse
+	4, // 7: holos.console.v1.GroupsService.AddGroupMember:output_type -> holos.console.v1.AddGroupMemberResponse
+	6, // 8: holos.console.v1.GroupsService.RemoveGroupMember:output_type -> holos.console.v1.RemoveGroupMemberResponse
+	6, // [6:9] is the sub-list for method output_type
+	3, // [3:6] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_holos_console_v1_groups_proto_init() }
+func file_holos_console_v1_groups_proto_init() {
+	if File_holos_console_v1_groups_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_holos_console_v1_groups_proto_rawDesc), len(file_holos_console_v1_groups_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_holos_console_v1_groups_proto_goTypes,
+		DependencyIndexes: file_holos_console_v1_groups_proto_depIdxs,
+		MessageInfos:      file_holos_console_v1_groups_proto_msgTypes,
+	}.Build()
+	File_holos_console_v1_groups_proto = out.File
+	file_holos_console_v1_groups_proto_goTypes = nil
+	file_holos_console_v1_groups_proto_depIdxs = nil
+}
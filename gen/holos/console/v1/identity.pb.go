@@ -0,0 +1,515 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: holos/console/v1/identity.proto
+
+package consolev1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// WhoAmIRequest is empty as no parameters are needed.
+type WhoAmIRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WhoAmIRequest) Reset() {
+	*x = WhoAmIRequest{}
+	mi := &file_holos_console_v1_identity_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WhoAmIRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WhoAmIRequest) ProtoMessage() {}
+
+func (x *WhoAmIRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_identity_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WhoAmIRequest.ProtoReflect.Descriptor instead.
+func (*WhoAmIRequest) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_identity_proto_rawDescGZIP(), []int{0}
+}
+
+// WhoAmIResponse describes the authenticated caller.
+type WhoAmIResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// issuer is the iss claim of the caller's token. Empty for Kubernetes
+	// ServiceAccounts.
+	Issuer string `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	// subject is the sub claim, or the ServiceAccount username
+	// (system:serviceaccount:<namespace>:<name>).
+	Subject string `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	// email is the email claim.
+	Email string `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	// email_verified is the email_verified claim.
+	EmailVerified bool `protobuf:"varint,4,opt,name=email_verified,json=emailVerified,proto3" json:"email_verified,omitempty"`
+	// name is the name claim.
+	Name string `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	// groups are the values of the configured roles claim (--roles-claim),
+	// or the ServiceAccount's groups.
+	Groups []string `protobuf:"bytes,6,rep,name=groups,proto3" json:"groups,omitempty"`
+	// principal_type is "user" for OIDC users and "serviceaccount" for
+	// Kubernetes ServiceAccounts.
+	PrincipalType string `protobuf:"bytes,7,opt,name=principal_type,json=principalType,proto3" json:"principal_type,omitempty"`
+	// issued_at is the iat claim. Unset when the token has none.
+	IssuedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=issued_at,json=issuedAt,proto3" json:"issued_at,omitempty"`
+	// expires_at is the exp claim. Unset when the token has none.
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// kubernetes_user is the username the console impersonates for the
+	// caller's Kubernetes requests, e.g. "oidc:<sub>".
+	KubernetesUser string `protobuf:"bytes,10,opt,name=kubernetes_user,json=kubernetesUser,proto3" json:"kubernetes_user,omitempty"`
+	// kubernetes_groups are the groups the console impersonates for the
+	// caller's Kubernetes requests, e.g. "oidc:<group>".
+	KubernetesGroups []string `protobuf:"bytes,11,rep,name=kubernetes_groups,json=kubernetesGroups,proto3" json:"kubernetes_groups,omitempty"`
+	// platform_roles are the console-wide roles the caller holds, e.g.
+	// "organization-creator". Organization, folder and project roles are
+	// reported by the resource's own RPCs.
+	PlatformRoles []string `protobuf:"bytes,12,rep,name=platform_roles,json=platformRoles,proto3" json:"platform_roles,omitempty"`
+	// impersonator is the email (or subject) of the real principal when an
+	// administrator is acting as this caller via X-Impersonate-User.
+	Impersonator  string `protobuf:"bytes,13,opt,name=impersonator,proto3" json:"impersonator,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WhoAmIResponse) Reset() {
+	*x = WhoAmIResponse{}
+	mi := &file_holos_console_v1_identity_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WhoAmIResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WhoAmIResponse) ProtoMessage() {}
+
+func (x *WhoAmIResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_identity_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WhoAmIResponse.ProtoReflect.Descriptor instead.
+func (*WhoAmIResponse) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_identity_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *WhoAmIResponse) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *WhoAmIResponse) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *WhoAmIResponse) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *WhoAmIResponse) GetEmailVerified() bool {
+	if x != nil {
+		return x.EmailVerified
+	}
+	return false
+}
+
+func (x *WhoAmIResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WhoAmIResponse) GetGroups() []string {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *WhoAmIResponse) GetPrincipalType() string {
+	if x != nil {
+		return x.PrincipalType
+	}
+	return ""
+}
+
+func (x *WhoAmIResponse) GetIssuedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.IssuedAt
+	}
+	return nil
+}
+
+func (x *WhoAmIResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *WhoAmIResponse) GetKubernetesUser() string {
+	if x != nil {
+		return x.KubernetesUser
+	}
+	return ""
+}
+
+func (x *WhoAmIResponse) GetKubernetesGroups() []string {
+	if x != nil {
+		return x.KubernetesGroups
+	}
+	return nil
+}
+
+func (x *WhoAmIResponse) GetPlatformRoles() []string {
+	if x != nil {
+		return x.PlatformRoles
+	}
+	return nil
+}
+
+func (x *WhoAmIResponse) GetImpersonator() string {
+	if x != nil {
+		return x.Impersonator
+	}
+	return ""
+}
+
+// GetSessionRequest is empty as no parameters are needed.
+type GetSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSessionRequest) Reset() {
+	*x = GetSessionRequest{}
+	mi := &file_holos_console_v1_identity_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSessionRequest) ProtoMessage() {}
+
+func (x *GetSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_identity_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSessionRequest.ProtoReflect.Descriptor instead.
+func (*GetSessionRequest) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_identity_proto_rawDescGZIP(), []int{2}
+}
+
+// GetSessionResponse describes the caller's session.
+type GetSessionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// expires_at is when the console stops accepting the caller's token: the
+	// earlier of the exp claim and --max-token-age after the iat claim. Unset
+	// when neither applies, e.g. for ServiceAccount tokens.
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// remaining_seconds is the time left until expires_at, zero when unset
+	// or already passed.
+	RemainingSeconds int64 `protobuf:"varint,2,opt,name=remaining_seconds,json=remainingSeconds,proto3" json:"remaining_seconds,omitempty"`
+	// authenticated_at is when the caller last signed in: the auth_time claim
+	// when present, otherwise iat.
+	AuthenticatedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=authenticated_at,json=authenticatedAt,proto3" json:"authenticated_at,omitempty"`
+	// max_token_age_seconds is the configured --max-token-age, zero when
+	// unlimited.
+	MaxTokenAgeSeconds int64 `protobuf:"varint,4,opt,name=max_token_age_seconds,json=maxTokenAgeSeconds,proto3" json:"max_token_age_seconds,omitempty"`
+	// step_up lists the RPCs that require a recent sign-in.
+	StepUp        []*StepUpRequirement `protobuf:"bytes,5,rep,name=step_up,json=stepUp,proto3" json:"step_up,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSessionResponse) Reset() {
+	*x = GetSessionResponse{}
+	mi := &file_holos_console_v1_identity_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSessionResponse) ProtoMessage() {}
+
+func (x *GetSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_identity_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSessionResponse.ProtoReflect.Descriptor instead.
+func (*GetSessionResponse) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_identity_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetSessionResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *GetSessionResponse) GetRemainingSeconds() int64 {
+	if x != nil {
+		return x.RemainingSeconds
+	}
+	return 0
+}
+
+func (x *GetSessionResponse) GetAuthenticatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AuthenticatedAt
+	}
+	return nil
+}
+
+func (x *GetSessionResponse) GetMaxTokenAgeSeconds() int64 {
+	if x != nil {
+		return x.MaxTokenAgeSeconds
+	}
+	return 0
+}
+
+func (x *GetSessionResponse) GetStepUp() []*StepUpRequirement {
+	if x != nil {
+		return x.StepUp
+	}
+	return nil
+}
+
+// StepUpRequirement is an RPC that requires the caller to have signed in
+// recently.
+type StepUpRequirement struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// procedure is the RPC, e.g. "/holos.console.v1.SecretsService/GetSecret".
+	Procedure string `protobuf:"bytes,1,opt,name=procedure,proto3" json:"procedure,omitempty"`
+	// max_age_seconds is how recently the caller must have signed in.
+	MaxAgeSeconds int64 `protobuf:"varint,2,opt,name=max_age_seconds,json=maxAgeSeconds,proto3" json:"max_age_seconds,omitempty"`
+	// satisfied_until is when the caller's current sign-in stops satisfying
+	// the requirement.
+	SatisfiedUntil *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=satisfied_until,json=satisfiedUntil,proto3" json:"satisfied_until,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *StepUpRequirement) Reset() {
+	*x = StepUpRequirement{}
+	mi := &file_holos_console_v1_identity_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StepUpRequirement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StepUpRequirement) ProtoMessage() {}
+
+func (x *StepUpRequirement) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_identity_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StepUpRequirement.ProtoReflect.Descriptor instead.
+func (*StepUpRequirement) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_identity_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *StepUpRequirement) GetProcedure() string {
+	if x != nil {
+		return x.Procedure
+	}
+	return ""
+}
+
+func (x *StepUpRequirement) GetMaxAgeSeconds() int64 {
+	if x != nil {
+		return x.MaxAgeSeconds
+	}
+	return 0
+}
+
+func (x *StepUpRequirement) GetSatisfiedUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SatisfiedUntil
+	}
+	return nil
+}
+
+var File_holos_console_v1_identity_proto protoreflect.FileDescriptor
+
+const file_holos_console_v1_identity_proto_rawDesc = "" +
+	"\n" +
+	"\x1fholos/console/v1/identity.proto\x12\x10holos.console.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x0f\n" +
+	"\rWhoAmIRequest\"\xe7\x03\n" +
+	"\x0eWhoAmIResponse\x12\x16\n" +
+	"\x06issuer\x18\x01 \x01(\tR\x06issuer\x12\x18\n" +
+	"\asubject\x18\x02 \x01(\tR\asubject\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12%\n" +
+	"\x0eemail_verified\x18\x04 \x01(\bR\remailVerified\x12\x12\n" +
+	"\x04name\x18\x05 \x01(\tR\x04name\x12\x16\n" +
+	"\x06groups\x18\x06 \x03(\tR\x06groups\x12%\n" +
+	"\x0eprincipal_type\x18\a \x01(\tR\rprincipalType\x127\n" +
+	"\tissued_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\bissuedAt\x129\n" +
+	"\n" +
+	"expires_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12'\n" +
+	"\x0fkubernetes_user\x18\n" +
+	" \x01(\tR\x0ekubernetesUser\x12+\n" +
+	"\x11kubernetes_groups\x18\v \x03(\tR\x10kubernetesGroups\x12%\n" +
+	"\x0eplatform_roles\x18\f \x03(\tR\rplatformRoles\x12\"\n" +
+	"\fimpersonator\x18\r \x01(\tR\fimpersonator\"\x13\n" +
+	"\x11GetSessionRequest\"\xb4\x02\n" +
+	"\x12GetSessionResponse\x129\n" +
+	"\n" +
+	"expires_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12+\n" +
+	"\x11remaining_seconds\x18\x02 \x01(\x03R\x10remainingSeconds\x12E\n" +
+	"\x10authenticated_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x0fauthenticatedAt\x121\n" +
+	"\x15max_token_age_seconds\x18\x04 \x01(\x03R\x12maxTokenAgeSeconds\x12<\n" +
+	"\astep_up\x18\x05 \x03(\v2#.holos.console.v1.StepUpRequirementR\x06stepUp\"\x9e\x01\n" +
+	"\x11StepUpRequirement\x12\x1c\n" +
+	"\tprocedure\x18\x01 \x01(\tR\tprocedure\x12&\n" +
+	"\x0fmax_age_seconds\x18\x02 \x01(\x03R\rmaxAgeSeconds\x12C\n" +
+	"\x0fsatisfied_until\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x0esatisfiedUntil2\xbc\x01\n" +
+	"\x0fIdentityService\x12P\n" +
+	"\x06WhoAmI\x12\x1f.holos.console.v1.WhoAmIRequest\x1a .holos.console.v1.WhoAmIResponse\"\x03\x90\x02\x01\x12W\n" +
+	"\n" +
+	"GetSession\x12#.holos.console.v1.GetSessionRequest\x1a$.holos.console.v1.GetSessionResponseBCZAgithub.com/holos-run/holos-console/gen/holos/console/v1;consolev1b\x06proto3"
+
+var (
+	file_holos_console_v1_identity_proto_rawDescOnce sync.Once
+	file_holos_console_v1_identity_proto_rawDescData []byte
+)
+
+func file_holos_console_v1_identity_proto_rawDescGZIP() []byte {
+	file_holos_console_v1_identity_proto_rawDescOnce.Do(func() {
+		file_holos_console_v1_identity_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_holos_console_v1_identity_proto_rawDesc), len(file_holos_console_v1_identity_proto_rawDesc)))
+	})
+	return file_holos_console_v1_identity_proto_rawDescData
+}
+
+var file_holos_console_v1_identity_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_holos_console_v1_identity_proto_goTypes = []any{
+	(*WhoAmIRequest)(nil),         // 0: holos.console.v1.WhoAmIRequest
+	(*WhoAmIResponse)(nil),        // 1: holos.console.v1.WhoAmIResponse
+	(*GetSessionRequest)(nil),     // 2: holos.console.v1.GetSessionRequest
+	(*GetSessionResponse)(nil),    // 3: holos.console.v1.GetSessionResponse
+	(*StepUpRequirement)(nil),     // 4: holos.console.v1.StepUpRequirement
+	(*timestamppb.Timestamp)(nil), // 5: google.protobuf.Timestamp
+}
+var file_holos_console_v1_identity_proto_depIdxs = []int32{
+	5, // 0: holos.console.v1.WhoAmIResponse.issued_at:type_name -> google.protobuf.Timestamp
+	5, // 1: holos.console.v1.WhoAmIResponse.expires_at:type_name -> google.protobuf.Timestamp
+	5, // 2: holos.console.v1.GetSessionResponse.expires_at:type_name -> google.protobuf.Timestamp
+	5, // 3: holos.console.v1.GetSessionResponse.authenticated_at:type_name -> google.protobuf.Timestamp
+	4, // 4: holos.console.v1.GetSessionResponse.step_up:type_name -> holos.console.v1.StepUpRequirement
+	5, // 5: holos.console.v1.StepUpRequirement.satisfied_until:type_name -> google.protobuf.Timestamp
+	0, // 6: holos.console.v1.IdentityService.WhoAmI:input_type -> holos.console.v1.WhoAmIRequest
+	2, // 7: holos.console.v1.IdentityService.GetSession:input_type -> holos.console.v1.GetSessionRequest
+	1, // 8: holos.console.v1.IdentityService.WhoAmI:output_type -> holos.console.v1.WhoAmIResponse
+	3, // 9: holos.console.v1.IdentityService.GetSession:output_type -> holos.console.v1.GetSessionResponse
+	8, // [8:10] is the sub-list for method output_type
+	6, // [6:8] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_holos_console_v1_identity_proto_init() }
+func file_holos_console_v1_identity_proto_init() {
+	if File_holos_console_v1_identity_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_holos_console_v1_identity_proto_rawDesc), len(file_holos_console_v1_identity_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_holos_console_v1_identity_proto_goTypes,
+		DependencyIndexes: file_holos_console_v1_identity_proto_depIdxs,
+		MessageInfos:      file_holos_console_v1_identity_proto_msgTypes,
+	}.Build()
+	File_holos_console_v1_identity_proto = out.File
+	file_holos_console_v1_identity_proto_goTypes = nil
+	file_holos_console_v1_identity_proto_depIdxs = nil
+}
@@ -0,0 +1,308 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: holos/console/v1/list_filter.proto
+
+package consolev1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ListOrderField selects the attribute a list RPC sorts its results by.
+type ListOrderField int32
+
+const (
+	// LIST_ORDER_FIELD_UNSPECIFIED sorts by name.
+	ListOrderField_LIST_ORDER_FIELD_UNSPECIFIED ListOrderField = 0
+	// LIST_ORDER_FIELD_NAME sorts by resource name.
+	ListOrderField_LIST_ORDER_FIELD_NAME ListOrderField = 1
+	// LIST_ORDER_FIELD_CREATED sorts by creation time.
+	ListOrderField_LIST_ORDER_FIELD_CREATED ListOrderField = 2
+	// LIST_ORDER_FIELD_MODIFIED sorts by the time of the most recent write
+	// to the backing Kubernetes object, falling back to its creation time.
+	ListOrderField_LIST_ORDER_FIELD_MODIFIED ListOrderField = 3
+)
+
+// Enum value maps for ListOrderField.
+var (
+	ListOrderField_name = map[int32]string{
+		0: "LIST_ORDER_FIELD_UNSPECIFIED",
+		1: "LIST_ORDER_FIELD_NAME",
+		2: "LIST_ORDER_FIELD_CREATED",
+		3: "LIST_ORDER_FIELD_MODIFIED",
+	}
+	ListOrderField_value = map[string]int32{
+		"LIST_ORDER_FIELD_UNSPECIFIED": 0,
+		"LIST_ORDER_FIELD_NAME":        1,
+		"LIST_ORDER_FIELD_CREATED":     2,
+		"LIST_ORDER_FIELD_MODIFIED":    3,
+	}
+)
+
+func (x ListOrderField) Enum() *ListOrderField {
+	p := new(ListOrderField)
+	*p = x
+	return p
+}
+
+func (x ListOrderField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ListOrderField) Descriptor() protoreflect.EnumDescriptor {
+	return file_holos_console_v1_list_filter_proto_enumTypes[0].Descriptor()
+}
+
+func (ListOrderField) Type() protoreflect.EnumType {
+	return &file_holos_console_v1_list_filter_proto_enumTypes[0]
+}
+
+func (x ListOrderField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ListOrderField.Descriptor instead.
+func (ListOrderField) EnumDescriptor() ([]byte, []int) {
+	return file_holos_console_v1_list_filter_proto_rawDescGZIP(), []int{0}
+}
+
+// ListFilter narrows the results of a list RPC on the server so clients do
+// not need to download every resource to search them. Every set field must
+// match; an unset filter returns everything the caller can list.
+type ListFilter struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name_contains keeps resources whose name or display name contains the
+	// value, ignoring case.
+	NameContains string `protobuf:"bytes,1,opt,name=name_contains,json=nameContains,proto3" json:"name_contains,omitempty"`
+	// label_selector keeps resources whose Kubernetes labels match the
+	// selector, in the kubectl --selector syntax (e.g. "env=prod,tier!=db").
+	LabelSelector string `protobuf:"bytes,2,opt,name=label_selector,json=labelSelector,proto3" json:"label_selector,omitempty"`
+	// description_contains keeps resources whose description contains the
+	// value, ignoring case.
+	DescriptionContains string `protobuf:"bytes,3,opt,name=description_contains,json=descriptionContains,proto3" json:"description_contains,omitempty"`
+	// accessible_only keeps only resources the caller can read. Resources
+	// that are listed but not readable, such as secrets behind a deny grant,
+	// are dropped.
+	AccessibleOnly bool `protobuf:"varint,4,opt,name=accessible_only,json=accessibleOnly,proto3" json:"accessible_only,omitempty"`
+	// min_role keeps resources on which the caller's role is at least
+	// min_role, e.g. ROLE_OWNER for "resources I own".
+	MinRole       Role `protobuf:"varint,5,opt,name=min_role,json=minRole,proto3,enum=holos.console.v1.Role" json:"min_role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFilter) Reset() {
+	*x = ListFilter{}
+	mi := &file_holos_console_v1_list_filter_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFilter) ProtoMessage() {}
+
+func (x *ListFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_list_filter_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFilter.ProtoReflect.Descriptor instead.
+func (*ListFilter) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_list_filter_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListFilter) GetNameContains() string {
+	if x != nil {
+		return x.NameContains
+	}
+	return ""
+}
+
+func (x *ListFilter) GetLabelSelector() string {
+	if x != nil {
+		return x.LabelSelector
+	}
+	return ""
+}
+
+func (x *ListFilter) GetDescriptionContains() string {
+	if x != nil {
+		return x.DescriptionContains
+	}
+	return ""
+}
+
+func (x *ListFilter) GetAccessibleOnly() bool {
+	if x != nil {
+		return x.AccessibleOnly
+	}
+	return false
+}
+
+func (x *ListFilter) GetMinRole() Role {
+	if x != nil {
+		return x.MinRole
+	}
+	return Role_ROLE_UNSPECIFIED
+}
+
+// ListOrder sorts the results of a list RPC on the server, after
+// filtering, so tables show a stable order. Resources that compare equal
+// are ordered by name. When a list request leaves order_by unset the
+// server's default order applies.
+type ListOrder struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// field is the attribute to sort by.
+	Field ListOrderField `protobuf:"varint,1,opt,name=field,proto3,enum=holos.console.v1.ListOrderField" json:"field,omitempty"`
+	// descending reverses the order, e.g. newest first for
+	// LIST_ORDER_FIELD_CREATED.
+	Descending    bool `protobuf:"varint,2,opt,name=descending,proto3" json:"descending,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrder) Reset() {
+	*x = ListOrder{}
+	mi := &file_holos_console_v1_list_filter_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrder) ProtoMessage() {}
+
+func (x *ListOrder) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_list_filter_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrder.ProtoReflect.Descriptor instead.
+func (*ListOrder) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_list_filter_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListOrder) GetField() ListOrderField {
+	if x != nil {
+		return x.Field
+	}
+	return ListOrderField_LIST_ORDER_FIELD_UNSPECIFIED
+}
+
+func (x *ListOrder) GetDescending() bool {
+	if x != nil {
+		return x.Descending
+	}
+	return false
+}
+
+var File_holos_console_v1_list_filter_proto protoreflect.FileDescriptor
+
+const file_holos_console_v1_list_filter_proto_rawDesc = "" +
+	"\n" +
+	"\"holos/console/v1/list_filter.proto\x12\x10holos.console.v1\x1a\x1bholos/console/v1/rbac.proto\"\xe7\x01\n" +
+	"\n" +
+	"ListFilter\x12#\n" +
+	"\rname_contains\x18\x01 \x01(\tR\fnameContains\x12%\n" +
+	"\x0elabel_selector\x18\x02 \x01(\tR\rlabelSelector\x121\n" +
+	"\x14description_contains\x18\x03 \x01(\tR\x13descriptionContains\x12'\n" +
+	"\x0faccessible_only\x18\x04 \x01(\bR\x0eaccessibleOnly\x121\n" +
+	"\bmin_role\x18\x05 \x01(\x0e2\x16.holos.console.v1.RoleR\aminRole\"c\n" +
+	"\tListOrder\x126\n" +
+	"\x05field\x18\x01 \x01(\x0e2 .holos.console.v1.ListOrderFieldR\x05field\x12\x1e\n" +
+	"\n" +
+	"descending\x18\x02 \x01(\bR\n" +
+	"descending*\x8a\x01\n" +
+	"\x0eListOrderField\x12 \n" +
+	"\x1cLIST_ORDER_FIELD_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15LIST_ORDER_FIELD_NAME\x10\x01\x12\x1c\n" +
+	"\x18LIST_ORDER_FIELD_CREATED\x10\x02\x12\x1d\n" +
+	"\x19LIST_ORDER_FIELD_MODIFIED\x10\x03BCZAgithub.com/holos-run/holos-console/gen/holos/console/v1;consolev1b\x06proto3"
+
+var (
+	file_holos_console_v1_list_filter_proto_rawDescOnce sync.Once
+	file_holos_console_v1_list_filter_proto_rawDescData []byte
+)
+
+func file_holos_console_v1_list_filter_proto_rawDescGZIP() []byte {
+	file_holos_console_v1_list_filter_proto_rawDescOnce.Do(func() {
+		file_holos_console_v1_list_filter_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_holos_console_v1_list_filter_proto_rawDesc), len(file_holos_console_v1_list_filter_proto_rawDesc)))
+	})
+	return file_holos_console_v1_list_filter_proto_rawDescData
+}
+
+var file_holos_console_v1_list_filter_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_holos_console_v1_list_filter_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_holos_console_v1_list_filter_proto_goTypes = []any{
+	(ListOrderField)(0), // 0: holos.console.v1.ListOrderField
+	(*ListFilter)(nil),  // 1: holos.console.v1.ListFilter
+	(*ListOrder)(nil),   // 2: holos.console.v1.ListOrder
+	(Role)(0),           // 3: holos.console.v1.Role
+}
+var file_holos_console_v1_list_filter_proto_depIdxs = []int32{
+	3, // 0: holos.console.v1.ListFilter.min_role:type_name -> holos.console.v1.Role
+	0, // 1: holos.console.v1.ListOrder.field:type_name -> holos.console.v1.ListOrderField
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_holos_console_v1_list_filter_proto_init() }
+func file_holos_console_v1_list_filter_proto_init() {
+	if File_holos_console_v1_list_filter_proto != nil {
+		return
+	}
+	file_holos_console_v1_rbac_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_holos_console_v1_list_filter_proto_rawDesc), len(file_holos_console_v1_list_filter_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_holos_console_v1_list_filter_proto_goTypes,
+		DependencyIndexes: file_holos_console_v1_list_filter_proto_depIdxs,
+		EnumInfos:         file_holos_console_v1_list_filter_proto_enumTypes,
+		MessageInfos:      file_holos_console_v1_list_filter_proto_msgTypes,
+	}.Build()
+	File_holos_console_v1_list_filter_proto = out.File
+	file_holos_console_v1_list_filter_proto_goTypes = nil
+	file_holos_console_v1_list_filter_proto_depIdxs = nil
+}
@@ -0,0 +1,365 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: holos/console/v1/maintenance.proto
+
+package consolev1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Maintenance describes the maintenance mode state.
+type Maintenance struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// enabled is true while mutating RPCs are rejected.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// message is returned in the error of rejected RPCs and shown in the UI.
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// changed_at is when the state was last changed. Unset for the state set
+	// by flags at startup.
+	ChangedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`
+	// changed_by is the email, or subject, of the platform owner who last
+	// changed the state. Empty for the state set by flags at startup.
+	ChangedBy     string `protobuf:"bytes,4,opt,name=changed_by,json=changedBy,proto3" json:"changed_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Maintenance) Reset() {
+	*x = Maintenance{}
+	mi := &file_holos_console_v1_maintenance_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Maintenance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Maintenance) ProtoMessage() {}
+
+func (x *Maintenance) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_maintenance_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Maintenance.ProtoReflect.Descriptor instead.
+func (*Maintenance) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_maintenance_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Maintenance) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Maintenance) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Maintenance) GetChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return nil
+}
+
+func (x *Maintenance) GetChangedBy() string {
+	if x != nil {
+		return x.ChangedBy
+	}
+	return ""
+}
+
+// GetMaintenanceRequest is empty as no parameters are needed.
+type GetMaintenanceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMaintenanceRequest) Reset() {
+	*x = GetMaintenanceRequest{}
+	mi := &file_holos_console_v1_maintenance_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMaintenanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMaintenanceRequest) ProtoMessage() {}
+
+func (x *GetMaintenanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_maintenance_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMaintenanceRequest.ProtoReflect.Descriptor instead.
+func (*GetMaintenanceRequest) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_maintenance_proto_rawDescGZIP(), []int{1}
+}
+
+// GetMaintenanceResponse contains the maintenance state.
+type GetMaintenanceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Maintenance   *Maintenance           `protobuf:"bytes,1,opt,name=maintenance,proto3" json:"maintenance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMaintenanceResponse) Reset() {
+	*x = GetMaintenanceResponse{}
+	mi := &file_holos_console_v1_maintenance_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMaintenanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMaintenanceResponse) ProtoMessage() {}
+
+func (x *GetMaintenanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_maintenance_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMaintenanceResponse.ProtoReflect.Descriptor instead.
+func (*GetMaintenanceResponse) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_maintenance_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetMaintenanceResponse) GetMaintenance() *Maintenance {
+	if x != nil {
+		return x.Maintenance
+	}
+	return nil
+}
+
+// SetMaintenanceRequest changes the maintenance state.
+type SetMaintenanceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// enabled turns maintenance mode on or off.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// message replaces the message returned to rejected RPCs. Empty keeps the
+	// default message.
+	Message       string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetMaintenanceRequest) Reset() {
+	*x = SetMaintenanceRequest{}
+	mi := &file_holos_console_v1_maintenance_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMaintenanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMaintenanceRequest) ProtoMessage() {}
+
+func (x *SetMaintenanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_maintenance_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMaintenanceRequest.ProtoReflect.Descriptor instead.
+func (*SetMaintenanceRequest) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_maintenance_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SetMaintenanceRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *SetMaintenanceRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// SetMaintenanceResponse contains the new maintenance state.
+type SetMaintenanceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Maintenance   *Maintenance           `protobuf:"bytes,1,opt,name=maintenance,proto3" json:"maintenance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetMaintenanceResponse) Reset() {
+	*x = SetMaintenanceResponse{}
+	mi := &file_holos_console_v1_maintenance_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMaintenanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMaintenanceResponse) ProtoMessage() {}
+
+func (x *SetMaintenanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_maintenance_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMaintenanceResponse.ProtoReflect.Descriptor instead.
+func (*SetMaintenanceResponse) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_maintenance_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SetMaintenanceResponse) GetMaintenance() *Maintenance {
+	if x != nil {
+		return x.Maintenance
+	}
+	return nil
+}
+
+var File_holos_console_v1_maintenance_proto protoreflect.FileDescriptor
+
+const file_holos_console_v1_maintenance_proto_rawDesc = "" +
+	"\n" +
+	"\"holos/console/v1/maintenance.proto\x12\x10holos.console.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x9b\x01\n" +
+	"\vMaintenance\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x129\n" +
+	"\n" +
+	"changed_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tchangedAt\x12\x1d\n" +
+	"\n" +
+	"changed_by\x18\x04 \x01(\tR\tchangedBy\"\x17\n" +
+	"\x15GetMaintenanceRequest\"Y\n" +
+	"\x16GetMaintenanceResponse\x12?\n" +
+	"\vmaintenance\x18\x01 \x01(\v2\x1d.holos.console.v1.MaintenanceR\vmaintenance\"K\n" +
+	"\x15SetMaintenanceRequest\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"Y\n" +
+	"\x16SetMaintenanceResponse\x12?\n" +
+	"\vmaintenance\x18\x01 \x01(\v2\x1d.holos.console.v1.MaintenanceR\vmaintenance2\xe3\x01\n" +
+	"\x12MaintenanceService\x12h\n" +
+	"\x0eGetMaintenance\x12'.holos.console.v1.GetMaintenanceRequest\x1a(.holos.console.v1.GetMaintenanceResponse\"\x03\x90\x02\x01\x12c\n" +
+	"\x0eSetMaintenance\x12'.holos.console.v1.SetMaintenanceRequest\x1a(.holos.console.v1.SetMaintenanceResponseBCZAgithub.com/holos-run/holos-console/gen/holos/console/v1;consolev1b\x06proto3"
+
+var (
+	file_holos_console_v1_maintenance_proto_rawDescOnce sync.Once
+	file_holos_console_v1_maintenance_proto_rawDescData []byte
+)
+
+func file_holos_console_v1_maintenance_proto_rawDescGZIP() []byte {
+	file_holos_console_v1_maintenance_proto_rawDescOnce.Do(func() {
+		file_holos_console_v1_maintenance_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_holos_console_v1_maintenance_proto_rawDesc), len(file_holos_console_v1_maintenance_proto_rawDesc)))
+	})
+	return file_holos_console_v1_maintenance_proto_rawDescData
+}
+
+var file_holos_console_v1_maintenance_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_holos_console_v1_maintenance_proto_goTypes = []any{
+	(*Maintenance)(nil),            // 0: holos.console.v1.Maintenance
+	(*GetMaintenanceRequest)(nil),  // 1: holos.console.v1.GetMaintenanceRequest
+	(*GetMaintenanceResponse)(nil), // 2: holos.console.v1.GetMaintenanceResponse
+	(*SetMaintenanceRequest)(nil),  // 3: holos.console.v1.SetMaintenanceRequest
+	(*SetMaintenanceResponse)(nil), // 4: holos.console.v1.SetMaintenanceResponse
+	(*timestamppb.Timestamp)(nil),  // 5: google.protobuf.Timestamp
+}
+var file_holos_console_v1_maintenance_proto_depIdxs = []int32{
+	5, // 0: holos.console.v1.Maintenance.changed_at:type_name -> google.protobuf.Timestamp
+	0, // 1: holos.console.v1.GetMaintenanceResponse.maintenance:type_name -> holos.console.v1.Maintenance
+	0, // 2: holos.console.v1.SetMaintenanceResponse.maintenance:type_name -> holos.console.v1.Maintenance
+	1, // 3: holos.console.v1.MaintenanceService.GetMaintenance:input_type -> holos.console.v1.GetMaintenanceRequest
+	3, // 4: holos.console.v1.MaintenanceService.SetMaintenance:input_type -> holos.console.v1.SetMaintenanceRequest
+	2, // 5: holos.console.v1.MaintenanceService.GetMaintenance:output_type -> holos.console.v1.GetMaintenanceResponse
+	4, // 6: holos.console.v1.MaintenanceService.SetMaintenance:output_type -> holos.console.v1.SetMaintenanceResponse
+	5, // [5:7] is the sub-list for method output_type
+	3, // [3:5] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_holos_console_v1_maintenance_proto_init() }
+func file_holos_console_v1_maintenance_proto_init() {
+	if File_holos_console_v1_maintenance_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_holos_console_v1_maintenance_proto_rawDesc), len(file_holos_console_v1_maintenance_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_holos_console_v1_maintenance_proto_goTypes,
+		DependencyIndexes: file_holos_console_v1_maintenance_proto_depIdxs,
+		MessageInfos:      file_holos_console_v1_maintenance_proto_msgTypes,
+	}.Build()
+	File_holos_console_v1_maintenance_proto = out.File
+	file_holos_console_v1_maintenance_proto_goTypes = nil
+	file_holos_console_v1_maintenance_proto_depIdxs = nil
+}
@@ -7,8 +7,10 @@
 package consolev1
 
 import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -49,8 +51,14 @@ type Organization struct {
 	// `console.holos.run/gateway-namespace` annotation on the org namespace and
 	// surfaced to template inputs via `platform.gatewayNamespace` (HOL-526).
 	GatewayNamespace string `protobuf:"bytes,12,opt,name=gateway_namespace,json=gatewayNamespace,proto3" json:"gateway_namespace,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	// updated_at is the RFC3339-formatted timestamp of the most recent write to
+	// this organization's namespace, sourced from metadata.managedFields.
+	UpdatedAt string `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// user_role_source explains where user_role comes from: a grant on this
+	// organization or the platform. Unset when user_role is ROLE_UNSPECIFIED.
+	UserRoleSource *RoleSource `protobuf:"bytes,14,opt,name=user_role_source,json=userRoleSource,proto3" json:"user_role_source,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *Organization) Reset() {
@@ -160,9 +168,28 @@ func (x *Organization) GetGatewayNamespace() string {
 	return ""
 }
 
+func (x *Organization) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *Organization) GetUserRoleSource() *RoleSource {
+	if x != nil {
+		return x.UserRoleSource
+	}
+	return nil
+}
+
 // ListOrganizationsRequest contains optional filters for listing organizations.
 type ListOrganizationsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// filter narrows the returned organizations. Labels are the organization
+	// namespace's labels.
+	Filter *ListFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// order_by sorts the returned organizations.
+	OrderBy       *ListOrder `protobuf:"bytes,2,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -197,6 +224,20 @@ func (*ListOrganizationsRequest) Descriptor() ([]byte, []int) {
 	return file_holos_console_v1_organizations_proto_rawDescGZIP(), []int{1}
 }
 
+func (x *ListOrganizationsRequest) GetFilter() *ListFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListOrganizationsRequest) GetOrderBy() *ListOrder {
+	if x != nil {
+		return x.OrderBy
+	}
+	return nil
+}
+
 // ListOrganizationsResponse contains the list of organizations the user can access.
 type ListOrganizationsResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -679,7 +720,10 @@ type UpdateOrganizationSharingRequest struct {
 	// user_grants are the per-user sharing grants to set.
 	UserGrants []*ShareGrant `protobuf:"bytes,2,rep,name=user_grants,json=userGrants,proto3" json:"user_grants,omitempty"`
 	// role_grants are the per-role sharing grants to set.
-	RoleGrants    []*ShareGrant `protobuf:"bytes,3,rep,name=role_grants,json=roleGrants,proto3" json:"role_grants,omitempty"`
+	RoleGrants []*ShareGrant `protobuf:"bytes,3,rep,name=role_grants,json=roleGrants,proto3" json:"role_grants,omitempty"`
+	// force accepts grants that leave the organization without an active
+	// owner. Only platform owners may set it.
+	Force         bool `protobuf:"varint,4,opt,name=force,proto3" json:"force,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -735,6 +779,13 @@ func (x *UpdateOrganizationSharingRequest) GetRoleGrants() []*ShareGrant {
 	return nil
 }
 
+func (x *UpdateOrganizationSharingRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
 // UpdateOrganizationSharingResponse contains the updated organization.
 type UpdateOrganizationSharingResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -781,13 +832,20 @@ func (x *UpdateOrganizationSharingResponse) GetOrganization() *Organization {
 	return nil
 }
 
-// GetOrganizationRawRequest contains the name of the organization to retrieve as raw JSON.
+// GetOrganizationRawRequest contains the name of the organization to retrieve as a raw manifest.
 type GetOrganizationRawRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// name is the name of the organization to retrieve.
-	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// format selects JSON (the default) or YAML output.
+	Format RawFormat `protobuf:"varint,2,opt,name=format,proto3,enum=holos.console.v1.RawFormat" json:"format,omitempty"`
+	// strip_managed_fields omits the server-populated fields (uid,
+	// resourceVersion, generation, creationTimestamp, managedFields,
+	// ownerReferences, and status) so the manifest can be applied to another
+	// cluster with kubectl apply.
+	StripManagedFields bool `protobuf:"varint,3,opt,name=strip_managed_fields,json=stripManagedFields,proto3" json:"strip_managed_fields,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *GetOrganizationRawRequest) Reset() {
@@ -827,10 +885,25 @@ func (x *GetOrganizationRawRequest) GetName() string {
 	return ""
 }
 
-// GetOrganizationRawResponse contains the full Kubernetes Namespace object as JSON.
+func (x *GetOrganizationRawRequest) GetFormat() RawFormat {
+	if x != nil {
+		return x.Format
+	}
+	return RawFormat_RAW_FORMAT_UNSPECIFIED
+}
+
+func (x *GetOrganizationRawRequest) GetStripManagedFields() bool {
+	if x != nil {
+		return x.StripManagedFields
+	}
+	return false
+}
+
+// GetOrganizationRawResponse contains the full Kubernetes Namespace object as JSON or YAML.
 type GetOrganizationRawResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// raw is the verbatim JSON-serialized Namespace object from the K8s API.
+	// raw is the Namespace object from the K8s API, serialized in the
+	// requested format.
 	Raw           string `protobuf:"bytes,1,opt,name=raw,proto3" json:"raw,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -983,11 +1056,408 @@ func (x *UpdateOrganizationDefaultSharingResponse) GetOrganization() *Organizati
 	return nil
 }
 
+// GetOrganizationStatsRequest names the organization to summarize.
+type GetOrganizationStatsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name is the name of the organization.
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrganizationStatsRequest) Reset() {
+	*x = GetOrganizationStatsRequest{}
+	mi := &file_holos_console_v1_organizations_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrganizationStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrganizationStatsRequest) ProtoMessage() {}
+
+func (x *GetOrganizationStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_organizations_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrganizationStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetOrganizationStatsRequest) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_organizations_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetOrganizationStatsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// ActivitySummary aggregates the recent audit events of one action.
+type ActivitySummary struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// action is the audit action, e.g. "secret_update".
+	Action string `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	// count is the number of events in the activity window.
+	Count int32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	// last_time is when the most recent event was recorded.
+	LastTime      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_time,json=lastTime,proto3" json:"last_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivitySummary) Reset() {
+	*x = ActivitySummary{}
+	mi := &file_holos_console_v1_organizations_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivitySummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivitySummary) ProtoMessage() {}
+
+func (x *ActivitySummary) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_organizations_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivitySummary.ProtoReflect.Descriptor instead.
+func (*ActivitySummary) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_organizations_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ActivitySummary) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ActivitySummary) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *ActivitySummary) GetLastTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastTime
+	}
+	return nil
+}
+
+// GetOrganizationStatsResponse contains the organization's usage counts.
+type GetOrganizationStatsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// project_count is the number of projects in the organization.
+	ProjectCount int32 `protobuf:"varint,1,opt,name=project_count,json=projectCount,proto3" json:"project_count,omitempty"`
+	// secret_count is the number of console-managed secrets across the
+	// organization's projects.
+	SecretCount int32 `protobuf:"varint,2,opt,name=secret_count,json=secretCount,proto3" json:"secret_count,omitempty"`
+	// member_count is the number of distinct users and groups granted a role
+	// on the organization, its folders, or its projects. Deny and expired
+	// grants are not counted.
+	MemberCount int32 `protobuf:"varint,3,opt,name=member_count,json=memberCount,proto3" json:"member_count,omitempty"`
+	// recent_activity summarizes audit events for the organization and its
+	// projects within activity_window_seconds, most frequent first. Events
+	// come from the serving replica's in-memory audit buffer, so older or
+	// other replicas' events may be missing.
+	RecentActivity []*ActivitySummary `protobuf:"bytes,4,rep,name=recent_activity,json=recentActivity,proto3" json:"recent_activity,omitempty"`
+	// activity_window_seconds is the length of the window recent_activity
+	// covers.
+	ActivityWindowSeconds int64 `protobuf:"varint,5,opt,name=activity_window_seconds,json=activityWindowSeconds,proto3" json:"activity_window_seconds,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *GetOrganizationStatsResponse) Reset() {
+	*x = GetOrganizationStatsResponse{}
+	mi := &file_holos_console_v1_organizations_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrganizationStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrganizationStatsResponse) ProtoMessage() {}
+
+func (x *GetOrganizationStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_organizations_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrganizationStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetOrganizationStatsResponse) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_organizations_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetOrganizationStatsResponse) GetProjectCount() int32 {
+	if x != nil {
+		return x.ProjectCount
+	}
+	return 0
+}
+
+func (x *GetOrganizationStatsResponse) GetSecretCount() int32 {
+	if x != nil {
+		return x.SecretCount
+	}
+	return 0
+}
+
+func (x *GetOrganizationStatsResponse) GetMemberCount() int32 {
+	if x != nil {
+		return x.MemberCount
+	}
+	return 0
+}
+
+func (x *GetOrganizationStatsResponse) GetRecentActivity() []*ActivitySummary {
+	if x != nil {
+		return x.RecentActivity
+	}
+	return nil
+}
+
+func (x *GetOrganizationStatsResponse) GetActivityWindowSeconds() int64 {
+	if x != nil {
+		return x.ActivityWindowSeconds
+	}
+	return 0
+}
+
+// ListOrganizationMembersRequest names the organization whose members to
+// list.
+type ListOrganizationMembersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name is the name of the organization.
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrganizationMembersRequest) Reset() {
+	*x = ListOrganizationMembersRequest{}
+	mi := &file_holos_console_v1_organizations_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrganizationMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrganizationMembersRequest) ProtoMessage() {}
+
+func (x *ListOrganizationMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_organizations_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrganizationMembersRequest.ProtoReflect.Descriptor instead.
+func (*ListOrganizationMembersRequest) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_organizations_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListOrganizationMembersRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// OrganizationMember is a principal holding grants in an organization and
+// how many it holds at each level. Deny and inactive grants are not counted.
+type OrganizationMember struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// principal is the email address, "*@domain", "*", or group name the
+	// grants name, without the "oidc:" prefix.
+	Principal string `protobuf:"bytes,1,opt,name=principal,proto3" json:"principal,omitempty"`
+	// kind is PRINCIPAL_KIND_USER for user grants and PRINCIPAL_KIND_GROUP
+	// for role grants.
+	Kind PrincipalKind `protobuf:"varint,2,opt,name=kind,proto3,enum=holos.console.v1.PrincipalKind" json:"kind,omitempty"`
+	// highest_role is the highest role any of the principal's grants confers.
+	HighestRole Role `protobuf:"varint,3,opt,name=highest_role,json=highestRole,proto3,enum=holos.console.v1.Role" json:"highest_role,omitempty"`
+	// organization_grants is 1 when the organization itself grants the
+	// principal a role.
+	OrganizationGrants int32 `protobuf:"varint,4,opt,name=organization_grants,json=organizationGrants,proto3" json:"organization_grants,omitempty"`
+	// folder_grants is the number of folders granting the principal a role.
+	FolderGrants int32 `protobuf:"varint,5,opt,name=folder_grants,json=folderGrants,proto3" json:"folder_grants,omitempty"`
+	// project_grants is the number of projects granting the principal a role.
+	ProjectGrants int32 `protobuf:"varint,6,opt,name=project_grants,json=projectGrants,proto3" json:"project_grants,omitempty"`
+	// secret_grants is the number of secret grants the principal holds:
+	// project-wide secret sharing grants and per-secret key grants.
+	SecretGrants  int32 `protobuf:"varint,7,opt,name=secret_grants,json=secretGrants,proto3" json:"secret_grants,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrganizationMember) Reset() {
+	*x = OrganizationMember{}
+	mi := &file_holos_console_v1_organizations_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrganizationMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrganizationMember) ProtoMessage() {}
+
+func (x *OrganizationMember) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_organizations_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrganizationMember.ProtoReflect.Descriptor instead.
+func (*OrganizationMember) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_organizations_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *OrganizationMember) GetPrincipal() string {
+	if x != nil {
+		return x.Principal
+	}
+	return ""
+}
+
+func (x *OrganizationMember) GetKind() PrincipalKind {
+	if x != nil {
+		return x.Kind
+	}
+	return PrincipalKind_PRINCIPAL_KIND_UNSPECIFIED
+}
+
+func (x *OrganizationMember) GetHighestRole() Role {
+	if x != nil {
+		return x.HighestRole
+	}
+	return Role_ROLE_UNSPECIFIED
+}
+
+func (x *OrganizationMember) GetOrganizationGrants() int32 {
+	if x != nil {
+		return x.OrganizationGrants
+	}
+	return 0
+}
+
+func (x *OrganizationMember) GetFolderGrants() int32 {
+	if x != nil {
+		return x.FolderGrants
+	}
+	return 0
+}
+
+func (x *OrganizationMember) GetProjectGrants() int32 {
+	if x != nil {
+		return x.ProjectGrants
+	}
+	return 0
+}
+
+func (x *OrganizationMember) GetSecretGrants() int32 {
+	if x != nil {
+		return x.SecretGrants
+	}
+	return 0
+}
+
+// ListOrganizationMembersResponse lists the organization's members, highest
+// role first and then by principal.
+type ListOrganizationMembersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Members       []*OrganizationMember  `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrganizationMembersResponse) Reset() {
+	*x = ListOrganizationMembersResponse{}
+	mi := &file_holos_console_v1_organizations_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrganizationMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrganizationMembersResponse) ProtoMessage() {}
+
+func (x *ListOrganizationMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_organizations_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrganizationMembersResponse.ProtoReflect.Descriptor instead.
+func (*ListOrganizationMembersResponse) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_organizations_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListOrganizationMembersResponse) GetMembers() []*OrganizationMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
 var File_holos_console_v1_organizations_proto protoreflect.FileDescriptor
 
 const file_holos_console_v1_organizations_proto_rawDesc = "" +
 	"\n" +
-	"$holos/console/v1/organizations.proto\x12\x10holos.console.v1\x1a\x1bholos/console/v1/rbac.proto\x1a\x1eholos/console/v1/secrets.proto\"\xad\x04\n" +
+	"$holos/console/v1/organizations.proto\x12\x10holos.console.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\"holos/console/v1/list_filter.proto\x1a\"holos/console/v1/permissions.proto\x1a!holos/console/v1/raw_format.proto\x1a\x1bholos/console/v1/rbac.proto\x1a\x1eholos/console/v1/secrets.proto\"\x94\x05\n" +
 	"\fOrganization\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12!\n" +
 	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12 \n" +
@@ -1003,18 +1473,23 @@ const file_holos_console_v1_organizations_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\n" +
 	" \x01(\tR\tcreatedAt\x12+\n" +
-	"\x11gateway_namespace\x18\f \x01(\tR\x10gatewayNamespaceJ\x04\b\v\x10\f\"\x1a\n" +
-	"\x18ListOrganizationsRequest\"a\n" +
+	"\x11gateway_namespace\x18\f \x01(\tR\x10gatewayNamespace\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\r \x01(\tR\tupdatedAt\x12F\n" +
+	"\x10user_role_source\x18\x0e \x01(\v2\x1c.holos.console.v1.RoleSourceR\x0euserRoleSourceJ\x04\b\v\x10\f\"\x88\x01\n" +
+	"\x18ListOrganizationsRequest\x124\n" +
+	"\x06filter\x18\x01 \x01(\v2\x1c.holos.console.v1.ListFilterR\x06filter\x126\n" +
+	"\border_by\x18\x02 \x01(\v2\x1b.holos.console.v1.ListOrderR\aorderBy\"a\n" +
 	"\x19ListOrganizationsResponse\x12D\n" +
-	"\rorganizations\x18\x01 \x03(\v2\x1e.holos.console.v1.OrganizationR\rorganizations\",\n" +
-	"\x16GetOrganizationRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\"]\n" +
+	"\rorganizations\x18\x01 \x03(\v2\x1e.holos.console.v1.OrganizationR\rorganizations\"4\n" +
+	"\x16GetOrganizationRequest\x12\x1a\n" +
+	"\x04name\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x04name\"]\n" +
 	"\x17GetOrganizationResponse\x12B\n" +
-	"\forganization\x18\x01 \x01(\v2\x1e.holos.console.v1.OrganizationR\forganization\"\xc0\x02\n" +
-	"\x19CreateOrganizationRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12!\n" +
-	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\x12=\n" +
+	"\forganization\x18\x01 \x01(\v2\x1e.holos.console.v1.OrganizationR\forganization\"\xf1\x02\n" +
+	"\x19CreateOrganizationRequest\x129\n" +
+	"\x04name\x18\x01 \x01(\tB%\xbaH\"\xc8\x01\x01r\x1d\x18?2\x19^[a-z][a-z0-9-]*[a-z0-9]$R\x04name\x12!\n" +
+	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12*\n" +
+	"\vdescription\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\x80 R\vdescription\x12=\n" +
 	"\vuser_grants\x18\x04 \x03(\v2\x1c.holos.console.v1.ShareGrantR\n" +
 	"userGrants\x12=\n" +
 	"\vrole_grants\x18\x05 \x03(\v2\x1c.holos.console.v1.ShareGrantR\n" +
@@ -1022,46 +1497,75 @@ const file_holos_console_v1_organizations_proto_rawDesc = "" +
 	"\x11populate_defaults\x18\a \x01(\bH\x00R\x10populateDefaults\x88\x01\x01B\x14\n" +
 	"\x12_populate_defaultsJ\x04\b\x06\x10\a\"0\n" +
 	"\x1aCreateOrganizationResponse\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\"\xed\x01\n" +
-	"\x19UpdateOrganizationRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12&\n" +
-	"\fdisplay_name\x18\x02 \x01(\tH\x00R\vdisplayName\x88\x01\x01\x12%\n" +
-	"\vdescription\x18\x03 \x01(\tH\x01R\vdescription\x88\x01\x01\x120\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"\xff\x01\n" +
+	"\x19UpdateOrganizationRequest\x12\x1a\n" +
+	"\x04name\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x04name\x12&\n" +
+	"\fdisplay_name\x18\x02 \x01(\tH\x00R\vdisplayName\x88\x01\x01\x12/\n" +
+	"\vdescription\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\x80 H\x01R\vdescription\x88\x01\x01\x120\n" +
 	"\x11gateway_namespace\x18\x05 \x01(\tH\x02R\x10gatewayNamespace\x88\x01\x01B\x0f\n" +
 	"\r_display_nameB\x0e\n" +
 	"\f_descriptionB\x14\n" +
 	"\x12_gateway_namespaceJ\x04\b\x04\x10\x05\"\x1c\n" +
-	"\x1aUpdateOrganizationResponse\"/\n" +
-	"\x19DeleteOrganizationRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\"\x1c\n" +
-	"\x1aDeleteOrganizationResponse\"\xb4\x01\n" +
-	" UpdateOrganizationSharingRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12=\n" +
+	"\x1aUpdateOrganizationResponse\"7\n" +
+	"\x19DeleteOrganizationRequest\x12\x1a\n" +
+	"\x04name\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x04name\"\x1c\n" +
+	"\x1aDeleteOrganizationResponse\"\xd2\x01\n" +
+	" UpdateOrganizationSharingRequest\x12\x1a\n" +
+	"\x04name\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x04name\x12=\n" +
 	"\vuser_grants\x18\x02 \x03(\v2\x1c.holos.console.v1.ShareGrantR\n" +
 	"userGrants\x12=\n" +
 	"\vrole_grants\x18\x03 \x03(\v2\x1c.holos.console.v1.ShareGrantR\n" +
-	"roleGrants\"g\n" +
+	"roleGrants\x12\x14\n" +
+	"\x05force\x18\x04 \x01(\bR\x05force\"g\n" +
 	"!UpdateOrganizationSharingResponse\x12B\n" +
-	"\forganization\x18\x01 \x01(\v2\x1e.holos.console.v1.OrganizationR\forganization\"/\n" +
-	"\x19GetOrganizationRawRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\".\n" +
+	"\forganization\x18\x01 \x01(\v2\x1e.holos.console.v1.OrganizationR\forganization\"\x9e\x01\n" +
+	"\x19GetOrganizationRawRequest\x12\x1a\n" +
+	"\x04name\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x04name\x123\n" +
+	"\x06format\x18\x02 \x01(\x0e2\x1b.holos.console.v1.RawFormatR\x06format\x120\n" +
+	"\x14strip_managed_fields\x18\x03 \x01(\bR\x12stripManagedFields\".\n" +
 	"\x1aGetOrganizationRawResponse\x12\x10\n" +
-	"\x03raw\x18\x01 \x01(\tR\x03raw\"\xd9\x01\n" +
-	"'UpdateOrganizationDefaultSharingRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12L\n" +
+	"\x03raw\x18\x01 \x01(\tR\x03raw\"\xe1\x01\n" +
+	"'UpdateOrganizationDefaultSharingRequest\x12\x1a\n" +
+	"\x04name\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x04name\x12L\n" +
 	"\x13default_user_grants\x18\x02 \x03(\v2\x1c.holos.console.v1.ShareGrantR\x11defaultUserGrants\x12L\n" +
 	"\x13default_role_grants\x18\x03 \x03(\v2\x1c.holos.console.v1.ShareGrantR\x11defaultRoleGrants\"n\n" +
 	"(UpdateOrganizationDefaultSharingResponse\x12B\n" +
-	"\forganization\x18\x01 \x01(\v2\x1e.holos.console.v1.OrganizationR\forganization2\xd2\a\n" +
-	"\x13OrganizationService\x12l\n" +
-	"\x11ListOrganizations\x12*.holos.console.v1.ListOrganizationsRequest\x1a+.holos.console.v1.ListOrganizationsResponse\x12f\n" +
-	"\x0fGetOrganization\x12(.holos.console.v1.GetOrganizationRequest\x1a).holos.console.v1.GetOrganizationResponse\x12o\n" +
+	"\forganization\x18\x01 \x01(\v2\x1e.holos.console.v1.OrganizationR\forganization\"9\n" +
+	"\x1bGetOrganizationStatsRequest\x12\x1a\n" +
+	"\x04name\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x04name\"x\n" +
+	"\x0fActivitySummary\x12\x16\n" +
+	"\x06action\x18\x01 \x01(\tR\x06action\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\x127\n" +
+	"\tlast_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\blastTime\"\x8d\x02\n" +
+	"\x1cGetOrganizationStatsResponse\x12#\n" +
+	"\rproject_count\x18\x01 \x01(\x05R\fprojectCount\x12!\n" +
+	"\fsecret_count\x18\x02 \x01(\x05R\vsecretCount\x12!\n" +
+	"\fmember_count\x18\x03 \x01(\x05R\vmemberCount\x12J\n" +
+	"\x0frecent_activity\x18\x04 \x03(\v2!.holos.console.v1.ActivitySummaryR\x0erecentActivity\x126\n" +
+	"\x17activity_window_seconds\x18\x05 \x01(\x03R\x15activityWindowSeconds\"<\n" +
+	"\x1eListOrganizationMembersRequest\x12\x1a\n" +
+	"\x04name\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x04name\"\xc4\x02\n" +
+	"\x12OrganizationMember\x12\x1c\n" +
+	"\tprincipal\x18\x01 \x01(\tR\tprincipal\x123\n" +
+	"\x04kind\x18\x02 \x01(\x0e2\x1f.holos.console.v1.PrincipalKindR\x04kind\x129\n" +
+	"\fhighest_role\x18\x03 \x01(\x0e2\x16.holos.console.v1.RoleR\vhighestRole\x12/\n" +
+	"\x13organization_grants\x18\x04 \x01(\x05R\x12organizationGrants\x12#\n" +
+	"\rfolder_grants\x18\x05 \x01(\x05R\ffolderGrants\x12%\n" +
+	"\x0eproject_grants\x18\x06 \x01(\x05R\rprojectGrants\x12#\n" +
+	"\rsecret_grants\x18\a \x01(\x05R\fsecretGrants\"a\n" +
+	"\x1fListOrganizationMembersResponse\x12>\n" +
+	"\amembers\x18\x01 \x03(\v2$.holos.console.v1.OrganizationMemberR\amembers2\xe3\t\n" +
+	"\x13OrganizationService\x12q\n" +
+	"\x11ListOrganizations\x12*.holos.console.v1.ListOrganizationsRequest\x1a+.holos.console.v1.ListOrganizationsResponse\"\x03\x90\x02\x01\x12k\n" +
+	"\x0fGetOrganization\x12(.holos.console.v1.GetOrganizationRequest\x1a).holos.console.v1.GetOrganizationResponse\"\x03\x90\x02\x01\x12o\n" +
 	"\x12CreateOrganization\x12+.holos.console.v1.CreateOrganizationRequest\x1a,.holos.console.v1.CreateOrganizationResponse\x12o\n" +
 	"\x12UpdateOrganization\x12+.holos.console.v1.UpdateOrganizationRequest\x1a,.holos.console.v1.UpdateOrganizationResponse\x12o\n" +
 	"\x12DeleteOrganization\x12+.holos.console.v1.DeleteOrganizationRequest\x1a,.holos.console.v1.DeleteOrganizationResponse\x12\x84\x01\n" +
-	"\x19UpdateOrganizationSharing\x122.holos.console.v1.UpdateOrganizationSharingRequest\x1a3.holos.console.v1.UpdateOrganizationSharingResponse\x12o\n" +
-	"\x12GetOrganizationRaw\x12+.holos.console.v1.GetOrganizationRawRequest\x1a,.holos.console.v1.GetOrganizationRawResponse\x12\x99\x01\n" +
-	" UpdateOrganizationDefaultSharing\x129.holos.console.v1.UpdateOrganizationDefaultSharingRequest\x1a:.holos.console.v1.UpdateOrganizationDefaultSharingResponseBCZAgithub.com/holos-run/holos-console/gen/holos/console/v1;consolev1b\x06proto3"
+	"\x19UpdateOrganizationSharing\x122.holos.console.v1.UpdateOrganizationSharingRequest\x1a3.holos.console.v1.UpdateOrganizationSharingResponse\x12t\n" +
+	"\x12GetOrganizationRaw\x12+.holos.console.v1.GetOrganizationRawRequest\x1a,.holos.console.v1.GetOrganizationRawResponse\"\x03\x90\x02\x01\x12\x99\x01\n" +
+	" UpdateOrganizationDefaultSharing\x129.holos.console.v1.UpdateOrganizationDefaultSharingRequest\x1a:.holos.console.v1.UpdateOrganizationDefaultSharingResponse\x12z\n" +
+	"\x14GetOrganizationStats\x12-.holos.console.v1.GetOrganizationStatsRequest\x1a..holos.console.v1.GetOrganizationStatsResponse\"\x03\x90\x02\x01\x12\x83\x01\n" +
+	"\x17ListOrganizationMembers\x120.holos.console.v1.ListOrganizationMembersRequest\x1a1.holos.console.v1.ListOrganizationMembersResponse\"\x03\x90\x02\x01BCZAgithub.com/holos-run/holos-console/gen/holos/console/v1;consolev1b\x06proto3"
 
 var (
 	file_holos_console_v1_organizations_proto_rawDescOnce sync.Once
@@ -1075,7 +1579,7 @@ func file_holos_console_v1_organizations_proto_rawDescGZIP() []byte {
 	return file_holos_console_v1_organizations_proto_rawDescData
 }
 
-var file_holos_console_v1_organizations_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_holos_console_v1_organizations_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
 var file_holos_console_v1_organizations_proto_goTypes = []any{
 	(*Organization)(nil),                             // 0: holos.console.v1.Organization
 	(*ListOrganizationsRequest)(nil),                 // 1: holos.console.v1.ListOrganizationsRequest
@@ -1094,46 +1598,71 @@ var file_holos_console_v1_organizations_proto_goTypes = []any{
 	(*GetOrganizationRawResponse)(nil),               // 14: holos.console.v1.GetOrganizationRawResponse
 	(*UpdateOrganizationDefaultSharingRequest)(nil),  // 15: holos.console.v1.UpdateOrganizationDefaultSharingRequest
 	(*UpdateOrganizationDefaultSharingResponse)(nil), // 16: holos.console.v1.UpdateOrganizationDefaultSharingResponse
-	(*ShareGrant)(nil),                               // 17: holos.console.v1.ShareGrant
-	(Role)(0),                                        // 18: holos.console.v1.Role
+	(*GetOrganizationStatsRequest)(nil),              // 17: holos.console.v1.GetOrganizationStatsRequest
+	(*ActivitySummary)(nil),                          // 18: holos.console.v1.ActivitySummary
+	(*GetOrganizationStatsResponse)(nil),             // 19: holos.console.v1.GetOrganizationStatsResponse
+	(*ListOrganizationMembersRequest)(nil),           // 20: holos.console.v1.ListOrganizationMembersRequest
+	(*OrganizationMember)(nil),                       // 21: holos.console.v1.OrganizationMember
+	(*ListOrganizationMembersResponse)(nil),          // 22: holos.console.v1.ListOrganizationMembersResponse
+	(*ShareGrant)(nil),                               // 23: holos.console.v1.ShareGrant
+	(Role)(0),                                        // 24: holos.console.v1.Role
+	(*RoleSource)(nil),                               // 25: holos.console.v1.RoleSource
+	(*ListFilter)(nil),                               // 26: holos.console.v1.ListFilter
+	(*ListOrder)(nil),                                // 27: holos.console.v1.ListOrder
+	(RawFormat)(0),                                   // 28: holos.console.v1.RawFormat
+	(*timestamppb.Timestamp)(nil),                    // 29: google.protobuf.Timestamp
+	(PrincipalKind)(0),                               // 30: holos.console.v1.PrincipalKind
 }
 var file_holos_console_v1_organizations_proto_depIdxs = []int32{
-	17, // 0: holos.console.v1.Organization.user_grants:type_name -> holos.console.v1.ShareGrant
-	17, // 1: holos.console.v1.Organization.role_grants:type_name -> holos.console.v1.ShareGrant
-	18, // 2: holos.console.v1.Organization.user_role:type_name -> holos.console.v1.Role
-	17, // 3: holos.console.v1.Organization.default_user_grants:type_name -> holos.console.v1.ShareGrant
-	17, // 4: holos.console.v1.Organization.default_role_grants:type_name -> holos.console.v1.ShareGrant
-	0,  // 5: holos.console.v1.ListOrganizationsResponse.organizations:type_name -> holos.console.v1.Organization
-	0,  // 6: holos.console.v1.GetOrganizationResponse.organization:type_name -> holos.console.v1.Organization
-	17, // 7: holos.console.v1.CreateOrganizationRequest.user_grants:type_name -> holos.console.v1.ShareGrant
-	17, // 8: holos.console.v1.CreateOrganizationRequest.role_grants:type_name -> holos.console.v1.ShareGrant
-	17, // 9: holos.console.v1.UpdateOrganizationSharingRequest.user_grants:type_name -> holos.console.v1.ShareGrant
-	17, // 10: holos.console.v1.UpdateOrganizationSharingRequest.role_grants:type_name -> holos.console.v1.ShareGrant
-	0,  // 11: holos.console.v1.UpdateOrganizationSharingResponse.organization:type_name -> holos.console.v1.Organization
-	17, // 12: holos.console.v1.UpdateOrganizationDefaultSharingRequest.default_user_grants:type_name -> holos.console.v1.ShareGrant
-	17, // 13: holos.console.v1.UpdateOrganizationDefaultSharingRequest.default_role_grants:type_name -> holos.console.v1.ShareGrant
-	0,  // 14: holos.console.v1.UpdateOrganizationDefaultSharingResponse.organization:type_name -> holos.console.v1.Organization
-	1,  // 15: holos.console.v1.OrganizationService.ListOrganizations:input_type -> holos.console.v1.ListOrganizationsRequest
-	3,  // 16: holos.console.v1.OrganizationService.GetOrganization:input_type -> holos.console.v1.GetOrganizationRequest
-	5,  // 17: holos.console.v1.OrganizationService.CreateOrganization:input_type -> holos.console.v1.CreateOrganizationRequest
-	7,  // 18: holos.console.v1.OrganizationService.UpdateOrganization:input_type -> holos.console.v1.UpdateOrganizationRequest
-	9,  // 19: holos.console.v1.OrganizationService.DeleteOrganization:input_type -> holos.console.v1.DeleteOrganizationRequest
-	11, // 20: holos.console.v1.OrganizationService.UpdateOrganizationSharing:input_type -> holos.console.v1.UpdateOrganizationSharingRequest
-	13, // 21: holos.console.v1.OrganizationService.GetOrganizationRaw:input_type -> holos.console.v1.GetOrganizationRawRequest
-	15, // 22: holos.console.v1.OrganizationService.UpdateOrganizationDefaultSharing:input_type -> holos.console.v1.UpdateOrganizationDefaultSharingRequest
-	2,  // 23: holos.console.v1.OrganizationService.ListOrganizations:output_type -> holos.console.v1.ListOrganizationsResponse
-	4,  // 24: holos.console.v1.OrganizationService.GetOrganization:output_type -> holos.console.v1.GetOrganizationResponse
-	6,  // 25: holos.console.v1.OrganizationService.CreateOrganization:output_type -> holos.console.v1.CreateOrganizationResponse
-	8,  // 26: holos.console.v1.OrganizationService.UpdateOrganization:output_type -> holos.console.v1.UpdateOrganizationResponse
-	10, // 27: holos.console.v1.OrganizationService.DeleteOrganization:output_type -> holos.console.v1.DeleteOrganizationResponse
-	12, // 28: holos.console.v1.OrganizationService.UpdateOrganizationSharing:output_type -> holos.console.v1.UpdateOrganizationSharingResponse
-	14, // 29: holos.console.v1.OrganizationService.GetOrganizationRaw:output_type -> holos.console.v1.GetOrganizationRawResponse
-	16, // 30: holos.console.v1.OrganizationService.UpdateOrganizationDefaultSharing:output_type -> holos.console.v1.UpdateOrganizationDefaultSharingResponse
-	23, // [23:31] is the sub-list for method output_type
-	15, // [15:23] is the sub-list for method input_type
-	15, // [15:15] is the sub-list for extension type_name
-	15, // [15:15] is the sub-list for extension extendee
-	0,  // [0:15] is the sub-list for field type_name
+	23, // 0: holos.console.v1.Organization.user_grants:type_name -> holos.console.v1.ShareGrant
+	23, // 1: holos.console.v1.Organization.role_grants:type_name -> holos.console.v1.ShareGrant
+	24, // 2: holos.console.v1.Organization.user_role:type_name -> holos.console.v1.Role
+	23, // 3: holos.console.v1.Organization.default_user_grants:type_name -> holos.console.v1.ShareGrant
+	23, // 4: holos.console.v1.Organization.default_role_grants:type_name -> holos.console.v1.ShareGrant
+	25, // 5: holos.console.v1.Organization.user_role_source:type_name -> holos.console.v1.RoleSource
+	26, // 6: holos.console.v1.ListOrganizationsRequest.filter:type_name -> holos.console.v1.ListFilter
+	27, // 7: holos.console.v1.ListOrganizationsRequest.order_by:type_name -> holos.console.v1.ListOrder
+	0,  // 8: holos.console.v1.ListOrganizationsResponse.organizations:type_name -> holos.console.v1.Organization
+	0,  // 9: holos.console.v1.GetOrganizationResponse.organization:type_name -> holos.console.v1.Organization
+	23, // 10: holos.console.v1.CreateOrganizationRequest.user_grants:type_name -> holos.console.v1.ShareGrant
+	23, // 11: holos.console.v1.CreateOrganizationRequest.role_grants:type_name -> holos.console.v1.ShareGrant
+	23, // 12: holos.console.v1.UpdateOrganizationSharingRequest.user_grants:type_name -> holos.console.v1.ShareGrant
+	23, // 13: holos.console.v1.UpdateOrganizationSharingRequest.role_grants:type_name -> holos.console.v1.ShareGrant
+	0,  // 14: holos.console.v1.UpdateOrganizationSharingResponse.organization:type_name -> holos.console.v1.Organization
+	28, // 15: holos.console.v1.GetOrganizationRawRequest.format:type_name -> holos.console.v1.RawFormat
+	23, // 16: holos.console.v1.UpdateOrganizationDefaultSharingRequest.default_user_grants:type_name -> holos.console.v1.ShareGrant
+	23, // 17: holos.console.v1.UpdateOrganizationDefaultSharingRequest.default_role_grants:type_name -> holos.console.v1.ShareGrant
+	0,  // 18: holos.console.v1.UpdateOrganizationDefaultSharingResponse.organization:type_name -> holos.console.v1.Organization
+	29, // 19: holos.console.v1.ActivitySummary.last_time:type_name -> google.protobuf.Timestamp
+	18, // 20: holos.console.v1.GetOrganizationStatsResponse.recent_activity:type_name -> holos.console.v1.ActivitySummary
+	30, // 21: holos.console.v1.OrganizationMember.kind:type_name -> holos.console.v1.PrincipalKind
+	24, // 22: holos.console.v1.OrganizationMember.highest_role:type_name -> holos.console.v1.Role
+	21, // 23: holos.console.v1.ListOrganizationMembersResponse.members:type_name -> holos.console.v1.OrganizationMember
+	1,  // 24: holos.console.v1.OrganizationService.ListOrganizations:input_type -> holos.console.v1.ListOrganizationsRequest
+	3,  // 25: holos.console.v1.OrganizationService.GetOrganization:input_type -> holos.console.v1.GetOrganizationRequest
+	5,  // 26: holos.console.v1.OrganizationService.CreateOrganization:input_type -> holos.console.v1.CreateOrganizationRequest
+	7,  // 27: holos.console.v1.OrganizationService.UpdateOrganization:input_type -> holos.console.v1.UpdateOrganizationRequest
+	9,  // 28: holos.console.v1.OrganizationService.DeleteOrganization:input_type -> holos.console.v1.DeleteOrganizationRequest
+	11, // 29: holos.console.v1.OrganizationService.UpdateOrganizationSharing:input_type -> holos.console.v1.UpdateOrganizationSharingRequest
+	13, // 30: holos.console.v1.OrganizationService.GetOrganizationRaw:input_type -> holos.console.v1.GetOrganizationRawRequest
+	15, // 31: holos.console.v1.OrganizationService.UpdateOrganizationDefaultSharing:input_type -> holos.console.v1.UpdateOrganizationDefaultSharingRequest
+	17, // 32: holos.console.v1.OrganizationService.GetOrganizationStats:input_type -> holos.console.v1.GetOrganizationStatsRequest
+	20, // 33: holos.console.v1.OrganizationService.ListOrganizationMembers:input_type -> holos.console.v1.ListOrganizationMembersRequest
+	2,  // 34: holos.console.v1.OrganizationService.ListOrganizations:output_type -> holos.console.v1.ListOrganizationsResponse
+	4,  // 35: holos.console.v1.OrganizationService.GetOrganization:output_type -> holos.console.v1.GetOrganizationResponse
+	6,  // 36: holos.console.v1.OrganizationService.CreateOrganization:output_type -> holos.console.v1.CreateOrganizationResponse
+	8,  // 37: holos.console.v1.OrganizationService.UpdateOrganization:output_type -> holos.console.v1.UpdateOrganizationResponse
+	10, // 38: holos.console.v1.OrganizationService.DeleteOrganization:output_type -> holos.console.v1.DeleteOrganizationResponse
+	12, // 39: holos.console.v1.OrganizationService.UpdateOrganizationSharing:output_type -> holos.console.v1.UpdateOrganizationSharingResponse
+	14, // 40: holos.console.v1.OrganizationService.GetOrganizationRaw:output_type -> holos.console.v1.GetOrganizationRawResponse
+	16, // 41: holos.console.v1.OrganizationService.UpdateOrganizationDefaultSharing:output_type -> holos.console.v1.UpdateOrganizationDefaultSharingResponse
+	19, // 42: holos.console.v1.OrganizationService.GetOrganizationStats:output_type -> holos.console.v1.GetOrganizationStatsResponse
+	22, // 43: holos.console.v1.OrganizationService.ListOrganizationMembers:output_type -> holos.console.v1.ListOrganizationMembersResponse
+	34, // [34:44] is the sub-list for method output_type
+	24, // [24:34] is the sub-list for method input_type
+	24, // [24:24] is the sub-list for extension type_name
+	24, // [24:24] is the sub-list for extension extendee
+	0,  // [0:24] is the sub-list for field type_name
 }
 
 func init() { file_holos_console_v1_organizations_proto_init() }
@@ -1141,6 +1670,9 @@ func file_holos_console_v1_organizations_proto_init() {
 	if File_holos_console_v1_organizations_proto != nil {
 		return
 	}
+	file_holos_console_v1_list_filter_proto_init()
+	file_holos_console_v1_permissions_proto_init()
+	file_holos_console_v1_raw_format_proto_init()
 	file_holos_console_v1_rbac_proto_init()
 	file_holos_console_v1_secrets_proto_init()
 	file_holos_console_v1_organizations_proto_msgTypes[5].OneofWrappers = []any{}
@@ -1151,7 +1683,7 @@ func file_holos_console_v1_organizations_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_holos_console_v1_organizations_proto_rawDesc), len(file_holos_console_v1_organizations_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   17,
+			NumMessages:   23,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
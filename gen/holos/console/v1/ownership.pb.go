@@ -0,0 +1,234 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: holos/console/v1/ownership.proto
+
+package consolev1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// TransferOwnershipRequest identifies the resource and its new owner.
+type TransferOwnershipRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// resource_type is "organization" or "project". Secrets are transferred
+	// with their project: project owners own every secret in the project.
+	ResourceType string `protobuf:"bytes,1,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	// name is the organization or project name.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// new_owner is the email address of the user who becomes an owner. Any
+	// grant they already hold is replaced by the owner grant.
+	NewOwner string `protobuf:"bytes,3,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty"`
+	// caller_role is the role the caller keeps after the transfer.
+	// ROLE_UNSPECIFIED leaves the caller an owner; ROLE_EDITOR or ROLE_VIEWER
+	// demotes the caller's user grant. A caller who owns the resource through
+	// a role grant keeps that grant.
+	CallerRole    Role `protobuf:"varint,4,opt,name=caller_role,json=callerRole,proto3,enum=holos.console.v1.Role" json:"caller_role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferOwnershipRequest) Reset() {
+	*x = TransferOwnershipRequest{}
+	mi := &file_holos_console_v1_ownership_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferOwnershipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferOwnershipRequest) ProtoMessage() {}
+
+func (x *TransferOwnershipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_ownership_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferOwnershipRequest.ProtoReflect.Descriptor instead.
+func (*TransferOwnershipRequest) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_ownership_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TransferOwnershipRequest) GetResourceType() string {
+	if x != nil {
+		return x.ResourceType
+	}
+	return ""
+}
+
+func (x *TransferOwnershipRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TransferOwnershipRequest) GetNewOwner() string {
+	if x != nil {
+		return x.NewOwner
+	}
+	return ""
+}
+
+func (x *TransferOwnershipRequest) GetCallerRole() Role {
+	if x != nil {
+		return x.CallerRole
+	}
+	return Role_ROLE_UNSPECIFIED
+}
+
+// TransferOwnershipResponse contains the sharing grants after the transfer.
+type TransferOwnershipResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// user_grants are the per-user sharing grants on the resource.
+	UserGrants []*ShareGrant `protobuf:"bytes,1,rep,name=user_grants,json=userGrants,proto3" json:"user_grants,omitempty"`
+	// role_grants are the per-role sharing grants on the resource.
+	RoleGrants    []*ShareGrant `protobuf:"bytes,2,rep,name=role_grants,json=roleGrants,proto3" json:"role_grants,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferOwnershipResponse) Reset() {
+	*x = TransferOwnershipResponse{}
+	mi := &file_holos_console_v1_ownership_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferOwnershipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferOwnershipResponse) ProtoMessage() {}
+
+func (x *TransferOwnershipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_ownership_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferOwnershipResponse.ProtoReflect.Descriptor instead.
+func (*TransferOwnershipResponse) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_ownership_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TransferOwnershipResponse) GetUserGrants() []*ShareGrant {
+	if x != nil {
+		return x.UserGrants
+	}
+	return nil
+}
+
+func (x *TransferOwnershipResponse) GetRoleGrants() []*ShareGrant {
+	if x != nil {
+		return x.RoleGrants
+	}
+	return nil
+}
+
+var File_holos_console_v1_ownership_proto protoreflect.FileDescriptor
+
+const file_holos_console_v1_ownership_proto_rawDesc = "" +
+	"\n" +
+	" holos/console/v1/ownership.proto\x12\x10holos.console.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1bholos/console/v1/rbac.proto\x1a\x1eholos/console/v1/secrets.proto\"\xe8\x01\n" +
+	"\x18TransferOwnershipRequest\x12D\n" +
+	"\rresource_type\x18\x01 \x01(\tB\x1f\xbaH\x1c\xc8\x01\x01r\x17R\forganizationR\aprojectR\fresourceType\x12\x1a\n" +
+	"\x04name\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x04name\x12'\n" +
+	"\tnew_owner\x18\x03 \x01(\tB\n" +
+	"\xbaH\a\xc8\x01\x01r\x02`\x01R\bnewOwner\x12A\n" +
+	"\vcaller_role\x18\x04 \x01(\x0e2\x16.holos.console.v1.RoleB\b\xbaH\x05\x82\x01\x02\x10\x01R\n" +
+	"callerRole\"\x99\x01\n" +
+	"\x19TransferOwnershipResponse\x12=\n" +
+	"\vuser_grants\x18\x01 \x03(\v2\x1c.holos.console.v1.ShareGrantR\n" +
+	"userGrants\x12=\n" +
+	"\vrole_grants\x18\x02 \x03(\v2\x1c.holos.console.v1.ShareGrantR\n" +
+	"roleGrants2\x80\x01\n" +
+	"\x10OwnershipService\x12l\n" +
+	"\x11TransferOwnership\x12*.holos.console.v1.TransferOwnershipRequest\x1a+.holos.console.v1.TransferOwnershipResponseBCZAgithub.com/holos-run/holos-console/gen/holos/console/v1;consolev1b\x06proto3"
+
+var (
+	file_holos_console_v1_ownership_proto_rawDescOnce sync.Once
+	file_holos_console_v1_ownership_proto_rawDescData []byte
+)
+
+func file_holos_console_v1_ownership_proto_rawDescGZIP() []byte {
+	file_holos_console_v1_ownership_proto_rawDescOnce.Do(func() {
+		file_holos_console_v1_ownership_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_holos_console_v1_ownership_proto_rawDesc), len(file_holos_console_v1_ownership_proto_rawDesc)))
+	})
+	return file_holos_console_v1_ownership_proto_rawDescData
+}
+
+var file_holos_console_v1_ownership_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_holos_console_v1_ownership_proto_goTypes = []any{
+	(*TransferOwnershipRequest)(nil),  // 0: holos.console.v1.TransferOwnershipRequest
+	(*TransferOwnershipResponse)(nil), // 1: holos.console.v1.TransferOwnershipResponse
+	(Role)(0),                         // 2: holos.console.v1.Role
+	(*ShareGrant)(nil),                // 3: holos.console.v1.ShareGrant
+}
+var file_holos_console_v1_ownership_proto_depIdxs = []int32{
+	2, // 0: holos.console.v1.TransferOwnershipRequest.caller_role:type_name -> holos.console.v1.Role
+	3, // 1: holos.console.v1.TransferOwnershipResponse.user_grants:type_name -> holos.console.v1.ShareGrant
+	3, // 2: holos.console.v1.TransferOwnershipResponse.role_grants:type_name -> holos.console.v1.ShareGrant
+	0, // 3: holos.console.v1.OwnershipService.TransferOwnership:input_type -> holos.console.v1.TransferOwnershipRequest
+	1, // 4: holos.console.v1.OwnershipService.TransferOwnership:output_type -> holos.console.v1.TransferOwnershipResponse
+	4, // [4:5] is the sub-list for method output_type
+	3, // [3:4] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_holos_console_v1_ownership_proto_init() }
+func file_holos_console_v1_ownership_proto_init() {
+	if File_holos_console_v1_ownership_proto != nil {
+		return
+	}
+	file_holos_console_v1_rbac_proto_init()
+	file_holos_console_v1_secrets_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_holos_console_v1_ownership_proto_rawDesc), len(file_holos_console_v1_ownership_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_holos_console_v1_ownership_proto_goTypes,
+		DependencyIndexes: file_holos_console_v1_ownership_proto_depIdxs,
+		MessageInfos:      file_holos_console_v1_ownership_proto_msgTypes,
+	}.Build()
+	File_holos_console_v1_ownership_proto = out.File
+	file_holos_console_v1_ownership_proto_goTypes = nil
+	file_holos_console_v1_ownership_proto_depIdxs = nil
+}
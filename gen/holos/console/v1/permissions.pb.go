@@ -21,6 +21,63 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// PrincipalKind is the kind of Kubernetes RBAC subject an access grant names.
+type PrincipalKind int32
+
+const (
+	// PRINCIPAL_KIND_UNSPECIFIED indicates no kind was specified.
+	PrincipalKind_PRINCIPAL_KIND_UNSPECIFIED PrincipalKind = 0
+	// PRINCIPAL_KIND_USER is an OIDC user.
+	PrincipalKind_PRINCIPAL_KIND_USER PrincipalKind = 1
+	// PRINCIPAL_KIND_GROUP is an OIDC group or Kubernetes group.
+	PrincipalKind_PRINCIPAL_KIND_GROUP PrincipalKind = 2
+	// PRINCIPAL_KIND_SERVICE_ACCOUNT is a Kubernetes ServiceAccount.
+	PrincipalKind_PRINCIPAL_KIND_SERVICE_ACCOUNT PrincipalKind = 3
+)
+
+// Enum value maps for PrincipalKind.
+var (
+	PrincipalKind_name = map[int32]string{
+		0: "PRINCIPAL_KIND_UNSPECIFIED",
+		1: "PRINCIPAL_KIND_USER",
+		2: "PRINCIPAL_KIND_GROUP",
+		3: "PRINCIPAL_KIND_SERVICE_ACCOUNT",
+	}
+	PrincipalKind_value = map[string]int32{
+		"PRINCIPAL_KIND_UNSPECIFIED":     0,
+		"PRINCIPAL_KIND_USER":            1,
+		"PRINCIPAL_KIND_GROUP":           2,
+		"PRINCIPAL_KIND_SERVICE_ACCOUNT": 3,
+	}
+)
+
+func (x PrincipalKind) Enum() *PrincipalKind {
+	p := new(PrincipalKind)
+	*p = x
+	return p
+}
+
+func (x PrincipalKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PrincipalKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_holos_console_v1_permissions_proto_enumTypes[0].Descriptor()
+}
+
+func (PrincipalKind) Type() protoreflect.EnumType {
+	return &file_holos_console_v1_permissions_proto_enumTypes[0]
+}
+
+func (x PrincipalKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PrincipalKind.Descriptor instead.
+func (PrincipalKind) EnumDescriptor() ([]byte, []int) {
+	return file_holos_console_v1_permissions_proto_rawDescGZIP(), []int{0}
+}
+
 // ResourceAttributes describe a single SubjectAccessReview check. The shape
 // mirrors authorization.k8s.io/v1 ResourceAttributes so the backend can pass
 // the message through to client-go without an extra type conversion.
@@ -296,11 +353,589 @@ func (x *ListResourcePermissionsResponse) GetPermissions() []*ResourcePermission
 	return nil
 }
 
+// GetAccessReviewRequest identifies the resource to review.
+type GetAccessReviewRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// resource_type is "secret", "project", "folder", or "organization".
+	ResourceType string `protobuf:"bytes,1,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	// name is the resource name.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// project is the project that owns the secret. Required when
+	// resource_type is "secret" and ignored otherwise.
+	Project       string `protobuf:"bytes,3,opt,name=project,proto3" json:"project,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccessReviewRequest) Reset() {
+	*x = GetAccessReviewRequest{}
+	mi := &file_holos_console_v1_permissions_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccessReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccessReviewRequest) ProtoMessage() {}
+
+func (x *GetAccessReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_permissions_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccessReviewRequest.ProtoReflect.Descriptor instead.
+func (*GetAccessReviewRequest) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_permissions_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetAccessReviewRequest) GetResourceType() string {
+	if x != nil {
+		return x.ResourceType
+	}
+	return ""
+}
+
+func (x *GetAccessReviewRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetAccessReviewRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+// AccessGrant is the effective role one principal holds on the resource.
+type AccessGrant struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// principal is the subject name without the "oidc:" prefix, e.g.
+	// "jane@example.com" or "platform-admins". ServiceAccounts are reported as
+	// "system:serviceaccount:<namespace>:<name>".
+	Principal string `protobuf:"bytes,1,opt,name=principal,proto3" json:"principal,omitempty"`
+	// kind is the kind of subject.
+	Kind PrincipalKind `protobuf:"varint,2,opt,name=kind,proto3,enum=holos.console.v1.PrincipalKind" json:"kind,omitempty"`
+	// role is the highest role granted: ROLE_OWNER when the principal may
+	// delete the resource, ROLE_EDITOR when it may update it, and ROLE_VIEWER
+	// when it may only read it.
+	Role Role `protobuf:"varint,3,opt,name=role,proto3,enum=holos.console.v1.Role" json:"role,omitempty"`
+	// sources lists every binding that grants access, as
+	// "RoleBinding/<namespace>/<name>" or "ClusterRoleBinding/<name>".
+	Sources       []string `protobuf:"bytes,4,rep,name=sources,proto3" json:"sources,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccessGrant) Reset() {
+	*x = AccessGrant{}
+	mi := &file_holos_console_v1_permissions_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccessGrant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessGrant) ProtoMessage() {}
+
+func (x *AccessGrant) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_permissions_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessGrant.ProtoReflect.Descriptor instead.
+func (*AccessGrant) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_permissions_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *AccessGrant) GetPrincipal() string {
+	if x != nil {
+		return x.Principal
+	}
+	return ""
+}
+
+func (x *AccessGrant) GetKind() PrincipalKind {
+	if x != nil {
+		return x.Kind
+	}
+	return PrincipalKind_PRINCIPAL_KIND_UNSPECIFIED
+}
+
+func (x *AccessGrant) GetRole() Role {
+	if x != nil {
+		return x.Role
+	}
+	return Role_ROLE_UNSPECIFIED
+}
+
+func (x *AccessGrant) GetSources() []string {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
+}
+
+// GetAccessReviewResponse lists every principal with access, ordered by role
+// (owners first) and then by principal.
+type GetAccessReviewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Grants        []*AccessGrant         `protobuf:"bytes,1,rep,name=grants,proto3" json:"grants,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccessReviewResponse) Reset() {
+	*x = GetAccessReviewResponse{}
+	mi := &file_holos_console_v1_permissions_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccessReviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccessReviewResponse) ProtoMessage() {}
+
+func (x *GetAccessReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_permissions_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccessReviewResponse.ProtoReflect.Descriptor instead.
+func (*GetAccessReviewResponse) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_permissions_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetAccessReviewResponse) GetGrants() []*AccessGrant {
+	if x != nil {
+		return x.Grants
+	}
+	return nil
+}
+
+// CanIRequest names a permission and the resource to check it against.
+type CanIRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// permission is the console permission to check. Supported permissions are
+	// the PERMISSION_SECRETS_*, PERMISSION_PROJECTS_*, PERMISSION_FOLDERS_*,
+	// and PERMISSION_ORGANIZATIONS_* families.
+	Permission Permission `protobuf:"varint,1,opt,name=permission,proto3,enum=holos.console.v1.Permission" json:"permission,omitempty"`
+	// resource_type is "secret", "project", "folder", or "organization" and
+	// must match the permission's family.
+	ResourceType string `protobuf:"bytes,2,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	// name is the resource name. Leave empty for LIST and CREATE permissions,
+	// which apply to the collection rather than a single resource.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// project is the project that owns the secret. Required when
+	// resource_type is "secret".
+	Project       string `protobuf:"bytes,4,opt,name=project,proto3" json:"project,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanIRequest) Reset() {
+	*x = CanIRequest{}
+	mi := &file_holos_console_v1_permissions_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanIRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanIRequest) ProtoMessage() {}
+
+func (x *CanIRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_permissions_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanIRequest.ProtoReflect.Descriptor instead.
+func (*CanIRequest) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_permissions_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CanIRequest) GetPermission() Permission {
+	if x != nil {
+		return x.Permission
+	}
+	return Permission_PERMISSION_UNSPECIFIED
+}
+
+func (x *CanIRequest) GetResourceType() string {
+	if x != nil {
+		return x.ResourceType
+	}
+	return ""
+}
+
+func (x *CanIRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CanIRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+// CanIResponse is the access decision.
+type CanIResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// allowed reports whether the caller holds the permission.
+	Allowed bool `protobuf:"varint,1,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	// reason carries the decision reason text from the API server when present.
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// attributes is the SubjectAccessReview the permission was translated to.
+	Attributes    *ResourceAttributes `protobuf:"bytes,3,opt,name=attributes,proto3" json:"attributes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanIResponse) Reset() {
+	*x = CanIResponse{}
+	mi := &file_holos_console_v1_permissions_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanIResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanIResponse) ProtoMessage() {}
+
+func (x *CanIResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_permissions_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanIResponse.ProtoReflect.Descriptor instead.
+func (*CanIResponse) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_permissions_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CanIResponse) GetAllowed() bool {
+	if x != nil {
+		return x.Allowed
+	}
+	return false
+}
+
+func (x *CanIResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CanIResponse) GetAttributes() *ResourceAttributes {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+// ListMyGroupsRequest has no fields; the caller's groups come from the
+// validated ID token.
+type ListMyGroupsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyGroupsRequest) Reset() {
+	*x = ListMyGroupsRequest{}
+	mi := &file_holos_console_v1_permissions_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyGroupsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyGroupsRequest) ProtoMessage() {}
+
+func (x *ListMyGroupsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_permissions_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyGroupsRequest.ProtoReflect.Descriptor instead.
+func (*ListMyGroupsRequest) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_permissions_proto_rawDescGZIP(), []int{9}
+}
+
+// GroupGrant is one binding that activates access for a group.
+type GroupGrant struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// source is the binding, as "RoleBinding/<namespace>/<name>" or
+	// "ClusterRoleBinding/<name>".
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	// role_ref is the bound role, as "Role/<name>" or "ClusterRole/<name>".
+	RoleRef string `protobuf:"bytes,2,opt,name=role_ref,json=roleRef,proto3" json:"role_ref,omitempty"`
+	// namespace is the RoleBinding namespace, empty for ClusterRoleBindings.
+	Namespace string `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// resource_type is "organization", "folder", or "project" when namespace
+	// backs a console resource, and empty otherwise.
+	ResourceType string `protobuf:"bytes,4,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	// resource_name is the console resource namespace backs, when known.
+	ResourceName string `protobuf:"bytes,5,opt,name=resource_name,json=resourceName,proto3" json:"resource_name,omitempty"`
+	// role is the console role the binding confers on namespace, or on every
+	// namespace for a ClusterRoleBinding: ROLE_OWNER for delete, ROLE_EDITOR
+	// for update, ROLE_VIEWER for get. ROLE_UNSPECIFIED means the binding
+	// grants narrower access, such as secrets only.
+	Role          Role `protobuf:"varint,6,opt,name=role,proto3,enum=holos.console.v1.Role" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupGrant) Reset() {
+	*x = GroupGrant{}
+	mi := &file_holos_console_v1_permissions_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupGrant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupGrant) ProtoMessage() {}
+
+func (x *GroupGrant) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_permissions_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupGrant.ProtoReflect.Descriptor instead.
+func (*GroupGrant) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_permissions_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GroupGrant) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *GroupGrant) GetRoleRef() string {
+	if x != nil {
+		return x.RoleRef
+	}
+	return ""
+}
+
+func (x *GroupGrant) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *GroupGrant) GetResourceType() string {
+	if x != nil {
+		return x.ResourceType
+	}
+	return ""
+}
+
+func (x *GroupGrant) GetResourceName() string {
+	if x != nil {
+		return x.ResourceName
+	}
+	return ""
+}
+
+func (x *GroupGrant) GetRole() Role {
+	if x != nil {
+		return x.Role
+	}
+	return Role_ROLE_UNSPECIFIED
+}
+
+// GroupMembership is one group from the caller's ID token.
+type GroupMembership struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// group is the group name exactly as the ID token carries it.
+	Group string `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	// principal is the name the group is impersonated as, e.g.
+	// "oidc:platform-admins". Empty for system: groups, which are never
+	// forwarded.
+	Principal string `protobuf:"bytes,2,opt,name=principal,proto3" json:"principal,omitempty"`
+	// grants lists every binding naming principal, cluster-wide bindings
+	// first.
+	Grants        []*GroupGrant `protobuf:"bytes,3,rep,name=grants,proto3" json:"grants,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupMembership) Reset() {
+	*x = GroupMembership{}
+	mi := &file_holos_console_v1_permissions_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupMembership) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupMembership) ProtoMessage() {}
+
+func (x *GroupMembership) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_permissions_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupMembership.ProtoReflect.Descriptor instead.
+func (*GroupMembership) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_permissions_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GroupMembership) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *GroupMembership) GetPrincipal() string {
+	if x != nil {
+		return x.Principal
+	}
+	return ""
+}
+
+func (x *GroupMembership) GetGrants() []*GroupGrant {
+	if x != nil {
+		return x.Grants
+	}
+	return nil
+}
+
+// ListMyGroupsResponse reports the caller's resolved groups.
+type ListMyGroupsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// groups is in ID token order.
+	Groups        []*GroupMembership `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyGroupsResponse) Reset() {
+	*x = ListMyGroupsResponse{}
+	mi := &file_holos_console_v1_permissions_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyGroupsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyGroupsResponse) ProtoMessage() {}
+
+func (x *ListMyGroupsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_permissions_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyGroupsResponse.ProtoReflect.Descriptor instead.
+func (*ListMyGroupsResponse) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_permissions_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListMyGroupsResponse) GetGroups() []*GroupMembership {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
 var File_holos_console_v1_permissions_proto protoreflect.FileDescriptor
 
 const file_holos_console_v1_permissions_proto_rawDesc = "" +
 	"\n" +
-	"\"holos/console/v1/permissions.proto\x12\x10holos.console.v1\"\xae\x01\n" +
+	"\"holos/console/v1/permissions.proto\x12\x10holos.console.v1\x1a\x1bholos/console/v1/rbac.proto\"\xae\x01\n" +
 	"\x12ResourceAttributes\x12\x12\n" +
 	"\x04verb\x18\x01 \x01(\tR\x04verb\x12\x14\n" +
 	"\x05group\x18\x02 \x01(\tR\x05group\x12\x1a\n" +
@@ -321,9 +956,56 @@ const file_holos_console_v1_permissions_proto_rawDesc = "" +
 	"\x06reason\x18\x04 \x01(\tR\x06reason\x12\x10\n" +
 	"\x03key\x18\x05 \x01(\tR\x03key\"i\n" +
 	"\x1fListResourcePermissionsResponse\x12F\n" +
-	"\vpermissions\x18\x01 \x03(\v2$.holos.console.v1.ResourcePermissionR\vpermissions2\x94\x01\n" +
+	"\vpermissions\x18\x01 \x03(\v2$.holos.console.v1.ResourcePermissionR\vpermissions\"k\n" +
+	"\x16GetAccessReviewRequest\x12#\n" +
+	"\rresource_type\x18\x01 \x01(\tR\fresourceType\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\aproject\x18\x03 \x01(\tR\aproject\"\xa6\x01\n" +
+	"\vAccessGrant\x12\x1c\n" +
+	"\tprincipal\x18\x01 \x01(\tR\tprincipal\x123\n" +
+	"\x04kind\x18\x02 \x01(\x0e2\x1f.holos.console.v1.PrincipalKindR\x04kind\x12*\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x16.holos.console.v1.RoleR\x04role\x12\x18\n" +
+	"\asources\x18\x04 \x03(\tR\asources\"P\n" +
+	"\x17GetAccessReviewResponse\x125\n" +
+	"\x06grants\x18\x01 \x03(\v2\x1d.holos.console.v1.AccessGrantR\x06grants\"\x9e\x01\n" +
+	"\vCanIRequest\x12<\n" +
+	"\n" +
+	"permission\x18\x01 \x01(\x0e2\x1c.holos.console.v1.PermissionR\n" +
+	"permission\x12#\n" +
+	"\rresource_type\x18\x02 \x01(\tR\fresourceType\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x18\n" +
+	"\aproject\x18\x04 \x01(\tR\aproject\"\x86\x01\n" +
+	"\fCanIResponse\x12\x18\n" +
+	"\aallowed\x18\x01 \x01(\bR\aallowed\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12D\n" +
+	"\n" +
+	"attributes\x18\x03 \x01(\v2$.holos.console.v1.ResourceAttributesR\n" +
+	"attributes\"\x15\n" +
+	"\x13ListMyGroupsRequest\"\xd3\x01\n" +
+	"\n" +
+	"GroupGrant\x12\x16\n" +
+	"\x06source\x18\x01 \x01(\tR\x06source\x12\x19\n" +
+	"\brole_ref\x18\x02 \x01(\tR\aroleRef\x12\x1c\n" +
+	"\tnamespace\x18\x03 \x01(\tR\tnamespace\x12#\n" +
+	"\rresource_type\x18\x04 \x01(\tR\fresourceType\x12#\n" +
+	"\rresource_name\x18\x05 \x01(\tR\fresourceName\x12*\n" +
+	"\x04role\x18\x06 \x01(\x0e2\x16.holos.console.v1.RoleR\x04role\"{\n" +
+	"\x0fGroupMembership\x12\x14\n" +
+	"\x05group\x18\x01 \x01(\tR\x05group\x12\x1c\n" +
+	"\tprincipal\x18\x02 \x01(\tR\tprincipal\x124\n" +
+	"\x06grants\x18\x03 \x03(\v2\x1c.holos.console.v1.GroupGrantR\x06grants\"Q\n" +
+	"\x14ListMyGroupsResponse\x129\n" +
+	"\x06groups\x18\x01 \x03(\v2!.holos.console.v1.GroupMembershipR\x06groups*\x86\x01\n" +
+	"\rPrincipalKind\x12\x1e\n" +
+	"\x1aPRINCIPAL_KIND_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13PRINCIPAL_KIND_USER\x10\x01\x12\x18\n" +
+	"\x14PRINCIPAL_KIND_GROUP\x10\x02\x12\"\n" +
+	"\x1ePRINCIPAL_KIND_SERVICE_ACCOUNT\x10\x032\xb1\x03\n" +
 	"\x12PermissionsService\x12~\n" +
-	"\x17ListResourcePermissions\x120.holos.console.v1.ListResourcePermissionsRequest\x1a1.holos.console.v1.ListResourcePermissionsResponseBCZAgithub.com/holos-run/holos-console/gen/holos/console/v1;consolev1b\x06proto3"
+	"\x17ListResourcePermissions\x120.holos.console.v1.ListResourcePermissionsRequest\x1a1.holos.console.v1.ListResourcePermissionsResponse\x12k\n" +
+	"\x0fGetAccessReview\x12(.holos.console.v1.GetAccessReviewRequest\x1a).holos.console.v1.GetAccessReviewResponse\"\x03\x90\x02\x01\x12J\n" +
+	"\x04CanI\x12\x1d.holos.console.v1.CanIRequest\x1a\x1e.holos.console.v1.CanIResponse\"\x03\x90\x02\x01\x12b\n" +
+	"\fListMyGroups\x12%.holos.console.v1.ListMyGroupsRequest\x1a&.holos.console.v1.ListMyGroupsResponse\"\x03\x90\x02\x01BCZAgithub.com/holos-run/holos-console/gen/holos/console/v1;consolev1b\x06proto3"
 
 var (
 	file_holos_console_v1_permissions_proto_rawDescOnce sync.Once
@@ -337,24 +1019,51 @@ func file_holos_console_v1_permissions_proto_rawDescGZIP() []byte {
 	return file_holos_console_v1_permissions_proto_rawDescData
 }
 
-var file_holos_console_v1_permissions_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_holos_console_v1_permissions_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_holos_console_v1_permissions_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_holos_console_v1_permissions_proto_goTypes = []any{
-	(*ResourceAttributes)(nil),              // 0: holos.console.v1.ResourceAttributes
-	(*ListResourcePermissionsRequest)(nil),  // 1: holos.console.v1.ListResourcePermissionsRequest
-	(*ResourcePermission)(nil),              // 2: holos.console.v1.ResourcePermission
-	(*ListResourcePermissionsResponse)(nil), // 3: holos.console.v1.ListResourcePermissionsResponse
+	(PrincipalKind)(0),                      // 0: holos.console.v1.PrincipalKind
+	(*ResourceAttributes)(nil),              // 1: holos.console.v1.ResourceAttributes
+	(*ListResourcePermissionsRequest)(nil),  // 2: holos.console.v1.ListResourcePermissionsRequest
+	(*ResourcePermission)(nil),              // 3: holos.console.v1.ResourcePermission
+	(*ListResourcePermissionsResponse)(nil), // 4: holos.console.v1.ListResourcePermissionsResponse
+	(*GetAccessReviewRequest)(nil),          // 5: holos.console.v1.GetAccessReviewRequest
+	(*AccessGrant)(nil),                     // 6: holos.console.v1.AccessGrant
+	(*GetAccessReviewResponse)(nil),         // 7: holos.console.v1.GetAccessReviewResponse
+	(*CanIRequest)(nil),                     // 8: holos.console.v1.CanIRequest
+	(*CanIResponse)(nil),                    // 9: holos.console.v1.CanIResponse
+	(*ListMyGroupsRequest)(nil),             // 10: holos.console.v1.ListMyGroupsRequest
+	(*GroupGrant)(nil),                      // 11: holos.console.v1.GroupGrant
+	(*GroupMembership)(nil),                 // 12: holos.console.v1.GroupMembership
+	(*ListMyGroupsResponse)(nil),            // 13: holos.console.v1.ListMyGroupsResponse
+	(Role)(0),                               // 14: holos.console.v1.Role
+	(Permission)(0),                         // 15: holos.console.v1.Permission
 }
 var file_holos_console_v1_permissions_proto_depIdxs = []int32{
-	0, // 0: holos.console.v1.ListResourcePermissionsRequest.attributes:type_name -> holos.console.v1.ResourceAttributes
-	0, // 1: holos.console.v1.ResourcePermission.attributes:type_name -> holos.console.v1.ResourceAttributes
-	2, // 2: holos.console.v1.ListResourcePermissionsResponse.permissions:type_name -> holos.console.v1.ResourcePermission
-	1, // 3: holos.console.v1.PermissionsService.ListResourcePermissions:input_type -> holos.console.v1.ListResourcePermissionsRequest
-	3, // 4: holos.console.v1.PermissionsService.ListResourcePermissions:output_type -> holos.console.v1.ListResourcePermissionsResponse
-	4, // [4:5] is the sub-list for method output_type
-	3, // [3:4] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	1,  // 0: holos.console.v1.ListResourcePermissionsRequest.attributes:type_name -> holos.console.v1.ResourceAttributes
+	1,  // 1: holos.console.v1.ResourcePermission.attributes:type_name -> holos.console.v1.ResourceAttributes
+	3,  // 2: holos.console.v1.ListResourcePermissionsResponse.permissions:type_name -> holos.console.v1.ResourcePermission
+	0,  // 3: holos.console.v1.AccessGrant.kind:type_name -> holos.console.v1.PrincipalKind
+	14, // 4: holos.console.v1.AccessGrant.role:type_name -> holos.console.v1.Role
+	6,  // 5: holos.console.v1.GetAccessReviewResponse.grants:type_name -> holos.console.v1.AccessGrant
+	15, // 6: holos.console.v1.CanIRequest.permission:type_name -> holos.console.v1.Permission
+	1,  // 7: holos.console.v1.CanIResponse.attributes:type_name -> holos.console.v1.ResourceAttributes
+	14, // 8: holos.console.v1.GroupGrant.role:type_name -> holos.console.v1.Role
+	11, // 9: holos.console.v1.GroupMembership.grants:type_name -> holos.console.v1.GroupGrant
+	12, // 10: holos.console.v1.ListMyGroupsResponse.groups:type_name -> holos.console.v1.GroupMembership
+	2,  // 11: holos.console.v1.PermissionsService.ListResourcePermissions:input_type -> holos.console.v1.ListResourcePermissionsRequest
+	5,  // 12: holos.console.v1.PermissionsService.GetAccessReview:input_type -> holos.console.v1.GetAccessReviewRequest
+	8,  // 13: holos.console.v1.PermissionsService.CanI:input_type -> holos.console.v1.CanIRequest
+	10, // 14: holos.console.v1.PermissionsService.ListMyGroups:input_type -> holos.console.v1.ListMyGroupsRequest
+	4,  // 15: holos.console.v1.PermissionsService.ListResourcePermissions:output_type -> holos.console.v1.ListResourcePermissionsResponse
+	7,  // 16: holos.console.v1.PermissionsService.GetAccessReview:output_type -> holos.console.v1.GetAccessReviewResponse
+	9,  // 17: holos.console.v1.PermissionsService.CanI:output_type -> holos.console.v1.CanIResponse
+	13, // 18: holos.console.v1.PermissionsService.ListMyGroups:output_type -> holos.console.v1.ListMyGroupsResponse
+	15, // [15:19] is the sub-list for method output_type
+	11, // [11:15] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_holos_console_v1_permissions_proto_init() }
@@ -362,18 +1071,20 @@ func file_holos_console_v1_permissions_proto_init() {
 	if File_holos_console_v1_permissions_proto != nil {
 		return
 	}
+	file_holos_console_v1_rbac_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_holos_console_v1_permissions_proto_rawDesc), len(file_holos_console_v1_permissions_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   4,
+			NumEnums:      1,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_holos_console_v1_permissions_proto_goTypes,
 		DependencyIndexes: file_holos_console_v1_permissions_proto_depIdxs,
+		EnumInfos:         file_holos_console_v1_permissions_proto_enumTypes,
 		MessageInfos:      file_holos_console_v1_permissions_proto_msgTypes,
 	}.Build()
 	File_holos_console_v1_permissions_proto = out.File
@@ -367,11 +367,11 @@ const file_holos_console_v1_project_settings_proto_rawDesc = "" +
 	"\x1cGetProjectSettingsRawRequest\x12\x18\n" +
 	"\aproject\x18\x01 \x01(\tR\aproject\"1\n" +
 	"\x1dGetProjectSettingsRawResponse\x12\x10\n" +
-	"\x03raw\x18\x01 \x01(\tR\x03raw2\xfd\x02\n" +
-	"\x16ProjectSettingsService\x12o\n" +
-	"\x12GetProjectSettings\x12+.holos.console.v1.GetProjectSettingsRequest\x1a,.holos.console.v1.GetProjectSettingsResponse\x12x\n" +
-	"\x15UpdateProjectSettings\x12..holos.console.v1.UpdateProjectSettingsRequest\x1a/.holos.console.v1.UpdateProjectSettingsResponse\x12x\n" +
-	"\x15GetProjectSettingsRaw\x12..holos.console.v1.GetProjectSettingsRawRequest\x1a/.holos.console.v1.GetProjectSettingsRawResponseBCZAgithub.com/holos-run/holos-console/gen/holos/console/v1;consolev1b\x06proto3"
+	"\x03raw\x18\x01 \x01(\tR\x03raw2\x87\x03\n" +
+	"\x16ProjectSettingsService\x12t\n" +
+	"\x12GetProjectSettings\x12+.holos.console.v1.GetProjectSettingsRequest\x1a,.holos.console.v1.GetProjectSettingsResponse\"\x03\x90\x02\x01\x12x\n" +
+	"\x15UpdateProjectSettings\x12..holos.console.v1.UpdateProjectSettingsRequest\x1a/.holos.console.v1.UpdateProjectSettingsResponse\x12}\n" +
+	"\x15GetProjectSettingsRaw\x12..holos.console.v1.GetProjectSettingsRawRequest\x1a/.holos.console.v1.GetProjectSettingsRawResponse\"\x03\x90\x02\x01BCZAgithub.com/holos-run/holos-console/gen/holos/console/v1;consolev1b\x06proto3"
 
 var (
 	file_holos_console_v1_project_settings_proto_rawDescOnce sync.Once
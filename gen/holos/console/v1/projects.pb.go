@@ -7,6 +7,7 @@
 package consolev1
 
 import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -51,9 +52,16 @@ type Project struct {
 	ParentType ParentType `protobuf:"varint,12,opt,name=parent_type,json=parentType,proto3,enum=holos.console.v1.ParentType" json:"parent_type,omitempty"`
 	// parent_name is the name of the immediate parent scope (v1alpha2).
 	// For projects this is the organization name.
-	ParentName    string `protobuf:"bytes,13,opt,name=parent_name,json=parentName,proto3" json:"parent_name,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	ParentName string `protobuf:"bytes,13,opt,name=parent_name,json=parentName,proto3" json:"parent_name,omitempty"`
+	// updated_at is the RFC3339-formatted timestamp of the most recent write to
+	// this project's namespace, sourced from metadata.managedFields.
+	UpdatedAt string `protobuf:"bytes,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// user_role_source explains where user_role comes from: a grant on this
+	// project, a grant on its organization, or the platform. Unset when
+	// user_role is ROLE_UNSPECIFIED.
+	UserRoleSource *RoleSource `protobuf:"bytes,15,opt,name=user_role_source,json=userRoleSource,proto3" json:"user_role_source,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *Project) Reset() {
@@ -177,6 +185,20 @@ func (x *Project) GetParentName() string {
 	return ""
 }
 
+func (x *Project) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *Project) GetUserRoleSource() *RoleSource {
+	if x != nil {
+		return x.UserRoleSource
+	}
+	return nil
+}
+
 // ListProjectsRequest contains optional filters for listing projects.
 type ListProjectsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -185,8 +207,17 @@ type ListProjectsRequest struct {
 	// parent_type and parent_name together filter to immediate children of a
 	// specific parent scope. When both are empty, returns all accessible projects
 	// in the organization.
-	ParentType    ParentType `protobuf:"varint,2,opt,name=parent_type,json=parentType,proto3,enum=holos.console.v1.ParentType" json:"parent_type,omitempty"`
-	ParentName    string     `protobuf:"bytes,3,opt,name=parent_name,json=parentName,proto3" json:"parent_name,omitempty"`
+	ParentType ParentType `protobuf:"varint,2,opt,name=parent_type,json=parentType,proto3,enum=holos.console.v1.ParentType" json:"parent_type,omitempty"`
+	ParentName string     `protobuf:"bytes,3,opt,name=parent_name,json=parentName,proto3" json:"parent_name,omitempty"`
+	// filter narrows the returned projects. Labels are the project
+	// namespace's labels.
+	Filter *ListFilter `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	// order_by sorts the returned projects.
+	OrderBy *ListOrder `protobuf:"bytes,5,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	// if_none_match is the etag of a previous response. When the listing is
+	// unchanged the response carries only etag and not_modified. The
+	// If-None-Match header is ignored, since browsers send it on their own.
+	IfNoneMatch   string `protobuf:"bytes,6,opt,name=if_none_match,json=ifNoneMatch,proto3" json:"if_none_match,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -242,11 +273,38 @@ func (x *ListProjectsRequest) GetParentName() string {
 	return ""
 }
 
+func (x *ListProjectsRequest) GetFilter() *ListFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListProjectsRequest) GetOrderBy() *ListOrder {
+	if x != nil {
+		return x.OrderBy
+	}
+	return nil
+}
+
+func (x *ListProjectsRequest) GetIfNoneMatch() string {
+	if x != nil {
+		return x.IfNoneMatch
+	}
+	return ""
+}
+
 // ListProjectsResponse contains the list of projects the user can access.
 type ListProjectsResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// projects contains the list of projects.
-	Projects      []*Project `protobuf:"bytes,1,rep,name=projects,proto3" json:"projects,omitempty"`
+	Projects []*Project `protobuf:"bytes,1,rep,name=projects,proto3" json:"projects,omitempty"`
+	// etag identifies this listing for if_none_match. It is derived from the
+	// caller, the request, and the projects' resource versions, and changes at
+	// least once a minute so access granted elsewhere is picked up.
+	Etag string `protobuf:"bytes,2,opt,name=etag,proto3" json:"etag,omitempty"`
+	// not_modified is true when if_none_match matched and projects is omitted.
+	NotModified   bool `protobuf:"varint,3,opt,name=not_modified,json=notModified,proto3" json:"not_modified,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -288,11 +346,29 @@ func (x *ListProjectsResponse) GetProjects() []*Project {
 	return nil
 }
 
+func (x *ListProjectsResponse) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+func (x *ListProjectsResponse) GetNotModified() bool {
+	if x != nil {
+		return x.NotModified
+	}
+	return false
+}
+
 // GetProjectRequest contains the name of the project to retrieve.
 type GetProjectRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// name is the name of the project to retrieve.
-	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// if_none_match is the etag of a previous response. When the project is
+	// unchanged the response carries only etag and not_modified. The
+	// If-None-Match header is ignored, since browsers send it on their own.
+	IfNoneMatch   string `protobuf:"bytes,2,opt,name=if_none_match,json=ifNoneMatch,proto3" json:"if_none_match,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -334,11 +410,23 @@ func (x *GetProjectRequest) GetName() string {
 	return ""
 }
 
+func (x *GetProjectRequest) GetIfNoneMatch() string {
+	if x != nil {
+		return x.IfNoneMatch
+	}
+	return ""
+}
+
 // GetProjectResponse contains the project.
 type GetProjectResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// project is the retrieved project.
-	Project       *Project `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	Project *Project `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	// etag identifies this version of the response for if_none_match. It is
+	// derived like ListProjectsResponse.etag.
+	Etag string `protobuf:"bytes,2,opt,name=etag,proto3" json:"etag,omitempty"`
+	// not_modified is true when if_none_match matched and project is omitted.
+	NotModified   bool `protobuf:"varint,3,opt,name=not_modified,json=notModified,proto3" json:"not_modified,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -380,6 +468,20 @@ func (x *GetProjectResponse) GetProject() *Project {
 	return nil
 }
 
+func (x *GetProjectResponse) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+func (x *GetProjectResponse) GetNotModified() bool {
+	if x != nil {
+		return x.NotModified
+	}
+	return false
+}
+
 // CreateProjectRequest contains the fields for creating a new project.
 type CreateProjectRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -400,7 +502,10 @@ type CreateProjectRequest struct {
 	ParentType ParentType `protobuf:"varint,7,opt,name=parent_type,json=parentType,proto3,enum=holos.console.v1.ParentType" json:"parent_type,omitempty"`
 	// parent_name is retained only for legacy clients. When set, it must match
 	// organization.
-	ParentName    string `protobuf:"bytes,8,opt,name=parent_name,json=parentName,proto3" json:"parent_name,omitempty"`
+	ParentName string `protobuf:"bytes,8,opt,name=parent_name,json=parentName,proto3" json:"parent_name,omitempty"`
+	// dry_run runs validation and authorization, and submits every Kubernetes
+	// write with server-side dry-run, without persisting any change.
+	DryRun        bool `protobuf:"varint,9,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -491,6 +596,13 @@ func (x *CreateProjectRequest) GetParentName() string {
 	return ""
 }
 
+func (x *CreateProjectRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
 // CreateProjectResponse contains the name of the created project.
 type CreateProjectResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -552,7 +664,10 @@ type UpdateProjectRequest struct {
 	ParentType *ParentType `protobuf:"varint,4,opt,name=parent_type,json=parentType,proto3,enum=holos.console.v1.ParentType,oneof" json:"parent_type,omitempty"`
 	// parent_name is the new parent name for reparenting. When unset, no reparenting occurs.
 	// Must be set together with parent_type and must name the organization.
-	ParentName    *string `protobuf:"bytes,5,opt,name=parent_name,json=parentName,proto3,oneof" json:"parent_name,omitempty"`
+	ParentName *string `protobuf:"bytes,5,opt,name=parent_name,json=parentName,proto3,oneof" json:"parent_name,omitempty"`
+	// dry_run runs validation and authorization, and submits every Kubernetes
+	// write with server-side dry-run, without persisting any change.
+	DryRun        bool `protobuf:"varint,6,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -622,6 +737,13 @@ func (x *UpdateProjectRequest) GetParentName() string {
 	return ""
 }
 
+func (x *UpdateProjectRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
 // UpdateProjectResponse is empty on success.
 type UpdateProjectResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -659,18 +781,152 @@ func (*UpdateProjectResponse) Descriptor() ([]byte, []int) {
 	return file_holos_console_v1_projects_proto_rawDescGZIP(), []int{8}
 }
 
+// DiffProjectRequest carries the fields of an UpdateProjectRequest to
+// compare with the project. Unset fields are unchanged, as in UpdateProject.
+type DiffProjectRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name is the name of the project to compare.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// display_name is the proposed display name.
+	DisplayName *string `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3,oneof" json:"display_name,omitempty"`
+	// description is the proposed description.
+	Description *string `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	// parent_type is the proposed parent type. Must be set together with
+	// parent_name.
+	ParentType *ParentType `protobuf:"varint,4,opt,name=parent_type,json=parentType,proto3,enum=holos.console.v1.ParentType,oneof" json:"parent_type,omitempty"`
+	// parent_name is the proposed parent name.
+	ParentName    *string `protobuf:"bytes,5,opt,name=parent_name,json=parentName,proto3,oneof" json:"parent_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffProjectRequest) Reset() {
+	*x = DiffProjectRequest{}
+	mi := &file_holos_console_v1_projects_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffProjectRequest) ProtoMessage() {}
+
+func (x *DiffProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_projects_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffProjectRequest.ProtoReflect.Descriptor instead.
+func (*DiffProjectRequest) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_projects_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DiffProjectRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DiffProjectRequest) GetDisplayName() string {
+	if x != nil && x.DisplayName != nil {
+		return *x.DisplayName
+	}
+	return ""
+}
+
+func (x *DiffProjectRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *DiffProjectRequest) GetParentType() ParentType {
+	if x != nil && x.ParentType != nil {
+		return *x.ParentType
+	}
+	return ParentType_PARENT_TYPE_UNSPECIFIED
+}
+
+func (x *DiffProjectRequest) GetParentName() string {
+	if x != nil && x.ParentName != nil {
+		return *x.ParentName
+	}
+	return ""
+}
+
+// DiffProjectResponse lists the changes a proposed update would make.
+type DiffProjectResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// changes lists the changed fields in request field order. It is empty
+	// when the update would not change the project.
+	Changes       []*FieldChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffProjectResponse) Reset() {
+	*x = DiffProjectResponse{}
+	mi := &file_holos_console_v1_projects_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffProjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffProjectResponse) ProtoMessage() {}
+
+func (x *DiffProjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_projects_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffProjectResponse.ProtoReflect.Descriptor instead.
+func (*DiffProjectResponse) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_projects_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DiffProjectResponse) GetChanges() []*FieldChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
 // DeleteProjectRequest contains the name of the project to delete.
 type DeleteProjectRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// name is the name of the project to delete.
-	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// dry_run runs validation and authorization, and submits every Kubernetes
+	// write with server-side dry-run, without persisting any change.
+	DryRun        bool `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DeleteProjectRequest) Reset() {
 	*x = DeleteProjectRequest{}
-	mi := &file_holos_console_v1_projects_proto_msgTypes[9]
+	mi := &file_holos_console_v1_projects_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -682,7 +938,7 @@ func (x *DeleteProjectRequest) String() string {
 func (*DeleteProjectRequest) ProtoMessage() {}
 
 func (x *DeleteProjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_holos_console_v1_projects_proto_msgTypes[9]
+	mi := &file_holos_console_v1_projects_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -695,7 +951,7 @@ func (x *DeleteProjectRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteProjectRequest.ProtoReflect.Descriptor instead.
 func (*DeleteProjectRequest) Descriptor() ([]byte, []int) {
-	return file_holos_console_v1_projects_proto_rawDescGZIP(), []int{9}
+	return file_holos_console_v1_projects_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *DeleteProjectRequest) GetName() string {
@@ -705,6 +961,13 @@ func (x *DeleteProjectRequest) GetName() string {
 	return ""
 }
 
+func (x *DeleteProjectRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
 // DeleteProjectResponse is empty on success.
 type DeleteProjectResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -714,7 +977,7 @@ type DeleteProjectResponse struct {
 
 func (x *DeleteProjectResponse) Reset() {
 	*x = DeleteProjectResponse{}
-	mi := &file_holos_console_v1_projects_proto_msgTypes[10]
+	mi := &file_holos_console_v1_projects_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -726,7 +989,7 @@ func (x *DeleteProjectResponse) String() string {
 func (*DeleteProjectResponse) ProtoMessage() {}
 
 func (x *DeleteProjectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_holos_console_v1_projects_proto_msgTypes[10]
+	mi := &file_holos_console_v1_projects_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -739,7 +1002,7 @@ func (x *DeleteProjectResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteProjectResponse.ProtoReflect.Descriptor instead.
 func (*DeleteProjectResponse) Descriptor() ([]byte, []int) {
-	return file_holos_console_v1_projects_proto_rawDescGZIP(), []int{10}
+	return file_holos_console_v1_projects_proto_rawDescGZIP(), []int{12}
 }
 
 // UpdateProjectSharingRequest contains the sharing grants to set on a project.
@@ -750,14 +1013,20 @@ type UpdateProjectSharingRequest struct {
 	// user_grants are the per-user sharing grants to set.
 	UserGrants []*ShareGrant `protobuf:"bytes,2,rep,name=user_grants,json=userGrants,proto3" json:"user_grants,omitempty"`
 	// role_grants are the per-role sharing grants to set.
-	RoleGrants    []*ShareGrant `protobuf:"bytes,3,rep,name=role_grants,json=roleGrants,proto3" json:"role_grants,omitempty"`
+	RoleGrants []*ShareGrant `protobuf:"bytes,3,rep,name=role_grants,json=roleGrants,proto3" json:"role_grants,omitempty"`
+	// dry_run runs validation and authorization, and submits every Kubernetes
+	// write with server-side dry-run, without persisting any change.
+	DryRun bool `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// force accepts grants that leave the project without an active owner.
+	// Only platform owners may set it.
+	Force         bool `protobuf:"varint,5,opt,name=force,proto3" json:"force,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateProjectSharingRequest) Reset() {
 	*x = UpdateProjectSharingRequest{}
-	mi := &file_holos_console_v1_projects_proto_msgTypes[11]
+	mi := &file_holos_console_v1_projects_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -769,7 +1038,7 @@ func (x *UpdateProjectSharingRequest) String() string {
 func (*UpdateProjectSharingRequest) ProtoMessage() {}
 
 func (x *UpdateProjectSharingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_holos_console_v1_projects_proto_msgTypes[11]
+	mi := &file_holos_console_v1_projects_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -782,7 +1051,7 @@ func (x *UpdateProjectSharingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateProjectSharingRequest.ProtoReflect.Descriptor instead.
 func (*UpdateProjectSharingRequest) Descriptor() ([]byte, []int) {
-	return file_holos_console_v1_projects_proto_rawDescGZIP(), []int{11}
+	return file_holos_console_v1_projects_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *UpdateProjectSharingRequest) GetName() string {
@@ -806,6 +1075,20 @@ func (x *UpdateProjectSharingRequest) GetRoleGrants() []*ShareGrant {
 	return nil
 }
 
+func (x *UpdateProjectSharingRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *UpdateProjectSharingRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
 // UpdateProjectSharingResponse contains the updated project.
 type UpdateProjectSharingResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -817,7 +1100,7 @@ type UpdateProjectSharingResponse struct {
 
 func (x *UpdateProjectSharingResponse) Reset() {
 	*x = UpdateProjectSharingResponse{}
-	mi := &file_holos_console_v1_projects_proto_msgTypes[12]
+	mi := &file_holos_console_v1_projects_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -829,7 +1112,7 @@ func (x *UpdateProjectSharingResponse) String() string {
 func (*UpdateProjectSharingResponse) ProtoMessage() {}
 
 func (x *UpdateProjectSharingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_holos_console_v1_projects_proto_msgTypes[12]
+	mi := &file_holos_console_v1_projects_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -842,7 +1125,7 @@ func (x *UpdateProjectSharingResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateProjectSharingResponse.ProtoReflect.Descriptor instead.
 func (*UpdateProjectSharingResponse) Descriptor() ([]byte, []int) {
-	return file_holos_console_v1_projects_proto_rawDescGZIP(), []int{12}
+	return file_holos_console_v1_projects_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *UpdateProjectSharingResponse) GetProject() *Project {
@@ -852,18 +1135,25 @@ func (x *UpdateProjectSharingResponse) GetProject() *Project {
 	return nil
 }
 
-// GetProjectRawRequest contains the name of the project to retrieve as raw JSON.
+// GetProjectRawRequest contains the name of the project to retrieve as a raw manifest.
 type GetProjectRawRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// name is the name of the project to retrieve.
-	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// format selects JSON (the default) or YAML output.
+	Format RawFormat `protobuf:"varint,2,opt,name=format,proto3,enum=holos.console.v1.RawFormat" json:"format,omitempty"`
+	// strip_managed_fields omits the server-populated fields (uid,
+	// resourceVersion, generation, creationTimestamp, managedFields,
+	// ownerReferences, and status) so the manifest can be applied to another
+	// cluster with kubectl apply.
+	StripManagedFields bool `protobuf:"varint,3,opt,name=strip_managed_fields,json=stripManagedFields,proto3" json:"strip_managed_fields,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *GetProjectRawRequest) Reset() {
 	*x = GetProjectRawRequest{}
-	mi := &file_holos_console_v1_projects_proto_msgTypes[13]
+	mi := &file_holos_console_v1_projects_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -875,7 +1165,7 @@ func (x *GetProjectRawRequest) String() string {
 func (*GetProjectRawRequest) ProtoMessage() {}
 
 func (x *GetProjectRawRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_holos_console_v1_projects_proto_msgTypes[13]
+	mi := &file_holos_console_v1_projects_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -888,7 +1178,7 @@ func (x *GetProjectRawRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProjectRawRequest.ProtoReflect.Descriptor instead.
 func (*GetProjectRawRequest) Descriptor() ([]byte, []int) {
-	return file_holos_console_v1_projects_proto_rawDescGZIP(), []int{13}
+	return file_holos_console_v1_projects_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *GetProjectRawRequest) GetName() string {
@@ -898,10 +1188,25 @@ func (x *GetProjectRawRequest) GetName() string {
 	return ""
 }
 
-// GetProjectRawResponse contains the full Kubernetes Namespace object as JSON.
+func (x *GetProjectRawRequest) GetFormat() RawFormat {
+	if x != nil {
+		return x.Format
+	}
+	return RawFormat_RAW_FORMAT_UNSPECIFIED
+}
+
+func (x *GetProjectRawRequest) GetStripManagedFields() bool {
+	if x != nil {
+		return x.StripManagedFields
+	}
+	return false
+}
+
+// GetProjectRawResponse contains the full Kubernetes Namespace object as JSON or YAML.
 type GetProjectRawResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// raw is the verbatim JSON-serialized Namespace object from the K8s API.
+	// raw is the Namespace object from the K8s API, serialized in the
+	// requested format.
 	Raw           string `protobuf:"bytes,1,opt,name=raw,proto3" json:"raw,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -909,7 +1214,7 @@ type GetProjectRawResponse struct {
 
 func (x *GetProjectRawResponse) Reset() {
 	*x = GetProjectRawResponse{}
-	mi := &file_holos_console_v1_projects_proto_msgTypes[14]
+	mi := &file_holos_console_v1_projects_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -921,7 +1226,7 @@ func (x *GetProjectRawResponse) String() string {
 func (*GetProjectRawResponse) ProtoMessage() {}
 
 func (x *GetProjectRawResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_holos_console_v1_projects_proto_msgTypes[14]
+	mi := &file_holos_console_v1_projects_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -934,7 +1239,7 @@ func (x *GetProjectRawResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProjectRawResponse.ProtoReflect.Descriptor instead.
 func (*GetProjectRawResponse) Descriptor() ([]byte, []int) {
-	return file_holos_console_v1_projects_proto_rawDescGZIP(), []int{14}
+	return file_holos_console_v1_projects_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *GetProjectRawResponse) GetRaw() string {
@@ -959,7 +1264,7 @@ type UpdateProjectDefaultSharingRequest struct {
 
 func (x *UpdateProjectDefaultSharingRequest) Reset() {
 	*x = UpdateProjectDefaultSharingRequest{}
-	mi := &file_holos_console_v1_projects_proto_msgTypes[15]
+	mi := &file_holos_console_v1_projects_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -971,7 +1276,7 @@ func (x *UpdateProjectDefaultSharingRequest) String() string {
 func (*UpdateProjectDefaultSharingRequest) ProtoMessage() {}
 
 func (x *UpdateProjectDefaultSharingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_holos_console_v1_projects_proto_msgTypes[15]
+	mi := &file_holos_console_v1_projects_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -984,7 +1289,7 @@ func (x *UpdateProjectDefaultSharingRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use UpdateProjectDefaultSharingRequest.ProtoReflect.Descriptor instead.
 func (*UpdateProjectDefaultSharingRequest) Descriptor() ([]byte, []int) {
-	return file_holos_console_v1_projects_proto_rawDescGZIP(), []int{15}
+	return file_holos_console_v1_projects_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *UpdateProjectDefaultSharingRequest) GetName() string {
@@ -1019,7 +1324,7 @@ type UpdateProjectDefaultSharingResponse struct {
 
 func (x *UpdateProjectDefaultSharingResponse) Reset() {
 	*x = UpdateProjectDefaultSharingResponse{}
-	mi := &file_holos_console_v1_projects_proto_msgTypes[16]
+	mi := &file_holos_console_v1_projects_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1031,7 +1336,7 @@ func (x *UpdateProjectDefaultSharingResponse) String() string {
 func (*UpdateProjectDefaultSharingResponse) ProtoMessage() {}
 
 func (x *UpdateProjectDefaultSharingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_holos_console_v1_projects_proto_msgTypes[16]
+	mi := &file_holos_console_v1_projects_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1044,7 +1349,7 @@ func (x *UpdateProjectDefaultSharingResponse) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use UpdateProjectDefaultSharingResponse.ProtoReflect.Descriptor instead.
 func (*UpdateProjectDefaultSharingResponse) Descriptor() ([]byte, []int) {
-	return file_holos_console_v1_projects_proto_rawDescGZIP(), []int{16}
+	return file_holos_console_v1_projects_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *UpdateProjectDefaultSharingResponse) GetProject() *Project {
@@ -1065,7 +1370,7 @@ type CheckProjectIdentifierRequest struct {
 
 func (x *CheckProjectIdentifierRequest) Reset() {
 	*x = CheckProjectIdentifierRequest{}
-	mi := &file_holos_console_v1_projects_proto_msgTypes[17]
+	mi := &file_holos_console_v1_projects_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1077,7 +1382,7 @@ func (x *CheckProjectIdentifierRequest) String() string {
 func (*CheckProjectIdentifierRequest) ProtoMessage() {}
 
 func (x *CheckProjectIdentifierRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_holos_console_v1_projects_proto_msgTypes[17]
+	mi := &file_holos_console_v1_projects_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1090,7 +1395,7 @@ func (x *CheckProjectIdentifierRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckProjectIdentifierRequest.ProtoReflect.Descriptor instead.
 func (*CheckProjectIdentifierRequest) Descriptor() ([]byte, []int) {
-	return file_holos_console_v1_projects_proto_rawDescGZIP(), []int{17}
+	return file_holos_console_v1_projects_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *CheckProjectIdentifierRequest) GetIdentifier() string {
@@ -1114,7 +1419,7 @@ type CheckProjectIdentifierResponse struct {
 
 func (x *CheckProjectIdentifierResponse) Reset() {
 	*x = CheckProjectIdentifierResponse{}
-	mi := &file_holos_console_v1_projects_proto_msgTypes[18]
+	mi := &file_holos_console_v1_projects_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1126,7 +1431,7 @@ func (x *CheckProjectIdentifierResponse) String() string {
 func (*CheckProjectIdentifierResponse) ProtoMessage() {}
 
 func (x *CheckProjectIdentifierResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_holos_console_v1_projects_proto_msgTypes[18]
+	mi := &file_holos_console_v1_projects_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1139,7 +1444,7 @@ func (x *CheckProjectIdentifierResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckProjectIdentifierResponse.ProtoReflect.Descriptor instead.
 func (*CheckProjectIdentifierResponse) Descriptor() ([]byte, []int) {
-	return file_holos_console_v1_projects_proto_rawDescGZIP(), []int{18}
+	return file_holos_console_v1_projects_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *CheckProjectIdentifierResponse) GetAvailable() bool {
@@ -1156,14 +1461,862 @@ func (x *CheckProjectIdentifierResponse) GetSuggestedIdentifier() string {
 	return ""
 }
 
-var File_holos_console_v1_projects_proto protoreflect.FileDescriptor
+// ListProjectResourcesRequest names the project whose workloads to list.
+type ListProjectResourcesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// project is the name of the project to list workloads in.
+	Project       string `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_holos_console_v1_projects_proto_rawDesc = "" +
-	"\n" +
-	"\x1fholos/console/v1/projects.proto\x12\x10holos.console.v1\x1a\x1eholos/console/v1/folders.proto\x1a\x1bholos/console/v1/rbac.proto\x1a\x1eholos/console/v1/secrets.proto\"\xf9\x04\n" +
-	"\aProject\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12!\n" +
-	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12 \n" +
+func (x *ListProjectResourcesRequest) Reset() {
+	*x = ListProjectResourcesRequest{}
+	mi := &file_holos_console_v1_projects_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProjectResourcesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProjectResourcesRequest) ProtoMessage() {}
+
+func (x *ListProjectResourcesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_projects_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProjectResourcesRequest.ProtoReflect.Descriptor instead.
+func (*ListProjectResourcesRequest) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_projects_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListProjectResourcesRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+// ProjectResource summarizes one workload object in a project namespace.
+type ProjectResource struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// kind is the Kubernetes kind: Deployment, StatefulSet, Service, or Ingress.
+	Kind string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	// name is the object name.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// ready is true when the object has reached its desired state: all
+	// replicas ready for Deployments and StatefulSets, an address assigned for
+	// LoadBalancer Services and Ingresses. Other Services are always ready.
+	Ready bool `protobuf:"varint,3,opt,name=ready,proto3" json:"ready,omitempty"`
+	// status is a short human-readable status, e.g. "2/3 ready" or
+	// "ClusterIP 10.96.0.10".
+	Status string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	// created_at is the RFC3339-formatted creation timestamp.
+	CreatedAt     string `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProjectResource) Reset() {
+	*x = ProjectResource{}
+	mi := &file_holos_console_v1_projects_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProjectResource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectResource) ProtoMessage() {}
+
+func (x *ProjectResource) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_projects_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectResource.ProtoReflect.Descriptor instead.
+func (*ProjectResource) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_projects_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ProjectResource) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ProjectResource) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProjectResource) GetReady() bool {
+	if x != nil {
+		return x.Ready
+	}
+	return false
+}
+
+func (x *ProjectResource) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ProjectResource) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// ListProjectResourcesResponse lists the project's workloads ordered by kind,
+// then name.
+type ListProjectResourcesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// resources are the workloads in the project namespace.
+	Resources     []*ProjectResource `protobuf:"bytes,1,rep,name=resources,proto3" json:"resources,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProjectResourcesResponse) Reset() {
+	*x = ListProjectResourcesResponse{}
+	mi := &file_holos_console_v1_projects_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProjectResourcesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProjectResourcesResponse) ProtoMessage() {}
+
+func (x *ListProjectResourcesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_projects_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProjectResourcesResponse.ProtoReflect.Descriptor instead.
+func (*ListProjectResourcesResponse) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_projects_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListProjectResourcesResponse) GetResources() []*ProjectResource {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+// ListProjectEventsRequest selects and pages through a project's events.
+// Unset filters match every event.
+type ListProjectEventsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// project is the name of the project to list events in.
+	Project string `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	// types restricts results to these event types, e.g. "Warning".
+	Types []string `protobuf:"bytes,2,rep,name=types,proto3" json:"types,omitempty"`
+	// involved_kind restricts results to events about objects of this kind,
+	// e.g. "Deployment" or "Pod".
+	InvolvedKind string `protobuf:"bytes,3,opt,name=involved_kind,json=involvedKind,proto3" json:"involved_kind,omitempty"`
+	// involved_name restricts results to events about the object with this
+	// name.
+	InvolvedName string `protobuf:"bytes,4,opt,name=involved_name,json=involvedName,proto3" json:"involved_name,omitempty"`
+	// page_size caps the number of events returned. Zero selects the server
+	// default of 50.
+	PageSize int32 `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// page_token is the next_page_token of a previous response.
+	PageToken     string `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProjectEventsRequest) Reset() {
+	*x = ListProjectEventsRequest{}
+	mi := &file_holos_console_v1_projects_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProjectEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProjectEventsRequest) ProtoMessage() {}
+
+func (x *ListProjectEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_projects_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProjectEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListProjectEventsRequest) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_projects_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListProjectEventsRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *ListProjectEventsRequest) GetTypes() []string {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *ListProjectEventsRequest) GetInvolvedKind() string {
+	if x != nil {
+		return x.InvolvedKind
+	}
+	return ""
+}
+
+func (x *ListProjectEventsRequest) GetInvolvedName() string {
+	if x != nil {
+		return x.InvolvedName
+	}
+	return ""
+}
+
+func (x *ListProjectEventsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListProjectEventsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// ProjectEvent is a Kubernetes Event recorded in a project namespace.
+type ProjectEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// type is the event type, "Normal" or "Warning".
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// reason is the short machine-readable reason, e.g. "BackOff".
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// message is the human-readable description.
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	// involved_kind is the kind of the object the event is about.
+	InvolvedKind string `protobuf:"bytes,4,opt,name=involved_kind,json=involvedKind,proto3" json:"involved_kind,omitempty"`
+	// involved_name is the name of the object the event is about.
+	InvolvedName string `protobuf:"bytes,5,opt,name=involved_name,json=involvedName,proto3" json:"involved_name,omitempty"`
+	// count is how many times the event has occurred.
+	Count int32 `protobuf:"varint,6,opt,name=count,proto3" json:"count,omitempty"`
+	// source is the component that reported the event.
+	Source string `protobuf:"bytes,7,opt,name=source,proto3" json:"source,omitempty"`
+	// first_seen is the RFC3339-formatted time the event first occurred.
+	FirstSeen string `protobuf:"bytes,8,opt,name=first_seen,json=firstSeen,proto3" json:"first_seen,omitempty"`
+	// last_seen is the RFC3339-formatted time the event most recently occurred.
+	LastSeen      string `protobuf:"bytes,9,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProjectEvent) Reset() {
+	*x = ProjectEvent{}
+	mi := &file_holos_console_v1_projects_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProjectEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectEvent) ProtoMessage() {}
+
+func (x *ProjectEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_projects_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectEvent.ProtoReflect.Descriptor instead.
+func (*ProjectEvent) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_projects_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ProjectEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ProjectEvent) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ProjectEvent) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ProjectEvent) GetInvolvedKind() string {
+	if x != nil {
+		return x.InvolvedKind
+	}
+	return ""
+}
+
+func (x *ProjectEvent) GetInvolvedName() string {
+	if x != nil {
+		return x.InvolvedName
+	}
+	return ""
+}
+
+func (x *ProjectEvent) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *ProjectEvent) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *ProjectEvent) GetFirstSeen() string {
+	if x != nil {
+		return x.FirstSeen
+	}
+	return ""
+}
+
+func (x *ProjectEvent) GetLastSeen() string {
+	if x != nil {
+		return x.LastSeen
+	}
+	return ""
+}
+
+// ListProjectEventsResponse contains one page of matching events, most
+// recent first.
+type ListProjectEventsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// events contains the matching events.
+	Events []*ProjectEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	// next_page_token fetches the following page. Empty on the last page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProjectEventsResponse) Reset() {
+	*x = ListProjectEventsResponse{}
+	mi := &file_holos_console_v1_projects_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProjectEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProjectEventsResponse) ProtoMessage() {}
+
+func (x *ListProjectEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_projects_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProjectEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListProjectEventsResponse) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_projects_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListProjectEventsResponse) GetEvents() []*ProjectEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *ListProjectEventsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// ListDeletedProjectsRequest filters the soft-deleted projects to list.
+type ListDeletedProjectsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// organization limits the list to projects in this organization. Empty
+	// lists every organization.
+	Organization  string `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeletedProjectsRequest) Reset() {
+	*x = ListDeletedProjectsRequest{}
+	mi := &file_holos_console_v1_projects_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeletedProjectsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedProjectsRequest) ProtoMessage() {}
+
+func (x *ListDeletedProjectsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_projects_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedProjectsRequest.ProtoReflect.Descriptor instead.
+func (*ListDeletedProjectsRequest) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_projects_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListDeletedProjectsRequest) GetOrganization() string {
+	if x != nil {
+		return x.Organization
+	}
+	return ""
+}
+
+// DeletedProject describes a soft-deleted project.
+type DeletedProject struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name is the name of the project.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// display_name is the project's display name.
+	DisplayName string `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	// organization is the organization the project belongs to.
+	Organization string `protobuf:"bytes,3,opt,name=organization,proto3" json:"organization,omitempty"`
+	// deleted_at is when the project was deleted, in RFC 3339 format.
+	DeletedAt string `protobuf:"bytes,4,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	// deleted_by is the email of the principal who deleted the project.
+	DeletedBy string `protobuf:"bytes,5,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"`
+	// purge_at is when the project will be permanently deleted, in RFC 3339
+	// format.
+	PurgeAt       string `protobuf:"bytes,6,opt,name=purge_at,json=purgeAt,proto3" json:"purge_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletedProject) Reset() {
+	*x = DeletedProject{}
+	mi := &file_holos_console_v1_projects_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletedProject) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletedProject) ProtoMessage() {}
+
+func (x *DeletedProject) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_projects_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletedProject.ProtoReflect.Descriptor instead.
+func (*DeletedProject) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_projects_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *DeletedProject) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DeletedProject) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *DeletedProject) GetOrganization() string {
+	if x != nil {
+		return x.Organization
+	}
+	return ""
+}
+
+func (x *DeletedProject) GetDeletedAt() string {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return ""
+}
+
+func (x *DeletedProject) GetDeletedBy() string {
+	if x != nil {
+		return x.DeletedBy
+	}
+	return ""
+}
+
+func (x *DeletedProject) GetPurgeAt() string {
+	if x != nil {
+		return x.PurgeAt
+	}
+	return ""
+}
+
+// ListDeletedProjectsResponse lists soft-deleted projects, most recently
+// deleted first.
+type ListDeletedProjectsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Projects      []*DeletedProject      `protobuf:"bytes,1,rep,name=projects,proto3" json:"projects,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeletedProjectsResponse) Reset() {
+	*x = ListDeletedProjectsResponse{}
+	mi := &file_holos_console_v1_projects_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeletedProjectsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedProjectsResponse) ProtoMessage() {}
+
+func (x *ListDeletedProjectsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_projects_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedProjectsResponse.ProtoReflect.Descriptor instead.
+func (*ListDeletedProjectsResponse) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_projects_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListDeletedProjectsResponse) GetProjects() []*DeletedProject {
+	if x != nil {
+		return x.Projects
+	}
+	return nil
+}
+
+// RestoreProjectRequest identifies the soft-deleted project to restore.
+type RestoreProjectRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name is the name of the project to restore.
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreProjectRequest) Reset() {
+	*x = RestoreProjectRequest{}
+	mi := &file_holos_console_v1_projects_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreProjectRequest) ProtoMessage() {}
+
+func (x *RestoreProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_projects_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreProjectRequest.ProtoReflect.Descriptor instead.
+func (*RestoreProjectRequest) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_projects_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *RestoreProjectRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// RestoreProjectResponse is empty on success.
+type RestoreProjectResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreProjectResponse) Reset() {
+	*x = RestoreProjectResponse{}
+	mi := &file_holos_console_v1_projects_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreProjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreProjectResponse) ProtoMessage() {}
+
+func (x *RestoreProjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_projects_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreProjectResponse.ProtoReflect.Descriptor instead.
+func (*RestoreProjectResponse) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_projects_proto_rawDescGZIP(), []int{31}
+}
+
+// CreateProjectTokenRequest selects the project and the token's lifetime and
+// audience.
+type CreateProjectTokenRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// project is the name of the project to mint a token for.
+	Project string `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	// ttl_seconds is how long the token is valid. Zero selects the server
+	// default of one hour; the server accepts 10 minutes to 24 hours.
+	TtlSeconds int64 `protobuf:"varint,2,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`
+	// audience is the intended audience of the token. Empty selects the API
+	// server's default audiences.
+	Audience      string `protobuf:"bytes,3,opt,name=audience,proto3" json:"audience,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateProjectTokenRequest) Reset() {
+	*x = CreateProjectTokenRequest{}
+	mi := &file_holos_console_v1_projects_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProjectTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProjectTokenRequest) ProtoMessage() {}
+
+func (x *CreateProjectTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_projects_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProjectTokenRequest.ProtoReflect.Descriptor instead.
+func (*CreateProjectTokenRequest) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_projects_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CreateProjectTokenRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *CreateProjectTokenRequest) GetTtlSeconds() int64 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+func (x *CreateProjectTokenRequest) GetAudience() string {
+	if x != nil {
+		return x.Audience
+	}
+	return ""
+}
+
+// CreateProjectTokenResponse carries the minted token. The token is not
+// stored by the console and cannot be retrieved again.
+type CreateProjectTokenResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// token is the bearer token.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// service_account is the ServiceAccount the token authenticates as, in
+	// the form system:serviceaccount:<namespace>:<name>.
+	ServiceAccount string `protobuf:"bytes,2,opt,name=service_account,json=serviceAccount,proto3" json:"service_account,omitempty"`
+	// expires_at is when the token expires, in RFC 3339 format.
+	ExpiresAt     string `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateProjectTokenResponse) Reset() {
+	*x = CreateProjectTokenResponse{}
+	mi := &file_holos_console_v1_projects_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProjectTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProjectTokenResponse) ProtoMessage() {}
+
+func (x *CreateProjectTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_projects_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProjectTokenResponse.ProtoReflect.Descriptor instead.
+func (*CreateProjectTokenResponse) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_projects_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *CreateProjectTokenResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *CreateProjectTokenResponse) GetServiceAccount() string {
+	if x != nil {
+		return x.ServiceAccount
+	}
+	return ""
+}
+
+func (x *CreateProjectTokenResponse) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+var File_holos_console_v1_projects_proto protoreflect.FileDescriptor
+
+const file_holos_console_v1_projects_proto_rawDesc = "" +
+	"\n" +
+	"\x1fholos/console/v1/projects.proto\x12\x10holos.console.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1bholos/console/v1/diff.proto\x1a\x1eholos/console/v1/folders.proto\x1a\"holos/console/v1/list_filter.proto\x1a!holos/console/v1/raw_format.proto\x1a\x1bholos/console/v1/rbac.proto\x1a\x1eholos/console/v1/secrets.proto\"\xe0\x05\n" +
+	"\aProject\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12!\n" +
+	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12 \n" +
 	"\vdescription\x18\x03 \x01(\tR\vdescription\x12=\n" +
 	"\vuser_grants\x18\x04 \x03(\v2\x1c.holos.console.v1.ShareGrantR\n" +
 	"userGrants\x12=\n" +
@@ -1180,86 +2333,184 @@ const file_holos_console_v1_projects_proto_rawDesc = "" +
 	"\vparent_type\x18\f \x01(\x0e2\x1c.holos.console.v1.ParentTypeR\n" +
 	"parentType\x12\x1f\n" +
 	"\vparent_name\x18\r \x01(\tR\n" +
-	"parentName\"\x99\x01\n" +
+	"parentName\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x0e \x01(\tR\tupdatedAt\x12F\n" +
+	"\x10user_role_source\x18\x0f \x01(\v2\x1c.holos.console.v1.RoleSourceR\x0euserRoleSource\"\xab\x02\n" +
 	"\x13ListProjectsRequest\x12\"\n" +
 	"\forganization\x18\x01 \x01(\tR\forganization\x12=\n" +
 	"\vparent_type\x18\x02 \x01(\x0e2\x1c.holos.console.v1.ParentTypeR\n" +
 	"parentType\x12\x1f\n" +
 	"\vparent_name\x18\x03 \x01(\tR\n" +
-	"parentName\"M\n" +
+	"parentName\x124\n" +
+	"\x06filter\x18\x04 \x01(\v2\x1c.holos.console.v1.ListFilterR\x06filter\x126\n" +
+	"\border_by\x18\x05 \x01(\v2\x1b.holos.console.v1.ListOrderR\aorderBy\x12\"\n" +
+	"\rif_none_match\x18\x06 \x01(\tR\vifNoneMatch\"\x84\x01\n" +
 	"\x14ListProjectsResponse\x125\n" +
-	"\bprojects\x18\x01 \x03(\v2\x19.holos.console.v1.ProjectR\bprojects\"'\n" +
-	"\x11GetProjectRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\"I\n" +
+	"\bprojects\x18\x01 \x03(\v2\x19.holos.console.v1.ProjectR\bprojects\x12\x12\n" +
+	"\x04etag\x18\x02 \x01(\tR\x04etag\x12!\n" +
+	"\fnot_modified\x18\x03 \x01(\bR\vnotModified\"S\n" +
+	"\x11GetProjectRequest\x12\x1a\n" +
+	"\x04name\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x04name\x12\"\n" +
+	"\rif_none_match\x18\x02 \x01(\tR\vifNoneMatch\"\x80\x01\n" +
 	"\x12GetProjectResponse\x123\n" +
-	"\aproject\x18\x01 \x01(\v2\x19.holos.console.v1.ProjectR\aproject\"\xf1\x02\n" +
-	"\x14CreateProjectRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12!\n" +
-	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\x12=\n" +
+	"\aproject\x18\x01 \x01(\v2\x19.holos.console.v1.ProjectR\aproject\x12\x12\n" +
+	"\x04etag\x18\x02 \x01(\tR\x04etag\x12!\n" +
+	"\fnot_modified\x18\x03 \x01(\bR\vnotModified\"\xb6\x04\n" +
+	"\x14CreateProjectRequest\x129\n" +
+	"\x04name\x18\x01 \x01(\tB%\xbaH\"\xd8\x01\x01r\x1d\x18?2\x19^[a-z][a-z0-9-]*[a-z0-9]$R\x04name\x12!\n" +
+	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12*\n" +
+	"\vdescription\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\x80 R\vdescription\x12=\n" +
 	"\vuser_grants\x18\x04 \x03(\v2\x1c.holos.console.v1.ShareGrantR\n" +
 	"userGrants\x12=\n" +
 	"\vrole_grants\x18\x05 \x03(\v2\x1c.holos.console.v1.ShareGrantR\n" +
-	"roleGrants\x12\"\n" +
-	"\forganization\x18\x06 \x01(\tR\forganization\x12=\n" +
+	"roleGrants\x12*\n" +
+	"\forganization\x18\x06 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\forganization\x12=\n" +
 	"\vparent_type\x18\a \x01(\x0e2\x1c.holos.console.v1.ParentTypeR\n" +
 	"parentType\x12\x1f\n" +
 	"\vparent_name\x18\b \x01(\tR\n" +
-	"parentName\"+\n" +
+	"parentName\x12\x17\n" +
+	"\adry_run\x18\t \x01(\bR\x06dryRun:q\xbaHn\x1al\n" +
+	"\x14name_or_display_name\x12(project name or display_name is required\x1a*this.name != '' || this.display_name != ''\"+\n" +
 	"\x15CreateProjectResponse\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\"\xa4\x02\n" +
-	"\x14UpdateProjectRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12&\n" +
-	"\fdisplay_name\x18\x02 \x01(\tH\x00R\vdisplayName\x88\x01\x01\x12%\n" +
-	"\vdescription\x18\x03 \x01(\tH\x01R\vdescription\x88\x01\x01\x12B\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"\xcf\x02\n" +
+	"\x14UpdateProjectRequest\x12\x1a\n" +
+	"\x04name\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x04name\x12&\n" +
+	"\fdisplay_name\x18\x02 \x01(\tH\x00R\vdisplayName\x88\x01\x01\x12/\n" +
+	"\vdescription\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\x80 H\x01R\vdescription\x88\x01\x01\x12B\n" +
 	"\vparent_type\x18\x04 \x01(\x0e2\x1c.holos.console.v1.ParentTypeH\x02R\n" +
 	"parentType\x88\x01\x01\x12$\n" +
 	"\vparent_name\x18\x05 \x01(\tH\x03R\n" +
-	"parentName\x88\x01\x01B\x0f\n" +
+	"parentName\x88\x01\x01\x12\x17\n" +
+	"\adry_run\x18\x06 \x01(\bR\x06dryRunB\x0f\n" +
 	"\r_display_nameB\x0e\n" +
 	"\f_descriptionB\x0e\n" +
 	"\f_parent_typeB\x0e\n" +
 	"\f_parent_name\"\x17\n" +
-	"\x15UpdateProjectResponse\"*\n" +
-	"\x14DeleteProjectRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\"\x17\n" +
-	"\x15DeleteProjectResponse\"\xaf\x01\n" +
-	"\x1bUpdateProjectSharingRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12=\n" +
+	"\x15UpdateProjectResponse\"\xb4\x02\n" +
+	"\x12DiffProjectRequest\x12\x1a\n" +
+	"\x04name\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x04name\x12&\n" +
+	"\fdisplay_name\x18\x02 \x01(\tH\x00R\vdisplayName\x88\x01\x01\x12/\n" +
+	"\vdescription\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\x80 H\x01R\vdescription\x88\x01\x01\x12B\n" +
+	"\vparent_type\x18\x04 \x01(\x0e2\x1c.holos.console.v1.ParentTypeH\x02R\n" +
+	"parentType\x88\x01\x01\x12$\n" +
+	"\vparent_name\x18\x05 \x01(\tH\x03R\n" +
+	"parentName\x88\x01\x01B\x0f\n" +
+	"\r_display_nameB\x0e\n" +
+	"\f_descriptionB\x0e\n" +
+	"\f_parent_typeB\x0e\n" +
+	"\f_parent_name\"N\n" +
+	"\x13DiffProjectResponse\x127\n" +
+	"\achanges\x18\x01 \x03(\v2\x1d.holos.console.v1.FieldChangeR\achanges\"K\n" +
+	"\x14DeleteProjectRequest\x12\x1a\n" +
+	"\x04name\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x04name\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\"\x17\n" +
+	"\x15DeleteProjectResponse\"\xe6\x01\n" +
+	"\x1bUpdateProjectSharingRequest\x12\x1a\n" +
+	"\x04name\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x04name\x12=\n" +
 	"\vuser_grants\x18\x02 \x03(\v2\x1c.holos.console.v1.ShareGrantR\n" +
 	"userGrants\x12=\n" +
 	"\vrole_grants\x18\x03 \x03(\v2\x1c.holos.console.v1.ShareGrantR\n" +
-	"roleGrants\"S\n" +
+	"roleGrants\x12\x17\n" +
+	"\adry_run\x18\x04 \x01(\bR\x06dryRun\x12\x14\n" +
+	"\x05force\x18\x05 \x01(\bR\x05force\"S\n" +
 	"\x1cUpdateProjectSharingResponse\x123\n" +
-	"\aproject\x18\x01 \x01(\v2\x19.holos.console.v1.ProjectR\aproject\"*\n" +
-	"\x14GetProjectRawRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\")\n" +
+	"\aproject\x18\x01 \x01(\v2\x19.holos.console.v1.ProjectR\aproject\"\x99\x01\n" +
+	"\x14GetProjectRawRequest\x12\x1a\n" +
+	"\x04name\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x04name\x123\n" +
+	"\x06format\x18\x02 \x01(\x0e2\x1b.holos.console.v1.RawFormatR\x06format\x120\n" +
+	"\x14strip_managed_fields\x18\x03 \x01(\bR\x12stripManagedFields\")\n" +
 	"\x15GetProjectRawResponse\x12\x10\n" +
-	"\x03raw\x18\x01 \x01(\tR\x03raw\"\xd4\x01\n" +
-	"\"UpdateProjectDefaultSharingRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12L\n" +
+	"\x03raw\x18\x01 \x01(\tR\x03raw\"\xdc\x01\n" +
+	"\"UpdateProjectDefaultSharingRequest\x12\x1a\n" +
+	"\x04name\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x04name\x12L\n" +
 	"\x13default_user_grants\x18\x02 \x03(\v2\x1c.holos.console.v1.ShareGrantR\x11defaultUserGrants\x12L\n" +
 	"\x13default_role_grants\x18\x03 \x03(\v2\x1c.holos.console.v1.ShareGrantR\x11defaultRoleGrants\"Z\n" +
 	"#UpdateProjectDefaultSharingResponse\x123\n" +
-	"\aproject\x18\x01 \x01(\v2\x19.holos.console.v1.ProjectR\aproject\"?\n" +
-	"\x1dCheckProjectIdentifierRequest\x12\x1e\n" +
+	"\aproject\x18\x01 \x01(\v2\x19.holos.console.v1.ProjectR\aproject\"G\n" +
+	"\x1dCheckProjectIdentifierRequest\x12&\n" +
 	"\n" +
-	"identifier\x18\x01 \x01(\tR\n" +
+	"identifier\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\n" +
 	"identifier\"q\n" +
 	"\x1eCheckProjectIdentifierResponse\x12\x1c\n" +
 	"\tavailable\x18\x01 \x01(\bR\tavailable\x121\n" +
-	"\x14suggested_identifier\x18\x02 \x01(\tR\x13suggestedIdentifier2\xd1\a\n" +
-	"\x0eProjectService\x12]\n" +
-	"\fListProjects\x12%.holos.console.v1.ListProjectsRequest\x1a&.holos.console.v1.ListProjectsResponse\x12W\n" +
+	"\x14suggested_identifier\x18\x02 \x01(\tR\x13suggestedIdentifier\"?\n" +
+	"\x1bListProjectResourcesRequest\x12 \n" +
+	"\aproject\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\aproject\"\x86\x01\n" +
+	"\x0fProjectResource\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05ready\x18\x03 \x01(\bR\x05ready\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt\"_\n" +
+	"\x1cListProjectResourcesResponse\x12?\n" +
+	"\tresources\x18\x01 \x03(\v2!.holos.console.v1.ProjectResourceR\tresources\"\xd8\x01\n" +
+	"\x18ListProjectEventsRequest\x12 \n" +
+	"\aproject\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\aproject\x12\x14\n" +
+	"\x05types\x18\x02 \x03(\tR\x05types\x12#\n" +
+	"\rinvolved_kind\x18\x03 \x01(\tR\finvolvedKind\x12#\n" +
+	"\rinvolved_name\x18\x04 \x01(\tR\finvolvedName\x12\x1b\n" +
+	"\tpage_size\x18\x05 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x06 \x01(\tR\tpageToken\"\x88\x02\n" +
+	"\fProjectEvent\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12#\n" +
+	"\rinvolved_kind\x18\x04 \x01(\tR\finvolvedKind\x12#\n" +
+	"\rinvolved_name\x18\x05 \x01(\tR\finvolvedName\x12\x14\n" +
+	"\x05count\x18\x06 \x01(\x05R\x05count\x12\x16\n" +
+	"\x06source\x18\a \x01(\tR\x06source\x12\x1d\n" +
+	"\n" +
+	"first_seen\x18\b \x01(\tR\tfirstSeen\x12\x1b\n" +
+	"\tlast_seen\x18\t \x01(\tR\blastSeen\"{\n" +
+	"\x19ListProjectEventsResponse\x126\n" +
+	"\x06events\x18\x01 \x03(\v2\x1e.holos.console.v1.ProjectEventR\x06events\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"@\n" +
+	"\x1aListDeletedProjectsRequest\x12\"\n" +
+	"\forganization\x18\x01 \x01(\tR\forganization\"\xc4\x01\n" +
+	"\x0eDeletedProject\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12!\n" +
+	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12\"\n" +
+	"\forganization\x18\x03 \x01(\tR\forganization\x12\x1d\n" +
+	"\n" +
+	"deleted_at\x18\x04 \x01(\tR\tdeletedAt\x12\x1d\n" +
+	"\n" +
+	"deleted_by\x18\x05 \x01(\tR\tdeletedBy\x12\x19\n" +
+	"\bpurge_at\x18\x06 \x01(\tR\apurgeAt\"[\n" +
+	"\x1bListDeletedProjectsResponse\x12<\n" +
+	"\bprojects\x18\x01 \x03(\v2 .holos.console.v1.DeletedProjectR\bprojects\"3\n" +
+	"\x15RestoreProjectRequest\x12\x1a\n" +
+	"\x04name\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x04name\"\x18\n" +
+	"\x16RestoreProjectResponse\"\x84\x01\n" +
+	"\x19CreateProjectTokenRequest\x12 \n" +
+	"\aproject\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\aproject\x12\x1f\n" +
+	"\vttl_seconds\x18\x02 \x01(\x03R\n" +
+	"ttlSeconds\x12$\n" +
+	"\baudience\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\xfd\x01R\baudience\"z\n" +
+	"\x1aCreateProjectTokenResponse\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12'\n" +
+	"\x0fservice_account\x18\x02 \x01(\tR\x0eserviceAccount\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\tR\texpiresAt2\x80\r\n" +
+	"\x0eProjectService\x12b\n" +
+	"\fListProjects\x12%.holos.console.v1.ListProjectsRequest\x1a&.holos.console.v1.ListProjectsResponse\"\x03\x90\x02\x01\x12\\\n" +
 	"\n" +
-	"GetProject\x12#.holos.console.v1.GetProjectRequest\x1a$.holos.console.v1.GetProjectResponse\x12`\n" +
+	"GetProject\x12#.holos.console.v1.GetProjectRequest\x1a$.holos.console.v1.GetProjectResponse\"\x03\x90\x02\x01\x12`\n" +
 	"\rCreateProject\x12&.holos.console.v1.CreateProjectRequest\x1a'.holos.console.v1.CreateProjectResponse\x12`\n" +
-	"\rUpdateProject\x12&.holos.console.v1.UpdateProjectRequest\x1a'.holos.console.v1.UpdateProjectResponse\x12`\n" +
+	"\rUpdateProject\x12&.holos.console.v1.UpdateProjectRequest\x1a'.holos.console.v1.UpdateProjectResponse\x12Z\n" +
+	"\vDiffProject\x12$.holos.console.v1.DiffProjectRequest\x1a%.holos.console.v1.DiffProjectResponse\x12`\n" +
 	"\rDeleteProject\x12&.holos.console.v1.DeleteProjectRequest\x1a'.holos.console.v1.DeleteProjectResponse\x12u\n" +
-	"\x14UpdateProjectSharing\x12-.holos.console.v1.UpdateProjectSharingRequest\x1a..holos.console.v1.UpdateProjectSharingResponse\x12`\n" +
-	"\rGetProjectRaw\x12&.holos.console.v1.GetProjectRawRequest\x1a'.holos.console.v1.GetProjectRawResponse\x12\x8a\x01\n" +
-	"\x1bUpdateProjectDefaultSharing\x124.holos.console.v1.UpdateProjectDefaultSharingRequest\x1a5.holos.console.v1.UpdateProjectDefaultSharingResponse\x12{\n" +
-	"\x16CheckProjectIdentifier\x12/.holos.console.v1.CheckProjectIdentifierRequest\x1a0.holos.console.v1.CheckProjectIdentifierResponseBCZAgithub.com/holos-run/holos-console/gen/holos/console/v1;consolev1b\x06proto3"
+	"\x14UpdateProjectSharing\x12-.holos.console.v1.UpdateProjectSharingRequest\x1a..holos.console.v1.UpdateProjectSharingResponse\x12e\n" +
+	"\rGetProjectRaw\x12&.holos.console.v1.GetProjectRawRequest\x1a'.holos.console.v1.GetProjectRawResponse\"\x03\x90\x02\x01\x12\x8a\x01\n" +
+	"\x1bUpdateProjectDefaultSharing\x124.holos.console.v1.UpdateProjectDefaultSharingRequest\x1a5.holos.console.v1.UpdateProjectDefaultSharingResponse\x12\x80\x01\n" +
+	"\x16CheckProjectIdentifier\x12/.holos.console.v1.CheckProjectIdentifierRequest\x1a0.holos.console.v1.CheckProjectIdentifierResponse\"\x03\x90\x02\x01\x12z\n" +
+	"\x14ListProjectResources\x12-.holos.console.v1.ListProjectResourcesRequest\x1a..holos.console.v1.ListProjectResourcesResponse\"\x03\x90\x02\x01\x12q\n" +
+	"\x11ListProjectEvents\x12*.holos.console.v1.ListProjectEventsRequest\x1a+.holos.console.v1.ListProjectEventsResponse\"\x03\x90\x02\x01\x12w\n" +
+	"\x13ListDeletedProjects\x12,.holos.console.v1.ListDeletedProjectsRequest\x1a-.holos.console.v1.ListDeletedProjectsResponse\"\x03\x90\x02\x01\x12c\n" +
+	"\x0eRestoreProject\x12'.holos.console.v1.RestoreProjectRequest\x1a(.holos.console.v1.RestoreProjectResponse\x12o\n" +
+	"\x12CreateProjectToken\x12+.holos.console.v1.CreateProjectTokenRequest\x1a,.holos.console.v1.CreateProjectTokenResponseBCZAgithub.com/holos-run/holos-console/gen/holos/console/v1;consolev1b\x06proto3"
 
 var (
 	file_holos_console_v1_projects_proto_rawDescOnce sync.Once
@@ -1273,7 +2524,7 @@ func file_holos_console_v1_projects_proto_rawDescGZIP() []byte {
 	return file_holos_console_v1_projects_proto_rawDescData
 }
 
-var file_holos_console_v1_projects_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_holos_console_v1_projects_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
 var file_holos_console_v1_projects_proto_goTypes = []any{
 	(*Project)(nil),                             // 0: holos.console.v1.Project
 	(*ListProjectsRequest)(nil),                 // 1: holos.console.v1.ListProjectsRequest
@@ -1284,63 +2535,104 @@ var file_holos_console_v1_projects_proto_goTypes = []any{
 	(*CreateProjectResponse)(nil),               // 6: holos.console.v1.CreateProjectResponse
 	(*UpdateProjectRequest)(nil),                // 7: holos.console.v1.UpdateProjectRequest
 	(*UpdateProjectResponse)(nil),               // 8: holos.console.v1.UpdateProjectResponse
-	(*DeleteProjectRequest)(nil),                // 9: holos.console.v1.DeleteProjectRequest
-	(*DeleteProjectResponse)(nil),               // 10: holos.console.v1.DeleteProjectResponse
-	(*UpdateProjectSharingRequest)(nil),         // 11: holos.console.v1.UpdateProjectSharingRequest
-	(*UpdateProjectSharingResponse)(nil),        // 12: holos.console.v1.UpdateProjectSharingResponse
-	(*GetProjectRawRequest)(nil),                // 13: holos.console.v1.GetProjectRawRequest
-	(*GetProjectRawResponse)(nil),               // 14: holos.console.v1.GetProjectRawResponse
-	(*UpdateProjectDefaultSharingRequest)(nil),  // 15: holos.console.v1.UpdateProjectDefaultSharingRequest
-	(*UpdateProjectDefaultSharingResponse)(nil), // 16: holos.console.v1.UpdateProjectDefaultSharingResponse
-	(*CheckProjectIdentifierRequest)(nil),       // 17: holos.console.v1.CheckProjectIdentifierRequest
-	(*CheckProjectIdentifierResponse)(nil),      // 18: holos.console.v1.CheckProjectIdentifierResponse
-	(*ShareGrant)(nil),                          // 19: holos.console.v1.ShareGrant
-	(Role)(0),                                   // 20: holos.console.v1.Role
-	(ParentType)(0),                             // 21: holos.console.v1.ParentType
+	(*DiffProjectRequest)(nil),                  // 9: holos.console.v1.DiffProjectRequest
+	(*DiffProjectResponse)(nil),                 // 10: holos.console.v1.DiffProjectResponse
+	(*DeleteProjectRequest)(nil),                // 11: holos.console.v1.DeleteProjectRequest
+	(*DeleteProjectResponse)(nil),               // 12: holos.console.v1.DeleteProjectResponse
+	(*UpdateProjectSharingRequest)(nil),         // 13: holos.console.v1.UpdateProjectSharingRequest
+	(*UpdateProjectSharingResponse)(nil),        // 14: holos.console.v1.UpdateProjectSharingResponse
+	(*GetProjectRawRequest)(nil),                // 15: holos.console.v1.GetProjectRawRequest
+	(*GetProjectRawResponse)(nil),               // 16: holos.console.v1.GetProjectRawResponse
+	(*UpdateProjectDefaultSharingRequest)(nil),  // 17: holos.console.v1.UpdateProjectDefaultSharingRequest
+	(*UpdateProjectDefaultSharingResponse)(nil), // 18: holos.console.v1.UpdateProjectDefaultSharingResponse
+	(*CheckProjectIdentifierRequest)(nil),       // 19: holos.console.v1.CheckProjectIdentifierRequest
+	(*CheckProjectIdentifierResponse)(nil),      // 20: holos.console.v1.CheckProjectIdentifierResponse
+	(*ListProjectResourcesRequest)(nil),         // 21: holos.console.v1.ListProjectResourcesRequest
+	(*ProjectResource)(nil),                     // 22: holos.console.v1.ProjectResource
+	(*ListProjectResourcesResponse)(nil),        // 23: holos.console.v1.ListProjectResourcesResponse
+	(*ListProjectEventsRequest)(nil),            // 24: holos.console.v1.ListProjectEventsRequest
+	(*ProjectEvent)(nil),                        // 25: holos.console.v1.ProjectEvent
+	(*ListProjectEventsResponse)(nil),           // 26: holos.console.v1.ListProjectEventsResponse
+	(*ListDeletedProjectsRequest)(nil),          // 27: holos.console.v1.ListDeletedProjectsRequest
+	(*DeletedProject)(nil),                      // 28: holos.console.v1.DeletedProject
+	(*ListDeletedProjectsResponse)(nil),         // 29: holos.console.v1.ListDeletedProjectsResponse
+	(*RestoreProjectRequest)(nil),               // 30: holos.console.v1.RestoreProjectRequest
+	(*RestoreProjectResponse)(nil),              // 31: holos.console.v1.RestoreProjectResponse
+	(*CreateProjectTokenRequest)(nil),           // 32: holos.console.v1.CreateProjectTokenRequest
+	(*CreateProjectTokenResponse)(nil),          // 33: holos.console.v1.CreateProjectTokenResponse
+	(*ShareGrant)(nil),                          // 34: holos.console.v1.ShareGrant
+	(Role)(0),                                   // 35: holos.console.v1.Role
+	(ParentType)(0),                             // 36: holos.console.v1.ParentType
+	(*RoleSource)(nil),                          // 37: holos.console.v1.RoleSource
+	(*ListFilter)(nil),                          // 38: holos.console.v1.ListFilter
+	(*ListOrder)(nil),                           // 39: holos.console.v1.ListOrder
+	(*FieldChange)(nil),                         // 40: holos.console.v1.FieldChange
+	(RawFormat)(0),                              // 41: holos.console.v1.RawFormat
 }
 var file_holos_console_v1_projects_proto_depIdxs = []int32{
-	19, // 0: holos.console.v1.Project.user_grants:type_name -> holos.console.v1.ShareGrant
-	19, // 1: holos.console.v1.Project.role_grants:type_name -> holos.console.v1.ShareGrant
-	20, // 2: holos.console.v1.Project.user_role:type_name -> holos.console.v1.Role
-	19, // 3: holos.console.v1.Project.default_user_grants:type_name -> holos.console.v1.ShareGrant
-	19, // 4: holos.console.v1.Project.default_role_grants:type_name -> holos.console.v1.ShareGrant
-	21, // 5: holos.console.v1.Project.parent_type:type_name -> holos.console.v1.ParentType
-	21, // 6: holos.console.v1.ListProjectsRequest.parent_type:type_name -> holos.console.v1.ParentType
-	0,  // 7: holos.console.v1.ListProjectsResponse.projects:type_name -> holos.console.v1.Project
-	0,  // 8: holos.console.v1.GetProjectResponse.project:type_name -> holos.console.v1.Project
-	19, // 9: holos.console.v1.CreateProjectRequest.user_grants:type_name -> holos.console.v1.ShareGrant
-	19, // 10: holos.console.v1.CreateProjectRequest.role_grants:type_name -> holos.console.v1.ShareGrant
-	21, // 11: holos.console.v1.CreateProjectRequest.parent_type:type_name -> holos.console.v1.ParentType
-	21, // 12: holos.console.v1.UpdateProjectRequest.parent_type:type_name -> holos.console.v1.ParentType
-	19, // 13: holos.console.v1.UpdateProjectSharingRequest.user_grants:type_name -> holos.console.v1.ShareGrant
-	19, // 14: holos.console.v1.UpdateProjectSharingRequest.role_grants:type_name -> holos.console.v1.ShareGrant
-	0,  // 15: holos.console.v1.UpdateProjectSharingResponse.project:type_name -> holos.console.v1.Project
-	19, // 16: holos.console.v1.UpdateProjectDefaultSharingRequest.default_user_grants:type_name -> holos.console.v1.ShareGrant
-	19, // 17: holos.console.v1.UpdateProjectDefaultSharingRequest.default_role_grants:type_name -> holos.console.v1.ShareGrant
-	0,  // 18: holos.console.v1.UpdateProjectDefaultSharingResponse.project:type_name -> holos.console.v1.Project
-	1,  // 19: holos.console.v1.ProjectService.ListProjects:input_type -> holos.console.v1.ListProjectsRequest
-	3,  // 20: holos.console.v1.ProjectService.GetProject:input_type -> holos.console.v1.GetProjectRequest
-	5,  // 21: holos.console.v1.ProjectService.CreateProject:input_type -> holos.console.v1.CreateProjectRequest
-	7,  // 22: holos.console.v1.ProjectService.UpdateProject:input_type -> holos.console.v1.UpdateProjectRequest
-	9,  // 23: holos.console.v1.ProjectService.DeleteProject:input_type -> holos.console.v1.DeleteProjectRequest
-	11, // 24: holos.console.v1.ProjectService.UpdateProjectSharing:input_type -> holos.console.v1.UpdateProjectSharingRequest
-	13, // 25: holos.console.v1.ProjectService.GetProjectRaw:input_type -> holos.console.v1.GetProjectRawRequest
-	15, // 26: holos.console.v1.ProjectService.UpdateProjectDefaultSharing:input_type -> holos.console.v1.UpdateProjectDefaultSharingRequest
-	17, // 27: holos.console.v1.ProjectService.CheckProjectIdentifier:input_type -> holos.console.v1.CheckProjectIdentifierRequest
-	2,  // 28: holos.console.v1.ProjectService.ListProjects:output_type -> holos.console.v1.ListProjectsResponse
-	4,  // 29: holos.console.v1.ProjectService.GetProject:output_type -> holos.console.v1.GetProjectResponse
-	6,  // 30: holos.console.v1.ProjectService.CreateProject:output_type -> holos.console.v1.CreateProjectResponse
-	8,  // 31: holos.console.v1.ProjectService.UpdateProject:output_type -> holos.console.v1.UpdateProjectResponse
-	10, // 32: holos.console.v1.ProjectService.DeleteProject:output_type -> holos.console.v1.DeleteProjectResponse
-	12, // 33: holos.console.v1.ProjectService.UpdateProjectSharing:output_type -> holos.console.v1.UpdateProjectSharingResponse
-	14, // 34: holos.console.v1.ProjectService.GetProjectRaw:output_type -> holos.console.v1.GetProjectRawResponse
-	16, // 35: holos.console.v1.ProjectService.UpdateProjectDefaultSharing:output_type -> holos.console.v1.UpdateProjectDefaultSharingResponse
-	18, // 36: holos.console.v1.ProjectService.CheckProjectIdentifier:output_type -> holos.console.v1.CheckProjectIdentifierResponse
-	28, // [28:37] is the sub-list for method output_type
-	19, // [19:28] is the sub-list for method input_type
-	19, // [19:19] is the sub-list for extension type_name
-	19, // [19:19] is the sub-list for extension extendee
-	0,  // [0:19] is the sub-list for field type_name
+	34, // 0: holos.console.v1.Project.user_grants:type_name -> holos.console.v1.ShareGrant
+	34, // 1: holos.console.v1.Project.role_grants:type_name -> holos.console.v1.ShareGrant
+	35, // 2: holos.console.v1.Project.user_role:type_name -> holos.console.v1.Role
+	34, // 3: holos.console.v1.Project.default_user_grants:type_name -> holos.console.v1.ShareGrant
+	34, // 4: holos.console.v1.Project.default_role_grants:type_name -> holos.console.v1.ShareGrant
+	36, // 5: holos.console.v1.Project.parent_type:type_name -> holos.console.v1.ParentType
+	37, // 6: holos.console.v1.Project.user_role_source:type_name -> holos.console.v1.RoleSource
+	36, // 7: holos.console.v1.ListProjectsRequest.parent_type:type_name -> holos.console.v1.ParentType
+	38, // 8: holos.console.v1.ListProjectsRequest.filter:type_name -> holos.console.v1.ListFilter
+	39, // 9: holos.console.v1.ListProjectsRequest.order_by:type_name -> holos.console.v1.ListOrder
+	0,  // 10: holos.console.v1.ListProjectsResponse.projects:type_name -> holos.console.v1.Project
+	0,  // 11: holos.console.v1.GetProjectResponse.project:type_name -> holos.console.v1.Project
+	34, // 12: holos.console.v1.CreateProjectRequest.user_grants:type_name -> holos.console.v1.ShareGrant
+	34, // 13: holos.console.v1.CreateProjectRequest.role_grants:type_name -> holos.console.v1.ShareGrant
+	36, // 14: holos.console.v1.CreateProjectRequest.parent_type:type_name -> holos.console.v1.ParentType
+	36, // 15: holos.console.v1.UpdateProjectRequest.parent_type:type_name -> holos.console.v1.ParentType
+	36, // 16: holos.console.v1.DiffProjectRequest.parent_type:type_name -> holos.console.v1.ParentType
+	40, // 17: holos.console.v1.DiffProjectResponse.changes:type_name -> holos.console.v1.FieldChange
+	34, // 18: holos.console.v1.UpdateProjectSharingRequest.user_grants:type_name -> holos.console.v1.ShareGrant
+	34, // 19: holos.console.v1.UpdateProjectSharingRequest.role_grants:type_name -> holos.console.v1.ShareGrant
+	0,  // 20: holos.console.v1.UpdateProjectSharingResponse.project:type_name -> holos.console.v1.Project
+	41, // 21: holos.console.v1.GetProjectRawRequest.format:type_name -> holos.console.v1.RawFormat
+	34, // 22: holos.console.v1.UpdateProjectDefaultSharingRequest.default_user_grants:type_name -> holos.console.v1.ShareGrant
+	34, // 23: holos.console.v1.UpdateProjectDefaultSharingRequest.default_role_grants:type_name -> holos.console.v1.ShareGrant
+	0,  // 24: holos.console.v1.UpdateProjectDefaultSharingResponse.project:type_name -> holos.console.v1.Project
+	22, // 25: holos.console.v1.ListProjectResourcesResponse.resources:type_name -> holos.console.v1.ProjectResource
+	25, // 26: holos.console.v1.ListProjectEventsResponse.events:type_name -> holos.console.v1.ProjectEvent
+	28, // 27: holos.console.v1.ListDeletedProjectsResponse.projects:type_name -> holos.console.v1.DeletedProject
+	1,  // 28: holos.console.v1.ProjectService.ListProjects:input_type -> holos.console.v1.ListProjectsRequest
+	3,  // 29: holos.console.v1.ProjectService.GetProject:input_type -> holos.console.v1.GetProjectRequest
+	5,  // 30: holos.console.v1.ProjectService.CreateProject:input_type -> holos.console.v1.CreateProjectRequest
+	7,  // 31: holos.console.v1.ProjectService.UpdateProject:input_type -> holos.console.v1.UpdateProjectRequest
+	9,  // 32: holos.console.v1.ProjectService.DiffProject:input_type -> holos.console.v1.DiffProjectRequest
+	11, // 33: holos.console.v1.ProjectService.DeleteProject:input_type -> holos.console.v1.DeleteProjectRequest
+	13, // 34: holos.console.v1.ProjectService.UpdateProjectSharing:input_type -> holos.console.v1.UpdateProjectSharingRequest
+	15, // 35: holos.console.v1.ProjectService.GetProjectRaw:input_type -> holos.console.v1.GetProjectRawRequest
+	17, // 36: holos.console.v1.ProjectService.UpdateProjectDefaultSharing:input_type -> holos.console.v1.UpdateProjectDefaultSharingRequest
+	19, // 37: holos.console.v1.ProjectService.CheckProjectIdentifier:input_type -> holos.console.v1.CheckProjectIdentifierRequest
+	21, // 38: holos.console.v1.ProjectService.ListProjectResources:input_type -> holos.console.v1.ListProjectResourcesRequest
+	24, // 39: holos.console.v1.ProjectService.ListProjectEvents:input_type -> holos.console.v1.ListProjectEventsRequest
+	27, // 40: holos.console.v1.ProjectService.ListDeletedProjects:input_type -> holos.console.v1.ListDeletedProjectsRequest
+	30, // 41: holos.console.v1.ProjectService.RestoreProject:input_type -> holos.console.v1.RestoreProjectRequest
+	32, // 42: holos.console.v1.ProjectService.CreateProjectToken:input_type -> holos.console.v1.CreateProjectTokenRequest
+	2,  // 43: holos.console.v1.ProjectService.ListProjects:output_type -> holos.console.v1.ListProjectsResponse
+	4,  // 44: holos.console.v1.ProjectService.GetProject:output_type -> holos.console.v1.GetProjectResponse
+	6,  // 45: holos.console.v1.ProjectService.CreateProject:output_type -> holos.console.v1.CreateProjectResponse
+	8,  // 46: holos.console.v1.ProjectService.UpdateProject:output_type -> holos.console.v1.UpdateProjectResponse
+	10, // 47: holos.console.v1.ProjectService.DiffProject:output_type -> holos.console.v1.DiffProjectResponse
+	12, // 48: holos.console.v1.ProjectService.DeleteProject:output_type -> holos.console.v1.DeleteProjectResponse
+	14, // 49: holos.console.v1.ProjectService.UpdateProjectSharing:output_type -> holos.console.v1.UpdateProjectSharingResponse
+	16, // 50: holos.console.v1.ProjectService.GetProjectRaw:output_type -> holos.console.v1.GetProjectRawResponse
+	18, // 51: holos.console.v1.ProjectService.UpdateProjectDefaultSharing:output_type -> holos.console.v1.UpdateProjectDefaultSharingResponse
+	20, // 52: holos.console.v1.ProjectService.CheckProjectIdentifier:output_type -> holos.console.v1.CheckProjectIdentifierResponse
+	23, // 53: holos.console.v1.ProjectService.ListProjectResources:output_type -> holos.console.v1.ListProjectResourcesResponse
+	26, // 54: holos.console.v1.ProjectService.ListProjectEvents:output_type -> holos.console.v1.ListProjectEventsResponse
+	29, // 55: holos.console.v1.ProjectService.ListDeletedProjects:output_type -> holos.console.v1.ListDeletedProjectsResponse
+	31, // 56: holos.console.v1.ProjectService.RestoreProject:output_type -> holos.console.v1.RestoreProjectResponse
+	33, // 57: holos.console.v1.ProjectService.CreateProjectToken:output_type -> holos.console.v1.CreateProjectTokenResponse
+	43, // [43:58] is the sub-list for method output_type
+	28, // [28:43] is the sub-list for method input_type
+	28, // [28:28] is the sub-list for extension type_name
+	28, // [28:28] is the sub-list for extension extendee
+	0,  // [0:28] is the sub-list for field type_name
 }
 
 func init() { file_holos_console_v1_projects_proto_init() }
@@ -1348,17 +2640,21 @@ func file_holos_console_v1_projects_proto_init() {
 	if File_holos_console_v1_projects_proto != nil {
 		return
 	}
+	file_holos_console_v1_diff_proto_init()
 	file_holos_console_v1_folders_proto_init()
+	file_holos_console_v1_list_filter_proto_init()
+	file_holos_console_v1_raw_format_proto_init()
 	file_holos_console_v1_rbac_proto_init()
 	file_holos_console_v1_secrets_proto_init()
 	file_holos_console_v1_projects_proto_msgTypes[7].OneofWrappers = []any{}
+	file_holos_console_v1_projects_proto_msgTypes[9].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_holos_console_v1_projects_proto_rawDesc), len(file_holos_console_v1_projects_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   19,
+			NumMessages:   34,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
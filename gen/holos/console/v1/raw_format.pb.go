@@ -0,0 +1,134 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: holos/console/v1/raw_format.proto
+
+package consolev1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// RawFormat selects the serialization of the Kubernetes object a Get*Raw
+// RPC returns.
+type RawFormat int32
+
+const (
+	// RAW_FORMAT_UNSPECIFIED returns JSON.
+	RawFormat_RAW_FORMAT_UNSPECIFIED RawFormat = 0
+	// RAW_FORMAT_JSON returns the object as JSON.
+	RawFormat_RAW_FORMAT_JSON RawFormat = 1
+	// RAW_FORMAT_YAML returns the object as a YAML manifest.
+	RawFormat_RAW_FORMAT_YAML RawFormat = 2
+)
+
+// Enum value maps for RawFormat.
+var (
+	RawFormat_name = map[int32]string{
+		0: "RAW_FORMAT_UNSPECIFIED",
+		1: "RAW_FORMAT_JSON",
+		2: "RAW_FORMAT_YAML",
+	}
+	RawFormat_value = map[string]int32{
+		"RAW_FORMAT_UNSPECIFIED": 0,
+		"RAW_FORMAT_JSON":        1,
+		"RAW_FORMAT_YAML":        2,
+	}
+)
+
+func (x RawFormat) Enum() *RawFormat {
+	p := new(RawFormat)
+	*p = x
+	return p
+}
+
+func (x RawFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RawFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_holos_console_v1_raw_format_proto_enumTypes[0].Descriptor()
+}
+
+func (RawFormat) Type() protoreflect.EnumType {
+	return &file_holos_console_v1_raw_format_proto_enumTypes[0]
+}
+
+func (x RawFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RawFormat.Descriptor instead.
+func (RawFormat) EnumDescriptor() ([]byte, []int) {
+	return file_holos_console_v1_raw_format_proto_rawDescGZIP(), []int{0}
+}
+
+var File_holos_console_v1_raw_format_proto protoreflect.FileDescriptor
+
+const file_holos_console_v1_raw_format_proto_rawDesc = "" +
+	"\n" +
+	"!holos/console/v1/raw_format.proto\x12\x10holos.console.v1*Q\n" +
+	"\tRawFormat\x12\x1a\n" +
+	"\x16RAW_FORMAT_UNSPECIFIED\x10\x00\x12\x13\n" +
+	"\x0fRAW_FORMAT_JSON\x10\x01\x12\x13\n" +
+	"\x0fRAW_FORMAT_YAML\x10\x02BCZAgithub.com/holos-run/holos-console/gen/holos/console/v1;consolev1b\x06proto3"
+
+var (
+	file_holos_console_v1_raw_format_proto_rawDescOnce sync.Once
+	file_holos_console_v1_raw_format_proto_rawDescData []byte
+)
+
+func file_holos_console_v1_raw_format_proto_rawDescGZIP() []byte {
+	file_holos_console_v1_raw_format_proto_rawDescOnce.Do(func() {
+		file_holos_console_v1_raw_format_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_holos_console_v1_raw_format_proto_rawDesc), len(file_holos_console_v1_raw_format_proto_rawDesc)))
+	})
+	return file_holos_console_v1_raw_format_proto_rawDescData
+}
+
+var file_holos_console_v1_raw_format_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_holos_console_v1_raw_format_proto_goTypes = []any{
+	(RawFormat)(0), // 0: holos.console.v1.RawFormat
+}
+var file_holos_console_v1_raw_format_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_holos_console_v1_raw_format_proto_init() }
+func file_holos_console_v1_raw_format_proto_init() {
+	if File_holos_console_v1_raw_format_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_holos_console_v1_raw_format_proto_rawDesc), len(file_holos_console_v1_raw_format_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_holos_console_v1_raw_format_proto_goTypes,
+		DependencyIndexes: file_holos_console_v1_raw_format_proto_depIdxs,
+		EnumInfos:         file_holos_console_v1_raw_format_proto_enumTypes,
+	}.Build()
+	File_holos_console_v1_raw_format_proto = out.File
+	file_holos_console_v1_raw_format_proto_goTypes = nil
+	file_holos_console_v1_raw_format_proto_depIdxs = nil
+}
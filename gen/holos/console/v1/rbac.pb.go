@@ -78,6 +78,66 @@ func (Role) EnumDescriptor() ([]byte, []int) {
 	return file_holos_console_v1_rbac_proto_rawDescGZIP(), []int{0}
 }
 
+// RoleSourceType is how a caller was granted their role on a resource.
+type RoleSourceType int32
+
+const (
+	// ROLE_SOURCE_TYPE_UNSPECIFIED indicates the caller holds no role.
+	RoleSourceType_ROLE_SOURCE_TYPE_UNSPECIFIED RoleSourceType = 0
+	// ROLE_SOURCE_TYPE_USER_GRANT is a user grant naming the caller, their
+	// email domain, or every user.
+	RoleSourceType_ROLE_SOURCE_TYPE_USER_GRANT RoleSourceType = 1
+	// ROLE_SOURCE_TYPE_GROUP_GRANT is a role grant naming one of the caller's
+	// groups.
+	RoleSourceType_ROLE_SOURCE_TYPE_GROUP_GRANT RoleSourceType = 2
+	// ROLE_SOURCE_TYPE_PLATFORM is Kubernetes RBAC outside the console's
+	// sharing grants, such as a platform group bound cluster-wide.
+	RoleSourceType_ROLE_SOURCE_TYPE_PLATFORM RoleSourceType = 3
+)
+
+// Enum value maps for RoleSourceType.
+var (
+	RoleSourceType_name = map[int32]string{
+		0: "ROLE_SOURCE_TYPE_UNSPECIFIED",
+		1: "ROLE_SOURCE_TYPE_USER_GRANT",
+		2: "ROLE_SOURCE_TYPE_GROUP_GRANT",
+		3: "ROLE_SOURCE_TYPE_PLATFORM",
+	}
+	RoleSourceType_value = map[string]int32{
+		"ROLE_SOURCE_TYPE_UNSPECIFIED": 0,
+		"ROLE_SOURCE_TYPE_USER_GRANT":  1,
+		"ROLE_SOURCE_TYPE_GROUP_GRANT": 2,
+		"ROLE_SOURCE_TYPE_PLATFORM":    3,
+	}
+)
+
+func (x RoleSourceType) Enum() *RoleSourceType {
+	p := new(RoleSourceType)
+	*p = x
+	return p
+}
+
+func (x RoleSourceType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RoleSourceType) Descriptor() protoreflect.EnumDescriptor {
+	return file_holos_console_v1_rbac_proto_enumTypes[1].Descriptor()
+}
+
+func (RoleSourceType) Type() protoreflect.EnumType {
+	return &file_holos_console_v1_rbac_proto_enumTypes[1]
+}
+
+func (x RoleSourceType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RoleSourceType.Descriptor instead.
+func (RoleSourceType) EnumDescriptor() ([]byte, []int) {
+	return file_holos_console_v1_rbac_proto_rawDescGZIP(), []int{1}
+}
+
 // Permission represents granular permissions for RBAC operations.
 // v1alpha2: template permissions are collapsed to a single set applied
 // uniformly at every scope level (ADR 021 Decision 2).
@@ -177,6 +237,21 @@ const (
 	Permission_PERMISSION_TEMPLATE_POLICIES_DELETE Permission = 50
 	// PERMISSION_TEMPLATE_POLICIES_ADMIN allows administrative operations on template policies.
 	Permission_PERMISSION_TEMPLATE_POLICIES_ADMIN Permission = 51
+	// PERMISSION_SECRETS_ROTATE allows rotating secret values with RotateSecret.
+	// Enforced by the apiserver as the custom "rotate" verb on secrets, granted
+	// to secret editors and owners.
+	Permission_PERMISSION_SECRETS_ROTATE Permission = 52
+	// PERMISSION_IMPERSONATE allows acting as another user by sending the
+	// X-Impersonate-User request header. Enforced by the apiserver as the
+	// "impersonate" verb on users (groups, for X-Impersonate-Group, and the
+	// "email" userextra, for X-Impersonate-Email), so it is granted with the
+	// same RBAC that governs kubectl --as. Scoped API tokens must also list
+	// it.
+	Permission_PERMISSION_IMPERSONATE Permission = 53
+	// PERMISSION_RESOURCES_READ allows listing the workloads running in a
+	// project namespace with ListProjectResources. Enforced by the apiserver as
+	// "list" on deployments.apps in the namespace.
+	Permission_PERMISSION_RESOURCES_READ Permission = 54
 )
 
 // Enum value maps for Permission.
@@ -228,6 +303,9 @@ var (
 		49: "PERMISSION_TEMPLATE_POLICIES_WRITE",
 		50: "PERMISSION_TEMPLATE_POLICIES_DELETE",
 		51: "PERMISSION_TEMPLATE_POLICIES_ADMIN",
+		52: "PERMISSION_SECRETS_ROTATE",
+		53: "PERMISSION_IMPERSONATE",
+		54: "PERMISSION_RESOURCES_READ",
 	}
 	Permission_value = map[string]int32{
 		"PERMISSION_UNSPECIFIED":                 0,
@@ -276,6 +354,9 @@ var (
 		"PERMISSION_TEMPLATE_POLICIES_WRITE":     49,
 		"PERMISSION_TEMPLATE_POLICIES_DELETE":    50,
 		"PERMISSION_TEMPLATE_POLICIES_ADMIN":     51,
+		"PERMISSION_SECRETS_ROTATE":              52,
+		"PERMISSION_IMPERSONATE":                 53,
+		"PERMISSION_RESOURCES_READ":              54,
 	}
 )
 
@@ -290,11 +371,11 @@ func (x Permission) String() string {
 }
 
 func (Permission) Descriptor() protoreflect.EnumDescriptor {
-	return file_holos_console_v1_rbac_proto_enumTypes[1].Descriptor()
+	return file_holos_console_v1_rbac_proto_enumTypes[2].Descriptor()
 }
 
 func (Permission) Type() protoreflect.EnumType {
-	return &file_holos_console_v1_rbac_proto_enumTypes[1]
+	return &file_holos_console_v1_rbac_proto_enumTypes[2]
 }
 
 func (x Permission) Number() protoreflect.EnumNumber {
@@ -303,20 +384,110 @@ func (x Permission) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Permission.Descriptor instead.
 func (Permission) EnumDescriptor() ([]byte, []int) {
-	return file_holos_console_v1_rbac_proto_rawDescGZIP(), []int{1}
+	return file_holos_console_v1_rbac_proto_rawDescGZIP(), []int{2}
+}
+
+// RoleSource explains where a caller's effective role comes from, so a UI
+// can say "you have Editor via group dev-team on project foo".
+type RoleSource struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// type is how the role was granted.
+	Type RoleSourceType `protobuf:"varint,1,opt,name=type,proto3,enum=holos.console.v1.RoleSourceType" json:"type,omitempty"`
+	// principal is the principal of the grant conferring the role: an email
+	// address, "*@domain", "*", or a group name. Empty for
+	// ROLE_SOURCE_TYPE_PLATFORM.
+	Principal string `protobuf:"bytes,2,opt,name=principal,proto3" json:"principal,omitempty"`
+	// resource_type is the type of the resource holding the grant,
+	// "organization", "folder", "project", or "secret". When it differs from the type of the
+	// resource described, the role cascades from that parent. Empty for
+	// ROLE_SOURCE_TYPE_PLATFORM.
+	ResourceType string `protobuf:"bytes,3,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	// resource_name is the name of the resource holding the grant.
+	ResourceName  string `protobuf:"bytes,4,opt,name=resource_name,json=resourceName,proto3" json:"resource_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoleSource) Reset() {
+	*x = RoleSource{}
+	mi := &file_holos_console_v1_rbac_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoleSource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleSource) ProtoMessage() {}
+
+func (x *RoleSource) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_rbac_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleSource.ProtoReflect.Descriptor instead.
+func (*RoleSource) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_rbac_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RoleSource) GetType() RoleSourceType {
+	if x != nil {
+		return x.Type
+	}
+	return RoleSourceType_ROLE_SOURCE_TYPE_UNSPECIFIED
+}
+
+func (x *RoleSource) GetPrincipal() string {
+	if x != nil {
+		return x.Principal
+	}
+	return ""
+}
+
+func (x *RoleSource) GetResourceType() string {
+	if x != nil {
+		return x.ResourceType
+	}
+	return ""
+}
+
+func (x *RoleSource) GetResourceName() string {
+	if x != nil {
+		return x.ResourceName
+	}
+	return ""
 }
 
 var File_holos_console_v1_rbac_proto protoreflect.FileDescriptor
 
 const file_holos_console_v1_rbac_proto_rawDesc = "" +
 	"\n" +
-	"\x1bholos/console/v1/rbac.proto\x12\x10holos.console.v1*N\n" +
+	"\x1bholos/console/v1/rbac.proto\x12\x10holos.console.v1\"\xaa\x01\n" +
+	"\n" +
+	"RoleSource\x124\n" +
+	"\x04type\x18\x01 \x01(\x0e2 .holos.console.v1.RoleSourceTypeR\x04type\x12\x1c\n" +
+	"\tprincipal\x18\x02 \x01(\tR\tprincipal\x12#\n" +
+	"\rresource_type\x18\x03 \x01(\tR\fresourceType\x12#\n" +
+	"\rresource_name\x18\x04 \x01(\tR\fresourceName*N\n" +
 	"\x04Role\x12\x14\n" +
 	"\x10ROLE_UNSPECIFIED\x10\x00\x12\x0f\n" +
 	"\vROLE_VIEWER\x10\x01\x12\x0f\n" +
 	"\vROLE_EDITOR\x10\x02\x12\x0e\n" +
 	"\n" +
-	"ROLE_OWNER\x10\x03*\x99\f\n" +
+	"ROLE_OWNER\x10\x03*\x94\x01\n" +
+	"\x0eRoleSourceType\x12 \n" +
+	"\x1cROLE_SOURCE_TYPE_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bROLE_SOURCE_TYPE_USER_GRANT\x10\x01\x12 \n" +
+	"\x1cROLE_SOURCE_TYPE_GROUP_GRANT\x10\x02\x12\x1d\n" +
+	"\x19ROLE_SOURCE_TYPE_PLATFORM\x10\x03*\xf3\f\n" +
 	"\n" +
 	"Permission\x12\x1a\n" +
 	"\x16PERMISSION_UNSPECIFIED\x10\x00\x12\x1b\n" +
@@ -365,7 +536,10 @@ const file_holos_console_v1_rbac_proto_rawDesc = "" +
 	"!PERMISSION_TEMPLATE_POLICIES_READ\x100\x12&\n" +
 	"\"PERMISSION_TEMPLATE_POLICIES_WRITE\x101\x12'\n" +
 	"#PERMISSION_TEMPLATE_POLICIES_DELETE\x102\x12&\n" +
-	"\"PERMISSION_TEMPLATE_POLICIES_ADMIN\x103BCZAgithub.com/holos-run/holos-console/gen/holos/console/v1;consolev1b\x06proto3"
+	"\"PERMISSION_TEMPLATE_POLICIES_ADMIN\x103\x12\x1d\n" +
+	"\x19PERMISSION_SECRETS_ROTATE\x104\x12\x1a\n" +
+	"\x16PERMISSION_IMPERSONATE\x105\x12\x1d\n" +
+	"\x19PERMISSION_RESOURCES_READ\x106BCZAgithub.com/holos-run/holos-console/gen/holos/console/v1;consolev1b\x06proto3"
 
 var (
 	file_holos_console_v1_rbac_proto_rawDescOnce sync.Once
@@ -379,17 +553,21 @@ func file_holos_console_v1_rbac_proto_rawDescGZIP() []byte {
 	return file_holos_console_v1_rbac_proto_rawDescData
 }
 
-var file_holos_console_v1_rbac_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_holos_console_v1_rbac_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_holos_console_v1_rbac_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_holos_console_v1_rbac_proto_goTypes = []any{
-	(Role)(0),       // 0: holos.console.v1.Role
-	(Permission)(0), // 1: holos.console.v1.Permission
+	(Role)(0),           // 0: holos.console.v1.Role
+	(RoleSourceType)(0), // 1: holos.console.v1.RoleSourceType
+	(Permission)(0),     // 2: holos.console.v1.Permission
+	(*RoleSource)(nil),  // 3: holos.console.v1.RoleSource
 }
 var file_holos_console_v1_rbac_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	1, // 0: holos.console.v1.RoleSource.type:type_name -> holos.console.v1.RoleSourceType
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_holos_console_v1_rbac_proto_init() }
@@ -402,14 +580,15 @@ func file_holos_console_v1_rbac_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_holos_console_v1_rbac_proto_rawDesc), len(file_holos_console_v1_rbac_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   0,
+			NumEnums:      3,
+			NumMessages:   1,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_holos_console_v1_rbac_proto_goTypes,
 		DependencyIndexes: file_holos_console_v1_rbac_proto_depIdxs,
 		EnumInfos:         file_holos_console_v1_rbac_proto_enumTypes,
+		MessageInfos:      file_holos_console_v1_rbac_proto_msgTypes,
 	}.Build()
 	File_holos_console_v1_rbac_proto = out.File
 	file_holos_console_v1_rbac_proto_goTypes = nil
@@ -0,0 +1,1087 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: holos/console/v1/secret_templates.proto
+
+package consolev1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// SecretTemplateKey describes one data key of secrets created from a
+// template. At most one of generate, default_value and required may be set.
+type SecretTemplateKey struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name is the secret data key.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// description explains what the value is, for example "database host".
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// generate makes the server generate a random value when the caller does
+	// not supply one.
+	Generate *GenerateSpec `protobuf:"bytes,3,opt,name=generate,proto3" json:"generate,omitempty"`
+	// default_value is stored when the caller does not supply a value.
+	DefaultValue string `protobuf:"bytes,4,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"`
+	// required makes the caller supply a value. Keys that are neither
+	// required, generated nor defaulted are left out when not supplied.
+	Required      bool `protobuf:"varint,5,opt,name=required,proto3" json:"required,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SecretTemplateKey) Reset() {
+	*x = SecretTemplateKey{}
+	mi := &file_holos_console_v1_secret_templates_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecretTemplateKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecretTemplateKey) ProtoMessage() {}
+
+func (x *SecretTemplateKey) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_secret_templates_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecretTemplateKey.ProtoReflect.Descriptor instead.
+func (*SecretTemplateKey) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_secret_templates_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SecretTemplateKey) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SecretTemplateKey) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *SecretTemplateKey) GetGenerate() *GenerateSpec {
+	if x != nil {
+		return x.Generate
+	}
+	return nil
+}
+
+func (x *SecretTemplateKey) GetDefaultValue() string {
+	if x != nil {
+		return x.DefaultValue
+	}
+	return ""
+}
+
+func (x *SecretTemplateKey) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+// SecretTemplate is a named blueprint for project secrets.
+type SecretTemplate struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name identifies the template within its scope.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// organization is set for organization templates.
+	Organization string `protobuf:"bytes,2,opt,name=organization,proto3" json:"organization,omitempty"`
+	// project is set for project templates.
+	Project string `protobuf:"bytes,3,opt,name=project,proto3" json:"project,omitempty"`
+	// display_name is a human-readable name for the template.
+	DisplayName string `protobuf:"bytes,4,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	// description explains what secrets created from the template are for.
+	Description string `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	// keys lays out the data keys of secrets created from the template.
+	Keys []*SecretTemplateKey `protobuf:"bytes,6,rep,name=keys,proto3" json:"keys,omitempty"`
+	// default_user_grants are the per-user sharing grants every secret
+	// created from the template starts with.
+	DefaultUserGrants []*ShareGrant `protobuf:"bytes,7,rep,name=default_user_grants,json=defaultUserGrants,proto3" json:"default_user_grants,omitempty"`
+	// default_role_grants are the per-role sharing grants every secret
+	// created from the template starts with.
+	DefaultRoleGrants []*ShareGrant `protobuf:"bytes,8,rep,name=default_role_grants,json=defaultRoleGrants,proto3" json:"default_role_grants,omitempty"`
+	// creator_email is the email address of the user who created the
+	// template. Output only.
+	CreatorEmail string `protobuf:"bytes,9,opt,name=creator_email,json=creatorEmail,proto3" json:"creator_email,omitempty"`
+	// created_at is when the template was created. Output only.
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SecretTemplate) Reset() {
+	*x = SecretTemplate{}
+	mi := &file_holos_console_v1_secret_templates_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecretTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecretTemplate) ProtoMessage() {}
+
+func (x *SecretTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_secret_templates_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecretTemplate.ProtoReflect.Descriptor instead.
+func (*SecretTemplate) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_secret_templates_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SecretTemplate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SecretTemplate) GetOrganization() string {
+	if x != nil {
+		return x.Organization
+	}
+	return ""
+}
+
+func (x *SecretTemplate) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *SecretTemplate) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *SecretTemplate) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *SecretTemplate) GetKeys() []*SecretTemplateKey {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+func (x *SecretTemplate) GetDefaultUserGrants() []*ShareGrant {
+	if x != nil {
+		return x.DefaultUserGrants
+	}
+	return nil
+}
+
+func (x *SecretTemplate) GetDefaultRoleGrants() []*ShareGrant {
+	if x != nil {
+		return x.DefaultRoleGrants
+	}
+	return nil
+}
+
+func (x *SecretTemplate) GetCreatorEmail() string {
+	if x != nil {
+		return x.CreatorEmail
+	}
+	return ""
+}
+
+func (x *SecretTemplate) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// ListSecretTemplatesRequest selects a scope. Set exactly one of
+// organization or project.
+type ListSecretTemplatesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// organization lists the templates of an organization.
+	Organization string `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty"`
+	// project lists the templates of a project and its organization.
+	Project       string `protobuf:"bytes,2,opt,name=project,proto3" json:"project,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSecretTemplatesRequest) Reset() {
+	*x = ListSecretTemplatesRequest{}
+	mi := &file_holos_console_v1_secret_templates_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSecretTemplatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSecretTemplatesRequest) ProtoMessage() {}
+
+func (x *ListSecretTemplatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_secret_templates_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSecretTemplatesRequest.ProtoReflect.Descriptor instead.
+func (*ListSecretTemplatesRequest) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_secret_templates_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListSecretTemplatesRequest) GetOrganization() string {
+	if x != nil {
+		return x.Organization
+	}
+	return ""
+}
+
+func (x *ListSecretTemplatesRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+// ListSecretTemplatesResponse contains the templates of a scope.
+type ListSecretTemplatesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// templates are sorted by name, project templates first.
+	Templates     []*SecretTemplate `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSecretTemplatesResponse) Reset() {
+	*x = ListSecretTemplatesResponse{}
+	mi := &file_holos_console_v1_secret_templates_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSecretTemplatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSecretTemplatesResponse) ProtoMessage() {}
+
+func (x *ListSecretTemplatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_secret_templates_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSecretTemplatesResponse.ProtoReflect.Descriptor instead.
+func (*ListSecretTemplatesResponse) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_secret_templates_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListSecretTemplatesResponse) GetTemplates() []*SecretTemplate {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+// GetSecretTemplateRequest names a template. Set exactly one of
+// organization or project.
+type GetSecretTemplateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// organization is the scope of an organization template.
+	Organization string `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty"`
+	// project is the scope of a project template.
+	Project string `protobuf:"bytes,2,opt,name=project,proto3" json:"project,omitempty"`
+	// name is the template name.
+	Name          string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSecretTemplateRequest) Reset() {
+	*x = GetSecretTemplateRequest{}
+	mi := &file_holos_console_v1_secret_templates_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSecretTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSecretTemplateRequest) ProtoMessage() {}
+
+func (x *GetSecretTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_secret_templates_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSecretTemplateRequest.ProtoReflect.Descriptor instead.
+func (*GetSecretTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_secret_templates_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetSecretTemplateRequest) GetOrganization() string {
+	if x != nil {
+		return x.Organization
+	}
+	return ""
+}
+
+func (x *GetSecretTemplateRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *GetSecretTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// GetSecretTemplateResponse contains the requested template.
+type GetSecretTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *SecretTemplate        `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSecretTemplateResponse) Reset() {
+	*x = GetSecretTemplateResponse{}
+	mi := &file_holos_console_v1_secret_templates_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSecretTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSecretTemplateResponse) ProtoMessage() {}
+
+func (x *GetSecretTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_secret_templates_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSecretTemplateResponse.ProtoReflect.Descriptor instead.
+func (*GetSecretTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_secret_templates_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetSecretTemplateResponse) GetTemplate() *SecretTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+// CreateSecretTemplateRequest contains the template to create. Set exactly
+// one of template.organization or template.project.
+type CreateSecretTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *SecretTemplate        `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSecretTemplateRequest) Reset() {
+	*x = CreateSecretTemplateRequest{}
+	mi := &file_holos_console_v1_secret_templates_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSecretTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSecretTemplateRequest) ProtoMessage() {}
+
+func (x *CreateSecretTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_secret_templates_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSecretTemplateRequest.ProtoReflect.Descriptor instead.
+func (*CreateSecretTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_secret_templates_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreateSecretTemplateRequest) GetTemplate() *SecretTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+// CreateSecretTemplateResponse contains the created template.
+type CreateSecretTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *SecretTemplate        `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSecretTemplateResponse) Reset() {
+	*x = CreateSecretTemplateResponse{}
+	mi := &file_holos_console_v1_secret_templates_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSecretTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSecretTemplateResponse) ProtoMessage() {}
+
+func (x *CreateSecretTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_secret_templates_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSecretTemplateResponse.ProtoReflect.Descriptor instead.
+func (*CreateSecretTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_secret_templates_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CreateSecretTemplateResponse) GetTemplate() *SecretTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+// UpdateSecretTemplateRequest contains the new definition of an existing
+// template, identified by its scope and name.
+type UpdateSecretTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *SecretTemplate        `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSecretTemplateRequest) Reset() {
+	*x = UpdateSecretTemplateRequest{}
+	mi := &file_holos_console_v1_secret_templates_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSecretTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSecretTemplateRequest) ProtoMessage() {}
+
+func (x *UpdateSecretTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_secret_templates_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSecretTemplateRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSecretTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_secret_templates_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdateSecretTemplateRequest) GetTemplate() *SecretTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+// UpdateSecretTemplateResponse contains the updated template.
+type UpdateSecretTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *SecretTemplate        `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSecretTemplateResponse) Reset() {
+	*x = UpdateSecretTemplateResponse{}
+	mi := &file_holos_console_v1_secret_templates_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSecretTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSecretTemplateResponse) ProtoMessage() {}
+
+func (x *UpdateSecretTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_secret_templates_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSecretTemplateResponse.ProtoReflect.Descriptor instead.
+func (*UpdateSecretTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_secret_templates_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdateSecretTemplateResponse) GetTemplate() *SecretTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+// DeleteSecretTemplateRequest names the template to delete. Set exactly one
+// of organization or project.
+type DeleteSecretTemplateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// organization is the scope of an organization template.
+	Organization string `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty"`
+	// project is the scope of a project template.
+	Project string `protobuf:"bytes,2,opt,name=project,proto3" json:"project,omitempty"`
+	// name is the template name.
+	Name          string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSecretTemplateRequest) Reset() {
+	*x = DeleteSecretTemplateRequest{}
+	mi := &file_holos_console_v1_secret_templates_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSecretTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSecretTemplateRequest) ProtoMessage() {}
+
+func (x *DeleteSecretTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_secret_templates_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSecretTemplateRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSecretTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_secret_templates_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DeleteSecretTemplateRequest) GetOrganization() string {
+	if x != nil {
+		return x.Organization
+	}
+	return ""
+}
+
+func (x *DeleteSecretTemplateRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *DeleteSecretTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// DeleteSecretTemplateResponse is empty.
+type DeleteSecretTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSecretTemplateResponse) Reset() {
+	*x = DeleteSecretTemplateResponse{}
+	mi := &file_holos_console_v1_secret_templates_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSecretTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSecretTemplateResponse) ProtoMessage() {}
+
+func (x *DeleteSecretTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_secret_templates_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSecretTemplateResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSecretTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_secret_templates_proto_rawDescGZIP(), []int{11}
+}
+
+// CreateSecretFromTemplateRequest creates a secret from a template visible
+// to the project.
+type CreateSecretFromTemplateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// project is the project to create the secret in.
+	Project string `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	// template names a template of the project or, when the project has none
+	// by that name, of its organization.
+	Template string `protobuf:"bytes,2,opt,name=template,proto3" json:"template,omitempty"`
+	// name is the name of the secret to create.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// string_data supplies values for template keys. Keys the template does
+	// not define are rejected.
+	StringData map[string]string `protobuf:"bytes,4,rep,name=string_data,json=stringData,proto3" json:"string_data,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// description is a human-readable description of the secret's purpose.
+	// Defaults to the template description.
+	Description *string `protobuf:"bytes,5,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	// url is a URL associated with the secret.
+	Url *string `protobuf:"bytes,6,opt,name=url,proto3,oneof" json:"url,omitempty"`
+	// user_grants are added to the template's default user grants.
+	UserGrants []*ShareGrant `protobuf:"bytes,7,rep,name=user_grants,json=userGrants,proto3" json:"user_grants,omitempty"`
+	// role_grants are added to the template's default role grants.
+	RoleGrants []*ShareGrant `protobuf:"bytes,8,rep,name=role_grants,json=roleGrants,proto3" json:"role_grants,omitempty"`
+	// dry_run validates the request and the secret without creating it.
+	DryRun        bool `protobuf:"varint,9,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSecretFromTemplateRequest) Reset() {
+	*x = CreateSecretFromTemplateRequest{}
+	mi := &file_holos_console_v1_secret_templates_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSecretFromTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSecretFromTemplateRequest) ProtoMessage() {}
+
+func (x *CreateSecretFromTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_secret_templates_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSecretFromTemplateRequest.ProtoReflect.Descriptor instead.
+func (*CreateSecretFromTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_secret_templates_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CreateSecretFromTemplateRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *CreateSecretFromTemplateRequest) GetTemplate() string {
+	if x != nil {
+		return x.Template
+	}
+	return ""
+}
+
+func (x *CreateSecretFromTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateSecretFromTemplateRequest) GetStringData() map[string]string {
+	if x != nil {
+		return x.StringData
+	}
+	return nil
+}
+
+func (x *CreateSecretFromTemplateRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *CreateSecretFromTemplateRequest) GetUrl() string {
+	if x != nil && x.Url != nil {
+		return *x.Url
+	}
+	return ""
+}
+
+func (x *CreateSecretFromTemplateRequest) GetUserGrants() []*ShareGrant {
+	if x != nil {
+		return x.UserGrants
+	}
+	return nil
+}
+
+func (x *CreateSecretFromTemplateRequest) GetRoleGrants() []*ShareGrant {
+	if x != nil {
+		return x.RoleGrants
+	}
+	return nil
+}
+
+func (x *CreateSecretFromTemplateRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+// CreateSecretFromTemplateResponse contains the created secret.
+type CreateSecretFromTemplateResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name is the name of the created secret.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// generated_values holds the values generated for template keys. They
+	// are returned only once, in this response.
+	GeneratedValues map[string]string `protobuf:"bytes,2,rep,name=generated_values,json=generatedValues,proto3" json:"generated_values,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateSecretFromTemplateResponse) Reset() {
+	*x = CreateSecretFromTemplateResponse{}
+	mi := &file_holos_console_v1_secret_templates_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSecretFromTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSecretFromTemplateResponse) ProtoMessage() {}
+
+func (x *CreateSecretFromTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_secret_templates_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSecretFromTemplateResponse.ProtoReflect.Descriptor instead.
+func (*CreateSecretFromTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_secret_templates_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CreateSecretFromTemplateResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateSecretFromTemplateResponse) GetGeneratedValues() map[string]string {
+	if x != nil {
+		return x.GeneratedValues
+	}
+	return nil
+}
+
+var File_holos_console_v1_secret_templates_proto protoreflect.FileDescriptor
+
+const file_holos_console_v1_secret_templates_proto_rawDesc = "" +
+	"\n" +
+	"'holos/console/v1/secret_templates.proto\x12\x10holos.console.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1eholos/console/v1/secrets.proto\"\xc6\x01\n" +
+	"\x11SecretTemplateKey\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12:\n" +
+	"\bgenerate\x18\x03 \x01(\v2\x1e.holos.console.v1.GenerateSpecR\bgenerate\x12#\n" +
+	"\rdefault_value\x18\x04 \x01(\tR\fdefaultValue\x12\x1a\n" +
+	"\brequired\x18\x05 \x01(\bR\brequired\"\xdc\x03\n" +
+	"\x0eSecretTemplate\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\"\n" +
+	"\forganization\x18\x02 \x01(\tR\forganization\x12\x18\n" +
+	"\aproject\x18\x03 \x01(\tR\aproject\x12!\n" +
+	"\fdisplay_name\x18\x04 \x01(\tR\vdisplayName\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x127\n" +
+	"\x04keys\x18\x06 \x03(\v2#.holos.console.v1.SecretTemplateKeyR\x04keys\x12L\n" +
+	"\x13default_user_grants\x18\a \x03(\v2\x1c.holos.console.v1.ShareGrantR\x11defaultUserGrants\x12L\n" +
+	"\x13default_role_grants\x18\b \x03(\v2\x1c.holos.console.v1.ShareGrantR\x11defaultRoleGrants\x12#\n" +
+	"\rcreator_email\x18\t \x01(\tR\fcreatorEmail\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"Z\n" +
+	"\x1aListSecretTemplatesRequest\x12\"\n" +
+	"\forganization\x18\x01 \x01(\tR\forganization\x12\x18\n" +
+	"\aproject\x18\x02 \x01(\tR\aproject\"]\n" +
+	"\x1bListSecretTemplatesResponse\x12>\n" +
+	"\ttemplates\x18\x01 \x03(\v2 .holos.console.v1.SecretTemplateR\ttemplates\"l\n" +
+	"\x18GetSecretTemplateRequest\x12\"\n" +
+	"\forganization\x18\x01 \x01(\tR\forganization\x12\x18\n" +
+	"\aproject\x18\x02 \x01(\tR\aproject\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\"Y\n" +
+	"\x19GetSecretTemplateResponse\x12<\n" +
+	"\btemplate\x18\x01 \x01(\v2 .holos.console.v1.SecretTemplateR\btemplate\"[\n" +
+	"\x1bCreateSecretTemplateRequest\x12<\n" +
+	"\btemplate\x18\x01 \x01(\v2 .holos.console.v1.SecretTemplateR\btemplate\"\\\n" +
+	"\x1cCreateSecretTemplateResponse\x12<\n" +
+	"\btemplate\x18\x01 \x01(\v2 .holos.console.v1.SecretTemplateR\btemplate\"[\n" +
+	"\x1bUpdateSecretTemplateRequest\x12<\n" +
+	"\btemplate\x18\x01 \x01(\v2 .holos.console.v1.SecretTemplateR\btemplate\"\\\n" +
+	"\x1cUpdateSecretTemplateResponse\x12<\n" +
+	"\btemplate\x18\x01 \x01(\v2 .holos.console.v1.SecretTemplateR\btemplate\"o\n" +
+	"\x1bDeleteSecretTemplateRequest\x12\"\n" +
+	"\forganization\x18\x01 \x01(\tR\forganization\x12\x18\n" +
+	"\aproject\x18\x02 \x01(\tR\aproject\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\"\x1e\n" +
+	"\x1cDeleteSecretTemplateResponse\"\xfb\x03\n" +
+	"\x1fCreateSecretFromTemplateRequest\x12\x18\n" +
+	"\aproject\x18\x01 \x01(\tR\aproject\x12\x1a\n" +
+	"\btemplate\x18\x02 \x01(\tR\btemplate\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12b\n" +
+	"\vstring_data\x18\x04 \x03(\v2A.holos.console.v1.CreateSecretFromTemplateRequest.StringDataEntryR\n" +
+	"stringData\x12%\n" +
+	"\vdescription\x18\x05 \x01(\tH\x00R\vdescription\x88\x01\x01\x12\x15\n" +
+	"\x03url\x18\x06 \x01(\tH\x01R\x03url\x88\x01\x01\x12=\n" +
+	"\vuser_grants\x18\a \x03(\v2\x1c.holos.console.v1.ShareGrantR\n" +
+	"userGrants\x12=\n" +
+	"\vrole_grants\x18\b \x03(\v2\x1c.holos.console.v1.ShareGrantR\n" +
+	"roleGrants\x12\x17\n" +
+	"\adry_run\x18\t \x01(\bR\x06dryRun\x1a=\n" +
+	"\x0fStringDataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\x0e\n" +
+	"\f_descriptionB\x06\n" +
+	"\x04_url\"\xee\x01\n" +
+	" CreateSecretFromTemplateResponse\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12r\n" +
+	"\x10generated_values\x18\x02 \x03(\v2G.holos.console.v1.CreateSecretFromTemplateResponse.GeneratedValuesEntryR\x0fgeneratedValues\x1aB\n" +
+	"\x14GeneratedValuesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x012\xed\x05\n" +
+	"\x16SecretTemplatesService\x12w\n" +
+	"\x13ListSecretTemplates\x12,.holos.console.v1.ListSecretTemplatesRequest\x1a-.holos.console.v1.ListSecretTemplatesResponse\"\x03\x90\x02\x01\x12q\n" +
+	"\x11GetSecretTemplate\x12*.holos.console.v1.GetSecretTemplateRequest\x1a+.holos.console.v1.GetSecretTemplateResponse\"\x03\x90\x02\x01\x12u\n" +
+	"\x14CreateSecretTemplate\x12-.holos.console.v1.CreateSecretTemplateRequest\x1a..holos.console.v1.CreateSecretTemplateResponse\x12u\n" +
+	"\x14UpdateSecretTemplate\x12-.holos.console.v1.UpdateSecretTemplateRequest\x1a..holos.console.v1.UpdateSecretTemplateResponse\x12u\n" +
+	"\x14DeleteSecretTemplate\x12-.holos.console.v1.DeleteSecretTemplateRequest\x1a..holos.console.v1.DeleteSecretTemplateResponse\x12\x81\x01\n" +
+	"\x18CreateSecretFromTemplate\x121.holos.console.v1.CreateSecretFromTemplateRequest\x1a2.holos.console.v1.CreateSecretFromTemplateResponseBCZAgithub.com/holos-run/holos-console/gen/holos/console/v1;consolev1b\x06proto3"
+
+var (
+	file_holos_console_v1_secret_templates_proto_rawDescOnce sync.Once
+	file_holos_console_v1_secret_templates_proto_rawDescData []byte
+)
+
+func file_holos_console_v1_secret_templates_proto_rawDescGZIP() []byte {
+	file_holos_console_v1_secret_templates_proto_rawDescOnce.Do(func() {
+		file_holos_console_v1_secret_templates_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_holos_console_v1_secret_templates_proto_rawDesc), len(file_holos_console_v1_secret_templates_proto_rawDesc)))
+	})
+	return file_holos_console_v1_secret_templates_proto_rawDescData
+}
+
+var file_holos_console_v1_secret_templates_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_holos_console_v1_secret_templates_proto_goTypes = []any{
+	(*SecretTemplateKey)(nil),                // 0: holos.console.v1.SecretTemplateKey
+	(*SecretTemplate)(nil),                   // 1: holos.console.v1.SecretTemplate
+	(*ListSecretTemplatesRequest)(nil),       // 2: holos.console.v1.ListSecretTemplatesRequest
+	(*ListSecretTemplatesResponse)(nil),      // 3: holos.console.v1.ListSecretTemplatesResponse
+	(*GetSecretTemplateRequest)(nil),         // 4: holos.console.v1.GetSecretTemplateRequest
+	(*GetSecretTemplateResponse)(nil),        // 5: holos.console.v1.GetSecretTemplateResponse
+	(*CreateSecretTemplateRequest)(nil),      // 6: holos.console.v1.CreateSecretTemplateRequest
+	(*CreateSecretTemplateResponse)(nil),     // 7: holos.console.v1.CreateSecretTemplateResponse
+	(*UpdateSecretTemplateRequest)(nil),      // 8: holos.console.v1.UpdateSecretTemplateRequest
+	(*UpdateSecretTemplateResponse)(nil),     // 9: holos.console.v1.UpdateSecretTemplateResponse
+	(*DeleteSecretTemplateRequest)(nil),      // 10: holos.console.v1.DeleteSecretTemplateRequest
+	(*DeleteSecretTemplateResponse)(nil),     // 11: holos.console.v1.DeleteSecretTemplateResponse
+	(*CreateSecretFromTemplateRequest)(nil),  // 12: holos.console.v1.CreateSecretFromTemplateRequest
+	(*CreateSecretFromTemplateResponse)(nil), // 13: holos.console.v1.CreateSecretFromTemplateResponse
+	nil,                                      // 14: holos.console.v1.CreateSecretFromTemplateRequest.StringDataEntry
+	nil,                                      // 15: holos.console.v1.CreateSecretFromTemplateResponse.GeneratedValuesEntry
+	(*GenerateSpec)(nil),                     // 16: holos.console.v1.GenerateSpec
+	(*ShareGrant)(nil),                       // 17: holos.console.v1.ShareGrant
+	(*timestamppb.Timestamp)(nil),            // 18: google.protobuf.Timestamp
+}
+var file_holos_console_v1_secret_templates_proto_depIdxs = []int32{
+	16, // 0: holos.console.v1.SecretTemplateKey.generate:type_name -> holos.console.v1.GenerateSpec
+	0,  // 1: holos.console.v1.SecretTemplate.keys:type_name -> holos.console.v1.SecretTemplateKey
+	17, // 2: holos.console.v1.SecretTemplate.default_user_grants:type_name -> holos.console.v1.ShareGrant
+	17, // 3: holos.console.v1.SecretTemplate.default_role_grants:type_name -> holos.console.v1.ShareGrant
+	18, // 4: holos.console.v1.SecretTemplate.created_at:type_name -> google.protobuf.Timestamp
+	1,  // 5: holos.console.v1.ListSecretTemplatesResponse.templates:type_name -> holos.console.v1.SecretTemplate
+	1,  // 6: holos.console.v1.GetSecretTemplateResponse.template:type_name -> holos.console.v1.SecretTemplate
+	1,  // 7: holos.console.v1.CreateSecretTemplateRequest.template:type_name -> holos.console.v1.SecretTemplate
+	1,  // 8: holos.console.v1.CreateSecretTemplateResponse.template:type_name -> holos.console.v1.SecretTemplate
+	1,  // 9: holos.console.v1.UpdateSecretTemplateRequest.template:type_name -> holos.console.v1.SecretTemplate
+	1,  // 10: holos.console.v1.UpdateSecretTemplateResponse.template:type_name -> holos.console.v1.SecretTemplate
+	14, // 11: holos.console.v1.CreateSecretFromTemplateRequest.string_data:type_name -> holos.console.v1.CreateSecretFromTemplateRequest.StringDataEntry
+	17, // 12: holos.console.v1.CreateSecretFromTemplateRequest.user_grants:type_name -> holos.console.v1.ShareGrant
+	17, // 13: holos.console.v1.CreateSecretFromTemplateRequest.role_grants:type_name -> holos.console.v1.ShareGrant
+	15, // 14: holos.console.v1.CreateSecretFromTemplateResponse.generated_values:type_name -> holos.console.v1.CreateSecretFromTemplateResponse.GeneratedValuesEntry
+	2,  // 15: holos.console.v1.SecretTemplatesService.ListSecretTemplates:input_type -> holos.console.v1.ListSecretTemplatesRequest
+	4,  // 16: holos.console.v1.SecretTemplatesService.GetSecretTemplate:input_type -> holos.console.v1.GetSecretTemplateRequest
+	6,  // 17: holos.console.v1.SecretTemplatesService.CreateSecretTemplate:input_type -> holos.console.v1.CreateSecretTemplateRequest
+	8,  // 18: holos.console.v1.SecretTemplatesService.UpdateSecretTemplate:input_type -> holos.console.v1.UpdateSecretTemplateRequest
+	10, // 19: holos.console.v1.SecretTemplatesService.DeleteSecretTemplate:input_type -> holos.console.v1.DeleteSecretTemplateRequest
+	12, // 20: holos.console.v1.SecretTemplatesService.CreateSecretFromTemplate:input_type -> holos.console.v1.CreateSecretFromTemplateRequest
+	3,  // 21: holos.console.v1.SecretTemplatesService.ListSecretTemplates:output_type -> holos.console.v1.ListSecretTemplatesResponse
+	5,  // 22: holos.console.v1.SecretTemplatesService.GetSecretTemplate:output_type -> holos.console.v1.GetSecretTemplateResponse
+	7,  // 23: holos.console.v1.SecretTemplatesService.CreateSecretTemplate:output_type -> holos.console.v1.CreateSecretTemplateResponse
+	9,  // 24: holos.console.v1.SecretTemplatesService.UpdateSecretTemplate:output_type -> holos.console.v1.UpdateSecretTemplateResponse
+	11, // 25: holos.console.v1.SecretTemplatesService.DeleteSecretTemplate:output_type -> holos.console.v1.DeleteSecretTemplateResponse
+	13, // 26: holos.console.v1.SecretTemplatesService.CreateSecretFromTemplate:output_type -> holos.console.v1.CreateSecretFromTemplateResponse
+	21, // [21:27] is the sub-list for method output_type
+	15, // [15:21] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
+}
+
+func init() { file_holos_console_v1_secret_templates_proto_init() }
+func file_holos_console_v1_secret_templates_proto_init() {
+	if File_holos_console_v1_secret_templates_proto != nil {
+		return
+	}
+	file_holos_console_v1_secrets_proto_init()
+	file_holos_console_v1_secret_templates_proto_msgTypes[12].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_holos_console_v1_secret_templates_proto_rawDesc), len(file_holos_console_v1_secret_templates_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   16,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_holos_console_v1_secret_templates_proto_goTypes,
+		DependencyIndexes: file_holos_console_v1_secret_templates_proto_depIdxs,
+		MessageInfos:      file_holos_console_v1_secret_templates_proto_msgTypes,
+	}.Build()
+	File_holos_console_v1_secret_templates_proto = out.File
+	file_holos_console_v1_secret_templates_proto_goTypes = nil
+	file_holos_console_v1_secret_templates_proto_depIdxs = nil
+}
@@ -7,6 +7,7 @@
 package consolev1
 
 import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -21,13 +22,82 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// GenerateFormat selects how a generated secret value is encoded.
+type GenerateFormat int32
+
+const (
+	// GENERATE_FORMAT_UNSPECIFIED defaults to GENERATE_FORMAT_PASSWORD.
+	GenerateFormat_GENERATE_FORMAT_UNSPECIFIED GenerateFormat = 0
+	// GENERATE_FORMAT_PASSWORD draws length characters from the charset.
+	GenerateFormat_GENERATE_FORMAT_PASSWORD GenerateFormat = 1
+	// GENERATE_FORMAT_HEX hex-encodes length random bytes.
+	GenerateFormat_GENERATE_FORMAT_HEX GenerateFormat = 2
+	// GENERATE_FORMAT_BASE64 standard base64-encodes length random bytes.
+	GenerateFormat_GENERATE_FORMAT_BASE64 GenerateFormat = 3
+	// GENERATE_FORMAT_UUID produces a random (version 4) UUID. length and
+	// charset are ignored.
+	GenerateFormat_GENERATE_FORMAT_UUID GenerateFormat = 4
+)
+
+// Enum value maps for GenerateFormat.
+var (
+	GenerateFormat_name = map[int32]string{
+		0: "GENERATE_FORMAT_UNSPECIFIED",
+		1: "GENERATE_FORMAT_PASSWORD",
+		2: "GENERATE_FORMAT_HEX",
+		3: "GENERATE_FORMAT_BASE64",
+		4: "GENERATE_FORMAT_UUID",
+	}
+	GenerateFormat_value = map[string]int32{
+		"GENERATE_FORMAT_UNSPECIFIED": 0,
+		"GENERATE_FORMAT_PASSWORD":    1,
+		"GENERATE_FORMAT_HEX":         2,
+		"GENERATE_FORMAT_BASE64":      3,
+		"GENERATE_FORMAT_UUID":        4,
+	}
+)
+
+func (x GenerateFormat) Enum() *GenerateFormat {
+	p := new(GenerateFormat)
+	*p = x
+	return p
+}
+
+func (x GenerateFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GenerateFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_holos_console_v1_secrets_proto_enumTypes[0].Descriptor()
+}
+
+func (GenerateFormat) Type() protoreflect.EnumType {
+	return &file_holos_console_v1_secrets_proto_enumTypes[0]
+}
+
+func (x GenerateFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GenerateFormat.Descriptor instead.
+func (GenerateFormat) EnumDescriptor() ([]byte, []int) {
+	return file_holos_console_v1_secrets_proto_rawDescGZIP(), []int{0}
+}
+
 // GetSecretRequest contains the name of the secret to retrieve.
 type GetSecretRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// name is the name of the secret to retrieve.
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	// project is the project (namespace) containing the secret.
-	Project       string `protobuf:"bytes,2,opt,name=project,proto3" json:"project,omitempty"`
+	Project string `protobuf:"bytes,2,opt,name=project,proto3" json:"project,omitempty"`
+	// cluster names the registered cluster to act in. Empty targets the
+	// cluster the console runs in.
+	Cluster string `protobuf:"bytes,3,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	// if_none_match is the etag of a previous response. When the secret is
+	// unchanged the response carries only etag and not_modified. The
+	// If-None-Match header is ignored, since browsers send it on their own.
+	IfNoneMatch   string `protobuf:"bytes,4,opt,name=if_none_match,json=ifNoneMatch,proto3" json:"if_none_match,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -76,12 +146,32 @@ func (x *GetSecretRequest) GetProject() string {
 	return ""
 }
 
+func (x *GetSecretRequest) GetCluster() string {
+	if x != nil {
+		return x.Cluster
+	}
+	return ""
+}
+
+func (x *GetSecretRequest) GetIfNoneMatch() string {
+	if x != nil {
+		return x.IfNoneMatch
+	}
+	return ""
+}
+
 // GetSecretResponse contains the secret data.
 type GetSecretResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// data contains the secret key-value pairs.
 	// Values are the raw secret bytes (not base64 encoded).
-	Data          map[string][]byte `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Data map[string][]byte `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// etag identifies this version of the response for if_none_match. It is
+	// derived from the secret's resource version, never its values, and is
+	// empty for secrets whose values live in an external backend.
+	Etag string `protobuf:"bytes,2,opt,name=etag,proto3" json:"etag,omitempty"`
+	// not_modified is true when if_none_match matched and data is omitted.
+	NotModified   bool `protobuf:"varint,3,opt,name=not_modified,json=notModified,proto3" json:"not_modified,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -123,11 +213,36 @@ func (x *GetSecretResponse) GetData() map[string][]byte {
 	return nil
 }
 
+func (x *GetSecretResponse) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+func (x *GetSecretResponse) GetNotModified() bool {
+	if x != nil {
+		return x.NotModified
+	}
+	return false
+}
+
 // ListSecretsRequest contains optional filters for listing secrets.
 type ListSecretsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// project is the project (namespace) to list secrets from.
-	Project       string `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	Project string `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	// cluster names the registered cluster to act in. Empty targets the
+	// cluster the console runs in.
+	Cluster string `protobuf:"bytes,2,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	// filter narrows the returned secrets. Labels are the Secret's labels.
+	Filter *ListFilter `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	// order_by sorts the returned secrets.
+	OrderBy *ListOrder `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	// if_none_match is the etag of a previous response. When the listing is
+	// unchanged the response carries only etag and not_modified. The
+	// If-None-Match header is ignored, since browsers send it on their own.
+	IfNoneMatch   string `protobuf:"bytes,5,opt,name=if_none_match,json=ifNoneMatch,proto3" json:"if_none_match,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -169,11 +284,46 @@ func (x *ListSecretsRequest) GetProject() string {
 	return ""
 }
 
+func (x *ListSecretsRequest) GetCluster() string {
+	if x != nil {
+		return x.Cluster
+	}
+	return ""
+}
+
+func (x *ListSecretsRequest) GetFilter() *ListFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListSecretsRequest) GetOrderBy() *ListOrder {
+	if x != nil {
+		return x.OrderBy
+	}
+	return nil
+}
+
+func (x *ListSecretsRequest) GetIfNoneMatch() string {
+	if x != nil {
+		return x.IfNoneMatch
+	}
+	return ""
+}
+
 // ListSecretsResponse contains the list of secrets in the namespace.
 type ListSecretsResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// secrets contains metadata about each secret.
-	Secrets       []*SecretMetadata `protobuf:"bytes,1,rep,name=secrets,proto3" json:"secrets,omitempty"`
+	Secrets []*SecretMetadata `protobuf:"bytes,1,rep,name=secrets,proto3" json:"secrets,omitempty"`
+	// etag identifies this listing for if_none_match. It is derived from the
+	// caller, the request, the secrets' resource versions, and the project's
+	// sharing grants, and changes at least once a minute so other changes,
+	// such as a pending grant's user signing in, are picked up.
+	Etag string `protobuf:"bytes,2,opt,name=etag,proto3" json:"etag,omitempty"`
+	// not_modified is true when if_none_match matched and secrets is omitted.
+	NotModified   bool `protobuf:"varint,3,opt,name=not_modified,json=notModified,proto3" json:"not_modified,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -215,6 +365,20 @@ func (x *ListSecretsResponse) GetSecrets() []*SecretMetadata {
 	return nil
 }
 
+func (x *ListSecretsResponse) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+func (x *ListSecretsResponse) GetNotModified() bool {
+	if x != nil {
+		return x.NotModified
+	}
+	return false
+}
+
 // UpdateSecretRequest contains the name and replacement data for a secret.
 type UpdateSecretRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -235,7 +399,17 @@ type UpdateSecretRequest struct {
 	// When set, updates the URL annotation. When unset, preserves the existing value.
 	Url *string `protobuf:"bytes,5,opt,name=url,proto3,oneof" json:"url,omitempty"`
 	// project is the project (namespace) containing the secret.
-	Project       string `protobuf:"bytes,6,opt,name=project,proto3" json:"project,omitempty"`
+	Project string `protobuf:"bytes,6,opt,name=project,proto3" json:"project,omitempty"`
+	// dry_run runs validation and authorization, and submits every Kubernetes
+	// write with server-side dry-run, without persisting any change.
+	DryRun bool `protobuf:"varint,7,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// cluster names the registered cluster to act in. Empty targets the
+	// cluster the console runs in.
+	Cluster string `protobuf:"bytes,8,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	// content_types replaces the media types recorded for the secret's keys.
+	// When empty, the recorded types of keys that remain in data are kept.
+	// Every key must be set by data or string_data.
+	ContentTypes  map[string]string `protobuf:"bytes,9,rep,name=content_types,json=contentTypes,proto3" json:"content_types,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -312,6 +486,27 @@ func (x *UpdateSecretRequest) GetProject() string {
 	return ""
 }
 
+func (x *UpdateSecretRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *UpdateSecretRequest) GetCluster() string {
+	if x != nil {
+		return x.Cluster
+	}
+	return ""
+}
+
+func (x *UpdateSecretRequest) GetContentTypes() map[string]string {
+	if x != nil {
+		return x.ContentTypes
+	}
+	return nil
+}
+
 // UpdateSecretResponse is empty on success.
 type UpdateSecretResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -349,47 +544,51 @@ func (*UpdateSecretResponse) Descriptor() ([]byte, []int) {
 	return file_holos_console_v1_secrets_proto_rawDescGZIP(), []int{5}
 }
 
-// CreateSecretRequest contains the new secret's name, data, and sharing grants.
-type CreateSecretRequest struct {
+// PatchSecretRequest names the keys to set and remove on an existing secret.
+type PatchSecretRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// name is the name of the secret to create.
+	// name is the name of the secret to patch.
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// data contains the secret key-value pairs.
-	// Values are the raw secret bytes (not base64 encoded).
-	Data map[string][]byte `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	// string_data contains plaintext string values that are merged into data.
-	// Values are encoded to bytes before storage, matching Kubernetes stringData
-	// semantics. When both data and string_data contain the same key, string_data
-	// takes precedence.
-	StringData map[string]string `protobuf:"bytes,3,rep,name=string_data,json=stringData,proto3" json:"string_data,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	// user_grants are the per-user sharing grants to set on the created secret.
-	UserGrants []*ShareGrant `protobuf:"bytes,4,rep,name=user_grants,json=userGrants,proto3" json:"user_grants,omitempty"`
-	// role_grants are the per-role sharing grants to set on the created secret.
-	RoleGrants []*ShareGrant `protobuf:"bytes,5,rep,name=role_grants,json=roleGrants,proto3" json:"role_grants,omitempty"`
-	// description is a human-readable description of the secret's purpose.
-	Description *string `protobuf:"bytes,6,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	// url is a URL associated with the secret (e.g. link to the service that uses it).
-	Url *string `protobuf:"bytes,7,opt,name=url,proto3,oneof" json:"url,omitempty"`
 	// project is the project (namespace) containing the secret.
-	Project       string `protobuf:"bytes,8,opt,name=project,proto3" json:"project,omitempty"`
+	Project string `protobuf:"bytes,2,opt,name=project,proto3" json:"project,omitempty"`
+	// data contains keys to add or replace.
+	// Values are the raw secret bytes (not base64 encoded).
+	Data map[string][]byte `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// string_data contains plaintext string values to add or replace. When both
+	// data and string_data contain the same key, string_data takes precedence.
+	StringData map[string]string `protobuf:"bytes,4,rep,name=string_data,json=stringData,proto3" json:"string_data,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// remove_keys lists keys to delete from the secret. A key may not appear in
+	// both remove_keys and data or string_data. Removing a key that does not
+	// exist is not an error.
+	RemoveKeys []string `protobuf:"bytes,5,rep,name=remove_keys,json=removeKeys,proto3" json:"remove_keys,omitempty"`
+	// dry_run runs validation and authorization, and submits every Kubernetes
+	// write with server-side dry-run, without persisting any change.
+	DryRun bool `protobuf:"varint,6,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// cluster names the registered cluster to act in. Empty targets the
+	// cluster the console runs in.
+	Cluster string `protobuf:"bytes,7,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	// content_types sets the media types of keys in data or string_data. The
+	// recorded type of a key that is set without one is kept, and the type of
+	// a removed key is dropped.
+	ContentTypes  map[string]string `protobuf:"bytes,8,rep,name=content_types,json=contentTypes,proto3" json:"content_types,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateSecretRequest) Reset() {
-	*x = CreateSecretRequest{}
+func (x *PatchSecretRequest) Reset() {
+	*x = PatchSecretRequest{}
 	mi := &file_holos_console_v1_secrets_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateSecretRequest) String() string {
+func (x *PatchSecretRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateSecretRequest) ProtoMessage() {}
+func (*PatchSecretRequest) ProtoMessage() {}
 
-func (x *CreateSecretRequest) ProtoReflect() protoreflect.Message {
+func (x *PatchSecretRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_holos_console_v1_secrets_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -401,90 +600,90 @@ func (x *CreateSecretRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateSecretRequest.ProtoReflect.Descriptor instead.
-func (*CreateSecretRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PatchSecretRequest.ProtoReflect.Descriptor instead.
+func (*PatchSecretRequest) Descriptor() ([]byte, []int) {
 	return file_holos_console_v1_secrets_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *CreateSecretRequest) GetName() string {
+func (x *PatchSecretRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *CreateSecretRequest) GetData() map[string][]byte {
+func (x *PatchSecretRequest) GetProject() string {
 	if x != nil {
-		return x.Data
+		return x.Project
 	}
-	return nil
+	return ""
 }
 
-func (x *CreateSecretRequest) GetStringData() map[string]string {
+func (x *PatchSecretRequest) GetData() map[string][]byte {
 	if x != nil {
-		return x.StringData
+		return x.Data
 	}
 	return nil
 }
 
-func (x *CreateSecretRequest) GetUserGrants() []*ShareGrant {
+func (x *PatchSecretRequest) GetStringData() map[string]string {
 	if x != nil {
-		return x.UserGrants
+		return x.StringData
 	}
 	return nil
 }
 
-func (x *CreateSecretRequest) GetRoleGrants() []*ShareGrant {
+func (x *PatchSecretRequest) GetRemoveKeys() []string {
 	if x != nil {
-		return x.RoleGrants
+		return x.RemoveKeys
 	}
 	return nil
 }
 
-func (x *CreateSecretRequest) GetDescription() string {
-	if x != nil && x.Description != nil {
-		return *x.Description
+func (x *PatchSecretRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
 	}
-	return ""
+	return false
 }
 
-func (x *CreateSecretRequest) GetUrl() string {
-	if x != nil && x.Url != nil {
-		return *x.Url
+func (x *PatchSecretRequest) GetCluster() string {
+	if x != nil {
+		return x.Cluster
 	}
 	return ""
 }
 
-func (x *CreateSecretRequest) GetProject() string {
+func (x *PatchSecretRequest) GetContentTypes() map[string]string {
 	if x != nil {
-		return x.Project
+		return x.ContentTypes
 	}
-	return ""
+	return nil
 }
 
-// CreateSecretResponse contains the name of the created secret.
-type CreateSecretResponse struct {
+// PatchSecretResponse lists the keys present on the secret after the patch.
+type PatchSecretResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// name is the name of the created secret.
-	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// keys are the secret's data keys after the patch, sorted.
+	Keys          []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateSecretResponse) Reset() {
-	*x = CreateSecretResponse{}
+func (x *PatchSecretResponse) Reset() {
+	*x = PatchSecretResponse{}
 	mi := &file_holos_console_v1_secrets_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateSecretResponse) String() string {
+func (x *PatchSecretResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateSecretResponse) ProtoMessage() {}
+func (*PatchSecretResponse) ProtoMessage() {}
 
-func (x *CreateSecretResponse) ProtoReflect() protoreflect.Message {
+func (x *PatchSecretResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_holos_console_v1_secrets_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -496,43 +695,58 @@ func (x *CreateSecretResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateSecretResponse.ProtoReflect.Descriptor instead.
-func (*CreateSecretResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PatchSecretResponse.ProtoReflect.Descriptor instead.
+func (*PatchSecretResponse) Descriptor() ([]byte, []int) {
 	return file_holos_console_v1_secrets_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *CreateSecretResponse) GetName() string {
+func (x *PatchSecretResponse) GetKeys() []string {
 	if x != nil {
-		return x.Name
+		return x.Keys
 	}
-	return ""
+	return nil
 }
 
-// DeleteSecretRequest contains the name of the secret to delete.
-type DeleteSecretRequest struct {
+// AppendSecretKeyRequest carries one chunk of a key's value.
+type AppendSecretKeyRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// name is the name of the secret to delete.
+	// name is the name of the secret to upload into.
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	// project is the project (namespace) containing the secret.
-	Project       string `protobuf:"bytes,2,opt,name=project,proto3" json:"project,omitempty"`
+	Project string `protobuf:"bytes,2,opt,name=project,proto3" json:"project,omitempty"`
+	// key is the data key whose value is uploaded.
+	Key string `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	// offset is the position of chunk within the value. It is 0 for the first
+	// chunk and the size of the stored value for every later one.
+	Offset int64 `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	// total_size is the size of the complete value, the same in every chunk.
+	TotalSize int64 `protobuf:"varint,5,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	// chunk is the next part of the raw value (not base64 encoded).
+	Chunk []byte `protobuf:"bytes,6,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	// content_type sets the media type of the key. It is read from the first
+	// chunk only; when empty the recorded type of the key is kept.
+	ContentType string `protobuf:"bytes,7,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// cluster names the registered cluster to act in. Empty targets the
+	// cluster the console runs in.
+	Cluster       string `protobuf:"bytes,8,opt,name=cluster,proto3" json:"cluster,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteSecretRequest) Reset() {
-	*x = DeleteSecretRequest{}
+func (x *AppendSecretKeyRequest) Reset() {
+	*x = AppendSecretKeyRequest{}
 	mi := &file_holos_console_v1_secrets_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteSecretRequest) String() string {
+func (x *AppendSecretKeyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteSecretRequest) ProtoMessage() {}
+func (*AppendSecretKeyRequest) ProtoMessage() {}
 
-func (x *DeleteSecretRequest) ProtoReflect() protoreflect.Message {
+func (x *AppendSecretKeyRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_holos_console_v1_secrets_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -544,99 +758,93 @@ func (x *DeleteSecretRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteSecretRequest.ProtoReflect.Descriptor instead.
-func (*DeleteSecretRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AppendSecretKeyRequest.ProtoReflect.Descriptor instead.
+func (*AppendSecretKeyRequest) Descriptor() ([]byte, []int) {
 	return file_holos_console_v1_secrets_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *DeleteSecretRequest) GetName() string {
+func (x *AppendSecretKeyRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *DeleteSecretRequest) GetProject() string {
+func (x *AppendSecretKeyRequest) GetProject() string {
 	if x != nil {
 		return x.Project
 	}
 	return ""
 }
 
-// DeleteSecretResponse is empty on success.
-type DeleteSecretResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *AppendSecretKeyRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
 }
 
-func (x *DeleteSecretResponse) Reset() {
-	*x = DeleteSecretResponse{}
-	mi := &file_holos_console_v1_secrets_proto_msgTypes[9]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *AppendSecretKeyRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
 }
 
-func (x *DeleteSecretResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *AppendSecretKeyRequest) GetTotalSize() int64 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
 }
 
-func (*DeleteSecretResponse) ProtoMessage() {}
+func (x *AppendSecretKeyRequest) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
 
-func (x *DeleteSecretResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_holos_console_v1_secrets_proto_msgTypes[9]
+func (x *AppendSecretKeyRequest) GetContentType() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ContentType
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use DeleteSecretResponse.ProtoReflect.Descriptor instead.
-func (*DeleteSecretResponse) Descriptor() ([]byte, []int) {
-	return file_holos_console_v1_secrets_proto_rawDescGZIP(), []int{9}
+func (x *AppendSecretKeyRequest) GetCluster() string {
+	if x != nil {
+		return x.Cluster
+	}
+	return ""
 }
 
-// SecretMetadata contains non-sensitive information about a secret.
-type SecretMetadata struct {
+// AppendSecretKeyResponse reports the upload's progress.
+type AppendSecretKeyResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// name is the name of the secret.
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// accessible indicates whether the current user has permission to read this secret.
-	Accessible bool `protobuf:"varint,2,opt,name=accessible,proto3" json:"accessible,omitempty"`
-	// user_grants contains per-user sharing grants on this secret.
-	UserGrants []*ShareGrant `protobuf:"bytes,5,rep,name=user_grants,json=userGrants,proto3" json:"user_grants,omitempty"`
-	// role_grants contains per-role sharing grants on this secret.
-	RoleGrants []*ShareGrant `protobuf:"bytes,6,rep,name=role_grants,json=roleGrants,proto3" json:"role_grants,omitempty"`
-	// description is a human-readable description of the secret's purpose.
-	Description *string `protobuf:"bytes,7,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	// url is a URL associated with the secret (e.g. link to the service that uses it).
-	Url *string `protobuf:"bytes,8,opt,name=url,proto3,oneof" json:"url,omitempty"`
-	// created_at is the RFC3339-formatted timestamp when the underlying Kubernetes
-	// Secret was created, sourced from metadata.creationTimestamp.
-	CreatedAt     string `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// size is the number of bytes of the value stored so far.
+	Size int64 `protobuf:"varint,1,opt,name=size,proto3" json:"size,omitempty"`
+	// complete is true once size reaches total_size.
+	Complete      bool `protobuf:"varint,2,opt,name=complete,proto3" json:"complete,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SecretMetadata) Reset() {
-	*x = SecretMetadata{}
-	mi := &file_holos_console_v1_secrets_proto_msgTypes[10]
+func (x *AppendSecretKeyResponse) Reset() {
+	*x = AppendSecretKeyResponse{}
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SecretMetadata) String() string {
+func (x *AppendSecretKeyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SecretMetadata) ProtoMessage() {}
+func (*AppendSecretKeyResponse) ProtoMessage() {}
 
-func (x *SecretMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_holos_console_v1_secrets_proto_msgTypes[10]
+func (x *AppendSecretKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -647,92 +855,3299 @@ func (x *SecretMetadata) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SecretMetadata.ProtoReflect.Descriptor instead.
-func (*SecretMetadata) Descriptor() ([]byte, []int) {
-	return file_holos_console_v1_secrets_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use AppendSecretKeyResponse.ProtoReflect.Descriptor instead.
+func (*AppendSecretKeyResponse) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_secrets_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *SecretMetadata) GetName() string {
+func (x *AppendSecretKeyResponse) GetSize() int64 {
 	if x != nil {
-		return x.Name
+		return x.Size
 	}
-	return ""
+	return 0
 }
 
-func (x *SecretMetadata) GetAccessible() bool {
+func (x *AppendSecretKeyResponse) GetComplete() bool {
 	if x != nil {
-		return x.Accessible
+		return x.Complete
 	}
 	return false
 }
 
-func (x *SecretMetadata) GetUserGrants() []*ShareGrant {
-	if x != nil {
-		return x.UserGrants
-	}
-	return nil
-}
-
-func (x *SecretMetadata) GetRoleGrants() []*ShareGrant {
-	if x != nil {
-		return x.RoleGrants
-	}
-	return nil
+// CreateSecretRequest contains the new secret's name, data, and sharing grants.
+type CreateSecretRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name is the name of the secret to create.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// data contains the secret key-value pairs.
+	// Values are the raw secret bytes (not base64 encoded).
+	Data map[string][]byte `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// string_data contains plaintext string values that are merged into data.
+	// Values are encoded to bytes before storage, matching Kubernetes stringData
+	// semantics. When both data and string_data contain the same key, string_data
+	// takes precedence.
+	StringData map[string]string `protobuf:"bytes,3,rep,name=string_data,json=stringData,proto3" json:"string_data,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// user_grants are the per-user sharing grants to set on the created secret.
+	UserGrants []*ShareGrant `protobuf:"bytes,4,rep,name=user_grants,json=userGrants,proto3" json:"user_grants,omitempty"`
+	// role_grants are the per-role sharing grants to set on the created secret.
+	RoleGrants []*ShareGrant `protobuf:"bytes,5,rep,name=role_grants,json=roleGrants,proto3" json:"role_grants,omitempty"`
+	// description is a human-readable description of the secret's purpose.
+	Description *string `protobuf:"bytes,6,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	// url is a URL associated with the secret (e.g. link to the service that uses it).
+	Url *string `protobuf:"bytes,7,opt,name=url,proto3,oneof" json:"url,omitempty"`
+	// project is the project (namespace) containing the secret.
+	Project string `protobuf:"bytes,8,opt,name=project,proto3" json:"project,omitempty"`
+	// dry_run runs validation and authorization, and submits every Kubernetes
+	// write with server-side dry-run, without persisting any change.
+	DryRun bool `protobuf:"varint,9,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// generate maps secret keys to random values the server generates and
+	// stores. A key may not appear in both generate and data or string_data.
+	Generate map[string]*GenerateSpec `protobuf:"bytes,10,rep,name=generate,proto3" json:"generate,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// cluster names the registered cluster to act in. Empty targets the
+	// cluster the console runs in.
+	Cluster string `protobuf:"bytes,11,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	// content_types maps data keys to the media type of their value, e.g.
+	// "application/x-pkcs12" for an uploaded keystore. The secret key
+	// download endpoint serves a key with its recorded type. Every key must
+	// be set by data, string_data or generate.
+	ContentTypes map[string]string `protobuf:"bytes,12,rep,name=content_types,json=contentTypes,proto3" json:"content_types,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// type is the Kubernetes secret type. Empty or "Opaque" creates a generic
+	// secret. "kubernetes.io/tls" requires tls.crt and tls.key holding a PEM
+	// certificate chain and its matching private key.
+	// "kubernetes.io/dockerconfigjson" requires docker_registry or a
+	// .dockerconfigjson key.
+	// "kubernetes.io/ssh-auth" requires an ssh-privatekey key holding a PEM or
+	// OpenSSH private key.
+	Type string `protobuf:"bytes,13,opt,name=type,proto3" json:"type,omitempty"`
+	// docker_registry generates the .dockerconfigjson key of a
+	// kubernetes.io/dockerconfigjson image pull secret from registry
+	// credentials. type defaults to "kubernetes.io/dockerconfigjson" when set,
+	// and the request may not also supply .dockerconfigjson.
+	DockerRegistry *RegistryCredentials `protobuf:"bytes,14,opt,name=docker_registry,json=dockerRegistry,proto3" json:"docker_registry,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateSecretRequest) Reset() {
+	*x = CreateSecretRequest{}
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSecretRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSecretRequest) ProtoMessage() {}
+
+func (x *CreateSecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSecretRequest.ProtoReflect.Descriptor instead.
+func (*CreateSecretRequest) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_secrets_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CreateSecretRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateSecretRequest) GetData() map[string][]byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *CreateSecretRequest) GetStringData() map[string]string {
+	if x != nil {
+		return x.StringData
+	}
+	return nil
+}
+
+func (x *CreateSecretRequest) GetUserGrants() []*ShareGrant {
+	if x != nil {
+		return x.UserGrants
+	}
+	return nil
+}
+
+func (x *CreateSecretRequest) GetRoleGrants() []*ShareGrant {
+	if x != nil {
+		return x.RoleGrants
+	}
+	return nil
+}
+
+func (x *CreateSecretRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *CreateSecretRequest) GetUrl() string {
+	if x != nil && x.Url != nil {
+		return *x.Url
+	}
+	return ""
+}
+
+func (x *CreateSecretRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *CreateSecretRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *CreateSecretRequest) GetGenerate() map[string]*GenerateSpec {
+	if x != nil {
+		return x.Generate
+	}
+	return nil
+}
+
+func (x *CreateSecretRequest) GetCluster() string {
+	if x != nil {
+		return x.Cluster
+	}
+	return ""
+}
+
+func (x *CreateSecretRequest) GetContentTypes() map[string]string {
+	if x != nil {
+		return x.ContentTypes
+	}
+	return nil
+}
+
+func (x *CreateSecretRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CreateSecretRequest) GetDockerRegistry() *RegistryCredentials {
+	if x != nil {
+		return x.DockerRegistry
+	}
+	return nil
+}
+
+// RegistryCredentials are the credentials for one container registry.
+type RegistryCredentials struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// server is the registry host, e.g. "ghcr.io", or the Docker Hub URL
+	// "https://index.docker.io/v1/".
+	Server string `protobuf:"bytes,1,opt,name=server,proto3" json:"server,omitempty"`
+	// username is the registry user name.
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	// password is the registry password or access token.
+	Password string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	// email is the optional email address recorded with the credentials.
+	Email         string `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegistryCredentials) Reset() {
+	*x = RegistryCredentials{}
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegistryCredentials) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegistryCredentials) ProtoMessage() {}
+
+func (x *RegistryCredentials) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegistryCredentials.ProtoReflect.Descriptor instead.
+func (*RegistryCredentials) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_secrets_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RegistryCredentials) GetServer() string {
+	if x != nil {
+		return x.Server
+	}
+	return ""
+}
+
+func (x *RegistryCredentials) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *RegistryCredentials) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *RegistryCredentials) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+// GenerateSpec describes a random value generated server-side for a secret key.
+type GenerateSpec struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// format selects the encoding of the generated value.
+	Format GenerateFormat `protobuf:"varint,1,opt,name=format,proto3,enum=holos.console.v1.GenerateFormat" json:"format,omitempty"`
+	// length is the number of characters for passwords and the number of random
+	// bytes for hex and base64. Zero uses 32. The maximum is 4096.
+	Length int32 `protobuf:"varint,2,opt,name=length,proto3" json:"length,omitempty"`
+	// charset is the set of characters passwords are drawn from. Empty uses
+	// ASCII letters, digits, and the symbols !#$%&*+-=?@^_.
+	Charset       string `protobuf:"bytes,3,opt,name=charset,proto3" json:"charset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateSpec) Reset() {
+	*x = GenerateSpec{}
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateSpec) ProtoMessage() {}
+
+func (x *GenerateSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateSpec.ProtoReflect.Descriptor instead.
+func (*GenerateSpec) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_secrets_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GenerateSpec) GetFormat() GenerateFormat {
+	if x != nil {
+		return x.Format
+	}
+	return GenerateFormat_GENERATE_FORMAT_UNSPECIFIED
+}
+
+func (x *GenerateSpec) GetLength() int32 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *GenerateSpec) GetCharset() string {
+	if x != nil {
+		return x.Charset
+	}
+	return ""
+}
+
+// CreateSecretResponse contains the name of the created secret.
+type CreateSecretResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name is the name of the created secret.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// generated_values holds the values generated for the keys in
+	// CreateSecretRequest.generate. They are returned only once, in this
+	// response, so callers can copy them.
+	GeneratedValues map[string]string `protobuf:"bytes,2,rep,name=generated_values,json=generatedValues,proto3" json:"generated_values,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateSecretResponse) Reset() {
+	*x = CreateSecretResponse{}
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSecretResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSecretResponse) ProtoMessage() {}
+
+func (x *CreateSecretResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSecretResponse.ProtoReflect.Descriptor instead.
+func (*CreateSecretResponse) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_secrets_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CreateSecretResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateSecretResponse) GetGeneratedValues() map[string]string {
+	if x != nil {
+		return x.GeneratedValues
+	}
+	return nil
+}
+
+// CreateSSHKeySecretRequest names the SSH key secret to generate.
+type CreateSSHKeySecretRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name is the name of the secret to create.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// project is the project (namespace) to create the secret in.
+	Project string `protobuf:"bytes,2,opt,name=project,proto3" json:"project,omitempty"`
+	// comment is appended to the public key, e.g. "deploy@example.com".
+	Comment string `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
+	// user_grants are the per-user sharing grants to set on the created secret.
+	UserGrants []*ShareGrant `protobuf:"bytes,4,rep,name=user_grants,json=userGrants,proto3" json:"user_grants,omitempty"`
+	// role_grants are the per-role sharing grants to set on the created secret.
+	RoleGrants []*ShareGrant `protobuf:"bytes,5,rep,name=role_grants,json=roleGrants,proto3" json:"role_grants,omitempty"`
+	// description is a human-readable description of the secret's purpose.
+	Description *string `protobuf:"bytes,6,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	// url is a URL associated with the secret.
+	Url *string `protobuf:"bytes,7,opt,name=url,proto3,oneof" json:"url,omitempty"`
+	// dry_run runs validation and authorization without persisting the secret.
+	DryRun bool `protobuf:"varint,8,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// cluster names the registered cluster to act in. Empty targets the
+	// cluster the console runs in.
+	Cluster       string `protobuf:"bytes,9,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSSHKeySecretRequest) Reset() {
+	*x = CreateSSHKeySecretRequest{}
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSSHKeySecretRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSSHKeySecretRequest) ProtoMessage() {}
+
+func (x *CreateSSHKeySecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSSHKeySecretRequest.ProtoReflect.Descriptor instead.
+func (*CreateSSHKeySecretRequest) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_secrets_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CreateSSHKeySecretRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateSSHKeySecretRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *CreateSSHKeySecretRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *CreateSSHKeySecretRequest) GetUserGrants() []*ShareGrant {
+	if x != nil {
+		return x.UserGrants
+	}
+	return nil
+}
+
+func (x *CreateSSHKeySecretRequest) GetRoleGrants() []*ShareGrant {
+	if x != nil {
+		return x.RoleGrants
+	}
+	return nil
+}
+
+func (x *CreateSSHKeySecretRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *CreateSSHKeySecretRequest) GetUrl() string {
+	if x != nil && x.Url != nil {
+		return *x.Url
+	}
+	return ""
+}
+
+func (x *CreateSSHKeySecretRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *CreateSSHKeySecretRequest) GetCluster() string {
+	if x != nil {
+		return x.Cluster
+	}
+	return ""
+}
+
+// CreateSSHKeySecretResponse carries the public half of the generated key.
+type CreateSSHKeySecretResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name is the name of the created secret.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// public_key is the public key in authorized_keys format.
+	PublicKey string `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// fingerprint is the SHA256 fingerprint of the public key.
+	Fingerprint   string `protobuf:"bytes,3,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSSHKeySecretResponse) Reset() {
+	*x = CreateSSHKeySecretResponse{}
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSSHKeySecretResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSSHKeySecretResponse) ProtoMessage() {}
+
+func (x *CreateSSHKeySecretResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSSHKeySecretResponse.ProtoReflect.Descriptor instead.
+func (*CreateSSHKeySecretResponse) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_secrets_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CreateSSHKeySecretResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateSSHKeySecretResponse) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+func (x *CreateSSHKeySecretResponse) GetFingerprint() string {
+	if x != nil {
+		return x.Fingerprint
+	}
+	return ""
+}
+
+// ExportSecretSealedRequest names the secret to export.
+type ExportSecretSealedRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name is the name of the secret.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// project is the project (namespace) containing the secret.
+	Project string `protobuf:"bytes,2,opt,name=project,proto3" json:"project,omitempty"`
+	// cluster names the registered cluster to act in. Empty targets the
+	// cluster the console runs in.
+	Cluster       string `protobuf:"bytes,3,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportSecretSealedRequest) Reset() {
+	*x = ExportSecretSealedRequest{}
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportSecretSealedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportSecretSealedRequest) ProtoMessage() {}
+
+func (x *ExportSecretSealedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportSecretSealedRequest.ProtoReflect.Descriptor instead.
+func (*ExportSecretSealedRequest) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_secrets_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ExportSecretSealedRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ExportSecretSealedRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *ExportSecretSealedRequest) GetCluster() string {
+	if x != nil {
+		return x.Cluster
+	}
+	return ""
+}
+
+// ExportSecretSealedResponse carries the sealed manifest.
+type ExportSecretSealedResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// manifest is the SealedSecret as YAML.
+	Manifest string `protobuf:"bytes,1,opt,name=manifest,proto3" json:"manifest,omitempty"`
+	// certificate_fingerprint is the SHA-256 fingerprint of the sealing
+	// certificate, hex encoded, so callers can check which controller key
+	// the manifest was sealed to.
+	CertificateFingerprint string `protobuf:"bytes,2,opt,name=certificate_fingerprint,json=certificateFingerprint,proto3" json:"certificate_fingerprint,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ExportSecretSealedResponse) Reset() {
+	*x = ExportSecretSealedResponse{}
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportSecretSealedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportSecretSealedResponse) ProtoMessage() {}
+
+func (x *ExportSecretSealedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportSecretSealedResponse.ProtoReflect.Descriptor instead.
+func (*ExportSecretSealedResponse) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_secrets_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ExportSecretSealedResponse) GetManifest() string {
+	if x != nil {
+		return x.Manifest
+	}
+	return ""
+}
+
+func (x *ExportSecretSealedResponse) GetCertificateFingerprint() string {
+	if x != nil {
+		return x.CertificateFingerprint
+	}
+	return ""
+}
+
+// CreateShareLinkRequest identifies the secret to share and for how long.
+type CreateShareLinkRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name is the name of the secret.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// project is the project (namespace) containing the secret.
+	Project string `protobuf:"bytes,2,opt,name=project,proto3" json:"project,omitempty"`
+	// ttl_seconds is how long the link is valid. Defaults to one day when
+	// unset; at most seven days.
+	TtlSeconds int64 `protobuf:"varint,3,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`
+	// keys restricts the link to the listed data keys. Every key must exist
+	// on the secret. When empty the link reads every key.
+	Keys          []string `protobuf:"bytes,4,rep,name=keys,proto3" json:"keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateShareLinkRequest) Reset() {
+	*x = CreateShareLinkRequest{}
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateShareLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateShareLinkRequest) ProtoMessage() {}
+
+func (x *CreateShareLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateShareLinkRequest.ProtoReflect.Descriptor instead.
+func (*CreateShareLinkRequest) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_secrets_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CreateShareLinkRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateShareLinkRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *CreateShareLinkRequest) GetTtlSeconds() int64 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+func (x *CreateShareLinkRequest) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+// CreateShareLinkResponse carries the share link.
+type CreateShareLinkResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// url is the share link. It embeds the capability token, so treat it as
+	// a secret.
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// expires_at is the RFC3339-formatted time the link stops working.
+	ExpiresAt     string `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateShareLinkResponse) Reset() {
+	*x = CreateShareLinkResponse{}
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateShareLinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateShareLinkResponse) ProtoMessage() {}
+
+func (x *CreateShareLinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateShareLinkResponse.ProtoReflect.Descriptor instead.
+func (*CreateShareLinkResponse) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_secrets_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CreateShareLinkResponse) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CreateShareLinkResponse) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+// ApplySecretRawRequest carries the Secret manifest to apply.
+type ApplySecretRawRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// project is the project (namespace) the secret belongs to. A namespace
+	// in the manifest must be the project's namespace.
+	Project string `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	// manifest is a single v1 Secret in JSON or YAML.
+	Manifest string `protobuf:"bytes,2,opt,name=manifest,proto3" json:"manifest,omitempty"`
+	// dry_run runs validation and authorization, and submits the apply with
+	// server-side dry-run, without persisting any change.
+	DryRun        bool `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplySecretRawRequest) Reset() {
+	*x = ApplySecretRawRequest{}
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplySecretRawRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplySecretRawRequest) ProtoMessage() {}
+
+func (x *ApplySecretRawRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplySecretRawRequest.ProtoReflect.Descriptor instead.
+func (*ApplySecretRawRequest) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_secrets_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ApplySecretRawRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *ApplySecretRawRequest) GetManifest() string {
+	if x != nil {
+		return x.Manifest
+	}
+	return ""
+}
+
+func (x *ApplySecretRawRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+// ApplySecretRawResponse reports the applied secret.
+type ApplySecretRawResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name is the name of the applied secret.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// created is true when the apply created the secret.
+	Created       bool `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplySecretRawResponse) Reset() {
+	*x = ApplySecretRawResponse{}
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplySecretRawResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplySecretRawResponse) ProtoMessage() {}
+
+func (x *ApplySecretRawResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplySecretRawResponse.ProtoReflect.Descriptor instead.
+func (*ApplySecretRawResponse) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_secrets_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ApplySecretRawResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ApplySecretRawResponse) GetCreated() bool {
+	if x != nil {
+		return x.Created
+	}
+	return false
+}
+
+// DiffSecretRequest carries the data an UpdateSecret would write.
+type DiffSecretRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name is the name of the secret to compare.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// project is the project (namespace) the secret belongs to.
+	Project string `protobuf:"bytes,2,opt,name=project,proto3" json:"project,omitempty"`
+	// proposed_data is the complete data UpdateSecret would write, as in
+	// UpdateSecretRequest.data.
+	ProposedData map[string][]byte `protobuf:"bytes,3,rep,name=proposed_data,json=proposedData,proto3" json:"proposed_data,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// proposed_string_data is merged into proposed_data, taking precedence,
+	// as in UpdateSecretRequest.string_data.
+	ProposedStringData map[string]string `protobuf:"bytes,4,rep,name=proposed_string_data,json=proposedStringData,proto3" json:"proposed_string_data,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *DiffSecretRequest) Reset() {
+	*x = DiffSecretRequest{}
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffSecretRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffSecretRequest) ProtoMessage() {}
+
+func (x *DiffSecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffSecretRequest.ProtoReflect.Descriptor instead.
+func (*DiffSecretRequest) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_secrets_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *DiffSecretRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DiffSecretRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *DiffSecretRequest) GetProposedData() map[string][]byte {
+	if x != nil {
+		return x.ProposedData
+	}
+	return nil
+}
+
+func (x *DiffSecretRequest) GetProposedStringData() map[string]string {
+	if x != nil {
+		return x.ProposedStringData
+	}
+	return nil
+}
+
+// SecretKeyChange describes how an update would change one data key.
+type SecretKeyChange struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// key is the data key.
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// change classifies the change.
+	Change ChangeType `protobuf:"varint,2,opt,name=change,proto3,enum=holos.console.v1.ChangeType" json:"change,omitempty"`
+	// old_size is the current value's length in bytes, 0 when change is ADDED.
+	OldSize int64 `protobuf:"varint,3,opt,name=old_size,json=oldSize,proto3" json:"old_size,omitempty"`
+	// new_size is the proposed value's length in bytes, 0 when change is
+	// REMOVED.
+	NewSize       int64 `protobuf:"varint,4,opt,name=new_size,json=newSize,proto3" json:"new_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SecretKeyChange) Reset() {
+	*x = SecretKeyChange{}
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecretKeyChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecretKeyChange) ProtoMessage() {}
+
+func (x *SecretKeyChange) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecretKeyChange.ProtoReflect.Descriptor instead.
+func (*SecretKeyChange) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_secrets_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SecretKeyChange) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *SecretKeyChange) GetChange() ChangeType {
+	if x != nil {
+		return x.Change
+	}
+	return ChangeType_CHANGE_TYPE_UNSPECIFIED
+}
+
+func (x *SecretKeyChange) GetOldSize() int64 {
+	if x != nil {
+		return x.OldSize
+	}
+	return 0
+}
+
+func (x *SecretKeyChange) GetNewSize() int64 {
+	if x != nil {
+		return x.NewSize
+	}
+	return 0
+}
+
+// DiffSecretResponse is the redacted diff of a proposed update.
+type DiffSecretResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// changes lists the changed keys in key order. It is empty when the
+	// update would not change the data.
+	Changes []*SecretKeyChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	// unchanged_keys is the number of keys the update keeps as they are.
+	UnchangedKeys int32 `protobuf:"varint,2,opt,name=unchanged_keys,json=unchangedKeys,proto3" json:"unchanged_keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffSecretResponse) Reset() {
+	*x = DiffSecretResponse{}
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffSecretResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffSecretResponse) ProtoMessage() {}
+
+func (x *DiffSecretResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffSecretResponse.ProtoReflect.Descriptor instead.
+func (*DiffSecretResponse) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_secrets_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *DiffSecretResponse) GetChanges() []*SecretKeyChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *DiffSecretResponse) GetUnchangedKeys() int32 {
+	if x != nil {
+		return x.UnchangedKeys
+	}
+	return 0
+}
+
+// DeleteSecretRequest contains the name of the secret to delete.
+type DeleteSecretRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name is the name of the secret to delete.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// project is the project (namespace) containing the secret.
+	Project string `protobuf:"bytes,2,opt,name=project,proto3" json:"project,omitempty"`
+	// dry_run runs validation and authorization, and submits every Kubernetes
+	// write with server-side dry-run, without persisting any change.
+	DryRun bool `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// cluster names the registered cluster to act in. Empty targets the
+	// cluster the console runs in.
+	Cluster string `protobuf:"bytes,4,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	// force deletes the secret even when workloads in the project reference
+	// it. Without force, DeleteSecret fails with FailedPrecondition and a
+	// SecretInUse error detail listing the references, or with
+	// FailedPrecondition when the caller may not list a workload kind and the
+	// secret cannot be shown to be unused.
+	Force         bool `protobuf:"varint,5,opt,name=force,proto3" json:"force,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSecretRequest) Reset() {
+	*x = DeleteSecretRequest{}
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSecretRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSecretRequest) ProtoMessage() {}
+
+func (x *DeleteSecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSecretRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSecretRequest) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_secrets_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *DeleteSecretRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DeleteSecretRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *DeleteSecretRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *DeleteSecretRequest) GetCluster() string {
+	if x != nil {
+		return x.Cluster
+	}
+	return ""
+}
+
+func (x *DeleteSecretRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+// DeleteSecretResponse is empty on success.
+type DeleteSecretResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSecretResponse) Reset() {
+	*x = DeleteSecretResponse{}
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSecretResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSecretResponse) ProtoMessage() {}
+
+func (x *DeleteSecretResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSecretResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSecretResponse) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_secrets_proto_rawDescGZIP(), []int{26}
+}
+
+// BatchItemError is why one item of a batch failed.
+type BatchItemError struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// code is the ConnectRPC error code the single-item RPC would have
+	// returned, e.g. "permission_denied" or "not_found".
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	// message describes the failure.
+	Message       string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchItemError) Reset() {
+	*x = BatchItemError{}
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchItemError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchItemError) ProtoMessage() {}
+
+func (x *BatchItemError) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchItemError.ProtoReflect.Descriptor instead.
+func (*BatchItemError) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_secrets_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *BatchItemError) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *BatchItemError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// BatchGetSecretsRequest names the secrets to retrieve.
+type BatchGetSecretsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// names are the secrets to retrieve, at most 100.
+	Names []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+	// project is the project (namespace) containing the secrets.
+	Project string `protobuf:"bytes,2,opt,name=project,proto3" json:"project,omitempty"`
+	// cluster names the registered cluster to act in. Empty targets the
+	// cluster the console runs in.
+	Cluster       string `protobuf:"bytes,3,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetSecretsRequest) Reset() {
+	*x = BatchGetSecretsRequest{}
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetSecretsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetSecretsRequest) ProtoMessage() {}
+
+func (x *BatchGetSecretsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetSecretsRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetSecretsRequest) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_secrets_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *BatchGetSecretsRequest) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+func (x *BatchGetSecretsRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *BatchGetSecretsRequest) GetCluster() string {
+	if x != nil {
+		return x.Cluster
+	}
+	return ""
+}
+
+// BatchGetSecretsResponse holds one result per requested name, in request
+// order.
+type BatchGetSecretsResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Results       []*BatchGetSecretResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetSecretsResponse) Reset() {
+	*x = BatchGetSecretsResponse{}
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetSecretsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetSecretsResponse) ProtoMessage() {}
+
+func (x *BatchGetSecretsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetSecretsResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetSecretsResponse) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_secrets_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *BatchGetSecretsResponse) GetResults() []*BatchGetSecretResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// BatchGetSecretResult is the outcome for one secret of a BatchGetSecrets
+// call.
+type BatchGetSecretResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name is the requested secret.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// data is the secret's data, as GetSecret returns it. Empty on error.
+	Data map[string][]byte `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// error is set when the secret could not be retrieved.
+	Error         *BatchItemError `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetSecretResult) Reset() {
+	*x = BatchGetSecretResult{}
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetSecretResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetSecretResult) ProtoMessage() {}
+
+func (x *BatchGetSecretResult) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetSecretResult.ProtoReflect.Descriptor instead.
+func (*BatchGetSecretResult) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_secrets_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *BatchGetSecretResult) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BatchGetSecretResult) GetData() map[string][]byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *BatchGetSecretResult) GetError() *BatchItemError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+// BatchDeleteSecretsRequest names the secrets to delete.
+type BatchDeleteSecretsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// names are the secrets to delete, at most 100.
+	Names []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+	// project is the project (namespace) containing the secrets.
+	Project string `protobuf:"bytes,2,opt,name=project,proto3" json:"project,omitempty"`
+	// dry_run runs validation and authorization, and submits every Kubernetes
+	// write with server-side dry-run, without persisting any change.
+	DryRun bool `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// cluster names the registered cluster to act in. Empty targets the
+	// cluster the console runs in.
+	Cluster string `protobuf:"bytes,4,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	// force deletes secrets even when workloads in the project reference
+	// them, as DeleteSecretRequest.force does.
+	Force         bool `protobuf:"varint,5,opt,name=force,proto3" json:"force,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchDeleteSecretsRequest) Reset() {
+	*x = BatchDeleteSecretsRequest{}
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchDeleteSecretsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchDeleteSecretsRequest) ProtoMessage() {}
+
+func (x *BatchDeleteSecretsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchDeleteSecretsRequest.ProtoReflect.Descriptor instead.
+func (*BatchDeleteSecretsRequest) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_secrets_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *BatchDeleteSecretsRequest) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+func (x *BatchDeleteSecretsRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *BatchDeleteSecretsRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *BatchDeleteSecretsRequest) GetCluster() string {
+	if x != nil {
+		return x.Cluster
+	}
+	return ""
+}
+
+func (x *BatchDeleteSecretsRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+// BatchDeleteSecretsResponse holds one result per requested name, in
+// request order.
+type BatchDeleteSecretsResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Results       []*BatchDeleteSecretResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchDeleteSecretsResponse) Reset() {
+	*x = BatchDeleteSecretsResponse{}
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchDeleteSecretsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchDeleteSecretsResponse) ProtoMessage() {}
+
+func (x *BatchDeleteSecretsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchDeleteSecretsResponse.ProtoReflect.Descriptor instead.
+func (*BatchDeleteSecretsResponse) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_secrets_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *BatchDeleteSecretsResponse) GetResults() []*BatchDeleteSecretResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// BatchDeleteSecretResult is the outcome for one secret of a
+// BatchDeleteSecrets call.
+type BatchDeleteSecretResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name is the requested secret.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// error is set when the secret was not deleted.
+	Error         *BatchItemError `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchDeleteSecretResult) Reset() {
+	*x = BatchDeleteSecretResult{}
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchDeleteSecretResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchDeleteSecretResult) ProtoMessage() {}
+
+func (x *BatchDeleteSecretResult) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchDeleteSecretResult.ProtoReflect.Descriptor instead.
+func (*BatchDeleteSecretResult) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_secrets_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *BatchDeleteSecretResult) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BatchDeleteSecretResult) GetError() *BatchItemError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+// SecretInUse is the error detail attached when DeleteSecret refuses to
+// delete a secret that workloads still reference.
+type SecretInUse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// consumers are the workloads referencing the secret.
+	Consumers     []*SecretConsumer `protobuf:"bytes,1,rep,name=consumers,proto3" json:"consumers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SecretInUse) Reset() {
+	*x = SecretInUse{}
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecretInUse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecretInUse) ProtoMessage() {}
+
+func (x *SecretInUse) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecretInUse.ProtoReflect.Descriptor instead.
+func (*SecretInUse) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_secrets_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *SecretInUse) GetConsumers() []*SecretConsumer {
+	if x != nil {
+		return x.Consumers
+	}
+	return nil
+}
+
+// SecretTooLarge is the error detail attached when a write would grow a
+// secret past the Kubernetes object size limit or the key limit.
+type SecretTooLarge struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// size_bytes is the size the secret would have after the write.
+	SizeBytes int64 `protobuf:"varint,1,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	// current_bytes is the size of the secret before the write, 0 when creating.
+	CurrentBytes int64 `protobuf:"varint,2,opt,name=current_bytes,json=currentBytes,proto3" json:"current_bytes,omitempty"`
+	// limit_bytes is the most a secret may hold.
+	LimitBytes int64 `protobuf:"varint,3,opt,name=limit_bytes,json=limitBytes,proto3" json:"limit_bytes,omitempty"`
+	// remaining_bytes is how much the secret can still grow, limit_bytes
+	// minus current_bytes.
+	RemainingBytes int64 `protobuf:"varint,4,opt,name=remaining_bytes,json=remainingBytes,proto3" json:"remaining_bytes,omitempty"`
+	// key_count is the number of keys the secret would hold after the write.
+	KeyCount int32 `protobuf:"varint,5,opt,name=key_count,json=keyCount,proto3" json:"key_count,omitempty"`
+	// max_keys is the most keys a secret may hold.
+	MaxKeys       int32 `protobuf:"varint,6,opt,name=max_keys,json=maxKeys,proto3" json:"max_keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SecretTooLarge) Reset() {
+	*x = SecretTooLarge{}
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecretTooLarge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecretTooLarge) ProtoMessage() {}
+
+func (x *SecretTooLarge) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecretTooLarge.ProtoReflect.Descriptor instead.
+func (*SecretTooLarge) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_secrets_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SecretTooLarge) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *SecretTooLarge) GetCurrentBytes() int64 {
+	if x != nil {
+		return x.CurrentBytes
+	}
+	return 0
+}
+
+func (x *SecretTooLarge) GetLimitBytes() int64 {
+	if x != nil {
+		return x.LimitBytes
+	}
+	return 0
+}
+
+func (x *SecretTooLarge) GetRemainingBytes() int64 {
+	if x != nil {
+		return x.RemainingBytes
+	}
+	return 0
+}
+
+func (x *SecretTooLarge) GetKeyCount() int32 {
+	if x != nil {
+		return x.KeyCount
+	}
+	return 0
+}
+
+func (x *SecretTooLarge) GetMaxKeys() int32 {
+	if x != nil {
+		return x.MaxKeys
+	}
+	return 0
+}
+
+// SecretMetadata contains non-sensitive information about a secret.
+type SecretMetadata struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name is the name of the secret.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// accessible indicates whether the current user has permission to read this secret.
+	Accessible bool `protobuf:"varint,2,opt,name=accessible,proto3" json:"accessible,omitempty"`
+	// user_grants contains per-user sharing grants on this secret.
+	UserGrants []*ShareGrant `protobuf:"bytes,5,rep,name=user_grants,json=userGrants,proto3" json:"user_grants,omitempty"`
+	// role_grants contains per-role sharing grants on this secret.
+	RoleGrants []*ShareGrant `protobuf:"bytes,6,rep,name=role_grants,json=roleGrants,proto3" json:"role_grants,omitempty"`
+	// description is a human-readable description of the secret's purpose.
+	Description *string `protobuf:"bytes,7,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	// url is a URL associated with the secret (e.g. link to the service that uses it).
+	Url *string `protobuf:"bytes,8,opt,name=url,proto3,oneof" json:"url,omitempty"`
+	// created_at is the RFC3339-formatted timestamp when the underlying Kubernetes
+	// Secret was created, sourced from metadata.creationTimestamp.
+	CreatedAt string `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// source identifies what manages the underlying Kubernetes Secret:
+	// "console" for secrets created through the console, or "external" for
+	// secrets synced by External Secrets Operator. External secrets are
+	// read-only; UpdateSecret, PatchSecret, RotateSecret, UpdateSharing, and
+	// DeleteSecret reject them with FAILED_PRECONDITION.
+	Source string `protobuf:"bytes,10,opt,name=source,proto3" json:"source,omitempty"`
+	// updated_at is the RFC3339-formatted timestamp of the most recent write to
+	// the underlying Kubernetes Secret, sourced from metadata.managedFields.
+	// Equal to created_at when the Secret has not been modified.
+	UpdatedAt string `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// creator_email is the email address of the user who created this secret
+	// through the console. Empty for external secrets and for secrets created
+	// before the console recorded its creator.
+	CreatorEmail string `protobuf:"bytes,12,opt,name=creator_email,json=creatorEmail,proto3" json:"creator_email,omitempty"`
+	// last_accessed_at is the RFC3339-formatted timestamp of the most recent
+	// GetSecret that read this secret's values. It is refreshed at most once
+	// an hour, so it may trail the true last read by up to an hour. Empty when
+	// the secret has not been read since access tracking was introduced, and
+	// always empty for external secrets.
+	LastAccessedAt string `protobuf:"bytes,13,opt,name=last_accessed_at,json=lastAccessedAt,proto3" json:"last_accessed_at,omitempty"`
+	// last_accessed_by is the email address of the caller recorded with
+	// last_accessed_at.
+	LastAccessedBy string `protobuf:"bytes,14,opt,name=last_accessed_by,json=lastAccessedBy,proto3" json:"last_accessed_by,omitempty"`
+	// content_types maps data keys to the media type recorded when the value
+	// was uploaded. Keys without a recorded type are absent.
+	ContentTypes map[string]string `protobuf:"bytes,15,rep,name=content_types,json=contentTypes,proto3" json:"content_types,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// type is the Kubernetes secret type, e.g. "Opaque" or "kubernetes.io/tls".
+	Type string `protobuf:"bytes,16,opt,name=type,proto3" json:"type,omitempty"`
+	// tls_certificate describes the leaf certificate of a kubernetes.io/tls
+	// secret. Unset for other types or when tls.crt does not parse.
+	TlsCertificate *TLSCertificate `protobuf:"bytes,17,opt,name=tls_certificate,json=tlsCertificate,proto3" json:"tls_certificate,omitempty"`
+	// ssh_public_key is the authorized_keys line of a kubernetes.io/ssh-auth
+	// secret. It is set for every caller, including those who cannot read the
+	// private key.
+	SshPublicKey string `protobuf:"bytes,18,opt,name=ssh_public_key,json=sshPublicKey,proto3" json:"ssh_public_key,omitempty"`
+	// ssh_fingerprint is the SHA256 fingerprint of ssh_public_key.
+	SshFingerprint string `protobuf:"bytes,19,opt,name=ssh_fingerprint,json=sshFingerprint,proto3" json:"ssh_fingerprint,omitempty"`
+	// vault_path is the HashiCorp Vault path the secret's values are read
+	// from, from the console.holos.run/vault-path annotation. Empty when the
+	// values are stored on the Kubernetes Secret. Values of a Vault-backed
+	// secret cannot be changed through the console.
+	VaultPath string `protobuf:"bytes,20,opt,name=vault_path,json=vaultPath,proto3" json:"vault_path,omitempty"`
+	// size_bytes approximates the stored size of the Kubernetes Secret: its
+	// data, labels, and annotations, including grant annotations.
+	SizeBytes int64 `protobuf:"varint,21,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	// size_limit_bytes is the most size_bytes may reach; writes past it fail
+	// with RESOURCE_EXHAUSTED and a SecretTooLarge detail.
+	SizeLimitBytes int64 `protobuf:"varint,22,opt,name=size_limit_bytes,json=sizeLimitBytes,proto3" json:"size_limit_bytes,omitempty"`
+	// key_count is the number of data keys the secret holds.
+	KeyCount int32 `protobuf:"varint,23,opt,name=key_count,json=keyCount,proto3" json:"key_count,omitempty"`
+	// user_role is the calling user's effective role on this secret.
+	// ROLE_UNSPECIFIED when a deny grant excludes them.
+	UserRole Role `protobuf:"varint,24,opt,name=user_role,json=userRole,proto3,enum=holos.console.v1.Role" json:"user_role,omitempty"`
+	// user_role_source explains where user_role comes from. Secret sharing
+	// grants are held by the project, so the source names the project. Unset
+	// when user_role is ROLE_UNSPECIFIED.
+	UserRoleSource *RoleSource `protobuf:"bytes,25,opt,name=user_role_source,json=userRoleSource,proto3" json:"user_role_source,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SecretMetadata) Reset() {
+	*x = SecretMetadata{}
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecretMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecretMetadata) ProtoMessage() {}
+
+func (x *SecretMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecretMetadata.ProtoReflect.Descriptor instead.
+func (*SecretMetadata) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_secrets_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *SecretMetadata) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SecretMetadata) GetAccessible() bool {
+	if x != nil {
+		return x.Accessible
+	}
+	return false
+}
+
+func (x *SecretMetadata) GetUserGrants() []*ShareGrant {
+	if x != nil {
+		return x.UserGrants
+	}
+	return nil
+}
+
+func (x *SecretMetadata) GetRoleGrants() []*ShareGrant {
+	if x != nil {
+		return x.RoleGrants
+	}
+	return nil
+}
+
+func (x *SecretMetadata) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *SecretMetadata) GetUrl() string {
+	if x != nil && x.Url != nil {
+		return *x.Url
+	}
+	return ""
+}
+
+func (x *SecretMetadata) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *SecretMetadata) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *SecretMetadata) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *SecretMetadata) GetCreatorEmail() string {
+	if x != nil {
+		return x.CreatorEmail
+	}
+	return ""
+}
+
+func (x *SecretMetadata) GetLastAccessedAt() string {
+	if x != nil {
+		return x.LastAccessedAt
+	}
+	return ""
+}
+
+func (x *SecretMetadata) GetLastAccessedBy() string {
+	if x != nil {
+		return x.LastAccessedBy
+	}
+	return ""
+}
+
+func (x *SecretMetadata) GetContentTypes() map[string]string {
+	if x != nil {
+		return x.ContentTypes
+	}
+	return nil
+}
+
+func (x *SecretMetadata) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *SecretMetadata) GetTlsCertificate() *TLSCertificate {
+	if x != nil {
+		return x.TlsCertificate
+	}
+	return nil
+}
+
+func (x *SecretMetadata) GetSshPublicKey() string {
+	if x != nil {
+		return x.SshPublicKey
+	}
+	return ""
+}
+
+func (x *SecretMetadata) GetSshFingerprint() string {
+	if x != nil {
+		return x.SshFingerprint
+	}
+	return ""
+}
+
+func (x *SecretMetadata) GetVaultPath() string {
+	if x != nil {
+		return x.VaultPath
+	}
+	return ""
+}
+
+func (x *SecretMetadata) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *SecretMetadata) GetSizeLimitBytes() int64 {
+	if x != nil {
+		return x.SizeLimitBytes
+	}
+	return 0
+}
+
+func (x *SecretMetadata) GetKeyCount() int32 {
+	if x != nil {
+		return x.KeyCount
+	}
+	return 0
+}
+
+func (x *SecretMetadata) GetUserRole() Role {
+	if x != nil {
+		return x.UserRole
+	}
+	return Role_ROLE_UNSPECIFIED
+}
+
+func (x *SecretMetadata) GetUserRoleSource() *RoleSource {
+	if x != nil {
+		return x.UserRoleSource
+	}
+	return nil
+}
+
+// TLSCertificate is the parsed metadata of a TLS secret's leaf certificate.
+type TLSCertificate struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// subject is the certificate subject distinguished name.
+	Subject string `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"`
+	// issuer is the certificate issuer distinguished name.
+	Issuer string `protobuf:"bytes,2,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	// dns_names are the DNS subject alternative names.
+	DnsNames []string `protobuf:"bytes,3,rep,name=dns_names,json=dnsNames,proto3" json:"dns_names,omitempty"`
+	// ip_addresses are the IP address subject alternative names.
+	IpAddresses []string `protobuf:"bytes,4,rep,name=ip_addresses,json=ipAddresses,proto3" json:"ip_addresses,omitempty"`
+	// email_addresses are the email subject alternative names.
+	EmailAddresses []string `protobuf:"bytes,5,rep,name=email_addresses,json=emailAddresses,proto3" json:"email_addresses,omitempty"`
+	// uris are the URI subject alternative names.
+	Uris []string `protobuf:"bytes,6,rep,name=uris,proto3" json:"uris,omitempty"`
+	// not_before is when the certificate becomes valid (RFC 3339).
+	NotBefore string `protobuf:"bytes,7,opt,name=not_before,json=notBefore,proto3" json:"not_before,omitempty"`
+	// not_after is when the certificate expires (RFC 3339).
+	NotAfter      string `protobuf:"bytes,8,opt,name=not_after,json=notAfter,proto3" json:"not_after,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TLSCertificate) Reset() {
+	*x = TLSCertificate{}
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TLSCertificate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TLSCertificate) ProtoMessage() {}
+
+func (x *TLSCertificate) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TLSCertificate.ProtoReflect.Descriptor instead.
+func (*TLSCertificate) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_secrets_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *TLSCertificate) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *TLSCertificate) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *TLSCertificate) GetDnsNames() []string {
+	if x != nil {
+		return x.DnsNames
+	}
+	return nil
+}
+
+func (x *TLSCertificate) GetIpAddresses() []string {
+	if x != nil {
+		return x.IpAddresses
+	}
+	return nil
+}
+
+func (x *TLSCertificate) GetEmailAddresses() []string {
+	if x != nil {
+		return x.EmailAddresses
+	}
+	return nil
+}
+
+func (x *TLSCertificate) GetUris() []string {
+	if x != nil {
+		return x.Uris
+	}
+	return nil
+}
+
+func (x *TLSCertificate) GetNotBefore() string {
+	if x != nil {
+		return x.NotBefore
+	}
+	return ""
+}
+
+func (x *TLSCertificate) GetNotAfter() string {
+	if x != nil {
+		return x.NotAfter
+	}
+	return ""
+}
+
+// ShareGrant represents a sharing grant for a principal (user email or role name).
+type ShareGrant struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// principal is the email address (for users) or role name (for roles).
+	Principal string `protobuf:"bytes,1,opt,name=principal,proto3" json:"principal,omitempty"`
+	// role is the permission level granted to the principal.
+	Role Role `protobuf:"varint,2,opt,name=role,proto3,enum=holos.console.v1.Role" json:"role,omitempty"`
+	// nbf (not before) is the unix timestamp before which the grant is inactive.
+	// When unset, the grant has no start time restriction.
+	Nbf *int64 `protobuf:"varint,3,opt,name=nbf,proto3,oneof" json:"nbf,omitempty"`
+	// exp (expiration) is the unix timestamp at or after which the grant is inactive.
+	// When unset, the grant has no expiration.
+	Exp *int64 `protobuf:"varint,4,opt,name=exp,proto3,oneof" json:"exp,omitempty"`
+	// keys restricts a secret sharing grant to the listed data keys. A grant
+	// with keys confers read access to those keys through GetSecretKey only;
+	// the role is treated as ROLE_VIEWER. Empty means the grant applies to the
+	// whole secret. Only honored by SecretsService.
+	Keys []string `protobuf:"bytes,5,rep,name=keys,proto3" json:"keys,omitempty"`
+	// deny excludes the principal from the secret even when a project or
+	// organization grant would otherwise allow access. role and keys are
+	// ignored for deny grants. Only honored by SecretsService.
+	Deny bool `protobuf:"varint,6,opt,name=deny,proto3" json:"deny,omitempty"`
+	// pending is set on user grants whose principal has never signed in to the
+	// console, so an owner can spot a mistyped email address. The grant takes
+	// effect as soon as the user signs in. Output only; ignored on input.
+	Pending       bool `protobuf:"varint,7,opt,name=pending,proto3" json:"pending,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShareGrant) Reset() {
+	*x = ShareGrant{}
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareGrant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareGrant) ProtoMessage() {}
+
+func (x *ShareGrant) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareGrant.ProtoReflect.Descriptor instead.
+func (*ShareGrant) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_secrets_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ShareGrant) GetPrincipal() string {
+	if x != nil {
+		return x.Principal
+	}
+	return ""
+}
+
+func (x *ShareGrant) GetRole() Role {
+	if x != nil {
+		return x.Role
+	}
+	return Role_ROLE_UNSPECIFIED
+}
+
+func (x *ShareGrant) GetNbf() int64 {
+	if x != nil && x.Nbf != nil {
+		return *x.Nbf
+	}
+	return 0
+}
+
+func (x *ShareGrant) GetExp() int64 {
+	if x != nil && x.Exp != nil {
+		return *x.Exp
+	}
+	return 0
+}
+
+func (x *ShareGrant) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+func (x *ShareGrant) GetDeny() bool {
+	if x != nil {
+		return x.Deny
+	}
+	return false
+}
+
+func (x *ShareGrant) GetPending() bool {
+	if x != nil {
+		return x.Pending
+	}
+	return false
+}
+
+// UpdateSharingRequest contains the sharing grants to set on a secret.
+type UpdateSharingRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name is the name of the secret to update sharing for.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// user_grants are the per-user sharing grants to set.
+	UserGrants []*ShareGrant `protobuf:"bytes,2,rep,name=user_grants,json=userGrants,proto3" json:"user_grants,omitempty"`
+	// role_grants are the per-role sharing grants to set.
+	RoleGrants []*ShareGrant `protobuf:"bytes,3,rep,name=role_grants,json=roleGrants,proto3" json:"role_grants,omitempty"`
+	// project is the project (namespace) containing the secret.
+	Project string `protobuf:"bytes,4,opt,name=project,proto3" json:"project,omitempty"`
+	// dry_run runs validation and authorization, and submits every Kubernetes
+	// write with server-side dry-run, without persisting any change.
+	DryRun bool `protobuf:"varint,5,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// cluster names the registered cluster to act in. Empty targets the
+	// cluster the console runs in.
+	Cluster string `protobuf:"bytes,6,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	// invite_users records a pending invitation for every user grant naming
+	// an email address that has never signed in, and emails the invitee when
+	// the console has SMTP configured. The invitation is accepted on the
+	// invitee's first sign-in.
+	InviteUsers   bool `protobuf:"varint,7,opt,name=invite_users,json=inviteUsers,proto3" json:"invite_users,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSharingRequest) Reset() {
+	*x = UpdateSharingRequest{}
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSharingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSharingRequest) ProtoMessage() {}
+
+func (x *UpdateSharingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSharingRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSharingRequest) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_secrets_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *UpdateSharingRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateSharingRequest) GetUserGrants() []*ShareGrant {
+	if x != nil {
+		return x.UserGrants
+	}
+	return nil
+}
+
+func (x *UpdateSharingRequest) GetRoleGrants() []*ShareGrant {
+	if x != nil {
+		return x.RoleGrants
+	}
+	return nil
+}
+
+func (x *UpdateSharingRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *UpdateSharingRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *UpdateSharingRequest) GetCluster() string {
+	if x != nil {
+		return x.Cluster
+	}
+	return ""
+}
+
+func (x *UpdateSharingRequest) GetInviteUsers() bool {
+	if x != nil {
+		return x.InviteUsers
+	}
+	return false
+}
+
+// UpdateSharingResponse contains the updated secret metadata.
+type UpdateSharingResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// metadata contains the updated secret metadata including new sharing grants.
+	Metadata      *SecretMetadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSharingResponse) Reset() {
+	*x = UpdateSharingResponse{}
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSharingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSharingResponse) ProtoMessage() {}
+
+func (x *UpdateSharingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSharingResponse.ProtoReflect.Descriptor instead.
+func (*UpdateSharingResponse) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_secrets_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *UpdateSharingResponse) GetMetadata() *SecretMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+// GetSecretRawRequest contains the name of the secret to retrieve as a raw manifest.
+type GetSecretRawRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name is the name of the secret to retrieve.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// project is the project (namespace) containing the secret.
+	Project string `protobuf:"bytes,2,opt,name=project,proto3" json:"project,omitempty"`
+	// cluster names the registered cluster to act in. Empty targets the
+	// cluster the console runs in.
+	Cluster string `protobuf:"bytes,3,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	// format selects JSON (the default) or YAML output.
+	Format RawFormat `protobuf:"varint,4,opt,name=format,proto3,enum=holos.console.v1.RawFormat" json:"format,omitempty"`
+	// strip_managed_fields omits the server-populated fields (uid,
+	// resourceVersion, generation, creationTimestamp, managedFields,
+	// ownerReferences, and status) so the manifest can be applied to another
+	// cluster with kubectl apply.
+	StripManagedFields bool `protobuf:"varint,5,opt,name=strip_managed_fields,json=stripManagedFields,proto3" json:"strip_managed_fields,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GetSecretRawRequest) Reset() {
+	*x = GetSecretRawRequest{}
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSecretRawRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSecretRawRequest) ProtoMessage() {}
+
+func (x *GetSecretRawRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSecretRawRequest.ProtoReflect.Descriptor instead.
+func (*GetSecretRawRequest) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_secrets_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetSecretRawRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetSecretRawRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *GetSecretRawRequest) GetCluster() string {
+	if x != nil {
+		return x.Cluster
+	}
+	return ""
+}
+
+func (x *GetSecretRawRequest) GetFormat() RawFormat {
+	if x != nil {
+		return x.Format
+	}
+	return RawFormat_RAW_FORMAT_UNSPECIFIED
+}
+
+func (x *GetSecretRawRequest) GetStripManagedFields() bool {
+	if x != nil {
+		return x.StripManagedFields
+	}
+	return false
+}
+
+// GetSecretRawResponse contains the full Kubernetes Secret object as JSON or YAML.
+type GetSecretRawResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// raw is the Secret object from the K8s API, serialized in the
+	// requested format.
+	Raw           string `protobuf:"bytes,1,opt,name=raw,proto3" json:"raw,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSecretRawResponse) Reset() {
+	*x = GetSecretRawResponse{}
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSecretRawResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSecretRawResponse) ProtoMessage() {}
+
+func (x *GetSecretRawResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSecretRawResponse.ProtoReflect.Descriptor instead.
+func (*GetSecretRawResponse) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_secrets_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetSecretRawResponse) GetRaw() string {
+	if x != nil {
+		return x.Raw
+	}
+	return ""
+}
+
+// GetSecretKeyRequest identifies a single data key within a secret.
+type GetSecretKeyRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name is the name of the secret containing the key.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// project is the project (namespace) containing the secret.
+	Project string `protobuf:"bytes,2,opt,name=project,proto3" json:"project,omitempty"`
+	// key is the data key to retrieve.
+	Key string `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	// cluster names the registered cluster to act in. Empty targets the
+	// cluster the console runs in.
+	Cluster       string `protobuf:"bytes,4,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSecretKeyRequest) Reset() {
+	*x = GetSecretKeyRequest{}
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSecretKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSecretKeyRequest) ProtoMessage() {}
+
+func (x *GetSecretKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSecretKeyRequest.ProtoReflect.Descriptor instead.
+func (*GetSecretKeyRequest) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_secrets_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetSecretKeyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetSecretKeyRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *GetSecretKeyRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *GetSecretKeyRequest) GetCluster() string {
+	if x != nil {
+		return x.Cluster
+	}
+	return ""
+}
+
+// GetSecretKeyResponse contains the value of a single secret data key.
+type GetSecretKeyResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// value is the raw secret bytes (not base64 encoded).
+	Value []byte `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	// content_type is the media type recorded for the key, or
+	// "application/octet-stream" when none was recorded.
+	ContentType   string `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSecretKeyResponse) Reset() {
+	*x = GetSecretKeyResponse{}
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSecretKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSecretKeyResponse) ProtoMessage() {}
+
+func (x *GetSecretKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSecretKeyResponse.ProtoReflect.Descriptor instead.
+func (*GetSecretKeyResponse) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_secrets_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetSecretKeyResponse) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *GetSecretKeyResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+// RotateSecretRequest names the keys to rotate on an existing secret.
+type RotateSecretRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name is the name of the secret to rotate.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// project is the project (namespace) containing the secret.
+	Project string `protobuf:"bytes,2,opt,name=project,proto3" json:"project,omitempty"`
+	// keys maps each key to rotate to the spec its new value is generated from.
+	// Every key must already exist on the secret.
+	Keys map[string]*GenerateSpec `protobuf:"bytes,3,rep,name=keys,proto3" json:"keys,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// dry_run runs validation and authorization, and submits every Kubernetes
+	// write with server-side dry-run, without persisting any change. The
+	// rotation webhook is not called.
+	DryRun bool `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// cluster names the registered cluster to act in. Empty targets the
+	// cluster the console runs in.
+	Cluster       string `protobuf:"bytes,5,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateSecretRequest) Reset() {
+	*x = RotateSecretRequest{}
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateSecretRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateSecretRequest) ProtoMessage() {}
+
+func (x *RotateSecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateSecretRequest.ProtoReflect.Descriptor instead.
+func (*RotateSecretRequest) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_secrets_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *RotateSecretRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RotateSecretRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *RotateSecretRequest) GetKeys() map[string]*GenerateSpec {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+func (x *RotateSecretRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *RotateSecretRequest) GetCluster() string {
+	if x != nil {
+		return x.Cluster
+	}
+	return ""
+}
+
+// RotateSecretResponse returns the rotated values and the webhook outcome.
+type RotateSecretResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// rotated_values holds the new value of each rotated key. They are returned
+	// only once, in this response.
+	RotatedValues map[string]string `protobuf:"bytes,1,rep,name=rotated_values,json=rotatedValues,proto3" json:"rotated_values,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// webhook_notified is true when a rotation webhook is configured and
+	// acknowledged the notification with a 2xx response.
+	WebhookNotified bool `protobuf:"varint,2,opt,name=webhook_notified,json=webhookNotified,proto3" json:"webhook_notified,omitempty"`
+	// webhook_error describes why the rotation webhook could not be notified.
+	// The rotation itself is stored regardless.
+	WebhookError  string `protobuf:"bytes,3,opt,name=webhook_error,json=webhookError,proto3" json:"webhook_error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateSecretResponse) Reset() {
+	*x = RotateSecretResponse{}
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateSecretResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateSecretResponse) ProtoMessage() {}
+
+func (x *RotateSecretResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateSecretResponse.ProtoReflect.Descriptor instead.
+func (*RotateSecretResponse) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_secrets_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *RotateSecretResponse) GetRotatedValues() map[string]string {
+	if x != nil {
+		return x.RotatedValues
+	}
+	return nil
+}
+
+func (x *RotateSecretResponse) GetWebhookNotified() bool {
+	if x != nil {
+		return x.WebhookNotified
+	}
+	return false
+}
+
+func (x *RotateSecretResponse) GetWebhookError() string {
+	if x != nil {
+		return x.WebhookError
+	}
+	return ""
+}
+
+// ProjectQuota limits the console-managed secrets in a project. Zero means
+// unlimited.
+type ProjectQuota struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// max_secrets is the maximum number of console-managed secrets.
+	MaxSecrets int64 `protobuf:"varint,1,opt,name=max_secrets,json=maxSecrets,proto3" json:"max_secrets,omitempty"`
+	// max_secret_bytes is the maximum total size of the keys and values of
+	// all console-managed secrets.
+	MaxSecretBytes int64 `protobuf:"varint,2,opt,name=max_secret_bytes,json=maxSecretBytes,proto3" json:"max_secret_bytes,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ProjectQuota) Reset() {
+	*x = ProjectQuota{}
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProjectQuota) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectQuota) ProtoMessage() {}
+
+func (x *ProjectQuota) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectQuota.ProtoReflect.Descriptor instead.
+func (*ProjectQuota) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_secrets_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ProjectQuota) GetMaxSecrets() int64 {
+	if x != nil {
+		return x.MaxSecrets
+	}
+	return 0
+}
+
+func (x *ProjectQuota) GetMaxSecretBytes() int64 {
+	if x != nil {
+		return x.MaxSecretBytes
+	}
+	return 0
+}
+
+// ProjectQuotaUsage is the current consumption measured against a
+// ProjectQuota.
+type ProjectQuotaUsage struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// secrets is the number of console-managed secrets.
+	Secrets int64 `protobuf:"varint,1,opt,name=secrets,proto3" json:"secrets,omitempty"`
+	// secret_bytes is the total size of the keys and values of all
+	// console-managed secrets.
+	SecretBytes   int64 `protobuf:"varint,2,opt,name=secret_bytes,json=secretBytes,proto3" json:"secret_bytes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProjectQuotaUsage) Reset() {
+	*x = ProjectQuotaUsage{}
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProjectQuotaUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectQuotaUsage) ProtoMessage() {}
+
+func (x *ProjectQuotaUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectQuotaUsage.ProtoReflect.Descriptor instead.
+func (*ProjectQuotaUsage) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_secrets_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ProjectQuotaUsage) GetSecrets() int64 {
+	if x != nil {
+		return x.Secrets
+	}
+	return 0
+}
+
+func (x *ProjectQuotaUsage) GetSecretBytes() int64 {
+	if x != nil {
+		return x.SecretBytes
+	}
+	return 0
+}
+
+// GetProjectQuotaRequest names the project to report on.
+type GetProjectQuotaRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// project is the project name.
+	Project string `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	// cluster names the registered cluster to act in. Empty targets the
+	// cluster the console runs in.
+	Cluster       string `protobuf:"bytes,2,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProjectQuotaRequest) Reset() {
+	*x = GetProjectQuotaRequest{}
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProjectQuotaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProjectQuotaRequest) ProtoMessage() {}
+
+func (x *GetProjectQuotaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProjectQuotaRequest.ProtoReflect.Descriptor instead.
+func (*GetProjectQuotaRequest) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_secrets_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetProjectQuotaRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *GetProjectQuotaRequest) GetCluster() string {
+	if x != nil {
+		return x.Cluster
+	}
+	return ""
+}
+
+// GetProjectQuotaResponse returns the effective quota and current usage.
+type GetProjectQuotaResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// limit is the effective quota after applying organization defaults.
+	Limit *ProjectQuota `protobuf:"bytes,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	// usage is the project's current consumption.
+	Usage         *ProjectQuotaUsage `protobuf:"bytes,2,opt,name=usage,proto3" json:"usage,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProjectQuotaResponse) Reset() {
+	*x = GetProjectQuotaResponse{}
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProjectQuotaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProjectQuotaResponse) ProtoMessage() {}
+
+func (x *GetProjectQuotaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProjectQuotaResponse.ProtoReflect.Descriptor instead.
+func (*GetProjectQuotaResponse) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_secrets_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetProjectQuotaResponse) GetLimit() *ProjectQuota {
+	if x != nil {
+		return x.Limit
+	}
+	return nil
+}
+
+func (x *GetProjectQuotaResponse) GetUsage() *ProjectQuotaUsage {
+	if x != nil {
+		return x.Usage
+	}
+	return nil
+}
+
+// GetSecretUsageRequest names the secret to look up consumers for.
+type GetSecretUsageRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name is the name of the secret.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// project is the project (namespace) containing the secret.
+	Project string `protobuf:"bytes,2,opt,name=project,proto3" json:"project,omitempty"`
+	// cluster names the registered cluster to act in. Empty targets the
+	// cluster the console runs in.
+	Cluster       string `protobuf:"bytes,3,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSecretUsageRequest) Reset() {
+	*x = GetSecretUsageRequest{}
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSecretUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSecretUsageRequest) ProtoMessage() {}
+
+func (x *GetSecretUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSecretUsageRequest.ProtoReflect.Descriptor instead.
+func (*GetSecretUsageRequest) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_secrets_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetSecretUsageRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetSecretUsageRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *GetSecretUsageRequest) GetCluster() string {
+	if x != nil {
+		return x.Cluster
+	}
+	return ""
+}
+
+// SecretReference is one place a workload's pod template uses a secret.
+type SecretReference struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// type is how the secret is referenced: "env", "envFrom", "volume", or
+	// "imagePullSecret".
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// container is the container holding an env or envFrom reference,
+	// including init and ephemeral containers. Empty for the other types.
+	Container string `protobuf:"bytes,2,opt,name=container,proto3" json:"container,omitempty"`
+	// name is the environment variable for "env" and the volume name for
+	// "volume".
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// key is the secret data key an "env" reference reads.
+	Key           string `protobuf:"bytes,4,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SecretReference) Reset() {
+	*x = SecretReference{}
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecretReference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecretReference) ProtoMessage() {}
+
+func (x *SecretReference) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *SecretMetadata) GetDescription() string {
-	if x != nil && x.Description != nil {
-		return *x.Description
+// Deprecated: Use SecretReference.ProtoReflect.Descriptor instead.
+func (*SecretReference) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_secrets_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *SecretReference) GetType() string {
+	if x != nil {
+		return x.Type
 	}
 	return ""
 }
 
-func (x *SecretMetadata) GetUrl() string {
-	if x != nil && x.Url != nil {
-		return *x.Url
+func (x *SecretReference) GetContainer() string {
+	if x != nil {
+		return x.Container
 	}
 	return ""
 }
 
-func (x *SecretMetadata) GetCreatedAt() string {
+func (x *SecretReference) GetName() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.Name
 	}
 	return ""
 }
 
-// ShareGrant represents a sharing grant for a principal (user email or role name).
-type ShareGrant struct {
+func (x *SecretReference) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+// SecretConsumer is a workload that references the secret.
+type SecretConsumer struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// principal is the email address (for users) or role name (for roles).
-	Principal string `protobuf:"bytes,1,opt,name=principal,proto3" json:"principal,omitempty"`
-	// role is the permission level granted to the principal.
-	Role Role `protobuf:"varint,2,opt,name=role,proto3,enum=holos.console.v1.Role" json:"role,omitempty"`
-	// nbf (not before) is the unix timestamp before which the grant is inactive.
-	// When unset, the grant has no start time restriction.
-	Nbf *int64 `protobuf:"varint,3,opt,name=nbf,proto3,oneof" json:"nbf,omitempty"`
-	// exp (expiration) is the unix timestamp at or after which the grant is inactive.
-	// When unset, the grant has no expiration.
-	Exp           *int64 `protobuf:"varint,4,opt,name=exp,proto3,oneof" json:"exp,omitempty"`
+	// kind is Deployment, StatefulSet, DaemonSet, or CronJob.
+	Kind string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	// name is the workload name.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// references lists every reference to the secret in the pod template.
+	References    []*SecretReference `protobuf:"bytes,3,rep,name=references,proto3" json:"references,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ShareGrant) Reset() {
-	*x = ShareGrant{}
-	mi := &file_holos_console_v1_secrets_proto_msgTypes[11]
+func (x *SecretConsumer) Reset() {
+	*x = SecretConsumer{}
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ShareGrant) String() string {
+func (x *SecretConsumer) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ShareGrant) ProtoMessage() {}
+func (*SecretConsumer) ProtoMessage() {}
 
-func (x *ShareGrant) ProtoReflect() protoreflect.Message {
-	mi := &file_holos_console_v1_secrets_proto_msgTypes[11]
+func (x *SecretConsumer) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -743,69 +4158,176 @@ func (x *ShareGrant) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ShareGrant.ProtoReflect.Descriptor instead.
-func (*ShareGrant) Descriptor() ([]byte, []int) {
-	return file_holos_console_v1_secrets_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use SecretConsumer.ProtoReflect.Descriptor instead.
+func (*SecretConsumer) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_secrets_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *ShareGrant) GetPrincipal() string {
+func (x *SecretConsumer) GetKind() string {
 	if x != nil {
-		return x.Principal
+		return x.Kind
 	}
 	return ""
 }
 
-func (x *ShareGrant) GetRole() Role {
+func (x *SecretConsumer) GetName() string {
 	if x != nil {
-		return x.Role
+		return x.Name
 	}
-	return Role_ROLE_UNSPECIFIED
+	return ""
 }
 
-func (x *ShareGrant) GetNbf() int64 {
-	if x != nil && x.Nbf != nil {
-		return *x.Nbf
+func (x *SecretConsumer) GetReferences() []*SecretReference {
+	if x != nil {
+		return x.References
 	}
-	return 0
+	return nil
 }
 
-func (x *ShareGrant) GetExp() int64 {
-	if x != nil && x.Exp != nil {
-		return *x.Exp
+// GetSecretUsageResponse lists the workloads that use the secret, ordered by
+// kind, then name.
+type GetSecretUsageResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// consumers are the workloads referencing the secret.
+	Consumers []*SecretConsumer `protobuf:"bytes,1,rep,name=consumers,proto3" json:"consumers,omitempty"`
+	// unscanned_kinds are the workload kinds the caller may not list. Their
+	// consumers, if any, are not reported.
+	UnscannedKinds []string `protobuf:"bytes,2,rep,name=unscanned_kinds,json=unscannedKinds,proto3" json:"unscanned_kinds,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetSecretUsageResponse) Reset() {
+	*x = GetSecretUsageResponse{}
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSecretUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSecretUsageResponse) ProtoMessage() {}
+
+func (x *GetSecretUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-// UpdateSharingRequest contains the sharing grants to set on a secret.
-type UpdateSharingRequest struct {
+// Deprecated: Use GetSecretUsageResponse.ProtoReflect.Descriptor instead.
+func (*GetSecretUsageResponse) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_secrets_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *GetSecretUsageResponse) GetConsumers() []*SecretConsumer {
+	if x != nil {
+		return x.Consumers
+	}
+	return nil
+}
+
+func (x *GetSecretUsageResponse) GetUnscannedKinds() []string {
+	if x != nil {
+		return x.UnscannedKinds
+	}
+	return nil
+}
+
+// ListDeletedSecretsRequest selects the project whose trash to list.
+type ListDeletedSecretsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// name is the name of the secret to update sharing for.
+	// project is the project (namespace) to list.
+	Project string `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	// cluster names the registered cluster to act in. Empty targets the
+	// cluster the console runs in.
+	Cluster       string `protobuf:"bytes,2,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeletedSecretsRequest) Reset() {
+	*x = ListDeletedSecretsRequest{}
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeletedSecretsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedSecretsRequest) ProtoMessage() {}
+
+func (x *ListDeletedSecretsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedSecretsRequest.ProtoReflect.Descriptor instead.
+func (*ListDeletedSecretsRequest) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_secrets_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ListDeletedSecretsRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *ListDeletedSecretsRequest) GetCluster() string {
+	if x != nil {
+		return x.Cluster
+	}
+	return ""
+}
+
+// DeletedSecret describes a soft-deleted secret.
+type DeletedSecret struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name is the name of the secret.
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// user_grants are the per-user sharing grants to set.
-	UserGrants []*ShareGrant `protobuf:"bytes,2,rep,name=user_grants,json=userGrants,proto3" json:"user_grants,omitempty"`
-	// role_grants are the per-role sharing grants to set.
-	RoleGrants []*ShareGrant `protobuf:"bytes,3,rep,name=role_grants,json=roleGrants,proto3" json:"role_grants,omitempty"`
-	// project is the project (namespace) containing the secret.
-	Project       string `protobuf:"bytes,4,opt,name=project,proto3" json:"project,omitempty"`
+	// deleted_at is when the secret was deleted, in RFC 3339 format.
+	DeletedAt string `protobuf:"bytes,2,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	// deleted_by is the email of the principal who deleted the secret.
+	DeletedBy string `protobuf:"bytes,3,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"`
+	// purge_at is when the secret will be permanently deleted, in RFC 3339
+	// format.
+	PurgeAt       string `protobuf:"bytes,4,opt,name=purge_at,json=purgeAt,proto3" json:"purge_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateSharingRequest) Reset() {
-	*x = UpdateSharingRequest{}
-	mi := &file_holos_console_v1_secrets_proto_msgTypes[12]
+func (x *DeletedSecret) Reset() {
+	*x = DeletedSecret{}
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateSharingRequest) String() string {
+func (x *DeletedSecret) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateSharingRequest) ProtoMessage() {}
+func (*DeletedSecret) ProtoMessage() {}
 
-func (x *UpdateSharingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_holos_console_v1_secrets_proto_msgTypes[12]
+func (x *DeletedSecret) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -816,63 +4338,63 @@ func (x *UpdateSharingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateSharingRequest.ProtoReflect.Descriptor instead.
-func (*UpdateSharingRequest) Descriptor() ([]byte, []int) {
-	return file_holos_console_v1_secrets_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use DeletedSecret.ProtoReflect.Descriptor instead.
+func (*DeletedSecret) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_secrets_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *UpdateSharingRequest) GetName() string {
+func (x *DeletedSecret) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *UpdateSharingRequest) GetUserGrants() []*ShareGrant {
+func (x *DeletedSecret) GetDeletedAt() string {
 	if x != nil {
-		return x.UserGrants
+		return x.DeletedAt
 	}
-	return nil
+	return ""
 }
 
-func (x *UpdateSharingRequest) GetRoleGrants() []*ShareGrant {
+func (x *DeletedSecret) GetDeletedBy() string {
 	if x != nil {
-		return x.RoleGrants
+		return x.DeletedBy
 	}
-	return nil
+	return ""
 }
 
-func (x *UpdateSharingRequest) GetProject() string {
+func (x *DeletedSecret) GetPurgeAt() string {
 	if x != nil {
-		return x.Project
+		return x.PurgeAt
 	}
 	return ""
 }
 
-// UpdateSharingResponse contains the updated secret metadata.
-type UpdateSharingResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// metadata contains the updated secret metadata including new sharing grants.
-	Metadata      *SecretMetadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+// ListDeletedSecretsResponse lists a project's soft-deleted secrets, most
+// recently deleted first.
+type ListDeletedSecretsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Secrets       []*DeletedSecret       `protobuf:"bytes,1,rep,name=secrets,proto3" json:"secrets,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateSharingResponse) Reset() {
-	*x = UpdateSharingResponse{}
-	mi := &file_holos_console_v1_secrets_proto_msgTypes[13]
+func (x *ListDeletedSecretsResponse) Reset() {
+	*x = ListDeletedSecretsResponse{}
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateSharingResponse) String() string {
+func (x *ListDeletedSecretsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateSharingResponse) ProtoMessage() {}
+func (*ListDeletedSecretsResponse) ProtoMessage() {}
 
-func (x *UpdateSharingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_holos_console_v1_secrets_proto_msgTypes[13]
+func (x *ListDeletedSecretsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -883,44 +4405,47 @@ func (x *UpdateSharingResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateSharingResponse.ProtoReflect.Descriptor instead.
-func (*UpdateSharingResponse) Descriptor() ([]byte, []int) {
-	return file_holos_console_v1_secrets_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use ListDeletedSecretsResponse.ProtoReflect.Descriptor instead.
+func (*ListDeletedSecretsResponse) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_secrets_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *UpdateSharingResponse) GetMetadata() *SecretMetadata {
+func (x *ListDeletedSecretsResponse) GetSecrets() []*DeletedSecret {
 	if x != nil {
-		return x.Metadata
+		return x.Secrets
 	}
 	return nil
 }
 
-// GetSecretRawRequest contains the name of the secret to retrieve as raw JSON.
-type GetSecretRawRequest struct {
+// RestoreSecretRequest identifies the soft-deleted secret to restore.
+type RestoreSecretRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// name is the name of the secret to retrieve.
+	// name is the name of the secret to restore.
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	// project is the project (namespace) containing the secret.
-	Project       string `protobuf:"bytes,2,opt,name=project,proto3" json:"project,omitempty"`
+	Project string `protobuf:"bytes,2,opt,name=project,proto3" json:"project,omitempty"`
+	// cluster names the registered cluster to act in. Empty targets the
+	// cluster the console runs in.
+	Cluster       string `protobuf:"bytes,3,opt,name=cluster,proto3" json:"cluster,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSecretRawRequest) Reset() {
-	*x = GetSecretRawRequest{}
-	mi := &file_holos_console_v1_secrets_proto_msgTypes[14]
+func (x *RestoreSecretRequest) Reset() {
+	*x = RestoreSecretRequest{}
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSecretRawRequest) String() string {
+func (x *RestoreSecretRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSecretRawRequest) ProtoMessage() {}
+func (*RestoreSecretRequest) ProtoMessage() {}
 
-func (x *GetSecretRawRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_holos_console_v1_secrets_proto_msgTypes[14]
+func (x *RestoreSecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -931,49 +4456,54 @@ func (x *GetSecretRawRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSecretRawRequest.ProtoReflect.Descriptor instead.
-func (*GetSecretRawRequest) Descriptor() ([]byte, []int) {
-	return file_holos_console_v1_secrets_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use RestoreSecretRequest.ProtoReflect.Descriptor instead.
+func (*RestoreSecretRequest) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_secrets_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *GetSecretRawRequest) GetName() string {
+func (x *RestoreSecretRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *GetSecretRawRequest) GetProject() string {
+func (x *RestoreSecretRequest) GetProject() string {
 	if x != nil {
 		return x.Project
 	}
 	return ""
 }
 
-// GetSecretRawResponse contains the full Kubernetes Secret object as JSON.
-type GetSecretRawResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// raw is the verbatim JSON-serialized Secret object from the K8s API.
-	Raw           string `protobuf:"bytes,1,opt,name=raw,proto3" json:"raw,omitempty"`
+func (x *RestoreSecretRequest) GetCluster() string {
+	if x != nil {
+		return x.Cluster
+	}
+	return ""
+}
+
+// RestoreSecretResponse is empty on success.
+type RestoreSecretResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSecretRawResponse) Reset() {
-	*x = GetSecretRawResponse{}
-	mi := &file_holos_console_v1_secrets_proto_msgTypes[15]
+func (x *RestoreSecretResponse) Reset() {
+	*x = RestoreSecretResponse{}
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSecretRawResponse) String() string {
+func (x *RestoreSecretResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSecretRawResponse) ProtoMessage() {}
+func (*RestoreSecretResponse) ProtoMessage() {}
 
-func (x *GetSecretRawResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_holos_console_v1_secrets_proto_msgTypes[15]
+func (x *RestoreSecretResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_holos_console_v1_secrets_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -984,78 +4514,252 @@ func (x *GetSecretRawResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSecretRawResponse.ProtoReflect.Descriptor instead.
-func (*GetSecretRawResponse) Descriptor() ([]byte, []int) {
-	return file_holos_console_v1_secrets_proto_rawDescGZIP(), []int{15}
-}
-
-func (x *GetSecretRawResponse) GetRaw() string {
-	if x != nil {
-		return x.Raw
-	}
-	return ""
+// Deprecated: Use RestoreSecretResponse.ProtoReflect.Descriptor instead.
+func (*RestoreSecretResponse) Descriptor() ([]byte, []int) {
+	return file_holos_console_v1_secrets_proto_rawDescGZIP(), []int{59}
 }
 
 var File_holos_console_v1_secrets_proto protoreflect.FileDescriptor
 
 const file_holos_console_v1_secrets_proto_rawDesc = "" +
 	"\n" +
-	"\x1eholos/console/v1/secrets.proto\x12\x10holos.console.v1\x1a\x1bholos/console/v1/rbac.proto\"@\n" +
-	"\x10GetSecretRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
-	"\aproject\x18\x02 \x01(\tR\aproject\"\x8f\x01\n" +
+	"\x1eholos/console/v1/secrets.proto\x12\x10holos.console.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1bholos/console/v1/diff.proto\x1a\"holos/console/v1/list_filter.proto\x1a!holos/console/v1/raw_format.proto\x1a\x1bholos/console/v1/rbac.proto\"\xd6\x01\n" +
+	"\x10GetSecretRequest\x12b\n" +
+	"\x04name\x18\x01 \x01(\tBN\xbaHK\xc8\x01\x01rF\x18\xfd\x012A^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$R\x04name\x12 \n" +
+	"\aproject\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\aproject\x12\x18\n" +
+	"\acluster\x18\x03 \x01(\tR\acluster\x12\"\n" +
+	"\rif_none_match\x18\x04 \x01(\tR\vifNoneMatch\"\xc6\x01\n" +
 	"\x11GetSecretResponse\x12A\n" +
-	"\x04data\x18\x01 \x03(\v2-.holos.console.v1.GetSecretResponse.DataEntryR\x04data\x1a7\n" +
+	"\x04data\x18\x01 \x03(\v2-.holos.console.v1.GetSecretResponse.DataEntryR\x04data\x12\x12\n" +
+	"\x04etag\x18\x02 \x01(\tR\x04etag\x12!\n" +
+	"\fnot_modified\x18\x03 \x01(\bR\vnotModified\x1a7\n" +
 	"\tDataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\fR\x05value:\x028\x01\".\n" +
-	"\x12ListSecretsRequest\x12\x18\n" +
-	"\aproject\x18\x01 \x01(\tR\aproject\"Q\n" +
+	"\x05value\x18\x02 \x01(\fR\x05value:\x028\x01\"\xe2\x01\n" +
+	"\x12ListSecretsRequest\x12 \n" +
+	"\aproject\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\aproject\x12\x18\n" +
+	"\acluster\x18\x02 \x01(\tR\acluster\x124\n" +
+	"\x06filter\x18\x03 \x01(\v2\x1c.holos.console.v1.ListFilterR\x06filter\x126\n" +
+	"\border_by\x18\x04 \x01(\v2\x1b.holos.console.v1.ListOrderR\aorderBy\x12\"\n" +
+	"\rif_none_match\x18\x05 \x01(\tR\vifNoneMatch\"\x88\x01\n" +
 	"\x13ListSecretsResponse\x12:\n" +
-	"\asecrets\x18\x01 \x03(\v2 .holos.console.v1.SecretMetadataR\asecrets\"\xae\x03\n" +
-	"\x13UpdateSecretRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12C\n" +
-	"\x04data\x18\x02 \x03(\v2/.holos.console.v1.UpdateSecretRequest.DataEntryR\x04data\x12V\n" +
-	"\vstring_data\x18\x03 \x03(\v25.holos.console.v1.UpdateSecretRequest.StringDataEntryR\n" +
-	"stringData\x12%\n" +
-	"\vdescription\x18\x04 \x01(\tH\x00R\vdescription\x88\x01\x01\x12\x15\n" +
-	"\x03url\x18\x05 \x01(\tH\x01R\x03url\x88\x01\x01\x12\x18\n" +
-	"\aproject\x18\x06 \x01(\tR\aproject\x1a7\n" +
+	"\asecrets\x18\x01 \x03(\v2 .holos.console.v1.SecretMetadataR\asecrets\x12\x12\n" +
+	"\x04etag\x18\x02 \x01(\tR\x04etag\x12!\n" +
+	"\fnot_modified\x18\x03 \x01(\bR\vnotModified\"\x8c\x06\n" +
+	"\x13UpdateSecretRequest\x12b\n" +
+	"\x04name\x18\x01 \x01(\tBN\xbaHK\xc8\x01\x01rF\x18\xfd\x012A^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$R\x04name\x12S\n" +
+	"\x04data\x18\x02 \x03(\v2/.holos.console.v1.UpdateSecretRequest.DataEntryB\x0e\xbaH\v\x9a\x01\b*\x06z\x04\x18\x80\x80@R\x04data\x12f\n" +
+	"\vstring_data\x18\x03 \x03(\v25.holos.console.v1.UpdateSecretRequest.StringDataEntryB\x0e\xbaH\v\x9a\x01\b*\x06r\x04(\x80\x80@R\n" +
+	"stringData\x12/\n" +
+	"\vdescription\x18\x04 \x01(\tB\b\xbaH\x05r\x03\x18\x80 H\x00R\vdescription\x88\x01\x01\x12\x1f\n" +
+	"\x03url\x18\x05 \x01(\tB\b\xbaH\x05r\x03\x18\x80\x10H\x01R\x03url\x88\x01\x01\x12 \n" +
+	"\aproject\x18\x06 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\aproject\x12\x17\n" +
+	"\adry_run\x18\a \x01(\bR\x06dryRun\x12\x18\n" +
+	"\acluster\x18\b \x01(\tR\acluster\x12\\\n" +
+	"\rcontent_types\x18\t \x03(\v27.holos.console.v1.UpdateSecretRequest.ContentTypesEntryR\fcontentTypes\x1a7\n" +
 	"\tDataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\fR\x05value:\x028\x01\x1a=\n" +
 	"\x0fStringDataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a?\n" +
+	"\x11ContentTypesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\x0e\n" +
 	"\f_descriptionB\x06\n" +
 	"\x04_url\"\x16\n" +
-	"\x14UpdateSecretResponse\"\xac\x04\n" +
-	"\x13CreateSecretRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12C\n" +
-	"\x04data\x18\x02 \x03(\v2/.holos.console.v1.CreateSecretRequest.DataEntryR\x04data\x12V\n" +
-	"\vstring_data\x18\x03 \x03(\v25.holos.console.v1.CreateSecretRequest.StringDataEntryR\n" +
+	"\x14UpdateSecretResponse\"\xbf\x05\n" +
+	"\x12PatchSecretRequest\x12b\n" +
+	"\x04name\x18\x01 \x01(\tBN\xbaHK\xc8\x01\x01rF\x18\xfd\x012A^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$R\x04name\x12 \n" +
+	"\aproject\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\aproject\x12R\n" +
+	"\x04data\x18\x03 \x03(\v2..holos.console.v1.PatchSecretRequest.DataEntryB\x0e\xbaH\v\x9a\x01\b*\x06z\x04\x18\x80\x80@R\x04data\x12e\n" +
+	"\vstring_data\x18\x04 \x03(\v24.holos.console.v1.PatchSecretRequest.StringDataEntryB\x0e\xbaH\v\x9a\x01\b*\x06r\x04(\x80\x80@R\n" +
+	"stringData\x12\x1f\n" +
+	"\vremove_keys\x18\x05 \x03(\tR\n" +
+	"removeKeys\x12\x17\n" +
+	"\adry_run\x18\x06 \x01(\bR\x06dryRun\x12\x18\n" +
+	"\acluster\x18\a \x01(\tR\acluster\x12[\n" +
+	"\rcontent_types\x18\b \x03(\v26.holos.console.v1.PatchSecretRequest.ContentTypesEntryR\fcontentTypes\x1a7\n" +
+	"\tDataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\fR\x05value:\x028\x01\x1a=\n" +
+	"\x0fStringDataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a?\n" +
+	"\x11ContentTypesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\")\n" +
+	"\x13PatchSecretResponse\x12\x12\n" +
+	"\x04keys\x18\x01 \x03(\tR\x04keys\"\xe3\x02\n" +
+	"\x16AppendSecretKeyRequest\x12b\n" +
+	"\x04name\x18\x01 \x01(\tBN\xbaHK\xc8\x01\x01rF\x18\xfd\x012A^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$R\x04name\x12 \n" +
+	"\aproject\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\aproject\x12\x18\n" +
+	"\x03key\x18\x03 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x03key\x12\x1f\n" +
+	"\x06offset\x18\x04 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\x06offset\x12*\n" +
+	"\n" +
+	"total_size\x18\x05 \x01(\x03B\v\xbaH\b\"\x06\x18\x80\x80@ \x00R\ttotalSize\x12\x1f\n" +
+	"\x05chunk\x18\x06 \x01(\fB\t\xbaH\x06z\x04\x18\x80\x80\x10R\x05chunk\x12!\n" +
+	"\fcontent_type\x18\a \x01(\tR\vcontentType\x12\x18\n" +
+	"\acluster\x18\b \x01(\tR\acluster\"I\n" +
+	"\x17AppendSecretKeyResponse\x12\x12\n" +
+	"\x04size\x18\x01 \x01(\x03R\x04size\x12\x1a\n" +
+	"\bcomplete\x18\x02 \x01(\bR\bcomplete\"\x9c\t\n" +
+	"\x13CreateSecretRequest\x12b\n" +
+	"\x04name\x18\x01 \x01(\tBN\xbaHK\xc8\x01\x01rF\x18\xfd\x012A^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$R\x04name\x12S\n" +
+	"\x04data\x18\x02 \x03(\v2/.holos.console.v1.CreateSecretRequest.DataEntryB\x0e\xbaH\v\x9a\x01\b*\x06z\x04\x18\x80\x80@R\x04data\x12f\n" +
+	"\vstring_data\x18\x03 \x03(\v25.holos.console.v1.CreateSecretRequest.StringDataEntryB\x0e\xbaH\v\x9a\x01\b*\x06r\x04(\x80\x80@R\n" +
 	"stringData\x12=\n" +
 	"\vuser_grants\x18\x04 \x03(\v2\x1c.holos.console.v1.ShareGrantR\n" +
 	"userGrants\x12=\n" +
 	"\vrole_grants\x18\x05 \x03(\v2\x1c.holos.console.v1.ShareGrantR\n" +
-	"roleGrants\x12%\n" +
-	"\vdescription\x18\x06 \x01(\tH\x00R\vdescription\x88\x01\x01\x12\x15\n" +
-	"\x03url\x18\a \x01(\tH\x01R\x03url\x88\x01\x01\x12\x18\n" +
-	"\aproject\x18\b \x01(\tR\aproject\x1a7\n" +
+	"roleGrants\x12/\n" +
+	"\vdescription\x18\x06 \x01(\tB\b\xbaH\x05r\x03\x18\x80 H\x00R\vdescription\x88\x01\x01\x12\x1f\n" +
+	"\x03url\x18\a \x01(\tB\b\xbaH\x05r\x03\x18\x80\x10H\x01R\x03url\x88\x01\x01\x12 \n" +
+	"\aproject\x18\b \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\aproject\x12\x17\n" +
+	"\adry_run\x18\t \x01(\bR\x06dryRun\x12O\n" +
+	"\bgenerate\x18\n" +
+	" \x03(\v23.holos.console.v1.CreateSecretRequest.GenerateEntryR\bgenerate\x12\x18\n" +
+	"\acluster\x18\v \x01(\tR\acluster\x12\\\n" +
+	"\rcontent_types\x18\f \x03(\v27.holos.console.v1.CreateSecretRequest.ContentTypesEntryR\fcontentTypes\x12\x12\n" +
+	"\x04type\x18\r \x01(\tR\x04type\x12N\n" +
+	"\x0fdocker_registry\x18\x0e \x01(\v2%.holos.console.v1.RegistryCredentialsR\x0edockerRegistry\x1a7\n" +
 	"\tDataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\fR\x05value:\x028\x01\x1a=\n" +
 	"\x0fStringDataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a[\n" +
+	"\rGenerateEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x124\n" +
+	"\x05value\x18\x02 \x01(\v2\x1e.holos.console.v1.GenerateSpecR\x05value:\x028\x01\x1a?\n" +
+	"\x11ContentTypesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\x0e\n" +
 	"\f_descriptionB\x06\n" +
-	"\x04_url\"*\n" +
+	"\x04_url\"\x98\x01\n" +
+	"\x13RegistryCredentials\x12#\n" +
+	"\x06server\x18\x01 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\x18\x80\x10R\x06server\x12\"\n" +
+	"\busername\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\busername\x12\"\n" +
+	"\bpassword\x18\x03 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\bpassword\x12\x14\n" +
+	"\x05email\x18\x04 \x01(\tR\x05email\"z\n" +
+	"\fGenerateSpec\x128\n" +
+	"\x06format\x18\x01 \x01(\x0e2 .holos.console.v1.GenerateFormatR\x06format\x12\x16\n" +
+	"\x06length\x18\x02 \x01(\x05R\x06length\x12\x18\n" +
+	"\acharset\x18\x03 \x01(\tR\acharset\"\xd6\x01\n" +
 	"\x14CreateSecretResponse\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\"C\n" +
-	"\x13DeleteSecretRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12f\n" +
+	"\x10generated_values\x18\x02 \x03(\v2;.holos.console.v1.CreateSecretResponse.GeneratedValuesEntryR\x0fgeneratedValues\x1aB\n" +
+	"\x14GeneratedValuesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xe0\x03\n" +
+	"\x19CreateSSHKeySecretRequest\x12b\n" +
+	"\x04name\x18\x01 \x01(\tBN\xbaHK\xc8\x01\x01rF\x18\xfd\x012A^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$R\x04name\x12 \n" +
+	"\aproject\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\aproject\x12\"\n" +
+	"\acomment\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\x80\x02R\acomment\x12=\n" +
+	"\vuser_grants\x18\x04 \x03(\v2\x1c.holos.console.v1.ShareGrantR\n" +
+	"userGrants\x12=\n" +
+	"\vrole_grants\x18\x05 \x03(\v2\x1c.holos.console.v1.ShareGrantR\n" +
+	"roleGrants\x12/\n" +
+	"\vdescription\x18\x06 \x01(\tB\b\xbaH\x05r\x03\x18\x80 H\x00R\vdescription\x88\x01\x01\x12\x1f\n" +
+	"\x03url\x18\a \x01(\tB\b\xbaH\x05r\x03\x18\x80\x10H\x01R\x03url\x88\x01\x01\x12\x17\n" +
+	"\adry_run\x18\b \x01(\bR\x06dryRun\x12\x18\n" +
+	"\acluster\x18\t \x01(\tR\aclusterB\x0e\n" +
+	"\f_descriptionB\x06\n" +
+	"\x04_url\"q\n" +
+	"\x1aCreateSSHKeySecretResponse\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"public_key\x18\x02 \x01(\tR\tpublicKey\x12 \n" +
+	"\vfingerprint\x18\x03 \x01(\tR\vfingerprint\"\xbb\x01\n" +
+	"\x19ExportSecretSealedRequest\x12b\n" +
+	"\x04name\x18\x01 \x01(\tBN\xbaHK\xc8\x01\x01rF\x18\xfd\x012A^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$R\x04name\x12 \n" +
+	"\aproject\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\aproject\x12\x18\n" +
+	"\acluster\x18\x03 \x01(\tR\acluster\"q\n" +
+	"\x1aExportSecretSealedResponse\x12\x1a\n" +
+	"\bmanifest\x18\x01 \x01(\tR\bmanifest\x127\n" +
+	"\x17certificate_fingerprint\x18\x02 \x01(\tR\x16certificateFingerprint\"\xe0\x01\n" +
+	"\x16CreateShareLinkRequest\x12b\n" +
+	"\x04name\x18\x01 \x01(\tBN\xbaHK\xc8\x01\x01rF\x18\xfd\x012A^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$R\x04name\x12 \n" +
+	"\aproject\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\aproject\x12,\n" +
+	"\vttl_seconds\x18\x03 \x01(\x03B\v\xbaH\b\"\x06\x18\x80\xf5$(\x00R\n" +
+	"ttlSeconds\x12\x12\n" +
+	"\x04keys\x18\x04 \x03(\tR\x04keys\"J\n" +
+	"\x17CreateShareLinkResponse\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\tR\texpiresAt\"}\n" +
+	"\x15ApplySecretRawRequest\x12 \n" +
+	"\aproject\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\aproject\x12)\n" +
+	"\bmanifest\x18\x02 \x01(\tB\r\xbaH\n" +
+	"\xc8\x01\x01r\x05(\x80\x80\x80\x02R\bmanifest\x12\x17\n" +
+	"\adry_run\x18\x03 \x01(\bR\x06dryRun\"F\n" +
+	"\x16ApplySecretRawResponse\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
-	"\aproject\x18\x02 \x01(\tR\aproject\"\x16\n" +
-	"\x14DeleteSecretResponse\"\xb7\x02\n" +
+	"\acreated\x18\x02 \x01(\bR\acreated\"\xa4\x03\n" +
+	"\x11DiffSecretRequest\x12\x1a\n" +
+	"\x04name\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x04name\x12 \n" +
+	"\aproject\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\aproject\x12Z\n" +
+	"\rproposed_data\x18\x03 \x03(\v25.holos.console.v1.DiffSecretRequest.ProposedDataEntryR\fproposedData\x12m\n" +
+	"\x14proposed_string_data\x18\x04 \x03(\v2;.holos.console.v1.DiffSecretRequest.ProposedStringDataEntryR\x12proposedStringData\x1a?\n" +
+	"\x11ProposedDataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\fR\x05value:\x028\x01\x1aE\n" +
+	"\x17ProposedStringDataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x8f\x01\n" +
+	"\x0fSecretKeyChange\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x124\n" +
+	"\x06change\x18\x02 \x01(\x0e2\x1c.holos.console.v1.ChangeTypeR\x06change\x12\x19\n" +
+	"\bold_size\x18\x03 \x01(\x03R\aoldSize\x12\x19\n" +
+	"\bnew_size\x18\x04 \x01(\x03R\anewSize\"x\n" +
+	"\x12DiffSecretResponse\x12;\n" +
+	"\achanges\x18\x01 \x03(\v2!.holos.console.v1.SecretKeyChangeR\achanges\x12%\n" +
+	"\x0eunchanged_keys\x18\x02 \x01(\x05R\runchangedKeys\"\xe4\x01\n" +
+	"\x13DeleteSecretRequest\x12b\n" +
+	"\x04name\x18\x01 \x01(\tBN\xbaHK\xc8\x01\x01rF\x18\xfd\x012A^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$R\x04name\x12 \n" +
+	"\aproject\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\aproject\x12\x17\n" +
+	"\adry_run\x18\x03 \x01(\bR\x06dryRun\x12\x18\n" +
+	"\acluster\x18\x04 \x01(\tR\acluster\x12\x14\n" +
+	"\x05force\x18\x05 \x01(\bR\x05force\"\x16\n" +
+	"\x14DeleteSecretResponse\">\n" +
+	"\x0eBatchItemError\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xc2\x01\n" +
+	"\x16BatchGetSecretsRequest\x12l\n" +
+	"\x05names\x18\x01 \x03(\tBV\xbaHS\x92\x01P\b\x01\x10d\x18\x01\"HrF\x18\xfd\x012A^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$R\x05names\x12 \n" +
+	"\aproject\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\aproject\x12\x18\n" +
+	"\acluster\x18\x03 \x01(\tR\acluster\"[\n" +
+	"\x17BatchGetSecretsResponse\x12@\n" +
+	"\aresults\x18\x01 \x03(\v2&.holos.console.v1.BatchGetSecretResultR\aresults\"\xe1\x01\n" +
+	"\x14BatchGetSecretResult\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12D\n" +
+	"\x04data\x18\x02 \x03(\v20.holos.console.v1.BatchGetSecretResult.DataEntryR\x04data\x126\n" +
+	"\x05error\x18\x03 \x01(\v2 .holos.console.v1.BatchItemErrorR\x05error\x1a7\n" +
+	"\tDataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\fR\x05value:\x028\x01\"\xf4\x01\n" +
+	"\x19BatchDeleteSecretsRequest\x12l\n" +
+	"\x05names\x18\x01 \x03(\tBV\xbaHS\x92\x01P\b\x01\x10d\x18\x01\"HrF\x18\xfd\x012A^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$R\x05names\x12 \n" +
+	"\aproject\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\aproject\x12\x17\n" +
+	"\adry_run\x18\x03 \x01(\bR\x06dryRun\x12\x18\n" +
+	"\acluster\x18\x04 \x01(\tR\acluster\x12\x14\n" +
+	"\x05force\x18\x05 \x01(\bR\x05force\"a\n" +
+	"\x1aBatchDeleteSecretsResponse\x12C\n" +
+	"\aresults\x18\x01 \x03(\v2).holos.console.v1.BatchDeleteSecretResultR\aresults\"e\n" +
+	"\x17BatchDeleteSecretResult\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x126\n" +
+	"\x05error\x18\x02 \x01(\v2 .holos.console.v1.BatchItemErrorR\x05error\"M\n" +
+	"\vSecretInUse\x12>\n" +
+	"\tconsumers\x18\x01 \x03(\v2 .holos.console.v1.SecretConsumerR\tconsumers\"\xd6\x01\n" +
+	"\x0eSecretTooLarge\x12\x1d\n" +
+	"\n" +
+	"size_bytes\x18\x01 \x01(\x03R\tsizeBytes\x12#\n" +
+	"\rcurrent_bytes\x18\x02 \x01(\x03R\fcurrentBytes\x12\x1f\n" +
+	"\vlimit_bytes\x18\x03 \x01(\x03R\n" +
+	"limitBytes\x12'\n" +
+	"\x0fremaining_bytes\x18\x04 \x01(\x03R\x0eremainingBytes\x12\x1b\n" +
+	"\tkey_count\x18\x05 \x01(\x05R\bkeyCount\x12\x19\n" +
+	"\bmax_keys\x18\x06 \x01(\x05R\amaxKeys\"\xb1\b\n" +
 	"\x0eSecretMetadata\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1e\n" +
 	"\n" +
@@ -1068,39 +4772,175 @@ const file_holos_console_v1_secrets_proto_rawDesc = "" +
 	"\vdescription\x18\a \x01(\tH\x00R\vdescription\x88\x01\x01\x12\x15\n" +
 	"\x03url\x18\b \x01(\tH\x01R\x03url\x88\x01\x01\x12\x1d\n" +
 	"\n" +
-	"created_at\x18\t \x01(\tR\tcreatedAtB\x0e\n" +
+	"created_at\x18\t \x01(\tR\tcreatedAt\x12\x16\n" +
+	"\x06source\x18\n" +
+	" \x01(\tR\x06source\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\tR\tupdatedAt\x12#\n" +
+	"\rcreator_email\x18\f \x01(\tR\fcreatorEmail\x12(\n" +
+	"\x10last_accessed_at\x18\r \x01(\tR\x0elastAccessedAt\x12(\n" +
+	"\x10last_accessed_by\x18\x0e \x01(\tR\x0elastAccessedBy\x12W\n" +
+	"\rcontent_types\x18\x0f \x03(\v22.holos.console.v1.SecretMetadata.ContentTypesEntryR\fcontentTypes\x12\x12\n" +
+	"\x04type\x18\x10 \x01(\tR\x04type\x12I\n" +
+	"\x0ftls_certificate\x18\x11 \x01(\v2 .holos.console.v1.TLSCertificateR\x0etlsCertificate\x12$\n" +
+	"\x0essh_public_key\x18\x12 \x01(\tR\fsshPublicKey\x12'\n" +
+	"\x0fssh_fingerprint\x18\x13 \x01(\tR\x0esshFingerprint\x12\x1d\n" +
+	"\n" +
+	"vault_path\x18\x14 \x01(\tR\tvaultPath\x12\x1d\n" +
+	"\n" +
+	"size_bytes\x18\x15 \x01(\x03R\tsizeBytes\x12(\n" +
+	"\x10size_limit_bytes\x18\x16 \x01(\x03R\x0esizeLimitBytes\x12\x1b\n" +
+	"\tkey_count\x18\x17 \x01(\x05R\bkeyCount\x123\n" +
+	"\tuser_role\x18\x18 \x01(\x0e2\x16.holos.console.v1.RoleR\buserRole\x12F\n" +
+	"\x10user_role_source\x18\x19 \x01(\v2\x1c.holos.console.v1.RoleSourceR\x0euserRoleSource\x1a?\n" +
+	"\x11ContentTypesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\x0e\n" +
 	"\f_descriptionB\x06\n" +
-	"\x04_url\"\x94\x01\n" +
+	"\x04_url\"\xfb\x01\n" +
+	"\x0eTLSCertificate\x12\x18\n" +
+	"\asubject\x18\x01 \x01(\tR\asubject\x12\x16\n" +
+	"\x06issuer\x18\x02 \x01(\tR\x06issuer\x12\x1b\n" +
+	"\tdns_names\x18\x03 \x03(\tR\bdnsNames\x12!\n" +
+	"\fip_addresses\x18\x04 \x03(\tR\vipAddresses\x12'\n" +
+	"\x0femail_addresses\x18\x05 \x03(\tR\x0eemailAddresses\x12\x12\n" +
+	"\x04uris\x18\x06 \x03(\tR\x04uris\x12\x1d\n" +
+	"\n" +
+	"not_before\x18\a \x01(\tR\tnotBefore\x12\x1b\n" +
+	"\tnot_after\x18\b \x01(\tR\bnotAfter\"\xd6\x01\n" +
 	"\n" +
 	"ShareGrant\x12\x1c\n" +
 	"\tprincipal\x18\x01 \x01(\tR\tprincipal\x12*\n" +
 	"\x04role\x18\x02 \x01(\x0e2\x16.holos.console.v1.RoleR\x04role\x12\x15\n" +
 	"\x03nbf\x18\x03 \x01(\x03H\x00R\x03nbf\x88\x01\x01\x12\x15\n" +
-	"\x03exp\x18\x04 \x01(\x03H\x01R\x03exp\x88\x01\x01B\x06\n" +
+	"\x03exp\x18\x04 \x01(\x03H\x01R\x03exp\x88\x01\x01\x12\x12\n" +
+	"\x04keys\x18\x05 \x03(\tR\x04keys\x12\x12\n" +
+	"\x04deny\x18\x06 \x01(\bR\x04deny\x12\x18\n" +
+	"\apending\x18\a \x01(\bR\apendingB\x06\n" +
 	"\x04_nbfB\x06\n" +
-	"\x04_exp\"\xc2\x01\n" +
-	"\x14UpdateSharingRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12=\n" +
+	"\x04_exp\"\xf0\x02\n" +
+	"\x14UpdateSharingRequest\x12b\n" +
+	"\x04name\x18\x01 \x01(\tBN\xbaHK\xc8\x01\x01rF\x18\xfd\x012A^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$R\x04name\x12=\n" +
 	"\vuser_grants\x18\x02 \x03(\v2\x1c.holos.console.v1.ShareGrantR\n" +
 	"userGrants\x12=\n" +
 	"\vrole_grants\x18\x03 \x03(\v2\x1c.holos.console.v1.ShareGrantR\n" +
-	"roleGrants\x12\x18\n" +
-	"\aproject\x18\x04 \x01(\tR\aproject\"U\n" +
+	"roleGrants\x12 \n" +
+	"\aproject\x18\x04 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\aproject\x12\x17\n" +
+	"\adry_run\x18\x05 \x01(\bR\x06dryRun\x12\x18\n" +
+	"\acluster\x18\x06 \x01(\tR\acluster\x12!\n" +
+	"\finvite_users\x18\a \x01(\bR\vinviteUsers\"U\n" +
 	"\x15UpdateSharingResponse\x12<\n" +
-	"\bmetadata\x18\x01 \x01(\v2 .holos.console.v1.SecretMetadataR\bmetadata\"C\n" +
-	"\x13GetSecretRawRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
-	"\aproject\x18\x02 \x01(\tR\aproject\"(\n" +
+	"\bmetadata\x18\x01 \x01(\v2 .holos.console.v1.SecretMetadataR\bmetadata\"\x9c\x02\n" +
+	"\x13GetSecretRawRequest\x12b\n" +
+	"\x04name\x18\x01 \x01(\tBN\xbaHK\xc8\x01\x01rF\x18\xfd\x012A^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$R\x04name\x12 \n" +
+	"\aproject\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\aproject\x12\x18\n" +
+	"\acluster\x18\x03 \x01(\tR\acluster\x123\n" +
+	"\x06format\x18\x04 \x01(\x0e2\x1b.holos.console.v1.RawFormatR\x06format\x120\n" +
+	"\x14strip_managed_fields\x18\x05 \x01(\bR\x12stripManagedFields\"(\n" +
 	"\x14GetSecretRawResponse\x12\x10\n" +
-	"\x03raw\x18\x01 \x01(\tR\x03raw2\xa0\x05\n" +
-	"\x0eSecretsService\x12Z\n" +
-	"\vListSecrets\x12$.holos.console.v1.ListSecretsRequest\x1a%.holos.console.v1.ListSecretsResponse\x12T\n" +
+	"\x03raw\x18\x01 \x01(\tR\x03raw\"\xcf\x01\n" +
+	"\x13GetSecretKeyRequest\x12b\n" +
+	"\x04name\x18\x01 \x01(\tBN\xbaHK\xc8\x01\x01rF\x18\xfd\x012A^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$R\x04name\x12 \n" +
+	"\aproject\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\aproject\x12\x18\n" +
+	"\x03key\x18\x03 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x03key\x12\x18\n" +
+	"\acluster\x18\x04 \x01(\tR\acluster\"O\n" +
+	"\x14GetSecretKeyResponse\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\fR\x05value\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\"\xec\x02\n" +
+	"\x13RotateSecretRequest\x12b\n" +
+	"\x04name\x18\x01 \x01(\tBN\xbaHK\xc8\x01\x01rF\x18\xfd\x012A^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$R\x04name\x12 \n" +
+	"\aproject\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\aproject\x12C\n" +
+	"\x04keys\x18\x03 \x03(\v2/.holos.console.v1.RotateSecretRequest.KeysEntryR\x04keys\x12\x17\n" +
+	"\adry_run\x18\x04 \x01(\bR\x06dryRun\x12\x18\n" +
+	"\acluster\x18\x05 \x01(\tR\acluster\x1aW\n" +
+	"\tKeysEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x124\n" +
+	"\x05value\x18\x02 \x01(\v2\x1e.holos.console.v1.GenerateSpecR\x05value:\x028\x01\"\x8a\x02\n" +
+	"\x14RotateSecretResponse\x12`\n" +
+	"\x0erotated_values\x18\x01 \x03(\v29.holos.console.v1.RotateSecretResponse.RotatedValuesEntryR\rrotatedValues\x12)\n" +
+	"\x10webhook_notified\x18\x02 \x01(\bR\x0fwebhookNotified\x12#\n" +
+	"\rwebhook_error\x18\x03 \x01(\tR\fwebhookError\x1a@\n" +
+	"\x12RotatedValuesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"Y\n" +
+	"\fProjectQuota\x12\x1f\n" +
+	"\vmax_secrets\x18\x01 \x01(\x03R\n" +
+	"maxSecrets\x12(\n" +
+	"\x10max_secret_bytes\x18\x02 \x01(\x03R\x0emaxSecretBytes\"P\n" +
+	"\x11ProjectQuotaUsage\x12\x18\n" +
+	"\asecrets\x18\x01 \x01(\x03R\asecrets\x12!\n" +
+	"\fsecret_bytes\x18\x02 \x01(\x03R\vsecretBytes\"T\n" +
+	"\x16GetProjectQuotaRequest\x12 \n" +
+	"\aproject\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\aproject\x12\x18\n" +
+	"\acluster\x18\x02 \x01(\tR\acluster\"\x8a\x01\n" +
+	"\x17GetProjectQuotaResponse\x124\n" +
+	"\x05limit\x18\x01 \x01(\v2\x1e.holos.console.v1.ProjectQuotaR\x05limit\x129\n" +
+	"\x05usage\x18\x02 \x01(\v2#.holos.console.v1.ProjectQuotaUsageR\x05usage\"\xb7\x01\n" +
+	"\x15GetSecretUsageRequest\x12b\n" +
+	"\x04name\x18\x01 \x01(\tBN\xbaHK\xc8\x01\x01rF\x18\xfd\x012A^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$R\x04name\x12 \n" +
+	"\aproject\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\aproject\x12\x18\n" +
+	"\acluster\x18\x03 \x01(\tR\acluster\"i\n" +
+	"\x0fSecretReference\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x1c\n" +
+	"\tcontainer\x18\x02 \x01(\tR\tcontainer\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x10\n" +
+	"\x03key\x18\x04 \x01(\tR\x03key\"{\n" +
+	"\x0eSecretConsumer\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12A\n" +
+	"\n" +
+	"references\x18\x03 \x03(\v2!.holos.console.v1.SecretReferenceR\n" +
+	"references\"\x81\x01\n" +
+	"\x16GetSecretUsageResponse\x12>\n" +
+	"\tconsumers\x18\x01 \x03(\v2 .holos.console.v1.SecretConsumerR\tconsumers\x12'\n" +
+	"\x0funscanned_kinds\x18\x02 \x03(\tR\x0eunscannedKinds\"W\n" +
+	"\x19ListDeletedSecretsRequest\x12 \n" +
+	"\aproject\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\aproject\x12\x18\n" +
+	"\acluster\x18\x02 \x01(\tR\acluster\"|\n" +
+	"\rDeletedSecret\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"deleted_at\x18\x02 \x01(\tR\tdeletedAt\x12\x1d\n" +
+	"\n" +
+	"deleted_by\x18\x03 \x01(\tR\tdeletedBy\x12\x19\n" +
+	"\bpurge_at\x18\x04 \x01(\tR\apurgeAt\"W\n" +
+	"\x1aListDeletedSecretsResponse\x129\n" +
+	"\asecrets\x18\x01 \x03(\v2\x1f.holos.console.v1.DeletedSecretR\asecrets\"\xb6\x01\n" +
+	"\x14RestoreSecretRequest\x12b\n" +
+	"\x04name\x18\x01 \x01(\tBN\xbaHK\xc8\x01\x01rF\x18\xfd\x012A^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$R\x04name\x12 \n" +
+	"\aproject\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\aproject\x12\x18\n" +
+	"\acluster\x18\x03 \x01(\tR\acluster\"\x17\n" +
+	"\x15RestoreSecretResponse*\x9e\x01\n" +
+	"\x0eGenerateFormat\x12\x1f\n" +
+	"\x1bGENERATE_FORMAT_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18GENERATE_FORMAT_PASSWORD\x10\x01\x12\x17\n" +
+	"\x13GENERATE_FORMAT_HEX\x10\x02\x12\x1a\n" +
+	"\x16GENERATE_FORMAT_BASE64\x10\x03\x12\x18\n" +
+	"\x14GENERATE_FORMAT_UUID\x10\x042\xb7\x11\n" +
+	"\x0eSecretsService\x12_\n" +
+	"\vListSecrets\x12$.holos.console.v1.ListSecretsRequest\x1a%.holos.console.v1.ListSecretsResponse\"\x03\x90\x02\x01\x12T\n" +
 	"\tGetSecret\x12\".holos.console.v1.GetSecretRequest\x1a#.holos.console.v1.GetSecretResponse\x12]\n" +
-	"\fUpdateSecret\x12%.holos.console.v1.UpdateSecretRequest\x1a&.holos.console.v1.UpdateSecretResponse\x12]\n" +
+	"\fUpdateSecret\x12%.holos.console.v1.UpdateSecretRequest\x1a&.holos.console.v1.UpdateSecretResponse\x12W\n" +
+	"\n" +
+	"DiffSecret\x12#.holos.console.v1.DiffSecretRequest\x1a$.holos.console.v1.DiffSecretResponse\x12Z\n" +
+	"\vPatchSecret\x12$.holos.console.v1.PatchSecretRequest\x1a%.holos.console.v1.PatchSecretResponse\x12f\n" +
+	"\x0fAppendSecretKey\x12(.holos.console.v1.AppendSecretKeyRequest\x1a).holos.console.v1.AppendSecretKeyResponse\x12]\n" +
 	"\fCreateSecret\x12%.holos.console.v1.CreateSecretRequest\x1a&.holos.console.v1.CreateSecretResponse\x12]\n" +
-	"\fDeleteSecret\x12%.holos.console.v1.DeleteSecretRequest\x1a&.holos.console.v1.DeleteSecretResponse\x12`\n" +
+	"\fDeleteSecret\x12%.holos.console.v1.DeleteSecretRequest\x1a&.holos.console.v1.DeleteSecretResponse\x12f\n" +
+	"\x0fBatchGetSecrets\x12(.holos.console.v1.BatchGetSecretsRequest\x1a).holos.console.v1.BatchGetSecretsResponse\x12o\n" +
+	"\x12BatchDeleteSecrets\x12+.holos.console.v1.BatchDeleteSecretsRequest\x1a,.holos.console.v1.BatchDeleteSecretsResponse\x12`\n" +
 	"\rUpdateSharing\x12&.holos.console.v1.UpdateSharingRequest\x1a'.holos.console.v1.UpdateSharingResponse\x12]\n" +
-	"\fGetSecretRaw\x12%.holos.console.v1.GetSecretRawRequest\x1a&.holos.console.v1.GetSecretRawResponseBCZAgithub.com/holos-run/holos-console/gen/holos/console/v1;consolev1b\x06proto3"
+	"\fGetSecretRaw\x12%.holos.console.v1.GetSecretRawRequest\x1a&.holos.console.v1.GetSecretRawResponse\x12]\n" +
+	"\fGetSecretKey\x12%.holos.console.v1.GetSecretKeyRequest\x1a&.holos.console.v1.GetSecretKeyResponse\x12]\n" +
+	"\fRotateSecret\x12%.holos.console.v1.RotateSecretRequest\x1a&.holos.console.v1.RotateSecretResponse\x12k\n" +
+	"\x0fGetProjectQuota\x12(.holos.console.v1.GetProjectQuotaRequest\x1a).holos.console.v1.GetProjectQuotaResponse\"\x03\x90\x02\x01\x12h\n" +
+	"\x0eGetSecretUsage\x12'.holos.console.v1.GetSecretUsageRequest\x1a(.holos.console.v1.GetSecretUsageResponse\"\x03\x90\x02\x01\x12t\n" +
+	"\x12ListDeletedSecrets\x12+.holos.console.v1.ListDeletedSecretsRequest\x1a,.holos.console.v1.ListDeletedSecretsResponse\"\x03\x90\x02\x01\x12`\n" +
+	"\rRestoreSecret\x12&.holos.console.v1.RestoreSecretRequest\x1a'.holos.console.v1.RestoreSecretResponse\x12o\n" +
+	"\x12CreateSSHKeySecret\x12+.holos.console.v1.CreateSSHKeySecretRequest\x1a,.holos.console.v1.CreateSSHKeySecretResponse\x12o\n" +
+	"\x12ExportSecretSealed\x12+.holos.console.v1.ExportSecretSealedRequest\x1a,.holos.console.v1.ExportSecretSealedResponse\x12f\n" +
+	"\x0fCreateShareLink\x12(.holos.console.v1.CreateShareLinkRequest\x1a).holos.console.v1.CreateShareLinkResponse\x12c\n" +
+	"\x0eApplySecretRaw\x12'.holos.console.v1.ApplySecretRawRequest\x1a(.holos.console.v1.ApplySecretRawResponseBCZAgithub.com/holos-run/holos-console/gen/holos/console/v1;consolev1b\x06proto3"
 
 var (
 	file_holos_console_v1_secrets_proto_rawDescOnce sync.Once
@@ -1114,65 +4954,196 @@ func file_holos_console_v1_secrets_proto_rawDescGZIP() []byte {
 	return file_holos_console_v1_secrets_proto_rawDescData
 }
 
-var file_holos_console_v1_secrets_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_holos_console_v1_secrets_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_holos_console_v1_secrets_proto_msgTypes = make([]protoimpl.MessageInfo, 78)
 var file_holos_console_v1_secrets_proto_goTypes = []any{
-	(*GetSecretRequest)(nil),      // 0: holos.console.v1.GetSecretRequest
-	(*GetSecretResponse)(nil),     // 1: holos.console.v1.GetSecretResponse
-	(*ListSecretsRequest)(nil),    // 2: holos.console.v1.ListSecretsRequest
-	(*ListSecretsResponse)(nil),   // 3: holos.console.v1.ListSecretsResponse
-	(*UpdateSecretRequest)(nil),   // 4: holos.console.v1.UpdateSecretRequest
-	(*UpdateSecretResponse)(nil),  // 5: holos.console.v1.UpdateSecretResponse
-	(*CreateSecretRequest)(nil),   // 6: holos.console.v1.CreateSecretRequest
-	(*CreateSecretResponse)(nil),  // 7: holos.console.v1.CreateSecretResponse
-	(*DeleteSecretRequest)(nil),   // 8: holos.console.v1.DeleteSecretRequest
-	(*DeleteSecretResponse)(nil),  // 9: holos.console.v1.DeleteSecretResponse
-	(*SecretMetadata)(nil),        // 10: holos.console.v1.SecretMetadata
-	(*ShareGrant)(nil),            // 11: holos.console.v1.ShareGrant
-	(*UpdateSharingRequest)(nil),  // 12: holos.console.v1.UpdateSharingRequest
-	(*UpdateSharingResponse)(nil), // 13: holos.console.v1.UpdateSharingResponse
-	(*GetSecretRawRequest)(nil),   // 14: holos.console.v1.GetSecretRawRequest
-	(*GetSecretRawResponse)(nil),  // 15: holos.console.v1.GetSecretRawResponse
-	nil,                           // 16: holos.console.v1.GetSecretResponse.DataEntry
-	nil,                           // 17: holos.console.v1.UpdateSecretRequest.DataEntry
-	nil,                           // 18: holos.console.v1.UpdateSecretRequest.StringDataEntry
-	nil,                           // 19: holos.console.v1.CreateSecretRequest.DataEntry
-	nil,                           // 20: holos.console.v1.CreateSecretRequest.StringDataEntry
-	(Role)(0),                     // 21: holos.console.v1.Role
+	(GenerateFormat)(0),                // 0: holos.console.v1.GenerateFormat
+	(*GetSecretRequest)(nil),           // 1: holos.console.v1.GetSecretRequest
+	(*GetSecretResponse)(nil),          // 2: holos.console.v1.GetSecretResponse
+	(*ListSecretsRequest)(nil),         // 3: holos.console.v1.ListSecretsRequest
+	(*ListSecretsResponse)(nil),        // 4: holos.console.v1.ListSecretsResponse
+	(*UpdateSecretRequest)(nil),        // 5: holos.console.v1.UpdateSecretRequest
+	(*UpdateSecretResponse)(nil),       // 6: holos.console.v1.UpdateSecretResponse
+	(*PatchSecretRequest)(nil),         // 7: holos.console.v1.PatchSecretRequest
+	(*PatchSecretResponse)(nil),        // 8: holos.console.v1.PatchSecretResponse
+	(*AppendSecretKeyRequest)(nil),     // 9: holos.console.v1.AppendSecretKeyRequest
+	(*AppendSecretKeyResponse)(nil),    // 10: holos.console.v1.AppendSecretKeyResponse
+	(*CreateSecretRequest)(nil),        // 11: holos.console.v1.CreateSecretRequest
+	(*RegistryCredentials)(nil),        // 12: holos.console.v1.RegistryCredentials
+	(*GenerateSpec)(nil),               // 13: holos.console.v1.GenerateSpec
+	(*CreateSecretResponse)(nil),       // 14: holos.console.v1.CreateSecretResponse
+	(*CreateSSHKeySecretRequest)(nil),  // 15: holos.console.v1.CreateSSHKeySecretRequest
+	(*CreateSSHKeySecretResponse)(nil), // 16: holos.console.v1.CreateSSHKeySecretResponse
+	(*ExportSecretSealedRequest)(nil),  // 17: holos.console.v1.ExportSecretSealedRequest
+	(*ExportSecretSealedResponse)(nil), // 18: holos.console.v1.ExportSecretSealedResponse
+	(*CreateShareLinkRequest)(nil),     // 19: holos.console.v1.CreateShareLinkRequest
+	(*CreateShareLinkResponse)(nil),    // 20: holos.console.v1.CreateShareLinkResponse
+	(*ApplySecretRawRequest)(nil),      // 21: holos.console.v1.ApplySecretRawRequest
+	(*ApplySecretRawResponse)(nil),     // 22: holos.console.v1.ApplySecretRawResponse
+	(*DiffSecretRequest)(nil),          // 23: holos.console.v1.DiffSecretRequest
+	(*SecretKeyChange)(nil),            // 24: holos.console.v1.SecretKeyChange
+	(*DiffSecretResponse)(nil),         // 25: holos.console.v1.DiffSecretResponse
+	(*DeleteSecretRequest)(nil),        // 26: holos.console.v1.DeleteSecretRequest
+	(*DeleteSecretResponse)(nil),       // 27: holos.console.v1.DeleteSecretResponse
+	(*BatchItemError)(nil),             // 28: holos.console.v1.BatchItemError
+	(*BatchGetSecretsRequest)(nil),     // 29: holos.console.v1.BatchGetSecretsRequest
+	(*BatchGetSecretsResponse)(nil),    // 30: holos.console.v1.BatchGetSecretsResponse
+	(*BatchGetSecretResult)(nil),       // 31: holos.console.v1.BatchGetSecretResult
+	(*BatchDeleteSecretsRequest)(nil),  // 32: holos.console.v1.BatchDeleteSecretsRequest
+	(*BatchDeleteSecretsResponse)(nil), // 33: holos.console.v1.BatchDeleteSecretsResponse
+	(*BatchDeleteSecretResult)(nil),    // 34: holos.console.v1.BatchDeleteSecretResult
+	(*SecretInUse)(nil),                // 35: holos.console.v1.SecretInUse
+	(*SecretTooLarge)(nil),             // 36: holos.console.v1.SecretTooLarge
+	(*SecretMetadata)(nil),             // 37: holos.console.v1.SecretMetadata
+	(*TLSCertificate)(nil),             // 38: holos.console.v1.TLSCertificate
+	(*ShareGrant)(nil),                 // 39: holos.console.v1.ShareGrant
+	(*UpdateSharingRequest)(nil),       // 40: holos.console.v1.UpdateSharingRequest
+	(*UpdateSharingResponse)(nil),      // 41: holos.console.v1.UpdateSharingResponse
+	(*GetSecretRawRequest)(nil),        // 42: holos.console.v1.GetSecretRawRequest
+	(*GetSecretRawResponse)(nil),       // 43: holos.console.v1.GetSecretRawResponse
+	(*GetSecretKeyRequest)(nil),        // 44: holos.console.v1.GetSecretKeyRequest
+	(*GetSecretKeyResponse)(nil),       // 45: holos.console.v1.GetSecretKeyResponse
+	(*RotateSecretRequest)(nil),        // 46: holos.console.v1.RotateSecretRequest
+	(*RotateSecretResponse)(nil),       // 47: holos.console.v1.RotateSecretResponse
+	(*ProjectQuota)(nil),               // 48: holos.console.v1.ProjectQuota
+	(*ProjectQuotaUsage)(nil),          // 49: holos.console.v1.ProjectQuotaUsage
+	(*GetProjectQuotaRequest)(nil),     // 50: holos.console.v1.GetProjectQuotaRequest
+	(*GetProjectQuotaResponse)(nil),    // 51: holos.console.v1.GetProjectQuotaResponse
+	(*GetSecretUsageRequest)(nil),      // 52: holos.console.v1.GetSecretUsageRequest
+	(*SecretReference)(nil),            // 53: holos.console.v1.SecretReference
+	(*SecretConsumer)(nil),             // 54: holos.console.v1.SecretConsumer
+	(*GetSecretUsageResponse)(nil),     // 55: holos.console.v1.GetSecretUsageResponse
+	(*ListDeletedSecretsRequest)(nil),  // 56: holos.console.v1.ListDeletedSecretsRequest
+	(*DeletedSecret)(nil),              // 57: holos.console.v1.DeletedSecret
+	(*ListDeletedSecretsResponse)(nil), // 58: holos.console.v1.ListDeletedSecretsResponse
+	(*RestoreSecretRequest)(nil),       // 59: holos.console.v1.RestoreSecretRequest
+	(*RestoreSecretResponse)(nil),      // 60: holos.console.v1.RestoreSecretResponse
+	nil,                                // 61: holos.console.v1.GetSecretResponse.DataEntry
+	nil,                                // 62: holos.console.v1.UpdateSecretRequest.DataEntry
+	nil,                                // 63: holos.console.v1.UpdateSecretRequest.StringDataEntry
+	nil,                                // 64: holos.console.v1.UpdateSecretRequest.ContentTypesEntry
+	nil,                                // 65: holos.console.v1.PatchSecretRequest.DataEntry
+	nil,                                // 66: holos.console.v1.PatchSecretRequest.StringDataEntry
+	nil,                                // 67: holos.console.v1.PatchSecretRequest.ContentTypesEntry
+	nil,                                // 68: holos.console.v1.CreateSecretRequest.DataEntry
+	nil,                                // 69: holos.console.v1.CreateSecretRequest.StringDataEntry
+	nil,                                // 70: holos.console.v1.CreateSecretRequest.GenerateEntry
+	nil,                                // 71: holos.console.v1.CreateSecretRequest.ContentTypesEntry
+	nil,                                // 72: holos.console.v1.CreateSecretResponse.GeneratedValuesEntry
+	nil,                                // 73: holos.console.v1.DiffSecretRequest.ProposedDataEntry
+	nil,                                // 74: holos.console.v1.DiffSecretRequest.ProposedStringDataEntry
+	nil,                                // 75: holos.console.v1.BatchGetSecretResult.DataEntry
+	nil,                                // 76: holos.console.v1.SecretMetadata.ContentTypesEntry
+	nil,                                // 77: holos.console.v1.RotateSecretRequest.KeysEntry
+	nil,                                // 78: holos.console.v1.RotateSecretResponse.RotatedValuesEntry
+	(*ListFilter)(nil),                 // 79: holos.console.v1.ListFilter
+	(*ListOrder)(nil),                  // 80: holos.console.v1.ListOrder
+	(ChangeType)(0),                    // 81: holos.console.v1.ChangeType
+	(Role)(0),                          // 82: holos.console.v1.Role
+	(*RoleSource)(nil),                 // 83: holos.console.v1.RoleSource
+	(RawFormat)(0),                     // 84: holos.console.v1.RawFormat
 }
 var file_holos_console_v1_secrets_proto_depIdxs = []int32{
-	16, // 0: holos.console.v1.GetSecretResponse.data:type_name -> holos.console.v1.GetSecretResponse.DataEntry
-	10, // 1: holos.console.v1.ListSecretsResponse.secrets:type_name -> holos.console.v1.SecretMetadata
-	17, // 2: holos.console.v1.UpdateSecretRequest.data:type_name -> holos.console.v1.UpdateSecretRequest.DataEntry
-	18, // 3: holos.console.v1.UpdateSecretRequest.string_data:type_name -> holos.console.v1.UpdateSecretRequest.StringDataEntry
-	19, // 4: holos.console.v1.CreateSecretRequest.data:type_name -> holos.console.v1.CreateSecretRequest.DataEntry
-	20, // 5: holos.console.v1.CreateSecretRequest.string_data:type_name -> holos.console.v1.CreateSecretRequest.StringDataEntry
-	11, // 6: holos.console.v1.CreateSecretRequest.user_grants:type_name -> holos.console.v1.ShareGrant
-	11, // 7: holos.console.v1.CreateSecretRequest.role_grants:type_name -> holos.console.v1.ShareGrant
-	11, // 8: holos.console.v1.SecretMetadata.user_grants:type_name -> holos.console.v1.ShareGrant
-	11, // 9: holos.console.v1.SecretMetadata.role_grants:type_name -> holos.console.v1.ShareGrant
-	21, // 10: holos.console.v1.ShareGrant.role:type_name -> holos.console.v1.Role
-	11, // 11: holos.console.v1.UpdateSharingRequest.user_grants:type_name -> holos.console.v1.ShareGrant
-	11, // 12: holos.console.v1.UpdateSharingRequest.role_grants:type_name -> holos.console.v1.ShareGrant
-	10, // 13: holos.console.v1.UpdateSharingResponse.metadata:type_name -> holos.console.v1.SecretMetadata
-	2,  // 14: holos.console.v1.SecretsService.ListSecrets:input_type -> holos.console.v1.ListSecretsRequest
-	0,  // 15: holos.console.v1.SecretsService.GetSecret:input_type -> holos.console.v1.GetSecretRequest
-	4,  // 16: holos.console.v1.SecretsService.UpdateSecret:input_type -> holos.console.v1.UpdateSecretRequest
-	6,  // 17: holos.console.v1.SecretsService.CreateSecret:input_type -> holos.console.v1.CreateSecretRequest
-	8,  // 18: holos.console.v1.SecretsService.DeleteSecret:input_type -> holos.console.v1.DeleteSecretRequest
-	12, // 19: holos.console.v1.SecretsService.UpdateSharing:input_type -> holos.console.v1.UpdateSharingRequest
-	14, // 20: holos.console.v1.SecretsService.GetSecretRaw:input_type -> holos.console.v1.GetSecretRawRequest
-	3,  // 21: holos.console.v1.SecretsService.ListSecrets:output_type -> holos.console.v1.ListSecretsResponse
-	1,  // 22: holos.console.v1.SecretsService.GetSecret:output_type -> holos.console.v1.GetSecretResponse
-	5,  // 23: holos.console.v1.SecretsService.UpdateSecret:output_type -> holos.console.v1.UpdateSecretResponse
-	7,  // 24: holos.console.v1.SecretsService.CreateSecret:output_type -> holos.console.v1.CreateSecretResponse
-	9,  // 25: holos.console.v1.SecretsService.DeleteSecret:output_type -> holos.console.v1.DeleteSecretResponse
-	13, // 26: holos.console.v1.SecretsService.UpdateSharing:output_type -> holos.console.v1.UpdateSharingResponse
-	15, // 27: holos.console.v1.SecretsService.GetSecretRaw:output_type -> holos.console.v1.GetSecretRawResponse
-	21, // [21:28] is the sub-list for method output_type
-	14, // [14:21] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	61, // 0: holos.console.v1.GetSecretResponse.data:type_name -> holos.console.v1.GetSecretResponse.DataEntry
+	79, // 1: holos.console.v1.ListSecretsRequest.filter:type_name -> holos.console.v1.ListFilter
+	80, // 2: holos.console.v1.ListSecretsRequest.order_by:type_name -> holos.console.v1.ListOrder
+	37, // 3: holos.console.v1.ListSecretsResponse.secrets:type_name -> holos.console.v1.SecretMetadata
+	62, // 4: holos.console.v1.UpdateSecretRequest.data:type_name -> holos.console.v1.UpdateSecretRequest.DataEntry
+	63, // 5: holos.console.v1.UpdateSecretRequest.string_data:type_name -> holos.console.v1.UpdateSecretRequest.StringDataEntry
+	64, // 6: holos.console.v1.UpdateSecretRequest.content_types:type_name -> holos.console.v1.UpdateSecretRequest.ContentTypesEntry
+	65, // 7: holos.console.v1.PatchSecretRequest.data:type_name -> holos.console.v1.PatchSecretRequest.DataEntry
+	66, // 8: holos.console.v1.PatchSecretRequest.string_data:type_name -> holos.console.v1.PatchSecretRequest.StringDataEntry
+	67, // 9: holos.console.v1.PatchSecretRequest.content_types:type_name -> holos.console.v1.PatchSecretRequest.ContentTypesEntry
+	68, // 10: holos.console.v1.CreateSecretRequest.data:type_name -> holos.console.v1.CreateSecretRequest.DataEntry
+	69, // 11: holos.console.v1.CreateSecretRequest.string_data:type_name -> holos.console.v1.CreateSecretRequest.StringDataEntry
+	39, // 12: holos.console.v1.CreateSecretRequest.user_grants:type_name -> holos.console.v1.ShareGrant
+	39, // 13: holos.console.v1.CreateSecretRequest.role_grants:type_name -> holos.console.v1.ShareGrant
+	70, // 14: holos.console.v1.CreateSecretRequest.generate:type_name -> holos.console.v1.CreateSecretRequest.GenerateEntry
+	71, // 15: holos.console.v1.CreateSecretRequest.content_types:type_name -> holos.console.v1.CreateSecretRequest.ContentTypesEntry
+	12, // 16: holos.console.v1.CreateSecretRequest.docker_registry:type_name -> holos.console.v1.RegistryCredentials
+	0,  // 17: holos.console.v1.GenerateSpec.format:type_name -> holos.console.v1.GenerateFormat
+	72, // 18: holos.console.v1.CreateSecretResponse.generated_values:type_name -> holos.console.v1.CreateSecretResponse.GeneratedValuesEntry
+	39, // 19: holos.console.v1.CreateSSHKeySecretRequest.user_grants:type_name -> holos.console.v1.ShareGrant
+	39, // 20: holos.console.v1.CreateSSHKeySecretRequest.role_grants:type_name -> holos.console.v1.ShareGrant
+	73, // 21: holos.console.v1.DiffSecretRequest.proposed_data:type_name -> holos.console.v1.DiffSecretRequest.ProposedDataEntry
+	74, // 22: holos.console.v1.DiffSecretRequest.proposed_string_data:type_name -> holos.console.v1.DiffSecretRequest.ProposedStringDataEntry
+	81, // 23: holos.console.v1.SecretKeyChange.change:type_name -> holos.console.v1.ChangeType
+	24, // 24: holos.console.v1.DiffSecretResponse.changes:type_name -> holos.console.v1.SecretKeyChange
+	31, // 25: holos.console.v1.BatchGetSecretsResponse.results:type_name -> holos.console.v1.BatchGetSecretResult
+	75, // 26: holos.console.v1.BatchGetSecretResult.data:type_name -> holos.console.v1.BatchGetSecretResult.DataEntry
+	28, // 27: holos.console.v1.BatchGetSecretResult.error:type_name -> holos.console.v1.BatchItemError
+	34, // 28: holos.console.v1.BatchDeleteSecretsResponse.results:type_name -> holos.console.v1.BatchDeleteSecretResult
+	28, // 29: holos.console.v1.BatchDeleteSecretResult.error:type_name -> holos.console.v1.BatchItemError
+	54, // 30: holos.console.v1.SecretInUse.consumers:type_name -> holos.console.v1.SecretConsumer
+	39, // 31: holos.console.v1.SecretMetadata.user_grants:type_name -> holos.console.v1.ShareGrant
+	39, // 32: holos.console.v1.SecretMetadata.role_grants:type_name -> holos.console.v1.ShareGrant
+	76, // 33: holos.console.v1.SecretMetadata.content_types:type_name -> holos.console.v1.SecretMetadata.ContentTypesEntry
+	38, // 34: holos.console.v1.SecretMetadata.tls_certificate:type_name -> holos.console.v1.TLSCertificate
+	82, // 35: holos.console.v1.SecretMetadata.user_role:type_name -> holos.console.v1.Role
+	83, // 36: holos.console.v1.SecretMetadata.user_role_source:type_name -> holos.console.v1.RoleSource
+	82, // 37: holos.console.v1.ShareGrant.role:type_name -> holos.console.v1.Role
+	39, // 38: holos.console.v1.UpdateSharingRequest.user_grants:type_name -> holos.console.v1.ShareGrant
+	39, // 39: holos.console.v1.UpdateSharingRequest.role_grants:type_name -> holos.console.v1.ShareGrant
+	37, // 40: holos.console.v1.UpdateSharingResponse.metadata:type_name -> holos.console.v1.SecretMetadata
+	84, // 41: holos.console.v1.GetSecretRawRequest.format:type_name -> holos.console.v1.RawFormat
+	77, // 42: holos.console.v1.RotateSecretRequest.keys:type_name -> holos.console.v1.RotateSecretRequest.KeysEntry
+	78, // 43: holos.console.v1.RotateSecretResponse.rotated_values:type_name -> holos.console.v1.RotateSecretResponse.RotatedValuesEntry
+	48, // 44: holos.console.v1.GetProjectQuotaResponse.limit:type_name -> holos.console.v1.ProjectQuota
+	49, // 45: holos.console.v1.GetProjectQuotaResponse.usage:type_name -> holos.console.v1.ProjectQuotaUsage
+	53, // 46: holos.console.v1.SecretConsumer.references:type_name -> holos.console.v1.SecretReference
+	54, // 47: holos.console.v1.GetSecretUsageResponse.consumers:type_name -> holos.console.v1.SecretConsumer
+	57, // 48: holos.console.v1.ListDeletedSecretsResponse.secrets:type_name -> holos.console.v1.DeletedSecret
+	13, // 49: holos.console.v1.CreateSecretRequest.GenerateEntry.value:type_name -> holos.console.v1.GenerateSpec
+	13, // 50: holos.console.v1.RotateSecretRequest.KeysEntry.value:type_name -> holos.console.v1.GenerateSpec
+	3,  // 51: holos.console.v1.SecretsService.ListSecrets:input_type -> holos.console.v1.ListSecretsRequest
+	1,  // 52: holos.console.v1.SecretsService.GetSecret:input_type -> holos.console.v1.GetSecretRequest
+	5,  // 53: holos.console.v1.SecretsService.UpdateSecret:input_type -> holos.console.v1.UpdateSecretRequest
+	23, // 54: holos.console.v1.SecretsService.DiffSecret:input_type -> holos.console.v1.DiffSecretRequest
+	7,  // 55: holos.console.v1.SecretsService.PatchSecret:input_type -> holos.console.v1.PatchSecretRequest
+	9,  // 56: holos.console.v1.SecretsService.AppendSecretKey:input_type -> holos.console.v1.AppendSecretKeyRequest
+	11, // 57: holos.console.v1.SecretsService.CreateSecret:input_type -> holos.console.v1.CreateSecretRequest
+	26, // 58: holos.console.v1.SecretsService.DeleteSecret:input_type -> holos.console.v1.DeleteSecretRequest
+	29, // 59: holos.console.v1.SecretsService.BatchGetSecrets:input_type -> holos.console.v1.BatchGetSecretsRequest
+	32, // 60: holos.console.v1.SecretsService.BatchDeleteSecrets:input_type -> holos.console.v1.BatchDeleteSecretsRequest
+	40, // 61: holos.console.v1.SecretsService.UpdateSharing:input_type -> holos.console.v1.UpdateSharingRequest
+	42, // 62: holos.console.v1.SecretsService.GetSecretRaw:input_type -> holos.console.v1.GetSecretRawRequest
+	44, // 63: holos.console.v1.SecretsService.GetSecretKey:input_type -> holos.console.v1.GetSecretKeyRequest
+	46, // 64: holos.console.v1.SecretsService.RotateSecret:input_type -> holos.console.v1.RotateSecretRequest
+	50, // 65: holos.console.v1.SecretsService.GetProjectQuota:input_type -> holos.console.v1.GetProjectQuotaRequest
+	52, // 66: holos.console.v1.SecretsService.GetSecretUsage:input_type -> holos.console.v1.GetSecretUsageRequest
+	56, // 67: holos.console.v1.SecretsService.ListDeletedSecrets:input_type -> holos.console.v1.ListDeletedSecretsRequest
+	59, // 68: holos.console.v1.SecretsService.RestoreSecret:input_type -> holos.console.v1.RestoreSecretRequest
+	15, // 69: holos.console.v1.SecretsService.CreateSSHKeySecret:input_type -> holos.console.v1.CreateSSHKeySecretRequest
+	17, // 70: holos.console.v1.SecretsService.ExportSecretSealed:input_type -> holos.console.v1.ExportSecretSealedRequest
+	19, // 71: holos.console.v1.SecretsService.CreateShareLink:input_type -> holos.console.v1.CreateShareLinkRequest
+	21, // 72: holos.console.v1.SecretsService.ApplySecretRaw:input_type -> holos.console.v1.ApplySecretRawRequest
+	4,  // 73: holos.console.v1.SecretsService.ListSecrets:output_type -> holos.console.v1.ListSecretsResponse
+	2,  // 74: holos.console.v1.SecretsService.GetSecret:output_type -> holos.console.v1.GetSecretResponse
+	6,  // 75: holos.console.v1.SecretsService.UpdateSecret:output_type -> holos.console.v1.UpdateSecretResponse
+	25, // 76: holos.console.v1.SecretsService.DiffSecret:output_type -> holos.console.v1.DiffSecretResponse
+	8,  // 77: holos.console.v1.SecretsService.PatchSecret:output_type -> holos.console.v1.PatchSecretResponse
+	10, // 78: holos.console.v1.SecretsService.AppendSecretKey:output_type -> holos.console.v1.AppendSecretKeyResponse
+	14, // 79: holos.console.v1.SecretsService.CreateSecret:output_type -> holos.console.v1.CreateSecretResponse
+	27, // 80: holos.console.v1.SecretsService.DeleteSecret:output_type -> holos.console.v1.DeleteSecretResponse
+	30, // 81: holos.console.v1.SecretsService.BatchGetSecrets:output_type -> holos.console.v1.BatchGetSecretsResponse
+	33, // 82: holos.console.v1.SecretsService.BatchDeleteSecrets:output_type -> holos.console.v1.BatchDeleteSecretsResponse
+	41, // 83: holos.console.v1.SecretsService.UpdateSharing:output_type -> holos.console.v1.UpdateSharingResponse
+	43, // 84: holos.console.v1.SecretsService.GetSecretRaw:output_type -> holos.console.v1.GetSecretRawResponse
+	45, // 85: holos.console.v1.SecretsService.GetSecretKey:output_type -> holos.console.v1.GetSecretKeyResponse
+	47, // 86: holos.console.v1.SecretsService.RotateSecret:output_type -> holos.console.v1.RotateSecretResponse
+	51, // 87: holos.console.v1.SecretsService.GetProjectQuota:output_type -> holos.console.v1.GetProjectQuotaResponse
+	55, // 88: holos.console.v1.SecretsService.GetSecretUsage:output_type -> holos.console.v1.GetSecretUsageResponse
+	58, // 89: holos.console.v1.SecretsService.ListDeletedSecrets:output_type -> holos.console.v1.ListDeletedSecretsResponse
+	60, // 90: holos.console.v1.SecretsService.RestoreSecret:output_type -> holos.console.v1.RestoreSecretResponse
+	16, // 91: holos.console.v1.SecretsService.CreateSSHKeySecret:output_type -> holos.console.v1.CreateSSHKeySecretResponse
+	18, // 92: holos.console.v1.SecretsService.ExportSecretSealed:output_type -> holos.console.v1.ExportSecretSealedResponse
+	20, // 93: holos.console.v1.SecretsService.CreateShareLink:output_type -> holos.console.v1.CreateShareLinkResponse
+	22, // 94: holos.console.v1.SecretsService.ApplySecretRaw:output_type -> holos.console.v1.ApplySecretRawResponse
+	73, // [73:95] is the sub-list for method output_type
+	51, // [51:73] is the sub-list for method input_type
+	51, // [51:51] is the sub-list for extension type_name
+	51, // [51:51] is the sub-list for extension extendee
+	0,  // [0:51] is the sub-list for field type_name
 }
 
 func init() { file_holos_console_v1_secrets_proto_init() }
@@ -1180,23 +5151,28 @@ func file_holos_console_v1_secrets_proto_init() {
 	if File_holos_console_v1_secrets_proto != nil {
 		return
 	}
+	file_holos_console_v1_diff_proto_init()
+	file_holos_console_v1_list_filter_proto_init()
+	file_holos_console_v1_raw_format_proto_init()
 	file_holos_console_v1_rbac_proto_init()
 	file_holos_console_v1_secrets_proto_msgTypes[4].OneofWrappers = []any{}
-	file_holos_console_v1_secrets_proto_msgTypes[6].OneofWrappers = []any{}
 	file_holos_console_v1_secrets_proto_msgTypes[10].OneofWrappers = []any{}
-	file_holos_console_v1_secrets_proto_msgTypes[11].OneofWrappers = []any{}
+	file_holos_console_v1_secrets_proto_msgTypes[14].OneofWrappers = []any{}
+	file_holos_console_v1_secrets_proto_msgTypes[36].OneofWrappers = []any{}
+	file_holos_console_v1_secrets_proto_msgTypes[38].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_holos_console_v1_secrets_proto_rawDesc), len(file_holos_console_v1_secrets_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   21,
+			NumEnums:      1,
+			NumMessages:   78,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_holos_console_v1_secrets_proto_goTypes,
 		DependencyIndexes: file_holos_console_v1_secrets_proto_depIdxs,
+		EnumInfos:         file_holos_console_v1_secrets_proto_enumTypes,
 		MessageInfos:      file_holos_console_v1_secrets_proto_msgTypes,
 	}.Build()
 	File_holos_console_v1_secrets_proto = out.File
@@ -788,10 +788,10 @@ const file_holos_console_v1_template_dependencies_proto_rawDesc = "" +
 	"\x1fDeleteTemplateDependencyRequest\x12\x1c\n" +
 	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\"\"\n" +
-	" DeleteTemplateDependencyResponse2\xa5\x05\n" +
-	"\x19TemplateDependencyService\x12\x81\x01\n" +
-	"\x18ListTemplateDependencies\x121.holos.console.v1.ListTemplateDependenciesRequest\x1a2.holos.console.v1.ListTemplateDependenciesResponse\x12x\n" +
-	"\x15GetTemplateDependency\x12..holos.console.v1.GetTemplateDependencyRequest\x1a/.holos.console.v1.GetTemplateDependencyResponse\x12\x81\x01\n" +
+	" DeleteTemplateDependencyResponse2\xaf\x05\n" +
+	"\x19TemplateDependencyService\x12\x86\x01\n" +
+	"\x18ListTemplateDependencies\x121.holos.console.v1.ListTemplateDependenciesRequest\x1a2.holos.console.v1.ListTemplateDependenciesResponse\"\x03\x90\x02\x01\x12}\n" +
+	"\x15GetTemplateDependency\x12..holos.console.v1.GetTemplateDependencyRequest\x1a/.holos.console.v1.GetTemplateDependencyResponse\"\x03\x90\x02\x01\x12\x81\x01\n" +
 	"\x18CreateTemplateDependency\x121.holos.console.v1.CreateTemplateDependencyRequest\x1a2.holos.console.v1.CreateTemplateDependencyResponse\x12\x81\x01\n" +
 	"\x18UpdateTemplateDependency\x121.holos.console.v1.UpdateTemplateDependencyRequest\x1a2.holos.console.v1.UpdateTemplateDependencyResponse\x12\x81\x01\n" +
 	"\x18DeleteTemplateDependency\x121.holos.console.v1.DeleteTemplateDependencyRequest\x1a2.holos.console.v1.DeleteTemplateDependencyResponseBCZAgithub.com/holos-run/holos-console/gen/holos/console/v1;consolev1b\x06proto3"
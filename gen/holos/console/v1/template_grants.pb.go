@@ -940,10 +940,10 @@ const file_holos_console_v1_template_grants_proto_rawDesc = "" +
 	"\x1aDeleteTemplateGrantRequest\x12\x1c\n" +
 	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\"\x1d\n" +
-	"\x1bDeleteTemplateGrantResponse2\xce\x04\n" +
-	"\x14TemplateGrantService\x12o\n" +
-	"\x12ListTemplateGrants\x12+.holos.console.v1.ListTemplateGrantsRequest\x1a,.holos.console.v1.ListTemplateGrantsResponse\x12i\n" +
-	"\x10GetTemplateGrant\x12).holos.console.v1.GetTemplateGrantRequest\x1a*.holos.console.v1.GetTemplateGrantResponse\x12r\n" +
+	"\x1bDeleteTemplateGrantResponse2\xd8\x04\n" +
+	"\x14TemplateGrantService\x12t\n" +
+	"\x12ListTemplateGrants\x12+.holos.console.v1.ListTemplateGrantsRequest\x1a,.holos.console.v1.ListTemplateGrantsResponse\"\x03\x90\x02\x01\x12n\n" +
+	"\x10GetTemplateGrant\x12).holos.console.v1.GetTemplateGrantRequest\x1a*.holos.console.v1.GetTemplateGrantResponse\"\x03\x90\x02\x01\x12r\n" +
 	"\x13CreateTemplateGrant\x12,.holos.console.v1.CreateTemplateGrantRequest\x1a-.holos.console.v1.CreateTemplateGrantResponse\x12r\n" +
 	"\x13UpdateTemplateGrant\x12,.holos.console.v1.UpdateTemplateGrantRequest\x1a-.holos.console.v1.UpdateTemplateGrantResponse\x12r\n" +
 	"\x13DeleteTemplateGrant\x12,.holos.console.v1.DeleteTemplateGrantRequest\x1a-.holos.console.v1.DeleteTemplateGrantResponseBCZAgithub.com/holos-run/holos-console/gen/holos/console/v1;consolev1b\x06proto3"
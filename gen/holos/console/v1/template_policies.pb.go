@@ -978,14 +978,14 @@ const file_holos_console_v1_template_policies_proto_rawDesc = "" +
 	"\x12TemplatePolicyKind\x12$\n" +
 	" TEMPLATE_POLICY_KIND_UNSPECIFIED\x10\x00\x12 \n" +
 	"\x1cTEMPLATE_POLICY_KIND_REQUIRE\x10\x01\x12 \n" +
-	"\x1cTEMPLATE_POLICY_KIND_EXCLUDE\x10\x022\xf1\x05\n" +
-	"\x15TemplatePolicyService\x12u\n" +
-	"\x14ListTemplatePolicies\x12-.holos.console.v1.ListTemplatePoliciesRequest\x1a..holos.console.v1.ListTemplatePoliciesResponse\x12l\n" +
-	"\x11GetTemplatePolicy\x12*.holos.console.v1.GetTemplatePolicyRequest\x1a+.holos.console.v1.GetTemplatePolicyResponse\x12u\n" +
+	"\x1cTEMPLATE_POLICY_KIND_EXCLUDE\x10\x022\x80\x06\n" +
+	"\x15TemplatePolicyService\x12z\n" +
+	"\x14ListTemplatePolicies\x12-.holos.console.v1.ListTemplatePoliciesRequest\x1a..holos.console.v1.ListTemplatePoliciesResponse\"\x03\x90\x02\x01\x12q\n" +
+	"\x11GetTemplatePolicy\x12*.holos.console.v1.GetTemplatePolicyRequest\x1a+.holos.console.v1.GetTemplatePolicyResponse\"\x03\x90\x02\x01\x12u\n" +
 	"\x14CreateTemplatePolicy\x12-.holos.console.v1.CreateTemplatePolicyRequest\x1a..holos.console.v1.CreateTemplatePolicyResponse\x12u\n" +
 	"\x14UpdateTemplatePolicy\x12-.holos.console.v1.UpdateTemplatePolicyRequest\x1a..holos.console.v1.UpdateTemplatePolicyResponse\x12u\n" +
-	"\x14DeleteTemplatePolicy\x12-.holos.console.v1.DeleteTemplatePolicyRequest\x1a..holos.console.v1.DeleteTemplatePolicyResponse\x12\x8d\x01\n" +
-	"\x1cListLinkableTemplatePolicies\x125.holos.console.v1.ListLinkableTemplatePoliciesRequest\x1a6.holos.console.v1.ListLinkableTemplatePoliciesResponseBCZAgithub.com/holos-run/holos-console/gen/holos/console/v1;consolev1b\x06proto3"
+	"\x14DeleteTemplatePolicy\x12-.holos.console.v1.DeleteTemplatePolicyRequest\x1a..holos.console.v1.DeleteTemplatePolicyResponse\x12\x92\x01\n" +
+	"\x1cListLinkableTemplatePolicies\x125.holos.console.v1.ListLinkableTemplatePoliciesRequest\x1a6.holos.console.v1.ListLinkableTemplatePoliciesResponse\"\x03\x90\x02\x01BCZAgithub.com/holos-run/holos-console/gen/holos/console/v1;consolev1b\x06proto3"
 
 var (
 	file_holos_console_v1_template_policies_proto_rawDescOnce sync.Once
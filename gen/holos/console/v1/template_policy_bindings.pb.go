@@ -901,10 +901,10 @@ const file_holos_console_v1_template_policy_bindings_proto_rawDesc = "" +
 	"/TEMPLATE_POLICY_BINDING_TARGET_KIND_UNSPECIFIED\x10\x00\x128\n" +
 	"4TEMPLATE_POLICY_BINDING_TARGET_KIND_PROJECT_TEMPLATE\x10\x01\x122\n" +
 	".TEMPLATE_POLICY_BINDING_TARGET_KIND_DEPLOYMENT\x10\x02\x129\n" +
-	"5TEMPLATE_POLICY_BINDING_TARGET_KIND_PROJECT_NAMESPACE\x10\x032\xd3\x05\n" +
-	"\x1cTemplatePolicyBindingService\x12\x87\x01\n" +
-	"\x1aListTemplatePolicyBindings\x123.holos.console.v1.ListTemplatePolicyBindingsRequest\x1a4.holos.console.v1.ListTemplatePolicyBindingsResponse\x12\x81\x01\n" +
-	"\x18GetTemplatePolicyBinding\x121.holos.console.v1.GetTemplatePolicyBindingRequest\x1a2.holos.console.v1.GetTemplatePolicyBindingResponse\x12\x8a\x01\n" +
+	"5TEMPLATE_POLICY_BINDING_TARGET_KIND_PROJECT_NAMESPACE\x10\x032\xdd\x05\n" +
+	"\x1cTemplatePolicyBindingService\x12\x8c\x01\n" +
+	"\x1aListTemplatePolicyBindings\x123.holos.console.v1.ListTemplatePolicyBindingsRequest\x1a4.holos.console.v1.ListTemplatePolicyBindingsResponse\"\x03\x90\x02\x01\x12\x86\x01\n" +
+	"\x18GetTemplatePolicyBinding\x121.holos.console.v1.GetTemplatePolicyBindingRequest\x1a2.holos.console.v1.GetTemplatePolicyBindingResponse\"\x03\x90\x02\x01\x12\x8a\x01\n" +
 	"\x1bCreateTemplatePolicyBinding\x124.holos.console.v1.CreateTemplatePolicyBindingRequest\x1a5.holos.console.v1.CreateTemplatePolicyBindingResponse\x12\x8a\x01\n" +
 	"\x1bUpdateTemplatePolicyBinding\x124.holos.console.v1.UpdateTemplatePolicyBindingRequest\x1a5.holos.console.v1.UpdateTemplatePolicyBindingResponse\x12\x8a\x01\n" +
 	"\x1bDeleteTemplatePolicyBinding\x124.holos.console.v1.DeleteTemplatePolicyBindingRequest\x1a5.holos.console.v1.DeleteTemplatePolicyBindingResponseBCZAgithub.com/holos-run/holos-console/gen/holos/console/v1;consolev1b\x06proto3"
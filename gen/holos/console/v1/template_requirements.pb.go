@@ -849,10 +849,10 @@ const file_holos_console_v1_template_requirements_proto_rawDesc = "" +
 	" DeleteTemplateRequirementRequest\x12\x1c\n" +
 	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\"#\n" +
-	"!DeleteTemplateRequirementResponse2\xb2\x05\n" +
-	"\x1aTemplateRequirementService\x12\x81\x01\n" +
-	"\x18ListTemplateRequirements\x121.holos.console.v1.ListTemplateRequirementsRequest\x1a2.holos.console.v1.ListTemplateRequirementsResponse\x12{\n" +
-	"\x16GetTemplateRequirement\x12/.holos.console.v1.GetTemplateRequirementRequest\x1a0.holos.console.v1.GetTemplateRequirementResponse\x12\x84\x01\n" +
+	"!DeleteTemplateRequirementResponse2\xbd\x05\n" +
+	"\x1aTemplateRequirementService\x12\x86\x01\n" +
+	"\x18ListTemplateRequirements\x121.holos.console.v1.ListTemplateRequirementsRequest\x1a2.holos.console.v1.ListTemplateRequirementsResponse\"\x03\x90\x02\x01\x12\x80\x01\n" +
+	"\x16GetTemplateRequirement\x12/.holos.console.v1.GetTemplateRequirementRequest\x1a0.holos.console.v1.GetTemplateRequirementResponse\"\x03\x90\x02\x01\x12\x84\x01\n" +
 	"\x19CreateTemplateRequirement\x122.holos.console.v1.CreateTemplateRequirementRequest\x1a3.holos.console.v1.CreateTemplateRequirementResponse\x12\x84\x01\n" +
 	"\x19UpdateTemplateRequirement\x122.holos.console.v1.UpdateTemplateRequirementRequest\x1a3.holos.console.v1.UpdateTemplateRequirementResponse\x12\x84\x01\n" +
 	"\x19DeleteTemplateRequirement\x122.holos.console.v1.DeleteTemplateRequirementRequest\x1a3.holos.console.v1.DeleteTemplateRequirementResponseBCZAgithub.com/holos-run/holos-console/gen/holos/console/v1;consolev1b\x06proto3"
@@ -434,8 +434,10 @@ func (x *Template) GetCreatedAt() string {
 
 // ListTemplatesRequest requests all templates visible in the given namespace.
 type ListTemplatesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Namespace string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// order_by sorts the returned templates.
+	OrderBy       *ListOrder `protobuf:"bytes,2,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -477,6 +479,13 @@ func (x *ListTemplatesRequest) GetNamespace() string {
 	return ""
 }
 
+func (x *ListTemplatesRequest) GetOrderBy() *ListOrder {
+	if x != nil {
+		return x.OrderBy
+	}
+	return nil
+}
+
 // ListTemplatesResponse contains the list of templates.
 type ListTemplatesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -2662,7 +2671,7 @@ var File_holos_console_v1_templates_proto protoreflect.FileDescriptor
 
 const file_holos_console_v1_templates_proto_rawDesc = "" +
 	"\n" +
-	" holos/console/v1/templates.proto\x12\x10holos.console.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\"holos/console/v1/deployments.proto\x1a#holos/console/v1/policy_state.proto\"\xde\x01\n" +
+	" holos/console/v1/templates.proto\x12\x10holos.console.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\"holos/console/v1/deployments.proto\x1a\"holos/console/v1/list_filter.proto\x1a#holos/console/v1/policy_state.proto\"\xde\x01\n" +
 	"\x10TemplateDefaults\x12\x14\n" +
 	"\x05image\x18\x01 \x01(\tR\x05image\x12\x10\n" +
 	"\x03tag\x18\x02 \x01(\tR\x03tag\x12\x18\n" +
@@ -2688,9 +2697,10 @@ const file_holos_console_v1_templates_proto_rawDesc = "" +
 	"\aversion\x18\n" +
 	" \x01(\tR\aversion\x12\x1d\n" +
 	"\n" +
-	"created_at\x18\v \x01(\tR\tcreatedAtJ\x04\b\a\x10\bJ\x04\b\b\x10\tR\x10linked_templatesR\tmandatory\"4\n" +
+	"created_at\x18\v \x01(\tR\tcreatedAtJ\x04\b\a\x10\bJ\x04\b\b\x10\tR\x10linked_templatesR\tmandatory\"l\n" +
 	"\x14ListTemplatesRequest\x12\x1c\n" +
-	"\tnamespace\x18\x01 \x01(\tR\tnamespace\"Q\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x126\n" +
+	"\border_by\x18\x02 \x01(\v2\x1b.holos.console.v1.ListOrderR\aorderBy\"Q\n" +
 	"\x15ListTemplatesResponse\x128\n" +
 	"\ttemplates\x18\x01 \x03(\v2\x1a.holos.console.v1.TemplateR\ttemplates\"F\n" +
 	"\x12GetTemplateRequest\x12\x1c\n" +
@@ -2827,27 +2837,27 @@ const file_holos_console_v1_templates_proto_rawDesc = "" +
 	"\x1cDEPENDENCY_SCOPE_UNSPECIFIED\x10\x00\x12\x1d\n" +
 	"\x19DEPENDENCY_SCOPE_INSTANCE\x10\x01\x12\x1c\n" +
 	"\x18DEPENDENCY_SCOPE_PROJECT\x10\x02\x12#\n" +
-	"\x1fDEPENDENCY_SCOPE_REMOTE_PROJECT\x10\x032\xba\x0f\n" +
-	"\x0fTemplateService\x12`\n" +
-	"\rListTemplates\x12&.holos.console.v1.ListTemplatesRequest\x1a'.holos.console.v1.ListTemplatesResponse\x12Z\n" +
-	"\vGetTemplate\x12$.holos.console.v1.GetTemplateRequest\x1a%.holos.console.v1.GetTemplateResponse\x12c\n" +
+	"\x1fDEPENDENCY_SCOPE_REMOTE_PROJECT\x10\x032\xf7\x0f\n" +
+	"\x0fTemplateService\x12e\n" +
+	"\rListTemplates\x12&.holos.console.v1.ListTemplatesRequest\x1a'.holos.console.v1.ListTemplatesResponse\"\x03\x90\x02\x01\x12_\n" +
+	"\vGetTemplate\x12$.holos.console.v1.GetTemplateRequest\x1a%.holos.console.v1.GetTemplateResponse\"\x03\x90\x02\x01\x12c\n" +
 	"\x0eCreateTemplate\x12'.holos.console.v1.CreateTemplateRequest\x1a(.holos.console.v1.CreateTemplateResponse\x12c\n" +
 	"\x0eUpdateTemplate\x12'.holos.console.v1.UpdateTemplateRequest\x1a(.holos.console.v1.UpdateTemplateResponse\x12c\n" +
 	"\x0eDeleteTemplate\x12'.holos.console.v1.DeleteTemplateRequest\x1a(.holos.console.v1.DeleteTemplateResponse\x12c\n" +
 	"\x0eRenderTemplate\x12'.holos.console.v1.RenderTemplateRequest\x1a(.holos.console.v1.RenderTemplateResponse\x12`\n" +
-	"\rCloneTemplate\x12&.holos.console.v1.CloneTemplateRequest\x1a'.holos.console.v1.CloneTemplateResponse\x12x\n" +
-	"\x15ListLinkableTemplates\x12..holos.console.v1.ListLinkableTemplatesRequest\x1a/.holos.console.v1.ListLinkableTemplatesResponse\x12x\n" +
-	"\x15ListAncestorTemplates\x12..holos.console.v1.ListAncestorTemplatesRequest\x1a/.holos.console.v1.ListAncestorTemplatesResponse\x12`\n" +
-	"\rCreateRelease\x12&.holos.console.v1.CreateReleaseRequest\x1a'.holos.console.v1.CreateReleaseResponse\x12]\n" +
-	"\fListReleases\x12%.holos.console.v1.ListReleasesRequest\x1a&.holos.console.v1.ListReleasesResponse\x12W\n" +
+	"\rCloneTemplate\x12&.holos.console.v1.CloneTemplateRequest\x1a'.holos.console.v1.CloneTemplateResponse\x12}\n" +
+	"\x15ListLinkableTemplates\x12..holos.console.v1.ListLinkableTemplatesRequest\x1a/.holos.console.v1.ListLinkableTemplatesResponse\"\x03\x90\x02\x01\x12}\n" +
+	"\x15ListAncestorTemplates\x12..holos.console.v1.ListAncestorTemplatesRequest\x1a/.holos.console.v1.ListAncestorTemplatesResponse\"\x03\x90\x02\x01\x12`\n" +
+	"\rCreateRelease\x12&.holos.console.v1.CreateReleaseRequest\x1a'.holos.console.v1.CreateReleaseResponse\x12b\n" +
+	"\fListReleases\x12%.holos.console.v1.ListReleasesRequest\x1a&.holos.console.v1.ListReleasesResponse\"\x03\x90\x02\x01\x12\\\n" +
 	"\n" +
-	"GetRelease\x12#.holos.console.v1.GetReleaseRequest\x1a$.holos.console.v1.GetReleaseResponse\x12r\n" +
-	"\x13GetTemplateDefaults\x12,.holos.console.v1.GetTemplateDefaultsRequest\x1a-.holos.console.v1.GetTemplateDefaultsResponse\x12\x90\x01\n" +
-	"\x1dGetProjectTemplatePolicyState\x126.holos.console.v1.GetProjectTemplatePolicyStateRequest\x1a7.holos.console.v1.GetProjectTemplatePolicyStateResponse\x12f\n" +
-	"\x0fSearchTemplates\x12(.holos.console.v1.SearchTemplatesRequest\x1a).holos.console.v1.SearchTemplatesResponse\x12u\n" +
-	"\x14ListTemplateExamples\x12-.holos.console.v1.ListTemplateExamplesRequest\x1a..holos.console.v1.ListTemplateExamplesResponse\x12{\n" +
-	"\x16ListTemplateDependents\x12/.holos.console.v1.ListTemplateDependentsRequest\x1a0.holos.console.v1.ListTemplateDependentsResponse\x12\x81\x01\n" +
-	"\x18ListDeploymentDependents\x121.holos.console.v1.ListDeploymentDependentsRequest\x1a2.holos.console.v1.ListDeploymentDependentsResponseBCZAgithub.com/holos-run/holos-console/gen/holos/console/v1;consolev1b\x06proto3"
+	"GetRelease\x12#.holos.console.v1.GetReleaseRequest\x1a$.holos.console.v1.GetReleaseResponse\"\x03\x90\x02\x01\x12w\n" +
+	"\x13GetTemplateDefaults\x12,.holos.console.v1.GetTemplateDefaultsRequest\x1a-.holos.console.v1.GetTemplateDefaultsResponse\"\x03\x90\x02\x01\x12\x95\x01\n" +
+	"\x1dGetProjectTemplatePolicyState\x126.holos.console.v1.GetProjectTemplatePolicyStateRequest\x1a7.holos.console.v1.GetProjectTemplatePolicyStateResponse\"\x03\x90\x02\x01\x12k\n" +
+	"\x0fSearchTemplates\x12(.holos.console.v1.SearchTemplatesRequest\x1a).holos.console.v1.SearchTemplatesResponse\"\x03\x90\x02\x01\x12z\n" +
+	"\x14ListTemplateExamples\x12-.holos.console.v1.ListTemplateExamplesRequest\x1a..holos.console.v1.ListTemplateExamplesResponse\"\x03\x90\x02\x01\x12\x80\x01\n" +
+	"\x16ListTemplateDependents\x12/.holos.console.v1.ListTemplateDependentsRequest\x1a0.holos.console.v1.ListTemplateDependentsResponse\"\x03\x90\x02\x01\x12\x86\x01\n" +
+	"\x18ListDeploymentDependents\x121.holos.console.v1.ListDeploymentDependentsRequest\x1a2.holos.console.v1.ListDeploymentDependentsResponse\"\x03\x90\x02\x01BCZAgithub.com/holos-run/holos-console/gen/holos/console/v1;consolev1b\x06proto3"
 
 var (
 	file_holos_console_v1_templates_proto_rawDescOnce sync.Once
@@ -2907,73 +2917,75 @@ var file_holos_console_v1_templates_proto_goTypes = []any{
 	(*ListDeploymentDependentsRequest)(nil),       // 40: holos.console.v1.ListDeploymentDependentsRequest
 	(*ListDeploymentDependentsResponse)(nil),      // 41: holos.console.v1.ListDeploymentDependentsResponse
 	(*EnvVar)(nil),                                // 42: holos.console.v1.EnvVar
-	(*timestamppb.Timestamp)(nil),                 // 43: google.protobuf.Timestamp
-	(*GetProjectTemplatePolicyStateRequest)(nil),  // 44: holos.console.v1.GetProjectTemplatePolicyStateRequest
-	(*GetProjectTemplatePolicyStateResponse)(nil), // 45: holos.console.v1.GetProjectTemplatePolicyStateResponse
+	(*ListOrder)(nil),                             // 43: holos.console.v1.ListOrder
+	(*timestamppb.Timestamp)(nil),                 // 44: google.protobuf.Timestamp
+	(*GetProjectTemplatePolicyStateRequest)(nil),  // 45: holos.console.v1.GetProjectTemplatePolicyStateRequest
+	(*GetProjectTemplatePolicyStateResponse)(nil), // 46: holos.console.v1.GetProjectTemplatePolicyStateResponse
 }
 var file_holos_console_v1_templates_proto_depIdxs = []int32{
 	42, // 0: holos.console.v1.TemplateDefaults.env:type_name -> holos.console.v1.EnvVar
 	1,  // 1: holos.console.v1.GetTemplateDefaultsResponse.defaults:type_name -> holos.console.v1.TemplateDefaults
 	1,  // 2: holos.console.v1.Template.defaults:type_name -> holos.console.v1.TemplateDefaults
-	4,  // 3: holos.console.v1.ListTemplatesResponse.templates:type_name -> holos.console.v1.Template
-	4,  // 4: holos.console.v1.GetTemplateResponse.template:type_name -> holos.console.v1.Template
-	4,  // 5: holos.console.v1.CreateTemplateRequest.template:type_name -> holos.console.v1.Template
-	4,  // 6: holos.console.v1.UpdateTemplateRequest.template:type_name -> holos.console.v1.Template
-	24, // 7: holos.console.v1.LinkableTemplate.releases:type_name -> holos.console.v1.Release
-	20, // 8: holos.console.v1.ListLinkableTemplatesResponse.templates:type_name -> holos.console.v1.LinkableTemplate
-	4,  // 9: holos.console.v1.ListAncestorTemplatesResponse.templates:type_name -> holos.console.v1.Template
-	1,  // 10: holos.console.v1.Release.defaults:type_name -> holos.console.v1.TemplateDefaults
-	43, // 11: holos.console.v1.Release.created_at:type_name -> google.protobuf.Timestamp
-	24, // 12: holos.console.v1.CreateReleaseRequest.release:type_name -> holos.console.v1.Release
-	24, // 13: holos.console.v1.CreateReleaseResponse.release:type_name -> holos.console.v1.Release
-	24, // 14: holos.console.v1.ListReleasesResponse.releases:type_name -> holos.console.v1.Release
-	24, // 15: holos.console.v1.GetReleaseResponse.release:type_name -> holos.console.v1.Release
-	4,  // 16: holos.console.v1.SearchTemplatesResponse.templates:type_name -> holos.console.v1.Template
-	33, // 17: holos.console.v1.ListTemplateExamplesResponse.examples:type_name -> holos.console.v1.TemplateExample
-	0,  // 18: holos.console.v1.TemplateDependentRecord.scope:type_name -> holos.console.v1.DependencyScope
-	36, // 19: holos.console.v1.ListTemplateDependentsResponse.dependents:type_name -> holos.console.v1.TemplateDependentRecord
-	39, // 20: holos.console.v1.ListDeploymentDependentsResponse.dependents:type_name -> holos.console.v1.DeploymentDependentRecord
-	5,  // 21: holos.console.v1.TemplateService.ListTemplates:input_type -> holos.console.v1.ListTemplatesRequest
-	7,  // 22: holos.console.v1.TemplateService.GetTemplate:input_type -> holos.console.v1.GetTemplateRequest
-	9,  // 23: holos.console.v1.TemplateService.CreateTemplate:input_type -> holos.console.v1.CreateTemplateRequest
-	11, // 24: holos.console.v1.TemplateService.UpdateTemplate:input_type -> holos.console.v1.UpdateTemplateRequest
-	13, // 25: holos.console.v1.TemplateService.DeleteTemplate:input_type -> holos.console.v1.DeleteTemplateRequest
-	15, // 26: holos.console.v1.TemplateService.RenderTemplate:input_type -> holos.console.v1.RenderTemplateRequest
-	17, // 27: holos.console.v1.TemplateService.CloneTemplate:input_type -> holos.console.v1.CloneTemplateRequest
-	19, // 28: holos.console.v1.TemplateService.ListLinkableTemplates:input_type -> holos.console.v1.ListLinkableTemplatesRequest
-	22, // 29: holos.console.v1.TemplateService.ListAncestorTemplates:input_type -> holos.console.v1.ListAncestorTemplatesRequest
-	25, // 30: holos.console.v1.TemplateService.CreateRelease:input_type -> holos.console.v1.CreateReleaseRequest
-	27, // 31: holos.console.v1.TemplateService.ListReleases:input_type -> holos.console.v1.ListReleasesRequest
-	29, // 32: holos.console.v1.TemplateService.GetRelease:input_type -> holos.console.v1.GetReleaseRequest
-	2,  // 33: holos.console.v1.TemplateService.GetTemplateDefaults:input_type -> holos.console.v1.GetTemplateDefaultsRequest
-	44, // 34: holos.console.v1.TemplateService.GetProjectTemplatePolicyState:input_type -> holos.console.v1.GetProjectTemplatePolicyStateRequest
-	31, // 35: holos.console.v1.TemplateService.SearchTemplates:input_type -> holos.console.v1.SearchTemplatesRequest
-	34, // 36: holos.console.v1.TemplateService.ListTemplateExamples:input_type -> holos.console.v1.ListTemplateExamplesRequest
-	37, // 37: holos.console.v1.TemplateService.ListTemplateDependents:input_type -> holos.console.v1.ListTemplateDependentsRequest
-	40, // 38: holos.console.v1.TemplateService.ListDeploymentDependents:input_type -> holos.console.v1.ListDeploymentDependentsRequest
-	6,  // 39: holos.console.v1.TemplateService.ListTemplates:output_type -> holos.console.v1.ListTemplatesResponse
-	8,  // 40: holos.console.v1.TemplateService.GetTemplate:output_type -> holos.console.v1.GetTemplateResponse
-	10, // 41: holos.console.v1.TemplateService.CreateTemplate:output_type -> holos.console.v1.CreateTemplateResponse
-	12, // 42: holos.console.v1.TemplateService.UpdateTemplate:output_type -> holos.console.v1.UpdateTemplateResponse
-	14, // 43: holos.console.v1.TemplateService.DeleteTemplate:output_type -> holos.console.v1.DeleteTemplateResponse
-	16, // 44: holos.console.v1.TemplateService.RenderTemplate:output_type -> holos.console.v1.RenderTemplateResponse
-	18, // 45: holos.console.v1.TemplateService.CloneTemplate:output_type -> holos.console.v1.CloneTemplateResponse
-	21, // 46: holos.console.v1.TemplateService.ListLinkableTemplates:output_type -> holos.console.v1.ListLinkableTemplatesResponse
-	23, // 47: holos.console.v1.TemplateService.ListAncestorTemplates:output_type -> holos.console.v1.ListAncestorTemplatesResponse
-	26, // 48: holos.console.v1.TemplateService.CreateRelease:output_type -> holos.console.v1.CreateReleaseResponse
-	28, // 49: holos.console.v1.TemplateService.ListReleases:output_type -> holos.console.v1.ListReleasesResponse
-	30, // 50: holos.console.v1.TemplateService.GetRelease:output_type -> holos.console.v1.GetReleaseResponse
-	3,  // 51: holos.console.v1.TemplateService.GetTemplateDefaults:output_type -> holos.console.v1.GetTemplateDefaultsResponse
-	45, // 52: holos.console.v1.TemplateService.GetProjectTemplatePolicyState:output_type -> holos.console.v1.GetProjectTemplatePolicyStateResponse
-	32, // 53: holos.console.v1.TemplateService.SearchTemplates:output_type -> holos.console.v1.SearchTemplatesResponse
-	35, // 54: holos.console.v1.TemplateService.ListTemplateExamples:output_type -> holos.console.v1.ListTemplateExamplesResponse
-	38, // 55: holos.console.v1.TemplateService.ListTemplateDependents:output_type -> holos.console.v1.ListTemplateDependentsResponse
-	41, // 56: holos.console.v1.TemplateService.ListDeploymentDependents:output_type -> holos.console.v1.ListDeploymentDependentsResponse
-	39, // [39:57] is the sub-list for method output_type
-	21, // [21:39] is the sub-list for method input_type
-	21, // [21:21] is the sub-list for extension type_name
-	21, // [21:21] is the sub-list for extension extendee
-	0,  // [0:21] is the sub-list for field type_name
+	43, // 3: holos.console.v1.ListTemplatesRequest.order_by:type_name -> holos.console.v1.ListOrder
+	4,  // 4: holos.console.v1.ListTemplatesResponse.templates:type_name -> holos.console.v1.Template
+	4,  // 5: holos.console.v1.GetTemplateResponse.template:type_name -> holos.console.v1.Template
+	4,  // 6: holos.console.v1.CreateTemplateRequest.template:type_name -> holos.console.v1.Template
+	4,  // 7: holos.console.v1.UpdateTemplateRequest.template:type_name -> holos.console.v1.Template
+	24, // 8: holos.console.v1.LinkableTemplate.releases:type_name -> holos.console.v1.Release
+	20, // 9: holos.console.v1.ListLinkableTemplatesResponse.templates:type_name -> holos.console.v1.LinkableTemplate
+	4,  // 10: holos.console.v1.ListAncestorTemplatesResponse.templates:type_name -> holos.console.v1.Template
+	1,  // 11: holos.console.v1.Release.defaults:type_name -> holos.console.v1.TemplateDefaults
+	44, // 12: holos.console.v1.Release.created_at:type_name -> google.protobuf.Timestamp
+	24, // 13: holos.console.v1.CreateReleaseRequest.release:type_name -> holos.console.v1.Release
+	24, // 14: holos.console.v1.CreateReleaseResponse.release:type_name -> holos.console.v1.Release
+	24, // 15: holos.console.v1.ListReleasesResponse.releases:type_name -> holos.console.v1.Release
+	24, // 16: holos.console.v1.GetReleaseResponse.release:type_name -> holos.console.v1.Release
+	4,  // 17: holos.console.v1.SearchTemplatesResponse.templates:type_name -> holos.console.v1.Template
+	33, // 18: holos.console.v1.ListTemplateExamplesResponse.examples:type_name -> holos.console.v1.TemplateExample
+	0,  // 19: holos.console.v1.TemplateDependentRecord.scope:type_name -> holos.console.v1.DependencyScope
+	36, // 20: holos.console.v1.ListTemplateDependentsResponse.dependents:type_name -> holos.console.v1.TemplateDependentRecord
+	39, // 21: holos.console.v1.ListDeploymentDependentsResponse.dependents:type_name -> holos.console.v1.DeploymentDependentRecord
+	5,  // 22: holos.console.v1.TemplateService.ListTemplates:input_type -> holos.console.v1.ListTemplatesRequest
+	7,  // 23: holos.console.v1.TemplateService.GetTemplate:input_type -> holos.console.v1.GetTemplateRequest
+	9,  // 24: holos.console.v1.TemplateService.CreateTemplate:input_type -> holos.console.v1.CreateTemplateRequest
+	11, // 25: holos.console.v1.TemplateService.UpdateTemplate:input_type -> holos.console.v1.UpdateTemplateRequest
+	13, // 26: holos.console.v1.TemplateService.DeleteTemplate:input_type -> holos.console.v1.DeleteTemplateRequest
+	15, // 27: holos.console.v1.TemplateService.RenderTemplate:input_type -> holos.console.v1.RenderTemplateRequest
+	17, // 28: holos.console.v1.TemplateService.CloneTemplate:input_type -> holos.console.v1.CloneTemplateRequest
+	19, // 29: holos.console.v1.TemplateService.ListLinkableTemplates:input_type -> holos.console.v1.ListLinkableTemplatesRequest
+	22, // 30: holos.console.v1.TemplateService.ListAncestorTemplates:input_type -> holos.console.v1.ListAncestorTemplatesRequest
+	25, // 31: holos.console.v1.TemplateService.CreateRelease:input_type -> holos.console.v1.CreateReleaseRequest
+	27, // 32: holos.console.v1.TemplateService.ListReleases:input_type -> holos.console.v1.ListReleasesRequest
+	29, // 33: holos.console.v1.TemplateService.GetRelease:input_type -> holos.console.v1.GetReleaseRequest
+	2,  // 34: holos.console.v1.TemplateService.GetTemplateDefaults:input_type -> holos.console.v1.GetTemplateDefaultsRequest
+	45, // 35: holos.console.v1.TemplateService.GetProjectTemplatePolicyState:input_type -> holos.console.v1.GetProjectTemplatePolicyStateRequest
+	31, // 36: holos.console.v1.TemplateService.SearchTemplates:input_type -> holos.console.v1.SearchTemplatesRequest
+	34, // 37: holos.console.v1.TemplateService.ListTemplateExamples:input_type -> holos.console.v1.ListTemplateExamplesRequest
+	37, // 38: holos.console.v1.TemplateService.ListTemplateDependents:input_type -> holos.console.v1.ListTemplateDependentsRequest
+	40, // 39: holos.console.v1.TemplateService.ListDeploymentDependents:input_type -> holos.console.v1.ListDeploymentDependentsRequest
+	6,  // 40: holos.console.v1.TemplateService.ListTemplates:output_type -> holos.console.v1.ListTemplatesResponse
+	8,  // 41: holos.console.v1.TemplateService.GetTemplate:output_type -> holos.console.v1.GetTemplateResponse
+	10, // 42: holos.console.v1.TemplateService.CreateTemplate:output_type -> holos.console.v1.CreateTemplateResponse
+	12, // 43: holos.console.v1.TemplateService.UpdateTemplate:output_type -> holos.console.v1.UpdateTemplateResponse
+	14, // 44: holos.console.v1.TemplateService.DeleteTemplate:output_type -> holos.console.v1.DeleteTemplateResponse
+	16, // 45: holos.console.v1.TemplateService.RenderTemplate:output_type -> holos.console.v1.RenderTemplateResponse
+	18, // 46: holos.console.v1.TemplateService.CloneTemplate:output_type -> holos.console.v1.CloneTemplateResponse
+	21, // 47: holos.console.v1.TemplateService.ListLinkableTemplates:output_type -> holos.console.v1.ListLinkableTemplatesResponse
+	23, // 48: holos.console.v1.TemplateService.ListAncestorTemplates:output_type -> holos.console.v1.ListAncestorTemplatesResponse
+	26, // 49: holos.console.v1.TemplateService.CreateRelease:output_type -> holos.console.v1.CreateReleaseResponse
+	28, // 50: holos.console.v1.TemplateService.ListReleases:output_type -> holos.console.v1.ListReleasesResponse
+	30, // 51: holos.console.v1.TemplateService.GetRelease:output_type -> holos.console.v1.GetReleaseResponse
+	3,  // 52: holos.console.v1.TemplateService.GetTemplateDefaults:output_type -> holos.console.v1.GetTemplateDefaultsResponse
+	46, // 53: holos.console.v1.TemplateService.GetProjectTemplatePolicyState:output_type -> holos.console.v1.GetProjectTemplatePolicyStateResponse
+	32, // 54: holos.console.v1.TemplateService.SearchTemplates:output_type -> holos.console.v1.SearchTemplatesResponse
+	35, // 55: holos.console.v1.TemplateService.ListTemplateExamples:output_type -> holos.console.v1.ListTemplateExamplesResponse
+	38, // 56: holos.console.v1.TemplateService.ListTemplateDependents:output_type -> holos.console.v1.ListTemplateDependentsResponse
+	41, // 57: holos.console.v1.TemplateService.ListDeploymentDependents:output_type -> holos.console.v1.ListDeploymentDependentsResponse
+	40, // [40:58] is the sub-list for method output_type
+	22, // [22:40] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
 }
 
 func init() { file_holos_console_v1_templates_proto_init() }
@@ -2982,6 +2994,7 @@ func file_holos_console_v1_templates_proto_init() {
 		return
 	}
 	file_holos_console_v1_deployments_proto_init()
+	file_holos_console_v1_list_filter_proto_init()
 	file_holos_console_v1_policy_state_proto_init()
 	file_holos_console_v1_templates_proto_msgTypes[15].OneofWrappers = []any{}
 	type x struct{}
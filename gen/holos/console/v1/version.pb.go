@@ -143,10 +143,10 @@ const file_holos_console_v1_version_proto_rawDesc = "" +
 	"git_commit\x18\x02 \x01(\tR\tgitCommit\x12$\n" +
 	"\x0egit_tree_state\x18\x03 \x01(\tR\fgitTreeState\x12\x1d\n" +
 	"\n" +
-	"build_date\x18\x04 \x01(\tR\tbuildDate2i\n" +
-	"\x0eVersionService\x12W\n" +
+	"build_date\x18\x04 \x01(\tR\tbuildDate2n\n" +
+	"\x0eVersionService\x12\\\n" +
 	"\n" +
-	"GetVersion\x12#.holos.console.v1.GetVersionRequest\x1a$.holos.console.v1.GetVersionResponseBCZAgithub.com/holos-run/holos-console/gen/holos/console/v1;consolev1b\x06proto3"
+	"GetVersion\x12#.holos.console.v1.GetVersionRequest\x1a$.holos.console.v1.GetVersionResponse\"\x03\x90\x02\x01BCZAgithub.com/holos-run/holos-console/gen/holos/console/v1;consolev1b\x06proto3"
 
 var (
 	file_holos_console_v1_version_proto_rawDescOnce sync.Once
@@ -43,11 +43,13 @@ import (
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/config"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 
+	consolev1alpha1 "github.com/holos-run/holos-console/api/console/v1alpha1"
 	deploymentsv1alpha1 "github.com/holos-run/holos-console/api/deployments/v1alpha1"
 	v1alpha1 "github.com/holos-run/holos-console/api/templates/v1alpha1"
 	"github.com/holos-run/holos-console/console/deployments"
@@ -73,6 +75,10 @@ func init() {
 	// and the CRWriter can issue SSA patches through the manager's client
 	// (HOL-957).
 	utilruntime.Must(deploymentsv1alpha1.AddToScheme(Scheme))
+	// Console resources (console.holos.run/v1alpha1) — registered so the
+	// crd resource store can read and write ConsoleProject and ConsoleGrant
+	// objects through the manager's clients.
+	utilruntime.Must(consolev1alpha1.AddToScheme(Scheme))
 }
 
 // Options holds the knobs the console needs to construct a controller-runtime
@@ -120,6 +126,10 @@ type Options struct {
 	OrganizationPrefix string
 	FolderPrefix       string
 	ProjectPrefix      string
+	// Namespaces limits the informer caches to these namespaces so the
+	// manager needs only namespace-scoped list and watch permissions.
+	// Empty watches every namespace.
+	Namespaces []string
 
 	// GrantCache is the TemplateGrantCache the TemplateGrantReconciler
 	// keeps current. When nil, NewManager allocates a fresh cache so
@@ -214,6 +224,12 @@ func NewManager(cfg *rest.Config, scheme *runtime.Scheme, opts Options) (*Manage
 		LeaderElection:   false,
 		LeaderElectionID: "holos-console-controller-lock",
 	}
+	if len(opts.Namespaces) > 0 {
+		ctrlOpts.Cache.DefaultNamespaces = make(map[string]cache.Config, len(opts.Namespaces))
+		for _, ns := range opts.Namespaces {
+			ctrlOpts.Cache.DefaultNamespaces[ns] = cache.Config{}
+		}
+	}
 	if opts.SkipControllerNameValidation {
 		skip := true
 		ctrlOpts.Controller = config.Controller{SkipNameValidation: &skip}
@@ -338,6 +354,9 @@ func NewManager(cfg *rest.Config, scheme *runtime.Scheme, opts Options) (*Manage
 	if err := resourcerbac.SetupProjectReconciler(mgr, rbacClientset); err != nil {
 		return nil, fmt.Errorf("controller.NewManager: registering Project RBAC reconciler: %w", err)
 	}
+	if err := resourcerbac.SetupProjectWorkloadReconciler(mgr, rbacClientset); err != nil {
+		return nil, fmt.Errorf("controller.NewManager: registering project workload RBAC reconciler: %w", err)
+	}
 
 	// Prime the Namespace informer so the reconcilers (HOL-621+) can read
 	// console.holos.run/resource-type labels without round-trips. Namespace